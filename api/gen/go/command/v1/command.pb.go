@@ -77,13 +77,162 @@ func (x CRUD) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
+// 単価の一括変更の方法
+type PriceAdjustmentType int32
+
+const (
+	PriceAdjustmentType_PRICE_ADJUSTMENT_TYPE_UNSPECIFIED PriceAdjustmentType = 0 // 不明
+	PriceAdjustmentType_PRICE_ADJUSTMENT_TYPE_PERCENT     PriceAdjustmentType = 1 // 現在の単価に対する割合（%）で変更
+	PriceAdjustmentType_PRICE_ADJUSTMENT_TYPE_FIXED       PriceAdjustmentType = 2 // 金額（通貨の最小単位）で変更
+)
+
+// Enum value maps for PriceAdjustmentType.
+var (
+	PriceAdjustmentType_name = map[int32]string{
+		0: "PRICE_ADJUSTMENT_TYPE_UNSPECIFIED",
+		1: "PRICE_ADJUSTMENT_TYPE_PERCENT",
+		2: "PRICE_ADJUSTMENT_TYPE_FIXED",
+	}
+	PriceAdjustmentType_value = map[string]int32{
+		"PRICE_ADJUSTMENT_TYPE_UNSPECIFIED": 0,
+		"PRICE_ADJUSTMENT_TYPE_PERCENT":     1,
+		"PRICE_ADJUSTMENT_TYPE_FIXED":       2,
+	}
+)
+
+func (x PriceAdjustmentType) Enum() *PriceAdjustmentType {
+	p := new(PriceAdjustmentType)
+	*p = x
+	return p
+}
+
+func (x PriceAdjustmentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceAdjustmentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_command_v1_command_proto_enumTypes[1].Descriptor()
+}
+
+func (PriceAdjustmentType) Type() protoreflect.EnumType {
+	return &file_command_v1_command_proto_enumTypes[1]
+}
+
+func (x PriceAdjustmentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// 変更後の単価の端数処理の方法
+type PriceRounding int32
+
+const (
+	PriceRounding_PRICE_ROUNDING_UNSPECIFIED PriceRounding = 0 // 未指定（切り捨てとして扱う）
+	PriceRounding_PRICE_ROUNDING_FLOOR       PriceRounding = 1 // 切り捨て
+	PriceRounding_PRICE_ROUNDING_HALF_UP     PriceRounding = 2 // 四捨五入
+	PriceRounding_PRICE_ROUNDING_CEIL        PriceRounding = 3 // 切り上げ
+)
+
+// Enum value maps for PriceRounding.
+var (
+	PriceRounding_name = map[int32]string{
+		0: "PRICE_ROUNDING_UNSPECIFIED",
+		1: "PRICE_ROUNDING_FLOOR",
+		2: "PRICE_ROUNDING_HALF_UP",
+		3: "PRICE_ROUNDING_CEIL",
+	}
+	PriceRounding_value = map[string]int32{
+		"PRICE_ROUNDING_UNSPECIFIED": 0,
+		"PRICE_ROUNDING_FLOOR":       1,
+		"PRICE_ROUNDING_HALF_UP":     2,
+		"PRICE_ROUNDING_CEIL":        3,
+	}
+)
+
+func (x PriceRounding) Enum() *PriceRounding {
+	p := new(PriceRounding)
+	*p = x
+	return p
+}
+
+func (x PriceRounding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceRounding) Descriptor() protoreflect.EnumDescriptor {
+	return file_command_v1_command_proto_enumTypes[2].Descriptor()
+}
+
+func (PriceRounding) Type() protoreflect.EnumType {
+	return &file_command_v1_command_proto_enumTypes[2]
+}
+
+func (x PriceRounding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// 在庫引当の状態
+type ReservationStatus int32
+
+const (
+	ReservationStatus_RESERVATION_STATUS_UNSPECIFIED ReservationStatus = 0 // 不明
+	ReservationStatus_RESERVATION_STATUS_RESERVED    ReservationStatus = 1 // 引当中
+	ReservationStatus_RESERVATION_STATUS_RELEASED    ReservationStatus = 2 // 解放済み
+	ReservationStatus_RESERVATION_STATUS_COMMITTED   ReservationStatus = 3 // 確定済み
+	ReservationStatus_RESERVATION_STATUS_EXPIRED     ReservationStatus = 4 // 期限切れ
+)
+
+// Enum value maps for ReservationStatus.
+var (
+	ReservationStatus_name = map[int32]string{
+		0: "RESERVATION_STATUS_UNSPECIFIED",
+		1: "RESERVATION_STATUS_RESERVED",
+		2: "RESERVATION_STATUS_RELEASED",
+		3: "RESERVATION_STATUS_COMMITTED",
+		4: "RESERVATION_STATUS_EXPIRED",
+	}
+	ReservationStatus_value = map[string]int32{
+		"RESERVATION_STATUS_UNSPECIFIED": 0,
+		"RESERVATION_STATUS_RESERVED":    1,
+		"RESERVATION_STATUS_RELEASED":    2,
+		"RESERVATION_STATUS_COMMITTED":   3,
+		"RESERVATION_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x ReservationStatus) Enum() *ReservationStatus {
+	p := new(ReservationStatus)
+	*p = x
+	return p
+}
+
+func (x ReservationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReservationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_command_v1_command_proto_enumTypes[3].Descriptor()
+}
+
+func (ReservationStatus) Type() protoreflect.EnumType {
+	return &file_command_v1_command_proto_enumTypes[3]
+}
+
+func (x ReservationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 // CategoryService用のRequest/Response型
 type CreateCategoryRequest struct {
-	state           protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Crud CRUD                   `protobuf:"varint,1,opt,name=crud,proto3,enum=command.v1.CRUD"`
-	xxx_hidden_Name *v1.CategoryName       `protobuf:"bytes,2,opt,name=name,proto3"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Crud         CRUD                   `protobuf:"varint,1,opt,name=crud,proto3,enum=command.v1.CRUD"`
+	xxx_hidden_Name         *v1.CategoryName       `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_ParentId     *v1.CategoryId         `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3"`
+	xxx_hidden_Translations map[string]string      `protobuf:"bytes,4,rep,name=translations,proto3" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Slug         *string                `protobuf:"bytes,5,opt,name=slug,proto3,oneof"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *CreateCategoryRequest) Reset() {
@@ -125,6 +274,30 @@ func (x *CreateCategoryRequest) GetName() *v1.CategoryName {
 	return nil
 }
 
+func (x *CreateCategoryRequest) GetParentId() *v1.CategoryId {
+	if x != nil {
+		return x.xxx_hidden_ParentId
+	}
+	return nil
+}
+
+func (x *CreateCategoryRequest) GetTranslations() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Translations
+	}
+	return nil
+}
+
+func (x *CreateCategoryRequest) GetSlug() string {
+	if x != nil {
+		if x.xxx_hidden_Slug != nil {
+			return *x.xxx_hidden_Slug
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *CreateCategoryRequest) SetCrud(v CRUD) {
 	x.xxx_hidden_Crud = v
 }
@@ -133,6 +306,19 @@ func (x *CreateCategoryRequest) SetName(v *v1.CategoryName) {
 	x.xxx_hidden_Name = v
 }
 
+func (x *CreateCategoryRequest) SetParentId(v *v1.CategoryId) {
+	x.xxx_hidden_ParentId = v
+}
+
+func (x *CreateCategoryRequest) SetTranslations(v map[string]string) {
+	x.xxx_hidden_Translations = v
+}
+
+func (x *CreateCategoryRequest) SetSlug(v string) {
+	x.xxx_hidden_Slug = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
 func (x *CreateCategoryRequest) HasName() bool {
 	if x == nil {
 		return false
@@ -140,15 +326,41 @@ func (x *CreateCategoryRequest) HasName() bool {
 	return x.xxx_hidden_Name != nil
 }
 
+func (x *CreateCategoryRequest) HasParentId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ParentId != nil
+}
+
+func (x *CreateCategoryRequest) HasSlug() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
 func (x *CreateCategoryRequest) ClearName() {
 	x.xxx_hidden_Name = nil
 }
 
+func (x *CreateCategoryRequest) ClearParentId() {
+	x.xxx_hidden_ParentId = nil
+}
+
+func (x *CreateCategoryRequest) ClearSlug() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Slug = nil
+}
+
 type CreateCategoryRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Crud CRUD
-	Name *v1.CategoryName
+	Crud         CRUD
+	Name         *v1.CategoryName
+	ParentId     *v1.CategoryId
+	Translations map[string]string
+	Slug         *string
 }
 
 func (b0 CreateCategoryRequest_builder) Build() *CreateCategoryRequest {
@@ -157,6 +369,12 @@ func (b0 CreateCategoryRequest_builder) Build() *CreateCategoryRequest {
 	_, _ = b, x
 	x.xxx_hidden_Crud = b.Crud
 	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_ParentId = b.ParentId
+	x.xxx_hidden_Translations = b.Translations
+	if b.Slug != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Slug = b.Slug
+	}
 	return m0
 }
 
@@ -678,29 +896,28 @@ func (b0 DeleteCategoryResponse_builder) Build() *DeleteCategoryResponse {
 	return m0
 }
 
-// ProductService用のRequest/Response型
-type CreateProductRequest struct {
-	state              protoimpl.MessageState        `protogen:"opaque.v1"`
-	xxx_hidden_Crud    CRUD                          `protobuf:"varint,1,opt,name=crud,proto3,enum=command.v1.CRUD"`
-	xxx_hidden_Product *CreateProductRequest_Product `protobuf:"bytes,2,opt,name=product,proto3"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type MoveCategoryRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CategoryId *v1.CategoryId         `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3"`
+	xxx_hidden_ParentId   *v1.CategoryId         `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *CreateProductRequest) Reset() {
-	*x = CreateProductRequest{}
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
 	mi := &file_command_v1_command_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductRequest) String() string {
+func (x *MoveCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductRequest) ProtoMessage() {}
+func (*MoveCategoryRequest) ProtoMessage() {}
 
-func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_command_v1_command_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -712,78 +929,89 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateProductRequest) GetCrud() CRUD {
+func (x *MoveCategoryRequest) GetCategoryId() *v1.CategoryId {
 	if x != nil {
-		return x.xxx_hidden_Crud
+		return x.xxx_hidden_CategoryId
 	}
-	return CRUD_CRUD_UNSPECIFIED
+	return nil
 }
 
-func (x *CreateProductRequest) GetProduct() *CreateProductRequest_Product {
+func (x *MoveCategoryRequest) GetParentId() *v1.CategoryId {
 	if x != nil {
-		return x.xxx_hidden_Product
+		return x.xxx_hidden_ParentId
 	}
 	return nil
 }
 
-func (x *CreateProductRequest) SetCrud(v CRUD) {
-	x.xxx_hidden_Crud = v
+func (x *MoveCategoryRequest) SetCategoryId(v *v1.CategoryId) {
+	x.xxx_hidden_CategoryId = v
 }
 
-func (x *CreateProductRequest) SetProduct(v *CreateProductRequest_Product) {
-	x.xxx_hidden_Product = v
+func (x *MoveCategoryRequest) SetParentId(v *v1.CategoryId) {
+	x.xxx_hidden_ParentId = v
 }
 
-func (x *CreateProductRequest) HasProduct() bool {
+func (x *MoveCategoryRequest) HasCategoryId() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Product != nil
+	return x.xxx_hidden_CategoryId != nil
 }
 
-func (x *CreateProductRequest) ClearProduct() {
-	x.xxx_hidden_Product = nil
+func (x *MoveCategoryRequest) HasParentId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ParentId != nil
 }
 
-type CreateProductRequest_builder struct {
+func (x *MoveCategoryRequest) ClearCategoryId() {
+	x.xxx_hidden_CategoryId = nil
+}
+
+func (x *MoveCategoryRequest) ClearParentId() {
+	x.xxx_hidden_ParentId = nil
+}
+
+type MoveCategoryRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Crud    CRUD
-	Product *CreateProductRequest_Product
+	CategoryId *v1.CategoryId
+	ParentId   *v1.CategoryId
 }
 
-func (b0 CreateProductRequest_builder) Build() *CreateProductRequest {
-	m0 := &CreateProductRequest{}
+func (b0 MoveCategoryRequest_builder) Build() *MoveCategoryRequest {
+	m0 := &MoveCategoryRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Crud = b.Crud
-	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_CategoryId = b.CategoryId
+	x.xxx_hidden_ParentId = b.ParentId
 	return m0
 }
 
-type CreateProductResponse struct {
+type MoveCategoryResponse struct {
 	state                protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Product   *v1.Product            `protobuf:"bytes,1,opt,name=product,proto3"`
+	xxx_hidden_Category  *v1.Category           `protobuf:"bytes,1,opt,name=category,proto3"`
 	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
 	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
-func (x *CreateProductResponse) Reset() {
-	*x = CreateProductResponse{}
+func (x *MoveCategoryResponse) Reset() {
+	*x = MoveCategoryResponse{}
 	mi := &file_command_v1_command_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductResponse) String() string {
+func (x *MoveCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductResponse) ProtoMessage() {}
+func (*MoveCategoryResponse) ProtoMessage() {}
 
-func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+func (x *MoveCategoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_command_v1_command_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -795,112 +1023,113 @@ func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateProductResponse) GetProduct() *v1.Product {
+func (x *MoveCategoryResponse) GetCategory() *v1.Category {
 	if x != nil {
-		return x.xxx_hidden_Product
+		return x.xxx_hidden_Category
 	}
 	return nil
 }
 
-func (x *CreateProductResponse) GetError() *v1.Error {
+func (x *MoveCategoryResponse) GetError() *v1.Error {
 	if x != nil {
 		return x.xxx_hidden_Error
 	}
 	return nil
 }
 
-func (x *CreateProductResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *MoveCategoryResponse) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.xxx_hidden_Timestamp
 	}
 	return nil
 }
 
-func (x *CreateProductResponse) SetProduct(v *v1.Product) {
-	x.xxx_hidden_Product = v
+func (x *MoveCategoryResponse) SetCategory(v *v1.Category) {
+	x.xxx_hidden_Category = v
 }
 
-func (x *CreateProductResponse) SetError(v *v1.Error) {
+func (x *MoveCategoryResponse) SetError(v *v1.Error) {
 	x.xxx_hidden_Error = v
 }
 
-func (x *CreateProductResponse) SetTimestamp(v *timestamppb.Timestamp) {
+func (x *MoveCategoryResponse) SetTimestamp(v *timestamppb.Timestamp) {
 	x.xxx_hidden_Timestamp = v
 }
 
-func (x *CreateProductResponse) HasProduct() bool {
+func (x *MoveCategoryResponse) HasCategory() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Product != nil
+	return x.xxx_hidden_Category != nil
 }
 
-func (x *CreateProductResponse) HasError() bool {
+func (x *MoveCategoryResponse) HasError() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Error != nil
 }
 
-func (x *CreateProductResponse) HasTimestamp() bool {
+func (x *MoveCategoryResponse) HasTimestamp() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Timestamp != nil
 }
 
-func (x *CreateProductResponse) ClearProduct() {
-	x.xxx_hidden_Product = nil
+func (x *MoveCategoryResponse) ClearCategory() {
+	x.xxx_hidden_Category = nil
 }
 
-func (x *CreateProductResponse) ClearError() {
+func (x *MoveCategoryResponse) ClearError() {
 	x.xxx_hidden_Error = nil
 }
 
-func (x *CreateProductResponse) ClearTimestamp() {
+func (x *MoveCategoryResponse) ClearTimestamp() {
 	x.xxx_hidden_Timestamp = nil
 }
 
-type CreateProductResponse_builder struct {
+type MoveCategoryResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Product   *v1.Product
+	Category  *v1.Category
 	Error     *v1.Error
 	Timestamp *timestamppb.Timestamp
 }
 
-func (b0 CreateProductResponse_builder) Build() *CreateProductResponse {
-	m0 := &CreateProductResponse{}
+func (b0 MoveCategoryResponse_builder) Build() *MoveCategoryResponse {
+	m0 := &MoveCategoryResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Category = b.Category
 	x.xxx_hidden_Error = b.Error
 	x.xxx_hidden_Timestamp = b.Timestamp
 	return m0
 }
 
-type UpdateProductRequest struct {
+// ProductService用のRequest/Response型
+type CreateProductRequest struct {
 	state              protoimpl.MessageState        `protogen:"opaque.v1"`
 	xxx_hidden_Crud    CRUD                          `protobuf:"varint,1,opt,name=crud,proto3,enum=command.v1.CRUD"`
-	xxx_hidden_Product *UpdateProductRequest_Product `protobuf:"bytes,2,opt,name=product,proto3"`
+	xxx_hidden_Product *CreateProductRequest_Product `protobuf:"bytes,2,opt,name=product,proto3"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
 	mi := &file_command_v1_command_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *CreateProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*CreateProductRequest) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_command_v1_command_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -912,48 +1141,48 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateProductRequest) GetCrud() CRUD {
+func (x *CreateProductRequest) GetCrud() CRUD {
 	if x != nil {
 		return x.xxx_hidden_Crud
 	}
 	return CRUD_CRUD_UNSPECIFIED
 }
 
-func (x *UpdateProductRequest) GetProduct() *UpdateProductRequest_Product {
+func (x *CreateProductRequest) GetProduct() *CreateProductRequest_Product {
 	if x != nil {
 		return x.xxx_hidden_Product
 	}
 	return nil
 }
 
-func (x *UpdateProductRequest) SetCrud(v CRUD) {
+func (x *CreateProductRequest) SetCrud(v CRUD) {
 	x.xxx_hidden_Crud = v
 }
 
-func (x *UpdateProductRequest) SetProduct(v *UpdateProductRequest_Product) {
+func (x *CreateProductRequest) SetProduct(v *CreateProductRequest_Product) {
 	x.xxx_hidden_Product = v
 }
 
-func (x *UpdateProductRequest) HasProduct() bool {
+func (x *CreateProductRequest) HasProduct() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Product != nil
 }
 
-func (x *UpdateProductRequest) ClearProduct() {
+func (x *CreateProductRequest) ClearProduct() {
 	x.xxx_hidden_Product = nil
 }
 
-type UpdateProductRequest_builder struct {
+type CreateProductRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Crud    CRUD
-	Product *UpdateProductRequest_Product
+	Product *CreateProductRequest_Product
 }
 
-func (b0 UpdateProductRequest_builder) Build() *UpdateProductRequest {
-	m0 := &UpdateProductRequest{}
+func (b0 CreateProductRequest_builder) Build() *CreateProductRequest {
+	m0 := &CreateProductRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_Crud = b.Crud
@@ -961,7 +1190,7 @@ func (b0 UpdateProductRequest_builder) Build() *UpdateProductRequest {
 	return m0
 }
 
-type UpdateProductResponse struct {
+type CreateProductResponse struct {
 	state                protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Product   *v1.Product            `protobuf:"bytes,1,opt,name=product,proto3"`
 	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
@@ -970,20 +1199,20 @@ type UpdateProductResponse struct {
 	sizeCache            protoimpl.SizeCache
 }
 
-func (x *UpdateProductResponse) Reset() {
-	*x = UpdateProductResponse{}
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
 	mi := &file_command_v1_command_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductResponse) String() string {
+func (x *CreateProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductResponse) ProtoMessage() {}
+func (*CreateProductResponse) ProtoMessage() {}
 
-func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_command_v1_command_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -995,47 +1224,247 @@ func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateProductResponse) GetProduct() *v1.Product {
+func (x *CreateProductResponse) GetProduct() *v1.Product {
 	if x != nil {
 		return x.xxx_hidden_Product
 	}
 	return nil
 }
 
-func (x *UpdateProductResponse) GetError() *v1.Error {
+func (x *CreateProductResponse) GetError() *v1.Error {
 	if x != nil {
 		return x.xxx_hidden_Error
 	}
 	return nil
 }
 
-func (x *UpdateProductResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *CreateProductResponse) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.xxx_hidden_Timestamp
 	}
 	return nil
 }
 
-func (x *UpdateProductResponse) SetProduct(v *v1.Product) {
+func (x *CreateProductResponse) SetProduct(v *v1.Product) {
 	x.xxx_hidden_Product = v
 }
 
-func (x *UpdateProductResponse) SetError(v *v1.Error) {
+func (x *CreateProductResponse) SetError(v *v1.Error) {
 	x.xxx_hidden_Error = v
 }
 
-func (x *UpdateProductResponse) SetTimestamp(v *timestamppb.Timestamp) {
+func (x *CreateProductResponse) SetTimestamp(v *timestamppb.Timestamp) {
 	x.xxx_hidden_Timestamp = v
 }
 
-func (x *UpdateProductResponse) HasProduct() bool {
+func (x *CreateProductResponse) HasProduct() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Product != nil
 }
 
-func (x *UpdateProductResponse) HasError() bool {
+func (x *CreateProductResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *CreateProductResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *CreateProductResponse) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+func (x *CreateProductResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *CreateProductResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type CreateProductResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product   *v1.Product
+	Error     *v1.Error
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 CreateProductResponse_builder) Build() *CreateProductResponse {
+	m0 := &CreateProductResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type UpdateProductRequest struct {
+	state              protoimpl.MessageState        `protogen:"opaque.v1"`
+	xxx_hidden_Crud    CRUD                          `protobuf:"varint,1,opt,name=crud,proto3,enum=command.v1.CRUD"`
+	xxx_hidden_Product *UpdateProductRequest_Product `protobuf:"bytes,2,opt,name=product,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateProductRequest) GetCrud() CRUD {
+	if x != nil {
+		return x.xxx_hidden_Crud
+	}
+	return CRUD_CRUD_UNSPECIFIED
+}
+
+func (x *UpdateProductRequest) GetProduct() *UpdateProductRequest_Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) SetCrud(v CRUD) {
+	x.xxx_hidden_Crud = v
+}
+
+func (x *UpdateProductRequest) SetProduct(v *UpdateProductRequest_Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *UpdateProductRequest) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *UpdateProductRequest) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+type UpdateProductRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Crud    CRUD
+	Product *UpdateProductRequest_Product
+}
+
+func (b0 UpdateProductRequest_builder) Build() *UpdateProductRequest {
+	m0 := &UpdateProductRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Crud = b.Crud
+	x.xxx_hidden_Product = b.Product
+	return m0
+}
+
+type UpdateProductResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product   *v1.Product            `protobuf:"bytes,1,opt,name=product,proto3"`
+	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateProductResponse) GetProduct() *v1.Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *UpdateProductResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *UpdateProductResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *UpdateProductResponse) SetProduct(v *v1.Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *UpdateProductResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *UpdateProductResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *UpdateProductResponse) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *UpdateProductResponse) HasError() bool {
 	if x == nil {
 		return false
 	}
@@ -1073,34 +1502,3610 @@ func (b0 UpdateProductResponse_builder) Build() *UpdateProductResponse {
 	m0 := &UpdateProductResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type DeleteProductRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteProductRequest) GetProductId() *v1.ProductId {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return nil
+}
+
+func (x *DeleteProductRequest) SetProductId(v *v1.ProductId) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *DeleteProductRequest) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ProductId != nil
+}
+
+func (x *DeleteProductRequest) ClearProductId() {
+	x.xxx_hidden_ProductId = nil
+}
+
+type DeleteProductRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId *v1.ProductId
+}
+
+func (b0 DeleteProductRequest_builder) Build() *DeleteProductRequest {
+	m0 := &DeleteProductRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	return m0
+}
+
+type DeleteProductResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product   *v1.Product            `protobuf:"bytes,1,opt,name=product,proto3"`
+	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteProductResponse) GetProduct() *v1.Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *DeleteProductResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *DeleteProductResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *DeleteProductResponse) SetProduct(v *v1.Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *DeleteProductResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *DeleteProductResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *DeleteProductResponse) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *DeleteProductResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *DeleteProductResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *DeleteProductResponse) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+func (x *DeleteProductResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *DeleteProductResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type DeleteProductResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product   *v1.Product
+	Error     *v1.Error
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 DeleteProductResponse_builder) Build() *DeleteProductResponse {
+	m0 := &DeleteProductResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type PublishProductRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PublishProductRequest) Reset() {
+	*x = PublishProductRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishProductRequest) ProtoMessage() {}
+
+func (x *PublishProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PublishProductRequest) GetProductId() *v1.ProductId {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return nil
+}
+
+func (x *PublishProductRequest) SetProductId(v *v1.ProductId) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *PublishProductRequest) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ProductId != nil
+}
+
+func (x *PublishProductRequest) ClearProductId() {
+	x.xxx_hidden_ProductId = nil
+}
+
+type PublishProductRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId *v1.ProductId
+}
+
+func (b0 PublishProductRequest_builder) Build() *PublishProductRequest {
+	m0 := &PublishProductRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	return m0
+}
+
+type PublishProductResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product   *v1.Product            `protobuf:"bytes,1,opt,name=product,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PublishProductResponse) Reset() {
+	*x = PublishProductResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishProductResponse) ProtoMessage() {}
+
+func (x *PublishProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PublishProductResponse) GetProduct() *v1.Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *PublishProductResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *PublishProductResponse) SetProduct(v *v1.Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *PublishProductResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *PublishProductResponse) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *PublishProductResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *PublishProductResponse) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+func (x *PublishProductResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type PublishProductResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product   *v1.Product
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 PublishProductResponse_builder) Build() *PublishProductResponse {
+	m0 := &PublishProductResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type SuspendProductRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SuspendProductRequest) Reset() {
+	*x = SuspendProductRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendProductRequest) ProtoMessage() {}
+
+func (x *SuspendProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SuspendProductRequest) GetProductId() *v1.ProductId {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return nil
+}
+
+func (x *SuspendProductRequest) SetProductId(v *v1.ProductId) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *SuspendProductRequest) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ProductId != nil
+}
+
+func (x *SuspendProductRequest) ClearProductId() {
+	x.xxx_hidden_ProductId = nil
+}
+
+type SuspendProductRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId *v1.ProductId
+}
+
+func (b0 SuspendProductRequest_builder) Build() *SuspendProductRequest {
+	m0 := &SuspendProductRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	return m0
+}
+
+type SuspendProductResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product   *v1.Product            `protobuf:"bytes,1,opt,name=product,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SuspendProductResponse) Reset() {
+	*x = SuspendProductResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendProductResponse) ProtoMessage() {}
+
+func (x *SuspendProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SuspendProductResponse) GetProduct() *v1.Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *SuspendProductResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *SuspendProductResponse) SetProduct(v *v1.Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *SuspendProductResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *SuspendProductResponse) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *SuspendProductResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *SuspendProductResponse) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+func (x *SuspendProductResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type SuspendProductResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product   *v1.Product
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 SuspendProductResponse_builder) Build() *SuspendProductResponse {
+	m0 := &SuspendProductResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type DiscontinueProductRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DiscontinueProductRequest) Reset() {
+	*x = DiscontinueProductRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscontinueProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscontinueProductRequest) ProtoMessage() {}
+
+func (x *DiscontinueProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DiscontinueProductRequest) GetProductId() *v1.ProductId {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return nil
+}
+
+func (x *DiscontinueProductRequest) SetProductId(v *v1.ProductId) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *DiscontinueProductRequest) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ProductId != nil
+}
+
+func (x *DiscontinueProductRequest) ClearProductId() {
+	x.xxx_hidden_ProductId = nil
+}
+
+type DiscontinueProductRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId *v1.ProductId
+}
+
+func (b0 DiscontinueProductRequest_builder) Build() *DiscontinueProductRequest {
+	m0 := &DiscontinueProductRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	return m0
+}
+
+type DiscontinueProductResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product   *v1.Product            `protobuf:"bytes,1,opt,name=product,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DiscontinueProductResponse) Reset() {
+	*x = DiscontinueProductResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscontinueProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscontinueProductResponse) ProtoMessage() {}
+
+func (x *DiscontinueProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DiscontinueProductResponse) GetProduct() *v1.Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *DiscontinueProductResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *DiscontinueProductResponse) SetProduct(v *v1.Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *DiscontinueProductResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *DiscontinueProductResponse) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *DiscontinueProductResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *DiscontinueProductResponse) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+func (x *DiscontinueProductResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type DiscontinueProductResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product   *v1.Product
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 DiscontinueProductResponse_builder) Build() *DiscontinueProductResponse {
+	m0 := &DiscontinueProductResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+// 商品バリエーションの属性（追加・更新リクエストで使用）
+type VariantAttributes struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3"`
+	xxx_hidden_Options       *[]*v1.VariantOption   `protobuf:"bytes,2,rep,name=options,proto3"`
+	xxx_hidden_PriceOverride int32                  `protobuf:"varint,3,opt,name=price_override,json=priceOverride,proto3,oneof"`
+	xxx_hidden_Status        v1.VariantStatus       `protobuf:"varint,4,opt,name=status,proto3,enum=common.v1.VariantStatus"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *VariantAttributes) Reset() {
+	*x = VariantAttributes{}
+	mi := &file_command_v1_command_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantAttributes) ProtoMessage() {}
+
+func (x *VariantAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VariantAttributes) GetSku() string {
+	if x != nil {
+		return x.xxx_hidden_Sku
+	}
+	return ""
+}
+
+func (x *VariantAttributes) GetOptions() []*v1.VariantOption {
+	if x != nil {
+		if x.xxx_hidden_Options != nil {
+			return *x.xxx_hidden_Options
+		}
+	}
+	return nil
+}
+
+func (x *VariantAttributes) GetPriceOverride() int32 {
+	if x != nil {
+		return x.xxx_hidden_PriceOverride
+	}
+	return 0
+}
+
+func (x *VariantAttributes) GetStatus() v1.VariantStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return v1.VariantStatus(0)
+}
+
+func (x *VariantAttributes) SetSku(v string) {
+	x.xxx_hidden_Sku = v
+}
+
+func (x *VariantAttributes) SetOptions(v []*v1.VariantOption) {
+	x.xxx_hidden_Options = &v
+}
+
+func (x *VariantAttributes) SetPriceOverride(v int32) {
+	x.xxx_hidden_PriceOverride = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *VariantAttributes) SetStatus(v v1.VariantStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *VariantAttributes) HasPriceOverride() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *VariantAttributes) ClearPriceOverride() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_PriceOverride = 0
+}
+
+type VariantAttributes_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Sku           string
+	Options       []*v1.VariantOption
+	PriceOverride *int32
+	Status        v1.VariantStatus
+}
+
+func (b0 VariantAttributes_builder) Build() *VariantAttributes {
+	m0 := &VariantAttributes{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Sku = b.Sku
+	x.xxx_hidden_Options = &b.Options
+	if b.PriceOverride != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_PriceOverride = *b.PriceOverride
+	}
+	x.xxx_hidden_Status = b.Status
+	return m0
+}
+
+type AddVariantRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_Variant   *VariantAttributes     `protobuf:"bytes,2,opt,name=variant,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AddVariantRequest) Reset() {
+	*x = AddVariantRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVariantRequest) ProtoMessage() {}
+
+func (x *AddVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AddVariantRequest) GetProductId() *v1.ProductId {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return nil
+}
+
+func (x *AddVariantRequest) GetVariant() *VariantAttributes {
+	if x != nil {
+		return x.xxx_hidden_Variant
+	}
+	return nil
+}
+
+func (x *AddVariantRequest) SetProductId(v *v1.ProductId) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *AddVariantRequest) SetVariant(v *VariantAttributes) {
+	x.xxx_hidden_Variant = v
+}
+
+func (x *AddVariantRequest) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ProductId != nil
+}
+
+func (x *AddVariantRequest) HasVariant() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Variant != nil
+}
+
+func (x *AddVariantRequest) ClearProductId() {
+	x.xxx_hidden_ProductId = nil
+}
+
+func (x *AddVariantRequest) ClearVariant() {
+	x.xxx_hidden_Variant = nil
+}
+
+type AddVariantRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId *v1.ProductId
+	Variant   *VariantAttributes
+}
+
+func (b0 AddVariantRequest_builder) Build() *AddVariantRequest {
+	m0 := &AddVariantRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_Variant = b.Variant
+	return m0
+}
+
+type AddVariantResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Variant   *v1.ProductVariant     `protobuf:"bytes,1,opt,name=variant,proto3"`
+	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AddVariantResponse) Reset() {
+	*x = AddVariantResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVariantResponse) ProtoMessage() {}
+
+func (x *AddVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AddVariantResponse) GetVariant() *v1.ProductVariant {
+	if x != nil {
+		return x.xxx_hidden_Variant
+	}
+	return nil
+}
+
+func (x *AddVariantResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *AddVariantResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *AddVariantResponse) SetVariant(v *v1.ProductVariant) {
+	x.xxx_hidden_Variant = v
+}
+
+func (x *AddVariantResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *AddVariantResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *AddVariantResponse) HasVariant() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Variant != nil
+}
+
+func (x *AddVariantResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *AddVariantResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *AddVariantResponse) ClearVariant() {
+	x.xxx_hidden_Variant = nil
+}
+
+func (x *AddVariantResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *AddVariantResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type AddVariantResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Variant   *v1.ProductVariant
+	Error     *v1.Error
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 AddVariantResponse_builder) Build() *AddVariantResponse {
+	m0 := &AddVariantResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Variant = b.Variant
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type UpdateVariantRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_VariantId string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3"`
+	xxx_hidden_Variant   *VariantAttributes     `protobuf:"bytes,3,opt,name=variant,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateVariantRequest) Reset() {
+	*x = UpdateVariantRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariantRequest) ProtoMessage() {}
+
+func (x *UpdateVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateVariantRequest) GetProductId() *v1.ProductId {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return nil
+}
+
+func (x *UpdateVariantRequest) GetVariantId() string {
+	if x != nil {
+		return x.xxx_hidden_VariantId
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetVariant() *VariantAttributes {
+	if x != nil {
+		return x.xxx_hidden_Variant
+	}
+	return nil
+}
+
+func (x *UpdateVariantRequest) SetProductId(v *v1.ProductId) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *UpdateVariantRequest) SetVariantId(v string) {
+	x.xxx_hidden_VariantId = v
+}
+
+func (x *UpdateVariantRequest) SetVariant(v *VariantAttributes) {
+	x.xxx_hidden_Variant = v
+}
+
+func (x *UpdateVariantRequest) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ProductId != nil
+}
+
+func (x *UpdateVariantRequest) HasVariant() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Variant != nil
+}
+
+func (x *UpdateVariantRequest) ClearProductId() {
+	x.xxx_hidden_ProductId = nil
+}
+
+func (x *UpdateVariantRequest) ClearVariant() {
+	x.xxx_hidden_Variant = nil
+}
+
+type UpdateVariantRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId *v1.ProductId
+	VariantId string
+	Variant   *VariantAttributes
+}
+
+func (b0 UpdateVariantRequest_builder) Build() *UpdateVariantRequest {
+	m0 := &UpdateVariantRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_VariantId = b.VariantId
+	x.xxx_hidden_Variant = b.Variant
+	return m0
+}
+
+type UpdateVariantResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Variant   *v1.ProductVariant     `protobuf:"bytes,1,opt,name=variant,proto3"`
+	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateVariantResponse) Reset() {
+	*x = UpdateVariantResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariantResponse) ProtoMessage() {}
+
+func (x *UpdateVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateVariantResponse) GetVariant() *v1.ProductVariant {
+	if x != nil {
+		return x.xxx_hidden_Variant
+	}
+	return nil
+}
+
+func (x *UpdateVariantResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *UpdateVariantResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *UpdateVariantResponse) SetVariant(v *v1.ProductVariant) {
+	x.xxx_hidden_Variant = v
+}
+
+func (x *UpdateVariantResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *UpdateVariantResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *UpdateVariantResponse) HasVariant() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Variant != nil
+}
+
+func (x *UpdateVariantResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *UpdateVariantResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *UpdateVariantResponse) ClearVariant() {
+	x.xxx_hidden_Variant = nil
+}
+
+func (x *UpdateVariantResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *UpdateVariantResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type UpdateVariantResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Variant   *v1.ProductVariant
+	Error     *v1.Error
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 UpdateVariantResponse_builder) Build() *UpdateVariantResponse {
+	m0 := &UpdateVariantResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Variant = b.Variant
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type RemoveVariantRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_VariantId string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RemoveVariantRequest) Reset() {
+	*x = RemoveVariantRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveVariantRequest) ProtoMessage() {}
+
+func (x *RemoveVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RemoveVariantRequest) GetProductId() *v1.ProductId {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return nil
+}
+
+func (x *RemoveVariantRequest) GetVariantId() string {
+	if x != nil {
+		return x.xxx_hidden_VariantId
+	}
+	return ""
+}
+
+func (x *RemoveVariantRequest) SetProductId(v *v1.ProductId) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *RemoveVariantRequest) SetVariantId(v string) {
+	x.xxx_hidden_VariantId = v
+}
+
+func (x *RemoveVariantRequest) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ProductId != nil
+}
+
+func (x *RemoveVariantRequest) ClearProductId() {
+	x.xxx_hidden_ProductId = nil
+}
+
+type RemoveVariantRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId *v1.ProductId
+	VariantId string
+}
+
+func (b0 RemoveVariantRequest_builder) Build() *RemoveVariantRequest {
+	m0 := &RemoveVariantRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_VariantId = b.VariantId
+	return m0
+}
+
+type RemoveVariantResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Variant   *v1.ProductVariant     `protobuf:"bytes,1,opt,name=variant,proto3"`
+	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RemoveVariantResponse) Reset() {
+	*x = RemoveVariantResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveVariantResponse) ProtoMessage() {}
+
+func (x *RemoveVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RemoveVariantResponse) GetVariant() *v1.ProductVariant {
+	if x != nil {
+		return x.xxx_hidden_Variant
+	}
+	return nil
+}
+
+func (x *RemoveVariantResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *RemoveVariantResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *RemoveVariantResponse) SetVariant(v *v1.ProductVariant) {
+	x.xxx_hidden_Variant = v
+}
+
+func (x *RemoveVariantResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *RemoveVariantResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *RemoveVariantResponse) HasVariant() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Variant != nil
+}
+
+func (x *RemoveVariantResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *RemoveVariantResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *RemoveVariantResponse) ClearVariant() {
+	x.xxx_hidden_Variant = nil
+}
+
+func (x *RemoveVariantResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *RemoveVariantResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type RemoveVariantResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Variant   *v1.ProductVariant
+	Error     *v1.Error
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 RemoveVariantResponse_builder) Build() *RemoveVariantResponse {
+	m0 := &RemoveVariantResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Variant = b.Variant
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+// 単価の一括変更の規則
+type PriceAdjustment struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Type         PriceAdjustmentType    `protobuf:"varint,1,opt,name=type,proto3,enum=command.v1.PriceAdjustmentType"`
+	xxx_hidden_Value        int32                  `protobuf:"varint,2,opt,name=value,proto3"`
+	xxx_hidden_Rounding     PriceRounding          `protobuf:"varint,3,opt,name=rounding,proto3,enum=command.v1.PriceRounding"`
+	xxx_hidden_RoundingUnit uint32                 `protobuf:"varint,4,opt,name=rounding_unit,json=roundingUnit,proto3"`
+	xxx_hidden_MinPrice     uint32                 `protobuf:"varint,5,opt,name=min_price,json=minPrice,proto3,oneof"`
+	xxx_hidden_MaxPrice     uint32                 `protobuf:"varint,6,opt,name=max_price,json=maxPrice,proto3,oneof"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *PriceAdjustment) Reset() {
+	*x = PriceAdjustment{}
+	mi := &file_command_v1_command_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAdjustment) ProtoMessage() {}
+
+func (x *PriceAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PriceAdjustment) GetType() PriceAdjustmentType {
+	if x != nil {
+		return x.xxx_hidden_Type
+	}
+	return PriceAdjustmentType_PRICE_ADJUSTMENT_TYPE_UNSPECIFIED
+}
+
+func (x *PriceAdjustment) GetValue() int32 {
+	if x != nil {
+		return x.xxx_hidden_Value
+	}
+	return 0
+}
+
+func (x *PriceAdjustment) GetRounding() PriceRounding {
+	if x != nil {
+		return x.xxx_hidden_Rounding
+	}
+	return PriceRounding_PRICE_ROUNDING_UNSPECIFIED
+}
+
+func (x *PriceAdjustment) GetRoundingUnit() uint32 {
+	if x != nil {
+		return x.xxx_hidden_RoundingUnit
+	}
+	return 0
+}
+
+func (x *PriceAdjustment) GetMinPrice() uint32 {
+	if x != nil {
+		return x.xxx_hidden_MinPrice
+	}
+	return 0
+}
+
+func (x *PriceAdjustment) GetMaxPrice() uint32 {
+	if x != nil {
+		return x.xxx_hidden_MaxPrice
+	}
+	return 0
+}
+
+func (x *PriceAdjustment) SetType(v PriceAdjustmentType) {
+	x.xxx_hidden_Type = v
+}
+
+func (x *PriceAdjustment) SetValue(v int32) {
+	x.xxx_hidden_Value = v
+}
+
+func (x *PriceAdjustment) SetRounding(v PriceRounding) {
+	x.xxx_hidden_Rounding = v
+}
+
+func (x *PriceAdjustment) SetRoundingUnit(v uint32) {
+	x.xxx_hidden_RoundingUnit = v
+}
+
+func (x *PriceAdjustment) SetMinPrice(v uint32) {
+	x.xxx_hidden_MinPrice = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *PriceAdjustment) SetMaxPrice(v uint32) {
+	x.xxx_hidden_MaxPrice = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *PriceAdjustment) HasMinPrice() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *PriceAdjustment) HasMaxPrice() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *PriceAdjustment) ClearMinPrice() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_MinPrice = 0
+}
+
+func (x *PriceAdjustment) ClearMaxPrice() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_MaxPrice = 0
+}
+
+type PriceAdjustment_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Type         PriceAdjustmentType
+	Value        int32
+	Rounding     PriceRounding
+	RoundingUnit uint32
+	MinPrice     *uint32
+	MaxPrice     *uint32
+}
+
+func (b0 PriceAdjustment_builder) Build() *PriceAdjustment {
+	m0 := &PriceAdjustment{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Type = b.Type
+	x.xxx_hidden_Value = b.Value
+	x.xxx_hidden_Rounding = b.Rounding
+	x.xxx_hidden_RoundingUnit = b.RoundingUnit
+	if b.MinPrice != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_MinPrice = *b.MinPrice
+	}
+	if b.MaxPrice != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_MaxPrice = *b.MaxPrice
+	}
+	return m0
+}
+
+type AdjustPricesRequest struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CategoryId         *v1.CategoryId         `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3"`
+	xxx_hidden_IncludeDescendants bool                   `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3"`
+	xxx_hidden_Tags               []string               `protobuf:"bytes,3,rep,name=tags,proto3"`
+	xxx_hidden_Adjustment         *PriceAdjustment       `protobuf:"bytes,4,opt,name=adjustment,proto3"`
+	xxx_hidden_Preview            bool                   `protobuf:"varint,5,opt,name=preview,proto3"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AdjustPricesRequest) Reset() {
+	*x = AdjustPricesRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustPricesRequest) ProtoMessage() {}
+
+func (x *AdjustPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AdjustPricesRequest) GetCategoryId() *v1.CategoryId {
+	if x != nil {
+		return x.xxx_hidden_CategoryId
+	}
+	return nil
+}
+
+func (x *AdjustPricesRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeDescendants
+	}
+	return false
+}
+
+func (x *AdjustPricesRequest) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
+func (x *AdjustPricesRequest) GetAdjustment() *PriceAdjustment {
+	if x != nil {
+		return x.xxx_hidden_Adjustment
+	}
+	return nil
+}
+
+func (x *AdjustPricesRequest) GetPreview() bool {
+	if x != nil {
+		return x.xxx_hidden_Preview
+	}
+	return false
+}
+
+func (x *AdjustPricesRequest) SetCategoryId(v *v1.CategoryId) {
+	x.xxx_hidden_CategoryId = v
+}
+
+func (x *AdjustPricesRequest) SetIncludeDescendants(v bool) {
+	x.xxx_hidden_IncludeDescendants = v
+}
+
+func (x *AdjustPricesRequest) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
+func (x *AdjustPricesRequest) SetAdjustment(v *PriceAdjustment) {
+	x.xxx_hidden_Adjustment = v
+}
+
+func (x *AdjustPricesRequest) SetPreview(v bool) {
+	x.xxx_hidden_Preview = v
+}
+
+func (x *AdjustPricesRequest) HasCategoryId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CategoryId != nil
+}
+
+func (x *AdjustPricesRequest) HasAdjustment() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Adjustment != nil
+}
+
+func (x *AdjustPricesRequest) ClearCategoryId() {
+	x.xxx_hidden_CategoryId = nil
+}
+
+func (x *AdjustPricesRequest) ClearAdjustment() {
+	x.xxx_hidden_Adjustment = nil
+}
+
+type AdjustPricesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CategoryId         *v1.CategoryId
+	IncludeDescendants bool
+	Tags               []string
+	Adjustment         *PriceAdjustment
+	Preview            bool
+}
+
+func (b0 AdjustPricesRequest_builder) Build() *AdjustPricesRequest {
+	m0 := &AdjustPricesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_CategoryId = b.CategoryId
+	x.xxx_hidden_IncludeDescendants = b.IncludeDescendants
+	x.xxx_hidden_Tags = b.Tags
+	x.xxx_hidden_Adjustment = b.Adjustment
+	x.xxx_hidden_Preview = b.Preview
+	return m0
+}
+
+// 商品ごとの単価の変更結果
+type PriceAdjustmentResult struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId   string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_ProductName string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3"`
+	xxx_hidden_Currency    string                 `protobuf:"bytes,3,opt,name=currency,proto3"`
+	xxx_hidden_BeforePrice uint32                 `protobuf:"varint,4,opt,name=before_price,json=beforePrice,proto3"`
+	xxx_hidden_AfterPrice  uint32                 `protobuf:"varint,5,opt,name=after_price,json=afterPrice,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PriceAdjustmentResult) Reset() {
+	*x = PriceAdjustmentResult{}
+	mi := &file_command_v1_command_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceAdjustmentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAdjustmentResult) ProtoMessage() {}
+
+func (x *PriceAdjustmentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PriceAdjustmentResult) GetProductId() string {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return ""
+}
+
+func (x *PriceAdjustmentResult) GetProductName() string {
+	if x != nil {
+		return x.xxx_hidden_ProductName
+	}
+	return ""
+}
+
+func (x *PriceAdjustmentResult) GetCurrency() string {
+	if x != nil {
+		return x.xxx_hidden_Currency
+	}
+	return ""
+}
+
+func (x *PriceAdjustmentResult) GetBeforePrice() uint32 {
+	if x != nil {
+		return x.xxx_hidden_BeforePrice
+	}
+	return 0
+}
+
+func (x *PriceAdjustmentResult) GetAfterPrice() uint32 {
+	if x != nil {
+		return x.xxx_hidden_AfterPrice
+	}
+	return 0
+}
+
+func (x *PriceAdjustmentResult) SetProductId(v string) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *PriceAdjustmentResult) SetProductName(v string) {
+	x.xxx_hidden_ProductName = v
+}
+
+func (x *PriceAdjustmentResult) SetCurrency(v string) {
+	x.xxx_hidden_Currency = v
+}
+
+func (x *PriceAdjustmentResult) SetBeforePrice(v uint32) {
+	x.xxx_hidden_BeforePrice = v
+}
+
+func (x *PriceAdjustmentResult) SetAfterPrice(v uint32) {
+	x.xxx_hidden_AfterPrice = v
+}
+
+type PriceAdjustmentResult_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId   string
+	ProductName string
+	Currency    string
+	BeforePrice uint32
+	AfterPrice  uint32
+}
+
+func (b0 PriceAdjustmentResult_builder) Build() *PriceAdjustmentResult {
+	m0 := &PriceAdjustmentResult{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_ProductName = b.ProductName
+	x.xxx_hidden_Currency = b.Currency
+	x.xxx_hidden_BeforePrice = b.BeforePrice
+	x.xxx_hidden_AfterPrice = b.AfterPrice
+	return m0
+}
+
+type AdjustPricesResponse struct {
+	state                protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Results   *[]*PriceAdjustmentResult `protobuf:"bytes,1,rep,name=results,proto3"`
+	xxx_hidden_Preview   bool                      `protobuf:"varint,2,opt,name=preview,proto3"`
+	xxx_hidden_Error     *v1.Error                 `protobuf:"bytes,3,opt,name=error,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AdjustPricesResponse) Reset() {
+	*x = AdjustPricesResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustPricesResponse) ProtoMessage() {}
+
+func (x *AdjustPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AdjustPricesResponse) GetResults() []*PriceAdjustmentResult {
+	if x != nil {
+		if x.xxx_hidden_Results != nil {
+			return *x.xxx_hidden_Results
+		}
+	}
+	return nil
+}
+
+func (x *AdjustPricesResponse) GetPreview() bool {
+	if x != nil {
+		return x.xxx_hidden_Preview
+	}
+	return false
+}
+
+func (x *AdjustPricesResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *AdjustPricesResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *AdjustPricesResponse) SetResults(v []*PriceAdjustmentResult) {
+	x.xxx_hidden_Results = &v
+}
+
+func (x *AdjustPricesResponse) SetPreview(v bool) {
+	x.xxx_hidden_Preview = v
+}
+
+func (x *AdjustPricesResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *AdjustPricesResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *AdjustPricesResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *AdjustPricesResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *AdjustPricesResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *AdjustPricesResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type AdjustPricesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Results   []*PriceAdjustmentResult
+	Preview   bool
+	Error     *v1.Error
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 AdjustPricesResponse_builder) Build() *AdjustPricesResponse {
+	m0 := &AdjustPricesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Results = &b.Results
+	x.xxx_hidden_Preview = b.Preview
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+// 在庫引当型の定義, レスポンス用でありvalidationは緩い
+type Reservation struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id        string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_ProductId string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_Quantity  int32                  `protobuf:"varint,3,opt,name=quantity,proto3"`
+	xxx_hidden_Status    ReservationStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=command.v1.ReservationStatus"`
+	xxx_hidden_ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_command_v1_command_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Reservation) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *Reservation) GetProductId() string {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return ""
+}
+
+func (x *Reservation) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *Reservation) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (x *Reservation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *Reservation) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *Reservation) SetProductId(v string) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *Reservation) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+}
+
+func (x *Reservation) SetStatus(v ReservationStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *Reservation) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *Reservation) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *Reservation) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type Reservation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        string
+	ProductId string
+	Quantity  int32
+	Status    ReservationStatus
+	ExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 Reservation_builder) Build() *Reservation {
+	m0 := &Reservation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_Quantity = b.Quantity
+	x.xxx_hidden_Status = b.Status
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+// StockService用のRequest/Response型
+type AdjustStockRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_Delta     int32                  `protobuf:"varint,2,opt,name=delta,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AdjustStockRequest) GetProductId() *v1.ProductId {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return nil
+}
+
+func (x *AdjustStockRequest) GetDelta() int32 {
+	if x != nil {
+		return x.xxx_hidden_Delta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) SetProductId(v *v1.ProductId) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *AdjustStockRequest) SetDelta(v int32) {
+	x.xxx_hidden_Delta = v
+}
+
+func (x *AdjustStockRequest) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ProductId != nil
+}
+
+func (x *AdjustStockRequest) ClearProductId() {
+	x.xxx_hidden_ProductId = nil
+}
+
+type AdjustStockRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId *v1.ProductId
+	Delta     int32
+}
+
+func (b0 AdjustStockRequest_builder) Build() *AdjustStockRequest {
+	m0 := &AdjustStockRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_Delta = b.Delta
+	return m0
+}
+
+type AdjustStockResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Stock     *v1.Stock              `protobuf:"bytes,1,opt,name=stock,proto3"`
+	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AdjustStockResponse) GetStock() *v1.Stock {
+	if x != nil {
+		return x.xxx_hidden_Stock
+	}
+	return nil
+}
+
+func (x *AdjustStockResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *AdjustStockResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *AdjustStockResponse) SetStock(v *v1.Stock) {
+	x.xxx_hidden_Stock = v
+}
+
+func (x *AdjustStockResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *AdjustStockResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *AdjustStockResponse) HasStock() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Stock != nil
+}
+
+func (x *AdjustStockResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *AdjustStockResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *AdjustStockResponse) ClearStock() {
+	x.xxx_hidden_Stock = nil
+}
+
+func (x *AdjustStockResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *AdjustStockResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type AdjustStockResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Stock     *v1.Stock
+	Error     *v1.Error
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 AdjustStockResponse_builder) Build() *AdjustStockResponse {
+	m0 := &AdjustStockResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Stock = b.Stock
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type ReserveStockRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId  *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_Quantity   int32                  `protobuf:"varint,2,opt,name=quantity,proto3"`
+	xxx_hidden_TtlSeconds int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReserveStockRequest) GetProductId() *v1.ProductId {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return nil
+}
+
+func (x *ReserveStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.xxx_hidden_TtlSeconds
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) SetProductId(v *v1.ProductId) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *ReserveStockRequest) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+}
+
+func (x *ReserveStockRequest) SetTtlSeconds(v int32) {
+	x.xxx_hidden_TtlSeconds = v
+}
+
+func (x *ReserveStockRequest) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ProductId != nil
+}
+
+func (x *ReserveStockRequest) ClearProductId() {
+	x.xxx_hidden_ProductId = nil
+}
+
+type ReserveStockRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId  *v1.ProductId
+	Quantity   int32
+	TtlSeconds int32
+}
+
+func (b0 ReserveStockRequest_builder) Build() *ReserveStockRequest {
+	m0 := &ReserveStockRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_Quantity = b.Quantity
+	x.xxx_hidden_TtlSeconds = b.TtlSeconds
+	return m0
+}
+
+type ReserveStockResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Reservation *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3"`
+	xxx_hidden_Stock       *v1.Stock              `protobuf:"bytes,2,opt,name=stock,proto3"`
+	xxx_hidden_Error       *v1.Error              `protobuf:"bytes,3,opt,name=error,proto3"`
+	xxx_hidden_Timestamp   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockResponse) ProtoMessage() {}
+
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReserveStockResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.xxx_hidden_Reservation
+	}
+	return nil
+}
+
+func (x *ReserveStockResponse) GetStock() *v1.Stock {
+	if x != nil {
+		return x.xxx_hidden_Stock
+	}
+	return nil
+}
+
+func (x *ReserveStockResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *ReserveStockResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *ReserveStockResponse) SetReservation(v *Reservation) {
+	x.xxx_hidden_Reservation = v
+}
+
+func (x *ReserveStockResponse) SetStock(v *v1.Stock) {
+	x.xxx_hidden_Stock = v
+}
+
+func (x *ReserveStockResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *ReserveStockResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *ReserveStockResponse) HasReservation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Reservation != nil
+}
+
+func (x *ReserveStockResponse) HasStock() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Stock != nil
+}
+
+func (x *ReserveStockResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *ReserveStockResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *ReserveStockResponse) ClearReservation() {
+	x.xxx_hidden_Reservation = nil
+}
+
+func (x *ReserveStockResponse) ClearStock() {
+	x.xxx_hidden_Stock = nil
+}
+
+func (x *ReserveStockResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *ReserveStockResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type ReserveStockResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Reservation *Reservation
+	Stock       *v1.Stock
+	Error       *v1.Error
+	Timestamp   *timestamppb.Timestamp
+}
+
+func (b0 ReserveStockResponse_builder) Build() *ReserveStockResponse {
+	m0 := &ReserveStockResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Reservation = b.Reservation
+	x.xxx_hidden_Stock = b.Stock
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type ReleaseReservationRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationRequest) Reset() {
+	*x = ReleaseReservationRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationRequest) ProtoMessage() {}
+
+func (x *ReleaseReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReleaseReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.xxx_hidden_ReservationId
+	}
+	return ""
+}
+
+func (x *ReleaseReservationRequest) SetReservationId(v string) {
+	x.xxx_hidden_ReservationId = v
+}
+
+type ReleaseReservationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ReservationId string
+}
+
+func (b0 ReleaseReservationRequest_builder) Build() *ReleaseReservationRequest {
+	m0 := &ReleaseReservationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ReservationId = b.ReservationId
+	return m0
+}
+
+type ReleaseReservationResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Reservation *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3"`
+	xxx_hidden_Stock       *v1.Stock              `protobuf:"bytes,2,opt,name=stock,proto3"`
+	xxx_hidden_Error       *v1.Error              `protobuf:"bytes,3,opt,name=error,proto3"`
+	xxx_hidden_Timestamp   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationResponse) Reset() {
+	*x = ReleaseReservationResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationResponse) ProtoMessage() {}
+
+func (x *ReleaseReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReleaseReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.xxx_hidden_Reservation
+	}
+	return nil
+}
+
+func (x *ReleaseReservationResponse) GetStock() *v1.Stock {
+	if x != nil {
+		return x.xxx_hidden_Stock
+	}
+	return nil
+}
+
+func (x *ReleaseReservationResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *ReleaseReservationResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *ReleaseReservationResponse) SetReservation(v *Reservation) {
+	x.xxx_hidden_Reservation = v
+}
+
+func (x *ReleaseReservationResponse) SetStock(v *v1.Stock) {
+	x.xxx_hidden_Stock = v
+}
+
+func (x *ReleaseReservationResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *ReleaseReservationResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *ReleaseReservationResponse) HasReservation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Reservation != nil
+}
+
+func (x *ReleaseReservationResponse) HasStock() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Stock != nil
+}
+
+func (x *ReleaseReservationResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *ReleaseReservationResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *ReleaseReservationResponse) ClearReservation() {
+	x.xxx_hidden_Reservation = nil
+}
+
+func (x *ReleaseReservationResponse) ClearStock() {
+	x.xxx_hidden_Stock = nil
+}
+
+func (x *ReleaseReservationResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *ReleaseReservationResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type ReleaseReservationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Reservation *Reservation
+	Stock       *v1.Stock
+	Error       *v1.Error
+	Timestamp   *timestamppb.Timestamp
+}
+
+func (b0 ReleaseReservationResponse_builder) Build() *ReleaseReservationResponse {
+	m0 := &ReleaseReservationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Reservation = b.Reservation
+	x.xxx_hidden_Stock = b.Stock
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type CommitReservationRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CommitReservationRequest) Reset() {
+	*x = CommitReservationRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationRequest) ProtoMessage() {}
+
+func (x *CommitReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CommitReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.xxx_hidden_ReservationId
+	}
+	return ""
+}
+
+func (x *CommitReservationRequest) SetReservationId(v string) {
+	x.xxx_hidden_ReservationId = v
+}
+
+type CommitReservationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ReservationId string
+}
+
+func (b0 CommitReservationRequest_builder) Build() *CommitReservationRequest {
+	m0 := &CommitReservationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ReservationId = b.ReservationId
+	return m0
+}
+
+type CommitReservationResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Reservation *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3"`
+	xxx_hidden_Stock       *v1.Stock              `protobuf:"bytes,2,opt,name=stock,proto3"`
+	xxx_hidden_Error       *v1.Error              `protobuf:"bytes,3,opt,name=error,proto3"`
+	xxx_hidden_Timestamp   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CommitReservationResponse) Reset() {
+	*x = CommitReservationResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationResponse) ProtoMessage() {}
+
+func (x *CommitReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CommitReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.xxx_hidden_Reservation
+	}
+	return nil
+}
+
+func (x *CommitReservationResponse) GetStock() *v1.Stock {
+	if x != nil {
+		return x.xxx_hidden_Stock
+	}
+	return nil
+}
+
+func (x *CommitReservationResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *CommitReservationResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *CommitReservationResponse) SetReservation(v *Reservation) {
+	x.xxx_hidden_Reservation = v
+}
+
+func (x *CommitReservationResponse) SetStock(v *v1.Stock) {
+	x.xxx_hidden_Stock = v
+}
+
+func (x *CommitReservationResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *CommitReservationResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *CommitReservationResponse) HasReservation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Reservation != nil
+}
+
+func (x *CommitReservationResponse) HasStock() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Stock != nil
+}
+
+func (x *CommitReservationResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *CommitReservationResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *CommitReservationResponse) ClearReservation() {
+	x.xxx_hidden_Reservation = nil
+}
+
+func (x *CommitReservationResponse) ClearStock() {
+	x.xxx_hidden_Stock = nil
+}
+
+func (x *CommitReservationResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *CommitReservationResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type CommitReservationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Reservation *Reservation
+	Stock       *v1.Stock
+	Error       *v1.Error
+	Timestamp   *timestamppb.Timestamp
+}
+
+func (b0 CommitReservationResponse_builder) Build() *CommitReservationResponse {
+	m0 := &CommitReservationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Reservation = b.Reservation
+	x.xxx_hidden_Stock = b.Stock
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+// TagService用のRequest/Response型
+type AttachTagsRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductIds []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3"`
+	xxx_hidden_TagNames   []string               `protobuf:"bytes,2,rep,name=tag_names,json=tagNames,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AttachTagsRequest) Reset() {
+	*x = AttachTagsRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachTagsRequest) ProtoMessage() {}
+
+func (x *AttachTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AttachTagsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.xxx_hidden_ProductIds
+	}
+	return nil
+}
+
+func (x *AttachTagsRequest) GetTagNames() []string {
+	if x != nil {
+		return x.xxx_hidden_TagNames
+	}
+	return nil
+}
+
+func (x *AttachTagsRequest) SetProductIds(v []string) {
+	x.xxx_hidden_ProductIds = v
+}
+
+func (x *AttachTagsRequest) SetTagNames(v []string) {
+	x.xxx_hidden_TagNames = v
+}
+
+type AttachTagsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductIds []string
+	TagNames   []string
+}
+
+func (b0 AttachTagsRequest_builder) Build() *AttachTagsRequest {
+	m0 := &AttachTagsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductIds = b.ProductIds
+	x.xxx_hidden_TagNames = b.TagNames
+	return m0
+}
+
+type AttachTagsResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Tags          *[]*v1.Tag             `protobuf:"bytes,1,rep,name=tags,proto3"`
+	xxx_hidden_AttachedCount int32                  `protobuf:"varint,2,opt,name=attached_count,json=attachedCount,proto3"`
+	xxx_hidden_Error         *v1.Error              `protobuf:"bytes,3,opt,name=error,proto3"`
+	xxx_hidden_Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AttachTagsResponse) Reset() {
+	*x = AttachTagsResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachTagsResponse) ProtoMessage() {}
+
+func (x *AttachTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AttachTagsResponse) GetTags() []*v1.Tag {
+	if x != nil {
+		if x.xxx_hidden_Tags != nil {
+			return *x.xxx_hidden_Tags
+		}
+	}
+	return nil
+}
+
+func (x *AttachTagsResponse) GetAttachedCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_AttachedCount
+	}
+	return 0
+}
+
+func (x *AttachTagsResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *AttachTagsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *AttachTagsResponse) SetTags(v []*v1.Tag) {
+	x.xxx_hidden_Tags = &v
+}
+
+func (x *AttachTagsResponse) SetAttachedCount(v int32) {
+	x.xxx_hidden_AttachedCount = v
+}
+
+func (x *AttachTagsResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *AttachTagsResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *AttachTagsResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *AttachTagsResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *AttachTagsResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *AttachTagsResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type AttachTagsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Tags          []*v1.Tag
+	AttachedCount int32
+	Error         *v1.Error
+	Timestamp     *timestamppb.Timestamp
+}
+
+func (b0 AttachTagsResponse_builder) Build() *AttachTagsResponse {
+	m0 := &AttachTagsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Tags = &b.Tags
+	x.xxx_hidden_AttachedCount = b.AttachedCount
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type DetachTagsRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductIds []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3"`
+	xxx_hidden_TagNames   []string               `protobuf:"bytes,2,rep,name=tag_names,json=tagNames,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DetachTagsRequest) Reset() {
+	*x = DetachTagsRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachTagsRequest) ProtoMessage() {}
+
+func (x *DetachTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DetachTagsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.xxx_hidden_ProductIds
+	}
+	return nil
+}
+
+func (x *DetachTagsRequest) GetTagNames() []string {
+	if x != nil {
+		return x.xxx_hidden_TagNames
+	}
+	return nil
+}
+
+func (x *DetachTagsRequest) SetProductIds(v []string) {
+	x.xxx_hidden_ProductIds = v
+}
+
+func (x *DetachTagsRequest) SetTagNames(v []string) {
+	x.xxx_hidden_TagNames = v
+}
+
+type DetachTagsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductIds []string
+	TagNames   []string
+}
+
+func (b0 DetachTagsRequest_builder) Build() *DetachTagsRequest {
+	m0 := &DetachTagsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductIds = b.ProductIds
+	x.xxx_hidden_TagNames = b.TagNames
+	return m0
+}
+
+type DetachTagsResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Tags          *[]*v1.Tag             `protobuf:"bytes,1,rep,name=tags,proto3"`
+	xxx_hidden_DetachedCount int32                  `protobuf:"varint,2,opt,name=detached_count,json=detachedCount,proto3"`
+	xxx_hidden_Error         *v1.Error              `protobuf:"bytes,3,opt,name=error,proto3"`
+	xxx_hidden_Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *DetachTagsResponse) Reset() {
+	*x = DetachTagsResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachTagsResponse) ProtoMessage() {}
+
+func (x *DetachTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DetachTagsResponse) GetTags() []*v1.Tag {
+	if x != nil {
+		if x.xxx_hidden_Tags != nil {
+			return *x.xxx_hidden_Tags
+		}
+	}
+	return nil
+}
+
+func (x *DetachTagsResponse) GetDetachedCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_DetachedCount
+	}
+	return 0
+}
+
+func (x *DetachTagsResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *DetachTagsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *DetachTagsResponse) SetTags(v []*v1.Tag) {
+	x.xxx_hidden_Tags = &v
+}
+
+func (x *DetachTagsResponse) SetDetachedCount(v int32) {
+	x.xxx_hidden_DetachedCount = v
+}
+
+func (x *DetachTagsResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *DetachTagsResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *DetachTagsResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *DetachTagsResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *DetachTagsResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *DetachTagsResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type DetachTagsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Tags          []*v1.Tag
+	DetachedCount int32
+	Error         *v1.Error
+	Timestamp     *timestamppb.Timestamp
+}
+
+func (b0 DetachTagsResponse_builder) Build() *DetachTagsResponse {
+	m0 := &DetachTagsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Tags = &b.Tags
+	x.xxx_hidden_DetachedCount = b.DetachedCount
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+// PriceScheduleService用のRequest/Response型
+type SchedulePriceRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_Price     *v1.ProductPrice       `protobuf:"bytes,2,opt,name=price,proto3"`
+	xxx_hidden_StartsAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3"`
+	xxx_hidden_EndsAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SchedulePriceRequest) Reset() {
+	*x = SchedulePriceRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceRequest) ProtoMessage() {}
+
+func (x *SchedulePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SchedulePriceRequest) GetProductId() *v1.ProductId {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return nil
+}
+
+func (x *SchedulePriceRequest) GetPrice() *v1.ProductPrice {
+	if x != nil {
+		return x.xxx_hidden_Price
+	}
+	return nil
+}
+
+func (x *SchedulePriceRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StartsAt
+	}
+	return nil
+}
+
+func (x *SchedulePriceRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_EndsAt
+	}
+	return nil
+}
+
+func (x *SchedulePriceRequest) SetProductId(v *v1.ProductId) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *SchedulePriceRequest) SetPrice(v *v1.ProductPrice) {
+	x.xxx_hidden_Price = v
+}
+
+func (x *SchedulePriceRequest) SetStartsAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_StartsAt = v
+}
+
+func (x *SchedulePriceRequest) SetEndsAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_EndsAt = v
+}
+
+func (x *SchedulePriceRequest) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ProductId != nil
+}
+
+func (x *SchedulePriceRequest) HasPrice() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Price != nil
+}
+
+func (x *SchedulePriceRequest) HasStartsAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StartsAt != nil
+}
+
+func (x *SchedulePriceRequest) HasEndsAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_EndsAt != nil
+}
+
+func (x *SchedulePriceRequest) ClearProductId() {
+	x.xxx_hidden_ProductId = nil
+}
+
+func (x *SchedulePriceRequest) ClearPrice() {
+	x.xxx_hidden_Price = nil
+}
+
+func (x *SchedulePriceRequest) ClearStartsAt() {
+	x.xxx_hidden_StartsAt = nil
+}
+
+func (x *SchedulePriceRequest) ClearEndsAt() {
+	x.xxx_hidden_EndsAt = nil
+}
+
+type SchedulePriceRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId *v1.ProductId
+	Price     *v1.ProductPrice
+	StartsAt  *timestamppb.Timestamp
+	EndsAt    *timestamppb.Timestamp
+}
+
+func (b0 SchedulePriceRequest_builder) Build() *SchedulePriceRequest {
+	m0 := &SchedulePriceRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_Price = b.Price
+	x.xxx_hidden_StartsAt = b.StartsAt
+	x.xxx_hidden_EndsAt = b.EndsAt
+	return m0
+}
+
+type SchedulePriceResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PriceSchedule *v1.PriceSchedule      `protobuf:"bytes,1,opt,name=price_schedule,json=priceSchedule,proto3"`
+	xxx_hidden_Error         *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *SchedulePriceResponse) Reset() {
+	*x = SchedulePriceResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceResponse) ProtoMessage() {}
+
+func (x *SchedulePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SchedulePriceResponse) GetPriceSchedule() *v1.PriceSchedule {
+	if x != nil {
+		return x.xxx_hidden_PriceSchedule
+	}
+	return nil
+}
+
+func (x *SchedulePriceResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *SchedulePriceResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *SchedulePriceResponse) SetPriceSchedule(v *v1.PriceSchedule) {
+	x.xxx_hidden_PriceSchedule = v
+}
+
+func (x *SchedulePriceResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *SchedulePriceResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *SchedulePriceResponse) HasPriceSchedule() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PriceSchedule != nil
+}
+
+func (x *SchedulePriceResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *SchedulePriceResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *SchedulePriceResponse) ClearPriceSchedule() {
+	x.xxx_hidden_PriceSchedule = nil
+}
+
+func (x *SchedulePriceResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *SchedulePriceResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type SchedulePriceResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PriceSchedule *v1.PriceSchedule
+	Error         *v1.Error
+	Timestamp     *timestamppb.Timestamp
+}
+
+func (b0 SchedulePriceResponse_builder) Build() *SchedulePriceResponse {
+	m0 := &SchedulePriceResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PriceSchedule = b.PriceSchedule
 	x.xxx_hidden_Error = b.Error
 	x.xxx_hidden_Timestamp = b.Timestamp
 	return m0
 }
 
-type DeleteProductRequest struct {
-	state                protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_ProductId *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type CancelPriceScheduleRequest struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId       *v1.ProductId          `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_PriceScheduleId string                 `protobuf:"bytes,2,opt,name=price_schedule_id,json=priceScheduleId,proto3"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
-	mi := &file_command_v1_command_proto_msgTypes[10]
+func (x *CancelPriceScheduleRequest) Reset() {
+	*x = CancelPriceScheduleRequest{}
+	mi := &file_command_v1_command_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *CancelPriceScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*CancelPriceScheduleRequest) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_command_v1_command_proto_msgTypes[10]
+func (x *CancelPriceScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,66 +5116,79 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeleteProductRequest) GetProductId() *v1.ProductId {
+func (x *CancelPriceScheduleRequest) GetProductId() *v1.ProductId {
 	if x != nil {
 		return x.xxx_hidden_ProductId
 	}
 	return nil
 }
 
-func (x *DeleteProductRequest) SetProductId(v *v1.ProductId) {
+func (x *CancelPriceScheduleRequest) GetPriceScheduleId() string {
+	if x != nil {
+		return x.xxx_hidden_PriceScheduleId
+	}
+	return ""
+}
+
+func (x *CancelPriceScheduleRequest) SetProductId(v *v1.ProductId) {
 	x.xxx_hidden_ProductId = v
 }
 
-func (x *DeleteProductRequest) HasProductId() bool {
+func (x *CancelPriceScheduleRequest) SetPriceScheduleId(v string) {
+	x.xxx_hidden_PriceScheduleId = v
+}
+
+func (x *CancelPriceScheduleRequest) HasProductId() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_ProductId != nil
 }
 
-func (x *DeleteProductRequest) ClearProductId() {
+func (x *CancelPriceScheduleRequest) ClearProductId() {
 	x.xxx_hidden_ProductId = nil
 }
 
-type DeleteProductRequest_builder struct {
+type CancelPriceScheduleRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	ProductId *v1.ProductId
+	ProductId       *v1.ProductId
+	PriceScheduleId string
 }
 
-func (b0 DeleteProductRequest_builder) Build() *DeleteProductRequest {
-	m0 := &DeleteProductRequest{}
+func (b0 CancelPriceScheduleRequest_builder) Build() *CancelPriceScheduleRequest {
+	m0 := &CancelPriceScheduleRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_PriceScheduleId = b.PriceScheduleId
 	return m0
 }
 
-type DeleteProductResponse struct {
-	state                protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Product   *v1.Product            `protobuf:"bytes,1,opt,name=product,proto3"`
-	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
-	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type CancelPriceScheduleResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PriceSchedule *v1.PriceSchedule      `protobuf:"bytes,1,opt,name=price_schedule,json=priceSchedule,proto3"`
+	xxx_hidden_Error         *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *DeleteProductResponse) Reset() {
-	*x = DeleteProductResponse{}
-	mi := &file_command_v1_command_proto_msgTypes[11]
+func (x *CancelPriceScheduleResponse) Reset() {
+	*x = CancelPriceScheduleResponse{}
+	mi := &file_command_v1_command_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductResponse) String() string {
+func (x *CancelPriceScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductResponse) ProtoMessage() {}
+func (*CancelPriceScheduleResponse) ProtoMessage() {}
 
-func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_command_v1_command_proto_msgTypes[11]
+func (x *CancelPriceScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_v1_command_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,101 +5199,105 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeleteProductResponse) GetProduct() *v1.Product {
+func (x *CancelPriceScheduleResponse) GetPriceSchedule() *v1.PriceSchedule {
 	if x != nil {
-		return x.xxx_hidden_Product
+		return x.xxx_hidden_PriceSchedule
 	}
 	return nil
 }
 
-func (x *DeleteProductResponse) GetError() *v1.Error {
+func (x *CancelPriceScheduleResponse) GetError() *v1.Error {
 	if x != nil {
 		return x.xxx_hidden_Error
 	}
 	return nil
 }
 
-func (x *DeleteProductResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *CancelPriceScheduleResponse) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.xxx_hidden_Timestamp
 	}
 	return nil
 }
 
-func (x *DeleteProductResponse) SetProduct(v *v1.Product) {
-	x.xxx_hidden_Product = v
+func (x *CancelPriceScheduleResponse) SetPriceSchedule(v *v1.PriceSchedule) {
+	x.xxx_hidden_PriceSchedule = v
 }
 
-func (x *DeleteProductResponse) SetError(v *v1.Error) {
+func (x *CancelPriceScheduleResponse) SetError(v *v1.Error) {
 	x.xxx_hidden_Error = v
 }
 
-func (x *DeleteProductResponse) SetTimestamp(v *timestamppb.Timestamp) {
+func (x *CancelPriceScheduleResponse) SetTimestamp(v *timestamppb.Timestamp) {
 	x.xxx_hidden_Timestamp = v
 }
 
-func (x *DeleteProductResponse) HasProduct() bool {
+func (x *CancelPriceScheduleResponse) HasPriceSchedule() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Product != nil
+	return x.xxx_hidden_PriceSchedule != nil
 }
 
-func (x *DeleteProductResponse) HasError() bool {
+func (x *CancelPriceScheduleResponse) HasError() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Error != nil
 }
 
-func (x *DeleteProductResponse) HasTimestamp() bool {
+func (x *CancelPriceScheduleResponse) HasTimestamp() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Timestamp != nil
 }
 
-func (x *DeleteProductResponse) ClearProduct() {
-	x.xxx_hidden_Product = nil
+func (x *CancelPriceScheduleResponse) ClearPriceSchedule() {
+	x.xxx_hidden_PriceSchedule = nil
 }
 
-func (x *DeleteProductResponse) ClearError() {
+func (x *CancelPriceScheduleResponse) ClearError() {
 	x.xxx_hidden_Error = nil
 }
 
-func (x *DeleteProductResponse) ClearTimestamp() {
+func (x *CancelPriceScheduleResponse) ClearTimestamp() {
 	x.xxx_hidden_Timestamp = nil
 }
 
-type DeleteProductResponse_builder struct {
+type CancelPriceScheduleResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Product   *v1.Product
-	Error     *v1.Error
-	Timestamp *timestamppb.Timestamp
+	PriceSchedule *v1.PriceSchedule
+	Error         *v1.Error
+	Timestamp     *timestamppb.Timestamp
 }
 
-func (b0 DeleteProductResponse_builder) Build() *DeleteProductResponse {
-	m0 := &DeleteProductResponse{}
+func (b0 CancelPriceScheduleResponse_builder) Build() *CancelPriceScheduleResponse {
+	m0 := &CancelPriceScheduleResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_PriceSchedule = b.PriceSchedule
 	x.xxx_hidden_Error = b.Error
 	x.xxx_hidden_Timestamp = b.Timestamp
 	return m0
 }
 
 type UpdateCategoryRequest_Category struct {
-	state           protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id   *v1.CategoryId         `protobuf:"bytes,1,opt,name=id,proto3"`
-	xxx_hidden_Name *v1.CategoryName       `protobuf:"bytes,2,opt,name=name,proto3"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id           *v1.CategoryId         `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name         *v1.CategoryName       `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_Translations map[string]string      `protobuf:"bytes,3,rep,name=translations,proto3" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Slug         *string                `protobuf:"bytes,4,opt,name=slug,proto3,oneof"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *UpdateCategoryRequest_Category) Reset() {
 	*x = UpdateCategoryRequest_Category{}
-	mi := &file_command_v1_command_proto_msgTypes[12]
+	mi := &file_command_v1_command_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1287,7 +5309,7 @@ func (x *UpdateCategoryRequest_Category) String() string {
 func (*UpdateCategoryRequest_Category) ProtoMessage() {}
 
 func (x *UpdateCategoryRequest_Category) ProtoReflect() protoreflect.Message {
-	mi := &file_command_v1_command_proto_msgTypes[12]
+	mi := &file_command_v1_command_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1312,6 +5334,23 @@ func (x *UpdateCategoryRequest_Category) GetName() *v1.CategoryName {
 	return nil
 }
 
+func (x *UpdateCategoryRequest_Category) GetTranslations() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Translations
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest_Category) GetSlug() string {
+	if x != nil {
+		if x.xxx_hidden_Slug != nil {
+			return *x.xxx_hidden_Slug
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *UpdateCategoryRequest_Category) SetId(v *v1.CategoryId) {
 	x.xxx_hidden_Id = v
 }
@@ -1320,6 +5359,15 @@ func (x *UpdateCategoryRequest_Category) SetName(v *v1.CategoryName) {
 	x.xxx_hidden_Name = v
 }
 
+func (x *UpdateCategoryRequest_Category) SetTranslations(v map[string]string) {
+	x.xxx_hidden_Translations = v
+}
+
+func (x *UpdateCategoryRequest_Category) SetSlug(v string) {
+	x.xxx_hidden_Slug = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
 func (x *UpdateCategoryRequest_Category) HasId() bool {
 	if x == nil {
 		return false
@@ -1334,6 +5382,13 @@ func (x *UpdateCategoryRequest_Category) HasName() bool {
 	return x.xxx_hidden_Name != nil
 }
 
+func (x *UpdateCategoryRequest_Category) HasSlug() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
 func (x *UpdateCategoryRequest_Category) ClearId() {
 	x.xxx_hidden_Id = nil
 }
@@ -1342,11 +5397,18 @@ func (x *UpdateCategoryRequest_Category) ClearName() {
 	x.xxx_hidden_Name = nil
 }
 
+func (x *UpdateCategoryRequest_Category) ClearSlug() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Slug = nil
+}
+
 type UpdateCategoryRequest_Category_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id   *v1.CategoryId
-	Name *v1.CategoryName
+	Id           *v1.CategoryId
+	Name         *v1.CategoryName
+	Translations map[string]string
+	Slug         *string
 }
 
 func (b0 UpdateCategoryRequest_Category_builder) Build() *UpdateCategoryRequest_Category {
@@ -1355,21 +5417,33 @@ func (b0 UpdateCategoryRequest_Category_builder) Build() *UpdateCategoryRequest_
 	_, _ = b, x
 	x.xxx_hidden_Id = b.Id
 	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Translations = b.Translations
+	if b.Slug != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Slug = b.Slug
+	}
 	return m0
 }
 
 type CreateProductRequest_Product struct {
-	state               protoimpl.MessageState                 `protogen:"opaque.v1"`
-	xxx_hidden_Name     *v1.ProductName                        `protobuf:"bytes,1,opt,name=name,proto3"`
-	xxx_hidden_Price    *v1.ProductPrice                       `protobuf:"bytes,2,opt,name=price,proto3"`
-	xxx_hidden_Category *CreateProductRequest_Product_Category `protobuf:"bytes,3,opt,name=category,proto3"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	state                   protoimpl.MessageState                 `protogen:"opaque.v1"`
+	xxx_hidden_Name         *v1.ProductName                        `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Price        *v1.ProductPrice                       `protobuf:"bytes,2,opt,name=price,proto3"`
+	xxx_hidden_Category     *CreateProductRequest_Product_Category `protobuf:"bytes,3,opt,name=category,proto3"`
+	xxx_hidden_Currency     *string                                `protobuf:"bytes,4,opt,name=currency,proto3,oneof"`
+	xxx_hidden_TaxClass     v1.TaxClass                            `protobuf:"varint,5,opt,name=tax_class,json=taxClass,proto3,enum=common.v1.TaxClass"`
+	xxx_hidden_Translations map[string]string                      `protobuf:"bytes,6,rep,name=translations,proto3" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Barcode      *string                                `protobuf:"bytes,7,opt,name=barcode,proto3,oneof"`
+	xxx_hidden_Slug         *string                                `protobuf:"bytes,8,opt,name=slug,proto3,oneof"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *CreateProductRequest_Product) Reset() {
 	*x = CreateProductRequest_Product{}
-	mi := &file_command_v1_command_proto_msgTypes[13]
+	mi := &file_command_v1_command_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1381,7 +5455,7 @@ func (x *CreateProductRequest_Product) String() string {
 func (*CreateProductRequest_Product) ProtoMessage() {}
 
 func (x *CreateProductRequest_Product) ProtoReflect() protoreflect.Message {
-	mi := &file_command_v1_command_proto_msgTypes[13]
+	mi := &file_command_v1_command_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,6 +5487,50 @@ func (x *CreateProductRequest_Product) GetCategory() *CreateProductRequest_Produ
 	return nil
 }
 
+func (x *CreateProductRequest_Product) GetCurrency() string {
+	if x != nil {
+		if x.xxx_hidden_Currency != nil {
+			return *x.xxx_hidden_Currency
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateProductRequest_Product) GetTaxClass() v1.TaxClass {
+	if x != nil {
+		return x.xxx_hidden_TaxClass
+	}
+	return v1.TaxClass(0)
+}
+
+func (x *CreateProductRequest_Product) GetTranslations() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Translations
+	}
+	return nil
+}
+
+func (x *CreateProductRequest_Product) GetBarcode() string {
+	if x != nil {
+		if x.xxx_hidden_Barcode != nil {
+			return *x.xxx_hidden_Barcode
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateProductRequest_Product) GetSlug() string {
+	if x != nil {
+		if x.xxx_hidden_Slug != nil {
+			return *x.xxx_hidden_Slug
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *CreateProductRequest_Product) SetName(v *v1.ProductName) {
 	x.xxx_hidden_Name = v
 }
@@ -1425,6 +5543,29 @@ func (x *CreateProductRequest_Product) SetCategory(v *CreateProductRequest_Produ
 	x.xxx_hidden_Category = v
 }
 
+func (x *CreateProductRequest_Product) SetCurrency(v string) {
+	x.xxx_hidden_Currency = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *CreateProductRequest_Product) SetTaxClass(v v1.TaxClass) {
+	x.xxx_hidden_TaxClass = v
+}
+
+func (x *CreateProductRequest_Product) SetTranslations(v map[string]string) {
+	x.xxx_hidden_Translations = v
+}
+
+func (x *CreateProductRequest_Product) SetBarcode(v string) {
+	x.xxx_hidden_Barcode = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *CreateProductRequest_Product) SetSlug(v string) {
+	x.xxx_hidden_Slug = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
 func (x *CreateProductRequest_Product) HasName() bool {
 	if x == nil {
 		return false
@@ -1446,6 +5587,27 @@ func (x *CreateProductRequest_Product) HasCategory() bool {
 	return x.xxx_hidden_Category != nil
 }
 
+func (x *CreateProductRequest_Product) HasCurrency() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CreateProductRequest_Product) HasBarcode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *CreateProductRequest_Product) HasSlug() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
 func (x *CreateProductRequest_Product) ClearName() {
 	x.xxx_hidden_Name = nil
 }
@@ -1458,12 +5620,32 @@ func (x *CreateProductRequest_Product) ClearCategory() {
 	x.xxx_hidden_Category = nil
 }
 
+func (x *CreateProductRequest_Product) ClearCurrency() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Currency = nil
+}
+
+func (x *CreateProductRequest_Product) ClearBarcode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Barcode = nil
+}
+
+func (x *CreateProductRequest_Product) ClearSlug() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Slug = nil
+}
+
 type CreateProductRequest_Product_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Name     *v1.ProductName
-	Price    *v1.ProductPrice
-	Category *CreateProductRequest_Product_Category
+	Name         *v1.ProductName
+	Price        *v1.ProductPrice
+	Category     *CreateProductRequest_Product_Category
+	Currency     *string
+	TaxClass     v1.TaxClass
+	Translations map[string]string
+	Barcode      *string
+	Slug         *string
 }
 
 func (b0 CreateProductRequest_Product_builder) Build() *CreateProductRequest_Product {
@@ -1473,6 +5655,20 @@ func (b0 CreateProductRequest_Product_builder) Build() *CreateProductRequest_Pro
 	x.xxx_hidden_Name = b.Name
 	x.xxx_hidden_Price = b.Price
 	x.xxx_hidden_Category = b.Category
+	if b.Currency != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_Currency = b.Currency
+	}
+	x.xxx_hidden_TaxClass = b.TaxClass
+	x.xxx_hidden_Translations = b.Translations
+	if b.Barcode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_Barcode = b.Barcode
+	}
+	if b.Slug != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_Slug = b.Slug
+	}
 	return m0
 }
 
@@ -1486,7 +5682,7 @@ type CreateProductRequest_Product_Category struct {
 
 func (x *CreateProductRequest_Product_Category) Reset() {
 	*x = CreateProductRequest_Product_Category{}
-	mi := &file_command_v1_command_proto_msgTypes[14]
+	mi := &file_command_v1_command_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1498,7 +5694,7 @@ func (x *CreateProductRequest_Product_Category) String() string {
 func (*CreateProductRequest_Product_Category) ProtoMessage() {}
 
 func (x *CreateProductRequest_Product_Category) ProtoReflect() protoreflect.Message {
-	mi := &file_command_v1_command_proto_msgTypes[14]
+	mi := &file_command_v1_command_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1570,18 +5766,25 @@ func (b0 CreateProductRequest_Product_Category_builder) Build() *CreateProductRe
 }
 
 type UpdateProductRequest_Product struct {
-	state                 protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id         *v1.ProductId          `protobuf:"bytes,1,opt,name=id,proto3"`
-	xxx_hidden_Name       *v1.ProductName        `protobuf:"bytes,2,opt,name=name,proto3"`
-	xxx_hidden_Price      *v1.ProductPrice       `protobuf:"bytes,3,opt,name=price,proto3"`
-	xxx_hidden_CategoryId *v1.CategoryId         `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id           *v1.ProductId          `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name         *v1.ProductName        `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_Price        *v1.ProductPrice       `protobuf:"bytes,3,opt,name=price,proto3"`
+	xxx_hidden_CategoryId   *v1.CategoryId         `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3"`
+	xxx_hidden_Currency     *string                `protobuf:"bytes,5,opt,name=currency,proto3,oneof"`
+	xxx_hidden_TaxClass     v1.TaxClass            `protobuf:"varint,6,opt,name=tax_class,json=taxClass,proto3,enum=common.v1.TaxClass"`
+	xxx_hidden_Translations map[string]string      `protobuf:"bytes,7,rep,name=translations,proto3" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Barcode      *string                `protobuf:"bytes,8,opt,name=barcode,proto3,oneof"`
+	xxx_hidden_Slug         *string                `protobuf:"bytes,9,opt,name=slug,proto3,oneof"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *UpdateProductRequest_Product) Reset() {
 	*x = UpdateProductRequest_Product{}
-	mi := &file_command_v1_command_proto_msgTypes[15]
+	mi := &file_command_v1_command_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1593,7 +5796,7 @@ func (x *UpdateProductRequest_Product) String() string {
 func (*UpdateProductRequest_Product) ProtoMessage() {}
 
 func (x *UpdateProductRequest_Product) ProtoReflect() protoreflect.Message {
-	mi := &file_command_v1_command_proto_msgTypes[15]
+	mi := &file_command_v1_command_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1632,6 +5835,50 @@ func (x *UpdateProductRequest_Product) GetCategoryId() *v1.CategoryId {
 	return nil
 }
 
+func (x *UpdateProductRequest_Product) GetCurrency() string {
+	if x != nil {
+		if x.xxx_hidden_Currency != nil {
+			return *x.xxx_hidden_Currency
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest_Product) GetTaxClass() v1.TaxClass {
+	if x != nil {
+		return x.xxx_hidden_TaxClass
+	}
+	return v1.TaxClass(0)
+}
+
+func (x *UpdateProductRequest_Product) GetTranslations() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Translations
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest_Product) GetBarcode() string {
+	if x != nil {
+		if x.xxx_hidden_Barcode != nil {
+			return *x.xxx_hidden_Barcode
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest_Product) GetSlug() string {
+	if x != nil {
+		if x.xxx_hidden_Slug != nil {
+			return *x.xxx_hidden_Slug
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *UpdateProductRequest_Product) SetId(v *v1.ProductId) {
 	x.xxx_hidden_Id = v
 }
@@ -1648,6 +5895,29 @@ func (x *UpdateProductRequest_Product) SetCategoryId(v *v1.CategoryId) {
 	x.xxx_hidden_CategoryId = v
 }
 
+func (x *UpdateProductRequest_Product) SetCurrency(v string) {
+	x.xxx_hidden_Currency = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *UpdateProductRequest_Product) SetTaxClass(v v1.TaxClass) {
+	x.xxx_hidden_TaxClass = v
+}
+
+func (x *UpdateProductRequest_Product) SetTranslations(v map[string]string) {
+	x.xxx_hidden_Translations = v
+}
+
+func (x *UpdateProductRequest_Product) SetBarcode(v string) {
+	x.xxx_hidden_Barcode = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *UpdateProductRequest_Product) SetSlug(v string) {
+	x.xxx_hidden_Slug = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
 func (x *UpdateProductRequest_Product) HasId() bool {
 	if x == nil {
 		return false
@@ -1676,6 +5946,27 @@ func (x *UpdateProductRequest_Product) HasCategoryId() bool {
 	return x.xxx_hidden_CategoryId != nil
 }
 
+func (x *UpdateProductRequest_Product) HasCurrency() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *UpdateProductRequest_Product) HasBarcode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *UpdateProductRequest_Product) HasSlug() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
 func (x *UpdateProductRequest_Product) ClearId() {
 	x.xxx_hidden_Id = nil
 }
@@ -1692,13 +5983,33 @@ func (x *UpdateProductRequest_Product) ClearCategoryId() {
 	x.xxx_hidden_CategoryId = nil
 }
 
+func (x *UpdateProductRequest_Product) ClearCurrency() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Currency = nil
+}
+
+func (x *UpdateProductRequest_Product) ClearBarcode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Barcode = nil
+}
+
+func (x *UpdateProductRequest_Product) ClearSlug() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Slug = nil
+}
+
 type UpdateProductRequest_Product_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id         *v1.ProductId
-	Name       *v1.ProductName
-	Price      *v1.ProductPrice
-	CategoryId *v1.CategoryId
+	Id           *v1.ProductId
+	Name         *v1.ProductName
+	Price        *v1.ProductPrice
+	CategoryId   *v1.CategoryId
+	Currency     *string
+	TaxClass     v1.TaxClass
+	Translations map[string]string
+	Barcode      *string
+	Slug         *string
 }
 
 func (b0 UpdateProductRequest_Product_builder) Build() *UpdateProductRequest_Product {
@@ -1709,6 +6020,20 @@ func (b0 UpdateProductRequest_Product_builder) Build() *UpdateProductRequest_Pro
 	x.xxx_hidden_Name = b.Name
 	x.xxx_hidden_Price = b.Price
 	x.xxx_hidden_CategoryId = b.CategoryId
+	if b.Currency != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_Currency = b.Currency
+	}
+	x.xxx_hidden_TaxClass = b.TaxClass
+	x.xxx_hidden_Translations = b.Translations
+	if b.Barcode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_Barcode = b.Barcode
+	}
+	if b.Slug != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_Slug = b.Slug
+	}
 	return m0
 }
 
@@ -1717,20 +6042,33 @@ var File_command_v1_command_proto protoreflect.FileDescriptor
 const file_command_v1_command_proto_rawDesc = "" +
 	"\n" +
 	"\x18command/v1/command.proto\x12\n" +
-	"command.v1\x1a\x1bbuf/validate/validate.proto\x1a\x15common/v1/error.proto\x1a\x16common/v1/models.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"t\n" +
+	"command.v1\x1a\x1bbuf/validate/validate.proto\x1a\x15common/v1/error.proto\x1a\x16common/v1/models.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc7\x03\n" +
 	"\x15CreateCategoryRequest\x12.\n" +
 	"\x04crud\x18\x01 \x01(\x0e2\x10.command.v1.CRUDB\b\xbaH\x05\x82\x01\x02\b\x01R\x04crud\x12+\n" +
-	"\x04name\x18\x02 \x01(\v2\x17.common.v1.CategoryNameR\x04name\"\xb3\x01\n" +
+	"\x04name\x18\x02 \x01(\v2\x17.common.v1.CategoryNameR\x04name\x122\n" +
+	"\tparent_id\x18\x03 \x01(\v2\x15.common.v1.CategoryIdR\bparentId\x12\x90\x01\n" +
+	"\ftranslations\x18\x04 \x03(\v23.command.v1.CreateCategoryRequest.TranslationsEntryB7\xbaH4\x9a\x011\x10\x14\"%r#2!^[A-Za-z]{2,3}([-_][A-Za-z]{2})?$*\x06r\x04\x10\x01\x18\x14R\ftranslations\x12@\n" +
+	"\x04slug\x18\x05 \x01(\tB'\xbaH$r\"\x18d2\x1e^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$H\x00R\x04slug\x88\x01\x01\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_slug\"\xb3\x01\n" +
 	"\x16CreateCategoryResponse\x12/\n" +
 	"\bcategory\x18\x01 \x01(\v2\x13.common.v1.CategoryR\bcategory\x12&\n" +
 	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\xef\x01\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\x96\x04\n" +
 	"\x15UpdateCategoryRequest\x12.\n" +
 	"\x04crud\x18\x01 \x01(\x0e2\x10.command.v1.CRUDB\b\xbaH\x05\x82\x01\x02\b\x02R\x04crud\x12F\n" +
-	"\bcategory\x18\x02 \x01(\v2*.command.v1.UpdateCategoryRequest.CategoryR\bcategory\x1a^\n" +
+	"\bcategory\x18\x02 \x01(\v2*.command.v1.UpdateCategoryRequest.CategoryR\bcategory\x1a\x84\x03\n" +
 	"\bCategory\x12%\n" +
 	"\x02id\x18\x01 \x01(\v2\x15.common.v1.CategoryIdR\x02id\x12+\n" +
-	"\x04name\x18\x02 \x01(\v2\x17.common.v1.CategoryNameR\x04name\"\xb3\x01\n" +
+	"\x04name\x18\x02 \x01(\v2\x17.common.v1.CategoryNameR\x04name\x12\x97\x01\n" +
+	"\ftranslations\x18\x03 \x03(\v2<.command.v1.UpdateCategoryRequest.Category.TranslationsEntryB5\xbaH2\x9a\x01/\x10\x14\"%r#2!^[A-Za-z]{2,3}([-_][A-Za-z]{2})?$*\x04r\x02\x18\x14R\ftranslations\x12@\n" +
+	"\x04slug\x18\x04 \x01(\tB'\xbaH$r\"\x18d2\x1e^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$H\x00R\x04slug\x88\x01\x01\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_slug\"\xb3\x01\n" +
 	"\x16UpdateCategoryResponse\x12/\n" +
 	"\bcategory\x18\x01 \x01(\v2\x13.common.v1.CategoryR\bcategory\x12&\n" +
 	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
@@ -1742,30 +6080,64 @@ const file_command_v1_command_proto_rawDesc = "" +
 	"\x16DeleteCategoryResponse\x12/\n" +
 	"\bcategory\x18\x01 \x01(\v2\x13.common.v1.CategoryR\bcategory\x12&\n" +
 	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\xa0\x03\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\x89\x01\n" +
+	"\x13MoveCategoryRequest\x12>\n" +
+	"\vcategory_id\x18\x01 \x01(\v2\x15.common.v1.CategoryIdB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"categoryId\x122\n" +
+	"\tparent_id\x18\x02 \x01(\v2\x15.common.v1.CategoryIdR\bparentId\"\xb1\x01\n" +
+	"\x14MoveCategoryResponse\x12/\n" +
+	"\bcategory\x18\x01 \x01(\v2\x13.common.v1.CategoryR\bcategory\x12&\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\x90\a\n" +
 	"\x14CreateProductRequest\x12.\n" +
 	"\x04crud\x18\x01 \x01(\x0e2\x10.command.v1.CRUDB\b\xbaH\x05\x82\x01\x02\b\x01R\x04crud\x12B\n" +
-	"\aproduct\x18\x02 \x01(\v2(.command.v1.CreateProductRequest.ProductR\aproduct\x1a\x93\x02\n" +
+	"\aproduct\x18\x02 \x01(\v2(.command.v1.CreateProductRequest.ProductR\aproduct\x1a\x83\x06\n" +
 	"\aProduct\x12*\n" +
 	"\x04name\x18\x01 \x01(\v2\x16.common.v1.ProductNameR\x04name\x12-\n" +
 	"\x05price\x18\x02 \x01(\v2\x17.common.v1.ProductPriceR\x05price\x12M\n" +
-	"\bcategory\x18\x03 \x01(\v21.command.v1.CreateProductRequest.Product.CategoryR\bcategory\x1a^\n" +
+	"\bcategory\x18\x03 \x01(\v21.command.v1.CreateProductRequest.Product.CategoryR\bcategory\x122\n" +
+	"\bcurrency\x18\x04 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[A-Z]{3}$H\x00R\bcurrency\x88\x01\x01\x12:\n" +
+	"\ttax_class\x18\x05 \x01(\x0e2\x13.common.v1.TaxClassB\b\xbaH\x05\x82\x01\x02\x10\x01R\btaxClass\x12\x97\x01\n" +
+	"\ftranslations\x18\x06 \x03(\v2:.command.v1.CreateProductRequest.Product.TranslationsEntryB7\xbaH4\x9a\x011\x10\x14\"%r#2!^[A-Za-z]{2,3}([-_][A-Za-z]{2})?$*\x06r\x04\x10\x01\x18dR\ftranslations\x12?\n" +
+	"\abarcode\x18\a \x01(\tB \xbaH\x1dr\x1b2\x19^([0-9]{8}|[0-9]{12,13})$H\x01R\abarcode\x88\x01\x01\x12@\n" +
+	"\x04slug\x18\b \x01(\tB'\xbaH$r\"\x18d2\x1e^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$H\x02R\x04slug\x88\x01\x01\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a^\n" +
 	"\bCategory\x12%\n" +
 	"\x02id\x18\x01 \x01(\v2\x15.common.v1.CategoryIdR\x02id\x12+\n" +
-	"\x04name\x18\x02 \x01(\v2\x17.common.v1.CategoryNameR\x04name\"\xaf\x01\n" +
+	"\x04name\x18\x02 \x01(\v2\x17.common.v1.CategoryNameR\x04nameB\v\n" +
+	"\t_currencyB\n" +
+	"\n" +
+	"\b_barcodeB\a\n" +
+	"\x05_slug\"\xaf\x01\n" +
 	"\x15CreateProductResponse\x12,\n" +
 	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductR\aproduct\x12&\n" +
 	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\xcf\x02\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\xbe\x06\n" +
 	"\x14UpdateProductRequest\x12.\n" +
 	"\x04crud\x18\x01 \x01(\x0e2\x10.command.v1.CRUDB\b\xbaH\x05\x82\x01\x02\b\x02R\x04crud\x12B\n" +
-	"\aproduct\x18\x02 \x01(\v2(.command.v1.UpdateProductRequest.ProductR\aproduct\x1a\xc2\x01\n" +
+	"\aproduct\x18\x02 \x01(\v2(.command.v1.UpdateProductRequest.ProductR\aproduct\x1a\xb1\x05\n" +
 	"\aProduct\x12$\n" +
 	"\x02id\x18\x01 \x01(\v2\x14.common.v1.ProductIdR\x02id\x12*\n" +
 	"\x04name\x18\x02 \x01(\v2\x16.common.v1.ProductNameR\x04name\x12-\n" +
 	"\x05price\x18\x03 \x01(\v2\x17.common.v1.ProductPriceR\x05price\x126\n" +
 	"\vcategory_id\x18\x04 \x01(\v2\x15.common.v1.CategoryIdR\n" +
-	"categoryId\"\xaf\x01\n" +
+	"categoryId\x122\n" +
+	"\bcurrency\x18\x05 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[A-Z]{3}$H\x00R\bcurrency\x88\x01\x01\x12:\n" +
+	"\ttax_class\x18\x06 \x01(\x0e2\x13.common.v1.TaxClassB\b\xbaH\x05\x82\x01\x02\x10\x01R\btaxClass\x12\x95\x01\n" +
+	"\ftranslations\x18\a \x03(\v2:.command.v1.UpdateProductRequest.Product.TranslationsEntryB5\xbaH2\x9a\x01/\x10\x14\"%r#2!^[A-Za-z]{2,3}([-_][A-Za-z]{2})?$*\x04r\x02\x18dR\ftranslations\x12@\n" +
+	"\abarcode\x18\b \x01(\tB!\xbaH\x1er\x1c2\x1a^([0-9]{8}|[0-9]{12,13})?$H\x01R\abarcode\x88\x01\x01\x12@\n" +
+	"\x04slug\x18\t \x01(\tB'\xbaH$r\"\x18d2\x1e^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$H\x02R\x04slug\x88\x01\x01\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\v\n" +
+	"\t_currencyB\n" +
+	"\n" +
+	"\b_barcodeB\a\n" +
+	"\x05_slug\"\xaf\x01\n" +
 	"\x15UpdateProductResponse\x12,\n" +
 	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductR\aproduct\x12&\n" +
 	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
@@ -1776,112 +6148,476 @@ const file_command_v1_command_proto_rawDesc = "" +
 	"\x15DeleteProductResponse\x12,\n" +
 	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductR\aproduct\x12&\n" +
 	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"T\n" +
+	"\x15PublishProductRequest\x12;\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\v2\x14.common.v1.ProductIdB\x06\xbaH\x03\xc8\x01\x01R\tproductId\"\x88\x01\n" +
+	"\x16PublishProductResponse\x12,\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductR\aproduct\x12@\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"T\n" +
+	"\x15SuspendProductRequest\x12;\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\v2\x14.common.v1.ProductIdB\x06\xbaH\x03\xc8\x01\x01R\tproductId\"\x88\x01\n" +
+	"\x16SuspendProductResponse\x12,\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductR\aproduct\x12@\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"X\n" +
+	"\x19DiscontinueProductRequest\x12;\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\v2\x14.common.v1.ProductIdB\x06\xbaH\x03\xc8\x01\x01R\tproductId\"\x8c\x01\n" +
+	"\x1aDiscontinueProductResponse\x12,\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductR\aproduct\x12@\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\x89\x02\n" +
+	"\x11VariantAttributes\x12,\n" +
+	"\x03sku\x18\x01 \x01(\tB\x1a\xbaH\x17r\x15\x10\x01\x18@2\x0f^[a-zA-Z0-9-]+$R\x03sku\x12>\n" +
+	"\aoptions\x18\x02 \x03(\v2\x18.common.v1.VariantOptionB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10\x03R\aoptions\x127\n" +
+	"\x0eprice_override\x18\x03 \x01(\x05B\v\xbaH\b\x1a\x06\x18\xc0\x84= \x00H\x00R\rpriceOverride\x88\x01\x01\x12:\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.common.v1.VariantStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06statusB\x11\n" +
+	"\x0f_price_override\"\x89\x01\n" +
+	"\x11AddVariantRequest\x123\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\v2\x14.common.v1.ProductIdR\tproductId\x12?\n" +
+	"\avariant\x18\x02 \x01(\v2\x1d.command.v1.VariantAttributesB\x06\xbaH\x03\xc8\x01\x01R\avariant\"\xb3\x01\n" +
+	"\x12AddVariantResponse\x123\n" +
+	"\avariant\x18\x01 \x01(\v2\x19.common.v1.ProductVariantR\avariant\x12&\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\xb5\x01\n" +
+	"\x14UpdateVariantRequest\x123\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\v2\x14.common.v1.ProductIdR\tproductId\x12'\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tvariantId\x12?\n" +
+	"\avariant\x18\x03 \x01(\v2\x1d.command.v1.VariantAttributesB\x06\xbaH\x03\xc8\x01\x01R\avariant\"\xb6\x01\n" +
+	"\x15UpdateVariantResponse\x123\n" +
+	"\avariant\x18\x01 \x01(\v2\x19.common.v1.ProductVariantR\avariant\x12&\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"t\n" +
+	"\x14RemoveVariantRequest\x123\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\v2\x14.common.v1.ProductIdR\tproductId\x12'\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tvariantId\"\xb6\x01\n" +
+	"\x15RemoveVariantResponse\x123\n" +
+	"\avariant\x18\x01 \x01(\v2\x19.common.v1.ProductVariantR\avariant\x12&\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\xd2\x02\n" +
+	"\x0fPriceAdjustment\x12?\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1f.command.v1.PriceAdjustmentTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value\x12?\n" +
+	"\brounding\x18\x03 \x01(\x0e2\x19.command.v1.PriceRoundingB\b\xbaH\x05\x82\x01\x02\x10\x01R\brounding\x12-\n" +
+	"\rrounding_unit\x18\x04 \x01(\rB\b\xbaH\x05*\x03\x18\x90NR\froundingUnit\x12-\n" +
+	"\tmin_price\x18\x05 \x01(\rB\v\xbaH\b*\x06\x18\xc0\x84= \x00H\x00R\bminPrice\x88\x01\x01\x12-\n" +
+	"\tmax_price\x18\x06 \x01(\rB\v\xbaH\b*\x06\x18\xc0\x84= \x00H\x01R\bmaxPrice\x88\x01\x01B\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_price\"\x8b\x02\n" +
+	"\x13AdjustPricesRequest\x12>\n" +
+	"\vcategory_id\x18\x01 \x01(\v2\x15.common.v1.CategoryIdB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"categoryId\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\x12$\n" +
+	"\x04tags\x18\x03 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x18\x1eR\x04tags\x12C\n" +
+	"\n" +
+	"adjustment\x18\x04 \x01(\v2\x1b.command.v1.PriceAdjustmentB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"adjustment\x12\x18\n" +
+	"\apreview\x18\x05 \x01(\bR\apreview\"\xb9\x01\n" +
+	"\x15PriceAdjustmentResult\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12!\n" +
+	"\fbefore_price\x18\x04 \x01(\rR\vbeforePrice\x12\x1f\n" +
+	"\vafter_price\x18\x05 \x01(\rR\n" +
+	"afterPrice\"\xd7\x01\n" +
+	"\x14AdjustPricesResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.command.v1.PriceAdjustmentResultR\aresults\x12\x18\n" +
+	"\apreview\x18\x02 \x01(\bR\apreview\x12&\n" +
+	"\x05error\x18\x03 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\xdc\x01\n" +
+	"\vReservation\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12&\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x125\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1d.command.v1.ReservationStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"w\n" +
+	"\x12AdjustStockRequest\x123\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\v2\x14.common.v1.ProductIdR\tproductId\x12,\n" +
+	"\x05delta\x18\x02 \x01(\x05B\x16\xbaH\x13\x1a\x118\x00\x18\xc0\x84=(\xc0\xfb\xc2\xff\xff\xff\xff\xff\xff\x01R\x05delta\"\xa7\x01\n" +
+	"\x13AdjustStockResponse\x12&\n" +
+	"\x05stock\x18\x01 \x01(\v2\x10.common.v1.StockR\x05stock\x12&\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\xa1\x01\n" +
+	"\x13ReserveStockRequest\x123\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\v2\x14.common.v1.ProductIdR\tproductId\x12'\n" +
+	"\bquantity\x18\x02 \x01(\x05B\v\xbaH\b\x1a\x06\x18\xc0\x84= \x00R\bquantity\x12,\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05B\v\xbaH\b\x1a\x06\x18\x80\xa3\x05(\x00R\n" +
+	"ttlSeconds\"\xe3\x01\n" +
+	"\x14ReserveStockResponse\x129\n" +
+	"\vreservation\x18\x01 \x01(\v2\x17.command.v1.ReservationR\vreservation\x12&\n" +
+	"\x05stock\x18\x02 \x01(\v2\x10.common.v1.StockR\x05stock\x12&\n" +
+	"\x05error\x18\x03 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"L\n" +
+	"\x19ReleaseReservationRequest\x12/\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\rreservationId\"\xe9\x01\n" +
+	"\x1aReleaseReservationResponse\x129\n" +
+	"\vreservation\x18\x01 \x01(\v2\x17.command.v1.ReservationR\vreservation\x12&\n" +
+	"\x05stock\x18\x02 \x01(\v2\x10.common.v1.StockR\x05stock\x12&\n" +
+	"\x05error\x18\x03 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"K\n" +
+	"\x18CommitReservationRequest\x12/\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\rreservationId\"\xe8\x01\n" +
+	"\x19CommitReservationResponse\x129\n" +
+	"\vreservation\x18\x01 \x01(\v2\x17.command.v1.ReservationR\vreservation\x12&\n" +
+	"\x05stock\x18\x02 \x01(\v2\x10.common.v1.StockR\x05stock\x12&\n" +
+	"\x05error\x18\x03 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"x\n" +
+	"\x11AttachTagsRequest\x122\n" +
+	"\vproduct_ids\x18\x01 \x03(\tB\x11\xbaH\x0e\x92\x01\v\b\x01\x10d\"\x05r\x03\xb0\x01\x01R\n" +
+	"productIds\x12/\n" +
+	"\ttag_names\x18\x02 \x03(\tB\x12\xbaH\x0f\x92\x01\f\b\x01\x10\x14\"\x06r\x04\x10\x01\x18\x1eR\btagNames\"\xc9\x01\n" +
+	"\x12AttachTagsResponse\x12\"\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0e.common.v1.TagR\x04tags\x12%\n" +
+	"\x0eattached_count\x18\x02 \x01(\x05R\rattachedCount\x12&\n" +
+	"\x05error\x18\x03 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"x\n" +
+	"\x11DetachTagsRequest\x122\n" +
+	"\vproduct_ids\x18\x01 \x03(\tB\x11\xbaH\x0e\x92\x01\v\b\x01\x10d\"\x05r\x03\xb0\x01\x01R\n" +
+	"productIds\x12/\n" +
+	"\ttag_names\x18\x02 \x03(\tB\x12\xbaH\x0f\x92\x01\f\b\x01\x10\x14\"\x06r\x04\x10\x01\x18\x1eR\btagNames\"\xc9\x01\n" +
+	"\x12DetachTagsResponse\x12\"\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0e.common.v1.TagR\x04tags\x12%\n" +
+	"\x0edetached_count\x18\x02 \x01(\x05R\rdetachedCount\x12&\n" +
+	"\x05error\x18\x03 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\x88\x02\n" +
+	"\x14SchedulePriceRequest\x12;\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\v2\x14.common.v1.ProductIdB\x06\xbaH\x03\xc8\x01\x01R\tproductId\x125\n" +
+	"\x05price\x18\x02 \x01(\v2\x17.common.v1.ProductPriceB\x06\xbaH\x03\xc8\x01\x01R\x05price\x12?\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\bstartsAt\x12;\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x06endsAt\"\xc2\x01\n" +
+	"\x15SchedulePriceResponse\x12?\n" +
+	"\x0eprice_schedule\x18\x01 \x01(\v2\x18.common.v1.PriceScheduleR\rpriceSchedule\x12&\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\x8f\x01\n" +
+	"\x1aCancelPriceScheduleRequest\x12;\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\v2\x14.common.v1.ProductIdB\x06\xbaH\x03\xc8\x01\x01R\tproductId\x124\n" +
+	"\x11price_schedule_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0fpriceScheduleId\"\xc8\x01\n" +
+	"\x1bCancelPriceScheduleResponse\x12?\n" +
+	"\x0eprice_schedule\x18\x01 \x01(\v2\x18.common.v1.PriceScheduleR\rpriceSchedule\x12&\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
 	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp*O\n" +
 	"\x04CRUD\x12\x14\n" +
 	"\x10CRUD_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vCRUD_INSERT\x10\x01\x12\x0f\n" +
 	"\vCRUD_UPDATE\x10\x02\x12\x0f\n" +
-	"\vCRUD_DELETE\x10\x032\x9c\x02\n" +
+	"\vCRUD_DELETE\x10\x03*\x80\x01\n" +
+	"\x13PriceAdjustmentType\x12%\n" +
+	"!PRICE_ADJUSTMENT_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dPRICE_ADJUSTMENT_TYPE_PERCENT\x10\x01\x12\x1f\n" +
+	"\x1bPRICE_ADJUSTMENT_TYPE_FIXED\x10\x02*~\n" +
+	"\rPriceRounding\x12\x1e\n" +
+	"\x1aPRICE_ROUNDING_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PRICE_ROUNDING_FLOOR\x10\x01\x12\x1a\n" +
+	"\x16PRICE_ROUNDING_HALF_UP\x10\x02\x12\x17\n" +
+	"\x13PRICE_ROUNDING_CEIL\x10\x03*\xbb\x01\n" +
+	"\x11ReservationStatus\x12\"\n" +
+	"\x1eRESERVATION_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RESERVED\x10\x01\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RELEASED\x10\x02\x12 \n" +
+	"\x1cRESERVATION_STATUS_COMMITTED\x10\x03\x12\x1e\n" +
+	"\x1aRESERVATION_STATUS_EXPIRED\x10\x042\xef\x02\n" +
 	"\x0fCategoryService\x12W\n" +
 	"\x0eCreateCategory\x12!.command.v1.CreateCategoryRequest\x1a\".command.v1.CreateCategoryResponse\x12W\n" +
 	"\x0eUpdateCategory\x12!.command.v1.UpdateCategoryRequest\x1a\".command.v1.UpdateCategoryResponse\x12W\n" +
-	"\x0eDeleteCategory\x12!.command.v1.DeleteCategoryRequest\x1a\".command.v1.DeleteCategoryResponse2\x92\x02\n" +
+	"\x0eDeleteCategory\x12!.command.v1.DeleteCategoryRequest\x1a\".command.v1.DeleteCategoryResponse\x12Q\n" +
+	"\fMoveCategory\x12\x1f.command.v1.MoveCategoryRequest\x1a .command.v1.MoveCategoryResponse2\xf5\x06\n" +
 	"\x0eProductService\x12T\n" +
 	"\rCreateProduct\x12 .command.v1.CreateProductRequest\x1a!.command.v1.CreateProductResponse\x12T\n" +
 	"\rUpdateProduct\x12 .command.v1.UpdateProductRequest\x1a!.command.v1.UpdateProductResponse\x12T\n" +
-	"\rDeleteProduct\x12 .command.v1.DeleteProductRequest\x1a!.command.v1.DeleteProductResponseB\xbc\x01\n" +
+	"\rDeleteProduct\x12 .command.v1.DeleteProductRequest\x1a!.command.v1.DeleteProductResponse\x12W\n" +
+	"\x0ePublishProduct\x12!.command.v1.PublishProductRequest\x1a\".command.v1.PublishProductResponse\x12W\n" +
+	"\x0eSuspendProduct\x12!.command.v1.SuspendProductRequest\x1a\".command.v1.SuspendProductResponse\x12c\n" +
+	"\x12DiscontinueProduct\x12%.command.v1.DiscontinueProductRequest\x1a&.command.v1.DiscontinueProductResponse\x12K\n" +
+	"\n" +
+	"AddVariant\x12\x1d.command.v1.AddVariantRequest\x1a\x1e.command.v1.AddVariantResponse\x12T\n" +
+	"\rUpdateVariant\x12 .command.v1.UpdateVariantRequest\x1a!.command.v1.UpdateVariantResponse\x12T\n" +
+	"\rRemoveVariant\x12 .command.v1.RemoveVariantRequest\x1a!.command.v1.RemoveVariantResponse\x12Q\n" +
+	"\fAdjustPrices\x12\x1f.command.v1.AdjustPricesRequest\x1a .command.v1.AdjustPricesResponse2\xf8\x02\n" +
+	"\fStockService\x12N\n" +
+	"\vAdjustStock\x12\x1e.command.v1.AdjustStockRequest\x1a\x1f.command.v1.AdjustStockResponse\x12Q\n" +
+	"\fReserveStock\x12\x1f.command.v1.ReserveStockRequest\x1a .command.v1.ReserveStockResponse\x12c\n" +
+	"\x12ReleaseReservation\x12%.command.v1.ReleaseReservationRequest\x1a&.command.v1.ReleaseReservationResponse\x12`\n" +
+	"\x11CommitReservation\x12$.command.v1.CommitReservationRequest\x1a%.command.v1.CommitReservationResponse2\xa6\x01\n" +
+	"\n" +
+	"TagService\x12K\n" +
+	"\n" +
+	"AttachTags\x12\x1d.command.v1.AttachTagsRequest\x1a\x1e.command.v1.AttachTagsResponse\x12K\n" +
+	"\n" +
+	"DetachTags\x12\x1d.command.v1.DetachTagsRequest\x1a\x1e.command.v1.DetachTagsResponse2\xd4\x01\n" +
+	"\x14PriceScheduleService\x12T\n" +
+	"\rSchedulePrice\x12 .command.v1.SchedulePriceRequest\x1a!.command.v1.SchedulePriceResponse\x12f\n" +
+	"\x13CancelPriceSchedule\x12&.command.v1.CancelPriceScheduleRequest\x1a'.command.v1.CancelPriceScheduleResponseB\xbc\x01\n" +
 	"\x0ecom.command.v1B\fCommandProtoP\x01ZSgithub.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1;commandv1\xa2\x02\x03CXX\xaa\x02\n" +
 	"Command.V1\xca\x02\n" +
 	"Command\\V1\xe2\x02\x16Command\\V1\\GPBMetadata\xea\x02\vCommand::V1b\x06proto3"
 
-var file_command_v1_command_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_command_v1_command_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_command_v1_command_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_command_v1_command_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_command_v1_command_proto_goTypes = []any{
 	(CRUD)(0),                                     // 0: command.v1.CRUD
-	(*CreateCategoryRequest)(nil),                 // 1: command.v1.CreateCategoryRequest
-	(*CreateCategoryResponse)(nil),                // 2: command.v1.CreateCategoryResponse
-	(*UpdateCategoryRequest)(nil),                 // 3: command.v1.UpdateCategoryRequest
-	(*UpdateCategoryResponse)(nil),                // 4: command.v1.UpdateCategoryResponse
-	(*DeleteCategoryRequest)(nil),                 // 5: command.v1.DeleteCategoryRequest
-	(*DeleteCategoryResponse)(nil),                // 6: command.v1.DeleteCategoryResponse
-	(*CreateProductRequest)(nil),                  // 7: command.v1.CreateProductRequest
-	(*CreateProductResponse)(nil),                 // 8: command.v1.CreateProductResponse
-	(*UpdateProductRequest)(nil),                  // 9: command.v1.UpdateProductRequest
-	(*UpdateProductResponse)(nil),                 // 10: command.v1.UpdateProductResponse
-	(*DeleteProductRequest)(nil),                  // 11: command.v1.DeleteProductRequest
-	(*DeleteProductResponse)(nil),                 // 12: command.v1.DeleteProductResponse
-	(*UpdateCategoryRequest_Category)(nil),        // 13: command.v1.UpdateCategoryRequest.Category
-	(*CreateProductRequest_Product)(nil),          // 14: command.v1.CreateProductRequest.Product
-	(*CreateProductRequest_Product_Category)(nil), // 15: command.v1.CreateProductRequest.Product.Category
-	(*UpdateProductRequest_Product)(nil),          // 16: command.v1.UpdateProductRequest.Product
-	(*v1.CategoryName)(nil),                       // 17: common.v1.CategoryName
-	(*v1.Category)(nil),                           // 18: common.v1.Category
-	(*v1.Error)(nil),                              // 19: common.v1.Error
-	(*timestamppb.Timestamp)(nil),                 // 20: google.protobuf.Timestamp
-	(*v1.CategoryId)(nil),                         // 21: common.v1.CategoryId
-	(*v1.Product)(nil),                            // 22: common.v1.Product
-	(*v1.ProductId)(nil),                          // 23: common.v1.ProductId
-	(*v1.ProductName)(nil),                        // 24: common.v1.ProductName
-	(*v1.ProductPrice)(nil),                       // 25: common.v1.ProductPrice
+	(PriceAdjustmentType)(0),                      // 1: command.v1.PriceAdjustmentType
+	(PriceRounding)(0),                            // 2: command.v1.PriceRounding
+	(ReservationStatus)(0),                        // 3: command.v1.ReservationStatus
+	(*CreateCategoryRequest)(nil),                 // 4: command.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),                // 5: command.v1.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),                 // 6: command.v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),                // 7: command.v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),                 // 8: command.v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),                // 9: command.v1.DeleteCategoryResponse
+	(*MoveCategoryRequest)(nil),                   // 10: command.v1.MoveCategoryRequest
+	(*MoveCategoryResponse)(nil),                  // 11: command.v1.MoveCategoryResponse
+	(*CreateProductRequest)(nil),                  // 12: command.v1.CreateProductRequest
+	(*CreateProductResponse)(nil),                 // 13: command.v1.CreateProductResponse
+	(*UpdateProductRequest)(nil),                  // 14: command.v1.UpdateProductRequest
+	(*UpdateProductResponse)(nil),                 // 15: command.v1.UpdateProductResponse
+	(*DeleteProductRequest)(nil),                  // 16: command.v1.DeleteProductRequest
+	(*DeleteProductResponse)(nil),                 // 17: command.v1.DeleteProductResponse
+	(*PublishProductRequest)(nil),                 // 18: command.v1.PublishProductRequest
+	(*PublishProductResponse)(nil),                // 19: command.v1.PublishProductResponse
+	(*SuspendProductRequest)(nil),                 // 20: command.v1.SuspendProductRequest
+	(*SuspendProductResponse)(nil),                // 21: command.v1.SuspendProductResponse
+	(*DiscontinueProductRequest)(nil),             // 22: command.v1.DiscontinueProductRequest
+	(*DiscontinueProductResponse)(nil),            // 23: command.v1.DiscontinueProductResponse
+	(*VariantAttributes)(nil),                     // 24: command.v1.VariantAttributes
+	(*AddVariantRequest)(nil),                     // 25: command.v1.AddVariantRequest
+	(*AddVariantResponse)(nil),                    // 26: command.v1.AddVariantResponse
+	(*UpdateVariantRequest)(nil),                  // 27: command.v1.UpdateVariantRequest
+	(*UpdateVariantResponse)(nil),                 // 28: command.v1.UpdateVariantResponse
+	(*RemoveVariantRequest)(nil),                  // 29: command.v1.RemoveVariantRequest
+	(*RemoveVariantResponse)(nil),                 // 30: command.v1.RemoveVariantResponse
+	(*PriceAdjustment)(nil),                       // 31: command.v1.PriceAdjustment
+	(*AdjustPricesRequest)(nil),                   // 32: command.v1.AdjustPricesRequest
+	(*PriceAdjustmentResult)(nil),                 // 33: command.v1.PriceAdjustmentResult
+	(*AdjustPricesResponse)(nil),                  // 34: command.v1.AdjustPricesResponse
+	(*Reservation)(nil),                           // 35: command.v1.Reservation
+	(*AdjustStockRequest)(nil),                    // 36: command.v1.AdjustStockRequest
+	(*AdjustStockResponse)(nil),                   // 37: command.v1.AdjustStockResponse
+	(*ReserveStockRequest)(nil),                   // 38: command.v1.ReserveStockRequest
+	(*ReserveStockResponse)(nil),                  // 39: command.v1.ReserveStockResponse
+	(*ReleaseReservationRequest)(nil),             // 40: command.v1.ReleaseReservationRequest
+	(*ReleaseReservationResponse)(nil),            // 41: command.v1.ReleaseReservationResponse
+	(*CommitReservationRequest)(nil),              // 42: command.v1.CommitReservationRequest
+	(*CommitReservationResponse)(nil),             // 43: command.v1.CommitReservationResponse
+	(*AttachTagsRequest)(nil),                     // 44: command.v1.AttachTagsRequest
+	(*AttachTagsResponse)(nil),                    // 45: command.v1.AttachTagsResponse
+	(*DetachTagsRequest)(nil),                     // 46: command.v1.DetachTagsRequest
+	(*DetachTagsResponse)(nil),                    // 47: command.v1.DetachTagsResponse
+	(*SchedulePriceRequest)(nil),                  // 48: command.v1.SchedulePriceRequest
+	(*SchedulePriceResponse)(nil),                 // 49: command.v1.SchedulePriceResponse
+	(*CancelPriceScheduleRequest)(nil),            // 50: command.v1.CancelPriceScheduleRequest
+	(*CancelPriceScheduleResponse)(nil),           // 51: command.v1.CancelPriceScheduleResponse
+	nil,                                           // 52: command.v1.CreateCategoryRequest.TranslationsEntry
+	(*UpdateCategoryRequest_Category)(nil),        // 53: command.v1.UpdateCategoryRequest.Category
+	nil,                                           // 54: command.v1.UpdateCategoryRequest.Category.TranslationsEntry
+	(*CreateProductRequest_Product)(nil),          // 55: command.v1.CreateProductRequest.Product
+	nil,                                           // 56: command.v1.CreateProductRequest.Product.TranslationsEntry
+	(*CreateProductRequest_Product_Category)(nil), // 57: command.v1.CreateProductRequest.Product.Category
+	(*UpdateProductRequest_Product)(nil),          // 58: command.v1.UpdateProductRequest.Product
+	nil,                                           // 59: command.v1.UpdateProductRequest.Product.TranslationsEntry
+	(*v1.CategoryName)(nil),                       // 60: common.v1.CategoryName
+	(*v1.CategoryId)(nil),                         // 61: common.v1.CategoryId
+	(*v1.Category)(nil),                           // 62: common.v1.Category
+	(*v1.Error)(nil),                              // 63: common.v1.Error
+	(*timestamppb.Timestamp)(nil),                 // 64: google.protobuf.Timestamp
+	(*v1.Product)(nil),                            // 65: common.v1.Product
+	(*v1.ProductId)(nil),                          // 66: common.v1.ProductId
+	(*v1.VariantOption)(nil),                      // 67: common.v1.VariantOption
+	(v1.VariantStatus)(0),                         // 68: common.v1.VariantStatus
+	(*v1.ProductVariant)(nil),                     // 69: common.v1.ProductVariant
+	(*v1.Stock)(nil),                              // 70: common.v1.Stock
+	(*v1.Tag)(nil),                                // 71: common.v1.Tag
+	(*v1.ProductPrice)(nil),                       // 72: common.v1.ProductPrice
+	(*v1.PriceSchedule)(nil),                      // 73: common.v1.PriceSchedule
+	(*v1.ProductName)(nil),                        // 74: common.v1.ProductName
+	(v1.TaxClass)(0),                              // 75: common.v1.TaxClass
 }
 var file_command_v1_command_proto_depIdxs = []int32{
-	0,  // 0: command.v1.CreateCategoryRequest.crud:type_name -> command.v1.CRUD
-	17, // 1: command.v1.CreateCategoryRequest.name:type_name -> common.v1.CategoryName
-	18, // 2: command.v1.CreateCategoryResponse.category:type_name -> common.v1.Category
-	19, // 3: command.v1.CreateCategoryResponse.error:type_name -> common.v1.Error
-	20, // 4: command.v1.CreateCategoryResponse.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 5: command.v1.UpdateCategoryRequest.crud:type_name -> command.v1.CRUD
-	13, // 6: command.v1.UpdateCategoryRequest.category:type_name -> command.v1.UpdateCategoryRequest.Category
-	18, // 7: command.v1.UpdateCategoryResponse.category:type_name -> common.v1.Category
-	19, // 8: command.v1.UpdateCategoryResponse.error:type_name -> common.v1.Error
-	20, // 9: command.v1.UpdateCategoryResponse.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 10: command.v1.DeleteCategoryRequest.crud:type_name -> command.v1.CRUD
-	21, // 11: command.v1.DeleteCategoryRequest.category_id:type_name -> common.v1.CategoryId
-	18, // 12: command.v1.DeleteCategoryResponse.category:type_name -> common.v1.Category
-	19, // 13: command.v1.DeleteCategoryResponse.error:type_name -> common.v1.Error
-	20, // 14: command.v1.DeleteCategoryResponse.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 15: command.v1.CreateProductRequest.crud:type_name -> command.v1.CRUD
-	14, // 16: command.v1.CreateProductRequest.product:type_name -> command.v1.CreateProductRequest.Product
-	22, // 17: command.v1.CreateProductResponse.product:type_name -> common.v1.Product
-	19, // 18: command.v1.CreateProductResponse.error:type_name -> common.v1.Error
-	20, // 19: command.v1.CreateProductResponse.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 20: command.v1.UpdateProductRequest.crud:type_name -> command.v1.CRUD
-	16, // 21: command.v1.UpdateProductRequest.product:type_name -> command.v1.UpdateProductRequest.Product
-	22, // 22: command.v1.UpdateProductResponse.product:type_name -> common.v1.Product
-	19, // 23: command.v1.UpdateProductResponse.error:type_name -> common.v1.Error
-	20, // 24: command.v1.UpdateProductResponse.timestamp:type_name -> google.protobuf.Timestamp
-	23, // 25: command.v1.DeleteProductRequest.product_id:type_name -> common.v1.ProductId
-	22, // 26: command.v1.DeleteProductResponse.product:type_name -> common.v1.Product
-	19, // 27: command.v1.DeleteProductResponse.error:type_name -> common.v1.Error
-	20, // 28: command.v1.DeleteProductResponse.timestamp:type_name -> google.protobuf.Timestamp
-	21, // 29: command.v1.UpdateCategoryRequest.Category.id:type_name -> common.v1.CategoryId
-	17, // 30: command.v1.UpdateCategoryRequest.Category.name:type_name -> common.v1.CategoryName
-	24, // 31: command.v1.CreateProductRequest.Product.name:type_name -> common.v1.ProductName
-	25, // 32: command.v1.CreateProductRequest.Product.price:type_name -> common.v1.ProductPrice
-	15, // 33: command.v1.CreateProductRequest.Product.category:type_name -> command.v1.CreateProductRequest.Product.Category
-	21, // 34: command.v1.CreateProductRequest.Product.Category.id:type_name -> common.v1.CategoryId
-	17, // 35: command.v1.CreateProductRequest.Product.Category.name:type_name -> common.v1.CategoryName
-	23, // 36: command.v1.UpdateProductRequest.Product.id:type_name -> common.v1.ProductId
-	24, // 37: command.v1.UpdateProductRequest.Product.name:type_name -> common.v1.ProductName
-	25, // 38: command.v1.UpdateProductRequest.Product.price:type_name -> common.v1.ProductPrice
-	21, // 39: command.v1.UpdateProductRequest.Product.category_id:type_name -> common.v1.CategoryId
-	1,  // 40: command.v1.CategoryService.CreateCategory:input_type -> command.v1.CreateCategoryRequest
-	3,  // 41: command.v1.CategoryService.UpdateCategory:input_type -> command.v1.UpdateCategoryRequest
-	5,  // 42: command.v1.CategoryService.DeleteCategory:input_type -> command.v1.DeleteCategoryRequest
-	7,  // 43: command.v1.ProductService.CreateProduct:input_type -> command.v1.CreateProductRequest
-	9,  // 44: command.v1.ProductService.UpdateProduct:input_type -> command.v1.UpdateProductRequest
-	11, // 45: command.v1.ProductService.DeleteProduct:input_type -> command.v1.DeleteProductRequest
-	2,  // 46: command.v1.CategoryService.CreateCategory:output_type -> command.v1.CreateCategoryResponse
-	4,  // 47: command.v1.CategoryService.UpdateCategory:output_type -> command.v1.UpdateCategoryResponse
-	6,  // 48: command.v1.CategoryService.DeleteCategory:output_type -> command.v1.DeleteCategoryResponse
-	8,  // 49: command.v1.ProductService.CreateProduct:output_type -> command.v1.CreateProductResponse
-	10, // 50: command.v1.ProductService.UpdateProduct:output_type -> command.v1.UpdateProductResponse
-	12, // 51: command.v1.ProductService.DeleteProduct:output_type -> command.v1.DeleteProductResponse
-	46, // [46:52] is the sub-list for method output_type
-	40, // [40:46] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	0,   // 0: command.v1.CreateCategoryRequest.crud:type_name -> command.v1.CRUD
+	60,  // 1: command.v1.CreateCategoryRequest.name:type_name -> common.v1.CategoryName
+	61,  // 2: command.v1.CreateCategoryRequest.parent_id:type_name -> common.v1.CategoryId
+	52,  // 3: command.v1.CreateCategoryRequest.translations:type_name -> command.v1.CreateCategoryRequest.TranslationsEntry
+	62,  // 4: command.v1.CreateCategoryResponse.category:type_name -> common.v1.Category
+	63,  // 5: command.v1.CreateCategoryResponse.error:type_name -> common.v1.Error
+	64,  // 6: command.v1.CreateCategoryResponse.timestamp:type_name -> google.protobuf.Timestamp
+	0,   // 7: command.v1.UpdateCategoryRequest.crud:type_name -> command.v1.CRUD
+	53,  // 8: command.v1.UpdateCategoryRequest.category:type_name -> command.v1.UpdateCategoryRequest.Category
+	62,  // 9: command.v1.UpdateCategoryResponse.category:type_name -> common.v1.Category
+	63,  // 10: command.v1.UpdateCategoryResponse.error:type_name -> common.v1.Error
+	64,  // 11: command.v1.UpdateCategoryResponse.timestamp:type_name -> google.protobuf.Timestamp
+	0,   // 12: command.v1.DeleteCategoryRequest.crud:type_name -> command.v1.CRUD
+	61,  // 13: command.v1.DeleteCategoryRequest.category_id:type_name -> common.v1.CategoryId
+	62,  // 14: command.v1.DeleteCategoryResponse.category:type_name -> common.v1.Category
+	63,  // 15: command.v1.DeleteCategoryResponse.error:type_name -> common.v1.Error
+	64,  // 16: command.v1.DeleteCategoryResponse.timestamp:type_name -> google.protobuf.Timestamp
+	61,  // 17: command.v1.MoveCategoryRequest.category_id:type_name -> common.v1.CategoryId
+	61,  // 18: command.v1.MoveCategoryRequest.parent_id:type_name -> common.v1.CategoryId
+	62,  // 19: command.v1.MoveCategoryResponse.category:type_name -> common.v1.Category
+	63,  // 20: command.v1.MoveCategoryResponse.error:type_name -> common.v1.Error
+	64,  // 21: command.v1.MoveCategoryResponse.timestamp:type_name -> google.protobuf.Timestamp
+	0,   // 22: command.v1.CreateProductRequest.crud:type_name -> command.v1.CRUD
+	55,  // 23: command.v1.CreateProductRequest.product:type_name -> command.v1.CreateProductRequest.Product
+	65,  // 24: command.v1.CreateProductResponse.product:type_name -> common.v1.Product
+	63,  // 25: command.v1.CreateProductResponse.error:type_name -> common.v1.Error
+	64,  // 26: command.v1.CreateProductResponse.timestamp:type_name -> google.protobuf.Timestamp
+	0,   // 27: command.v1.UpdateProductRequest.crud:type_name -> command.v1.CRUD
+	58,  // 28: command.v1.UpdateProductRequest.product:type_name -> command.v1.UpdateProductRequest.Product
+	65,  // 29: command.v1.UpdateProductResponse.product:type_name -> common.v1.Product
+	63,  // 30: command.v1.UpdateProductResponse.error:type_name -> common.v1.Error
+	64,  // 31: command.v1.UpdateProductResponse.timestamp:type_name -> google.protobuf.Timestamp
+	66,  // 32: command.v1.DeleteProductRequest.product_id:type_name -> common.v1.ProductId
+	65,  // 33: command.v1.DeleteProductResponse.product:type_name -> common.v1.Product
+	63,  // 34: command.v1.DeleteProductResponse.error:type_name -> common.v1.Error
+	64,  // 35: command.v1.DeleteProductResponse.timestamp:type_name -> google.protobuf.Timestamp
+	66,  // 36: command.v1.PublishProductRequest.product_id:type_name -> common.v1.ProductId
+	65,  // 37: command.v1.PublishProductResponse.product:type_name -> common.v1.Product
+	64,  // 38: command.v1.PublishProductResponse.timestamp:type_name -> google.protobuf.Timestamp
+	66,  // 39: command.v1.SuspendProductRequest.product_id:type_name -> common.v1.ProductId
+	65,  // 40: command.v1.SuspendProductResponse.product:type_name -> common.v1.Product
+	64,  // 41: command.v1.SuspendProductResponse.timestamp:type_name -> google.protobuf.Timestamp
+	66,  // 42: command.v1.DiscontinueProductRequest.product_id:type_name -> common.v1.ProductId
+	65,  // 43: command.v1.DiscontinueProductResponse.product:type_name -> common.v1.Product
+	64,  // 44: command.v1.DiscontinueProductResponse.timestamp:type_name -> google.protobuf.Timestamp
+	67,  // 45: command.v1.VariantAttributes.options:type_name -> common.v1.VariantOption
+	68,  // 46: command.v1.VariantAttributes.status:type_name -> common.v1.VariantStatus
+	66,  // 47: command.v1.AddVariantRequest.product_id:type_name -> common.v1.ProductId
+	24,  // 48: command.v1.AddVariantRequest.variant:type_name -> command.v1.VariantAttributes
+	69,  // 49: command.v1.AddVariantResponse.variant:type_name -> common.v1.ProductVariant
+	63,  // 50: command.v1.AddVariantResponse.error:type_name -> common.v1.Error
+	64,  // 51: command.v1.AddVariantResponse.timestamp:type_name -> google.protobuf.Timestamp
+	66,  // 52: command.v1.UpdateVariantRequest.product_id:type_name -> common.v1.ProductId
+	24,  // 53: command.v1.UpdateVariantRequest.variant:type_name -> command.v1.VariantAttributes
+	69,  // 54: command.v1.UpdateVariantResponse.variant:type_name -> common.v1.ProductVariant
+	63,  // 55: command.v1.UpdateVariantResponse.error:type_name -> common.v1.Error
+	64,  // 56: command.v1.UpdateVariantResponse.timestamp:type_name -> google.protobuf.Timestamp
+	66,  // 57: command.v1.RemoveVariantRequest.product_id:type_name -> common.v1.ProductId
+	69,  // 58: command.v1.RemoveVariantResponse.variant:type_name -> common.v1.ProductVariant
+	63,  // 59: command.v1.RemoveVariantResponse.error:type_name -> common.v1.Error
+	64,  // 60: command.v1.RemoveVariantResponse.timestamp:type_name -> google.protobuf.Timestamp
+	1,   // 61: command.v1.PriceAdjustment.type:type_name -> command.v1.PriceAdjustmentType
+	2,   // 62: command.v1.PriceAdjustment.rounding:type_name -> command.v1.PriceRounding
+	61,  // 63: command.v1.AdjustPricesRequest.category_id:type_name -> common.v1.CategoryId
+	31,  // 64: command.v1.AdjustPricesRequest.adjustment:type_name -> command.v1.PriceAdjustment
+	33,  // 65: command.v1.AdjustPricesResponse.results:type_name -> command.v1.PriceAdjustmentResult
+	63,  // 66: command.v1.AdjustPricesResponse.error:type_name -> common.v1.Error
+	64,  // 67: command.v1.AdjustPricesResponse.timestamp:type_name -> google.protobuf.Timestamp
+	3,   // 68: command.v1.Reservation.status:type_name -> command.v1.ReservationStatus
+	64,  // 69: command.v1.Reservation.expires_at:type_name -> google.protobuf.Timestamp
+	66,  // 70: command.v1.AdjustStockRequest.product_id:type_name -> common.v1.ProductId
+	70,  // 71: command.v1.AdjustStockResponse.stock:type_name -> common.v1.Stock
+	63,  // 72: command.v1.AdjustStockResponse.error:type_name -> common.v1.Error
+	64,  // 73: command.v1.AdjustStockResponse.timestamp:type_name -> google.protobuf.Timestamp
+	66,  // 74: command.v1.ReserveStockRequest.product_id:type_name -> common.v1.ProductId
+	35,  // 75: command.v1.ReserveStockResponse.reservation:type_name -> command.v1.Reservation
+	70,  // 76: command.v1.ReserveStockResponse.stock:type_name -> common.v1.Stock
+	63,  // 77: command.v1.ReserveStockResponse.error:type_name -> common.v1.Error
+	64,  // 78: command.v1.ReserveStockResponse.timestamp:type_name -> google.protobuf.Timestamp
+	35,  // 79: command.v1.ReleaseReservationResponse.reservation:type_name -> command.v1.Reservation
+	70,  // 80: command.v1.ReleaseReservationResponse.stock:type_name -> common.v1.Stock
+	63,  // 81: command.v1.ReleaseReservationResponse.error:type_name -> common.v1.Error
+	64,  // 82: command.v1.ReleaseReservationResponse.timestamp:type_name -> google.protobuf.Timestamp
+	35,  // 83: command.v1.CommitReservationResponse.reservation:type_name -> command.v1.Reservation
+	70,  // 84: command.v1.CommitReservationResponse.stock:type_name -> common.v1.Stock
+	63,  // 85: command.v1.CommitReservationResponse.error:type_name -> common.v1.Error
+	64,  // 86: command.v1.CommitReservationResponse.timestamp:type_name -> google.protobuf.Timestamp
+	71,  // 87: command.v1.AttachTagsResponse.tags:type_name -> common.v1.Tag
+	63,  // 88: command.v1.AttachTagsResponse.error:type_name -> common.v1.Error
+	64,  // 89: command.v1.AttachTagsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	71,  // 90: command.v1.DetachTagsResponse.tags:type_name -> common.v1.Tag
+	63,  // 91: command.v1.DetachTagsResponse.error:type_name -> common.v1.Error
+	64,  // 92: command.v1.DetachTagsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	66,  // 93: command.v1.SchedulePriceRequest.product_id:type_name -> common.v1.ProductId
+	72,  // 94: command.v1.SchedulePriceRequest.price:type_name -> common.v1.ProductPrice
+	64,  // 95: command.v1.SchedulePriceRequest.starts_at:type_name -> google.protobuf.Timestamp
+	64,  // 96: command.v1.SchedulePriceRequest.ends_at:type_name -> google.protobuf.Timestamp
+	73,  // 97: command.v1.SchedulePriceResponse.price_schedule:type_name -> common.v1.PriceSchedule
+	63,  // 98: command.v1.SchedulePriceResponse.error:type_name -> common.v1.Error
+	64,  // 99: command.v1.SchedulePriceResponse.timestamp:type_name -> google.protobuf.Timestamp
+	66,  // 100: command.v1.CancelPriceScheduleRequest.product_id:type_name -> common.v1.ProductId
+	73,  // 101: command.v1.CancelPriceScheduleResponse.price_schedule:type_name -> common.v1.PriceSchedule
+	63,  // 102: command.v1.CancelPriceScheduleResponse.error:type_name -> common.v1.Error
+	64,  // 103: command.v1.CancelPriceScheduleResponse.timestamp:type_name -> google.protobuf.Timestamp
+	61,  // 104: command.v1.UpdateCategoryRequest.Category.id:type_name -> common.v1.CategoryId
+	60,  // 105: command.v1.UpdateCategoryRequest.Category.name:type_name -> common.v1.CategoryName
+	54,  // 106: command.v1.UpdateCategoryRequest.Category.translations:type_name -> command.v1.UpdateCategoryRequest.Category.TranslationsEntry
+	74,  // 107: command.v1.CreateProductRequest.Product.name:type_name -> common.v1.ProductName
+	72,  // 108: command.v1.CreateProductRequest.Product.price:type_name -> common.v1.ProductPrice
+	57,  // 109: command.v1.CreateProductRequest.Product.category:type_name -> command.v1.CreateProductRequest.Product.Category
+	75,  // 110: command.v1.CreateProductRequest.Product.tax_class:type_name -> common.v1.TaxClass
+	56,  // 111: command.v1.CreateProductRequest.Product.translations:type_name -> command.v1.CreateProductRequest.Product.TranslationsEntry
+	61,  // 112: command.v1.CreateProductRequest.Product.Category.id:type_name -> common.v1.CategoryId
+	60,  // 113: command.v1.CreateProductRequest.Product.Category.name:type_name -> common.v1.CategoryName
+	66,  // 114: command.v1.UpdateProductRequest.Product.id:type_name -> common.v1.ProductId
+	74,  // 115: command.v1.UpdateProductRequest.Product.name:type_name -> common.v1.ProductName
+	72,  // 116: command.v1.UpdateProductRequest.Product.price:type_name -> common.v1.ProductPrice
+	61,  // 117: command.v1.UpdateProductRequest.Product.category_id:type_name -> common.v1.CategoryId
+	75,  // 118: command.v1.UpdateProductRequest.Product.tax_class:type_name -> common.v1.TaxClass
+	59,  // 119: command.v1.UpdateProductRequest.Product.translations:type_name -> command.v1.UpdateProductRequest.Product.TranslationsEntry
+	4,   // 120: command.v1.CategoryService.CreateCategory:input_type -> command.v1.CreateCategoryRequest
+	6,   // 121: command.v1.CategoryService.UpdateCategory:input_type -> command.v1.UpdateCategoryRequest
+	8,   // 122: command.v1.CategoryService.DeleteCategory:input_type -> command.v1.DeleteCategoryRequest
+	10,  // 123: command.v1.CategoryService.MoveCategory:input_type -> command.v1.MoveCategoryRequest
+	12,  // 124: command.v1.ProductService.CreateProduct:input_type -> command.v1.CreateProductRequest
+	14,  // 125: command.v1.ProductService.UpdateProduct:input_type -> command.v1.UpdateProductRequest
+	16,  // 126: command.v1.ProductService.DeleteProduct:input_type -> command.v1.DeleteProductRequest
+	18,  // 127: command.v1.ProductService.PublishProduct:input_type -> command.v1.PublishProductRequest
+	20,  // 128: command.v1.ProductService.SuspendProduct:input_type -> command.v1.SuspendProductRequest
+	22,  // 129: command.v1.ProductService.DiscontinueProduct:input_type -> command.v1.DiscontinueProductRequest
+	25,  // 130: command.v1.ProductService.AddVariant:input_type -> command.v1.AddVariantRequest
+	27,  // 131: command.v1.ProductService.UpdateVariant:input_type -> command.v1.UpdateVariantRequest
+	29,  // 132: command.v1.ProductService.RemoveVariant:input_type -> command.v1.RemoveVariantRequest
+	32,  // 133: command.v1.ProductService.AdjustPrices:input_type -> command.v1.AdjustPricesRequest
+	36,  // 134: command.v1.StockService.AdjustStock:input_type -> command.v1.AdjustStockRequest
+	38,  // 135: command.v1.StockService.ReserveStock:input_type -> command.v1.ReserveStockRequest
+	40,  // 136: command.v1.StockService.ReleaseReservation:input_type -> command.v1.ReleaseReservationRequest
+	42,  // 137: command.v1.StockService.CommitReservation:input_type -> command.v1.CommitReservationRequest
+	44,  // 138: command.v1.TagService.AttachTags:input_type -> command.v1.AttachTagsRequest
+	46,  // 139: command.v1.TagService.DetachTags:input_type -> command.v1.DetachTagsRequest
+	48,  // 140: command.v1.PriceScheduleService.SchedulePrice:input_type -> command.v1.SchedulePriceRequest
+	50,  // 141: command.v1.PriceScheduleService.CancelPriceSchedule:input_type -> command.v1.CancelPriceScheduleRequest
+	5,   // 142: command.v1.CategoryService.CreateCategory:output_type -> command.v1.CreateCategoryResponse
+	7,   // 143: command.v1.CategoryService.UpdateCategory:output_type -> command.v1.UpdateCategoryResponse
+	9,   // 144: command.v1.CategoryService.DeleteCategory:output_type -> command.v1.DeleteCategoryResponse
+	11,  // 145: command.v1.CategoryService.MoveCategory:output_type -> command.v1.MoveCategoryResponse
+	13,  // 146: command.v1.ProductService.CreateProduct:output_type -> command.v1.CreateProductResponse
+	15,  // 147: command.v1.ProductService.UpdateProduct:output_type -> command.v1.UpdateProductResponse
+	17,  // 148: command.v1.ProductService.DeleteProduct:output_type -> command.v1.DeleteProductResponse
+	19,  // 149: command.v1.ProductService.PublishProduct:output_type -> command.v1.PublishProductResponse
+	21,  // 150: command.v1.ProductService.SuspendProduct:output_type -> command.v1.SuspendProductResponse
+	23,  // 151: command.v1.ProductService.DiscontinueProduct:output_type -> command.v1.DiscontinueProductResponse
+	26,  // 152: command.v1.ProductService.AddVariant:output_type -> command.v1.AddVariantResponse
+	28,  // 153: command.v1.ProductService.UpdateVariant:output_type -> command.v1.UpdateVariantResponse
+	30,  // 154: command.v1.ProductService.RemoveVariant:output_type -> command.v1.RemoveVariantResponse
+	34,  // 155: command.v1.ProductService.AdjustPrices:output_type -> command.v1.AdjustPricesResponse
+	37,  // 156: command.v1.StockService.AdjustStock:output_type -> command.v1.AdjustStockResponse
+	39,  // 157: command.v1.StockService.ReserveStock:output_type -> command.v1.ReserveStockResponse
+	41,  // 158: command.v1.StockService.ReleaseReservation:output_type -> command.v1.ReleaseReservationResponse
+	43,  // 159: command.v1.StockService.CommitReservation:output_type -> command.v1.CommitReservationResponse
+	45,  // 160: command.v1.TagService.AttachTags:output_type -> command.v1.AttachTagsResponse
+	47,  // 161: command.v1.TagService.DetachTags:output_type -> command.v1.DetachTagsResponse
+	49,  // 162: command.v1.PriceScheduleService.SchedulePrice:output_type -> command.v1.SchedulePriceResponse
+	51,  // 163: command.v1.PriceScheduleService.CancelPriceSchedule:output_type -> command.v1.CancelPriceScheduleResponse
+	142, // [142:164] is the sub-list for method output_type
+	120, // [120:142] is the sub-list for method input_type
+	120, // [120:120] is the sub-list for extension type_name
+	120, // [120:120] is the sub-list for extension extendee
+	0,   // [0:120] is the sub-list for field type_name
 }
 
 func init() { file_command_v1_command_proto_init() }
@@ -1889,15 +6625,21 @@ func file_command_v1_command_proto_init() {
 	if File_command_v1_command_proto != nil {
 		return
 	}
+	file_command_v1_command_proto_msgTypes[0].OneofWrappers = []any{}
+	file_command_v1_command_proto_msgTypes[20].OneofWrappers = []any{}
+	file_command_v1_command_proto_msgTypes[27].OneofWrappers = []any{}
+	file_command_v1_command_proto_msgTypes[49].OneofWrappers = []any{}
+	file_command_v1_command_proto_msgTypes[51].OneofWrappers = []any{}
+	file_command_v1_command_proto_msgTypes[54].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_command_v1_command_proto_rawDesc), len(file_command_v1_command_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   16,
+			NumEnums:      4,
+			NumMessages:   56,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   5,
 		},
 		GoTypes:           file_command_v1_command_proto_goTypes,
 		DependencyIndexes: file_command_v1_command_proto_depIdxs,
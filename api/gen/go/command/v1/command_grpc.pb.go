@@ -28,6 +28,7 @@ const (
 	CategoryService_CreateCategory_FullMethodName = "/command.v1.CategoryService/CreateCategory"
 	CategoryService_UpdateCategory_FullMethodName = "/command.v1.CategoryService/UpdateCategory"
 	CategoryService_DeleteCategory_FullMethodName = "/command.v1.CategoryService/DeleteCategory"
+	CategoryService_MoveCategory_FullMethodName   = "/command.v1.CategoryService/MoveCategory"
 )
 
 // CategoryServiceClient is the client API for CategoryService service.
@@ -43,6 +44,8 @@ type CategoryServiceClient interface {
 	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
 	// 商品カテゴリを削除する
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	// 商品カテゴリを別の親カテゴリの下に移動する（自身または子孫の下には移動できない）
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryResponse, error)
 }
 
 type categoryServiceClient struct {
@@ -83,6 +86,16 @@ func (c *categoryServiceClient) DeleteCategory(ctx context.Context, in *DeleteCa
 	return out, nil
 }
 
+func (c *categoryServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCategoryResponse)
+	err := c.cc.Invoke(ctx, CategoryService_MoveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CategoryServiceServer is the server API for CategoryService service.
 // All implementations must embed UnimplementedCategoryServiceServer
 // for forward compatibility.
@@ -96,6 +109,8 @@ type CategoryServiceServer interface {
 	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
 	// 商品カテゴリを削除する
 	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	// 商品カテゴリを別の親カテゴリの下に移動する（自身または子孫の下には移動できない）
+	MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryResponse, error)
 	mustEmbedUnimplementedCategoryServiceServer()
 }
 
@@ -115,6 +130,9 @@ func (UnimplementedCategoryServiceServer) UpdateCategory(context.Context, *Updat
 func (UnimplementedCategoryServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
 }
+func (UnimplementedCategoryServiceServer) MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
 func (UnimplementedCategoryServiceServer) mustEmbedUnimplementedCategoryServiceServer() {}
 func (UnimplementedCategoryServiceServer) testEmbeddedByValue()                         {}
 
@@ -190,6 +208,24 @@ func _CategoryService_DeleteCategory_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CategoryService_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_MoveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).MoveCategory(ctx, req.(*MoveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CategoryService_ServiceDesc is the grpc.ServiceDesc for CategoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -209,15 +245,26 @@ var CategoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCategory",
 			Handler:    _CategoryService_DeleteCategory_Handler,
 		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _CategoryService_MoveCategory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "command/v1/command.proto",
 }
 
 const (
-	ProductService_CreateProduct_FullMethodName = "/command.v1.ProductService/CreateProduct"
-	ProductService_UpdateProduct_FullMethodName = "/command.v1.ProductService/UpdateProduct"
-	ProductService_DeleteProduct_FullMethodName = "/command.v1.ProductService/DeleteProduct"
+	ProductService_CreateProduct_FullMethodName      = "/command.v1.ProductService/CreateProduct"
+	ProductService_UpdateProduct_FullMethodName      = "/command.v1.ProductService/UpdateProduct"
+	ProductService_DeleteProduct_FullMethodName      = "/command.v1.ProductService/DeleteProduct"
+	ProductService_PublishProduct_FullMethodName     = "/command.v1.ProductService/PublishProduct"
+	ProductService_SuspendProduct_FullMethodName     = "/command.v1.ProductService/SuspendProduct"
+	ProductService_DiscontinueProduct_FullMethodName = "/command.v1.ProductService/DiscontinueProduct"
+	ProductService_AddVariant_FullMethodName         = "/command.v1.ProductService/AddVariant"
+	ProductService_UpdateVariant_FullMethodName      = "/command.v1.ProductService/UpdateVariant"
+	ProductService_RemoveVariant_FullMethodName      = "/command.v1.ProductService/RemoveVariant"
+	ProductService_AdjustPrices_FullMethodName       = "/command.v1.ProductService/AdjustPrices"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -234,6 +281,20 @@ type ProductServiceClient interface {
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
 	// 商品を削除する
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	// 下書きまたは一時停止中の商品を公開する。許可されていない状態遷移の場合はFAILED_PRECONDITIONを返す
+	PublishProduct(ctx context.Context, in *PublishProductRequest, opts ...grpc.CallOption) (*PublishProductResponse, error)
+	// 公開中の商品の販売を一時停止する。許可されていない状態遷移の場合はFAILED_PRECONDITIONを返す
+	SuspendProduct(ctx context.Context, in *SuspendProductRequest, opts ...grpc.CallOption) (*SuspendProductResponse, error)
+	// 商品の販売を終了する。販売終了した商品は再び公開できない
+	DiscontinueProduct(ctx context.Context, in *DiscontinueProductRequest, opts ...grpc.CallOption) (*DiscontinueProductResponse, error)
+	// 商品にバリエーションを追加する。SKUまたは選択肢の組み合わせが重複する場合はALREADY_EXISTSを返す
+	AddVariant(ctx context.Context, in *AddVariantRequest, opts ...grpc.CallOption) (*AddVariantResponse, error)
+	// 商品のバリエーションを置き換える
+	UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*UpdateVariantResponse, error)
+	// 商品のバリエーションを削除する
+	RemoveVariant(ctx context.Context, in *RemoveVariantRequest, opts ...grpc.CallOption) (*RemoveVariantResponse, error)
+	// カテゴリ（子孫カテゴリ・タグで絞り込み可）の商品の単価を1つのトランザクションで一括変更する。previewの場合は変更せずに変更前後の単価を返す
+	AdjustPrices(ctx context.Context, in *AdjustPricesRequest, opts ...grpc.CallOption) (*AdjustPricesResponse, error)
 }
 
 type productServiceClient struct {
@@ -274,6 +335,76 @@ func (c *productServiceClient) DeleteProduct(ctx context.Context, in *DeleteProd
 	return out, nil
 }
 
+func (c *productServiceClient) PublishProduct(ctx context.Context, in *PublishProductRequest, opts ...grpc.CallOption) (*PublishProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_PublishProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SuspendProduct(ctx context.Context, in *SuspendProductRequest, opts ...grpc.CallOption) (*SuspendProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_SuspendProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DiscontinueProduct(ctx context.Context, in *DiscontinueProductRequest, opts ...grpc.CallOption) (*DiscontinueProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscontinueProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_DiscontinueProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AddVariant(ctx context.Context, in *AddVariantRequest, opts ...grpc.CallOption) (*AddVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_AddVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*UpdateVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RemoveVariant(ctx context.Context, in *RemoveVariantRequest, opts ...grpc.CallOption) (*RemoveVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_RemoveVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AdjustPrices(ctx context.Context, in *AdjustPricesRequest, opts ...grpc.CallOption) (*AdjustPricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustPricesResponse)
+	err := c.cc.Invoke(ctx, ProductService_AdjustPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -288,6 +419,20 @@ type ProductServiceServer interface {
 	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
 	// 商品を削除する
 	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	// 下書きまたは一時停止中の商品を公開する。許可されていない状態遷移の場合はFAILED_PRECONDITIONを返す
+	PublishProduct(context.Context, *PublishProductRequest) (*PublishProductResponse, error)
+	// 公開中の商品の販売を一時停止する。許可されていない状態遷移の場合はFAILED_PRECONDITIONを返す
+	SuspendProduct(context.Context, *SuspendProductRequest) (*SuspendProductResponse, error)
+	// 商品の販売を終了する。販売終了した商品は再び公開できない
+	DiscontinueProduct(context.Context, *DiscontinueProductRequest) (*DiscontinueProductResponse, error)
+	// 商品にバリエーションを追加する。SKUまたは選択肢の組み合わせが重複する場合はALREADY_EXISTSを返す
+	AddVariant(context.Context, *AddVariantRequest) (*AddVariantResponse, error)
+	// 商品のバリエーションを置き換える
+	UpdateVariant(context.Context, *UpdateVariantRequest) (*UpdateVariantResponse, error)
+	// 商品のバリエーションを削除する
+	RemoveVariant(context.Context, *RemoveVariantRequest) (*RemoveVariantResponse, error)
+	// カテゴリ（子孫カテゴリ・タグで絞り込み可）の商品の単価を1つのトランザクションで一括変更する。previewの場合は変更せずに変更前後の単価を返す
+	AdjustPrices(context.Context, *AdjustPricesRequest) (*AdjustPricesResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -307,6 +452,27 @@ func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateP
 func (UnimplementedProductServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
 }
+func (UnimplementedProductServiceServer) PublishProduct(context.Context, *PublishProductRequest) (*PublishProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishProduct not implemented")
+}
+func (UnimplementedProductServiceServer) SuspendProduct(context.Context, *SuspendProductRequest) (*SuspendProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendProduct not implemented")
+}
+func (UnimplementedProductServiceServer) DiscontinueProduct(context.Context, *DiscontinueProductRequest) (*DiscontinueProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscontinueProduct not implemented")
+}
+func (UnimplementedProductServiceServer) AddVariant(context.Context, *AddVariantRequest) (*AddVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVariant not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateVariant(context.Context, *UpdateVariantRequest) (*UpdateVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVariant not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveVariant(context.Context, *RemoveVariantRequest) (*RemoveVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVariant not implemented")
+}
+func (UnimplementedProductServiceServer) AdjustPrices(context.Context, *AdjustPricesRequest) (*AdjustPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustPrices not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -382,6 +548,132 @@ func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_PublishProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PublishProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PublishProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PublishProduct(ctx, req.(*PublishProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SuspendProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SuspendProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SuspendProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SuspendProduct(ctx, req.(*SuspendProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DiscontinueProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscontinueProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DiscontinueProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DiscontinueProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DiscontinueProduct(ctx, req.(*DiscontinueProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AddVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AddVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AddVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AddVariant(ctx, req.(*AddVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateVariant(ctx, req.(*UpdateVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RemoveVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RemoveVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RemoveVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RemoveVariant(ctx, req.(*RemoveVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AdjustPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AdjustPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AdjustPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AdjustPrices(ctx, req.(*AdjustPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -401,6 +693,570 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProduct",
 			Handler:    _ProductService_DeleteProduct_Handler,
 		},
+		{
+			MethodName: "PublishProduct",
+			Handler:    _ProductService_PublishProduct_Handler,
+		},
+		{
+			MethodName: "SuspendProduct",
+			Handler:    _ProductService_SuspendProduct_Handler,
+		},
+		{
+			MethodName: "DiscontinueProduct",
+			Handler:    _ProductService_DiscontinueProduct_Handler,
+		},
+		{
+			MethodName: "AddVariant",
+			Handler:    _ProductService_AddVariant_Handler,
+		},
+		{
+			MethodName: "UpdateVariant",
+			Handler:    _ProductService_UpdateVariant_Handler,
+		},
+		{
+			MethodName: "RemoveVariant",
+			Handler:    _ProductService_RemoveVariant_Handler,
+		},
+		{
+			MethodName: "AdjustPrices",
+			Handler:    _ProductService_AdjustPrices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "command/v1/command.proto",
+}
+
+const (
+	StockService_AdjustStock_FullMethodName        = "/command.v1.StockService/AdjustStock"
+	StockService_ReserveStock_FullMethodName       = "/command.v1.StockService/ReserveStock"
+	StockService_ReleaseReservation_FullMethodName = "/command.v1.StockService/ReleaseReservation"
+	StockService_CommitReservation_FullMethodName  = "/command.v1.StockService/CommitReservation"
+)
+
+// StockServiceClient is the client API for StockService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+//	在庫コマンドサービス型（書き込み専用）
+//
+// 在庫数の増減と在庫引当の操作を提供するサービス
+type StockServiceClient interface {
+	// 入荷や棚卸によって在庫数を増減する
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error)
+	// 在庫を引き当てる。引当可能な数量が不足する場合はFAILED_PRECONDITIONを返す
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error)
+	// 引当をキャンセルし、在庫を引当可能に戻す
+	ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error)
+	// 引当を確定し、在庫数から差し引く
+	CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*CommitReservationResponse, error)
+}
+
+type stockServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStockServiceClient(cc grpc.ClientConnInterface) StockServiceClient {
+	return &stockServiceClient{cc}
+}
+
+func (c *stockServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustStockResponse)
+	err := c.cc.Invoke(ctx, StockService_AdjustStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveStockResponse)
+	err := c.cc.Invoke(ctx, StockService_ReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseReservationResponse)
+	err := c.cc.Invoke(ctx, StockService_ReleaseReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*CommitReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitReservationResponse)
+	err := c.cc.Invoke(ctx, StockService_CommitReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StockServiceServer is the server API for StockService service.
+// All implementations must embed UnimplementedStockServiceServer
+// for forward compatibility.
+//
+//	在庫コマンドサービス型（書き込み専用）
+//
+// 在庫数の増減と在庫引当の操作を提供するサービス
+type StockServiceServer interface {
+	// 入荷や棚卸によって在庫数を増減する
+	AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error)
+	// 在庫を引き当てる。引当可能な数量が不足する場合はFAILED_PRECONDITIONを返す
+	ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error)
+	// 引当をキャンセルし、在庫を引当可能に戻す
+	ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error)
+	// 引当を確定し、在庫数から差し引く
+	CommitReservation(context.Context, *CommitReservationRequest) (*CommitReservationResponse, error)
+	mustEmbedUnimplementedStockServiceServer()
+}
+
+// UnimplementedStockServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStockServiceServer struct{}
+
+func (UnimplementedStockServiceServer) AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedStockServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedStockServiceServer) ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
+func (UnimplementedStockServiceServer) CommitReservation(context.Context, *CommitReservationRequest) (*CommitReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservation not implemented")
+}
+func (UnimplementedStockServiceServer) mustEmbedUnimplementedStockServiceServer() {}
+func (UnimplementedStockServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeStockServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StockServiceServer will
+// result in compilation errors.
+type UnsafeStockServiceServer interface {
+	mustEmbedUnimplementedStockServiceServer()
+}
+
+func RegisterStockServiceServer(s grpc.ServiceRegistrar, srv StockServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStockServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StockService_ServiceDesc, srv)
+}
+
+func _StockService_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_AdjustStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_ReleaseReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).ReleaseReservation(ctx, req.(*ReleaseReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_CommitReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).CommitReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_CommitReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).CommitReservation(ctx, req.(*CommitReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StockService_ServiceDesc is the grpc.ServiceDesc for StockService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StockService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "command.v1.StockService",
+	HandlerType: (*StockServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AdjustStock",
+			Handler:    _StockService_AdjustStock_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _StockService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _StockService_ReleaseReservation_Handler,
+		},
+		{
+			MethodName: "CommitReservation",
+			Handler:    _StockService_CommitReservation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "command/v1/command.proto",
+}
+
+const (
+	TagService_AttachTags_FullMethodName = "/command.v1.TagService/AttachTags"
+	TagService_DetachTags_FullMethodName = "/command.v1.TagService/DetachTags"
+)
+
+// TagServiceClient is the client API for TagService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+//	タグコマンドサービス型（書き込み専用）
+//
+// 複数の商品へのタグの一括付与・削除を提供するサービス
+type TagServiceClient interface {
+	// 指定したすべての商品に指定したすべてのタグを付与する。存在しない商品が含まれる場合はNOT_FOUNDを返す
+	AttachTags(ctx context.Context, in *AttachTagsRequest, opts ...grpc.CallOption) (*AttachTagsResponse, error)
+	// 指定したすべての商品から指定したすべてのタグを外す
+	DetachTags(ctx context.Context, in *DetachTagsRequest, opts ...grpc.CallOption) (*DetachTagsResponse, error)
+}
+
+type tagServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTagServiceClient(cc grpc.ClientConnInterface) TagServiceClient {
+	return &tagServiceClient{cc}
+}
+
+func (c *tagServiceClient) AttachTags(ctx context.Context, in *AttachTagsRequest, opts ...grpc.CallOption) (*AttachTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_AttachTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) DetachTags(ctx context.Context, in *DetachTagsRequest, opts ...grpc.CallOption) (*DetachTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetachTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_DetachTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TagServiceServer is the server API for TagService service.
+// All implementations must embed UnimplementedTagServiceServer
+// for forward compatibility.
+//
+//	タグコマンドサービス型（書き込み専用）
+//
+// 複数の商品へのタグの一括付与・削除を提供するサービス
+type TagServiceServer interface {
+	// 指定したすべての商品に指定したすべてのタグを付与する。存在しない商品が含まれる場合はNOT_FOUNDを返す
+	AttachTags(context.Context, *AttachTagsRequest) (*AttachTagsResponse, error)
+	// 指定したすべての商品から指定したすべてのタグを外す
+	DetachTags(context.Context, *DetachTagsRequest) (*DetachTagsResponse, error)
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+// UnimplementedTagServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTagServiceServer struct{}
+
+func (UnimplementedTagServiceServer) AttachTags(context.Context, *AttachTagsRequest) (*AttachTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachTags not implemented")
+}
+func (UnimplementedTagServiceServer) DetachTags(context.Context, *DetachTagsRequest) (*DetachTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachTags not implemented")
+}
+func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
+func (UnimplementedTagServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeTagServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TagServiceServer will
+// result in compilation errors.
+type UnsafeTagServiceServer interface {
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+func RegisterTagServiceServer(s grpc.ServiceRegistrar, srv TagServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTagServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TagService_ServiceDesc, srv)
+}
+
+func _TagService_AttachTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).AttachTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_AttachTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).AttachTags(ctx, req.(*AttachTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_DetachTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).DetachTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_DetachTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).DetachTags(ctx, req.(*DetachTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TagService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "command.v1.TagService",
+	HandlerType: (*TagServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AttachTags",
+			Handler:    _TagService_AttachTags_Handler,
+		},
+		{
+			MethodName: "DetachTags",
+			Handler:    _TagService_DetachTags_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "command/v1/command.proto",
+}
+
+const (
+	PriceScheduleService_SchedulePrice_FullMethodName       = "/command.v1.PriceScheduleService/SchedulePrice"
+	PriceScheduleService_CancelPriceSchedule_FullMethodName = "/command.v1.PriceScheduleService/CancelPriceSchedule"
+)
+
+// PriceScheduleServiceClient is the client API for PriceScheduleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+//	価格スケジュールコマンドサービス型（書き込み専用）
+//
+// 期間限定の販売価格の登録・取消を提供するサービス。開始・終了時刻になるとコマンドサービスが商品の単価を変更する
+type PriceScheduleServiceClient interface {
+	// 商品に価格スケジュールを登録する。同じ商品の予定または適用中の価格スケジュールと期間が重なる場合はFAILED_PRECONDITIONを返す
+	SchedulePrice(ctx context.Context, in *SchedulePriceRequest, opts ...grpc.CallOption) (*SchedulePriceResponse, error)
+	// 価格スケジュールを取り消す。適用中の場合は単価を適用前に戻す。終了済みまたは取消済みの場合はFAILED_PRECONDITIONを返す
+	CancelPriceSchedule(ctx context.Context, in *CancelPriceScheduleRequest, opts ...grpc.CallOption) (*CancelPriceScheduleResponse, error)
+}
+
+type priceScheduleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPriceScheduleServiceClient(cc grpc.ClientConnInterface) PriceScheduleServiceClient {
+	return &priceScheduleServiceClient{cc}
+}
+
+func (c *priceScheduleServiceClient) SchedulePrice(ctx context.Context, in *SchedulePriceRequest, opts ...grpc.CallOption) (*SchedulePriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePriceResponse)
+	err := c.cc.Invoke(ctx, PriceScheduleService_SchedulePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceScheduleServiceClient) CancelPriceSchedule(ctx context.Context, in *CancelPriceScheduleRequest, opts ...grpc.CallOption) (*CancelPriceScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPriceScheduleResponse)
+	err := c.cc.Invoke(ctx, PriceScheduleService_CancelPriceSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PriceScheduleServiceServer is the server API for PriceScheduleService service.
+// All implementations must embed UnimplementedPriceScheduleServiceServer
+// for forward compatibility.
+//
+//	価格スケジュールコマンドサービス型（書き込み専用）
+//
+// 期間限定の販売価格の登録・取消を提供するサービス。開始・終了時刻になるとコマンドサービスが商品の単価を変更する
+type PriceScheduleServiceServer interface {
+	// 商品に価格スケジュールを登録する。同じ商品の予定または適用中の価格スケジュールと期間が重なる場合はFAILED_PRECONDITIONを返す
+	SchedulePrice(context.Context, *SchedulePriceRequest) (*SchedulePriceResponse, error)
+	// 価格スケジュールを取り消す。適用中の場合は単価を適用前に戻す。終了済みまたは取消済みの場合はFAILED_PRECONDITIONを返す
+	CancelPriceSchedule(context.Context, *CancelPriceScheduleRequest) (*CancelPriceScheduleResponse, error)
+	mustEmbedUnimplementedPriceScheduleServiceServer()
+}
+
+// UnimplementedPriceScheduleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPriceScheduleServiceServer struct{}
+
+func (UnimplementedPriceScheduleServiceServer) SchedulePrice(context.Context, *SchedulePriceRequest) (*SchedulePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePrice not implemented")
+}
+func (UnimplementedPriceScheduleServiceServer) CancelPriceSchedule(context.Context, *CancelPriceScheduleRequest) (*CancelPriceScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPriceSchedule not implemented")
+}
+func (UnimplementedPriceScheduleServiceServer) mustEmbedUnimplementedPriceScheduleServiceServer() {}
+func (UnimplementedPriceScheduleServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafePriceScheduleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PriceScheduleServiceServer will
+// result in compilation errors.
+type UnsafePriceScheduleServiceServer interface {
+	mustEmbedUnimplementedPriceScheduleServiceServer()
+}
+
+func RegisterPriceScheduleServiceServer(s grpc.ServiceRegistrar, srv PriceScheduleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPriceScheduleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PriceScheduleService_ServiceDesc, srv)
+}
+
+func _PriceScheduleService_SchedulePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceScheduleServiceServer).SchedulePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceScheduleService_SchedulePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceScheduleServiceServer).SchedulePrice(ctx, req.(*SchedulePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceScheduleService_CancelPriceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPriceScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceScheduleServiceServer).CancelPriceSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceScheduleService_CancelPriceSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceScheduleServiceServer).CancelPriceSchedule(ctx, req.(*CancelPriceScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PriceScheduleService_ServiceDesc is the grpc.ServiceDesc for PriceScheduleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PriceScheduleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "command.v1.PriceScheduleService",
+	HandlerType: (*PriceScheduleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SchedulePrice",
+			Handler:    _PriceScheduleService_SchedulePrice_Handler,
+		},
+		{
+			MethodName: "CancelPriceSchedule",
+			Handler:    _PriceScheduleService_CancelPriceSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "command/v1/command.proto",
@@ -31,6 +31,12 @@ const (
 	CategoryServiceName = "command.v1.CategoryService"
 	// ProductServiceName is the fully-qualified name of the ProductService service.
 	ProductServiceName = "command.v1.ProductService"
+	// StockServiceName is the fully-qualified name of the StockService service.
+	StockServiceName = "command.v1.StockService"
+	// TagServiceName is the fully-qualified name of the TagService service.
+	TagServiceName = "command.v1.TagService"
+	// PriceScheduleServiceName is the fully-qualified name of the PriceScheduleService service.
+	PriceScheduleServiceName = "command.v1.PriceScheduleService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -50,6 +56,9 @@ const (
 	// CategoryServiceDeleteCategoryProcedure is the fully-qualified name of the CategoryService's
 	// DeleteCategory RPC.
 	CategoryServiceDeleteCategoryProcedure = "/command.v1.CategoryService/DeleteCategory"
+	// CategoryServiceMoveCategoryProcedure is the fully-qualified name of the CategoryService's
+	// MoveCategory RPC.
+	CategoryServiceMoveCategoryProcedure = "/command.v1.CategoryService/MoveCategory"
 	// ProductServiceCreateProductProcedure is the fully-qualified name of the ProductService's
 	// CreateProduct RPC.
 	ProductServiceCreateProductProcedure = "/command.v1.ProductService/CreateProduct"
@@ -59,6 +68,49 @@ const (
 	// ProductServiceDeleteProductProcedure is the fully-qualified name of the ProductService's
 	// DeleteProduct RPC.
 	ProductServiceDeleteProductProcedure = "/command.v1.ProductService/DeleteProduct"
+	// ProductServicePublishProductProcedure is the fully-qualified name of the ProductService's
+	// PublishProduct RPC.
+	ProductServicePublishProductProcedure = "/command.v1.ProductService/PublishProduct"
+	// ProductServiceSuspendProductProcedure is the fully-qualified name of the ProductService's
+	// SuspendProduct RPC.
+	ProductServiceSuspendProductProcedure = "/command.v1.ProductService/SuspendProduct"
+	// ProductServiceDiscontinueProductProcedure is the fully-qualified name of the ProductService's
+	// DiscontinueProduct RPC.
+	ProductServiceDiscontinueProductProcedure = "/command.v1.ProductService/DiscontinueProduct"
+	// ProductServiceAddVariantProcedure is the fully-qualified name of the ProductService's AddVariant
+	// RPC.
+	ProductServiceAddVariantProcedure = "/command.v1.ProductService/AddVariant"
+	// ProductServiceUpdateVariantProcedure is the fully-qualified name of the ProductService's
+	// UpdateVariant RPC.
+	ProductServiceUpdateVariantProcedure = "/command.v1.ProductService/UpdateVariant"
+	// ProductServiceRemoveVariantProcedure is the fully-qualified name of the ProductService's
+	// RemoveVariant RPC.
+	ProductServiceRemoveVariantProcedure = "/command.v1.ProductService/RemoveVariant"
+	// ProductServiceAdjustPricesProcedure is the fully-qualified name of the ProductService's
+	// AdjustPrices RPC.
+	ProductServiceAdjustPricesProcedure = "/command.v1.ProductService/AdjustPrices"
+	// StockServiceAdjustStockProcedure is the fully-qualified name of the StockService's AdjustStock
+	// RPC.
+	StockServiceAdjustStockProcedure = "/command.v1.StockService/AdjustStock"
+	// StockServiceReserveStockProcedure is the fully-qualified name of the StockService's ReserveStock
+	// RPC.
+	StockServiceReserveStockProcedure = "/command.v1.StockService/ReserveStock"
+	// StockServiceReleaseReservationProcedure is the fully-qualified name of the StockService's
+	// ReleaseReservation RPC.
+	StockServiceReleaseReservationProcedure = "/command.v1.StockService/ReleaseReservation"
+	// StockServiceCommitReservationProcedure is the fully-qualified name of the StockService's
+	// CommitReservation RPC.
+	StockServiceCommitReservationProcedure = "/command.v1.StockService/CommitReservation"
+	// TagServiceAttachTagsProcedure is the fully-qualified name of the TagService's AttachTags RPC.
+	TagServiceAttachTagsProcedure = "/command.v1.TagService/AttachTags"
+	// TagServiceDetachTagsProcedure is the fully-qualified name of the TagService's DetachTags RPC.
+	TagServiceDetachTagsProcedure = "/command.v1.TagService/DetachTags"
+	// PriceScheduleServiceSchedulePriceProcedure is the fully-qualified name of the
+	// PriceScheduleService's SchedulePrice RPC.
+	PriceScheduleServiceSchedulePriceProcedure = "/command.v1.PriceScheduleService/SchedulePrice"
+	// PriceScheduleServiceCancelPriceScheduleProcedure is the fully-qualified name of the
+	// PriceScheduleService's CancelPriceSchedule RPC.
+	PriceScheduleServiceCancelPriceScheduleProcedure = "/command.v1.PriceScheduleService/CancelPriceSchedule"
 )
 
 // CategoryServiceClient is a client for the command.v1.CategoryService service.
@@ -69,6 +121,8 @@ type CategoryServiceClient interface {
 	UpdateCategory(context.Context, *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error)
 	// 商品カテゴリを削除する
 	DeleteCategory(context.Context, *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error)
+	// 商品カテゴリを別の親カテゴリの下に移動する（自身または子孫の下には移動できない）
+	MoveCategory(context.Context, *connect.Request[v1.MoveCategoryRequest]) (*connect.Response[v1.MoveCategoryResponse], error)
 }
 
 // NewCategoryServiceClient constructs a client for the command.v1.CategoryService service. By
@@ -100,6 +154,12 @@ func NewCategoryServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(categoryServiceMethods.ByName("DeleteCategory")),
 			connect.WithClientOptions(opts...),
 		),
+		moveCategory: connect.NewClient[v1.MoveCategoryRequest, v1.MoveCategoryResponse](
+			httpClient,
+			baseURL+CategoryServiceMoveCategoryProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("MoveCategory")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -108,6 +168,7 @@ type categoryServiceClient struct {
 	createCategory *connect.Client[v1.CreateCategoryRequest, v1.CreateCategoryResponse]
 	updateCategory *connect.Client[v1.UpdateCategoryRequest, v1.UpdateCategoryResponse]
 	deleteCategory *connect.Client[v1.DeleteCategoryRequest, v1.DeleteCategoryResponse]
+	moveCategory   *connect.Client[v1.MoveCategoryRequest, v1.MoveCategoryResponse]
 }
 
 // CreateCategory calls command.v1.CategoryService.CreateCategory.
@@ -125,6 +186,11 @@ func (c *categoryServiceClient) DeleteCategory(ctx context.Context, req *connect
 	return c.deleteCategory.CallUnary(ctx, req)
 }
 
+// MoveCategory calls command.v1.CategoryService.MoveCategory.
+func (c *categoryServiceClient) MoveCategory(ctx context.Context, req *connect.Request[v1.MoveCategoryRequest]) (*connect.Response[v1.MoveCategoryResponse], error) {
+	return c.moveCategory.CallUnary(ctx, req)
+}
+
 // CategoryServiceHandler is an implementation of the command.v1.CategoryService service.
 type CategoryServiceHandler interface {
 	// 新しい商品カテゴリを作成する
@@ -133,6 +199,8 @@ type CategoryServiceHandler interface {
 	UpdateCategory(context.Context, *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error)
 	// 商品カテゴリを削除する
 	DeleteCategory(context.Context, *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error)
+	// 商品カテゴリを別の親カテゴリの下に移動する（自身または子孫の下には移動できない）
+	MoveCategory(context.Context, *connect.Request[v1.MoveCategoryRequest]) (*connect.Response[v1.MoveCategoryResponse], error)
 }
 
 // NewCategoryServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -160,6 +228,12 @@ func NewCategoryServiceHandler(svc CategoryServiceHandler, opts ...connect.Handl
 		connect.WithSchema(categoryServiceMethods.ByName("DeleteCategory")),
 		connect.WithHandlerOptions(opts...),
 	)
+	categoryServiceMoveCategoryHandler := connect.NewUnaryHandler(
+		CategoryServiceMoveCategoryProcedure,
+		svc.MoveCategory,
+		connect.WithSchema(categoryServiceMethods.ByName("MoveCategory")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/command.v1.CategoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CategoryServiceCreateCategoryProcedure:
@@ -168,6 +242,8 @@ func NewCategoryServiceHandler(svc CategoryServiceHandler, opts ...connect.Handl
 			categoryServiceUpdateCategoryHandler.ServeHTTP(w, r)
 		case CategoryServiceDeleteCategoryProcedure:
 			categoryServiceDeleteCategoryHandler.ServeHTTP(w, r)
+		case CategoryServiceMoveCategoryProcedure:
+			categoryServiceMoveCategoryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -189,6 +265,10 @@ func (UnimplementedCategoryServiceHandler) DeleteCategory(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.CategoryService.DeleteCategory is not implemented"))
 }
 
+func (UnimplementedCategoryServiceHandler) MoveCategory(context.Context, *connect.Request[v1.MoveCategoryRequest]) (*connect.Response[v1.MoveCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.CategoryService.MoveCategory is not implemented"))
+}
+
 // ProductServiceClient is a client for the command.v1.ProductService service.
 type ProductServiceClient interface {
 	// 新しい商品を作成する
@@ -197,6 +277,20 @@ type ProductServiceClient interface {
 	UpdateProduct(context.Context, *connect.Request[v1.UpdateProductRequest]) (*connect.Response[v1.UpdateProductResponse], error)
 	// 商品を削除する
 	DeleteProduct(context.Context, *connect.Request[v1.DeleteProductRequest]) (*connect.Response[v1.DeleteProductResponse], error)
+	// 下書きまたは一時停止中の商品を公開する。許可されていない状態遷移の場合はFAILED_PRECONDITIONを返す
+	PublishProduct(context.Context, *connect.Request[v1.PublishProductRequest]) (*connect.Response[v1.PublishProductResponse], error)
+	// 公開中の商品の販売を一時停止する。許可されていない状態遷移の場合はFAILED_PRECONDITIONを返す
+	SuspendProduct(context.Context, *connect.Request[v1.SuspendProductRequest]) (*connect.Response[v1.SuspendProductResponse], error)
+	// 商品の販売を終了する。販売終了した商品は再び公開できない
+	DiscontinueProduct(context.Context, *connect.Request[v1.DiscontinueProductRequest]) (*connect.Response[v1.DiscontinueProductResponse], error)
+	// 商品にバリエーションを追加する。SKUまたは選択肢の組み合わせが重複する場合はALREADY_EXISTSを返す
+	AddVariant(context.Context, *connect.Request[v1.AddVariantRequest]) (*connect.Response[v1.AddVariantResponse], error)
+	// 商品のバリエーションを置き換える
+	UpdateVariant(context.Context, *connect.Request[v1.UpdateVariantRequest]) (*connect.Response[v1.UpdateVariantResponse], error)
+	// 商品のバリエーションを削除する
+	RemoveVariant(context.Context, *connect.Request[v1.RemoveVariantRequest]) (*connect.Response[v1.RemoveVariantResponse], error)
+	// カテゴリ（子孫カテゴリ・タグで絞り込み可）の商品の単価を1つのトランザクションで一括変更する。previewの場合は変更せずに変更前後の単価を返す
+	AdjustPrices(context.Context, *connect.Request[v1.AdjustPricesRequest]) (*connect.Response[v1.AdjustPricesResponse], error)
 }
 
 // NewProductServiceClient constructs a client for the command.v1.ProductService service. By
@@ -228,14 +322,63 @@ func NewProductServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(productServiceMethods.ByName("DeleteProduct")),
 			connect.WithClientOptions(opts...),
 		),
+		publishProduct: connect.NewClient[v1.PublishProductRequest, v1.PublishProductResponse](
+			httpClient,
+			baseURL+ProductServicePublishProductProcedure,
+			connect.WithSchema(productServiceMethods.ByName("PublishProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendProduct: connect.NewClient[v1.SuspendProductRequest, v1.SuspendProductResponse](
+			httpClient,
+			baseURL+ProductServiceSuspendProductProcedure,
+			connect.WithSchema(productServiceMethods.ByName("SuspendProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		discontinueProduct: connect.NewClient[v1.DiscontinueProductRequest, v1.DiscontinueProductResponse](
+			httpClient,
+			baseURL+ProductServiceDiscontinueProductProcedure,
+			connect.WithSchema(productServiceMethods.ByName("DiscontinueProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		addVariant: connect.NewClient[v1.AddVariantRequest, v1.AddVariantResponse](
+			httpClient,
+			baseURL+ProductServiceAddVariantProcedure,
+			connect.WithSchema(productServiceMethods.ByName("AddVariant")),
+			connect.WithClientOptions(opts...),
+		),
+		updateVariant: connect.NewClient[v1.UpdateVariantRequest, v1.UpdateVariantResponse](
+			httpClient,
+			baseURL+ProductServiceUpdateVariantProcedure,
+			connect.WithSchema(productServiceMethods.ByName("UpdateVariant")),
+			connect.WithClientOptions(opts...),
+		),
+		removeVariant: connect.NewClient[v1.RemoveVariantRequest, v1.RemoveVariantResponse](
+			httpClient,
+			baseURL+ProductServiceRemoveVariantProcedure,
+			connect.WithSchema(productServiceMethods.ByName("RemoveVariant")),
+			connect.WithClientOptions(opts...),
+		),
+		adjustPrices: connect.NewClient[v1.AdjustPricesRequest, v1.AdjustPricesResponse](
+			httpClient,
+			baseURL+ProductServiceAdjustPricesProcedure,
+			connect.WithSchema(productServiceMethods.ByName("AdjustPrices")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // productServiceClient implements ProductServiceClient.
 type productServiceClient struct {
-	createProduct *connect.Client[v1.CreateProductRequest, v1.CreateProductResponse]
-	updateProduct *connect.Client[v1.UpdateProductRequest, v1.UpdateProductResponse]
-	deleteProduct *connect.Client[v1.DeleteProductRequest, v1.DeleteProductResponse]
+	createProduct      *connect.Client[v1.CreateProductRequest, v1.CreateProductResponse]
+	updateProduct      *connect.Client[v1.UpdateProductRequest, v1.UpdateProductResponse]
+	deleteProduct      *connect.Client[v1.DeleteProductRequest, v1.DeleteProductResponse]
+	publishProduct     *connect.Client[v1.PublishProductRequest, v1.PublishProductResponse]
+	suspendProduct     *connect.Client[v1.SuspendProductRequest, v1.SuspendProductResponse]
+	discontinueProduct *connect.Client[v1.DiscontinueProductRequest, v1.DiscontinueProductResponse]
+	addVariant         *connect.Client[v1.AddVariantRequest, v1.AddVariantResponse]
+	updateVariant      *connect.Client[v1.UpdateVariantRequest, v1.UpdateVariantResponse]
+	removeVariant      *connect.Client[v1.RemoveVariantRequest, v1.RemoveVariantResponse]
+	adjustPrices       *connect.Client[v1.AdjustPricesRequest, v1.AdjustPricesResponse]
 }
 
 // CreateProduct calls command.v1.ProductService.CreateProduct.
@@ -253,6 +396,41 @@ func (c *productServiceClient) DeleteProduct(ctx context.Context, req *connect.R
 	return c.deleteProduct.CallUnary(ctx, req)
 }
 
+// PublishProduct calls command.v1.ProductService.PublishProduct.
+func (c *productServiceClient) PublishProduct(ctx context.Context, req *connect.Request[v1.PublishProductRequest]) (*connect.Response[v1.PublishProductResponse], error) {
+	return c.publishProduct.CallUnary(ctx, req)
+}
+
+// SuspendProduct calls command.v1.ProductService.SuspendProduct.
+func (c *productServiceClient) SuspendProduct(ctx context.Context, req *connect.Request[v1.SuspendProductRequest]) (*connect.Response[v1.SuspendProductResponse], error) {
+	return c.suspendProduct.CallUnary(ctx, req)
+}
+
+// DiscontinueProduct calls command.v1.ProductService.DiscontinueProduct.
+func (c *productServiceClient) DiscontinueProduct(ctx context.Context, req *connect.Request[v1.DiscontinueProductRequest]) (*connect.Response[v1.DiscontinueProductResponse], error) {
+	return c.discontinueProduct.CallUnary(ctx, req)
+}
+
+// AddVariant calls command.v1.ProductService.AddVariant.
+func (c *productServiceClient) AddVariant(ctx context.Context, req *connect.Request[v1.AddVariantRequest]) (*connect.Response[v1.AddVariantResponse], error) {
+	return c.addVariant.CallUnary(ctx, req)
+}
+
+// UpdateVariant calls command.v1.ProductService.UpdateVariant.
+func (c *productServiceClient) UpdateVariant(ctx context.Context, req *connect.Request[v1.UpdateVariantRequest]) (*connect.Response[v1.UpdateVariantResponse], error) {
+	return c.updateVariant.CallUnary(ctx, req)
+}
+
+// RemoveVariant calls command.v1.ProductService.RemoveVariant.
+func (c *productServiceClient) RemoveVariant(ctx context.Context, req *connect.Request[v1.RemoveVariantRequest]) (*connect.Response[v1.RemoveVariantResponse], error) {
+	return c.removeVariant.CallUnary(ctx, req)
+}
+
+// AdjustPrices calls command.v1.ProductService.AdjustPrices.
+func (c *productServiceClient) AdjustPrices(ctx context.Context, req *connect.Request[v1.AdjustPricesRequest]) (*connect.Response[v1.AdjustPricesResponse], error) {
+	return c.adjustPrices.CallUnary(ctx, req)
+}
+
 // ProductServiceHandler is an implementation of the command.v1.ProductService service.
 type ProductServiceHandler interface {
 	// 新しい商品を作成する
@@ -261,6 +439,20 @@ type ProductServiceHandler interface {
 	UpdateProduct(context.Context, *connect.Request[v1.UpdateProductRequest]) (*connect.Response[v1.UpdateProductResponse], error)
 	// 商品を削除する
 	DeleteProduct(context.Context, *connect.Request[v1.DeleteProductRequest]) (*connect.Response[v1.DeleteProductResponse], error)
+	// 下書きまたは一時停止中の商品を公開する。許可されていない状態遷移の場合はFAILED_PRECONDITIONを返す
+	PublishProduct(context.Context, *connect.Request[v1.PublishProductRequest]) (*connect.Response[v1.PublishProductResponse], error)
+	// 公開中の商品の販売を一時停止する。許可されていない状態遷移の場合はFAILED_PRECONDITIONを返す
+	SuspendProduct(context.Context, *connect.Request[v1.SuspendProductRequest]) (*connect.Response[v1.SuspendProductResponse], error)
+	// 商品の販売を終了する。販売終了した商品は再び公開できない
+	DiscontinueProduct(context.Context, *connect.Request[v1.DiscontinueProductRequest]) (*connect.Response[v1.DiscontinueProductResponse], error)
+	// 商品にバリエーションを追加する。SKUまたは選択肢の組み合わせが重複する場合はALREADY_EXISTSを返す
+	AddVariant(context.Context, *connect.Request[v1.AddVariantRequest]) (*connect.Response[v1.AddVariantResponse], error)
+	// 商品のバリエーションを置き換える
+	UpdateVariant(context.Context, *connect.Request[v1.UpdateVariantRequest]) (*connect.Response[v1.UpdateVariantResponse], error)
+	// 商品のバリエーションを削除する
+	RemoveVariant(context.Context, *connect.Request[v1.RemoveVariantRequest]) (*connect.Response[v1.RemoveVariantResponse], error)
+	// カテゴリ（子孫カテゴリ・タグで絞り込み可）の商品の単価を1つのトランザクションで一括変更する。previewの場合は変更せずに変更前後の単価を返す
+	AdjustPrices(context.Context, *connect.Request[v1.AdjustPricesRequest]) (*connect.Response[v1.AdjustPricesResponse], error)
 }
 
 // NewProductServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -288,6 +480,48 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 		connect.WithSchema(productServiceMethods.ByName("DeleteProduct")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServicePublishProductHandler := connect.NewUnaryHandler(
+		ProductServicePublishProductProcedure,
+		svc.PublishProduct,
+		connect.WithSchema(productServiceMethods.ByName("PublishProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceSuspendProductHandler := connect.NewUnaryHandler(
+		ProductServiceSuspendProductProcedure,
+		svc.SuspendProduct,
+		connect.WithSchema(productServiceMethods.ByName("SuspendProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceDiscontinueProductHandler := connect.NewUnaryHandler(
+		ProductServiceDiscontinueProductProcedure,
+		svc.DiscontinueProduct,
+		connect.WithSchema(productServiceMethods.ByName("DiscontinueProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceAddVariantHandler := connect.NewUnaryHandler(
+		ProductServiceAddVariantProcedure,
+		svc.AddVariant,
+		connect.WithSchema(productServiceMethods.ByName("AddVariant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceUpdateVariantHandler := connect.NewUnaryHandler(
+		ProductServiceUpdateVariantProcedure,
+		svc.UpdateVariant,
+		connect.WithSchema(productServiceMethods.ByName("UpdateVariant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceRemoveVariantHandler := connect.NewUnaryHandler(
+		ProductServiceRemoveVariantProcedure,
+		svc.RemoveVariant,
+		connect.WithSchema(productServiceMethods.ByName("RemoveVariant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceAdjustPricesHandler := connect.NewUnaryHandler(
+		ProductServiceAdjustPricesProcedure,
+		svc.AdjustPrices,
+		connect.WithSchema(productServiceMethods.ByName("AdjustPrices")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/command.v1.ProductService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProductServiceCreateProductProcedure:
@@ -296,6 +530,20 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 			productServiceUpdateProductHandler.ServeHTTP(w, r)
 		case ProductServiceDeleteProductProcedure:
 			productServiceDeleteProductHandler.ServeHTTP(w, r)
+		case ProductServicePublishProductProcedure:
+			productServicePublishProductHandler.ServeHTTP(w, r)
+		case ProductServiceSuspendProductProcedure:
+			productServiceSuspendProductHandler.ServeHTTP(w, r)
+		case ProductServiceDiscontinueProductProcedure:
+			productServiceDiscontinueProductHandler.ServeHTTP(w, r)
+		case ProductServiceAddVariantProcedure:
+			productServiceAddVariantHandler.ServeHTTP(w, r)
+		case ProductServiceUpdateVariantProcedure:
+			productServiceUpdateVariantHandler.ServeHTTP(w, r)
+		case ProductServiceRemoveVariantProcedure:
+			productServiceRemoveVariantHandler.ServeHTTP(w, r)
+		case ProductServiceAdjustPricesProcedure:
+			productServiceAdjustPricesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -316,3 +564,387 @@ func (UnimplementedProductServiceHandler) UpdateProduct(context.Context, *connec
 func (UnimplementedProductServiceHandler) DeleteProduct(context.Context, *connect.Request[v1.DeleteProductRequest]) (*connect.Response[v1.DeleteProductResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.ProductService.DeleteProduct is not implemented"))
 }
+
+func (UnimplementedProductServiceHandler) PublishProduct(context.Context, *connect.Request[v1.PublishProductRequest]) (*connect.Response[v1.PublishProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.ProductService.PublishProduct is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) SuspendProduct(context.Context, *connect.Request[v1.SuspendProductRequest]) (*connect.Response[v1.SuspendProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.ProductService.SuspendProduct is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) DiscontinueProduct(context.Context, *connect.Request[v1.DiscontinueProductRequest]) (*connect.Response[v1.DiscontinueProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.ProductService.DiscontinueProduct is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) AddVariant(context.Context, *connect.Request[v1.AddVariantRequest]) (*connect.Response[v1.AddVariantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.ProductService.AddVariant is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) UpdateVariant(context.Context, *connect.Request[v1.UpdateVariantRequest]) (*connect.Response[v1.UpdateVariantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.ProductService.UpdateVariant is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) RemoveVariant(context.Context, *connect.Request[v1.RemoveVariantRequest]) (*connect.Response[v1.RemoveVariantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.ProductService.RemoveVariant is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) AdjustPrices(context.Context, *connect.Request[v1.AdjustPricesRequest]) (*connect.Response[v1.AdjustPricesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.ProductService.AdjustPrices is not implemented"))
+}
+
+// StockServiceClient is a client for the command.v1.StockService service.
+type StockServiceClient interface {
+	// 入荷や棚卸によって在庫数を増減する
+	AdjustStock(context.Context, *connect.Request[v1.AdjustStockRequest]) (*connect.Response[v1.AdjustStockResponse], error)
+	// 在庫を引き当てる。引当可能な数量が不足する場合はFAILED_PRECONDITIONを返す
+	ReserveStock(context.Context, *connect.Request[v1.ReserveStockRequest]) (*connect.Response[v1.ReserveStockResponse], error)
+	// 引当をキャンセルし、在庫を引当可能に戻す
+	ReleaseReservation(context.Context, *connect.Request[v1.ReleaseReservationRequest]) (*connect.Response[v1.ReleaseReservationResponse], error)
+	// 引当を確定し、在庫数から差し引く
+	CommitReservation(context.Context, *connect.Request[v1.CommitReservationRequest]) (*connect.Response[v1.CommitReservationResponse], error)
+}
+
+// NewStockServiceClient constructs a client for the command.v1.StockService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStockServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StockServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	stockServiceMethods := v1.File_command_v1_command_proto.Services().ByName("StockService").Methods()
+	return &stockServiceClient{
+		adjustStock: connect.NewClient[v1.AdjustStockRequest, v1.AdjustStockResponse](
+			httpClient,
+			baseURL+StockServiceAdjustStockProcedure,
+			connect.WithSchema(stockServiceMethods.ByName("AdjustStock")),
+			connect.WithClientOptions(opts...),
+		),
+		reserveStock: connect.NewClient[v1.ReserveStockRequest, v1.ReserveStockResponse](
+			httpClient,
+			baseURL+StockServiceReserveStockProcedure,
+			connect.WithSchema(stockServiceMethods.ByName("ReserveStock")),
+			connect.WithClientOptions(opts...),
+		),
+		releaseReservation: connect.NewClient[v1.ReleaseReservationRequest, v1.ReleaseReservationResponse](
+			httpClient,
+			baseURL+StockServiceReleaseReservationProcedure,
+			connect.WithSchema(stockServiceMethods.ByName("ReleaseReservation")),
+			connect.WithClientOptions(opts...),
+		),
+		commitReservation: connect.NewClient[v1.CommitReservationRequest, v1.CommitReservationResponse](
+			httpClient,
+			baseURL+StockServiceCommitReservationProcedure,
+			connect.WithSchema(stockServiceMethods.ByName("CommitReservation")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// stockServiceClient implements StockServiceClient.
+type stockServiceClient struct {
+	adjustStock        *connect.Client[v1.AdjustStockRequest, v1.AdjustStockResponse]
+	reserveStock       *connect.Client[v1.ReserveStockRequest, v1.ReserveStockResponse]
+	releaseReservation *connect.Client[v1.ReleaseReservationRequest, v1.ReleaseReservationResponse]
+	commitReservation  *connect.Client[v1.CommitReservationRequest, v1.CommitReservationResponse]
+}
+
+// AdjustStock calls command.v1.StockService.AdjustStock.
+func (c *stockServiceClient) AdjustStock(ctx context.Context, req *connect.Request[v1.AdjustStockRequest]) (*connect.Response[v1.AdjustStockResponse], error) {
+	return c.adjustStock.CallUnary(ctx, req)
+}
+
+// ReserveStock calls command.v1.StockService.ReserveStock.
+func (c *stockServiceClient) ReserveStock(ctx context.Context, req *connect.Request[v1.ReserveStockRequest]) (*connect.Response[v1.ReserveStockResponse], error) {
+	return c.reserveStock.CallUnary(ctx, req)
+}
+
+// ReleaseReservation calls command.v1.StockService.ReleaseReservation.
+func (c *stockServiceClient) ReleaseReservation(ctx context.Context, req *connect.Request[v1.ReleaseReservationRequest]) (*connect.Response[v1.ReleaseReservationResponse], error) {
+	return c.releaseReservation.CallUnary(ctx, req)
+}
+
+// CommitReservation calls command.v1.StockService.CommitReservation.
+func (c *stockServiceClient) CommitReservation(ctx context.Context, req *connect.Request[v1.CommitReservationRequest]) (*connect.Response[v1.CommitReservationResponse], error) {
+	return c.commitReservation.CallUnary(ctx, req)
+}
+
+// StockServiceHandler is an implementation of the command.v1.StockService service.
+type StockServiceHandler interface {
+	// 入荷や棚卸によって在庫数を増減する
+	AdjustStock(context.Context, *connect.Request[v1.AdjustStockRequest]) (*connect.Response[v1.AdjustStockResponse], error)
+	// 在庫を引き当てる。引当可能な数量が不足する場合はFAILED_PRECONDITIONを返す
+	ReserveStock(context.Context, *connect.Request[v1.ReserveStockRequest]) (*connect.Response[v1.ReserveStockResponse], error)
+	// 引当をキャンセルし、在庫を引当可能に戻す
+	ReleaseReservation(context.Context, *connect.Request[v1.ReleaseReservationRequest]) (*connect.Response[v1.ReleaseReservationResponse], error)
+	// 引当を確定し、在庫数から差し引く
+	CommitReservation(context.Context, *connect.Request[v1.CommitReservationRequest]) (*connect.Response[v1.CommitReservationResponse], error)
+}
+
+// NewStockServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStockServiceHandler(svc StockServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	stockServiceMethods := v1.File_command_v1_command_proto.Services().ByName("StockService").Methods()
+	stockServiceAdjustStockHandler := connect.NewUnaryHandler(
+		StockServiceAdjustStockProcedure,
+		svc.AdjustStock,
+		connect.WithSchema(stockServiceMethods.ByName("AdjustStock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockServiceReserveStockHandler := connect.NewUnaryHandler(
+		StockServiceReserveStockProcedure,
+		svc.ReserveStock,
+		connect.WithSchema(stockServiceMethods.ByName("ReserveStock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockServiceReleaseReservationHandler := connect.NewUnaryHandler(
+		StockServiceReleaseReservationProcedure,
+		svc.ReleaseReservation,
+		connect.WithSchema(stockServiceMethods.ByName("ReleaseReservation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockServiceCommitReservationHandler := connect.NewUnaryHandler(
+		StockServiceCommitReservationProcedure,
+		svc.CommitReservation,
+		connect.WithSchema(stockServiceMethods.ByName("CommitReservation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/command.v1.StockService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StockServiceAdjustStockProcedure:
+			stockServiceAdjustStockHandler.ServeHTTP(w, r)
+		case StockServiceReserveStockProcedure:
+			stockServiceReserveStockHandler.ServeHTTP(w, r)
+		case StockServiceReleaseReservationProcedure:
+			stockServiceReleaseReservationHandler.ServeHTTP(w, r)
+		case StockServiceCommitReservationProcedure:
+			stockServiceCommitReservationHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStockServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStockServiceHandler struct{}
+
+func (UnimplementedStockServiceHandler) AdjustStock(context.Context, *connect.Request[v1.AdjustStockRequest]) (*connect.Response[v1.AdjustStockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.StockService.AdjustStock is not implemented"))
+}
+
+func (UnimplementedStockServiceHandler) ReserveStock(context.Context, *connect.Request[v1.ReserveStockRequest]) (*connect.Response[v1.ReserveStockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.StockService.ReserveStock is not implemented"))
+}
+
+func (UnimplementedStockServiceHandler) ReleaseReservation(context.Context, *connect.Request[v1.ReleaseReservationRequest]) (*connect.Response[v1.ReleaseReservationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.StockService.ReleaseReservation is not implemented"))
+}
+
+func (UnimplementedStockServiceHandler) CommitReservation(context.Context, *connect.Request[v1.CommitReservationRequest]) (*connect.Response[v1.CommitReservationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.StockService.CommitReservation is not implemented"))
+}
+
+// TagServiceClient is a client for the command.v1.TagService service.
+type TagServiceClient interface {
+	// 指定したすべての商品に指定したすべてのタグを付与する。存在しない商品が含まれる場合はNOT_FOUNDを返す
+	AttachTags(context.Context, *connect.Request[v1.AttachTagsRequest]) (*connect.Response[v1.AttachTagsResponse], error)
+	// 指定したすべての商品から指定したすべてのタグを外す
+	DetachTags(context.Context, *connect.Request[v1.DetachTagsRequest]) (*connect.Response[v1.DetachTagsResponse], error)
+}
+
+// NewTagServiceClient constructs a client for the command.v1.TagService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTagServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TagServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tagServiceMethods := v1.File_command_v1_command_proto.Services().ByName("TagService").Methods()
+	return &tagServiceClient{
+		attachTags: connect.NewClient[v1.AttachTagsRequest, v1.AttachTagsResponse](
+			httpClient,
+			baseURL+TagServiceAttachTagsProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("AttachTags")),
+			connect.WithClientOptions(opts...),
+		),
+		detachTags: connect.NewClient[v1.DetachTagsRequest, v1.DetachTagsResponse](
+			httpClient,
+			baseURL+TagServiceDetachTagsProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("DetachTags")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tagServiceClient implements TagServiceClient.
+type tagServiceClient struct {
+	attachTags *connect.Client[v1.AttachTagsRequest, v1.AttachTagsResponse]
+	detachTags *connect.Client[v1.DetachTagsRequest, v1.DetachTagsResponse]
+}
+
+// AttachTags calls command.v1.TagService.AttachTags.
+func (c *tagServiceClient) AttachTags(ctx context.Context, req *connect.Request[v1.AttachTagsRequest]) (*connect.Response[v1.AttachTagsResponse], error) {
+	return c.attachTags.CallUnary(ctx, req)
+}
+
+// DetachTags calls command.v1.TagService.DetachTags.
+func (c *tagServiceClient) DetachTags(ctx context.Context, req *connect.Request[v1.DetachTagsRequest]) (*connect.Response[v1.DetachTagsResponse], error) {
+	return c.detachTags.CallUnary(ctx, req)
+}
+
+// TagServiceHandler is an implementation of the command.v1.TagService service.
+type TagServiceHandler interface {
+	// 指定したすべての商品に指定したすべてのタグを付与する。存在しない商品が含まれる場合はNOT_FOUNDを返す
+	AttachTags(context.Context, *connect.Request[v1.AttachTagsRequest]) (*connect.Response[v1.AttachTagsResponse], error)
+	// 指定したすべての商品から指定したすべてのタグを外す
+	DetachTags(context.Context, *connect.Request[v1.DetachTagsRequest]) (*connect.Response[v1.DetachTagsResponse], error)
+}
+
+// NewTagServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTagServiceHandler(svc TagServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tagServiceMethods := v1.File_command_v1_command_proto.Services().ByName("TagService").Methods()
+	tagServiceAttachTagsHandler := connect.NewUnaryHandler(
+		TagServiceAttachTagsProcedure,
+		svc.AttachTags,
+		connect.WithSchema(tagServiceMethods.ByName("AttachTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceDetachTagsHandler := connect.NewUnaryHandler(
+		TagServiceDetachTagsProcedure,
+		svc.DetachTags,
+		connect.WithSchema(tagServiceMethods.ByName("DetachTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/command.v1.TagService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TagServiceAttachTagsProcedure:
+			tagServiceAttachTagsHandler.ServeHTTP(w, r)
+		case TagServiceDetachTagsProcedure:
+			tagServiceDetachTagsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTagServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTagServiceHandler struct{}
+
+func (UnimplementedTagServiceHandler) AttachTags(context.Context, *connect.Request[v1.AttachTagsRequest]) (*connect.Response[v1.AttachTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.TagService.AttachTags is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) DetachTags(context.Context, *connect.Request[v1.DetachTagsRequest]) (*connect.Response[v1.DetachTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.TagService.DetachTags is not implemented"))
+}
+
+// PriceScheduleServiceClient is a client for the command.v1.PriceScheduleService service.
+type PriceScheduleServiceClient interface {
+	// 商品に価格スケジュールを登録する。同じ商品の予定または適用中の価格スケジュールと期間が重なる場合はFAILED_PRECONDITIONを返す
+	SchedulePrice(context.Context, *connect.Request[v1.SchedulePriceRequest]) (*connect.Response[v1.SchedulePriceResponse], error)
+	// 価格スケジュールを取り消す。適用中の場合は単価を適用前に戻す。終了済みまたは取消済みの場合はFAILED_PRECONDITIONを返す
+	CancelPriceSchedule(context.Context, *connect.Request[v1.CancelPriceScheduleRequest]) (*connect.Response[v1.CancelPriceScheduleResponse], error)
+}
+
+// NewPriceScheduleServiceClient constructs a client for the command.v1.PriceScheduleService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPriceScheduleServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PriceScheduleServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	priceScheduleServiceMethods := v1.File_command_v1_command_proto.Services().ByName("PriceScheduleService").Methods()
+	return &priceScheduleServiceClient{
+		schedulePrice: connect.NewClient[v1.SchedulePriceRequest, v1.SchedulePriceResponse](
+			httpClient,
+			baseURL+PriceScheduleServiceSchedulePriceProcedure,
+			connect.WithSchema(priceScheduleServiceMethods.ByName("SchedulePrice")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelPriceSchedule: connect.NewClient[v1.CancelPriceScheduleRequest, v1.CancelPriceScheduleResponse](
+			httpClient,
+			baseURL+PriceScheduleServiceCancelPriceScheduleProcedure,
+			connect.WithSchema(priceScheduleServiceMethods.ByName("CancelPriceSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// priceScheduleServiceClient implements PriceScheduleServiceClient.
+type priceScheduleServiceClient struct {
+	schedulePrice       *connect.Client[v1.SchedulePriceRequest, v1.SchedulePriceResponse]
+	cancelPriceSchedule *connect.Client[v1.CancelPriceScheduleRequest, v1.CancelPriceScheduleResponse]
+}
+
+// SchedulePrice calls command.v1.PriceScheduleService.SchedulePrice.
+func (c *priceScheduleServiceClient) SchedulePrice(ctx context.Context, req *connect.Request[v1.SchedulePriceRequest]) (*connect.Response[v1.SchedulePriceResponse], error) {
+	return c.schedulePrice.CallUnary(ctx, req)
+}
+
+// CancelPriceSchedule calls command.v1.PriceScheduleService.CancelPriceSchedule.
+func (c *priceScheduleServiceClient) CancelPriceSchedule(ctx context.Context, req *connect.Request[v1.CancelPriceScheduleRequest]) (*connect.Response[v1.CancelPriceScheduleResponse], error) {
+	return c.cancelPriceSchedule.CallUnary(ctx, req)
+}
+
+// PriceScheduleServiceHandler is an implementation of the command.v1.PriceScheduleService service.
+type PriceScheduleServiceHandler interface {
+	// 商品に価格スケジュールを登録する。同じ商品の予定または適用中の価格スケジュールと期間が重なる場合はFAILED_PRECONDITIONを返す
+	SchedulePrice(context.Context, *connect.Request[v1.SchedulePriceRequest]) (*connect.Response[v1.SchedulePriceResponse], error)
+	// 価格スケジュールを取り消す。適用中の場合は単価を適用前に戻す。終了済みまたは取消済みの場合はFAILED_PRECONDITIONを返す
+	CancelPriceSchedule(context.Context, *connect.Request[v1.CancelPriceScheduleRequest]) (*connect.Response[v1.CancelPriceScheduleResponse], error)
+}
+
+// NewPriceScheduleServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPriceScheduleServiceHandler(svc PriceScheduleServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	priceScheduleServiceMethods := v1.File_command_v1_command_proto.Services().ByName("PriceScheduleService").Methods()
+	priceScheduleServiceSchedulePriceHandler := connect.NewUnaryHandler(
+		PriceScheduleServiceSchedulePriceProcedure,
+		svc.SchedulePrice,
+		connect.WithSchema(priceScheduleServiceMethods.ByName("SchedulePrice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	priceScheduleServiceCancelPriceScheduleHandler := connect.NewUnaryHandler(
+		PriceScheduleServiceCancelPriceScheduleProcedure,
+		svc.CancelPriceSchedule,
+		connect.WithSchema(priceScheduleServiceMethods.ByName("CancelPriceSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/command.v1.PriceScheduleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PriceScheduleServiceSchedulePriceProcedure:
+			priceScheduleServiceSchedulePriceHandler.ServeHTTP(w, r)
+		case PriceScheduleServiceCancelPriceScheduleProcedure:
+			priceScheduleServiceCancelPriceScheduleHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPriceScheduleServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPriceScheduleServiceHandler struct{}
+
+func (UnimplementedPriceScheduleServiceHandler) SchedulePrice(context.Context, *connect.Request[v1.SchedulePriceRequest]) (*connect.Response[v1.SchedulePriceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.PriceScheduleService.SchedulePrice is not implemented"))
+}
+
+func (UnimplementedPriceScheduleServiceHandler) CancelPriceSchedule(context.Context, *connect.Request[v1.CancelPriceScheduleRequest]) (*connect.Response[v1.CancelPriceScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("command.v1.PriceScheduleService.CancelPriceSchedule is not implemented"))
+}
@@ -16,6 +16,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	unsafe "unsafe"
 )
@@ -27,6 +28,200 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 消費税の税率区分
+type TaxClass int32
+
+const (
+	TaxClass_TAX_CLASS_UNSPECIFIED TaxClass = 0 // 未指定（標準税率として扱う）
+	TaxClass_TAX_CLASS_STANDARD    TaxClass = 1 // 標準税率（10%）
+	TaxClass_TAX_CLASS_REDUCED     TaxClass = 2 // 軽減税率（8%）
+)
+
+// Enum value maps for TaxClass.
+var (
+	TaxClass_name = map[int32]string{
+		0: "TAX_CLASS_UNSPECIFIED",
+		1: "TAX_CLASS_STANDARD",
+		2: "TAX_CLASS_REDUCED",
+	}
+	TaxClass_value = map[string]int32{
+		"TAX_CLASS_UNSPECIFIED": 0,
+		"TAX_CLASS_STANDARD":    1,
+		"TAX_CLASS_REDUCED":     2,
+	}
+)
+
+func (x TaxClass) Enum() *TaxClass {
+	p := new(TaxClass)
+	*p = x
+	return p
+}
+
+func (x TaxClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaxClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_v1_models_proto_enumTypes[0].Descriptor()
+}
+
+func (TaxClass) Type() protoreflect.EnumType {
+	return &file_common_v1_models_proto_enumTypes[0]
+}
+
+func (x TaxClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// 商品の販売状態
+// DRAFT → PUBLISHED ⇄ SUSPENDED の順に遷移し、どの状態からもDISCONTINUEDに遷移できる（DISCONTINUEDからは遷移できない）
+type ProductStatus int32
+
+const (
+	ProductStatus_PRODUCT_STATUS_UNSPECIFIED  ProductStatus = 0 // 不明
+	ProductStatus_PRODUCT_STATUS_DRAFT        ProductStatus = 1 // 下書き（問合せサービスの一覧には表示されない）
+	ProductStatus_PRODUCT_STATUS_PUBLISHED    ProductStatus = 2 // 公開中
+	ProductStatus_PRODUCT_STATUS_SUSPENDED    ProductStatus = 3 // 一時停止
+	ProductStatus_PRODUCT_STATUS_DISCONTINUED ProductStatus = 4 // 販売終了
+)
+
+// Enum value maps for ProductStatus.
+var (
+	ProductStatus_name = map[int32]string{
+		0: "PRODUCT_STATUS_UNSPECIFIED",
+		1: "PRODUCT_STATUS_DRAFT",
+		2: "PRODUCT_STATUS_PUBLISHED",
+		3: "PRODUCT_STATUS_SUSPENDED",
+		4: "PRODUCT_STATUS_DISCONTINUED",
+	}
+	ProductStatus_value = map[string]int32{
+		"PRODUCT_STATUS_UNSPECIFIED":  0,
+		"PRODUCT_STATUS_DRAFT":        1,
+		"PRODUCT_STATUS_PUBLISHED":    2,
+		"PRODUCT_STATUS_SUSPENDED":    3,
+		"PRODUCT_STATUS_DISCONTINUED": 4,
+	}
+)
+
+func (x ProductStatus) Enum() *ProductStatus {
+	p := new(ProductStatus)
+	*p = x
+	return p
+}
+
+func (x ProductStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_v1_models_proto_enumTypes[1].Descriptor()
+}
+
+func (ProductStatus) Type() protoreflect.EnumType {
+	return &file_common_v1_models_proto_enumTypes[1]
+}
+
+func (x ProductStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// 商品バリエーションの販売状態
+type VariantStatus int32
+
+const (
+	VariantStatus_VARIANT_STATUS_UNSPECIFIED VariantStatus = 0 // 不明
+	VariantStatus_VARIANT_STATUS_ACTIVE      VariantStatus = 1 // 販売中
+	VariantStatus_VARIANT_STATUS_INACTIVE    VariantStatus = 2 // 販売停止
+)
+
+// Enum value maps for VariantStatus.
+var (
+	VariantStatus_name = map[int32]string{
+		0: "VARIANT_STATUS_UNSPECIFIED",
+		1: "VARIANT_STATUS_ACTIVE",
+		2: "VARIANT_STATUS_INACTIVE",
+	}
+	VariantStatus_value = map[string]int32{
+		"VARIANT_STATUS_UNSPECIFIED": 0,
+		"VARIANT_STATUS_ACTIVE":      1,
+		"VARIANT_STATUS_INACTIVE":    2,
+	}
+)
+
+func (x VariantStatus) Enum() *VariantStatus {
+	p := new(VariantStatus)
+	*p = x
+	return p
+}
+
+func (x VariantStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VariantStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_v1_models_proto_enumTypes[2].Descriptor()
+}
+
+func (VariantStatus) Type() protoreflect.EnumType {
+	return &file_common_v1_models_proto_enumTypes[2]
+}
+
+func (x VariantStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// 価格スケジュールの状態
+// SCHEDULED → ACTIVE → COMPLETED の順に遷移し、SCHEDULEDまたはACTIVEの間はCANCELLEDに遷移できる
+type PriceScheduleStatus int32
+
+const (
+	PriceScheduleStatus_PRICE_SCHEDULE_STATUS_UNSPECIFIED PriceScheduleStatus = 0 // 不明
+	PriceScheduleStatus_PRICE_SCHEDULE_STATUS_SCHEDULED   PriceScheduleStatus = 1 // 予定（開始前）
+	PriceScheduleStatus_PRICE_SCHEDULE_STATUS_ACTIVE      PriceScheduleStatus = 2 // 適用中
+	PriceScheduleStatus_PRICE_SCHEDULE_STATUS_COMPLETED   PriceScheduleStatus = 3 // 終了
+	PriceScheduleStatus_PRICE_SCHEDULE_STATUS_CANCELLED   PriceScheduleStatus = 4 // 取消
+)
+
+// Enum value maps for PriceScheduleStatus.
+var (
+	PriceScheduleStatus_name = map[int32]string{
+		0: "PRICE_SCHEDULE_STATUS_UNSPECIFIED",
+		1: "PRICE_SCHEDULE_STATUS_SCHEDULED",
+		2: "PRICE_SCHEDULE_STATUS_ACTIVE",
+		3: "PRICE_SCHEDULE_STATUS_COMPLETED",
+		4: "PRICE_SCHEDULE_STATUS_CANCELLED",
+	}
+	PriceScheduleStatus_value = map[string]int32{
+		"PRICE_SCHEDULE_STATUS_UNSPECIFIED": 0,
+		"PRICE_SCHEDULE_STATUS_SCHEDULED":   1,
+		"PRICE_SCHEDULE_STATUS_ACTIVE":      2,
+		"PRICE_SCHEDULE_STATUS_COMPLETED":   3,
+		"PRICE_SCHEDULE_STATUS_CANCELLED":   4,
+	}
+)
+
+func (x PriceScheduleStatus) Enum() *PriceScheduleStatus {
+	p := new(PriceScheduleStatus)
+	*p = x
+	return p
+}
+
+func (x PriceScheduleStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceScheduleStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_v1_models_proto_enumTypes[3].Descriptor()
+}
+
+func (PriceScheduleStatus) Type() protoreflect.EnumType {
+	return &file_common_v1_models_proto_enumTypes[3]
+}
+
+func (x PriceScheduleStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 // 商品カテゴリID型（削除リクエストなどで使用）
 type CategoryId struct {
 	state            protoimpl.MessageState `protogen:"opaque.v1"`
@@ -314,18 +509,96 @@ func (b0 ProductPrice_builder) Build() *ProductPrice {
 	return m0
 }
 
+// 金額型の定義（通貨の最小単位の金額と通貨コード）
+type Money struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Amount   int64                  `protobuf:"varint,1,opt,name=amount,proto3"`
+	xxx_hidden_Currency string                 `protobuf:"bytes,2,opt,name=currency,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_common_v1_models_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_models_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.xxx_hidden_Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.xxx_hidden_Currency
+	}
+	return ""
+}
+
+func (x *Money) SetAmount(v int64) {
+	x.xxx_hidden_Amount = v
+}
+
+func (x *Money) SetCurrency(v string) {
+	x.xxx_hidden_Currency = v
+}
+
+type Money_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Amount   int64
+	Currency string
+}
+
+func (b0 Money_builder) Build() *Money {
+	m0 := &Money{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Amount = b.Amount
+	x.xxx_hidden_Currency = b.Currency
+	return m0
+}
+
 // 商品カテゴリ型の定義, レスポンス用でありvalidationは緩い
 type Category struct {
-	state           protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id   string                 `protobuf:"bytes,1,opt,name=id,proto3"`
-	xxx_hidden_Name string                 `protobuf:"bytes,2,opt,name=name,proto3"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id           string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name         string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_ParentId     *string                `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof"`
+	xxx_hidden_Translations map[string]string      `protobuf:"bytes,4,rep,name=translations,proto3" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Locale       string                 `protobuf:"bytes,5,opt,name=locale,proto3"`
+	xxx_hidden_Slug         string                 `protobuf:"bytes,6,opt,name=slug,proto3"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *Category) Reset() {
 	*x = Category{}
-	mi := &file_common_v1_models_proto_msgTypes[5]
+	mi := &file_common_v1_models_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -337,7 +610,7 @@ func (x *Category) String() string {
 func (*Category) ProtoMessage() {}
 
 func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_models_proto_msgTypes[5]
+	mi := &file_common_v1_models_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,6 +635,37 @@ func (x *Category) GetName() string {
 	return ""
 }
 
+func (x *Category) GetParentId() string {
+	if x != nil {
+		if x.xxx_hidden_ParentId != nil {
+			return *x.xxx_hidden_ParentId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Category) GetTranslations() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Translations
+	}
+	return nil
+}
+
+func (x *Category) GetLocale() string {
+	if x != nil {
+		return x.xxx_hidden_Locale
+	}
+	return ""
+}
+
+func (x *Category) GetSlug() string {
+	if x != nil {
+		return x.xxx_hidden_Slug
+	}
+	return ""
+}
+
 func (x *Category) SetId(v string) {
 	x.xxx_hidden_Id = v
 }
@@ -370,11 +674,44 @@ func (x *Category) SetName(v string) {
 	x.xxx_hidden_Name = v
 }
 
+func (x *Category) SetParentId(v string) {
+	x.xxx_hidden_ParentId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *Category) SetTranslations(v map[string]string) {
+	x.xxx_hidden_Translations = v
+}
+
+func (x *Category) SetLocale(v string) {
+	x.xxx_hidden_Locale = v
+}
+
+func (x *Category) SetSlug(v string) {
+	x.xxx_hidden_Slug = v
+}
+
+func (x *Category) HasParentId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Category) ClearParentId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_ParentId = nil
+}
+
 type Category_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id   string
-	Name string
+	Id           string
+	Name         string
+	ParentId     *string
+	Translations map[string]string
+	Locale       string
+	Slug         string
 }
 
 func (b0 Category_builder) Build() *Category {
@@ -383,23 +720,43 @@ func (b0 Category_builder) Build() *Category {
 	_, _ = b, x
 	x.xxx_hidden_Id = b.Id
 	x.xxx_hidden_Name = b.Name
+	if b.ParentId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_ParentId = b.ParentId
+	}
+	x.xxx_hidden_Translations = b.Translations
+	x.xxx_hidden_Locale = b.Locale
+	x.xxx_hidden_Slug = b.Slug
 	return m0
 }
 
 // 商品型の定義, レスポンス用でありvalidationは緩い
 type Product struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id       string                 `protobuf:"bytes,1,opt,name=id,proto3"`
-	xxx_hidden_Name     string                 `protobuf:"bytes,2,opt,name=name,proto3"`
-	xxx_hidden_Price    int32                  `protobuf:"varint,3,opt,name=price,proto3"`
-	xxx_hidden_Category *Category              `protobuf:"bytes,4,opt,name=category,proto3,oneof"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id                string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name              string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_Price             int32                  `protobuf:"varint,3,opt,name=price,proto3"`
+	xxx_hidden_Category          *Category              `protobuf:"bytes,4,opt,name=category,proto3,oneof"`
+	xxx_hidden_AvailableQuantity int32                  `protobuf:"varint,5,opt,name=available_quantity,json=availableQuantity,proto3"`
+	xxx_hidden_InStock           bool                   `protobuf:"varint,6,opt,name=in_stock,json=inStock,proto3"`
+	xxx_hidden_Variants          *[]*ProductVariant     `protobuf:"bytes,7,rep,name=variants,proto3"`
+	xxx_hidden_Tags              *[]*Tag                `protobuf:"bytes,8,rep,name=tags,proto3"`
+	xxx_hidden_TaxClass          TaxClass               `protobuf:"varint,9,opt,name=tax_class,json=taxClass,proto3,enum=common.v1.TaxClass"`
+	xxx_hidden_PriceExcludingTax *Money                 `protobuf:"bytes,10,opt,name=price_excluding_tax,json=priceExcludingTax,proto3"`
+	xxx_hidden_PriceIncludingTax *Money                 `protobuf:"bytes,11,opt,name=price_including_tax,json=priceIncludingTax,proto3,oneof"`
+	xxx_hidden_Translations      map[string]string      `protobuf:"bytes,12,rep,name=translations,proto3" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Locale            string                 `protobuf:"bytes,13,opt,name=locale,proto3"`
+	xxx_hidden_Status            ProductStatus          `protobuf:"varint,14,opt,name=status,proto3,enum=common.v1.ProductStatus"`
+	xxx_hidden_PriceSchedules    *[]*PriceSchedule      `protobuf:"bytes,15,rep,name=price_schedules,json=priceSchedules,proto3"`
+	xxx_hidden_Barcode           string                 `protobuf:"bytes,16,opt,name=barcode,proto3"`
+	xxx_hidden_Slug              string                 `protobuf:"bytes,17,opt,name=slug,proto3"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
 	*x = Product{}
-	mi := &file_common_v1_models_proto_msgTypes[6]
+	mi := &file_common_v1_models_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -411,7 +768,7 @@ func (x *Product) String() string {
 func (*Product) ProtoMessage() {}
 
 func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_models_proto_msgTypes[6]
+	mi := &file_common_v1_models_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,6 +807,103 @@ func (x *Product) GetCategory() *Category {
 	return nil
 }
 
+func (x *Product) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_AvailableQuantity
+	}
+	return 0
+}
+
+func (x *Product) GetInStock() bool {
+	if x != nil {
+		return x.xxx_hidden_InStock
+	}
+	return false
+}
+
+func (x *Product) GetVariants() []*ProductVariant {
+	if x != nil {
+		if x.xxx_hidden_Variants != nil {
+			return *x.xxx_hidden_Variants
+		}
+	}
+	return nil
+}
+
+func (x *Product) GetTags() []*Tag {
+	if x != nil {
+		if x.xxx_hidden_Tags != nil {
+			return *x.xxx_hidden_Tags
+		}
+	}
+	return nil
+}
+
+func (x *Product) GetTaxClass() TaxClass {
+	if x != nil {
+		return x.xxx_hidden_TaxClass
+	}
+	return TaxClass_TAX_CLASS_UNSPECIFIED
+}
+
+func (x *Product) GetPriceExcludingTax() *Money {
+	if x != nil {
+		return x.xxx_hidden_PriceExcludingTax
+	}
+	return nil
+}
+
+func (x *Product) GetPriceIncludingTax() *Money {
+	if x != nil {
+		return x.xxx_hidden_PriceIncludingTax
+	}
+	return nil
+}
+
+func (x *Product) GetTranslations() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Translations
+	}
+	return nil
+}
+
+func (x *Product) GetLocale() string {
+	if x != nil {
+		return x.xxx_hidden_Locale
+	}
+	return ""
+}
+
+func (x *Product) GetStatus() ProductStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+}
+
+func (x *Product) GetPriceSchedules() []*PriceSchedule {
+	if x != nil {
+		if x.xxx_hidden_PriceSchedules != nil {
+			return *x.xxx_hidden_PriceSchedules
+		}
+	}
+	return nil
+}
+
+func (x *Product) GetBarcode() string {
+	if x != nil {
+		return x.xxx_hidden_Barcode
+	}
+	return ""
+}
+
+func (x *Product) GetSlug() string {
+	if x != nil {
+		return x.xxx_hidden_Slug
+	}
+	return ""
+}
+
 func (x *Product) SetId(v string) {
 	x.xxx_hidden_Id = v
 }
@@ -466,35 +920,709 @@ func (x *Product) SetCategory(v *Category) {
 	x.xxx_hidden_Category = v
 }
 
-func (x *Product) HasCategory() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_Category != nil
+func (x *Product) SetAvailableQuantity(v int32) {
+	x.xxx_hidden_AvailableQuantity = v
 }
 
-func (x *Product) ClearCategory() {
-	x.xxx_hidden_Category = nil
+func (x *Product) SetInStock(v bool) {
+	x.xxx_hidden_InStock = v
 }
 
-type Product_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *Product) SetVariants(v []*ProductVariant) {
+	x.xxx_hidden_Variants = &v
+}
 
-	Id    string
-	Name  string
-	Price int32
-	// Category category = 4 [features.field_presence = EXPLICIT]; // edition用
-	Category *Category
+func (x *Product) SetTags(v []*Tag) {
+	x.xxx_hidden_Tags = &v
 }
 
-func (b0 Product_builder) Build() *Product {
-	m0 := &Product{}
-	b, x := &b0, m0
-	_, _ = b, x
+func (x *Product) SetTaxClass(v TaxClass) {
+	x.xxx_hidden_TaxClass = v
+}
+
+func (x *Product) SetPriceExcludingTax(v *Money) {
+	x.xxx_hidden_PriceExcludingTax = v
+}
+
+func (x *Product) SetPriceIncludingTax(v *Money) {
+	x.xxx_hidden_PriceIncludingTax = v
+}
+
+func (x *Product) SetTranslations(v map[string]string) {
+	x.xxx_hidden_Translations = v
+}
+
+func (x *Product) SetLocale(v string) {
+	x.xxx_hidden_Locale = v
+}
+
+func (x *Product) SetStatus(v ProductStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *Product) SetPriceSchedules(v []*PriceSchedule) {
+	x.xxx_hidden_PriceSchedules = &v
+}
+
+func (x *Product) SetBarcode(v string) {
+	x.xxx_hidden_Barcode = v
+}
+
+func (x *Product) SetSlug(v string) {
+	x.xxx_hidden_Slug = v
+}
+
+func (x *Product) HasCategory() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Category != nil
+}
+
+func (x *Product) HasPriceExcludingTax() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PriceExcludingTax != nil
+}
+
+func (x *Product) HasPriceIncludingTax() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PriceIncludingTax != nil
+}
+
+func (x *Product) ClearCategory() {
+	x.xxx_hidden_Category = nil
+}
+
+func (x *Product) ClearPriceExcludingTax() {
+	x.xxx_hidden_PriceExcludingTax = nil
+}
+
+func (x *Product) ClearPriceIncludingTax() {
+	x.xxx_hidden_PriceIncludingTax = nil
+}
+
+type Product_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    string
+	Name  string
+	Price int32
+	// Category category = 4 [features.field_presence = EXPLICIT]; // edition用
+	Category          *Category
+	AvailableQuantity int32
+	InStock           bool
+	Variants          []*ProductVariant
+	Tags              []*Tag
+	TaxClass          TaxClass
+	PriceExcludingTax *Money
+	PriceIncludingTax *Money
+	Translations      map[string]string
+	Locale            string
+	Status            ProductStatus
+	PriceSchedules    []*PriceSchedule
+	Barcode           string
+	Slug              string
+}
+
+func (b0 Product_builder) Build() *Product {
+	m0 := &Product{}
+	b, x := &b0, m0
+	_, _ = b, x
 	x.xxx_hidden_Id = b.Id
 	x.xxx_hidden_Name = b.Name
 	x.xxx_hidden_Price = b.Price
 	x.xxx_hidden_Category = b.Category
+	x.xxx_hidden_AvailableQuantity = b.AvailableQuantity
+	x.xxx_hidden_InStock = b.InStock
+	x.xxx_hidden_Variants = &b.Variants
+	x.xxx_hidden_Tags = &b.Tags
+	x.xxx_hidden_TaxClass = b.TaxClass
+	x.xxx_hidden_PriceExcludingTax = b.PriceExcludingTax
+	x.xxx_hidden_PriceIncludingTax = b.PriceIncludingTax
+	x.xxx_hidden_Translations = b.Translations
+	x.xxx_hidden_Locale = b.Locale
+	x.xxx_hidden_Status = b.Status
+	x.xxx_hidden_PriceSchedules = &b.PriceSchedules
+	x.xxx_hidden_Barcode = b.Barcode
+	x.xxx_hidden_Slug = b.Slug
+	return m0
+}
+
+// タグ型の定義, レスポンス用でありvalidationは緩い
+type Tag struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id   string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Name string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_common_v1_models_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_models_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Tag) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *Tag) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *Tag) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+type Tag_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id   string
+	Name string
+}
+
+func (b0 Tag_builder) Build() *Tag {
+	m0 := &Tag{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Name = b.Name
+	return m0
+}
+
+// 商品バリエーションの選択肢型（例: サイズ=M）
+type VariantOption struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Axis  string                 `protobuf:"bytes,1,opt,name=axis,proto3"`
+	xxx_hidden_Value string                 `protobuf:"bytes,2,opt,name=value,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VariantOption) Reset() {
+	*x = VariantOption{}
+	mi := &file_common_v1_models_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantOption) ProtoMessage() {}
+
+func (x *VariantOption) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_models_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VariantOption) GetAxis() string {
+	if x != nil {
+		return x.xxx_hidden_Axis
+	}
+	return ""
+}
+
+func (x *VariantOption) GetValue() string {
+	if x != nil {
+		return x.xxx_hidden_Value
+	}
+	return ""
+}
+
+func (x *VariantOption) SetAxis(v string) {
+	x.xxx_hidden_Axis = v
+}
+
+func (x *VariantOption) SetValue(v string) {
+	x.xxx_hidden_Value = v
+}
+
+type VariantOption_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Axis  string
+	Value string
+}
+
+func (b0 VariantOption_builder) Build() *VariantOption {
+	m0 := &VariantOption{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Axis = b.Axis
+	x.xxx_hidden_Value = b.Value
+	return m0
+}
+
+// 商品バリエーション型の定義, レスポンス用でありvalidationは緩い
+type ProductVariant struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id            string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3"`
+	xxx_hidden_Options       *[]*VariantOption      `protobuf:"bytes,3,rep,name=options,proto3"`
+	xxx_hidden_PriceOverride int32                  `protobuf:"varint,4,opt,name=price_override,json=priceOverride,proto3,oneof"`
+	xxx_hidden_Price         int32                  `protobuf:"varint,5,opt,name=price,proto3"`
+	xxx_hidden_Status        VariantStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=common.v1.VariantStatus"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ProductVariant) Reset() {
+	*x = ProductVariant{}
+	mi := &file_common_v1_models_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductVariant) ProtoMessage() {}
+
+func (x *ProductVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_models_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ProductVariant) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *ProductVariant) GetSku() string {
+	if x != nil {
+		return x.xxx_hidden_Sku
+	}
+	return ""
+}
+
+func (x *ProductVariant) GetOptions() []*VariantOption {
+	if x != nil {
+		if x.xxx_hidden_Options != nil {
+			return *x.xxx_hidden_Options
+		}
+	}
+	return nil
+}
+
+func (x *ProductVariant) GetPriceOverride() int32 {
+	if x != nil {
+		return x.xxx_hidden_PriceOverride
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetPrice() int32 {
+	if x != nil {
+		return x.xxx_hidden_Price
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetStatus() VariantStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return VariantStatus_VARIANT_STATUS_UNSPECIFIED
+}
+
+func (x *ProductVariant) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *ProductVariant) SetSku(v string) {
+	x.xxx_hidden_Sku = v
+}
+
+func (x *ProductVariant) SetOptions(v []*VariantOption) {
+	x.xxx_hidden_Options = &v
+}
+
+func (x *ProductVariant) SetPriceOverride(v int32) {
+	x.xxx_hidden_PriceOverride = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *ProductVariant) SetPrice(v int32) {
+	x.xxx_hidden_Price = v
+}
+
+func (x *ProductVariant) SetStatus(v VariantStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *ProductVariant) HasPriceOverride() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ProductVariant) ClearPriceOverride() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_PriceOverride = 0
+}
+
+type ProductVariant_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id            string
+	Sku           string
+	Options       []*VariantOption
+	PriceOverride *int32
+	Price         int32
+	Status        VariantStatus
+}
+
+func (b0 ProductVariant_builder) Build() *ProductVariant {
+	m0 := &ProductVariant{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Sku = b.Sku
+	x.xxx_hidden_Options = &b.Options
+	if b.PriceOverride != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_PriceOverride = *b.PriceOverride
+	}
+	x.xxx_hidden_Price = b.Price
+	x.xxx_hidden_Status = b.Status
+	return m0
+}
+
+// 在庫型の定義, レスポンス用でありvalidationは緩い
+type Stock struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_OnHand    int32                  `protobuf:"varint,2,opt,name=on_hand,json=onHand,proto3"`
+	xxx_hidden_Reserved  int32                  `protobuf:"varint,3,opt,name=reserved,proto3"`
+	xxx_hidden_Available int32                  `protobuf:"varint,4,opt,name=available,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Stock) Reset() {
+	*x = Stock{}
+	mi := &file_common_v1_models_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stock) ProtoMessage() {}
+
+func (x *Stock) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_models_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Stock) GetProductId() string {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return ""
+}
+
+func (x *Stock) GetOnHand() int32 {
+	if x != nil {
+		return x.xxx_hidden_OnHand
+	}
+	return 0
+}
+
+func (x *Stock) GetReserved() int32 {
+	if x != nil {
+		return x.xxx_hidden_Reserved
+	}
+	return 0
+}
+
+func (x *Stock) GetAvailable() int32 {
+	if x != nil {
+		return x.xxx_hidden_Available
+	}
+	return 0
+}
+
+func (x *Stock) SetProductId(v string) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *Stock) SetOnHand(v int32) {
+	x.xxx_hidden_OnHand = v
+}
+
+func (x *Stock) SetReserved(v int32) {
+	x.xxx_hidden_Reserved = v
+}
+
+func (x *Stock) SetAvailable(v int32) {
+	x.xxx_hidden_Available = v
+}
+
+type Stock_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId string
+	OnHand    int32
+	Reserved  int32
+	Available int32
+}
+
+func (b0 Stock_builder) Build() *Stock {
+	m0 := &Stock{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_OnHand = b.OnHand
+	x.xxx_hidden_Reserved = b.Reserved
+	x.xxx_hidden_Available = b.Available
+	return m0
+}
+
+// 価格スケジュール型の定義（期間限定の販売価格）, レスポンス用でありvalidationは緩い
+type PriceSchedule struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id           string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_ProductId    string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_Price        int32                  `protobuf:"varint,3,opt,name=price,proto3"`
+	xxx_hidden_RegularPrice int32                  `protobuf:"varint,4,opt,name=regular_price,json=regularPrice,proto3,oneof"`
+	xxx_hidden_Status       PriceScheduleStatus    `protobuf:"varint,5,opt,name=status,proto3,enum=common.v1.PriceScheduleStatus"`
+	xxx_hidden_StartsAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3"`
+	xxx_hidden_EndsAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *PriceSchedule) Reset() {
+	*x = PriceSchedule{}
+	mi := &file_common_v1_models_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceSchedule) ProtoMessage() {}
+
+func (x *PriceSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_models_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PriceSchedule) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *PriceSchedule) GetProductId() string {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return ""
+}
+
+func (x *PriceSchedule) GetPrice() int32 {
+	if x != nil {
+		return x.xxx_hidden_Price
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetRegularPrice() int32 {
+	if x != nil {
+		return x.xxx_hidden_RegularPrice
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetStatus() PriceScheduleStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return PriceScheduleStatus_PRICE_SCHEDULE_STATUS_UNSPECIFIED
+}
+
+func (x *PriceSchedule) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StartsAt
+	}
+	return nil
+}
+
+func (x *PriceSchedule) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_EndsAt
+	}
+	return nil
+}
+
+func (x *PriceSchedule) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *PriceSchedule) SetProductId(v string) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *PriceSchedule) SetPrice(v int32) {
+	x.xxx_hidden_Price = v
+}
+
+func (x *PriceSchedule) SetRegularPrice(v int32) {
+	x.xxx_hidden_RegularPrice = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+}
+
+func (x *PriceSchedule) SetStatus(v PriceScheduleStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *PriceSchedule) SetStartsAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_StartsAt = v
+}
+
+func (x *PriceSchedule) SetEndsAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_EndsAt = v
+}
+
+func (x *PriceSchedule) HasRegularPrice() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *PriceSchedule) HasStartsAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StartsAt != nil
+}
+
+func (x *PriceSchedule) HasEndsAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_EndsAt != nil
+}
+
+func (x *PriceSchedule) ClearRegularPrice() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RegularPrice = 0
+}
+
+func (x *PriceSchedule) ClearStartsAt() {
+	x.xxx_hidden_StartsAt = nil
+}
+
+func (x *PriceSchedule) ClearEndsAt() {
+	x.xxx_hidden_EndsAt = nil
+}
+
+type PriceSchedule_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id           string
+	ProductId    string
+	Price        int32
+	RegularPrice *int32
+	Status       PriceScheduleStatus
+	StartsAt     *timestamppb.Timestamp
+	EndsAt       *timestamppb.Timestamp
+}
+
+func (b0 PriceSchedule_builder) Build() *PriceSchedule {
+	m0 := &PriceSchedule{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_Price = b.Price
+	if b.RegularPrice != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_RegularPrice = *b.RegularPrice
+	}
+	x.xxx_hidden_Status = b.Status
+	x.xxx_hidden_StartsAt = b.StartsAt
+	x.xxx_hidden_EndsAt = b.EndsAt
 	return m0
 }
 
@@ -502,7 +1630,7 @@ var File_common_v1_models_proto protoreflect.FileDescriptor
 
 const file_common_v1_models_proto_rawDesc = "" +
 	"\n" +
-	"\x16common/v1/models.proto\x12\tcommon.v1\x1a\x1bbuf/validate/validate.proto\"?\n" +
+	"\x16common/v1/models.proto\x12\tcommon.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"?\n" +
 	"\n" +
 	"CategoryId\x121\n" +
 	"\x05value\x18\x01 \x01(\tB\x1b\xbaH\x18r\x16\x10\x01\x1822\x10^[a-zA-Z0-9_-]+$R\x05value\"/\n" +
@@ -514,36 +1642,144 @@ const file_common_v1_models_proto_rawDesc = "" +
 	"\x05value\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x05value\"-\n" +
 	"\fProductPrice\x12\x1d\n" +
-	"\x05value\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x05value\"@\n" +
+	"\x05value\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x05value\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xa8\x02\n" +
 	"\bCategory\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1b\n" +
-	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\"\xa1\x01\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12 \n" +
+	"\tparent_id\x18\x03 \x01(\tH\x00R\bparentId\x88\x01\x01\x12I\n" +
+	"\ftranslations\x18\x04 \x03(\v2%.common.v1.Category.TranslationsEntryR\ftranslations\x12\x16\n" +
+	"\x06locale\x18\x05 \x01(\tR\x06locale\x12\x12\n" +
+	"\x04slug\x18\x06 \x01(\tR\x04slug\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_parent_id\"\xdf\x06\n" +
 	"\aProduct\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1b\n" +
 	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12\x1d\n" +
 	"\x05price\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x05price\x124\n" +
-	"\bcategory\x18\x04 \x01(\v2\x13.common.v1.CategoryH\x00R\bcategory\x88\x01\x01B\v\n" +
-	"\t_categoryB\xb4\x01\n" +
+	"\bcategory\x18\x04 \x01(\v2\x13.common.v1.CategoryH\x00R\bcategory\x88\x01\x01\x12-\n" +
+	"\x12available_quantity\x18\x05 \x01(\x05R\x11availableQuantity\x12\x19\n" +
+	"\bin_stock\x18\x06 \x01(\bR\ainStock\x125\n" +
+	"\bvariants\x18\a \x03(\v2\x19.common.v1.ProductVariantR\bvariants\x12\"\n" +
+	"\x04tags\x18\b \x03(\v2\x0e.common.v1.TagR\x04tags\x120\n" +
+	"\ttax_class\x18\t \x01(\x0e2\x13.common.v1.TaxClassR\btaxClass\x12@\n" +
+	"\x13price_excluding_tax\x18\n" +
+	" \x01(\v2\x10.common.v1.MoneyR\x11priceExcludingTax\x12E\n" +
+	"\x13price_including_tax\x18\v \x01(\v2\x10.common.v1.MoneyH\x01R\x11priceIncludingTax\x88\x01\x01\x12H\n" +
+	"\ftranslations\x18\f \x03(\v2$.common.v1.Product.TranslationsEntryR\ftranslations\x12\x16\n" +
+	"\x06locale\x18\r \x01(\tR\x06locale\x120\n" +
+	"\x06status\x18\x0e \x01(\x0e2\x18.common.v1.ProductStatusR\x06status\x12A\n" +
+	"\x0fprice_schedules\x18\x0f \x03(\v2\x18.common.v1.PriceScheduleR\x0epriceSchedules\x12\x18\n" +
+	"\abarcode\x18\x10 \x01(\tR\abarcode\x12\x12\n" +
+	"\x04slug\x18\x11 \x01(\tR\x04slug\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\v\n" +
+	"\t_categoryB\x16\n" +
+	"\x14_price_including_tax\";\n" +
+	"\x03Tag\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\"O\n" +
+	"\rVariantOption\x12\x1d\n" +
+	"\x04axis\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x1eR\x04axis\x12\x1f\n" +
+	"\x05value\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x05value\"\xff\x01\n" +
+	"\x0eProductVariant\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x19\n" +
+	"\x03sku\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x03sku\x122\n" +
+	"\aoptions\x18\x03 \x03(\v2\x18.common.v1.VariantOptionR\aoptions\x12*\n" +
+	"\x0eprice_override\x18\x04 \x01(\x05H\x00R\rpriceOverride\x88\x01\x01\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x05R\x05price\x120\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x18.common.v1.VariantStatusR\x06statusB\x11\n" +
+	"\x0f_price_override\"\x82\x01\n" +
+	"\x05Stock\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tproductId\x12\x17\n" +
+	"\aon_hand\x18\x02 \x01(\x05R\x06onHand\x12\x1a\n" +
+	"\breserved\x18\x03 \x01(\x05R\breserved\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\x05R\tavailable\"\xc8\x02\n" +
+	"\rPriceSchedule\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12&\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tproductId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12(\n" +
+	"\rregular_price\x18\x04 \x01(\x05H\x00R\fregularPrice\x88\x01\x01\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1e.common.v1.PriceScheduleStatusR\x06status\x127\n" +
+	"\tstarts_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAtB\x10\n" +
+	"\x0e_regular_price*T\n" +
+	"\bTaxClass\x12\x19\n" +
+	"\x15TAX_CLASS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TAX_CLASS_STANDARD\x10\x01\x12\x15\n" +
+	"\x11TAX_CLASS_REDUCED\x10\x02*\xa6\x01\n" +
+	"\rProductStatus\x12\x1e\n" +
+	"\x1aPRODUCT_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PRODUCT_STATUS_DRAFT\x10\x01\x12\x1c\n" +
+	"\x18PRODUCT_STATUS_PUBLISHED\x10\x02\x12\x1c\n" +
+	"\x18PRODUCT_STATUS_SUSPENDED\x10\x03\x12\x1f\n" +
+	"\x1bPRODUCT_STATUS_DISCONTINUED\x10\x04*g\n" +
+	"\rVariantStatus\x12\x1e\n" +
+	"\x1aVARIANT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15VARIANT_STATUS_ACTIVE\x10\x01\x12\x1b\n" +
+	"\x17VARIANT_STATUS_INACTIVE\x10\x02*\xcd\x01\n" +
+	"\x13PriceScheduleStatus\x12%\n" +
+	"!PRICE_SCHEDULE_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fPRICE_SCHEDULE_STATUS_SCHEDULED\x10\x01\x12 \n" +
+	"\x1cPRICE_SCHEDULE_STATUS_ACTIVE\x10\x02\x12#\n" +
+	"\x1fPRICE_SCHEDULE_STATUS_COMPLETED\x10\x03\x12#\n" +
+	"\x1fPRICE_SCHEDULE_STATUS_CANCELLED\x10\x04B\xb4\x01\n" +
 	"\rcom.common.v1B\vModelsProtoP\x01ZQgithub.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1;commonv1\xa2\x02\x03CXX\xaa\x02\tCommon.V1\xca\x02\tCommon\\V1\xe2\x02\x15Common\\V1\\GPBMetadata\xea\x02\n" +
 	"Common::V1b\x06proto3"
 
-var file_common_v1_models_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_common_v1_models_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_common_v1_models_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_common_v1_models_proto_goTypes = []any{
-	(*CategoryId)(nil),   // 0: common.v1.CategoryId
-	(*CategoryName)(nil), // 1: common.v1.CategoryName
-	(*ProductId)(nil),    // 2: common.v1.ProductId
-	(*ProductName)(nil),  // 3: common.v1.ProductName
-	(*ProductPrice)(nil), // 4: common.v1.ProductPrice
-	(*Category)(nil),     // 5: common.v1.Category
-	(*Product)(nil),      // 6: common.v1.Product
+	(TaxClass)(0),                 // 0: common.v1.TaxClass
+	(ProductStatus)(0),            // 1: common.v1.ProductStatus
+	(VariantStatus)(0),            // 2: common.v1.VariantStatus
+	(PriceScheduleStatus)(0),      // 3: common.v1.PriceScheduleStatus
+	(*CategoryId)(nil),            // 4: common.v1.CategoryId
+	(*CategoryName)(nil),          // 5: common.v1.CategoryName
+	(*ProductId)(nil),             // 6: common.v1.ProductId
+	(*ProductName)(nil),           // 7: common.v1.ProductName
+	(*ProductPrice)(nil),          // 8: common.v1.ProductPrice
+	(*Money)(nil),                 // 9: common.v1.Money
+	(*Category)(nil),              // 10: common.v1.Category
+	(*Product)(nil),               // 11: common.v1.Product
+	(*Tag)(nil),                   // 12: common.v1.Tag
+	(*VariantOption)(nil),         // 13: common.v1.VariantOption
+	(*ProductVariant)(nil),        // 14: common.v1.ProductVariant
+	(*Stock)(nil),                 // 15: common.v1.Stock
+	(*PriceSchedule)(nil),         // 16: common.v1.PriceSchedule
+	nil,                           // 17: common.v1.Category.TranslationsEntry
+	nil,                           // 18: common.v1.Product.TranslationsEntry
+	(*timestamppb.Timestamp)(nil), // 19: google.protobuf.Timestamp
 }
 var file_common_v1_models_proto_depIdxs = []int32{
-	5, // 0: common.v1.Product.category:type_name -> common.v1.Category
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	17, // 0: common.v1.Category.translations:type_name -> common.v1.Category.TranslationsEntry
+	10, // 1: common.v1.Product.category:type_name -> common.v1.Category
+	14, // 2: common.v1.Product.variants:type_name -> common.v1.ProductVariant
+	12, // 3: common.v1.Product.tags:type_name -> common.v1.Tag
+	0,  // 4: common.v1.Product.tax_class:type_name -> common.v1.TaxClass
+	9,  // 5: common.v1.Product.price_excluding_tax:type_name -> common.v1.Money
+	9,  // 6: common.v1.Product.price_including_tax:type_name -> common.v1.Money
+	18, // 7: common.v1.Product.translations:type_name -> common.v1.Product.TranslationsEntry
+	1,  // 8: common.v1.Product.status:type_name -> common.v1.ProductStatus
+	16, // 9: common.v1.Product.price_schedules:type_name -> common.v1.PriceSchedule
+	13, // 10: common.v1.ProductVariant.options:type_name -> common.v1.VariantOption
+	2,  // 11: common.v1.ProductVariant.status:type_name -> common.v1.VariantStatus
+	3,  // 12: common.v1.PriceSchedule.status:type_name -> common.v1.PriceScheduleStatus
+	19, // 13: common.v1.PriceSchedule.starts_at:type_name -> google.protobuf.Timestamp
+	19, // 14: common.v1.PriceSchedule.ends_at:type_name -> google.protobuf.Timestamp
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_common_v1_models_proto_init() }
@@ -552,18 +1788,22 @@ func file_common_v1_models_proto_init() {
 		return
 	}
 	file_common_v1_models_proto_msgTypes[6].OneofWrappers = []any{}
+	file_common_v1_models_proto_msgTypes[7].OneofWrappers = []any{}
+	file_common_v1_models_proto_msgTypes[10].OneofWrappers = []any{}
+	file_common_v1_models_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_v1_models_proto_rawDesc), len(file_common_v1_models_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      4,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_common_v1_models_proto_goTypes,
 		DependencyIndexes: file_common_v1_models_proto_depIdxs,
+		EnumInfos:         file_common_v1_models_proto_enumTypes,
 		MessageInfos:      file_common_v1_models_proto_msgTypes,
 	}.Build()
 	File_common_v1_models_proto = out.File
@@ -438,27 +438,27 @@ func (*getCategoryByIdResponse_Category) isGetCategoryByIdResponse_Result() {}
 
 func (*getCategoryByIdResponse_Error) isGetCategoryByIdResponse_Result() {}
 
-// ProductService用のRequest/Response型
-type StreamProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetCategoryBySlugRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Slug string                 `protobuf:"bytes,1,opt,name=slug,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *StreamProductsRequest) Reset() {
-	*x = StreamProductsRequest{}
+func (x *GetCategoryBySlugRequest) Reset() {
+	*x = GetCategoryBySlugRequest{}
 	mi := &file_query_v1_query_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamProductsRequest) String() string {
+func (x *GetCategoryBySlugRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamProductsRequest) ProtoMessage() {}
+func (*GetCategoryBySlugRequest) ProtoMessage() {}
 
-func (x *StreamProductsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCategoryBySlugRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_query_v1_query_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -470,39 +470,54 @@ func (x *StreamProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-type StreamProductsRequest_builder struct {
+func (x *GetCategoryBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.xxx_hidden_Slug
+	}
+	return ""
+}
+
+func (x *GetCategoryBySlugRequest) SetSlug(v string) {
+	x.xxx_hidden_Slug = v
+}
+
+type GetCategoryBySlugRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	Slug string
 }
 
-func (b0 StreamProductsRequest_builder) Build() *StreamProductsRequest {
-	m0 := &StreamProductsRequest{}
+func (b0 GetCategoryBySlugRequest_builder) Build() *GetCategoryBySlugRequest {
+	m0 := &GetCategoryBySlugRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
+	x.xxx_hidden_Slug = b.Slug
 	return m0
 }
 
-type StreamProductsResponse struct {
-	state              protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Product *v1.Product            `protobuf:"bytes,1,opt,name=product,proto3"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetCategoryBySlugResponse struct {
+	state                protoimpl.MessageState             `protogen:"opaque.v1"`
+	xxx_hidden_Result    isGetCategoryBySlugResponse_Result `protobuf_oneof:"result"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp             `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	xxx_hidden_Moved     bool                               `protobuf:"varint,4,opt,name=moved,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *StreamProductsResponse) Reset() {
-	*x = StreamProductsResponse{}
+func (x *GetCategoryBySlugResponse) Reset() {
+	*x = GetCategoryBySlugResponse{}
 	mi := &file_query_v1_query_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamProductsResponse) String() string {
+func (x *GetCategoryBySlugResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamProductsResponse) ProtoMessage() {}
+func (*GetCategoryBySlugResponse) ProtoMessage() {}
 
-func (x *StreamProductsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCategoryBySlugResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_query_v1_query_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -514,63 +529,207 @@ func (x *StreamProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *StreamProductsResponse) GetProduct() *v1.Product {
+func (x *GetCategoryBySlugResponse) GetCategory() *v1.Category {
 	if x != nil {
-		return x.xxx_hidden_Product
+		if x, ok := x.xxx_hidden_Result.(*getCategoryBySlugResponse_Category); ok {
+			return x.Category
+		}
 	}
 	return nil
 }
 
-func (x *StreamProductsResponse) SetProduct(v *v1.Product) {
-	x.xxx_hidden_Product = v
+func (x *GetCategoryBySlugResponse) GetError() *v1.Error {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Result.(*getCategoryBySlugResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
 }
 
-func (x *StreamProductsResponse) HasProduct() bool {
+func (x *GetCategoryBySlugResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *GetCategoryBySlugResponse) GetMoved() bool {
+	if x != nil {
+		return x.xxx_hidden_Moved
+	}
+	return false
+}
+
+func (x *GetCategoryBySlugResponse) SetCategory(v *v1.Category) {
+	if v == nil {
+		x.xxx_hidden_Result = nil
+		return
+	}
+	x.xxx_hidden_Result = &getCategoryBySlugResponse_Category{v}
+}
+
+func (x *GetCategoryBySlugResponse) SetError(v *v1.Error) {
+	if v == nil {
+		x.xxx_hidden_Result = nil
+		return
+	}
+	x.xxx_hidden_Result = &getCategoryBySlugResponse_Error{v}
+}
+
+func (x *GetCategoryBySlugResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *GetCategoryBySlugResponse) SetMoved(v bool) {
+	x.xxx_hidden_Moved = v
+}
+
+func (x *GetCategoryBySlugResponse) HasResult() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Product != nil
+	return x.xxx_hidden_Result != nil
 }
 
-func (x *StreamProductsResponse) ClearProduct() {
-	x.xxx_hidden_Product = nil
+func (x *GetCategoryBySlugResponse) HasCategory() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Result.(*getCategoryBySlugResponse_Category)
+	return ok
 }
 
-type StreamProductsResponse_builder struct {
+func (x *GetCategoryBySlugResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Result.(*getCategoryBySlugResponse_Error)
+	return ok
+}
+
+func (x *GetCategoryBySlugResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *GetCategoryBySlugResponse) ClearResult() {
+	x.xxx_hidden_Result = nil
+}
+
+func (x *GetCategoryBySlugResponse) ClearCategory() {
+	if _, ok := x.xxx_hidden_Result.(*getCategoryBySlugResponse_Category); ok {
+		x.xxx_hidden_Result = nil
+	}
+}
+
+func (x *GetCategoryBySlugResponse) ClearError() {
+	if _, ok := x.xxx_hidden_Result.(*getCategoryBySlugResponse_Error); ok {
+		x.xxx_hidden_Result = nil
+	}
+}
+
+func (x *GetCategoryBySlugResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+const GetCategoryBySlugResponse_Result_not_set_case case_GetCategoryBySlugResponse_Result = 0
+const GetCategoryBySlugResponse_Category_case case_GetCategoryBySlugResponse_Result = 1
+const GetCategoryBySlugResponse_Error_case case_GetCategoryBySlugResponse_Result = 2
+
+func (x *GetCategoryBySlugResponse) WhichResult() case_GetCategoryBySlugResponse_Result {
+	if x == nil {
+		return GetCategoryBySlugResponse_Result_not_set_case
+	}
+	switch x.xxx_hidden_Result.(type) {
+	case *getCategoryBySlugResponse_Category:
+		return GetCategoryBySlugResponse_Category_case
+	case *getCategoryBySlugResponse_Error:
+		return GetCategoryBySlugResponse_Error_case
+	default:
+		return GetCategoryBySlugResponse_Result_not_set_case
+	}
+}
+
+type GetCategoryBySlugResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Product *v1.Product
+	// エラーか検索結果のいずれかを返す
+
+	// Fields of oneof xxx_hidden_Result:
+	Category *v1.Category
+	Error    *v1.Error
+	// -- end of xxx_hidden_Result
+	Timestamp *timestamppb.Timestamp
+	Moved     bool
 }
 
-func (b0 StreamProductsResponse_builder) Build() *StreamProductsResponse {
-	m0 := &StreamProductsResponse{}
+func (b0 GetCategoryBySlugResponse_builder) Build() *GetCategoryBySlugResponse {
+	m0 := &GetCategoryBySlugResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Product = b.Product
+	if b.Category != nil {
+		x.xxx_hidden_Result = &getCategoryBySlugResponse_Category{b.Category}
+	}
+	if b.Error != nil {
+		x.xxx_hidden_Result = &getCategoryBySlugResponse_Error{b.Error}
+	}
+	x.xxx_hidden_Timestamp = b.Timestamp
+	x.xxx_hidden_Moved = b.Moved
 	return m0
 }
 
-// TODO: ページネーション対応
-type ListProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type case_GetCategoryBySlugResponse_Result protoreflect.FieldNumber
+
+func (x case_GetCategoryBySlugResponse_Result) String() string {
+	md := file_query_v1_query_proto_msgTypes[5].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
+type isGetCategoryBySlugResponse_Result interface {
+	isGetCategoryBySlugResponse_Result()
+}
+
+type getCategoryBySlugResponse_Category struct {
+	Category *v1.Category `protobuf:"bytes,1,opt,name=category,proto3,oneof"` // 商品カテゴリ
+}
+
+type getCategoryBySlugResponse_Error struct {
+	Error *v1.Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー
+}
+
+func (*getCategoryBySlugResponse_Category) isGetCategoryBySlugResponse_Result() {}
+
+func (*getCategoryBySlugResponse_Error) isGetCategoryBySlugResponse_Result() {}
+
+type ListChildCategoriesRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ParentId    *string                `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListChildCategoriesRequest) Reset() {
+	*x = ListChildCategoriesRequest{}
 	mi := &file_query_v1_query_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *ListChildCategoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*ListChildCategoriesRequest) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListChildCategoriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_query_v1_query_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -582,41 +741,73 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-type ListProductsRequest_builder struct {
+func (x *ListChildCategoriesRequest) GetParentId() string {
+	if x != nil {
+		if x.xxx_hidden_ParentId != nil {
+			return *x.xxx_hidden_ParentId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListChildCategoriesRequest) SetParentId(v string) {
+	x.xxx_hidden_ParentId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *ListChildCategoriesRequest) HasParentId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListChildCategoriesRequest) ClearParentId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ParentId = nil
+}
+
+type ListChildCategoriesRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	ParentId *string
 }
 
-func (b0 ListProductsRequest_builder) Build() *ListProductsRequest {
-	m0 := &ListProductsRequest{}
+func (b0 ListChildCategoriesRequest_builder) Build() *ListChildCategoriesRequest {
+	m0 := &ListChildCategoriesRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
+	if b.ParentId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_ParentId = b.ParentId
+	}
 	return m0
 }
 
-type ListProductsResponse struct {
-	state                protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Products  *[]*v1.Product         `protobuf:"bytes,1,rep,name=products,proto3"`
-	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
-	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type ListChildCategoriesResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Categories *[]*v1.Category        `protobuf:"bytes,1,rep,name=categories,proto3"`
+	xxx_hidden_Error      *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
+func (x *ListChildCategoriesResponse) Reset() {
+	*x = ListChildCategoriesResponse{}
 	mi := &file_query_v1_query_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsResponse) String() string {
+func (x *ListChildCategoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (*ListChildCategoriesResponse) ProtoMessage() {}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListChildCategoriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_query_v1_query_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -628,102 +819,102 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListProductsResponse) GetProducts() []*v1.Product {
+func (x *ListChildCategoriesResponse) GetCategories() []*v1.Category {
 	if x != nil {
-		if x.xxx_hidden_Products != nil {
-			return *x.xxx_hidden_Products
+		if x.xxx_hidden_Categories != nil {
+			return *x.xxx_hidden_Categories
 		}
 	}
 	return nil
 }
 
-func (x *ListProductsResponse) GetError() *v1.Error {
+func (x *ListChildCategoriesResponse) GetError() *v1.Error {
 	if x != nil {
 		return x.xxx_hidden_Error
 	}
 	return nil
 }
 
-func (x *ListProductsResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *ListChildCategoriesResponse) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.xxx_hidden_Timestamp
 	}
 	return nil
 }
 
-func (x *ListProductsResponse) SetProducts(v []*v1.Product) {
-	x.xxx_hidden_Products = &v
+func (x *ListChildCategoriesResponse) SetCategories(v []*v1.Category) {
+	x.xxx_hidden_Categories = &v
 }
 
-func (x *ListProductsResponse) SetError(v *v1.Error) {
+func (x *ListChildCategoriesResponse) SetError(v *v1.Error) {
 	x.xxx_hidden_Error = v
 }
 
-func (x *ListProductsResponse) SetTimestamp(v *timestamppb.Timestamp) {
+func (x *ListChildCategoriesResponse) SetTimestamp(v *timestamppb.Timestamp) {
 	x.xxx_hidden_Timestamp = v
 }
 
-func (x *ListProductsResponse) HasError() bool {
+func (x *ListChildCategoriesResponse) HasError() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Error != nil
 }
 
-func (x *ListProductsResponse) HasTimestamp() bool {
+func (x *ListChildCategoriesResponse) HasTimestamp() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Timestamp != nil
 }
 
-func (x *ListProductsResponse) ClearError() {
+func (x *ListChildCategoriesResponse) ClearError() {
 	x.xxx_hidden_Error = nil
 }
 
-func (x *ListProductsResponse) ClearTimestamp() {
+func (x *ListChildCategoriesResponse) ClearTimestamp() {
 	x.xxx_hidden_Timestamp = nil
 }
 
-type ListProductsResponse_builder struct {
+type ListChildCategoriesResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Products  []*v1.Product
-	Error     *v1.Error
-	Timestamp *timestamppb.Timestamp
+	Categories []*v1.Category
+	Error      *v1.Error
+	Timestamp  *timestamppb.Timestamp
 }
 
-func (b0 ListProductsResponse_builder) Build() *ListProductsResponse {
-	m0 := &ListProductsResponse{}
+func (b0 ListChildCategoriesResponse_builder) Build() *ListChildCategoriesResponse {
+	m0 := &ListChildCategoriesResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Products = &b.Products
+	x.xxx_hidden_Categories = &b.Categories
 	x.xxx_hidden_Error = b.Error
 	x.xxx_hidden_Timestamp = b.Timestamp
 	return m0
 }
 
-type GetProductByIdRequest struct {
+type GetCategoryAncestorsRequest struct {
 	state         protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductByIdRequest) Reset() {
-	*x = GetProductByIdRequest{}
+func (x *GetCategoryAncestorsRequest) Reset() {
+	*x = GetCategoryAncestorsRequest{}
 	mi := &file_query_v1_query_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductByIdRequest) String() string {
+func (x *GetCategoryAncestorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductByIdRequest) ProtoMessage() {}
+func (*GetCategoryAncestorsRequest) ProtoMessage() {}
 
-func (x *GetProductByIdRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCategoryAncestorsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_query_v1_query_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -735,53 +926,54 @@ func (x *GetProductByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetProductByIdRequest) GetId() string {
+func (x *GetCategoryAncestorsRequest) GetId() string {
 	if x != nil {
 		return x.xxx_hidden_Id
 	}
 	return ""
 }
 
-func (x *GetProductByIdRequest) SetId(v string) {
+func (x *GetCategoryAncestorsRequest) SetId(v string) {
 	x.xxx_hidden_Id = v
 }
 
-type GetProductByIdRequest_builder struct {
+type GetCategoryAncestorsRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Id string
 }
 
-func (b0 GetProductByIdRequest_builder) Build() *GetProductByIdRequest {
-	m0 := &GetProductByIdRequest{}
+func (b0 GetCategoryAncestorsRequest_builder) Build() *GetCategoryAncestorsRequest {
+	m0 := &GetCategoryAncestorsRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_Id = b.Id
 	return m0
 }
 
-type GetProductByIdResponse struct {
-	state                protoimpl.MessageState          `protogen:"opaque.v1"`
-	xxx_hidden_Result    isGetProductByIdResponse_Result `protobuf_oneof:"result"`
-	xxx_hidden_Timestamp *timestamppb.Timestamp          `protobuf:"bytes,3,opt,name=timestamp,proto3"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type GetCategoryAncestorsResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Categories *[]*v1.Category        `protobuf:"bytes,1,rep,name=categories,proto3"`
+	xxx_hidden_Error      *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GetProductByIdResponse) Reset() {
-	*x = GetProductByIdResponse{}
+func (x *GetCategoryAncestorsResponse) Reset() {
+	*x = GetCategoryAncestorsResponse{}
 	mi := &file_query_v1_query_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductByIdResponse) String() string {
+func (x *GetCategoryAncestorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductByIdResponse) ProtoMessage() {}
+func (*GetCategoryAncestorsResponse) ProtoMessage() {}
 
-func (x *GetProductByIdResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCategoryAncestorsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_query_v1_query_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -793,193 +985,2616 @@ func (x *GetProductByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetProductByIdResponse) GetProduct() *v1.Product {
+func (x *GetCategoryAncestorsResponse) GetCategories() []*v1.Category {
 	if x != nil {
-		if x, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Product); ok {
-			return x.Product
+		if x.xxx_hidden_Categories != nil {
+			return *x.xxx_hidden_Categories
 		}
 	}
 	return nil
 }
 
-func (x *GetProductByIdResponse) GetError() *v1.Error {
+func (x *GetCategoryAncestorsResponse) GetError() *v1.Error {
 	if x != nil {
-		if x, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Error); ok {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *GetCategoryAncestorsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *GetCategoryAncestorsResponse) SetCategories(v []*v1.Category) {
+	x.xxx_hidden_Categories = &v
+}
+
+func (x *GetCategoryAncestorsResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *GetCategoryAncestorsResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *GetCategoryAncestorsResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *GetCategoryAncestorsResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *GetCategoryAncestorsResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *GetCategoryAncestorsResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type GetCategoryAncestorsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Categories []*v1.Category
+	Error      *v1.Error
+	Timestamp  *timestamppb.Timestamp
+}
+
+func (b0 GetCategoryAncestorsResponse_builder) Build() *GetCategoryAncestorsResponse {
+	m0 := &GetCategoryAncestorsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Categories = &b.Categories
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type GetCategorySubtreeRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategorySubtreeRequest) Reset() {
+	*x = GetCategorySubtreeRequest{}
+	mi := &file_query_v1_query_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategorySubtreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategorySubtreeRequest) ProtoMessage() {}
+
+func (x *GetCategorySubtreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCategorySubtreeRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetCategorySubtreeRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type GetCategorySubtreeRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 GetCategorySubtreeRequest_builder) Build() *GetCategorySubtreeRequest {
+	m0 := &GetCategorySubtreeRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type GetCategorySubtreeResponse struct {
+	state                protoimpl.MessageState              `protogen:"opaque.v1"`
+	xxx_hidden_Result    isGetCategorySubtreeResponse_Result `protobuf_oneof:"result"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp              `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetCategorySubtreeResponse) Reset() {
+	*x = GetCategorySubtreeResponse{}
+	mi := &file_query_v1_query_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategorySubtreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategorySubtreeResponse) ProtoMessage() {}
+
+func (x *GetCategorySubtreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCategorySubtreeResponse) GetRoot() *CategoryNode {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Result.(*getCategorySubtreeResponse_Root); ok {
+			return x.Root
+		}
+	}
+	return nil
+}
+
+func (x *GetCategorySubtreeResponse) GetError() *v1.Error {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Result.(*getCategorySubtreeResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *GetCategorySubtreeResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *GetCategorySubtreeResponse) SetRoot(v *CategoryNode) {
+	if v == nil {
+		x.xxx_hidden_Result = nil
+		return
+	}
+	x.xxx_hidden_Result = &getCategorySubtreeResponse_Root{v}
+}
+
+func (x *GetCategorySubtreeResponse) SetError(v *v1.Error) {
+	if v == nil {
+		x.xxx_hidden_Result = nil
+		return
+	}
+	x.xxx_hidden_Result = &getCategorySubtreeResponse_Error{v}
+}
+
+func (x *GetCategorySubtreeResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *GetCategorySubtreeResponse) HasResult() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Result != nil
+}
+
+func (x *GetCategorySubtreeResponse) HasRoot() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Result.(*getCategorySubtreeResponse_Root)
+	return ok
+}
+
+func (x *GetCategorySubtreeResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Result.(*getCategorySubtreeResponse_Error)
+	return ok
+}
+
+func (x *GetCategorySubtreeResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *GetCategorySubtreeResponse) ClearResult() {
+	x.xxx_hidden_Result = nil
+}
+
+func (x *GetCategorySubtreeResponse) ClearRoot() {
+	if _, ok := x.xxx_hidden_Result.(*getCategorySubtreeResponse_Root); ok {
+		x.xxx_hidden_Result = nil
+	}
+}
+
+func (x *GetCategorySubtreeResponse) ClearError() {
+	if _, ok := x.xxx_hidden_Result.(*getCategorySubtreeResponse_Error); ok {
+		x.xxx_hidden_Result = nil
+	}
+}
+
+func (x *GetCategorySubtreeResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+const GetCategorySubtreeResponse_Result_not_set_case case_GetCategorySubtreeResponse_Result = 0
+const GetCategorySubtreeResponse_Root_case case_GetCategorySubtreeResponse_Result = 1
+const GetCategorySubtreeResponse_Error_case case_GetCategorySubtreeResponse_Result = 2
+
+func (x *GetCategorySubtreeResponse) WhichResult() case_GetCategorySubtreeResponse_Result {
+	if x == nil {
+		return GetCategorySubtreeResponse_Result_not_set_case
+	}
+	switch x.xxx_hidden_Result.(type) {
+	case *getCategorySubtreeResponse_Root:
+		return GetCategorySubtreeResponse_Root_case
+	case *getCategorySubtreeResponse_Error:
+		return GetCategorySubtreeResponse_Error_case
+	default:
+		return GetCategorySubtreeResponse_Result_not_set_case
+	}
+}
+
+type GetCategorySubtreeResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// エラーか検索結果のいずれかを返す
+
+	// Fields of oneof xxx_hidden_Result:
+	Root  *CategoryNode
+	Error *v1.Error
+	// -- end of xxx_hidden_Result
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 GetCategorySubtreeResponse_builder) Build() *GetCategorySubtreeResponse {
+	m0 := &GetCategorySubtreeResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Root != nil {
+		x.xxx_hidden_Result = &getCategorySubtreeResponse_Root{b.Root}
+	}
+	if b.Error != nil {
+		x.xxx_hidden_Result = &getCategorySubtreeResponse_Error{b.Error}
+	}
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type case_GetCategorySubtreeResponse_Result protoreflect.FieldNumber
+
+func (x case_GetCategorySubtreeResponse_Result) String() string {
+	md := file_query_v1_query_proto_msgTypes[11].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isGetCategorySubtreeResponse_Result interface {
+	isGetCategorySubtreeResponse_Result()
+}
+
+type getCategorySubtreeResponse_Root struct {
+	Root *CategoryNode `protobuf:"bytes,1,opt,name=root,proto3,oneof"` // 起点のカテゴリを根とする部分木
+}
+
+type getCategorySubtreeResponse_Error struct {
+	Error *v1.Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー
+}
+
+func (*getCategorySubtreeResponse_Root) isGetCategorySubtreeResponse_Result() {}
+
+func (*getCategorySubtreeResponse_Error) isGetCategorySubtreeResponse_Result() {}
+
+// カテゴリ木のノード
+type CategoryNode struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Category *v1.Category           `protobuf:"bytes,1,opt,name=category,proto3"`
+	xxx_hidden_Children *[]*CategoryNode       `protobuf:"bytes,2,rep,name=children,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	mi := &file_query_v1_query_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CategoryNode) GetCategory() *v1.Category {
+	if x != nil {
+		return x.xxx_hidden_Category
+	}
+	return nil
+}
+
+func (x *CategoryNode) GetChildren() []*CategoryNode {
+	if x != nil {
+		if x.xxx_hidden_Children != nil {
+			return *x.xxx_hidden_Children
+		}
+	}
+	return nil
+}
+
+func (x *CategoryNode) SetCategory(v *v1.Category) {
+	x.xxx_hidden_Category = v
+}
+
+func (x *CategoryNode) SetChildren(v []*CategoryNode) {
+	x.xxx_hidden_Children = &v
+}
+
+func (x *CategoryNode) HasCategory() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Category != nil
+}
+
+func (x *CategoryNode) ClearCategory() {
+	x.xxx_hidden_Category = nil
+}
+
+type CategoryNode_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Category *v1.Category
+	Children []*CategoryNode
+}
+
+func (b0 CategoryNode_builder) Build() *CategoryNode {
+	m0 := &CategoryNode{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Category = b.Category
+	x.xxx_hidden_Children = &b.Children
+	return m0
+}
+
+// ProductService用のRequest/Response型
+type StreamProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamProductsRequest) Reset() {
+	*x = StreamProductsRequest{}
+	mi := &file_query_v1_query_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamProductsRequest) ProtoMessage() {}
+
+func (x *StreamProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type StreamProductsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 StreamProductsRequest_builder) Build() *StreamProductsRequest {
+	m0 := &StreamProductsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type StreamProductsResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product *v1.Product            `protobuf:"bytes,1,opt,name=product,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StreamProductsResponse) Reset() {
+	*x = StreamProductsResponse{}
+	mi := &file_query_v1_query_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamProductsResponse) ProtoMessage() {}
+
+func (x *StreamProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *StreamProductsResponse) GetProduct() *v1.Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *StreamProductsResponse) SetProduct(v *v1.Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *StreamProductsResponse) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *StreamProductsResponse) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+type StreamProductsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product *v1.Product
+}
+
+func (b0 StreamProductsResponse_builder) Build() *StreamProductsResponse {
+	m0 := &StreamProductsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	return m0
+}
+
+// TODO: ページネーション対応
+type ListProductsRequest struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CategoryId         *string                `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3,oneof"`
+	xxx_hidden_IncludeDescendants bool                   `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3"`
+	xxx_hidden_Tags               []string               `protobuf:"bytes,3,rep,name=tags,proto3"`
+	xxx_hidden_Locale             *string                `protobuf:"bytes,4,opt,name=locale,proto3,oneof"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_query_v1_query_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListProductsRequest) GetCategoryId() string {
+	if x != nil {
+		if x.xxx_hidden_CategoryId != nil {
+			return *x.xxx_hidden_CategoryId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeDescendants
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetLocale() string {
+	if x != nil {
+		if x.xxx_hidden_Locale != nil {
+			return *x.xxx_hidden_Locale
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) SetCategoryId(v string) {
+	x.xxx_hidden_CategoryId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *ListProductsRequest) SetIncludeDescendants(v bool) {
+	x.xxx_hidden_IncludeDescendants = v
+}
+
+func (x *ListProductsRequest) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
+func (x *ListProductsRequest) SetLocale(v string) {
+	x.xxx_hidden_Locale = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *ListProductsRequest) HasCategoryId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListProductsRequest) HasLocale() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ListProductsRequest) ClearCategoryId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CategoryId = nil
+}
+
+func (x *ListProductsRequest) ClearLocale() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Locale = nil
+}
+
+type ListProductsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CategoryId         *string
+	IncludeDescendants bool
+	Tags               []string
+	Locale             *string
+}
+
+func (b0 ListProductsRequest_builder) Build() *ListProductsRequest {
+	m0 := &ListProductsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CategoryId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_CategoryId = b.CategoryId
+	}
+	x.xxx_hidden_IncludeDescendants = b.IncludeDescendants
+	x.xxx_hidden_Tags = b.Tags
+	if b.Locale != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Locale = b.Locale
+	}
+	return m0
+}
+
+type ListProductsResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Products  *[]*v1.Product         `protobuf:"bytes,1,rep,name=products,proto3"`
+	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_query_v1_query_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListProductsResponse) GetProducts() []*v1.Product {
+	if x != nil {
+		if x.xxx_hidden_Products != nil {
+			return *x.xxx_hidden_Products
+		}
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) SetProducts(v []*v1.Product) {
+	x.xxx_hidden_Products = &v
+}
+
+func (x *ListProductsResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *ListProductsResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *ListProductsResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *ListProductsResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *ListProductsResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *ListProductsResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+type ListProductsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Products  []*v1.Product
+	Error     *v1.Error
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 ListProductsResponse_builder) Build() *ListProductsResponse {
+	m0 := &ListProductsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Products = &b.Products
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type GetProductByIdRequest struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Locale             *string                `protobuf:"bytes,2,opt,name=locale,proto3,oneof"`
+	xxx_hidden_IncludeUnpublished bool                   `protobuf:"varint,3,opt,name=include_unpublished,json=includeUnpublished,proto3"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *GetProductByIdRequest) Reset() {
+	*x = GetProductByIdRequest{}
+	mi := &file_query_v1_query_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByIdRequest) ProtoMessage() {}
+
+func (x *GetProductByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetProductByIdRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetProductByIdRequest) GetLocale() string {
+	if x != nil {
+		if x.xxx_hidden_Locale != nil {
+			return *x.xxx_hidden_Locale
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetProductByIdRequest) GetIncludeUnpublished() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeUnpublished
+	}
+	return false
+}
+
+func (x *GetProductByIdRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *GetProductByIdRequest) SetLocale(v string) {
+	x.xxx_hidden_Locale = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *GetProductByIdRequest) SetIncludeUnpublished(v bool) {
+	x.xxx_hidden_IncludeUnpublished = v
+}
+
+func (x *GetProductByIdRequest) HasLocale() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetProductByIdRequest) ClearLocale() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Locale = nil
+}
+
+type GetProductByIdRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id                 string
+	Locale             *string
+	IncludeUnpublished bool
+}
+
+func (b0 GetProductByIdRequest_builder) Build() *GetProductByIdRequest {
+	m0 := &GetProductByIdRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	if b.Locale != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Locale = b.Locale
+	}
+	x.xxx_hidden_IncludeUnpublished = b.IncludeUnpublished
+	return m0
+}
+
+type GetProductByIdResponse struct {
+	state                protoimpl.MessageState          `protogen:"opaque.v1"`
+	xxx_hidden_Result    isGetProductByIdResponse_Result `protobuf_oneof:"result"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp          `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetProductByIdResponse) Reset() {
+	*x = GetProductByIdResponse{}
+	mi := &file_query_v1_query_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByIdResponse) ProtoMessage() {}
+
+func (x *GetProductByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetProductByIdResponse) GetProduct() *v1.Product {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Product); ok {
+			return x.Product
+		}
+	}
+	return nil
+}
+
+func (x *GetProductByIdResponse) GetError() *v1.Error {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *GetProductByIdResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *GetProductByIdResponse) SetProduct(v *v1.Product) {
+	if v == nil {
+		x.xxx_hidden_Result = nil
+		return
+	}
+	x.xxx_hidden_Result = &getProductByIdResponse_Product{v}
+}
+
+func (x *GetProductByIdResponse) SetError(v *v1.Error) {
+	if v == nil {
+		x.xxx_hidden_Result = nil
+		return
+	}
+	x.xxx_hidden_Result = &getProductByIdResponse_Error{v}
+}
+
+func (x *GetProductByIdResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *GetProductByIdResponse) HasResult() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Result != nil
+}
+
+func (x *GetProductByIdResponse) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Product)
+	return ok
+}
+
+func (x *GetProductByIdResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Error)
+	return ok
+}
+
+func (x *GetProductByIdResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *GetProductByIdResponse) ClearResult() {
+	x.xxx_hidden_Result = nil
+}
+
+func (x *GetProductByIdResponse) ClearProduct() {
+	if _, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Product); ok {
+		x.xxx_hidden_Result = nil
+	}
+}
+
+func (x *GetProductByIdResponse) ClearError() {
+	if _, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Error); ok {
+		x.xxx_hidden_Result = nil
+	}
+}
+
+func (x *GetProductByIdResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+const GetProductByIdResponse_Result_not_set_case case_GetProductByIdResponse_Result = 0
+const GetProductByIdResponse_Product_case case_GetProductByIdResponse_Result = 1
+const GetProductByIdResponse_Error_case case_GetProductByIdResponse_Result = 2
+
+func (x *GetProductByIdResponse) WhichResult() case_GetProductByIdResponse_Result {
+	if x == nil {
+		return GetProductByIdResponse_Result_not_set_case
+	}
+	switch x.xxx_hidden_Result.(type) {
+	case *getProductByIdResponse_Product:
+		return GetProductByIdResponse_Product_case
+	case *getProductByIdResponse_Error:
+		return GetProductByIdResponse_Error_case
+	default:
+		return GetProductByIdResponse_Result_not_set_case
+	}
+}
+
+type GetProductByIdResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// エラーか検索結果のいずれかを返す
+
+	// Fields of oneof xxx_hidden_Result:
+	Product *v1.Product
+	Error   *v1.Error
+	// -- end of xxx_hidden_Result
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 GetProductByIdResponse_builder) Build() *GetProductByIdResponse {
+	m0 := &GetProductByIdResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Product != nil {
+		x.xxx_hidden_Result = &getProductByIdResponse_Product{b.Product}
+	}
+	if b.Error != nil {
+		x.xxx_hidden_Result = &getProductByIdResponse_Error{b.Error}
+	}
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type case_GetProductByIdResponse_Result protoreflect.FieldNumber
+
+func (x case_GetProductByIdResponse_Result) String() string {
+	md := file_query_v1_query_proto_msgTypes[18].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isGetProductByIdResponse_Result interface {
+	isGetProductByIdResponse_Result()
+}
+
+type getProductByIdResponse_Product struct {
+	Product *v1.Product `protobuf:"bytes,1,opt,name=product,proto3,oneof"` // 検索結果
+}
+
+type getProductByIdResponse_Error struct {
+	Error *v1.Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // 検索エラー
+}
+
+func (*getProductByIdResponse_Product) isGetProductByIdResponse_Result() {}
+
+func (*getProductByIdResponse_Error) isGetProductByIdResponse_Result() {}
+
+type GetProductByBarcodeRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Barcode     string                 `protobuf:"bytes,1,opt,name=barcode,proto3"`
+	xxx_hidden_Locale      *string                `protobuf:"bytes,2,opt,name=locale,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetProductByBarcodeRequest) Reset() {
+	*x = GetProductByBarcodeRequest{}
+	mi := &file_query_v1_query_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByBarcodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByBarcodeRequest) ProtoMessage() {}
+
+func (x *GetProductByBarcodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetProductByBarcodeRequest) GetBarcode() string {
+	if x != nil {
+		return x.xxx_hidden_Barcode
+	}
+	return ""
+}
+
+func (x *GetProductByBarcodeRequest) GetLocale() string {
+	if x != nil {
+		if x.xxx_hidden_Locale != nil {
+			return *x.xxx_hidden_Locale
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetProductByBarcodeRequest) SetBarcode(v string) {
+	x.xxx_hidden_Barcode = v
+}
+
+func (x *GetProductByBarcodeRequest) SetLocale(v string) {
+	x.xxx_hidden_Locale = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *GetProductByBarcodeRequest) HasLocale() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetProductByBarcodeRequest) ClearLocale() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Locale = nil
+}
+
+type GetProductByBarcodeRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Barcode string
+	Locale  *string
+}
+
+func (b0 GetProductByBarcodeRequest_builder) Build() *GetProductByBarcodeRequest {
+	m0 := &GetProductByBarcodeRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Barcode = b.Barcode
+	if b.Locale != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Locale = b.Locale
+	}
+	return m0
+}
+
+type GetProductByBarcodeResponse struct {
+	state                protoimpl.MessageState               `protogen:"opaque.v1"`
+	xxx_hidden_Result    isGetProductByBarcodeResponse_Result `protobuf_oneof:"result"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp               `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetProductByBarcodeResponse) Reset() {
+	*x = GetProductByBarcodeResponse{}
+	mi := &file_query_v1_query_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByBarcodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByBarcodeResponse) ProtoMessage() {}
+
+func (x *GetProductByBarcodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetProductByBarcodeResponse) GetProduct() *v1.Product {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Result.(*getProductByBarcodeResponse_Product); ok {
+			return x.Product
+		}
+	}
+	return nil
+}
+
+func (x *GetProductByBarcodeResponse) GetError() *v1.Error {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Result.(*getProductByBarcodeResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *GetProductByBarcodeResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *GetProductByBarcodeResponse) SetProduct(v *v1.Product) {
+	if v == nil {
+		x.xxx_hidden_Result = nil
+		return
+	}
+	x.xxx_hidden_Result = &getProductByBarcodeResponse_Product{v}
+}
+
+func (x *GetProductByBarcodeResponse) SetError(v *v1.Error) {
+	if v == nil {
+		x.xxx_hidden_Result = nil
+		return
+	}
+	x.xxx_hidden_Result = &getProductByBarcodeResponse_Error{v}
+}
+
+func (x *GetProductByBarcodeResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *GetProductByBarcodeResponse) HasResult() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Result != nil
+}
+
+func (x *GetProductByBarcodeResponse) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Result.(*getProductByBarcodeResponse_Product)
+	return ok
+}
+
+func (x *GetProductByBarcodeResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Result.(*getProductByBarcodeResponse_Error)
+	return ok
+}
+
+func (x *GetProductByBarcodeResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *GetProductByBarcodeResponse) ClearResult() {
+	x.xxx_hidden_Result = nil
+}
+
+func (x *GetProductByBarcodeResponse) ClearProduct() {
+	if _, ok := x.xxx_hidden_Result.(*getProductByBarcodeResponse_Product); ok {
+		x.xxx_hidden_Result = nil
+	}
+}
+
+func (x *GetProductByBarcodeResponse) ClearError() {
+	if _, ok := x.xxx_hidden_Result.(*getProductByBarcodeResponse_Error); ok {
+		x.xxx_hidden_Result = nil
+	}
+}
+
+func (x *GetProductByBarcodeResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+const GetProductByBarcodeResponse_Result_not_set_case case_GetProductByBarcodeResponse_Result = 0
+const GetProductByBarcodeResponse_Product_case case_GetProductByBarcodeResponse_Result = 1
+const GetProductByBarcodeResponse_Error_case case_GetProductByBarcodeResponse_Result = 2
+
+func (x *GetProductByBarcodeResponse) WhichResult() case_GetProductByBarcodeResponse_Result {
+	if x == nil {
+		return GetProductByBarcodeResponse_Result_not_set_case
+	}
+	switch x.xxx_hidden_Result.(type) {
+	case *getProductByBarcodeResponse_Product:
+		return GetProductByBarcodeResponse_Product_case
+	case *getProductByBarcodeResponse_Error:
+		return GetProductByBarcodeResponse_Error_case
+	default:
+		return GetProductByBarcodeResponse_Result_not_set_case
+	}
+}
+
+type GetProductByBarcodeResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// エラーか検索結果のいずれかを返す
+
+	// Fields of oneof xxx_hidden_Result:
+	Product *v1.Product
+	Error   *v1.Error
+	// -- end of xxx_hidden_Result
+	Timestamp *timestamppb.Timestamp
+}
+
+func (b0 GetProductByBarcodeResponse_builder) Build() *GetProductByBarcodeResponse {
+	m0 := &GetProductByBarcodeResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Product != nil {
+		x.xxx_hidden_Result = &getProductByBarcodeResponse_Product{b.Product}
+	}
+	if b.Error != nil {
+		x.xxx_hidden_Result = &getProductByBarcodeResponse_Error{b.Error}
+	}
+	x.xxx_hidden_Timestamp = b.Timestamp
+	return m0
+}
+
+type case_GetProductByBarcodeResponse_Result protoreflect.FieldNumber
+
+func (x case_GetProductByBarcodeResponse_Result) String() string {
+	md := file_query_v1_query_proto_msgTypes[20].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isGetProductByBarcodeResponse_Result interface {
+	isGetProductByBarcodeResponse_Result()
+}
+
+type getProductByBarcodeResponse_Product struct {
+	Product *v1.Product `protobuf:"bytes,1,opt,name=product,proto3,oneof"` // 検索結果
+}
+
+type getProductByBarcodeResponse_Error struct {
+	Error *v1.Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // 検索エラー
+}
+
+func (*getProductByBarcodeResponse_Product) isGetProductByBarcodeResponse_Result() {}
+
+func (*getProductByBarcodeResponse_Error) isGetProductByBarcodeResponse_Result() {}
+
+type GetProductBySlugRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Slug        string                 `protobuf:"bytes,1,opt,name=slug,proto3"`
+	xxx_hidden_Locale      *string                `protobuf:"bytes,2,opt,name=locale,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetProductBySlugRequest) Reset() {
+	*x = GetProductBySlugRequest{}
+	mi := &file_query_v1_query_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySlugRequest) ProtoMessage() {}
+
+func (x *GetProductBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetProductBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.xxx_hidden_Slug
+	}
+	return ""
+}
+
+func (x *GetProductBySlugRequest) GetLocale() string {
+	if x != nil {
+		if x.xxx_hidden_Locale != nil {
+			return *x.xxx_hidden_Locale
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetProductBySlugRequest) SetSlug(v string) {
+	x.xxx_hidden_Slug = v
+}
+
+func (x *GetProductBySlugRequest) SetLocale(v string) {
+	x.xxx_hidden_Locale = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *GetProductBySlugRequest) HasLocale() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetProductBySlugRequest) ClearLocale() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Locale = nil
+}
+
+type GetProductBySlugRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Slug   string
+	Locale *string
+}
+
+func (b0 GetProductBySlugRequest_builder) Build() *GetProductBySlugRequest {
+	m0 := &GetProductBySlugRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Slug = b.Slug
+	if b.Locale != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Locale = b.Locale
+	}
+	return m0
+}
+
+type GetProductBySlugResponse struct {
+	state                protoimpl.MessageState            `protogen:"opaque.v1"`
+	xxx_hidden_Result    isGetProductBySlugResponse_Result `protobuf_oneof:"result"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp            `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	xxx_hidden_Moved     bool                              `protobuf:"varint,4,opt,name=moved,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetProductBySlugResponse) Reset() {
+	*x = GetProductBySlugResponse{}
+	mi := &file_query_v1_query_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySlugResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySlugResponse) ProtoMessage() {}
+
+func (x *GetProductBySlugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetProductBySlugResponse) GetProduct() *v1.Product {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Result.(*getProductBySlugResponse_Product); ok {
+			return x.Product
+		}
+	}
+	return nil
+}
+
+func (x *GetProductBySlugResponse) GetError() *v1.Error {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Result.(*getProductBySlugResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *GetProductBySlugResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *GetProductBySlugResponse) GetMoved() bool {
+	if x != nil {
+		return x.xxx_hidden_Moved
+	}
+	return false
+}
+
+func (x *GetProductBySlugResponse) SetProduct(v *v1.Product) {
+	if v == nil {
+		x.xxx_hidden_Result = nil
+		return
+	}
+	x.xxx_hidden_Result = &getProductBySlugResponse_Product{v}
+}
+
+func (x *GetProductBySlugResponse) SetError(v *v1.Error) {
+	if v == nil {
+		x.xxx_hidden_Result = nil
+		return
+	}
+	x.xxx_hidden_Result = &getProductBySlugResponse_Error{v}
+}
+
+func (x *GetProductBySlugResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *GetProductBySlugResponse) SetMoved(v bool) {
+	x.xxx_hidden_Moved = v
+}
+
+func (x *GetProductBySlugResponse) HasResult() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Result != nil
+}
+
+func (x *GetProductBySlugResponse) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Result.(*getProductBySlugResponse_Product)
+	return ok
+}
+
+func (x *GetProductBySlugResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Result.(*getProductBySlugResponse_Error)
+	return ok
+}
+
+func (x *GetProductBySlugResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *GetProductBySlugResponse) ClearResult() {
+	x.xxx_hidden_Result = nil
+}
+
+func (x *GetProductBySlugResponse) ClearProduct() {
+	if _, ok := x.xxx_hidden_Result.(*getProductBySlugResponse_Product); ok {
+		x.xxx_hidden_Result = nil
+	}
+}
+
+func (x *GetProductBySlugResponse) ClearError() {
+	if _, ok := x.xxx_hidden_Result.(*getProductBySlugResponse_Error); ok {
+		x.xxx_hidden_Result = nil
+	}
+}
+
+func (x *GetProductBySlugResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+const GetProductBySlugResponse_Result_not_set_case case_GetProductBySlugResponse_Result = 0
+const GetProductBySlugResponse_Product_case case_GetProductBySlugResponse_Result = 1
+const GetProductBySlugResponse_Error_case case_GetProductBySlugResponse_Result = 2
+
+func (x *GetProductBySlugResponse) WhichResult() case_GetProductBySlugResponse_Result {
+	if x == nil {
+		return GetProductBySlugResponse_Result_not_set_case
+	}
+	switch x.xxx_hidden_Result.(type) {
+	case *getProductBySlugResponse_Product:
+		return GetProductBySlugResponse_Product_case
+	case *getProductBySlugResponse_Error:
+		return GetProductBySlugResponse_Error_case
+	default:
+		return GetProductBySlugResponse_Result_not_set_case
+	}
+}
+
+type GetProductBySlugResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// エラーか検索結果のいずれかを返す
+
+	// Fields of oneof xxx_hidden_Result:
+	Product *v1.Product
+	Error   *v1.Error
+	// -- end of xxx_hidden_Result
+	Timestamp *timestamppb.Timestamp
+	Moved     bool
+}
+
+func (b0 GetProductBySlugResponse_builder) Build() *GetProductBySlugResponse {
+	m0 := &GetProductBySlugResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Product != nil {
+		x.xxx_hidden_Result = &getProductBySlugResponse_Product{b.Product}
+	}
+	if b.Error != nil {
+		x.xxx_hidden_Result = &getProductBySlugResponse_Error{b.Error}
+	}
+	x.xxx_hidden_Timestamp = b.Timestamp
+	x.xxx_hidden_Moved = b.Moved
+	return m0
+}
+
+type case_GetProductBySlugResponse_Result protoreflect.FieldNumber
+
+func (x case_GetProductBySlugResponse_Result) String() string {
+	md := file_query_v1_query_proto_msgTypes[22].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isGetProductBySlugResponse_Result interface {
+	isGetProductBySlugResponse_Result()
+}
+
+type getProductBySlugResponse_Product struct {
+	Product *v1.Product `protobuf:"bytes,1,opt,name=product,proto3,oneof"` // 検索結果
+}
+
+type getProductBySlugResponse_Error struct {
+	Error *v1.Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // 検索エラー
+}
+
+func (*getProductBySlugResponse_Product) isGetProductBySlugResponse_Result() {}
+
+func (*getProductBySlugResponse_Error) isGetProductBySlugResponse_Result() {}
+
+type SearchProductsByKeywordRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Keyword     string                 `protobuf:"bytes,1,opt,name=keyword,proto3"`
+	xxx_hidden_Locale      *string                `protobuf:"bytes,2,opt,name=locale,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SearchProductsByKeywordRequest) Reset() {
+	*x = SearchProductsByKeywordRequest{}
+	mi := &file_query_v1_query_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsByKeywordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsByKeywordRequest) ProtoMessage() {}
+
+func (x *SearchProductsByKeywordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchProductsByKeywordRequest) GetKeyword() string {
+	if x != nil {
+		return x.xxx_hidden_Keyword
+	}
+	return ""
+}
+
+func (x *SearchProductsByKeywordRequest) GetLocale() string {
+	if x != nil {
+		if x.xxx_hidden_Locale != nil {
+			return *x.xxx_hidden_Locale
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SearchProductsByKeywordRequest) SetKeyword(v string) {
+	x.xxx_hidden_Keyword = v
+}
+
+func (x *SearchProductsByKeywordRequest) SetLocale(v string) {
+	x.xxx_hidden_Locale = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *SearchProductsByKeywordRequest) HasLocale() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SearchProductsByKeywordRequest) ClearLocale() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Locale = nil
+}
+
+type SearchProductsByKeywordRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Keyword string
+	Locale  *string
+}
+
+func (b0 SearchProductsByKeywordRequest_builder) Build() *SearchProductsByKeywordRequest {
+	m0 := &SearchProductsByKeywordRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Keyword = b.Keyword
+	if b.Locale != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Locale = b.Locale
+	}
+	return m0
+}
+
+type SearchProductsByKeywordResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Products  *[]*v1.Product         `protobuf:"bytes,1,rep,name=products,proto3"`
+	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	xxx_hidden_Hits      *[]*SearchHit          `protobuf:"bytes,4,rep,name=hits,proto3"`
+	xxx_hidden_Facets    *SearchFacets          `protobuf:"bytes,5,opt,name=facets,proto3"`
+	xxx_hidden_Fallback  bool                   `protobuf:"varint,6,opt,name=fallback,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SearchProductsByKeywordResponse) Reset() {
+	*x = SearchProductsByKeywordResponse{}
+	mi := &file_query_v1_query_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsByKeywordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsByKeywordResponse) ProtoMessage() {}
+
+func (x *SearchProductsByKeywordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchProductsByKeywordResponse) GetProducts() []*v1.Product {
+	if x != nil {
+		if x.xxx_hidden_Products != nil {
+			return *x.xxx_hidden_Products
+		}
+	}
+	return nil
+}
+
+func (x *SearchProductsByKeywordResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *SearchProductsByKeywordResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Timestamp
+	}
+	return nil
+}
+
+func (x *SearchProductsByKeywordResponse) GetHits() []*SearchHit {
+	if x != nil {
+		if x.xxx_hidden_Hits != nil {
+			return *x.xxx_hidden_Hits
+		}
+	}
+	return nil
+}
+
+func (x *SearchProductsByKeywordResponse) GetFacets() *SearchFacets {
+	if x != nil {
+		return x.xxx_hidden_Facets
+	}
+	return nil
+}
+
+func (x *SearchProductsByKeywordResponse) GetFallback() bool {
+	if x != nil {
+		return x.xxx_hidden_Fallback
+	}
+	return false
+}
+
+func (x *SearchProductsByKeywordResponse) SetProducts(v []*v1.Product) {
+	x.xxx_hidden_Products = &v
+}
+
+func (x *SearchProductsByKeywordResponse) SetError(v *v1.Error) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *SearchProductsByKeywordResponse) SetTimestamp(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Timestamp = v
+}
+
+func (x *SearchProductsByKeywordResponse) SetHits(v []*SearchHit) {
+	x.xxx_hidden_Hits = &v
+}
+
+func (x *SearchProductsByKeywordResponse) SetFacets(v *SearchFacets) {
+	x.xxx_hidden_Facets = v
+}
+
+func (x *SearchProductsByKeywordResponse) SetFallback(v bool) {
+	x.xxx_hidden_Fallback = v
+}
+
+func (x *SearchProductsByKeywordResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *SearchProductsByKeywordResponse) HasTimestamp() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timestamp != nil
+}
+
+func (x *SearchProductsByKeywordResponse) HasFacets() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Facets != nil
+}
+
+func (x *SearchProductsByKeywordResponse) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+func (x *SearchProductsByKeywordResponse) ClearTimestamp() {
+	x.xxx_hidden_Timestamp = nil
+}
+
+func (x *SearchProductsByKeywordResponse) ClearFacets() {
+	x.xxx_hidden_Facets = nil
+}
+
+type SearchProductsByKeywordResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Products  []*v1.Product
+	Error     *v1.Error
+	Timestamp *timestamppb.Timestamp
+	Hits      []*SearchHit
+	Facets    *SearchFacets
+	Fallback  bool
+}
+
+func (b0 SearchProductsByKeywordResponse_builder) Build() *SearchProductsByKeywordResponse {
+	m0 := &SearchProductsByKeywordResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Products = &b.Products
+	x.xxx_hidden_Error = b.Error
+	x.xxx_hidden_Timestamp = b.Timestamp
+	x.xxx_hidden_Hits = &b.Hits
+	x.xxx_hidden_Facets = b.Facets
+	x.xxx_hidden_Fallback = b.Fallback
+	return m0
+}
+
+// 全文検索のヒット
+type SearchHit struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product    *v1.Product            `protobuf:"bytes,1,opt,name=product,proto3"`
+	xxx_hidden_Score      float64                `protobuf:"fixed64,2,opt,name=score,proto3"`
+	xxx_hidden_Highlights []string               `protobuf:"bytes,3,rep,name=highlights,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_query_v1_query_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchHit) GetProduct() *v1.Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.xxx_hidden_Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetHighlights() []string {
+	if x != nil {
+		return x.xxx_hidden_Highlights
+	}
+	return nil
+}
+
+func (x *SearchHit) SetProduct(v *v1.Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *SearchHit) SetScore(v float64) {
+	x.xxx_hidden_Score = v
+}
+
+func (x *SearchHit) SetHighlights(v []string) {
+	x.xxx_hidden_Highlights = v
+}
+
+func (x *SearchHit) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *SearchHit) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+type SearchHit_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product    *v1.Product
+	Score      float64
+	Highlights []string
+}
+
+func (b0 SearchHit_builder) Build() *SearchHit {
+	m0 := &SearchHit{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Score = b.Score
+	x.xxx_hidden_Highlights = b.Highlights
+	return m0
+}
+
+// ファセットの集計値
+type FacetCount struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Key   string                 `protobuf:"bytes,1,opt,name=key,proto3"`
+	xxx_hidden_Label string                 `protobuf:"bytes,2,opt,name=label,proto3"`
+	xxx_hidden_Count int32                  `protobuf:"varint,3,opt,name=count,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_query_v1_query_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FacetCount) GetKey() string {
+	if x != nil {
+		return x.xxx_hidden_Key
+	}
+	return ""
+}
+
+func (x *FacetCount) GetLabel() string {
+	if x != nil {
+		return x.xxx_hidden_Label
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_Count
+	}
+	return 0
+}
+
+func (x *FacetCount) SetKey(v string) {
+	x.xxx_hidden_Key = v
+}
+
+func (x *FacetCount) SetLabel(v string) {
+	x.xxx_hidden_Label = v
+}
+
+func (x *FacetCount) SetCount(v int32) {
+	x.xxx_hidden_Count = v
+}
+
+type FacetCount_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Key   string
+	Label string
+	Count int32
+}
+
+func (b0 FacetCount_builder) Build() *FacetCount {
+	m0 := &FacetCount{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Key = b.Key
+	x.xxx_hidden_Label = b.Label
+	x.xxx_hidden_Count = b.Count
+	return m0
+}
+
+// 検索結果のファセット
+type SearchFacets struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Categories *[]*FacetCount         `protobuf:"bytes,1,rep,name=categories,proto3"`
+	xxx_hidden_PriceBands *[]*FacetCount         `protobuf:"bytes,2,rep,name=price_bands,json=priceBands,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SearchFacets) Reset() {
+	*x = SearchFacets{}
+	mi := &file_query_v1_query_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacets) ProtoMessage() {}
+
+func (x *SearchFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchFacets) GetCategories() []*FacetCount {
+	if x != nil {
+		if x.xxx_hidden_Categories != nil {
+			return *x.xxx_hidden_Categories
+		}
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetPriceBands() []*FacetCount {
+	if x != nil {
+		if x.xxx_hidden_PriceBands != nil {
+			return *x.xxx_hidden_PriceBands
+		}
+	}
+	return nil
+}
+
+func (x *SearchFacets) SetCategories(v []*FacetCount) {
+	x.xxx_hidden_Categories = &v
+}
+
+func (x *SearchFacets) SetPriceBands(v []*FacetCount) {
+	x.xxx_hidden_PriceBands = &v
+}
+
+type SearchFacets_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Categories []*FacetCount
+	PriceBands []*FacetCount
+}
+
+func (b0 SearchFacets_builder) Build() *SearchFacets {
+	m0 := &SearchFacets{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Categories = &b.Categories
+	x.xxx_hidden_PriceBands = &b.PriceBands
+	return m0
+}
+
+type SuggestProductsRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Prefix string                 `protobuf:"bytes,1,opt,name=prefix,proto3"`
+	xxx_hidden_Limit  int32                  `protobuf:"varint,2,opt,name=limit,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SuggestProductsRequest) Reset() {
+	*x = SuggestProductsRequest{}
+	mi := &file_query_v1_query_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestProductsRequest) ProtoMessage() {}
+
+func (x *SuggestProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SuggestProductsRequest) GetPrefix() string {
+	if x != nil {
+		return x.xxx_hidden_Prefix
+	}
+	return ""
+}
+
+func (x *SuggestProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *SuggestProductsRequest) SetPrefix(v string) {
+	x.xxx_hidden_Prefix = v
+}
+
+func (x *SuggestProductsRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+}
+
+type SuggestProductsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Prefix string
+	Limit  int32
+}
+
+func (b0 SuggestProductsRequest_builder) Build() *SuggestProductsRequest {
+	m0 := &SuggestProductsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Prefix = b.Prefix
+	x.xxx_hidden_Limit = b.Limit
+	return m0
+}
+
+type SuggestProductsResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Prefix      string                 `protobuf:"bytes,1,opt,name=prefix,proto3"`
+	xxx_hidden_Suggestions *[]*ProductSuggestion  `protobuf:"bytes,2,rep,name=suggestions,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SuggestProductsResponse) Reset() {
+	*x = SuggestProductsResponse{}
+	mi := &file_query_v1_query_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestProductsResponse) ProtoMessage() {}
+
+func (x *SuggestProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SuggestProductsResponse) GetPrefix() string {
+	if x != nil {
+		return x.xxx_hidden_Prefix
+	}
+	return ""
+}
+
+func (x *SuggestProductsResponse) GetSuggestions() []*ProductSuggestion {
+	if x != nil {
+		if x.xxx_hidden_Suggestions != nil {
+			return *x.xxx_hidden_Suggestions
+		}
+	}
+	return nil
+}
+
+func (x *SuggestProductsResponse) SetPrefix(v string) {
+	x.xxx_hidden_Prefix = v
+}
+
+func (x *SuggestProductsResponse) SetSuggestions(v []*ProductSuggestion) {
+	x.xxx_hidden_Suggestions = &v
+}
+
+type SuggestProductsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Prefix      string
+	Suggestions []*ProductSuggestion
+}
+
+func (b0 SuggestProductsResponse_builder) Build() *SuggestProductsResponse {
+	m0 := &SuggestProductsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Prefix = b.Prefix
+	x.xxx_hidden_Suggestions = &b.Suggestions
+	return m0
+}
+
+type GetStockRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetStockRequest) Reset() {
+	*x = GetStockRequest{}
+	mi := &file_query_v1_query_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockRequest) ProtoMessage() {}
+
+func (x *GetStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetStockRequest) GetProductId() string {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return ""
+}
+
+func (x *GetStockRequest) SetProductId(v string) {
+	x.xxx_hidden_ProductId = v
+}
+
+type GetStockRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId string
+}
+
+func (b0 GetStockRequest_builder) Build() *GetStockRequest {
+	m0 := &GetStockRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	return m0
+}
+
+type GetStockResponse struct {
+	state                protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Result    isGetStockResponse_Result `protobuf_oneof:"result"`
+	xxx_hidden_Timestamp *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=timestamp,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetStockResponse) Reset() {
+	*x = GetStockResponse{}
+	mi := &file_query_v1_query_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockResponse) ProtoMessage() {}
+
+func (x *GetStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetStockResponse) GetStock() *v1.Stock {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Result.(*getStockResponse_Stock); ok {
+			return x.Stock
+		}
+	}
+	return nil
+}
+
+func (x *GetStockResponse) GetError() *v1.Error {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Result.(*getStockResponse_Error); ok {
 			return x.Error
 		}
 	}
 	return nil
 }
 
-func (x *GetProductByIdResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *GetStockResponse) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.xxx_hidden_Timestamp
 	}
 	return nil
 }
 
-func (x *GetProductByIdResponse) SetProduct(v *v1.Product) {
+func (x *GetStockResponse) SetStock(v *v1.Stock) {
 	if v == nil {
 		x.xxx_hidden_Result = nil
 		return
 	}
-	x.xxx_hidden_Result = &getProductByIdResponse_Product{v}
+	x.xxx_hidden_Result = &getStockResponse_Stock{v}
 }
 
-func (x *GetProductByIdResponse) SetError(v *v1.Error) {
+func (x *GetStockResponse) SetError(v *v1.Error) {
 	if v == nil {
 		x.xxx_hidden_Result = nil
 		return
 	}
-	x.xxx_hidden_Result = &getProductByIdResponse_Error{v}
+	x.xxx_hidden_Result = &getStockResponse_Error{v}
 }
 
-func (x *GetProductByIdResponse) SetTimestamp(v *timestamppb.Timestamp) {
+func (x *GetStockResponse) SetTimestamp(v *timestamppb.Timestamp) {
 	x.xxx_hidden_Timestamp = v
 }
 
-func (x *GetProductByIdResponse) HasResult() bool {
+func (x *GetStockResponse) HasResult() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Result != nil
 }
 
-func (x *GetProductByIdResponse) HasProduct() bool {
+func (x *GetStockResponse) HasStock() bool {
 	if x == nil {
 		return false
 	}
-	_, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Product)
+	_, ok := x.xxx_hidden_Result.(*getStockResponse_Stock)
 	return ok
 }
 
-func (x *GetProductByIdResponse) HasError() bool {
+func (x *GetStockResponse) HasError() bool {
 	if x == nil {
 		return false
 	}
-	_, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Error)
+	_, ok := x.xxx_hidden_Result.(*getStockResponse_Error)
 	return ok
 }
 
-func (x *GetProductByIdResponse) HasTimestamp() bool {
+func (x *GetStockResponse) HasTimestamp() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Timestamp != nil
 }
 
-func (x *GetProductByIdResponse) ClearResult() {
+func (x *GetStockResponse) ClearResult() {
 	x.xxx_hidden_Result = nil
 }
 
-func (x *GetProductByIdResponse) ClearProduct() {
-	if _, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Product); ok {
+func (x *GetStockResponse) ClearStock() {
+	if _, ok := x.xxx_hidden_Result.(*getStockResponse_Stock); ok {
 		x.xxx_hidden_Result = nil
 	}
 }
 
-func (x *GetProductByIdResponse) ClearError() {
-	if _, ok := x.xxx_hidden_Result.(*getProductByIdResponse_Error); ok {
+func (x *GetStockResponse) ClearError() {
+	if _, ok := x.xxx_hidden_Result.(*getStockResponse_Error); ok {
 		x.xxx_hidden_Result = nil
 	}
 }
 
-func (x *GetProductByIdResponse) ClearTimestamp() {
+func (x *GetStockResponse) ClearTimestamp() {
 	x.xxx_hidden_Timestamp = nil
 }
 
-const GetProductByIdResponse_Result_not_set_case case_GetProductByIdResponse_Result = 0
-const GetProductByIdResponse_Product_case case_GetProductByIdResponse_Result = 1
-const GetProductByIdResponse_Error_case case_GetProductByIdResponse_Result = 2
+const GetStockResponse_Result_not_set_case case_GetStockResponse_Result = 0
+const GetStockResponse_Stock_case case_GetStockResponse_Result = 1
+const GetStockResponse_Error_case case_GetStockResponse_Result = 2
 
-func (x *GetProductByIdResponse) WhichResult() case_GetProductByIdResponse_Result {
+func (x *GetStockResponse) WhichResult() case_GetStockResponse_Result {
 	if x == nil {
-		return GetProductByIdResponse_Result_not_set_case
+		return GetStockResponse_Result_not_set_case
 	}
 	switch x.xxx_hidden_Result.(type) {
-	case *getProductByIdResponse_Product:
-		return GetProductByIdResponse_Product_case
-	case *getProductByIdResponse_Error:
-		return GetProductByIdResponse_Error_case
+	case *getStockResponse_Stock:
+		return GetStockResponse_Stock_case
+	case *getStockResponse_Error:
+		return GetStockResponse_Error_case
 	default:
-		return GetProductByIdResponse_Result_not_set_case
+		return GetStockResponse_Result_not_set_case
 	}
 }
 
-type GetProductByIdResponse_builder struct {
+type GetStockResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	// エラーか検索結果のいずれかを返す
 
 	// Fields of oneof xxx_hidden_Result:
-	Product *v1.Product
-	Error   *v1.Error
+	Stock *v1.Stock
+	Error *v1.Error
 	// -- end of xxx_hidden_Result
 	Timestamp *timestamppb.Timestamp
 }
 
-func (b0 GetProductByIdResponse_builder) Build() *GetProductByIdResponse {
-	m0 := &GetProductByIdResponse{}
+func (b0 GetStockResponse_builder) Build() *GetStockResponse {
+	m0 := &GetStockResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Product != nil {
-		x.xxx_hidden_Result = &getProductByIdResponse_Product{b.Product}
+	if b.Stock != nil {
+		x.xxx_hidden_Result = &getStockResponse_Stock{b.Stock}
 	}
 	if b.Error != nil {
-		x.xxx_hidden_Result = &getProductByIdResponse_Error{b.Error}
+		x.xxx_hidden_Result = &getStockResponse_Error{b.Error}
 	}
 	x.xxx_hidden_Timestamp = b.Timestamp
 	return m0
 }
 
-type case_GetProductByIdResponse_Result protoreflect.FieldNumber
+type case_GetStockResponse_Result protoreflect.FieldNumber
 
-func (x case_GetProductByIdResponse_Result) String() string {
-	md := file_query_v1_query_proto_msgTypes[9].Descriptor()
+func (x case_GetStockResponse_Result) String() string {
+	md := file_query_v1_query_proto_msgTypes[31].Descriptor()
 	if x == 0 {
 		return "not set"
 	}
 	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
 }
 
-type isGetProductByIdResponse_Result interface {
-	isGetProductByIdResponse_Result()
+type isGetStockResponse_Result interface {
+	isGetStockResponse_Result()
 }
 
-type getProductByIdResponse_Product struct {
-	Product *v1.Product `protobuf:"bytes,1,opt,name=product,proto3,oneof"` // 検索結果
+type getStockResponse_Stock struct {
+	Stock *v1.Stock `protobuf:"bytes,1,opt,name=stock,proto3,oneof"` // 在庫
 }
 
-type getProductByIdResponse_Error struct {
+type getStockResponse_Error struct {
 	Error *v1.Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // 検索エラー
 }
 
-func (*getProductByIdResponse_Product) isGetProductByIdResponse_Result() {}
+func (*getStockResponse_Stock) isGetStockResponse_Result() {}
 
-func (*getProductByIdResponse_Error) isGetProductByIdResponse_Result() {}
+func (*getStockResponse_Error) isGetStockResponse_Result() {}
 
-type SearchProductsByKeywordRequest struct {
-	state              protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Keyword string                 `protobuf:"bytes,1,opt,name=keyword,proto3"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// TagService用のRequest/Response型
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchProductsByKeywordRequest) Reset() {
-	*x = SearchProductsByKeywordRequest{}
-	mi := &file_query_v1_query_proto_msgTypes[10]
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_query_v1_query_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProductsByKeywordRequest) String() string {
+func (x *ListTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProductsByKeywordRequest) ProtoMessage() {}
+func (*ListTagsRequest) ProtoMessage() {}
 
-func (x *SearchProductsByKeywordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_query_v1_query_proto_msgTypes[10]
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,55 +3605,42 @@ func (x *SearchProductsByKeywordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *SearchProductsByKeywordRequest) GetKeyword() string {
-	if x != nil {
-		return x.xxx_hidden_Keyword
-	}
-	return ""
-}
-
-func (x *SearchProductsByKeywordRequest) SetKeyword(v string) {
-	x.xxx_hidden_Keyword = v
-}
-
-type SearchProductsByKeywordRequest_builder struct {
+type ListTagsRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Keyword string
 }
 
-func (b0 SearchProductsByKeywordRequest_builder) Build() *SearchProductsByKeywordRequest {
-	m0 := &SearchProductsByKeywordRequest{}
+func (b0 ListTagsRequest_builder) Build() *ListTagsRequest {
+	m0 := &ListTagsRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Keyword = b.Keyword
 	return m0
 }
 
-type SearchProductsByKeywordResponse struct {
+type ListTagsResponse struct {
 	state                protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Products  *[]*v1.Product         `protobuf:"bytes,1,rep,name=products,proto3"`
+	xxx_hidden_Tags      *[]*TagUsage           `protobuf:"bytes,1,rep,name=tags,proto3"`
 	xxx_hidden_Error     *v1.Error              `protobuf:"bytes,2,opt,name=error,proto3"`
 	xxx_hidden_Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
-func (x *SearchProductsByKeywordResponse) Reset() {
-	*x = SearchProductsByKeywordResponse{}
-	mi := &file_query_v1_query_proto_msgTypes[11]
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_query_v1_query_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProductsByKeywordResponse) String() string {
+func (x *ListTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProductsByKeywordResponse) ProtoMessage() {}
+func (*ListTagsResponse) ProtoMessage() {}
 
-func (x *SearchProductsByKeywordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_query_v1_query_proto_msgTypes[11]
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,81 +3651,289 @@ func (x *SearchProductsByKeywordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *SearchProductsByKeywordResponse) GetProducts() []*v1.Product {
+func (x *ListTagsResponse) GetTags() []*TagUsage {
 	if x != nil {
-		if x.xxx_hidden_Products != nil {
-			return *x.xxx_hidden_Products
+		if x.xxx_hidden_Tags != nil {
+			return *x.xxx_hidden_Tags
 		}
 	}
 	return nil
 }
 
-func (x *SearchProductsByKeywordResponse) GetError() *v1.Error {
+func (x *ListTagsResponse) GetError() *v1.Error {
 	if x != nil {
 		return x.xxx_hidden_Error
 	}
 	return nil
 }
 
-func (x *SearchProductsByKeywordResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *ListTagsResponse) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.xxx_hidden_Timestamp
 	}
 	return nil
 }
 
-func (x *SearchProductsByKeywordResponse) SetProducts(v []*v1.Product) {
-	x.xxx_hidden_Products = &v
+func (x *ListTagsResponse) SetTags(v []*TagUsage) {
+	x.xxx_hidden_Tags = &v
 }
 
-func (x *SearchProductsByKeywordResponse) SetError(v *v1.Error) {
+func (x *ListTagsResponse) SetError(v *v1.Error) {
 	x.xxx_hidden_Error = v
 }
 
-func (x *SearchProductsByKeywordResponse) SetTimestamp(v *timestamppb.Timestamp) {
+func (x *ListTagsResponse) SetTimestamp(v *timestamppb.Timestamp) {
 	x.xxx_hidden_Timestamp = v
 }
 
-func (x *SearchProductsByKeywordResponse) HasError() bool {
+func (x *ListTagsResponse) HasError() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Error != nil
 }
 
-func (x *SearchProductsByKeywordResponse) HasTimestamp() bool {
+func (x *ListTagsResponse) HasTimestamp() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Timestamp != nil
 }
 
-func (x *SearchProductsByKeywordResponse) ClearError() {
+func (x *ListTagsResponse) ClearError() {
 	x.xxx_hidden_Error = nil
 }
 
-func (x *SearchProductsByKeywordResponse) ClearTimestamp() {
+func (x *ListTagsResponse) ClearTimestamp() {
 	x.xxx_hidden_Timestamp = nil
 }
 
-type SearchProductsByKeywordResponse_builder struct {
+type ListTagsResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Products  []*v1.Product
+	Tags      []*TagUsage
 	Error     *v1.Error
 	Timestamp *timestamppb.Timestamp
 }
 
-func (b0 SearchProductsByKeywordResponse_builder) Build() *SearchProductsByKeywordResponse {
-	m0 := &SearchProductsByKeywordResponse{}
+func (b0 ListTagsResponse_builder) Build() *ListTagsResponse {
+	m0 := &ListTagsResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Products = &b.Products
+	x.xxx_hidden_Tags = &b.Tags
 	x.xxx_hidden_Error = b.Error
 	x.xxx_hidden_Timestamp = b.Timestamp
 	return m0
 }
 
+// タグと付与された商品数
+type TagUsage struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Tag          *v1.Tag                `protobuf:"bytes,1,opt,name=tag,proto3"`
+	xxx_hidden_ProductCount int32                  `protobuf:"varint,2,opt,name=product_count,json=productCount,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *TagUsage) Reset() {
+	*x = TagUsage{}
+	mi := &file_query_v1_query_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagUsage) ProtoMessage() {}
+
+func (x *TagUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TagUsage) GetTag() *v1.Tag {
+	if x != nil {
+		return x.xxx_hidden_Tag
+	}
+	return nil
+}
+
+func (x *TagUsage) GetProductCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_ProductCount
+	}
+	return 0
+}
+
+func (x *TagUsage) SetTag(v *v1.Tag) {
+	x.xxx_hidden_Tag = v
+}
+
+func (x *TagUsage) SetProductCount(v int32) {
+	x.xxx_hidden_ProductCount = v
+}
+
+func (x *TagUsage) HasTag() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Tag != nil
+}
+
+func (x *TagUsage) ClearTag() {
+	x.xxx_hidden_Tag = nil
+}
+
+type TagUsage_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Tag          *v1.Tag
+	ProductCount int32
+}
+
+func (b0 TagUsage_builder) Build() *TagUsage {
+	m0 := &TagUsage{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Tag = b.Tag
+	x.xxx_hidden_ProductCount = b.ProductCount
+	return m0
+}
+
+// 検索語のサジェスト
+type ProductSuggestion struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3"`
+	xxx_hidden_Name      string                 `protobuf:"bytes,2,opt,name=name,proto3"`
+	xxx_hidden_Highlight string                 `protobuf:"bytes,3,opt,name=highlight,proto3"`
+	xxx_hidden_Category  *v1.Category           `protobuf:"bytes,4,opt,name=category,proto3"`
+	xxx_hidden_Score     float64                `protobuf:"fixed64,5,opt,name=score,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ProductSuggestion) Reset() {
+	*x = ProductSuggestion{}
+	mi := &file_query_v1_query_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSuggestion) ProtoMessage() {}
+
+func (x *ProductSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_query_v1_query_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ProductSuggestion) GetProductId() string {
+	if x != nil {
+		return x.xxx_hidden_ProductId
+	}
+	return ""
+}
+
+func (x *ProductSuggestion) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *ProductSuggestion) GetHighlight() string {
+	if x != nil {
+		return x.xxx_hidden_Highlight
+	}
+	return ""
+}
+
+func (x *ProductSuggestion) GetCategory() *v1.Category {
+	if x != nil {
+		return x.xxx_hidden_Category
+	}
+	return nil
+}
+
+func (x *ProductSuggestion) GetScore() float64 {
+	if x != nil {
+		return x.xxx_hidden_Score
+	}
+	return 0
+}
+
+func (x *ProductSuggestion) SetProductId(v string) {
+	x.xxx_hidden_ProductId = v
+}
+
+func (x *ProductSuggestion) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *ProductSuggestion) SetHighlight(v string) {
+	x.xxx_hidden_Highlight = v
+}
+
+func (x *ProductSuggestion) SetCategory(v *v1.Category) {
+	x.xxx_hidden_Category = v
+}
+
+func (x *ProductSuggestion) SetScore(v float64) {
+	x.xxx_hidden_Score = v
+}
+
+func (x *ProductSuggestion) HasCategory() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Category != nil
+}
+
+func (x *ProductSuggestion) ClearCategory() {
+	x.xxx_hidden_Category = nil
+}
+
+type ProductSuggestion_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ProductId string
+	Name      string
+	Highlight string
+	Category  *v1.Category
+	Score     float64
+}
+
+func (b0 ProductSuggestion_builder) Build() *ProductSuggestion {
+	m0 := &ProductSuggestion{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ProductId = b.ProductId
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Highlight = b.Highlight
+	x.xxx_hidden_Category = b.Category
+	x.xxx_hidden_Score = b.Score
+	return m0
+}
+
 var File_query_v1_query_proto protoreflect.FileDescriptor
 
 const file_query_v1_query_proto_rawDesc = "" +
@@ -1142,92 +3952,297 @@ const file_query_v1_query_proto_rawDesc = "" +
 	"\bcategory\x18\x01 \x01(\v2\x13.common.v1.CategoryH\x00R\bcategory\x12(\n" +
 	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorH\x00R\x05error\x12@\n" +
 	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestampB\b\n" +
-	"\x06result\"\x17\n" +
+	"\x06result\"9\n" +
+	"\x18GetCategoryBySlugRequest\x12\x1d\n" +
+	"\x04slug\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04slug\"\xda\x01\n" +
+	"\x19GetCategoryBySlugResponse\x121\n" +
+	"\bcategory\x18\x01 \x01(\v2\x13.common.v1.CategoryH\x00R\bcategory\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorH\x00R\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\x12\x14\n" +
+	"\x05moved\x18\x04 \x01(\bR\x05movedB\b\n" +
+	"\x06result\"U\n" +
+	"\x1aListChildCategoriesRequest\x12)\n" +
+	"\tparent_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\bparentId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_id\"\xbc\x01\n" +
+	"\x1bListChildCategoriesResponse\x123\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x13.common.v1.CategoryR\n" +
+	"categories\x12&\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"6\n" +
+	"\x1bGetCategoryAncestorsRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\"\xbd\x01\n" +
+	"\x1cGetCategoryAncestorsResponse\x123\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x13.common.v1.CategoryR\n" +
+	"categories\x12&\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"4\n" +
+	"\x19GetCategorySubtreeRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\"\xc0\x01\n" +
+	"\x1aGetCategorySubtreeResponse\x12,\n" +
+	"\x04root\x18\x01 \x01(\v2\x16.query.v1.CategoryNodeH\x00R\x04root\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorH\x00R\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestampB\b\n" +
+	"\x06result\"s\n" +
+	"\fCategoryNode\x12/\n" +
+	"\bcategory\x18\x01 \x01(\v2\x13.common.v1.CategoryR\bcategory\x122\n" +
+	"\bchildren\x18\x02 \x03(\v2\x16.query.v1.CategoryNodeR\bchildren\"\x17\n" +
 	"\x15StreamProductsRequest\"F\n" +
 	"\x16StreamProductsResponse\x12,\n" +
-	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductR\aproduct\"\x15\n" +
-	"\x13ListProductsRequest\"\xb0\x01\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductR\aproduct\"\xfb\x01\n" +
+	"\x13ListProductsRequest\x12-\n" +
+	"\vcategory_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\n" +
+	"categoryId\x88\x01\x01\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\x12\"\n" +
+	"\x04tags\x18\x03 \x03(\tB\x0e\xbaH\v\x92\x01\b\x10\x14\"\x04r\x02\x10\x01R\x04tags\x12E\n" +
+	"\x06locale\x18\x04 \x01(\tB(\xbaH%r#2!^[A-Za-z]{2,3}([-_][A-Za-z]{2})?$H\x01R\x06locale\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\t\n" +
+	"\a_locale\"\xb0\x01\n" +
 	"\x14ListProductsResponse\x12.\n" +
 	"\bproducts\x18\x01 \x03(\v2\x12.common.v1.ProductR\bproducts\x12&\n" +
 	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"0\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"\xb3\x01\n" +
 	"\x15GetProductByIdRequest\x12\x17\n" +
-	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\"\xbe\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12E\n" +
+	"\x06locale\x18\x02 \x01(\tB(\xbaH%r#2!^[A-Za-z]{2,3}([-_][A-Za-z]{2})?$H\x00R\x06locale\x88\x01\x01\x12/\n" +
+	"\x13include_unpublished\x18\x03 \x01(\bR\x12includeUnpublishedB\t\n" +
+	"\a_locale\"\xbe\x01\n" +
 	"\x16GetProductByIdResponse\x12.\n" +
 	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductH\x00R\aproduct\x12(\n" +
 	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorH\x00R\x05error\x12@\n" +
 	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestampB\b\n" +
-	"\x06result\"C\n" +
+	"\x06result\"\xaa\x01\n" +
+	"\x1aGetProductByBarcodeRequest\x12:\n" +
+	"\abarcode\x18\x01 \x01(\tB \xbaH\x1dr\x1b2\x19^([0-9]{8}|[0-9]{12,13})$R\abarcode\x12E\n" +
+	"\x06locale\x18\x02 \x01(\tB(\xbaH%r#2!^[A-Za-z]{2,3}([-_][A-Za-z]{2})?$H\x00R\x06locale\x88\x01\x01B\t\n" +
+	"\a_locale\"\xc3\x01\n" +
+	"\x1bGetProductByBarcodeResponse\x12.\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductH\x00R\aproduct\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorH\x00R\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestampB\b\n" +
+	"\x06result\"\x8a\x01\n" +
+	"\x17GetProductBySlugRequest\x12\x1d\n" +
+	"\x04slug\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04slug\x12E\n" +
+	"\x06locale\x18\x02 \x01(\tB(\xbaH%r#2!^[A-Za-z]{2,3}([-_][A-Za-z]{2})?$H\x00R\x06locale\x88\x01\x01B\t\n" +
+	"\a_locale\"\xd6\x01\n" +
+	"\x18GetProductBySlugResponse\x12.\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductH\x00R\aproduct\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorH\x00R\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\x12\x14\n" +
+	"\x05moved\x18\x04 \x01(\bR\x05movedB\b\n" +
+	"\x06result\"\x95\x01\n" +
 	"\x1eSearchProductsByKeywordRequest\x12!\n" +
-	"\akeyword\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\akeyword\"\xbb\x01\n" +
+	"\akeyword\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\akeyword\x12E\n" +
+	"\x06locale\x18\x02 \x01(\tB(\xbaH%r#2!^[A-Za-z]{2,3}([-_][A-Za-z]{2})?$H\x00R\x06locale\x88\x01\x01B\t\n" +
+	"\a_locale\"\xb0\x02\n" +
 	"\x1fSearchProductsByKeywordResponse\x12.\n" +
 	"\bproducts\x18\x01 \x03(\v2\x12.common.v1.ProductR\bproducts\x12&\n" +
 	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp2\xbe\x01\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\x12'\n" +
+	"\x04hits\x18\x04 \x03(\v2\x13.query.v1.SearchHitR\x04hits\x12.\n" +
+	"\x06facets\x18\x05 \x01(\v2\x16.query.v1.SearchFacetsR\x06facets\x12\x1a\n" +
+	"\bfallback\x18\x06 \x01(\bR\bfallback\"o\n" +
+	"\tSearchHit\x12,\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.common.v1.ProductR\aproduct\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x1e\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\tR\n" +
+	"highlights\"J\n" +
+	"\n" +
+	"FacetCount\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"{\n" +
+	"\fSearchFacets\x124\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x14.query.v1.FacetCountR\n" +
+	"categories\x125\n" +
+	"\vprice_bands\x18\x02 \x03(\v2\x14.query.v1.FacetCountR\n" +
+	"priceBands\"Z\n" +
+	"\x16SuggestProductsRequest\x12\x1f\n" +
+	"\x06prefix\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18dR\x06prefix\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x14(\x00R\x05limit\"p\n" +
+	"\x17SuggestProductsResponse\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12=\n" +
+	"\vsuggestions\x18\x02 \x03(\v2\x1b.query.v1.ProductSuggestionR\vsuggestions\"9\n" +
+	"\x0fGetStockRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tproductId\"\xb2\x01\n" +
+	"\x10GetStockResponse\x12(\n" +
+	"\x05stock\x18\x01 \x01(\v2\x10.common.v1.StockH\x00R\x05stock\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorH\x00R\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestampB\b\n" +
+	"\x06result\"\x11\n" +
+	"\x0fListTagsRequest\"\xa4\x01\n" +
+	"\x10ListTagsResponse\x12&\n" +
+	"\x04tags\x18\x01 \x03(\v2\x12.query.v1.TagUsageR\x04tags\x12&\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.common.v1.ErrorR\x05error\x12@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xb2\x01\x00R\ttimestamp\"Q\n" +
+	"\bTagUsage\x12 \n" +
+	"\x03tag\x18\x01 \x01(\v2\x0e.common.v1.TagR\x03tag\x12#\n" +
+	"\rproduct_count\x18\x02 \x01(\x05R\fproductCount\"\xab\x01\n" +
+	"\x11ProductSuggestion\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\thighlight\x18\x03 \x01(\tR\thighlight\x12/\n" +
+	"\bcategory\x18\x04 \x01(\v2\x13.common.v1.CategoryR\bcategory\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score2\xc8\x04\n" +
 	"\x0fCategoryService\x12S\n" +
 	"\x0eListCategories\x12\x1f.query.v1.ListCategoriesRequest\x1a .query.v1.ListCategoriesResponse\x12V\n" +
-	"\x0fGetCategoryById\x12 .query.v1.GetCategoryByIdRequest\x1a!.query.v1.GetCategoryByIdResponse2\xfb\x02\n" +
+	"\x0fGetCategoryById\x12 .query.v1.GetCategoryByIdRequest\x1a!.query.v1.GetCategoryByIdResponse\x12\\\n" +
+	"\x11GetCategoryBySlug\x12\".query.v1.GetCategoryBySlugRequest\x1a#.query.v1.GetCategoryBySlugResponse\x12b\n" +
+	"\x13ListChildCategories\x12$.query.v1.ListChildCategoriesRequest\x1a%.query.v1.ListChildCategoriesResponse\x12e\n" +
+	"\x14GetCategoryAncestors\x12%.query.v1.GetCategoryAncestorsRequest\x1a&.query.v1.GetCategoryAncestorsResponse\x12_\n" +
+	"\x12GetCategorySubtree\x12#.query.v1.GetCategorySubtreeRequest\x1a$.query.v1.GetCategorySubtreeResponse2\xd9\x05\n" +
 	"\x0eProductService\x12U\n" +
 	"\x0eStreamProducts\x12\x1f.query.v1.StreamProductsRequest\x1a .query.v1.StreamProductsResponse0\x01\x12M\n" +
 	"\fListProducts\x12\x1d.query.v1.ListProductsRequest\x1a\x1e.query.v1.ListProductsResponse\x12S\n" +
-	"\x0eGetProductById\x12\x1f.query.v1.GetProductByIdRequest\x1a .query.v1.GetProductByIdResponse\x12n\n" +
-	"\x17SearchProductsByKeyword\x12(.query.v1.SearchProductsByKeywordRequest\x1a).query.v1.SearchProductsByKeywordResponseB\xac\x01\n" +
+	"\x0eGetProductById\x12\x1f.query.v1.GetProductByIdRequest\x1a .query.v1.GetProductByIdResponse\x12b\n" +
+	"\x13GetProductByBarcode\x12$.query.v1.GetProductByBarcodeRequest\x1a%.query.v1.GetProductByBarcodeResponse\x12Y\n" +
+	"\x10GetProductBySlug\x12!.query.v1.GetProductBySlugRequest\x1a\".query.v1.GetProductBySlugResponse\x12n\n" +
+	"\x17SearchProductsByKeyword\x12(.query.v1.SearchProductsByKeywordRequest\x1a).query.v1.SearchProductsByKeywordResponse\x12Z\n" +
+	"\x0fSuggestProducts\x12 .query.v1.SuggestProductsRequest\x1a!.query.v1.SuggestProductsResponse(\x010\x01\x12A\n" +
+	"\bGetStock\x12\x19.query.v1.GetStockRequest\x1a\x1a.query.v1.GetStockResponse2O\n" +
+	"\n" +
+	"TagService\x12A\n" +
+	"\bListTags\x12\x19.query.v1.ListTagsRequest\x1a\x1a.query.v1.ListTagsResponseB\xac\x01\n" +
 	"\fcom.query.v1B\n" +
 	"QueryProtoP\x01ZOgithub.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1;queryv1\xa2\x02\x03QXX\xaa\x02\bQuery.V1\xca\x02\bQuery\\V1\xe2\x02\x14Query\\V1\\GPBMetadata\xea\x02\tQuery::V1b\x06proto3"
 
-var file_query_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_query_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_query_v1_query_proto_goTypes = []any{
 	(*ListCategoriesRequest)(nil),           // 0: query.v1.ListCategoriesRequest
 	(*ListCategoriesResponse)(nil),          // 1: query.v1.ListCategoriesResponse
 	(*GetCategoryByIdRequest)(nil),          // 2: query.v1.GetCategoryByIdRequest
 	(*GetCategoryByIdResponse)(nil),         // 3: query.v1.GetCategoryByIdResponse
-	(*StreamProductsRequest)(nil),           // 4: query.v1.StreamProductsRequest
-	(*StreamProductsResponse)(nil),          // 5: query.v1.StreamProductsResponse
-	(*ListProductsRequest)(nil),             // 6: query.v1.ListProductsRequest
-	(*ListProductsResponse)(nil),            // 7: query.v1.ListProductsResponse
-	(*GetProductByIdRequest)(nil),           // 8: query.v1.GetProductByIdRequest
-	(*GetProductByIdResponse)(nil),          // 9: query.v1.GetProductByIdResponse
-	(*SearchProductsByKeywordRequest)(nil),  // 10: query.v1.SearchProductsByKeywordRequest
-	(*SearchProductsByKeywordResponse)(nil), // 11: query.v1.SearchProductsByKeywordResponse
-	(*v1.Category)(nil),                     // 12: common.v1.Category
-	(*v1.Error)(nil),                        // 13: common.v1.Error
-	(*timestamppb.Timestamp)(nil),           // 14: google.protobuf.Timestamp
-	(*v1.Product)(nil),                      // 15: common.v1.Product
+	(*GetCategoryBySlugRequest)(nil),        // 4: query.v1.GetCategoryBySlugRequest
+	(*GetCategoryBySlugResponse)(nil),       // 5: query.v1.GetCategoryBySlugResponse
+	(*ListChildCategoriesRequest)(nil),      // 6: query.v1.ListChildCategoriesRequest
+	(*ListChildCategoriesResponse)(nil),     // 7: query.v1.ListChildCategoriesResponse
+	(*GetCategoryAncestorsRequest)(nil),     // 8: query.v1.GetCategoryAncestorsRequest
+	(*GetCategoryAncestorsResponse)(nil),    // 9: query.v1.GetCategoryAncestorsResponse
+	(*GetCategorySubtreeRequest)(nil),       // 10: query.v1.GetCategorySubtreeRequest
+	(*GetCategorySubtreeResponse)(nil),      // 11: query.v1.GetCategorySubtreeResponse
+	(*CategoryNode)(nil),                    // 12: query.v1.CategoryNode
+	(*StreamProductsRequest)(nil),           // 13: query.v1.StreamProductsRequest
+	(*StreamProductsResponse)(nil),          // 14: query.v1.StreamProductsResponse
+	(*ListProductsRequest)(nil),             // 15: query.v1.ListProductsRequest
+	(*ListProductsResponse)(nil),            // 16: query.v1.ListProductsResponse
+	(*GetProductByIdRequest)(nil),           // 17: query.v1.GetProductByIdRequest
+	(*GetProductByIdResponse)(nil),          // 18: query.v1.GetProductByIdResponse
+	(*GetProductByBarcodeRequest)(nil),      // 19: query.v1.GetProductByBarcodeRequest
+	(*GetProductByBarcodeResponse)(nil),     // 20: query.v1.GetProductByBarcodeResponse
+	(*GetProductBySlugRequest)(nil),         // 21: query.v1.GetProductBySlugRequest
+	(*GetProductBySlugResponse)(nil),        // 22: query.v1.GetProductBySlugResponse
+	(*SearchProductsByKeywordRequest)(nil),  // 23: query.v1.SearchProductsByKeywordRequest
+	(*SearchProductsByKeywordResponse)(nil), // 24: query.v1.SearchProductsByKeywordResponse
+	(*SearchHit)(nil),                       // 25: query.v1.SearchHit
+	(*FacetCount)(nil),                      // 26: query.v1.FacetCount
+	(*SearchFacets)(nil),                    // 27: query.v1.SearchFacets
+	(*SuggestProductsRequest)(nil),          // 28: query.v1.SuggestProductsRequest
+	(*SuggestProductsResponse)(nil),         // 29: query.v1.SuggestProductsResponse
+	(*GetStockRequest)(nil),                 // 30: query.v1.GetStockRequest
+	(*GetStockResponse)(nil),                // 31: query.v1.GetStockResponse
+	(*ListTagsRequest)(nil),                 // 32: query.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),                // 33: query.v1.ListTagsResponse
+	(*TagUsage)(nil),                        // 34: query.v1.TagUsage
+	(*ProductSuggestion)(nil),               // 35: query.v1.ProductSuggestion
+	(*v1.Category)(nil),                     // 36: common.v1.Category
+	(*v1.Error)(nil),                        // 37: common.v1.Error
+	(*timestamppb.Timestamp)(nil),           // 38: google.protobuf.Timestamp
+	(*v1.Product)(nil),                      // 39: common.v1.Product
+	(*v1.Stock)(nil),                        // 40: common.v1.Stock
+	(*v1.Tag)(nil),                          // 41: common.v1.Tag
 }
 var file_query_v1_query_proto_depIdxs = []int32{
-	12, // 0: query.v1.ListCategoriesResponse.categories:type_name -> common.v1.Category
-	13, // 1: query.v1.ListCategoriesResponse.error:type_name -> common.v1.Error
-	14, // 2: query.v1.ListCategoriesResponse.timestamp:type_name -> google.protobuf.Timestamp
-	12, // 3: query.v1.GetCategoryByIdResponse.category:type_name -> common.v1.Category
-	13, // 4: query.v1.GetCategoryByIdResponse.error:type_name -> common.v1.Error
-	14, // 5: query.v1.GetCategoryByIdResponse.timestamp:type_name -> google.protobuf.Timestamp
-	15, // 6: query.v1.StreamProductsResponse.product:type_name -> common.v1.Product
-	15, // 7: query.v1.ListProductsResponse.products:type_name -> common.v1.Product
-	13, // 8: query.v1.ListProductsResponse.error:type_name -> common.v1.Error
-	14, // 9: query.v1.ListProductsResponse.timestamp:type_name -> google.protobuf.Timestamp
-	15, // 10: query.v1.GetProductByIdResponse.product:type_name -> common.v1.Product
-	13, // 11: query.v1.GetProductByIdResponse.error:type_name -> common.v1.Error
-	14, // 12: query.v1.GetProductByIdResponse.timestamp:type_name -> google.protobuf.Timestamp
-	15, // 13: query.v1.SearchProductsByKeywordResponse.products:type_name -> common.v1.Product
-	13, // 14: query.v1.SearchProductsByKeywordResponse.error:type_name -> common.v1.Error
-	14, // 15: query.v1.SearchProductsByKeywordResponse.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 16: query.v1.CategoryService.ListCategories:input_type -> query.v1.ListCategoriesRequest
-	2,  // 17: query.v1.CategoryService.GetCategoryById:input_type -> query.v1.GetCategoryByIdRequest
-	4,  // 18: query.v1.ProductService.StreamProducts:input_type -> query.v1.StreamProductsRequest
-	6,  // 19: query.v1.ProductService.ListProducts:input_type -> query.v1.ListProductsRequest
-	8,  // 20: query.v1.ProductService.GetProductById:input_type -> query.v1.GetProductByIdRequest
-	10, // 21: query.v1.ProductService.SearchProductsByKeyword:input_type -> query.v1.SearchProductsByKeywordRequest
-	1,  // 22: query.v1.CategoryService.ListCategories:output_type -> query.v1.ListCategoriesResponse
-	3,  // 23: query.v1.CategoryService.GetCategoryById:output_type -> query.v1.GetCategoryByIdResponse
-	5,  // 24: query.v1.ProductService.StreamProducts:output_type -> query.v1.StreamProductsResponse
-	7,  // 25: query.v1.ProductService.ListProducts:output_type -> query.v1.ListProductsResponse
-	9,  // 26: query.v1.ProductService.GetProductById:output_type -> query.v1.GetProductByIdResponse
-	11, // 27: query.v1.ProductService.SearchProductsByKeyword:output_type -> query.v1.SearchProductsByKeywordResponse
-	22, // [22:28] is the sub-list for method output_type
-	16, // [16:22] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	36, // 0: query.v1.ListCategoriesResponse.categories:type_name -> common.v1.Category
+	37, // 1: query.v1.ListCategoriesResponse.error:type_name -> common.v1.Error
+	38, // 2: query.v1.ListCategoriesResponse.timestamp:type_name -> google.protobuf.Timestamp
+	36, // 3: query.v1.GetCategoryByIdResponse.category:type_name -> common.v1.Category
+	37, // 4: query.v1.GetCategoryByIdResponse.error:type_name -> common.v1.Error
+	38, // 5: query.v1.GetCategoryByIdResponse.timestamp:type_name -> google.protobuf.Timestamp
+	36, // 6: query.v1.GetCategoryBySlugResponse.category:type_name -> common.v1.Category
+	37, // 7: query.v1.GetCategoryBySlugResponse.error:type_name -> common.v1.Error
+	38, // 8: query.v1.GetCategoryBySlugResponse.timestamp:type_name -> google.protobuf.Timestamp
+	36, // 9: query.v1.ListChildCategoriesResponse.categories:type_name -> common.v1.Category
+	37, // 10: query.v1.ListChildCategoriesResponse.error:type_name -> common.v1.Error
+	38, // 11: query.v1.ListChildCategoriesResponse.timestamp:type_name -> google.protobuf.Timestamp
+	36, // 12: query.v1.GetCategoryAncestorsResponse.categories:type_name -> common.v1.Category
+	37, // 13: query.v1.GetCategoryAncestorsResponse.error:type_name -> common.v1.Error
+	38, // 14: query.v1.GetCategoryAncestorsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	12, // 15: query.v1.GetCategorySubtreeResponse.root:type_name -> query.v1.CategoryNode
+	37, // 16: query.v1.GetCategorySubtreeResponse.error:type_name -> common.v1.Error
+	38, // 17: query.v1.GetCategorySubtreeResponse.timestamp:type_name -> google.protobuf.Timestamp
+	36, // 18: query.v1.CategoryNode.category:type_name -> common.v1.Category
+	12, // 19: query.v1.CategoryNode.children:type_name -> query.v1.CategoryNode
+	39, // 20: query.v1.StreamProductsResponse.product:type_name -> common.v1.Product
+	39, // 21: query.v1.ListProductsResponse.products:type_name -> common.v1.Product
+	37, // 22: query.v1.ListProductsResponse.error:type_name -> common.v1.Error
+	38, // 23: query.v1.ListProductsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	39, // 24: query.v1.GetProductByIdResponse.product:type_name -> common.v1.Product
+	37, // 25: query.v1.GetProductByIdResponse.error:type_name -> common.v1.Error
+	38, // 26: query.v1.GetProductByIdResponse.timestamp:type_name -> google.protobuf.Timestamp
+	39, // 27: query.v1.GetProductByBarcodeResponse.product:type_name -> common.v1.Product
+	37, // 28: query.v1.GetProductByBarcodeResponse.error:type_name -> common.v1.Error
+	38, // 29: query.v1.GetProductByBarcodeResponse.timestamp:type_name -> google.protobuf.Timestamp
+	39, // 30: query.v1.GetProductBySlugResponse.product:type_name -> common.v1.Product
+	37, // 31: query.v1.GetProductBySlugResponse.error:type_name -> common.v1.Error
+	38, // 32: query.v1.GetProductBySlugResponse.timestamp:type_name -> google.protobuf.Timestamp
+	39, // 33: query.v1.SearchProductsByKeywordResponse.products:type_name -> common.v1.Product
+	37, // 34: query.v1.SearchProductsByKeywordResponse.error:type_name -> common.v1.Error
+	38, // 35: query.v1.SearchProductsByKeywordResponse.timestamp:type_name -> google.protobuf.Timestamp
+	25, // 36: query.v1.SearchProductsByKeywordResponse.hits:type_name -> query.v1.SearchHit
+	27, // 37: query.v1.SearchProductsByKeywordResponse.facets:type_name -> query.v1.SearchFacets
+	39, // 38: query.v1.SearchHit.product:type_name -> common.v1.Product
+	26, // 39: query.v1.SearchFacets.categories:type_name -> query.v1.FacetCount
+	26, // 40: query.v1.SearchFacets.price_bands:type_name -> query.v1.FacetCount
+	35, // 41: query.v1.SuggestProductsResponse.suggestions:type_name -> query.v1.ProductSuggestion
+	40, // 42: query.v1.GetStockResponse.stock:type_name -> common.v1.Stock
+	37, // 43: query.v1.GetStockResponse.error:type_name -> common.v1.Error
+	38, // 44: query.v1.GetStockResponse.timestamp:type_name -> google.protobuf.Timestamp
+	34, // 45: query.v1.ListTagsResponse.tags:type_name -> query.v1.TagUsage
+	37, // 46: query.v1.ListTagsResponse.error:type_name -> common.v1.Error
+	38, // 47: query.v1.ListTagsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	41, // 48: query.v1.TagUsage.tag:type_name -> common.v1.Tag
+	36, // 49: query.v1.ProductSuggestion.category:type_name -> common.v1.Category
+	0,  // 50: query.v1.CategoryService.ListCategories:input_type -> query.v1.ListCategoriesRequest
+	2,  // 51: query.v1.CategoryService.GetCategoryById:input_type -> query.v1.GetCategoryByIdRequest
+	4,  // 52: query.v1.CategoryService.GetCategoryBySlug:input_type -> query.v1.GetCategoryBySlugRequest
+	6,  // 53: query.v1.CategoryService.ListChildCategories:input_type -> query.v1.ListChildCategoriesRequest
+	8,  // 54: query.v1.CategoryService.GetCategoryAncestors:input_type -> query.v1.GetCategoryAncestorsRequest
+	10, // 55: query.v1.CategoryService.GetCategorySubtree:input_type -> query.v1.GetCategorySubtreeRequest
+	13, // 56: query.v1.ProductService.StreamProducts:input_type -> query.v1.StreamProductsRequest
+	15, // 57: query.v1.ProductService.ListProducts:input_type -> query.v1.ListProductsRequest
+	17, // 58: query.v1.ProductService.GetProductById:input_type -> query.v1.GetProductByIdRequest
+	19, // 59: query.v1.ProductService.GetProductByBarcode:input_type -> query.v1.GetProductByBarcodeRequest
+	21, // 60: query.v1.ProductService.GetProductBySlug:input_type -> query.v1.GetProductBySlugRequest
+	23, // 61: query.v1.ProductService.SearchProductsByKeyword:input_type -> query.v1.SearchProductsByKeywordRequest
+	28, // 62: query.v1.ProductService.SuggestProducts:input_type -> query.v1.SuggestProductsRequest
+	30, // 63: query.v1.ProductService.GetStock:input_type -> query.v1.GetStockRequest
+	32, // 64: query.v1.TagService.ListTags:input_type -> query.v1.ListTagsRequest
+	1,  // 65: query.v1.CategoryService.ListCategories:output_type -> query.v1.ListCategoriesResponse
+	3,  // 66: query.v1.CategoryService.GetCategoryById:output_type -> query.v1.GetCategoryByIdResponse
+	5,  // 67: query.v1.CategoryService.GetCategoryBySlug:output_type -> query.v1.GetCategoryBySlugResponse
+	7,  // 68: query.v1.CategoryService.ListChildCategories:output_type -> query.v1.ListChildCategoriesResponse
+	9,  // 69: query.v1.CategoryService.GetCategoryAncestors:output_type -> query.v1.GetCategoryAncestorsResponse
+	11, // 70: query.v1.CategoryService.GetCategorySubtree:output_type -> query.v1.GetCategorySubtreeResponse
+	14, // 71: query.v1.ProductService.StreamProducts:output_type -> query.v1.StreamProductsResponse
+	16, // 72: query.v1.ProductService.ListProducts:output_type -> query.v1.ListProductsResponse
+	18, // 73: query.v1.ProductService.GetProductById:output_type -> query.v1.GetProductByIdResponse
+	20, // 74: query.v1.ProductService.GetProductByBarcode:output_type -> query.v1.GetProductByBarcodeResponse
+	22, // 75: query.v1.ProductService.GetProductBySlug:output_type -> query.v1.GetProductBySlugResponse
+	24, // 76: query.v1.ProductService.SearchProductsByKeyword:output_type -> query.v1.SearchProductsByKeywordResponse
+	29, // 77: query.v1.ProductService.SuggestProducts:output_type -> query.v1.SuggestProductsResponse
+	31, // 78: query.v1.ProductService.GetStock:output_type -> query.v1.GetStockResponse
+	33, // 79: query.v1.TagService.ListTags:output_type -> query.v1.ListTagsResponse
+	65, // [65:80] is the sub-list for method output_type
+	50, // [50:65] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_query_v1_query_proto_init() }
@@ -1239,19 +4254,45 @@ func file_query_v1_query_proto_init() {
 		(*getCategoryByIdResponse_Category)(nil),
 		(*getCategoryByIdResponse_Error)(nil),
 	}
-	file_query_v1_query_proto_msgTypes[9].OneofWrappers = []any{
+	file_query_v1_query_proto_msgTypes[5].OneofWrappers = []any{
+		(*getCategoryBySlugResponse_Category)(nil),
+		(*getCategoryBySlugResponse_Error)(nil),
+	}
+	file_query_v1_query_proto_msgTypes[6].OneofWrappers = []any{}
+	file_query_v1_query_proto_msgTypes[11].OneofWrappers = []any{
+		(*getCategorySubtreeResponse_Root)(nil),
+		(*getCategorySubtreeResponse_Error)(nil),
+	}
+	file_query_v1_query_proto_msgTypes[15].OneofWrappers = []any{}
+	file_query_v1_query_proto_msgTypes[17].OneofWrappers = []any{}
+	file_query_v1_query_proto_msgTypes[18].OneofWrappers = []any{
 		(*getProductByIdResponse_Product)(nil),
 		(*getProductByIdResponse_Error)(nil),
 	}
+	file_query_v1_query_proto_msgTypes[19].OneofWrappers = []any{}
+	file_query_v1_query_proto_msgTypes[20].OneofWrappers = []any{
+		(*getProductByBarcodeResponse_Product)(nil),
+		(*getProductByBarcodeResponse_Error)(nil),
+	}
+	file_query_v1_query_proto_msgTypes[21].OneofWrappers = []any{}
+	file_query_v1_query_proto_msgTypes[22].OneofWrappers = []any{
+		(*getProductBySlugResponse_Product)(nil),
+		(*getProductBySlugResponse_Error)(nil),
+	}
+	file_query_v1_query_proto_msgTypes[23].OneofWrappers = []any{}
+	file_query_v1_query_proto_msgTypes[31].OneofWrappers = []any{
+		(*getStockResponse_Stock)(nil),
+		(*getStockResponse_Error)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_query_v1_query_proto_rawDesc), len(file_query_v1_query_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   36,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_query_v1_query_proto_goTypes,
 		DependencyIndexes: file_query_v1_query_proto_depIdxs,
@@ -25,8 +25,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CategoryService_ListCategories_FullMethodName  = "/query.v1.CategoryService/ListCategories"
-	CategoryService_GetCategoryById_FullMethodName = "/query.v1.CategoryService/GetCategoryById"
+	CategoryService_ListCategories_FullMethodName       = "/query.v1.CategoryService/ListCategories"
+	CategoryService_GetCategoryById_FullMethodName      = "/query.v1.CategoryService/GetCategoryById"
+	CategoryService_GetCategoryBySlug_FullMethodName    = "/query.v1.CategoryService/GetCategoryBySlug"
+	CategoryService_ListChildCategories_FullMethodName  = "/query.v1.CategoryService/ListChildCategories"
+	CategoryService_GetCategoryAncestors_FullMethodName = "/query.v1.CategoryService/GetCategoryAncestors"
+	CategoryService_GetCategorySubtree_FullMethodName   = "/query.v1.CategoryService/GetCategorySubtree"
 )
 
 // CategoryServiceClient is the client API for CategoryService service.
@@ -39,6 +43,14 @@ type CategoryServiceClient interface {
 	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
 	// 指定されたIDのカテゴリを問合せして返す
 	GetCategoryById(ctx context.Context, in *GetCategoryByIdRequest, opts ...grpc.CallOption) (*GetCategoryByIdResponse, error)
+	// 指定されたスラッグのカテゴリを問合せして返す（変更前のスラッグの場合は現在のスラッグへの誘導を示す）
+	GetCategoryBySlug(ctx context.Context, in *GetCategoryBySlugRequest, opts ...grpc.CallOption) (*GetCategoryBySlugResponse, error)
+	// 指定されたカテゴリの子カテゴリを問合せして返す（親カテゴリ未指定の場合はルートカテゴリ）
+	ListChildCategories(ctx context.Context, in *ListChildCategoriesRequest, opts ...grpc.CallOption) (*ListChildCategoriesResponse, error)
+	// ルートから指定されたカテゴリまでの祖先カテゴリを問合せして返す（パンくずリスト）
+	GetCategoryAncestors(ctx context.Context, in *GetCategoryAncestorsRequest, opts ...grpc.CallOption) (*GetCategoryAncestorsResponse, error)
+	// 指定されたカテゴリを根とする部分木を問合せして返す
+	GetCategorySubtree(ctx context.Context, in *GetCategorySubtreeRequest, opts ...grpc.CallOption) (*GetCategorySubtreeResponse, error)
 }
 
 type categoryServiceClient struct {
@@ -69,6 +81,46 @@ func (c *categoryServiceClient) GetCategoryById(ctx context.Context, in *GetCate
 	return out, nil
 }
 
+func (c *categoryServiceClient) GetCategoryBySlug(ctx context.Context, in *GetCategoryBySlugRequest, opts ...grpc.CallOption) (*GetCategoryBySlugResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryBySlugResponse)
+	err := c.cc.Invoke(ctx, CategoryService_GetCategoryBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) ListChildCategories(ctx context.Context, in *ListChildCategoriesRequest, opts ...grpc.CallOption) (*ListChildCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChildCategoriesResponse)
+	err := c.cc.Invoke(ctx, CategoryService_ListChildCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) GetCategoryAncestors(ctx context.Context, in *GetCategoryAncestorsRequest, opts ...grpc.CallOption) (*GetCategoryAncestorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryAncestorsResponse)
+	err := c.cc.Invoke(ctx, CategoryService_GetCategoryAncestors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) GetCategorySubtree(ctx context.Context, in *GetCategorySubtreeRequest, opts ...grpc.CallOption) (*GetCategorySubtreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategorySubtreeResponse)
+	err := c.cc.Invoke(ctx, CategoryService_GetCategorySubtree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CategoryServiceServer is the server API for CategoryService service.
 // All implementations must embed UnimplementedCategoryServiceServer
 // for forward compatibility.
@@ -79,6 +131,14 @@ type CategoryServiceServer interface {
 	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
 	// 指定されたIDのカテゴリを問合せして返す
 	GetCategoryById(context.Context, *GetCategoryByIdRequest) (*GetCategoryByIdResponse, error)
+	// 指定されたスラッグのカテゴリを問合せして返す（変更前のスラッグの場合は現在のスラッグへの誘導を示す）
+	GetCategoryBySlug(context.Context, *GetCategoryBySlugRequest) (*GetCategoryBySlugResponse, error)
+	// 指定されたカテゴリの子カテゴリを問合せして返す（親カテゴリ未指定の場合はルートカテゴリ）
+	ListChildCategories(context.Context, *ListChildCategoriesRequest) (*ListChildCategoriesResponse, error)
+	// ルートから指定されたカテゴリまでの祖先カテゴリを問合せして返す（パンくずリスト）
+	GetCategoryAncestors(context.Context, *GetCategoryAncestorsRequest) (*GetCategoryAncestorsResponse, error)
+	// 指定されたカテゴリを根とする部分木を問合せして返す
+	GetCategorySubtree(context.Context, *GetCategorySubtreeRequest) (*GetCategorySubtreeResponse, error)
 	mustEmbedUnimplementedCategoryServiceServer()
 }
 
@@ -95,6 +155,18 @@ func (UnimplementedCategoryServiceServer) ListCategories(context.Context, *ListC
 func (UnimplementedCategoryServiceServer) GetCategoryById(context.Context, *GetCategoryByIdRequest) (*GetCategoryByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryById not implemented")
 }
+func (UnimplementedCategoryServiceServer) GetCategoryBySlug(context.Context, *GetCategoryBySlugRequest) (*GetCategoryBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryBySlug not implemented")
+}
+func (UnimplementedCategoryServiceServer) ListChildCategories(context.Context, *ListChildCategoriesRequest) (*ListChildCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChildCategories not implemented")
+}
+func (UnimplementedCategoryServiceServer) GetCategoryAncestors(context.Context, *GetCategoryAncestorsRequest) (*GetCategoryAncestorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryAncestors not implemented")
+}
+func (UnimplementedCategoryServiceServer) GetCategorySubtree(context.Context, *GetCategorySubtreeRequest) (*GetCategorySubtreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategorySubtree not implemented")
+}
 func (UnimplementedCategoryServiceServer) mustEmbedUnimplementedCategoryServiceServer() {}
 func (UnimplementedCategoryServiceServer) testEmbeddedByValue()                         {}
 
@@ -152,6 +224,78 @@ func _CategoryService_GetCategoryById_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CategoryService_GetCategoryBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).GetCategoryBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_GetCategoryBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).GetCategoryBySlug(ctx, req.(*GetCategoryBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_ListChildCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).ListChildCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_ListChildCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).ListChildCategories(ctx, req.(*ListChildCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_GetCategoryAncestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryAncestorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).GetCategoryAncestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_GetCategoryAncestors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).GetCategoryAncestors(ctx, req.(*GetCategoryAncestorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_GetCategorySubtree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategorySubtreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).GetCategorySubtree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_GetCategorySubtree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).GetCategorySubtree(ctx, req.(*GetCategorySubtreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CategoryService_ServiceDesc is the grpc.ServiceDesc for CategoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -167,6 +311,22 @@ var CategoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCategoryById",
 			Handler:    _CategoryService_GetCategoryById_Handler,
 		},
+		{
+			MethodName: "GetCategoryBySlug",
+			Handler:    _CategoryService_GetCategoryBySlug_Handler,
+		},
+		{
+			MethodName: "ListChildCategories",
+			Handler:    _CategoryService_ListChildCategories_Handler,
+		},
+		{
+			MethodName: "GetCategoryAncestors",
+			Handler:    _CategoryService_GetCategoryAncestors_Handler,
+		},
+		{
+			MethodName: "GetCategorySubtree",
+			Handler:    _CategoryService_GetCategorySubtree_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query/v1/query.proto",
@@ -176,7 +336,11 @@ const (
 	ProductService_StreamProducts_FullMethodName          = "/query.v1.ProductService/StreamProducts"
 	ProductService_ListProducts_FullMethodName            = "/query.v1.ProductService/ListProducts"
 	ProductService_GetProductById_FullMethodName          = "/query.v1.ProductService/GetProductById"
+	ProductService_GetProductByBarcode_FullMethodName     = "/query.v1.ProductService/GetProductByBarcode"
+	ProductService_GetProductBySlug_FullMethodName        = "/query.v1.ProductService/GetProductBySlug"
 	ProductService_SearchProductsByKeyword_FullMethodName = "/query.v1.ProductService/SearchProductsByKeyword"
+	ProductService_SuggestProducts_FullMethodName         = "/query.v1.ProductService/SuggestProducts"
+	ProductService_GetStock_FullMethodName                = "/query.v1.ProductService/GetStock"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -187,12 +351,21 @@ const (
 type ProductServiceClient interface {
 	// すべての商品を問合せして返す(Server streaming RPC)
 	StreamProducts(ctx context.Context, in *StreamProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamProductsResponse], error)
-	// すべての商品を問合せして返す
+	// すべての商品を問合せして返す（カテゴリ指定時はそのカテゴリの商品、子孫カテゴリを含めることも可能。タグ指定時はすべてのタグが付与された商品）
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
-	// 指定されたIDの商品を問合せして返す
+	// 指定されたIDの公開中の商品を問合せして返す（include_unpublishedを指定した場合は公開中以外の商品も返す）
 	GetProductById(ctx context.Context, in *GetProductByIdRequest, opts ...grpc.CallOption) (*GetProductByIdResponse, error)
+	// 指定されたバーコードの商品を問合せして返す
+	GetProductByBarcode(ctx context.Context, in *GetProductByBarcodeRequest, opts ...grpc.CallOption) (*GetProductByBarcodeResponse, error)
+	// 指定されたスラッグの公開中の商品を問合せして返す（変更前のスラッグの場合は現在のスラッグへの誘導を示す）
+	GetProductBySlug(ctx context.Context, in *GetProductBySlugRequest, opts ...grpc.CallOption) (*GetProductBySlugResponse, error)
 	// 指定されたキーワードで商品を検索して返す
 	SearchProductsByKeyword(ctx context.Context, in *SearchProductsByKeywordRequest, opts ...grpc.CallOption) (*SearchProductsByKeywordResponse, error)
+	// 入力中の検索語を受け取るたびにサジェストを返す(Bidirectional streaming RPC)
+	// 新しい検索語を受信すると、処理中の古い検索語の問合せはキャンセルされる
+	SuggestProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SuggestProductsRequest, SuggestProductsResponse], error)
+	// 指定された商品の在庫を問合せして返す
+	GetStock(ctx context.Context, in *GetStockRequest, opts ...grpc.CallOption) (*GetStockResponse, error)
 }
 
 type productServiceClient struct {
@@ -242,6 +415,26 @@ func (c *productServiceClient) GetProductById(ctx context.Context, in *GetProduc
 	return out, nil
 }
 
+func (c *productServiceClient) GetProductByBarcode(ctx context.Context, in *GetProductByBarcodeRequest, opts ...grpc.CallOption) (*GetProductByBarcodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductByBarcodeResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductByBarcode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductBySlug(ctx context.Context, in *GetProductBySlugRequest, opts ...grpc.CallOption) (*GetProductBySlugResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductBySlugResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) SearchProductsByKeyword(ctx context.Context, in *SearchProductsByKeywordRequest, opts ...grpc.CallOption) (*SearchProductsByKeywordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchProductsByKeywordResponse)
@@ -252,6 +445,29 @@ func (c *productServiceClient) SearchProductsByKeyword(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *productServiceClient) SuggestProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SuggestProductsRequest, SuggestProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[1], ProductService_SuggestProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SuggestProductsRequest, SuggestProductsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_SuggestProductsClient = grpc.BidiStreamingClient[SuggestProductsRequest, SuggestProductsResponse]
+
+func (c *productServiceClient) GetStock(ctx context.Context, in *GetStockRequest, opts ...grpc.CallOption) (*GetStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStockResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -260,12 +476,21 @@ func (c *productServiceClient) SearchProductsByKeyword(ctx context.Context, in *
 type ProductServiceServer interface {
 	// すべての商品を問合せして返す(Server streaming RPC)
 	StreamProducts(*StreamProductsRequest, grpc.ServerStreamingServer[StreamProductsResponse]) error
-	// すべての商品を問合せして返す
+	// すべての商品を問合せして返す（カテゴリ指定時はそのカテゴリの商品、子孫カテゴリを含めることも可能。タグ指定時はすべてのタグが付与された商品）
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
-	// 指定されたIDの商品を問合せして返す
+	// 指定されたIDの公開中の商品を問合せして返す（include_unpublishedを指定した場合は公開中以外の商品も返す）
 	GetProductById(context.Context, *GetProductByIdRequest) (*GetProductByIdResponse, error)
+	// 指定されたバーコードの商品を問合せして返す
+	GetProductByBarcode(context.Context, *GetProductByBarcodeRequest) (*GetProductByBarcodeResponse, error)
+	// 指定されたスラッグの公開中の商品を問合せして返す（変更前のスラッグの場合は現在のスラッグへの誘導を示す）
+	GetProductBySlug(context.Context, *GetProductBySlugRequest) (*GetProductBySlugResponse, error)
 	// 指定されたキーワードで商品を検索して返す
 	SearchProductsByKeyword(context.Context, *SearchProductsByKeywordRequest) (*SearchProductsByKeywordResponse, error)
+	// 入力中の検索語を受け取るたびにサジェストを返す(Bidirectional streaming RPC)
+	// 新しい検索語を受信すると、処理中の古い検索語の問合せはキャンセルされる
+	SuggestProducts(grpc.BidiStreamingServer[SuggestProductsRequest, SuggestProductsResponse]) error
+	// 指定された商品の在庫を問合せして返す
+	GetStock(context.Context, *GetStockRequest) (*GetStockResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -285,9 +510,21 @@ func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProd
 func (UnimplementedProductServiceServer) GetProductById(context.Context, *GetProductByIdRequest) (*GetProductByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProductById not implemented")
 }
+func (UnimplementedProductServiceServer) GetProductByBarcode(context.Context, *GetProductByBarcodeRequest) (*GetProductByBarcodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductByBarcode not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductBySlug(context.Context, *GetProductBySlugRequest) (*GetProductBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductBySlug not implemented")
+}
 func (UnimplementedProductServiceServer) SearchProductsByKeyword(context.Context, *SearchProductsByKeywordRequest) (*SearchProductsByKeywordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchProductsByKeyword not implemented")
 }
+func (UnimplementedProductServiceServer) SuggestProducts(grpc.BidiStreamingServer[SuggestProductsRequest, SuggestProductsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SuggestProducts not implemented")
+}
+func (UnimplementedProductServiceServer) GetStock(context.Context, *GetStockRequest) (*GetStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStock not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -356,6 +593,42 @@ func _ProductService_GetProductById_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GetProductByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductByBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductByBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductByBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductByBarcode(ctx, req.(*GetProductByBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductBySlug(ctx, req.(*GetProductBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_SearchProductsByKeyword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchProductsByKeywordRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +647,31 @@ func _ProductService_SearchProductsByKeyword_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_SuggestProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductServiceServer).SuggestProducts(&grpc.GenericServerStream[SuggestProductsRequest, SuggestProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_SuggestProductsServer = grpc.BidiStreamingServer[SuggestProductsRequest, SuggestProductsResponse]
+
+func _ProductService_GetStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetStock(ctx, req.(*GetStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -389,10 +687,22 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProductById",
 			Handler:    _ProductService_GetProductById_Handler,
 		},
+		{
+			MethodName: "GetProductByBarcode",
+			Handler:    _ProductService_GetProductByBarcode_Handler,
+		},
+		{
+			MethodName: "GetProductBySlug",
+			Handler:    _ProductService_GetProductBySlug_Handler,
+		},
 		{
 			MethodName: "SearchProductsByKeyword",
 			Handler:    _ProductService_SearchProductsByKeyword_Handler,
 		},
+		{
+			MethodName: "GetStock",
+			Handler:    _ProductService_GetStock_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -400,6 +710,120 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ProductService_StreamProducts_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SuggestProducts",
+			Handler:       _ProductService_SuggestProducts_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "query/v1/query.proto",
 }
+
+const (
+	TagService_ListTags_FullMethodName = "/query.v1.TagService/ListTags"
+)
+
+// TagServiceClient is the client API for TagService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+//	タグ問合せサービス型（読み取り専用）
+type TagServiceClient interface {
+	// すべてのタグを付与された商品数とともに問合せして返す
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+}
+
+type tagServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTagServiceClient(cc grpc.ClientConnInterface) TagServiceClient {
+	return &tagServiceClient{cc}
+}
+
+func (c *tagServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TagServiceServer is the server API for TagService service.
+// All implementations must embed UnimplementedTagServiceServer
+// for forward compatibility.
+//
+//	タグ問合せサービス型（読み取り専用）
+type TagServiceServer interface {
+	// すべてのタグを付与された商品数とともに問合せして返す
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+// UnimplementedTagServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTagServiceServer struct{}
+
+func (UnimplementedTagServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
+func (UnimplementedTagServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeTagServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TagServiceServer will
+// result in compilation errors.
+type UnsafeTagServiceServer interface {
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+func RegisterTagServiceServer(s grpc.ServiceRegistrar, srv TagServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTagServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TagService_ServiceDesc, srv)
+}
+
+func _TagService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TagService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "query.v1.TagService",
+	HandlerType: (*TagServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTags",
+			Handler:    _TagService_ListTags_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "query/v1/query.proto",
+}
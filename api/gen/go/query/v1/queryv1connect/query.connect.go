@@ -31,6 +31,8 @@ const (
 	CategoryServiceName = "query.v1.CategoryService"
 	// ProductServiceName is the fully-qualified name of the ProductService service.
 	ProductServiceName = "query.v1.ProductService"
+	// TagServiceName is the fully-qualified name of the TagService service.
+	TagServiceName = "query.v1.TagService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -47,6 +49,18 @@ const (
 	// CategoryServiceGetCategoryByIdProcedure is the fully-qualified name of the CategoryService's
 	// GetCategoryById RPC.
 	CategoryServiceGetCategoryByIdProcedure = "/query.v1.CategoryService/GetCategoryById"
+	// CategoryServiceGetCategoryBySlugProcedure is the fully-qualified name of the CategoryService's
+	// GetCategoryBySlug RPC.
+	CategoryServiceGetCategoryBySlugProcedure = "/query.v1.CategoryService/GetCategoryBySlug"
+	// CategoryServiceListChildCategoriesProcedure is the fully-qualified name of the CategoryService's
+	// ListChildCategories RPC.
+	CategoryServiceListChildCategoriesProcedure = "/query.v1.CategoryService/ListChildCategories"
+	// CategoryServiceGetCategoryAncestorsProcedure is the fully-qualified name of the CategoryService's
+	// GetCategoryAncestors RPC.
+	CategoryServiceGetCategoryAncestorsProcedure = "/query.v1.CategoryService/GetCategoryAncestors"
+	// CategoryServiceGetCategorySubtreeProcedure is the fully-qualified name of the CategoryService's
+	// GetCategorySubtree RPC.
+	CategoryServiceGetCategorySubtreeProcedure = "/query.v1.CategoryService/GetCategorySubtree"
 	// ProductServiceStreamProductsProcedure is the fully-qualified name of the ProductService's
 	// StreamProducts RPC.
 	ProductServiceStreamProductsProcedure = "/query.v1.ProductService/StreamProducts"
@@ -56,9 +70,22 @@ const (
 	// ProductServiceGetProductByIdProcedure is the fully-qualified name of the ProductService's
 	// GetProductById RPC.
 	ProductServiceGetProductByIdProcedure = "/query.v1.ProductService/GetProductById"
+	// ProductServiceGetProductByBarcodeProcedure is the fully-qualified name of the ProductService's
+	// GetProductByBarcode RPC.
+	ProductServiceGetProductByBarcodeProcedure = "/query.v1.ProductService/GetProductByBarcode"
+	// ProductServiceGetProductBySlugProcedure is the fully-qualified name of the ProductService's
+	// GetProductBySlug RPC.
+	ProductServiceGetProductBySlugProcedure = "/query.v1.ProductService/GetProductBySlug"
 	// ProductServiceSearchProductsByKeywordProcedure is the fully-qualified name of the
 	// ProductService's SearchProductsByKeyword RPC.
 	ProductServiceSearchProductsByKeywordProcedure = "/query.v1.ProductService/SearchProductsByKeyword"
+	// ProductServiceSuggestProductsProcedure is the fully-qualified name of the ProductService's
+	// SuggestProducts RPC.
+	ProductServiceSuggestProductsProcedure = "/query.v1.ProductService/SuggestProducts"
+	// ProductServiceGetStockProcedure is the fully-qualified name of the ProductService's GetStock RPC.
+	ProductServiceGetStockProcedure = "/query.v1.ProductService/GetStock"
+	// TagServiceListTagsProcedure is the fully-qualified name of the TagService's ListTags RPC.
+	TagServiceListTagsProcedure = "/query.v1.TagService/ListTags"
 )
 
 // CategoryServiceClient is a client for the query.v1.CategoryService service.
@@ -67,6 +94,14 @@ type CategoryServiceClient interface {
 	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
 	// 指定されたIDのカテゴリを問合せして返す
 	GetCategoryById(context.Context, *connect.Request[v1.GetCategoryByIdRequest]) (*connect.Response[v1.GetCategoryByIdResponse], error)
+	// 指定されたスラッグのカテゴリを問合せして返す（変更前のスラッグの場合は現在のスラッグへの誘導を示す）
+	GetCategoryBySlug(context.Context, *connect.Request[v1.GetCategoryBySlugRequest]) (*connect.Response[v1.GetCategoryBySlugResponse], error)
+	// 指定されたカテゴリの子カテゴリを問合せして返す（親カテゴリ未指定の場合はルートカテゴリ）
+	ListChildCategories(context.Context, *connect.Request[v1.ListChildCategoriesRequest]) (*connect.Response[v1.ListChildCategoriesResponse], error)
+	// ルートから指定されたカテゴリまでの祖先カテゴリを問合せして返す（パンくずリスト）
+	GetCategoryAncestors(context.Context, *connect.Request[v1.GetCategoryAncestorsRequest]) (*connect.Response[v1.GetCategoryAncestorsResponse], error)
+	// 指定されたカテゴリを根とする部分木を問合せして返す
+	GetCategorySubtree(context.Context, *connect.Request[v1.GetCategorySubtreeRequest]) (*connect.Response[v1.GetCategorySubtreeResponse], error)
 }
 
 // NewCategoryServiceClient constructs a client for the query.v1.CategoryService service. By
@@ -92,13 +127,41 @@ func NewCategoryServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(categoryServiceMethods.ByName("GetCategoryById")),
 			connect.WithClientOptions(opts...),
 		),
+		getCategoryBySlug: connect.NewClient[v1.GetCategoryBySlugRequest, v1.GetCategoryBySlugResponse](
+			httpClient,
+			baseURL+CategoryServiceGetCategoryBySlugProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("GetCategoryBySlug")),
+			connect.WithClientOptions(opts...),
+		),
+		listChildCategories: connect.NewClient[v1.ListChildCategoriesRequest, v1.ListChildCategoriesResponse](
+			httpClient,
+			baseURL+CategoryServiceListChildCategoriesProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("ListChildCategories")),
+			connect.WithClientOptions(opts...),
+		),
+		getCategoryAncestors: connect.NewClient[v1.GetCategoryAncestorsRequest, v1.GetCategoryAncestorsResponse](
+			httpClient,
+			baseURL+CategoryServiceGetCategoryAncestorsProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("GetCategoryAncestors")),
+			connect.WithClientOptions(opts...),
+		),
+		getCategorySubtree: connect.NewClient[v1.GetCategorySubtreeRequest, v1.GetCategorySubtreeResponse](
+			httpClient,
+			baseURL+CategoryServiceGetCategorySubtreeProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("GetCategorySubtree")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // categoryServiceClient implements CategoryServiceClient.
 type categoryServiceClient struct {
-	listCategories  *connect.Client[v1.ListCategoriesRequest, v1.ListCategoriesResponse]
-	getCategoryById *connect.Client[v1.GetCategoryByIdRequest, v1.GetCategoryByIdResponse]
+	listCategories       *connect.Client[v1.ListCategoriesRequest, v1.ListCategoriesResponse]
+	getCategoryById      *connect.Client[v1.GetCategoryByIdRequest, v1.GetCategoryByIdResponse]
+	getCategoryBySlug    *connect.Client[v1.GetCategoryBySlugRequest, v1.GetCategoryBySlugResponse]
+	listChildCategories  *connect.Client[v1.ListChildCategoriesRequest, v1.ListChildCategoriesResponse]
+	getCategoryAncestors *connect.Client[v1.GetCategoryAncestorsRequest, v1.GetCategoryAncestorsResponse]
+	getCategorySubtree   *connect.Client[v1.GetCategorySubtreeRequest, v1.GetCategorySubtreeResponse]
 }
 
 // ListCategories calls query.v1.CategoryService.ListCategories.
@@ -111,12 +174,40 @@ func (c *categoryServiceClient) GetCategoryById(ctx context.Context, req *connec
 	return c.getCategoryById.CallUnary(ctx, req)
 }
 
+// GetCategoryBySlug calls query.v1.CategoryService.GetCategoryBySlug.
+func (c *categoryServiceClient) GetCategoryBySlug(ctx context.Context, req *connect.Request[v1.GetCategoryBySlugRequest]) (*connect.Response[v1.GetCategoryBySlugResponse], error) {
+	return c.getCategoryBySlug.CallUnary(ctx, req)
+}
+
+// ListChildCategories calls query.v1.CategoryService.ListChildCategories.
+func (c *categoryServiceClient) ListChildCategories(ctx context.Context, req *connect.Request[v1.ListChildCategoriesRequest]) (*connect.Response[v1.ListChildCategoriesResponse], error) {
+	return c.listChildCategories.CallUnary(ctx, req)
+}
+
+// GetCategoryAncestors calls query.v1.CategoryService.GetCategoryAncestors.
+func (c *categoryServiceClient) GetCategoryAncestors(ctx context.Context, req *connect.Request[v1.GetCategoryAncestorsRequest]) (*connect.Response[v1.GetCategoryAncestorsResponse], error) {
+	return c.getCategoryAncestors.CallUnary(ctx, req)
+}
+
+// GetCategorySubtree calls query.v1.CategoryService.GetCategorySubtree.
+func (c *categoryServiceClient) GetCategorySubtree(ctx context.Context, req *connect.Request[v1.GetCategorySubtreeRequest]) (*connect.Response[v1.GetCategorySubtreeResponse], error) {
+	return c.getCategorySubtree.CallUnary(ctx, req)
+}
+
 // CategoryServiceHandler is an implementation of the query.v1.CategoryService service.
 type CategoryServiceHandler interface {
 	// すべてのカテゴリを問合せして返す
 	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
 	// 指定されたIDのカテゴリを問合せして返す
 	GetCategoryById(context.Context, *connect.Request[v1.GetCategoryByIdRequest]) (*connect.Response[v1.GetCategoryByIdResponse], error)
+	// 指定されたスラッグのカテゴリを問合せして返す（変更前のスラッグの場合は現在のスラッグへの誘導を示す）
+	GetCategoryBySlug(context.Context, *connect.Request[v1.GetCategoryBySlugRequest]) (*connect.Response[v1.GetCategoryBySlugResponse], error)
+	// 指定されたカテゴリの子カテゴリを問合せして返す（親カテゴリ未指定の場合はルートカテゴリ）
+	ListChildCategories(context.Context, *connect.Request[v1.ListChildCategoriesRequest]) (*connect.Response[v1.ListChildCategoriesResponse], error)
+	// ルートから指定されたカテゴリまでの祖先カテゴリを問合せして返す（パンくずリスト）
+	GetCategoryAncestors(context.Context, *connect.Request[v1.GetCategoryAncestorsRequest]) (*connect.Response[v1.GetCategoryAncestorsResponse], error)
+	// 指定されたカテゴリを根とする部分木を問合せして返す
+	GetCategorySubtree(context.Context, *connect.Request[v1.GetCategorySubtreeRequest]) (*connect.Response[v1.GetCategorySubtreeResponse], error)
 }
 
 // NewCategoryServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -138,12 +229,44 @@ func NewCategoryServiceHandler(svc CategoryServiceHandler, opts ...connect.Handl
 		connect.WithSchema(categoryServiceMethods.ByName("GetCategoryById")),
 		connect.WithHandlerOptions(opts...),
 	)
+	categoryServiceGetCategoryBySlugHandler := connect.NewUnaryHandler(
+		CategoryServiceGetCategoryBySlugProcedure,
+		svc.GetCategoryBySlug,
+		connect.WithSchema(categoryServiceMethods.ByName("GetCategoryBySlug")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceListChildCategoriesHandler := connect.NewUnaryHandler(
+		CategoryServiceListChildCategoriesProcedure,
+		svc.ListChildCategories,
+		connect.WithSchema(categoryServiceMethods.ByName("ListChildCategories")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceGetCategoryAncestorsHandler := connect.NewUnaryHandler(
+		CategoryServiceGetCategoryAncestorsProcedure,
+		svc.GetCategoryAncestors,
+		connect.WithSchema(categoryServiceMethods.ByName("GetCategoryAncestors")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceGetCategorySubtreeHandler := connect.NewUnaryHandler(
+		CategoryServiceGetCategorySubtreeProcedure,
+		svc.GetCategorySubtree,
+		connect.WithSchema(categoryServiceMethods.ByName("GetCategorySubtree")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/query.v1.CategoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CategoryServiceListCategoriesProcedure:
 			categoryServiceListCategoriesHandler.ServeHTTP(w, r)
 		case CategoryServiceGetCategoryByIdProcedure:
 			categoryServiceGetCategoryByIdHandler.ServeHTTP(w, r)
+		case CategoryServiceGetCategoryBySlugProcedure:
+			categoryServiceGetCategoryBySlugHandler.ServeHTTP(w, r)
+		case CategoryServiceListChildCategoriesProcedure:
+			categoryServiceListChildCategoriesHandler.ServeHTTP(w, r)
+		case CategoryServiceGetCategoryAncestorsProcedure:
+			categoryServiceGetCategoryAncestorsHandler.ServeHTTP(w, r)
+		case CategoryServiceGetCategorySubtreeProcedure:
+			categoryServiceGetCategorySubtreeHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -161,16 +284,41 @@ func (UnimplementedCategoryServiceHandler) GetCategoryById(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.CategoryService.GetCategoryById is not implemented"))
 }
 
+func (UnimplementedCategoryServiceHandler) GetCategoryBySlug(context.Context, *connect.Request[v1.GetCategoryBySlugRequest]) (*connect.Response[v1.GetCategoryBySlugResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.CategoryService.GetCategoryBySlug is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) ListChildCategories(context.Context, *connect.Request[v1.ListChildCategoriesRequest]) (*connect.Response[v1.ListChildCategoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.CategoryService.ListChildCategories is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) GetCategoryAncestors(context.Context, *connect.Request[v1.GetCategoryAncestorsRequest]) (*connect.Response[v1.GetCategoryAncestorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.CategoryService.GetCategoryAncestors is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) GetCategorySubtree(context.Context, *connect.Request[v1.GetCategorySubtreeRequest]) (*connect.Response[v1.GetCategorySubtreeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.CategoryService.GetCategorySubtree is not implemented"))
+}
+
 // ProductServiceClient is a client for the query.v1.ProductService service.
 type ProductServiceClient interface {
 	// すべての商品を問合せして返す(Server streaming RPC)
 	StreamProducts(context.Context, *connect.Request[v1.StreamProductsRequest]) (*connect.ServerStreamForClient[v1.StreamProductsResponse], error)
-	// すべての商品を問合せして返す
+	// すべての商品を問合せして返す（カテゴリ指定時はそのカテゴリの商品、子孫カテゴリを含めることも可能。タグ指定時はすべてのタグが付与された商品）
 	ListProducts(context.Context, *connect.Request[v1.ListProductsRequest]) (*connect.Response[v1.ListProductsResponse], error)
-	// 指定されたIDの商品を問合せして返す
+	// 指定されたIDの公開中の商品を問合せして返す（include_unpublishedを指定した場合は公開中以外の商品も返す）
 	GetProductById(context.Context, *connect.Request[v1.GetProductByIdRequest]) (*connect.Response[v1.GetProductByIdResponse], error)
+	// 指定されたバーコードの商品を問合せして返す
+	GetProductByBarcode(context.Context, *connect.Request[v1.GetProductByBarcodeRequest]) (*connect.Response[v1.GetProductByBarcodeResponse], error)
+	// 指定されたスラッグの公開中の商品を問合せして返す（変更前のスラッグの場合は現在のスラッグへの誘導を示す）
+	GetProductBySlug(context.Context, *connect.Request[v1.GetProductBySlugRequest]) (*connect.Response[v1.GetProductBySlugResponse], error)
 	// 指定されたキーワードで商品を検索して返す
 	SearchProductsByKeyword(context.Context, *connect.Request[v1.SearchProductsByKeywordRequest]) (*connect.Response[v1.SearchProductsByKeywordResponse], error)
+	// 入力中の検索語を受け取るたびにサジェストを返す(Bidirectional streaming RPC)
+	// 新しい検索語を受信すると、処理中の古い検索語の問合せはキャンセルされる
+	SuggestProducts(context.Context) *connect.BidiStreamForClient[v1.SuggestProductsRequest, v1.SuggestProductsResponse]
+	// 指定された商品の在庫を問合せして返す
+	GetStock(context.Context, *connect.Request[v1.GetStockRequest]) (*connect.Response[v1.GetStockResponse], error)
 }
 
 // NewProductServiceClient constructs a client for the query.v1.ProductService service. By default,
@@ -202,12 +350,36 @@ func NewProductServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(productServiceMethods.ByName("GetProductById")),
 			connect.WithClientOptions(opts...),
 		),
+		getProductByBarcode: connect.NewClient[v1.GetProductByBarcodeRequest, v1.GetProductByBarcodeResponse](
+			httpClient,
+			baseURL+ProductServiceGetProductByBarcodeProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetProductByBarcode")),
+			connect.WithClientOptions(opts...),
+		),
+		getProductBySlug: connect.NewClient[v1.GetProductBySlugRequest, v1.GetProductBySlugResponse](
+			httpClient,
+			baseURL+ProductServiceGetProductBySlugProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetProductBySlug")),
+			connect.WithClientOptions(opts...),
+		),
 		searchProductsByKeyword: connect.NewClient[v1.SearchProductsByKeywordRequest, v1.SearchProductsByKeywordResponse](
 			httpClient,
 			baseURL+ProductServiceSearchProductsByKeywordProcedure,
 			connect.WithSchema(productServiceMethods.ByName("SearchProductsByKeyword")),
 			connect.WithClientOptions(opts...),
 		),
+		suggestProducts: connect.NewClient[v1.SuggestProductsRequest, v1.SuggestProductsResponse](
+			httpClient,
+			baseURL+ProductServiceSuggestProductsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("SuggestProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		getStock: connect.NewClient[v1.GetStockRequest, v1.GetStockResponse](
+			httpClient,
+			baseURL+ProductServiceGetStockProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetStock")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -216,7 +388,11 @@ type productServiceClient struct {
 	streamProducts          *connect.Client[v1.StreamProductsRequest, v1.StreamProductsResponse]
 	listProducts            *connect.Client[v1.ListProductsRequest, v1.ListProductsResponse]
 	getProductById          *connect.Client[v1.GetProductByIdRequest, v1.GetProductByIdResponse]
+	getProductByBarcode     *connect.Client[v1.GetProductByBarcodeRequest, v1.GetProductByBarcodeResponse]
+	getProductBySlug        *connect.Client[v1.GetProductBySlugRequest, v1.GetProductBySlugResponse]
 	searchProductsByKeyword *connect.Client[v1.SearchProductsByKeywordRequest, v1.SearchProductsByKeywordResponse]
+	suggestProducts         *connect.Client[v1.SuggestProductsRequest, v1.SuggestProductsResponse]
+	getStock                *connect.Client[v1.GetStockRequest, v1.GetStockResponse]
 }
 
 // StreamProducts calls query.v1.ProductService.StreamProducts.
@@ -234,21 +410,50 @@ func (c *productServiceClient) GetProductById(ctx context.Context, req *connect.
 	return c.getProductById.CallUnary(ctx, req)
 }
 
+// GetProductByBarcode calls query.v1.ProductService.GetProductByBarcode.
+func (c *productServiceClient) GetProductByBarcode(ctx context.Context, req *connect.Request[v1.GetProductByBarcodeRequest]) (*connect.Response[v1.GetProductByBarcodeResponse], error) {
+	return c.getProductByBarcode.CallUnary(ctx, req)
+}
+
+// GetProductBySlug calls query.v1.ProductService.GetProductBySlug.
+func (c *productServiceClient) GetProductBySlug(ctx context.Context, req *connect.Request[v1.GetProductBySlugRequest]) (*connect.Response[v1.GetProductBySlugResponse], error) {
+	return c.getProductBySlug.CallUnary(ctx, req)
+}
+
 // SearchProductsByKeyword calls query.v1.ProductService.SearchProductsByKeyword.
 func (c *productServiceClient) SearchProductsByKeyword(ctx context.Context, req *connect.Request[v1.SearchProductsByKeywordRequest]) (*connect.Response[v1.SearchProductsByKeywordResponse], error) {
 	return c.searchProductsByKeyword.CallUnary(ctx, req)
 }
 
+// SuggestProducts calls query.v1.ProductService.SuggestProducts.
+func (c *productServiceClient) SuggestProducts(ctx context.Context) *connect.BidiStreamForClient[v1.SuggestProductsRequest, v1.SuggestProductsResponse] {
+	return c.suggestProducts.CallBidiStream(ctx)
+}
+
+// GetStock calls query.v1.ProductService.GetStock.
+func (c *productServiceClient) GetStock(ctx context.Context, req *connect.Request[v1.GetStockRequest]) (*connect.Response[v1.GetStockResponse], error) {
+	return c.getStock.CallUnary(ctx, req)
+}
+
 // ProductServiceHandler is an implementation of the query.v1.ProductService service.
 type ProductServiceHandler interface {
 	// すべての商品を問合せして返す(Server streaming RPC)
 	StreamProducts(context.Context, *connect.Request[v1.StreamProductsRequest], *connect.ServerStream[v1.StreamProductsResponse]) error
-	// すべての商品を問合せして返す
+	// すべての商品を問合せして返す（カテゴリ指定時はそのカテゴリの商品、子孫カテゴリを含めることも可能。タグ指定時はすべてのタグが付与された商品）
 	ListProducts(context.Context, *connect.Request[v1.ListProductsRequest]) (*connect.Response[v1.ListProductsResponse], error)
-	// 指定されたIDの商品を問合せして返す
+	// 指定されたIDの公開中の商品を問合せして返す（include_unpublishedを指定した場合は公開中以外の商品も返す）
 	GetProductById(context.Context, *connect.Request[v1.GetProductByIdRequest]) (*connect.Response[v1.GetProductByIdResponse], error)
+	// 指定されたバーコードの商品を問合せして返す
+	GetProductByBarcode(context.Context, *connect.Request[v1.GetProductByBarcodeRequest]) (*connect.Response[v1.GetProductByBarcodeResponse], error)
+	// 指定されたスラッグの公開中の商品を問合せして返す（変更前のスラッグの場合は現在のスラッグへの誘導を示す）
+	GetProductBySlug(context.Context, *connect.Request[v1.GetProductBySlugRequest]) (*connect.Response[v1.GetProductBySlugResponse], error)
 	// 指定されたキーワードで商品を検索して返す
 	SearchProductsByKeyword(context.Context, *connect.Request[v1.SearchProductsByKeywordRequest]) (*connect.Response[v1.SearchProductsByKeywordResponse], error)
+	// 入力中の検索語を受け取るたびにサジェストを返す(Bidirectional streaming RPC)
+	// 新しい検索語を受信すると、処理中の古い検索語の問合せはキャンセルされる
+	SuggestProducts(context.Context, *connect.BidiStream[v1.SuggestProductsRequest, v1.SuggestProductsResponse]) error
+	// 指定された商品の在庫を問合せして返す
+	GetStock(context.Context, *connect.Request[v1.GetStockRequest]) (*connect.Response[v1.GetStockResponse], error)
 }
 
 // NewProductServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -276,12 +481,36 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 		connect.WithSchema(productServiceMethods.ByName("GetProductById")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceGetProductByBarcodeHandler := connect.NewUnaryHandler(
+		ProductServiceGetProductByBarcodeProcedure,
+		svc.GetProductByBarcode,
+		connect.WithSchema(productServiceMethods.ByName("GetProductByBarcode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceGetProductBySlugHandler := connect.NewUnaryHandler(
+		ProductServiceGetProductBySlugProcedure,
+		svc.GetProductBySlug,
+		connect.WithSchema(productServiceMethods.ByName("GetProductBySlug")),
+		connect.WithHandlerOptions(opts...),
+	)
 	productServiceSearchProductsByKeywordHandler := connect.NewUnaryHandler(
 		ProductServiceSearchProductsByKeywordProcedure,
 		svc.SearchProductsByKeyword,
 		connect.WithSchema(productServiceMethods.ByName("SearchProductsByKeyword")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceSuggestProductsHandler := connect.NewBidiStreamHandler(
+		ProductServiceSuggestProductsProcedure,
+		svc.SuggestProducts,
+		connect.WithSchema(productServiceMethods.ByName("SuggestProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceGetStockHandler := connect.NewUnaryHandler(
+		ProductServiceGetStockProcedure,
+		svc.GetStock,
+		connect.WithSchema(productServiceMethods.ByName("GetStock")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/query.v1.ProductService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProductServiceStreamProductsProcedure:
@@ -290,8 +519,16 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 			productServiceListProductsHandler.ServeHTTP(w, r)
 		case ProductServiceGetProductByIdProcedure:
 			productServiceGetProductByIdHandler.ServeHTTP(w, r)
+		case ProductServiceGetProductByBarcodeProcedure:
+			productServiceGetProductByBarcodeHandler.ServeHTTP(w, r)
+		case ProductServiceGetProductBySlugProcedure:
+			productServiceGetProductBySlugHandler.ServeHTTP(w, r)
 		case ProductServiceSearchProductsByKeywordProcedure:
 			productServiceSearchProductsByKeywordHandler.ServeHTTP(w, r)
+		case ProductServiceSuggestProductsProcedure:
+			productServiceSuggestProductsHandler.ServeHTTP(w, r)
+		case ProductServiceGetStockProcedure:
+			productServiceGetStockHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -313,6 +550,94 @@ func (UnimplementedProductServiceHandler) GetProductById(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.ProductService.GetProductById is not implemented"))
 }
 
+func (UnimplementedProductServiceHandler) GetProductByBarcode(context.Context, *connect.Request[v1.GetProductByBarcodeRequest]) (*connect.Response[v1.GetProductByBarcodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.ProductService.GetProductByBarcode is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) GetProductBySlug(context.Context, *connect.Request[v1.GetProductBySlugRequest]) (*connect.Response[v1.GetProductBySlugResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.ProductService.GetProductBySlug is not implemented"))
+}
+
 func (UnimplementedProductServiceHandler) SearchProductsByKeyword(context.Context, *connect.Request[v1.SearchProductsByKeywordRequest]) (*connect.Response[v1.SearchProductsByKeywordResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.ProductService.SearchProductsByKeyword is not implemented"))
 }
+
+func (UnimplementedProductServiceHandler) SuggestProducts(context.Context, *connect.BidiStream[v1.SuggestProductsRequest, v1.SuggestProductsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.ProductService.SuggestProducts is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) GetStock(context.Context, *connect.Request[v1.GetStockRequest]) (*connect.Response[v1.GetStockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.ProductService.GetStock is not implemented"))
+}
+
+// TagServiceClient is a client for the query.v1.TagService service.
+type TagServiceClient interface {
+	// すべてのタグを付与された商品数とともに問合せして返す
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
+}
+
+// NewTagServiceClient constructs a client for the query.v1.TagService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTagServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TagServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tagServiceMethods := v1.File_query_v1_query_proto.Services().ByName("TagService").Methods()
+	return &tagServiceClient{
+		listTags: connect.NewClient[v1.ListTagsRequest, v1.ListTagsResponse](
+			httpClient,
+			baseURL+TagServiceListTagsProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("ListTags")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tagServiceClient implements TagServiceClient.
+type tagServiceClient struct {
+	listTags *connect.Client[v1.ListTagsRequest, v1.ListTagsResponse]
+}
+
+// ListTags calls query.v1.TagService.ListTags.
+func (c *tagServiceClient) ListTags(ctx context.Context, req *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return c.listTags.CallUnary(ctx, req)
+}
+
+// TagServiceHandler is an implementation of the query.v1.TagService service.
+type TagServiceHandler interface {
+	// すべてのタグを付与された商品数とともに問合せして返す
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
+}
+
+// NewTagServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTagServiceHandler(svc TagServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tagServiceMethods := v1.File_query_v1_query_proto.Services().ByName("TagService").Methods()
+	tagServiceListTagsHandler := connect.NewUnaryHandler(
+		TagServiceListTagsProcedure,
+		svc.ListTags,
+		connect.WithSchema(tagServiceMethods.ByName("ListTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/query.v1.TagService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TagServiceListTagsProcedure:
+			tagServiceListTagsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTagServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTagServiceHandler struct{}
+
+func (UnimplementedTagServiceHandler) ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("query.v1.TagService.ListTags is not implemented"))
+}
@@ -1,3 +1,7 @@
+/*
+    初回起動時のスキーマ（db/migrations/0001_create_objects.up.sql と同じ定義）
+    以降のスキーマの変更は db/migrations にマイグレーションを追加し、migrateサブコマンドで適用する
+*/
 /*
     商品カテゴリ
 */
@@ -5,8 +9,18 @@ CREATE TABLE IF NOT EXISTS sample_db.category(
     id INT NOT NULL AUTO_INCREMENT,
     obj_id VARCHAR(36) NOT NULL,
     name VARCHAR(20) NOT NULL,
+    /* 重複判定用の正規化キー（NFKC・幅の統一・空白の集約）。照合順序によるかなの同一視を避けるためバイナリ比較にする */
+    name_key VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    /* 親カテゴリ（ルートカテゴリの場合はNULL）。子カテゴリを持つカテゴリは削除できない */
+    parent_id VARCHAR(36) NULL,
+    /* URLに使用するスラッグ（半角英小文字・数字とハイフン） */
+    slug VARCHAR(100) NOT NULL,
     PRIMARY KEY (id),
-    UNIQUE KEY idx_obj_id (obj_id)
+    UNIQUE KEY idx_obj_id (obj_id),
+    UNIQUE KEY idx_name_key (name_key),
+    UNIQUE KEY idx_slug (slug),
+    KEY idx_parent_id (parent_id),
+    FOREIGN KEY category_parent_fk (parent_id) REFERENCES category (obj_id)
 );
 /*
     商品
@@ -15,9 +29,168 @@ CREATE TABLE IF NOT EXISTS sample_db.product(
     id INT NOT NULL AUTO_INCREMENT,
     obj_id VARCHAR(36) NOT NULL,
     name VARCHAR(30) NOT NULL,
+    /* 重複判定用の正規化キー（NFKC・幅の統一・空白の集約）。照合順序によるかなの同一視を避けるためバイナリ比較にする */
+    name_key VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    /* 税抜の単価（通貨の最小単位） */
     price INT NOT NULL,
+    /* 通貨コード（ISO 4217） */
+    currency CHAR(3) NOT NULL DEFAULT 'JPY',
+    /* 消費税の税率区分（STANDARD: 標準税率10% / REDUCED: 軽減税率8%） */
+    tax_class VARCHAR(10) NOT NULL DEFAULT 'STANDARD',
+    /* 販売状態（DRAFT: 下書き / PUBLISHED: 公開中 / SUSPENDED: 一時停止 / DISCONTINUED: 販売終了）。既存の商品は公開中として扱う */
+    status VARCHAR(20) NOT NULL DEFAULT 'PUBLISHED',
+    /* JAN/EANバーコード（UPC-Aは先頭に0を付けた13桁に正規化）。未登録の場合はNULL */
+    barcode VARCHAR(13) NULL,
+    /* URLに使用するスラッグ（半角英小文字・数字とハイフン） */
+    slug VARCHAR(100) NOT NULL,
     category_id VARCHAR(36) NOT NULL,
     PRIMARY KEY (id),
     UNIQUE KEY idx_obj_id (obj_id),
+    UNIQUE KEY idx_name_key (name_key),
+    UNIQUE KEY idx_barcode (barcode),
+    UNIQUE KEY idx_slug (slug),
     FOREIGN KEY category_fk (category_id) REFERENCES category (obj_id)
 );
+/*
+    在庫
+    引当済みの数量(reserved)は在庫数(on_hand)を超えない
+*/
+CREATE TABLE IF NOT EXISTS sample_db.stock(
+    id INT NOT NULL AUTO_INCREMENT,
+    product_id VARCHAR(36) NOT NULL,
+    on_hand INT NOT NULL,
+    reserved INT NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_product_id (product_id),
+    CONSTRAINT stock_quantity_chk CHECK (reserved >= 0 AND reserved <= on_hand),
+    FOREIGN KEY stock_product_fk (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+/*
+    在庫引当
+    status: RESERVED(引当中) / RELEASED(解放済み) / COMMITTED(確定済み) / EXPIRED(期限切れ)
+*/
+CREATE TABLE IF NOT EXISTS sample_db.stock_reservation(
+    id INT NOT NULL AUTO_INCREMENT,
+    obj_id VARCHAR(36) NOT NULL,
+    product_id VARCHAR(36) NOT NULL,
+    quantity INT NOT NULL,
+    status VARCHAR(10) NOT NULL,
+    expires_at DATETIME NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_obj_id (obj_id),
+    KEY idx_status_expires_at (status, expires_at),
+    FOREIGN KEY stock_reservation_product_fk (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+/*
+    商品バリエーション
+    options: 選択肢の配列（例: [{"axis":"サイズ","value":"M"}]）
+    options_key: 商品内の選択肢の組み合わせの重複判定用の正規化キー
+    price_override: 価格の上書き（NULLの場合は商品価格を使用）
+    status: ACTIVE(販売中) / INACTIVE(販売停止)
+*/
+CREATE TABLE IF NOT EXISTS sample_db.product_variant(
+    id INT NOT NULL AUTO_INCREMENT,
+    obj_id VARCHAR(36) NOT NULL,
+    product_id VARCHAR(36) NOT NULL,
+    sku VARCHAR(64) NOT NULL,
+    options JSON NOT NULL,
+    options_key VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    price_override INT NULL,
+    status VARCHAR(10) NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_obj_id (obj_id),
+    UNIQUE KEY idx_sku (sku),
+    UNIQUE KEY idx_options_key (product_id, options_key),
+    FOREIGN KEY product_variant_product_fk (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+/*
+    タグ
+    商品に自由に付与できるラベル。カテゴリとは異なり1つの商品に複数付与できる
+*/
+CREATE TABLE IF NOT EXISTS sample_db.tag(
+    id INT NOT NULL AUTO_INCREMENT,
+    obj_id VARCHAR(36) NOT NULL,
+    name VARCHAR(30) NOT NULL,
+    /* 重複判定用の正規化キー（NFKC・幅の統一・空白の集約）。照合順序によるかなの同一視を避けるためバイナリ比較にする */
+    name_key VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_obj_id (obj_id),
+    UNIQUE KEY idx_name_key (name_key)
+);
+/*
+    商品とタグの関連
+*/
+CREATE TABLE IF NOT EXISTS sample_db.product_tag(
+    product_id VARCHAR(36) NOT NULL,
+    tag_id VARCHAR(36) NOT NULL,
+    PRIMARY KEY (product_id, tag_id),
+    KEY idx_tag_id (tag_id),
+    FOREIGN KEY product_tag_product_fk (product_id) REFERENCES product (obj_id) ON DELETE CASCADE,
+    FOREIGN KEY product_tag_tag_fk (tag_id) REFERENCES tag (obj_id) ON DELETE CASCADE
+);
+/*
+    商品名の翻訳（既定のロケール以外の商品名）
+    locale: 言語コード、または言語コードと地域コード（例: en, en-US）
+*/
+CREATE TABLE IF NOT EXISTS sample_db.product_name_translation(
+    product_id VARCHAR(36) NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    PRIMARY KEY (product_id, locale),
+    FOREIGN KEY product_name_translation_product_fk (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+/*
+    カテゴリ名の翻訳（既定のロケール以外のカテゴリ名）
+*/
+CREATE TABLE IF NOT EXISTS sample_db.category_name_translation(
+    category_id VARCHAR(36) NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(20) NOT NULL,
+    PRIMARY KEY (category_id, locale),
+    FOREIGN KEY category_name_translation_category_fk (category_id) REFERENCES category (obj_id) ON DELETE CASCADE
+);
+/*
+    商品の価格スケジュール（期間限定の販売価格）
+    price: 期間中の税抜の単価（商品の通貨の最小単位）
+    regular_price: 適用前の単価（適用時に記録し、終了時にこの価格へ戻す。未適用の場合はNULL）
+    status: SCHEDULED(予定) / ACTIVE(適用中) / COMPLETED(終了) / CANCELLED(取消)
+*/
+CREATE TABLE IF NOT EXISTS sample_db.product_price_schedule(
+    id INT NOT NULL AUTO_INCREMENT,
+    obj_id VARCHAR(36) NOT NULL,
+    product_id VARCHAR(36) NOT NULL,
+    price INT NOT NULL,
+    regular_price INT NULL,
+    status VARCHAR(10) NOT NULL,
+    starts_at DATETIME NOT NULL,
+    ends_at DATETIME NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_obj_id (obj_id),
+    KEY idx_product_id_status (product_id, status),
+    KEY idx_status_starts_at (status, starts_at),
+    KEY idx_status_ends_at (status, ends_at),
+    FOREIGN KEY product_price_schedule_product_fk (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+/*
+    商品のスラッグの履歴（変更前のスラッグ）
+    旧URLを現在のスラッグへ誘導するために使用する。現在のスラッグと重複しないことはアプリケーションで保証する
+*/
+CREATE TABLE IF NOT EXISTS sample_db.product_slug_history(
+    slug VARCHAR(100) NOT NULL,
+    product_id VARCHAR(36) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (slug),
+    KEY idx_product_id (product_id),
+    FOREIGN KEY product_slug_history_product_fk (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+/*
+    カテゴリのスラッグの履歴（変更前のスラッグ）
+*/
+CREATE TABLE IF NOT EXISTS sample_db.category_slug_history(
+    slug VARCHAR(100) NOT NULL,
+    category_id VARCHAR(36) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (slug),
+    KEY idx_category_id (category_id),
+    FOREIGN KEY category_slug_history_category_fk (category_id) REFERENCES category (obj_id) ON DELETE CASCADE
+);
@@ -1,35 +1,67 @@
 USE sample_db;
 
 /* 商品カテゴリ */
-INSERT INTO category (obj_id,name) VALUES('b1524011-b6af-417e-8bf2-f449dd58b5c0','文房具');
-INSERT INTO category (obj_id,name) VALUES('762bd1ea-9700-4bab-a28d-6cbebf20ddc2','雑貨');
-INSERT INTO category (obj_id,name) VALUES('c05b1952-3bdf-4449-9b83-d0d123a667ce','パソコン周辺機器');
+INSERT INTO category (obj_id,name,name_key,slug) VALUES('b1524011-b6af-417e-8bf2-f449dd58b5c0','文房具','文房具','stationery');
+INSERT INTO category (obj_id,name,name_key,slug) VALUES('762bd1ea-9700-4bab-a28d-6cbebf20ddc2','雑貨','雑貨','goods');
+INSERT INTO category (obj_id,name,name_key,slug) VALUES('c05b1952-3bdf-4449-9b83-d0d123a667ce','パソコン周辺機器','パソコン周辺機器','pc-peripherals');
+INSERT INTO category (obj_id,name,name_key,slug,parent_id) VALUES('3f6b8a2e-5c41-4d7e-9a0b-7e2d4c1f8b93','筆記具','筆記具','writing-instruments','b1524011-b6af-417e-8bf2-f449dd58b5c0');
+INSERT INTO category (obj_id,name,name_key,slug,parent_id) VALUES('a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64','鉛筆','鉛筆','pencils','3f6b8a2e-5c41-4d7e-9a0b-7e2d4c1f8b93');
 /* 商品 */
-INSERT INTO product (obj_id,name,price,category_id) VALUES('ac413f22-0cf1-490a-9635-7e9ca810e544','水性ボールペン(黒)',120,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('8f81a72a-58ef-422b-b472-d982e8665292','水性ボールペン(赤)',120,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('d952b98c-a1ea-478d-8380-3b90fde872ea','水性ボールペン(青)',120,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('9959e553-c9da-4646-bd85-8663a3541583','油性ボールペン(黒)',100,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('79023e82-9197-40a5-b236-26487f404be4','油性ボールペン(赤)',100,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('7dfd0fd0-0893-4d20-83ef-6f70aab0ab76','油性ボールペン(青)',100,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('dc7243af-c2ce-4136-bd5d-c6b28ee0a20a','蛍光ペン(黄)',130,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('83fbc81d-2498-4da6-b8c2-54878d3b67ff','蛍光ペン(赤)',130,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('ee4b3752-3fbd-45fc-afb5-8f37c3f701c9','蛍光ペン(青)',130,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('35cb51a7-df79-4771-9939-7f32c19bca45','蛍光ペン(緑)',130,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('e4850253-f363-4e79-8110-7335e4af45be','鉛筆(黒)',100,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('5ca7dbdf-0010-44c5-a001-e4c13c4fe3a1','鉛筆(赤)',100,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('fbc43b9b-90a9-4712-925c-4d66a2a30372','色鉛筆(12色)',400,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('4b3db238-8ada-49b4-bb60-1a034914e528','色鉛筆(48色)',1300,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('debdbd8c-5b48-4b1a-9697-98ba321ddd40','レザーネックレス',300,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('367197c5-32bd-479a-9102-c601145464c4','ワンタッチ開閉傘',3000,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('657578d2-8820-4490-a6ec-06d9c7cccd0f','金魚風呂敷',500,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('8c107894-4ebc-445b-9603-c9e8e6524f9d','折畳トートバッグ',600,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('2f8e074c-d0b1-441b-9dd4-6cf0ec570ce6','アイマスク',900,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('2fb9fe48-3520-47ef-9e1a-338db7152884','防水スプレー',500,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('f536311a-b9de-4873-a603-70953a2261be','キーホルダ',800,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('82014174-6785-4242-b307-a806fd1f8470','ワイヤレスマウス',900,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('ddd1e5ae-fb90-4a47-bb87-c91b305c7444','ワイヤレストラックボール',1300,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('aa5e07aa-06f9-4037-9755-e1de3c0ad4ac','有線光学式マウス',500,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('53cfa873-c86b-48bd-a68c-458d7bb5c844','光学式ゲーミングマウス',4800,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('376f7a75-cc99-4428-b35a-889bcb3c90af','有線ゲーミングマウス',3800,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('38c6e236-90ca-48a2-b427-acb9d834b591','USB有線式キーボード',1400,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
-INSERT INTO product (obj_id,name,price,category_id) VALUES('dc2e5a33-a2b7-4414-9a53-f9750e7da8ed','無線式キーボード',1900,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('ac413f22-0cf1-490a-9635-7e9ca810e544','水性ボールペン(黒)','水性ボールペン(黒)','water-based-ballpoint-pen-black',120,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('8f81a72a-58ef-422b-b472-d982e8665292','水性ボールペン(赤)','水性ボールペン(赤)','water-based-ballpoint-pen-red',120,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('d952b98c-a1ea-478d-8380-3b90fde872ea','水性ボールペン(青)','水性ボールペン(青)','water-based-ballpoint-pen-blue',120,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('9959e553-c9da-4646-bd85-8663a3541583','油性ボールペン(黒)','油性ボールペン(黒)','oil-based-ballpoint-pen-black',100,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('79023e82-9197-40a5-b236-26487f404be4','油性ボールペン(赤)','油性ボールペン(赤)','oil-based-ballpoint-pen-red',100,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('7dfd0fd0-0893-4d20-83ef-6f70aab0ab76','油性ボールペン(青)','油性ボールペン(青)','oil-based-ballpoint-pen-blue',100,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('dc7243af-c2ce-4136-bd5d-c6b28ee0a20a','蛍光ペン(黄)','蛍光ペン(黄)','highlighter-yellow',130,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('83fbc81d-2498-4da6-b8c2-54878d3b67ff','蛍光ペン(赤)','蛍光ペン(赤)','highlighter-red',130,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('ee4b3752-3fbd-45fc-afb5-8f37c3f701c9','蛍光ペン(青)','蛍光ペン(青)','highlighter-blue',130,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('35cb51a7-df79-4771-9939-7f32c19bca45','蛍光ペン(緑)','蛍光ペン(緑)','highlighter-green',130,'b1524011-b6af-417e-8bf2-f449dd58b5c0');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('e4850253-f363-4e79-8110-7335e4af45be','鉛筆(黒)','鉛筆(黒)','pencil-black',100,'a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('5ca7dbdf-0010-44c5-a001-e4c13c4fe3a1','鉛筆(赤)','鉛筆(赤)','pencil-red',100,'a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('fbc43b9b-90a9-4712-925c-4d66a2a30372','色鉛筆(12色)','色鉛筆(12色)','colored-pencils-12',400,'a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('4b3db238-8ada-49b4-bb60-1a034914e528','色鉛筆(48色)','色鉛筆(48色)','colored-pencils-48',1300,'a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('debdbd8c-5b48-4b1a-9697-98ba321ddd40','レザーネックレス','レザーネックレス','leather-necklace',300,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('367197c5-32bd-479a-9102-c601145464c4','ワンタッチ開閉傘','ワンタッチ開閉傘','one-touch-umbrella',3000,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('657578d2-8820-4490-a6ec-06d9c7cccd0f','金魚風呂敷','金魚風呂敷','goldfish-furoshiki',500,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('8c107894-4ebc-445b-9603-c9e8e6524f9d','折畳トートバッグ','折畳トートバッグ','foldable-tote-bag',600,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('2f8e074c-d0b1-441b-9dd4-6cf0ec570ce6','アイマスク','アイマスク','eye-mask',900,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('2fb9fe48-3520-47ef-9e1a-338db7152884','防水スプレー','防水スプレー','waterproof-spray',500,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('f536311a-b9de-4873-a603-70953a2261be','キーホルダ','キーホルダ','key-holder',800,'762bd1ea-9700-4bab-a28d-6cbebf20ddc2');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('82014174-6785-4242-b307-a806fd1f8470','ワイヤレスマウス','ワイヤレスマウス','wireless-mouse',900,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('ddd1e5ae-fb90-4a47-bb87-c91b305c7444','ワイヤレストラックボール','ワイヤレストラックボール','wireless-trackball',1300,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('aa5e07aa-06f9-4037-9755-e1de3c0ad4ac','有線光学式マウス','有線光学式マウス','wired-optical-mouse',500,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('53cfa873-c86b-48bd-a68c-458d7bb5c844','光学式ゲーミングマウス','光学式ゲーミングマウス','optical-gaming-mouse',4800,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('376f7a75-cc99-4428-b35a-889bcb3c90af','有線ゲーミングマウス','有線ゲーミングマウス','wired-gaming-mouse',3800,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('38c6e236-90ca-48a2-b427-acb9d834b591','USB有線式キーボード','USB有線式キーボード','usb-wired-keyboard',1400,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
+INSERT INTO product (obj_id,name,name_key,slug,price,category_id) VALUES('dc2e5a33-a2b7-4414-9a53-f9750e7da8ed','無線式キーボード','無線式キーボード','wireless-keyboard',1900,'c05b1952-3bdf-4449-9b83-d0d123a667ce');
+/* バーコード */
+UPDATE product SET barcode='4901234567894' WHERE obj_id='ac413f22-0cf1-490a-9635-7e9ca810e544';
+UPDATE product SET barcode='4569951116179' WHERE obj_id='82014174-6785-4242-b307-a806fd1f8470';
+/* 在庫 */
+INSERT INTO stock (product_id,on_hand,reserved) SELECT obj_id,100,0 FROM product;
+/* 商品バリエーション */
+INSERT INTO product_variant (obj_id,product_id,sku,options,options_key,price_override,status) VALUES('5e0f2d4a-8f5b-4f0e-9a55-2f3c3f6a1b01','ac413f22-0cf1-490a-9635-7e9ca810e544','PEN-BLK-05','[{"axis":"ボール径","value":"0.5mm"}]','ボール径=0.5mm',NULL,'ACTIVE');
+INSERT INTO product_variant (obj_id,product_id,sku,options,options_key,price_override,status) VALUES('5e0f2d4a-8f5b-4f0e-9a55-2f3c3f6a1b02','ac413f22-0cf1-490a-9635-7e9ca810e544','PEN-BLK-07','[{"axis":"ボール径","value":"0.7mm"}]','ボール径=0.7mm',130,'ACTIVE');
+/* タグ */
+INSERT INTO tag (obj_id,name,name_key) VALUES('0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a61','ワイヤレス','ワイヤレス');
+INSERT INTO tag (obj_id,name,name_key) VALUES('0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a62','ゲーミング','ゲーミング');
+/* 商品とタグの関連 */
+INSERT INTO product_tag (product_id,tag_id) VALUES('82014174-6785-4242-b307-a806fd1f8470','0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a61');
+INSERT INTO product_tag (product_id,tag_id) VALUES('ddd1e5ae-fb90-4a47-bb87-c91b305c7444','0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a61');
+INSERT INTO product_tag (product_id,tag_id) VALUES('dc2e5a33-a2b7-4414-9a53-f9750e7da8ed','0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a61');
+INSERT INTO product_tag (product_id,tag_id) VALUES('53cfa873-c86b-48bd-a68c-458d7bb5c844','0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a62');
+INSERT INTO product_tag (product_id,tag_id) VALUES('376f7a75-cc99-4428-b35a-889bcb3c90af','0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a62');
+/* 商品名・カテゴリ名の翻訳 */
+INSERT INTO category_name_translation (category_id,locale,name) VALUES('b1524011-b6af-417e-8bf2-f449dd58b5c0','en','Stationery');
+INSERT INTO category_name_translation (category_id,locale,name) VALUES('762bd1ea-9700-4bab-a28d-6cbebf20ddc2','en','Goods');
+INSERT INTO category_name_translation (category_id,locale,name) VALUES('c05b1952-3bdf-4449-9b83-d0d123a667ce','en','PC Peripherals');
+INSERT INTO product_name_translation (product_id,locale,name) VALUES('82014174-6785-4242-b307-a806fd1f8470','en','Wireless Mouse');
+INSERT INTO product_name_translation (product_id,locale,name) VALUES('ddd1e5ae-fb90-4a47-bb87-c91b305c7444','en','Wireless Trackball');
+INSERT INTO product_name_translation (product_id,locale,name) VALUES('dc2e5a33-a2b7-4414-9a53-f9750e7da8ed','en','Wireless Keyboard');
+/* 価格スケジュール（蛍光ペン(黄)の終了済みのセールと予定のセール） */
+INSERT INTO product_price_schedule (obj_id,product_id,price,regular_price,status,starts_at,ends_at) VALUES('5e0c2b7a-41d9-4f3e-9a68-0b1d2c3e4f51','dc7243af-c2ce-4136-bd5d-c6b28ee0a20a',100,130,'COMPLETED','2020-01-03 15:00:00','2020-01-05 15:00:00');
+INSERT INTO product_price_schedule (obj_id,product_id,price,status,starts_at,ends_at) VALUES('5e0c2b7a-41d9-4f3e-9a68-0b1d2c3e4f52','dc7243af-c2ce-4136-bd5d-c6b28ee0a20a',110,'SCHEDULED','2099-01-02 15:00:00','2099-01-04 15:00:00');
+/* スラッグの履歴（ワイヤレスマウスと文房具の変更前のスラッグ） */
+INSERT INTO product_slug_history (slug,product_id) VALUES('waiyaresumausu','82014174-6785-4242-b307-a806fd1f8470');
+INSERT INTO category_slug_history (slug,category_id) VALUES('bunbogu','b1524011-b6af-417e-8bf2-f449dd58b5c0');
@@ -0,0 +1,15 @@
+/*
+    初期スキーマの削除（外部キーの参照元から順に削除する）
+*/
+DROP TABLE IF EXISTS category_slug_history;
+DROP TABLE IF EXISTS product_slug_history;
+DROP TABLE IF EXISTS product_price_schedule;
+DROP TABLE IF EXISTS category_name_translation;
+DROP TABLE IF EXISTS product_name_translation;
+DROP TABLE IF EXISTS product_tag;
+DROP TABLE IF EXISTS tag;
+DROP TABLE IF EXISTS product_variant;
+DROP TABLE IF EXISTS stock_reservation;
+DROP TABLE IF EXISTS stock;
+DROP TABLE IF EXISTS product;
+DROP TABLE IF EXISTS category;
@@ -0,0 +1,12 @@
+// Package migrations はデータベースのスキーマのマイグレーションファイルを提供します。
+//
+// ファイル名は「<バージョン>_<名前>.up.sql」と「<バージョン>_<名前>.down.sql」の形式で、
+// バージョンの昇順に適用されます。スキーマを変更する場合は、既存のファイルを編集せずに新しいバージョンのファイルを追加してください。
+package migrations
+
+import "embed"
+
+// FS はマイグレーションファイルを埋め込んだファイルシステムです。
+//
+//go:embed *.sql
+var FS embed.FS
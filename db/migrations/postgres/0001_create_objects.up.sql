@@ -0,0 +1,198 @@
+/*
+    初期スキーマ（PostgreSQL用。../0001_create_objects.up.sql と同じ定義）
+    インデックス名はスキーマ内で一意である必要があるため、テーブル名を接頭辞に付ける。
+    一意制約違反の判定に使用するため、一意制約の名前は idx_name_key などMySQLのインデックス名を含めること。
+    テーブルは接続先のスキーマ（search_path）に作成する
+*/
+/*
+    商品カテゴリ
+*/
+CREATE TABLE IF NOT EXISTS category(
+    id INTEGER GENERATED BY DEFAULT AS IDENTITY,
+    obj_id VARCHAR(36) NOT NULL,
+    name VARCHAR(20) NOT NULL,
+    /* 重複判定用の正規化キー（NFKC・幅の統一・空白の集約）。PostgreSQLの文字列の比較はバイナリ比較のため照合順序の指定は不要 */
+    name_key VARCHAR(255) NOT NULL,
+    /* 親カテゴリ（ルートカテゴリの場合はNULL）。子カテゴリを持つカテゴリは削除できない */
+    parent_id VARCHAR(36) NULL,
+    /* URLに使用するスラッグ（半角英小文字・数字とハイフン） */
+    slug VARCHAR(100) NOT NULL,
+    CONSTRAINT category_pkey PRIMARY KEY (id),
+    CONSTRAINT category_idx_obj_id UNIQUE (obj_id),
+    CONSTRAINT category_idx_name_key UNIQUE (name_key),
+    CONSTRAINT category_idx_slug UNIQUE (slug),
+    CONSTRAINT category_parent_fk FOREIGN KEY (parent_id) REFERENCES category (obj_id)
+);
+CREATE INDEX IF NOT EXISTS category_idx_parent_id ON category (parent_id);
+/*
+    商品
+*/
+CREATE TABLE IF NOT EXISTS product(
+    id INTEGER GENERATED BY DEFAULT AS IDENTITY,
+    obj_id VARCHAR(36) NOT NULL,
+    name VARCHAR(30) NOT NULL,
+    /* 重複判定用の正規化キー（NFKC・幅の統一・空白の集約） */
+    name_key VARCHAR(255) NOT NULL,
+    /* 税抜の単価（通貨の最小単位） */
+    price INTEGER NOT NULL,
+    /* 通貨コード（ISO 4217） */
+    currency CHAR(3) NOT NULL DEFAULT 'JPY',
+    /* 消費税の税率区分（STANDARD: 標準税率10% / REDUCED: 軽減税率8%） */
+    tax_class VARCHAR(10) NOT NULL DEFAULT 'STANDARD',
+    /* 販売状態（DRAFT: 下書き / PUBLISHED: 公開中 / SUSPENDED: 一時停止 / DISCONTINUED: 販売終了）。既存の商品は公開中として扱う */
+    status VARCHAR(20) NOT NULL DEFAULT 'PUBLISHED',
+    /* JAN/EANバーコード（UPC-Aは先頭に0を付けた13桁に正規化）。未登録の場合はNULL */
+    barcode VARCHAR(13) NULL,
+    /* URLに使用するスラッグ（半角英小文字・数字とハイフン） */
+    slug VARCHAR(100) NOT NULL,
+    category_id VARCHAR(36) NOT NULL,
+    CONSTRAINT product_pkey PRIMARY KEY (id),
+    CONSTRAINT product_idx_obj_id UNIQUE (obj_id),
+    CONSTRAINT product_idx_name_key UNIQUE (name_key),
+    CONSTRAINT product_idx_barcode UNIQUE (barcode),
+    CONSTRAINT product_idx_slug UNIQUE (slug),
+    CONSTRAINT product_category_fk FOREIGN KEY (category_id) REFERENCES category (obj_id)
+);
+/*
+    在庫
+    引当済みの数量(reserved)は在庫数(on_hand)を超えない
+*/
+CREATE TABLE IF NOT EXISTS stock(
+    id INTEGER GENERATED BY DEFAULT AS IDENTITY,
+    product_id VARCHAR(36) NOT NULL,
+    on_hand INTEGER NOT NULL,
+    reserved INTEGER NOT NULL,
+    CONSTRAINT stock_pkey PRIMARY KEY (id),
+    CONSTRAINT stock_idx_product_id UNIQUE (product_id),
+    CONSTRAINT stock_quantity_chk CHECK (reserved >= 0 AND reserved <= on_hand),
+    CONSTRAINT stock_product_fk FOREIGN KEY (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+/*
+    在庫引当
+    status: RESERVED(引当中) / RELEASED(解放済み) / COMMITTED(確定済み) / EXPIRED(期限切れ)
+*/
+CREATE TABLE IF NOT EXISTS stock_reservation(
+    id INTEGER GENERATED BY DEFAULT AS IDENTITY,
+    obj_id VARCHAR(36) NOT NULL,
+    product_id VARCHAR(36) NOT NULL,
+    quantity INTEGER NOT NULL,
+    status VARCHAR(10) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT stock_reservation_pkey PRIMARY KEY (id),
+    CONSTRAINT stock_reservation_idx_obj_id UNIQUE (obj_id),
+    CONSTRAINT stock_reservation_product_fk FOREIGN KEY (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS stock_reservation_idx_status_expires_at ON stock_reservation (status, expires_at);
+/*
+    商品バリエーション
+    options: 選択肢の配列（例: [{"axis":"サイズ","value":"M"}]）
+    options_key: 商品内の選択肢の組み合わせの重複判定用の正規化キー
+    price_override: 価格の上書き（NULLの場合は商品価格を使用）
+    status: ACTIVE(販売中) / INACTIVE(販売停止)
+*/
+CREATE TABLE IF NOT EXISTS product_variant(
+    id INTEGER GENERATED BY DEFAULT AS IDENTITY,
+    obj_id VARCHAR(36) NOT NULL,
+    product_id VARCHAR(36) NOT NULL,
+    sku VARCHAR(64) NOT NULL,
+    options JSONB NOT NULL,
+    options_key VARCHAR(255) NOT NULL,
+    price_override INTEGER NULL,
+    status VARCHAR(10) NOT NULL,
+    CONSTRAINT product_variant_pkey PRIMARY KEY (id),
+    CONSTRAINT product_variant_idx_obj_id UNIQUE (obj_id),
+    CONSTRAINT product_variant_idx_sku UNIQUE (sku),
+    CONSTRAINT product_variant_idx_options_key UNIQUE (product_id, options_key),
+    CONSTRAINT product_variant_product_fk FOREIGN KEY (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+/*
+    タグ
+    商品に自由に付与できるラベル。カテゴリとは異なり1つの商品に複数付与できる
+*/
+CREATE TABLE IF NOT EXISTS tag(
+    id INTEGER GENERATED BY DEFAULT AS IDENTITY,
+    obj_id VARCHAR(36) NOT NULL,
+    name VARCHAR(30) NOT NULL,
+    /* 重複判定用の正規化キー（NFKC・幅の統一・空白の集約） */
+    name_key VARCHAR(255) NOT NULL,
+    CONSTRAINT tag_pkey PRIMARY KEY (id),
+    CONSTRAINT tag_idx_obj_id UNIQUE (obj_id),
+    CONSTRAINT tag_idx_name_key UNIQUE (name_key)
+);
+/*
+    商品とタグの関連
+*/
+CREATE TABLE IF NOT EXISTS product_tag(
+    product_id VARCHAR(36) NOT NULL,
+    tag_id VARCHAR(36) NOT NULL,
+    CONSTRAINT product_tag_pkey PRIMARY KEY (product_id, tag_id),
+    CONSTRAINT product_tag_product_fk FOREIGN KEY (product_id) REFERENCES product (obj_id) ON DELETE CASCADE,
+    CONSTRAINT product_tag_tag_fk FOREIGN KEY (tag_id) REFERENCES tag (obj_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS product_tag_idx_tag_id ON product_tag (tag_id);
+/*
+    商品名の翻訳（既定のロケール以外の商品名）
+    locale: 言語コード、または言語コードと地域コード（例: en, en-US）
+*/
+CREATE TABLE IF NOT EXISTS product_name_translation(
+    product_id VARCHAR(36) NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    CONSTRAINT product_name_translation_pkey PRIMARY KEY (product_id, locale),
+    CONSTRAINT product_name_translation_product_fk FOREIGN KEY (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+/*
+    カテゴリ名の翻訳（既定のロケール以外のカテゴリ名）
+*/
+CREATE TABLE IF NOT EXISTS category_name_translation(
+    category_id VARCHAR(36) NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(20) NOT NULL,
+    CONSTRAINT category_name_translation_pkey PRIMARY KEY (category_id, locale),
+    CONSTRAINT category_name_translation_category_fk FOREIGN KEY (category_id) REFERENCES category (obj_id) ON DELETE CASCADE
+);
+/*
+    商品の価格スケジュール（期間限定の販売価格）
+    price: 期間中の税抜の単価（商品の通貨の最小単位）
+    regular_price: 適用前の単価（適用時に記録し、終了時にこの価格へ戻す。未適用の場合はNULL）
+    status: SCHEDULED(予定) / ACTIVE(適用中) / COMPLETED(終了) / CANCELLED(取消)
+*/
+CREATE TABLE IF NOT EXISTS product_price_schedule(
+    id INTEGER GENERATED BY DEFAULT AS IDENTITY,
+    obj_id VARCHAR(36) NOT NULL,
+    product_id VARCHAR(36) NOT NULL,
+    price INTEGER NOT NULL,
+    regular_price INTEGER NULL,
+    status VARCHAR(10) NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT product_price_schedule_pkey PRIMARY KEY (id),
+    CONSTRAINT product_price_schedule_idx_obj_id UNIQUE (obj_id),
+    CONSTRAINT product_price_schedule_product_fk FOREIGN KEY (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS product_price_schedule_idx_product_id_status ON product_price_schedule (product_id, status);
+CREATE INDEX IF NOT EXISTS product_price_schedule_idx_status_starts_at ON product_price_schedule (status, starts_at);
+CREATE INDEX IF NOT EXISTS product_price_schedule_idx_status_ends_at ON product_price_schedule (status, ends_at);
+/*
+    商品のスラッグの履歴（変更前のスラッグ）
+    旧URLを現在のスラッグへ誘導するために使用する。現在のスラッグと重複しないことはアプリケーションで保証する
+*/
+CREATE TABLE IF NOT EXISTS product_slug_history(
+    slug VARCHAR(100) NOT NULL,
+    product_id VARCHAR(36) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT product_slug_history_pkey PRIMARY KEY (slug),
+    CONSTRAINT product_slug_history_product_fk FOREIGN KEY (product_id) REFERENCES product (obj_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS product_slug_history_idx_product_id ON product_slug_history (product_id);
+/*
+    カテゴリのスラッグの履歴（変更前のスラッグ）
+*/
+CREATE TABLE IF NOT EXISTS category_slug_history(
+    slug VARCHAR(100) NOT NULL,
+    category_id VARCHAR(36) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT category_slug_history_pkey PRIMARY KEY (slug),
+    CONSTRAINT category_slug_history_category_fk FOREIGN KEY (category_id) REFERENCES category (obj_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS category_slug_history_idx_category_id ON category_slug_history (category_id);
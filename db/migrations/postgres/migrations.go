@@ -0,0 +1,12 @@
+// Package postgres はPostgreSQL用のスキーマのマイグレーションファイルを提供します。
+//
+// ファイルの形式とバージョンは親ディレクトリのMySQL用のマイグレーションと同じです。
+// スキーマを変更する場合は、MySQL用と同じバージョンのファイルを両方に追加してください。
+package postgres
+
+import "embed"
+
+// FS はマイグレーションファイルを埋め込んだファイルシステムです。
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,228 @@
+package catalogclient_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
+	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/catalogclient"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/catalogclient/catalogclienttest"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCatalogClient(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CatalogClient Suite")
+}
+
+var _ = Describe("Client", func() {
+	var (
+		ctx    context.Context
+		server *catalogclienttest.Server
+		client *catalogclient.Client
+		drinks *catalogclient.Category
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = catalogclienttest.NewServer()
+		DeferCleanup(server.Close)
+		policy := interceptor.DefaultRetryPolicy()
+		policy.InitialBackoff = time.Millisecond
+		policy.MaxBackoff = time.Millisecond
+		client = server.Client(catalogclient.WithRetryPolicy(policy))
+
+		var err error
+		drinks, err = client.CreateCategory(ctx, &catalogclient.NewCategory{Name: "Drinks"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("商品", func() {
+		It("作成した商品を取得・更新できる", func() {
+			created, err := client.CreateProduct(ctx, &catalogclient.NewProduct{
+				Name:       "Green Tea",
+				Price:      300,
+				CategoryID: drinks.ID,
+				TaxClass:   catalogclient.TaxClassReduced,
+				Barcode:    "4901234567894",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.Category.Name).To(Equal("Drinks"), "カテゴリ名はQuery Serviceから補完する")
+			Expect(created.Status).To(Equal(catalogclient.ProductStatusDraft))
+			Expect(created.Slug).To(Equal("green-tea"))
+			Expect(created.PriceIncludingTax).To(Equal(catalogclient.Money{Amount: 324, Currency: "JPY"}))
+
+			got, err := client.GetProductByBarcode(ctx, "4901234567894")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.ID).To(Equal(created.ID))
+
+			updated, err := client.UpdateProduct(ctx, &catalogclient.ProductUpdate{
+				ID: created.ID, Name: "Matcha", Price: 500, CategoryID: drinks.ID, Slug: "matcha",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Name).To(Equal("Matcha"))
+			Expect(updated.TaxClass).To(Equal(catalogclient.TaxClassReduced), "未指定の税率区分は維持する")
+			Expect(updated.Barcode).To(Equal("4901234567894"), "nilのバーコードは維持する")
+
+			bySlug, moved, err := client.GetProductBySlug(ctx, "green-tea")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved).To(BeTrue())
+			Expect(bySlug.Slug).To(Equal("matcha"))
+
+			published, err := client.PublishProduct(ctx, created.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(published.Status).To(Equal(catalogclient.ProductStatusPublished))
+		})
+
+		DescribeTable("エラーコードに対応するエラーを返す",
+			func(act func() error, target error) {
+				Expect(act()).To(MatchError(target))
+			},
+			Entry("存在しない商品はErrNotFound", func() error {
+				_, err := client.GetProduct(ctx, "missing")
+				return err
+			}, catalogclient.ErrNotFound),
+			Entry("商品名の重複はErrAlreadyExists", func() error {
+				product := &catalogclient.NewProduct{Name: "Coffee", Price: 450, CategoryID: drinks.ID}
+				if _, err := client.CreateProduct(ctx, product); err != nil {
+					return err
+				}
+				_, err := client.CreateProduct(ctx, product)
+				return err
+			}, catalogclient.ErrAlreadyExists),
+			Entry("商品が存在するカテゴリの削除はErrFailedPrecondition", func() error {
+				if _, err := client.CreateProduct(ctx, &catalogclient.NewProduct{Name: "Coffee", Price: 450, CategoryID: drinks.ID}); err != nil {
+					return err
+				}
+				_, err := client.DeleteCategory(ctx, drinks.ID)
+				return err
+			}, catalogclient.ErrFailedPrecondition),
+			Entry("範囲外の単価は送信せずにErrInvalidArgument", func() error {
+				_, err := client.CreateProduct(ctx, &catalogclient.NewProduct{Name: "Coffee", Price: 0, CategoryID: drinks.ID})
+				return err
+			}, catalogclient.ErrInvalidArgument),
+		)
+
+		It("エラーからエラーコードとメッセージを取得できる", func() {
+			_, err := client.GetProduct(ctx, "missing")
+			var clientErr *catalogclient.Error
+			Expect(errors.As(err, &clientErr)).To(BeTrue())
+			Expect(clientErr.Code).To(Equal(connect.CodeNotFound))
+			Expect(clientErr.Message).To(ContainSubstring("missing"))
+		})
+	})
+
+	Describe("イテレーター", func() {
+		BeforeEach(func() {
+			for _, name := range []string{"Green Tea", "Coffee", "Orange Juice"} {
+				_, err := client.CreateProduct(ctx, &catalogclient.NewProduct{Name: name, Price: 300, CategoryID: drinks.ID})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		It("条件を指定しない場合はストリームから順に返す", func() {
+			var names []string
+			for product, err := range client.Products(ctx, nil) {
+				Expect(err).NotTo(HaveOccurred())
+				names = append(names, product.Name)
+			}
+			Expect(names).To(Equal([]string{"Green Tea", "Coffee", "Orange Juice"}))
+			Expect(server.Calls(queryconnect.ProductServiceStreamProductsProcedure)).To(Equal(1))
+		})
+
+		It("ループを途中で抜けられる", func() {
+			count := 0
+			for _, err := range client.Products(ctx, nil) {
+				Expect(err).NotTo(HaveOccurred())
+				count++
+				if count == 2 {
+					break
+				}
+			}
+			Expect(count).To(Equal(2))
+		})
+
+		It("条件を指定した場合は一覧を順に返す", func() {
+			var count int
+			for _, err := range client.Products(ctx, &catalogclient.ListProductsOptions{CategoryID: drinks.ID}) {
+				Expect(err).NotTo(HaveOccurred())
+				count++
+			}
+			Expect(count).To(Equal(3))
+			Expect(server.Calls(queryconnect.ProductServiceListProductsProcedure)).To(Equal(1))
+		})
+
+		It("エラーを要素として返して終了する", func() {
+			server.FailNext(queryconnect.ProductServiceStreamProductsProcedure, connect.NewError(connect.CodeInternal, errors.New("boom")), 1)
+			var errs []error
+			for product, err := range client.Products(ctx, nil) {
+				Expect(product).To(BeNil())
+				errs = append(errs, err)
+			}
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).To(MatchError(ContainSubstring("boom")))
+		})
+	})
+
+	Describe("再試行", func() {
+		It("参照系はUnavailableを再試行する", func() {
+			server.FailNext(queryconnect.CategoryServiceGetCategoryByIdProcedure, connect.NewError(connect.CodeUnavailable, errors.New("down")), 2)
+			category, err := client.GetCategory(ctx, drinks.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(category.Name).To(Equal("Drinks"))
+			Expect(server.Calls(queryconnect.CategoryServiceGetCategoryByIdProcedure)).To(Equal(3))
+		})
+
+		It("最大試行回数を超えた場合はErrUnavailableを返す", func() {
+			server.FailNext(queryconnect.CategoryServiceListCategoriesProcedure, connect.NewError(connect.CodeUnavailable, errors.New("down")), 3)
+			_, err := client.ListCategories(ctx)
+			Expect(err).To(MatchError(catalogclient.ErrUnavailable))
+			Expect(server.Calls(queryconnect.CategoryServiceListCategoriesProcedure)).To(Equal(3))
+		})
+
+		It("再試行しないエラーコードは再試行しない", func() {
+			_, err := client.GetCategory(ctx, "missing")
+			Expect(err).To(MatchError(catalogclient.ErrNotFound))
+			Expect(server.Calls(queryconnect.CategoryServiceGetCategoryByIdProcedure)).To(Equal(1))
+		})
+
+		It("更新系は重複して適用しないよう再試行しない", func() {
+			server.FailNext(cmdconnect.CategoryServiceCreateCategoryProcedure, connect.NewError(connect.CodeUnavailable, errors.New("down")), 1)
+			_, err := client.CreateCategory(ctx, &catalogclient.NewCategory{Name: "Food"})
+			Expect(err).To(MatchError(catalogclient.ErrUnavailable))
+			Expect(server.Calls(cmdconnect.CategoryServiceCreateCategoryProcedure)).To(Equal(2), "BeforeEachの作成を含む")
+		})
+	})
+
+	Describe("カテゴリ", func() {
+		It("子カテゴリの取得と移動ができる", func() {
+			tea, err := client.CreateCategory(ctx, &catalogclient.NewCategory{Name: "Tea", ParentID: drinks.ID})
+			Expect(err).NotTo(HaveOccurred())
+
+			children, err := client.ChildCategories(ctx, drinks.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(children).To(HaveLen(1))
+			Expect(children[0].ID).To(Equal(tea.ID))
+
+			_, err = client.MoveCategory(ctx, drinks.ID, tea.ID)
+			Expect(err).To(MatchError(catalogclient.ErrFailedPrecondition))
+
+			moved, err := client.MoveCategory(ctx, tea.ID, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved.ParentID).To(BeEmpty())
+
+			var names []string
+			for category, err := range client.Categories(ctx) {
+				Expect(err).NotTo(HaveOccurred())
+				names = append(names, category.Name)
+			}
+			Expect(names).To(Equal([]string{"Drinks", "Tea"}))
+		})
+	})
+})
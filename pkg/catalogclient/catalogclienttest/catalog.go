@@ -0,0 +1,461 @@
+package catalogclienttest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	command "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultCurrency は通貨を指定しない場合の通貨です。
+const defaultCurrency = "JPY"
+
+// statusTransitions は販売状態ごとに遷移できる状態です。Command Serviceと同じく、販売終了した商品は遷移できません。
+var statusTransitions = map[common.ProductStatus][]common.ProductStatus{
+	common.ProductStatus_PRODUCT_STATUS_DRAFT: {
+		common.ProductStatus_PRODUCT_STATUS_PUBLISHED, common.ProductStatus_PRODUCT_STATUS_DISCONTINUED,
+	},
+	common.ProductStatus_PRODUCT_STATUS_PUBLISHED: {
+		common.ProductStatus_PRODUCT_STATUS_SUSPENDED, common.ProductStatus_PRODUCT_STATUS_DISCONTINUED,
+	},
+	common.ProductStatus_PRODUCT_STATUS_SUSPENDED: {
+		common.ProductStatus_PRODUCT_STATUS_PUBLISHED, common.ProductStatus_PRODUCT_STATUS_DISCONTINUED,
+	},
+}
+
+// catalog は商品とカテゴリを登録順に保持します。Serverのロックを取得して操作します。
+type catalog struct {
+	seq           int
+	categories    []*common.Category
+	products      []*common.Product
+	categorySlugs map[string]string // 変更前のスラッグとカテゴリIDの対応
+	productSlugs  map[string]string // 変更前のスラッグと商品IDの対応
+}
+
+func newCatalog() *catalog {
+	return &catalog{categorySlugs: map[string]string{}, productSlugs: map[string]string{}}
+}
+
+// nextID は連番のIDを生成します。
+func (c *catalog) nextID(prefix string) string {
+	c.seq++
+	return fmt.Sprintf("%s-%04d", prefix, c.seq)
+}
+
+// category はIDでカテゴリを検索します。
+func (c *catalog) category(id string) (*common.Category, error) {
+	for _, category := range c.categories {
+		if category.GetId() == id {
+			return category, nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("category %s not found", id))
+}
+
+// categoryBySlug はスラッグでカテゴリを検索します。変更前のスラッグの場合はmovedをtrueにします。
+func (c *catalog) categoryBySlug(slug string) (*common.Category, bool, error) {
+	for _, category := range c.categories {
+		if category.GetSlug() == slug {
+			return category, false, nil
+		}
+	}
+	if id, ok := c.categorySlugs[slug]; ok {
+		category, err := c.category(id)
+		return category, true, err
+	}
+	return nil, false, connect.NewError(connect.CodeNotFound, fmt.Errorf("category slug %s not found", slug))
+}
+
+// children は直下の子カテゴリを返します。
+func (c *catalog) children(parentID string) []*common.Category {
+	var children []*common.Category
+	for _, category := range c.categories {
+		if category.GetParentId() == parentID {
+			children = append(children, category)
+		}
+	}
+	return children
+}
+
+// isDescendant はidのカテゴリがancestorIDの子孫（自身を含む）かを判定します。
+func (c *catalog) isDescendant(id string, ancestorID string) bool {
+	for id != "" {
+		if id == ancestorID {
+			return true
+		}
+		category, err := c.category(id)
+		if err != nil {
+			return false
+		}
+		id = category.GetParentId()
+	}
+	return false
+}
+
+// checkCategoryUnique はカテゴリ名・スラッグが他のカテゴリと重複していないかを検証します。
+func (c *catalog) checkCategoryUnique(id string, name string, slug string) error {
+	for _, category := range c.categories {
+		if category.GetId() == id {
+			continue
+		}
+		if category.GetName() == name {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("category name %s already exists", name))
+		}
+		if category.GetSlug() == slug {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("category slug %s already exists", slug))
+		}
+	}
+	return nil
+}
+
+// createCategory はカテゴリを作成します。
+func (c *catalog) createCategory(req *command.CreateCategoryRequest) (*common.Category, error) {
+	name := req.GetName().GetValue()
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("category name is required"))
+	}
+	parentID := req.GetParentId().GetValue()
+	if parentID != "" {
+		if _, err := c.category(parentID); err != nil {
+			return nil, err
+		}
+	}
+	slug := req.GetSlug()
+	if slug == "" {
+		slug = slugify(name)
+	}
+	if err := c.checkCategoryUnique("", name, slug); err != nil {
+		return nil, err
+	}
+	category := &common.Category{}
+	category.SetId(c.nextID("category"))
+	category.SetName(name)
+	if parentID != "" {
+		category.SetParentId(parentID)
+	}
+	category.SetSlug(slug)
+	category.SetTranslations(mergeTranslations(nil, req.GetTranslations()))
+	c.categories = append(c.categories, category)
+	return category, nil
+}
+
+// updateCategory はカテゴリを更新します。カテゴリ名はカテゴリを参照する商品にも反映します。
+func (c *catalog) updateCategory(in *command.UpdateCategoryRequest_Category) (*common.Category, error) {
+	category, err := c.category(in.GetId().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	name := in.GetName().GetValue()
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("category name is required"))
+	}
+	slug := category.GetSlug()
+	if in.HasSlug() {
+		slug = in.GetSlug()
+	}
+	if err := c.checkCategoryUnique(category.GetId(), name, slug); err != nil {
+		return nil, err
+	}
+	if slug != category.GetSlug() {
+		c.categorySlugs[category.GetSlug()] = category.GetId()
+	}
+	category.SetName(name)
+	category.SetSlug(slug)
+	category.SetTranslations(mergeTranslations(category.GetTranslations(), in.GetTranslations()))
+	for _, product := range c.products {
+		if product.GetCategory().GetId() == category.GetId() {
+			product.SetCategory(proto.CloneOf(category))
+		}
+	}
+	return category, nil
+}
+
+// deleteCategory はカテゴリを削除します。商品または子カテゴリが存在する場合は削除できません。
+func (c *catalog) deleteCategory(id string) (*common.Category, error) {
+	category, err := c.category(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.children(id)) > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("category %s has child categories", id))
+	}
+	for _, product := range c.products {
+		if product.GetCategory().GetId() == id {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("category %s has products", id))
+		}
+	}
+	c.categories = slices.DeleteFunc(c.categories, func(v *common.Category) bool { return v.GetId() == id })
+	return category, nil
+}
+
+// moveCategory はカテゴリの親カテゴリを変更します。自身の子孫の下には移動できません。
+func (c *catalog) moveCategory(id string, parentID string) (*common.Category, error) {
+	category, err := c.category(id)
+	if err != nil {
+		return nil, err
+	}
+	if parentID != "" {
+		if _, err := c.category(parentID); err != nil {
+			return nil, err
+		}
+		if c.isDescendant(parentID, id) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("category %s cannot be moved under its descendant %s", id, parentID))
+		}
+		category.SetParentId(parentID)
+	} else {
+		category.ClearParentId()
+	}
+	return category, nil
+}
+
+// product はIDで商品を検索します。
+func (c *catalog) product(id string) (*common.Product, error) {
+	for _, product := range c.products {
+		if product.GetId() == id {
+			return product, nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("product %s not found", id))
+}
+
+// productByBarcode はバーコードで商品を検索します。
+func (c *catalog) productByBarcode(barcode string) (*common.Product, error) {
+	for _, product := range c.products {
+		if barcode != "" && product.GetBarcode() == barcode {
+			return product, nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("product barcode %s not found", barcode))
+}
+
+// productBySlug はスラッグで商品を検索します。変更前のスラッグの場合はmovedをtrueにします。
+func (c *catalog) productBySlug(slug string) (*common.Product, bool, error) {
+	for _, product := range c.products {
+		if product.GetSlug() == slug {
+			return product, false, nil
+		}
+	}
+	if id, ok := c.productSlugs[slug]; ok {
+		product, err := c.product(id)
+		return product, true, err
+	}
+	return nil, false, connect.NewError(connect.CodeNotFound, fmt.Errorf("product slug %s not found", slug))
+}
+
+// listProducts はカテゴリで絞り込んだ商品を返します。
+func (c *catalog) listProducts(categoryID string, includeDescendants bool) []*common.Product {
+	var products []*common.Product
+	for _, product := range c.products {
+		id := product.GetCategory().GetId()
+		switch {
+		case categoryID == "", id == categoryID, includeDescendants && c.isDescendant(id, categoryID):
+			products = append(products, product)
+		}
+	}
+	return products
+}
+
+// searchProducts は商品名（翻訳を含む）にキーワードを含む商品を返します。
+func (c *catalog) searchProducts(keyword string) []*common.Product {
+	keyword = strings.ToLower(keyword)
+	var products []*common.Product
+	for _, product := range c.products {
+		names := []string{product.GetName()}
+		for _, name := range product.GetTranslations() {
+			names = append(names, name)
+		}
+		if slices.ContainsFunc(names, func(name string) bool { return strings.Contains(strings.ToLower(name), keyword) }) {
+			products = append(products, product)
+		}
+	}
+	return products
+}
+
+// checkProductUnique は商品名・スラッグ・バーコードが他の商品と重複していないかを検証します。
+func (c *catalog) checkProductUnique(id string, name string, slug string, barcode string) error {
+	for _, product := range c.products {
+		if product.GetId() == id {
+			continue
+		}
+		switch {
+		case product.GetName() == name:
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("product name %s already exists", name))
+		case product.GetSlug() == slug:
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("product slug %s already exists", slug))
+		case barcode != "" && product.GetBarcode() == barcode:
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("product barcode %s already exists", barcode))
+		}
+	}
+	return nil
+}
+
+// createProduct は下書きの商品を作成します。
+func (c *catalog) createProduct(in *command.CreateProductRequest_Product) (*common.Product, error) {
+	name := in.GetName().GetValue()
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("product name is required"))
+	}
+	category, err := c.category(in.GetCategory().GetId().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	if in.GetCategory().GetName().GetValue() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("category name is required"))
+	}
+	slug := in.GetSlug()
+	if slug == "" {
+		slug = slugify(name)
+	}
+	if err := c.checkProductUnique("", name, slug, in.GetBarcode()); err != nil {
+		return nil, err
+	}
+	product := &common.Product{}
+	product.SetId(c.nextID("product"))
+	product.SetName(name)
+	product.SetCategory(proto.CloneOf(category))
+	product.SetStatus(common.ProductStatus_PRODUCT_STATUS_DRAFT)
+	product.SetSlug(slug)
+	if in.GetBarcode() != "" {
+		product.SetBarcode(in.GetBarcode())
+	}
+	product.SetTranslations(mergeTranslations(nil, in.GetTranslations()))
+	currency := in.GetCurrency()
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	taxClass := in.GetTaxClass()
+	if taxClass == common.TaxClass_TAX_CLASS_UNSPECIFIED {
+		taxClass = common.TaxClass_TAX_CLASS_STANDARD
+	}
+	if err := setPrice(product, in.GetPrice().GetValue(), currency, taxClass); err != nil {
+		return nil, err
+	}
+	c.products = append(c.products, product)
+	return product, nil
+}
+
+// updateProduct は商品を更新します。通貨・税率区分・バーコード・スラッグは指定した場合のみ変更します。
+func (c *catalog) updateProduct(in *command.UpdateProductRequest_Product) (*common.Product, error) {
+	product, err := c.product(in.GetId().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	name := in.GetName().GetValue()
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("product name is required"))
+	}
+	category, err := c.category(in.GetCategoryId().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	slug := product.GetSlug()
+	if in.HasSlug() {
+		slug = in.GetSlug()
+	}
+	barcode := product.GetBarcode()
+	if in.HasBarcode() {
+		barcode = in.GetBarcode()
+	}
+	if err := c.checkProductUnique(product.GetId(), name, slug, barcode); err != nil {
+		return nil, err
+	}
+	currency := product.GetPriceExcludingTax().GetCurrency()
+	if in.HasCurrency() {
+		currency = in.GetCurrency()
+	}
+	taxClass := product.GetTaxClass()
+	if in.GetTaxClass() != common.TaxClass_TAX_CLASS_UNSPECIFIED {
+		taxClass = in.GetTaxClass()
+	}
+	if err := setPrice(product, in.GetPrice().GetValue(), currency, taxClass); err != nil {
+		return nil, err
+	}
+	if slug != product.GetSlug() {
+		c.productSlugs[product.GetSlug()] = product.GetId()
+	}
+	product.SetName(name)
+	product.SetCategory(proto.CloneOf(category))
+	product.SetSlug(slug)
+	product.SetBarcode(barcode)
+	product.SetTranslations(mergeTranslations(product.GetTranslations(), in.GetTranslations()))
+	return product, nil
+}
+
+// deleteProduct は商品を削除します。
+func (c *catalog) deleteProduct(id string) (*common.Product, error) {
+	product, err := c.product(id)
+	if err != nil {
+		return nil, err
+	}
+	c.products = slices.DeleteFunc(c.products, func(v *common.Product) bool { return v.GetId() == id })
+	return product, nil
+}
+
+// changeStatus は許可された遷移の場合のみ商品の販売状態を変更します。
+func (c *catalog) changeStatus(id string, to common.ProductStatus) (*common.Product, error) {
+	product, err := c.product(id)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(statusTransitions[product.GetStatus()], to) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("product status cannot be changed from %s to %s", product.GetStatus(), to))
+	}
+	product.SetStatus(to)
+	return product, nil
+}
+
+// setPrice は単価と税抜・税込価格を設定します。消費税額の端数は切り捨てます。
+func setPrice(product *common.Product, price int32, currency string, taxClass common.TaxClass) error {
+	if price <= 0 {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("price must be positive: %d", price))
+	}
+	amount, err := money.NewMoney(int64(price), currency)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	class := money.TaxClassStandard
+	if taxClass == common.TaxClass_TAX_CLASS_REDUCED {
+		class = money.TaxClassReduced
+	}
+	including := amount.IncludingTax(class, money.RoundingFloor)
+
+	excludingTax := &common.Money{}
+	excludingTax.SetAmount(amount.Amount())
+	excludingTax.SetCurrency(amount.Currency())
+	includingTax := &common.Money{}
+	includingTax.SetAmount(including.Amount())
+	includingTax.SetCurrency(including.Currency())
+
+	product.SetPrice(price)
+	product.SetTaxClass(taxClass)
+	product.SetPriceExcludingTax(excludingTax)
+	product.SetPriceIncludingTax(includingTax)
+	return nil
+}
+
+// mergeTranslations は翻訳を上書きします。エンプティの値はそのロケールを削除します。
+func mergeTranslations(current map[string]string, changes map[string]string) map[string]string {
+	merged := make(map[string]string, len(current)+len(changes))
+	for locale, name := range current {
+		merged[locale] = name
+	}
+	for locale, name := range changes {
+		if name == "" {
+			delete(merged, locale)
+		} else {
+			merged[locale] = name
+		}
+	}
+	return merged
+}
+
+// slugify は名前から小文字・ハイフン区切りのスラッグを生成します。
+func slugify(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), "-")
+}
@@ -0,0 +1,337 @@
+package catalogclienttest
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	command "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
+	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
+	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// 生成済みのUnimplementedハンドラーは同名のメソッドを持つため、サービスごとに別の型で埋め込みます。
+
+// commandCategories はCommand ServiceのCategoryServiceです。
+type commandCategories struct {
+	cmdconnect.UnimplementedCategoryServiceHandler
+	server *Server
+}
+
+// CreateCategory はカテゴリを作成します。
+func (h commandCategories) CreateCategory(_ context.Context, req *connect.Request[command.CreateCategoryRequest]) (*connect.Response[command.CreateCategoryResponse], error) {
+	category, err := withCatalog(h.server, func(c *catalog) (*common.Category, error) {
+		category, err := c.createCategory(req.Msg)
+		return proto.CloneOf(category), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &command.CreateCategoryResponse{}
+	resp.SetCategory(category)
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateCategory はカテゴリを更新します。
+func (h commandCategories) UpdateCategory(_ context.Context, req *connect.Request[command.UpdateCategoryRequest]) (*connect.Response[command.UpdateCategoryResponse], error) {
+	category, err := withCatalog(h.server, func(c *catalog) (*common.Category, error) {
+		category, err := c.updateCategory(req.Msg.GetCategory())
+		return proto.CloneOf(category), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &command.UpdateCategoryResponse{}
+	resp.SetCategory(category)
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteCategory はカテゴリを削除します。
+func (h commandCategories) DeleteCategory(_ context.Context, req *connect.Request[command.DeleteCategoryRequest]) (*connect.Response[command.DeleteCategoryResponse], error) {
+	category, err := withCatalog(h.server, func(c *catalog) (*common.Category, error) {
+		return c.deleteCategory(req.Msg.GetCategoryId().GetValue())
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &command.DeleteCategoryResponse{}
+	resp.SetCategory(category)
+	return connect.NewResponse(resp), nil
+}
+
+// MoveCategory はカテゴリを移動します。
+func (h commandCategories) MoveCategory(_ context.Context, req *connect.Request[command.MoveCategoryRequest]) (*connect.Response[command.MoveCategoryResponse], error) {
+	category, err := withCatalog(h.server, func(c *catalog) (*common.Category, error) {
+		category, err := c.moveCategory(req.Msg.GetCategoryId().GetValue(), req.Msg.GetParentId().GetValue())
+		return proto.CloneOf(category), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &command.MoveCategoryResponse{}
+	resp.SetCategory(category)
+	return connect.NewResponse(resp), nil
+}
+
+// commandProducts はCommand ServiceのProductServiceです。
+type commandProducts struct {
+	cmdconnect.UnimplementedProductServiceHandler
+	server *Server
+}
+
+// CreateProduct は商品を作成します。
+func (h commandProducts) CreateProduct(_ context.Context, req *connect.Request[command.CreateProductRequest]) (*connect.Response[command.CreateProductResponse], error) {
+	product, err := withCatalog(h.server, func(c *catalog) (*common.Product, error) {
+		product, err := c.createProduct(req.Msg.GetProduct())
+		return proto.CloneOf(product), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &command.CreateProductResponse{}
+	resp.SetProduct(product)
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateProduct は商品を更新します。
+func (h commandProducts) UpdateProduct(_ context.Context, req *connect.Request[command.UpdateProductRequest]) (*connect.Response[command.UpdateProductResponse], error) {
+	product, err := withCatalog(h.server, func(c *catalog) (*common.Product, error) {
+		product, err := c.updateProduct(req.Msg.GetProduct())
+		return proto.CloneOf(product), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &command.UpdateProductResponse{}
+	resp.SetProduct(product)
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteProduct は商品を削除します。
+func (h commandProducts) DeleteProduct(_ context.Context, req *connect.Request[command.DeleteProductRequest]) (*connect.Response[command.DeleteProductResponse], error) {
+	product, err := withCatalog(h.server, func(c *catalog) (*common.Product, error) {
+		return c.deleteProduct(req.Msg.GetProductId().GetValue())
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &command.DeleteProductResponse{}
+	resp.SetProduct(product)
+	return connect.NewResponse(resp), nil
+}
+
+// PublishProduct は商品を公開します。
+func (h commandProducts) PublishProduct(_ context.Context, req *connect.Request[command.PublishProductRequest]) (*connect.Response[command.PublishProductResponse], error) {
+	product, err := h.changeStatus(req.Msg.GetProductId(), common.ProductStatus_PRODUCT_STATUS_PUBLISHED)
+	if err != nil {
+		return nil, err
+	}
+	resp := &command.PublishProductResponse{}
+	resp.SetProduct(product)
+	return connect.NewResponse(resp), nil
+}
+
+// SuspendProduct は商品の販売を一時停止します。
+func (h commandProducts) SuspendProduct(_ context.Context, req *connect.Request[command.SuspendProductRequest]) (*connect.Response[command.SuspendProductResponse], error) {
+	product, err := h.changeStatus(req.Msg.GetProductId(), common.ProductStatus_PRODUCT_STATUS_SUSPENDED)
+	if err != nil {
+		return nil, err
+	}
+	resp := &command.SuspendProductResponse{}
+	resp.SetProduct(product)
+	return connect.NewResponse(resp), nil
+}
+
+// DiscontinueProduct は商品の販売を終了します。
+func (h commandProducts) DiscontinueProduct(_ context.Context, req *connect.Request[command.DiscontinueProductRequest]) (*connect.Response[command.DiscontinueProductResponse], error) {
+	product, err := h.changeStatus(req.Msg.GetProductId(), common.ProductStatus_PRODUCT_STATUS_DISCONTINUED)
+	if err != nil {
+		return nil, err
+	}
+	resp := &command.DiscontinueProductResponse{}
+	resp.SetProduct(product)
+	return connect.NewResponse(resp), nil
+}
+
+// changeStatus は商品の販売状態を変更します。
+func (h commandProducts) changeStatus(id *common.ProductId, to common.ProductStatus) (*common.Product, error) {
+	return withCatalog(h.server, func(c *catalog) (*common.Product, error) {
+		product, err := c.changeStatus(id.GetValue(), to)
+		return proto.CloneOf(product), err
+	})
+}
+
+// queryCategories はQuery ServiceのCategoryServiceです。
+type queryCategories struct {
+	queryconnect.UnimplementedCategoryServiceHandler
+	server *Server
+}
+
+// ListCategories はすべてのカテゴリを返します。
+func (h queryCategories) ListCategories(_ context.Context, _ *connect.Request[query.ListCategoriesRequest]) (*connect.Response[query.ListCategoriesResponse], error) {
+	categories, _ := withCatalog(h.server, func(c *catalog) ([]*common.Category, error) {
+		return cloneAll(c.categories), nil
+	})
+	resp := &query.ListCategoriesResponse{}
+	resp.SetCategories(categories)
+	return connect.NewResponse(resp), nil
+}
+
+// GetCategoryById はIDでカテゴリを返します。
+func (h queryCategories) GetCategoryById(_ context.Context, req *connect.Request[query.GetCategoryByIdRequest]) (*connect.Response[query.GetCategoryByIdResponse], error) {
+	category, err := withCatalog(h.server, func(c *catalog) (*common.Category, error) {
+		category, err := c.category(req.Msg.GetId())
+		return proto.CloneOf(category), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &query.GetCategoryByIdResponse{}
+	resp.SetCategory(category)
+	return connect.NewResponse(resp), nil
+}
+
+// GetCategoryBySlug はスラッグでカテゴリを返します。
+func (h queryCategories) GetCategoryBySlug(_ context.Context, req *connect.Request[query.GetCategoryBySlugRequest]) (*connect.Response[query.GetCategoryBySlugResponse], error) {
+	var moved bool
+	category, err := withCatalog(h.server, func(c *catalog) (*common.Category, error) {
+		category, m, err := c.categoryBySlug(req.Msg.GetSlug())
+		moved = m
+		return proto.CloneOf(category), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &query.GetCategoryBySlugResponse{}
+	resp.SetCategory(category)
+	resp.SetMoved(moved)
+	return connect.NewResponse(resp), nil
+}
+
+// ListChildCategories は直下の子カテゴリを返します。
+func (h queryCategories) ListChildCategories(_ context.Context, req *connect.Request[query.ListChildCategoriesRequest]) (*connect.Response[query.ListChildCategoriesResponse], error) {
+	categories, err := withCatalog(h.server, func(c *catalog) ([]*common.Category, error) {
+		if req.Msg.GetParentId() != "" {
+			if _, err := c.category(req.Msg.GetParentId()); err != nil {
+				return nil, err
+			}
+		}
+		return cloneAll(c.children(req.Msg.GetParentId())), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &query.ListChildCategoriesResponse{}
+	resp.SetCategories(categories)
+	return connect.NewResponse(resp), nil
+}
+
+// queryProducts はQuery ServiceのProductServiceです。
+type queryProducts struct {
+	queryconnect.UnimplementedProductServiceHandler
+	server *Server
+}
+
+// StreamProducts はすべての商品を1件ずつ送信します。
+func (h queryProducts) StreamProducts(_ context.Context, _ *connect.Request[query.StreamProductsRequest], stream *connect.ServerStream[query.StreamProductsResponse]) error {
+	products, _ := withCatalog(h.server, func(c *catalog) ([]*common.Product, error) {
+		return cloneAll(c.products), nil
+	})
+	for _, product := range products {
+		resp := &query.StreamProductsResponse{}
+		resp.SetProduct(product)
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListProducts はカテゴリとタグで絞り込んだ商品を返します。
+func (h queryProducts) ListProducts(_ context.Context, req *connect.Request[query.ListProductsRequest]) (*connect.Response[query.ListProductsResponse], error) {
+	products, err := withCatalog(h.server, func(c *catalog) ([]*common.Product, error) {
+		if req.Msg.HasCategoryId() {
+			if _, err := c.category(req.Msg.GetCategoryId()); err != nil {
+				return nil, err
+			}
+		}
+		products := c.listProducts(req.Msg.GetCategoryId(), req.Msg.GetIncludeDescendants())
+		if len(req.Msg.GetTags()) > 0 {
+			// インメモリのサーバーはタグの付与に対応しないため、タグを指定した場合は一致する商品がない
+			products = nil
+		}
+		return cloneAll(products), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &query.ListProductsResponse{}
+	resp.SetProducts(products)
+	return connect.NewResponse(resp), nil
+}
+
+// GetProductById はIDで商品を返します。
+func (h queryProducts) GetProductById(_ context.Context, req *connect.Request[query.GetProductByIdRequest]) (*connect.Response[query.GetProductByIdResponse], error) {
+	product, err := withCatalog(h.server, func(c *catalog) (*common.Product, error) {
+		product, err := c.product(req.Msg.GetId())
+		return proto.CloneOf(product), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &query.GetProductByIdResponse{}
+	resp.SetProduct(product)
+	return connect.NewResponse(resp), nil
+}
+
+// GetProductByBarcode はバーコードで商品を返します。
+func (h queryProducts) GetProductByBarcode(_ context.Context, req *connect.Request[query.GetProductByBarcodeRequest]) (*connect.Response[query.GetProductByBarcodeResponse], error) {
+	product, err := withCatalog(h.server, func(c *catalog) (*common.Product, error) {
+		product, err := c.productByBarcode(req.Msg.GetBarcode())
+		return proto.CloneOf(product), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &query.GetProductByBarcodeResponse{}
+	resp.SetProduct(product)
+	return connect.NewResponse(resp), nil
+}
+
+// GetProductBySlug はスラッグで商品を返します。
+func (h queryProducts) GetProductBySlug(_ context.Context, req *connect.Request[query.GetProductBySlugRequest]) (*connect.Response[query.GetProductBySlugResponse], error) {
+	var moved bool
+	product, err := withCatalog(h.server, func(c *catalog) (*common.Product, error) {
+		product, m, err := c.productBySlug(req.Msg.GetSlug())
+		moved = m
+		return proto.CloneOf(product), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &query.GetProductBySlugResponse{}
+	resp.SetProduct(product)
+	resp.SetMoved(moved)
+	return connect.NewResponse(resp), nil
+}
+
+// SearchProductsByKeyword は商品名にキーワードを含む商品を返します。
+func (h queryProducts) SearchProductsByKeyword(_ context.Context, req *connect.Request[query.SearchProductsByKeywordRequest]) (*connect.Response[query.SearchProductsByKeywordResponse], error) {
+	products, _ := withCatalog(h.server, func(c *catalog) ([]*common.Product, error) {
+		return cloneAll(c.searchProducts(req.Msg.GetKeyword())), nil
+	})
+	resp := &query.SearchProductsByKeywordResponse{}
+	resp.SetProducts(products)
+	return connect.NewResponse(resp), nil
+}
+
+// cloneAll はメッセージの一覧を複製します。ロックの外でシリアライズするためです。
+func cloneAll[M proto.Message](messages []M) []M {
+	cloned := make([]M, len(messages))
+	for i, m := range messages {
+		cloned[i] = proto.CloneOf(m)
+	}
+	return cloned
+}
@@ -0,0 +1,162 @@
+// Package catalogclienttest はcatalogclientを使用するコードのテスト用に、
+// Command Service・Query Serviceを模擬するインメモリのサーバーを提供します。
+//
+//	server := catalogclienttest.NewServer()
+//	defer server.Close()
+//	client := server.Client()
+//
+// サーバーは名前・スラッグ・バーコードの重複や存在しないIDを実際のサービスと同じエラーコードで返します。
+// FailNextで任意のエラーを返すように設定でき、再試行やエラー処理のテストに使用できます。
+package catalogclienttest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"connectrpc.com/connect"
+	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
+	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/catalogclient"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// Server はCommand Service・Query Serviceを1つのURLで模擬するインメモリのサーバーです。
+// 複数のゴルーチンから同時に使用できます。
+type Server struct {
+	// URL はサーバーのURLです。Command Service・Query ServiceのどちらのURLにも使用できます。
+	URL string
+
+	httpServer *httptest.Server
+	mu         sync.Mutex
+	catalog    *catalog
+	failures   map[string][]*connect.Error // 手続きごとに次に返すエラー
+	calls      map[string]int              // 手続きごとの呼び出し回数
+}
+
+// NewServer はサーバーを起動します。使用後はCloseで停止してください。
+//
+// Returns:
+//   - *Server: 起動したサーバー
+func NewServer() *Server {
+	s := &Server{
+		catalog:  newCatalog(),
+		failures: map[string][]*connect.Error{},
+		calls:    map[string]int{},
+	}
+	opts := connect.WithInterceptors(&faultInterceptor{server: s})
+	mux := http.NewServeMux()
+	mux.Handle(cmdconnect.NewCategoryServiceHandler(commandCategories{server: s}, opts))
+	mux.Handle(cmdconnect.NewProductServiceHandler(commandProducts{server: s}, opts))
+	mux.Handle(queryconnect.NewCategoryServiceHandler(queryCategories{server: s}, opts))
+	mux.Handle(queryconnect.NewProductServiceHandler(queryProducts{server: s}, opts))
+	// catalogclientの既定のHTTPクライアントはh2cで接続するため、HTTP/2を平文で受け付ける
+	s.httpServer = httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	s.URL = s.httpServer.URL
+	return s
+}
+
+// Close はサーバーを停止します。
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// Client はサーバーに接続するcatalogclient.Clientを生成します。
+//
+// Parameters:
+//   - opts: Clientの設定
+//
+// Returns:
+//   - *catalogclient.Client: Client
+func (s *Server) Client(opts ...catalogclient.Option) *catalogclient.Client {
+	return catalogclient.New(s.URL, s.URL, opts...)
+}
+
+// FailNext は指定した手続きの次のtimes回の呼び出しでerrを返すように設定します。
+// 手続きはcmdconnect.ProductServiceCreateProductProcedureなどの生成済みの定数で指定します。
+//
+// Parameters:
+//   - procedure: 手続き（例: /query.v1.ProductService/GetProductById）
+//   - err: 返すエラー
+//   - times: エラーを返す回数
+func (s *Server) FailNext(procedure string, err *connect.Error, times int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for range times {
+		s.failures[procedure] = append(s.failures[procedure], err)
+	}
+}
+
+// Calls は指定した手続きが呼び出された回数を返します。FailNextでエラーを返した呼び出しも含みます。
+//
+// Parameters:
+//   - procedure: 手続き
+//
+// Returns:
+//   - int: 呼び出し回数
+func (s *Server) Calls(procedure string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[procedure]
+}
+
+// Reset は登録した商品・カテゴリ、FailNextの設定と呼び出し回数を消去します。
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.catalog = newCatalog()
+	s.failures = map[string][]*connect.Error{}
+	s.calls = map[string]int{}
+}
+
+// record は呼び出し回数を記録し、FailNextで設定したエラーがあれば返します。
+func (s *Server) record(procedure string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[procedure]++
+	failures := s.failures[procedure]
+	if len(failures) == 0 {
+		return nil
+	}
+	s.failures[procedure] = failures[1:]
+	return failures[0]
+}
+
+// withCatalog はロックを取得してカタログを操作します。
+func withCatalog[T any](s *Server, fn func(c *catalog) (T, error)) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.catalog)
+}
+
+// faultInterceptor は呼び出し回数を記録し、FailNextで設定したエラーを返すインターセプターです。
+type faultInterceptor struct {
+	server *Server
+}
+
+// WrapUnary はUnary RPCの前に設定したエラーを返します。
+func (f *faultInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := f.server.record(req.Spec().Procedure); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient はクライアントのストリーミングRPCをそのまま返します。
+func (f *faultInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler はストリーミングRPCの前に設定したエラーを返します。
+func (f *faultInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := f.server.record(conn.Spec().Procedure); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
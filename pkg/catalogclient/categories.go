@@ -0,0 +1,231 @@
+package catalogclient
+
+import (
+	"context"
+	"iter"
+
+	"connectrpc.com/connect"
+	command "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
+	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
+)
+
+// GetCategory はIDでカテゴリを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: カテゴリID
+//
+// Returns:
+//   - *Category: カテゴリ
+//   - error: 存在しない場合はErrNotFound
+func (c *Client) GetCategory(ctx context.Context, id string) (*Category, error) {
+	req := &query.GetCategoryByIdRequest{}
+	req.SetId(id)
+	resp, err := c.queryCategory.GetCategoryById(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoCategory(resp.Msg.GetCategory()), nil
+}
+
+// GetCategoryBySlug はスラッグでカテゴリを取得します。変更前のスラッグでも取得できます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - slug: 現在のスラッグまたは変更前のスラッグ
+//
+// Returns:
+//   - *Category: カテゴリ（Slugは現在のスラッグ）
+//   - bool: 変更前のスラッグで取得した場合true（リダイレクトに使用する）
+//   - error: 存在しない場合はErrNotFound
+func (c *Client) GetCategoryBySlug(ctx context.Context, slug string) (*Category, bool, error) {
+	req := &query.GetCategoryBySlugRequest{}
+	req.SetSlug(slug)
+	resp, err := c.queryCategory.GetCategoryBySlug(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, false, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, false, err
+	}
+	return fromProtoCategory(resp.Msg.GetCategory()), resp.Msg.GetMoved(), nil
+}
+
+// ListCategories はすべてのカテゴリを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - []*Category: カテゴリ
+//   - error: エラー
+func (c *Client) ListCategories(ctx context.Context) ([]*Category, error) {
+	resp, err := c.queryCategory.ListCategories(ctx, connect.NewRequest(&query.ListCategoriesRequest{}))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoCategories(resp.Msg.GetCategories()), nil
+}
+
+// Categories はすべてのカテゴリを1件ずつ返すイテレーターを返します。
+// Query Serviceはページ分割に対応していないため、ListCategoriesで取得した結果を順に返します。
+// エラーが発生した場合は(nil, err)を返して終了します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - iter.Seq2[*Category, error]: カテゴリのイテレーター
+func (c *Client) Categories(ctx context.Context) iter.Seq2[*Category, error] {
+	return func(yield func(*Category, error) bool) {
+		categories, err := c.ListCategories(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for _, category := range categories {
+			if !yield(category, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ChildCategories は直下の子カテゴリを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - parentID: 親カテゴリID（エンプティの場合はルートカテゴリ）
+//
+// Returns:
+//   - []*Category: 子カテゴリ
+//   - error: 親カテゴリが存在しない場合はErrNotFound
+func (c *Client) ChildCategories(ctx context.Context, parentID string) ([]*Category, error) {
+	req := &query.ListChildCategoriesRequest{}
+	if parentID != "" {
+		req.SetParentId(parentID)
+	}
+	resp, err := c.queryCategory.ListChildCategories(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoCategories(resp.Msg.GetCategories()), nil
+}
+
+// CreateCategory はカテゴリを作成します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - category: 作成するカテゴリ
+//
+// Returns:
+//   - *Category: 作成されたカテゴリ
+//   - error: 名前・スラッグが重複する場合はErrAlreadyExists
+func (c *Client) CreateCategory(ctx context.Context, category *NewCategory) (*Category, error) {
+	req := &command.CreateCategoryRequest{}
+	req.SetCrud(command.CRUD_CRUD_INSERT)
+	req.SetName(newCategoryName(category.Name))
+	if category.ParentID != "" {
+		req.SetParentId(newCategoryID(category.ParentID))
+	}
+	req.SetTranslations(category.Translations)
+	if category.Slug != "" {
+		req.SetSlug(category.Slug)
+	}
+	resp, err := c.commandCategory.CreateCategory(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoCategory(resp.Msg.GetCategory()), nil
+}
+
+// UpdateCategory はカテゴリを更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - category: 更新内容
+//
+// Returns:
+//   - *Category: 更新されたカテゴリ
+//   - error: 存在しない場合はErrNotFound、名前・スラッグが重複する場合はErrAlreadyExists
+func (c *Client) UpdateCategory(ctx context.Context, category *CategoryUpdate) (*Category, error) {
+	v := &command.UpdateCategoryRequest_Category{}
+	v.SetId(newCategoryID(category.ID))
+	v.SetName(newCategoryName(category.Name))
+	v.SetTranslations(category.Translations)
+	if category.Slug != "" {
+		v.SetSlug(category.Slug)
+	}
+	req := &command.UpdateCategoryRequest{}
+	req.SetCrud(command.CRUD_CRUD_UPDATE)
+	req.SetCategory(v)
+	resp, err := c.commandCategory.UpdateCategory(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoCategory(resp.Msg.GetCategory()), nil
+}
+
+// DeleteCategory はカテゴリを削除します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: カテゴリID
+//
+// Returns:
+//   - *Category: 削除されたカテゴリ
+//   - error: 存在しない場合はErrNotFound、商品または子カテゴリが存在する場合はErrFailedPrecondition
+func (c *Client) DeleteCategory(ctx context.Context, id string) (*Category, error) {
+	req := &command.DeleteCategoryRequest{}
+	req.SetCrud(command.CRUD_CRUD_DELETE)
+	req.SetCategoryId(newCategoryID(id))
+	resp, err := c.commandCategory.DeleteCategory(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoCategory(resp.Msg.GetCategory()), nil
+}
+
+// MoveCategory はカテゴリを別の親カテゴリの下に移動します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: 移動するカテゴリID
+//   - parentID: 移動先の親カテゴリID（エンプティの場合はルートカテゴリにする）
+//
+// Returns:
+//   - *Category: 移動したカテゴリ
+//   - error: 自身の子孫の下へ移動する場合はErrFailedPrecondition
+func (c *Client) MoveCategory(ctx context.Context, id string, parentID string) (*Category, error) {
+	req := &command.MoveCategoryRequest{}
+	req.SetCategoryId(newCategoryID(id))
+	if parentID != "" {
+		req.SetParentId(newCategoryID(parentID))
+	}
+	resp, err := c.commandCategory.MoveCategory(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoCategory(resp.Msg.GetCategory()), nil
+}
@@ -0,0 +1,137 @@
+// Package catalogclient はCommand Service・Query Serviceを利用するためのGoクライアント（SDK）です。
+//
+// 生成済みのConnectクライアントをラップし、protobufのセッターではなくGoの構造体で商品とカテゴリを操作します。
+// サービスが返したエラーは*Errorに変換され、errors.Is(err, catalogclient.ErrNotFound)のように判定できます。
+// 参照系（Query Service）のRPCは冪等なため、一時的な障害では指数バックオフで再試行します。
+// 更新系（Command Service）のRPCは重複して適用されるおそれがあるため、再試行しません。
+//
+// テストではcatalogclienttestパッケージのインメモリのサーバーに接続できます。
+package catalogclient
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
+	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+)
+
+// Client はCommand Service・Query Serviceのクライアントです。複数のゴルーチンから同時に使用できます。
+type Client struct {
+	commandCategory cmdconnect.CategoryServiceClient   // カテゴリの更新
+	commandProduct  cmdconnect.ProductServiceClient    // 商品の更新
+	queryCategory   queryconnect.CategoryServiceClient // カテゴリの参照
+	queryProduct    queryconnect.ProductServiceClient  // 商品の参照
+}
+
+// options はNewの設定です。
+type options struct {
+	httpClient    connect.HTTPClient
+	retryPolicy   interceptor.RetryPolicy
+	clientOptions []connect.ClientOption
+}
+
+// Option はNewの設定を変更します。
+type Option func(*options)
+
+// WithHTTPClient は接続に使用するHTTPクライアントを指定します。
+// 既定では平文の場合もHTTP/2（h2c）で接続するクライアントを使用します。
+//
+// Parameters:
+//   - client: HTTPクライアント
+//
+// Returns:
+//   - Option: 設定
+func WithHTTPClient(client connect.HTTPClient) Option {
+	return func(o *options) {
+		o.httpClient = client
+	}
+}
+
+// WithRetryPolicy は参照系のRPCの再試行の方針を指定します。MaxAttemptsを1にすると再試行しません。
+// 既定はinterceptor.DefaultRetryPolicyです。
+//
+// Parameters:
+//   - policy: 再試行の方針
+//
+// Returns:
+//   - Option: 設定
+func WithRetryPolicy(policy interceptor.RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithClientOptions はConnectクライアントのオプション（インターセプターなど）を追加します。
+// 既定ではgRPCプロトコルで接続します。
+//
+// Parameters:
+//   - opts: Connectクライアントのオプション
+//
+// Returns:
+//   - Option: 設定
+func WithClientOptions(opts ...connect.ClientOption) Option {
+	return func(o *options) {
+		o.clientOptions = append(o.clientOptions, opts...)
+	}
+}
+
+// New はClientを生成します。接続は最初のリクエストで確立します。
+//
+// Parameters:
+//   - commandURL: Command ServiceのURL（例: http://localhost:8083）
+//   - queryURL: Query ServiceのURL（例: http://localhost:8085）
+//   - opts: 設定
+//
+// Returns:
+//   - *Client: Client
+func New(commandURL string, queryURL string, opts ...Option) *Client {
+	o := &options{retryPolicy: interceptor.DefaultRetryPolicy()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.httpClient == nil {
+		o.httpClient = newHTTPClient()
+	}
+
+	base := append([]connect.ClientOption{connect.WithGRPC()}, o.clientOptions...)
+	commandOpts := base
+	// 参照系はロケールを転送し、冪等なため再試行する
+	queryOpts := append(append([]connect.ClientOption{}, base...),
+		connect.WithInterceptors(interceptor.NewAcceptLanguageForwarder(), interceptor.NewRetry(o.retryPolicy)),
+	)
+
+	return &Client{
+		commandCategory: cmdconnect.NewCategoryServiceClient(o.httpClient, commandURL, commandOpts...),
+		commandProduct:  cmdconnect.NewProductServiceClient(o.httpClient, commandURL, commandOpts...),
+		queryCategory:   queryconnect.NewCategoryServiceClient(o.httpClient, queryURL, queryOpts...),
+		queryProduct:    queryconnect.NewProductServiceClient(o.httpClient, queryURL, queryOpts...),
+	}
+}
+
+// WithLocale は参照系のRPCで返す商品名・カテゴリ名のロケールをコンテキストに設定します。
+// Accept-Languageヘッダーとして送信するため、"en, ja;q=0.8"のような優先順位付きの値も指定できます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - locale: ロケール
+//
+// Returns:
+//   - context.Context: ロケールを設定したコンテキスト
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return interceptor.WithAcceptLanguage(ctx, locale)
+}
+
+// newHTTPClient は平文の場合もHTTP/2（h2c）で接続するHTTPクライアントを生成します。
+// 各サービスはh2cで待ち受け、ストリーミングRPCにはHTTP/2が必要なためです。
+// ストリーミングRPCを打ち切らないよう、タイムアウトはコンテキストで指定します。
+func newHTTPClient() *http.Client {
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	protocols := new(http.Protocols)
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+	tr.Protocols = protocols
+	return &http.Client{Transport: tr}
+}
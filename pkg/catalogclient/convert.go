@@ -0,0 +1,140 @@
+package catalogclient
+
+import (
+	"math"
+	"strings"
+
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+)
+
+// fromProtoCategory はprotobufのCategoryを変換します。
+func fromProtoCategory(c *common.Category) *Category {
+	if c == nil {
+		return nil
+	}
+	return &Category{
+		ID:           c.GetId(),
+		Name:         c.GetName(),
+		ParentID:     c.GetParentId(),
+		Slug:         c.GetSlug(),
+		Locale:       c.GetLocale(),
+		Translations: c.GetTranslations(),
+	}
+}
+
+// fromProtoCategories はprotobufのCategoryの一覧を変換します。
+func fromProtoCategories(categories []*common.Category) []*Category {
+	converted := make([]*Category, len(categories))
+	for i, c := range categories {
+		converted[i] = fromProtoCategory(c)
+	}
+	return converted
+}
+
+// fromProtoProduct はprotobufのProductを変換します。
+func fromProtoProduct(p *common.Product) *Product {
+	if p == nil {
+		return nil
+	}
+	tags := make([]string, len(p.GetTags()))
+	for i, tag := range p.GetTags() {
+		tags[i] = tag.GetName()
+	}
+	return &Product{
+		ID:                p.GetId(),
+		Name:              p.GetName(),
+		Price:             int(p.GetPrice()),
+		TaxClass:          fromProtoTaxClass(p.GetTaxClass()),
+		PriceExcludingTax: fromProtoMoney(p.GetPriceExcludingTax()),
+		PriceIncludingTax: fromProtoMoney(p.GetPriceIncludingTax()),
+		Category:          fromProtoCategory(p.GetCategory()),
+		Status:            fromProtoProductStatus(p.GetStatus()),
+		Tags:              tags,
+		AvailableQuantity: int(p.GetAvailableQuantity()),
+		InStock:           p.GetInStock(),
+		Barcode:           p.GetBarcode(),
+		Slug:              p.GetSlug(),
+		Locale:            p.GetLocale(),
+		Translations:      p.GetTranslations(),
+	}
+}
+
+// fromProtoProducts はprotobufのProductの一覧を変換します。
+func fromProtoProducts(products []*common.Product) []*Product {
+	converted := make([]*Product, len(products))
+	for i, p := range products {
+		converted[i] = fromProtoProduct(p)
+	}
+	return converted
+}
+
+// fromProtoMoney はprotobufのMoneyを変換します。
+func fromProtoMoney(m *common.Money) Money {
+	return Money{Amount: m.GetAmount(), Currency: m.GetCurrency()}
+}
+
+// fromProtoTaxClass はprotobufの税率区分を変換します。未指定は標準税率として扱われるため、標準税率にします。
+func fromProtoTaxClass(class common.TaxClass) TaxClass {
+	if class == common.TaxClass_TAX_CLASS_UNSPECIFIED {
+		return TaxClassStandard
+	}
+	return TaxClass(strings.TrimPrefix(class.String(), "TAX_CLASS_"))
+}
+
+// fromProtoProductStatus はprotobufの販売状態を変換します。不明の場合はエンプティにします。
+func fromProtoProductStatus(status common.ProductStatus) ProductStatus {
+	if status == common.ProductStatus_PRODUCT_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return ProductStatus(strings.TrimPrefix(status.String(), "PRODUCT_STATUS_"))
+}
+
+// toProtoTaxClass は税率区分をprotobufの値に変換します。
+func toProtoTaxClass(class TaxClass) (common.TaxClass, error) {
+	if class == TaxClassUnspecified {
+		return common.TaxClass_TAX_CLASS_UNSPECIFIED, nil
+	}
+	v, ok := common.TaxClass_value["TAX_CLASS_"+string(class)]
+	if !ok {
+		return 0, invalidArgument("unknown tax class %q", class)
+	}
+	return common.TaxClass(v), nil
+}
+
+// toProtoPrice は単価をprotobufの値オブジェクトに変換します。
+func toProtoPrice(price int) (*common.ProductPrice, error) {
+	if price <= 0 || price > math.MaxInt32 {
+		return nil, invalidArgument("price must be between 1 and %d: %d", math.MaxInt32, price)
+	}
+	v := &common.ProductPrice{}
+	v.SetValue(int32(price))
+	return v, nil
+}
+
+// newProductID は商品IDのprotobuf値オブジェクトを生成します。
+func newProductID(id string) *common.ProductId {
+	v := &common.ProductId{}
+	v.SetValue(id)
+	return v
+}
+
+// newProductName は商品名のprotobuf値オブジェクトを生成します。
+func newProductName(name string) *common.ProductName {
+	v := &common.ProductName{}
+	v.SetValue(name)
+	return v
+}
+
+// newCategoryID はカテゴリIDのprotobuf値オブジェクトを生成します。
+func newCategoryID(id string) *common.CategoryId {
+	v := &common.CategoryId{}
+	v.SetValue(id)
+	return v
+}
+
+// newCategoryName はカテゴリ名のprotobuf値オブジェクトを生成します。
+func newCategoryName(name string) *common.CategoryName {
+	v := &common.CategoryName{}
+	v.SetValue(name)
+	return v
+}
@@ -0,0 +1,101 @@
+package catalogclient
+
+import (
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+)
+
+// エラーの種類を判定するためのエラーです。errors.Isで*Errorと比較できます。
+var (
+	// ErrNotFound は商品・カテゴリが存在しないことを表します。
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists は名前・スラッグ・バーコードなどの一意な値が重複していることを表します。
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrInvalidArgument はリクエストの値が不正なことを表します。
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrFailedPrecondition は現在の状態では操作できないこと（商品が存在するカテゴリの削除など）を表します。
+	ErrFailedPrecondition = errors.New("failed precondition")
+	// ErrUnavailable はサービスに一時的に接続できないことを表します。再試行で成功する可能性があります。
+	ErrUnavailable = errors.New("unavailable")
+)
+
+// codeErrors はConnectのエラーコードに対応するエラーです。
+var codeErrors = map[connect.Code]error{
+	connect.CodeNotFound:           ErrNotFound,
+	connect.CodeAlreadyExists:      ErrAlreadyExists,
+	connect.CodeInvalidArgument:    ErrInvalidArgument,
+	connect.CodeFailedPrecondition: ErrFailedPrecondition,
+	connect.CodeUnavailable:        ErrUnavailable,
+}
+
+// responseErrorCodes はレスポンスの操作エラーの種類に対応するConnectのエラーコードです。
+var responseErrorCodes = map[string]connect.Code{
+	"NOT_FOUND":      connect.CodeNotFound,
+	"ALREADY_EXISTS": connect.CodeAlreadyExists,
+	"INVALID":        connect.CodeInvalidArgument,
+}
+
+// Error はCommand Service・Query Serviceが返したエラーです。
+type Error struct {
+	Code    connect.Code // Connectのエラーコード
+	Message string       // サービスが返したエラーメッセージ
+	cause   error        // 元のエラー
+}
+
+// Error はエラーメッセージを返します。
+//
+// Returns:
+//   - string: エラーメッセージ
+func (e *Error) Error() string {
+	return fmt.Sprintf("catalogclient: %s: %s", e.Code, e.Message)
+}
+
+// Unwrap は元のエラーを返します。context.Canceledなどの判定に使用します。
+//
+// Returns:
+//   - error: 元のエラー
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is はエラーコードに対応するエラー（ErrNotFoundなど）と一致するかを判定します。
+//
+// Parameters:
+//   - target: 比較するエラー
+//
+// Returns:
+//   - bool: 一致する場合true
+func (e *Error) Is(target error) bool {
+	codeErr, ok := codeErrors[e.Code]
+	return ok && codeErr == target
+}
+
+// wrapError はConnectのエラーを*Errorに変換します。Connectのエラー以外はそのまま返します。
+func wrapError(err error) error {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return err
+	}
+	return &Error{Code: connectErr.Code(), Message: connectErr.Message(), cause: err}
+}
+
+// responseError はレスポンスに含まれる操作エラーを*Errorに変換します。
+func responseError(e *common.Error) error {
+	if e == nil {
+		return nil
+	}
+	code, ok := responseErrorCodes[e.GetType()]
+	if !ok {
+		code = connect.CodeUnknown
+	}
+	return &Error{Code: code, Message: e.GetMessage(), cause: errors.New(e.GetMessage())}
+}
+
+// invalidArgument はクライアント側で検出した不正な値のエラーを生成します。
+func invalidArgument(format string, args ...any) error {
+	err := fmt.Errorf(format, args...)
+	return &Error{Code: connect.CodeInvalidArgument, Message: err.Error(), cause: err}
+}
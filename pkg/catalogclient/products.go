@@ -0,0 +1,363 @@
+package catalogclient
+
+import (
+	"context"
+	"iter"
+
+	"connectrpc.com/connect"
+	command "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
+	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
+)
+
+// GetProduct はIDで商品を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: 商品ID
+//
+// Returns:
+//   - *Product: 商品
+//   - error: 存在しない場合はErrNotFound
+func (c *Client) GetProduct(ctx context.Context, id string) (*Product, error) {
+	req := &query.GetProductByIdRequest{}
+	req.SetId(id)
+	resp, err := c.queryProduct.GetProductById(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoProduct(resp.Msg.GetProduct()), nil
+}
+
+// GetProductByBarcode はJAN/EAN/UPCバーコードで商品を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - barcode: バーコード（8桁、12桁または13桁）
+//
+// Returns:
+//   - *Product: 商品
+//   - error: 存在しない場合はErrNotFound
+func (c *Client) GetProductByBarcode(ctx context.Context, barcode string) (*Product, error) {
+	req := &query.GetProductByBarcodeRequest{}
+	req.SetBarcode(barcode)
+	resp, err := c.queryProduct.GetProductByBarcode(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoProduct(resp.Msg.GetProduct()), nil
+}
+
+// GetProductBySlug はスラッグで商品を取得します。変更前のスラッグでも取得できます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - slug: 現在のスラッグまたは変更前のスラッグ
+//
+// Returns:
+//   - *Product: 商品（Slugは現在のスラッグ）
+//   - bool: 変更前のスラッグで取得した場合true（リダイレクトに使用する）
+//   - error: 存在しない場合はErrNotFound
+func (c *Client) GetProductBySlug(ctx context.Context, slug string) (*Product, bool, error) {
+	req := &query.GetProductBySlugRequest{}
+	req.SetSlug(slug)
+	resp, err := c.queryProduct.GetProductBySlug(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, false, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, false, err
+	}
+	return fromProtoProduct(resp.Msg.GetProduct()), resp.Msg.GetMoved(), nil
+}
+
+// ListProducts は条件に一致する商品の一覧を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - opts: 絞り込み条件（nilの場合はすべての商品）
+//
+// Returns:
+//   - []*Product: 商品
+//   - error: エラー
+func (c *Client) ListProducts(ctx context.Context, opts *ListProductsOptions) ([]*Product, error) {
+	req := &query.ListProductsRequest{}
+	if opts != nil {
+		if opts.CategoryID != "" {
+			req.SetCategoryId(opts.CategoryID)
+		}
+		req.SetIncludeDescendants(opts.IncludeDescendants)
+		req.SetTags(opts.Tags)
+		if opts.Locale != "" {
+			req.SetLocale(opts.Locale)
+		}
+	}
+	resp, err := c.queryProduct.ListProducts(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoProducts(resp.Msg.GetProducts()), nil
+}
+
+// Products は条件に一致する商品を1件ずつ返すイテレーターを返します。
+// 条件を指定しない場合はStreamProductsで受信した商品から順に返すため、商品数が多くても全件をメモリに保持しません。
+// 条件を指定した場合はListProductsの結果を順に返します。
+// エラーが発生した場合は(nil, err)を返して終了します。ループを途中で抜けるとストリームを閉じます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - opts: 絞り込み条件（nilの場合はすべての商品）
+//
+// Returns:
+//   - iter.Seq2[*Product, error]: 商品のイテレーター
+func (c *Client) Products(ctx context.Context, opts *ListProductsOptions) iter.Seq2[*Product, error] {
+	if !opts.isZero() {
+		return func(yield func(*Product, error) bool) {
+			products, err := c.ListProducts(ctx, opts)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, p := range products {
+				if !yield(p, nil) {
+					return
+				}
+			}
+		}
+	}
+	return func(yield func(*Product, error) bool) {
+		stream, err := c.queryProduct.StreamProducts(ctx, connect.NewRequest(&query.StreamProductsRequest{}))
+		if err != nil {
+			yield(nil, wrapError(err))
+			return
+		}
+		defer stream.Close()
+		for stream.Receive() {
+			if !yield(fromProtoProduct(stream.Msg().GetProduct()), nil) {
+				return
+			}
+		}
+		if err := stream.Err(); err != nil {
+			yield(nil, wrapError(err))
+		}
+	}
+}
+
+// SearchProducts はキーワードに一致する商品を関連度順に取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - keyword: キーワード（すべてのロケールの商品名と一致させる）
+//
+// Returns:
+//   - []*Product: 商品（関連度順）
+//   - error: エラー
+func (c *Client) SearchProducts(ctx context.Context, keyword string) ([]*Product, error) {
+	req := &query.SearchProductsByKeywordRequest{}
+	req.SetKeyword(keyword)
+	resp, err := c.queryProduct.SearchProductsByKeyword(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoProducts(resp.Msg.GetProducts()), nil
+}
+
+// CreateProduct は商品を作成します。
+// カテゴリ名が未指定の場合は、Query Serviceから取得してから作成します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - product: 作成する商品
+//
+// Returns:
+//   - *Product: 作成された商品
+//   - error: 名前・バーコード・スラッグが重複する場合はErrAlreadyExists、値が不正な場合はErrInvalidArgument
+func (c *Client) CreateProduct(ctx context.Context, product *NewProduct) (*Product, error) {
+	price, err := toProtoPrice(product.Price)
+	if err != nil {
+		return nil, err
+	}
+	taxClass, err := toProtoTaxClass(product.TaxClass)
+	if err != nil {
+		return nil, err
+	}
+	categoryName := product.CategoryName
+	if categoryName == "" && product.CategoryID != "" {
+		category, err := c.GetCategory(ctx, product.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		categoryName = category.Name
+	}
+
+	category := &command.CreateProductRequest_Product_Category{}
+	category.SetId(newCategoryID(product.CategoryID))
+	category.SetName(newCategoryName(categoryName))
+
+	p := &command.CreateProductRequest_Product{}
+	p.SetName(newProductName(product.Name))
+	p.SetPrice(price)
+	p.SetCategory(category)
+	p.SetTaxClass(taxClass)
+	p.SetTranslations(product.Translations)
+	if product.Currency != "" {
+		p.SetCurrency(product.Currency)
+	}
+	if product.Barcode != "" {
+		p.SetBarcode(product.Barcode)
+	}
+	if product.Slug != "" {
+		p.SetSlug(product.Slug)
+	}
+
+	req := &command.CreateProductRequest{}
+	req.SetCrud(command.CRUD_CRUD_INSERT)
+	req.SetProduct(p)
+	resp, err := c.commandProduct.CreateProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoProduct(resp.Msg.GetProduct()), nil
+}
+
+// UpdateProduct は商品を更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - product: 更新内容
+//
+// Returns:
+//   - *Product: 更新された商品
+//   - error: 存在しない場合はErrNotFound、値が重複する場合はErrAlreadyExists
+func (c *Client) UpdateProduct(ctx context.Context, product *ProductUpdate) (*Product, error) {
+	price, err := toProtoPrice(product.Price)
+	if err != nil {
+		return nil, err
+	}
+	taxClass, err := toProtoTaxClass(product.TaxClass)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &command.UpdateProductRequest_Product{}
+	p.SetId(newProductID(product.ID))
+	p.SetName(newProductName(product.Name))
+	p.SetPrice(price)
+	p.SetCategoryId(newCategoryID(product.CategoryID))
+	p.SetTaxClass(taxClass)
+	p.SetTranslations(product.Translations)
+	if product.Currency != "" {
+		p.SetCurrency(product.Currency)
+	}
+	if product.Barcode != nil {
+		p.SetBarcode(*product.Barcode)
+	}
+	if product.Slug != "" {
+		p.SetSlug(product.Slug)
+	}
+
+	req := &command.UpdateProductRequest{}
+	req.SetCrud(command.CRUD_CRUD_UPDATE)
+	req.SetProduct(p)
+	resp, err := c.commandProduct.UpdateProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoProduct(resp.Msg.GetProduct()), nil
+}
+
+// DeleteProduct は商品を削除します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: 商品ID
+//
+// Returns:
+//   - *Product: 削除された商品
+//   - error: 存在しない場合はErrNotFound
+func (c *Client) DeleteProduct(ctx context.Context, id string) (*Product, error) {
+	req := &command.DeleteProductRequest{}
+	req.SetProductId(newProductID(id))
+	resp, err := c.commandProduct.DeleteProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return fromProtoProduct(resp.Msg.GetProduct()), nil
+}
+
+// PublishProduct は商品を公開（販売中に）します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: 商品ID
+//
+// Returns:
+//   - *Product: 状態を変更した商品
+//   - error: 販売終了した商品の場合はErrFailedPrecondition
+func (c *Client) PublishProduct(ctx context.Context, id string) (*Product, error) {
+	req := &command.PublishProductRequest{}
+	req.SetProductId(newProductID(id))
+	resp, err := c.commandProduct.PublishProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return fromProtoProduct(resp.Msg.GetProduct()), nil
+}
+
+// SuspendProduct は商品の販売を一時停止します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: 商品ID
+//
+// Returns:
+//   - *Product: 状態を変更した商品
+//   - error: 販売中でない商品の場合はErrFailedPrecondition
+func (c *Client) SuspendProduct(ctx context.Context, id string) (*Product, error) {
+	req := &command.SuspendProductRequest{}
+	req.SetProductId(newProductID(id))
+	resp, err := c.commandProduct.SuspendProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return fromProtoProduct(resp.Msg.GetProduct()), nil
+}
+
+// DiscontinueProduct は商品の販売を終了します。販売終了した商品は再び公開できません。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: 商品ID
+//
+// Returns:
+//   - *Product: 状態を変更した商品
+//   - error: 存在しない場合はErrNotFound
+func (c *Client) DiscontinueProduct(ctx context.Context, id string) (*Product, error) {
+	req := &command.DiscontinueProductRequest{}
+	req.SetProductId(newProductID(id))
+	resp, err := c.commandProduct.DiscontinueProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return fromProtoProduct(resp.Msg.GetProduct()), nil
+}
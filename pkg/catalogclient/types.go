@@ -0,0 +1,118 @@
+package catalogclient
+
+// TaxClass は消費税の税率区分です。
+type TaxClass string
+
+const (
+	// TaxClassUnspecified は税率区分の未指定です。作成では標準税率、更新では現在の税率区分を維持します。
+	TaxClassUnspecified TaxClass = ""
+	// TaxClassStandard は標準税率です。
+	TaxClassStandard TaxClass = "STANDARD"
+	// TaxClassReduced は軽減税率です。
+	TaxClassReduced TaxClass = "REDUCED"
+)
+
+// ProductStatus は商品の販売状態です。
+type ProductStatus string
+
+const (
+	// ProductStatusDraft は公開前の商品です。
+	ProductStatusDraft ProductStatus = "DRAFT"
+	// ProductStatusPublished は販売中の商品です。
+	ProductStatusPublished ProductStatus = "PUBLISHED"
+	// ProductStatusSuspended は販売を一時停止した商品です。
+	ProductStatusSuspended ProductStatus = "SUSPENDED"
+	// ProductStatusDiscontinued は販売を終了した商品です。
+	ProductStatusDiscontinued ProductStatus = "DISCONTINUED"
+)
+
+// Money は通貨の最小単位で表した金額です。
+type Money struct {
+	Amount   int64  // 金額（通貨の最小単位）
+	Currency string // ISO 4217の通貨コード
+}
+
+// Category は商品カテゴリです。
+type Category struct {
+	ID           string            // カテゴリID
+	Name         string            // カテゴリ名（Localeの名前）
+	ParentID     string            // 親カテゴリID（ルートカテゴリの場合はエンプティ）
+	Slug         string            // URLに使用するスラッグ
+	Locale       string            // Nameのロケール
+	Translations map[string]string // 既定のロケール以外のカテゴリ名（キーはロケール）
+}
+
+// Product は商品です。
+type Product struct {
+	ID                string            // 商品ID
+	Name              string            // 商品名（Localeの名前）
+	Price             int               // 単価（税抜、通貨の最小単位）
+	TaxClass          TaxClass          // 税率区分
+	PriceExcludingTax Money             // 税抜価格
+	PriceIncludingTax Money             // 税込価格
+	Category          *Category         // 商品カテゴリ
+	Status            ProductStatus     // 販売状態
+	Tags              []string          // タグ名
+	AvailableQuantity int               // 引当可能な在庫数
+	InStock           bool              // 在庫がある場合true
+	Barcode           string            // JAN/EAN/UPCバーコード（未登録の場合はエンプティ）
+	Slug              string            // URLに使用するスラッグ
+	Locale            string            // Name・Category.Nameのロケール
+	Translations      map[string]string // 既定のロケール以外の商品名（キーはロケール）
+}
+
+// NewProduct は作成する商品です。
+type NewProduct struct {
+	Name         string            // 商品名（必須）
+	Price        int               // 単価（税抜、通貨の最小単位、必須）
+	CategoryID   string            // カテゴリID（必須）
+	CategoryName string            // カテゴリ名（エンプティの場合はQuery Serviceから取得）
+	Currency     string            // 通貨コード（エンプティの場合はJPY）
+	TaxClass     TaxClass          // 税率区分（未指定の場合は標準税率）
+	Barcode      string            // JAN/EAN/UPCバーコード（エンプティの場合は未登録）
+	Slug         string            // スラッグ（エンプティの場合は商品名から生成）
+	Translations map[string]string // 既定のロケール以外の商品名（キーはロケール）
+}
+
+// ProductUpdate は商品の更新内容です。
+// 商品名・単価・カテゴリはCommand Serviceで必須のため、変更しない場合も現在の値を指定します。
+type ProductUpdate struct {
+	ID           string            // 商品ID（必須）
+	Name         string            // 商品名（必須）
+	Price        int               // 単価（必須）
+	CategoryID   string            // カテゴリID（必須）
+	Currency     string            // 通貨コード（エンプティの場合は現在の通貨を維持）
+	TaxClass     TaxClass          // 税率区分（未指定の場合は現在の税率区分を維持）
+	Barcode      *string           // バーコード（nilの場合は現在の値を維持し、エンプティの場合は削除）
+	Slug         string            // スラッグ（エンプティの場合は現在のスラッグを維持）
+	Translations map[string]string // 変更するロケールの商品名（エンプティの値はそのロケールを削除）
+}
+
+// NewCategory は作成するカテゴリです。
+type NewCategory struct {
+	Name         string            // カテゴリ名（必須）
+	ParentID     string            // 親カテゴリID（エンプティの場合はルートカテゴリ）
+	Slug         string            // スラッグ（エンプティの場合はカテゴリ名から生成）
+	Translations map[string]string // 既定のロケール以外のカテゴリ名（キーはロケール）
+}
+
+// CategoryUpdate はカテゴリの更新内容です。
+type CategoryUpdate struct {
+	ID           string            // カテゴリID（必須）
+	Name         string            // カテゴリ名（必須）
+	Slug         string            // スラッグ（エンプティの場合は現在のスラッグを維持）
+	Translations map[string]string // 変更するロケールのカテゴリ名（エンプティの値はそのロケールを削除）
+}
+
+// ListProductsOptions は商品一覧の絞り込み条件です。
+type ListProductsOptions struct {
+	CategoryID         string   // カテゴリID（エンプティの場合はすべての商品）
+	IncludeDescendants bool     // trueの場合は子孫カテゴリの商品も含める
+	Tags               []string // 指定したすべてのタグが付与された商品のみ返す
+	Locale             string   // 商品名・カテゴリ名のロケール（エンプティの場合はWithLocaleまたはサーバーの既定）
+}
+
+// isZero は絞り込み条件が指定されていないかを判定します。
+func (o *ListProductsOptions) isZero() bool {
+	return o == nil || (o.CategoryID == "" && !o.IncludeDescendants && len(o.Tags) == 0 && o.Locale == "")
+}
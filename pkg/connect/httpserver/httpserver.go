@@ -0,0 +1,241 @@
+// Package httpserver はConnect RPCのサービスやAPI Gatewayを公開するHTTPサーバーの共通処理を提供します。
+//
+// Unary RPCにはサーバー全体の読み書きのタイムアウトを適用し、ストリーミングRPCは長時間のレスポンスが
+// 途中で切断されないよう書き込みのタイムアウトの対象外にして、代わりにRPCごとの期限を設定します。
+// 停止時はヘルスチェックをNOT_SERVINGにしてロードバランサーの振り分け対象から外れるのを待ってから停止します。
+// API GatewayのようにgRPCのヘルスチェックを持たないサーバーは、レディネスチェックを失敗させてからDrainで停止します。
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/grpchealth"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// streamDeadlineGrace はストリーミングRPCの期限を過ぎてからコネクションの読み書きの期限までの猶予です。
+// 期限を過ぎたハンドラがDeadlineExceededのステータスを書き込めるよう、読み書きの期限を少し遅らせます。
+const streamDeadlineGrace = time.Second
+
+// StopMargin はHTTPサーバーの停止後にデータベース接続のクローズなど他の停止処理に充てる時間です。
+const StopMargin = 5 * time.Second
+
+// Config はHTTPサーバーの設定です。
+type Config struct {
+	Host              string                   // リッスンするホスト
+	Port              string                   // リッスンするポート
+	ReadHeaderTimeout time.Duration            // リクエストヘッダーの読み込みのタイムアウト
+	ReadTimeout       time.Duration            // Unary RPCのリクエストの読み込みのタイムアウト
+	WriteTimeout      time.Duration            // Unary RPCのレスポンスの書き込みのタイムアウト
+	IdleTimeout       time.Duration            // Keep-Aliveのアイドルタイムアウト
+	StreamTimeout     time.Duration            // ストリーミングRPCの既定の期限（0の場合は期限なし）
+	StreamTimeouts    map[string]time.Duration // 手続きごとのストリーミングRPCの期限（StreamTimeoutより優先）
+	DrainPeriod       time.Duration            // NOT_SERVINGにしてから停止を始めるまでの待ち時間
+	ShutdownTimeout   time.Duration            // 処理中のリクエストの完了を待つ時間
+}
+
+// streamTimeout は設定ファイルの[[server.stream_timeouts]]の要素です。
+type streamTimeout struct {
+	Procedure string        `mapstructure:"procedure"` // 手続き（例: /query.v1.ProductService/StreamProducts）
+	Timeout   time.Duration `mapstructure:"timeout"`   // 期限
+}
+
+// NewConfig は設定ファイルの[server]からConfigを生成します。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *Config: 設定のインスタンス
+//   - error: 設定の読み込みエラー
+func NewConfig(v *viper.Viper) (*Config, error) {
+	var configErrors []error
+	cfg := &Config{
+		Host:              utils.GetKey[string](v, "server.host", &configErrors),
+		Port:              utils.GetKey[string](v, "server.port", &configErrors),
+		ReadHeaderTimeout: utils.GetKey[time.Duration](v, "server.read_header_timeout", &configErrors),
+		ReadTimeout:       utils.GetKey[time.Duration](v, "server.read_timeout", &configErrors),
+		WriteTimeout:      utils.GetKey[time.Duration](v, "server.write_timeout", &configErrors),
+		IdleTimeout:       utils.GetKey[time.Duration](v, "server.idle_timeout", &configErrors),
+		StreamTimeout:     utils.GetKey[time.Duration](v, "server.stream_timeout", &configErrors),
+		DrainPeriod:       utils.GetKey[time.Duration](v, "server.drain_period", &configErrors),
+		ShutdownTimeout:   utils.GetKey[time.Duration](v, "server.shutdown_timeout", &configErrors),
+		StreamTimeouts:    map[string]time.Duration{},
+	}
+	if v.IsSet("server.stream_timeouts") {
+		var entries []streamTimeout
+		if err := v.UnmarshalKey("server.stream_timeouts", &entries); err != nil {
+			configErrors = append(configErrors, fmt.Errorf("failed to read server.stream_timeouts: %w", err))
+		}
+		for _, entry := range entries {
+			if !strings.HasPrefix(entry.Procedure, "/") || entry.Timeout < 0 {
+				configErrors = append(configErrors, fmt.Errorf("invalid server.stream_timeouts entry: procedure %q timeout %s", entry.Procedure, entry.Timeout))
+				continue
+			}
+			cfg.StreamTimeouts[entry.Procedure] = entry.Timeout
+		}
+	}
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+	return cfg, nil
+}
+
+// StopTimeout はアプリケーションの停止のタイムアウトを返します。
+// Shutdownは停止のコンテキストの期限を超えて待てないため、fx.StopTimeoutにこの値を指定します。
+// fxの既定（15秒）のままでは、drain_periodとshutdown_timeoutの合計が15秒を超えると処理中のストリームが早期に切断されます。
+//
+// Returns:
+//   - time.Duration: DrainPeriod、ShutdownTimeout、StopMarginの合計
+func (c *Config) StopTimeout() time.Duration {
+	return c.DrainPeriod + c.ShutdownTimeout + StopMargin
+}
+
+// StreamingProcedures はprotoファイルに定義されたストリーミングRPCの手続きを返します。
+//
+// Parameters:
+//   - files: サービスを定義したprotoファイルの記述子
+//
+// Returns:
+//   - map[string]bool: ストリーミングRPCの手続き（例: /query.v1.ProductService/StreamProducts）
+func StreamingProcedures(files ...protoreflect.FileDescriptor) map[string]bool {
+	procedures := map[string]bool{}
+	for _, file := range files {
+		services := file.Services()
+		for i := range services.Len() {
+			service := services.Get(i)
+			methods := service.Methods()
+			for j := range methods.Len() {
+				method := methods.Get(j)
+				if method.IsStreamingClient() || method.IsStreamingServer() {
+					procedures[fmt.Sprintf("/%s/%s", service.FullName(), method.Name())] = true
+				}
+			}
+		}
+	}
+	return procedures
+}
+
+// New はHTTPサーバーを生成します。
+// gRPCクライアントはTLSなしのHTTP/2（prior knowledge）で接続するため、HTTP/1.1と平文のHTTP/2の両方を受け付けます。
+// h2cパッケージと異なりHTTP/2のコネクションもhttp.Serverが管理するため、Shutdownで処理中のストリームの完了を待てます。
+//
+// Parameters:
+//   - cfg: HTTPサーバーの設定
+//   - handler: Connect RPCのハンドラ
+//   - streaming: ストリーミングRPCの手続き（書き込みのタイムアウトの対象外にする）
+//
+// Returns:
+//   - *http.Server: HTTPサーバー
+func New(cfg *Config, handler http.Handler, streaming map[string]bool) *http.Server {
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	return &http.Server{
+		Addr:              net.JoinHostPort(cfg.Host, cfg.Port),
+		Handler:           streamDeadlines(handler, streaming, cfg),
+		Protocols:         protocols,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		ReadTimeout:       cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		// HTTP Keep-Aliveのタイムアウト設定
+		IdleTimeout: cfg.IdleTimeout,
+	}
+}
+
+// streamDeadlines はストリーミングRPCのリクエストの読み書きの期限をRPCごとの期限に置き換えるミドルウェアです。
+// 期限はリクエストのコンテキストにも設定し、ハンドラが期限を過ぎたことを検知できるようにします。
+func streamDeadlines(next http.Handler, streaming map[string]bool, cfg *Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !streaming[r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+		timeout, ok := cfg.StreamTimeouts[r.URL.Path]
+		if !ok {
+			timeout = cfg.StreamTimeout
+		}
+		// ゼロ値の時刻は期限なしを表す
+		var connDeadline time.Time
+		if timeout > 0 {
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+			r = r.WithContext(ctx)
+			connDeadline = time.Now().Add(timeout + streamDeadlineGrace)
+		}
+		// 対応していないResponseWriterの場合はサーバー全体のタイムアウトのままにする
+		rc := http.NewResponseController(w)
+		_ = rc.SetReadDeadline(connDeadline)
+		_ = rc.SetWriteDeadline(connDeadline)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Shutdown はコネクションを切り替えさせてからHTTPサーバーを停止します。
+//
+//  1. ヘルスチェックをNOT_SERVINGにする
+//  2. Drainでコネクションを切り替えさせてから停止する
+//
+// Parameters:
+//   - ctx: コンテキスト（期限を過ぎた場合は待機を打ち切る）
+//   - srv: HTTPサーバー
+//   - checker: ヘルスチェック
+//   - services: ヘルスチェックに登録したサービス名（サーバー全体の""は常にNOT_SERVINGにする）
+//   - cfg: HTTPサーバーの設定
+//   - logger: ロガー
+//
+// Returns:
+//   - error: 停止エラー
+func Shutdown(ctx context.Context, srv *http.Server, checker *grpchealth.StaticChecker, services []string, cfg *Config, logger *slog.Logger) error {
+	checker.SetStatus("", grpchealth.StatusNotServing)
+	for _, service := range services {
+		checker.SetStatus(service, grpchealth.StatusNotServing)
+	}
+	return Drain(ctx, srv, cfg, logger)
+}
+
+// Drain はKeep-Aliveを無効にし、DrainPeriodだけ待ってからHTTPサーバーを停止します。
+// 呼び出す前にレディネスチェックを失敗させ、ロードバランサーが振り分け対象から外せるようにしてください。
+//
+//  1. Keep-Aliveを無効にする
+//  2. ロードバランサーやクライアントが振り分け対象から外すまでDrainPeriodだけ待つ
+//  3. ShutdownTimeoutを上限に処理中のリクエストの完了を待って停止する
+//
+// Parameters:
+//   - ctx: コンテキスト（期限を過ぎた場合は待機を打ち切る）
+//   - srv: HTTPサーバー
+//   - cfg: HTTPサーバーの設定
+//   - logger: ロガー
+//
+// Returns:
+//   - error: 停止エラー
+func Drain(ctx context.Context, srv *http.Server, cfg *Config, logger *slog.Logger) error {
+	srv.SetKeepAlivesEnabled(false)
+
+	if cfg.DrainPeriod > 0 {
+		logger.InfoContext(ctx, "Draining connections", slog.Duration("drain_period", cfg.DrainPeriod))
+		timer := time.NewTimer(cfg.DrainPeriod)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, cfg.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		// 待ちきれなかったリクエストは強制的に切断する
+		return errors.Join(err, srv.Close())
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package httpserver_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/grpchealth"
+	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/httpserver"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+func TestHTTPServer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "HTTPServer Suite")
+}
+
+// serve はサーバーをポート0で起動し、ベースURLを返します。
+func serve(srv *http.Server) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	go func() { _ = srv.Serve(ln) }()
+	DeferCleanup(srv.Close)
+	return "http://" + ln.Addr().String()
+}
+
+// slowHandler は100ミリ秒ごとに3回書き込むハンドラです。
+func slowHandler(w http.ResponseWriter, r *http.Request) {
+	for range 3 {
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = io.WriteString(w, "chunk\n")
+		_ = http.NewResponseController(w).Flush()
+	}
+}
+
+var _ = Describe("NewConfig", func() {
+	var v *viper.Viper
+
+	BeforeEach(func() {
+		v = viper.New()
+		v.Set("server.host", "localhost")
+		v.Set("server.port", 8085)
+		v.Set("server.read_header_timeout", "5s")
+		v.Set("server.read_timeout", "10s")
+		v.Set("server.write_timeout", "10s")
+		v.Set("server.idle_timeout", "120s")
+		v.Set("server.stream_timeout", "5m")
+		v.Set("server.drain_period", "5s")
+		v.Set("server.shutdown_timeout", "20s")
+	})
+
+	It("[server]の設定と手続きごとのストリーミングRPCの期限を読み込む", func() {
+		v.Set("server.stream_timeouts", []map[string]any{{"procedure": "/query.v1.ProductService/SuggestProducts", "timeout": "30m"}})
+
+		cfg, err := httpserver.NewConfig(v)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Port).To(Equal("8085"))
+		Expect(cfg.WriteTimeout).To(Equal(10 * time.Second))
+		Expect(cfg.StreamTimeouts).To(HaveKeyWithValue("/query.v1.ProductService/SuggestProducts", 30*time.Minute))
+	})
+
+	It("停止のタイムアウトはドレインとシャットダウンの待ち時間に猶予を加えた値にする", func() {
+		cfg, err := httpserver.NewConfig(v)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.StopTimeout()).To(Equal(5*time.Second + 20*time.Second + httpserver.StopMargin))
+	})
+
+	It("手続きが不正な場合はエラーを返す", func() {
+		v.Set("server.stream_timeouts", []map[string]any{{"procedure": "SuggestProducts", "timeout": "30m"}})
+
+		_, err := httpserver.NewConfig(v)
+
+		Expect(err).To(MatchError(ContainSubstring("invalid server.stream_timeouts entry")))
+	})
+
+	It("必須のキーがない場合はエラーを返す", func() {
+		_, err := httpserver.NewConfig(viper.New())
+
+		Expect(err).To(MatchError(ContainSubstring("config key 'server.drain_period' is not set")))
+	})
+})
+
+var _ = Describe("StreamingProcedures", func() {
+	It("サーバーストリーミングと双方向ストリーミングの手続きのみを返す", func() {
+		procedures := httpserver.StreamingProcedures(query.File_query_v1_query_proto)
+
+		Expect(procedures).To(Equal(map[string]bool{
+			"/query.v1.ProductService/StreamProducts":  true,
+			"/query.v1.ProductService/SuggestProducts": true,
+		}))
+	})
+})
+
+var _ = Describe("New", func() {
+	var cfg *httpserver.Config
+
+	BeforeEach(func() {
+		cfg = &httpserver.Config{
+			Host:            "127.0.0.1",
+			Port:            "0",
+			WriteTimeout:    150 * time.Millisecond,
+			StreamTimeouts:  map[string]time.Duration{},
+			ShutdownTimeout: time.Second,
+		}
+	})
+
+	It("Unary RPCは書き込みのタイムアウトで切断する", func() {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/unary", slowHandler)
+		base := serve(httpserver.New(cfg, mux, map[string]bool{"/stream": true}))
+
+		resp, err := http.Get(base + "/unary")
+		if err == nil {
+			_, err = io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("ストリーミングRPCは書き込みのタイムアウトの対象外にする", func() {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/stream", slowHandler)
+		base := serve(httpserver.New(cfg, mux, map[string]bool{"/stream": true}))
+
+		resp, err := http.Get(base + "/stream")
+		Expect(err).NotTo(HaveOccurred())
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(body)).To(Equal("chunk\nchunk\nchunk\n"))
+	})
+
+	It("ストリーミングRPCにはRPCごとの期限を設定する", func() {
+		cfg.StreamTimeouts["/stream"] = 150 * time.Millisecond
+		deadlines := make(chan bool, 1)
+		mux := http.NewServeMux()
+		mux.HandleFunc("/stream", func(w http.ResponseWriter, r *http.Request) {
+			_, ok := r.Context().Deadline()
+			deadlines <- ok
+			slowHandler(w, r)
+		})
+		base := serve(httpserver.New(cfg, mux, map[string]bool{"/stream": true}))
+
+		resp, err := http.Get(base + "/stream")
+		Expect(err).NotTo(HaveOccurred())
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+
+		Expect(<-deadlines).To(BeTrue())
+		Expect(string(body)).To(Equal("chunk\n"), "期限を過ぎたハンドラは書き込みをやめる")
+	})
+})
+
+var _ = Describe("Shutdown", func() {
+	It("ヘルスチェックをNOT_SERVINGにして待ち時間の後に停止する", func() {
+		cfg := &httpserver.Config{Host: "127.0.0.1", Port: "0", DrainPeriod: 100 * time.Millisecond, ShutdownTimeout: time.Second}
+		checker := grpchealth.NewStaticChecker("query.v1.ProductService")
+		srv := httpserver.New(cfg, http.NewServeMux(), nil)
+		serve(srv)
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+		done := make(chan error, 1)
+		start := time.Now()
+		go func() {
+			done <- httpserver.Shutdown(context.Background(), srv, checker, []string{"query.v1.ProductService"}, cfg, logger)
+		}()
+
+		Eventually(func() grpchealth.Status {
+			resp, err := checker.Check(context.Background(), &grpchealth.CheckRequest{Service: "query.v1.ProductService"})
+			Expect(err).NotTo(HaveOccurred())
+			return resp.Status
+		}).Should(Equal(grpchealth.StatusNotServing))
+		resp, err := checker.Check(context.Background(), &grpchealth.CheckRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Status).To(Equal(grpchealth.StatusNotServing))
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(time.Since(start)).To(BeNumerically(">=", cfg.DrainPeriod))
+	})
+})
+
+var _ = Describe("Drain", func() {
+	It("待ち時間の間もリクエストを受け付け、その後に停止する", func() {
+		cfg := &httpserver.Config{Host: "127.0.0.1", Port: "0", DrainPeriod: 200 * time.Millisecond, ShutdownTimeout: time.Second}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, "pong")
+		})
+		srv := httpserver.New(cfg, mux, nil)
+		base := serve(srv)
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+		done := make(chan error, 1)
+		start := time.Now()
+		go func() {
+			done <- httpserver.Drain(context.Background(), srv, cfg, logger)
+		}()
+
+		resp, err := http.Get(base + "/ping")
+		Expect(err).NotTo(HaveOccurred())
+		_ = resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(time.Since(start)).To(BeNumerically(">=", cfg.DrainPeriod))
+
+		_, err = http.Get(base + "/ping")
+		Expect(err).To(HaveOccurred())
+	})
+})
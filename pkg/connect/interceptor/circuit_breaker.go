@@ -0,0 +1,252 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// ErrCircuitOpen はサーキットブレーカーが開いているため呼び出しを拒否したことを表します。
+// 拒否した呼び出しはconnect.CodeUnavailableのエラーで、errors.Isで判定できます。
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState はサーキットブレーカーの状態です。
+type CircuitState int
+
+const (
+	CircuitClosed   CircuitState = iota // 閉（すべての呼び出しを通す）
+	CircuitHalfOpen                     // 半開（1件だけ試行し、結果で閉または開に戻す）
+	CircuitOpen                         // 開（すべての呼び出しを即座に拒否する）
+)
+
+// String は状態の名前を返します。
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitHalfOpen:
+		return "half-open"
+	case CircuitOpen:
+		return "open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+// CircuitBreakerPolicy はサーキットブレーカーの方針です。
+type CircuitBreakerPolicy struct {
+	FailureThreshold int            // 開にする連続失敗回数
+	OpenTimeout      time.Duration  // 開から半開にするまでの時間
+	FailureCodes     []connect.Code // 失敗として数えるエラーコード（NotFoundなどの業務エラーは数えない）
+}
+
+// DefaultCircuitBreakerPolicy は既定のサーキットブレーカーの方針を返します。
+// 接続先の障害を表すエラーが5回連続した場合に開にし、10秒後に半開にします。
+//
+// Returns:
+//   - CircuitBreakerPolicy: 既定の方針
+func DefaultCircuitBreakerPolicy() CircuitBreakerPolicy {
+	return CircuitBreakerPolicy{
+		FailureThreshold: 5,
+		OpenTimeout:      10 * time.Second,
+		FailureCodes: []connect.Code{
+			connect.CodeUnavailable,
+			connect.CodeDeadlineExceeded,
+			connect.CodeResourceExhausted,
+			connect.CodeInternal,
+			connect.CodeUnknown,
+		},
+	}
+}
+
+// CircuitBreaker は接続先ごとに障害を検知し、障害中の呼び出しを即座に失敗させるクライアント用インターセプターです。
+// 障害中の接続先へのリクエストを止めることで、タイムアウト待ちの滞留と接続先の復旧の妨げを防ぎます。
+// Unary RPCの結果で状態を更新します。ストリーミングRPCは開の場合のみ拒否し、結果は数えません。
+// キャンセルした呼び出し（ヘッジの敗者やクライアントの中断）と、状態が変わる前に通した呼び出しの結果は数えません。
+type CircuitBreaker struct {
+	name     string
+	policy   CircuitBreakerPolicy
+	now      func() time.Time
+	rejected atomic.Uint64
+
+	mu         sync.Mutex
+	state      CircuitState
+	generation uint64    // 状態を変更するたびに増やす世代（前の状態で通した呼び出しの結果を無視するために使用する）
+	failures   int       // 連続失敗回数
+	openedAt   time.Time // 開にした時刻
+	probing    bool      // 半開で試行中の呼び出しがある場合true
+}
+
+// circuitCall は通した呼び出しの世代と、半開の試行かどうかです。
+type circuitCall struct {
+	generation uint64
+	probe      bool
+}
+
+// NewCircuitBreaker はCircuitBreakerを生成します。
+//
+// Parameters:
+//   - name: 接続先の名前（エラーメッセージとメトリクスに使用する）
+//   - policy: サーキットブレーカーの方針
+//
+// Returns:
+//   - *CircuitBreaker: CircuitBreaker
+func NewCircuitBreaker(name string, policy CircuitBreakerPolicy) *CircuitBreaker {
+	return &CircuitBreaker{name: name, policy: policy, now: time.Now}
+}
+
+// Name は接続先の名前を返します。
+func (b *CircuitBreaker) Name() string {
+	return b.name
+}
+
+// State は現在の状態を返します。開にしてからOpenTimeoutが経過した場合は半開を返します。
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.currentState()
+}
+
+// Rejected は開または半開のために拒否した呼び出しの累計を返します。
+func (b *CircuitBreaker) Rejected() uint64 {
+	return b.rejected.Load()
+}
+
+// currentState はOpenTimeoutの経過を反映した状態を返します。ロックを取得して呼び出します。
+func (b *CircuitBreaker) currentState() CircuitState {
+	if b.state == CircuitOpen && b.now().Sub(b.openedAt) >= b.policy.OpenTimeout {
+		b.setState(CircuitHalfOpen)
+	}
+	return b.state
+}
+
+// setState は状態を変更して世代を進めます。ロックを取得して呼び出します。
+func (b *CircuitBreaker) setState(state CircuitState) {
+	b.state = state
+	b.generation++
+	b.probing = false
+	switch state {
+	case CircuitClosed:
+		b.failures = 0
+	case CircuitOpen:
+		b.openedAt = b.now()
+	}
+}
+
+// allow は呼び出しを通すかを判定します。通した場合、半開では試行中として記録します。
+//
+// Returns:
+//   - circuitCall: 通した呼び出し（recordに渡す）
+//   - error: 開または半開で試行中のために拒否した場合のエラー
+func (b *CircuitBreaker) allow() (circuitCall, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.currentState() {
+	case CircuitOpen:
+	case CircuitHalfOpen:
+		if !b.probing {
+			b.probing = true
+			return circuitCall{generation: b.generation, probe: true}, nil
+		}
+	default:
+		return circuitCall{generation: b.generation}, nil
+	}
+	b.rejected.Add(1)
+	return circuitCall{}, connect.NewError(connect.CodeUnavailable, fmt.Errorf("%s: %w", b.name, ErrCircuitOpen))
+}
+
+// record は呼び出しの結果で状態を更新します。
+// キャンセルした呼び出しは接続先の状態を表さないため数えず、半開の試行枠のみ解放します。
+// 通したときから状態が変わっている場合（閉で通した呼び出しが開になった後に完了した場合など）は結果を無視します。
+func (b *CircuitBreaker) record(call circuitCall, err error) {
+	code := connect.CodeOf(err)
+	failed := err != nil && slices.Contains(b.policy.FailureCodes, code)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if call.generation != b.generation {
+		return
+	}
+	if err != nil && code == connect.CodeCanceled {
+		if call.probe {
+			b.probing = false
+		}
+		return
+	}
+	switch b.state {
+	case CircuitClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.policy.FailureThreshold {
+			b.setState(CircuitOpen)
+		}
+	case CircuitHalfOpen:
+		if !call.probe {
+			return
+		}
+		if failed {
+			b.setState(CircuitOpen)
+		} else {
+			b.setState(CircuitClosed)
+		}
+	}
+}
+
+// WrapUnary はクライアントのUnary RPCを、開の場合は拒否し、結果を状態に反映するようにラップします。
+func (b *CircuitBreaker) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		call, err := b.allow()
+		if err != nil {
+			return nil, err
+		}
+		res, err := next(ctx, req)
+		b.record(call, err)
+		return res, err
+	}
+}
+
+// WrapStreamingClient はクライアントのストリーミングRPCを、開の場合は拒否するようにラップします。
+// 半開の試行枠はUnary RPCの結果で閉じるため、ストリーミングRPCでは使用しません。
+func (b *CircuitBreaker) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		if b.State() == CircuitOpen {
+			b.rejected.Add(1)
+			err := connect.NewError(connect.CodeUnavailable, fmt.Errorf("%s: %w", b.name, ErrCircuitOpen))
+			return &failedClientConn{spec: spec, err: err}
+		}
+		return next(ctx, spec)
+	}
+}
+
+// WrapStreamingHandler はハンドラ側のストリームをそのまま返します。
+func (b *CircuitBreaker) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// failedClientConn は送受信で常にエラーを返すクライアント側のストリームです。
+type failedClientConn struct {
+	spec connect.Spec
+	err  error
+}
+
+func (c *failedClientConn) Spec() connect.Spec           { return c.spec }
+func (c *failedClientConn) Peer() connect.Peer           { return connect.Peer{} }
+func (c *failedClientConn) Send(any) error               { return c.err }
+func (c *failedClientConn) RequestHeader() http.Header   { return http.Header{} }
+func (c *failedClientConn) CloseRequest() error          { return nil }
+func (c *failedClientConn) Receive(any) error            { return c.err }
+func (c *failedClientConn) ResponseHeader() http.Header  { return http.Header{} }
+func (c *failedClientConn) ResponseTrailer() http.Header { return http.Header{} }
+func (c *failedClientConn) CloseResponse() error         { return nil }
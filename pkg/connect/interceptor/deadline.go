@@ -0,0 +1,76 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// NewDeadline は手続きごとの期限をコンテキストに設定するクライアント用インターセプターを返します。
+// 再試行を含めた呼び出し全体の期限にするため、NewRetryより外側（先）に指定します。
+// 呼び出し元のコンテキストの期限の方が早い場合は、呼び出し元の期限を優先します。
+// ストリーミングRPCは長時間維持されることがあるため、timeoutsで指定した手続きのみ期限を設定します。
+//
+// Parameters:
+//   - defaultTimeout: Unary RPCの既定の期限（0以下の場合は設定しない）
+//   - timeouts: 手続き（例: /query.v1.ProductService/SearchProductsByKeyword）ごとの期限
+//
+// Returns:
+//   - connect.Interceptor: インターセプター
+func NewDeadline(defaultTimeout time.Duration, timeouts map[string]time.Duration) connect.Interceptor {
+	return &deadlineInterceptor{defaultTimeout: defaultTimeout, timeouts: timeouts}
+}
+
+// deadlineInterceptor は手続きごとの期限を設定するインターセプターです。
+type deadlineInterceptor struct {
+	defaultTimeout time.Duration
+	timeouts       map[string]time.Duration
+}
+
+// WrapUnary はクライアントのUnary RPCに期限を設定するようにラップします。
+func (d *deadlineInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		timeout, ok := d.timeouts[req.Spec().Procedure]
+		if !ok {
+			timeout = d.defaultTimeout
+		}
+		if !req.Spec().IsClient || timeout <= 0 {
+			return next(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient は期限を指定した手続きのストリーミングRPCに期限を設定するようにラップします。
+// 期限のコンテキストはレスポンスを閉じたときに解放します。
+func (d *deadlineInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		timeout, ok := d.timeouts[spec.Procedure]
+		if !ok || timeout <= 0 {
+			return next(ctx, spec)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		return &cancelOnCloseConn{StreamingClientConn: next(ctx, spec), cancel: cancel}
+	}
+}
+
+// WrapStreamingHandler はハンドラ側のストリームをそのまま返します。
+func (d *deadlineInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// cancelOnCloseConn はレスポンスを閉じたときにコンテキストを解放するクライアント側のストリームです。
+type cancelOnCloseConn struct {
+	connect.StreamingClientConn
+	cancel context.CancelFunc
+}
+
+// CloseResponse はレスポンスを閉じてコンテキストを解放します。
+func (c *cancelOnCloseConn) CloseResponse() error {
+	err := c.StreamingClientConn.CloseResponse()
+	c.cancel()
+	return err
+}
@@ -0,0 +1,75 @@
+package interceptor
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+)
+
+// AcceptLanguageHeader はロケールの指定に使用するヘッダー名です。
+const AcceptLanguageHeader = "Accept-Language"
+
+// acceptLanguageKey はAccept-Languageの値を保持するコンテキストキーです。
+type acceptLanguageKey struct{}
+
+// WithAcceptLanguage は下流のサービスへ転送するAccept-Languageの値をコンテキストに設定します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - value: Accept-Languageヘッダーの値
+//
+// Returns:
+//   - context.Context: 値を設定したコンテキスト
+func WithAcceptLanguage(ctx context.Context, value string) context.Context {
+	return context.WithValue(ctx, acceptLanguageKey{}, value)
+}
+
+// AcceptLanguageFromContext はコンテキストに設定されたAccept-Languageの値を返します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - string: Accept-Languageヘッダーの値（未設定の場合はエンプティ）
+func AcceptLanguageFromContext(ctx context.Context) string {
+	value, _ := ctx.Value(acceptLanguageKey{}).(string)
+	return value
+}
+
+// NewAcceptLanguageForwarder はコンテキストのAccept-Languageをリクエストヘッダーに設定するクライアント用インターセプターを返します。
+// ハンドラ側のストリームには何もしません。
+//
+// Returns:
+//   - connect.Interceptor: インターセプター
+func NewAcceptLanguageForwarder() connect.Interceptor {
+	return &acceptLanguageForwarder{}
+}
+
+// acceptLanguageForwarder はAccept-Languageを下流のサービスへ転送するインターセプターです。
+type acceptLanguageForwarder struct{}
+
+// WrapUnary はUnary RPCのリクエストヘッダーにAccept-Languageを設定するようにラップします。
+func (f *acceptLanguageForwarder) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if value := AcceptLanguageFromContext(ctx); value != "" && req.Spec().IsClient {
+			req.Header().Set(AcceptLanguageHeader, value)
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient はクライアント側のストリームのリクエストヘッダーにAccept-Languageを設定するようにラップします。
+func (f *acceptLanguageForwarder) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		if value := AcceptLanguageFromContext(ctx); value != "" {
+			conn.RequestHeader().Set(AcceptLanguageHeader, value)
+		}
+		return conn
+	}
+}
+
+// WrapStreamingHandler はハンドラ側のストリームをそのまま返します。
+func (f *acceptLanguageForwarder) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
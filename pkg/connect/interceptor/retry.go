@@ -0,0 +1,118 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// RetryPolicy はクライアントのUnary RPCを再試行する方針です。
+type RetryPolicy struct {
+	MaxAttempts    int                         // 最初の呼び出しを含む最大試行回数（1以下の場合は再試行しない）
+	InitialBackoff time.Duration               // 1回目の再試行までの待ち時間の上限
+	MaxBackoff     time.Duration               // 待ち時間の上限
+	Multiplier     float64                     // 再試行ごとに待ち時間の上限に掛ける倍率
+	RetryableCodes []connect.Code              // 再試行するエラーコード
+	Retryable      func(procedure string) bool // 再試行してよい（冪等な）手続きか（nilの場合はすべての手続き）
+}
+
+// DefaultRetryPolicy は既定の再試行の方針を返します。
+// 一時的な障害を表すUnavailableとResourceExhaustedのみ、最大3回まで試行します。
+//
+// Returns:
+//   - RetryPolicy: 既定の再試行の方針
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		RetryableCodes: []connect.Code{connect.CodeUnavailable, connect.CodeResourceExhausted},
+	}
+}
+
+// backoff はattempt回目（1始まり）の失敗後の待ち時間を返します。
+// 同時に失敗したクライアントの再試行が集中しないよう、上限までの一様乱数（Full Jitter）にします。
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	limit := float64(p.InitialBackoff)
+	for range attempt - 1 {
+		limit *= p.Multiplier
+		if limit >= float64(p.MaxBackoff) {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 {
+		limit = min(limit, float64(p.MaxBackoff))
+	}
+	if limit <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(limit) + 1))
+}
+
+// shouldRetry はエラーが再試行の対象かを判定します。
+func (p RetryPolicy) shouldRetry(procedure string, err error) bool {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return false
+	}
+	if p.Retryable != nil && !p.Retryable(procedure) {
+		return false
+	}
+	return slices.Contains(p.RetryableCodes, connectErr.Code())
+}
+
+// NewRetry はUnary RPCを指数バックオフで再試行するクライアント用インターセプターを返します。
+// 再試行は同じリクエストを再送するため、冪等な手続きにのみ使用してください。
+// ストリーミングRPCは送受信済みのメッセージを再送できないため、再試行しません。
+//
+// Parameters:
+//   - policy: 再試行の方針
+//
+// Returns:
+//   - connect.Interceptor: インターセプター
+func NewRetry(policy RetryPolicy) connect.Interceptor {
+	return &retryInterceptor{policy: policy}
+}
+
+// retryInterceptor はUnary RPCを再試行するインターセプターです。
+type retryInterceptor struct {
+	policy RetryPolicy
+}
+
+// WrapUnary はクライアントのUnary RPCを再試行するようにラップします。
+func (r *retryInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		for attempt := 1; ; attempt++ {
+			res, err := next(ctx, req)
+			if err == nil || attempt >= r.policy.MaxAttempts || !r.policy.shouldRetry(req.Spec().Procedure, err) {
+				return res, err
+			}
+			timer := time.NewTimer(r.policy.backoff(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				// 待機中に期限切れになった場合は、原因がわかるよう最後のエラーを返す
+				return nil, err
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// WrapStreamingClient はストリーミングRPCをそのまま返します。
+func (r *retryInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler はストリーミングRPCをそのまま返します。
+func (r *retryInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
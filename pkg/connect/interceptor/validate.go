@@ -44,3 +44,55 @@ func (v *Validator) NewUnaryInterceptor() connect.UnaryInterceptorFunc {
 		}
 	}
 }
+
+// NewStreamInterceptor はストリーミングRPCで受信する各メッセージのバリデーションを行うインターセプターを返します。
+// Unary RPCとクライアント側のストリームには何もしません。
+func (v *Validator) NewStreamInterceptor() connect.Interceptor {
+	return &streamValidator{validator: v}
+}
+
+// streamValidator はハンドラ側のストリーミングRPCで受信メッセージを検証するインターセプターです。
+type streamValidator struct {
+	validator *Validator
+}
+
+// WrapUnary はUnary RPCをそのまま返します。
+func (s *streamValidator) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return next
+}
+
+// WrapStreamingClient はクライアント側のストリームをそのまま返します。
+func (s *streamValidator) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler はハンドラ側のストリームの受信メッセージを検証するようにラップします。
+func (s *streamValidator) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &validatingHandlerConn{StreamingHandlerConn: conn, ctx: ctx, validator: s.validator})
+	}
+}
+
+// validatingHandlerConn は受信メッセージを検証するStreamingHandlerConnです。
+type validatingHandlerConn struct {
+	connect.StreamingHandlerConn
+	ctx       context.Context
+	validator *Validator
+}
+
+// Receive はメッセージを受信し、バリデーションに失敗した場合はInvalidArgumentエラーを返します。
+func (c *validatingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	m, ok := msg.(proto.Message)
+	if !ok {
+		c.validator.logger.ErrorContext(c.ctx, "request type is not proto.Message")
+		return connect.NewError(connect.CodeInternal, errors.New("request type is not proto.Message"))
+	}
+	if err := c.validator.validator.Validate(m); err != nil {
+		c.validator.logger.InfoContext(c.ctx, "stream message validation failed", "error", err)
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package log
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/spf13/viper"
+)
+
+// AdminPath はログレベルを変更する管理用エンドポイントのパスです。
+const AdminPath = "/admin/log-level"
+
+// AdminConfig は管理用エンドポイントの設定です。
+type AdminConfig struct {
+	Token string // Bearerトークン（空文字列の場合は管理用エンドポイントを無効にする）
+}
+
+// NewAdminConfig は設定ファイルの[admin]からAdminConfigを生成します。
+// トークンは設定ファイルに書かず、環境変数ADMIN_TOKENで指定してください。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *AdminConfig: 設定のインスタンス
+//   - error: 設定の読み込みエラー
+func NewAdminConfig(v *viper.Viper) (*AdminConfig, error) {
+	var configErrors []error
+	cfg := &AdminConfig{
+		Token: utils.GetKey[string](v, "admin.token", &configErrors),
+	}
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+	return cfg, nil
+}
+
+// AdminHandler はログレベルを参照・変更する管理用のHTTPハンドラです。
+//
+//   - GET: 全体のレベルとグループごとの上書きを返す
+//   - PUT: {"level": "debug"}で全体のレベルを、{"group": "db", "level": "debug"}でグループのレベルを変更する。
+//     グループを指定してlevelを空文字列にすると、グループの上書きを解除する
+//
+// リクエストにはAuthorization: Bearer <admin.token>ヘッダーが必要です。
+type AdminHandler struct {
+	levels *Levels
+	token  string
+	logger *slog.Logger
+}
+
+// levelState は管理用エンドポイントのレスポンスです。
+type levelState struct {
+	Level  string            `json:"level"`
+	Groups map[string]string `json:"groups"`
+}
+
+// levelUpdate は管理用エンドポイントのリクエストです。
+type levelUpdate struct {
+	Group string `json:"group"`
+	Level string `json:"level"`
+}
+
+// NewAdminHandler はAdminHandlerを生成します。
+//
+// Parameters:
+//   - levels: 実行時に変更できるログレベル
+//   - cfg: 管理用エンドポイントの設定
+//   - logger: ロガー
+//
+// Returns:
+//   - *AdminHandler: AdminHandler
+func NewAdminHandler(levels *Levels, cfg *AdminConfig, logger *slog.Logger) *AdminHandler {
+	return &AdminHandler{levels: levels, token: cfg.Token, logger: logger}
+}
+
+// Enabled は管理用エンドポイントが有効（トークンを設定済み）かを返します。
+//
+// Returns:
+//   - bool: 有効な場合はtrue
+func (h *AdminHandler) Enabled() bool {
+	return h.token != ""
+}
+
+// ServeHTTP はログレベルの参照・変更のリクエストを処理します。
+func (h *AdminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.Enabled() {
+		http.NotFound(w, r)
+		return
+	}
+	if !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var req levelUpdate
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<10)).Decode(&req); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+		group := groupName(req.Group)
+		if group != "" && req.Level == "" {
+			h.levels.ResetGroup(group)
+		} else {
+			level, err := ParseLevel(req.Level)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if group == "" {
+				h.levels.SetGlobal(level)
+			} else {
+				h.levels.SetGroup(group, level)
+			}
+		}
+		h.logger.InfoContext(r.Context(), "Log level changed via admin endpoint",
+			slog.String("group", group), slog.String("level", req.Level))
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	state := levelState{Level: h.levels.Global().String(), Groups: map[string]string{}}
+	for group, level := range h.levels.Groups() {
+		state.Groups[group] = level.String()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(state)
+}
+
+// authorized はAuthorizationヘッダーのBearerトークンを定数時間で比較します。
+func (h *AdminHandler) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) == 1
+}
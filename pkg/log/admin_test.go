@@ -0,0 +1,89 @@
+package log_test
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("AdminHandler", func() {
+	var (
+		levels  *log.Levels
+		handler *log.AdminHandler
+	)
+
+	// request は管理用エンドポイントにリクエストし、レスポンスを返します。
+	request := func(method, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, log.AdminPath, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	BeforeEach(func() {
+		v := viper.New()
+		v.Set("log.level", "info")
+		v.Set("admin.token", "secret")
+		var err error
+		levels, err = log.NewLevels(v)
+		Expect(err).NotTo(HaveOccurred())
+		cfg, err := log.NewAdminConfig(v)
+		Expect(err).NotTo(HaveOccurred())
+		handler = log.NewAdminHandler(levels, cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	})
+
+	It("トークンがない、または一致しない場合は401を返す", func() {
+		Expect(request(http.MethodGet, "", "").Code).To(Equal(http.StatusUnauthorized))
+		Expect(request(http.MethodPut, "wrong", `{"level":"debug"}`).Code).To(Equal(http.StatusUnauthorized))
+		Expect(levels.Global()).To(Equal(slog.LevelInfo))
+	})
+
+	It("トークンが未設定の場合は404を返す", func() {
+		handler = log.NewAdminHandler(levels, &log.AdminConfig{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+		Expect(request(http.MethodGet, "", "").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("全体のレベルを変更する", func() {
+		rec := request(http.MethodPut, "secret", `{"level":"debug"}`)
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(levels.Global()).To(Equal(slog.LevelDebug))
+		var state map[string]any
+		Expect(json.Unmarshal(rec.Body.Bytes(), &state)).To(Succeed())
+		Expect(state).To(HaveKeyWithValue("level", "DEBUG"))
+	})
+
+	It("グループのレベルを変更し、levelを空にすると上書きを解除する", func() {
+		Expect(request(http.MethodPut, "secret", `{"group":"db","level":"debug"}`).Code).To(Equal(http.StatusOK))
+		Expect(levels.Level("db")).To(Equal(slog.LevelDebug))
+
+		rec := request(http.MethodGet, "secret", "")
+		Expect(rec.Body.String()).To(MatchJSON(`{"level":"INFO","groups":{"db":"DEBUG"}}`))
+
+		Expect(request(http.MethodPut, "secret", `{"group":"db","level":""}`).Code).To(Equal(http.StatusOK))
+		Expect(levels.Level("db")).To(Equal(slog.LevelInfo))
+	})
+
+	It("不正なレベルの場合は400を返す", func() {
+		rec := request(http.MethodPut, "secret", `{"level":"verbose"}`)
+
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(levels.Global()).To(Equal(slog.LevelInfo))
+	})
+
+	It("GETとPUT以外のメソッドは405を返す", func() {
+		Expect(request(http.MethodPost, "secret", `{"level":"debug"}`).Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
@@ -0,0 +1,243 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+// Levels は実行時に変更できるログレベルを管理します。
+// 全体のレベルに加えて、Namedで生成したロガーのグループ（例: rpc、db）ごとにレベルを上書きできます。
+// レベルはゴルーチンから安全に読み書きでき、GORMやSQLBoilerはクエリごとにdbグループのレベルを参照してログ出力を追従させます。
+type Levels struct {
+	global *slog.LevelVar
+
+	mu        sync.RWMutex
+	groups    map[string]slog.Level // グループごとの上書き
+	listeners []func()
+}
+
+// NewLevels は設定ファイルのlog.levelとlog.groupsからLevelsを生成します。
+//
+// Parameters:
+//   - config: Viperインスタンス
+//
+// Returns:
+//   - *Levels: Levels
+//   - error: ログレベルが不正な場合のエラー
+func NewLevels(config *viper.Viper) (*Levels, error) {
+	levels := &Levels{global: new(slog.LevelVar), groups: map[string]slog.Level{}}
+	if err := levels.Apply(config); err != nil {
+		return nil, err
+	}
+	return levels, nil
+}
+
+// ParseLevel はログレベルの名前（debug / info / warn / error）を解析します。
+//
+// Parameters:
+//   - s: ログレベルの名前（大文字・小文字は区別しない）
+//
+// Returns:
+//   - slog.Level: ログレベル
+//   - error: 不正な名前の場合のエラー
+func ParseLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(s)); err != nil {
+		return 0, fmt.Errorf("invalid log level %q: %w", s, err)
+	}
+	return level, nil
+}
+
+// Apply は設定ファイルのlog.levelとlog.groupsでレベルを置き換えます。
+// 設定ファイルにないグループの上書きは解除します。
+//
+// Parameters:
+//   - config: Viperインスタンス
+//
+// Returns:
+//   - error: ログレベルが不正な場合のエラー（レベルは変更しない）
+func (l *Levels) Apply(config *viper.Viper) error {
+	global, err := ParseLevel(config.GetString("log.level"))
+	if err != nil {
+		return fmt.Errorf("log.level: %w", err)
+	}
+	groups := map[string]slog.Level{}
+	for group, value := range config.GetStringMapString("log.groups") {
+		level, err := ParseLevel(value)
+		if err != nil {
+			return fmt.Errorf("log.groups.%s: %w", group, err)
+		}
+		groups[groupName(group)] = level
+	}
+
+	l.mu.Lock()
+	l.global.Set(global)
+	l.groups = groups
+	l.mu.Unlock()
+	l.notify()
+	return nil
+}
+
+// Level はグループに適用するレベルを返します。グループの上書きがない場合は全体のレベルを返します。
+//
+// Parameters:
+//   - group: グループ（空文字列の場合は全体）
+//
+// Returns:
+//   - slog.Level: ログレベル
+func (l *Levels) Level(group string) slog.Level {
+	if group = groupName(group); group != "" {
+		l.mu.RLock()
+		level, ok := l.groups[group]
+		l.mu.RUnlock()
+		if ok {
+			return level
+		}
+	}
+	return l.global.Level()
+}
+
+// Global は全体のレベルを返します。
+//
+// Returns:
+//   - slog.Level: ログレベル
+func (l *Levels) Global() slog.Level {
+	return l.global.Level()
+}
+
+// Groups はグループごとの上書きを返します。
+//
+// Returns:
+//   - map[string]slog.Level: グループごとのレベル（上書きしていないグループは含まない）
+func (l *Levels) Groups() map[string]slog.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return maps.Clone(l.groups)
+}
+
+// SetGlobal は全体のレベルを変更します。
+//
+// Parameters:
+//   - level: ログレベル
+func (l *Levels) SetGlobal(level slog.Level) {
+	l.global.Set(level)
+	l.notify()
+}
+
+// SetGroup はグループのレベルを上書きします。グループ名の大文字・小文字は区別しません。
+//
+// Parameters:
+//   - group: グループ
+//   - level: ログレベル
+func (l *Levels) SetGroup(group string, level slog.Level) {
+	l.mu.Lock()
+	l.groups[groupName(group)] = level
+	l.mu.Unlock()
+	l.notify()
+}
+
+// ResetGroup はグループの上書きを解除し、全体のレベルに従わせます。
+//
+// Parameters:
+//   - group: グループ
+func (l *Levels) ResetGroup(group string) {
+	l.mu.Lock()
+	delete(l.groups, groupName(group))
+	l.mu.Unlock()
+	l.notify()
+}
+
+// OnChange はレベルを変更したときに呼び出す関数を登録します。
+//
+// Parameters:
+//   - fn: レベルの変更後に呼び出す関数
+func (l *Levels) OnChange(fn func()) {
+	l.mu.Lock()
+	l.listeners = append(l.listeners, fn)
+	l.mu.Unlock()
+}
+
+// groupName はグループ名を小文字に正規化します。
+// 設定ファイル（Viperはキーを小文字にする）と管理用エンドポイントで同じグループを指せるようにします。
+func groupName(group string) string {
+	return strings.ToLower(strings.TrimSpace(group))
+}
+
+func (l *Levels) notify() {
+	l.mu.RLock()
+	listeners := append([]func(){}, l.listeners...)
+	l.mu.RUnlock()
+	for _, fn := range listeners {
+		fn()
+	}
+}
+
+// WatchConfig は設定ファイルを監視し、変更時にログレベルを読み込み直します。
+// log.watchがfalseの場合は監視しません。環境変数（LOG_LEVEL）で指定したレベルは設定ファイルより優先されます。
+// 管理用エンドポイントで変更したレベルは、次に設定ファイルが変更されたときに設定ファイルの値に戻ります。
+//
+// Parameters:
+//   - config: Viperインスタンス
+//   - levels: Levels
+//   - logger: ロガー
+func WatchConfig(config *viper.Viper, levels *Levels, logger *slog.Logger) {
+	if !config.GetBool("log.watch") {
+		return
+	}
+	config.OnConfigChange(func(e fsnotify.Event) {
+		ctx := context.Background()
+		if err := levels.Apply(config); err != nil {
+			logger.WarnContext(ctx, "Failed to reload log level; keeping current level", slog.String("file", e.Name), slog.Any("error", err))
+			return
+		}
+		logger.InfoContext(ctx, "Log level reloaded", slog.String("file", e.Name), slog.String("level", levels.Global().String()))
+	})
+	config.WatchConfig()
+}
+
+// levelHandler はLevelsに従ってログを出力するかを判定するslog.Handlerです。
+type levelHandler struct {
+	next   slog.Handler
+	levels *Levels
+	group  string // Namedで指定したグループ
+}
+
+func (h *levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.levels.Level(h.group) && h.next.Enabled(ctx, level)
+}
+
+func (h *levelHandler) Handle(ctx context.Context, r slog.Record) error {
+	return h.next.Handle(ctx, r)
+}
+
+func (h *levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &levelHandler{next: h.next.WithAttrs(attrs), levels: h.levels, group: h.group}
+}
+
+func (h *levelHandler) WithGroup(name string) slog.Handler {
+	return &levelHandler{next: h.next.WithGroup(name), levels: h.levels, group: h.group}
+}
+
+// Named はグループ名を付けたロガーを返します。
+// グループのレベルはLevels.SetGroupまたは設定ファイルのlog.groupsで全体のレベルと別に変更できます。
+// ログにはlogger属性としてグループ名を出力します。
+//
+// Parameters:
+//   - logger: NewLoggerWithLevelsで生成したロガー
+//   - group: グループ名（例: rpc、db）
+//
+// Returns:
+//   - *slog.Logger: グループ名を付けたロガー（Levelsを使わないロガーの場合はlogger属性のみ付ける）
+func Named(logger *slog.Logger, group string) *slog.Logger {
+	if h, ok := logger.Handler().(*levelHandler); ok {
+		logger = slog.New(&levelHandler{next: h.next, levels: h.levels, group: groupName(group)})
+	}
+	return logger.With(slog.String("logger", group))
+}
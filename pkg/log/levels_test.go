@@ -0,0 +1,138 @@
+package log_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+func TestLog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Log Suite")
+}
+
+var _ = Describe("Levels", func() {
+	var (
+		v      *viper.Viper
+		levels *log.Levels
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		v = viper.New()
+		v.Set("log.level", "info")
+		v.Set("log.groups", map[string]any{"db": "warn"})
+		var err error
+		levels, err = log.NewLevels(v)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("設定ファイルの全体のレベルとグループごとのレベルを読み込む", func() {
+		Expect(levels.Global()).To(Equal(slog.LevelInfo))
+		Expect(levels.Level("db")).To(Equal(slog.LevelWarn))
+		Expect(levels.Level("rpc")).To(Equal(slog.LevelInfo), "上書きしていないグループは全体のレベルに従う")
+	})
+
+	It("不正なレベルの場合はエラーを返す", func() {
+		v.Set("log.level", "verbose")
+
+		_, err := log.NewLevels(v)
+
+		Expect(err).To(MatchError(ContainSubstring("log.level")))
+	})
+
+	It("レベルを変更すると生成済みのロガーにも反映する", func() {
+		logger := log.NewLoggerWithLevels(v, levels)
+		rpcLogger := log.Named(logger, "rpc")
+		Expect(logger.Enabled(ctx, slog.LevelDebug)).To(BeFalse())
+
+		levels.SetGlobal(slog.LevelDebug)
+
+		Expect(logger.Enabled(ctx, slog.LevelDebug)).To(BeTrue())
+		Expect(rpcLogger.Enabled(ctx, slog.LevelDebug)).To(BeTrue())
+	})
+
+	It("グループのレベルはそのグループのロガーにのみ反映する", func() {
+		logger := log.NewLoggerWithLevels(v, levels)
+		rpcLogger := log.Named(logger, "rpc").With(slog.String("procedure", "/query.v1.ProductService/ListProducts"))
+
+		levels.SetGroup("rpc", slog.LevelError)
+
+		Expect(rpcLogger.Enabled(ctx, slog.LevelWarn)).To(BeFalse())
+		Expect(logger.Enabled(ctx, slog.LevelWarn)).To(BeTrue())
+
+		levels.ResetGroup("rpc")
+
+		Expect(rpcLogger.Enabled(ctx, slog.LevelWarn)).To(BeTrue())
+	})
+
+	It("グループ名の大文字・小文字を区別しない", func() {
+		logger := log.NewLoggerWithLevels(v, levels)
+		dbLogger := log.Named(logger, "db")
+
+		levels.SetGroup("DB", slog.LevelDebug)
+
+		Expect(levels.Level("db")).To(Equal(slog.LevelDebug))
+		Expect(dbLogger.Enabled(ctx, slog.LevelDebug)).To(BeTrue())
+		Expect(levels.Groups()).To(Equal(map[string]slog.Level{"db": slog.LevelDebug}))
+
+		levels.ResetGroup(" Db ")
+
+		Expect(levels.Level("db")).To(Equal(slog.LevelInfo))
+	})
+
+	It("レベルを変更するとOnChangeで登録した関数を呼び出す", func() {
+		var calls int
+		levels.OnChange(func() { calls++ })
+
+		levels.SetGlobal(slog.LevelDebug)
+		levels.SetGroup("db", slog.LevelDebug)
+
+		Expect(calls).To(Equal(2))
+	})
+
+	It("設定ファイルを読み込み直すと設定ファイルにないグループの上書きを解除する", func() {
+		levels.SetGroup("rpc", slog.LevelError)
+		v.Set("log.level", "warn")
+
+		Expect(levels.Apply(v)).To(Succeed())
+
+		Expect(levels.Global()).To(Equal(slog.LevelWarn))
+		Expect(levels.Groups()).To(Equal(map[string]slog.Level{"db": slog.LevelWarn}))
+	})
+
+	It("設定ファイルが不正な場合はレベルを変更しない", func() {
+		v.Set("log.groups", map[string]any{"db": "verbose"})
+
+		Expect(levels.Apply(v)).To(MatchError(ContainSubstring("log.groups.db")))
+
+		Expect(levels.Level("db")).To(Equal(slog.LevelWarn))
+	})
+})
+
+var _ = Describe("WatchConfig", func() {
+	It("設定ファイルの変更時にレベルを読み込み直す", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "config.toml")
+		Expect(os.WriteFile(path, []byte("[log]\nlevel = \"info\"\nwatch = true\n"), 0o600)).To(Succeed())
+		v := viper.New()
+		v.SetConfigFile(path)
+		Expect(v.ReadInConfig()).To(Succeed())
+		levels, err := log.NewLevels(v)
+		Expect(err).NotTo(HaveOccurred())
+
+		log.WatchConfig(v, levels, slog.New(slog.NewTextHandler(io.Discard, nil)))
+		Expect(os.WriteFile(path, []byte("[log]\nlevel = \"debug\"\nwatch = true\n\n[log.groups]\ndb = \"error\"\n"), 0o600)).To(Succeed())
+
+		Eventually(levels.Global).Should(Equal(slog.LevelDebug))
+		Eventually(func() slog.Level { return levels.Level("db") }).Should(Equal(slog.LevelError))
+	})
+})
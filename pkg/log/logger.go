@@ -3,6 +3,7 @@ package log
 import (
 	"context"
 	"log/slog"
+	"math"
 	"os"
 
 	"github.com/spf13/viper"
@@ -10,16 +11,29 @@ import (
 )
 
 // NewLogger は設定に基づいてslog.Loggerを初期化します。
+// ログレベルは生成時に固定されます。実行時にレベルを変更する場合はNewLoggerWithLevelsを使用してください。
 func NewLogger(config *viper.Viper) (*slog.Logger, error) {
-	// ログレベルを設定
-	level := slog.LevelInfo
-	if err := level.UnmarshalText([]byte(config.GetString("log.level"))); err != nil {
+	levels, err := NewLevels(config)
+	if err != nil {
 		return nil, err
 	}
+	return NewLoggerWithLevels(config, levels), nil
+}
 
+// NewLoggerWithLevels はLevelsに従ってログを出力するslog.Loggerを初期化します。
+// Levelsのレベルを変更すると、生成済みのロガーにも即座に反映されます。
+//
+// Parameters:
+//   - config: Viperインスタンス
+//   - levels: 実行時に変更できるログレベル
+//
+// Returns:
+//   - *slog.Logger: ロガー
+func NewLoggerWithLevels(config *viper.Viper, levels *Levels) *slog.Logger {
 	isDevelopment := config.GetString("env") == "dev"
 	opts := &slog.HandlerOptions{
-		Level:     level,
+		// レベルの判定はlevelHandlerで行うため、ハンドラではすべてのレベルを受け付ける
+		Level:     slog.Level(math.MinInt),
 		AddSource: isDevelopment,
 	}
 
@@ -37,8 +51,7 @@ func NewLogger(config *viper.Viper) (*slog.Logger, error) {
 	otelHandler := &OtelHandler{Next: handler}
 
 	// ロガーを作成して返す
-	logger := slog.New(otelHandler)
-	return logger, nil
+	return slog.New(&levelHandler{next: otelHandler, levels: levels})
 }
 
 // OtelHandler はログレコードにトレースIDとスパンIDを自動で追加するslog.Handlerです。
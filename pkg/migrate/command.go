@@ -0,0 +1,125 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// Usage はmigrateサブコマンドの使い方です。
+const Usage = `usage: migrate <command>
+
+commands:
+  up        未適用のマイグレーションをすべて適用する
+  down [N]  適用済みのマイグレーションを新しいものからN個（既定は1個）取り消す
+  status    マイグレーションの適用状況と未適用の数を表示する
+`
+
+// Command は解釈したmigrateサブコマンドです。
+type Command struct {
+	Name  string // up / down / status
+	Steps int    // downで取り消すマイグレーションの数
+}
+
+// ParseArgs はmigrateサブコマンドの引数を解釈します。
+// DBに接続する前に引数を検証するために使用します。
+//
+// Parameters:
+//   - args: サブコマンド以降の引数（例: ["down", "2"]）
+//
+// Returns:
+//   - *Command: 解釈したサブコマンド
+//   - error: 引数が不正な場合のエラー（使い方を含む）
+func ParseArgs(args []string) (*Command, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no command specified\n%s", Usage)
+	}
+	switch args[0] {
+	case "up", "status":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s takes no arguments\n%s", args[0], Usage)
+		}
+		return &Command{Name: args[0]}, nil
+	case "down":
+		if len(args) > 2 {
+			return nil, fmt.Errorf("down takes at most one argument\n%s", Usage)
+		}
+		steps := 1
+		if len(args) == 2 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid number of steps: %s\n%s", args[1], Usage)
+			}
+			steps = n
+		}
+		return &Command{Name: "down", Steps: steps}, nil
+	default:
+		return nil, fmt.Errorf("unknown command: %s\n%s", args[0], Usage)
+	}
+}
+
+// Run はサブコマンドに応じてマイグレーションを実行し、結果をwに出力します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - m: Migrator
+//   - cmd: ParseArgsで解釈したサブコマンド
+//   - w: 結果の出力先
+//
+// Returns:
+//   - error: マイグレーションの実行に失敗した場合のエラー
+func Run(ctx context.Context, m *Migrator, cmd *Command, w io.Writer) error {
+	switch cmd.Name {
+	case "up":
+		applied, err := m.Up(ctx)
+		_, _ = fmt.Fprintf(w, "applied %d migration(s)\n", applied)
+		return err
+	case "down":
+		reverted, err := m.Down(ctx, cmd.Steps)
+		_, _ = fmt.Fprintf(w, "reverted %d migration(s)\n", reverted)
+		return err
+	default:
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		return WriteStatus(w, statuses)
+	}
+}
+
+// WriteStatus はマイグレーションの適用状況を表形式で出力し、最後に未適用の数を出力します。
+//
+// Parameters:
+//   - w: 出力先
+//   - statuses: 適用状況
+//
+// Returns:
+//   - error: 出力に失敗した場合のエラー
+func WriteStatus(w io.Writer, statuses []*Status) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, status := range statuses {
+		state := "pending"
+		switch {
+		case status.Dirty:
+			state = "dirty"
+		case status.Unknown:
+			state = "applied (missing file)"
+		case status.Applied:
+			state = "applied"
+		}
+		appliedAt := "-"
+		if status.Applied {
+			appliedAt = status.AppliedAt.Format(time.DateTime)
+		}
+		_, _ = fmt.Fprintf(tw, "%04d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "pending: %d\n", CountPending(statuses))
+	return err
+}
@@ -0,0 +1,134 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dialect はマイグレーションの管理に使用するSQLのデータベースごとの方言です。
+type Dialect int
+
+const (
+	// MySQL はMySQLの方言です。ロックにはGET_LOCKを使用します。
+	MySQL Dialect = iota
+	// Postgres はPostgreSQLの方言です。ロックにはセッション単位のアドバイザリロックを使用します。
+	Postgres
+)
+
+// postgresLockPollInterval はPostgreSQLでアドバイザリロックの取得を再試行する間隔です。
+const postgresLockPollInterval = 100 * time.Millisecond
+
+// String は方言の名前を返します。
+//
+// Returns:
+//   - string: 方言の名前（mysql / postgres）
+func (d Dialect) String() string {
+	switch d {
+	case MySQL:
+		return "mysql"
+	case Postgres:
+		return "postgres"
+	default:
+		return "unknown(" + strconv.Itoa(int(d)) + ")"
+	}
+}
+
+// createTableSQL は適用済みのバージョンを記録するテーブルを作成するSQLを返します。
+// dirtyは適用または取り消しの途中で失敗したバージョンを表します。
+func (d Dialect) createTableSQL() string {
+	if d == Postgres {
+		return `CREATE TABLE IF NOT EXISTS schema_migrations(
+    version BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    dirty BOOLEAN NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (version)
+)`
+	}
+	return `CREATE TABLE IF NOT EXISTS schema_migrations(
+    version BIGINT UNSIGNED NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    dirty BOOLEAN NOT NULL,
+    applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (version)
+)`
+}
+
+// tableExistsSQL はschema_migrationsテーブルの数を返すSQLを返します。
+func (d Dialect) tableExistsSQL() string {
+	schema := "DATABASE()"
+	if d == Postgres {
+		schema = "current_schema()"
+	}
+	return "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = " + schema + " AND table_name = 'schema_migrations'"
+}
+
+// rebind は?のプレースホルダーを方言のプレースホルダーに置き換えます。
+// マイグレーションの管理に使用するSQLは文字列リテラルに?を含まないため、単純に置き換えます。
+func (d Dialect) rebind(query string) string {
+	if d != Postgres {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// lock はconnでマイグレーションのロックを取得します。ロックは接続単位のため、解放も同じ接続で行います。
+// ロックはサーバー全体で共有されるため、名前にデータベース名を含めます。
+func (d Dialect) lock(ctx context.Context, conn *sql.Conn, timeout time.Duration) error {
+	if d != Postgres {
+		var acquired sql.NullInt64
+		if err := conn.QueryRowContext(ctx,
+			"SELECT GET_LOCK(CONCAT(DATABASE(), '.schema_migrations'), ?)", int(timeout.Seconds()),
+		).Scan(&acquired); err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		if acquired.Int64 != 1 {
+			return fmt.Errorf("failed to acquire migration lock within %s", timeout)
+		}
+		return nil
+	}
+
+	// pg_advisory_lockは待ち時間を指定できないため、pg_try_advisory_lockを期限まで繰り返す
+	deadline := time.Now().Add(timeout)
+	for {
+		var acquired bool
+		if err := conn.QueryRowContext(ctx,
+			"SELECT pg_try_advisory_lock(hashtext(current_database() || '.schema_migrations'))",
+		).Scan(&acquired); err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		if acquired {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("failed to acquire migration lock within %s", timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to acquire migration lock: %w", ctx.Err())
+		case <-time.After(postgresLockPollInterval):
+		}
+	}
+}
+
+// unlockSQL はlockで取得したロックを解放するSQLを返します。
+func (d Dialect) unlockSQL() string {
+	if d == Postgres {
+		return "SELECT pg_advisory_unlock(hashtext(current_database() || '.schema_migrations'))"
+	}
+	return "SELECT RELEASE_LOCK(CONCAT(DATABASE(), '.schema_migrations'))"
+}
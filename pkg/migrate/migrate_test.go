@@ -0,0 +1,203 @@
+package migrate
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/db/migrations"
+	"github.com/haru-256/practical-go-grpc-micro-service/db/migrations/postgres"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMigrate(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Migrate Suite")
+}
+
+var _ = Describe("Load", func() {
+	It("バージョンの昇順にupとdownを組み合わせて読み込む", func() {
+		fsys := fstest.MapFS{
+			"0010_add_index.up.sql":        {Data: []byte("CREATE INDEX idx ON t (c);")},
+			"0002_create_table.up.sql":     {Data: []byte("CREATE TABLE t (c INT);")},
+			"0002_create_table.down.sql":   {Data: []byte("DROP TABLE t;")},
+			"README.md":                    {Data: []byte("ignored")},
+			"0003_not_a_migration.sql.bak": {Data: []byte("ignored")},
+		}
+		migrations, err := Load(fsys)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(migrations).To(HaveLen(2))
+		Expect(migrations[0]).To(Equal(&Migration{Version: 2, Name: "create_table", Up: "CREATE TABLE t (c INT);", Down: "DROP TABLE t;"}))
+		Expect(migrations[1].Version).To(Equal(uint64(10)))
+		Expect(migrations[1].Down).To(BeEmpty())
+	})
+
+	DescribeTable("不正なファイル構成はエラーになる",
+		func(fsys fstest.MapFS, message string) {
+			_, err := Load(fsys)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("upファイルがない", fstest.MapFS{
+			"0001_init.down.sql": {Data: []byte("DROP TABLE t;")},
+		}, "has no up file"),
+		Entry("同じバージョンで名前が異なる", fstest.MapFS{
+			"0001_init.up.sql":  {Data: []byte("CREATE TABLE t (c INT);")},
+			"0001_other.up.sql": {Data: []byte("CREATE TABLE u (c INT);")},
+		}, "duplicate migration version 1"),
+	)
+
+	It("リポジトリのマイグレーションを読み込める", func() {
+		loaded, err := Load(migrations.FS)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded).NotTo(BeEmpty())
+		Expect(loaded[0].Name).To(Equal("create_objects"))
+		for _, migration := range loaded {
+			Expect(migration.Down).NotTo(BeEmpty(), "migration %d_%s", migration.Version, migration.Name)
+		}
+		Expect(splitStatements(loaded[0].Up, MySQL)).To(HaveLen(12))
+		Expect(splitStatements(loaded[0].Down, MySQL)).To(HaveLen(12))
+	})
+
+	It("PostgreSQL用のマイグレーションはMySQL用と同じバージョンで構成される", func() {
+		mysqlLoaded, err := Load(migrations.FS)
+		Expect(err).NotTo(HaveOccurred())
+		postgresLoaded, err := Load(postgres.FS)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(postgresLoaded).To(HaveLen(len(mysqlLoaded)))
+		for i, migration := range postgresLoaded {
+			Expect(migration.Version).To(Equal(mysqlLoaded[i].Version))
+			Expect(migration.Name).To(Equal(mysqlLoaded[i].Name))
+			Expect(migration.Down).NotTo(BeEmpty(), "migration %d_%s", migration.Version, migration.Name)
+		}
+		// テーブル12個と一意制約以外のインデックス8個
+		Expect(splitStatements(postgresLoaded[0].Up, Postgres)).To(HaveLen(20))
+		Expect(splitStatements(postgresLoaded[0].Down, Postgres)).To(HaveLen(12))
+	})
+})
+
+var _ = Describe("Dialect", func() {
+	DescribeTable("プレースホルダーを方言に合わせて置き換える",
+		func(dialect Dialect, expected string) {
+			Expect(dialect.rebind("UPDATE schema_migrations SET name = ? WHERE version = ?")).To(Equal(expected))
+		},
+		Entry("MySQLはそのまま", MySQL, "UPDATE schema_migrations SET name = ? WHERE version = ?"),
+		Entry("PostgreSQLは番号付き", Postgres, "UPDATE schema_migrations SET name = $1 WHERE version = $2"),
+	)
+
+	DescribeTable("方言ごとのSQLを返す",
+		func(dialect Dialect, name string, schema string, appliedAt string, unlock string) {
+			Expect(dialect.String()).To(Equal(name))
+			Expect(dialect.tableExistsSQL()).To(ContainSubstring("table_schema = " + schema))
+			Expect(dialect.createTableSQL()).To(ContainSubstring("applied_at " + appliedAt))
+			Expect(dialect.unlockSQL()).To(HavePrefix("SELECT " + unlock + "("))
+		},
+		Entry("MySQL", MySQL, "mysql", "DATABASE()", "DATETIME", "RELEASE_LOCK"),
+		Entry("PostgreSQL", Postgres, "postgres", "current_schema()", "TIMESTAMPTZ", "pg_advisory_unlock"),
+	)
+})
+
+var _ = Describe("splitStatements", func() {
+	DescribeTable("セミコロンで文に分割する",
+		func(sql string, expected []string) {
+			Expect(splitStatements(sql, MySQL)).To(Equal(expected))
+		},
+		Entry("複数の文", "CREATE TABLE a (c INT);\nCREATE TABLE b (c INT);\n",
+			[]string{"CREATE TABLE a (c INT)", "CREATE TABLE b (c INT)"}),
+		Entry("末尾のセミコロンがない", "SELECT 1", []string{"SELECT 1"}),
+		Entry("文字列リテラル内のセミコロン", "INSERT INTO t VALUES ('a;b', \"c;d\", 'e''s;');",
+			[]string{"INSERT INTO t VALUES ('a;b', \"c;d\", 'e''s;')"}),
+		Entry("エスケープされた引用符", `INSERT INTO t VALUES ('a\';b');`, []string{`INSERT INTO t VALUES ('a\';b')`}),
+		Entry("識別子の引用符内のセミコロン", "SELECT `a;b` FROM t;", []string{"SELECT `a;b` FROM t"}),
+		Entry("コメント内のセミコロン", "/* a; b */\nSELECT 1; -- c; d\n# e; f\nSELECT 2;",
+			[]string{"/* a; b */\nSELECT 1", "-- c; d\n# e; f\nSELECT 2"}),
+		Entry("コメントだけの文は除く", "SELECT 1;\n/* 終わり */\n-- 終わり\n", []string{"SELECT 1"}),
+		Entry("空のSQL", "  \n", []string{}),
+	)
+
+	DescribeTable("PostgreSQLの構文に合わせて文に分割する",
+		func(sql string, expected []string) {
+			Expect(splitStatements(sql, Postgres)).To(Equal(expected))
+		},
+		Entry("#はコメントではなくビット演算子", "SELECT 5 # 3;\nSELECT 1;",
+			[]string{"SELECT 5 # 3", "SELECT 1"}),
+		Entry("$$で囲んだ関数の本体", "CREATE FUNCTION f() RETURNS trigger AS $$\nBEGIN\n  NEW.a := 1;\n  RETURN NEW;\nEND;\n$$ LANGUAGE plpgsql;\nSELECT 1;",
+			[]string{"CREATE FUNCTION f() RETURNS trigger AS $$\nBEGIN\n  NEW.a := 1;\n  RETURN NEW;\nEND;\n$$ LANGUAGE plpgsql", "SELECT 1"}),
+		Entry("タグ付きのドル引用符の中の$$", "DO $body$ BEGIN PERFORM 'a;b'; EXECUTE $$SELECT 1;$$; END $body$;\nSELECT 2;",
+			[]string{"DO $body$ BEGIN PERFORM 'a;b'; EXECUTE $$SELECT 1;$$; END $body$", "SELECT 2"}),
+		Entry("パラメータと識別子の$はドル引用符ではない", "SELECT $1, a$b FROM t;SELECT 2;",
+			[]string{"SELECT $1, a$b FROM t", "SELECT 2"}),
+		Entry("バックスラッシュはE''の文字列でのみエスケープ", `SELECT 'a\'; SELECT E'b\';c';`,
+			[]string{`SELECT 'a\'`, `SELECT E'b\';c'`}),
+	)
+})
+
+var _ = Describe("buildStatuses", func() {
+	appliedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	migrations := []*Migration{
+		{Version: 1, Name: "create_objects"},
+		{Version: 2, Name: "add_column"},
+		{Version: 3, Name: "add_index"},
+	}
+
+	It("適用済み・未適用・ファイルが存在しないバージョンをバージョンの昇順に返す", func() {
+		records := map[uint64]*Status{
+			1: {Version: 1, Name: "create_objects", Applied: true, AppliedAt: appliedAt},
+			2: {Version: 2, Name: "add_column", Applied: true, Dirty: true, AppliedAt: appliedAt},
+			5: {Version: 5, Name: "removed", Applied: true, AppliedAt: appliedAt},
+		}
+		statuses := buildStatuses(migrations, records)
+		Expect(statuses).To(Equal([]*Status{
+			{Version: 1, Name: "create_objects", Applied: true, AppliedAt: appliedAt},
+			{Version: 2, Name: "add_column", Applied: true, Dirty: true, AppliedAt: appliedAt},
+			{Version: 3, Name: "add_index"},
+			{Version: 5, Name: "removed", Applied: true, Unknown: true, AppliedAt: appliedAt},
+		}))
+		Expect(CountPending(statuses)).To(Equal(1))
+	})
+
+	It("表形式で出力し、最後に未適用の数を出力する", func() {
+		statuses := buildStatuses(migrations, map[uint64]*Status{
+			1: {Version: 1, Name: "create_objects", Applied: true, AppliedAt: appliedAt},
+		})
+		var buf bytes.Buffer
+		Expect(WriteStatus(&buf, statuses)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		Expect(lines).To(HaveLen(5))
+		Expect(lines[0]).To(MatchRegexp(`^VERSION\s+NAME\s+STATUS\s+APPLIED AT$`))
+		Expect(lines[1]).To(MatchRegexp(`^0001\s+create_objects\s+applied\s+2026-10-01 12:00:00$`))
+		Expect(lines[2]).To(MatchRegexp(`^0002\s+add_column\s+pending\s+-$`))
+		Expect(lines[4]).To(Equal("pending: 2"))
+	})
+})
+
+var _ = Describe("ParseArgs", func() {
+	DescribeTable("サブコマンドを解釈する",
+		func(args []string, expected *Command) {
+			cmd, err := ParseArgs(args)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmd).To(Equal(expected))
+		},
+		Entry("up", []string{"up"}, &Command{Name: "up"}),
+		Entry("downの既定は1個", []string{"down"}, &Command{Name: "down", Steps: 1}),
+		Entry("downの数を指定", []string{"down", "2"}, &Command{Name: "down", Steps: 2}),
+		Entry("status", []string{"status"}, &Command{Name: "status"}),
+	)
+
+	DescribeTable("不正な引数はエラーになる",
+		func(args []string, message string) {
+			cmd, err := ParseArgs(args)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+			Expect(err).To(MatchError(ContainSubstring("usage: migrate")))
+			Expect(cmd).To(BeNil())
+		},
+		Entry("コマンドなし", []string{}, "no command specified"),
+		Entry("未知のコマンド", []string{"redo"}, "unknown command: redo"),
+		Entry("upに引数", []string{"up", "1"}, "up takes no arguments"),
+		Entry("downの数が不正", []string{"down", "0"}, "invalid number of steps: 0"),
+		Entry("downの引数が多い", []string{"down", "1", "2"}, "down takes at most one argument"),
+		Entry("statusに引数", []string{"status", "all"}, "status takes no arguments"),
+	)
+})
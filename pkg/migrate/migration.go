@@ -0,0 +1,84 @@
+// Package migrate はSQLファイルによるデータベースのスキーマのマイグレーションを提供します。
+//
+// マイグレーションはバージョンの昇順に適用され、適用済みのバージョンはschema_migrationsテーブルに記録されます。
+// 複数のプロセスから同時に実行されないように、MySQLのアドバイザリロック（GET_LOCK）で排他制御します。
+package migrate
+
+import (
+	"cmp"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+// Migration は1つのバージョンのマイグレーションです。
+type Migration struct {
+	Version uint64 // バージョン
+	Name    string // 名前
+	Up      string // 適用するSQL
+	Down    string // 取り消すSQL（ファイルがない場合は空）
+}
+
+// fileNamePattern はマイグレーションファイル名のパターンです（例: 0001_create_objects.up.sql）。
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([0-9A-Za-z_]+)\.(up|down)\.sql$`)
+
+// Load はファイルシステムのルートにあるマイグレーションファイルを読み込み、バージョンの昇順に返します。
+// パターンに一致しないファイルは無視します。
+//
+// Parameters:
+//   - fsys: マイグレーションファイルを含むファイルシステム
+//
+// Returns:
+//   - []*Migration: バージョンの昇順のマイグレーション
+//   - error: ファイルの読み込みに失敗した場合、同じバージョンのファイルが重複している場合、またはupファイルがない場合のエラー
+func Load(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	byVersion := map[uint64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version %s: %w", entry.Name(), err)
+		}
+		body, err := fs.ReadFile(fsys, path.Join(".", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		} else if migration.Name != matches[2] {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, migration.Name, matches[2])
+		}
+		switch matches[3] {
+		case "up":
+			migration.Up = string(body)
+		case "down":
+			migration.Down = string(body)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, migration)
+	}
+	slices.SortFunc(migrations, func(a, b *Migration) int { return cmp.Compare(a.Version, b.Version) })
+	return migrations, nil
+}
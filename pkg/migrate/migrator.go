@@ -0,0 +1,280 @@
+package migrate
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// Status はマイグレーションの適用状況です。
+type Status struct {
+	Version   uint64    // バージョン
+	Name      string    // 名前
+	Applied   bool      // 適用済みかどうか
+	Dirty     bool      // 適用または取り消しの途中で失敗したかどうか
+	Unknown   bool      // 適用済みだがファイルが存在しないかどうか
+	AppliedAt time.Time // 適用日時（未適用の場合はゼロ値）
+}
+
+// Migrator はマイグレーションを実行します。
+type Migrator struct {
+	db          *sql.DB
+	dialect     Dialect
+	migrations  []*Migration
+	lockTimeout time.Duration
+	logger      *slog.Logger
+}
+
+// NewMigrator は新しいMigratorインスタンスを生成します。
+//
+// Parameters:
+//   - db: データベース接続
+//   - dialect: データベースの方言
+//   - migrations: バージョンの昇順のマイグレーション（Loadで読み込んだもの）
+//   - lockTimeout: アドバイザリロックの取得を待つ時間
+//   - logger: ロガー
+//
+// Returns:
+//   - *Migrator: Migratorポインタ
+func NewMigrator(db *sql.DB, dialect Dialect, migrations []*Migration, lockTimeout time.Duration, logger *slog.Logger) *Migrator {
+	return &Migrator{db: db, dialect: dialect, migrations: migrations, lockTimeout: lockTimeout, logger: logger}
+}
+
+// Up は未適用のマイグレーションをバージョンの昇順にすべて適用します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - int: 適用したマイグレーションの数
+//   - error: ロックの取得、またはマイグレーションの適用に失敗した場合のエラー
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.withLock(ctx, func(conn *sql.Conn, records map[uint64]*Status) error {
+		for _, migration := range m.migrations {
+			if _, ok := records[migration.Version]; ok {
+				continue
+			}
+			if err := m.apply(ctx, conn, migration); err != nil {
+				return err
+			}
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down は適用済みのマイグレーションを新しいバージョンから指定された数だけ取り消します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - steps: 取り消すマイグレーションの数（1以上）
+//
+// Returns:
+//   - int: 取り消したマイグレーションの数
+//   - error: ロックの取得、またはマイグレーションの取り消しに失敗した場合のエラー
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	if steps < 1 {
+		return 0, fmt.Errorf("steps must be positive: %d", steps)
+	}
+	reverted := 0
+	err := m.withLock(ctx, func(conn *sql.Conn, records map[uint64]*Status) error {
+		for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := records[migration.Version]; !ok {
+				continue
+			}
+			if err := m.revert(ctx, conn, migration); err != nil {
+				return err
+			}
+			reverted++
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status はマイグレーションの適用状況をバージョンの昇順に返します。
+// ファイルが存在しない適用済みのバージョンも含みます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - []*Status: 適用状況
+//   - error: 適用済みのバージョンの読み込みに失敗した場合のエラー
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	records, err := m.records(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	return buildStatuses(m.migrations, records), nil
+}
+
+// withLock はアドバイザリロックを取得した接続で、適用済みのバージョンを渡してfnを実行します。
+// 適用途中で失敗したバージョンがある場合は、手動で修復するまで実行しません。
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn, records map[uint64]*Status) error) (err error) {
+	// ロックは接続単位のため、同じ接続で実行する
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if err := m.dialect.lock(ctx, conn, m.lockTimeout); err != nil {
+		return err
+	}
+	defer func() {
+		if _, releaseErr := conn.ExecContext(context.WithoutCancel(ctx), m.dialect.unlockSQL()); releaseErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to release migration lock: %w", releaseErr))
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, m.dialect.createTableSQL()); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	records, err := m.records(ctx, conn)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if record.Dirty {
+			return fmt.Errorf("migration %d_%s is dirty: fix the schema manually and delete or update the row in schema_migrations", record.Version, record.Name)
+		}
+	}
+	return fn(conn, records)
+}
+
+// records は適用済みのバージョンをバージョンをキーとして返します。
+// schema_migrationsテーブルが存在しない場合は空を返します。
+func (m *Migrator) records(ctx context.Context, conn *sql.Conn) (map[uint64]*Status, error) {
+	var exists int
+	err := conn.QueryRowContext(ctx, m.dialect.tableExistsSQL()).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check schema_migrations: %w", err)
+	}
+	records := map[uint64]*Status{}
+	if exists == 0 {
+		return records, nil
+	}
+
+	rows, err := conn.QueryContext(ctx, "SELECT version, name, dirty, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		record := &Status{Applied: true}
+		if err := rows.Scan(&record.Version, &record.Name, &record.Dirty, &record.AppliedAt); err != nil {
+			return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+		}
+		records[record.Version] = record
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	return records, nil
+}
+
+// apply はマイグレーションを適用します。
+// MySQLのDDLは暗黙的にコミットされトランザクションで戻せないため（PostgreSQLでも同じ手順で記録します）、実行前にdirtyとして記録し、すべての文が成功した後に解除します。
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	m.logger.InfoContext(ctx, "Applying migration", slog.Uint64("version", migration.Version), slog.String("name", migration.Name))
+	if _, err := conn.ExecContext(ctx,
+		m.dialect.rebind("INSERT INTO schema_migrations (version, name, dirty) VALUES (?, ?, TRUE)"), migration.Version, migration.Name,
+	); err != nil {
+		return fmt.Errorf("failed to record migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	if err := execStatements(ctx, conn, m.dialect, migration.Up); err != nil {
+		return fmt.Errorf("failed to apply migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	if _, err := conn.ExecContext(ctx,
+		m.dialect.rebind("UPDATE schema_migrations SET dirty = FALSE, applied_at = CURRENT_TIMESTAMP WHERE version = ?"), migration.Version,
+	); err != nil {
+		return fmt.Errorf("failed to record migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+// revert はマイグレーションを取り消します。実行前にdirtyとして記録し、すべての文が成功した後に記録を削除します。
+func (m *Migrator) revert(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	if migration.Down == "" {
+		return fmt.Errorf("migration %d_%s has no down file", migration.Version, migration.Name)
+	}
+	m.logger.InfoContext(ctx, "Reverting migration", slog.Uint64("version", migration.Version), slog.String("name", migration.Name))
+	if _, err := conn.ExecContext(ctx, m.dialect.rebind("UPDATE schema_migrations SET dirty = TRUE WHERE version = ?"), migration.Version); err != nil {
+		return fmt.Errorf("failed to record migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	if err := execStatements(ctx, conn, m.dialect, migration.Down); err != nil {
+		return fmt.Errorf("failed to revert migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	if _, err := conn.ExecContext(ctx, m.dialect.rebind("DELETE FROM schema_migrations WHERE version = ?"), migration.Version); err != nil {
+		return fmt.Errorf("failed to record migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+// execStatements はSQLを方言に合わせて文ごとに分割して順に実行します。
+func execStatements(ctx context.Context, conn *sql.Conn, dialect Dialect, sql string) error {
+	for _, statement := range splitStatements(sql, dialect) {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildStatuses はマイグレーションと適用済みのバージョンから適用状況をバージョンの昇順に組み立てます。
+func buildStatuses(migrations []*Migration, records map[uint64]*Status) []*Status {
+	statuses := make([]*Status, 0, len(migrations))
+	known := map[uint64]bool{}
+	for _, migration := range migrations {
+		known[migration.Version] = true
+		status := &Status{Version: migration.Version, Name: migration.Name}
+		if record, ok := records[migration.Version]; ok {
+			status.Applied = true
+			status.Dirty = record.Dirty
+			status.AppliedAt = record.AppliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	for version, record := range records {
+		if known[version] {
+			continue
+		}
+		statuses = append(statuses, &Status{
+			Version: version, Name: record.Name, Applied: true, Dirty: record.Dirty, Unknown: true, AppliedAt: record.AppliedAt,
+		})
+	}
+	slices.SortFunc(statuses, func(a, b *Status) int { return cmp.Compare(a.Version, b.Version) })
+	return statuses
+}
+
+// CountPending は適用状況のうち未適用のマイグレーションの数を返します。
+//
+// Parameters:
+//   - statuses: 適用状況
+//
+// Returns:
+//   - int: 未適用のマイグレーションの数
+func CountPending(statuses []*Status) int {
+	pending := 0
+	for _, status := range statuses {
+		if !status.Applied {
+			pending++
+		}
+	}
+	return pending
+}
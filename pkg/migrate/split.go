@@ -0,0 +1,141 @@
+package migrate
+
+import (
+	"strings"
+	"unicode"
+)
+
+// splitStatements はSQLをセミコロンで区切って文ごとに分割します。
+// 文字列リテラル・識別子の引用符とコメントの中のセミコロンは区切りとして扱いません。
+// コメントと空白だけの文は除きます。
+//
+// 方言により次の構文の扱いが異なります。
+//   - MySQL: 「#」から行末までをコメント、「`」を識別子の引用符とし、文字列リテラル内のバックスラッシュをエスケープとして扱う
+//   - PostgreSQL: 「#」はビット演算子のため文の一部とし、関数やDOの本体の「$$」「$tag$」（ドル引用符）の中のセミコロンは区切りとして扱わない。
+//     バックスラッシュはE'...'の文字列リテラルでのみエスケープとして扱う
+//
+// Parameters:
+//   - sql: 複数の文を含むSQL
+//   - dialect: データベースの方言
+//
+// Returns:
+//   - []string: 末尾のセミコロンを除いた文
+func splitStatements(sql string, dialect Dialect) []string {
+	statements := []string{}
+	var current strings.Builder
+	hasCode := false // コメントと空白以外の文字を含むかどうか
+	flush := func() {
+		if hasCode {
+			statements = append(statements, strings.TrimSpace(current.String()))
+		}
+		current.Reset()
+		hasCode = false
+	}
+
+	runes := []rune(sql)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'' || r == '"' || (r == '`' && dialect == MySQL):
+			// 引用符の終わりまで読み進める（バックスラッシュによるエスケープと引用符の二重化に対応）
+			backslash := r != '`' && (dialect == MySQL || (r == '\'' && isEscapeStringPrefix(runes, i)))
+			end := i + 1
+			for end < len(runes) {
+				if runes[end] == '\\' && backslash {
+					end += 2
+					continue
+				}
+				if runes[end] == r {
+					if end+1 < len(runes) && runes[end+1] == r {
+						end += 2
+						continue
+					}
+					break
+				}
+				end++
+			}
+			end = min(end, len(runes)-1)
+			current.WriteString(string(runes[i : end+1]))
+			hasCode = true
+			i = end
+		case r == '$' && dialect == Postgres:
+			// ドル引用符の終わりの「$tag$」まで読み進める。「$1」などのパラメータはそのまま書き込む
+			tag, ok := dollarQuoteTag(runes, i)
+			if !ok {
+				current.WriteRune(r)
+				hasCode = true
+				continue
+			}
+			n := len([]rune(tag))
+			end := len(runes) - 1
+			for j := i + n; j+n <= len(runes); j++ {
+				if string(runes[j:j+n]) == tag {
+					end = j + n - 1
+					break
+				}
+			}
+			current.WriteString(string(runes[i : end+1]))
+			hasCode = true
+			i = end
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := i + 2
+			for end+1 < len(runes) && (runes[end] != '*' || runes[end+1] != '/') {
+				end++
+			}
+			end = min(end+1, len(runes)-1)
+			current.WriteString(string(runes[i : end+1]))
+			i = end
+		case (r == '#' && dialect == MySQL) || (r == '-' && i+2 < len(runes) && runes[i+1] == '-' && (runes[i+2] == ' ' || runes[i+2] == '\t')):
+			end := i
+			for end < len(runes) && runes[end] != '\n' {
+				end++
+			}
+			current.WriteString(string(runes[i:end]))
+			i = end - 1
+		case r == ';':
+			flush()
+		default:
+			current.WriteRune(r)
+			if !isSpace(r) {
+				hasCode = true
+			}
+		}
+	}
+	flush()
+	return statements
+}
+
+// isEscapeStringPrefix はi番目の引用符がPostgreSQLのエスケープ文字列（E'...'）の開始かどうかを返します。
+func isEscapeStringPrefix(runes []rune, i int) bool {
+	if i == 0 || (runes[i-1] != 'E' && runes[i-1] != 'e') {
+		return false
+	}
+	return i == 1 || !isIdentifierRune(runes[i-2])
+}
+
+// dollarQuoteTag はi番目の「$」から始まるドル引用符の開始タグ（「$$」または「$tag$」）を返します。
+// 識別子の途中の「$」やパラメータ（$1）の場合はfalseを返します。
+func dollarQuoteTag(runes []rune, i int) (string, bool) {
+	if i > 0 && isIdentifierRune(runes[i-1]) {
+		return "", false
+	}
+	for end := i + 1; end < len(runes); end++ {
+		switch r := runes[end]; {
+		case r == '$':
+			return string(runes[i : end+1]), true
+		case unicode.IsDigit(r) && end == i+1, !isIdentifierRune(r):
+			return "", false
+		}
+	}
+	return "", false
+}
+
+// isIdentifierRune は識別子に使用できる文字かどうかを返します。
+func isIdentifierRune(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// isSpace はSQLの空白文字かどうかを返します。
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
@@ -0,0 +1,92 @@
+// Package money は金額と消費税の計算を提供します。
+// 金額は通貨の最小単位（円、セントなど）の整数で保持し、浮動小数点による誤差を避けます。
+package money
+
+import (
+	"fmt"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// DefaultCurrency は通貨が指定されていない場合に使用する通貨です。
+const DefaultCurrency = "JPY"
+
+// minorUnits は対応している通貨ごとの小数点以下の桁数（ISO 4217）です。
+var minorUnits = map[string]int{
+	"JPY": 0,
+	"USD": 2,
+	"EUR": 2,
+}
+
+// Money は通貨と金額を表す値オブジェクトです。
+type Money struct {
+	amount   int64  // 金額（通貨の最小単位）
+	currency string // 通貨コード（ISO 4217）
+}
+
+// NewMoney はMoneyを生成します。
+//
+// Parameters:
+//   - amount: 金額（通貨の最小単位、0以上）
+//   - currency: 通貨コード（ISO 4217、JPY/USD/EUR）
+//
+// Returns:
+//   - *Money: Moneyポインタ
+//   - error: 金額が負の場合や未対応の通貨の場合はINVALID_ARGUMENTエラー
+func NewMoney(amount int64, currency string) (*Money, error) {
+	if amount < 0 {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "金額は0以上で入力してください")
+	}
+	if !IsSupportedCurrency(currency) {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("未対応の通貨です: %s", currency))
+	}
+	return &Money{amount: amount, currency: currency}, nil
+}
+
+// IsSupportedCurrency は通貨コードに対応しているかを返します。
+//
+// Parameters:
+//   - currency: 通貨コード
+//
+// Returns:
+//   - bool: 対応している場合true
+func IsSupportedCurrency(currency string) bool {
+	_, ok := minorUnits[currency]
+	return ok
+}
+
+// Amount は金額を通貨の最小単位で返します。
+func (m *Money) Amount() int64 {
+	return m.amount
+}
+
+// Currency は通貨コードを返します。
+func (m *Money) Currency() string {
+	return m.currency
+}
+
+// MinorUnit は通貨の小数点以下の桁数を返します。
+func (m *Money) MinorUnit() int {
+	return minorUnits[m.currency]
+}
+
+// Equals は通貨と金額が等しいかを返します。
+func (m *Money) Equals(other *Money) bool {
+	if other == nil {
+		return false
+	}
+	return m.amount == other.amount && m.currency == other.currency
+}
+
+// String は金額を通貨の表記で返します（例: "1100 JPY", "12.50 USD"）。
+func (m *Money) String() string {
+	digits := m.MinorUnit()
+	if digits == 0 {
+		return fmt.Sprintf("%d %s", m.amount, m.currency)
+	}
+	scale := int64(1)
+	for range digits {
+		scale *= 10
+	}
+	return fmt.Sprintf("%d.%0*d %s", m.amount/scale, digits, m.amount%scale, m.currency)
+}
@@ -0,0 +1,114 @@
+package money
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMoney(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Money Suite")
+}
+
+var _ = Describe("Money", func() {
+	Describe("NewMoney", func() {
+		It("対応している通貨で生成できる", func() {
+			m, err := NewMoney(1250, "USD")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.Amount()).To(Equal(int64(1250)))
+			Expect(m.Currency()).To(Equal("USD"))
+			Expect(m.String()).To(Equal("12.50 USD"))
+		})
+
+		It("円は小数点以下を持たない", func() {
+			m, err := NewMoney(1100, DefaultCurrency)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.MinorUnit()).To(Equal(0))
+			Expect(m.String()).To(Equal("1100 JPY"))
+		})
+
+		DescribeTable("不正な値はエラーになる",
+			func(amount int64, currency string) {
+				_, err := NewMoney(amount, currency)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("INVALID_ARGUMENT"))
+			},
+			Entry("負の金額", int64(-1), "JPY"),
+			Entry("未対応の通貨", int64(100), "GBP"),
+			Entry("小文字の通貨コード", int64(100), "jpy"),
+		)
+	})
+
+	Describe("Equals", func() {
+		It("通貨と金額が等しい場合true", func() {
+			a, _ := NewMoney(100, "JPY")
+			b, _ := NewMoney(100, "JPY")
+			c, _ := NewMoney(100, "USD")
+			Expect(a.Equals(b)).To(BeTrue())
+			Expect(a.Equals(c)).To(BeFalse())
+			Expect(a.Equals(nil)).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("消費税", func() {
+	DescribeTable("ParseTaxClass",
+		func(value string, want TaxClass, wantErr bool) {
+			class, err := ParseTaxClass(value)
+			if wantErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(class).To(Equal(want))
+		},
+		Entry("エンプティは標準税率", "", TaxClassStandard, false),
+		Entry("軽減税率", "REDUCED", TaxClassReduced, false),
+		Entry("小文字", "standard", TaxClassStandard, false),
+		Entry("未対応", "EXEMPT", TaxClass(""), true),
+	)
+
+	DescribeTable("ParseRounding",
+		func(value string, want Rounding, wantErr bool) {
+			rounding, err := ParseRounding(value)
+			if wantErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rounding).To(Equal(want))
+		},
+		Entry("エンプティは切り捨て", "", RoundingFloor, false),
+		Entry("四捨五入", "half_up", RoundingHalfUp, false),
+		Entry("未対応", "BANKERS", Rounding(""), true),
+	)
+
+	DescribeTable("IncludingTax",
+		func(amount int64, class TaxClass, rounding Rounding, want int64) {
+			m, err := NewMoney(amount, "JPY")
+			Expect(err).NotTo(HaveOccurred())
+			included := m.IncludingTax(class, rounding)
+			Expect(included.Amount()).To(Equal(want))
+			Expect(included.Currency()).To(Equal("JPY"))
+			Expect(m.Amount()).To(Equal(amount), "元の金額は変更しない")
+		},
+		Entry("標準税率", int64(1000), TaxClassStandard, RoundingFloor, int64(1100)),
+		Entry("軽減税率", int64(1000), TaxClassReduced, RoundingFloor, int64(1080)),
+		Entry("切り捨て", int64(198), TaxClassReduced, RoundingFloor, int64(213)), // 15.84円
+		Entry("四捨五入（切り上がる）", int64(198), TaxClassReduced, RoundingHalfUp, int64(214)),
+		Entry("四捨五入（0.5は切り上げ）", int64(105), TaxClassStandard, RoundingHalfUp, int64(116)),   // 10.5円
+		Entry("四捨五入（0.5未満は切り捨て）", int64(104), TaxClassStandard, RoundingHalfUp, int64(114)), // 10.4円
+		Entry("切り上げ", int64(101), TaxClassStandard, RoundingCeil, int64(112)),
+		Entry("割り切れる場合の切り上げ", int64(100), TaxClassStandard, RoundingCeil, int64(110)),
+		Entry("0円", int64(0), TaxClassStandard, RoundingCeil, int64(0)),
+	)
+
+	It("最小単位で端数処理する", func() {
+		m, err := NewMoney(999, "USD") // 9.99 USD
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.Tax(TaxClassStandard, RoundingHalfUp).Amount()).To(Equal(int64(100))) // 0.999 → 1.00
+		Expect(m.IncludingTax(TaxClassStandard, RoundingFloor).String()).To(Equal("10.98 USD"))
+	})
+})
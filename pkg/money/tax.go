@@ -0,0 +1,127 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// TaxClass は消費税の税率区分です。
+type TaxClass string
+
+const (
+	TaxClassStandard TaxClass = "STANDARD" // 標準税率（10%）
+	TaxClassReduced  TaxClass = "REDUCED"  // 軽減税率（8%）
+)
+
+// taxRates は税率区分ごとの税率（%）です。
+var taxRates = map[TaxClass]int64{
+	TaxClassStandard: 10,
+	TaxClassReduced:  8,
+}
+
+// ParseTaxClass は文字列から税率区分を生成します。
+// エンプティの場合は標準税率として扱います。
+//
+// Parameters:
+//   - value: 税率区分（STANDARD / REDUCED、大文字・小文字は区別しない）
+//
+// Returns:
+//   - TaxClass: 税率区分
+//   - error: 未対応の税率区分の場合はINVALID_ARGUMENTエラー
+func ParseTaxClass(value string) (TaxClass, error) {
+	if value == "" {
+		return TaxClassStandard, nil
+	}
+	class := TaxClass(strings.ToUpper(value))
+	if _, ok := taxRates[class]; !ok {
+		return "", errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("未対応の税率区分です: %s", value))
+	}
+	return class, nil
+}
+
+// Rate は税率（%）を返します。
+func (c TaxClass) Rate() int64 {
+	return taxRates[c]
+}
+
+// Rounding は消費税額の端数処理の方法です。
+type Rounding string
+
+const (
+	RoundingFloor  Rounding = "FLOOR"   // 切り捨て
+	RoundingHalfUp Rounding = "HALF_UP" // 四捨五入
+	RoundingCeil   Rounding = "CEIL"    // 切り上げ
+)
+
+// ParseRounding は文字列から端数処理の方法を生成します。
+// エンプティの場合は切り捨てとして扱います。
+//
+// Parameters:
+//   - value: 端数処理の方法（FLOOR / HALF_UP / CEIL、大文字・小文字は区別しない）
+//
+// Returns:
+//   - Rounding: 端数処理の方法
+//   - error: 未対応の方法の場合はINVALID_ARGUMENTエラー
+func ParseRounding(value string) (Rounding, error) {
+	if value == "" {
+		return RoundingFloor, nil
+	}
+	rounding := Rounding(strings.ToUpper(value))
+	switch rounding {
+	case RoundingFloor, RoundingHalfUp, RoundingCeil:
+		return rounding, nil
+	default:
+		return "", errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("未対応の端数処理です: %s", value))
+	}
+}
+
+// Divide は端数処理の方法に従って0以上の整数の割り算を行います。
+//
+// Parameters:
+//   - numerator: 割られる数（0以上）
+//   - denominator: 割る数（1以上）
+//
+// Returns:
+//   - int64: 端数処理した商
+func (r Rounding) Divide(numerator, denominator int64) int64 {
+	quotient, remainder := numerator/denominator, numerator%denominator
+	switch r {
+	case RoundingCeil:
+		if remainder > 0 {
+			quotient++
+		}
+	case RoundingHalfUp:
+		if remainder*2 >= denominator {
+			quotient++
+		}
+	}
+	return quotient
+}
+
+// Tax は税抜金額に対する消費税額を返します。
+// 端数は通貨の最小単位で処理します。
+//
+// Parameters:
+//   - class: 税率区分
+//   - rounding: 端数処理の方法
+//
+// Returns:
+//   - *Money: 消費税額
+func (m *Money) Tax(class TaxClass, rounding Rounding) *Money {
+	return &Money{amount: rounding.Divide(m.amount*class.Rate(), 100), currency: m.currency}
+}
+
+// IncludingTax は税抜金額から税込金額を計算します。
+// 税込金額は税抜金額と端数処理後の消費税額の合計です。
+//
+// Parameters:
+//   - class: 税率区分
+//   - rounding: 端数処理の方法
+//
+// Returns:
+//   - *Money: 税込金額
+func (m *Money) IncludingTax(class TaxClass, rounding Rounding) *Money {
+	return &Money{amount: m.amount + m.Tax(class, rounding).amount, currency: m.currency}
+}
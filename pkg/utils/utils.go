@@ -15,6 +15,7 @@ import (
 //   - int
 //   - bool
 //   - time.Duration
+//   - []string
 //
 // Parameters:
 //   - v: Viperインスタンス
@@ -41,6 +42,9 @@ func GetKey[T any](v *viper.Viper, key string, errs *[]error) T {
 		// v.GetDuration() を使うことで、"30m" や "1h" のような文字列を
 		// time.Duration型へ安全にパースする処理をViperに任せます。
 		return any(v.GetDuration(key)).(T)
+	case []string:
+		// 環境変数で指定した場合は空白区切りの文字列をスライスとして扱います。
+		return any(v.GetStringSlice(key)).(T)
 	default:
 		*errs = append(*errs, fmt.Errorf("unsupported type for key '%s'", key))
 		return zero
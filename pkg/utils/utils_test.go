@@ -117,6 +117,32 @@ var _ = Describe("GetKey", func() {
 		})
 	})
 
+	Describe("[]string型", func() {
+		Context("配列が設定されている場合", func() {
+			BeforeEach(func() {
+				v.Set("test.strings", []string{"a", "b"})
+			})
+
+			It("設定値を返す", func() {
+				result := GetKey[[]string](v, "test.strings", &errs)
+				Expect(result).To(Equal([]string{"a", "b"}))
+				Expect(errs).To(BeEmpty())
+			})
+		})
+
+		Context("空白区切りの文字列が設定されている場合", func() {
+			BeforeEach(func() {
+				v.Set("test.strings", "a b")
+			})
+
+			It("スライスとして返す", func() {
+				result := GetKey[[]string](v, "test.strings", &errs)
+				Expect(result).To(Equal([]string{"a", "b"}))
+				Expect(errs).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("サポートされていない型", func() {
 		Context("float64型を指定した場合", func() {
 			BeforeEach(func() {
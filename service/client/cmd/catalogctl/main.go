@@ -0,0 +1,24 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/catalogctl"
+)
+
+// catalogctl は商品とカテゴリを操作する運用者向けのCLIです。
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cmd := catalogctl.NewRootCommand(&catalogctl.Options{In: os.Stdin, Out: os.Stdout, Err: os.Stderr})
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		stop()
+		os.Exit(1)
+	}
+}
@@ -1,19 +1,39 @@
 package main
 
 import (
+	"fmt"
+	"os"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/httpserver"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/infrastructure/config"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/presentation"
 	"go.uber.org/fx"
 )
 
+// NOTE: バイナリを実行する位置からの相対パスで指定する
+const (
+	configPath = "./"
+	configName = "config"
+)
+
 // @title Client Service API
 // @version 1.0
 // @description CQRS Client Service API
 // @BasePath /
 func main() {
+	// 停止時のドレインとグレースフルシャットダウンがfxの既定の停止のタイムアウト（15秒）で打ち切られないよう、
+	// 停止のタイムアウトを[server]のdrain_periodとshutdown_timeoutから決める
+	serverCfg, err := httpserver.NewConfig(config.NewViper(configPath, configName))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
 	app := fx.New(
+		fx.StopTimeout(serverCfg.StopTimeout()),
 		fx.Supply(
-			fx.Annotate("./", fx.ResultTags(`name:"configPath"`)),
-			fx.Annotate("config", fx.ResultTags(`name:"configName"`)),
+			fx.Annotate(configPath, fx.ResultTags(`name:"configPath"`)),
+			fx.Annotate(configName, fx.ResultTags(`name:"configName"`)),
 		),
 		presentation.Module,
 	)
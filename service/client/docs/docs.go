@@ -26,13 +26,56 @@ const docTemplate = `{
                 ],
                 "summary": "カテゴリ一覧取得",
                 "operationId": "list-categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "前回取得時のETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（例: en, ja;q=0.8）",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（Accept-Languageより優先）",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "前回取得時のLast-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CategoryListResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "キャッシュ方針"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "レスポンスボディの強いETag"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "ゲートウェイが観測した最新の変更時刻"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -45,7 +88,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "カテゴリを登録します。",
+                "description": "カテゴリを登録します。slugを省略した場合はカテゴリ名から生成します。指定したslugが使用済みの場合は409を返します。",
                 "consumes": [
                     "application/json"
                 ],
@@ -84,6 +127,103 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/by-slug/{slug}": {
+            "get": {
+                "description": "スラッグでカテゴリを取得します。変更前のスラッグの場合はmovedをtrueにし、redirect_toに現在のスラッグのパスを返します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category"
+                ],
+                "summary": "スラッグによるカテゴリ取得",
+                "operationId": "get-category-by-slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "前回取得時のETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（例: en, ja;q=0.8）",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（Accept-Languageより優先）",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "スラッグ",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CategoryBySlugResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "キャッシュ方針"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "レスポンスボディの強いETag"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -108,6 +248,24 @@ const docTemplate = `{
                 "summary": "カテゴリ取得",
                 "operationId": "get-category-by-id",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "前回取得時のETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（例: en, ja;q=0.8）",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（Accept-Languageより優先）",
+                        "name": "locale",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "カテゴリID",
@@ -121,8 +279,21 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CategoryByIdResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "キャッシュ方針"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "レスポンスボディの強いETag"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -144,7 +315,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "カテゴリを更新します。",
+                "description": "カテゴリを更新します。slugを変更した場合、変更前のslugは引き続きスラッグによる取得で解決できます。",
                 "consumes": [
                     "application/json"
                 ],
@@ -190,6 +361,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -242,9 +422,87 @@ const docTemplate = `{
                 }
             }
         },
+        "/categories/{id}/price-adjustments": {
+            "post": {
+                "description": "カテゴリ（子孫カテゴリ・タグで絞り込み可）に属する商品の単価を割合または金額で一括変更します。すべての商品を1つのトランザクションで変更します。previewを指定した場合は変更せずに変更前後の単価を返します。変更後の単価が範囲外になる場合や、金額を含む変更で通貨の異なる商品が含まれる場合は409を返します。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PriceAdjustment"
+                ],
+                "summary": "カテゴリ単位の単価の一括変更",
+                "operationId": "adjust-prices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "カテゴリID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "単価の一括変更の条件と規則",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.AdjustPricesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.AdjustPricesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/products": {
             "get": {
-                "description": "商品一覧を取得します。keywordパラメータを指定すると検索を行います。",
+                "description": "商品一覧を取得します。keywordパラメータを指定すると検索を行います。\ntagsパラメータを指定すると、指定されたすべてのタグが付与された商品に絞り込みます（keywordとは併用できません）。",
                 "produces": [
                     "application/json"
                 ],
@@ -254,11 +512,45 @@ const docTemplate = `{
                 "summary": "商品一覧取得・検索",
                 "operationId": "list-products",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "前回取得時のETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（例: en, ja;q=0.8）",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（Accept-Languageより優先）",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "前回取得時のLast-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    },
                     {
                         "type": "string",
                         "description": "検索キーワード",
                         "name": "keyword",
                         "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "タグ名（複数指定時はAND条件）",
+                        "name": "tags",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -266,8 +558,25 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductListResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "キャッシュ方針"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "レスポンスボディの強いETag"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "ゲートウェイが観測した最新の変更時刻"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -289,7 +598,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "商品を登録します。",
+                "description": "商品を登録します。slugを省略した場合は商品名から生成します。\n商品名、バーコードまたは指定したslugが他の商品と重複する場合は409を返します。",
                 "consumes": [
                     "application/json"
                 ],
@@ -328,6 +637,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -340,22 +658,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{id}": {
+        "/products/by-barcode/{code}": {
             "get": {
-                "description": "IDで商品を取得します。",
+                "description": "JAN/EAN/UPCバーコード（8桁、12桁または13桁）で公開中の商品を取得します。UPC-Aは先頭に0を付けたEAN-13として検索します。",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Product"
                 ],
-                "summary": "商品取得",
-                "operationId": "get-product-by-id",
+                "summary": "バーコードによる商品取得",
+                "operationId": "get-product-by-barcode",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "商品ID",
-                        "name": "id",
+                        "description": "前回取得時のETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（例: en, ja;q=0.8）",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（Accept-Languageより優先）",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "バーコード",
+                        "name": "code",
                         "in": "path",
                         "required": true
                     }
@@ -364,9 +700,22 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductByIdResponse"
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductByBarcodeResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "キャッシュ方針"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "レスポンスボディの強いETag"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -376,6 +725,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -386,45 +744,66 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "商品を更新します。",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/products/by-slug/{slug}": {
+            "get": {
+                "description": "スラッグで公開中の商品を取得します。変更前のスラッグの場合はmovedをtrueにし、redirect_toに現在のスラッグのパスを返します。",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Product"
                 ],
-                "summary": "商品更新",
-                "operationId": "update-product",
+                "summary": "スラッグによる商品取得",
+                "operationId": "get-product-by-slug",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "商品ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "前回取得時のETag",
+                        "name": "If-None-Match",
+                        "in": "header"
                     },
                     {
-                        "description": "商品情報",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.UpdateProductRequest"
-                        }
+                        "type": "string",
+                        "description": "名前のロケール（例: en, ja;q=0.8）",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（Accept-Languageより優先）",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "スラッグ",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.UpdateProductResponse"
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductBySlugResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "キャッシュ方針"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "レスポンスボディの強いETag"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -434,15 +813,188 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
-                    }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}": {
+            "get": {
+                "description": "IDで公開中の商品を取得します。公開中以外の商品は404を返します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "商品取得",
+                "operationId": "get-product-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "前回取得時のETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（例: en, ja;q=0.8）",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名前のロケール（Accept-Languageより優先）",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "商品ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductByIdResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "キャッシュ方針"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "レスポンスボディの強いETag"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "商品を更新します。barcodeを省略した場合は現在のバーコードを維持し、空文字列の場合は削除します。\nslugを省略した場合は現在のスラッグを維持します。変更前のslugは引き続きスラッグによる取得で解決できます。\n商品名、バーコードまたはslugが他の商品と重複する場合は409を返します。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "商品更新",
+                "operationId": "update-product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "商品ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "商品情報",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.UpdateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.UpdateProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
                 }
             },
             "delete": {
@@ -486,22 +1038,136 @@ const docTemplate = `{
                 }
             }
         },
-        "/stream/products": {
-            "get": {
-                "description": "Queryサービスからのストリーミング結果をまとめて返します。",
+        "/products/{id}/discontinue": {
+            "post": {
+                "description": "商品を販売終了にします。販売終了した商品は他の状態に遷移できません。既に販売終了している場合は409を返します。",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Product"
                 ],
-                "summary": "商品ストリーム取得",
-                "operationId": "stream-products",
+                "summary": "商品販売終了",
+                "operationId": "discontinue-product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "商品ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductStreamResponse"
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ChangeProductStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/price-schedules": {
+            "post": {
+                "description": "期間を指定して商品の単価を変更します（例: 金曜0時から日曜23時59分まで980円）。開始時刻に単価を変更し、終了時刻に元の単価へ戻します。期間が他の価格スケジュールと重なる場合は409を返します。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PriceSchedule"
+                ],
+                "summary": "価格スケジュール登録",
+                "operationId": "schedule-price",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "商品ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "価格スケジュール情報",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.SchedulePriceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.SchedulePriceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
@@ -515,181 +1181,1526 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category": {
+        },
+        "/products/{id}/price-schedules/{scheduleId}": {
+            "delete": {
+                "description": "商品の価格スケジュールを取り消します。適用中の場合は元の単価に戻します。既に終了または取消済みの場合は409を返します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PriceSchedule"
+                ],
+                "summary": "価格スケジュール取消",
+                "operationId": "cancel-price-schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "商品ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "価格スケジュールID",
+                        "name": "scheduleId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CancelPriceScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/publish": {
+            "post": {
+                "description": "下書きまたは一時停止中の商品を公開します。公開中の商品のみ一覧・検索に表示されます。遷移できない状態の場合は409を返します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "商品公開",
+                "operationId": "publish-product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "商品ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ChangeProductStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/suspend": {
+            "post": {
+                "description": "公開中の商品を一時停止し、一覧・検索に表示されないようにします。遷移できない状態の場合は409を返します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "商品一時停止",
+                "operationId": "suspend-product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "商品ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ChangeProductStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/variants": {
+            "get": {
+                "description": "商品のバリエーション一覧を取得します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Variant"
+                ],
+                "summary": "バリエーション一覧取得",
+                "operationId": "list-variants",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "商品ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.VariantListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "商品にバリエーションを追加します。SKUまたは選択肢の組み合わせが重複する場合は409を返します。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Variant"
+                ],
+                "summary": "バリエーション追加",
+                "operationId": "create-variant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "商品ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "バリエーション情報",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateVariantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateVariantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/variants/{variantId}": {
+            "put": {
+                "description": "商品のバリエーションを更新します。SKUまたは選択肢の組み合わせが重複する場合は409を返します。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Variant"
+                ],
+                "summary": "バリエーション更新",
+                "operationId": "update-variant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "商品ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "バリエーションID",
+                        "name": "variantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "バリエーション情報",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.UpdateVariantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.UpdateVariantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "商品のバリエーションを削除します。",
+                "tags": [
+                    "Variant"
+                ],
+                "summary": "バリエーション削除",
+                "operationId": "delete-variant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "商品ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "バリエーションID",
+                        "name": "variantId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/stream/products": {
+            "get": {
+                "description": "Queryサービスからのストリーミング結果をまとめて返します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "商品ストリーム取得",
+                "operationId": "stream-products",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductStreamResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "タグ一覧を付与された商品数の多い順に取得します。商品に付与されていないタグも含みます。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "タグ一覧取得",
+                "operationId": "list-tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "前回取得時のETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "前回取得時のLast-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.TagListResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "キャッシュ方針"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "レスポンスボディの強いETag"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "ゲートウェイが観測した最新の変更時刻"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/attach": {
+            "post": {
+                "description": "複数の商品に複数のタグを一括で付与します。存在しないタグは作成され、既に付与済みの組み合わせは無視されます。\n存在しない商品が含まれる場合は404を返し、いずれの商品にも付与しません。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "タグ一括付与",
+                "operationId": "attach-tags",
+                "parameters": [
+                    {
+                        "description": "対象の商品IDとタグ名",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ChangeTagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.AttachTagsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/detach": {
+            "post": {
+                "description": "複数の商品から複数のタグを一括で解除します。存在しないタグや付与されていない組み合わせは無視されます。\n存在しない商品が含まれる場合は404を返し、いずれの商品からも解除しません。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "タグ一括解除",
+                "operationId": "detach-tags",
+                "parameters": [
+                    {
+                        "description": "対象の商品IDとタグ名",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ChangeTagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.DetachTagsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/ws/products/suggest": {
+            "get": {
+                "description": "WebSocketに接続し、dto.SuggestProductsRequestのJSONを送信するたびにdto.SuggestProductsResponseのJSONを受信します。\n不正な問合せにはerrorを含むメッセージを返し、接続は維持されます。",
+                "tags": [
+                    "Product"
+                ],
+                "summary": "商品サジェスト（WebSocket）",
+                "operationId": "suggest-products",
+                "parameters": [
+                    {
+                        "description": "WebSocketで送信するメッセージ",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.SuggestProductsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "WebSocketで受信するメッセージ",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.SuggestProductsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.AdjustPricesRequest": {
+            "type": "object",
+            "required": [
+                "adjustment"
+            ],
+            "properties": {
+                "adjustment": {
+                    "description": "変更の規則",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.PriceAdjustment"
+                        }
+                    ]
+                },
+                "include_descendants": {
+                    "description": "子孫カテゴリの商品を含めるかどうか",
+                    "type": "boolean"
+                },
+                "preview": {
+                    "description": "trueの場合は単価を変更せずに変更前後の単価のみを返す",
+                    "type": "boolean"
+                },
+                "tags": {
+                    "description": "指定したすべてのタグが付与された商品に絞り込む",
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.AdjustPricesResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "description": "商品ごとの変更前後の単価（商品ID順）",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.PriceChange"
+                    }
+                },
+                "preview": {
+                    "description": "プレビューの場合はtrue（単価は変更していない）",
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.AttachTagsResponse": {
+            "type": "object",
+            "properties": {
+                "attached_count": {
+                    "description": "新たに付与された商品とタグの組み合わせ数",
+                    "type": "integer"
+                },
+                "tags": {
+                    "description": "付与したタグ",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Tag"
+                    }
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CancelPriceScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "price_schedule": {
+                    "description": "取り消された価格スケジュール",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.PriceSchedule"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "id": {
+                    "description": "カテゴリID",
+                    "type": "string"
+                },
+                "locale": {
+                    "description": "カテゴリ名のロケール（問合せ結果のみ設定）",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "カテゴリ名（問合せ結果は要求したロケールの名前）",
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1
+                },
+                "slug": {
+                    "description": "URLに使用するスラッグ（結果のみ設定）",
+                    "type": "string"
+                },
+                "translations": {
+                    "description": "ロケールごとの翻訳名（問合せ結果のみ設定）",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CategoryByIdResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "カテゴリ情報",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CategoryBySlugResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "カテゴリ情報",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category"
+                        }
+                    ]
+                },
+                "moved": {
+                    "description": "変更前のスラッグで取得した場合true",
+                    "type": "boolean"
+                },
+                "redirect_to": {
+                    "description": "変更前のスラッグで取得した場合の現在のスラッグのパス",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CategoryListResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "description": "カテゴリ一覧",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category"
+                    }
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ChangeProductStatusResponse": {
+            "type": "object",
+            "properties": {
+                "product": {
+                    "description": "販売状態を変更した商品情報",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ChangeTagsRequest": {
+            "type": "object",
+            "required": [
+                "product_ids",
+                "tag_names"
+            ],
+            "properties": {
+                "product_ids": {
+                    "description": "対象の商品ID（1-100件）",
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tag_names": {
+                    "description": "タグ名（1-20件）",
+                    "type": "array",
+                    "maxItems": 20,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "description": "カテゴリ名",
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1
+                },
+                "slug": {
+                    "description": "URLに使用するスラッグ（未設定の場合はカテゴリ名から生成）",
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "作成されたカテゴリ情報",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateProductRequest": {
+            "type": "object",
+            "required": [
+                "category",
+                "name",
+                "price",
+                "translations"
+            ],
+            "properties": {
+                "barcode": {
+                    "description": "JAN/EAN/UPCバーコード（8桁、12桁または13桁）",
+                    "type": "string"
+                },
+                "category": {
+                    "description": "カテゴリ情報",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category"
+                        }
+                    ]
+                },
+                "currency": {
+                    "description": "通貨コード（未設定の場合はJPY）",
+                    "type": "string",
+                    "enum": [
+                        "JPY",
+                        "USD",
+                        "EUR"
+                    ]
+                },
+                "name": {
+                    "description": "商品名",
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "price": {
+                    "description": "税抜の価格（通貨の最小単位）",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "slug": {
+                    "description": "URLに使用するスラッグ（未設定の場合は商品名から生成）",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "tax_class": {
+                    "description": "税率区分（未設定の場合は標準税率）",
+                    "type": "string",
+                    "enum": [
+                        "STANDARD",
+                        "REDUCED"
+                    ]
+                },
+                "translations": {
+                    "description": "ロケールごとの翻訳名（既定のロケールはname）",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateProductResponse": {
+            "type": "object",
+            "properties": {
+                "product": {
+                    "description": "作成された商品情報",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateVariantRequest": {
+            "type": "object",
+            "required": [
+                "options",
+                "sku"
+            ],
+            "properties": {
+                "options": {
+                    "description": "選択肢の組み合わせ（1-3軸）",
+                    "type": "array",
+                    "maxItems": 3,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.VariantOption"
+                    }
+                },
+                "price_override": {
+                    "description": "価格の上書き（未設定の場合は商品の単価）",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "sku": {
+                    "description": "SKUコード（英数字とハイフン）",
+                    "type": "string",
+                    "maxLength": 64,
+                    "minLength": 1
+                },
+                "status": {
+                    "description": "販売状態（未設定の場合はACTIVE）",
+                    "type": "string",
+                    "enum": [
+                        "ACTIVE",
+                        "INACTIVE"
+                    ]
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateVariantResponse": {
+            "type": "object",
+            "properties": {
+                "variant": {
+                    "description": "追加されたバリエーション",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Variant"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.DetachTagsResponse": {
+            "type": "object",
+            "properties": {
+                "detached_count": {
+                    "description": "解除された商品とタグの組み合わせ数",
+                    "type": "integer"
+                },
+                "tags": {
+                    "description": "解除したタグ（存在しないタグは含まない）",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Tag"
+                    }
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Money": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "通貨の最小単位での金額（例: JPYは円、USDはセント）",
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "通貨コード（ISO 4217）",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.PriceAdjustment": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "max_price": {
+                    "description": "変更後の単価の上限（未設定の場合は上限なし）",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "min_price": {
+                    "description": "変更後の単価の下限（未設定の場合は下限なし）",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "rounding": {
+                    "description": "端数処理の方法（未設定の場合はFLOOR）",
+                    "type": "string",
+                    "enum": [
+                        "FLOOR",
+                        "HALF_UP",
+                        "CEIL"
+                    ]
+                },
+                "rounding_unit": {
+                    "description": "端数処理の単位（例: 10の場合は10円単位、未設定の場合は1）",
+                    "type": "integer",
+                    "maximum": 10000
+                },
+                "type": {
+                    "description": "変更の方法（PERCENT: 割合、FIXED: 金額）",
+                    "type": "string",
+                    "enum": [
+                        "PERCENT",
+                        "FIXED"
+                    ]
+                },
+                "value": {
+                    "description": "変更量（PERCENTの場合は-99〜1000%、FIXEDの場合は-1000000〜1000000）",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.PriceChange": {
+            "type": "object",
+            "properties": {
+                "after_price": {
+                    "description": "変更後の税抜の単価",
+                    "type": "integer"
+                },
+                "before_price": {
+                    "description": "変更前の税抜の単価",
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "通貨コード",
+                    "type": "string"
+                },
+                "product_id": {
+                    "description": "商品ID",
+                    "type": "string"
+                },
+                "product_name": {
+                    "description": "商品名",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.PriceSchedule": {
+            "type": "object",
+            "properties": {
+                "ends_at": {
+                    "description": "終了時刻",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "価格スケジュールID",
+                    "type": "string"
+                },
+                "price": {
+                    "description": "期間中の税抜の価格",
+                    "type": "integer"
+                },
+                "product_id": {
+                    "description": "商品ID",
+                    "type": "string"
+                },
+                "regular_price": {
+                    "description": "期間開始前の税抜の価格（適用中または適用後のみ設定）",
+                    "type": "integer"
+                },
+                "starts_at": {
+                    "description": "開始時刻",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "状態（SCHEDULED / ACTIVE / COMPLETED / CANCELLED）",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product": {
+            "type": "object",
+            "properties": {
+                "barcode": {
+                    "description": "JAN/EANバーコード（UPC-Aは先頭に0を付けた13桁）",
+                    "type": "string"
+                },
+                "category": {
+                    "description": "カテゴリ情報",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "商品ID",
+                    "type": "string"
+                },
+                "locale": {
+                    "description": "商品名のロケール（問合せ結果のみ設定）",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "商品名（要求したロケールの名前）",
+                    "type": "string"
+                },
+                "price": {
+                    "description": "税抜の価格（通貨の最小単位）",
+                    "type": "integer"
+                },
+                "price_excluding_tax": {
+                    "description": "税抜価格",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Money"
+                        }
+                    ]
+                },
+                "price_including_tax": {
+                    "description": "税込価格（問合せ結果のみ設定）",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Money"
+                        }
+                    ]
+                },
+                "price_schedules": {
+                    "description": "適用中・適用予定の価格スケジュール（商品の個別取得時のみ設定）",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.PriceSchedule"
+                    }
+                },
+                "slug": {
+                    "description": "URLに使用するスラッグ",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "販売状態（DRAFT / PUBLISHED / SUSPENDED / DISCONTINUED）",
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "タグ",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Tag"
+                    }
+                },
+                "tax_class": {
+                    "description": "税率区分（STANDARD / REDUCED）",
+                    "type": "string"
+                },
+                "translations": {
+                    "description": "ロケールごとの翻訳名",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "variants": {
+                    "description": "バリエーション（商品の個別取得時のみ設定）",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Variant"
+                    }
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductByBarcodeResponse": {
             "type": "object",
-            "required": [
-                "id",
-                "name"
-            ],
             "properties": {
-                "id": {
-                    "description": "カテゴリID",
-                    "type": "string"
-                },
-                "name": {
-                    "description": "カテゴリ名",
-                    "type": "string",
-                    "maxLength": 20,
-                    "minLength": 1
+                "product": {
+                    "description": "商品情報",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product"
+                        }
+                    ]
                 }
             }
         },
-        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CategoryByIdResponse": {
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductByIdResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "description": "カテゴリ情報",
+                "product": {
+                    "description": "商品情報",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category"
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product"
                         }
                     ]
                 }
             }
         },
-        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CategoryListResponse": {
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductBySlugResponse": {
             "type": "object",
             "properties": {
-                "categories": {
-                    "description": "カテゴリ一覧",
+                "moved": {
+                    "description": "変更前のスラッグで取得した場合true",
+                    "type": "boolean"
+                },
+                "product": {
+                    "description": "商品情報",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product"
+                        }
+                    ]
+                },
+                "redirect_to": {
+                    "description": "変更前のスラッグで取得した場合の現在のスラッグのパス",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductListResponse": {
+            "type": "object",
+            "properties": {
+                "products": {
+                    "description": "商品一覧",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category"
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product"
                     }
                 }
             }
         },
-        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateCategoryRequest": {
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductStreamResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "name": {
-                    "description": "カテゴリ名",
-                    "type": "string",
-                    "maxLength": 20,
-                    "minLength": 1
+                "products": {
+                    "description": "商品一覧",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product"
+                    }
                 }
             }
         },
-        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateCategoryResponse": {
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductSuggestion": {
             "type": "object",
             "properties": {
                 "category": {
-                    "description": "作成されたカテゴリ情報",
+                    "description": "カテゴリ情報",
                     "allOf": [
                         {
                             "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category"
                         }
                     ]
+                },
+                "highlight": {
+                    "description": "一致箇所を\u003cmark\u003eで強調した商品名（HTMLエスケープ済み）",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "商品名",
+                    "type": "string"
+                },
+                "product_id": {
+                    "description": "商品ID",
+                    "type": "string"
+                },
+                "score": {
+                    "description": "関連度スコア",
+                    "type": "number"
                 }
             }
         },
-        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateProductRequest": {
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.SchedulePriceRequest": {
             "type": "object",
             "required": [
-                "category",
-                "name",
-                "price"
+                "ends_at",
+                "price",
+                "starts_at"
             ],
             "properties": {
-                "category": {
-                    "description": "カテゴリ情報",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category"
-                        }
-                    ]
-                },
-                "name": {
-                    "description": "商品名",
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 1
+                "ends_at": {
+                    "description": "終了時刻（RFC3339、開始時刻より後）",
+                    "type": "string"
                 },
                 "price": {
-                    "description": "価格",
+                    "description": "期間中の税抜の価格（通貨の最小単位）",
                     "type": "integer",
                     "minimum": 1
+                },
+                "starts_at": {
+                    "description": "開始時刻（RFC3339）",
+                    "type": "string"
                 }
             }
         },
-        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.CreateProductResponse": {
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.SchedulePriceResponse": {
             "type": "object",
             "properties": {
-                "product": {
-                    "description": "作成された商品情報",
+                "price_schedule": {
+                    "description": "登録された価格スケジュール",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product"
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.PriceSchedule"
                         }
                     ]
                 }
             }
         },
-        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product": {
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.SuggestProductsRequest": {
             "type": "object",
             "properties": {
-                "category": {
-                    "description": "カテゴリ情報",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Category"
-                        }
-                    ]
+                "limit": {
+                    "description": "最大件数（0の場合はデフォルト値）",
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 0
                 },
-                "id": {
-                    "description": "商品ID",
+                "prefix": {
+                    "description": "入力中の検索語",
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.SuggestProductsResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "問合せが不正な場合のエラーメッセージ",
                     "type": "string"
                 },
-                "name": {
-                    "description": "商品名",
+                "prefix": {
+                    "description": "対応する検索語",
                     "type": "string"
                 },
-                "price": {
-                    "description": "価格",
-                    "type": "integer"
+                "suggestions": {
+                    "description": "関連度順のサジェスト",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductSuggestion"
+                    }
                 }
             }
         },
-        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductByIdResponse": {
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Tag": {
             "type": "object",
             "properties": {
-                "product": {
-                    "description": "商品情報",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product"
-                        }
-                    ]
+                "id": {
+                    "description": "タグID",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "タグ名",
+                    "type": "string"
                 }
             }
         },
-        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductListResponse": {
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.TagListResponse": {
             "type": "object",
             "properties": {
-                "products": {
-                    "description": "商品一覧",
+                "tags": {
+                    "description": "付与された商品数の多い順のタグ一覧",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product"
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.TagUsage"
                     }
                 }
             }
         },
-        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.ProductStreamResponse": {
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.TagUsage": {
             "type": "object",
             "properties": {
-                "products": {
-                    "description": "商品一覧",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Product"
-                    }
+                "product_count": {
+                    "description": "タグが付与された商品数",
+                    "type": "integer"
+                },
+                "tag": {
+                    "description": "タグ情報",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Tag"
+                        }
+                    ]
                 }
             }
         },
@@ -700,10 +2711,22 @@ const docTemplate = `{
             ],
             "properties": {
                 "name": {
-                    "description": "カテゴリ名",
+                    "description": "カテゴリ名（既定のロケール）",
                     "type": "string",
                     "maxLength": 20,
                     "minLength": 1
+                },
+                "slug": {
+                    "description": "URLに使用するスラッグ（未設定の場合は現在のスラッグを維持）",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "translations": {
+                    "description": "変更するロケールごとの翻訳名（エンプティの名前はその翻訳を削除）",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -728,6 +2751,10 @@ const docTemplate = `{
                 "price"
             ],
             "properties": {
+                "barcode": {
+                    "description": "JAN/EAN/UPCバーコード（未設定の場合は現在のバーコードを維持、エンプティの場合は削除）",
+                    "type": "string"
+                },
                 "category": {
                     "description": "カテゴリ情報",
                     "allOf": [
@@ -736,6 +2763,15 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "currency": {
+                    "description": "通貨コード（未設定の場合は現在の通貨を維持）",
+                    "type": "string",
+                    "enum": [
+                        "JPY",
+                        "USD",
+                        "EUR"
+                    ]
+                },
                 "name": {
                     "description": "商品名",
                     "type": "string",
@@ -743,9 +2779,29 @@ const docTemplate = `{
                     "minLength": 1
                 },
                 "price": {
-                    "description": "価格",
+                    "description": "税抜の価格（通貨の最小単位）",
                     "type": "integer",
                     "minimum": 1
+                },
+                "slug": {
+                    "description": "URLに使用するスラッグ（未設定の場合は現在のスラッグを維持）",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "tax_class": {
+                    "description": "税率区分（未設定の場合は現在の税率区分を維持）",
+                    "type": "string",
+                    "enum": [
+                        "STANDARD",
+                        "REDUCED"
+                    ]
+                },
+                "translations": {
+                    "description": "変更するロケールごとの翻訳名（エンプティの名前はその翻訳を削除）",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -761,6 +2817,121 @@ const docTemplate = `{
                     ]
                 }
             }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.UpdateVariantRequest": {
+            "type": "object",
+            "required": [
+                "options",
+                "sku"
+            ],
+            "properties": {
+                "options": {
+                    "description": "選択肢の組み合わせ（1-3軸）",
+                    "type": "array",
+                    "maxItems": 3,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.VariantOption"
+                    }
+                },
+                "price_override": {
+                    "description": "価格の上書き（未設定の場合は商品の単価）",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "sku": {
+                    "description": "SKUコード（英数字とハイフン）",
+                    "type": "string",
+                    "maxLength": 64,
+                    "minLength": 1
+                },
+                "status": {
+                    "description": "販売状態（未設定の場合はACTIVE）",
+                    "type": "string",
+                    "enum": [
+                        "ACTIVE",
+                        "INACTIVE"
+                    ]
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.UpdateVariantResponse": {
+            "type": "object",
+            "properties": {
+                "variant": {
+                    "description": "更新されたバリエーション",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Variant"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Variant": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "バリエーションID",
+                    "type": "string"
+                },
+                "options": {
+                    "description": "選択肢の組み合わせ",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.VariantOption"
+                    }
+                },
+                "price": {
+                    "description": "販売価格",
+                    "type": "integer"
+                },
+                "price_override": {
+                    "description": "価格の上書き",
+                    "type": "integer"
+                },
+                "sku": {
+                    "description": "SKUコード",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "販売状態（ACTIVE / INACTIVE）",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.VariantListResponse": {
+            "type": "object",
+            "properties": {
+                "variants": {
+                    "description": "バリエーション一覧",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.Variant"
+                    }
+                }
+            }
+        },
+        "github_com_haru-256_practical-go-grpc-micro-service_service_client_internal_presentation_dto.VariantOption": {
+            "type": "object",
+            "required": [
+                "axis",
+                "value"
+            ],
+            "properties": {
+                "axis": {
+                    "description": "選択肢の軸（例: サイズ）",
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 1
+                },
+                "value": {
+                    "description": "選択肢の値（例: M）",
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1
+                }
+            }
         }
     }
 }`
@@ -0,0 +1,163 @@
+package catalogctl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+// bulkFlags は一括操作のフラグです。
+type bulkFlags struct {
+	filename        string // 項目の配列を記述したYAMLまたはJSONファイル（-の場合は標準入力）
+	continueOnError bool   // 失敗した項目があっても残りの項目を処理する
+}
+
+// register は一括操作のフラグを登録します。
+func (f *bulkFlags) register(fs *pflag.FlagSet) {
+	fs.StringVarP(&f.filename, "filename", "f", "", "YAML or JSON file with a list of items to process (- for stdin)")
+	fs.BoolVar(&f.continueOnError, "continue-on-error", false, "keep processing the remaining items when an item fails")
+}
+
+// readItems はファイルから項目の配列を読み込みます。
+// YAMLはJSONの上位互換のため、どちらの形式もYAMLとして解析します。未知のフィールドはエラーにします。
+//
+// Parameters:
+//   - filename: ファイルのパス（-の場合は標準入力）
+//   - in: 標準入力
+//
+// Returns:
+//   - []T: 項目
+//   - error: 読み込みまたは解析のエラー
+func readItems[T any](filename string, in io.Reader) ([]T, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if filename == "-" {
+		data, err = io.ReadAll(in)
+	} else {
+		data, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", filename, err)
+	}
+	var items []T
+	if err := yaml.UnmarshalStrict(data, &items); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no items in %s", filename)
+	}
+	return items, nil
+}
+
+// collectInputs は--filenameが指定されている場合はファイルから、それ以外はフラグから処理する項目を集めます。
+// ファイルとフラグの値の混在は、どちらを優先するか曖昧になるためエラーにします。
+//
+// Parameters:
+//   - cmd: 実行中のコマンド
+//   - bulk: 一括操作のフラグ
+//   - fieldFlags: 項目の値を指定するフラグ名
+//   - fromFlags: フラグから1項目を生成する関数
+//
+// Returns:
+//   - []T: 項目
+//   - error: ファイルの読み込みエラー、またはファイルとフラグを併用した場合のエラー
+func collectInputs[T any](cmd *cobra.Command, bulk *bulkFlags, fieldFlags []string, fromFlags func() (T, error)) ([]T, error) {
+	if bulk.filename == "" {
+		item, err := fromFlags()
+		if err != nil {
+			return nil, err
+		}
+		return []T{item}, nil
+	}
+	if changed := changedFlags(cmd.Flags(), fieldFlags...); len(changed) > 0 {
+		return nil, fmt.Errorf("%s cannot be combined with --filename", strings.Join(changed, ", "))
+	}
+	return readItems[T](bulk.filename, cmd.InOrStdin())
+}
+
+// runBulk は項目を先頭から順に処理します。
+// 失敗した項目は標準エラー出力に報告し、continueOnErrorでなければその時点で中断します。
+// 成功した項目の結果は中断した場合も返すため、呼び出し元で出力できます。
+//
+// Parameters:
+//   - cmd: 実行中のコマンド
+//   - items: 項目
+//   - continueOnError: 失敗した項目があっても残りの項目を処理する場合true
+//   - label: 報告に使用する項目の識別子
+//   - do: 1項目の処理
+//
+// Returns:
+//   - []R: 成功した項目の結果
+//   - error: 失敗した項目がある場合のエラー
+func runBulk[T, R any](
+	cmd *cobra.Command,
+	items []T,
+	continueOnError bool,
+	label func(T) string,
+	do func(context.Context, T) (R, error),
+) ([]R, error) {
+	results := make([]R, 0, len(items))
+	failed := 0
+	for i, item := range items {
+		result, err := do(cmd.Context(), item)
+		if err != nil {
+			failed++
+			fmt.Fprintf(cmd.ErrOrStderr(), "item %d (%s): %v\n", i+1, label(item), err)
+			if !continueOnError {
+				return results, fmt.Errorf("aborted at item %d of %d: %w", i+1, len(items), err)
+			}
+			continue
+		}
+		results = append(results, result)
+	}
+	if failed > 0 {
+		return results, fmt.Errorf("%d of %d items failed", failed, len(items))
+	}
+	return results, nil
+}
+
+// changedFlags は指定されたフラグのうち、コマンドラインで指定されたものを返します。
+func changedFlags(fs *pflag.FlagSet, names ...string) []string {
+	var changed []string
+	for _, name := range names {
+		if fs.Changed(name) {
+			changed = append(changed, "--"+name)
+		}
+	}
+	return changed
+}
+
+// printResults は操作結果を出力します。
+// ファイルによる一括操作では件数にかかわらず配列として、それ以外は1件のオブジェクトとして出力します。
+//
+// Parameters:
+//   - p: printer
+//   - results: 成功した項目の結果
+//   - bulk: ファイルによる一括操作の場合true
+//   - t: 表形式の列
+//   - err: 操作のエラー（結果を出力した後に返す）
+//
+// Returns:
+//   - error: 操作のエラーと出力エラー
+func printResults[T proto.Message](p *printer, results []T, bulk bool, t tableFormat[T], err error) error {
+	if len(results) == 0 && (err != nil || !bulk) {
+		return err
+	}
+	var perr error
+	if bulk {
+		perr = printList(p, results, t)
+	} else {
+		perr = printOne(p, results[0], t)
+	}
+	return errors.Join(err, perr)
+}
@@ -0,0 +1,460 @@
+package catalogctl_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	command "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
+	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
+	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/catalogctl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"sigs.k8s.io/yaml"
+)
+
+// fakeCatalog はCommand Service・Query Serviceの両方を模擬するインメモリのカタログです。
+type fakeCatalog struct {
+	mu         sync.Mutex
+	products   map[string]*common.Product
+	categories map[string]*common.Category
+	created    []*command.CreateProductRequest
+	updated    []*command.UpdateProductRequest
+	seq        int
+}
+
+func newFakeCatalog() *fakeCatalog {
+	f := &fakeCatalog{products: map[string]*common.Product{}, categories: map[string]*common.Category{}}
+	f.putCategory("c-001", "Drinks", "drinks")
+	f.putProduct("p-001", "Green Tea", 300, "c-001")
+	f.putProduct("p-002", "Coffee", 450, "c-001")
+	return f
+}
+
+func (f *fakeCatalog) putCategory(id, name, slug string) {
+	c := &common.Category{}
+	c.SetId(id)
+	c.SetName(name)
+	c.SetSlug(slug)
+	f.categories[id] = c
+}
+
+func (f *fakeCatalog) putProduct(id, name string, price int32, categoryID string) *common.Product {
+	p := &common.Product{}
+	p.SetId(id)
+	p.SetName(name)
+	p.SetPrice(price)
+	p.SetCategory(f.categories[categoryID])
+	p.SetStatus(common.ProductStatus_PRODUCT_STATUS_PUBLISHED)
+	f.products[id] = p
+	return p
+}
+
+func notFound(kind, id string) *common.Error {
+	e := &common.Error{}
+	e.SetType("NOT_FOUND")
+	e.SetMessage(fmt.Sprintf("%s %s not found", kind, id))
+	return e
+}
+
+// queryProducts はQuery ServiceのProductServiceです。
+type queryProducts struct {
+	queryconnect.UnimplementedProductServiceHandler
+	*fakeCatalog
+}
+
+func (q queryProducts) ListProducts(_ context.Context, _ *connect.Request[query.ListProductsRequest]) (*connect.Response[query.ListProductsResponse], error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	ids := make([]string, 0, len(q.products))
+	for id := range q.products {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	products := make([]*common.Product, len(ids))
+	for i, id := range ids {
+		products[i] = q.products[id]
+	}
+	resp := &query.ListProductsResponse{}
+	resp.SetProducts(products)
+	return connect.NewResponse(resp), nil
+}
+
+func (q queryProducts) GetProductById(_ context.Context, req *connect.Request[query.GetProductByIdRequest]) (*connect.Response[query.GetProductByIdResponse], error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	resp := &query.GetProductByIdResponse{}
+	// Query Serviceと同じく、include_unpublishedを指定しない場合は公開中の商品のみを返す
+	if p, ok := q.products[req.Msg.GetId()]; ok && (req.Msg.GetIncludeUnpublished() || p.GetStatus() == common.ProductStatus_PRODUCT_STATUS_PUBLISHED) {
+		resp.SetProduct(p)
+	} else {
+		resp.SetError(notFound("product", req.Msg.GetId()))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// queryCategories はQuery ServiceのCategoryServiceです。
+type queryCategories struct {
+	queryconnect.UnimplementedCategoryServiceHandler
+	*fakeCatalog
+}
+
+func (q queryCategories) GetCategoryById(_ context.Context, req *connect.Request[query.GetCategoryByIdRequest]) (*connect.Response[query.GetCategoryByIdResponse], error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	resp := &query.GetCategoryByIdResponse{}
+	if c, ok := q.categories[req.Msg.GetId()]; ok {
+		resp.SetCategory(c)
+	} else {
+		resp.SetError(notFound("category", req.Msg.GetId()))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// commandProducts はCommand ServiceのProductServiceです。
+type commandProducts struct {
+	cmdconnect.UnimplementedProductServiceHandler
+	*fakeCatalog
+}
+
+func (c commandProducts) CreateProduct(_ context.Context, req *connect.Request[command.CreateProductRequest]) (*connect.Response[command.CreateProductResponse], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.created = append(c.created, req.Msg)
+	in := req.Msg.GetProduct()
+	for _, p := range c.products {
+		if p.GetName() == in.GetName().GetValue() {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("product name already exists"))
+		}
+	}
+	c.seq++
+	p := c.putProduct(fmt.Sprintf("p-new-%d", c.seq), in.GetName().GetValue(), in.GetPrice().GetValue(), in.GetCategory().GetId().GetValue())
+	resp := &command.CreateProductResponse{}
+	resp.SetProduct(p)
+	return connect.NewResponse(resp), nil
+}
+
+func (c commandProducts) UpdateProduct(_ context.Context, req *connect.Request[command.UpdateProductRequest]) (*connect.Response[command.UpdateProductResponse], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.updated = append(c.updated, req.Msg)
+	in := req.Msg.GetProduct()
+	p := c.putProduct(in.GetId().GetValue(), in.GetName().GetValue(), in.GetPrice().GetValue(), in.GetCategoryId().GetValue())
+	resp := &command.UpdateProductResponse{}
+	resp.SetProduct(p)
+	return connect.NewResponse(resp), nil
+}
+
+func (c commandProducts) DeleteProduct(_ context.Context, req *connect.Request[command.DeleteProductRequest]) (*connect.Response[command.DeleteProductResponse], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := req.Msg.GetProductId().GetValue()
+	p, ok := c.products[id]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("product %s not found", id))
+	}
+	delete(c.products, id)
+	resp := &command.DeleteProductResponse{}
+	resp.SetProduct(p)
+	return connect.NewResponse(resp), nil
+}
+
+// commandCategories はCommand ServiceのCategoryServiceです。
+type commandCategories struct {
+	cmdconnect.UnimplementedCategoryServiceHandler
+	*fakeCatalog
+}
+
+func (c commandCategories) DeleteCategory(_ context.Context, req *connect.Request[command.DeleteCategoryRequest]) (*connect.Response[command.DeleteCategoryResponse], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := req.Msg.GetCategoryId().GetValue()
+	category, ok := c.categories[id]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("category %s not found", id))
+	}
+	delete(c.categories, id)
+	resp := &command.DeleteCategoryResponse{}
+	resp.SetCategory(category)
+	return connect.NewResponse(resp), nil
+}
+
+// startServer は両サービスをh2cで待ち受けるサーバーを起動します。
+func startServer(t *testing.T, f *fakeCatalog) string {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(queryconnect.NewProductServiceHandler(queryProducts{fakeCatalog: f}))
+	mux.Handle(queryconnect.NewCategoryServiceHandler(queryCategories{fakeCatalog: f}))
+	mux.Handle(cmdconnect.NewProductServiceHandler(commandProducts{fakeCatalog: f}))
+	mux.Handle(cmdconnect.NewCategoryServiceHandler(commandCategories{fakeCatalog: f}))
+	srv := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+// execute はcatalogctlを実行し、標準出力と標準エラー出力を返します。
+func execute(t *testing.T, url string, stdin string, args ...string) (string, string, error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	cmd := catalogctl.NewRootCommand(&catalogctl.Options{In: strings.NewReader(stdin), Out: &stdout, Err: &stderr})
+	global := []string{"--config", filepath.Join(t.TempDir(), "config.yaml"), "--command-url", url, "--query-url", url}
+	cmd.SetArgs(append(global, args...))
+	err := cmd.ExecuteContext(context.Background())
+	return stdout.String(), stderr.String(), err
+}
+
+func TestProductsCommand(t *testing.T) {
+	t.Run("正常系: 商品一覧を表形式で出力できる", func(t *testing.T) {
+		// Arrange
+		url := startServer(t, newFakeCatalog())
+
+		// Act
+		stdout, _, err := execute(t, url, "", "products", "list")
+
+		// Assert
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(stdout), "\n")
+		require.Len(t, lines, 3)
+		assert.Regexp(t, `^ID\s+NAME\s+PRICE`, lines[0])
+		assert.Regexp(t, `^p-001\s+Green Tea\s+300\s+Drinks\s+PUBLISHED`, lines[1])
+		assert.Regexp(t, `^p-002\s+Coffee\s+450`, lines[2])
+	})
+
+	t.Run("正常系: 商品をJSONとYAMLで出力できる", func(t *testing.T) {
+		// Arrange
+		url := startServer(t, newFakeCatalog())
+
+		// Act
+		jsonOut, _, jsonErr := execute(t, url, "", "products", "get", "p-001", "-o", "json")
+		yamlOut, _, yamlErr := execute(t, url, "", "products", "list", "-o", "yaml")
+
+		// Assert
+		require.NoError(t, jsonErr)
+		var product map[string]any
+		require.NoError(t, json.Unmarshal([]byte(jsonOut), &product))
+		assert.Equal(t, "Green Tea", product["name"])
+		assert.Equal(t, "PRODUCT_STATUS_PUBLISHED", product["status"])
+
+		require.NoError(t, yamlErr)
+		var products []map[string]any
+		require.NoError(t, yaml.Unmarshal([]byte(yamlOut), &products))
+		require.Len(t, products, 2)
+		assert.Equal(t, "Coffee", products[1]["name"])
+	})
+
+	t.Run("正常系: 公開中以外の商品もIDで取得できる", func(t *testing.T) {
+		// Arrange
+		f := newFakeCatalog()
+		f.putProduct("p-003", "Barley Tea", 200, "c-001").SetStatus(common.ProductStatus_PRODUCT_STATUS_DRAFT)
+		url := startServer(t, f)
+
+		// Act
+		stdout, _, err := execute(t, url, "", "products", "get", "p-003", "-o", "json")
+
+		// Assert
+		require.NoError(t, err)
+		var product map[string]any
+		require.NoError(t, json.Unmarshal([]byte(stdout), &product))
+		assert.Equal(t, "PRODUCT_STATUS_DRAFT", product["status"])
+	})
+
+	t.Run("異常系: 存在しない商品はレスポンスのエラーを返す", func(t *testing.T) {
+		// Arrange
+		url := startServer(t, newFakeCatalog())
+
+		// Act
+		_, _, err := execute(t, url, "", "products", "get", "p-999")
+
+		// Assert
+		require.Error(t, err)
+		assert.Equal(t, "NOT_FOUND: product p-999 not found", err.Error())
+	})
+
+	t.Run("正常系: カテゴリ名を省略するとQuery Serviceから取得して作成する", func(t *testing.T) {
+		// Arrange
+		f := newFakeCatalog()
+		url := startServer(t, f)
+
+		// Act
+		stdout, _, err := execute(t, url, "", "products", "create",
+			"--name", "Black Tea", "--price", "280", "--category", "c-001", "--tax-class", "reduced")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Contains(t, stdout, "p-new-1")
+		require.Len(t, f.created, 1)
+		product := f.created[0].GetProduct()
+		assert.Equal(t, command.CRUD_CRUD_INSERT, f.created[0].GetCrud())
+		assert.Equal(t, "Drinks", product.GetCategory().GetName().GetValue())
+		assert.Equal(t, common.TaxClass_TAX_CLASS_REDUCED, product.GetTaxClass())
+		assert.False(t, product.HasSlug(), "未指定のスラッグは送信しない")
+	})
+
+	t.Run("正常系: 更新では指定しなかった値に現在の値を使用する", func(t *testing.T) {
+		// Arrange
+		f := newFakeCatalog()
+		url := startServer(t, f)
+
+		// Act
+		_, _, err := execute(t, url, "", "products", "update", "p-001", "--price", "350")
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, f.updated, 1)
+		product := f.updated[0].GetProduct()
+		assert.Equal(t, "Green Tea", product.GetName().GetValue())
+		assert.Equal(t, int32(350), product.GetPrice().GetValue())
+		assert.Equal(t, "c-001", product.GetCategoryId().GetValue())
+		assert.False(t, product.HasCurrency(), "未指定の通貨は送信せず現在の値を維持する")
+	})
+
+	t.Run("異常系: ファイルとフラグを併用するとエラーになる", func(t *testing.T) {
+		// Arrange
+		url := startServer(t, newFakeCatalog())
+
+		// Act
+		_, _, err := execute(t, url, "", "products", "create", "-f", "-", "--name", "Tea")
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--name cannot be combined with --filename")
+	})
+}
+
+func TestBulkOperations(t *testing.T) {
+	input := `
+- name: Black Tea
+  price: 280
+  category_id: c-001
+  category_name: Drinks
+- name: Coffee
+  price: 500
+  category_id: c-001
+  category_name: Drinks
+- name: Oolong Tea
+  price: 320
+  category_id: c-001
+  category_name: Drinks
+`
+
+	t.Run("正常系: continue-on-errorでは失敗した項目を報告して残りを処理する", func(t *testing.T) {
+		// Arrange
+		f := newFakeCatalog()
+		url := startServer(t, f)
+
+		// Act
+		stdout, stderr, err := execute(t, url, input, "products", "create", "-f", "-", "--continue-on-error", "-o", "json")
+
+		// Assert
+		require.Error(t, err)
+		assert.Equal(t, "1 of 3 items failed", err.Error())
+		assert.Contains(t, stderr, "item 2 (Coffee): already_exists: product name already exists")
+		var products []map[string]any
+		require.NoError(t, json.Unmarshal([]byte(stdout), &products))
+		require.Len(t, products, 2)
+		assert.Equal(t, "Black Tea", products[0]["name"])
+		assert.Equal(t, "Oolong Tea", products[1]["name"])
+	})
+
+	t.Run("異常系: 既定では最初に失敗した項目で中断する", func(t *testing.T) {
+		// Arrange
+		f := newFakeCatalog()
+		url := startServer(t, f)
+
+		// Act
+		_, _, err := execute(t, url, input, "products", "create", "-f", "-")
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "aborted at item 2 of 3")
+		assert.Len(t, f.created, 2, "中断後の項目は送信しない")
+	})
+
+	t.Run("正常系: ファイルに記述したカテゴリを削除できる", func(t *testing.T) {
+		// Arrange
+		f := newFakeCatalog()
+		url := startServer(t, f)
+		path := filepath.Join(t.TempDir(), "categories.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[{"id": "c-001", "name": "Drinks"}]`), 0o600))
+
+		// Act
+		stdout, _, err := execute(t, url, "", "categories", "delete", "-f", path)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Regexp(t, `c-001\s+Drinks`, stdout)
+		assert.Empty(t, f.categories)
+	})
+
+	t.Run("異常系: 未知のフィールドを含むファイルはエラーになる", func(t *testing.T) {
+		// Arrange
+		url := startServer(t, newFakeCatalog())
+
+		// Act
+		_, _, err := execute(t, url, `[{"id": "p-001", "prise": 100}]`, "products", "update", "-f", "-")
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "prise")
+	})
+}
+
+func TestConfigCommand(t *testing.T) {
+	t.Run("正常系: 追加したコンテキストに切り替えて接続できる", func(t *testing.T) {
+		// Arrange
+		url := startServer(t, newFakeCatalog())
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		run := func(args ...string) string {
+			var stdout bytes.Buffer
+			cmd := catalogctl.NewRootCommand(&catalogctl.Options{In: strings.NewReader(""), Out: &stdout, Err: io.Discard})
+			cmd.SetArgs(append([]string{"--config", path}, args...))
+			require.NoError(t, cmd.Execute(), "args: %v", args)
+			return stdout.String()
+		}
+
+		// Act
+		run("config", "set-context", "staging", "--command-url", url, "--query-url", url, "--timeout", "5s")
+		run("config", "use-context", "staging")
+		current := run("config", "current-context")
+		contexts := run("config", "get-contexts")
+		categories := run("categories", "get", "c-001")
+
+		// Assert
+		assert.Equal(t, "staging\n", current)
+		assert.Regexp(t, `\*\s+staging\s+`+url+`\s+`+url+`\s+5s`, contexts)
+		assert.Regexp(t, `\s+local\s+http://localhost:8083`, contexts)
+		assert.Regexp(t, `c-001\s+Drinks\s+drinks`, categories)
+	})
+
+	t.Run("異常系: 存在しないコンテキストには切り替えられない", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		cmd := catalogctl.NewRootCommand(&catalogctl.Options{In: strings.NewReader(""), Out: io.Discard, Err: io.Discard})
+		cmd.SetArgs([]string{"--config", path, "config", "use-context", "production"})
+
+		// Act
+		err := cmd.Execute()
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `context "production" not found`)
+		assert.NoFileExists(t, path)
+	})
+}
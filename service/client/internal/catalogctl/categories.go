@@ -0,0 +1,385 @@
+package catalogctl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	command "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// categoryInput は作成・更新するカテゴリの値です。ファイルによる一括操作の1項目にも使用します。
+// 更新では未指定（nil）の値は現在の値を維持します。
+type categoryInput struct {
+	ID           string            `json:"id,omitempty"`           // カテゴリID（更新・削除のみ）
+	Name         *string           `json:"name,omitempty"`         // カテゴリ名
+	ParentID     string            `json:"parent_id,omitempty"`    // 親カテゴリID（作成のみ、未指定の場合はルート）
+	Slug         *string           `json:"slug,omitempty"`         // スラッグ
+	Translations map[string]string `json:"translations,omitempty"` // ロケールごとのカテゴリ名
+}
+
+// categoryFieldFlags はカテゴリの値を指定するフラグ名です。
+var categoryFieldFlags = []string{"name", "parent", "slug", "translation"}
+
+// categoryFlags はカテゴリの値を指定するフラグです。
+type categoryFlags struct {
+	name         string
+	parentID     string
+	slug         string
+	translations map[string]string
+}
+
+// register はカテゴリの値を指定するフラグを登録します。
+func (f *categoryFlags) register(fs *pflag.FlagSet, create bool) {
+	fs.StringVar(&f.name, "name", "", "category name")
+	if create {
+		fs.StringVar(&f.parentID, "parent", "", "parent category ID (a root category when omitted)")
+	}
+	fs.StringVar(&f.slug, "slug", "", "URL slug")
+	fs.StringToStringVar(&f.translations, "translation", nil, "translated name as LOCALE=NAME (repeatable)")
+}
+
+// input はコマンドラインで指定されたフラグだけを設定したcategoryInputを生成します。
+func (f *categoryFlags) input(fs *pflag.FlagSet) *categoryInput {
+	in := &categoryInput{ParentID: f.parentID, Translations: f.translations}
+	if fs.Changed("name") {
+		in.Name = &f.name
+	}
+	if fs.Changed("slug") {
+		in.Slug = &f.slug
+	}
+	return in
+}
+
+// label は一括操作の報告に使用するカテゴリの識別子を返します。
+func (in *categoryInput) label() string {
+	switch {
+	case in.ID != "":
+		return in.ID
+	case in.Name != nil:
+		return *in.Name
+	default:
+		return "-"
+	}
+}
+
+// newCategoriesCommand はカテゴリを操作するコマンドを生成します。
+//
+// Parameters:
+//   - c: グローバルフラグ
+//
+// Returns:
+//   - *cobra.Command: categoriesコマンド
+func newCategoriesCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "categories",
+		Aliases: []string{"category"},
+		Short:   "List, get, create, update and delete categories",
+	}
+	cmd.AddCommand(
+		newCategoriesListCommand(c),
+		newCategoriesGetCommand(c),
+		newCategoriesCreateCommand(c),
+		newCategoriesUpdateCommand(c),
+		newCategoriesDeleteCommand(c),
+	)
+	return cmd
+}
+
+func newCategoriesListCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List categories",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, err := c.printer()
+			if err != nil {
+				return err
+			}
+			cl, err := c.clients()
+			if err != nil {
+				return err
+			}
+			resp, err := cl.queryCategory.ListCategories(cmd.Context(), connect.NewRequest(&query.ListCategoriesRequest{}))
+			if err != nil {
+				return err
+			}
+			if err := responseError(resp.Msg.GetError()); err != nil {
+				return err
+			}
+			return printList(p, resp.Msg.GetCategories(), categoryTable)
+		},
+	}
+}
+
+func newCategoriesGetCommand(c *cli) *cobra.Command {
+	var slug string
+	cmd := &cobra.Command{
+		Use:   "get [ID]",
+		Short: "Get a category by ID or slug",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if n := len(args) + len(changedFlags(cmd.Flags(), "slug")); n != 1 {
+				return errors.New("specify exactly one of ID or --slug")
+			}
+			p, err := c.printer()
+			if err != nil {
+				return err
+			}
+			cl, err := c.clients()
+			if err != nil {
+				return err
+			}
+			var category *common.Category
+			if slug != "" {
+				req := &query.GetCategoryBySlugRequest{}
+				req.SetSlug(slug)
+				resp, err := cl.queryCategory.GetCategoryBySlug(cmd.Context(), connect.NewRequest(req))
+				if err != nil {
+					return err
+				}
+				if err := responseError(resp.Msg.GetError()); err != nil {
+					return err
+				}
+				if resp.Msg.GetMoved() {
+					fmt.Fprintf(cmd.ErrOrStderr(), "slug %q has moved to %q\n", slug, resp.Msg.GetCategory().GetSlug())
+				}
+				category = resp.Msg.GetCategory()
+			} else {
+				category, err = cl.categoryByID(cmd.Context(), args[0])
+				if err != nil {
+					return err
+				}
+			}
+			return printOne(p, category, categoryTable)
+		},
+	}
+	cmd.Flags().StringVar(&slug, "slug", "", "get the category by current or previous slug")
+	return cmd
+}
+
+func newCategoriesCreateCommand(c *cli) *cobra.Command {
+	var (
+		f    categoryFlags
+		bulk bulkFlags
+	)
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create categories from flags or a file",
+		Example: `  catalogctl categories create --name Drinks --translation en=Drinks
+  catalogctl categories create -f categories.yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			items, err := collectInputs(cmd, &bulk, categoryFieldFlags, func() (*categoryInput, error) {
+				return f.input(cmd.Flags()), nil
+			})
+			if err != nil {
+				return err
+			}
+			p, err := c.printer()
+			if err != nil {
+				return err
+			}
+			cl, err := c.clients()
+			if err != nil {
+				return err
+			}
+			results, err := runBulk(cmd, items, bulk.continueOnError, (*categoryInput).label, cl.createCategory)
+			return printResults(p, results, bulk.filename != "", categoryTable, err)
+		},
+	}
+	f.register(cmd.Flags(), true)
+	bulk.register(cmd.Flags())
+	return cmd
+}
+
+func newCategoriesUpdateCommand(c *cli) *cobra.Command {
+	var (
+		f    categoryFlags
+		bulk bulkFlags
+	)
+	cmd := &cobra.Command{
+		Use:   "update [ID]",
+		Short: "Update categories from flags or a file",
+		Long: `Update categories from flags or a file.
+
+Only the given values are changed. The name of the current category is read
+from the Query Service when --name is omitted.`,
+		Example: `  catalogctl categories update c-001 --slug drinks
+  catalogctl categories update -f categories.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if bulk.filename != "" && len(args) > 0 {
+				return errors.New("the category ID cannot be combined with --filename")
+			}
+			items, err := collectInputs(cmd, &bulk, categoryFieldFlags, func() (*categoryInput, error) {
+				if len(args) != 1 {
+					return nil, errors.New("specify the category ID or --filename")
+				}
+				in := f.input(cmd.Flags())
+				in.ID = args[0]
+				return in, nil
+			})
+			if err != nil {
+				return err
+			}
+			p, err := c.printer()
+			if err != nil {
+				return err
+			}
+			cl, err := c.clients()
+			if err != nil {
+				return err
+			}
+			results, err := runBulk(cmd, items, bulk.continueOnError, (*categoryInput).label, cl.updateCategory)
+			return printResults(p, results, bulk.filename != "", categoryTable, err)
+		},
+	}
+	f.register(cmd.Flags(), false)
+	bulk.register(cmd.Flags())
+	return cmd
+}
+
+func newCategoriesDeleteCommand(c *cli) *cobra.Command {
+	var bulk bulkFlags
+	cmd := &cobra.Command{
+		Use:   "delete [ID...]",
+		Short: "Delete categories by ID or from a file",
+		Example: `  catalogctl categories delete c-001 c-002
+  catalogctl categories delete -f categories.yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			items, err := deleteInputs(cmd, &bulk, args, func(id string) *categoryInput { return &categoryInput{ID: id} })
+			if err != nil {
+				return err
+			}
+			p, err := c.printer()
+			if err != nil {
+				return err
+			}
+			cl, err := c.clients()
+			if err != nil {
+				return err
+			}
+			results, err := runBulk(cmd, items, bulk.continueOnError, (*categoryInput).label,
+				func(ctx context.Context, in *categoryInput) (*common.Category, error) {
+					return cl.deleteCategory(ctx, in.ID)
+				})
+			return printResults(p, results, true, categoryTable, err)
+		},
+	}
+	bulk.register(cmd.Flags())
+	return cmd
+}
+
+// categoryByID はQuery ServiceからIDでカテゴリを取得します。
+func (cl *clients) categoryByID(ctx context.Context, id string) (*common.Category, error) {
+	req := &query.GetCategoryByIdRequest{}
+	req.SetId(id)
+	resp, err := cl.queryCategory.GetCategoryById(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetCategory(), nil
+}
+
+// createCategory はカテゴリを作成します。
+func (cl *clients) createCategory(ctx context.Context, in *categoryInput) (*common.Category, error) {
+	if in.Name == nil {
+		return nil, errors.New("name is required")
+	}
+	req := &command.CreateCategoryRequest{}
+	req.SetCrud(command.CRUD_CRUD_INSERT)
+	req.SetName(newCategoryName(*in.Name))
+	if in.ParentID != "" {
+		req.SetParentId(newCategoryID(in.ParentID))
+	}
+	req.SetTranslations(in.Translations)
+	if in.Slug != nil {
+		req.SetSlug(*in.Slug)
+	}
+	resp, err := cl.commandCategory.CreateCategory(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetCategory(), nil
+}
+
+// updateCategory はカテゴリを更新します。カテゴリ名は更新に必須のため、未指定の場合は現在の値を使用します。
+func (cl *clients) updateCategory(ctx context.Context, in *categoryInput) (*common.Category, error) {
+	if in.ID == "" {
+		return nil, errors.New("id is required")
+	}
+	var name string
+	if in.Name != nil {
+		name = *in.Name
+	} else {
+		current, err := cl.categoryByID(ctx, in.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category %s: %w", in.ID, err)
+		}
+		name = current.GetName()
+	}
+
+	c := &command.UpdateCategoryRequest_Category{}
+	c.SetId(newCategoryID(in.ID))
+	c.SetName(newCategoryName(name))
+	c.SetTranslations(in.Translations)
+	if in.Slug != nil {
+		c.SetSlug(*in.Slug)
+	}
+
+	req := &command.UpdateCategoryRequest{}
+	req.SetCrud(command.CRUD_CRUD_UPDATE)
+	req.SetCategory(c)
+	resp, err := cl.commandCategory.UpdateCategory(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetCategory(), nil
+}
+
+// deleteCategory はカテゴリを削除します。
+func (cl *clients) deleteCategory(ctx context.Context, id string) (*common.Category, error) {
+	if id == "" {
+		return nil, errors.New("id is required")
+	}
+	req := &command.DeleteCategoryRequest{}
+	req.SetCrud(command.CRUD_CRUD_DELETE)
+	req.SetCategoryId(newCategoryID(id))
+	resp, err := cl.commandCategory.DeleteCategory(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetCategory(), nil
+}
+
+// newCategoryID はカテゴリIDのprotobuf値オブジェクトを生成します。
+func newCategoryID(id string) *common.CategoryId {
+	v := &common.CategoryId{}
+	v.SetValue(id)
+	return v
+}
+
+// newCategoryName はカテゴリ名のprotobuf値オブジェクトを生成します。
+func newCategoryName(name string) *common.CategoryName {
+	v := &common.CategoryName{}
+	v.SetValue(name)
+	return v
+}
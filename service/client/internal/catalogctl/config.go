@@ -0,0 +1,184 @@
+package catalogctl
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigEnv は設定ファイルのパスを指定する環境変数です。
+	ConfigEnv = "CATALOGCTL_CONFIG"
+	// DefaultContextName は設定ファイルに定義がなくても使用できるローカル環境のコンテキスト名です。
+	DefaultContextName = "local"
+	// defaultTimeout はコンテキストにタイムアウトが未設定の場合のリクエストタイムアウトです。
+	defaultTimeout = 10 * time.Second
+)
+
+// Config は接続先の環境（コンテキスト）を管理する設定です。
+type Config struct {
+	CurrentContext string              `json:"current-context,omitempty"` // 使用中のコンテキスト名
+	Contexts       map[string]*Context `json:"contexts,omitempty"`        // コンテキスト名ごとの接続先
+}
+
+// Context は1つの環境の接続先です。
+type Context struct {
+	CommandURL string `json:"command-url"`       // Command ServiceのURL
+	QueryURL   string `json:"query-url"`         // Query ServiceのURL
+	Timeout    string `json:"timeout,omitempty"` // リクエストタイムアウト（例: 10s）
+}
+
+// DefaultConfigPath は設定ファイルの既定のパスを返します。
+// 環境変数CATALOGCTL_CONFIGが設定されている場合はその値を使用します。
+//
+// Returns:
+//   - string: 設定ファイルのパス
+func DefaultConfigPath() string {
+	if path := os.Getenv(ConfigEnv); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return filepath.Join(".catalogctl", "config.yaml")
+	}
+	return filepath.Join(dir, "catalogctl", "config.yaml")
+}
+
+// localContext はローカル環境（make run-serverなどで起動したサービス）の接続先を返します。
+func localContext() *Context {
+	return &Context{
+		CommandURL: "http://localhost:8083",
+		QueryURL:   "http://localhost:8085",
+	}
+}
+
+// LoadConfig は設定ファイルを読み込みます。ファイルが存在しない場合は空の設定を返します。
+//
+// Parameters:
+//   - path: 設定ファイルのパス
+//
+// Returns:
+//   - *Config: 設定
+//   - error: 読み込みまたは解析のエラー
+func LoadConfig(path string) (*Config, error) {
+	cfg := &Config{Contexts: map[string]*Context{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	if cfg.Contexts == nil {
+		cfg.Contexts = map[string]*Context{}
+	}
+	for name, c := range cfg.Contexts {
+		if err := c.validate(); err != nil {
+			return nil, fmt.Errorf("invalid context %q in %s: %w", name, path, err)
+		}
+	}
+	return cfg, nil
+}
+
+// Save は設定ファイルを書き込みます。接続先を含むため、所有者のみ読み書きできる権限で作成します。
+//
+// Parameters:
+//   - path: 設定ファイルのパス
+//
+// Returns:
+//   - error: 書き込みエラー
+func (c *Config) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write config %s: %w", path, err)
+	}
+	return nil
+}
+
+// Resolve は使用するコンテキストを決定します。
+// nameが空の場合は現在のコンテキスト、それも未設定の場合はlocalを使用します。
+// localは設定ファイルで上書きしない限り、ローカル環境の接続先になります。
+//
+// Parameters:
+//   - name: コンテキスト名（空の場合は現在のコンテキスト）
+//
+// Returns:
+//   - string: 使用するコンテキスト名
+//   - *Context: 接続先
+//   - error: コンテキストが存在しない場合のエラー
+func (c *Config) Resolve(name string) (string, *Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		name = DefaultContextName
+	}
+	if ctx, ok := c.Contexts[name]; ok {
+		return name, ctx, nil
+	}
+	if name == DefaultContextName {
+		return name, localContext(), nil
+	}
+	return "", nil, fmt.Errorf("context %q not found (available: %v)", name, c.Names())
+}
+
+// Names はlocalを含むコンテキスト名を昇順で返します。
+//
+// Returns:
+//   - []string: コンテキスト名
+func (c *Config) Names() []string {
+	names := make([]string, 0, len(c.Contexts)+1)
+	for name := range c.Contexts {
+		names = append(names, name)
+	}
+	if _, ok := c.Contexts[DefaultContextName]; !ok {
+		names = append(names, DefaultContextName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RequestTimeout はリクエストタイムアウトを返します。未設定の場合は10秒です。
+//
+// Returns:
+//   - time.Duration: リクエストタイムアウト
+func (c *Context) RequestTimeout() time.Duration {
+	if c.Timeout == "" {
+		return defaultTimeout
+	}
+	// validateで検証済みのため、解析エラーにはならない
+	d, _ := time.ParseDuration(c.Timeout)
+	return d
+}
+
+// validate は接続先の必須項目とタイムアウトの形式を検証します。
+func (c *Context) validate() error {
+	if c == nil || c.CommandURL == "" || c.QueryURL == "" {
+		return errors.New("command-url and query-url are required")
+	}
+	if c.Timeout != "" {
+		d, err := time.ParseDuration(c.Timeout)
+		if err != nil {
+			return fmt.Errorf("invalid timeout %q: %w", c.Timeout, err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("timeout must be positive: %s", c.Timeout)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package catalogctl
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+// newConfigCommand はコンテキストを管理するコマンドを生成します。
+//
+// Parameters:
+//   - c: グローバルフラグ
+//
+// Returns:
+//   - *cobra.Command: configコマンド
+func newConfigCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage contexts for multiple environments",
+		Long: `Manage contexts for multiple environments.
+
+A context names the Command Service URL, the Query Service URL and the request
+timeout of one environment. The config file is YAML:
+
+  current-context: staging
+  contexts:
+    staging:
+      command-url: https://command.staging.example.com
+      query-url: https://query.staging.example.com
+      timeout: 30s`,
+	}
+	cmd.AddCommand(
+		newConfigGetContextsCommand(c),
+		newConfigCurrentContextCommand(c),
+		newConfigUseContextCommand(c),
+		newConfigSetContextCommand(c),
+		newConfigDeleteContextCommand(c),
+	)
+	return cmd
+}
+
+func newConfigGetContextsCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-contexts",
+		Short: "List contexts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := LoadConfig(c.configPath)
+			if err != nil {
+				return err
+			}
+			current, _, err := cfg.Resolve("")
+			if err != nil {
+				// 現在のコンテキストが削除されていても一覧は表示する
+				current = ""
+			}
+			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+			fmt.Fprintln(tw, "CURRENT\tNAME\tCOMMAND-URL\tQUERY-URL\tTIMEOUT")
+			for _, name := range cfg.Names() {
+				_, ctx, _ := cfg.Resolve(name)
+				mark := ""
+				if name == current {
+					mark = "*"
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", mark, name, ctx.CommandURL, ctx.QueryURL, ctx.RequestTimeout())
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+func newConfigCurrentContextCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "current-context",
+		Short: "Print the current context",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := LoadConfig(c.configPath)
+			if err != nil {
+				return err
+			}
+			name, _, err := cfg.Resolve("")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), name)
+			return nil
+		},
+	}
+}
+
+func newConfigUseContextCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "use-context NAME",
+		Short: "Set the current context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := LoadConfig(c.configPath)
+			if err != nil {
+				return err
+			}
+			if _, _, err := cfg.Resolve(args[0]); err != nil {
+				return err
+			}
+			cfg.CurrentContext = args[0]
+			if err := cfg.Save(c.configPath); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Switched to context %q.\n", args[0])
+			return nil
+		},
+	}
+}
+
+func newConfigSetContextCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-context NAME",
+		Short: "Create or modify a context",
+		Long: `Create or modify a context with the values of --command-url, --query-url
+and --timeout. Values that are not given keep their current value.`,
+		Example: `  catalogctl config set-context staging --command-url https://command.staging.example.com \
+      --query-url https://query.staging.example.com --timeout 30s`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := LoadConfig(c.configPath)
+			if err != nil {
+				return err
+			}
+			name := args[0]
+			ctx, ok := cfg.Contexts[name]
+			if !ok {
+				ctx = &Context{}
+				if name == DefaultContextName {
+					ctx = localContext()
+				}
+			}
+			if c.commandURL != "" {
+				ctx.CommandURL = c.commandURL
+			}
+			if c.queryURL != "" {
+				ctx.QueryURL = c.queryURL
+			}
+			if c.timeout > 0 {
+				ctx.Timeout = c.timeout.String()
+			}
+			if err := ctx.validate(); err != nil {
+				return fmt.Errorf("invalid context %q: %w", name, err)
+			}
+			cfg.Contexts[name] = ctx
+			if err := cfg.Save(c.configPath); err != nil {
+				return err
+			}
+			verb := "Modified"
+			if !ok {
+				verb = "Created"
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s context %q.\n", verb, name)
+			return nil
+		},
+	}
+}
+
+func newConfigDeleteContextCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete-context NAME",
+		Short: "Delete a context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := LoadConfig(c.configPath)
+			if err != nil {
+				return err
+			}
+			name := args[0]
+			if _, ok := cfg.Contexts[name]; !ok {
+				return fmt.Errorf("context %q not found in %s", name, c.configPath)
+			}
+			delete(cfg.Contexts, name)
+			if cfg.CurrentContext == name {
+				cfg.CurrentContext = ""
+			}
+			if err := cfg.Save(c.configPath); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Deleted context %q.\n", name)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,108 @@
+package catalogctl_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/catalogctl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Run("正常系: 設定ファイルがない場合はlocalを使用する", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "config.yaml")
+
+		// Act
+		cfg, err := catalogctl.LoadConfig(path)
+		require.NoError(t, err)
+		name, ctx, err := cfg.Resolve("")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, catalogctl.DefaultContextName, name)
+		assert.Equal(t, "http://localhost:8083", ctx.CommandURL)
+		assert.Equal(t, "http://localhost:8085", ctx.QueryURL)
+		assert.Equal(t, 10*time.Second, ctx.RequestTimeout())
+	})
+
+	t.Run("正常系: 保存した設定を読み込める", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "nested", "config.yaml")
+		cfg := &catalogctl.Config{
+			CurrentContext: "prod",
+			Contexts: map[string]*catalogctl.Context{
+				"prod": {CommandURL: "https://command.example.com", QueryURL: "https://query.example.com", Timeout: "30s"},
+			},
+		}
+
+		// Act
+		require.NoError(t, cfg.Save(path))
+		loaded, err := catalogctl.LoadConfig(path)
+		require.NoError(t, err)
+		name, ctx, err := loaded.Resolve("")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "prod", name)
+		assert.Equal(t, "https://command.example.com", ctx.CommandURL)
+		assert.Equal(t, 30*time.Second, ctx.RequestTimeout())
+		assert.Equal(t, []string{"local", "prod"}, loaded.Names())
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	})
+
+	t.Run("異常系: 不正なコンテキストを含む設定はエラーになる", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			content string
+			wantErr string
+		}{
+			{
+				name:    "URLが未設定",
+				content: "contexts:\n  dev:\n    command-url: http://dev:8083\n",
+				wantErr: "command-url and query-url are required",
+			},
+			{
+				name:    "タイムアウトの形式が不正",
+				content: "contexts:\n  dev:\n    command-url: http://dev:8083\n    query-url: http://dev:8085\n    timeout: soon\n",
+				wantErr: `invalid timeout "soon"`,
+			},
+			{
+				name:    "未知のフィールド",
+				content: "contexts:\n  dev:\n    command_url: http://dev:8083\n",
+				wantErr: "command_url",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// Arrange
+				path := filepath.Join(t.TempDir(), "config.yaml")
+				require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+				// Act
+				_, err := catalogctl.LoadConfig(path)
+
+				// Assert
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("異常系: 存在しないコンテキストは解決できない", func(t *testing.T) {
+		// Arrange
+		cfg := &catalogctl.Config{CurrentContext: "removed"}
+
+		// Act
+		_, _, err := cfg.Resolve("")
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `context "removed" not found`)
+	})
+}
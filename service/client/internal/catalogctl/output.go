@@ -0,0 +1,155 @@
+package catalogctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	outputTable = "table" // 列を揃えた表形式（既定）
+	outputJSON  = "json"  // protobufのJSONマッピング（フィールド名はprotoの名前）
+	outputYAML  = "yaml"  // JSONと同じ構造のYAML
+)
+
+// printer は操作結果を指定された形式で出力します。
+type printer struct {
+	format string
+	w      io.Writer
+}
+
+// tableFormat は表形式で出力する列の定義です。
+type tableFormat[T proto.Message] struct {
+	header []string         // 列名
+	row    func(T) []string // 1件分の列の値
+}
+
+// productTable は商品の表形式の列です。
+var productTable = tableFormat[*common.Product]{
+	header: []string{"ID", "NAME", "PRICE", "CURRENCY", "CATEGORY", "STATUS", "STOCK", "SLUG"},
+	row: func(p *common.Product) []string {
+		return []string{
+			p.GetId(),
+			p.GetName(),
+			strconv.Itoa(int(p.GetPrice())),
+			p.GetPriceExcludingTax().GetCurrency(),
+			p.GetCategory().GetName(),
+			strings.TrimPrefix(p.GetStatus().String(), "PRODUCT_STATUS_"),
+			strconv.Itoa(int(p.GetAvailableQuantity())),
+			p.GetSlug(),
+		}
+	},
+}
+
+// categoryTable はカテゴリの表形式の列です。
+var categoryTable = tableFormat[*common.Category]{
+	header: []string{"ID", "NAME", "PARENT", "SLUG"},
+	row: func(c *common.Category) []string {
+		return []string{c.GetId(), c.GetName(), c.GetParentId(), c.GetSlug()}
+	},
+}
+
+// newPrinter は出力形式を検証してprinterを生成します。
+//
+// Parameters:
+//   - format: 出力形式（table / json / yaml）
+//   - w: 出力先
+//
+// Returns:
+//   - *printer: printer
+//   - error: 出力形式が不正な場合のエラー
+func newPrinter(format string, w io.Writer) (*printer, error) {
+	switch format {
+	case outputTable, outputJSON, outputYAML:
+		return &printer{format: format, w: w}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q (want table, json or yaml)", format)
+	}
+}
+
+// printList は複数件の結果を出力します。JSON・YAMLでは配列として出力します。
+//
+// Parameters:
+//   - p: printer
+//   - items: 出力する結果
+//   - t: 表形式の列
+//
+// Returns:
+//   - error: 出力エラー
+func printList[T proto.Message](p *printer, items []T, t tableFormat[T]) error {
+	if p.format == outputTable {
+		return writeTable(p.w, items, t)
+	}
+	raw := make([]json.RawMessage, len(items))
+	for i, item := range items {
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+		raw[i] = data
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("failed to encode result: %w", err)
+	}
+	return p.write(data)
+}
+
+// printOne は1件の結果を出力します。JSON・YAMLではオブジェクトとして出力します。
+//
+// Parameters:
+//   - p: printer
+//   - item: 出力する結果
+//   - t: 表形式の列
+//
+// Returns:
+//   - error: 出力エラー
+func printOne[T proto.Message](p *printer, item T, t tableFormat[T]) error {
+	if p.format == outputTable {
+		return writeTable(p.w, []T{item}, t)
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed to encode result: %w", err)
+	}
+	return p.write(data)
+}
+
+// write はJSONを整形して、またはYAMLに変換して出力します。
+func (p *printer) write(data []byte) error {
+	if p.format == outputYAML {
+		out, err := yaml.JSONToYAML(data)
+		if err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+		_, err = p.w.Write(out)
+		return err
+	}
+	// protojsonの出力は空白が安定しないため、整形し直す
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return fmt.Errorf("failed to encode result: %w", err)
+	}
+	buf.WriteByte('\n')
+	_, err := buf.WriteTo(p.w)
+	return err
+}
+
+// writeTable は列を揃えた表を出力します。
+func writeTable[T proto.Message](w io.Writer, items []T, t tableFormat[T]) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(t.header, "\t"))
+	for _, item := range items {
+		fmt.Fprintln(tw, strings.Join(t.row(item), "\t"))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,579 @@
+package catalogctl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	command "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// productInput は作成・更新する商品の値です。ファイルによる一括操作の1項目にも使用します。
+// 更新では未指定（nil）の値は現在の値を維持します。
+type productInput struct {
+	ID           string            `json:"id,omitempty"`            // 商品ID（更新・削除のみ）
+	Name         *string           `json:"name,omitempty"`          // 商品名
+	Price        *int32            `json:"price,omitempty"`         // 商品単価（税抜）
+	CategoryID   *string           `json:"category_id,omitempty"`   // カテゴリID
+	CategoryName string            `json:"category_name,omitempty"` // カテゴリ名（作成のみ、未指定の場合はQuery Serviceから取得）
+	Currency     *string           `json:"currency,omitempty"`      // 通貨コード
+	TaxClass     *string           `json:"tax_class,omitempty"`     // 税率区分（STANDARD / REDUCED）
+	Barcode      *string           `json:"barcode,omitempty"`       // バーコード（更新で空文字列の場合は削除）
+	Slug         *string           `json:"slug,omitempty"`          // スラッグ
+	Translations map[string]string `json:"translations,omitempty"`  // ロケールごとの商品名
+}
+
+// productFieldFlags は商品の値を指定するフラグ名です。
+var productFieldFlags = []string{"name", "price", "category", "category-name", "currency", "tax-class", "barcode", "slug", "translation"}
+
+// productFlags は商品の値を指定するフラグです。
+type productFlags struct {
+	name         string
+	price        int32
+	categoryID   string
+	categoryName string
+	currency     string
+	taxClass     string
+	barcode      string
+	slug         string
+	translations map[string]string
+}
+
+// register は商品の値を指定するフラグを登録します。
+func (f *productFlags) register(fs *pflag.FlagSet, create bool) {
+	fs.StringVar(&f.name, "name", "", "product name")
+	fs.Int32Var(&f.price, "price", 0, "unit price excluding tax in the smallest currency unit")
+	fs.StringVar(&f.categoryID, "category", "", "category ID")
+	if create {
+		fs.StringVar(&f.categoryName, "category-name", "", "category name (looked up from the Query Service when omitted)")
+	}
+	fs.StringVar(&f.currency, "currency", "", "ISO 4217 currency code")
+	fs.StringVar(&f.taxClass, "tax-class", "", "tax class: STANDARD or REDUCED")
+	fs.StringVar(&f.barcode, "barcode", "", "JAN/EAN/UPC barcode")
+	fs.StringVar(&f.slug, "slug", "", "URL slug")
+	fs.StringToStringVar(&f.translations, "translation", nil, "translated name as LOCALE=NAME (repeatable)")
+}
+
+// input はコマンドラインで指定されたフラグだけを設定したproductInputを生成します。
+func (f *productFlags) input(fs *pflag.FlagSet) *productInput {
+	in := &productInput{CategoryName: f.categoryName, Translations: f.translations}
+	if fs.Changed("name") {
+		in.Name = &f.name
+	}
+	if fs.Changed("price") {
+		in.Price = &f.price
+	}
+	if fs.Changed("category") {
+		in.CategoryID = &f.categoryID
+	}
+	if fs.Changed("currency") {
+		in.Currency = &f.currency
+	}
+	if fs.Changed("tax-class") {
+		in.TaxClass = &f.taxClass
+	}
+	if fs.Changed("barcode") {
+		in.Barcode = &f.barcode
+	}
+	if fs.Changed("slug") {
+		in.Slug = &f.slug
+	}
+	return in
+}
+
+// label は一括操作の報告に使用する商品の識別子を返します。
+func (in *productInput) label() string {
+	switch {
+	case in.ID != "":
+		return in.ID
+	case in.Name != nil:
+		return *in.Name
+	default:
+		return "-"
+	}
+}
+
+// newProductsCommand は商品を操作するコマンドを生成します。
+//
+// Parameters:
+//   - c: グローバルフラグ
+//
+// Returns:
+//   - *cobra.Command: productsコマンド
+func newProductsCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "products",
+		Aliases: []string{"product"},
+		Short:   "List, get, search, create, update and delete products",
+	}
+	cmd.AddCommand(
+		newProductsListCommand(c),
+		newProductsGetCommand(c),
+		newProductsSearchCommand(c),
+		newProductsCreateCommand(c),
+		newProductsUpdateCommand(c),
+		newProductsDeleteCommand(c),
+	)
+	return cmd
+}
+
+func newProductsListCommand(c *cli) *cobra.Command {
+	var (
+		categoryID  string
+		descendants bool
+		tags        []string
+		locale      string
+	)
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List products",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, err := c.printer()
+			if err != nil {
+				return err
+			}
+			cl, err := c.clients()
+			if err != nil {
+				return err
+			}
+			req := &query.ListProductsRequest{}
+			if categoryID != "" {
+				req.SetCategoryId(categoryID)
+			}
+			req.SetIncludeDescendants(descendants)
+			req.SetTags(tags)
+			if locale != "" {
+				req.SetLocale(locale)
+			}
+			resp, err := cl.queryProduct.ListProducts(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			if err := responseError(resp.Msg.GetError()); err != nil {
+				return err
+			}
+			return printList(p, resp.Msg.GetProducts(), productTable)
+		},
+	}
+	cmd.Flags().StringVar(&categoryID, "category", "", "only products in the category")
+	cmd.Flags().BoolVar(&descendants, "descendants", false, "include products in descendant categories of --category")
+	cmd.Flags().StringSliceVar(&tags, "tag", nil, "only products with all of the tags (repeatable)")
+	cmd.Flags().StringVar(&locale, "locale", "", "locale of product names")
+	return cmd
+}
+
+func newProductsGetCommand(c *cli) *cobra.Command {
+	var barcode, slug, locale string
+	cmd := &cobra.Command{
+		Use:   "get [ID]",
+		Short: "Get a product by ID, barcode or slug",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if n := len(args) + len(changedFlags(cmd.Flags(), "barcode", "slug")); n != 1 {
+				return errors.New("specify exactly one of ID, --barcode or --slug")
+			}
+			p, err := c.printer()
+			if err != nil {
+				return err
+			}
+			cl, err := c.clients()
+			if err != nil {
+				return err
+			}
+			var product *common.Product
+			switch {
+			case barcode != "":
+				req := &query.GetProductByBarcodeRequest{}
+				req.SetBarcode(barcode)
+				if locale != "" {
+					req.SetLocale(locale)
+				}
+				resp, err := cl.queryProduct.GetProductByBarcode(cmd.Context(), connect.NewRequest(req))
+				if err != nil {
+					return err
+				}
+				if err := responseError(resp.Msg.GetError()); err != nil {
+					return err
+				}
+				product = resp.Msg.GetProduct()
+			case slug != "":
+				req := &query.GetProductBySlugRequest{}
+				req.SetSlug(slug)
+				if locale != "" {
+					req.SetLocale(locale)
+				}
+				resp, err := cl.queryProduct.GetProductBySlug(cmd.Context(), connect.NewRequest(req))
+				if err != nil {
+					return err
+				}
+				if err := responseError(resp.Msg.GetError()); err != nil {
+					return err
+				}
+				if resp.Msg.GetMoved() {
+					fmt.Fprintf(cmd.ErrOrStderr(), "slug %q has moved to %q\n", slug, resp.Msg.GetProduct().GetSlug())
+				}
+				product = resp.Msg.GetProduct()
+			default:
+				product, err = cl.productByID(cmd.Context(), args[0], locale)
+				if err != nil {
+					return err
+				}
+			}
+			return printOne(p, product, productTable)
+		},
+	}
+	cmd.Flags().StringVar(&barcode, "barcode", "", "get the product by JAN/EAN/UPC barcode")
+	cmd.Flags().StringVar(&slug, "slug", "", "get the product by current or previous slug")
+	cmd.Flags().StringVar(&locale, "locale", "", "locale of the product name")
+	return cmd
+}
+
+func newProductsSearchCommand(c *cli) *cobra.Command {
+	var locale string
+	cmd := &cobra.Command{
+		Use:   "search KEYWORD",
+		Short: "Search products by keyword",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := c.printer()
+			if err != nil {
+				return err
+			}
+			cl, err := c.clients()
+			if err != nil {
+				return err
+			}
+			req := &query.SearchProductsByKeywordRequest{}
+			req.SetKeyword(args[0])
+			if locale != "" {
+				req.SetLocale(locale)
+			}
+			resp, err := cl.queryProduct.SearchProductsByKeyword(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			if err := responseError(resp.Msg.GetError()); err != nil {
+				return err
+			}
+			return printList(p, resp.Msg.GetProducts(), productTable)
+		},
+	}
+	cmd.Flags().StringVar(&locale, "locale", "", "locale of the keyword and product names")
+	return cmd
+}
+
+func newProductsCreateCommand(c *cli) *cobra.Command {
+	var (
+		f    productFlags
+		bulk bulkFlags
+	)
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create products from flags or a file",
+		Example: `  catalogctl products create --name "Green Tea" --price 300 --category c-001 --tax-class REDUCED
+  catalogctl products create -f products.yaml --continue-on-error`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			items, err := collectInputs(cmd, &bulk, productFieldFlags, func() (*productInput, error) {
+				return f.input(cmd.Flags()), nil
+			})
+			if err != nil {
+				return err
+			}
+			p, err := c.printer()
+			if err != nil {
+				return err
+			}
+			cl, err := c.clients()
+			if err != nil {
+				return err
+			}
+			results, err := runBulk(cmd, items, bulk.continueOnError, (*productInput).label, cl.createProduct)
+			return printResults(p, results, bulk.filename != "", productTable, err)
+		},
+	}
+	f.register(cmd.Flags(), true)
+	bulk.register(cmd.Flags())
+	return cmd
+}
+
+func newProductsUpdateCommand(c *cli) *cobra.Command {
+	var (
+		f    productFlags
+		bulk bulkFlags
+	)
+	cmd := &cobra.Command{
+		Use:   "update [ID]",
+		Short: "Update products from flags or a file",
+		Long: `Update products from flags or a file.
+
+Only the given values are changed. The name, price and category of the
+current product are read from the Query Service, so a change made just
+before may not be reflected yet.`,
+		Example: `  catalogctl products update p-001 --price 350
+  catalogctl products update -f products.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if bulk.filename != "" && len(args) > 0 {
+				return errors.New("the product ID cannot be combined with --filename")
+			}
+			items, err := collectInputs(cmd, &bulk, productFieldFlags, func() (*productInput, error) {
+				if len(args) != 1 {
+					return nil, errors.New("specify the product ID or --filename")
+				}
+				in := f.input(cmd.Flags())
+				in.ID = args[0]
+				return in, nil
+			})
+			if err != nil {
+				return err
+			}
+			p, err := c.printer()
+			if err != nil {
+				return err
+			}
+			cl, err := c.clients()
+			if err != nil {
+				return err
+			}
+			results, err := runBulk(cmd, items, bulk.continueOnError, (*productInput).label, cl.updateProduct)
+			return printResults(p, results, bulk.filename != "", productTable, err)
+		},
+	}
+	f.register(cmd.Flags(), false)
+	bulk.register(cmd.Flags())
+	return cmd
+}
+
+func newProductsDeleteCommand(c *cli) *cobra.Command {
+	var bulk bulkFlags
+	cmd := &cobra.Command{
+		Use:   "delete [ID...]",
+		Short: "Delete products by ID or from a file",
+		Example: `  catalogctl products delete p-001 p-002
+  catalogctl products delete -f products.yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			items, err := deleteInputs(cmd, &bulk, args, func(id string) *productInput { return &productInput{ID: id} })
+			if err != nil {
+				return err
+			}
+			p, err := c.printer()
+			if err != nil {
+				return err
+			}
+			cl, err := c.clients()
+			if err != nil {
+				return err
+			}
+			results, err := runBulk(cmd, items, bulk.continueOnError, (*productInput).label,
+				func(ctx context.Context, in *productInput) (*common.Product, error) {
+					return cl.deleteProduct(ctx, in.ID)
+				})
+			return printResults(p, results, true, productTable, err)
+		},
+	}
+	bulk.register(cmd.Flags())
+	return cmd
+}
+
+// deleteInputs は削除する項目を引数のIDまたはファイルから集めます。
+// ファイルは更新と同じ形式で、IDだけを使用します。
+func deleteInputs[T any](cmd *cobra.Command, bulk *bulkFlags, args []string, fromID func(string) T) ([]T, error) {
+	switch {
+	case bulk.filename != "" && len(args) > 0:
+		return nil, errors.New("IDs cannot be combined with --filename")
+	case bulk.filename != "":
+		return readItems[T](bulk.filename, cmd.InOrStdin())
+	case len(args) == 0:
+		return nil, errors.New("specify IDs or --filename")
+	}
+	items := make([]T, len(args))
+	for i, id := range args {
+		items[i] = fromID(id)
+	}
+	return items, nil
+}
+
+// productByID はQuery ServiceからIDで商品を取得します。管理用のため公開中以外の商品も取得します。
+func (cl *clients) productByID(ctx context.Context, id string, locale string) (*common.Product, error) {
+	req := &query.GetProductByIdRequest{}
+	req.SetId(id)
+	req.SetIncludeUnpublished(true)
+	if locale != "" {
+		req.SetLocale(locale)
+	}
+	resp, err := cl.queryProduct.GetProductById(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetProduct(), nil
+}
+
+// createProduct は商品を作成します。カテゴリ名が未指定の場合はQuery Serviceから取得します。
+func (cl *clients) createProduct(ctx context.Context, in *productInput) (*common.Product, error) {
+	if in.Name == nil || in.Price == nil || in.CategoryID == nil {
+		return nil, errors.New("name, price and category are required")
+	}
+	categoryName := in.CategoryName
+	if categoryName == "" {
+		category, err := cl.categoryByID(ctx, *in.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category %s: %w", *in.CategoryID, err)
+		}
+		categoryName = category.GetName()
+	}
+
+	category := &command.CreateProductRequest_Product_Category{}
+	category.SetId(newCategoryID(*in.CategoryID))
+	category.SetName(newCategoryName(categoryName))
+
+	p := &command.CreateProductRequest_Product{}
+	p.SetName(newProductName(*in.Name))
+	p.SetPrice(newProductPrice(*in.Price))
+	p.SetCategory(category)
+	p.SetTranslations(in.Translations)
+	if in.Currency != nil {
+		p.SetCurrency(*in.Currency)
+	}
+	if in.TaxClass != nil {
+		taxClass, err := parseTaxClass(*in.TaxClass)
+		if err != nil {
+			return nil, err
+		}
+		p.SetTaxClass(taxClass)
+	}
+	if in.Barcode != nil {
+		p.SetBarcode(*in.Barcode)
+	}
+	if in.Slug != nil {
+		p.SetSlug(*in.Slug)
+	}
+
+	req := &command.CreateProductRequest{}
+	req.SetCrud(command.CRUD_CRUD_INSERT)
+	req.SetProduct(p)
+	resp, err := cl.commandProduct.CreateProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetProduct(), nil
+}
+
+// updateProduct は商品を更新します。
+// 商品名・価格・カテゴリは更新に必須のため、未指定の場合は現在の値を使用します。
+func (cl *clients) updateProduct(ctx context.Context, in *productInput) (*common.Product, error) {
+	if in.ID == "" {
+		return nil, errors.New("id is required")
+	}
+	current, err := cl.productByID(ctx, in.ID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %s: %w", in.ID, err)
+	}
+	name := current.GetName()
+	if in.Name != nil {
+		name = *in.Name
+	}
+	price := current.GetPrice()
+	if in.Price != nil {
+		price = *in.Price
+	}
+	categoryID := current.GetCategory().GetId()
+	if in.CategoryID != nil {
+		categoryID = *in.CategoryID
+	}
+
+	p := &command.UpdateProductRequest_Product{}
+	p.SetId(newProductID(in.ID))
+	p.SetName(newProductName(name))
+	p.SetPrice(newProductPrice(price))
+	p.SetCategoryId(newCategoryID(categoryID))
+	p.SetTranslations(in.Translations)
+	if in.Currency != nil {
+		p.SetCurrency(*in.Currency)
+	}
+	if in.TaxClass != nil {
+		taxClass, err := parseTaxClass(*in.TaxClass)
+		if err != nil {
+			return nil, err
+		}
+		p.SetTaxClass(taxClass)
+	}
+	if in.Barcode != nil {
+		p.SetBarcode(*in.Barcode)
+	}
+	if in.Slug != nil {
+		p.SetSlug(*in.Slug)
+	}
+
+	req := &command.UpdateProductRequest{}
+	req.SetCrud(command.CRUD_CRUD_UPDATE)
+	req.SetProduct(p)
+	resp, err := cl.commandProduct.UpdateProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetProduct(), nil
+}
+
+// deleteProduct は商品を削除します。
+func (cl *clients) deleteProduct(ctx context.Context, id string) (*common.Product, error) {
+	if id == "" {
+		return nil, errors.New("id is required")
+	}
+	req := &command.DeleteProductRequest{}
+	req.SetProductId(newProductID(id))
+	resp, err := cl.commandProduct.DeleteProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(resp.Msg.GetError()); err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetProduct(), nil
+}
+
+// parseTaxClass は税率区分の名前（大文字小文字を区別しない）をprotobufの値に変換します。
+func parseTaxClass(s string) (common.TaxClass, error) {
+	v, ok := common.TaxClass_value["TAX_CLASS_"+strings.ToUpper(s)]
+	if !ok || common.TaxClass(v) == common.TaxClass_TAX_CLASS_UNSPECIFIED {
+		return 0, fmt.Errorf("invalid tax class %q (want STANDARD or REDUCED)", s)
+	}
+	return common.TaxClass(v), nil
+}
+
+// newProductID は商品IDのprotobuf値オブジェクトを生成します。
+func newProductID(id string) *common.ProductId {
+	v := &common.ProductId{}
+	v.SetValue(id)
+	return v
+}
+
+// newProductName は商品名のprotobuf値オブジェクトを生成します。
+func newProductName(name string) *common.ProductName {
+	v := &common.ProductName{}
+	v.SetValue(name)
+	return v
+}
+
+// newProductPrice は商品単価のprotobuf値オブジェクトを生成します。
+func newProductPrice(price int32) *common.ProductPrice {
+	v := &common.ProductPrice{}
+	v.SetValue(price)
+	return v
+}
@@ -0,0 +1,173 @@
+// Package catalogctl は商品とカテゴリを操作する運用者向けのCLI（catalogctl）を提供します。
+// Command Service・Query Serviceへは生成済みのConnectクライアントで直接接続します。
+package catalogctl
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
+	"github.com/spf13/cobra"
+)
+
+// Options はCLIの入出力先です。
+type Options struct {
+	In  io.Reader // 標準入力（-f -で読み込むファイルの内容）
+	Out io.Writer // 標準出力（操作結果）
+	Err io.Writer // 標準エラー出力（一括操作で失敗した項目）
+}
+
+// cli はすべてのサブコマンドで共有するグローバルフラグの値です。
+type cli struct {
+	opts        *Options
+	configPath  string        // 設定ファイルのパス
+	contextName string        // 使用するコンテキスト名
+	commandURL  string        // Command ServiceのURL（コンテキストの値を上書き）
+	queryURL    string        // Query ServiceのURL（コンテキストの値を上書き）
+	timeout     time.Duration // リクエストタイムアウト（コンテキストの値を上書き）
+	output      string        // 出力形式
+}
+
+// clients はサブコマンドが使用するCommand Service・Query Serviceのクライアントです。
+type clients struct {
+	commandCategory cmdconnect.CategoryServiceClient
+	commandProduct  cmdconnect.ProductServiceClient
+	queryCategory   queryconnect.CategoryServiceClient
+	queryProduct    queryconnect.ProductServiceClient
+}
+
+// NewRootCommand はcatalogctlのルートコマンドを生成します。
+//
+// Parameters:
+//   - opts: CLIの入出力先
+//
+// Returns:
+//   - *cobra.Command: ルートコマンド
+func NewRootCommand(opts *Options) *cobra.Command {
+	c := &cli{opts: opts}
+	cmd := &cobra.Command{
+		Use:   "catalogctl",
+		Short: "Manage products and categories of the catalog services",
+		Long: `catalogctl manages products and categories by calling the Command Service
+and the Query Service directly.
+
+Connection settings are grouped into contexts stored in the config file
+(see "catalogctl config --help"). Without any config the "local" context
+(http://localhost:8083 and http://localhost:8085) is used.`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	cmd.SetIn(opts.In)
+	cmd.SetOut(opts.Out)
+	cmd.SetErr(opts.Err)
+
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&c.configPath, "config", DefaultConfigPath(), "path to the config file (env "+ConfigEnv+")")
+	flags.StringVar(&c.contextName, "context", "", "context to use instead of the current context")
+	flags.StringVar(&c.commandURL, "command-url", "", "Command Service URL (overrides the context)")
+	flags.StringVar(&c.queryURL, "query-url", "", "Query Service URL (overrides the context)")
+	flags.DurationVar(&c.timeout, "timeout", 0, "timeout of each request (overrides the context, default 10s)")
+	flags.StringVarP(&c.output, "output", "o", outputTable, "output format: table, json or yaml")
+
+	cmd.AddCommand(
+		newProductsCommand(c),
+		newCategoriesCommand(c),
+		newConfigCommand(c),
+	)
+	return cmd
+}
+
+// endpoint はコンテキストとグローバルフラグから接続先を決定します。
+//
+// Returns:
+//   - *Context: 接続先
+//   - error: 設定ファイルの読み込みエラーまたはコンテキストが存在しない場合のエラー
+func (c *cli) endpoint() (*Context, error) {
+	cfg, err := LoadConfig(c.configPath)
+	if err != nil {
+		return nil, err
+	}
+	_, resolved, err := cfg.Resolve(c.contextName)
+	if err != nil {
+		return nil, err
+	}
+	ep := *resolved
+	if c.commandURL != "" {
+		ep.CommandURL = c.commandURL
+	}
+	if c.queryURL != "" {
+		ep.QueryURL = c.queryURL
+	}
+	if c.timeout > 0 {
+		ep.Timeout = c.timeout.String()
+	}
+	return &ep, nil
+}
+
+// clients は接続先のクライアントを生成します。
+//
+// Returns:
+//   - *clients: クライアント
+//   - error: 接続先を決定できない場合のエラー
+func (c *cli) clients() (*clients, error) {
+	ep, err := c.endpoint()
+	if err != nil {
+		return nil, err
+	}
+	client := newHTTPClient(ep.RequestTimeout())
+	return &clients{
+		commandCategory: cmdconnect.NewCategoryServiceClient(client, ep.CommandURL, connect.WithGRPC()),
+		commandProduct:  cmdconnect.NewProductServiceClient(client, ep.CommandURL, connect.WithGRPC()),
+		queryCategory:   queryconnect.NewCategoryServiceClient(client, ep.QueryURL, connect.WithGRPC()),
+		queryProduct:    queryconnect.NewProductServiceClient(client, ep.QueryURL, connect.WithGRPC()),
+	}, nil
+}
+
+// printer は--outputで指定された形式の出力先を生成します。
+//
+// Returns:
+//   - *printer: 出力先
+//   - error: 出力形式が不正な場合のエラー
+func (c *cli) printer() (*printer, error) {
+	return newPrinter(c.output, c.opts.Out)
+}
+
+// newHTTPClient はgRPCで接続するHTTPクライアントを生成します。
+// 各サービスはh2cで待ち受けるため、平文の場合もHTTP/2で接続します。
+//
+// Parameters:
+//   - timeout: 1リクエストあたりのタイムアウト（一括操作では項目ごとに適用）
+//
+// Returns:
+//   - *http.Client: HTTPクライアント
+func newHTTPClient(timeout time.Duration) *http.Client {
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	protocols := new(http.Protocols)
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+	tr.Protocols = protocols
+	return &http.Client{Transport: tr, Timeout: timeout}
+}
+
+// responseError はレスポンスに含まれる操作エラーをerrorに変換します。
+//
+// Parameters:
+//   - e: レスポンスの操作エラー（エラーがない場合はnil）
+//
+// Returns:
+//   - error: 操作エラー（エラーがない場合はnil）
+func responseError(e *common.Error) error {
+	if e == nil {
+		return nil
+	}
+	if e.GetType() == "" {
+		return errors.New(e.GetMessage())
+	}
+	return fmt.Errorf("%s: %s", e.GetType(), e.GetMessage())
+}
@@ -2,8 +2,11 @@ package models
 
 // カテゴリエンティティ
 type Category struct {
-	id   string
-	name string
+	id           string
+	name         string
+	translations map[string]string // ロケールごとの翻訳名
+	locale       string            // nameのロケール（問合せサービスから取得した場合のみ設定）
+	slug         string            // URLに使用するスラッグ（カテゴリの更新でエンプティの場合は現在のスラッグを維持）
 }
 
 // NewCategory はCategoryを生成します。
@@ -33,3 +36,66 @@ func (c *Category) Id() string {
 func (c *Category) Name() string {
 	return c.name
 }
+
+// WithTranslations は翻訳名を設定したカテゴリのコピーを返します。
+//
+// Parameters:
+//   - translations: ロケールごとの翻訳名
+//
+// Returns:
+//   - *Category: 翻訳名を設定したCategoryポインタ
+func (c *Category) WithTranslations(translations map[string]string) *Category {
+	copied := *c
+	copied.translations = translations
+	return &copied
+}
+
+// Translations はロケールごとの翻訳名を返します。
+//
+// Returns:
+//   - map[string]string: 翻訳名
+func (c *Category) Translations() map[string]string {
+	return c.translations
+}
+
+// WithLocale はカテゴリ名のロケールを設定したカテゴリのコピーを返します。
+//
+// Parameters:
+//   - locale: ロケール
+//
+// Returns:
+//   - *Category: ロケールを設定したCategoryポインタ
+func (c *Category) WithLocale(locale string) *Category {
+	copied := *c
+	copied.locale = locale
+	return &copied
+}
+
+// Locale はカテゴリ名のロケールを返します。
+//
+// Returns:
+//   - string: ロケール
+func (c *Category) Locale() string {
+	return c.locale
+}
+
+// WithSlug はスラッグを設定したカテゴリのコピーを返します。
+//
+// Parameters:
+//   - slug: URLに使用するスラッグ
+//
+// Returns:
+//   - *Category: スラッグを設定したCategoryポインタ
+func (c *Category) WithSlug(slug string) *Category {
+	copied := *c
+	copied.slug = slug
+	return &copied
+}
+
+// Slug はURLに使用するスラッグを返します。
+//
+// Returns:
+//   - string: スラッグ
+func (c *Category) Slug() string {
+	return c.slug
+}
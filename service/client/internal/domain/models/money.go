@@ -0,0 +1,35 @@
+package models
+
+// Money は金額を表す値オブジェクト
+type Money struct {
+	amount   int64  // 通貨の最小単位での金額
+	currency string // 通貨コード（ISO 4217）
+}
+
+// NewMoney はMoneyを生成します。
+//
+// Parameters:
+//   - amount: 通貨の最小単位での金額
+//   - currency: 通貨コード
+//
+// Returns:
+//   - *Money: Moneyポインタ
+func NewMoney(amount int64, currency string) *Money {
+	return &Money{amount: amount, currency: currency}
+}
+
+// Amount は通貨の最小単位での金額を返します。
+//
+// Returns:
+//   - int64: 金額
+func (m *Money) Amount() int64 {
+	return m.amount
+}
+
+// Currency は通貨コードを返します。
+//
+// Returns:
+//   - string: 通貨コード
+func (m *Money) Currency() string {
+	return m.currency
+}
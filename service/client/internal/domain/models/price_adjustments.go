@@ -0,0 +1,152 @@
+package models
+
+// PriceAdjustment はカテゴリ単位で商品の単価を一括変更する規則
+type PriceAdjustment struct {
+	adjustmentType string  // 変更の方法（PERCENT / FIXED）
+	value          int32   // 変更量（PERCENTの場合は%、FIXEDの場合は金額）
+	rounding       string  // 端数処理の方法（FLOOR / HALF_UP / CEIL、エンプティの場合はFLOOR）
+	roundingUnit   uint32  // 端数処理の単位（0の場合は1）
+	minPrice       *uint32 // 変更後の単価の下限（nilの場合は下限なし）
+	maxPrice       *uint32 // 変更後の単価の上限（nilの場合は上限なし）
+}
+
+// NewPriceAdjustment はPriceAdjustmentを生成します。
+//
+// Parameters:
+//   - adjustmentType: 変更の方法（PERCENT / FIXED）
+//   - value: 変更量（PERCENTの場合は%、FIXEDの場合は金額）
+//   - rounding: 端数処理の方法（エンプティの場合はFLOOR）
+//   - roundingUnit: 端数処理の単位（0の場合は1）
+//   - minPrice: 変更後の単価の下限（nilの場合は下限なし）
+//   - maxPrice: 変更後の単価の上限（nilの場合は上限なし）
+//
+// Returns:
+//   - *PriceAdjustment: PriceAdjustmentポインタ
+func NewPriceAdjustment(adjustmentType string, value int32, rounding string, roundingUnit uint32, minPrice *uint32, maxPrice *uint32) *PriceAdjustment {
+	return &PriceAdjustment{
+		adjustmentType: adjustmentType,
+		value:          value,
+		rounding:       rounding,
+		roundingUnit:   roundingUnit,
+		minPrice:       minPrice,
+		maxPrice:       maxPrice,
+	}
+}
+
+// AdjustmentType は変更の方法を返します。
+//
+// Returns:
+//   - string: 変更の方法（PERCENT / FIXED）
+func (a *PriceAdjustment) AdjustmentType() string {
+	return a.adjustmentType
+}
+
+// Value は変更量を返します。
+//
+// Returns:
+//   - int32: 変更量
+func (a *PriceAdjustment) Value() int32 {
+	return a.value
+}
+
+// Rounding は端数処理の方法を返します。
+//
+// Returns:
+//   - string: 端数処理の方法（エンプティの場合はFLOOR）
+func (a *PriceAdjustment) Rounding() string {
+	return a.rounding
+}
+
+// RoundingUnit は端数処理の単位を返します。
+//
+// Returns:
+//   - uint32: 端数処理の単位（0の場合は1）
+func (a *PriceAdjustment) RoundingUnit() uint32 {
+	return a.roundingUnit
+}
+
+// MinPrice は変更後の単価の下限を返します。
+//
+// Returns:
+//   - *uint32: 変更後の単価の下限（nilの場合は下限なし）
+func (a *PriceAdjustment) MinPrice() *uint32 {
+	return a.minPrice
+}
+
+// MaxPrice は変更後の単価の上限を返します。
+//
+// Returns:
+//   - *uint32: 変更後の単価の上限（nilの場合は上限なし）
+func (a *PriceAdjustment) MaxPrice() *uint32 {
+	return a.maxPrice
+}
+
+// PriceChange は単価の一括変更による商品ごとの変更前後の単価
+type PriceChange struct {
+	productId   string // 商品ID
+	productName string // 商品名
+	currency    string // 通貨コード
+	beforePrice uint32 // 変更前の税抜の単価
+	afterPrice  uint32 // 変更後の税抜の単価
+}
+
+// NewPriceChange はPriceChangeを生成します。
+//
+// Parameters:
+//   - productId: 商品ID
+//   - productName: 商品名
+//   - currency: 通貨コード
+//   - beforePrice: 変更前の税抜の単価
+//   - afterPrice: 変更後の税抜の単価
+//
+// Returns:
+//   - *PriceChange: PriceChangeポインタ
+func NewPriceChange(productId string, productName string, currency string, beforePrice uint32, afterPrice uint32) *PriceChange {
+	return &PriceChange{
+		productId:   productId,
+		productName: productName,
+		currency:    currency,
+		beforePrice: beforePrice,
+		afterPrice:  afterPrice,
+	}
+}
+
+// ProductId は商品IDを返します。
+//
+// Returns:
+//   - string: 商品ID
+func (c *PriceChange) ProductId() string {
+	return c.productId
+}
+
+// ProductName は商品名を返します。
+//
+// Returns:
+//   - string: 商品名
+func (c *PriceChange) ProductName() string {
+	return c.productName
+}
+
+// Currency は通貨コードを返します。
+//
+// Returns:
+//   - string: 通貨コード
+func (c *PriceChange) Currency() string {
+	return c.currency
+}
+
+// BeforePrice は変更前の税抜の単価を返します。
+//
+// Returns:
+//   - uint32: 変更前の税抜の単価
+func (c *PriceChange) BeforePrice() uint32 {
+	return c.beforePrice
+}
+
+// AfterPrice は変更後の税抜の単価を返します。
+//
+// Returns:
+//   - uint32: 変更後の税抜の単価
+func (c *PriceChange) AfterPrice() uint32 {
+	return c.afterPrice
+}
@@ -0,0 +1,95 @@
+package models
+
+import "time"
+
+// PriceSchedule は期間を指定した価格変更（セール価格など）のエンティティ
+type PriceSchedule struct {
+	id           string    // 価格スケジュールID
+	productId    string    // 商品ID
+	price        uint32    // 期間中の単価
+	regularPrice *uint32   // 期間開始前の単価（適用前はnil）
+	status       string    // 状態（SCHEDULED / ACTIVE / COMPLETED / CANCELLED）
+	startsAt     time.Time // 開始時刻
+	endsAt       time.Time // 終了時刻
+}
+
+// NewPriceSchedule はPriceScheduleを生成します。
+//
+// Parameters:
+//   - id: 価格スケジュールID（登録前はエンプティ）
+//   - productId: 商品ID
+//   - price: 期間中の単価
+//   - regularPrice: 期間開始前の単価（適用前はnil）
+//   - status: 状態（登録前はエンプティ）
+//   - startsAt: 開始時刻
+//   - endsAt: 終了時刻
+//
+// Returns:
+//   - *PriceSchedule: PriceScheduleポインタ
+func NewPriceSchedule(id string, productId string, price uint32, regularPrice *uint32, status string, startsAt time.Time, endsAt time.Time) *PriceSchedule {
+	return &PriceSchedule{
+		id:           id,
+		productId:    productId,
+		price:        price,
+		regularPrice: regularPrice,
+		status:       status,
+		startsAt:     startsAt,
+		endsAt:       endsAt,
+	}
+}
+
+// Id は価格スケジュールIDを返します。
+//
+// Returns:
+//   - string: 価格スケジュールID
+func (s *PriceSchedule) Id() string {
+	return s.id
+}
+
+// ProductId は商品IDを返します。
+//
+// Returns:
+//   - string: 商品ID
+func (s *PriceSchedule) ProductId() string {
+	return s.productId
+}
+
+// Price は期間中の単価を返します。
+//
+// Returns:
+//   - uint32: 期間中の単価
+func (s *PriceSchedule) Price() uint32 {
+	return s.price
+}
+
+// RegularPrice は期間開始前の単価を返します。
+//
+// Returns:
+//   - *uint32: 期間開始前の単価（適用前はnil）
+func (s *PriceSchedule) RegularPrice() *uint32 {
+	return s.regularPrice
+}
+
+// Status は状態を返します。
+//
+// Returns:
+//   - string: 状態（SCHEDULED / ACTIVE / COMPLETED / CANCELLED）
+func (s *PriceSchedule) Status() string {
+	return s.status
+}
+
+// StartsAt は開始時刻を返します。
+//
+// Returns:
+//   - time.Time: 開始時刻
+func (s *PriceSchedule) StartsAt() time.Time {
+	return s.startsAt
+}
+
+// EndsAt は終了時刻を返します。
+//
+// Returns:
+//   - time.Time: 終了時刻
+func (s *PriceSchedule) EndsAt() time.Time {
+	return s.endsAt
+}
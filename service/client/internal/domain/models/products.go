@@ -2,10 +2,22 @@ package models
 
 // Product は商品エンティティ
 type Product struct {
-	id       string    // 商品ID
-	name     string    // 商品名
-	price    uint32    // 価格
-	category *Category // カテゴリ
+	id                string            // 商品ID
+	name              string            // 商品名
+	price             uint32            // 税抜の価格（通貨の最小単位）
+	currency          string            // 通貨コード（エンプティの場合はサービスのデフォルト）
+	taxClass          string            // 税率区分（エンプティの場合はサービスのデフォルト）
+	priceExcludingTax *Money            // 税抜価格
+	priceIncludingTax *Money            // 税込価格（問合せサービスから取得した場合のみ設定）
+	category          *Category         // カテゴリ
+	variants          []*Variant        // バリエーション（商品の個別取得時のみ設定）
+	tags              []*Tag            // タグ
+	translations      map[string]string // ロケールごとの翻訳名
+	locale            string            // nameのロケール（問合せサービスから取得した場合のみ設定）
+	status            string            // 販売状態（DRAFT, PUBLISHED, SUSPENDED, DISCONTINUED）
+	priceSchedules    []*PriceSchedule  // 適用中・適用予定の価格スケジュール（商品の個別取得時のみ設定）
+	barcode           *string           // JAN/EANバーコード（nilの場合は未登録、商品の更新では現在のバーコードを維持）
+	slug              string            // URLに使用するスラッグ（商品の作成でエンプティの場合は商品名から生成、更新では現在のスラッグを維持）
 }
 
 // NewProduct はProductを生成します。
@@ -41,11 +53,73 @@ func (p *Product) Name() string {
 // Price は価格を返します。
 //
 // Returns:
-//   - uint32: 価格
+//   - uint32: 税抜の価格（通貨の最小単位）
 func (p *Product) Price() uint32 {
 	return p.price
 }
 
+// WithTax は通貨と税率区分を設定した商品のコピーを返します。
+//
+// Parameters:
+//   - currency: 通貨コード
+//   - taxClass: 税率区分（STANDARD / REDUCED）
+//
+// Returns:
+//   - *Product: 通貨と税率区分を設定したProductポインタ
+func (p *Product) WithTax(currency string, taxClass string) *Product {
+	copied := *p
+	copied.currency = currency
+	copied.taxClass = taxClass
+	return &copied
+}
+
+// Currency は通貨コードを返します。
+//
+// Returns:
+//   - string: 通貨コード
+func (p *Product) Currency() string {
+	return p.currency
+}
+
+// TaxClass は税率区分を返します。
+//
+// Returns:
+//   - string: 税率区分
+func (p *Product) TaxClass() string {
+	return p.taxClass
+}
+
+// WithPrices は税抜価格と税込価格を設定した商品のコピーを返します。
+//
+// Parameters:
+//   - excludingTax: 税抜価格
+//   - includingTax: 税込価格（未計算の場合はnil）
+//
+// Returns:
+//   - *Product: 価格を設定したProductポインタ
+func (p *Product) WithPrices(excludingTax *Money, includingTax *Money) *Product {
+	copied := *p
+	copied.priceExcludingTax = excludingTax
+	copied.priceIncludingTax = includingTax
+	return &copied
+}
+
+// PriceExcludingTax は税抜価格を返します。
+//
+// Returns:
+//   - *Money: 税抜価格
+func (p *Product) PriceExcludingTax() *Money {
+	return p.priceExcludingTax
+}
+
+// PriceIncludingTax は税込価格を返します。
+//
+// Returns:
+//   - *Money: 税込価格（問合せサービスから取得した場合のみ設定）
+func (p *Product) PriceIncludingTax() *Money {
+	return p.priceIncludingTax
+}
+
 // Category はカテゴリを返します。
 //
 // Returns:
@@ -53,3 +127,171 @@ func (p *Product) Price() uint32 {
 func (p *Product) Category() *Category {
 	return p.category
 }
+
+// WithVariants はバリエーションを設定した商品のコピーを返します。
+//
+// Parameters:
+//   - variants: バリエーション
+//
+// Returns:
+//   - *Product: バリエーションを設定したProductポインタ
+func (p *Product) WithVariants(variants []*Variant) *Product {
+	copied := *p
+	copied.variants = variants
+	return &copied
+}
+
+// Variants はバリエーションを返します。
+//
+// Returns:
+//   - []*Variant: バリエーション
+func (p *Product) Variants() []*Variant {
+	return p.variants
+}
+
+// WithTags はタグを設定した商品のコピーを返します。
+//
+// Parameters:
+//   - tags: タグ
+//
+// Returns:
+//   - *Product: タグを設定したProductポインタ
+func (p *Product) WithTags(tags []*Tag) *Product {
+	copied := *p
+	copied.tags = tags
+	return &copied
+}
+
+// Tags はタグを返します。
+//
+// Returns:
+//   - []*Tag: タグ
+func (p *Product) Tags() []*Tag {
+	return p.tags
+}
+
+// WithTranslations は翻訳名を設定した商品のコピーを返します。
+//
+// Parameters:
+//   - translations: ロケールごとの翻訳名
+//
+// Returns:
+//   - *Product: 翻訳名を設定したProductポインタ
+func (p *Product) WithTranslations(translations map[string]string) *Product {
+	copied := *p
+	copied.translations = translations
+	return &copied
+}
+
+// Translations はロケールごとの翻訳名を返します。
+//
+// Returns:
+//   - map[string]string: 翻訳名
+func (p *Product) Translations() map[string]string {
+	return p.translations
+}
+
+// WithLocale は商品名のロケールを設定した商品のコピーを返します。
+//
+// Parameters:
+//   - locale: ロケール
+//
+// Returns:
+//   - *Product: ロケールを設定したProductポインタ
+func (p *Product) WithLocale(locale string) *Product {
+	copied := *p
+	copied.locale = locale
+	return &copied
+}
+
+// Locale は商品名のロケールを返します。
+//
+// Returns:
+//   - string: ロケール
+func (p *Product) Locale() string {
+	return p.locale
+}
+
+// WithStatus は販売状態を設定した商品のコピーを返します。
+//
+// Parameters:
+//   - status: 販売状態
+//
+// Returns:
+//   - *Product: 販売状態を設定したProductポインタ
+func (p *Product) WithStatus(status string) *Product {
+	copied := *p
+	copied.status = status
+	return &copied
+}
+
+// Status は商品の販売状態を返します。
+//
+// Returns:
+//   - string: 販売状態（不明な場合はエンプティ）
+func (p *Product) Status() string {
+	return p.status
+}
+
+// WithPriceSchedules は価格スケジュールを設定した商品のコピーを返します。
+//
+// Parameters:
+//   - schedules: 適用中・適用予定の価格スケジュール
+//
+// Returns:
+//   - *Product: 価格スケジュールを設定したProductポインタ
+func (p *Product) WithPriceSchedules(schedules []*PriceSchedule) *Product {
+	copied := *p
+	copied.priceSchedules = schedules
+	return &copied
+}
+
+// PriceSchedules は適用中・適用予定の価格スケジュールを返します。
+//
+// Returns:
+//   - []*PriceSchedule: 価格スケジュール
+func (p *Product) PriceSchedules() []*PriceSchedule {
+	return p.priceSchedules
+}
+
+// WithBarcode はバーコードを設定した商品のコピーを返します。
+//
+// Parameters:
+//   - barcode: JAN/EAN/UPCバーコード（nilの場合は未指定、商品の更新でエンプティの場合はバーコードを削除）
+//
+// Returns:
+//   - *Product: バーコードを設定したProductポインタ
+func (p *Product) WithBarcode(barcode *string) *Product {
+	copied := *p
+	copied.barcode = barcode
+	return &copied
+}
+
+// Barcode はバーコードを返します。
+//
+// Returns:
+//   - *string: JAN/EANバーコード（未登録の場合はnil）
+func (p *Product) Barcode() *string {
+	return p.barcode
+}
+
+// WithSlug はスラッグを設定した商品のコピーを返します。
+//
+// Parameters:
+//   - slug: URLに使用するスラッグ
+//
+// Returns:
+//   - *Product: スラッグを設定したProductポインタ
+func (p *Product) WithSlug(slug string) *Product {
+	copied := *p
+	copied.slug = slug
+	return &copied
+}
+
+// Slug はURLに使用するスラッグを返します。
+//
+// Returns:
+//   - string: スラッグ
+func (p *Product) Slug() string {
+	return p.slug
+}
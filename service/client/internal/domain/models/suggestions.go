@@ -0,0 +1,65 @@
+package models
+
+// ProductSuggestion は入力中の検索語に対する商品サジェスト
+type ProductSuggestion struct {
+	productId string    // 商品ID
+	name      string    // 商品名
+	highlight string    // 一致箇所を強調した商品名
+	category  *Category // カテゴリ
+	score     float64   // 関連度スコア
+}
+
+// NewProductSuggestion はProductSuggestionを生成します。
+//
+// Parameters:
+//   - productId: 商品ID
+//   - name: 商品名
+//   - highlight: 一致箇所を強調した商品名
+//   - category: カテゴリ
+//   - score: 関連度スコア
+//
+// Returns:
+//   - *ProductSuggestion: ProductSuggestionポインタ
+func NewProductSuggestion(productId string, name string, highlight string, category *Category, score float64) *ProductSuggestion {
+	return &ProductSuggestion{productId: productId, name: name, highlight: highlight, category: category, score: score}
+}
+
+// ProductId は商品IDを返します。
+//
+// Returns:
+//   - string: 商品ID
+func (s *ProductSuggestion) ProductId() string {
+	return s.productId
+}
+
+// Name は商品名を返します。
+//
+// Returns:
+//   - string: 商品名
+func (s *ProductSuggestion) Name() string {
+	return s.name
+}
+
+// Highlight は一致箇所を強調した商品名を返します。
+//
+// Returns:
+//   - string: 一致箇所を強調した商品名
+func (s *ProductSuggestion) Highlight() string {
+	return s.highlight
+}
+
+// Category はカテゴリを返します。
+//
+// Returns:
+//   - *Category: Categoryポインタ
+func (s *ProductSuggestion) Category() *Category {
+	return s.category
+}
+
+// Score は関連度スコアを返します。
+//
+// Returns:
+//   - float64: 関連度スコア
+func (s *ProductSuggestion) Score() float64 {
+	return s.score
+}
@@ -0,0 +1,103 @@
+package models
+
+// Tag はタグエンティティ
+type Tag struct {
+	id   string // タグID
+	name string // タグ名
+}
+
+// NewTag はTagを生成します。
+//
+// Parameters:
+//   - id: タグID
+//   - name: タグ名
+//
+// Returns:
+//   - *Tag: Tagポインタ
+func NewTag(id string, name string) *Tag {
+	return &Tag{id: id, name: name}
+}
+
+// Id はタグIDを返します。
+//
+// Returns:
+//   - string: タグID
+func (t *Tag) Id() string {
+	return t.id
+}
+
+// Name はタグ名を返します。
+//
+// Returns:
+//   - string: タグ名
+func (t *Tag) Name() string {
+	return t.name
+}
+
+// TagUsage はタグと付与された商品数
+type TagUsage struct {
+	tag          *Tag // タグ
+	productCount int  // タグが付与された商品数
+}
+
+// NewTagUsage はTagUsageを生成します。
+//
+// Parameters:
+//   - tag: タグ
+//   - productCount: タグが付与された商品数
+//
+// Returns:
+//   - *TagUsage: TagUsageポインタ
+func NewTagUsage(tag *Tag, productCount int) *TagUsage {
+	return &TagUsage{tag: tag, productCount: productCount}
+}
+
+// Tag はタグを返します。
+//
+// Returns:
+//   - *Tag: Tagポインタ
+func (u *TagUsage) Tag() *Tag {
+	return u.tag
+}
+
+// ProductCount はタグが付与された商品数を返します。
+//
+// Returns:
+//   - int: タグが付与された商品数
+func (u *TagUsage) ProductCount() int {
+	return u.productCount
+}
+
+// TagChange はタグの一括付与・解除の結果
+type TagChange struct {
+	tags    []*Tag // 対象のタグ
+	changed int    // 付与・解除された商品とタグの組み合わせ数
+}
+
+// NewTagChange はTagChangeを生成します。
+//
+// Parameters:
+//   - tags: 対象のタグ
+//   - changed: 付与・解除された商品とタグの組み合わせ数
+//
+// Returns:
+//   - *TagChange: TagChangeポインタ
+func NewTagChange(tags []*Tag, changed int) *TagChange {
+	return &TagChange{tags: tags, changed: changed}
+}
+
+// Tags は対象のタグを返します。
+//
+// Returns:
+//   - []*Tag: 対象のタグ
+func (c *TagChange) Tags() []*Tag {
+	return c.tags
+}
+
+// Changed は付与・解除された商品とタグの組み合わせ数を返します。
+//
+// Returns:
+//   - int: 付与・解除された組み合わせ数（既に付与済み・未付与の組み合わせは含まない）
+func (c *TagChange) Changed() int {
+	return c.changed
+}
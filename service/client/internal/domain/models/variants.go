@@ -0,0 +1,109 @@
+package models
+
+// VariantOption は商品バリエーションの選択肢（例: サイズ=M）
+type VariantOption struct {
+	axis  string // 選択肢の軸
+	value string // 選択肢の値
+}
+
+// NewVariantOption はVariantOptionを生成します。
+//
+// Parameters:
+//   - axis: 選択肢の軸
+//   - value: 選択肢の値
+//
+// Returns:
+//   - *VariantOption: VariantOptionポインタ
+func NewVariantOption(axis string, value string) *VariantOption {
+	return &VariantOption{axis: axis, value: value}
+}
+
+// Axis は選択肢の軸を返します。
+//
+// Returns:
+//   - string: 選択肢の軸
+func (o *VariantOption) Axis() string {
+	return o.axis
+}
+
+// Value は選択肢の値を返します。
+//
+// Returns:
+//   - string: 選択肢の値
+func (o *VariantOption) Value() string {
+	return o.value
+}
+
+// Variant は商品バリエーションエンティティ
+type Variant struct {
+	id            string           // バリエーションID
+	sku           string           // SKUコード
+	options       []*VariantOption // 選択肢の組み合わせ
+	priceOverride *uint32          // 価格の上書き（nilの場合は商品の単価）
+	price         uint32           // 販売価格
+	status        string           // 販売状態（ACTIVE / INACTIVE）
+}
+
+// NewVariant はVariantを生成します。
+//
+// Parameters:
+//   - id: バリエーションID（追加前はエンプティ）
+//   - sku: SKUコード
+//   - options: 選択肢の組み合わせ
+//   - priceOverride: 価格の上書き（nilの場合は商品の単価）
+//   - price: 販売価格（レスポンスから生成する場合のみ設定）
+//   - status: 販売状態（ACTIVE / INACTIVE、エンプティの場合はACTIVE）
+//
+// Returns:
+//   - *Variant: Variantポインタ
+func NewVariant(id string, sku string, options []*VariantOption, priceOverride *uint32, price uint32, status string) *Variant {
+	return &Variant{id: id, sku: sku, options: options, priceOverride: priceOverride, price: price, status: status}
+}
+
+// Id はバリエーションIDを返します。
+//
+// Returns:
+//   - string: バリエーションID
+func (v *Variant) Id() string {
+	return v.id
+}
+
+// Sku はSKUコードを返します。
+//
+// Returns:
+//   - string: SKUコード
+func (v *Variant) Sku() string {
+	return v.sku
+}
+
+// Options は選択肢の組み合わせを返します。
+//
+// Returns:
+//   - []*VariantOption: 選択肢の組み合わせ
+func (v *Variant) Options() []*VariantOption {
+	return v.options
+}
+
+// PriceOverride は価格の上書きを返します。
+//
+// Returns:
+//   - *uint32: 価格の上書き（nilの場合は商品の単価）
+func (v *Variant) PriceOverride() *uint32 {
+	return v.priceOverride
+}
+
+// Price は販売価格を返します。
+//
+// Returns:
+//   - uint32: 販売価格
+func (v *Variant) Price() uint32 {
+	return v.price
+}
+
+// Status は販売状態を返します。
+//
+// Returns:
+//   - string: 販売状態（ACTIVE / INACTIVE）
+func (v *Variant) Status() string {
+	return v.status
+}
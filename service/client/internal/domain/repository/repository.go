@@ -11,13 +11,33 @@ type StreamProductsResult struct {
 	Err     error
 }
 
+// SuggestProductsQuery はサジェストの問合せ
+type SuggestProductsQuery struct {
+	Prefix string // 入力中の検索語
+	Limit  int32  // 最大件数（0の場合はサーバーのデフォルト値）
+}
+
+// SuggestProductsResult はサジェストの受信結果
+type SuggestProductsResult struct {
+	Prefix      string                      // 対応する検索語
+	Suggestions []*models.ProductSuggestion // 関連度順のサジェスト
+	Err         error
+}
+
+// PriceAdjustmentTarget は単価の一括変更の対象の商品の条件
+type PriceAdjustmentTarget struct {
+	CategoryId         string   // 対象の商品が属するカテゴリID
+	IncludeDescendants bool     // 子孫カテゴリの商品を含めるかどうか
+	Tags               []string // 指定したすべてのタグが付与された商品に絞り込む（空の場合は絞り込まない）
+}
+
 // CQRSRepository はCQRSパターンに基づくリポジトリインターフェース
 // Command ServiceとQuery Serviceへの書き込み・読み取り操作を提供します。
 //
 //go:generate go tool mockgen -source=$GOFILE -destination=../../mock/repository/repository_mock.go -package=mock_repository
 type CQRSRepository interface {
-	// CreateCategory はカテゴリを作成します。
-	CreateCategory(ctx context.Context, categoryName string) (*models.Category, error)
+	// CreateCategory はカテゴリを作成します。slugがエンプティの場合はカテゴリ名から生成します。
+	CreateCategory(ctx context.Context, categoryName string, slug string) (*models.Category, error)
 	// UpdateCategory はカテゴリを更新します。
 	UpdateCategory(ctx context.Context, category *models.Category) (*models.Category, error)
 	// DeleteCategory はカテゴリを削除します。
@@ -26,19 +46,58 @@ type CQRSRepository interface {
 	CategoryList(ctx context.Context) ([]*models.Category, error)
 	// CategoryById はIDでカテゴリを取得します。
 	CategoryById(ctx context.Context, id string) (*models.Category, error)
+	// CategoryBySlug はスラッグでカテゴリを取得します。変更前のスラッグの場合はmovedがtrueになります。
+	CategoryBySlug(ctx context.Context, slug string) (category *models.Category, moved bool, err error)
 
 	// CreateProduct は商品を作成します。
-	CreateProduct(ctx context.Context, productName string, productPrice uint32, category *models.Category) (*models.Product, error)
+	CreateProduct(ctx context.Context, product *models.Product) (*models.Product, error)
 	// UpdateProduct は商品を更新します。
 	UpdateProduct(ctx context.Context, product *models.Product) (*models.Product, error)
 	// DeleteProduct は商品を削除します。
 	DeleteProduct(ctx context.Context, id string) error
 	// ProductList は商品一覧を取得します。
 	ProductList(ctx context.Context) ([]*models.Product, error)
+	// ProductListByTags は指定されたすべてのタグが付与された商品一覧を取得します。
+	ProductListByTags(ctx context.Context, tags []string) ([]*models.Product, error)
 	// StreamProducts は商品一覧をストリーミングで取得します。
 	StreamProducts(ctx context.Context) (<-chan *StreamProductsResult, error)
-	// ProductById はIDで商品を取得します。
+	// SuggestProducts は双方向ストリーミングで入力中の検索語に対するサジェストを取得します。
+	// queriesをcloseするとストリームを終了します。
+	SuggestProducts(ctx context.Context, queries <-chan *SuggestProductsQuery) (<-chan *SuggestProductsResult, error)
+	// ProductById はIDで公開中の商品を取得します。
 	ProductById(ctx context.Context, id string) (*models.Product, error)
+	// ProductByBarcode はJAN/EAN/UPCバーコードで公開中の商品を取得します。
+	ProductByBarcode(ctx context.Context, barcode string) (*models.Product, error)
+	// ProductBySlug はスラッグで公開中の商品を取得します。変更前のスラッグの場合はmovedがtrueになります。
+	ProductBySlug(ctx context.Context, slug string) (product *models.Product, moved bool, err error)
 	// ProductByKeyword はキーワードで商品を検索します。
 	ProductByKeyword(ctx context.Context, keyword string) ([]*models.Product, error)
+
+	// AddVariant は商品にバリエーションを追加します。
+	AddVariant(ctx context.Context, productId string, variant *models.Variant) (*models.Variant, error)
+	// UpdateVariant は商品のバリエーションを更新します。
+	UpdateVariant(ctx context.Context, productId string, variant *models.Variant) (*models.Variant, error)
+	// RemoveVariant は商品のバリエーションを削除します。
+	RemoveVariant(ctx context.Context, productId string, variantId string) error
+
+	// PublishProduct は商品を公開します。
+	PublishProduct(ctx context.Context, productId string) (*models.Product, error)
+	// SuspendProduct は公開中の商品を一時停止します。
+	SuspendProduct(ctx context.Context, productId string) (*models.Product, error)
+	// DiscontinueProduct は商品を販売終了にします。
+	DiscontinueProduct(ctx context.Context, productId string) (*models.Product, error)
+
+	// SchedulePrice は商品に期間を指定した価格変更を登録します。
+	SchedulePrice(ctx context.Context, schedule *models.PriceSchedule) (*models.PriceSchedule, error)
+	// CancelPriceSchedule は商品の価格スケジュールを取り消します。適用中の場合は元の単価に戻します。
+	CancelPriceSchedule(ctx context.Context, productId string, scheduleId string) (*models.PriceSchedule, error)
+	// AdjustPrices は条件に一致する商品の単価を一括で変更します。previewの場合は変更せずに変更前後の単価のみを返します。
+	AdjustPrices(ctx context.Context, target *PriceAdjustmentTarget, adjustment *models.PriceAdjustment, preview bool) ([]*models.PriceChange, error)
+
+	// TagList はタグ一覧を付与された商品数とともに取得します。
+	TagList(ctx context.Context) ([]*models.TagUsage, error)
+	// AttachTags は複数の商品に複数のタグを一括で付与します。存在しないタグは作成されます。
+	AttachTags(ctx context.Context, productIds []string, tagNames []string) (*models.TagChange, error)
+	// DetachTags は複数の商品から複数のタグを一括で解除します。
+	DetachTags(ctx context.Context, productIds []string, tagNames []string) (*models.TagChange, error)
 }
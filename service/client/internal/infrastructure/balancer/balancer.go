@@ -0,0 +1,503 @@
+// Package balancer はQuery Serviceの複数のレプリカへリクエストを振り分けるクライアントサイドのロードバランサーです。
+//
+// 接続先は静的なURL（http://host:port）またはDNSで解決するアドレス（dns:///host:port）で指定します。
+// 振り分けはラウンドロビンまたは処理中のリクエストが最も少ない接続先を選ぶ方式から選べます。
+// gRPCヘルスチェックと通信エラーが連続した接続先は振り分け対象から外し（イジェクト）、
+// ヘルスチェックが成功した時点で戻します。
+package balancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"buf.build/gen/go/grpc/grpc/connectrpc/go/grpc/health/v1/healthv1connect"
+	healthv1 "buf.build/gen/go/grpc/grpc/protocolbuffers/go/grpc/health/v1"
+	"connectrpc.com/connect"
+)
+
+// dnsScheme はDNSで接続先を解決するターゲットのスキームです。
+const dnsScheme = "dns"
+
+// ErrNoEndpoints は振り分け先の接続先が1つもないことを表します。
+var ErrNoEndpoints = errors.New("no endpoints available")
+
+// Policy は振り分け方式です。
+type Policy string
+
+const (
+	RoundRobin  Policy = "round_robin"  // 接続先を順番に選ぶ
+	LeastLoaded Policy = "least_loaded" // 処理中のリクエストが最も少ない接続先を選ぶ
+)
+
+// ParsePolicy は設定値から振り分け方式を取得します。
+//
+// Parameters:
+//   - s: 設定値（round_robin / least_loaded）
+//
+// Returns:
+//   - Policy: 振り分け方式
+//   - error: 未知の方式の場合のエラー
+func ParsePolicy(s string) (Policy, error) {
+	switch p := Policy(s); p {
+	case RoundRobin, LeastLoaded:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown balancer policy %q (want %q or %q)", s, RoundRobin, LeastLoaded)
+	}
+}
+
+// Config はロードバランサーの設定です。
+type Config struct {
+	Targets             []string      // 接続先（http(s)://host:port または dns:///host:port）
+	Policy              Policy        // 振り分け方式
+	DNSRefreshInterval  time.Duration // DNSを再解決する間隔
+	HealthCheckInterval time.Duration // ヘルスチェックの間隔（0の場合はヘルスチェックしない）
+	EjectionThreshold   int           // イジェクトする連続失敗回数（0以下の場合はイジェクトしない）
+}
+
+// target は設定された接続先です。
+type target struct {
+	raw    string   // 設定値
+	base   *url.URL // http(s)://host:port
+	dns    bool     // DNSで解決するか
+	host   string   // DNSで解決するホスト名
+	port   string   // DNSで解決した各アドレスに使うポート
+	static *endpoint
+}
+
+// parseTarget は設定された接続先を解析します。
+func parseTarget(raw string) (*target, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target %q: %w", raw, err)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		if u.Host == "" {
+			return nil, fmt.Errorf("invalid target %q: missing host", raw)
+		}
+		return &target{raw: raw, base: u}, nil
+	case dnsScheme:
+		// dns:///host:port の形式（gRPCの名前解決と同じ書式）
+		host, port, err := net.SplitHostPort(u.Path[min(1, len(u.Path)):])
+		if err != nil || host == "" || port == "" {
+			return nil, fmt.Errorf("invalid target %q: want dns:///host:port", raw)
+		}
+		base := &url.URL{Scheme: "http", Host: net.JoinHostPort(host, port)}
+		return &target{raw: raw, base: base, dns: true, host: host, port: port}, nil
+	default:
+		return nil, fmt.Errorf("invalid target %q: scheme must be http, https or dns", raw)
+	}
+}
+
+// ValidateTargets は接続先の書式を検証します。
+//
+// Parameters:
+//   - targets: 接続先
+//
+// Returns:
+//   - error: 接続先がない、または書式が不正な場合のエラー
+func ValidateTargets(targets []string) error {
+	if len(targets) == 0 {
+		return errors.New("at least one target is required")
+	}
+	for _, raw := range targets {
+		if _, err := parseTarget(raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// endpoint は振り分け先の1つの接続先です。
+type endpoint struct {
+	url       *url.URL // リクエストの送信先
+	authority string   // Hostヘッダー（DNSで解決した場合は元のホスト名）
+	health    healthv1connect.HealthClient
+	inflight  atomic.Int64 // 処理中のリクエスト数
+	failures  atomic.Int32 // 連続失敗回数
+	ejected   atomic.Bool  // 振り分け対象から外しているか
+}
+
+func newEndpoint(u *url.URL, authority string, client *http.Client) *endpoint {
+	return &endpoint{
+		url:       u,
+		authority: authority,
+		health:    healthv1connect.NewHealthClient(client, u.String(), connect.WithGRPC()),
+	}
+}
+
+// EndpointStatus は接続先の状態です。
+type EndpointStatus struct {
+	URL      string // 接続先
+	Inflight int64  // 処理中のリクエスト数
+	Ejected  bool   // 振り分け対象から外しているか
+}
+
+// Pool は振り分け先の接続先を管理します。
+// DNSの再解決とヘルスチェックはStartで開始し、Stopで停止します。
+type Pool struct {
+	cfg      Config
+	targets  []*target
+	client   *http.Client // ヘルスチェック用のHTTPクライアント（振り分けを経由しない）
+	resolver *net.Resolver
+	logger   *slog.Logger
+	next     atomic.Uint64
+
+	mu        sync.RWMutex
+	endpoints []*endpoint
+	resolved  bool // DNSを1度でも解決したか
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPool はPoolを生成します。静的な接続先はこの時点で振り分け対象になります。
+//
+// Parameters:
+//   - cfg: ロードバランサーの設定
+//   - client: ヘルスチェックに使うHTTPクライアント
+//   - logger: ロガー
+//
+// Returns:
+//   - *Pool: Pool
+//   - error: 設定が不正な場合のエラー
+func NewPool(cfg Config, client *http.Client, logger *slog.Logger) (*Pool, error) {
+	if cfg.Policy == "" {
+		cfg.Policy = RoundRobin
+	}
+	if _, err := ParsePolicy(string(cfg.Policy)); err != nil {
+		return nil, err
+	}
+	if err := ValidateTargets(cfg.Targets); err != nil {
+		return nil, err
+	}
+	p := &Pool{
+		cfg:      cfg,
+		client:   client,
+		resolver: net.DefaultResolver,
+		logger:   logger,
+	}
+	for _, raw := range cfg.Targets {
+		t, _ := parseTarget(raw)
+		if !t.dns {
+			t.static = newEndpoint(t.base, t.base.Host, client)
+		}
+		p.targets = append(p.targets, t)
+	}
+	p.endpoints = p.staticEndpoints()
+	p.resolved = !p.hasDNSTargets()
+	return p, nil
+}
+
+// BaseURL はConnectクライアントに渡すベースURLを返します。
+// 実際の送信先はTransportが振り分け時に書き換えます。
+//
+// Returns:
+//   - string: 1つ目の接続先のURL
+func (p *Pool) BaseURL() string {
+	return p.targets[0].base.String()
+}
+
+// Start はDNSの再解決とヘルスチェックを開始します。
+// DNSの接続先は開始前に1度解決し、1件も解決できない場合はエラーを返します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - error: 接続先を解決できない場合のエラー
+func (p *Pool) Start(ctx context.Context) error {
+	if p.hasDNSTargets() {
+		if err := p.refresh(ctx); err != nil {
+			return err
+		}
+	}
+	loopCtx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	if p.hasDNSTargets() && p.cfg.DNSRefreshInterval > 0 {
+		p.loop(loopCtx, p.cfg.DNSRefreshInterval, func(ctx context.Context) {
+			if err := p.refresh(ctx); err != nil {
+				p.logger.WarnContext(ctx, "Failed to resolve query endpoints; keeping previous endpoints", "error", err)
+			}
+		})
+	}
+	if p.cfg.HealthCheckInterval > 0 {
+		p.loop(loopCtx, p.cfg.HealthCheckInterval, p.checkHealth)
+	}
+	return nil
+}
+
+// Stop はDNSの再解決とヘルスチェックを停止します。
+func (p *Pool) Stop() {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.wg.Wait()
+}
+
+// Endpoints は接続先ごとの状態を返します。
+//
+// Returns:
+//   - []EndpointStatus: 接続先ごとの状態
+func (p *Pool) Endpoints() []EndpointStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	statuses := make([]EndpointStatus, 0, len(p.endpoints))
+	for _, ep := range p.endpoints {
+		statuses = append(statuses, EndpointStatus{
+			URL:      ep.url.String(),
+			Inflight: ep.inflight.Load(),
+			Ejected:  ep.ejected.Load(),
+		})
+	}
+	return statuses
+}
+
+// Transport はリクエストを接続先に振り分けるhttp.RoundTripperを返します。
+// 同じPoolから複数のTransportを生成することで、HTTP/1.1とh2cのクライアントで接続先の状態を共有できます。
+//
+// Parameters:
+//   - base: 実際にリクエストを送信するRoundTripper（nilの場合はhttp.DefaultTransport）
+//
+// Returns:
+//   - http.RoundTripper: 振り分けを行うRoundTripper
+func (p *Pool) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{pool: p, base: base}
+}
+
+func (p *Pool) hasDNSTargets() bool {
+	for _, t := range p.targets {
+		if t.dns {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Pool) staticEndpoints() []*endpoint {
+	var endpoints []*endpoint
+	for _, t := range p.targets {
+		if t.static != nil {
+			endpoints = append(endpoints, t.static)
+		}
+	}
+	return endpoints
+}
+
+// loop はintervalごとにfnを実行するゴルーチンを開始します。
+func (p *Pool) loop(ctx context.Context, interval time.Duration, fn func(context.Context)) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				fn(ctx)
+			}
+		}
+	}()
+}
+
+// refresh はDNSの接続先を再解決し、振り分け先を更新します。
+// 解決後も残るアドレスは処理中のリクエスト数やイジェクトの状態を引き継ぎます。
+func (p *Pool) refresh(ctx context.Context) error {
+	p.mu.RLock()
+	current := make(map[string]*endpoint, len(p.endpoints))
+	for _, ep := range p.endpoints {
+		current[ep.url.String()] = ep
+	}
+	p.mu.RUnlock()
+
+	endpoints := p.staticEndpoints()
+	var errs []error
+	for _, t := range p.targets {
+		if !t.dns {
+			continue
+		}
+		addrs, err := p.resolver.LookupHost(ctx, t.host)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to resolve %s: %w", t.raw, err))
+			// 解決に失敗したターゲットは既存の接続先を維持する
+			for _, ep := range current {
+				if ep.authority == t.base.Host {
+					endpoints = append(endpoints, ep)
+				}
+			}
+			continue
+		}
+		for _, addr := range addrs {
+			u := &url.URL{Scheme: t.base.Scheme, Host: net.JoinHostPort(addr, t.port)}
+			ep, ok := current[u.String()]
+			if !ok {
+				ep = newEndpoint(u, t.base.Host, p.client)
+			}
+			endpoints = append(endpoints, ep)
+		}
+	}
+	if len(endpoints) == 0 {
+		errs = append(errs, ErrNoEndpoints)
+	}
+
+	p.mu.Lock()
+	if len(endpoints) > 0 {
+		p.endpoints = endpoints
+		p.resolved = true
+	}
+	p.mu.Unlock()
+	return errors.Join(errs...)
+}
+
+// checkHealth はすべての接続先にgRPCヘルスチェックを実行します。
+// 成功した接続先は連続失敗回数を戻してイジェクトを解除し、失敗した接続先は失敗として数えます。
+func (p *Pool) checkHealth(ctx context.Context) {
+	p.mu.RLock()
+	endpoints := append([]*endpoint(nil), p.endpoints...)
+	p.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, ep := range endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, p.cfg.HealthCheckInterval)
+			defer cancel()
+			resp, err := ep.health.Check(checkCtx, connect.NewRequest(&healthv1.HealthCheckRequest{}))
+			if err == nil && resp.Msg.GetStatus() == healthv1.HealthCheckResponse_SERVING {
+				ep.failures.Store(0)
+				if ep.ejected.CompareAndSwap(true, false) {
+					p.logger.InfoContext(ctx, "Query endpoint restored", "endpoint", ep.url.String())
+				}
+				return
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil {
+				err = fmt.Errorf("status %s", resp.Msg.GetStatus())
+			}
+			p.recordFailure(ctx, ep, err)
+		}()
+	}
+	wg.Wait()
+}
+
+// recordFailure は接続先の失敗を数え、連続失敗回数がしきい値に達した場合はイジェクトします。
+func (p *Pool) recordFailure(ctx context.Context, ep *endpoint, err error) {
+	failures := ep.failures.Add(1)
+	if p.cfg.EjectionThreshold <= 0 || int(failures) < p.cfg.EjectionThreshold {
+		return
+	}
+	if ep.ejected.CompareAndSwap(false, true) {
+		p.logger.WarnContext(ctx, "Query endpoint ejected", "endpoint", ep.url.String(), "failures", failures, "error", err)
+	}
+}
+
+// pick は振り分け方式に従って接続先を選びます。
+// すべての接続先がイジェクトされている場合は、全面的な停止を避けるためすべての接続先から選びます。
+func (p *Pool) pick(ctx context.Context) (*endpoint, error) {
+	p.mu.RLock()
+	resolved := p.resolved
+	p.mu.RUnlock()
+	if !resolved {
+		// Startを呼ばずに使われた場合は、最初のリクエストで解決する
+		if err := p.refresh(ctx); err != nil && !errors.Is(err, ErrNoEndpoints) {
+			p.logger.WarnContext(ctx, "Failed to resolve query endpoints", "error", err)
+		}
+	}
+
+	p.mu.RLock()
+	all := p.endpoints
+	p.mu.RUnlock()
+	candidates := make([]*endpoint, 0, len(all))
+	for _, ep := range all {
+		if !ep.ejected.Load() {
+			candidates = append(candidates, ep)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = all
+	}
+	if len(candidates) == 0 {
+		return nil, ErrNoEndpoints
+	}
+
+	start := int(p.next.Add(1)-1) % len(candidates)
+	if p.cfg.Policy != LeastLoaded {
+		return candidates[start], nil
+	}
+	// 同数の場合に同じ接続先へ偏らないよう、ラウンドロビンの位置から探す
+	best := candidates[start]
+	for i := 1; i < len(candidates); i++ {
+		ep := candidates[(start+i)%len(candidates)]
+		if ep.inflight.Load() < best.inflight.Load() {
+			best = ep
+		}
+	}
+	return best, nil
+}
+
+// transport はリクエストの送信先を選んだ接続先に書き換えるhttp.RoundTripperです。
+type transport struct {
+	pool *Pool
+	base http.RoundTripper
+}
+
+// RoundTrip はリクエストを接続先に振り分けて送信します。
+// 接続できないなどの通信エラーは接続先の失敗として数えます。
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ep, err := t.pool.pick(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	out := req.Clone(req.Context())
+	out.URL.Scheme = ep.url.Scheme
+	out.URL.Host = ep.url.Host
+	out.Host = ep.authority
+
+	ep.inflight.Add(1)
+	resp, err := t.base.RoundTrip(out)
+	if err != nil {
+		ep.inflight.Add(-1)
+		if req.Context().Err() == nil {
+			t.pool.recordFailure(req.Context(), ep, err)
+		}
+		return nil, err
+	}
+	ep.failures.Store(0)
+	// すべての接続先がイジェクトされている間に応答できた接続先は振り分け対象に戻す
+	ep.ejected.Store(false)
+	// ストリーミングの応答も含め、ボディを閉じるまで処理中として数える
+	resp.Body = &trackedBody{ReadCloser: resp.Body, done: func() { ep.inflight.Add(-1) }}
+	return resp, nil
+}
+
+// trackedBody は閉じたときに1度だけdoneを呼び出すレスポンスボディです。
+type trackedBody struct {
+	io.ReadCloser
+	once sync.Once
+	done func()
+}
+
+// Close はボディを閉じ、処理中のリクエスト数を減らします。
+func (b *trackedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.done)
+	return err
+}
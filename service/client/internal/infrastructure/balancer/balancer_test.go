@@ -0,0 +1,235 @@
+package balancer_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"connectrpc.com/grpchealth"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/infrastructure/balancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// replica は名前を返すQuery Serviceのレプリカです。
+type replica struct {
+	name    string
+	checker *grpchealth.StaticChecker
+	server  *httptest.Server
+	block   chan struct{} // nil以外の場合、閉じるまで応答しない
+}
+
+func newReplica(t *testing.T, name string) *replica {
+	t.Helper()
+	r := &replica{name: name, checker: grpchealth.NewStaticChecker()}
+	mux := http.NewServeMux()
+	mux.Handle(grpchealth.NewHandler(r.checker))
+	mux.HandleFunc("/name", func(w http.ResponseWriter, req *http.Request) {
+		if r.block != nil {
+			<-r.block
+		}
+		_, _ = io.WriteString(w, r.name)
+	})
+	r.server = httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func newPool(t *testing.T, cfg balancer.Config) *balancer.Pool {
+	t.Helper()
+	pool, err := balancer.NewPool(cfg, &http.Client{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	t.Cleanup(pool.Stop)
+	return pool
+}
+
+func get(t *testing.T, pool *balancer.Pool) string {
+	t.Helper()
+	client := &http.Client{Transport: pool.Transport(nil)}
+	resp, err := client.Get(pool.BaseURL() + "/name")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestNewPool(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     balancer.Config
+		wantErr string
+	}{
+		{
+			name: "正常系: 静的な接続先とDNSの接続先を指定できる",
+			cfg:  balancer.Config{Targets: []string{"http://query-1:8085", "dns:///query-headless:8085"}, Policy: balancer.LeastLoaded},
+		},
+		{
+			name:    "異常系: 接続先がない",
+			cfg:     balancer.Config{},
+			wantErr: "at least one target is required",
+		},
+		{
+			name:    "異常系: DNSの接続先にポートがない",
+			cfg:     balancer.Config{Targets: []string{"dns:///query-headless"}},
+			wantErr: "want dns:///host:port",
+		},
+		{
+			name:    "異常系: 未知のスキーム",
+			cfg:     balancer.Config{Targets: []string{"tcp://query-1:8085"}},
+			wantErr: "scheme must be http, https or dns",
+		},
+		{
+			name:    "異常系: 未知の振り分け方式",
+			cfg:     balancer.Config{Targets: []string{"http://query-1:8085"}, Policy: "random"},
+			wantErr: "unknown balancer policy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := balancer.NewPool(tt.cfg, &http.Client{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+			// Assert
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPool(t *testing.T) {
+	t.Run("正常系: ラウンドロビンで均等に振り分ける", func(t *testing.T) {
+		// Arrange
+		a, b, c := newReplica(t, "a"), newReplica(t, "b"), newReplica(t, "c")
+		pool := newPool(t, balancer.Config{Targets: []string{a.server.URL, b.server.URL, c.server.URL}})
+
+		// Act
+		counts := map[string]int{}
+		for range 6 {
+			counts[get(t, pool)]++
+		}
+
+		// Assert
+		assert.Equal(t, map[string]int{"a": 2, "b": 2, "c": 2}, counts)
+	})
+
+	t.Run("正常系: least_loadedは処理中のリクエストが少ない接続先を選ぶ", func(t *testing.T) {
+		// Arrange
+		a, b := newReplica(t, "a"), newReplica(t, "b")
+		a.block = make(chan struct{})
+		pool := newPool(t, balancer.Config{Targets: []string{a.server.URL, b.server.URL}, Policy: balancer.LeastLoaded})
+		done := make(chan string)
+		go func() {
+			// requireはテストのゴルーチンでのみ使えるため、ここではエラーを空文字列として扱う
+			resp, err := (&http.Client{Transport: pool.Transport(nil)}).Get(pool.BaseURL() + "/name")
+			if err != nil {
+				done <- ""
+				return
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+			done <- string(body)
+		}()
+		require.Eventually(t, func() bool { return pool.Endpoints()[0].Inflight == 1 }, time.Second, 5*time.Millisecond)
+
+		// Act
+		second, third := get(t, pool), get(t, pool)
+
+		// Assert
+		assert.Equal(t, "b", second)
+		assert.Equal(t, "b", third, "ラウンドロビンではaの順番だが、aは処理中のため選ばない")
+		close(a.block)
+		assert.Equal(t, "a", <-done)
+		assert.Zero(t, pool.Endpoints()[0].Inflight)
+	})
+
+	t.Run("正常系: ヘルスチェックに失敗した接続先を外し、成功したら戻す", func(t *testing.T) {
+		// Arrange
+		a, b := newReplica(t, "a"), newReplica(t, "b")
+		b.checker.SetStatus("", grpchealth.StatusNotServing)
+		pool := newPool(t, balancer.Config{
+			Targets:             []string{a.server.URL, b.server.URL},
+			HealthCheckInterval: 10 * time.Millisecond,
+			EjectionThreshold:   2,
+		})
+
+		// Act
+		require.NoError(t, pool.Start(context.Background()))
+
+		// Assert
+		require.Eventually(t, func() bool { return pool.Endpoints()[1].Ejected }, time.Second, 5*time.Millisecond)
+		for range 4 {
+			assert.Equal(t, "a", get(t, pool))
+		}
+		b.checker.SetStatus("", grpchealth.StatusServing)
+		require.Eventually(t, func() bool { return !pool.Endpoints()[1].Ejected }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("異常系: 接続できない接続先は通信エラーが続くと外す", func(t *testing.T) {
+		// Arrange
+		a, down := newReplica(t, "a"), newReplica(t, "down")
+		down.server.Close()
+		pool := newPool(t, balancer.Config{Targets: []string{a.server.URL, down.server.URL}, EjectionThreshold: 1})
+		client := &http.Client{Transport: pool.Transport(nil)}
+
+		// Act
+		var failures int
+		for range 4 {
+			resp, err := client.Get(pool.BaseURL() + "/name")
+			if err != nil {
+				failures++
+				continue
+			}
+			_ = resp.Body.Close()
+		}
+
+		// Assert
+		assert.Equal(t, 1, failures, "1回の失敗で外し、以降は振り分けない")
+		assert.True(t, pool.Endpoints()[1].Ejected)
+	})
+
+	t.Run("正常系: すべての接続先を外した場合もすべての接続先から選ぶ", func(t *testing.T) {
+		// Arrange
+		a := newReplica(t, "a")
+		a.checker.SetStatus("", grpchealth.StatusNotServing)
+		pool := newPool(t, balancer.Config{Targets: []string{a.server.URL}, HealthCheckInterval: 10 * time.Millisecond, EjectionThreshold: 1})
+		require.NoError(t, pool.Start(context.Background()))
+		require.Eventually(t, func() bool { return pool.Endpoints()[0].Ejected }, time.Second, 5*time.Millisecond)
+
+		// Act
+		name := get(t, pool)
+
+		// Assert
+		assert.Equal(t, "a", name)
+	})
+
+	t.Run("正常系: dns:///で指定した接続先を解決する", func(t *testing.T) {
+		// Arrange
+		a := newReplica(t, "a")
+		u, err := url.Parse(a.server.URL)
+		require.NoError(t, err)
+		pool := newPool(t, balancer.Config{Targets: []string{"dns:///localhost:" + u.Port()}, DNSRefreshInterval: time.Minute})
+
+		// Act
+		err = pool.Start(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		var urls []string
+		for _, endpoint := range pool.Endpoints() {
+			urls = append(urls, endpoint.URL)
+		}
+		assert.Contains(t, urls, a.server.URL)
+		assert.Equal(t, "http://localhost:"+u.Port(), pool.BaseURL())
+	})
+}
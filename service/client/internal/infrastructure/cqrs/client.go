@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/infrastructure/balancer"
 	"github.com/spf13/viper"
 	"go.uber.org/fx"
 )
@@ -26,6 +27,21 @@ type CQRSServiceConfig struct {
 	IdleConnTimeout       time.Duration // アイドル接続タイムアウト
 	MaxIdleConns          int           // 最大アイドル接続数
 	MaxIdleConnsPerHost   int           // ホストごとの最大アイドル接続数
+
+	DefaultDeadline         time.Duration            // Unary RPCの既定の期限（再試行を含む）
+	Deadlines               map[string]time.Duration // 手続きごとの期限（既定の期限より優先）
+	RetryMaxAttempts        int                      // Query Serviceの参照系RPCの最大試行回数（初回を含む）
+	RetryInitialBackoff     time.Duration            // 1回目の再試行までの待ち時間の上限
+	RetryMaxBackoff         time.Duration            // 再試行までの待ち時間の上限
+	BreakerFailureThreshold int                      // サーキットブレーカーを開にする連続失敗回数
+	BreakerOpenTimeout      time.Duration            // サーキットブレーカーを開から半開にするまでの時間
+
+	QueryServiceEndpoints    []string      // Query Serviceのレプリカの接続先（空の場合はQueryServiceURLのみ）
+	QueryBalancer            string        // 振り分け方式（round_robin / least_loaded）
+	QueryDNSRefreshInterval  time.Duration // dns:///で指定した接続先を再解決する間隔
+	QueryHealthCheckInterval time.Duration // レプリカごとのヘルスチェックの間隔（0の場合はヘルスチェックしない）
+	QueryEjectionThreshold   int           // レプリカを振り分け対象から外す連続失敗回数（0の場合は外さない）
+	QueryHedgeDelay          time.Duration // GetProductByIdのヘッジリクエストを送信するまでの待ち時間（0の場合はヘッジしない）
 }
 
 // NewCQRSServiceConfig は設定ファイルからCQRSServiceConfigを生成します。
@@ -49,6 +65,28 @@ func NewCQRSServiceConfig(v *viper.Viper) (*CQRSServiceConfig, error) {
 		IdleConnTimeout:       utils.GetKey[time.Duration](v, "cqrs.idle_conn_timeout", &configErrors),
 		MaxIdleConns:          utils.GetKey[int](v, "cqrs.max_idle_conns", &configErrors),
 		MaxIdleConnsPerHost:   utils.GetKey[int](v, "cqrs.max_idle_conns_per_host", &configErrors),
+
+		DefaultDeadline:         utils.GetKey[time.Duration](v, "cqrs.default_deadline", &configErrors),
+		RetryMaxAttempts:        utils.GetKey[int](v, "cqrs.retry_max_attempts", &configErrors),
+		RetryInitialBackoff:     utils.GetKey[time.Duration](v, "cqrs.retry_initial_backoff", &configErrors),
+		RetryMaxBackoff:         utils.GetKey[time.Duration](v, "cqrs.retry_max_backoff", &configErrors),
+		BreakerFailureThreshold: utils.GetKey[int](v, "cqrs.breaker_failure_threshold", &configErrors),
+		BreakerOpenTimeout:      utils.GetKey[time.Duration](v, "cqrs.breaker_open_timeout", &configErrors),
+
+		QueryServiceEndpoints:    utils.GetKey[[]string](v, "cqrs.query_service_endpoints", &configErrors),
+		QueryBalancer:            utils.GetKey[string](v, "cqrs.query_balancer", &configErrors),
+		QueryDNSRefreshInterval:  utils.GetKey[time.Duration](v, "cqrs.query_dns_refresh_interval", &configErrors),
+		QueryHealthCheckInterval: utils.GetKey[time.Duration](v, "cqrs.query_health_check_interval", &configErrors),
+		QueryEjectionThreshold:   utils.GetKey[int](v, "cqrs.query_ejection_threshold", &configErrors),
+		QueryHedgeDelay:          utils.GetKey[time.Duration](v, "cqrs.query_hedge_delay", &configErrors),
+	}
+	deadlines, err := loadDeadlines(v)
+	if err != nil {
+		configErrors = append(configErrors, err)
+	}
+	cfg.Deadlines = deadlines
+	if err := cfg.validateBalancer(); err != nil {
+		configErrors = append(configErrors, err)
 	}
 	// すべての環境変数を読み込んだ後、エラーがあればまとめて返す
 	if len(configErrors) > 0 {
@@ -106,14 +144,31 @@ func NewClient(cfg *CQRSServiceConfig) *http.Client {
 //   - client: HTTPクライアント
 //   - cmdClient: Commandサービスクライアント
 //   - queryClient: Queryサービスクライアント
+//   - pool: Query Serviceのレプリカへの振り分け
 //   - logger: ロガー
 func RegisterLifecycleHooks(
 	lc fx.Lifecycle,
 	client *http.Client,
 	cmdClient *CommandServiceClient,
 	queryClient *QueryServiceClient,
+	pool *balancer.Pool,
 	logger *slog.Logger,
 ) {
+	// 接続確認より先にQuery Serviceのレプリカを解決し、レプリカごとのヘルスチェックを開始する
+	// （接続確認が失敗した場合もfxがOnStopを呼び出して停止する）
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := pool.Start(ctx); err != nil {
+				logger.ErrorContext(ctx, "Failed to resolve Query Service endpoints", "error", err)
+				return fmt.Errorf("query service endpoint resolution failed: %w", err)
+			}
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			pool.Stop()
+			return nil
+		},
+	})
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			logger.InfoContext(ctx, "Checking backend service connections...")
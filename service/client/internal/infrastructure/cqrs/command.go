@@ -9,17 +9,23 @@ import (
 	healthv1 "buf.build/gen/go/grpc/grpc/protocolbuffers/go/grpc/health/v1"
 	"connectrpc.com/connect"
 	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
 )
 
 // CommandServiceClient はCommand Serviceへの接続を管理するクライアント
 type CommandServiceClient struct {
-	Category     cmdconnect.CategoryServiceClient // カテゴリサービスクライアント
-	Product      cmdconnect.ProductServiceClient  // 商品サービスクライアント
-	healthClient healthv1connect.HealthClient     // ヘルスチェッククライアント
-	serviceURL   string                           // サービスURL
+	Category      cmdconnect.CategoryServiceClient      // カテゴリサービスクライアント
+	Product       cmdconnect.ProductServiceClient       // 商品サービスクライアント
+	Tag           cmdconnect.TagServiceClient           // タグサービスクライアント
+	PriceSchedule cmdconnect.PriceScheduleServiceClient // 価格スケジュールサービスクライアント
+	healthClient  healthv1connect.HealthClient          // ヘルスチェッククライアント
+	breaker       *interceptor.CircuitBreaker           // サーキットブレーカー
+	serviceURL    string                                // サービスURL
 }
 
 // NewCommandServiceClient はCommandServiceClientを生成します。
+// 各RPCには期限とサーキットブレーカーを適用します。更新系のRPCは重複して適用されるおそれがあるため再試行しません。
+// ヘルスチェックは障害中も接続先の状態を確認できるよう、サーキットブレーカーを適用しません。
 //
 // Parameters:
 //   - client: HTTPクライアント
@@ -28,18 +34,33 @@ type CommandServiceClient struct {
 // Returns:
 //   - *CommandServiceClient: CommandServiceClient
 func NewCommandServiceClient(client *http.Client, cfg *CQRSServiceConfig) *CommandServiceClient {
-	categoryClient := cmdconnect.NewCategoryServiceClient(client, cfg.CommandServiceURL, connect.WithGRPC())
-	productClient := cmdconnect.NewProductServiceClient(client, cfg.CommandServiceURL, connect.WithGRPC())
+	breaker := interceptor.NewCircuitBreaker("command", cfg.circuitBreakerPolicy())
+	interceptors := connect.WithInterceptors(interceptor.NewDeadline(cfg.DefaultDeadline, cfg.Deadlines), breaker)
+	categoryClient := cmdconnect.NewCategoryServiceClient(client, cfg.CommandServiceURL, connect.WithGRPC(), interceptors)
+	productClient := cmdconnect.NewProductServiceClient(client, cfg.CommandServiceURL, connect.WithGRPC(), interceptors)
+	tagClient := cmdconnect.NewTagServiceClient(client, cfg.CommandServiceURL, connect.WithGRPC(), interceptors)
+	priceScheduleClient := cmdconnect.NewPriceScheduleServiceClient(client, cfg.CommandServiceURL, connect.WithGRPC(), interceptors)
 	healthClient := healthv1connect.NewHealthClient(client, cfg.CommandServiceURL, connect.WithGRPC())
 
 	return &CommandServiceClient{
-		Category:     categoryClient,
-		Product:      productClient,
-		healthClient: healthClient,
-		serviceURL:   cfg.CommandServiceURL,
+		Category:      categoryClient,
+		Product:       productClient,
+		Tag:           tagClient,
+		PriceSchedule: priceScheduleClient,
+		healthClient:  healthClient,
+		breaker:       breaker,
+		serviceURL:    cfg.CommandServiceURL,
 	}
 }
 
+// CircuitBreaker はCommand Serviceのサーキットブレーカーを返します。
+//
+// Returns:
+//   - *interceptor.CircuitBreaker: サーキットブレーカー
+func (c *CommandServiceClient) CircuitBreaker() *interceptor.CircuitBreaker {
+	return c.breaker
+}
+
 // HealthCheck はCommand Serviceへのヘルスチェックを実行します。
 //
 // Parameters:
@@ -0,0 +1,77 @@
+package cqrs
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"connectrpc.com/connect"
+	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
+	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
+)
+
+// hedgedProductClient はGetProductByIdをヘッジするProductServiceClientです。
+// 最初の呼び出しがdelay以内に応答しない場合に同じリクエストをもう1件送信し、先に成功した応答を返します。
+// 2件目はロードバランサーによって別のレプリカに振り分けられるため、一部のレプリカの遅延の影響を抑えられます。
+type hedgedProductClient struct {
+	queryconnect.ProductServiceClient
+	delay time.Duration
+}
+
+// GetProductById はIDで商品を取得します。応答が遅い場合はヘッジリクエストを送信します。
+func (c *hedgedProductClient) GetProductById(ctx context.Context, req *connect.Request[query.GetProductByIdRequest]) (*connect.Response[query.GetProductByIdResponse], error) {
+	// インターセプターがヘッダーを書き換えるため、呼び出しごとに元のヘッダーを複製したリクエストを送信する
+	header := req.Header().Clone()
+	return hedge(ctx, c.delay, func(ctx context.Context) (*connect.Response[query.GetProductByIdResponse], error) {
+		r := connect.NewRequest(req.Msg)
+		maps.Copy(r.Header(), header.Clone())
+		return c.ProductServiceClient.GetProductById(ctx, r)
+	})
+}
+
+// hedge はcallを実行し、delay以内に完了しない場合にもう1件callを並行して実行します。
+// 先に成功した結果を返し、残りの呼び出しはキャンセルします。
+// delay以内に失敗した場合はヘッジせずにそのエラーを返します（一時的な障害の再試行はインターセプターが行います）。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - delay: ヘッジリクエストを送信するまでの待ち時間
+//   - call: 呼び出し
+//
+// Returns:
+//   - T: 先に成功した結果
+//   - error: すべての呼び出しが失敗した場合は最後のエラー
+func hedge[T any](ctx context.Context, delay time.Duration, call func(context.Context) (T, error)) (T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		value T
+		err   error
+	}
+	results := make(chan result, 2)
+	run := func() {
+		value, err := call(ctx)
+		results <- result{value: value, err: err}
+	}
+
+	go run()
+	pending := 1
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	hedgeC := timer.C
+	for {
+		select {
+		case <-hedgeC:
+			hedgeC = nil
+			pending++
+			go run()
+		case r := <-results:
+			pending--
+			if r.err == nil || pending == 0 {
+				return r.value, r.err
+			}
+			// 失敗した場合は、もう一方の呼び出しの結果を待つ
+		}
+	}
+}
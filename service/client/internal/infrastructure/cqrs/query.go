@@ -3,41 +3,133 @@ package cqrs
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"strings"
 
 	"buf.build/gen/go/grpc/grpc/connectrpc/go/grpc/health/v1/healthv1connect"
 	healthv1 "buf.build/gen/go/grpc/grpc/protocolbuffers/go/grpc/health/v1"
 	"connectrpc.com/connect"
 	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/infrastructure/balancer"
 )
 
 // QueryServiceClient はQuery Serviceへの接続を管理するクライアント
 type QueryServiceClient struct {
 	Category     queryconnect.CategoryServiceClient // カテゴリサービスクライアント
 	Product      queryconnect.ProductServiceClient  // 商品サービスクライアント
+	Suggest      queryconnect.ProductServiceClient  // 双方向ストリーミング用の商品サービスクライアント（HTTP/2）
+	Tag          queryconnect.TagServiceClient      // タグサービスクライアント
 	healthClient healthv1connect.HealthClient       // ヘルスチェッククライアント
+	breaker      *interceptor.CircuitBreaker        // サーキットブレーカー
+	pool         *balancer.Pool                     // レプリカへの振り分け
 	serviceURL   string                             // サービスURL
 }
 
+// NewQueryBalancer はQuery Serviceのレプリカへリクエストを振り分けるPoolを生成します。
+// ヘルスチェックは振り分けを経由せず、接続先ごとに直接実行します。
+//
+// Parameters:
+//   - client: HTTPクライアント
+//   - cfg: CQRS設定
+//   - logger: ロガー
+//
+// Returns:
+//   - *balancer.Pool: Pool
+//   - error: 接続先または振り分け方式が不正な場合のエラー
+func NewQueryBalancer(client *http.Client, cfg *CQRSServiceConfig, logger *slog.Logger) (*balancer.Pool, error) {
+	return balancer.NewPool(cfg.balancerConfig(), client, logger)
+}
+
 // NewQueryServiceClient はQueryServiceClientを生成します。
+// 各RPCには期限とサーキットブレーカーを適用し、冪等なUnary RPCはUnavailableの場合に指数バックオフで再試行します。
+// サーキットブレーカーを再試行より外側にすることで、開の間は再試行せずに即座に失敗させます。
+// リクエストはpoolでレプリカに振り分けるため、再試行は別のレプリカに送信されます。
+// cfg.QueryHedgeDelayが0より大きい場合、GetProductByIdはヘッジリクエストを送信します。
 //
 // Parameters:
 //   - client: HTTPクライアント
 //   - cfg: CQRS設定
+//   - pool: レプリカへの振り分け
 //
 // Returns:
 //   - *QueryServiceClient: QueryServiceClient
-func NewQueryServiceClient(client *http.Client, cfg *CQRSServiceConfig) *QueryServiceClient {
-	categoryClient := queryconnect.NewCategoryServiceClient(client, cfg.QueryServiceURL, connect.WithGRPC())
-	productClient := queryconnect.NewProductServiceClient(client, cfg.QueryServiceURL, connect.WithGRPC())
-	healthClient := healthv1connect.NewHealthClient(client, cfg.QueryServiceURL, connect.WithGRPC())
+func NewQueryServiceClient(client *http.Client, cfg *CQRSServiceConfig, pool *balancer.Pool) *QueryServiceClient {
+	breaker := interceptor.NewCircuitBreaker("query", cfg.circuitBreakerPolicy())
+	interceptors := connect.WithInterceptors(
+		interceptor.NewDeadline(cfg.DefaultDeadline, cfg.Deadlines),
+		breaker,
+		interceptor.NewRetry(cfg.retryPolicy()),
+		// クライアントのAccept-Languageを転送し、Query Serviceでロケールに応じた名前を解決させる
+		interceptor.NewAcceptLanguageForwarder(),
+	)
+	balanced := &http.Client{Transport: pool.Transport(client.Transport), Timeout: client.Timeout}
+	bidi := newBidiStreamClient(client)
+	bidi.Transport = pool.Transport(bidi.Transport)
+	baseURL := pool.BaseURL()
+
+	categoryClient := queryconnect.NewCategoryServiceClient(balanced, baseURL, connect.WithGRPC(), interceptors)
+	var productClient queryconnect.ProductServiceClient = queryconnect.NewProductServiceClient(balanced, baseURL, connect.WithGRPC(), interceptors)
+	if cfg.QueryHedgeDelay > 0 {
+		productClient = &hedgedProductClient{ProductServiceClient: productClient, delay: cfg.QueryHedgeDelay}
+	}
+	tagClient := queryconnect.NewTagServiceClient(balanced, baseURL, connect.WithGRPC(), interceptors)
+	healthClient := healthv1connect.NewHealthClient(balanced, baseURL, connect.WithGRPC())
+	suggestClient := queryconnect.NewProductServiceClient(bidi, baseURL, connect.WithGRPC(), interceptors)
 
 	return &QueryServiceClient{
 		Category:     categoryClient,
 		Product:      productClient,
+		Suggest:      suggestClient,
+		Tag:          tagClient,
 		healthClient: healthClient,
-		serviceURL:   cfg.QueryServiceURL,
+		breaker:      breaker,
+		pool:         pool,
+		serviceURL:   strings.Join(cfg.queryTargets(), ","),
+	}
+}
+
+// CircuitBreaker はQuery Serviceのサーキットブレーカーを返します。
+//
+// Returns:
+//   - *interceptor.CircuitBreaker: サーキットブレーカー
+func (c *QueryServiceClient) CircuitBreaker() *interceptor.CircuitBreaker {
+	return c.breaker
+}
+
+// Balancer はQuery Serviceのレプリカへの振り分けを返します。
+//
+// Returns:
+//   - *balancer.Pool: Pool
+func (c *QueryServiceClient) Balancer() *balancer.Pool {
+	return c.pool
+}
+
+// newBidiStreamClient は双方向ストリーミング用のHTTPクライアントを生成します。
+// 双方向ストリーミングにはHTTP/2が必要なため、平文の場合もh2cで接続します。
+// ストリームは長時間維持されるため、リクエスト全体のタイムアウトは設定しません。
+//
+// Parameters:
+//   - client: 元となるHTTPクライアント
+//
+// Returns:
+//   - *http.Client: HTTP/2専用のHTTPクライアント
+func newBidiStreamClient(client *http.Client) *http.Client {
+	var tr *http.Transport
+	if base, ok := client.Transport.(*http.Transport); ok {
+		tr = base.Clone()
+	} else {
+		tr = http.DefaultTransport.(*http.Transport).Clone()
 	}
+	protocols := new(http.Protocols)
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+	tr.Protocols = protocols
+	// ResponseHeaderTimeoutはサーバーが最初の応答を返すまでの待ち時間になるため無効にする
+	tr.ResponseHeaderTimeout = 0
+
+	return &http.Client{Transport: tr}
 }
 
 // HealthCheck はQuery Serviceへのヘルスチェックを実行します。
@@ -2,7 +2,10 @@ package cqrs
 
 import (
 	"context"
+	"errors"
+	"io"
 	"log/slog"
+	"strings"
 
 	"connectrpc.com/connect"
 	command "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
@@ -10,6 +13,7 @@ import (
 	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/domain/models"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/domain/repository"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // CQRSRepositoryImpl はCQRSRepositoryの実装
@@ -115,18 +119,50 @@ func newCreateProductCategory(category *models.Category) *command.CreateProductR
 	return c
 }
 
+// newVariantAttributes はバリエーションの属性を生成します。
+//
+// Parameters:
+//   - variant: バリエーション
+//
+// Returns:
+//   - *command.VariantAttributes: バリエーションの属性
+func newVariantAttributes(variant *models.Variant) *command.VariantAttributes {
+	options := make([]*common.VariantOption, len(variant.Options()))
+	for i, option := range variant.Options() {
+		o := &common.VariantOption{}
+		o.SetAxis(option.Axis())
+		o.SetValue(option.Value())
+		options[i] = o
+	}
+
+	attrs := &command.VariantAttributes{}
+	attrs.SetSku(variant.Sku())
+	attrs.SetOptions(options)
+	if variant.PriceOverride() != nil {
+		attrs.SetPriceOverride(int32(*variant.PriceOverride()))
+	}
+	if variant.Status() != "" {
+		attrs.SetStatus(common.VariantStatus(common.VariantStatus_value["VARIANT_STATUS_"+variant.Status()]))
+	}
+	return attrs
+}
+
 // CreateCategory はカテゴリを作成します。
 //
 // Parameters:
 //   - ctx: コンテキスト
 //   - categoryName: カテゴリ名
+//   - slug: URLに使用するスラッグ（エンプティの場合はカテゴリ名から生成）
 //
 // Returns:
 //   - *models.Category: 作成されたカテゴリ
 //   - error: エラー
-func (r *CQRSRepositoryImpl) CreateCategory(ctx context.Context, categoryName string) (*models.Category, error) {
+func (r *CQRSRepositoryImpl) CreateCategory(ctx context.Context, categoryName string, slug string) (*models.Category, error) {
 	req := &command.CreateCategoryRequest{}
 	req.SetName(newCategoryName(categoryName))
+	if slug != "" {
+		req.SetSlug(slug)
+	}
 	req.SetCrud(command.CRUD_CRUD_INSERT)
 
 	resp, err := r.commandServiceClient.Category.CreateCategory(ctx, connect.NewRequest(req))
@@ -150,6 +186,10 @@ func (r *CQRSRepositoryImpl) UpdateCategory(ctx context.Context, category *model
 	c := &command.UpdateCategoryRequest_Category{}
 	c.SetId(newCategoryId(category.Id()))
 	c.SetName(newCategoryName(category.Name()))
+	c.SetTranslations(category.Translations())
+	if category.Slug() != "" {
+		c.SetSlug(category.Slug())
+	}
 
 	req := &command.UpdateCategoryRequest{}
 	req.SetCategory(c)
@@ -217,23 +257,54 @@ func (r *CQRSRepositoryImpl) CategoryById(ctx context.Context, id string) (*mode
 	return toModelCategory(resp.Msg.GetCategory()), nil
 }
 
+// CategoryBySlug はスラッグでカテゴリを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - slug: スラッグ（現在のスラッグまたは変更前のスラッグ）
+//
+// Returns:
+//   - *models.Category: カテゴリ（スラッグは現在のスラッグ）
+//   - bool: 変更前のスラッグで取得した場合true
+//   - error: エラー
+func (r *CQRSRepositoryImpl) CategoryBySlug(ctx context.Context, slug string) (*models.Category, bool, error) {
+	req := &query.GetCategoryBySlugRequest{}
+	req.SetSlug(slug)
+
+	resp, err := r.queryServiceClient.Category.GetCategoryBySlug(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return toModelCategory(resp.Msg.GetCategory()), resp.Msg.GetMoved(), nil
+}
+
 // CreateProduct は商品を作成します。
 //
 // Parameters:
 //   - ctx: コンテキスト
-//   - productName: 商品名
-//   - productPrice: 価格
-//   - category: カテゴリ
+//   - product: 作成する商品（IDは未設定）
 //
 // Returns:
 //   - *models.Product: 作成された商品
 //   - error: エラー
-func (r *CQRSRepositoryImpl) CreateProduct(ctx context.Context, productName string, productPrice uint32, category *models.Category) (*models.Product, error) {
+func (r *CQRSRepositoryImpl) CreateProduct(ctx context.Context, product *models.Product) (*models.Product, error) {
 	// Product nested message
 	p := &command.CreateProductRequest_Product{}
-	p.SetName(newProductName(productName))
-	p.SetPrice(newProductPrice(productPrice))
-	p.SetCategory(newCreateProductCategory(category))
+	p.SetName(newProductName(product.Name()))
+	p.SetPrice(newProductPrice(product.Price()))
+	p.SetCategory(newCreateProductCategory(product.Category()))
+	if product.Currency() != "" {
+		p.SetCurrency(product.Currency())
+	}
+	p.SetTaxClass(toProtoTaxClass(product.TaxClass()))
+	p.SetTranslations(product.Translations())
+	if product.Barcode() != nil {
+		p.SetBarcode(*product.Barcode())
+	}
+	if product.Slug() != "" {
+		p.SetSlug(product.Slug())
+	}
 
 	req := &command.CreateProductRequest{}
 	req.SetProduct(p)
@@ -262,6 +333,17 @@ func (r *CQRSRepositoryImpl) UpdateProduct(ctx context.Context, product *models.
 	p.SetName(newProductName(product.Name()))
 	p.SetPrice(newProductPrice(product.Price()))
 	p.SetCategoryId(newCategoryId(product.Category().Id()))
+	if product.Currency() != "" {
+		p.SetCurrency(product.Currency())
+	}
+	p.SetTaxClass(toProtoTaxClass(product.TaxClass()))
+	p.SetTranslations(product.Translations())
+	if product.Barcode() != nil {
+		p.SetBarcode(*product.Barcode())
+	}
+	if product.Slug() != "" {
+		p.SetSlug(product.Slug())
+	}
 
 	req := &command.UpdateProductRequest{}
 	req.SetProduct(p)
@@ -307,6 +389,26 @@ func (r *CQRSRepositoryImpl) ProductList(ctx context.Context) ([]*models.Product
 	return toModelProducts(resp.Msg.GetProducts()), nil
 }
 
+// ProductListByTags は指定されたすべてのタグが付与された商品一覧を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tags: タグ名
+//
+// Returns:
+//   - []*models.Product: 商品一覧
+//   - error: エラー
+func (r *CQRSRepositoryImpl) ProductListByTags(ctx context.Context, tags []string) ([]*models.Product, error) {
+	req := &query.ListProductsRequest{}
+	req.SetTags(tags)
+
+	resp, err := r.queryServiceClient.Product.ListProducts(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return toModelProducts(resp.Msg.GetProducts()), nil
+}
+
 // StreamProducts はQuery ServiceのサーバーストリーミングRPCを呼び出し、
 // 受信結果をchannelで返します。
 //
@@ -364,7 +466,80 @@ func (r *CQRSRepositoryImpl) StreamProducts(ctx context.Context) (<-chan *reposi
 	return ch, nil
 }
 
-// ProductById はIDで商品を取得します。
+// SuggestProducts はQuery Serviceの双方向ストリーミングRPCを呼び出し、
+// queriesから受け取った検索語を送信してサジェストをchannelで返します。
+// queriesがcloseされると送信を終了し、サーバーの応答がすべて届いた時点で結果のchannelがcloseされます。
+//
+// Parameters:
+//   - ctx: コンテキスト（キャンセルするとストリームを中断）
+//   - queries: 送信する検索語
+//
+// Returns:
+//   - <-chan *repository.SuggestProductsResult: サジェストの受信結果
+//   - error: エラー
+func (r *CQRSRepositoryImpl) SuggestProducts(ctx context.Context, queries <-chan *repository.SuggestProductsQuery) (<-chan *repository.SuggestProductsResult, error) {
+	stream := r.queryServiceClient.Suggest.SuggestProducts(ctx)
+
+	// 送信側: queriesがcloseされたらリクエストストリームを閉じる
+	go func() {
+		defer func() {
+			if closeErr := stream.CloseRequest(); closeErr != nil {
+				r.logger.WarnContext(ctx, "Failed to close suggest request stream", "error", closeErr)
+			}
+		}()
+		for {
+			select {
+			case q, ok := <-queries:
+				if !ok {
+					return
+				}
+				req := &query.SuggestProductsRequest{}
+				req.SetPrefix(q.Prefix)
+				req.SetLimit(q.Limit)
+				// サーバー側でストリームが終了した場合はio.EOFが返り、原因は受信側で取得できる
+				if err := stream.Send(req); err != nil {
+					if !errors.Is(err, io.EOF) {
+						r.logger.WarnContext(ctx, "Failed to send suggest request", "error", err)
+					}
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// 受信側: サーバーからの応答をchannelへ流す
+	ch := make(chan *repository.SuggestProductsResult, 1)
+	go func() {
+		defer func() {
+			if closeErr := stream.CloseResponse(); closeErr != nil {
+				r.logger.WarnContext(ctx, "Failed to close suggest response stream", "error", closeErr)
+			}
+			close(ch)
+		}()
+		for {
+			msg, err := stream.Receive()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					select {
+					case ch <- &repository.SuggestProductsResult{Err: err}:
+					case <-ctx.Done():
+					}
+				}
+				return
+			}
+			select {
+			case ch <- &repository.SuggestProductsResult{Prefix: msg.GetPrefix(), Suggestions: toModelSuggestions(msg.GetSuggestions())}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// ProductById はIDで公開中の商品を取得します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -385,6 +560,49 @@ func (r *CQRSRepositoryImpl) ProductById(ctx context.Context, id string) (*model
 	return toModelProduct(resp.Msg.GetProduct()), nil
 }
 
+// ProductByBarcode はJAN/EAN/UPCバーコードで公開中の商品を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - barcode: JAN/EAN/UPCバーコード
+//
+// Returns:
+//   - *models.Product: 商品
+//   - error: エラー
+func (r *CQRSRepositoryImpl) ProductByBarcode(ctx context.Context, barcode string) (*models.Product, error) {
+	req := &query.GetProductByBarcodeRequest{}
+	req.SetBarcode(barcode)
+
+	resp, err := r.queryServiceClient.Product.GetProductByBarcode(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return toModelProduct(resp.Msg.GetProduct()), nil
+}
+
+// ProductBySlug はスラッグで公開中の商品を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - slug: スラッグ（現在のスラッグまたは変更前のスラッグ）
+//
+// Returns:
+//   - *models.Product: 商品（スラッグは現在のスラッグ）
+//   - bool: 変更前のスラッグで取得した場合true
+//   - error: エラー
+func (r *CQRSRepositoryImpl) ProductBySlug(ctx context.Context, slug string) (*models.Product, bool, error) {
+	req := &query.GetProductBySlugRequest{}
+	req.SetSlug(slug)
+
+	resp, err := r.queryServiceClient.Product.GetProductBySlug(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return toModelProduct(resp.Msg.GetProduct()), resp.Msg.GetMoved(), nil
+}
+
 // ProductByKeyword はキーワードで商品を検索します。
 //
 // Parameters:
@@ -406,6 +624,286 @@ func (r *CQRSRepositoryImpl) ProductByKeyword(ctx context.Context, keyword strin
 	return toModelProducts(resp.Msg.GetProducts()), nil
 }
 
+// AddVariant は商品にバリエーションを追加します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productId: 商品ID
+//   - variant: 追加するバリエーション
+//
+// Returns:
+//   - *models.Variant: 追加されたバリエーション
+//   - error: エラー
+func (r *CQRSRepositoryImpl) AddVariant(ctx context.Context, productId string, variant *models.Variant) (*models.Variant, error) {
+	req := &command.AddVariantRequest{}
+	req.SetProductId(newProductId(productId))
+	req.SetVariant(newVariantAttributes(variant))
+
+	resp, err := r.commandServiceClient.Product.AddVariant(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return toModelVariant(resp.Msg.GetVariant()), nil
+}
+
+// UpdateVariant は商品のバリエーションを更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productId: 商品ID
+//   - variant: 更新するバリエーション
+//
+// Returns:
+//   - *models.Variant: 更新されたバリエーション
+//   - error: エラー
+func (r *CQRSRepositoryImpl) UpdateVariant(ctx context.Context, productId string, variant *models.Variant) (*models.Variant, error) {
+	req := &command.UpdateVariantRequest{}
+	req.SetProductId(newProductId(productId))
+	req.SetVariantId(variant.Id())
+	req.SetVariant(newVariantAttributes(variant))
+
+	resp, err := r.commandServiceClient.Product.UpdateVariant(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return toModelVariant(resp.Msg.GetVariant()), nil
+}
+
+// RemoveVariant は商品のバリエーションを削除します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productId: 商品ID
+//   - variantId: 削除するバリエーションID
+//
+// Returns:
+//   - error: エラー
+func (r *CQRSRepositoryImpl) RemoveVariant(ctx context.Context, productId string, variantId string) error {
+	req := &command.RemoveVariantRequest{}
+	req.SetProductId(newProductId(productId))
+	req.SetVariantId(variantId)
+
+	_, err := r.commandServiceClient.Product.RemoveVariant(ctx, connect.NewRequest(req))
+	return err
+}
+
+// PublishProduct は商品を公開します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productId: 商品ID
+//
+// Returns:
+//   - *models.Product: 公開された商品
+//   - error: エラー
+func (r *CQRSRepositoryImpl) PublishProduct(ctx context.Context, productId string) (*models.Product, error) {
+	req := &command.PublishProductRequest{}
+	req.SetProductId(newProductId(productId))
+
+	resp, err := r.commandServiceClient.Product.PublishProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return toModelProduct(resp.Msg.GetProduct()), nil
+}
+
+// SuspendProduct は公開中の商品を一時停止します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productId: 商品ID
+//
+// Returns:
+//   - *models.Product: 一時停止された商品
+//   - error: エラー
+func (r *CQRSRepositoryImpl) SuspendProduct(ctx context.Context, productId string) (*models.Product, error) {
+	req := &command.SuspendProductRequest{}
+	req.SetProductId(newProductId(productId))
+
+	resp, err := r.commandServiceClient.Product.SuspendProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return toModelProduct(resp.Msg.GetProduct()), nil
+}
+
+// DiscontinueProduct は商品を販売終了にします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productId: 商品ID
+//
+// Returns:
+//   - *models.Product: 販売終了にした商品
+//   - error: エラー
+func (r *CQRSRepositoryImpl) DiscontinueProduct(ctx context.Context, productId string) (*models.Product, error) {
+	req := &command.DiscontinueProductRequest{}
+	req.SetProductId(newProductId(productId))
+
+	resp, err := r.commandServiceClient.Product.DiscontinueProduct(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return toModelProduct(resp.Msg.GetProduct()), nil
+}
+
+// SchedulePrice は商品に期間を指定した価格変更を登録します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - schedule: 登録する価格スケジュール
+//
+// Returns:
+//   - *models.PriceSchedule: 登録された価格スケジュール
+//   - error: エラー
+func (r *CQRSRepositoryImpl) SchedulePrice(ctx context.Context, schedule *models.PriceSchedule) (*models.PriceSchedule, error) {
+	req := &command.SchedulePriceRequest{}
+	req.SetProductId(newProductId(schedule.ProductId()))
+	req.SetPrice(newProductPrice(schedule.Price()))
+	req.SetStartsAt(timestamppb.New(schedule.StartsAt()))
+	req.SetEndsAt(timestamppb.New(schedule.EndsAt()))
+
+	resp, err := r.commandServiceClient.PriceSchedule.SchedulePrice(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return toModelPriceSchedule(resp.Msg.GetPriceSchedule()), nil
+}
+
+// CancelPriceSchedule は商品の価格スケジュールを取り消します。適用中の場合は元の単価に戻します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productId: 商品ID
+//   - scheduleId: 価格スケジュールID
+//
+// Returns:
+//   - *models.PriceSchedule: 取り消された価格スケジュール
+//   - error: エラー
+func (r *CQRSRepositoryImpl) CancelPriceSchedule(ctx context.Context, productId string, scheduleId string) (*models.PriceSchedule, error) {
+	req := &command.CancelPriceScheduleRequest{}
+	req.SetProductId(newProductId(productId))
+	req.SetPriceScheduleId(scheduleId)
+
+	resp, err := r.commandServiceClient.PriceSchedule.CancelPriceSchedule(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return toModelPriceSchedule(resp.Msg.GetPriceSchedule()), nil
+}
+
+// AdjustPrices は条件に一致する商品の単価を一括で変更します。previewの場合は変更せずに変更前後の単価のみを返します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - target: 対象の商品の条件
+//   - adjustment: 単価の変更規則
+//   - preview: trueの場合は単価を変更しない
+//
+// Returns:
+//   - []*models.PriceChange: 商品ごとの変更前後の単価（商品ID順）
+//   - error: エラー
+func (r *CQRSRepositoryImpl) AdjustPrices(ctx context.Context, target *repository.PriceAdjustmentTarget, adjustment *models.PriceAdjustment, preview bool) ([]*models.PriceChange, error) {
+	a := &command.PriceAdjustment{}
+	a.SetType(command.PriceAdjustmentType(command.PriceAdjustmentType_value["PRICE_ADJUSTMENT_TYPE_"+adjustment.AdjustmentType()]))
+	a.SetValue(adjustment.Value())
+	a.SetRounding(command.PriceRounding(command.PriceRounding_value["PRICE_ROUNDING_"+adjustment.Rounding()]))
+	a.SetRoundingUnit(adjustment.RoundingUnit())
+	if minPrice := adjustment.MinPrice(); minPrice != nil {
+		a.SetMinPrice(*minPrice)
+	}
+	if maxPrice := adjustment.MaxPrice(); maxPrice != nil {
+		a.SetMaxPrice(*maxPrice)
+	}
+
+	req := &command.AdjustPricesRequest{}
+	req.SetCategoryId(newCategoryId(target.CategoryId))
+	req.SetIncludeDescendants(target.IncludeDescendants)
+	req.SetTags(target.Tags)
+	req.SetAdjustment(a)
+	req.SetPreview(preview)
+
+	resp, err := r.commandServiceClient.Product.AdjustPrices(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.PriceChange, len(resp.Msg.GetResults()))
+	for i, change := range resp.Msg.GetResults() {
+		result[i] = models.NewPriceChange(change.GetProductId(), change.GetProductName(), change.GetCurrency(), change.GetBeforePrice(), change.GetAfterPrice())
+	}
+	return result, nil
+}
+
+// TagList はタグ一覧を付与された商品数とともに取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - []*models.TagUsage: タグ一覧
+//   - error: エラー
+func (r *CQRSRepositoryImpl) TagList(ctx context.Context) ([]*models.TagUsage, error) {
+	resp, err := r.queryServiceClient.Tag.ListTags(ctx, connect.NewRequest(&query.ListTagsRequest{}))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.TagUsage, len(resp.Msg.GetTags()))
+	for i, usage := range resp.Msg.GetTags() {
+		result[i] = models.NewTagUsage(toModelTag(usage.GetTag()), int(usage.GetProductCount()))
+	}
+	return result, nil
+}
+
+// AttachTags は複数の商品に複数のタグを一括で付与します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productIds: 商品ID
+//   - tagNames: タグ名
+//
+// Returns:
+//   - *models.TagChange: 付与結果
+//   - error: エラー
+func (r *CQRSRepositoryImpl) AttachTags(ctx context.Context, productIds []string, tagNames []string) (*models.TagChange, error) {
+	req := &command.AttachTagsRequest{}
+	req.SetProductIds(productIds)
+	req.SetTagNames(tagNames)
+
+	resp, err := r.commandServiceClient.Tag.AttachTags(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewTagChange(toModelTags(resp.Msg.GetTags()), int(resp.Msg.GetAttachedCount())), nil
+}
+
+// DetachTags は複数の商品から複数のタグを一括で解除します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productIds: 商品ID
+//   - tagNames: タグ名
+//
+// Returns:
+//   - *models.TagChange: 解除結果
+//   - error: エラー
+func (r *CQRSRepositoryImpl) DetachTags(ctx context.Context, productIds []string, tagNames []string) (*models.TagChange, error) {
+	req := &command.DetachTagsRequest{}
+	req.SetProductIds(productIds)
+	req.SetTagNames(tagNames)
+
+	resp, err := r.commandServiceClient.Tag.DetachTags(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewTagChange(toModelTags(resp.Msg.GetTags()), int(resp.Msg.GetDetachedCount())), nil
+}
+
 // toModelCategory はprotobufのCategoryをドメインモデルに変換します。
 //
 // Parameters:
@@ -414,7 +912,10 @@ func (r *CQRSRepositoryImpl) ProductByKeyword(ctx context.Context, keyword strin
 // Returns:
 //   - *models.Category: Categoryドメインモデル
 func toModelCategory(category *common.Category) *models.Category {
-	return models.NewCategory(category.GetId(), category.GetName())
+	return models.NewCategory(category.GetId(), category.GetName()).
+		WithTranslations(category.GetTranslations()).
+		WithLocale(category.GetLocale()).
+		WithSlug(category.GetSlug())
 }
 
 // toModelCategories はprotobufのCategoryスライスをドメインモデルスライスに変換します。
@@ -440,7 +941,167 @@ func toModelCategories(categories []*common.Category) []*models.Category {
 // Returns:
 //   - *models.Product: Productドメインモデル
 func toModelProduct(product *common.Product) *models.Product {
-	return models.NewProduct(product.GetId(), product.GetName(), uint32(product.GetPrice()), toModelCategory(product.GetCategory()))
+	p := models.NewProduct(product.GetId(), product.GetName(), uint32(product.GetPrice()), toModelCategory(product.GetCategory())).
+		WithTax(product.GetPriceExcludingTax().GetCurrency(), toModelTaxClass(product.GetTaxClass())).
+		WithTranslations(product.GetTranslations()).
+		WithLocale(product.GetLocale()).
+		WithStatus(toModelProductStatus(product.GetStatus())).
+		WithSlug(product.GetSlug())
+	if product.HasPriceExcludingTax() {
+		var includingTax *models.Money
+		if product.HasPriceIncludingTax() {
+			includingTax = toModelMoney(product.GetPriceIncludingTax())
+		}
+		p = p.WithPrices(toModelMoney(product.GetPriceExcludingTax()), includingTax)
+	}
+	if len(product.GetTags()) > 0 {
+		p = p.WithTags(toModelTags(product.GetTags()))
+	}
+	if barcode := product.GetBarcode(); barcode != "" {
+		p = p.WithBarcode(&barcode)
+	}
+	if len(product.GetPriceSchedules()) > 0 {
+		schedules := make([]*models.PriceSchedule, len(product.GetPriceSchedules()))
+		for i, schedule := range product.GetPriceSchedules() {
+			schedules[i] = toModelPriceSchedule(schedule)
+		}
+		p = p.WithPriceSchedules(schedules)
+	}
+	if len(product.GetVariants()) == 0 {
+		return p
+	}
+	variants := make([]*models.Variant, len(product.GetVariants()))
+	for i, variant := range product.GetVariants() {
+		variants[i] = toModelVariant(variant)
+	}
+	return p.WithVariants(variants)
+}
+
+// toModelMoney はprotobufのMoneyをドメインモデルに変換します。
+//
+// Parameters:
+//   - m: protobuf Money
+//
+// Returns:
+//   - *models.Money: Moneyドメインモデル
+func toModelMoney(m *common.Money) *models.Money {
+	return models.NewMoney(m.GetAmount(), m.GetCurrency())
+}
+
+// toModelTaxClass はprotobufの税率区分をドメインモデルの税率区分に変換します。
+//
+// Parameters:
+//   - class: protobuf TaxClass
+//
+// Returns:
+//   - string: 税率区分（未指定の場合はエンプティ）
+func toModelTaxClass(class common.TaxClass) string {
+	if class == common.TaxClass_TAX_CLASS_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(class.String(), "TAX_CLASS_")
+}
+
+// toModelProductStatus はprotobufの販売状態をドメインモデルの販売状態に変換します。
+//
+// Parameters:
+//   - status: protobuf ProductStatus
+//
+// Returns:
+//   - string: 販売状態（未指定の場合はエンプティ）
+func toModelProductStatus(status common.ProductStatus) string {
+	if status == common.ProductStatus_PRODUCT_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(status.String(), "PRODUCT_STATUS_")
+}
+
+// toProtoTaxClass はドメインモデルの税率区分をprotobufの税率区分に変換します。
+//
+// Parameters:
+//   - class: 税率区分（エンプティの場合は未指定）
+//
+// Returns:
+//   - common.TaxClass: protobuf TaxClass
+func toProtoTaxClass(class string) common.TaxClass {
+	return common.TaxClass(common.TaxClass_value["TAX_CLASS_"+class])
+}
+
+// toModelVariant はprotobufのProductVariantをドメインモデルに変換します。
+//
+// Parameters:
+//   - variant: protobuf ProductVariant
+//
+// Returns:
+//   - *models.Variant: Variantドメインモデル
+func toModelVariant(variant *common.ProductVariant) *models.Variant {
+	options := make([]*models.VariantOption, len(variant.GetOptions()))
+	for i, option := range variant.GetOptions() {
+		options[i] = models.NewVariantOption(option.GetAxis(), option.GetValue())
+	}
+	var priceOverride *uint32
+	if variant.HasPriceOverride() {
+		price := uint32(variant.GetPriceOverride())
+		priceOverride = &price
+	}
+	return models.NewVariant(
+		variant.GetId(),
+		variant.GetSku(),
+		options,
+		priceOverride,
+		uint32(variant.GetPrice()),
+		strings.TrimPrefix(variant.GetStatus().String(), "VARIANT_STATUS_"),
+	)
+}
+
+// toModelPriceSchedule はprotobufのPriceScheduleをドメインモデルに変換します。
+//
+// Parameters:
+//   - schedule: protobuf PriceSchedule
+//
+// Returns:
+//   - *models.PriceSchedule: PriceScheduleドメインモデル
+func toModelPriceSchedule(schedule *common.PriceSchedule) *models.PriceSchedule {
+	var regularPrice *uint32
+	if schedule.HasRegularPrice() {
+		price := uint32(schedule.GetRegularPrice())
+		regularPrice = &price
+	}
+	return models.NewPriceSchedule(
+		schedule.GetId(),
+		schedule.GetProductId(),
+		uint32(schedule.GetPrice()),
+		regularPrice,
+		strings.TrimPrefix(schedule.GetStatus().String(), "PRICE_SCHEDULE_STATUS_"),
+		schedule.GetStartsAt().AsTime(),
+		schedule.GetEndsAt().AsTime(),
+	)
+}
+
+// toModelTag はprotobufのTagをドメインモデルに変換します。
+//
+// Parameters:
+//   - tag: protobuf Tag
+//
+// Returns:
+//   - *models.Tag: Tagドメインモデル
+func toModelTag(tag *common.Tag) *models.Tag {
+	return models.NewTag(tag.GetId(), tag.GetName())
+}
+
+// toModelTags はprotobufのTagスライスをドメインモデルスライスに変換します。
+//
+// Parameters:
+//   - tags: protobuf Tagスライス
+//
+// Returns:
+//   - []*models.Tag: Tagドメインモデルスライス
+func toModelTags(tags []*common.Tag) []*models.Tag {
+	result := make([]*models.Tag, len(tags))
+	for i, tag := range tags {
+		result[i] = toModelTag(tag)
+	}
+	return result
 }
 
 // toModelProducts はprotobufのProductスライスをドメインモデルスライスに変換します。
@@ -458,4 +1119,19 @@ func toModelProducts(products []*common.Product) []*models.Product {
 	return result
 }
 
+// toModelSuggestions はprotobufのProductSuggestionスライスをドメインモデルスライスに変換します。
+//
+// Parameters:
+//   - suggestions: protobuf ProductSuggestionスライス
+//
+// Returns:
+//   - []*models.ProductSuggestion: ProductSuggestionドメインモデルスライス
+func toModelSuggestions(suggestions []*query.ProductSuggestion) []*models.ProductSuggestion {
+	result := make([]*models.ProductSuggestion, len(suggestions))
+	for i, s := range suggestions {
+		result[i] = models.NewProductSuggestion(s.GetProductId(), s.GetName(), s.GetHighlight(), toModelCategory(s.GetCategory()), s.GetScore())
+	}
+	return result
+}
+
 var _ repository.CQRSRepository = (*CQRSRepositoryImpl)(nil)
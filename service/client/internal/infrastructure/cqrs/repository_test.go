@@ -6,9 +6,11 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/domain/models"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/domain/repository"
@@ -36,7 +38,11 @@ func TestMain(m *testing.M) {
 	}
 	client := cqrs.NewClient(cfg)
 	commandServiceClient = cqrs.NewCommandServiceClient(client, cfg)
-	queryServiceClient = cqrs.NewQueryServiceClient(client, cfg)
+	pool, err := cqrs.NewQueryBalancer(client, cfg, logger)
+	if err != nil {
+		panic(err)
+	}
+	queryServiceClient = cqrs.NewQueryServiceClient(client, cfg, pool)
 	m.Run()
 }
 
@@ -150,11 +156,13 @@ func TestCQRSRepository_Category(t *testing.T) {
 	t.Run("カテゴリの作成", func(t *testing.T) {
 		// カテゴリ名は1文字以上20文字以下
 		categoryName := "TestCat" + uuid.New().String()[:8]
-		created, err := repo.CreateCategory(ctx, categoryName)
+		created, err := repo.CreateCategory(ctx, categoryName, "")
 		require.NoError(t, err)
 		require.NotNil(t, created)
 		assert.NotEmpty(t, created.Id())
 		assert.Equal(t, categoryName, created.Name())
+		// スラッグはカテゴリ名から生成される
+		assert.Equal(t, strings.ToLower(categoryName), created.Slug())
 		createdCategory = created
 	})
 
@@ -202,6 +210,23 @@ func TestCQRSRepository_Category(t *testing.T) {
 		createdCategory = updated
 	})
 
+	t.Run("スラッグでカテゴリを取得", func(t *testing.T) {
+		require.NotNil(t, createdCategory, "カテゴリが作成されていません")
+
+		require.Eventually(t,
+			func() bool {
+				category, moved, err := repo.CategoryBySlug(ctx, createdCategory.Slug())
+				if err != nil {
+					return false
+				}
+				return assert.Equal(t, createdCategory.Id(), category.Id()) && assert.False(t, moved)
+			},
+			replicationTimeout,
+			pollingInterval,
+			"指定時間内にカテゴリがレプリケーションされませんでした",
+		)
+	})
+
 	t.Run("カテゴリ一覧の取得", func(t *testing.T) {
 		require.NotNil(t, createdCategory, "カテゴリが作成されていません")
 
@@ -249,7 +274,7 @@ func TestCQRSRepository_Product(t *testing.T) {
 	t.Run("テスト用カテゴリの作成", func(t *testing.T) {
 		// カテゴリ名は1文字以上20文字以下
 		categoryName := "ProdCat" + uuid.New().String()[:8]
-		created, err := repo.CreateCategory(ctx, categoryName)
+		created, err := repo.CreateCategory(ctx, categoryName, "")
 		require.NoError(t, err)
 		require.NotNil(t, created)
 		testCategory = created
@@ -261,13 +286,19 @@ func TestCQRSRepository_Product(t *testing.T) {
 		// 商品名も適切な長さに
 		productName := "TestProd" + uuid.New().String()[:8]
 		productPrice := uint32(1000)
-		created, err := repo.CreateProduct(ctx, productName, productPrice, testCategory)
+		product := models.NewProduct("", productName, productPrice, testCategory).WithTax("JPY", "REDUCED")
+		created, err := repo.CreateProduct(ctx, product)
 		require.NoError(t, err)
 		require.NotNil(t, created)
 		assert.NotEmpty(t, created.Id())
 		assert.Equal(t, productName, created.Name())
 		assert.Equal(t, productPrice, created.Price())
 		assert.Equal(t, testCategory.Id(), created.Category().Id())
+		assert.Equal(t, "REDUCED", created.TaxClass())
+		require.NotNil(t, created.PriceExcludingTax())
+		assert.Equal(t, int64(productPrice), created.PriceExcludingTax().Amount())
+		assert.Equal(t, "JPY", created.PriceExcludingTax().Currency())
+		assert.Nil(t, created.PriceIncludingTax(), "税込価格は問合せサービスのみ設定すること")
 		createdProduct = created
 	})
 
@@ -291,6 +322,7 @@ func TestCQRSRepository_Product(t *testing.T) {
 		assert.Equal(t, createdProduct.Id(), updated.Id())
 		assert.Equal(t, updatedName, updated.Name())
 		assert.Equal(t, updatedPrice, updated.Price())
+		assert.Equal(t, "REDUCED", updated.TaxClass(), "税率区分を指定しない場合は現在の税率区分を維持すること")
 		createdProduct = updated
 	})
 
@@ -310,6 +342,125 @@ func TestCQRSRepository_Product(t *testing.T) {
 		assertProductInSearch(t, ctx, repo, keyword, createdProduct.Id())
 	})
 
+	t.Run("バーコードで商品取得", func(t *testing.T) {
+		// 初期データの水性ボールペン(黒)
+		product, err := repo.ProductByBarcode(ctx, "4901234567894")
+		require.NoError(t, err)
+		assert.Equal(t, "ac413f22-0cf1-490a-9635-7e9ca810e544", product.Id())
+		require.NotNil(t, product.Barcode())
+		assert.Equal(t, "4901234567894", *product.Barcode())
+
+		_, err = repo.ProductByBarcode(ctx, "036000291452")
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("商品サジェスト(双方向Streaming)", func(t *testing.T) {
+		// 検索インデックスへの同期は定期実行のため、ここではストリームの往復のみを確認する
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, streamTimeout)
+		defer cancel()
+		queries := make(chan *repository.SuggestProductsQuery, 2)
+		ch, err := repo.SuggestProducts(ctxWithTimeout, queries)
+		require.NoError(t, err)
+
+		for _, prefix := range []string{"Test", "TestProd"} {
+			queries <- &repository.SuggestProductsQuery{Prefix: prefix, Limit: 5}
+			select {
+			case result, ok := <-ch:
+				require.True(t, ok, "サジェストのストリームが終了しました")
+				require.NoError(t, result.Err)
+				assert.Equal(t, prefix, result.Prefix)
+			case <-ctxWithTimeout.Done():
+				require.Fail(t, "SuggestProducts did not respond before timeout")
+			}
+		}
+
+		close(queries)
+		for result := range ch {
+			require.NoError(t, result.Err)
+		}
+	})
+
+	t.Run("バリエーションの追加・更新・削除", func(t *testing.T) {
+		require.NotNil(t, createdProduct, "商品が作成されていません")
+
+		sku := "TS-" + strings.ToUpper(uuid.New().String()[:8])
+		options := []*models.VariantOption{models.NewVariantOption("サイズ", "M")}
+		added, err := repo.AddVariant(ctx, createdProduct.Id(), models.NewVariant("", sku, options, nil, 0, ""))
+		require.NoError(t, err)
+		require.NotNil(t, added)
+		assert.NotEmpty(t, added.Id())
+		assert.Equal(t, sku, added.Sku())
+		assert.Equal(t, createdProduct.Price(), added.Price())
+		assert.Equal(t, "ACTIVE", added.Status())
+
+		priceOverride := uint32(2500)
+		updated, err := repo.UpdateVariant(ctx, createdProduct.Id(), models.NewVariant(added.Id(), sku, options, &priceOverride, 0, "INACTIVE"))
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, priceOverride, updated.Price())
+		assert.Equal(t, "INACTIVE", updated.Status())
+
+		// 同じSKUのバリエーションは追加できない
+		_, err = repo.AddVariant(ctx, createdProduct.Id(), models.NewVariant("", sku, []*models.VariantOption{models.NewVariantOption("サイズ", "L")}, nil, 0, ""))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+		err = repo.RemoveVariant(ctx, createdProduct.Id(), added.Id())
+		require.NoError(t, err)
+	})
+
+	t.Run("タグの付与・絞り込み・解除", func(t *testing.T) {
+		require.NotNil(t, createdProduct, "商品が作成されていません")
+
+		tagName := "tag-" + uuid.New().String()[:8]
+		attached, err := repo.AttachTags(ctx, []string{createdProduct.Id()}, []string{tagName})
+		require.NoError(t, err)
+		require.Len(t, attached.Tags(), 1)
+		assert.Equal(t, tagName, attached.Tags()[0].Name())
+		assert.Equal(t, 1, attached.Changed())
+
+		waitForReplication(t, func() bool {
+			products, err := repo.ProductListByTags(ctx, []string{tagName})
+			return err == nil && findProductByID(products, createdProduct.Id())
+		})
+		usages, err := repo.TagList(ctx)
+		require.NoError(t, err)
+		assert.True(t, func() bool {
+			for _, usage := range usages {
+				if usage.Tag().Name() == tagName {
+					return usage.ProductCount() == 1
+				}
+			}
+			return false
+		}())
+
+		detached, err := repo.DetachTags(ctx, []string{createdProduct.Id()}, []string{tagName})
+		require.NoError(t, err)
+		assert.Equal(t, 1, detached.Changed())
+	})
+
+	t.Run("単価の一括変更（プレビューと適用）", func(t *testing.T) {
+		require.NotNil(t, createdProduct, "商品が作成されていません")
+		require.NotNil(t, testCategory, "テスト用カテゴリが作成されていません")
+
+		// テスト用カテゴリには更新後の単価2000の商品のみが属する
+		target := &repository.PriceAdjustmentTarget{CategoryId: testCategory.Id()}
+		adjustment := models.NewPriceAdjustment("PERCENT", -10, "", 0, nil, nil)
+		preview, err := repo.AdjustPrices(ctx, target, adjustment, true)
+		require.NoError(t, err)
+		require.Len(t, preview, 1)
+		assert.Equal(t, createdProduct.Id(), preview[0].ProductId())
+		assert.Equal(t, uint32(2000), preview[0].BeforePrice())
+		assert.Equal(t, uint32(1800), preview[0].AfterPrice())
+
+		applied, err := repo.AdjustPrices(ctx, target, adjustment, false)
+		require.NoError(t, err)
+		require.Len(t, applied, 1)
+		assert.Equal(t, uint32(1800), applied[0].AfterPrice())
+		waitForProductReplication(t, ctx, repo, createdProduct.Id(), applied[0].ProductName(), 1800)
+	})
+
 	t.Run("商品の削除", func(t *testing.T) {
 		require.NotNil(t, createdProduct, "商品が作成されていません")
 
@@ -0,0 +1,96 @@
+package cqrs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/infrastructure/balancer"
+	"github.com/spf13/viper"
+)
+
+// procedureDeadline は設定ファイルの[[cqrs.deadlines]]の要素です。
+type procedureDeadline struct {
+	Procedure string        `mapstructure:"procedure"` // 手続き（例: /query.v1.ProductService/SearchProductsByKeyword）
+	Timeout   time.Duration `mapstructure:"timeout"`   // 期限
+}
+
+// loadDeadlines は手続きごとの期限を読み込みます。未設定の場合はエンプティのマップを返します。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - map[string]time.Duration: 手続きごとの期限
+//   - error: 手続きまたは期限が不正な場合のエラー
+func loadDeadlines(v *viper.Viper) (map[string]time.Duration, error) {
+	deadlines := map[string]time.Duration{}
+	if !v.IsSet("cqrs.deadlines") {
+		return deadlines, nil
+	}
+	var entries []procedureDeadline
+	if err := v.UnmarshalKey("cqrs.deadlines", &entries); err != nil {
+		return nil, fmt.Errorf("failed to read cqrs.deadlines: %w", err)
+	}
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Procedure, "/") || entry.Timeout <= 0 {
+			return nil, fmt.Errorf("invalid cqrs.deadlines entry: procedure %q timeout %s", entry.Procedure, entry.Timeout)
+		}
+		deadlines[entry.Procedure] = entry.Timeout
+	}
+	return deadlines, nil
+}
+
+// retryPolicy はQuery Serviceの参照系RPCの再試行の方針を返します。
+// 参照系のRPCは冪等なため、再起動などで一時的に接続できないUnavailableのみ再試行します。
+func (cfg *CQRSServiceConfig) retryPolicy() interceptor.RetryPolicy {
+	policy := interceptor.DefaultRetryPolicy()
+	policy.MaxAttempts = cfg.RetryMaxAttempts
+	policy.InitialBackoff = cfg.RetryInitialBackoff
+	policy.MaxBackoff = cfg.RetryMaxBackoff
+	policy.RetryableCodes = []connect.Code{connect.CodeUnavailable}
+	return policy
+}
+
+// circuitBreakerPolicy はバックエンドごとのサーキットブレーカーの方針を返します。
+func (cfg *CQRSServiceConfig) circuitBreakerPolicy() interceptor.CircuitBreakerPolicy {
+	policy := interceptor.DefaultCircuitBreakerPolicy()
+	policy.FailureThreshold = cfg.BreakerFailureThreshold
+	policy.OpenTimeout = cfg.BreakerOpenTimeout
+	return policy
+}
+
+// queryTargets はQuery Serviceの接続先を返します。
+// QueryServiceEndpointsが空の場合はQueryServiceURLのみを接続先とします。
+func (cfg *CQRSServiceConfig) queryTargets() []string {
+	if len(cfg.QueryServiceEndpoints) > 0 {
+		return cfg.QueryServiceEndpoints
+	}
+	return []string{cfg.QueryServiceURL}
+}
+
+// balancerConfig はQuery Serviceのレプリカへの振り分けの設定を返します。
+func (cfg *CQRSServiceConfig) balancerConfig() balancer.Config {
+	return balancer.Config{
+		Targets:             cfg.queryTargets(),
+		Policy:              balancer.Policy(cfg.QueryBalancer),
+		DNSRefreshInterval:  cfg.QueryDNSRefreshInterval,
+		HealthCheckInterval: cfg.QueryHealthCheckInterval,
+		EjectionThreshold:   cfg.QueryEjectionThreshold,
+	}
+}
+
+// validateBalancer はQuery Serviceのレプリカへの振り分けの設定を検証します。
+func (cfg *CQRSServiceConfig) validateBalancer() error {
+	if cfg.QueryBalancer != "" {
+		if _, err := balancer.ParsePolicy(cfg.QueryBalancer); err != nil {
+			return fmt.Errorf("invalid cqrs.query_balancer: %w", err)
+		}
+	}
+	if err := balancer.ValidateTargets(cfg.queryTargets()); err != nil {
+		return fmt.Errorf("invalid cqrs.query_service_endpoints: %w", err)
+	}
+	return nil
+}
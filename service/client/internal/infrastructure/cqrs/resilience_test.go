@@ -0,0 +1,414 @@
+package cqrs_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	command "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
+	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
+	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/infrastructure/config"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/infrastructure/cqrs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// flakyBackend は指定した回数だけUnavailableを返すCommand Service・Query Serviceです。
+type flakyBackend struct {
+	queryconnect.UnimplementedCategoryServiceHandler
+	failures atomic.Int32 // 残りの失敗回数
+	calls    atomic.Int32 // GetCategoryByIdの呼び出し回数
+	delay    time.Duration
+	release  chan struct{} // slowCategoryIdの呼び出しはreleaseを閉じるまで待ってから成功する
+}
+
+// slowCategoryId はflakyBackend.releaseを待つカテゴリIDです。
+const slowCategoryId = "c-slow"
+
+func (b *flakyBackend) GetCategoryById(ctx context.Context, req *connect.Request[query.GetCategoryByIdRequest]) (*connect.Response[query.GetCategoryByIdResponse], error) {
+	b.calls.Add(1)
+	if req.Msg.GetId() == slowCategoryId {
+		select {
+		case <-b.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		resp := &query.GetCategoryByIdResponse{}
+		resp.SetCategory(&common.Category{})
+		return connect.NewResponse(resp), nil
+	}
+	if b.delay > 0 {
+		select {
+		case <-time.After(b.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if b.failures.Add(-1) >= 0 {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("restarting"))
+	}
+	category := &common.Category{}
+	category.SetId(req.Msg.GetId())
+	resp := &query.GetCategoryByIdResponse{}
+	resp.SetCategory(category)
+	return connect.NewResponse(resp), nil
+}
+
+// unavailableCommands は常にUnavailableを返すCommand ServiceのCategoryServiceです。
+type unavailableCommands struct {
+	cmdconnect.UnimplementedCategoryServiceHandler
+	calls atomic.Int32
+}
+
+func (u *unavailableCommands) CreateCategory(context.Context, *connect.Request[command.CreateCategoryRequest]) (*connect.Response[command.CreateCategoryResponse], error) {
+	u.calls.Add(1)
+	return nil, connect.NewError(connect.CodeUnavailable, errors.New("restarting"))
+}
+
+// slowProducts は最初の呼び出しだけ遅延するQuery ServiceのProductServiceです。
+type slowProducts struct {
+	queryconnect.UnimplementedProductServiceHandler
+	calls atomic.Int32
+	delay time.Duration
+}
+
+func (s *slowProducts) GetProductById(ctx context.Context, req *connect.Request[query.GetProductByIdRequest]) (*connect.Response[query.GetProductByIdResponse], error) {
+	if s.calls.Add(1) == 1 {
+		select {
+		case <-time.After(s.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	product := &common.Product{}
+	product.SetId(req.Msg.GetId())
+	resp := &query.GetProductByIdResponse{}
+	resp.SetProduct(product)
+	return connect.NewResponse(resp), nil
+}
+
+func newResilienceTestEnv(t *testing.T, backend *flakyBackend, commands *unavailableCommands) *cqrs.CQRSServiceConfig {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(queryconnect.NewCategoryServiceHandler(backend))
+	mux.Handle(cmdconnect.NewCategoryServiceHandler(commands))
+	server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	t.Cleanup(server.Close)
+	return &cqrs.CQRSServiceConfig{
+		CommandServiceURL:       server.URL,
+		QueryServiceURL:         server.URL,
+		RequestTimeout:          5 * time.Second,
+		TCPTimeout:              time.Second,
+		DefaultDeadline:         5 * time.Second,
+		Deadlines:               map[string]time.Duration{},
+		RetryMaxAttempts:        3,
+		RetryInitialBackoff:     time.Millisecond,
+		RetryMaxBackoff:         time.Millisecond,
+		BreakerFailureThreshold: 2,
+		BreakerOpenTimeout:      50 * time.Millisecond,
+	}
+}
+
+func newQueryServiceClient(t *testing.T, cfg *cqrs.CQRSServiceConfig) *cqrs.QueryServiceClient {
+	t.Helper()
+	client := cqrs.NewClient(cfg)
+	pool, err := cqrs.NewQueryBalancer(client, cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	return cqrs.NewQueryServiceClient(client, cfg, pool)
+}
+
+func getCategory(client *cqrs.QueryServiceClient) error {
+	return getCategoryById(context.Background(), client, "c-001")
+}
+
+func getCategoryById(ctx context.Context, client *cqrs.QueryServiceClient, id string) error {
+	req := &query.GetCategoryByIdRequest{}
+	req.SetId(id)
+	_, err := client.Category.GetCategoryById(ctx, connect.NewRequest(req))
+	return err
+}
+
+// getSlowCategoryCanceled はslowCategoryIdの呼び出しがバックエンドに届いた後にキャンセルし、そのエラーを返します。
+func getSlowCategoryCanceled(t *testing.T, client *cqrs.QueryServiceClient, backend *flakyBackend) error {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := backend.calls.Load()
+	go func() {
+		for backend.calls.Load() == calls {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+	}()
+	return getCategoryById(ctx, client, slowCategoryId)
+}
+
+func TestQueryServiceClientResilience(t *testing.T) {
+	t.Run("正常系: Unavailableは再試行して成功する", func(t *testing.T) {
+		// Arrange
+		backend := &flakyBackend{}
+		backend.failures.Store(2)
+		cfg := newResilienceTestEnv(t, backend, &unavailableCommands{})
+		client := newQueryServiceClient(t, cfg)
+
+		// Act
+		err := getCategory(client)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), backend.calls.Load())
+		assert.Equal(t, interceptor.CircuitClosed, client.CircuitBreaker().State())
+	})
+
+	t.Run("異常系: 連続して失敗するとサーキットブレーカーが開き、呼び出さずに失敗する", func(t *testing.T) {
+		// Arrange
+		backend := &flakyBackend{}
+		backend.failures.Store(100)
+		cfg := newResilienceTestEnv(t, backend, &unavailableCommands{})
+		cfg.RetryMaxAttempts = 1
+		cmdClient := cqrs.NewCommandServiceClient(cqrs.NewClient(cfg), cfg)
+		client := newQueryServiceClient(t, cfg)
+		status := cqrs.NewBackendStatus(cmdClient, client)
+
+		// Act
+		require.Error(t, getCategory(client))
+		require.Error(t, getCategory(client))
+		err := getCategory(client)
+
+		// Assert
+		assert.ErrorIs(t, err, interceptor.ErrCircuitOpen)
+		assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+		assert.Equal(t, int32(2), backend.calls.Load(), "開の間はバックエンドを呼び出さない")
+		assert.Equal(t, map[string]interceptor.CircuitState{
+			"command": interceptor.CircuitClosed,
+			"query":   interceptor.CircuitOpen,
+		}, status.CircuitStates())
+		assert.Equal(t, uint64(1), client.CircuitBreaker().Rejected())
+	})
+
+	t.Run("正常系: 開から一定時間後の試行が成功すると閉に戻る", func(t *testing.T) {
+		// Arrange
+		backend := &flakyBackend{}
+		backend.failures.Store(2)
+		cfg := newResilienceTestEnv(t, backend, &unavailableCommands{})
+		cfg.RetryMaxAttempts = 1
+		client := newQueryServiceClient(t, cfg)
+		require.Error(t, getCategory(client))
+		require.Error(t, getCategory(client))
+		require.Equal(t, interceptor.CircuitOpen, client.CircuitBreaker().State())
+
+		// Act
+		require.Eventually(t, func() bool {
+			return client.CircuitBreaker().State() == interceptor.CircuitHalfOpen
+		}, time.Second, 10*time.Millisecond)
+		err := getCategory(client)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, interceptor.CircuitClosed, client.CircuitBreaker().State())
+	})
+
+	t.Run("異常系: キャンセルした呼び出し（ヘッジの敗者など）は連続失敗回数をリセットしない", func(t *testing.T) {
+		// Arrange
+		backend := &flakyBackend{release: make(chan struct{})}
+		backend.failures.Store(100)
+		cfg := newResilienceTestEnv(t, backend, &unavailableCommands{})
+		cfg.RetryMaxAttempts = 1
+		cfg.BreakerOpenTimeout = time.Minute
+		client := newQueryServiceClient(t, cfg)
+		require.Error(t, getCategory(client))
+
+		// Act
+		canceledErr := getSlowCategoryCanceled(t, client, backend)
+		err := getCategory(client)
+
+		// Assert
+		assert.Equal(t, connect.CodeCanceled, connect.CodeOf(canceledErr))
+		assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+		assert.Equal(t, interceptor.CircuitOpen, client.CircuitBreaker().State(), "キャンセルを挟んでも2回の失敗で開になる")
+	})
+
+	t.Run("異常系: 半開の試行がキャンセルされた場合は閉に戻さず、次の呼び出しで試行する", func(t *testing.T) {
+		// Arrange
+		backend := &flakyBackend{release: make(chan struct{})}
+		backend.failures.Store(2)
+		cfg := newResilienceTestEnv(t, backend, &unavailableCommands{})
+		cfg.RetryMaxAttempts = 1
+		client := newQueryServiceClient(t, cfg)
+		require.Error(t, getCategory(client))
+		require.Error(t, getCategory(client))
+		require.Eventually(t, func() bool {
+			return client.CircuitBreaker().State() == interceptor.CircuitHalfOpen
+		}, time.Second, 10*time.Millisecond)
+
+		// Act
+		canceledErr := getSlowCategoryCanceled(t, client, backend)
+		stateAfterCancel := client.CircuitBreaker().State()
+		err := getCategory(client)
+
+		// Assert
+		assert.Equal(t, connect.CodeCanceled, connect.CodeOf(canceledErr))
+		assert.Equal(t, interceptor.CircuitHalfOpen, stateAfterCancel)
+		require.NoError(t, err, "試行枠を解放するため次の呼び出しを試行として通す")
+		assert.Equal(t, interceptor.CircuitClosed, client.CircuitBreaker().State())
+	})
+
+	t.Run("異常系: 開になる前に開始した呼び出しが遅れて成功しても閉に戻さない", func(t *testing.T) {
+		// Arrange
+		backend := &flakyBackend{release: make(chan struct{})}
+		backend.failures.Store(100)
+		cfg := newResilienceTestEnv(t, backend, &unavailableCommands{})
+		cfg.RetryMaxAttempts = 1
+		cfg.BreakerOpenTimeout = time.Minute
+		client := newQueryServiceClient(t, cfg)
+		slowErr := make(chan error, 1)
+		go func() { slowErr <- getCategoryById(context.Background(), client, slowCategoryId) }()
+		require.Eventually(t, func() bool { return backend.calls.Load() == 1 }, time.Second, time.Millisecond)
+		require.Error(t, getCategory(client))
+		require.Error(t, getCategory(client))
+		require.Equal(t, interceptor.CircuitOpen, client.CircuitBreaker().State())
+
+		// Act
+		close(backend.release)
+		err := <-slowErr
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, interceptor.CircuitOpen, client.CircuitBreaker().State())
+	})
+
+	t.Run("正常系: GetProductByIdは応答が遅い場合にヘッジリクエストの応答を返す", func(t *testing.T) {
+		// Arrange
+		products := &slowProducts{delay: time.Second}
+		mux := http.NewServeMux()
+		mux.Handle(queryconnect.NewProductServiceHandler(products))
+		server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+		t.Cleanup(server.Close)
+		cfg := newResilienceTestEnv(t, &flakyBackend{}, &unavailableCommands{})
+		cfg.QueryServiceURL = server.URL
+		cfg.QueryHedgeDelay = 20 * time.Millisecond
+		client := newQueryServiceClient(t, cfg)
+		req := &query.GetProductByIdRequest{}
+		req.SetId("p-001")
+
+		// Act
+		start := time.Now()
+		resp, err := client.Product.GetProductById(context.Background(), connect.NewRequest(req))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "p-001", resp.Msg.GetProduct().GetId())
+		assert.Equal(t, int32(2), products.calls.Load())
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	t.Run("異常系: 手続きごとの期限を超えるとDeadlineExceededになる", func(t *testing.T) {
+		// Arrange
+		backend := &flakyBackend{delay: time.Second}
+		cfg := newResilienceTestEnv(t, backend, &unavailableCommands{})
+		cfg.Deadlines[queryconnect.CategoryServiceGetCategoryByIdProcedure] = 50 * time.Millisecond
+		client := newQueryServiceClient(t, cfg)
+
+		// Act
+		start := time.Now()
+		err := getCategory(client)
+
+		// Assert
+		assert.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(err))
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+}
+
+func TestCommandServiceClientResilience(t *testing.T) {
+	t.Run("異常系: 更新系のRPCは再試行しない", func(t *testing.T) {
+		// Arrange
+		commands := &unavailableCommands{}
+		cfg := newResilienceTestEnv(t, &flakyBackend{}, commands)
+		client := cqrs.NewCommandServiceClient(cqrs.NewClient(cfg), cfg)
+		name := &common.CategoryName{}
+		name.SetValue("Drinks")
+		req := &command.CreateCategoryRequest{}
+		req.SetName(name)
+
+		// Act
+		_, err := client.Category.CreateCategory(context.Background(), connect.NewRequest(req))
+
+		// Assert
+		assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+		assert.Equal(t, int32(1), commands.calls.Load())
+	})
+}
+
+func TestNewMetricsRegistry(t *testing.T) {
+	t.Run("正常系: バックエンドごとのサーキットブレーカーの状態を公開する", func(t *testing.T) {
+		// Arrange
+		cfg := newResilienceTestEnv(t, &flakyBackend{}, &unavailableCommands{})
+		status := cqrs.NewBackendStatus(cqrs.NewCommandServiceClient(cqrs.NewClient(cfg), cfg), newQueryServiceClient(t, cfg))
+
+		// Act
+		registry, err := cqrs.NewMetricsRegistry(status)
+		require.NoError(t, err)
+		families, err := registry.Gather()
+		require.NoError(t, err)
+
+		// Assert
+		var states int
+		for _, family := range families {
+			if family.GetName() == "cqrs_backend_circuit_state" {
+				states = len(family.GetMetric())
+			}
+		}
+		assert.Equal(t, 2, states)
+	})
+}
+
+func TestNewCQRSServiceConfig(t *testing.T) {
+	t.Run("正常系: 手続きごとの期限を読み込める", func(t *testing.T) {
+		// Arrange
+		v := config.NewViper("../../../", "config")
+
+		// Act
+		cfg, err := cqrs.NewCQRSServiceConfig(v)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Second, cfg.Deadlines[queryconnect.ProductServiceSearchProductsByKeywordProcedure])
+		assert.Equal(t, 3, cfg.RetryMaxAttempts)
+	})
+
+	t.Run("異常系: 手続きが不正な場合はエラーになる", func(t *testing.T) {
+		// Arrange
+		v := config.NewViper("../../../", "config")
+		v.Set("cqrs.deadlines", []map[string]any{{"procedure": "SearchProductsByKeyword", "timeout": "1s"}})
+
+		// Act
+		_, err := cqrs.NewCQRSServiceConfig(v)
+
+		// Assert
+		assert.ErrorContains(t, err, "invalid cqrs.deadlines entry")
+	})
+
+	t.Run("異常系: Query Serviceの接続先が不正な場合はエラーになる", func(t *testing.T) {
+		// Arrange
+		v := config.NewViper("../../../", "config")
+		v.Set("cqrs.query_service_endpoints", []string{"http://query-1:8085", "dns:///query-headless"})
+
+		// Act
+		_, err := cqrs.NewCQRSServiceConfig(v)
+
+		// Assert
+		assert.ErrorContains(t, err, "invalid cqrs.query_service_endpoints")
+	})
+}
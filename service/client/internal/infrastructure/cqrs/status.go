@@ -0,0 +1,112 @@
+package cqrs
+
+import (
+	"fmt"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/infrastructure/balancer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// BackendStatus はバックエンドごとのサーキットブレーカーの状態を提供します。
+type BackendStatus struct {
+	breakers []*interceptor.CircuitBreaker // バックエンドごとのサーキットブレーカー
+	pool     *balancer.Pool                // Query Serviceのレプリカへの振り分け
+}
+
+// NewBackendStatus はBackendStatusを生成します。
+//
+// Parameters:
+//   - cmdClient: Commandサービスクライアント
+//   - queryClient: Queryサービスクライアント
+//
+// Returns:
+//   - *BackendStatus: BackendStatus
+func NewBackendStatus(cmdClient *CommandServiceClient, queryClient *QueryServiceClient) *BackendStatus {
+	return &BackendStatus{
+		breakers: []*interceptor.CircuitBreaker{cmdClient.CircuitBreaker(), queryClient.CircuitBreaker()},
+		pool:     queryClient.Balancer(),
+	}
+}
+
+// CircuitStates はバックエンドの名前ごとのサーキットブレーカーの状態を返します。
+//
+// Returns:
+//   - map[string]interceptor.CircuitState: バックエンドの名前（command / query）ごとの状態
+func (s *BackendStatus) CircuitStates() map[string]interceptor.CircuitState {
+	states := make(map[string]interceptor.CircuitState, len(s.breakers))
+	for _, breaker := range s.breakers {
+		states[breaker.Name()] = breaker.State()
+	}
+	return states
+}
+
+// NewMetricsRegistry はバックエンドの状態とランタイムのメトリクスを登録したPrometheusのレジストリを生成します。
+// サーキットブレーカーの状態はスクレイプ時に取得するため、状態の変化を通知する必要はありません。
+//
+//   - cqrs_backend_circuit_state: サーキットブレーカーの状態（0: closed、1: half-open、2: open）
+//   - cqrs_backend_circuit_rejected_total: サーキットブレーカーが拒否した呼び出しの累計
+//   - cqrs_query_endpoints: Query Serviceのレプリカの数（state: available / ejected）
+//
+// Parameters:
+//   - status: バックエンドの状態
+//
+// Returns:
+//   - *prometheus.Registry: レジストリ
+//   - error: メトリクスの登録エラー
+func NewMetricsRegistry(status *BackendStatus) (*prometheus.Registry, error) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	for _, breaker := range status.breakers {
+		labels := prometheus.Labels{"backend": breaker.Name()}
+		state := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   "cqrs",
+			Subsystem:   "backend",
+			Name:        "circuit_state",
+			Help:        "Circuit breaker state of the backend service (0: closed, 1: half-open, 2: open).",
+			ConstLabels: labels,
+		}, func() float64 { return float64(breaker.State()) })
+		rejected := prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   "cqrs",
+			Subsystem:   "backend",
+			Name:        "circuit_rejected_total",
+			Help:        "Total number of calls rejected by the circuit breaker of the backend service.",
+			ConstLabels: labels,
+		}, func() float64 { return float64(breaker.Rejected()) })
+		if err := registry.Register(state); err != nil {
+			return nil, fmt.Errorf("failed to register circuit state metric for %s: %w", breaker.Name(), err)
+		}
+		if err := registry.Register(rejected); err != nil {
+			return nil, fmt.Errorf("failed to register circuit rejected metric for %s: %w", breaker.Name(), err)
+		}
+	}
+	for _, ejected := range []bool{false, true} {
+		state := "available"
+		if ejected {
+			state = "ejected"
+		}
+		endpoints := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   "cqrs",
+			Subsystem:   "query",
+			Name:        "endpoints",
+			Help:        "Number of query service endpoints by state (available: receiving requests, ejected: removed after consecutive failures).",
+			ConstLabels: prometheus.Labels{"state": state},
+		}, func() float64 {
+			var n int
+			for _, endpoint := range status.pool.Endpoints() {
+				if endpoint.Ejected == ejected {
+					n++
+				}
+			}
+			return float64(n)
+		})
+		if err := registry.Register(endpoints); err != nil {
+			return nil, fmt.Errorf("failed to register query endpoints metric for %s: %w", state, err)
+		}
+	}
+	return registry, nil
+}
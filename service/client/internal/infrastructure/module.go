@@ -16,11 +16,16 @@ var Module = fx.Module(
 			config.NewViper,
 			fx.ParamTags(`name:"configPath"`, `name:"configName"`),
 		),
-		log.NewLogger,
+		log.NewLevels,
+		log.NewLoggerWithLevels,
+		log.NewAdminConfig,
+		log.NewAdminHandler,
 		cqrs.NewCQRSServiceConfig,
 		cqrs.NewClient,
 		cqrs.NewCommandServiceClient,
+		cqrs.NewQueryBalancer,
 		cqrs.NewQueryServiceClient,
+		cqrs.NewMetricsRegistry,
 		fx.Annotate(
 			cqrs.NewCQRSRepositoryImpl,
 			fx.As(new(repository.CQRSRepository)),
@@ -28,4 +33,6 @@ var Module = fx.Module(
 	),
 	// ライフサイクルフックを登録
 	fx.Invoke(cqrs.RegisterLifecycleHooks),
+	// 設定ファイルの変更時にログレベルを読み込み直す
+	fx.Invoke(log.WatchConfig),
 )
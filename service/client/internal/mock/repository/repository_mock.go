@@ -42,6 +42,66 @@ func (m *MockCQRSRepository) EXPECT() *MockCQRSRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddVariant mocks base method.
+func (m *MockCQRSRepository) AddVariant(ctx context.Context, productId string, variant *models.Variant) (*models.Variant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVariant", ctx, productId, variant)
+	ret0, _ := ret[0].(*models.Variant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVariant indicates an expected call of AddVariant.
+func (mr *MockCQRSRepositoryMockRecorder) AddVariant(ctx, productId, variant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVariant", reflect.TypeOf((*MockCQRSRepository)(nil).AddVariant), ctx, productId, variant)
+}
+
+// AdjustPrices mocks base method.
+func (m *MockCQRSRepository) AdjustPrices(ctx context.Context, target *repository.PriceAdjustmentTarget, adjustment *models.PriceAdjustment, preview bool) ([]*models.PriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustPrices", ctx, target, adjustment, preview)
+	ret0, _ := ret[0].([]*models.PriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustPrices indicates an expected call of AdjustPrices.
+func (mr *MockCQRSRepositoryMockRecorder) AdjustPrices(ctx, target, adjustment, preview any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustPrices", reflect.TypeOf((*MockCQRSRepository)(nil).AdjustPrices), ctx, target, adjustment, preview)
+}
+
+// AttachTags mocks base method.
+func (m *MockCQRSRepository) AttachTags(ctx context.Context, productIds, tagNames []string) (*models.TagChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachTags", ctx, productIds, tagNames)
+	ret0, _ := ret[0].(*models.TagChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachTags indicates an expected call of AttachTags.
+func (mr *MockCQRSRepositoryMockRecorder) AttachTags(ctx, productIds, tagNames any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTags", reflect.TypeOf((*MockCQRSRepository)(nil).AttachTags), ctx, productIds, tagNames)
+}
+
+// CancelPriceSchedule mocks base method.
+func (m *MockCQRSRepository) CancelPriceSchedule(ctx context.Context, productId, scheduleId string) (*models.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPriceSchedule", ctx, productId, scheduleId)
+	ret0, _ := ret[0].(*models.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPriceSchedule indicates an expected call of CancelPriceSchedule.
+func (mr *MockCQRSRepositoryMockRecorder) CancelPriceSchedule(ctx, productId, scheduleId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPriceSchedule", reflect.TypeOf((*MockCQRSRepository)(nil).CancelPriceSchedule), ctx, productId, scheduleId)
+}
+
 // CategoryById mocks base method.
 func (m *MockCQRSRepository) CategoryById(ctx context.Context, id string) (*models.Category, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +117,22 @@ func (mr *MockCQRSRepositoryMockRecorder) CategoryById(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategoryById", reflect.TypeOf((*MockCQRSRepository)(nil).CategoryById), ctx, id)
 }
 
+// CategoryBySlug mocks base method.
+func (m *MockCQRSRepository) CategoryBySlug(ctx context.Context, slug string) (*models.Category, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CategoryBySlug", ctx, slug)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CategoryBySlug indicates an expected call of CategoryBySlug.
+func (mr *MockCQRSRepositoryMockRecorder) CategoryBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategoryBySlug", reflect.TypeOf((*MockCQRSRepository)(nil).CategoryBySlug), ctx, slug)
+}
+
 // CategoryList mocks base method.
 func (m *MockCQRSRepository) CategoryList(ctx context.Context) ([]*models.Category, error) {
 	m.ctrl.T.Helper()
@@ -73,33 +149,33 @@ func (mr *MockCQRSRepositoryMockRecorder) CategoryList(ctx any) *gomock.Call {
 }
 
 // CreateCategory mocks base method.
-func (m *MockCQRSRepository) CreateCategory(ctx context.Context, categoryName string) (*models.Category, error) {
+func (m *MockCQRSRepository) CreateCategory(ctx context.Context, categoryName, slug string) (*models.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCategory", ctx, categoryName)
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, categoryName, slug)
 	ret0, _ := ret[0].(*models.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateCategory indicates an expected call of CreateCategory.
-func (mr *MockCQRSRepositoryMockRecorder) CreateCategory(ctx, categoryName any) *gomock.Call {
+func (mr *MockCQRSRepositoryMockRecorder) CreateCategory(ctx, categoryName, slug any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCQRSRepository)(nil).CreateCategory), ctx, categoryName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCQRSRepository)(nil).CreateCategory), ctx, categoryName, slug)
 }
 
 // CreateProduct mocks base method.
-func (m *MockCQRSRepository) CreateProduct(ctx context.Context, productName string, productPrice uint32, category *models.Category) (*models.Product, error) {
+func (m *MockCQRSRepository) CreateProduct(ctx context.Context, product *models.Product) (*models.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateProduct", ctx, productName, productPrice, category)
+	ret := m.ctrl.Call(m, "CreateProduct", ctx, product)
 	ret0, _ := ret[0].(*models.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateProduct indicates an expected call of CreateProduct.
-func (mr *MockCQRSRepositoryMockRecorder) CreateProduct(ctx, productName, productPrice, category any) *gomock.Call {
+func (mr *MockCQRSRepositoryMockRecorder) CreateProduct(ctx, product any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockCQRSRepository)(nil).CreateProduct), ctx, productName, productPrice, category)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockCQRSRepository)(nil).CreateProduct), ctx, product)
 }
 
 // DeleteCategory mocks base method.
@@ -130,6 +206,51 @@ func (mr *MockCQRSRepositoryMockRecorder) DeleteProduct(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockCQRSRepository)(nil).DeleteProduct), ctx, id)
 }
 
+// DetachTags mocks base method.
+func (m *MockCQRSRepository) DetachTags(ctx context.Context, productIds, tagNames []string) (*models.TagChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachTags", ctx, productIds, tagNames)
+	ret0, _ := ret[0].(*models.TagChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachTags indicates an expected call of DetachTags.
+func (mr *MockCQRSRepositoryMockRecorder) DetachTags(ctx, productIds, tagNames any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTags", reflect.TypeOf((*MockCQRSRepository)(nil).DetachTags), ctx, productIds, tagNames)
+}
+
+// DiscontinueProduct mocks base method.
+func (m *MockCQRSRepository) DiscontinueProduct(ctx context.Context, productId string) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscontinueProduct", ctx, productId)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscontinueProduct indicates an expected call of DiscontinueProduct.
+func (mr *MockCQRSRepositoryMockRecorder) DiscontinueProduct(ctx, productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscontinueProduct", reflect.TypeOf((*MockCQRSRepository)(nil).DiscontinueProduct), ctx, productId)
+}
+
+// ProductByBarcode mocks base method.
+func (m *MockCQRSRepository) ProductByBarcode(ctx context.Context, barcode string) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductByBarcode", ctx, barcode)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProductByBarcode indicates an expected call of ProductByBarcode.
+func (mr *MockCQRSRepositoryMockRecorder) ProductByBarcode(ctx, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductByBarcode", reflect.TypeOf((*MockCQRSRepository)(nil).ProductByBarcode), ctx, barcode)
+}
+
 // ProductById mocks base method.
 func (m *MockCQRSRepository) ProductById(ctx context.Context, id string) (*models.Product, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +281,22 @@ func (mr *MockCQRSRepositoryMockRecorder) ProductByKeyword(ctx, keyword any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductByKeyword", reflect.TypeOf((*MockCQRSRepository)(nil).ProductByKeyword), ctx, keyword)
 }
 
+// ProductBySlug mocks base method.
+func (m *MockCQRSRepository) ProductBySlug(ctx context.Context, slug string) (*models.Product, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductBySlug", ctx, slug)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ProductBySlug indicates an expected call of ProductBySlug.
+func (mr *MockCQRSRepositoryMockRecorder) ProductBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductBySlug", reflect.TypeOf((*MockCQRSRepository)(nil).ProductBySlug), ctx, slug)
+}
+
 // ProductList mocks base method.
 func (m *MockCQRSRepository) ProductList(ctx context.Context) ([]*models.Product, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +312,65 @@ func (mr *MockCQRSRepositoryMockRecorder) ProductList(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductList", reflect.TypeOf((*MockCQRSRepository)(nil).ProductList), ctx)
 }
 
+// ProductListByTags mocks base method.
+func (m *MockCQRSRepository) ProductListByTags(ctx context.Context, tags []string) ([]*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductListByTags", ctx, tags)
+	ret0, _ := ret[0].([]*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProductListByTags indicates an expected call of ProductListByTags.
+func (mr *MockCQRSRepositoryMockRecorder) ProductListByTags(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductListByTags", reflect.TypeOf((*MockCQRSRepository)(nil).ProductListByTags), ctx, tags)
+}
+
+// PublishProduct mocks base method.
+func (m *MockCQRSRepository) PublishProduct(ctx context.Context, productId string) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishProduct", ctx, productId)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishProduct indicates an expected call of PublishProduct.
+func (mr *MockCQRSRepositoryMockRecorder) PublishProduct(ctx, productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishProduct", reflect.TypeOf((*MockCQRSRepository)(nil).PublishProduct), ctx, productId)
+}
+
+// RemoveVariant mocks base method.
+func (m *MockCQRSRepository) RemoveVariant(ctx context.Context, productId, variantId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVariant", ctx, productId, variantId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveVariant indicates an expected call of RemoveVariant.
+func (mr *MockCQRSRepositoryMockRecorder) RemoveVariant(ctx, productId, variantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVariant", reflect.TypeOf((*MockCQRSRepository)(nil).RemoveVariant), ctx, productId, variantId)
+}
+
+// SchedulePrice mocks base method.
+func (m *MockCQRSRepository) SchedulePrice(ctx context.Context, schedule *models.PriceSchedule) (*models.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePrice", ctx, schedule)
+	ret0, _ := ret[0].(*models.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePrice indicates an expected call of SchedulePrice.
+func (mr *MockCQRSRepositoryMockRecorder) SchedulePrice(ctx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePrice", reflect.TypeOf((*MockCQRSRepository)(nil).SchedulePrice), ctx, schedule)
+}
+
 // StreamProducts mocks base method.
 func (m *MockCQRSRepository) StreamProducts(ctx context.Context) (<-chan *repository.StreamProductsResult, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +386,51 @@ func (mr *MockCQRSRepositoryMockRecorder) StreamProducts(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamProducts", reflect.TypeOf((*MockCQRSRepository)(nil).StreamProducts), ctx)
 }
 
+// SuggestProducts mocks base method.
+func (m *MockCQRSRepository) SuggestProducts(ctx context.Context, queries <-chan *repository.SuggestProductsQuery) (<-chan *repository.SuggestProductsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestProducts", ctx, queries)
+	ret0, _ := ret[0].(<-chan *repository.SuggestProductsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestProducts indicates an expected call of SuggestProducts.
+func (mr *MockCQRSRepositoryMockRecorder) SuggestProducts(ctx, queries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestProducts", reflect.TypeOf((*MockCQRSRepository)(nil).SuggestProducts), ctx, queries)
+}
+
+// SuspendProduct mocks base method.
+func (m *MockCQRSRepository) SuspendProduct(ctx context.Context, productId string) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendProduct", ctx, productId)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendProduct indicates an expected call of SuspendProduct.
+func (mr *MockCQRSRepositoryMockRecorder) SuspendProduct(ctx, productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendProduct", reflect.TypeOf((*MockCQRSRepository)(nil).SuspendProduct), ctx, productId)
+}
+
+// TagList mocks base method.
+func (m *MockCQRSRepository) TagList(ctx context.Context) ([]*models.TagUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagList", ctx)
+	ret0, _ := ret[0].([]*models.TagUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagList indicates an expected call of TagList.
+func (mr *MockCQRSRepositoryMockRecorder) TagList(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagList", reflect.TypeOf((*MockCQRSRepository)(nil).TagList), ctx)
+}
+
 // UpdateCategory mocks base method.
 func (m *MockCQRSRepository) UpdateCategory(ctx context.Context, category *models.Category) (*models.Category, error) {
 	m.ctrl.T.Helper()
@@ -219,3 +460,18 @@ func (mr *MockCQRSRepositoryMockRecorder) UpdateProduct(ctx, product any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockCQRSRepository)(nil).UpdateProduct), ctx, product)
 }
+
+// UpdateVariant mocks base method.
+func (m *MockCQRSRepository) UpdateVariant(ctx context.Context, productId string, variant *models.Variant) (*models.Variant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", ctx, productId, variant)
+	ret0, _ := ret[0].(*models.Variant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockCQRSRepositoryMockRecorder) UpdateVariant(ctx, productId, variant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockCQRSRepository)(nil).UpdateVariant), ctx, productId, variant)
+}
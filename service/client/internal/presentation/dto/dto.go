@@ -1,14 +1,20 @@
 package dto
 
+import "time"
+
 // Category はカテゴリ情報を表すDTO
 type Category struct {
-	Id   string `json:"id" validate:"required,uuid4"`          // カテゴリID
-	Name string `json:"name" validate:"required,min=1,max=20"` // カテゴリ名
+	Id           string            `json:"id" validate:"required,uuid4"`          // カテゴリID
+	Name         string            `json:"name" validate:"required,min=1,max=20"` // カテゴリ名（問合せ結果は要求したロケールの名前）
+	Locale       string            `json:"locale,omitempty"`                      // カテゴリ名のロケール（問合せ結果のみ設定）
+	Translations map[string]string `json:"translations,omitempty"`                // ロケールごとの翻訳名（問合せ結果のみ設定）
+	Slug         string            `json:"slug,omitempty"`                        // URLに使用するスラッグ（結果のみ設定）
 }
 
 // CreateCategoryRequest はカテゴリ作成リクエスト
 type CreateCategoryRequest struct {
-	Name string `json:"name" validate:"required,min=1,max=20"` // カテゴリ名
+	Name string `json:"name" validate:"required,min=1,max=20"`       // カテゴリ名
+	Slug string `json:"slug,omitempty" validate:"omitempty,max=100"` // URLに使用するスラッグ（未設定の場合はカテゴリ名から生成）
 }
 
 // CreateCategoryResponse はカテゴリ作成レスポンス
@@ -23,7 +29,9 @@ type CategoryListResponse struct {
 
 // UpdateCategoryRequest はカテゴリ更新リクエスト
 type UpdateCategoryRequest struct {
-	Name string `json:"name" validate:"required,min=1,max=20"` // カテゴリ名
+	Name         string            `json:"name" validate:"required,min=1,max=20"`                                                    // カテゴリ名（既定のロケール）
+	Translations map[string]string `json:"translations,omitempty" validate:"omitempty,max=20,dive,keys,min=2,max=10,endkeys,max=20"` // 変更するロケールごとの翻訳名（エンプティの名前はその翻訳を削除）
+	Slug         string            `json:"slug,omitempty" validate:"omitempty,max=100"`                                              // URLに使用するスラッグ（未設定の場合は現在のスラッグを維持）
 }
 
 // UpdateCategoryResponse はカテゴリ更新レスポンス
@@ -36,19 +44,48 @@ type CategoryByIdResponse struct {
 	Category *Category `json:"category"` // カテゴリ情報
 }
 
+// CategoryBySlugResponse はスラッグによるカテゴリ取得レスポンス
+type CategoryBySlugResponse struct {
+	Category   *Category `json:"category"`              // カテゴリ情報
+	Moved      bool      `json:"moved"`                 // 変更前のスラッグで取得した場合true
+	RedirectTo string    `json:"redirect_to,omitempty"` // 変更前のスラッグで取得した場合の現在のスラッグのパス
+}
+
+// Money は金額を表すDTO
+type Money struct {
+	Amount   int64  `json:"amount"`   // 通貨の最小単位での金額（例: JPYは円、USDはセント）
+	Currency string `json:"currency"` // 通貨コード（ISO 4217）
+}
+
 // Product は商品情報を表すDTO
 type Product struct {
-	Id       string    `json:"id"`       // 商品ID
-	Name     string    `json:"name"`     // 商品名
-	Price    uint32    `json:"price"`    // 価格
-	Category *Category `json:"category"` // カテゴリ情報
+	Id                string            `json:"id"`                            // 商品ID
+	Name              string            `json:"name"`                          // 商品名（要求したロケールの名前）
+	Locale            string            `json:"locale,omitempty"`              // 商品名のロケール（問合せ結果のみ設定）
+	Translations      map[string]string `json:"translations,omitempty"`        // ロケールごとの翻訳名
+	Price             uint32            `json:"price"`                         // 税抜の価格（通貨の最小単位）
+	TaxClass          string            `json:"tax_class,omitempty"`           // 税率区分（STANDARD / REDUCED）
+	PriceExcludingTax *Money            `json:"price_excluding_tax,omitempty"` // 税抜価格
+	PriceIncludingTax *Money            `json:"price_including_tax,omitempty"` // 税込価格（問合せ結果のみ設定）
+	Category          *Category         `json:"category"`                      // カテゴリ情報
+	Variants          []*Variant        `json:"variants,omitempty"`            // バリエーション（商品の個別取得時のみ設定）
+	Tags              []*Tag            `json:"tags,omitempty"`                // タグ
+	Status            string            `json:"status,omitempty"`              // 販売状態（DRAFT / PUBLISHED / SUSPENDED / DISCONTINUED）
+	PriceSchedules    []*PriceSchedule  `json:"price_schedules,omitempty"`     // 適用中・適用予定の価格スケジュール（商品の個別取得時のみ設定）
+	Barcode           string            `json:"barcode,omitempty"`             // JAN/EANバーコード（UPC-Aは先頭に0を付けた13桁）
+	Slug              string            `json:"slug,omitempty"`                // URLに使用するスラッグ
 }
 
 // CreateProductRequest は商品作成リクエスト
 type CreateProductRequest struct {
-	Name     string    `json:"name" validate:"required,min=1,max=100"` // 商品名
-	Price    uint32    `json:"price" validate:"required,min=1"`        // 価格
-	Category *Category `json:"category" validate:"required"`           // カテゴリ情報
+	Name         string            `json:"name" validate:"required,min=1,max=100"`                                                             // 商品名
+	Price        uint32            `json:"price" validate:"required,min=1"`                                                                    // 税抜の価格（通貨の最小単位）
+	Currency     string            `json:"currency,omitempty" validate:"omitempty,oneof=JPY USD EUR"`                                          // 通貨コード（未設定の場合はJPY）
+	TaxClass     string            `json:"tax_class,omitempty" validate:"omitempty,oneof=STANDARD REDUCED"`                                    // 税率区分（未設定の場合は標準税率）
+	Category     *Category         `json:"category" validate:"required"`                                                                       // カテゴリ情報
+	Translations map[string]string `json:"translations,omitempty" validate:"omitempty,max=20,dive,keys,min=2,max=10,endkeys,required,max=100"` // ロケールごとの翻訳名（既定のロケールはname）
+	Barcode      string            `json:"barcode,omitempty" validate:"omitempty,numeric,len=8|len=12|len=13"`                                 // JAN/EAN/UPCバーコード（8桁、12桁または13桁）
+	Slug         string            `json:"slug,omitempty" validate:"omitempty,max=100"`                                                        // URLに使用するスラッグ（未設定の場合は商品名から生成）
 }
 
 // CreateProductResponse は商品作成レスポンス
@@ -58,9 +95,14 @@ type CreateProductResponse struct {
 
 // UpdateProductRequest は商品更新リクエスト
 type UpdateProductRequest struct {
-	Name     string    `json:"name" validate:"required,min=1,max=100"` // 商品名
-	Price    uint32    `json:"price" validate:"required,min=1"`        // 価格
-	Category *Category `json:"category" validate:"required"`           // カテゴリ情報
+	Name         string            `json:"name" validate:"required,min=1,max=100"`                                                    // 商品名
+	Price        uint32            `json:"price" validate:"required,min=1"`                                                           // 税抜の価格（通貨の最小単位）
+	Currency     string            `json:"currency,omitempty" validate:"omitempty,oneof=JPY USD EUR"`                                 // 通貨コード（未設定の場合は現在の通貨を維持）
+	TaxClass     string            `json:"tax_class,omitempty" validate:"omitempty,oneof=STANDARD REDUCED"`                           // 税率区分（未設定の場合は現在の税率区分を維持）
+	Category     *Category         `json:"category" validate:"required"`                                                              // カテゴリ情報
+	Translations map[string]string `json:"translations,omitempty" validate:"omitempty,max=20,dive,keys,min=2,max=10,endkeys,max=100"` // 変更するロケールごとの翻訳名（エンプティの名前はその翻訳を削除）
+	Barcode      *string           `json:"barcode,omitempty" validate:"omitempty,len=0|numeric,len=0|len=8|len=12|len=13"`            // JAN/EAN/UPCバーコード（未設定の場合は現在のバーコードを維持、エンプティの場合は削除）
+	Slug         string            `json:"slug,omitempty" validate:"omitempty,max=100"`                                               // URLに使用するスラッグ（未設定の場合は現在のスラッグを維持）
 }
 
 // UpdateProductResponse は商品更新レスポンス
@@ -68,6 +110,11 @@ type UpdateProductResponse struct {
 	Product *Product `json:"product"` // 更新された商品情報
 }
 
+// ChangeProductStatusResponse は商品の販売状態変更レスポンス
+type ChangeProductStatusResponse struct {
+	Product *Product `json:"product"` // 販売状態を変更した商品情報
+}
+
 // ProductListResponse は商品一覧レスポンス
 type ProductListResponse struct {
 	Products []*Product `json:"products"` // 商品一覧
@@ -83,7 +130,184 @@ type ProductByIdResponse struct {
 	Product *Product `json:"product"` // 商品情報
 }
 
+// ProductByBarcodeResponse はバーコードによる商品取得レスポンス
+type ProductByBarcodeResponse struct {
+	Product *Product `json:"product"` // 商品情報
+}
+
+// ProductBySlugResponse はスラッグによる商品取得レスポンス
+type ProductBySlugResponse struct {
+	Product    *Product `json:"product"`               // 商品情報
+	Moved      bool     `json:"moved"`                 // 変更前のスラッグで取得した場合true
+	RedirectTo string   `json:"redirect_to,omitempty"` // 変更前のスラッグで取得した場合の現在のスラッグのパス
+}
+
 // ProductByKeywordResponse は商品検索レスポンス
 type ProductByKeywordResponse struct {
 	Products []*Product `json:"products"` // 検索結果の商品一覧
 }
+
+// VariantOption は商品バリエーションの選択肢を表すDTO
+type VariantOption struct {
+	Axis  string `json:"axis" validate:"required,min=1,max=30"`  // 選択肢の軸（例: サイズ）
+	Value string `json:"value" validate:"required,min=1,max=50"` // 選択肢の値（例: M）
+}
+
+// Variant は商品バリエーションを表すDTO
+type Variant struct {
+	Id            string           `json:"id"`                       // バリエーションID
+	Sku           string           `json:"sku"`                      // SKUコード
+	Options       []*VariantOption `json:"options"`                  // 選択肢の組み合わせ
+	PriceOverride *uint32          `json:"price_override,omitempty"` // 価格の上書き
+	Price         uint32           `json:"price"`                    // 販売価格
+	Status        string           `json:"status"`                   // 販売状態（ACTIVE / INACTIVE）
+}
+
+// VariantListResponse はバリエーション一覧レスポンス
+type VariantListResponse struct {
+	Variants []*Variant `json:"variants"` // バリエーション一覧
+}
+
+// CreateVariantRequest はバリエーション追加リクエスト
+type CreateVariantRequest struct {
+	Sku           string           `json:"sku" validate:"required,min=1,max=64"`                        // SKUコード（英数字とハイフン）
+	Options       []*VariantOption `json:"options" validate:"required,min=1,max=3,dive,required"`       // 選択肢の組み合わせ（1-3軸）
+	PriceOverride *uint32          `json:"price_override,omitempty" validate:"omitempty,min=1"`         // 価格の上書き（未設定の場合は商品の単価）
+	Status        string           `json:"status,omitempty" validate:"omitempty,oneof=ACTIVE INACTIVE"` // 販売状態（未設定の場合はACTIVE）
+}
+
+// CreateVariantResponse はバリエーション追加レスポンス
+type CreateVariantResponse struct {
+	Variant *Variant `json:"variant"` // 追加されたバリエーション
+}
+
+// UpdateVariantRequest はバリエーション更新リクエスト
+type UpdateVariantRequest struct {
+	Sku           string           `json:"sku" validate:"required,min=1,max=64"`                        // SKUコード（英数字とハイフン）
+	Options       []*VariantOption `json:"options" validate:"required,min=1,max=3,dive,required"`       // 選択肢の組み合わせ（1-3軸）
+	PriceOverride *uint32          `json:"price_override,omitempty" validate:"omitempty,min=1"`         // 価格の上書き（未設定の場合は商品の単価）
+	Status        string           `json:"status,omitempty" validate:"omitempty,oneof=ACTIVE INACTIVE"` // 販売状態（未設定の場合はACTIVE）
+}
+
+// UpdateVariantResponse はバリエーション更新レスポンス
+type UpdateVariantResponse struct {
+	Variant *Variant `json:"variant"` // 更新されたバリエーション
+}
+
+// PriceSchedule は期間を指定した価格変更を表すDTO
+type PriceSchedule struct {
+	Id           string    `json:"id"`                      // 価格スケジュールID
+	ProductId    string    `json:"product_id"`              // 商品ID
+	Price        uint32    `json:"price"`                   // 期間中の税抜の価格
+	RegularPrice *uint32   `json:"regular_price,omitempty"` // 期間開始前の税抜の価格（適用中または適用後のみ設定）
+	Status       string    `json:"status"`                  // 状態（SCHEDULED / ACTIVE / COMPLETED / CANCELLED）
+	StartsAt     time.Time `json:"starts_at"`               // 開始時刻
+	EndsAt       time.Time `json:"ends_at"`                 // 終了時刻
+}
+
+// SchedulePriceRequest は価格スケジュール登録リクエスト
+type SchedulePriceRequest struct {
+	Price    uint32    `json:"price" validate:"required,min=1"`              // 期間中の税抜の価格（通貨の最小単位）
+	StartsAt time.Time `json:"starts_at" validate:"required"`                // 開始時刻（RFC3339）
+	EndsAt   time.Time `json:"ends_at" validate:"required,gtfield=StartsAt"` // 終了時刻（RFC3339、開始時刻より後）
+}
+
+// SchedulePriceResponse は価格スケジュール登録レスポンス
+type SchedulePriceResponse struct {
+	PriceSchedule *PriceSchedule `json:"price_schedule"` // 登録された価格スケジュール
+}
+
+// CancelPriceScheduleResponse は価格スケジュール取消レスポンス
+type CancelPriceScheduleResponse struct {
+	PriceSchedule *PriceSchedule `json:"price_schedule"` // 取り消された価格スケジュール
+}
+
+// PriceAdjustment は単価の一括変更の規則を表すDTO
+type PriceAdjustment struct {
+	Type         string  `json:"type" validate:"required,oneof=PERCENT FIXED"`                     // 変更の方法（PERCENT: 割合、FIXED: 金額）
+	Value        int32   `json:"value"`                                                            // 変更量（PERCENTの場合は-99〜1000%、FIXEDの場合は-1000000〜1000000）
+	Rounding     string  `json:"rounding,omitempty" validate:"omitempty,oneof=FLOOR HALF_UP CEIL"` // 端数処理の方法（未設定の場合はFLOOR）
+	RoundingUnit uint32  `json:"rounding_unit,omitempty" validate:"max=10000"`                     // 端数処理の単位（例: 10の場合は10円単位、未設定の場合は1）
+	MinPrice     *uint32 `json:"min_price,omitempty" validate:"omitempty,min=1"`                   // 変更後の単価の下限（未設定の場合は下限なし）
+	MaxPrice     *uint32 `json:"max_price,omitempty" validate:"omitempty,min=1"`                   // 変更後の単価の上限（未設定の場合は上限なし）
+}
+
+// AdjustPricesRequest はカテゴリ単位の単価の一括変更リクエスト
+type AdjustPricesRequest struct {
+	IncludeDescendants bool             `json:"include_descendants,omitempty"`                      // 子孫カテゴリの商品を含めるかどうか
+	Tags               []string         `json:"tags,omitempty" validate:"max=20,dive,min=1,max=30"` // 指定したすべてのタグが付与された商品に絞り込む
+	Adjustment         *PriceAdjustment `json:"adjustment" validate:"required"`                     // 変更の規則
+	Preview            bool             `json:"preview,omitempty"`                                  // trueの場合は単価を変更せずに変更前後の単価のみを返す
+}
+
+// PriceChange は商品ごとの変更前後の単価を表すDTO
+type PriceChange struct {
+	ProductId   string `json:"product_id"`   // 商品ID
+	ProductName string `json:"product_name"` // 商品名
+	Currency    string `json:"currency"`     // 通貨コード
+	BeforePrice uint32 `json:"before_price"` // 変更前の税抜の単価
+	AfterPrice  uint32 `json:"after_price"`  // 変更後の税抜の単価
+}
+
+// AdjustPricesResponse はカテゴリ単位の単価の一括変更レスポンス
+type AdjustPricesResponse struct {
+	Changes []*PriceChange `json:"changes"` // 商品ごとの変更前後の単価（商品ID順）
+	Preview bool           `json:"preview"` // プレビューの場合はtrue（単価は変更していない）
+}
+
+// Tag はタグ情報を表すDTO
+type Tag struct {
+	Id   string `json:"id"`   // タグID
+	Name string `json:"name"` // タグ名
+}
+
+// TagUsage はタグと付与された商品数を表すDTO
+type TagUsage struct {
+	Tag          *Tag `json:"tag"`           // タグ情報
+	ProductCount int  `json:"product_count"` // タグが付与された商品数
+}
+
+// TagListResponse はタグ一覧レスポンス
+type TagListResponse struct {
+	Tags []*TagUsage `json:"tags"` // 付与された商品数の多い順のタグ一覧
+}
+
+// ChangeTagsRequest はタグの一括付与・解除リクエスト
+type ChangeTagsRequest struct {
+	ProductIds []string `json:"product_ids" validate:"required,min=1,max=100,dive,uuid4"`     // 対象の商品ID（1-100件）
+	TagNames   []string `json:"tag_names" validate:"required,min=1,max=20,dive,min=1,max=30"` // タグ名（1-20件）
+}
+
+// AttachTagsResponse はタグの一括付与レスポンス
+type AttachTagsResponse struct {
+	Tags          []*Tag `json:"tags"`           // 付与したタグ
+	AttachedCount int    `json:"attached_count"` // 新たに付与された商品とタグの組み合わせ数
+}
+
+// DetachTagsResponse はタグの一括解除レスポンス
+type DetachTagsResponse struct {
+	Tags          []*Tag `json:"tags"`           // 解除したタグ（存在しないタグは含まない）
+	DetachedCount int    `json:"detached_count"` // 解除された商品とタグの組み合わせ数
+}
+
+// SuggestProductsRequest はWebSocketで受信するサジェスト問合せ
+type SuggestProductsRequest struct {
+	Prefix string `json:"prefix" validate:"max=100"`     // 入力中の検索語
+	Limit  int32  `json:"limit" validate:"min=0,max=20"` // 最大件数（0の場合はデフォルト値）
+}
+
+// ProductSuggestion は商品サジェストを表すDTO
+type ProductSuggestion struct {
+	ProductId string    `json:"product_id"` // 商品ID
+	Name      string    `json:"name"`       // 商品名
+	Highlight string    `json:"highlight"`  // 一致箇所を<mark>で強調した商品名（HTMLエスケープ済み）
+	Category  *Category `json:"category"`   // カテゴリ情報
+	Score     float64   `json:"score"`      // 関連度スコア
+}
+
+// SuggestProductsResponse はWebSocketで送信するサジェスト結果
+type SuggestProductsResponse struct {
+	Prefix      string               `json:"prefix"`          // 対応する検索語
+	Suggestions []*ProductSuggestion `json:"suggestions"`     // 関連度順のサジェスト
+	Error       string               `json:"error,omitempty"` // 問合せが不正な場合のエラーメッセージ
+}
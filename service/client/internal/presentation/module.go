@@ -1,7 +1,9 @@
 package presentation
 
 import (
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/httpserver"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/infrastructure"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/infrastructure/cqrs"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/presentation/server"
 	"go.uber.org/fx"
 )
@@ -11,9 +13,17 @@ var Module = fx.Module(
 	"presentation",
 	infrastructure.Module,
 	fx.Provide(
-		server.NewCQRSServiceConfig,
+		httpserver.NewConfig,
 		server.NewCQRSServiceHandler,
+		server.NewHTTPCacheConfig,
+		server.NewHTTPCache,
 		server.NewCQRSServiceServer,
+		// /readyzで参照するため、バックエンドの状態をserver.BackendStatusとしても提供する
+		fx.Annotate(
+			cqrs.NewBackendStatus,
+			fx.As(fx.Self()),
+			fx.As(new(server.BackendStatus)),
+		),
 	),
 	// ライフサイクルフックを登録
 	fx.Invoke(server.RegisterLifecycleHooks),
@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/httpserver"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/presentation"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/presentation/dto"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/presentation/server"
@@ -39,9 +40,10 @@ func setupTestApp(t *testing.T, populate ...interface{}) *fx.App {
 			func() (*slog.Logger, error) {
 				return slog.New(slog.NewTextHandler(io.Discard, nil)), nil
 			},
-			// Viperの設定をデコレートしてポート0(動的に空いているところを使用)を指定する
+			// Viperの設定をデコレートしてポート0(動的に空いているところを使用)を指定し、停止時の待ち時間を短くする
 			func(v *viper.Viper) *viper.Viper {
 				v.Set("server.port", "0")
+				v.Set("server.drain_period", "200ms")
 				return v
 			},
 		),
@@ -66,7 +68,7 @@ func TestModule(t *testing.T) {
 	t.Run("依存関係の注入と初期化", func(t *testing.T) {
 		// Arrange
 		var (
-			cfg *server.CQRSServiceConfig
+			cfg *httpserver.Config
 			h   *server.CQRSServiceHandler
 			srv *server.CQRSServiceServer
 		)
@@ -81,6 +83,37 @@ func TestModule(t *testing.T) {
 
 		// 設定値の確認
 		assert.NotEmpty(t, cfg.Port, "server port should not be empty")
+		assert.Positive(t, cfg.WriteTimeout, "server write timeout should be set")
+	})
+
+	t.Run("停止中は/readyzが503を返す", func(t *testing.T) {
+		// Arrange
+		var srv *server.CQRSServiceServer
+		app := setupTestApp(t, &srv)
+		require.NotNil(t, srv, "server should not be nil")
+		readyz := func() int {
+			resp, err := http.Get("http://" + srv.Addr + "/readyz")
+			if err != nil {
+				return 0
+			}
+			_ = resp.Body.Close()
+			return resp.StatusCode
+		}
+		require.Equal(t, http.StatusOK, readyz(), "readyz should be ok before stopping")
+
+		// Act
+		stopped := make(chan error, 1)
+		go func() {
+			stopCtx, stopCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer stopCancel()
+			stopped <- app.Stop(stopCtx)
+		}()
+
+		// Assert
+		assert.Eventually(t, func() bool {
+			return readyz() == http.StatusServiceUnavailable
+		}, time.Second, 10*time.Millisecond, "readyz should fail while draining")
+		require.NoError(t, <-stopped, "fx app should stop without errors")
 	})
 
 	t.Run("CategoryListハンドラーの動作確認", func(t *testing.T) {
@@ -0,0 +1,268 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+)
+
+const (
+	headerETag           = "ETag"            // Echoに定義がないため独自に定義
+	headerIfNoneMatch    = "If-None-Match"   // Echoに定義がないため独自に定義
+	headerAcceptLanguage = "Accept-Language" // Echoに定義がないため独自に定義
+)
+
+// HTTPCacheConfig はHTTPキャッシュヘッダーの設定
+type HTTPCacheConfig struct {
+	ProductsCacheControl   string // 商品エンドポイントのCache-Controlヘッダー値
+	CategoriesCacheControl string // カテゴリエンドポイントのCache-Controlヘッダー値
+}
+
+// NewHTTPCacheConfig は設定ファイルからHTTPCacheConfigを生成します。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *HTTPCacheConfig: 設定のインスタンス
+//   - error: 設定の読み込みエラー
+func NewHTTPCacheConfig(v *viper.Viper) (*HTTPCacheConfig, error) {
+	var configErrors []error
+	cfg := &HTTPCacheConfig{
+		ProductsCacheControl:   utils.GetKey[string](v, "http_cache.products_cache_control", &configErrors),
+		CategoriesCacheControl: utils.GetKey[string](v, "http_cache.categories_cache_control", &configErrors),
+	}
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+
+	return cfg, nil
+}
+
+// cacheRule はルートごとのキャッシュ方針
+type cacheRule struct {
+	cacheControl string // Cache-Controlヘッダー値
+	isList       bool   // 一覧レスポンスかどうか（Last-Modifiedを付与する）
+}
+
+// HTTPCache はGETレスポンスにETag/Cache-Control/Last-Modifiedを付与し、条件付きGETに応答するミドルウェア
+type HTTPCache struct {
+	rules        map[string]cacheRule // ルートパスごとのキャッシュ方針
+	mu           sync.RWMutex         // lastModified・lastTouchedの保護
+	lastModified time.Time            // Last-Modifiedに返す最終更新時刻
+	lastTouched  time.Time            // ゲートウェイが観測した最新の変更時刻（秒単位）
+}
+
+// NewHTTPCache はHTTPCacheを生成します。
+// 最終更新時刻の初期値はゲートウェイの起動時刻です。
+//
+// Parameters:
+//   - cfg: HTTPキャッシュ設定
+//
+// Returns:
+//   - *HTTPCache: HTTPCacheのインスタンス
+func NewHTTPCache(cfg *HTTPCacheConfig) *HTTPCache {
+	started := time.Now().UTC().Truncate(time.Second)
+	return &HTTPCache{
+		rules: map[string]cacheRule{
+			"/products":                         {cacheControl: cfg.ProductsCacheControl, isList: true},
+			"/products/:id":                     {cacheControl: cfg.ProductsCacheControl},
+			"/products/by-barcode/:code":        {cacheControl: cfg.ProductsCacheControl},
+			"/products/by-slug/:slug":           {cacheControl: cfg.ProductsCacheControl},
+			"/products/:id/variants":            {cacheControl: cfg.ProductsCacheControl, isList: true},
+			"/products/:id/variants/:variantId": {cacheControl: cfg.ProductsCacheControl},
+			"/categories":                       {cacheControl: cfg.CategoriesCacheControl, isList: true},
+			"/categories/:id":                   {cacheControl: cfg.CategoriesCacheControl},
+			"/categories/by-slug/:slug":         {cacheControl: cfg.CategoriesCacheControl},
+			"/tags":                             {cacheControl: cfg.ProductsCacheControl, isList: true},
+			// 書き込み専用のルートは成功時に最終更新時刻を進めるためだけに登録する
+			"/tags/attach":                              {},
+			"/tags/detach":                              {},
+			"/products/:id/publish":                     {},
+			"/products/:id/suspend":                     {},
+			"/products/:id/discontinue":                 {},
+			"/products/:id/price-schedules":             {},
+			"/products/:id/price-schedules/:scheduleId": {},
+			"/categories/:id/price-adjustments":         {},
+		},
+		lastModified: started,
+		lastTouched:  started,
+	}
+}
+
+// LastModified はゲートウェイが観測した最新の変更時刻を返します。
+//
+// Returns:
+//   - time.Time: 最新の変更時刻（秒単位）
+func (h *HTTPCache) LastModified() time.Time {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.lastModified
+}
+
+// Touch は変更を観測したことを記録し、最終更新時刻を進めます。
+// Last-Modifiedは秒単位のため、返した最終更新時刻と同じ秒の変更では最終更新時刻を1秒進めます。
+// これにより、If-Modified-Sinceのみで再検証するクライアントに古いレスポンスで304を返しません。
+// 観測済みの変更より古い時刻の場合は巻き戻さずに無視します。
+//
+// Parameters:
+//   - t: 変更を観測した時刻
+func (h *HTTPCache) Touch(t time.Time) {
+	t = t.UTC().Truncate(time.Second)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if t.Before(h.lastTouched) {
+		return
+	}
+	h.lastTouched = t
+	if t.After(h.lastModified) {
+		h.lastModified = t
+		return
+	}
+	h.lastModified = h.lastModified.Add(time.Second)
+}
+
+// Middleware はHTTPキャッシュ用のEchoミドルウェアを返します。
+// 対象ルートへのGETではレスポンスを一旦バッファし、強いETagを計算して条件付きGETを評価します。
+// 対象ルートへの書き込みが成功した場合は最終更新時刻を進めます。
+//
+// Returns:
+//   - echo.MiddlewareFunc: ミドルウェア
+func (h *HTTPCache) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			rule, ok := h.rules[c.Path()]
+			if !ok {
+				return next(c)
+			}
+
+			req := c.Request()
+			if req.Method != http.MethodGet && req.Method != http.MethodHead {
+				if err := next(c); err != nil {
+					return err
+				}
+				if status := c.Response().Status; status >= 200 && status < 300 {
+					h.Touch(time.Now())
+				}
+				return nil
+			}
+
+			// 書き込みの成否でLast-Modifiedがずれないよう、ハンドラ実行前の時刻を採用する
+			lastModified := h.LastModified()
+
+			res := c.Response()
+			original := res.Writer
+			buf := &cacheResponseWriter{header: original.Header(), status: http.StatusOK}
+			res.Writer = buf
+			err := next(c)
+			res.Writer = original
+			if err != nil {
+				// エラーはEchoのHTTPErrorHandlerに任せる
+				res.Committed = false
+				return err
+			}
+
+			if buf.status != http.StatusOK {
+				original.WriteHeader(buf.status)
+				_, err = original.Write(buf.body.Bytes())
+				return err
+			}
+
+			header := original.Header()
+			etag := strongETag(buf.body.Bytes())
+			header.Set(headerETag, etag)
+			// 名前はAccept-Languageに応じて変わるため、共有キャッシュがロケールごとに保持するよう指示する
+			header.Add(echo.HeaderVary, headerAcceptLanguage)
+			if rule.cacheControl != "" {
+				header.Set(echo.HeaderCacheControl, rule.cacheControl)
+			}
+			if rule.isList {
+				header.Set(echo.HeaderLastModified, lastModified.Format(http.TimeFormat))
+			}
+
+			if isNotModified(req, etag, lastModified, rule.isList) {
+				// 304ではボディ関連のヘッダーを送らない
+				header.Del(echo.HeaderContentType)
+				header.Del(echo.HeaderContentLength)
+				original.WriteHeader(http.StatusNotModified)
+				res.Status = http.StatusNotModified
+				return nil
+			}
+
+			original.WriteHeader(http.StatusOK)
+			if req.Method == http.MethodHead {
+				return nil
+			}
+			_, err = original.Write(buf.body.Bytes())
+			return err
+		}
+	}
+}
+
+// isNotModified は条件付きGETを評価し、304を返すべきかを判定します。
+// RFC 9110に従い、If-None-Matchが存在する場合はIf-Modified-Sinceを無視します。
+func isNotModified(req *http.Request, etag string, lastModified time.Time, isList bool) bool {
+	if inm := req.Header.Get(headerIfNoneMatch); inm != "" {
+		return etagMatches(inm, etag)
+	}
+	if !isList {
+		return false
+	}
+	ims := req.Header.Get(echo.HeaderIfModifiedSince)
+	if ims == "" {
+		return false
+	}
+	since, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+	return !lastModified.After(since)
+}
+
+// etagMatches はIf-None-Matchヘッダーの値とETagを弱い比較で照合します。
+func etagMatches(header, etag string) bool {
+	for candidate := range strings.SplitSeq(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// strongETag はレスポンスボディから強いETagを計算します。
+func strongETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// cacheResponseWriter はETag計算のためにレスポンスをバッファするhttp.ResponseWriter
+type cacheResponseWriter struct {
+	header http.Header  // 元のレスポンスヘッダー
+	status int          // ハンドラが書き込んだステータスコード
+	body   bytes.Buffer // ハンドラが書き込んだボディ
+}
+
+func (w *cacheResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *cacheResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *cacheResponseWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
@@ -0,0 +1,196 @@
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/domain/models"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/presentation/server"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHTTPCache_Middleware(t *testing.T) {
+	newCacheTestEnv := func(t *testing.T) (*echo.Echo, *server.HTTPCache) {
+		t.Helper()
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		cache := server.NewHTTPCache(&server.HTTPCacheConfig{
+			ProductsCacheControl:   "public, max-age=30",
+			CategoriesCacheControl: "public, max-age=60",
+		})
+		e.Use(cache.Middleware())
+		e.GET("/categories", handler.CategoryList)
+		e.GET("/categories/:id", handler.CategoryById)
+		e.POST("/categories", handler.CreateCategory)
+
+		categories := []*models.Category{models.NewCategory("cat-1", "文房具")}
+		mockRepo.EXPECT().CategoryList(gomock.Any()).Return(categories, nil).AnyTimes()
+		mockRepo.EXPECT().
+			CreateCategory(gomock.Any(), "雑貨", "").
+			Return(models.NewCategory("cat-2", "雑貨"), nil).
+			AnyTimes()
+		return e, cache
+	}
+	serve := func(e *echo.Echo, method, target string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("正常系: ETag・Cache-Control・Last-Modifiedが付与される", func(t *testing.T) {
+		// Arrange
+		e, cache := newCacheTestEnv(t)
+
+		// Act
+		rec := serve(e, http.MethodGet, "/categories", nil)
+
+		// Assert
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Regexp(t, `^"[0-9a-f]{32}"$`, rec.Header().Get("ETag"))
+		assert.Equal(t, "public, max-age=60", rec.Header().Get(echo.HeaderCacheControl))
+		assert.Equal(t, cache.LastModified().Format(http.TimeFormat), rec.Header().Get(echo.HeaderLastModified))
+		assert.Equal(t, "Accept-Language", rec.Header().Get(echo.HeaderVary))
+		assert.Contains(t, rec.Body.String(), "文房具")
+	})
+
+	t.Run("正常系: If-None-Matchが一致すると304を返す", func(t *testing.T) {
+		// Arrange
+		e, _ := newCacheTestEnv(t)
+		first := serve(e, http.MethodGet, "/categories", nil)
+		etag := first.Header().Get("ETag")
+
+		// Act
+		rec := serve(e, http.MethodGet, "/categories", http.Header{"If-None-Match": {`"other", W/` + etag}})
+
+		// Assert
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Empty(t, rec.Body.String())
+		assert.Equal(t, etag, rec.Header().Get("ETag"))
+	})
+
+	t.Run("正常系: If-None-Matchが一致しない場合は200を返す", func(t *testing.T) {
+		// Arrange
+		e, _ := newCacheTestEnv(t)
+
+		// Act
+		rec := serve(e, http.MethodGet, "/categories", http.Header{"If-None-Match": {`"stale"`}})
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotEmpty(t, rec.Body.String())
+	})
+
+	t.Run("正常系: If-Modified-Sinceが最終更新時刻以降なら304を返す", func(t *testing.T) {
+		// Arrange
+		e, cache := newCacheTestEnv(t)
+		since := cache.LastModified().Format(http.TimeFormat)
+
+		// Act
+		rec := serve(e, http.MethodGet, "/categories", http.Header{echo.HeaderIfModifiedSince: {since}})
+
+		// Assert
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+	})
+
+	t.Run("正常系: 書き込み成功後はIf-Modified-Sinceが古くなり200を返す", func(t *testing.T) {
+		// Arrange
+		e, cache := newCacheTestEnv(t)
+		since := cache.LastModified()
+		cache.Touch(since.Add(-time.Hour)) // 過去の時刻では巻き戻らない
+		require.Equal(t, since, cache.LastModified())
+
+		// Act
+		created := serve(e, http.MethodPost, "/categories", http.Header{echo.HeaderContentType: {echo.MIMEApplicationJSON}})
+		require.Equal(t, http.StatusBadRequest, created.Code)
+		assert.Equal(t, since, cache.LastModified(), "失敗した書き込みでは更新されない")
+		cache.Touch(since.Add(2 * time.Second))
+		rec := serve(e, http.MethodGet, "/categories", http.Header{echo.HeaderIfModifiedSince: {since.Format(http.TimeFormat)}})
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("正常系: 最終更新時刻と同じ秒の書き込みでも最終更新時刻が進み、If-Modified-Sinceの再検証で200を返す", func(t *testing.T) {
+		// Arrange
+		e, cache := newCacheTestEnv(t)
+		since := cache.LastModified()
+		fresh := serve(e, http.MethodGet, "/categories", nil)
+		require.Equal(t, since.Format(http.TimeFormat), fresh.Header().Get(echo.HeaderLastModified))
+
+		// Act
+		cache.Touch(since.Add(500 * time.Millisecond))
+		cache.Touch(since.Add(700 * time.Millisecond))
+		rec := serve(e, http.MethodGet, "/categories", http.Header{echo.HeaderIfModifiedSince: {since.Format(http.TimeFormat)}})
+
+		// Assert
+		assert.Equal(t, since.Add(2*time.Second), cache.LastModified(), "同じ秒の書き込みごとに1秒進める")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, since.Add(2*time.Second).Format(http.TimeFormat), rec.Header().Get(echo.HeaderLastModified))
+	})
+
+	t.Run("正常系: 単一リソースにはLast-Modifiedを付与しない", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		cache := server.NewHTTPCache(&server.HTTPCacheConfig{ProductsCacheControl: "no-cache"})
+		e.Use(cache.Middleware())
+		e.GET("/products/:id", handler.ProductById)
+		category := models.NewCategory("cat-1", "文房具")
+		mockRepo.EXPECT().
+			ProductById(gomock.Any(), "prod-1").
+			Return(models.NewProduct("prod-1", "鉛筆", 100, category), nil)
+
+		// Act
+		rec := serve(e, http.MethodGet, "/products/prod-1", nil)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get("ETag"))
+		assert.Equal(t, "no-cache", rec.Header().Get(echo.HeaderCacheControl))
+		assert.Empty(t, rec.Header().Get(echo.HeaderLastModified))
+	})
+
+	t.Run("正常系: タグ一覧には商品と同じキャッシュ方針を適用する", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		cache := server.NewHTTPCache(&server.HTTPCacheConfig{ProductsCacheControl: "public, max-age=30"})
+		e.Use(cache.Middleware())
+		e.GET("/tags", handler.TagList)
+		mockRepo.EXPECT().
+			TagList(gomock.Any()).
+			Return([]*models.TagUsage{models.NewTagUsage(models.NewTag("tag-1", "ワイヤレス"), 3)}, nil)
+
+		// Act
+		rec := serve(e, http.MethodGet, "/tags", nil)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get("ETag"))
+		assert.Equal(t, "public, max-age=30", rec.Header().Get(echo.HeaderCacheControl))
+		assert.Equal(t, cache.LastModified().Format(http.TimeFormat), rec.Header().Get(echo.HeaderLastModified))
+	})
+
+	t.Run("異常系: エラーレスポンスにはキャッシュヘッダーを付与しない", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		cache := server.NewHTTPCache(&server.HTTPCacheConfig{CategoriesCacheControl: "public, max-age=60"})
+		e.Use(cache.Middleware())
+		e.GET("/categories/:id", handler.CategoryById)
+		mockRepo.EXPECT().CategoryById(gomock.Any(), "missing").Return(nil, assert.AnError)
+
+		// Act
+		rec := serve(e, http.MethodGet, "/categories/missing", nil)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Empty(t, rec.Header().Get("ETag"))
+		assert.Empty(t, rec.Header().Get(echo.HeaderCacheControl))
+	})
+}
@@ -1,16 +1,26 @@
 package server
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
+	"connectrpc.com/connect"
 	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/websocket"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/domain/models"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/domain/repository"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/presentation/dto"
 	"github.com/labstack/echo/v4"
 )
 
+// wsCloseTimeout はWebSocketのクローズフレーム送信のタイムアウト
+const wsCloseTimeout = time.Second
+
 // RequestValidator はEchoのバリデータインターフェースを実装する構造体
 type RequestValidator struct {
 	validator *validator.Validate // validator/v10のバリデータ
@@ -42,8 +52,9 @@ func (cv *RequestValidator) Validate(i interface{}) error {
 
 // CQRSServiceHandler はCQRSサービスのHTTPハンドラ
 type CQRSServiceHandler struct {
-	logger *slog.Logger              // ロガー
-	repo   repository.CQRSRepository // CQRSリポジトリ
+	logger   *slog.Logger              // ロガー
+	repo     repository.CQRSRepository // CQRSリポジトリ
+	upgrader websocket.Upgrader        // WebSocketへのアップグレーダ
 }
 
 // NewCQRSServiceHandler はCQRSServiceHandlerを生成します。
@@ -56,21 +67,23 @@ type CQRSServiceHandler struct {
 //   - *CQRSServiceHandler: CQRSServiceHandlerのインスタンス
 func NewCQRSServiceHandler(logger *slog.Logger, repo repository.CQRSRepository) *CQRSServiceHandler {
 	return &CQRSServiceHandler{
-		logger: logger,
-		repo:   repo,
+		logger:   logger,
+		repo:     repo,
+		upgrader: websocket.Upgrader{},
 	}
 }
 
 // CreateCategory はカテゴリを登録します。
 // @tags Category
 // @Summary カテゴリ登録
-// @Description カテゴリを登録します。
+// @Description カテゴリを登録します。slugを省略した場合はカテゴリ名から生成します。指定したslugが使用済みの場合は409を返します。
 // @ID create-category
 // @Accept application/json
 // @Produce application/json
 // @Param request body dto.CreateCategoryRequest true "カテゴリ情報"
 // @Success 201 {object} dto.CreateCategoryResponse
 // @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /categories [post]
 func (h *CQRSServiceHandler) CreateCategory(c echo.Context) error {
@@ -87,10 +100,10 @@ func (h *CQRSServiceHandler) CreateCategory(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	category, err := h.repo.CreateCategory(c.Request().Context(), req.Name)
+	category, err := h.repo.CreateCategory(c.Request().Context(), req.Name, req.Slug)
 	if err != nil {
 		h.logger.Error("Failed to create category", "error", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create category").SetInternal(err)
+		return toHTTPError(err, "Failed to create category")
 	}
 
 	resp := dto.CreateCategoryResponse{
@@ -105,7 +118,15 @@ func (h *CQRSServiceHandler) CreateCategory(c echo.Context) error {
 // @Description カテゴリの一覧を取得します。
 // @ID list-categories
 // @Produce application/json
+// @Param If-None-Match header string false "前回取得時のETag"
+// @Param Accept-Language header string false "名前のロケール（例: en, ja;q=0.8）"
+// @Param locale query string false "名前のロケール（Accept-Languageより優先）"
+// @Param If-Modified-Since header string false "前回取得時のLast-Modified"
 // @Success 200 {object} dto.CategoryListResponse
+// @Header 200 {string} ETag "レスポンスボディの強いETag"
+// @Header 200 {string} Cache-Control "キャッシュ方針"
+// @Header 200 {string} Last-Modified "ゲートウェイが観測した最新の変更時刻"
+// @Success 304 "Not Modified"
 // @Failure 500 {object} map[string]string
 // @Router /categories [get]
 func (h *CQRSServiceHandler) CategoryList(c echo.Context) error {
@@ -124,7 +145,7 @@ func (h *CQRSServiceHandler) CategoryList(c echo.Context) error {
 // UpdateCategory はカテゴリを更新します。
 // @tags Category
 // @Summary カテゴリ更新
-// @Description カテゴリを更新します。
+// @Description カテゴリを更新します。slugを変更した場合、変更前のslugは引き続きスラッグによる取得で解決できます。
 // @ID update-category
 // @Accept application/json
 // @Produce application/json
@@ -132,6 +153,7 @@ func (h *CQRSServiceHandler) CategoryList(c echo.Context) error {
 // @Param request body dto.UpdateCategoryRequest true "カテゴリ情報"
 // @Success 200 {object} dto.UpdateCategoryResponse
 // @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /categories/{id} [put]
 func (h *CQRSServiceHandler) UpdateCategory(c echo.Context) error {
@@ -151,12 +173,12 @@ func (h *CQRSServiceHandler) UpdateCategory(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	category := models.NewCategory(id, req.Name)
+	category := models.NewCategory(id, req.Name).WithTranslations(req.Translations).WithSlug(req.Slug)
 
 	updated, err := h.repo.UpdateCategory(c.Request().Context(), category)
 	if err != nil {
 		h.logger.Error("Failed to update category", "error", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update category").SetInternal(err)
+		return toHTTPError(err, "Failed to update category")
 	}
 
 	resp := dto.UpdateCategoryResponse{
@@ -195,8 +217,14 @@ func (h *CQRSServiceHandler) DeleteCategory(c echo.Context) error {
 // @Description IDでカテゴリを取得します。
 // @ID get-category-by-id
 // @Produce application/json
+// @Param If-None-Match header string false "前回取得時のETag"
+// @Param Accept-Language header string false "名前のロケール（例: en, ja;q=0.8）"
+// @Param locale query string false "名前のロケール（Accept-Languageより優先）"
 // @Param id path string true "カテゴリID"
 // @Success 200 {object} dto.CategoryByIdResponse
+// @Header 200 {string} ETag "レスポンスボディの強いETag"
+// @Header 200 {string} Cache-Control "キャッシュ方針"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /categories/{id} [get]
@@ -218,16 +246,58 @@ func (h *CQRSServiceHandler) CategoryById(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+// CategoryBySlug はスラッグでカテゴリを取得します。
+// @tags Category
+// @Summary スラッグによるカテゴリ取得
+// @Description スラッグでカテゴリを取得します。変更前のスラッグの場合はmovedをtrueにし、redirect_toに現在のスラッグのパスを返します。
+// @ID get-category-by-slug
+// @Produce application/json
+// @Param If-None-Match header string false "前回取得時のETag"
+// @Param Accept-Language header string false "名前のロケール（例: en, ja;q=0.8）"
+// @Param locale query string false "名前のロケール（Accept-Languageより優先）"
+// @Param slug path string true "スラッグ"
+// @Success 200 {object} dto.CategoryBySlugResponse
+// @Header 200 {string} ETag "レスポンスボディの強いETag"
+// @Header 200 {string} Cache-Control "キャッシュ方針"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /categories/by-slug/{slug} [get]
+func (h *CQRSServiceHandler) CategoryBySlug(c echo.Context) error {
+	slug := c.Param("slug")
+	if slug == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "slug is required")
+	}
+
+	category, moved, err := h.repo.CategoryBySlug(c.Request().Context(), slug)
+	if err != nil {
+		h.logger.Error("Failed to get category by slug", "error", err)
+		return toHTTPError(err, "Failed to get category")
+	}
+
+	resp := dto.CategoryBySlugResponse{
+		Category: categoryToDTO(category),
+		Moved:    moved,
+	}
+	if moved {
+		resp.RedirectTo = "/categories/by-slug/" + category.Slug()
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
 // CreateProduct は商品を作成します。
 // @tags Product
 // @Summary 商品登録
-// @Description 商品を登録します。
+// @Description 商品を登録します。slugを省略した場合は商品名から生成します。
+// @Description 商品名、バーコードまたは指定したslugが他の商品と重複する場合は409を返します。
 // @ID create-product
 // @Accept application/json
 // @Produce application/json
 // @Param request body dto.CreateProductRequest true "商品情報"
 // @Success 201 {object} dto.CreateProductResponse
 // @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /products [post]
 func (h *CQRSServiceHandler) CreateProduct(c echo.Context) error {
@@ -243,16 +313,21 @@ func (h *CQRSServiceHandler) CreateProduct(c echo.Context) error {
 	}
 
 	category := models.NewCategory(req.Category.Id, req.Category.Name)
-
 	// FIXME: category nameは不要なはずなのに要求している
-	product, err := h.repo.CreateProduct(c.Request().Context(), req.Name, req.Price, category)
+	product := models.NewProduct("", req.Name, req.Price, category).WithTax(req.Currency, req.TaxClass).WithTranslations(req.Translations).
+		WithSlug(req.Slug)
+	if req.Barcode != "" {
+		product = product.WithBarcode(&req.Barcode)
+	}
+
+	created, err := h.repo.CreateProduct(c.Request().Context(), product)
 	if err != nil {
 		h.logger.Error("Failed to create product", "error", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create product").SetInternal(err)
+		return toHTTPError(err, "Failed to create product")
 	}
 
 	resp := dto.CreateProductResponse{
-		Product: productToDTO(product),
+		Product: productToDTO(created),
 	}
 	return c.JSON(http.StatusCreated, resp)
 }
@@ -260,7 +335,9 @@ func (h *CQRSServiceHandler) CreateProduct(c echo.Context) error {
 // UpdateProduct は商品を更新します。
 // @tags Product
 // @Summary 商品更新
-// @Description 商品を更新します。
+// @Description 商品を更新します。barcodeを省略した場合は現在のバーコードを維持し、空文字列の場合は削除します。
+// @Description slugを省略した場合は現在のスラッグを維持します。変更前のslugは引き続きスラッグによる取得で解決できます。
+// @Description 商品名、バーコードまたはslugが他の商品と重複する場合は409を返します。
 // @ID update-product
 // @Accept application/json
 // @Produce application/json
@@ -268,6 +345,8 @@ func (h *CQRSServiceHandler) CreateProduct(c echo.Context) error {
 // @Param request body dto.UpdateProductRequest true "商品情報"
 // @Success 200 {object} dto.UpdateProductResponse
 // @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /products/{id} [put]
 func (h *CQRSServiceHandler) UpdateProduct(c echo.Context) error {
@@ -289,12 +368,13 @@ func (h *CQRSServiceHandler) UpdateProduct(c echo.Context) error {
 
 	// FIXME: category nameは不要なはずなのに要求している
 	category := models.NewCategory(req.Category.Id, req.Category.Name)
-	product := models.NewProduct(id, req.Name, req.Price, category)
+	product := models.NewProduct(id, req.Name, req.Price, category).WithTax(req.Currency, req.TaxClass).WithTranslations(req.Translations).
+		WithBarcode(req.Barcode).WithSlug(req.Slug)
 
 	updated, err := h.repo.UpdateProduct(c.Request().Context(), product)
 	if err != nil {
 		h.logger.Error("Failed to update product", "error", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update product").SetInternal(err)
+		return toHTTPError(err, "Failed to update product")
 	}
 
 	resp := dto.UpdateProductResponse{
@@ -327,25 +407,50 @@ func (h *CQRSServiceHandler) DeleteProduct(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
-// ProductList は商品一覧を取得します。keywordパラメータがある場合は検索を行います。
+// ProductList は商品一覧を取得します。keywordパラメータがある場合は検索を行い、
+// tagsパラメータがある場合は指定されたすべてのタグが付与された商品に絞り込みます。
 // @tags Product
 // @Summary 商品一覧取得・検索
 // @Description 商品一覧を取得します。keywordパラメータを指定すると検索を行います。
+// @Description tagsパラメータを指定すると、指定されたすべてのタグが付与された商品に絞り込みます（keywordとは併用できません）。
 // @ID list-products
 // @Produce application/json
+// @Param If-None-Match header string false "前回取得時のETag"
+// @Param Accept-Language header string false "名前のロケール（例: en, ja;q=0.8）"
+// @Param locale query string false "名前のロケール（Accept-Languageより優先）"
+// @Param If-Modified-Since header string false "前回取得時のLast-Modified"
 // @Param keyword query string false "検索キーワード"
+// @Param tags query []string false "タグ名（複数指定時はAND条件）" collectionFormat(multi)
 // @Success 200 {object} dto.ProductListResponse
+// @Header 200 {string} ETag "レスポンスボディの強いETag"
+// @Header 200 {string} Cache-Control "キャッシュ方針"
+// @Header 200 {string} Last-Modified "ゲートウェイが観測した最新の変更時刻"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /products [get]
 func (h *CQRSServiceHandler) ProductList(c echo.Context) error {
 	keyword := c.QueryParam("keyword")
+	tags := c.QueryParams()["tags"]
 
 	// keywordパラメータがある場合は検索
 	if keyword != "" {
+		if len(tags) > 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "keyword and tags cannot be used together")
+		}
 		return h.ProductByKeyword(c)
 	}
 
+	// tagsパラメータがある場合はタグで絞り込み
+	if len(tags) > 0 {
+		products, err := h.repo.ProductListByTags(c.Request().Context(), tags)
+		if err != nil {
+			h.logger.Error("Failed to list products by tags", "error", err)
+			return toHTTPError(err, "Failed to list products")
+		}
+		return c.JSON(http.StatusOK, dto.ProductListResponse{Products: productsToDTO(products)})
+	}
+
 	// keywordパラメータがない場合は一覧取得
 	products, err := h.repo.ProductList(c.Request().Context())
 	if err != nil {
@@ -394,15 +499,154 @@ func (h *CQRSServiceHandler) ProductStream(c echo.Context) error {
 	}
 }
 
+// SuggestProducts はWebSocketで入力中の検索語を受信し、商品サジェストを返します。
+// 受信したメッセージごとにQueryサービスの双方向ストリーミングRPCへ中継し、
+// 新しい検索語を受信した時点で古い検索語の問合せはQueryサービス側でキャンセルされます。
+// @tags Product
+// @Summary 商品サジェスト（WebSocket）
+// @Description WebSocketに接続し、dto.SuggestProductsRequestのJSONを送信するたびにdto.SuggestProductsResponseのJSONを受信します。
+// @Description 不正な問合せにはerrorを含むメッセージを返し、接続は維持されます。
+// @ID suggest-products
+// @Param request body dto.SuggestProductsRequest false "WebSocketで送信するメッセージ"
+// @Success 101 {object} dto.SuggestProductsResponse "WebSocketで受信するメッセージ"
+// @Failure 400 {object} map[string]string
+// @Router /ws/products/suggest [get]
+func (h *CQRSServiceHandler) SuggestProducts(c echo.Context) error {
+	conn, err := h.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// アップグレードに失敗した場合、エラーレスポンスはUpgrader側で返却済み
+		h.logger.Error("Failed to upgrade to websocket", "error", err)
+		return nil
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+
+	queries := make(chan *repository.SuggestProductsQuery)
+	results, err := h.repo.SuggestProducts(ctx, queries)
+	if err != nil {
+		h.logger.Error("Failed to suggest products", "error", err)
+		h.closeWebSocket(conn, websocket.CloseInternalServerErr, "Failed to suggest products")
+		return nil
+	}
+
+	// WebSocketへの書き込みはこのゴルーチンのみで行い、受信側の検証エラーはchannel経由で受け取る
+	invalid := make(chan string)
+	go h.readSuggestQueries(ctx, c, conn, queries, invalid)
+
+	for {
+		select {
+		case msg := <-invalid:
+			if err := conn.WriteJSON(dto.SuggestProductsResponse{Error: msg}); err != nil {
+				h.logger.Warn("Failed to write websocket message", "error", err)
+				return nil
+			}
+		case result, ok := <-results:
+			if !ok {
+				h.closeWebSocket(conn, websocket.CloseNormalClosure, "")
+				return nil
+			}
+			if result.Err != nil {
+				h.logger.Error("Failed to suggest products", "error", result.Err)
+				h.closeWebSocket(conn, websocket.CloseInternalServerErr, "Failed to suggest products")
+				return nil
+			}
+			resp := dto.SuggestProductsResponse{
+				Prefix:      result.Prefix,
+				Suggestions: suggestionsToDTO(result.Suggestions),
+			}
+			if err := conn.WriteJSON(resp); err != nil {
+				h.logger.Warn("Failed to write websocket message", "error", err)
+				return nil
+			}
+		}
+	}
+}
+
+// readSuggestQueries はWebSocketから問合せを読み取り、検証してqueriesへ送信します。
+// 接続が閉じられるとqueriesをcloseします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - c: Echoコンテキスト（バリデーションに使用）
+//   - conn: WebSocket接続
+//   - queries: 問合せの送信先
+//   - invalid: 検証エラーメッセージの送信先
+func (h *CQRSServiceHandler) readSuggestQueries(
+	ctx context.Context,
+	c echo.Context,
+	conn *websocket.Conn,
+	queries chan<- *repository.SuggestProductsQuery,
+	invalid chan<- string,
+) {
+	defer close(queries)
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) && ctx.Err() == nil {
+				h.logger.Warn("Failed to read websocket message", "error", err)
+			}
+			return
+		}
+
+		req := new(dto.SuggestProductsRequest)
+		if err = json.Unmarshal(data, req); err != nil {
+			err = fmt.Errorf("invalid message: %w", err)
+		} else {
+			err = c.Validate(req)
+		}
+		if err != nil {
+			msg := err.Error()
+			var he *echo.HTTPError
+			if errors.As(err, &he) {
+				msg = fmt.Sprint(he.Message)
+			}
+			select {
+			case invalid <- msg:
+			case <-ctx.Done():
+				return
+			}
+			continue
+		}
+
+		select {
+		case queries <- &repository.SuggestProductsQuery{Prefix: req.Prefix, Limit: req.Limit}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// closeWebSocket はクローズフレームを送信します。
+//
+// Parameters:
+//   - conn: WebSocket接続
+//   - code: クローズコード
+//   - reason: クローズ理由
+func (h *CQRSServiceHandler) closeWebSocket(conn *websocket.Conn, code int, reason string) {
+	msg := websocket.FormatCloseMessage(code, reason)
+	if err := conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(wsCloseTimeout)); err != nil && !errors.Is(err, websocket.ErrCloseSent) {
+		h.logger.Warn("Failed to write websocket close message", "error", err)
+	}
+}
+
 // ProductById はIDで商品を取得します。
 // @tags Product
 // @Summary 商品取得
-// @Description IDで商品を取得します。
+// @Description IDで公開中の商品を取得します。公開中以外の商品は404を返します。
 // @ID get-product-by-id
 // @Produce application/json
+// @Param If-None-Match header string false "前回取得時のETag"
+// @Param Accept-Language header string false "名前のロケール（例: en, ja;q=0.8）"
+// @Param locale query string false "名前のロケール（Accept-Languageより優先）"
 // @Param id path string true "商品ID"
 // @Success 200 {object} dto.ProductByIdResponse
+// @Header 200 {string} ETag "レスポンスボディの強いETag"
+// @Header 200 {string} Cache-Control "キャッシュ方針"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /products/{id} [get]
 func (h *CQRSServiceHandler) ProductById(c echo.Context) error {
@@ -414,7 +658,7 @@ func (h *CQRSServiceHandler) ProductById(c echo.Context) error {
 	product, err := h.repo.ProductById(c.Request().Context(), id)
 	if err != nil {
 		h.logger.Error("Failed to get product", "error", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get product").SetInternal(err)
+		return toHTTPError(err, "Failed to get product")
 	}
 
 	resp := dto.ProductByIdResponse{
@@ -423,6 +667,95 @@ func (h *CQRSServiceHandler) ProductById(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+// ProductByBarcode はJAN/EAN/UPCバーコードで商品を取得します。
+// @tags Product
+// @Summary バーコードによる商品取得
+// @Description JAN/EAN/UPCバーコード（8桁、12桁または13桁）で公開中の商品を取得します。UPC-Aは先頭に0を付けたEAN-13として検索します。
+// @ID get-product-by-barcode
+// @Produce application/json
+// @Param If-None-Match header string false "前回取得時のETag"
+// @Param Accept-Language header string false "名前のロケール（例: en, ja;q=0.8）"
+// @Param locale query string false "名前のロケール（Accept-Languageより優先）"
+// @Param code path string true "バーコード"
+// @Success 200 {object} dto.ProductByBarcodeResponse
+// @Header 200 {string} ETag "レスポンスボディの強いETag"
+// @Header 200 {string} Cache-Control "キャッシュ方針"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /products/by-barcode/{code} [get]
+func (h *CQRSServiceHandler) ProductByBarcode(c echo.Context) error {
+	code := c.Param("code")
+	if !isBarcode(code) {
+		return echo.NewHTTPError(http.StatusBadRequest, "code must be 8, 12 or 13 digits")
+	}
+
+	product, err := h.repo.ProductByBarcode(c.Request().Context(), code)
+	if err != nil {
+		h.logger.Error("Failed to get product by barcode", "error", err)
+		return toHTTPError(err, "Failed to get product")
+	}
+
+	resp := dto.ProductByBarcodeResponse{
+		Product: productToDTO(product),
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ProductBySlug はスラッグで商品を取得します。
+// @tags Product
+// @Summary スラッグによる商品取得
+// @Description スラッグで公開中の商品を取得します。変更前のスラッグの場合はmovedをtrueにし、redirect_toに現在のスラッグのパスを返します。
+// @ID get-product-by-slug
+// @Produce application/json
+// @Param If-None-Match header string false "前回取得時のETag"
+// @Param Accept-Language header string false "名前のロケール（例: en, ja;q=0.8）"
+// @Param locale query string false "名前のロケール（Accept-Languageより優先）"
+// @Param slug path string true "スラッグ"
+// @Success 200 {object} dto.ProductBySlugResponse
+// @Header 200 {string} ETag "レスポンスボディの強いETag"
+// @Header 200 {string} Cache-Control "キャッシュ方針"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /products/by-slug/{slug} [get]
+func (h *CQRSServiceHandler) ProductBySlug(c echo.Context) error {
+	slug := c.Param("slug")
+	if slug == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "slug is required")
+	}
+
+	product, moved, err := h.repo.ProductBySlug(c.Request().Context(), slug)
+	if err != nil {
+		h.logger.Error("Failed to get product by slug", "error", err)
+		return toHTTPError(err, "Failed to get product")
+	}
+
+	resp := dto.ProductBySlugResponse{
+		Product: productToDTO(product),
+		Moved:   moved,
+	}
+	if moved {
+		resp.RedirectTo = "/products/by-slug/" + product.Slug()
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// isBarcode はバーコードが8桁、12桁または13桁の数字かを判定します。チェックデジットはコマンドサービスで検証済みのため確認しません。
+func isBarcode(code string) bool {
+	if len(code) != 8 && len(code) != 12 && len(code) != 13 {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // ProductByKeyword はキーワードで商品を検索します。
 // このメソッドはProductListから内部的に呼び出されます。
 func (h *CQRSServiceHandler) ProductByKeyword(c echo.Context) error {
@@ -443,13 +776,525 @@ func (h *CQRSServiceHandler) ProductByKeyword(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+// VariantList は商品のバリエーション一覧を取得します。
+// @tags Variant
+// @Summary バリエーション一覧取得
+// @Description 商品のバリエーション一覧を取得します。
+// @ID list-variants
+// @Produce application/json
+// @Param id path string true "商品ID"
+// @Success 200 {object} dto.VariantListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /products/{id}/variants [get]
+func (h *CQRSServiceHandler) VariantList(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "id is required")
+	}
+
+	product, err := h.repo.ProductById(c.Request().Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get product", "error", err)
+		return toHTTPError(err, "Failed to get variants")
+	}
+
+	resp := dto.VariantListResponse{
+		Variants: variantsToDTO(product.Variants()),
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateVariant は商品にバリエーションを追加します。
+// @tags Variant
+// @Summary バリエーション追加
+// @Description 商品にバリエーションを追加します。SKUまたは選択肢の組み合わせが重複する場合は409を返します。
+// @ID create-variant
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "商品ID"
+// @Param request body dto.CreateVariantRequest true "バリエーション情報"
+// @Success 201 {object} dto.CreateVariantResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /products/{id}/variants [post]
+func (h *CQRSServiceHandler) CreateVariant(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "id is required")
+	}
+
+	req := new(dto.CreateVariantRequest)
+	if err := c.Bind(req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := c.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	variant := models.NewVariant("", req.Sku, optionsFromDTO(req.Options), req.PriceOverride, 0, req.Status)
+	added, err := h.repo.AddVariant(c.Request().Context(), id, variant)
+	if err != nil {
+		h.logger.Error("Failed to add variant", "error", err)
+		return toHTTPError(err, "Failed to add variant")
+	}
+
+	resp := dto.CreateVariantResponse{
+		Variant: variantToDTO(added),
+	}
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// UpdateVariant は商品のバリエーションを更新します。
+// @tags Variant
+// @Summary バリエーション更新
+// @Description 商品のバリエーションを更新します。SKUまたは選択肢の組み合わせが重複する場合は409を返します。
+// @ID update-variant
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "商品ID"
+// @Param variantId path string true "バリエーションID"
+// @Param request body dto.UpdateVariantRequest true "バリエーション情報"
+// @Success 200 {object} dto.UpdateVariantResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /products/{id}/variants/{variantId} [put]
+func (h *CQRSServiceHandler) UpdateVariant(c echo.Context) error {
+	id := c.Param("id")
+	variantId := c.Param("variantId")
+	if id == "" || variantId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "id and variantId are required")
+	}
+
+	req := new(dto.UpdateVariantRequest)
+	if err := c.Bind(req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := c.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	variant := models.NewVariant(variantId, req.Sku, optionsFromDTO(req.Options), req.PriceOverride, 0, req.Status)
+	updated, err := h.repo.UpdateVariant(c.Request().Context(), id, variant)
+	if err != nil {
+		h.logger.Error("Failed to update variant", "error", err)
+		return toHTTPError(err, "Failed to update variant")
+	}
+
+	resp := dto.UpdateVariantResponse{
+		Variant: variantToDTO(updated),
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteVariant は商品のバリエーションを削除します。
+// @tags Variant
+// @Summary バリエーション削除
+// @Description 商品のバリエーションを削除します。
+// @ID delete-variant
+// @Param id path string true "商品ID"
+// @Param variantId path string true "バリエーションID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /products/{id}/variants/{variantId} [delete]
+func (h *CQRSServiceHandler) DeleteVariant(c echo.Context) error {
+	id := c.Param("id")
+	variantId := c.Param("variantId")
+	if id == "" || variantId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "id and variantId are required")
+	}
+
+	if err := h.repo.RemoveVariant(c.Request().Context(), id, variantId); err != nil {
+		h.logger.Error("Failed to remove variant", "error", err)
+		return toHTTPError(err, "Failed to remove variant")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// PublishProduct は商品を公開します。
+// @tags Product
+// @Summary 商品公開
+// @Description 下書きまたは一時停止中の商品を公開します。公開中の商品のみ一覧・検索に表示されます。遷移できない状態の場合は409を返します。
+// @ID publish-product
+// @Produce application/json
+// @Param id path string true "商品ID"
+// @Success 200 {object} dto.ChangeProductStatusResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /products/{id}/publish [post]
+func (h *CQRSServiceHandler) PublishProduct(c echo.Context) error {
+	return h.changeProductStatus(c, h.repo.PublishProduct, "Failed to publish product")
+}
+
+// SuspendProduct は公開中の商品を一時停止します。
+// @tags Product
+// @Summary 商品一時停止
+// @Description 公開中の商品を一時停止し、一覧・検索に表示されないようにします。遷移できない状態の場合は409を返します。
+// @ID suspend-product
+// @Produce application/json
+// @Param id path string true "商品ID"
+// @Success 200 {object} dto.ChangeProductStatusResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /products/{id}/suspend [post]
+func (h *CQRSServiceHandler) SuspendProduct(c echo.Context) error {
+	return h.changeProductStatus(c, h.repo.SuspendProduct, "Failed to suspend product")
+}
+
+// DiscontinueProduct は商品を販売終了にします。
+// @tags Product
+// @Summary 商品販売終了
+// @Description 商品を販売終了にします。販売終了した商品は他の状態に遷移できません。既に販売終了している場合は409を返します。
+// @ID discontinue-product
+// @Produce application/json
+// @Param id path string true "商品ID"
+// @Success 200 {object} dto.ChangeProductStatusResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /products/{id}/discontinue [post]
+func (h *CQRSServiceHandler) DiscontinueProduct(c echo.Context) error {
+	return h.changeProductStatus(c, h.repo.DiscontinueProduct, "Failed to discontinue product")
+}
+
+// changeProductStatus はパスの商品IDに対して販売状態の遷移を実行し、変更後の商品を返します。
+//
+// Parameters:
+//   - c: Echoコンテキスト
+//   - transition: 販売状態を遷移させるリポジトリのメソッド
+//   - message: 内部エラー時のメッセージ
+//
+// Returns:
+//   - error: エラー
+func (h *CQRSServiceHandler) changeProductStatus(c echo.Context, transition func(ctx context.Context, productId string) (*models.Product, error), message string) error {
+	id := c.Param("id")
+	if id == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "id is required")
+	}
+
+	product, err := transition(c.Request().Context(), id)
+	if err != nil {
+		h.logger.Error(message, "error", err)
+		return toHTTPError(err, message)
+	}
+
+	resp := dto.ChangeProductStatusResponse{
+		Product: productToDTO(product),
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SchedulePrice は商品に期間を指定した価格変更を登録します。
+// @tags PriceSchedule
+// @Summary 価格スケジュール登録
+// @Description 期間を指定して商品の単価を変更します（例: 金曜0時から日曜23時59分まで980円）。開始時刻に単価を変更し、終了時刻に元の単価へ戻します。期間が他の価格スケジュールと重なる場合は409を返します。
+// @ID schedule-price
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "商品ID"
+// @Param request body dto.SchedulePriceRequest true "価格スケジュール情報"
+// @Success 201 {object} dto.SchedulePriceResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /products/{id}/price-schedules [post]
+func (h *CQRSServiceHandler) SchedulePrice(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "id is required")
+	}
+
+	req := new(dto.SchedulePriceRequest)
+	if err := c.Bind(req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := c.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	schedule := models.NewPriceSchedule("", id, req.Price, nil, "", req.StartsAt, req.EndsAt)
+	scheduled, err := h.repo.SchedulePrice(c.Request().Context(), schedule)
+	if err != nil {
+		h.logger.Error("Failed to schedule price", "error", err)
+		return toHTTPError(err, "Failed to schedule price")
+	}
+
+	resp := dto.SchedulePriceResponse{
+		PriceSchedule: priceScheduleToDTO(scheduled),
+	}
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// CancelPriceSchedule は商品の価格スケジュールを取り消します。
+// @tags PriceSchedule
+// @Summary 価格スケジュール取消
+// @Description 商品の価格スケジュールを取り消します。適用中の場合は元の単価に戻します。既に終了または取消済みの場合は409を返します。
+// @ID cancel-price-schedule
+// @Produce application/json
+// @Param id path string true "商品ID"
+// @Param scheduleId path string true "価格スケジュールID"
+// @Success 200 {object} dto.CancelPriceScheduleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /products/{id}/price-schedules/{scheduleId} [delete]
+func (h *CQRSServiceHandler) CancelPriceSchedule(c echo.Context) error {
+	id := c.Param("id")
+	scheduleId := c.Param("scheduleId")
+	if id == "" || scheduleId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "id and scheduleId are required")
+	}
+
+	cancelled, err := h.repo.CancelPriceSchedule(c.Request().Context(), id, scheduleId)
+	if err != nil {
+		h.logger.Error("Failed to cancel price schedule", "error", err)
+		return toHTTPError(err, "Failed to cancel price schedule")
+	}
+
+	resp := dto.CancelPriceScheduleResponse{
+		PriceSchedule: priceScheduleToDTO(cancelled),
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// AdjustPrices はカテゴリに属する商品の単価を一括で変更します。
+// @tags PriceAdjustment
+// @Summary カテゴリ単位の単価の一括変更
+// @Description カテゴリ（子孫カテゴリ・タグで絞り込み可）に属する商品の単価を割合または金額で一括変更します。すべての商品を1つのトランザクションで変更します。previewを指定した場合は変更せずに変更前後の単価を返します。変更後の単価が範囲外になる場合や、金額を含む変更で通貨の異なる商品が含まれる場合は409を返します。
+// @ID adjust-prices
+// @Accept application/json
+// @Produce application/json
+// @Param id path string true "カテゴリID"
+// @Param request body dto.AdjustPricesRequest true "単価の一括変更の条件と規則"
+// @Success 200 {object} dto.AdjustPricesResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /categories/{id}/price-adjustments [post]
+func (h *CQRSServiceHandler) AdjustPrices(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "id is required")
+	}
+
+	req := new(dto.AdjustPricesRequest)
+	if err := c.Bind(req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := c.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	target := &repository.PriceAdjustmentTarget{
+		CategoryId:         id,
+		IncludeDescendants: req.IncludeDescendants,
+		Tags:               req.Tags,
+	}
+	adjustment := models.NewPriceAdjustment(
+		req.Adjustment.Type,
+		req.Adjustment.Value,
+		req.Adjustment.Rounding,
+		req.Adjustment.RoundingUnit,
+		req.Adjustment.MinPrice,
+		req.Adjustment.MaxPrice,
+	)
+	changes, err := h.repo.AdjustPrices(c.Request().Context(), target, adjustment, req.Preview)
+	if err != nil {
+		h.logger.Error("Failed to adjust prices", "error", err)
+		return toHTTPError(err, "Failed to adjust prices")
+	}
+
+	resp := dto.AdjustPricesResponse{
+		Changes: make([]*dto.PriceChange, 0, len(changes)),
+		Preview: req.Preview,
+	}
+	for _, change := range changes {
+		resp.Changes = append(resp.Changes, &dto.PriceChange{
+			ProductId:   change.ProductId(),
+			ProductName: change.ProductName(),
+			Currency:    change.Currency(),
+			BeforePrice: change.BeforePrice(),
+			AfterPrice:  change.AfterPrice(),
+		})
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// TagList はタグ一覧を付与された商品数とともに取得します。
+// @tags Tag
+// @Summary タグ一覧取得
+// @Description タグ一覧を付与された商品数の多い順に取得します。商品に付与されていないタグも含みます。
+// @ID list-tags
+// @Produce application/json
+// @Param If-None-Match header string false "前回取得時のETag"
+// @Param If-Modified-Since header string false "前回取得時のLast-Modified"
+// @Success 200 {object} dto.TagListResponse
+// @Header 200 {string} ETag "レスポンスボディの強いETag"
+// @Header 200 {string} Cache-Control "キャッシュ方針"
+// @Header 200 {string} Last-Modified "ゲートウェイが観測した最新の変更時刻"
+// @Success 304 "Not Modified"
+// @Failure 500 {object} map[string]string
+// @Router /tags [get]
+func (h *CQRSServiceHandler) TagList(c echo.Context) error {
+	usages, err := h.repo.TagList(c.Request().Context())
+	if err != nil {
+		h.logger.Error("Failed to list tags", "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list tags").SetInternal(err)
+	}
+
+	resp := dto.TagListResponse{
+		Tags: tagUsagesToDTO(usages),
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// AttachTags は複数の商品に複数のタグを一括で付与します。
+// @tags Tag
+// @Summary タグ一括付与
+// @Description 複数の商品に複数のタグを一括で付与します。存在しないタグは作成され、既に付与済みの組み合わせは無視されます。
+// @Description 存在しない商品が含まれる場合は404を返し、いずれの商品にも付与しません。
+// @ID attach-tags
+// @Accept application/json
+// @Produce application/json
+// @Param request body dto.ChangeTagsRequest true "対象の商品IDとタグ名"
+// @Success 200 {object} dto.AttachTagsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /tags/attach [post]
+func (h *CQRSServiceHandler) AttachTags(c echo.Context) error {
+	req := new(dto.ChangeTagsRequest)
+	if err := c.Bind(req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := c.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	change, err := h.repo.AttachTags(c.Request().Context(), req.ProductIds, req.TagNames)
+	if err != nil {
+		h.logger.Error("Failed to attach tags", "error", err)
+		return toHTTPError(err, "Failed to attach tags")
+	}
+
+	resp := dto.AttachTagsResponse{
+		Tags:          tagsToDTO(change.Tags()),
+		AttachedCount: change.Changed(),
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DetachTags は複数の商品から複数のタグを一括で解除します。
+// @tags Tag
+// @Summary タグ一括解除
+// @Description 複数の商品から複数のタグを一括で解除します。存在しないタグや付与されていない組み合わせは無視されます。
+// @Description 存在しない商品が含まれる場合は404を返し、いずれの商品からも解除しません。
+// @ID detach-tags
+// @Accept application/json
+// @Produce application/json
+// @Param request body dto.ChangeTagsRequest true "対象の商品IDとタグ名"
+// @Success 200 {object} dto.DetachTagsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /tags/detach [post]
+func (h *CQRSServiceHandler) DetachTags(c echo.Context) error {
+	req := new(dto.ChangeTagsRequest)
+	if err := c.Bind(req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := c.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	change, err := h.repo.DetachTags(c.Request().Context(), req.ProductIds, req.TagNames)
+	if err != nil {
+		h.logger.Error("Failed to detach tags", "error", err)
+		return toHTTPError(err, "Failed to detach tags")
+	}
+
+	resp := dto.DetachTagsResponse{
+		Tags:          tagsToDTO(change.Tags()),
+		DetachedCount: change.Changed(),
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// toHTTPError はバックエンドサービスのエラーコードをHTTPエラーに変換します。
+// 入力不正・未存在・重複はそれぞれ400・404・409とし、それ以外は500とします。
+//
+// Parameters:
+//   - err: バックエンドサービスのエラー
+//   - message: 500の場合のエラーメッセージ
+//
+// Returns:
+//   - *echo.HTTPError: HTTPエラー
+func toHTTPError(err error, message string) *echo.HTTPError {
+	switch connect.CodeOf(err) {
+	case connect.CodeInvalidArgument:
+		return echo.NewHTTPError(http.StatusBadRequest, connectErrorMessage(err)).SetInternal(err)
+	case connect.CodeNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, connectErrorMessage(err)).SetInternal(err)
+	case connect.CodeAlreadyExists, connect.CodeFailedPrecondition:
+		return echo.NewHTTPError(http.StatusConflict, connectErrorMessage(err)).SetInternal(err)
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, message).SetInternal(err)
+	}
+}
+
+// connectErrorMessage はConnectエラーからクライアントに返すメッセージを取り出します。
+func connectErrorMessage(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Message()
+	}
+	return err.Error()
+}
+
 func categoryToDTO(category *models.Category) *dto.Category {
 	if category == nil {
 		return nil
 	}
 	return &dto.Category{
-		Id:   category.Id(),
-		Name: category.Name(),
+		Id:           category.Id(),
+		Name:         category.Name(),
+		Locale:       category.Locale(),
+		Translations: category.Translations(),
+		Slug:         category.Slug(),
 	}
 }
 
@@ -469,11 +1314,145 @@ func productToDTO(product *models.Product) *dto.Product {
 		return nil
 	}
 	return &dto.Product{
-		Id:       product.Id(),
-		Name:     product.Name(),
-		Price:    product.Price(),
-		Category: categoryToDTO(product.Category()),
+		Id:                product.Id(),
+		Name:              product.Name(),
+		Locale:            product.Locale(),
+		Translations:      product.Translations(),
+		Price:             product.Price(),
+		TaxClass:          product.TaxClass(),
+		PriceExcludingTax: moneyToDTO(product.PriceExcludingTax()),
+		PriceIncludingTax: moneyToDTO(product.PriceIncludingTax()),
+		Category:          categoryToDTO(product.Category()),
+		Variants:          variantsToDTO(product.Variants()),
+		Tags:              tagsToDTO(product.Tags()),
+		Status:            product.Status(),
+		PriceSchedules:    priceSchedulesToDTO(product.PriceSchedules()),
+		Barcode:           barcodeToDTO(product.Barcode()),
+		Slug:              product.Slug(),
+	}
+}
+
+func barcodeToDTO(barcode *string) string {
+	if barcode == nil {
+		return ""
+	}
+	return *barcode
+}
+
+func moneyToDTO(m *models.Money) *dto.Money {
+	if m == nil {
+		return nil
+	}
+	return &dto.Money{Amount: m.Amount(), Currency: m.Currency()}
+}
+
+func variantToDTO(variant *models.Variant) *dto.Variant {
+	if variant == nil {
+		return nil
+	}
+	options := make([]*dto.VariantOption, len(variant.Options()))
+	for i, option := range variant.Options() {
+		options[i] = &dto.VariantOption{Axis: option.Axis(), Value: option.Value()}
+	}
+	return &dto.Variant{
+		Id:            variant.Id(),
+		Sku:           variant.Sku(),
+		Options:       options,
+		PriceOverride: variant.PriceOverride(),
+		Price:         variant.Price(),
+		Status:        variant.Status(),
+	}
+}
+
+func priceScheduleToDTO(schedule *models.PriceSchedule) *dto.PriceSchedule {
+	if schedule == nil {
+		return nil
+	}
+	return &dto.PriceSchedule{
+		Id:           schedule.Id(),
+		ProductId:    schedule.ProductId(),
+		Price:        schedule.Price(),
+		RegularPrice: schedule.RegularPrice(),
+		Status:       schedule.Status(),
+		StartsAt:     schedule.StartsAt(),
+		EndsAt:       schedule.EndsAt(),
+	}
+}
+
+func priceSchedulesToDTO(schedules []*models.PriceSchedule) []*dto.PriceSchedule {
+	if len(schedules) == 0 {
+		return nil
+	}
+	converted := make([]*dto.PriceSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		converted = append(converted, priceScheduleToDTO(schedule))
+	}
+	return converted
+}
+
+func variantsToDTO(variants []*models.Variant) []*dto.Variant {
+	if len(variants) == 0 {
+		return nil
+	}
+	converted := make([]*dto.Variant, 0, len(variants))
+	for _, variant := range variants {
+		converted = append(converted, variantToDTO(variant))
+	}
+	return converted
+}
+
+func optionsFromDTO(options []*dto.VariantOption) []*models.VariantOption {
+	converted := make([]*models.VariantOption, len(options))
+	for i, option := range options {
+		converted[i] = models.NewVariantOption(option.Axis, option.Value)
+	}
+	return converted
+}
+
+func tagToDTO(tag *models.Tag) *dto.Tag {
+	if tag == nil {
+		return nil
+	}
+	return &dto.Tag{
+		Id:   tag.Id(),
+		Name: tag.Name(),
+	}
+}
+
+func tagsToDTO(tags []*models.Tag) []*dto.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	converted := make([]*dto.Tag, 0, len(tags))
+	for _, tag := range tags {
+		converted = append(converted, tagToDTO(tag))
+	}
+	return converted
+}
+
+func tagUsagesToDTO(usages []*models.TagUsage) []*dto.TagUsage {
+	converted := make([]*dto.TagUsage, len(usages))
+	for i, usage := range usages {
+		converted[i] = &dto.TagUsage{
+			Tag:          tagToDTO(usage.Tag()),
+			ProductCount: usage.ProductCount(),
+		}
+	}
+	return converted
+}
+
+func suggestionsToDTO(suggestions []*models.ProductSuggestion) []*dto.ProductSuggestion {
+	result := make([]*dto.ProductSuggestion, len(suggestions))
+	for i, s := range suggestions {
+		result[i] = &dto.ProductSuggestion{
+			ProductId: s.ProductId(),
+			Name:      s.Name(),
+			Highlight: s.Highlight(),
+			Category:  categoryToDTO(s.Category()),
+			Score:     s.Score(),
+		}
 	}
+	return result
 }
 
 func productsToDTO(products []*models.Product) []*dto.Product {
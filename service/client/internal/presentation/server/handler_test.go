@@ -10,7 +10,10 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"connectrpc.com/connect"
+	"github.com/gorilla/websocket"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/domain/models"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/domain/repository"
 	mock_repository "github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/mock/repository"
@@ -33,7 +36,7 @@ func TestCQRSServiceHandler_CreateCategory(t *testing.T) {
 		// モックの設定
 		expectedCategory := models.NewCategory("cat-123", "TestCategory")
 		mockRepo.EXPECT().
-			CreateCategory(gomock.Any(), "TestCategory").
+			CreateCategory(gomock.Any(), "TestCategory", "").
 			Return(expectedCategory, nil)
 
 		// Act
@@ -100,7 +103,7 @@ func TestCQRSServiceHandler_CreateCategory(t *testing.T) {
 
 		// モックの設定: エラーを返す
 		mockRepo.EXPECT().
-			CreateCategory(gomock.Any(), "TestCategory").
+			CreateCategory(gomock.Any(), "TestCategory", "").
 			Return(nil, errors.New("database error"))
 
 		// Act
@@ -110,6 +113,24 @@ func TestCQRSServiceHandler_CreateCategory(t *testing.T) {
 		assertHTTPError(t, err, http.StatusInternalServerError)
 	})
 
+	t.Run("異常系: 指定したスラッグが使用済みの場合は409を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		requestBody := `{"name":"TestCategory","slug":"stationery"}`
+		c, _ := newJSONContext(e, http.MethodPost, "/categories", requestBody)
+
+		mockRepo.EXPECT().
+			CreateCategory(gomock.Any(), "TestCategory", "stationery").
+			Return(nil, connect.NewError(connect.CodeAlreadyExists, errors.New("slug already exists")))
+
+		// Act
+		err := handler.CreateCategory(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusConflict)
+	})
+
 	t.Run("正常系: 境界値テスト（1文字）", func(t *testing.T) {
 		// Arrange
 		handler, mockRepo, e := newHandlerTestEnv(t)
@@ -120,7 +141,7 @@ func TestCQRSServiceHandler_CreateCategory(t *testing.T) {
 		// モックの設定
 		expectedCategory := models.NewCategory("cat-123", "A")
 		mockRepo.EXPECT().
-			CreateCategory(gomock.Any(), "A").
+			CreateCategory(gomock.Any(), "A", "").
 			Return(expectedCategory, nil)
 
 		// Act
@@ -142,7 +163,7 @@ func TestCQRSServiceHandler_CreateCategory(t *testing.T) {
 		// モックの設定
 		expectedCategory := models.NewCategory("cat-123", categoryName)
 		mockRepo.EXPECT().
-			CreateCategory(gomock.Any(), categoryName).
+			CreateCategory(gomock.Any(), categoryName, "").
 			Return(expectedCategory, nil)
 
 		// Act
@@ -369,6 +390,82 @@ func TestCQRSServiceHandler_CategoryById(t *testing.T) {
 	})
 }
 
+func TestCQRSServiceHandler_CategoryBySlug(t *testing.T) {
+	t.Run("正常系: 現在のスラッグでカテゴリを取得できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/categories/by-slug/stationery", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("stationery")
+
+		expectedCategory := models.NewCategory("cat-123", "TestCategory").WithSlug("stationery")
+		mockRepo.EXPECT().
+			CategoryBySlug(gomock.Any(), "stationery").
+			Return(expectedCategory, false, nil)
+
+		// Act
+		err := handler.CategoryBySlug(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.CategoryBySlugResponse
+		decodeJSONResponse(t, rec, &response)
+		assert.Equal(t, "cat-123", response.Category.Id)
+		assert.Equal(t, "stationery", response.Category.Slug)
+		assert.False(t, response.Moved)
+		assert.Empty(t, response.RedirectTo)
+	})
+
+	t.Run("正常系: 変更前のスラッグの場合は現在のスラッグのパスを返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/categories/by-slug/bunbogu", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("bunbogu")
+
+		expectedCategory := models.NewCategory("cat-123", "TestCategory").WithSlug("stationery")
+		mockRepo.EXPECT().
+			CategoryBySlug(gomock.Any(), "bunbogu").
+			Return(expectedCategory, true, nil)
+
+		// Act
+		err := handler.CategoryBySlug(c)
+
+		// Assert
+		require.NoError(t, err)
+		var response dto.CategoryBySlugResponse
+		decodeJSONResponse(t, rec, &response)
+		assert.True(t, response.Moved)
+		assert.Equal(t, "/categories/by-slug/stationery", response.RedirectTo)
+	})
+
+	t.Run("異常系: カテゴリが見つからない場合は404を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/categories/by-slug/furniture", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("furniture")
+
+		mockRepo.EXPECT().
+			CategoryBySlug(gomock.Any(), "furniture").
+			Return(nil, false, connect.NewError(connect.CodeNotFound, errors.New("category not found")))
+
+		// Act
+		err := handler.CategoryBySlug(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusNotFound)
+	})
+}
+
 func TestCQRSServiceHandler_CreateProduct(t *testing.T) {
 	t.Run("正常系: 商品を作成できる", func(t *testing.T) {
 		// Arrange
@@ -381,8 +478,14 @@ func TestCQRSServiceHandler_CreateProduct(t *testing.T) {
 		category := models.NewCategory("550e8400-e29b-41d4-a716-446655440000", "TestCategory")
 		expectedProduct := models.NewProduct("prod-123", "TestProduct", 1000, category)
 		mockRepo.EXPECT().
-			CreateProduct(gomock.Any(), "TestProduct", uint32(1000), gomock.Any()).
-			Return(expectedProduct, nil)
+			CreateProduct(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, product *models.Product) (*models.Product, error) {
+				assert.Equal(t, "TestProduct", product.Name())
+				assert.Equal(t, uint32(1000), product.Price())
+				assert.Empty(t, product.Currency())
+				assert.Empty(t, product.TaxClass())
+				return expectedProduct, nil
+			})
 
 		// Act
 		err := handler.CreateProduct(c)
@@ -399,6 +502,70 @@ func TestCQRSServiceHandler_CreateProduct(t *testing.T) {
 		assert.NotNil(t, response.Product.Category)
 	})
 
+	t.Run("正常系: 通貨と税率区分を指定して作成し、税抜・税込価格を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		requestBody := `{"name":"Coffee","price":1250,"currency":"USD","tax_class":"REDUCED","category": {"id":"550e8400-e29b-41d4-a716-446655440000","name":"Food"}}`
+		c, rec := newJSONContext(e, http.MethodPost, "/products", requestBody)
+
+		category := models.NewCategory("550e8400-e29b-41d4-a716-446655440000", "Food")
+		expectedProduct := models.NewProduct("prod-123", "Coffee", 1250, category).
+			WithTax("USD", "REDUCED").
+			WithPrices(models.NewMoney(1250, "USD"), models.NewMoney(1350, "USD"))
+		mockRepo.EXPECT().
+			CreateProduct(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, product *models.Product) (*models.Product, error) {
+				assert.Equal(t, "USD", product.Currency())
+				assert.Equal(t, "REDUCED", product.TaxClass())
+				return expectedProduct, nil
+			})
+
+		// Act
+		err := handler.CreateProduct(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var response dto.CreateProductResponse
+		decodeJSONResponse(t, rec, &response)
+		assert.Equal(t, "REDUCED", response.Product.TaxClass)
+		require.NotNil(t, response.Product.PriceExcludingTax)
+		assert.Equal(t, int64(1250), response.Product.PriceExcludingTax.Amount)
+		assert.Equal(t, "USD", response.Product.PriceExcludingTax.Currency)
+		require.NotNil(t, response.Product.PriceIncludingTax)
+		assert.Equal(t, int64(1350), response.Product.PriceIncludingTax.Amount)
+	})
+
+	t.Run("異常系: バリデーションエラー（未対応の税率区分）", func(t *testing.T) {
+		// Arrange
+		handler, _, e := newHandlerTestEnv(t)
+
+		requestBody := `{"name":"TestProduct","price":1000,"tax_class":"ZERO","category": {"id":"550e8400-e29b-41d4-a716-446655440000","name":"TestCategory"}}`
+		c, _ := newJSONContext(e, http.MethodPost, "/products", requestBody)
+
+		// Act
+		err := handler.CreateProduct(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("異常系: バリデーションエラー（バーコードの桁数が不正）", func(t *testing.T) {
+		// Arrange
+		handler, _, e := newHandlerTestEnv(t)
+
+		requestBody := `{"name":"TestProduct","price":1000,"barcode":"12345","category": {"id":"550e8400-e29b-41d4-a716-446655440000","name":"TestCategory"}}`
+		c, _ := newJSONContext(e, http.MethodPost, "/products", requestBody)
+
+		// Act
+		err := handler.CreateProduct(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusBadRequest)
+	})
+
 	t.Run("異常系: バリデーションエラー（priceが0）", func(t *testing.T) {
 		// Arrange
 		handler, _, e := newHandlerTestEnv(t)
@@ -428,216 +595,1253 @@ func TestCQRSServiceHandler_CreateProduct(t *testing.T) {
 	})
 }
 
-func TestCQRSServiceHandler_ProductList(t *testing.T) {
-	t.Run("正常系: 商品一覧を取得できる", func(t *testing.T) {
+func TestCQRSServiceHandler_VariantList(t *testing.T) {
+	t.Run("正常系: 商品のバリエーション一覧を取得できる", func(t *testing.T) {
 		// Arrange
 		handler, mockRepo, e := newHandlerTestEnv(t)
-		req := httptest.NewRequest(http.MethodGet, "/products", nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
+
+		c, rec := newJSONContext(e, http.MethodGet, "/products/prod-123/variants", "")
+		c.SetPath("/products/:id/variants")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
 
 		// モックの設定
-		category := models.NewCategory("cat-1", "Category1")
-		expectedProducts := []*models.Product{
-			models.NewProduct("prod-1", "Product1", 1000, category),
-			models.NewProduct("prod-2", "Product2", 2000, category),
-		}
-		mockRepo.EXPECT().
-			ProductList(gomock.Any()).
-			Return(expectedProducts, nil)
+		priceOverride := uint32(1200)
+		product := models.NewProduct("prod-123", "TestProduct", 1000, models.NewCategory("cat-123", "TestCategory")).
+			WithVariants([]*models.Variant{
+				models.NewVariant("var-1", "TS-RED-M", []*models.VariantOption{models.NewVariantOption("サイズ", "M")}, nil, 1000, "ACTIVE"),
+				models.NewVariant("var-2", "TS-RED-L", []*models.VariantOption{models.NewVariantOption("サイズ", "L")}, &priceOverride, 1200, "INACTIVE"),
+			})
+		mockRepo.EXPECT().ProductById(gomock.Any(), "prod-123").Return(product, nil)
 
 		// Act
-		err := handler.ProductList(c)
+		err := handler.VariantList(c)
 
 		// Assert
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var response dto.ProductListResponse
+		var response dto.VariantListResponse
 		decodeJSONResponse(t, rec, &response)
-		assert.Len(t, response.Products, 2)
-		assert.Equal(t, "prod-1", response.Products[0].Id)
-		assert.Equal(t, uint32(1000), response.Products[0].Price)
+		require.Len(t, response.Variants, 2)
+		assert.Equal(t, "TS-RED-M", response.Variants[0].Sku)
+		assert.Equal(t, "サイズ", response.Variants[0].Options[0].Axis)
+		assert.Nil(t, response.Variants[0].PriceOverride)
+		assert.Equal(t, uint32(1000), response.Variants[0].Price)
+		require.NotNil(t, response.Variants[1].PriceOverride)
+		assert.Equal(t, uint32(1200), *response.Variants[1].PriceOverride)
+		assert.Equal(t, "INACTIVE", response.Variants[1].Status)
 	})
 
-	t.Run("正常系: keywordパラメータで検索できる", func(t *testing.T) {
+	t.Run("異常系: 商品が存在しない場合は404を返す", func(t *testing.T) {
 		// Arrange
 		handler, mockRepo, e := newHandlerTestEnv(t)
-		req := httptest.NewRequest(http.MethodGet, "/products?keyword=test", nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
 
-		// モックの設定（検索結果）
-		category := models.NewCategory("cat-1", "Category1")
-		expectedProducts := []*models.Product{
-			models.NewProduct("prod-1", "TestProduct1", 1000, category),
-			models.NewProduct("prod-2", "TestProduct2", 2000, category),
-		}
+		c, _ := newJSONContext(e, http.MethodGet, "/products/prod-404/variants", "")
+		c.SetPath("/products/:id/variants")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-404")
+
 		mockRepo.EXPECT().
-			ProductByKeyword(gomock.Any(), "test").
-			Return(expectedProducts, nil)
+			ProductById(gomock.Any(), "prod-404").
+			Return(nil, connect.NewError(connect.CodeNotFound, errors.New("product not found")))
 
 		// Act
-		err := handler.ProductList(c)
+		err := handler.VariantList(c)
 
 		// Assert
-		require.NoError(t, err)
-		assert.Equal(t, http.StatusOK, rec.Code)
-
-		var response dto.ProductByKeywordResponse
-		decodeJSONResponse(t, rec, &response)
-		assert.Len(t, response.Products, 2)
-		assert.Equal(t, "prod-1", response.Products[0].Id)
+		assertHTTPError(t, err, http.StatusNotFound)
 	})
 }
 
-func TestCQRSServiceHandler_ProductStream(t *testing.T) {
-	t.Run("正常系: 商品一覧をStream取得できる", func(t *testing.T) {
+func TestCQRSServiceHandler_CreateVariant(t *testing.T) {
+	t.Run("正常系: バリエーションを追加できる", func(t *testing.T) {
 		// Arrange
 		handler, mockRepo, e := newHandlerTestEnv(t)
-		req := httptest.NewRequest(http.MethodGet, "/stream/products", nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
+
+		requestBody := `{"sku":"TS-RED-M","options":[{"axis":"サイズ","value":"M"},{"axis":"カラー","value":"赤"}],"price_override":1200}`
+		c, rec := newJSONContext(e, http.MethodPost, "/products/prod-123/variants", requestBody)
+		c.SetPath("/products/:id/variants")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
 
 		// モックの設定
-		category := models.NewCategory("cat-1", "Category1")
-		expectedProducts := []*models.Product{
-			models.NewProduct("prod-1", "Product1", 1000, category),
-			models.NewProduct("prod-2", "Product2", 2000, category),
-		}
-		resultCh := make(chan *repository.StreamProductsResult, len(expectedProducts))
 		mockRepo.EXPECT().
-			StreamProducts(gomock.Any()).
-			Return(resultCh, nil)
-		// 結果をチャネルに送信
-		go func() {
-			for _, p := range expectedProducts {
-				resultCh <- &repository.StreamProductsResult{Product: p, Err: nil}
-			}
-			close(resultCh)
-		}()
+			AddVariant(gomock.Any(), "prod-123", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, productId string, variant *models.Variant) (*models.Variant, error) {
+				assert.Empty(t, variant.Id())
+				assert.Equal(t, "TS-RED-M", variant.Sku())
+				require.Len(t, variant.Options(), 2)
+				assert.Equal(t, "カラー", variant.Options()[1].Axis())
+				require.NotNil(t, variant.PriceOverride())
+				assert.Equal(t, uint32(1200), *variant.PriceOverride())
+				return models.NewVariant("var-1", variant.Sku(), variant.Options(), variant.PriceOverride(), 1200, "ACTIVE"), nil
+			})
 
 		// Act
-		err := handler.ProductStream(c)
+		err := handler.CreateVariant(c)
 
 		// Assert
 		require.NoError(t, err)
-		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, http.StatusCreated, rec.Code)
 
-		var response dto.ProductListResponse
+		var response dto.CreateVariantResponse
 		decodeJSONResponse(t, rec, &response)
-		assert.Len(t, response.Products, 2)
-		assert.Equal(t, "prod-1", response.Products[0].Id)
-		assert.Equal(t, uint32(1000), response.Products[0].Price)
-		assert.Equal(t, "prod-2", response.Products[1].Id)
-		assert.Equal(t, uint32(2000), response.Products[1].Price)
+		assert.Equal(t, "var-1", response.Variant.Id)
+		assert.Equal(t, uint32(1200), response.Variant.Price)
+		assert.Equal(t, "ACTIVE", response.Variant.Status)
 	})
 
-	t.Run("異常系: ストリーム受信中にエラー", func(t *testing.T) {
+	t.Run("異常系: バリデーションエラー（optionsが空）", func(t *testing.T) {
 		// Arrange
-		handler, mockRepo, e := newHandlerTestEnv(t)
-		req := httptest.NewRequest(http.MethodGet, "/stream/products", nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
-
-		resultCh := make(chan *repository.StreamProductsResult, 1)
-		resultCh <- &repository.StreamProductsResult{Err: errors.New("stream failure")}
-		close(resultCh)
+		handler, _, e := newHandlerTestEnv(t)
 
-		mockRepo.EXPECT().
-			StreamProducts(gomock.Any()).
-			Return(resultCh, nil)
+		requestBody := `{"sku":"TS-RED-M","options":[]}`
+		c, _ := newJSONContext(e, http.MethodPost, "/products/prod-123/variants", requestBody)
+		c.SetPath("/products/:id/variants")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
 
 		// Act
-		err := handler.ProductStream(c)
+		err := handler.CreateVariant(c)
 
 		// Assert
-		assertHTTPError(t, err, http.StatusInternalServerError)
+		assertHTTPError(t, err, http.StatusBadRequest)
 	})
 
-	t.Run("異常系: リポジトリエラー", func(t *testing.T) {
+	t.Run("異常系: バリデーションエラー（statusが不正）", func(t *testing.T) {
 		// Arrange
-		handler, mockRepo, e := newHandlerTestEnv(t)
-		req := httptest.NewRequest(http.MethodGet, "/stream/products", nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
+		handler, _, e := newHandlerTestEnv(t)
 
-		mockRepo.EXPECT().
-			StreamProducts(gomock.Any()).
-			Return(nil, errors.New("database error"))
+		requestBody := `{"sku":"TS-RED-M","options":[{"axis":"サイズ","value":"M"}],"status":"SOLD_OUT"}`
+		c, _ := newJSONContext(e, http.MethodPost, "/products/prod-123/variants", requestBody)
+		c.SetPath("/products/:id/variants")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
 
 		// Act
-		err := handler.ProductStream(c)
+		err := handler.CreateVariant(c)
 
 		// Assert
-		assertHTTPError(t, err, http.StatusInternalServerError)
+		assertHTTPError(t, err, http.StatusBadRequest)
 	})
 
-	t.Run("異常系: コンテキストキャンセル", func(t *testing.T) {
+	t.Run("異常系: SKUが重複する場合は409を返す", func(t *testing.T) {
 		// Arrange
 		handler, mockRepo, e := newHandlerTestEnv(t)
-		req := httptest.NewRequest(http.MethodGet, "/stream/products", nil)
-		ctx, cancel := context.WithCancel(req.Context())
-		req = req.WithContext(ctx)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
 
-		resultCh := make(chan *repository.StreamProductsResult)
+		requestBody := `{"sku":"TS-RED-M","options":[{"axis":"サイズ","value":"M"}]}`
+		c, _ := newJSONContext(e, http.MethodPost, "/products/prod-123/variants", requestBody)
+		c.SetPath("/products/:id/variants")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
+
 		mockRepo.EXPECT().
-			StreamProducts(gomock.Any()).
-			Return(resultCh, nil)
+			AddVariant(gomock.Any(), "prod-123", gomock.Any()).
+			Return(nil, connect.NewError(connect.CodeAlreadyExists, errors.New("variant already exists")))
 
-		errCh := make(chan error, 1)
 		// Act
-		go func() {
-			errCh <- handler.ProductStream(c)
-		}() // cancel直後に呼び出されるようにするため
-		cancel()
-		err := <-errCh
+		err := handler.CreateVariant(c)
 
 		// Assert
-		require.Error(t, err)
-		assert.ErrorIs(t, err, context.Canceled)
+		assertHTTPError(t, err, http.StatusConflict)
 	})
 }
 
-func TestCQRSServiceHandler_ProductById(t *testing.T) {
-	t.Run("正常系: 商品を取得できる", func(t *testing.T) {
+func TestCQRSServiceHandler_UpdateVariant(t *testing.T) {
+	t.Run("正常系: バリエーションを更新できる", func(t *testing.T) {
 		// Arrange
 		handler, mockRepo, e := newHandlerTestEnv(t)
-		req := httptest.NewRequest(http.MethodGet, "/products/prod-123", nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
-		c.SetParamNames("id")
-		c.SetParamValues("prod-123")
 
-		category := models.NewCategory("cat-1", "Category1")
-		expectedProduct := models.NewProduct("prod-123", "TestProduct", 1000, category)
+		requestBody := `{"sku":"TS-RED-M","options":[{"axis":"サイズ","value":"M"}],"status":"INACTIVE"}`
+		c, rec := newJSONContext(e, http.MethodPut, "/products/prod-123/variants/var-1", requestBody)
+		c.SetPath("/products/:id/variants/:variantId")
+		c.SetParamNames("id", "variantId")
+		c.SetParamValues("prod-123", "var-1")
+
+		// モックの設定
 		mockRepo.EXPECT().
-			ProductById(gomock.Any(), "prod-123").
-			Return(expectedProduct, nil)
+			UpdateVariant(gomock.Any(), "prod-123", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, productId string, variant *models.Variant) (*models.Variant, error) {
+				assert.Equal(t, "var-1", variant.Id())
+				assert.Nil(t, variant.PriceOverride())
+				assert.Equal(t, "INACTIVE", variant.Status())
+				return models.NewVariant(variant.Id(), variant.Sku(), variant.Options(), nil, 1000, variant.Status()), nil
+			})
 
 		// Act
-		err := handler.ProductById(c)
+		err := handler.UpdateVariant(c)
 
 		// Assert
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var response dto.ProductByIdResponse
+		var response dto.UpdateVariantResponse
 		decodeJSONResponse(t, rec, &response)
-		assert.Equal(t, "prod-123", response.Product.Id)
-		assert.Equal(t, "TestProduct", response.Product.Name)
+		assert.Equal(t, "var-1", response.Variant.Id)
+		assert.Equal(t, "INACTIVE", response.Variant.Status)
 	})
-}
 
-func TestCQRSServiceHandler_UpdateProduct(t *testing.T) {
-	t.Run("正常系: 商品を更新できる", func(t *testing.T) {
+	t.Run("異常系: バリエーションIDが空", func(t *testing.T) {
 		// Arrange
-		handler, mockRepo, e := newHandlerTestEnv(t)
+		handler, _, e := newHandlerTestEnv(t)
 
-		requestBody := `{"name":"UpdatedProduct","price":2000,"category":{"id":"550e8400-e29b-41d4-a716-446655440000","name":"TestCategory"}}`
-		c, rec := newJSONContext(e, http.MethodPut, "/products/prod-123", requestBody)
+		requestBody := `{"sku":"TS-RED-M","options":[{"axis":"サイズ","value":"M"}]}`
+		c, _ := newJSONContext(e, http.MethodPut, "/products/prod-123/variants/", requestBody)
+		c.SetPath("/products/:id/variants/:variantId")
+		c.SetParamNames("id", "variantId")
+		c.SetParamValues("prod-123", "")
+
+		// Act
+		err := handler.UpdateVariant(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestCQRSServiceHandler_DeleteVariant(t *testing.T) {
+	t.Run("正常系: バリエーションを削除できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		c, rec := newJSONContext(e, http.MethodDelete, "/products/prod-123/variants/var-1", "")
+		c.SetPath("/products/:id/variants/:variantId")
+		c.SetParamNames("id", "variantId")
+		c.SetParamValues("prod-123", "var-1")
+
+		mockRepo.EXPECT().RemoveVariant(gomock.Any(), "prod-123", "var-1").Return(nil)
+
+		// Act
+		err := handler.DeleteVariant(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("異常系: バリエーションが存在しない場合は404を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		c, _ := newJSONContext(e, http.MethodDelete, "/products/prod-123/variants/var-404", "")
+		c.SetPath("/products/:id/variants/:variantId")
+		c.SetParamNames("id", "variantId")
+		c.SetParamValues("prod-123", "var-404")
+
+		mockRepo.EXPECT().
+			RemoveVariant(gomock.Any(), "prod-123", "var-404").
+			Return(connect.NewError(connect.CodeNotFound, errors.New("variant not found")))
+
+		// Act
+		err := handler.DeleteVariant(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusNotFound)
+	})
+
+	t.Run("異常系: 想定外のエラーは500を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		c, _ := newJSONContext(e, http.MethodDelete, "/products/prod-123/variants/var-1", "")
+		c.SetPath("/products/:id/variants/:variantId")
+		c.SetParamNames("id", "variantId")
+		c.SetParamValues("prod-123", "var-1")
+
+		mockRepo.EXPECT().RemoveVariant(gomock.Any(), "prod-123", "var-1").Return(errors.New("connection refused"))
+
+		// Act
+		err := handler.DeleteVariant(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusInternalServerError)
+	})
+}
+
+func TestCQRSServiceHandler_PublishProduct(t *testing.T) {
+	t.Run("正常系: 商品を公開できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		c, rec := newJSONContext(e, http.MethodPost, "/products/prod-123/publish", "")
+		c.SetPath("/products/:id/publish")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
+
+		category := models.NewCategory("cat-1", "文房具")
+		mockRepo.EXPECT().
+			PublishProduct(gomock.Any(), "prod-123").
+			Return(models.NewProduct("prod-123", "ボールペン", 120, category).WithStatus("PUBLISHED"), nil)
+
+		// Act
+		err := handler.PublishProduct(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.ChangeProductStatusResponse
+		decodeJSONResponse(t, rec, &response)
+		require.NotNil(t, response.Product)
+		assert.Equal(t, "prod-123", response.Product.Id)
+		assert.Equal(t, "PUBLISHED", response.Product.Status)
+	})
+
+	t.Run("異常系: 遷移できない状態の場合は409を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		c, _ := newJSONContext(e, http.MethodPost, "/products/prod-123/publish", "")
+		c.SetPath("/products/:id/publish")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
+
+		mockRepo.EXPECT().
+			PublishProduct(gomock.Any(), "prod-123").
+			Return(nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("販売終了した商品は公開できません")))
+
+		// Act
+		err := handler.PublishProduct(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusConflict)
+	})
+}
+
+func TestCQRSServiceHandler_SuspendProduct(t *testing.T) {
+	t.Run("異常系: 商品が存在しない場合は404を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		c, _ := newJSONContext(e, http.MethodPost, "/products/prod-404/suspend", "")
+		c.SetPath("/products/:id/suspend")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-404")
+
+		mockRepo.EXPECT().
+			SuspendProduct(gomock.Any(), "prod-404").
+			Return(nil, connect.NewError(connect.CodeNotFound, errors.New("product not found")))
+
+		// Act
+		err := handler.SuspendProduct(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusNotFound)
+	})
+}
+
+func TestCQRSServiceHandler_DiscontinueProduct(t *testing.T) {
+	t.Run("異常系: 想定外のエラーは500を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		c, _ := newJSONContext(e, http.MethodPost, "/products/prod-123/discontinue", "")
+		c.SetPath("/products/:id/discontinue")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
+
+		mockRepo.EXPECT().
+			DiscontinueProduct(gomock.Any(), "prod-123").
+			Return(nil, errors.New("connection refused"))
+
+		// Act
+		err := handler.DiscontinueProduct(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusInternalServerError)
+	})
+}
+
+func TestCQRSServiceHandler_SchedulePrice(t *testing.T) {
+	t.Run("正常系: 価格スケジュールを登録できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		requestBody := `{"price":980,"starts_at":"2026-10-23T00:00:00+09:00","ends_at":"2026-10-25T23:59:00+09:00"}`
+		c, rec := newJSONContext(e, http.MethodPost, "/products/prod-123/price-schedules", requestBody)
+		c.SetPath("/products/:id/price-schedules")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
+
+		jst := time.FixedZone("JST", 9*60*60)
+		startsAt := time.Date(2026, 10, 23, 0, 0, 0, 0, jst)
+		endsAt := time.Date(2026, 10, 25, 23, 59, 0, 0, jst)
+		mockRepo.EXPECT().
+			SchedulePrice(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, schedule *models.PriceSchedule) (*models.PriceSchedule, error) {
+				assert.Empty(t, schedule.Id())
+				assert.Equal(t, "prod-123", schedule.ProductId())
+				assert.Equal(t, uint32(980), schedule.Price())
+				assert.True(t, startsAt.Equal(schedule.StartsAt()))
+				assert.True(t, endsAt.Equal(schedule.EndsAt()))
+				return models.NewPriceSchedule("ps-1", "prod-123", 980, nil, "SCHEDULED", startsAt, endsAt), nil
+			})
+
+		// Act
+		err := handler.SchedulePrice(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var response dto.SchedulePriceResponse
+		decodeJSONResponse(t, rec, &response)
+		require.NotNil(t, response.PriceSchedule)
+		assert.Equal(t, "ps-1", response.PriceSchedule.Id)
+		assert.Equal(t, uint32(980), response.PriceSchedule.Price)
+		assert.Nil(t, response.PriceSchedule.RegularPrice)
+		assert.Equal(t, "SCHEDULED", response.PriceSchedule.Status)
+		assert.True(t, startsAt.Equal(response.PriceSchedule.StartsAt))
+	})
+
+	t.Run("異常系: 終了時刻が開始時刻以前の場合は400を返す", func(t *testing.T) {
+		// Arrange
+		handler, _, e := newHandlerTestEnv(t)
+
+		requestBody := `{"price":980,"starts_at":"2026-10-25T00:00:00+09:00","ends_at":"2026-10-23T00:00:00+09:00"}`
+		c, _ := newJSONContext(e, http.MethodPost, "/products/prod-123/price-schedules", requestBody)
+		c.SetPath("/products/:id/price-schedules")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
+
+		// Act
+		err := handler.SchedulePrice(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("異常系: 期間が重なる場合は409を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		requestBody := `{"price":980,"starts_at":"2026-10-23T00:00:00+09:00","ends_at":"2026-10-25T23:59:00+09:00"}`
+		c, _ := newJSONContext(e, http.MethodPost, "/products/prod-123/price-schedules", requestBody)
+		c.SetPath("/products/:id/price-schedules")
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
+
+		mockRepo.EXPECT().
+			SchedulePrice(gomock.Any(), gomock.Any()).
+			Return(nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("期間が重なる価格スケジュールが存在します")))
+
+		// Act
+		err := handler.SchedulePrice(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusConflict)
+	})
+}
+
+func TestCQRSServiceHandler_AdjustPrices(t *testing.T) {
+	t.Run("正常系: 変更前後の単価をプレビューできる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		requestBody := `{"include_descendants":true,"tags":["セール"],"adjustment":{"type":"PERCENT","value":-10,"rounding":"HALF_UP","rounding_unit":10,"min_price":100},"preview":true}`
+		c, rec := newJSONContext(e, http.MethodPost, "/categories/cat-123/price-adjustments", requestBody)
+		c.SetPath("/categories/:id/price-adjustments")
+		c.SetParamNames("id")
+		c.SetParamValues("cat-123")
+
+		mockRepo.EXPECT().
+			AdjustPrices(gomock.Any(), &repository.PriceAdjustmentTarget{CategoryId: "cat-123", IncludeDescendants: true, Tags: []string{"セール"}}, gomock.Any(), true).
+			DoAndReturn(func(ctx context.Context, target *repository.PriceAdjustmentTarget, adjustment *models.PriceAdjustment, preview bool) ([]*models.PriceChange, error) {
+				assert.Equal(t, "PERCENT", adjustment.AdjustmentType())
+				assert.Equal(t, int32(-10), adjustment.Value())
+				assert.Equal(t, "HALF_UP", adjustment.Rounding())
+				assert.Equal(t, uint32(10), adjustment.RoundingUnit())
+				require.NotNil(t, adjustment.MinPrice())
+				assert.Equal(t, uint32(100), *adjustment.MinPrice())
+				assert.Nil(t, adjustment.MaxPrice())
+				return []*models.PriceChange{models.NewPriceChange("prod-1", "ボールペン", "JPY", 1234, 1110)}, nil
+			})
+
+		// Act
+		err := handler.AdjustPrices(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.AdjustPricesResponse
+		decodeJSONResponse(t, rec, &response)
+		assert.True(t, response.Preview)
+		require.Len(t, response.Changes, 1)
+		assert.Equal(t, "prod-1", response.Changes[0].ProductId)
+		assert.Equal(t, uint32(1234), response.Changes[0].BeforePrice)
+		assert.Equal(t, uint32(1110), response.Changes[0].AfterPrice)
+	})
+
+	t.Run("異常系: 変更の方法が不正な場合は400を返す", func(t *testing.T) {
+		// Arrange
+		handler, _, e := newHandlerTestEnv(t)
+
+		requestBody := `{"adjustment":{"type":"RATIO","value":10}}`
+		c, _ := newJSONContext(e, http.MethodPost, "/categories/cat-123/price-adjustments", requestBody)
+		c.SetPath("/categories/:id/price-adjustments")
+		c.SetParamNames("id")
+		c.SetParamValues("cat-123")
+
+		// Act
+		err := handler.AdjustPrices(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("異常系: 変更後の単価が範囲外の場合は409を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		requestBody := `{"adjustment":{"type":"FIXED","value":-2000}}`
+		c, _ := newJSONContext(e, http.MethodPost, "/categories/cat-123/price-adjustments", requestBody)
+		c.SetPath("/categories/:id/price-adjustments")
+		c.SetParamNames("id")
+		c.SetParamValues("cat-123")
+
+		mockRepo.EXPECT().
+			AdjustPrices(gomock.Any(), gomock.Any(), gomock.Any(), false).
+			Return(nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("変更後の単価が商品価格の範囲外です")))
+
+		// Act
+		err := handler.AdjustPrices(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusConflict)
+	})
+}
+
+func TestCQRSServiceHandler_CancelPriceSchedule(t *testing.T) {
+	t.Run("正常系: 価格スケジュールを取り消せる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		c, rec := newJSONContext(e, http.MethodDelete, "/products/prod-123/price-schedules/ps-1", "")
+		c.SetPath("/products/:id/price-schedules/:scheduleId")
+		c.SetParamNames("id", "scheduleId")
+		c.SetParamValues("prod-123", "ps-1")
+
+		regularPrice := uint32(1200)
+		now := time.Now()
+		mockRepo.EXPECT().
+			CancelPriceSchedule(gomock.Any(), "prod-123", "ps-1").
+			Return(models.NewPriceSchedule("ps-1", "prod-123", 980, &regularPrice, "CANCELLED", now.Add(-time.Hour), now.Add(time.Hour)), nil)
+
+		// Act
+		err := handler.CancelPriceSchedule(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.CancelPriceScheduleResponse
+		decodeJSONResponse(t, rec, &response)
+		require.NotNil(t, response.PriceSchedule)
+		assert.Equal(t, "CANCELLED", response.PriceSchedule.Status)
+		require.NotNil(t, response.PriceSchedule.RegularPrice)
+		assert.Equal(t, uint32(1200), *response.PriceSchedule.RegularPrice)
+	})
+
+	t.Run("異常系: 価格スケジュールが存在しない場合は404を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		c, _ := newJSONContext(e, http.MethodDelete, "/products/prod-123/price-schedules/ps-404", "")
+		c.SetPath("/products/:id/price-schedules/:scheduleId")
+		c.SetParamNames("id", "scheduleId")
+		c.SetParamValues("prod-123", "ps-404")
+
+		mockRepo.EXPECT().
+			CancelPriceSchedule(gomock.Any(), "prod-123", "ps-404").
+			Return(nil, connect.NewError(connect.CodeNotFound, errors.New("price schedule not found")))
+
+		// Act
+		err := handler.CancelPriceSchedule(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusNotFound)
+	})
+}
+
+func TestCQRSServiceHandler_ProductList(t *testing.T) {
+	t.Run("正常系: 商品一覧を取得できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// モックの設定
+		category := models.NewCategory("cat-1", "Category1")
+		expectedProducts := []*models.Product{
+			models.NewProduct("prod-1", "Product1", 1000, category),
+			models.NewProduct("prod-2", "Product2", 2000, category),
+		}
+		mockRepo.EXPECT().
+			ProductList(gomock.Any()).
+			Return(expectedProducts, nil)
+
+		// Act
+		err := handler.ProductList(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.ProductListResponse
+		decodeJSONResponse(t, rec, &response)
+		assert.Len(t, response.Products, 2)
+		assert.Equal(t, "prod-1", response.Products[0].Id)
+		assert.Equal(t, uint32(1000), response.Products[0].Price)
+	})
+
+	t.Run("正常系: keywordパラメータで検索できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products?keyword=test", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// モックの設定（検索結果）
+		category := models.NewCategory("cat-1", "Category1")
+		expectedProducts := []*models.Product{
+			models.NewProduct("prod-1", "TestProduct1", 1000, category),
+			models.NewProduct("prod-2", "TestProduct2", 2000, category),
+		}
+		mockRepo.EXPECT().
+			ProductByKeyword(gomock.Any(), "test").
+			Return(expectedProducts, nil)
+
+		// Act
+		err := handler.ProductList(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.ProductByKeywordResponse
+		decodeJSONResponse(t, rec, &response)
+		assert.Len(t, response.Products, 2)
+		assert.Equal(t, "prod-1", response.Products[0].Id)
+	})
+
+	t.Run("正常系: tagsパラメータで絞り込める", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products?tags=ワイヤレス&tags=ゲーミング", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// モックの設定
+		category := models.NewCategory("cat-1", "Category1")
+		tags := []*models.Tag{models.NewTag("tag-2", "ゲーミング"), models.NewTag("tag-1", "ワイヤレス")}
+		mockRepo.EXPECT().
+			ProductListByTags(gomock.Any(), []string{"ワイヤレス", "ゲーミング"}).
+			Return([]*models.Product{models.NewProduct("prod-1", "Product1", 1000, category).WithTags(tags)}, nil)
+
+		// Act
+		err := handler.ProductList(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.ProductListResponse
+		decodeJSONResponse(t, rec, &response)
+		require.Len(t, response.Products, 1)
+		require.Len(t, response.Products[0].Tags, 2)
+		assert.Equal(t, "ゲーミング", response.Products[0].Tags[0].Name)
+	})
+
+	t.Run("異常系: keywordとtagsは併用できない", func(t *testing.T) {
+		// Arrange
+		handler, _, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products?keyword=test&tags=ワイヤレス", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.ProductList(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("異常系: タグ名が不正な場合は400を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products?tags=", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockRepo.EXPECT().
+			ProductListByTags(gomock.Any(), []string{""}).
+			Return(nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid tags")))
+
+		// Act
+		err := handler.ProductList(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestCQRSServiceHandler_TagList(t *testing.T) {
+	t.Run("正常系: タグ一覧を取得できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/tags", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockRepo.EXPECT().
+			TagList(gomock.Any()).
+			Return([]*models.TagUsage{
+				models.NewTagUsage(models.NewTag("tag-1", "ワイヤレス"), 3),
+				models.NewTagUsage(models.NewTag("tag-2", "ゲーミング"), 0),
+			}, nil)
+
+		// Act
+		err := handler.TagList(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.TagListResponse
+		decodeJSONResponse(t, rec, &response)
+		require.Len(t, response.Tags, 2)
+		assert.Equal(t, "ワイヤレス", response.Tags[0].Tag.Name)
+		assert.Equal(t, 3, response.Tags[0].ProductCount)
+		assert.Equal(t, 0, response.Tags[1].ProductCount)
+	})
+
+	t.Run("異常系: リポジトリエラーの場合は500を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/tags", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockRepo.EXPECT().
+			TagList(gomock.Any()).
+			Return(nil, errors.New("connection error"))
+
+		// Act
+		err := handler.TagList(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusInternalServerError)
+	})
+}
+
+func TestCQRSServiceHandler_AttachTags(t *testing.T) {
+	const productId = "82014174-b7b8-4a18-9a6a-4f0b4e0b8f2c"
+
+	t.Run("正常系: タグを一括で付与できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		requestBody := `{"product_ids":["` + productId + `"],"tag_names":["ワイヤレス","セール"]}`
+		c, rec := newJSONContext(e, http.MethodPost, "/tags/attach", requestBody)
+
+		mockRepo.EXPECT().
+			AttachTags(gomock.Any(), []string{productId}, []string{"ワイヤレス", "セール"}).
+			Return(models.NewTagChange([]*models.Tag{
+				models.NewTag("tag-1", "ワイヤレス"),
+				models.NewTag("tag-3", "セール"),
+			}, 1), nil)
+
+		// Act
+		err := handler.AttachTags(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.AttachTagsResponse
+		decodeJSONResponse(t, rec, &response)
+		require.Len(t, response.Tags, 2)
+		assert.Equal(t, "tag-3", response.Tags[1].Id)
+		assert.Equal(t, 1, response.AttachedCount)
+	})
+
+	t.Run("異常系: バリデーションエラー（商品IDがUUIDではない）", func(t *testing.T) {
+		// Arrange
+		handler, _, e := newHandlerTestEnv(t)
+		c, _ := newJSONContext(e, http.MethodPost, "/tags/attach", `{"product_ids":["prod-1"],"tag_names":["ワイヤレス"]}`)
+
+		// Act
+		err := handler.AttachTags(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("異常系: バリデーションエラー（タグ名が空）", func(t *testing.T) {
+		// Arrange
+		handler, _, e := newHandlerTestEnv(t)
+		c, _ := newJSONContext(e, http.MethodPost, "/tags/attach", `{"product_ids":["`+productId+`"],"tag_names":[]}`)
+
+		// Act
+		err := handler.AttachTags(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("異常系: 商品が存在しない場合は404を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		c, _ := newJSONContext(e, http.MethodPost, "/tags/attach", `{"product_ids":["`+productId+`"],"tag_names":["ワイヤレス"]}`)
+
+		mockRepo.EXPECT().
+			AttachTags(gomock.Any(), []string{productId}, []string{"ワイヤレス"}).
+			Return(nil, connect.NewError(connect.CodeNotFound, errors.New("product not found")))
+
+		// Act
+		err := handler.AttachTags(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusNotFound)
+	})
+}
+
+func TestCQRSServiceHandler_DetachTags(t *testing.T) {
+	const productId = "82014174-b7b8-4a18-9a6a-4f0b4e0b8f2c"
+
+	t.Run("正常系: タグを一括で解除できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		c, rec := newJSONContext(e, http.MethodPost, "/tags/detach", `{"product_ids":["`+productId+`"],"tag_names":["ワイヤレス"]}`)
+
+		mockRepo.EXPECT().
+			DetachTags(gomock.Any(), []string{productId}, []string{"ワイヤレス"}).
+			Return(models.NewTagChange([]*models.Tag{models.NewTag("tag-1", "ワイヤレス")}, 1), nil)
+
+		// Act
+		err := handler.DetachTags(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.DetachTagsResponse
+		decodeJSONResponse(t, rec, &response)
+		require.Len(t, response.Tags, 1)
+		assert.Equal(t, 1, response.DetachedCount)
+	})
+
+	t.Run("異常系: 商品IDが101件以上の場合は400を返す", func(t *testing.T) {
+		// Arrange
+		handler, _, e := newHandlerTestEnv(t)
+		ids := make([]string, 101)
+		for i := range ids {
+			ids[i] = `"` + productId + `"`
+		}
+		c, _ := newJSONContext(e, http.MethodPost, "/tags/detach", `{"product_ids":[`+strings.Join(ids, ",")+`],"tag_names":["ワイヤレス"]}`)
+
+		// Act
+		err := handler.DetachTags(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestCQRSServiceHandler_ProductStream(t *testing.T) {
+	t.Run("正常系: 商品一覧をStream取得できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/stream/products", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// モックの設定
+		category := models.NewCategory("cat-1", "Category1")
+		expectedProducts := []*models.Product{
+			models.NewProduct("prod-1", "Product1", 1000, category),
+			models.NewProduct("prod-2", "Product2", 2000, category),
+		}
+		resultCh := make(chan *repository.StreamProductsResult, len(expectedProducts))
+		mockRepo.EXPECT().
+			StreamProducts(gomock.Any()).
+			Return(resultCh, nil)
+		// 結果をチャネルに送信
+		go func() {
+			for _, p := range expectedProducts {
+				resultCh <- &repository.StreamProductsResult{Product: p, Err: nil}
+			}
+			close(resultCh)
+		}()
+
+		// Act
+		err := handler.ProductStream(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.ProductListResponse
+		decodeJSONResponse(t, rec, &response)
+		assert.Len(t, response.Products, 2)
+		assert.Equal(t, "prod-1", response.Products[0].Id)
+		assert.Equal(t, uint32(1000), response.Products[0].Price)
+		assert.Equal(t, "prod-2", response.Products[1].Id)
+		assert.Equal(t, uint32(2000), response.Products[1].Price)
+	})
+
+	t.Run("異常系: ストリーム受信中にエラー", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/stream/products", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		resultCh := make(chan *repository.StreamProductsResult, 1)
+		resultCh <- &repository.StreamProductsResult{Err: errors.New("stream failure")}
+		close(resultCh)
+
+		mockRepo.EXPECT().
+			StreamProducts(gomock.Any()).
+			Return(resultCh, nil)
+
+		// Act
+		err := handler.ProductStream(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusInternalServerError)
+	})
+
+	t.Run("異常系: リポジトリエラー", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/stream/products", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockRepo.EXPECT().
+			StreamProducts(gomock.Any()).
+			Return(nil, errors.New("database error"))
+
+		// Act
+		err := handler.ProductStream(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusInternalServerError)
+	})
+
+	t.Run("異常系: コンテキストキャンセル", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/stream/products", nil)
+		ctx, cancel := context.WithCancel(req.Context())
+		req = req.WithContext(ctx)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		resultCh := make(chan *repository.StreamProductsResult)
+		mockRepo.EXPECT().
+			StreamProducts(gomock.Any()).
+			Return(resultCh, nil)
+
+		errCh := make(chan error, 1)
+		// Act
+		go func() {
+			errCh <- handler.ProductStream(c)
+		}() // cancel直後に呼び出されるようにするため
+		cancel()
+		err := <-errCh
+
+		// Assert
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestCQRSServiceHandler_SuggestProducts(t *testing.T) {
+	category := models.NewCategory("cat-1", "文房具")
+
+	// newSuggestServer はWebSocketエンドポイントを持つテストサーバーに接続します。
+	// 接続と同時にハンドラが実行されるため、モックの設定は接続前にsetupで行います。
+	newSuggestServer := func(t *testing.T, setup func(*mock_repository.MockCQRSRepository)) *websocket.Conn {
+		t.Helper()
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		setup(mockRepo)
+		e.GET("/ws/products/suggest", handler.SuggestProducts)
+		srv := httptest.NewServer(e)
+		t.Cleanup(srv.Close)
+
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/ws/products/suggest", nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		return conn
+	}
+
+	// echoSuggestions は受信した問合せごとにサジェストを1件返すモック実装です。
+	echoSuggestions := func(_ context.Context, queries <-chan *repository.SuggestProductsQuery) (<-chan *repository.SuggestProductsResult, error) {
+		ch := make(chan *repository.SuggestProductsResult)
+		go func() {
+			defer close(ch)
+			for q := range queries {
+				ch <- &repository.SuggestProductsResult{
+					Prefix: q.Prefix,
+					Suggestions: []*models.ProductSuggestion{
+						models.NewProductSuggestion("prod-1", "ボールペン", "<mark>"+q.Prefix+"</mark>ルペン", category, 1.5),
+					},
+				}
+			}
+		}()
+		return ch, nil
+	}
+
+	t.Run("正常系: 送信した検索語ごとにサジェストを受信できる", func(t *testing.T) {
+		conn := newSuggestServer(t, func(mockRepo *mock_repository.MockCQRSRepository) {
+			mockRepo.EXPECT().SuggestProducts(gomock.Any(), gomock.Any()).DoAndReturn(echoSuggestions)
+		})
+
+		for _, prefix := range []string{"ボ", "ボー"} {
+			require.NoError(t, conn.WriteJSON(dto.SuggestProductsRequest{Prefix: prefix, Limit: 5}))
+			var resp dto.SuggestProductsResponse
+			require.NoError(t, conn.ReadJSON(&resp))
+			assert.Equal(t, prefix, resp.Prefix)
+			require.Len(t, resp.Suggestions, 1)
+			assert.Equal(t, "prod-1", resp.Suggestions[0].ProductId)
+			assert.Equal(t, "<mark>"+prefix+"</mark>ルペン", resp.Suggestions[0].Highlight)
+			assert.Equal(t, "文房具", resp.Suggestions[0].Category.Name)
+			assert.Empty(t, resp.Error)
+		}
+	})
+
+	t.Run("異常系: 不正な問合せはエラーメッセージを返し接続を維持する", func(t *testing.T) {
+		conn := newSuggestServer(t, func(mockRepo *mock_repository.MockCQRSRepository) {
+			mockRepo.EXPECT().SuggestProducts(gomock.Any(), gomock.Any()).DoAndReturn(echoSuggestions)
+		})
+
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("invalid json")))
+		var resp dto.SuggestProductsResponse
+		require.NoError(t, conn.ReadJSON(&resp))
+		assert.NotEmpty(t, resp.Error)
+
+		require.NoError(t, conn.WriteJSON(dto.SuggestProductsRequest{Prefix: "ボ", Limit: 21}))
+		resp = dto.SuggestProductsResponse{}
+		require.NoError(t, conn.ReadJSON(&resp))
+		assert.NotEmpty(t, resp.Error)
+
+		require.NoError(t, conn.WriteJSON(dto.SuggestProductsRequest{Prefix: "ボ"}))
+		resp = dto.SuggestProductsResponse{}
+		require.NoError(t, conn.ReadJSON(&resp))
+		assert.Empty(t, resp.Error)
+		assert.Equal(t, "ボ", resp.Prefix)
+	})
+
+	t.Run("異常系: ストリームエラーで接続を閉じる", func(t *testing.T) {
+		conn := newSuggestServer(t, func(mockRepo *mock_repository.MockCQRSRepository) {
+			mockRepo.EXPECT().SuggestProducts(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, queries <-chan *repository.SuggestProductsQuery) (<-chan *repository.SuggestProductsResult, error) {
+					ch := make(chan *repository.SuggestProductsResult, 1)
+					go func() {
+						defer close(ch)
+						<-queries
+						ch <- &repository.SuggestProductsResult{Err: errors.New("stream error")}
+					}()
+					return ch, nil
+				})
+		})
+
+		require.NoError(t, conn.WriteJSON(dto.SuggestProductsRequest{Prefix: "ボ"}))
+		_, _, err := conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseInternalServerErr), "unexpected error: %v", err)
+	})
+
+	t.Run("異常系: ストリームを開始できない場合は接続を閉じる", func(t *testing.T) {
+		conn := newSuggestServer(t, func(mockRepo *mock_repository.MockCQRSRepository) {
+			mockRepo.EXPECT().SuggestProducts(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection error"))
+		})
+
+		_, _, err := conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseInternalServerErr), "unexpected error: %v", err)
+	})
+}
+
+func TestCQRSServiceHandler_ProductById(t *testing.T) {
+	t.Run("正常系: 商品を取得できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products/prod-123", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
+
+		category := models.NewCategory("cat-1", "Category1")
+		expectedProduct := models.NewProduct("prod-123", "TestProduct", 1000, category)
+		mockRepo.EXPECT().
+			ProductById(gomock.Any(), "prod-123").
+			Return(expectedProduct, nil)
+
+		// Act
+		err := handler.ProductById(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.ProductByIdResponse
+		decodeJSONResponse(t, rec, &response)
+		assert.Equal(t, "prod-123", response.Product.Id)
+		assert.Equal(t, "TestProduct", response.Product.Name)
+	})
+
+	t.Run("異常系: 公開中以外の商品は見つからない扱いで404を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products/prod-draft", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("prod-draft")
+
+		mockRepo.EXPECT().
+			ProductById(gomock.Any(), "prod-draft").
+			Return(nil, connect.NewError(connect.CodeNotFound, errors.New("product not found")))
+
+		// Act
+		err := handler.ProductById(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusNotFound)
+	})
+}
+
+func TestCQRSServiceHandler_ProductByBarcode(t *testing.T) {
+	t.Run("正常系: バーコードで商品を取得できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products/by-barcode/4901234567894", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("code")
+		c.SetParamValues("4901234567894")
+
+		barcode := "4901234567894"
+		category := models.NewCategory("cat-1", "Category1")
+		expectedProduct := models.NewProduct("prod-123", "TestProduct", 1000, category).WithBarcode(&barcode)
+		mockRepo.EXPECT().
+			ProductByBarcode(gomock.Any(), "4901234567894").
+			Return(expectedProduct, nil)
+
+		// Act
+		err := handler.ProductByBarcode(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.ProductByBarcodeResponse
+		decodeJSONResponse(t, rec, &response)
+		assert.Equal(t, "prod-123", response.Product.Id)
+		assert.Equal(t, "4901234567894", response.Product.Barcode)
+	})
+
+	t.Run("異常系: 商品が見つからない場合は404を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products/by-barcode/036000291452", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("code")
+		c.SetParamValues("036000291452")
+
+		mockRepo.EXPECT().
+			ProductByBarcode(gomock.Any(), "036000291452").
+			Return(nil, connect.NewError(connect.CodeNotFound, errors.New("product not found")))
+
+		// Act
+		err := handler.ProductByBarcode(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusNotFound)
+	})
+
+	t.Run("異常系: 数字以外を含むバーコードは400を返す", func(t *testing.T) {
+		// Arrange
+		handler, _, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products/by-barcode/49012345678X4", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("code")
+		c.SetParamValues("49012345678X4")
+
+		// Act
+		err := handler.ProductByBarcode(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestCQRSServiceHandler_ProductBySlug(t *testing.T) {
+	t.Run("正常系: 変更前のスラッグの場合は現在のスラッグのパスを返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products/by-slug/waiyaresumausu", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("waiyaresumausu")
+
+		category := models.NewCategory("cat-1", "Category1")
+		expectedProduct := models.NewProduct("prod-123", "TestProduct", 1000, category).WithSlug("wireless-mouse")
+		mockRepo.EXPECT().
+			ProductBySlug(gomock.Any(), "waiyaresumausu").
+			Return(expectedProduct, true, nil)
+
+		// Act
+		err := handler.ProductBySlug(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.ProductBySlugResponse
+		decodeJSONResponse(t, rec, &response)
+		assert.Equal(t, "prod-123", response.Product.Id)
+		assert.Equal(t, "wireless-mouse", response.Product.Slug)
+		assert.True(t, response.Moved)
+		assert.Equal(t, "/products/by-slug/wireless-mouse", response.RedirectTo)
+	})
+
+	t.Run("異常系: 商品が見つからない場合は404を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+		req := httptest.NewRequest(http.MethodGet, "/products/by-slug/fountain-pen", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("fountain-pen")
+
+		mockRepo.EXPECT().
+			ProductBySlug(gomock.Any(), "fountain-pen").
+			Return(nil, false, connect.NewError(connect.CodeNotFound, errors.New("product not found")))
+
+		// Act
+		err := handler.ProductBySlug(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusNotFound)
+	})
+}
+
+func TestCQRSServiceHandler_UpdateProduct(t *testing.T) {
+	t.Run("正常系: 商品を更新できる", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		requestBody := `{"name":"UpdatedProduct","price":2000,"category":{"id":"550e8400-e29b-41d4-a716-446655440000","name":"TestCategory"}}`
+		c, rec := newJSONContext(e, http.MethodPut, "/products/prod-123", requestBody)
 		c.SetParamNames("id")
 		c.SetParamValues("prod-123")
 
@@ -661,6 +1865,59 @@ func TestCQRSServiceHandler_UpdateProduct(t *testing.T) {
 		assert.Equal(t, "UpdatedProduct", response.Product.Name)
 		assert.Equal(t, uint32(2000), response.Product.Price)
 	})
+
+	t.Run("正常系: 空文字列のバーコードを削除として渡し、省略した場合は渡さない", func(t *testing.T) {
+		for _, tc := range []struct {
+			body     string
+			expected *string
+		}{
+			{body: `,"barcode":""`, expected: new(string)},
+			{body: ``, expected: nil},
+		} {
+			// Arrange
+			handler, mockRepo, e := newHandlerTestEnv(t)
+
+			requestBody := `{"name":"UpdatedProduct","price":2000,"category":{"id":"550e8400-e29b-41d4-a716-446655440000","name":"TestCategory"}` + tc.body + `}`
+			c, rec := newJSONContext(e, http.MethodPut, "/products/prod-123", requestBody)
+			c.SetParamNames("id")
+			c.SetParamValues("prod-123")
+
+			category := models.NewCategory("550e8400-e29b-41d4-a716-446655440000", "TestCategory")
+			mockRepo.EXPECT().
+				UpdateProduct(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, product *models.Product) (*models.Product, error) {
+					assert.Equal(t, tc.expected, product.Barcode())
+					return models.NewProduct("prod-123", "UpdatedProduct", 2000, category), nil
+				})
+
+			// Act
+			err := handler.UpdateProduct(c)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("異常系: バーコードが重複する場合は409を返す", func(t *testing.T) {
+		// Arrange
+		handler, mockRepo, e := newHandlerTestEnv(t)
+
+		requestBody := `{"name":"UpdatedProduct","price":2000,"barcode":"4901234567894","category":{"id":"550e8400-e29b-41d4-a716-446655440000","name":"TestCategory"}}`
+		c, _ := newJSONContext(e, http.MethodPut, "/products/prod-123", requestBody)
+		c.SetParamNames("id")
+		c.SetParamValues("prod-123")
+
+		mockRepo.EXPECT().
+			UpdateProduct(gomock.Any(), gomock.Any()).
+			Return(nil, connect.NewError(connect.CodeAlreadyExists, errors.New("同じバーコードが既に登録されています。")))
+
+		// Act
+		err := handler.UpdateProduct(c)
+
+		// Assert
+		assertHTTPError(t, err, http.StatusConflict)
+	})
 }
 
 func TestCQRSServiceHandler_DeleteProduct(t *testing.T) {
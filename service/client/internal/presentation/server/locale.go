@@ -0,0 +1,30 @@
+package server
+
+import (
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+	"github.com/labstack/echo/v4"
+)
+
+// localeQueryParam は名前のロケールを指定するクエリパラメータ名
+const localeQueryParam = "locale"
+
+// LocaleMiddleware はリクエストで指定されたロケールをQuery Serviceへ転送するためにコンテキストへ設定するミドルウェアを返します。
+// localeクエリパラメータが指定された場合はAccept-Languageヘッダーより優先します。
+//
+// Returns:
+//   - echo.MiddlewareFunc: ミドルウェア
+func LocaleMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			value := c.QueryParam(localeQueryParam)
+			if value == "" {
+				value = c.Request().Header.Get(headerAcceptLanguage)
+			}
+			if value != "" {
+				req := c.Request()
+				c.SetRequest(req.WithContext(interceptor.WithAcceptLanguage(req.Context(), value)))
+			}
+			return next(c)
+		}
+	}
+}
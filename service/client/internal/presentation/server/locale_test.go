@@ -0,0 +1,52 @@
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/presentation/server"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocaleMiddleware(t *testing.T) {
+	newLocaleTestEnv := func() *echo.Echo {
+		e := echo.New()
+		e.Use(server.LocaleMiddleware())
+		e.GET("/products", func(c echo.Context) error {
+			return c.String(http.StatusOK, interceptor.AcceptLanguageFromContext(c.Request().Context()))
+		})
+		return e
+	}
+
+	tests := []struct {
+		name           string
+		target         string
+		acceptLanguage string
+		want           string
+	}{
+		{name: "Accept-Languageを転送する", target: "/products", acceptLanguage: "en-US,en;q=0.9", want: "en-US,en;q=0.9"},
+		{name: "localeクエリパラメータを優先する", target: "/products?locale=en", acceptLanguage: "ja", want: "en"},
+		{name: "指定がない場合は転送しない", target: "/products", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			e := newLocaleTestEnv()
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			rec := httptest.NewRecorder()
+
+			// Act
+			e.ServeHTTP(rec, req)
+
+			// Assert
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.want, rec.Body.String())
+		})
+	}
+}
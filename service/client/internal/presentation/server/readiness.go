@@ -0,0 +1,51 @@
+package server
+
+import (
+	"net/http"
+	"sync/atomic"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+	"github.com/labstack/echo/v4"
+)
+
+// BackendStatus はバックエンドごとのサーキットブレーカーの状態を提供します。
+type BackendStatus interface {
+	// CircuitStates はバックエンドの名前ごとのサーキットブレーカーの状態を返します。
+	CircuitStates() map[string]interceptor.CircuitState
+}
+
+// ReadinessResponse は/readyzのレスポンスです。
+type ReadinessResponse struct {
+	Status   string            `json:"status"`   // ready / not_ready / draining
+	Backends map[string]string `json:"backends"` // バックエンドごとのサーキットブレーカーの状態
+}
+
+// ReadinessHandler はリクエストを受け付けられるかを返すハンドラを生成します。
+// いずれかのバックエンドのサーキットブレーカーが開の場合は503を返し、ロードバランサーの振り分け対象から外させます。
+// 半開は復旧を確認するためにリクエストが必要なため、受け付け可能として扱います。
+// 停止中はバックエンドの状態に関わらず503を返し、ドレインの間に新しいリクエストが振り分けられないようにします。
+//
+// Parameters:
+//   - status: バックエンドの状態
+//   - draining: 停止中かどうか
+//
+// Returns:
+//   - echo.HandlerFunc: ハンドラ
+func ReadinessHandler(status BackendStatus, draining *atomic.Bool) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		resp := ReadinessResponse{Status: "ready", Backends: map[string]string{}}
+		code := http.StatusOK
+		for name, state := range status.CircuitStates() {
+			resp.Backends[name] = state.String()
+			if state == interceptor.CircuitOpen {
+				resp.Status = "not_ready"
+				code = http.StatusServiceUnavailable
+			}
+		}
+		if draining.Load() {
+			resp.Status = "draining"
+			code = http.StatusServiceUnavailable
+		}
+		return c.JSON(code, resp)
+	}
+}
@@ -0,0 +1,79 @@
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/client/internal/presentation/server"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBackendStatus は固定のサーキットブレーカーの状態を返します。
+type fakeBackendStatus map[string]interceptor.CircuitState
+
+func (f fakeBackendStatus) CircuitStates() map[string]interceptor.CircuitState {
+	return f
+}
+
+func TestReadinessHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		states     fakeBackendStatus
+		draining   bool
+		wantCode   int
+		wantStatus string
+	}{
+		{
+			name:       "正常系: すべて閉の場合は200",
+			states:     fakeBackendStatus{"command": interceptor.CircuitClosed, "query": interceptor.CircuitClosed},
+			wantCode:   http.StatusOK,
+			wantStatus: "ready",
+		},
+		{
+			name:       "正常系: 半開は復旧確認のため受け付ける",
+			states:     fakeBackendStatus{"command": interceptor.CircuitClosed, "query": interceptor.CircuitHalfOpen},
+			wantCode:   http.StatusOK,
+			wantStatus: "ready",
+		},
+		{
+			name:       "異常系: いずれかが開の場合は503",
+			states:     fakeBackendStatus{"command": interceptor.CircuitClosed, "query": interceptor.CircuitOpen},
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: "not_ready",
+		},
+		{
+			name:       "異常系: 停止中はすべて閉でも503",
+			states:     fakeBackendStatus{"command": interceptor.CircuitClosed, "query": interceptor.CircuitClosed},
+			draining:   true,
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: "draining",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var draining atomic.Bool
+			draining.Store(tt.draining)
+			e := echo.New()
+			e.GET("/readyz", server.ReadinessHandler(tt.states, &draining))
+			req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+			rec := httptest.NewRecorder()
+
+			// Act
+			e.ServeHTTP(rec, req)
+
+			// Assert
+			assert.Equal(t, tt.wantCode, rec.Code)
+			var resp server.ReadinessResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantStatus, resp.Status)
+			assert.Equal(t, tt.states["query"].String(), resp.Backends["query"])
+		})
+	}
+}
@@ -7,64 +7,65 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"sync/atomic"
 
-	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/httpserver"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
 	_ "github.com/haru-256/practical-go-grpc-micro-service/service/client/docs"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
-	"github.com/spf13/viper"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"go.uber.org/fx"
 )
 
 const (
 	healthPath  = "/health"
+	readyPath   = "/readyz"
+	metricsPath = "/metrics"
 	swaggerPath = "/swagger"
+	wsPath      = "/ws"
+
+	streamProductsPath  = "/stream/products"
+	suggestProductsPath = wsPath + "/products/suggest"
 )
 
 // CQRSServiceServer はCQRSクライアントサービスのHTTPサーバー
 type CQRSServiceServer struct {
-	logger *slog.Logger // ロガー
-	e      *echo.Echo   // Echoインスタンス
-	Addr   string       // サーバーの実際のアドレス（テスト用）
-}
-
-// CQRSServiceConfig はCQRSサービスの設定
-type CQRSServiceConfig struct {
-	Port string // サーバーポート
-}
-
-// NewCQRSServiceConfig は設定ファイルからCQRSServiceConfigを生成します。
-//
-// Parameters:
-//   - v: Viperインスタンス
-//
-// Returns:
-//   - *CQRSServiceConfig: 設定のインスタンス
-//   - error: 設定の読み込みエラー
-func NewCQRSServiceConfig(v *viper.Viper) (*CQRSServiceConfig, error) {
-	var configErrors []error
-	cfg := &CQRSServiceConfig{
-		Port: utils.GetKey[string](v, "server.port", &configErrors),
-	}
-	if len(configErrors) > 0 {
-		return nil, errors.Join(configErrors...)
-	}
-
-	return cfg, nil
+	logger   *slog.Logger // ロガー
+	e        *echo.Echo   // Echoインスタンス
+	srv      *http.Server // タイムアウトを設定したHTTPサーバー
+	draining atomic.Bool  // 停止中（/readyzで503を返す）
+	Addr     string       // サーバーの実際のアドレス（テスト用）
 }
 
 // NewCQRSServiceServer はCQRSServiceServerを生成します。
 //
 // Parameters:
-//   - cfg: サーバー設定
+//   - cfg: サーバー設定（[server]のタイムアウトと停止時の待ち時間）
 //   - logger: ロガー
 //   - handler: HTTPハンドラ
+//   - cache: HTTPキャッシュミドルウェア
+//   - status: バックエンドの状態（/readyzで使用）
+//   - registry: /metricsで公開するPrometheusのレジストリ
+//   - admin: ログレベルを変更する管理用エンドポイントのハンドラ
 //
 // Returns:
 //   - *CQRSServiceServer: CQRSServiceServerのインスタンス
-func NewCQRSServiceServer(cfg *CQRSServiceConfig, logger *slog.Logger, handler *CQRSServiceHandler) *CQRSServiceServer {
+func NewCQRSServiceServer(
+	cfg *httpserver.Config,
+	logger *slog.Logger,
+	handler *CQRSServiceHandler,
+	cache *HTTPCache,
+	status BackendStatus,
+	registry *prometheus.Registry,
+	admin *log.AdminHandler,
+) *CQRSServiceServer {
+	server := &CQRSServiceServer{logger: logger}
 	e := echo.New()
+	// リクエストのログはhttpグループとしてレベルを個別に変更できるようにする
+	httpLogger := log.Named(logger, "http")
 	// Echoのデフォルトロガーを無効化 (二重出力を防ぐため)
 	// e.HideBanner = true
 	// e.HidePort = true
@@ -78,13 +79,13 @@ func NewCQRSServiceServer(cfg *CQRSServiceConfig, logger *slog.Logger, handler *
 		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
 			// slogを使ってログ出力
 			if v.Error == nil {
-				logger.LogAttrs(c.Request().Context(), slog.LevelInfo, "REQUEST",
+				httpLogger.LogAttrs(c.Request().Context(), slog.LevelInfo, "REQUEST",
 					slog.String("uri", v.URI),
 					slog.Int("status", v.Status),
 					slog.String("method", v.Method),
 				)
 			} else {
-				logger.LogAttrs(c.Request().Context(), slog.LevelError, "REQUEST_ERROR",
+				httpLogger.LogAttrs(c.Request().Context(), slog.LevelError, "REQUEST_ERROR",
 					slog.String("uri", v.URI),
 					slog.Int("status", v.Status),
 					slog.String("method", v.Method),
@@ -96,11 +97,15 @@ func NewCQRSServiceServer(cfg *CQRSServiceConfig, logger *slog.Logger, handler *
 	}))
 	e.Use(middleware.BodyDumpWithConfig(middleware.BodyDumpConfig{
 		Skipper: func(c echo.Context) bool {
-			// ヘルスチェックとSwaggerエンドポイントはスキップ
-			return c.Path() == healthPath || strings.HasPrefix(c.Path(), swaggerPath)
+			// ヘルスチェック、メトリクス、管理用、Swagger、WebSocketエンドポイントはスキップ
+			switch c.Path() {
+			case healthPath, readyPath, metricsPath, log.AdminPath:
+				return true
+			}
+			return strings.HasPrefix(c.Path(), swaggerPath) || strings.HasPrefix(c.Path(), wsPath)
 		},
 		Handler: func(c echo.Context, reqBody, resBody []byte) {
-			logger.InfoContext(c.Request().Context(), "Response Dump",
+			httpLogger.InfoContext(c.Request().Context(), "Response Dump",
 				slog.String("method", c.Request().Method),
 				slog.String("uri", c.Request().RequestURI),
 				slog.Int("status", c.Response().Status),
@@ -108,6 +113,10 @@ func NewCQRSServiceServer(cfg *CQRSServiceConfig, logger *slog.Logger, handler *
 			)
 		},
 	}))
+	// ETag/Cache-Control/Last-Modifiedの付与と条件付きGETの評価
+	e.Use(cache.Middleware())
+	// ロケールをQuery Serviceへ転送する
+	e.Use(LocaleMiddleware())
 
 	// validatorの設定
 	e.Validator = NewRequestValidator()
@@ -120,52 +129,75 @@ func NewCQRSServiceServer(cfg *CQRSServiceConfig, logger *slog.Logger, handler *
 			"status": "healthy",
 		})
 	})
+	// バックエンドのサーキットブレーカーが開の場合と停止中は503を返すレディネスチェック
+	e.GET(readyPath, ReadinessHandler(status, &server.draining))
+	e.GET(metricsPath, echo.WrapHandler(promhttp.HandlerFor(registry, promhttp.HandlerOpts{})))
 	e.GET(swaggerPath+"/*", echoSwagger.WrapHandler)
+	// ログレベルの管理用エンドポイント（admin.tokenが未設定の場合は404を返す）
+	e.Match([]string{http.MethodGet, http.MethodPut}, log.AdminPath, echo.WrapHandler(admin))
 
 	// カテゴリ関連のエンドポイント
 	e.GET("/categories", handler.CategoryList)
 	e.POST("/categories", handler.CreateCategory)
+	e.GET("/categories/by-slug/:slug", handler.CategoryBySlug)
 	e.GET("/categories/:id", handler.CategoryById)
 	e.PUT("/categories/:id", handler.UpdateCategory)
 	e.DELETE("/categories/:id", handler.DeleteCategory)
+	e.POST("/categories/:id/price-adjustments", handler.AdjustPrices)
 
 	// 商品関連のエンドポイント
 	e.GET("/products", handler.ProductList) // keywordパラメータがある場合は検索、ない場合は一覧取得
 	e.POST("/products", handler.CreateProduct)
+	e.GET("/products/by-barcode/:code", handler.ProductByBarcode)
+	e.GET("/products/by-slug/:slug", handler.ProductBySlug)
 	e.GET("/products/:id", handler.ProductById)
 	e.PUT("/products/:id", handler.UpdateProduct)
 	e.DELETE("/products/:id", handler.DeleteProduct)
-	e.GET("/stream/products", handler.ProductStream)
+	e.POST("/products/:id/publish", handler.PublishProduct)
+	e.POST("/products/:id/suspend", handler.SuspendProduct)
+	e.POST("/products/:id/discontinue", handler.DiscontinueProduct)
+	e.POST("/products/:id/price-schedules", handler.SchedulePrice)
+	e.DELETE("/products/:id/price-schedules/:scheduleId", handler.CancelPriceSchedule)
+	e.GET("/products/:id/variants", handler.VariantList)
+	e.POST("/products/:id/variants", handler.CreateVariant)
+	e.PUT("/products/:id/variants/:variantId", handler.UpdateVariant)
+	e.DELETE("/products/:id/variants/:variantId", handler.DeleteVariant)
+	e.GET(streamProductsPath, handler.ProductStream)
+	e.GET("/tags", handler.TagList)
+	e.POST("/tags/attach", handler.AttachTags)
+	e.POST("/tags/detach", handler.DetachTags)
+	e.GET(suggestProductsPath, handler.SuggestProducts)
 
-	return &CQRSServiceServer{
-		logger: logger,
-		e:      e,
-	}
+	server.e = e
+	// 商品ストリームとWebSocketは長時間のレスポンスが途中で切断されないよう書き込みのタイムアウトの対象外にする
+	server.srv = httpserver.New(cfg, e, map[string]bool{
+		streamProductsPath:  true,
+		suggestProductsPath: true,
+	})
+	return server
 }
 
 // RegisterLifecycleHooks はサーバーのライフサイクルフックを登録します。
+// 停止時は/readyzを503にしてからhttpserver.Drainでロードバランサーの振り分け対象から外れるのを待って停止します。
 //
 // Parameters:
 //   - lc: fxライフサイクル
 //   - server: CQRSServiceServer
 //   - cfg: サーバー設定
-func RegisterLifecycleHooks(lc fx.Lifecycle, server *CQRSServiceServer, cfg *CQRSServiceConfig) {
+func RegisterLifecycleHooks(lc fx.Lifecycle, server *CQRSServiceServer, cfg *httpserver.Config) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
-			// port競合によるエラーを回避し、動的ポート割り当てをサポートするため、
-			// 事前にListenしてからStartServerを使用する
+			// port競合によるエラーを回避し、動的ポート割り当てをサポートするため、事前にListenする
+			// コンテナではSERVER_PORTのみを指定するため、server.hostに関わらずすべてのインターフェースで待ち受ける
 			ln, err := net.Listen("tcp", ":"+cfg.Port)
 			if err != nil {
 				return err
 			}
 			// 実際に割り当てられたアドレスを保存（ポート0の場合、動的に割り当てられる）
 			server.Addr = ln.Addr().String()
-			server.e.Listener = ln
 			go func() {
 				server.logger.InfoContext(ctx, "Starting CQRS Client Service Server", slog.String("addr", server.Addr))
-				// NOTE: EchoのStartは内部でListenを呼び出すため、ここではStart("")を使用する
-				// https://echo.labstack.com/docs/customization?utm_source=chatgpt.com#custom-listener
-				if err = server.e.Start(""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				if err := server.srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
 					server.logger.ErrorContext(ctx, "Failed to start server", "error", err)
 				}
 			}()
@@ -173,7 +205,8 @@ func RegisterLifecycleHooks(lc fx.Lifecycle, server *CQRSServiceServer, cfg *CQR
 		},
 		OnStop: func(ctx context.Context) error {
 			server.logger.InfoContext(ctx, "Shutting down CQRS Client Service Server...")
-			return server.e.Shutdown(ctx)
+			server.draining.Store(true)
+			return httpserver.Drain(ctx, server.srv, cfg, server.logger)
 		},
 	})
 }
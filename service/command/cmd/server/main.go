@@ -1,16 +1,46 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/httpserver"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/config"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/presentation"
 	"go.uber.org/fx"
 )
 
+// NOTE: バイナリを実行する位置からの相対パスで指定する
+const (
+	configPath = "./"
+	configName = "config"
+)
+
 func main() {
+	// migrateサブコマンド: スキーマのマイグレーションを実行して終了する（例: main migrate up）
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := infrastructure.RunMigrate(context.Background(), configPath, configName, os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// 停止時のドレインとグレースフルシャットダウンがfxの既定の停止のタイムアウト（15秒）で打ち切られないよう、
+	// 停止のタイムアウトを[server]のdrain_periodとshutdown_timeoutから決める
+	serverCfg, err := httpserver.NewConfig(config.NewViper(configPath, configName))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
 	app := fx.New(
+		fx.StopTimeout(serverCfg.StopTimeout()),
 		fx.Supply(
-			// NOTE: バイナリを実行する位置からの相対パスで指定する
-			fx.Annotate("./", fx.ResultTags(`name:"configPath"`)),
-			fx.Annotate("config", fx.ResultTags(`name:"configName"`)),
+			fx.Annotate(configPath, fx.ResultTags(`name:"configPath"`)),
+			fx.Annotate(configName, fx.ResultTags(`name:"configName"`)),
 		),
 		presentation.Module,
 	)
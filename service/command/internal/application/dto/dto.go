@@ -1,25 +1,38 @@
 package dto
 
 import (
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
 )
 
 // CategoryDTO はカテゴリデータのDTOです。
 type CategoryDTO struct {
-	Id   string // カテゴリID
-	Name string // カテゴリ名
+	Id       string // カテゴリID
+	Name     string // カテゴリ名
+	ParentId string // 親カテゴリID（ルートカテゴリの場合はエンプティ）
+	Slug     string // URLに使用するスラッグ
+
+	Translations map[string]string // 既定のロケール以外のカテゴリ名（キーはロケール）
 }
 
 // CreateCategoryDTO はカテゴリの新規作成時に使用するDTOです。
 type CreateCategoryDTO struct {
-	Name string // カテゴリ名
+	Name     string // カテゴリ名
+	ParentId string // 親カテゴリID（エンプティの場合はルートカテゴリとして作成）
+	Slug     string // URLに使用するスラッグ（エンプティの場合はカテゴリ名から生成）
+
+	Translations map[string]string // 既定のロケール以外のカテゴリ名（キーはロケール）
 }
 
 // UpdateCategoryDTO はカテゴリの更新時に使用するDTOです。
 type UpdateCategoryDTO struct {
 	Id   string // カテゴリID
 	Name string // カテゴリ名
+	Slug string // URLに使用するスラッグ（エンプティの場合は現在のスラッグを維持）
+
+	Translations map[string]string // 変更する既定のロケール以外のカテゴリ名（名前がエンプティのロケールは削除）
 }
 
 // DeleteCategoryDTO はカテゴリの削除時に使用するDTOです。
@@ -27,27 +40,52 @@ type DeleteCategoryDTO struct {
 	Id string // カテゴリID
 }
 
+// MoveCategoryDTO はカテゴリの移動時に使用するDTOです。
+type MoveCategoryDTO struct {
+	Id       string // カテゴリID
+	ParentId string // 移動先の親カテゴリID（エンプティの場合はルートに移動）
+}
+
 // ProductDTO は商品データのDTOです。
 type ProductDTO struct {
 	Id       string       // 商品ID
 	Name     string       // 商品名
 	Category *CategoryDTO // 商品カテゴリ
-	Price    uint32       // 単価
+	Price    uint32       // 税抜の単価（通貨の最小単位）
+	Currency string       // 通貨コード
+	TaxClass string       // 税率区分
+	Status   string       // 販売状態（DRAFT / PUBLISHED / SUSPENDED / DISCONTINUED）
+	Barcode  string       // JAN/EANバーコード（未登録の場合はエンプティ）
+	Slug     string       // URLに使用するスラッグ
+
+	Translations map[string]string // 既定のロケール以外の商品名（キーはロケール）
 }
 
 // CreateProductDTO は商品の新規作成時に使用するDTOです。
 type CreateProductDTO struct {
 	Name     string       // 商品名
-	Price    uint32       // 単価
+	Price    uint32       // 税抜の単価（通貨の最小単位）
+	Currency string       // 通貨コード（エンプティの場合はJPY）
+	TaxClass string       // 税率区分（エンプティの場合は標準税率）
+	Barcode  string       // JAN/EAN/UPCバーコード（エンプティの場合は未登録）
+	Slug     string       // URLに使用するスラッグ（エンプティの場合は商品名から生成）
 	Category *CategoryDTO // 既存カテゴリ情報
+
+	Translations map[string]string // 既定のロケール以外の商品名（キーはロケール）
 }
 
 // UpdateProductDTO は商品の更新時に使用するDTOです。
 type UpdateProductDTO struct {
-	Id         string // 商品ID
-	Name       string // 商品名
-	Price      uint32 // 単価
-	CategoryId string // 商品カテゴリID
+	Id         string  // 商品ID
+	Name       string  // 商品名
+	Price      uint32  // 税抜の単価（通貨の最小単位）
+	Currency   string  // 通貨コード（エンプティの場合は現在の通貨を維持）
+	TaxClass   string  // 税率区分（エンプティの場合は現在の税率区分を維持）
+	CategoryId string  // 商品カテゴリID
+	Barcode    *string // JAN/EAN/UPCバーコード（nilの場合は現在のバーコードを維持、エンプティの場合は削除）
+	Slug       string  // URLに使用するスラッグ（エンプティの場合は現在のスラッグを維持）
+
+	Translations map[string]string // 変更する既定のロケール以外の商品名（名前がエンプティのロケールは削除）
 }
 
 // DeleteProductDTO は商品の削除時に使用するDTOです。
@@ -55,6 +93,11 @@ type DeleteProductDTO struct {
 	Id string // 商品ID
 }
 
+// ChangeProductStatusDTO は商品の公開・一時停止・販売終了時に使用するDTOです。
+type ChangeProductStatusDTO struct {
+	Id string // 商品ID
+}
+
 // NewCategoryDTOFromEntity はドメインエンティティからDTOを生成します。
 //
 // Parameters:
@@ -63,10 +106,16 @@ type DeleteProductDTO struct {
 // Returns:
 //   - *CategoryDTO: プレゼンテーション層で使用するDTO
 func NewCategoryDTOFromEntity(category *categories.Category) *CategoryDTO {
-	return &CategoryDTO{
-		Id:   category.Id().Value(),
-		Name: category.Name().Value(),
+	result := &CategoryDTO{
+		Id:           category.Id().Value(),
+		Name:         category.Name().Value(),
+		Slug:         category.Slug().Value(),
+		Translations: translationsToDTO(category.Translations()),
 	}
+	if !category.IsRoot() {
+		result.ParentId = category.ParentId().Value()
+	}
+	return result
 }
 
 // CategoryFromDTO はDTOからドメインエンティティを再構築します。
@@ -86,10 +135,15 @@ func CategoryFromDTO(dto *CategoryDTO) (*categories.Category, error) {
 	if err != nil {
 		return nil, err
 	}
-	return categories.BuildCategory(id, name)
+	parentId, err := ParentIdFromDTO(dto.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	return categories.BuildCategory(id, name, parentId)
 }
 
-// CategoryFromCreateDTO は新規作成用DTOからドメインエンティティを生成します。
+// CategoryFromCreateDTO は新規作成用DTOからルートカテゴリのドメインエンティティを生成します。
+// 親カテゴリの指定はParentIdFromDTOで変換し、アプリケーションサービスで移動します。
 //
 // Parameters:
 //   - dto: 変換元のDTO
@@ -102,10 +156,22 @@ func CategoryFromCreateDTO(dto *CreateCategoryDTO) (*categories.Category, error)
 	if err != nil {
 		return nil, err
 	}
-	return categories.NewCategory(name)
+	translations, err := CategoryTranslationsFromDTO(nil, dto.Translations)
+	if err != nil {
+		return nil, err
+	}
+	category, err := categories.NewCategory(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := category.ChangeTranslations(translations); err != nil {
+		return nil, err
+	}
+	return category, nil
 }
 
 // CategoryFromUpdateDTO は更新用DTOからドメインエンティティを再構築します。
+// 翻訳は保存済みの翻訳とマージする必要があるため、CategoryTranslationsFromDTOで変換します。
 //
 // Parameters:
 //   - dto: 変換元のDTO
@@ -122,7 +188,7 @@ func CategoryFromUpdateDTO(dto *UpdateCategoryDTO) (*categories.Category, error)
 	if err != nil {
 		return nil, err
 	}
-	return categories.BuildCategory(id, name)
+	return categories.BuildCategory(id, name, nil)
 }
 
 // CategoryIdFromDeleteDTO は削除用DTOからカテゴリIDを取得します。
@@ -137,6 +203,21 @@ func CategoryIdFromDeleteDTO(dto *DeleteCategoryDTO) (*categories.CategoryId, er
 	return categories.NewCategoryId(dto.Id)
 }
 
+// ParentIdFromDTO はDTOの親カテゴリIDをドメインの値オブジェクトに変換します。
+//
+// Parameters:
+//   - parentId: 親カテゴリID（エンプティの場合はルート）
+//
+// Returns:
+//   - *categories.CategoryId: 親カテゴリID（エンプティの場合はnil）
+//   - error: 変換エラー
+func ParentIdFromDTO(parentId string) (*categories.CategoryId, error) {
+	if parentId == "" {
+		return nil, nil
+	}
+	return categories.NewCategoryId(parentId)
+}
+
 // NewProductDTOFromEntity はドメインエンティティからDTOを生成します。
 //
 // Parameters:
@@ -146,13 +227,17 @@ func CategoryIdFromDeleteDTO(dto *DeleteCategoryDTO) (*categories.CategoryId, er
 //   - *ProductDTO: プレゼンテーション層で使用するDTO
 func NewProductDTOFromEntity(product *products.Product) *ProductDTO {
 	return &ProductDTO{
-		Id:   product.Id().Value(),
-		Name: product.Name().Value(),
-		Category: &CategoryDTO{
-			Id:   product.Category().Id().Value(),
-			Name: product.Category().Name().Value(),
-		},
-		Price: product.Price().Value(),
+		Id:       product.Id().Value(),
+		Name:     product.Name().Value(),
+		Category: NewCategoryDTOFromEntity(product.Category()),
+		Price:    product.Price().Value(),
+		Currency: product.Price().Currency(),
+		TaxClass: string(product.Price().TaxClass()),
+		Status:   string(product.Status()),
+		Barcode:  barcodeToDTO(product.Barcode()),
+		Slug:     product.Slug().Value(),
+
+		Translations: translationsToDTO(product.Translations()),
 	}
 }
 
@@ -173,7 +258,7 @@ func ProductFromDTO(dto *ProductDTO) (*products.Product, error) {
 	if err != nil {
 		return nil, err
 	}
-	price, err := products.NewProductPrice(dto.Price)
+	price, err := productPriceFromDTO(dto.Price, dto.Currency, dto.TaxClass, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +266,13 @@ func ProductFromDTO(dto *ProductDTO) (*products.Product, error) {
 	if err != nil {
 		return nil, err
 	}
-	return products.BuildProduct(id, name, price, category)
+	status := products.PRODUCT_DRAFT
+	if dto.Status != "" {
+		if status, err = products.ParseProductStatus(dto.Status); err != nil {
+			return nil, err
+		}
+	}
+	return products.BuildProduct(id, name, price, category, status)
 }
 
 // ProductFromCreateDTO は新規作成用DTOからドメインエンティティを生成します。
@@ -197,7 +288,7 @@ func ProductFromCreateDTO(dto *CreateProductDTO) (*products.Product, error) {
 	if err != nil {
 		return nil, err
 	}
-	price, err := products.NewProductPrice(dto.Price)
+	price, err := productPriceFromDTO(dto.Price, dto.Currency, dto.TaxClass, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +296,23 @@ func ProductFromCreateDTO(dto *CreateProductDTO) (*products.Product, error) {
 	if err != nil {
 		return nil, err
 	}
-	return products.NewProduct(name, price, category)
+	translations, err := ProductTranslationsFromDTO(nil, dto.Translations)
+	if err != nil {
+		return nil, err
+	}
+	barcode, err := BarcodeFromDTO(dto.Barcode)
+	if err != nil {
+		return nil, err
+	}
+	product, err := products.NewProduct(name, price, category)
+	if err != nil {
+		return nil, err
+	}
+	if err := product.ChangeTranslations(translations); err != nil {
+		return nil, err
+	}
+	product.ChangeBarcode(barcode)
+	return product, nil
 }
 
 // ProductFromUpdateDTO は更新用DTOからドメインエンティティを再構築します。
@@ -213,11 +320,12 @@ func ProductFromCreateDTO(dto *CreateProductDTO) (*products.Product, error) {
 // Parameters:
 //   - dto: 変換元のDTO
 //   - categoryName: カテゴリ名
+//   - current: 更新前の商品（通貨・税率区分・バーコードが未指定の場合に引き継ぎ、翻訳をマージする。販売状態とスラッグは常に引き継ぐ）
 //
 // Returns:
 //   - *products.Product: 再構築されたドメインエンティティ
 //   - error: 変換エラー
-func ProductFromUpdateDTO(dto *UpdateProductDTO, categoryName *categories.CategoryName) (*products.Product, error) {
+func ProductFromUpdateDTO(dto *UpdateProductDTO, categoryName *categories.CategoryName, current *products.Product) (*products.Product, error) {
 	id, err := products.NewProductId(dto.Id)
 	if err != nil {
 		return nil, err
@@ -226,7 +334,30 @@ func ProductFromUpdateDTO(dto *UpdateProductDTO, categoryName *categories.Catego
 	if err != nil {
 		return nil, err
 	}
-	price, err := products.NewProductPrice(dto.Price)
+	var (
+		currentPrice        *products.ProductPrice
+		currentTranslations map[names.Locale]*products.ProductName
+		barcode             *products.Barcode
+		slug                names.Slug
+		status              = products.PRODUCT_DRAFT
+	)
+	if current != nil {
+		currentPrice = current.Price()
+		currentTranslations = current.Translations()
+		barcode = current.Barcode()
+		slug = current.Slug()
+		status = current.Status()
+	}
+	if dto.Barcode != nil {
+		if barcode, err = BarcodeFromDTO(*dto.Barcode); err != nil {
+			return nil, err
+		}
+	}
+	price, err := productPriceFromDTO(dto.Price, dto.Currency, dto.TaxClass, currentPrice)
+	if err != nil {
+		return nil, err
+	}
+	translations, err := ProductTranslationsFromDTO(currentTranslations, dto.Translations)
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +369,83 @@ func ProductFromUpdateDTO(dto *UpdateProductDTO, categoryName *categories.Catego
 	if err != nil {
 		return nil, err
 	}
-	return products.BuildProduct(id, name, price, category)
+	product, err := products.BuildProduct(id, name, price, category, status)
+	if err != nil {
+		return nil, err
+	}
+	if err := product.ChangeTranslations(translations); err != nil {
+		return nil, err
+	}
+	product.ChangeBarcode(barcode)
+	product.ChangeSlug(slug)
+	return product, nil
+}
+
+// SlugFromDTO はDTOのスラッグから値オブジェクトを生成します。
+//
+// Parameters:
+//   - value: スラッグ（エンプティの場合は未指定）
+//
+// Returns:
+//   - names.Slug: スラッグ（エンプティの場合はエンプティ）
+//   - error: スラッグが不正な場合はDomainError (コード: INVALID_ARGUMENT)
+func SlugFromDTO(value string) (names.Slug, error) {
+	if value == "" {
+		return "", nil
+	}
+	return names.NewSlug(value)
+}
+
+// BarcodeFromDTO はDTOのバーコードから値オブジェクトを生成します。
+//
+// Parameters:
+//   - value: JAN/EAN/UPCバーコード（エンプティの場合は未登録）
+//
+// Returns:
+//   - *products.Barcode: バーコード（エンプティの場合はnil）
+//   - error: バーコードが不正な場合はDomainError (コード: INVALID_ARGUMENT)
+func BarcodeFromDTO(value string) (*products.Barcode, error) {
+	if value == "" {
+		return nil, nil
+	}
+	return products.NewBarcode(value)
+}
+
+// barcodeToDTO はバーコードをDTOの文字列に変換します。未登録の場合はエンプティです。
+func barcodeToDTO(barcode *products.Barcode) string {
+	if barcode == nil {
+		return ""
+	}
+	return barcode.Value()
+}
+
+// productPriceFromDTO はDTOの単価・通貨・税率区分から商品価格を生成します。
+// 通貨・税率区分がエンプティの場合はcurrentの値を引き継ぎ、currentがnilの場合はデフォルト値を使用します。
+//
+// Parameters:
+//   - value: 税抜の単価
+//   - currency: 通貨コード
+//   - taxClass: 税率区分
+//   - current: 引き継ぎ元の商品価格（nilの場合はデフォルト値）
+//
+// Returns:
+//   - *products.ProductPrice: 商品価格
+//   - error: 変換エラー
+func productPriceFromDTO(value uint32, currency string, taxClass string, current *products.ProductPrice) (*products.ProductPrice, error) {
+	if currency == "" {
+		currency = money.DefaultCurrency
+		if current != nil {
+			currency = current.Currency()
+		}
+	}
+	class, err := money.ParseTaxClass(taxClass)
+	if err != nil {
+		return nil, err
+	}
+	if taxClass == "" && current != nil {
+		class = current.TaxClass()
+	}
+	return products.NewProductPriceWithTax(value, currency, class)
 }
 
 // ProductIdFromDeleteDTO は削除用DTOから商品IDを取得します。
@@ -1,8 +1,10 @@
 package dto_test
 
 import (
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -162,10 +164,53 @@ var _ = Describe("ProductFromCreateDTO", func() {
 			Expect(result.Price().Value()).To(Equal(uint32(50000)))
 			Expect(result.Category().Name().Value()).To(Equal("Electronics"))
 			Expect(result.Category().Id().Value()).To(Equal("550e8400-e29b-41d4-a716-446655440000"))
+			Expect(result.Price().Currency()).To(Equal("JPY"))
+			Expect(result.Price().TaxClass()).To(Equal(money.TaxClassStandard))
+		})
+
+		It("通貨と税率区分を指定して生成できる", func() {
+			// Arrange
+			createDTO := &dto.CreateProductDTO{
+				Name:     "Coffee Beans",
+				Price:    1250,
+				Currency: "USD",
+				TaxClass: "reduced",
+				Category: &dto.CategoryDTO{
+					Id:   "550e8400-e29b-41d4-a716-446655440000",
+					Name: "Food",
+				},
+			}
+
+			// Act
+			result, err := dto.ProductFromCreateDTO(createDTO)
+
+			// Assert
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Price().Currency()).To(Equal("USD"))
+			Expect(result.Price().TaxClass()).To(Equal(money.TaxClassReduced))
 		})
 	})
 
 	Context("異常系", func() {
+		It("未対応の税率区分の場合エラーを返す", func() {
+			// Arrange
+			createDTO := &dto.CreateProductDTO{
+				Name:     "Smartphone",
+				Price:    50000,
+				TaxClass: "ZERO",
+				Category: &dto.CategoryDTO{
+					Id:   "550e8400-e29b-41d4-a716-446655440000",
+					Name: "Electronics",
+				},
+			}
+
+			// Act
+			_, err := dto.ProductFromCreateDTO(createDTO)
+
+			// Assert
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("不正なProductNameの場合エラーを返す", func() {
 			// Arrange
 			createDTO := &dto.CreateProductDTO{
@@ -218,7 +263,7 @@ var _ = Describe("ProductFromUpdateDTO", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			// Act
-			result, err := dto.ProductFromUpdateDTO(updateDTO, categoryName)
+			result, err := dto.ProductFromUpdateDTO(updateDTO, categoryName, nil)
 
 			// Assert
 			Expect(err).NotTo(HaveOccurred())
@@ -229,6 +274,114 @@ var _ = Describe("ProductFromUpdateDTO", func() {
 			Expect(result.Category().Id().Value()).To(Equal("550e8400-e29b-41d4-a716-446655440000"))
 			Expect(result.Category().Name().Value()).To(Equal("Updated Category"))
 		})
+
+		It("通貨と税率区分が未指定の場合は更新前の値を引き継ぐ", func() {
+			// Arrange
+			updateDTO := &dto.UpdateProductDTO{
+				Id:         "650e8400-e29b-41d4-a716-446655440000",
+				Name:       "Updated Product",
+				Price:      980,
+				CategoryId: "550e8400-e29b-41d4-a716-446655440000",
+			}
+			categoryName, err := categories.NewCategoryName("Food")
+			Expect(err).NotTo(HaveOccurred())
+			currentPrice, err := products.NewProductPriceWithTax(1200, "EUR", money.TaxClassReduced)
+			Expect(err).NotTo(HaveOccurred())
+			current := newCurrentProduct(currentPrice, nil)
+
+			// Act
+			result, err := dto.ProductFromUpdateDTO(updateDTO, categoryName, current)
+
+			// Assert
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Price().Value()).To(Equal(uint32(980)))
+			Expect(result.Price().Currency()).To(Equal("EUR"))
+			Expect(result.Price().TaxClass()).To(Equal(money.TaxClassReduced))
+		})
+
+		It("税率区分を指定した場合は更新前の値を上書きする", func() {
+			// Arrange
+			updateDTO := &dto.UpdateProductDTO{
+				Id:         "650e8400-e29b-41d4-a716-446655440000",
+				Name:       "Updated Product",
+				Price:      980,
+				TaxClass:   "STANDARD",
+				CategoryId: "550e8400-e29b-41d4-a716-446655440000",
+			}
+			categoryName, err := categories.NewCategoryName("Food")
+			Expect(err).NotTo(HaveOccurred())
+			currentPrice, err := products.NewProductPriceWithTax(1200, "EUR", money.TaxClassReduced)
+			Expect(err).NotTo(HaveOccurred())
+			current := newCurrentProduct(currentPrice, nil)
+
+			// Act
+			result, err := dto.ProductFromUpdateDTO(updateDTO, categoryName, current)
+
+			// Assert
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Price().Currency()).To(Equal("EUR"))
+			Expect(result.Price().TaxClass()).To(Equal(money.TaxClassStandard))
+		})
+
+		It("翻訳は更新前の翻訳にマージし、名前が空のロケールは削除する", func() {
+			// Arrange
+			updateDTO := &dto.UpdateProductDTO{
+				Id:           "650e8400-e29b-41d4-a716-446655440000",
+				Name:         "ボールペン",
+				Price:        120,
+				CategoryId:   "550e8400-e29b-41d4-a716-446655440000",
+				Translations: map[string]string{"en": "", "fr-fr": "Stylo"},
+			}
+			categoryName, err := categories.NewCategoryName("文房具")
+			Expect(err).NotTo(HaveOccurred())
+			currentPrice, err := products.NewProductPrice(100)
+			Expect(err).NotTo(HaveOccurred())
+			en, err := products.NewProductName("Ballpoint pen")
+			Expect(err).NotTo(HaveOccurred())
+			de, err := products.NewProductName("Kugelschreiber")
+			Expect(err).NotTo(HaveOccurred())
+			current := newCurrentProduct(currentPrice, map[names.Locale]*products.ProductName{"en": en, "de": de})
+
+			// Act
+			result, err := dto.ProductFromUpdateDTO(updateDTO, categoryName, current)
+
+			// Assert
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Translations()).To(HaveLen(2))
+			Expect(result.Translations()).To(HaveKeyWithValue(names.Locale("de"), de))
+			Expect(result.Translations()[names.Locale("fr-FR")].Value()).To(Equal("Stylo"))
+		})
+
+		DescribeTable("バーコードは未指定の場合に引き継ぎ、空文字列の場合に削除する",
+			func(barcode *string, expected string) {
+				// Arrange
+				updateDTO := &dto.UpdateProductDTO{
+					Id:         "650e8400-e29b-41d4-a716-446655440000",
+					Name:       "ボールペン",
+					Price:      120,
+					CategoryId: "550e8400-e29b-41d4-a716-446655440000",
+					Barcode:    barcode,
+				}
+				categoryName, err := categories.NewCategoryName("文房具")
+				Expect(err).NotTo(HaveOccurred())
+				currentPrice, err := products.NewProductPrice(100)
+				Expect(err).NotTo(HaveOccurred())
+				current := newCurrentProduct(currentPrice, nil)
+				currentBarcode, err := products.NewBarcode("4901234567894")
+				Expect(err).NotTo(HaveOccurred())
+				current.ChangeBarcode(currentBarcode)
+
+				// Act
+				result, err := dto.ProductFromUpdateDTO(updateDTO, categoryName, current)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dto.NewProductDTOFromEntity(result).Barcode).To(Equal(expected))
+			},
+			Entry("未指定", nil, "4901234567894"),
+			Entry("空文字列", new(string), ""),
+			Entry("UPC-A", func() *string { v := "036000291452"; return &v }(), "0036000291452"),
+		)
 	})
 
 	Context("異常系", func() {
@@ -244,7 +397,7 @@ var _ = Describe("ProductFromUpdateDTO", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			// Act
-			_, err = dto.ProductFromUpdateDTO(updateDTO, categoryName)
+			_, err = dto.ProductFromUpdateDTO(updateDTO, categoryName, nil)
 
 			// Assert
 			Expect(err).To(HaveOccurred())
@@ -262,7 +415,7 @@ var _ = Describe("ProductFromUpdateDTO", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			// Act
-			_, err = dto.ProductFromUpdateDTO(updateDTO, categoryName)
+			_, err = dto.ProductFromUpdateDTO(updateDTO, categoryName, nil)
 
 			// Assert
 			Expect(err).To(HaveOccurred())
@@ -363,3 +516,45 @@ var _ = Describe("ProductIdFromDeleteDTO", func() {
 		})
 	})
 })
+
+var _ = Describe("ProductFromCreateDTO（翻訳）", func() {
+	It("既定のロケールの翻訳を指定した場合エラーを返す", func() {
+		createDTO := &dto.CreateProductDTO{
+			Name:         "ボールペン",
+			Price:        120,
+			Category:     &dto.CategoryDTO{Id: "550e8400-e29b-41d4-a716-446655440000", Name: "文房具"},
+			Translations: map[string]string{"JA": "ボールペン"},
+		}
+
+		_, err := dto.ProductFromCreateDTO(createDTO)
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("不正なロケールの場合エラーを返す", func() {
+		createDTO := &dto.CreateProductDTO{
+			Name:         "ボールペン",
+			Price:        120,
+			Category:     &dto.CategoryDTO{Id: "550e8400-e29b-41d4-a716-446655440000", Name: "文房具"},
+			Translations: map[string]string{"english": "Ballpoint pen"},
+		}
+
+		_, err := dto.ProductFromCreateDTO(createDTO)
+
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+// newCurrentProduct は更新前の商品を生成します。
+func newCurrentProduct(price *products.ProductPrice, translations map[names.Locale]*products.ProductName) *products.Product {
+	name, err := products.NewProductName("更新前の商品")
+	Expect(err).NotTo(HaveOccurred())
+	categoryName, err := categories.NewCategoryName("更新前のカテゴリ")
+	Expect(err).NotTo(HaveOccurred())
+	category, err := categories.NewCategory(categoryName)
+	Expect(err).NotTo(HaveOccurred())
+	product, err := products.NewProduct(name, price, category)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(product.ChangeTranslations(translations)).To(Succeed())
+	return product
+}
@@ -0,0 +1,120 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/pricing"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// SchedulePriceDTO は価格スケジュールの登録時に使用するDTOです。
+type SchedulePriceDTO struct {
+	ProductId string    // 商品ID
+	Price     uint32    // 期間中の税抜の単価（商品の通貨の最小単位）
+	StartsAt  time.Time // 開始時刻
+	EndsAt    time.Time // 終了時刻
+}
+
+// CancelPriceScheduleDTO は価格スケジュールの取消時に使用するDTOです。
+type CancelPriceScheduleDTO struct {
+	ProductId string // 商品ID
+	Id        string // 価格スケジュールID
+}
+
+// PriceScheduleDTO は価格スケジュールデータのDTOです。
+type PriceScheduleDTO struct {
+	Id           string    // 価格スケジュールID
+	ProductId    string    // 商品ID
+	Price        uint32    // 期間中の税抜の単価
+	RegularPrice uint32    // 適用前の単価（未適用の場合は0）
+	Status       string    // 状態
+	StartsAt     time.Time // 開始時刻
+	EndsAt       time.Time // 終了時刻
+}
+
+// NewPriceScheduleDTOFromEntity はドメインエンティティからDTOを生成します。
+//
+// Parameters:
+//   - schedule: 変換元の価格スケジュール
+//
+// Returns:
+//   - *PriceScheduleDTO: プレゼンテーション層で使用するDTO
+func NewPriceScheduleDTOFromEntity(schedule *pricing.PriceSchedule) *PriceScheduleDTO {
+	return &PriceScheduleDTO{
+		Id:           schedule.Id().Value(),
+		ProductId:    schedule.ProductId().Value(),
+		Price:        schedule.Price(),
+		RegularPrice: schedule.RegularPrice(),
+		Status:       string(schedule.Status()),
+		StartsAt:     schedule.StartsAt(),
+		EndsAt:       schedule.EndsAt(),
+	}
+}
+
+// AdjustPricesDTO は単価の一括変更時に使用するDTOです。
+type AdjustPricesDTO struct {
+	CategoryId         string   // 対象の商品が属するカテゴリID
+	IncludeDescendants bool     // 子孫カテゴリの商品を含めるかどうか
+	Tags               []string // 商品にすべて付与されている必要があるタグ名（空の場合は絞り込まない）
+	Type               string   // 変更の方法（PERCENT / FIXED）
+	Value              int32    // 変更量（PERCENTの場合は%、FIXEDの場合は金額）
+	Rounding           string   // 端数処理の方法（FLOOR / HALF_UP / CEIL、エンプティの場合はFLOOR）
+	RoundingUnit       uint32   // 端数処理の単位（0の場合は1）
+	MinPrice           uint32   // 変更後の単価の下限（0の場合は下限なし）
+	MaxPrice           uint32   // 変更後の単価の上限（0の場合は上限なし）
+	Preview            bool     // trueの場合は単価を変更せずに変更前後の単価のみを返す
+}
+
+// PriceAdjustmentDTO は商品ごとの単価の変更結果のDTOです。
+type PriceAdjustmentDTO struct {
+	ProductId   string // 商品ID
+	ProductName string // 商品名
+	Currency    string // 通貨コード
+	BeforePrice uint32 // 変更前の税抜の単価
+	AfterPrice  uint32 // 変更後の税抜の単価
+}
+
+// AdjustPricesResultDTO は単価の一括変更の結果のDTOです。
+type AdjustPricesResultDTO struct {
+	Adjustments []*PriceAdjustmentDTO // 商品ごとの変更結果（商品ID順）
+	Preview     bool                  // プレビューの場合はtrue（単価は変更していない）
+}
+
+// PriceAdjustmentFromDTO はDTOから単価の一括変更の規則を生成します。
+//
+// Parameters:
+//   - dto: 単価の一括変更のDTO
+//
+// Returns:
+//   - *pricing.PriceAdjustment: 単価の一括変更の規則
+//   - error: 値が不正な場合はDomainError (コード: INVALID_ARGUMENT)
+func PriceAdjustmentFromDTO(dto *AdjustPricesDTO) (*pricing.PriceAdjustment, error) {
+	adjustmentType, err := pricing.ParsePriceAdjustmentType(dto.Type)
+	if err != nil {
+		return nil, err
+	}
+	rounding, err := money.ParseRounding(dto.Rounding)
+	if err != nil {
+		return nil, err
+	}
+	return pricing.NewPriceAdjustment(adjustmentType, dto.Value, rounding, dto.RoundingUnit, dto.MinPrice, dto.MaxPrice)
+}
+
+// NewPriceAdjustmentDTO は単価を変更した商品からDTOを生成します。
+//
+// Parameters:
+//   - product: 単価を変更した商品
+//   - beforePrice: 変更前の税抜の単価
+//
+// Returns:
+//   - *PriceAdjustmentDTO: 商品ごとの単価の変更結果
+func NewPriceAdjustmentDTO(product *products.Product, beforePrice uint32) *PriceAdjustmentDTO {
+	return &PriceAdjustmentDTO{
+		ProductId:   product.Id().Value(),
+		ProductName: product.Name().Value(),
+		Currency:    product.Price().Currency(),
+		BeforePrice: beforePrice,
+		AfterPrice:  product.Price().Value(),
+	}
+}
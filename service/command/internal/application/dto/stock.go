@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/stocks"
+)
+
+// StockDTO は在庫データのDTOです。
+type StockDTO struct {
+	ProductId string // 商品ID
+	OnHand    uint32 // 在庫数
+	Reserved  uint32 // 引当済みの数量
+	Available uint32 // 引当可能な数量
+}
+
+// AdjustStockDTO は在庫数の増減時に使用するDTOです。
+type AdjustStockDTO struct {
+	ProductId string // 商品ID
+	Delta     int32  // 在庫数の増減（入荷は正、棚卸による減少は負）
+}
+
+// ReserveStockDTO は在庫の引当時に使用するDTOです。
+type ReserveStockDTO struct {
+	ProductId string        // 商品ID
+	Quantity  uint32        // 引当数量
+	TTL       time.Duration // 引当の有効期限（0の場合は既定値）
+}
+
+// ReleaseReservationDTO は引当の解放時に使用するDTOです。
+type ReleaseReservationDTO struct {
+	Id string // 引当ID
+}
+
+// CommitReservationDTO は引当の確定時に使用するDTOです。
+type CommitReservationDTO struct {
+	Id string // 引当ID
+}
+
+// ReservationDTO は在庫引当データのDTOです。
+type ReservationDTO struct {
+	Id        string    // 引当ID
+	ProductId string    // 商品ID
+	Quantity  uint32    // 引当数量
+	Status    string    // 状態
+	ExpiresAt time.Time // 有効期限
+	Stock     *StockDTO // 操作後の在庫
+}
+
+// NewStockDTOFromEntity はドメインエンティティからDTOを生成します。
+//
+// Parameters:
+//   - stock: 変換元の在庫集約
+//
+// Returns:
+//   - *StockDTO: プレゼンテーション層で使用するDTO
+func NewStockDTOFromEntity(stock *stocks.Stock) *StockDTO {
+	return &StockDTO{
+		ProductId: stock.ProductId().Value(),
+		OnHand:    stock.OnHand(),
+		Reserved:  stock.Reserved(),
+		Available: stock.Available(),
+	}
+}
+
+// NewReservationDTOFromEntity はドメインエンティティからDTOを生成します。
+//
+// Parameters:
+//   - reservation: 変換元の在庫引当
+//   - stock: 操作後の在庫集約
+//
+// Returns:
+//   - *ReservationDTO: プレゼンテーション層で使用するDTO
+func NewReservationDTOFromEntity(reservation *stocks.Reservation, stock *stocks.Stock) *ReservationDTO {
+	return &ReservationDTO{
+		Id:        reservation.Id().Value(),
+		ProductId: reservation.ProductId().Value(),
+		Quantity:  reservation.Quantity().Value(),
+		Status:    string(reservation.Status()),
+		ExpiresAt: reservation.ExpiresAt(),
+		Stock:     NewStockDTOFromEntity(stock),
+	}
+}
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/tags"
+)
+
+// TagDTO はタグデータのDTOです。
+type TagDTO struct {
+	Id   string // タグID
+	Name string // タグ名
+}
+
+// ChangeTagsDTO は商品へのタグの付与・削除時に使用するDTOです。
+// 指定されたすべての商品に対して、指定されたすべてのタグを付与または削除します。
+type ChangeTagsDTO struct {
+	ProductIds []string // 対象の商品ID
+	TagNames   []string // 付与または削除するタグ名
+}
+
+// TagChangeResultDTO は商品へのタグの付与・削除の結果のDTOです。
+type TagChangeResultDTO struct {
+	Tags    []TagDTO // 付与または削除の対象となったタグ
+	Changed int64    // 新たに付与した、または削除した商品とタグの組み合わせの数
+}
+
+// NewTagChangeResultDTO はドメインエンティティからDTOを生成します。
+//
+// Parameters:
+//   - tagList: 付与または削除の対象となったタグ
+//   - changed: 新たに付与した、または削除した商品とタグの組み合わせの数
+//
+// Returns:
+//   - *TagChangeResultDTO: プレゼンテーション層で使用するDTO
+func NewTagChangeResultDTO(tagList []*tags.Tag, changed int64) *TagChangeResultDTO {
+	tagDTOs := make([]TagDTO, 0, len(tagList))
+	for _, tag := range tagList {
+		tagDTOs = append(tagDTOs, TagDTO{Id: tag.Id().Value(), Name: tag.Name().Value()})
+	}
+	return &TagChangeResultDTO{Tags: tagDTOs, Changed: changed}
+}
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"maps"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// ProductTranslationsFromDTO はDTOの商品名の翻訳を現在の翻訳にマージします。
+// 名前がエンプティのロケールは削除し、指定されていないロケールは現在の翻訳を維持します。
+//
+// Parameters:
+//   - current: 現在の翻訳（新規作成の場合はnil）
+//   - changes: ロケールごとの商品名
+//
+// Returns:
+//   - map[names.Locale]*products.ProductName: マージ後の翻訳
+//   - error: ロケールまたは商品名が不正な場合のエラー
+func ProductTranslationsFromDTO(current map[names.Locale]*products.ProductName, changes map[string]string) (map[names.Locale]*products.ProductName, error) {
+	return translationsFromDTO(current, changes, products.NewProductName)
+}
+
+// CategoryTranslationsFromDTO はDTOのカテゴリ名の翻訳を現在の翻訳にマージします。
+// マージの規則はProductTranslationsFromDTOと同じです。
+//
+// Parameters:
+//   - current: 現在の翻訳（新規作成の場合はnil）
+//   - changes: ロケールごとのカテゴリ名
+//
+// Returns:
+//   - map[names.Locale]*categories.CategoryName: マージ後の翻訳
+//   - error: ロケールまたはカテゴリ名が不正な場合のエラー
+func CategoryTranslationsFromDTO(current map[names.Locale]*categories.CategoryName, changes map[string]string) (map[names.Locale]*categories.CategoryName, error) {
+	return translationsFromDTO(current, changes, categories.NewCategoryName)
+}
+
+// translationsFromDTO はロケールごとの名前を現在の翻訳にマージします。
+func translationsFromDTO[T any](current map[names.Locale]T, changes map[string]string, newName func(string) (T, error)) (map[names.Locale]T, error) {
+	merged := maps.Clone(current)
+	if merged == nil {
+		merged = make(map[names.Locale]T, len(changes))
+	}
+	for value, name := range changes {
+		locale, err := names.NewLocale(value)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			delete(merged, locale)
+			continue
+		}
+		if merged[locale], err = newName(name); err != nil {
+			return nil, err
+		}
+	}
+	return merged, nil
+}
+
+// translationsToDTO はロケールごとの名前をDTOの形式に変換します。
+func translationsToDTO[T interface{ Value() string }](translations map[names.Locale]T) map[string]string {
+	if len(translations) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(translations))
+	for locale, name := range translations {
+		result[locale.Value()] = name.Value()
+	}
+	return result
+}
@@ -0,0 +1,149 @@
+package dto
+
+import (
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// VariantOptionDTO はバリエーションの選択肢のDTOです。
+type VariantOptionDTO struct {
+	Axis  string // 選択肢の軸（例: サイズ）
+	Value string // 選択肢の値（例: M）
+}
+
+// VariantDTO は商品バリエーションデータのDTOです。
+type VariantDTO struct {
+	Id            string             // バリエーションID
+	ProductId     string             // 商品ID
+	Sku           string             // SKUコード
+	Options       []VariantOptionDTO // 選択肢の組み合わせ
+	PriceOverride *uint32            // 価格の上書き（nilの場合は商品価格）
+	Price         uint32             // 販売価格（価格の上書きがない場合は商品価格）
+	Status        string             // 販売状態
+}
+
+// AddVariantDTO はバリエーションの追加時に使用するDTOです。
+type AddVariantDTO struct {
+	ProductId     string             // 商品ID
+	Sku           string             // SKUコード
+	Options       []VariantOptionDTO // 選択肢の組み合わせ
+	PriceOverride *uint32            // 価格の上書き（nilの場合は商品価格）
+	Status        string             // 販売状態（空の場合はACTIVE）
+}
+
+// UpdateVariantDTO はバリエーションの更新時に使用するDTOです。
+// すべての項目を指定された値で置き換えます。
+type UpdateVariantDTO struct {
+	Id            string             // バリエーションID
+	ProductId     string             // 商品ID
+	Sku           string             // SKUコード
+	Options       []VariantOptionDTO // 選択肢の組み合わせ
+	PriceOverride *uint32            // 価格の上書き（nilの場合は商品価格）
+	Status        string             // 販売状態（空の場合はACTIVE）
+}
+
+// RemoveVariantDTO はバリエーションの削除時に使用するDTOです。
+type RemoveVariantDTO struct {
+	Id        string // バリエーションID
+	ProductId string // 商品ID
+}
+
+// NewVariantDTOFromEntity はドメインエンティティからDTOを生成します。
+//
+// Parameters:
+//   - product: バリエーションを持つ商品集約
+//   - variant: 変換元のバリエーション
+//
+// Returns:
+//   - *VariantDTO: プレゼンテーション層で使用するDTO
+func NewVariantDTOFromEntity(product *products.Product, variant *products.Variant) *VariantDTO {
+	values := variant.Options().Values()
+	options := make([]VariantOptionDTO, 0, len(values))
+	for _, option := range values {
+		options = append(options, VariantOptionDTO{Axis: option.Axis(), Value: option.Value()})
+	}
+	var priceOverride *uint32
+	if variant.PriceOverride() != nil {
+		value := variant.PriceOverride().Value()
+		priceOverride = &value
+	}
+	return &VariantDTO{
+		Id:            variant.Id().Value(),
+		ProductId:     product.Id().Value(),
+		Sku:           variant.Sku().Value(),
+		Options:       options,
+		PriceOverride: priceOverride,
+		Price:         variant.EffectivePrice(product.Price()).Value(),
+		Status:        string(variant.Status()),
+	}
+}
+
+// VariantFromAddDTO は追加用DTOから新しいバリエーションを生成します。
+//
+// Parameters:
+//   - dto: バリエーション追加用のDTO
+//
+// Returns:
+//   - *products.Variant: 生成されたバリエーション
+//   - error: 値が不正な場合のエラー
+func VariantFromAddDTO(dto *AddVariantDTO) (*products.Variant, error) {
+	sku, options, priceOverride, status, err := variantAttributesFromDTO(dto.Sku, dto.Options, dto.PriceOverride, dto.Status)
+	if err != nil {
+		return nil, err
+	}
+	return products.NewVariant(sku, options, priceOverride, status)
+}
+
+// VariantFromUpdateDTO は更新用DTOからバリエーションを再構築します。
+//
+// Parameters:
+//   - dto: バリエーション更新用のDTO
+//
+// Returns:
+//   - *products.Variant: 再構築されたバリエーション
+//   - error: 値が不正な場合のエラー
+func VariantFromUpdateDTO(dto *UpdateVariantDTO) (*products.Variant, error) {
+	id, err := products.NewVariantId(dto.Id)
+	if err != nil {
+		return nil, err
+	}
+	sku, options, priceOverride, status, err := variantAttributesFromDTO(dto.Sku, dto.Options, dto.PriceOverride, dto.Status)
+	if err != nil {
+		return nil, err
+	}
+	return products.BuildVariant(id, sku, options, priceOverride, status), nil
+}
+
+// variantAttributesFromDTO はDTOの値からバリエーションの属性を生成します。
+func variantAttributesFromDTO(skuValue string, optionDTOs []VariantOptionDTO, priceOverrideValue *uint32, statusValue string) (*products.Sku, *products.VariantOptions, *products.ProductPrice, products.VariantStatus, error) {
+	sku, err := products.NewSku(skuValue)
+	if err != nil {
+		return nil, nil, nil, "", err
+	}
+	values := make([]*products.VariantOption, 0, len(optionDTOs))
+	for _, optionDTO := range optionDTOs {
+		option, err := products.NewVariantOption(optionDTO.Axis, optionDTO.Value)
+		if err != nil {
+			return nil, nil, nil, "", err
+		}
+		values = append(values, option)
+	}
+	options, err := products.NewVariantOptions(values...)
+	if err != nil {
+		return nil, nil, nil, "", err
+	}
+	var priceOverride *products.ProductPrice
+	if priceOverrideValue != nil {
+		priceOverride, err = products.NewProductPrice(*priceOverrideValue)
+		if err != nil {
+			return nil, nil, nil, "", err
+		}
+	}
+	status := products.VARIANT_ACTIVE
+	if statusValue != "" {
+		status, err = products.ParseVariantStatus(statusValue)
+		if err != nil {
+			return nil, nil, nil, "", err
+		}
+	}
+	return sku, options, priceOverride, status, nil
+}
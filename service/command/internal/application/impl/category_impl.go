@@ -9,6 +9,7 @@ import (
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 )
 
 // CategoryServiceImpl はカテゴリサービスの実装です。
@@ -41,7 +42,9 @@ func NewCategoryServiceImpl(logger *slog.Logger, repo categories.CategoryReposit
 }
 
 // Add は新しいカテゴリを追加します。
-// カテゴリ名に重複がないことを確認してから、永続化します。
+// カテゴリ名の重複は正規化キーの一意制約で検出します。
+// 親カテゴリが指定された場合は、親カテゴリの存在を確認してその下に作成します。
+// スラッグが指定されない場合はカテゴリ名から生成し、使用済みの場合は連番を付けて一意にします。
 //
 // Parameters:
 //   - ctx: リクエストコンテキスト
@@ -53,14 +56,24 @@ func NewCategoryServiceImpl(logger *slog.Logger, repo categories.CategoryReposit
 func (s *CategoryServiceImpl) Add(ctx context.Context, categoryDTO *dto.CreateCategoryDTO) (result *dto.CategoryDTO, err error) {
 	var (
 		category *categories.Category
+		parentId *categories.CategoryId
+		explicit names.Slug
+		slug     names.Slug
 		tx       *sql.Tx
-		exists   bool
 	)
 
 	category, err = dto.CategoryFromCreateDTO(categoryDTO)
 	if err != nil {
 		return nil, err
 	}
+	explicit, err = dto.SlugFromDTO(categoryDTO.Slug)
+	if err != nil {
+		return nil, err
+	}
+	parentId, err = dto.ParentIdFromDTO(categoryDTO.ParentId)
+	if err != nil {
+		return nil, err
+	}
 
 	tx, err = s.tm.Begin(ctx)
 	if err != nil {
@@ -71,17 +84,22 @@ func (s *CategoryServiceImpl) Add(ctx context.Context, categoryDTO *dto.CreateCa
 		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
 	}()
 
-	exists, err = s.repo.ExistsByName(ctx, tx, category.Name())
-	if err != nil {
-		return nil, err
+	if parentId != nil {
+		if err = s.moveCategory(ctx, tx, category, parentId); err != nil {
+			return nil, err
+		}
 	}
-	if exists {
-		// defer内で評価されるerrに代入し、トランザクション完了時にロールバックされるようにする
-		err = errs.NewApplicationError("CATEGORY_ALREADY_EXISTS", "Category already exists")
+
+	generated := names.GenerateSlug(category.Name().Value(), slugFallback(category.Id().Value()))
+	slug, err = assignSlug(explicit, generated, category.Id().Value(), s.slugOwnerFinder(ctx, tx), "CATEGORY_SLUG_ALREADY_EXISTS")
+	if err != nil {
 		return nil, err
 	}
+	category.ChangeSlug(slug)
 
+	// 正規化した名前の一意制約で重複を検出する（事前の存在確認は同時実行時に競合するため行わない）
 	if err = s.repo.Create(ctx, tx, category); err != nil {
+		err = toAlreadyExistsError(err, "CATEGORY_ALREADY_EXISTS", "Category already exists")
 		return nil, err
 	}
 
@@ -89,7 +107,8 @@ func (s *CategoryServiceImpl) Add(ctx context.Context, categoryDTO *dto.CreateCa
 	return result, nil
 }
 
-// Update は既存のカテゴリ情報を更新します。
+// Update は既存のカテゴリ名を更新します。親カテゴリは変更しません。
+// カテゴリ名を変更してもスラッグは変わらず、スラッグが指定された場合のみ変更します。
 //
 // Parameters:
 //   - ctx: リクエストコンテキスト
@@ -100,11 +119,18 @@ func (s *CategoryServiceImpl) Add(ctx context.Context, categoryDTO *dto.CreateCa
 //   - error: 指定したIDのカテゴリが存在しない場合や、その他の永続化に関するエラー
 func (s *CategoryServiceImpl) Update(ctx context.Context, categoryDTO *dto.UpdateCategoryDTO) (result *dto.CategoryDTO, err error) {
 	var (
-		category *categories.Category
-		tx       *sql.Tx
+		input        *categories.Category
+		category     *categories.Category
+		translations map[names.Locale]*categories.CategoryName
+		slug         names.Slug
+		tx           *sql.Tx
 	)
 
-	category, err = dto.CategoryFromUpdateDTO(categoryDTO)
+	input, err = dto.CategoryFromUpdateDTO(categoryDTO)
+	if err != nil {
+		return nil, err
+	}
+	slug, err = dto.SlugFromDTO(categoryDTO.Slug)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +143,29 @@ func (s *CategoryServiceImpl) Update(ctx context.Context, categoryDTO *dto.Updat
 		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
 	}()
 
+	// 親カテゴリを引き継ぎ翻訳をマージするため、保存済みのカテゴリの名前を変更する
+	category, err = s.repo.FindById(ctx, tx, input.Id())
+	if err != nil {
+		return nil, err
+	}
+	category.ChangeName(input.Name())
+	translations, err = dto.CategoryTranslationsFromDTO(category.Translations(), categoryDTO.Translations)
+	if err != nil {
+		return nil, err
+	}
+	if err = category.ChangeTranslations(translations); err != nil {
+		return nil, err
+	}
+	if slug != "" && slug != category.Slug() {
+		slug, err = assignSlug(slug, "", category.Id().Value(), s.slugOwnerFinder(ctx, tx), "CATEGORY_SLUG_ALREADY_EXISTS")
+		if err != nil {
+			return nil, err
+		}
+		category.ChangeSlug(slug)
+	}
+
 	if err = s.repo.UpdateById(ctx, tx, category); err != nil {
+		err = toAlreadyExistsError(err, "CATEGORY_ALREADY_EXISTS", "Category already exists")
 		return nil, err
 	}
 
@@ -126,6 +174,7 @@ func (s *CategoryServiceImpl) Update(ctx context.Context, categoryDTO *dto.Updat
 }
 
 // Delete は指定されたカテゴリを削除します。
+// 子カテゴリを持つカテゴリは削除できません。
 //
 // Parameters:
 //   - ctx: リクエストコンテキスト
@@ -133,7 +182,7 @@ func (s *CategoryServiceImpl) Update(ctx context.Context, categoryDTO *dto.Updat
 //
 // Returns:
 //   - *dto.CategoryDTO: 削除されたカテゴリのDTO
-//   - error: 指定したIDのカテゴリが存在しない場合や、その他の永続化に関するエラー
+//   - error: 指定したIDのカテゴリが存在しない場合、子カテゴリを持つ場合や、その他の永続化に関するエラー
 func (s *CategoryServiceImpl) Delete(ctx context.Context, categoryDTO *dto.DeleteCategoryDTO) (result *dto.CategoryDTO, err error) {
 	var (
 		categoryID *categories.CategoryId
@@ -159,6 +208,15 @@ func (s *CategoryServiceImpl) Delete(ctx context.Context, categoryDTO *dto.Delet
 		return nil, err
 	}
 
+	var hasChildren bool
+	if hasChildren, err = s.repo.ExistsByParentId(ctx, tx, categoryID); err != nil {
+		return nil, err
+	}
+	if hasChildren {
+		err = errs.NewApplicationError("CATEGORY_HAS_CHILDREN", "Category has child categories")
+		return nil, err
+	}
+
 	if err = s.repo.DeleteById(ctx, tx, categoryID); err != nil {
 		return nil, err
 	}
@@ -167,4 +225,89 @@ func (s *CategoryServiceImpl) Delete(ctx context.Context, categoryDTO *dto.Delet
 	return result, nil
 }
 
+// Move は指定されたカテゴリを別の親カテゴリの下に移動します。
+// 移動するカテゴリと移動先の親カテゴリからルートまでの経路を排他ロックしてから循環を判定します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - categoryDTO: 移動するカテゴリのIDと移動先の親カテゴリID（エンプティの場合はルートに移動）
+//
+// Returns:
+//   - *dto.CategoryDTO: 移動後のカテゴリのDTO
+//   - error: カテゴリや親カテゴリが存在しない場合、移動により循環する場合や、その他の永続化に関するエラー
+func (s *CategoryServiceImpl) Move(ctx context.Context, categoryDTO *dto.MoveCategoryDTO) (result *dto.CategoryDTO, err error) {
+	var (
+		categoryId *categories.CategoryId
+		parentId   *categories.CategoryId
+		category   *categories.Category
+		tx         *sql.Tx
+	)
+
+	if categoryId, err = categories.NewCategoryId(categoryDTO.Id); err != nil {
+		return nil, err
+	}
+	if parentId, err = dto.ParentIdFromDTO(categoryDTO.ParentId); err != nil {
+		return nil, err
+	}
+
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	category, err = s.repo.LockById(ctx, tx, categoryId)
+	if err != nil {
+		err = toNotFoundError(err, "CATEGORY_NOT_FOUND", "category not found")
+		return nil, err
+	}
+
+	if err = s.moveCategory(ctx, tx, category, parentId); err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.UpdateParentById(ctx, tx, category); err != nil {
+		return nil, err
+	}
+
+	result = dto.NewCategoryDTOFromEntity(category)
+	return result, nil
+}
+
+// moveCategory は移動先の親カテゴリからルートまでの経路をロックし、カテゴリを親カテゴリの下に移動します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - tx: トランザクション
+//   - category: 移動するカテゴリ
+//   - parentId: 移動先の親カテゴリID（nilの場合はルートに移動）
+//
+// Returns:
+//   - error: 親カテゴリが存在しない場合はApplicationError (コード: PARENT_CATEGORY_NOT_FOUND)、
+//     循環する場合はDomainError (コード: CATEGORY_CYCLE)
+func (s *CategoryServiceImpl) moveCategory(ctx context.Context, tx *sql.Tx, category *categories.Category, parentId *categories.CategoryId) error {
+	var parentPath []*categories.CategoryId
+	if parentId != nil {
+		path, err := s.repo.LockPath(ctx, tx, parentId)
+		if err != nil {
+			return toNotFoundError(err, "PARENT_CATEGORY_NOT_FOUND", "parent category not found")
+		}
+		parentPath = path
+	}
+	return category.MoveTo(parentPath)
+}
+
+// slugOwnerFinder はスラッグを使用しているカテゴリのIDを取得する関数を返します。
+func (s *CategoryServiceImpl) slugOwnerFinder(ctx context.Context, tx *sql.Tx) slugOwnerFinder {
+	return func(slug names.Slug) (string, error) {
+		id, err := s.repo.FindIdBySlug(ctx, tx, slug)
+		if err != nil {
+			return "", err
+		}
+		return id.Value(), nil
+	}
+}
+
 var _ service.CategoryService = (*CategoryServiceImpl)(nil)
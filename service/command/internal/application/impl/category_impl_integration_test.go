@@ -1,7 +1,7 @@
 //go:build integration || !ci
 
 // Package impl_test provides integration tests for the application service layer.
-// These tests verify the CategoryService implementation including Add, Update, and Delete operations
+// These tests verify the CategoryService implementation including Add, Update, Delete and Move operations
 // using a real database connection. Each test case is independent with automatic cleanup
 // to ensure test isolation and prevent data pollution.
 package impl
@@ -216,4 +216,72 @@ var _ = Describe("CategoryService Integration Test", Label("IntegrationTests"),
 			Expect(crudErr.Code).To(Equal("NOT_FOUND"), "エラーコードが期待値と異なります")
 		})
 	})
+
+	Context("Moveメソッドの動作確認", func() {
+		var parentDTO, childDTO, grandChildDTO *dto.CategoryDTO
+
+		BeforeEach(func() {
+			// 親 > 子 > 孫 の3階層のカテゴリを作成（クリーンアップは子孫から実行される）
+			var err error
+			parentDTO, err = cs.Add(ctx, &dto.CreateCategoryDTO{Name: testhelpers.GenerateUniqueCategoryName()})
+			Expect(err).NotTo(HaveOccurred(), "親カテゴリの追加に失敗しました")
+			DeferCleanup(testhelpers.CleanupCategory, tm, repo, parentDTO)
+			childDTO, err = cs.Add(ctx, &dto.CreateCategoryDTO{Name: testhelpers.GenerateUniqueCategoryName(), ParentId: parentDTO.Id})
+			Expect(err).NotTo(HaveOccurred(), "子カテゴリの追加に失敗しました")
+			DeferCleanup(testhelpers.CleanupCategory, tm, repo, childDTO)
+			grandChildDTO, err = cs.Add(ctx, &dto.CreateCategoryDTO{Name: testhelpers.GenerateUniqueCategoryName(), ParentId: childDTO.Id})
+			Expect(err).NotTo(HaveOccurred(), "孫カテゴリの追加に失敗しました")
+			DeferCleanup(testhelpers.CleanupCategory, tm, repo, grandChildDTO)
+		})
+
+		It("親カテゴリを指定して追加したカテゴリが親カテゴリを持つこと", func() {
+			Expect(childDTO.ParentId).To(Equal(parentDTO.Id))
+			Expect(grandChildDTO.ParentId).To(Equal(childDTO.Id))
+		})
+
+		It("カテゴリをルートに移動し、再び親カテゴリの下に移動できること", func() {
+			result, err := cs.Move(ctx, &dto.MoveCategoryDTO{Id: grandChildDTO.Id})
+			Expect(err).NotTo(HaveOccurred(), "ルートへの移動に失敗しました")
+			Expect(result.ParentId).To(BeEmpty())
+
+			result, err = cs.Move(ctx, &dto.MoveCategoryDTO{Id: grandChildDTO.Id, ParentId: parentDTO.Id})
+			Expect(err).NotTo(HaveOccurred(), "親カテゴリの下への移動に失敗しました")
+			Expect(result.ParentId).To(Equal(parentDTO.Id))
+		})
+
+		It("子孫カテゴリの下に移動しようとするとエラーになること", func() {
+			result, err := cs.Move(ctx, &dto.MoveCategoryDTO{Id: parentDTO.Id, ParentId: grandChildDTO.Id})
+			Expect(err).To(HaveOccurred(), "子孫カテゴリの下に移動できてしまいました")
+			Expect(result).To(BeNil())
+
+			domainErr, ok := err.(*errs.DomainError)
+			Expect(ok).To(BeTrue(), "返されたエラーがDomainErrorではありません")
+			Expect(domainErr.Code).To(Equal("CATEGORY_CYCLE"), "エラーコードが期待値と異なります")
+		})
+
+		It("存在しない親カテゴリの下に移動しようとするとエラーになること", func() {
+			nonExistentName, err := categories.NewCategoryName(testhelpers.GenerateUniqueCategoryName())
+			Expect(err).NotTo(HaveOccurred())
+			nonExistentCategory, err := categories.NewCategory(nonExistentName)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := cs.Move(ctx, &dto.MoveCategoryDTO{Id: childDTO.Id, ParentId: nonExistentCategory.Id().Value()})
+			Expect(err).To(HaveOccurred())
+			Expect(result).To(BeNil())
+
+			appErr, ok := err.(*errs.ApplicationError)
+			Expect(ok).To(BeTrue(), "返されたエラーがApplicationErrorではありません")
+			Expect(appErr.Code).To(Equal("PARENT_CATEGORY_NOT_FOUND"), "エラーコードが期待値と異なります")
+		})
+
+		It("子カテゴリを持つカテゴリを削除しようとするとエラーになること", func() {
+			result, err := cs.Delete(ctx, &dto.DeleteCategoryDTO{Id: childDTO.Id})
+			Expect(err).To(HaveOccurred(), "子カテゴリを持つカテゴリを削除できてしまいました")
+			Expect(result).To(BeNil())
+
+			appErr, ok := err.(*errs.ApplicationError)
+			Expect(ok).To(BeTrue(), "返されたエラーがApplicationErrorではありません")
+			Expect(appErr.Code).To(Equal("CATEGORY_HAS_CHILDREN"), "エラーコードが期待値と異なります")
+		})
+	})
 })
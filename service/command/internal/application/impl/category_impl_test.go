@@ -3,6 +3,7 @@ package impl
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -11,6 +12,7 @@ import (
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 	mock_repository "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/mock/repository"
 	mock_service "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/mock/service"
 	. "github.com/onsi/ginkgo/v2"
@@ -63,7 +65,7 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 				// Arrange: モックの期待値を順序付きで設定
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
-					mockRepo.EXPECT().ExistsByName(ctx, mockTx, testCategory.Name()).Return(false, nil),
+					mockRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("testcategory")).Return(nil, errs.NewCRUDError("NOT_FOUND", "not found")),
 					mockRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Do(
 						func(ctx context.Context, tx *sql.Tx, category *categories.Category) {
 							Expect(category).NotTo(BeNil())
@@ -82,6 +84,58 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 			})
 		})
 
+		Context("when parent category is specified", func() {
+			It("should add the category under the parent", func() {
+				// Arrange
+				parentId, err := categories.NewCategoryId("b1524011-b6af-417e-8bf2-f449dd58b5c0")
+				Expect(err).NotTo(HaveOccurred())
+				createDTO := &dto.CreateCategoryDTO{
+					Name:     "TestCategory",
+					ParentId: parentId.Value(),
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockRepo.EXPECT().LockPath(ctx, mockTx, parentId).Return([]*categories.CategoryId{parentId}, nil),
+					mockRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("testcategory")).Return(nil, errs.NewCRUDError("NOT_FOUND", "not found")),
+					mockRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Do(
+						func(ctx context.Context, tx *sql.Tx, category *categories.Category) {
+							Expect(category.ParentId().Equals(parentId)).To(BeTrue())
+						}).Return(nil),
+					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+				)
+
+				// Act
+				result, err := cs.Add(ctx, createDTO)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.ParentId).To(Equal(parentId.Value()))
+			})
+
+			It("should return ApplicationError with PARENT_CATEGORY_NOT_FOUND code when parent does not exist", func() {
+				// Arrange
+				createDTO := &dto.CreateCategoryDTO{
+					Name:     "TestCategory",
+					ParentId: "b1524011-b6af-417e-8bf2-f449dd58b5c0",
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockRepo.EXPECT().LockPath(ctx, mockTx, gomock.Any()).
+						Return(nil, errs.NewCRUDError("NOT_FOUND", "category not found")),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+				)
+
+				// Act
+				result, err := cs.Add(ctx, createDTO)
+
+				// Assert
+				Expect(result).To(BeNil())
+				var appErr *errs.ApplicationError
+				Expect(errors.As(err, &appErr)).To(BeTrue())
+				Expect(appErr.Code).To(Equal("PARENT_CATEGORY_NOT_FOUND"))
+			})
+		})
+
 		Context("when category name already exists", func() {
 			It("should return ApplicationError with CATEGORY_ALREADY_EXISTS code", func() {
 				// Arrange
@@ -91,7 +145,9 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
-					mockRepo.EXPECT().ExistsByName(ctx, mockTx, testCategory.Name()).Return(true, nil),
+					mockRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("testcategory")).Return(nil, errs.NewCRUDError("NOT_FOUND", "not found")),
+					mockRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).
+						Return(errs.NewCRUDError("ALREADY_EXISTS", "同じ名前が既に登録されています。")),
 					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).
 						Do(func(ctx context.Context, tx *sql.Tx, err error) {
 							// Completeに渡されるエラーがApplicationErrorであることを検証
@@ -133,29 +189,6 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 			})
 		})
 
-		Context("when ExistsByName fails", func() {
-			It("should return the error and rollback", func() {
-				// Arrange
-				createDTO := &dto.CreateCategoryDTO{
-					Name: "TestCategory",
-				}
-				existsErr := fmt.Errorf("database error")
-				gomock.InOrder(
-					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
-					mockRepo.EXPECT().ExistsByName(ctx, mockTx, testCategory.Name()).Return(false, existsErr),
-					mockTm.EXPECT().Complete(ctx, mockTx, existsErr).Return(nil),
-				)
-
-				// Act
-				result, err := cs.Add(ctx, createDTO)
-
-				// Assert
-				Expect(err).To(HaveOccurred())
-				Expect(result).To(BeNil())
-				Expect(err).To(Equal(existsErr))
-			})
-		})
-
 		Context("when Create fails", func() {
 			It("should return the error and rollback", func() {
 				// Arrange
@@ -165,7 +198,7 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 				createErr := errs.NewCRUDError("DB_ERROR", "failed to create category")
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
-					mockRepo.EXPECT().ExistsByName(ctx, mockTx, testCategory.Name()).Return(false, nil),
+					mockRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("testcategory")).Return(nil, errs.NewCRUDError("NOT_FOUND", "not found")),
 					mockRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Do(
 						func(ctx context.Context, tx *sql.Tx, category *categories.Category) {
 							Expect(category).NotTo(BeNil())
@@ -193,7 +226,7 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 				commitErr := fmt.Errorf("commit failed")
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
-					mockRepo.EXPECT().ExistsByName(ctx, mockTx, testCategory.Name()).Return(false, nil),
+					mockRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("testcategory")).Return(nil, errs.NewCRUDError("NOT_FOUND", "not found")),
 					mockRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Return(nil),
 					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(commitErr),
 				)
@@ -219,6 +252,7 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 				}
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockRepo.EXPECT().FindById(ctx, mockTx, gomock.Any()).Return(testCategory, nil),
 					mockRepo.EXPECT().UpdateById(ctx, mockTx, gomock.Any()).Return(nil),
 					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
 				)
@@ -243,6 +277,7 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 				updateErr := errs.NewCRUDError("NOT_FOUND", "category not found")
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockRepo.EXPECT().FindById(ctx, mockTx, gomock.Any()).Return(testCategory, nil),
 					mockRepo.EXPECT().UpdateById(ctx, mockTx, gomock.Any()).Return(updateErr),
 					mockTm.EXPECT().Complete(ctx, mockTx, updateErr).Return(nil),
 				)
@@ -257,6 +292,33 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 			})
 		})
 
+		Context("when updated name already exists", func() {
+			It("should return ApplicationError with CATEGORY_ALREADY_EXISTS code", func() {
+				// Arrange
+				updateDTO := &dto.UpdateCategoryDTO{
+					Id:   testCategory.Id().Value(),
+					Name: "UpdatedCategory",
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockRepo.EXPECT().FindById(ctx, mockTx, gomock.Any()).Return(testCategory, nil),
+					mockRepo.EXPECT().UpdateById(ctx, mockTx, gomock.Any()).
+						Return(errs.NewCRUDError("ALREADY_EXISTS", "同じ名前が既に登録されています。")),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+				)
+
+				// Act
+				result, err := cs.Update(ctx, updateDTO)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+				Expect(err).To(BeAssignableToTypeOf(&errs.ApplicationError{}))
+				appErr := err.(*errs.ApplicationError)
+				Expect(appErr.Code).To(Equal("CATEGORY_ALREADY_EXISTS"))
+			})
+		})
+
 		Context("when Begin fails", func() {
 			It("should return the error from Begin", func() {
 				// Arrange
@@ -288,6 +350,7 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
 					mockRepo.EXPECT().FindById(ctx, mockTx, testCategory.Id()).Return(testCategory, nil),
+					mockRepo.EXPECT().ExistsByParentId(ctx, mockTx, testCategory.Id()).Return(false, nil),
 					mockRepo.EXPECT().DeleteById(ctx, mockTx, testCategory.Id()).Return(nil),
 					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
 				)
@@ -302,6 +365,30 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 			})
 		})
 
+		Context("when category has children", func() {
+			It("should return ApplicationError with CATEGORY_HAS_CHILDREN code", func() {
+				// Arrange
+				deleteDTO := &dto.DeleteCategoryDTO{
+					Id: testCategory.Id().Value(),
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockRepo.EXPECT().FindById(ctx, mockTx, testCategory.Id()).Return(testCategory, nil),
+					mockRepo.EXPECT().ExistsByParentId(ctx, mockTx, testCategory.Id()).Return(true, nil),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+				)
+
+				// Act
+				result, err := cs.Delete(ctx, deleteDTO)
+
+				// Assert
+				Expect(result).To(BeNil())
+				var appErr *errs.ApplicationError
+				Expect(errors.As(err, &appErr)).To(BeTrue())
+				Expect(appErr.Code).To(Equal("CATEGORY_HAS_CHILDREN"))
+			})
+		})
+
 		Context("when DeleteById fails", func() {
 			It("should return the error and rollback", func() {
 				// Arrange
@@ -344,4 +431,144 @@ var _ = Describe("CategoryService", Label("UnitTests"), func() {
 			})
 		})
 	})
+
+	Describe("Move", func() {
+		var (
+			parentId      *categories.CategoryId
+			grandParentId *categories.CategoryId
+		)
+
+		BeforeEach(func() {
+			var err error
+			parentId, err = categories.NewCategoryId("b1524011-b6af-417e-8bf2-f449dd58b5c0")
+			Expect(err).NotTo(HaveOccurred())
+			grandParentId, err = categories.NewCategoryId("762cc7b5-8bc1-4ba7-b2f0-2d8a6b6f6e57")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when parent category exists", func() {
+			It("should move the category under the parent", func() {
+				// Arrange
+				moveDTO := &dto.MoveCategoryDTO{
+					Id:       testCategory.Id().Value(),
+					ParentId: parentId.Value(),
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockRepo.EXPECT().LockById(ctx, mockTx, testCategory.Id()).Return(testCategory, nil),
+					mockRepo.EXPECT().LockPath(ctx, mockTx, parentId).Return([]*categories.CategoryId{parentId, grandParentId}, nil),
+					mockRepo.EXPECT().UpdateParentById(ctx, mockTx, testCategory).Return(nil),
+					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+				)
+
+				// Act
+				result, err := cs.Move(ctx, moveDTO)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Id).To(Equal(testCategory.Id().Value()))
+				Expect(result.ParentId).To(Equal(parentId.Value()))
+			})
+		})
+
+		Context("when parent category is not specified", func() {
+			It("should move the category to the root", func() {
+				// Arrange
+				child, err := categories.BuildCategory(testCategory.Id(), testCategory.Name(), parentId)
+				Expect(err).NotTo(HaveOccurred())
+				moveDTO := &dto.MoveCategoryDTO{
+					Id: child.Id().Value(),
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockRepo.EXPECT().LockById(ctx, mockTx, child.Id()).Return(child, nil),
+					mockRepo.EXPECT().UpdateParentById(ctx, mockTx, child).Return(nil),
+					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+				)
+
+				// Act
+				result, err := cs.Move(ctx, moveDTO)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.ParentId).To(BeEmpty())
+			})
+		})
+
+		Context("when the parent is a descendant of the category", func() {
+			It("should return DomainError with CATEGORY_CYCLE code", func() {
+				// Arrange
+				moveDTO := &dto.MoveCategoryDTO{
+					Id:       testCategory.Id().Value(),
+					ParentId: parentId.Value(),
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockRepo.EXPECT().LockById(ctx, mockTx, testCategory.Id()).Return(testCategory, nil),
+					mockRepo.EXPECT().LockPath(ctx, mockTx, parentId).Return([]*categories.CategoryId{parentId, testCategory.Id()}, nil),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+				)
+
+				// Act
+				result, err := cs.Move(ctx, moveDTO)
+
+				// Assert
+				Expect(result).To(BeNil())
+				var domainErr *errs.DomainError
+				Expect(errors.As(err, &domainErr)).To(BeTrue())
+				Expect(domainErr.Code).To(Equal("CATEGORY_CYCLE"))
+			})
+		})
+
+		Context("when the category does not exist", func() {
+			It("should return ApplicationError with CATEGORY_NOT_FOUND code", func() {
+				// Arrange
+				moveDTO := &dto.MoveCategoryDTO{
+					Id:       testCategory.Id().Value(),
+					ParentId: parentId.Value(),
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockRepo.EXPECT().LockById(ctx, mockTx, testCategory.Id()).
+						Return(nil, errs.NewCRUDError("NOT_FOUND", "category not found")),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+				)
+
+				// Act
+				result, err := cs.Move(ctx, moveDTO)
+
+				// Assert
+				Expect(result).To(BeNil())
+				var appErr *errs.ApplicationError
+				Expect(errors.As(err, &appErr)).To(BeTrue())
+				Expect(appErr.Code).To(Equal("CATEGORY_NOT_FOUND"))
+			})
+		})
+
+		Context("when the parent category does not exist", func() {
+			It("should return ApplicationError with PARENT_CATEGORY_NOT_FOUND code", func() {
+				// Arrange
+				moveDTO := &dto.MoveCategoryDTO{
+					Id:       testCategory.Id().Value(),
+					ParentId: parentId.Value(),
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockRepo.EXPECT().LockById(ctx, mockTx, testCategory.Id()).Return(testCategory, nil),
+					mockRepo.EXPECT().LockPath(ctx, mockTx, parentId).
+						Return(nil, errs.NewCRUDError("NOT_FOUND", "category not found")),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+				)
+
+				// Act
+				result, err := cs.Move(ctx, moveDTO)
+
+				// Assert
+				Expect(result).To(BeNil())
+				var appErr *errs.ApplicationError
+				Expect(errors.As(err, &appErr)).To(BeTrue())
+				Expect(appErr.Code).To(Equal("PARENT_CATEGORY_NOT_FOUND"))
+			})
+		})
+	})
 })
@@ -0,0 +1,41 @@
+package impl
+
+import (
+	"errors"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// toAlreadyExistsError は名前の一意制約違反を表すCRUDErrorをアプリケーションエラーに変換します。
+//
+// Parameters:
+//   - err: リポジトリから返されたエラー
+//   - code: 重複時のアプリケーションエラーコード
+//   - message: 重複時のエラーメッセージ
+//
+// Returns:
+//   - error: 重複の場合はApplicationError、それ以外は元のエラー
+func toAlreadyExistsError(err error, code string, message string) error {
+	var crudErr *errs.CRUDError
+	if errors.As(err, &crudErr) && crudErr.Code == "ALREADY_EXISTS" {
+		return errs.NewApplicationErrorWithCause(code, message, err)
+	}
+	return err
+}
+
+// toNotFoundError はレコードが存在しないことを表すCRUDErrorをアプリケーションエラーに変換します。
+//
+// Parameters:
+//   - err: リポジトリから返されたエラー
+//   - code: 存在しない場合のアプリケーションエラーコード
+//   - message: 存在しない場合のエラーメッセージ
+//
+// Returns:
+//   - error: 存在しない場合はApplicationError、それ以外は元のエラー
+func toNotFoundError(err error, code string, message string) error {
+	var crudErr *errs.CRUDError
+	if errors.As(err, &crudErr) && crudErr.Code == "NOT_FOUND" {
+		return errs.NewApplicationErrorWithCause(code, message, err)
+	}
+	return err
+}
@@ -0,0 +1,318 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/pricing"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// PriceScheduleServiceImpl は価格スケジュールサービスの実装です。
+// 商品行、価格スケジュール行の順にロックしてから状態を変更するため、
+// 複数のインスタンスで同時に処理しても価格スケジュールの適用・終了は1回だけ行われます。
+type PriceScheduleServiceImpl struct {
+	scheduleRepo pricing.PriceScheduleRepository // 価格スケジュールの永続化
+	productRepo  products.ProductRepository      // 商品の単価の更新
+	tm           service.TransactionManager      // トランザクション管理
+	logger       *slog.Logger                    // 構造化ログ出力
+	now          func() time.Time                // 現在時刻（テストで差し替え可能）
+}
+
+// NewPriceScheduleServiceImpl は新しいPriceScheduleServiceImplインスタンスを生成します。
+//
+// Parameters:
+//   - logger: 構造化ログ出力用のロガー
+//   - scheduleRepo: 価格スケジュールの永続化を担うリポジトリ
+//   - productRepo: 商品の単価の更新を担うリポジトリ
+//   - tm: トランザクション管理を担うマネージャー
+//
+// Returns:
+//   - *PriceScheduleServiceImpl: 初期化された価格スケジュールサービス実装
+func NewPriceScheduleServiceImpl(logger *slog.Logger, scheduleRepo pricing.PriceScheduleRepository, productRepo products.ProductRepository, tm service.TransactionManager) *PriceScheduleServiceImpl {
+	return &PriceScheduleServiceImpl{
+		scheduleRepo: scheduleRepo,
+		productRepo:  productRepo,
+		tm:           tm,
+		logger:       logger,
+		now:          func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Schedule は商品に価格スケジュールを登録します。
+// 同じ商品の予定または適用中の価格スケジュールと期間が重なる場合は登録しません。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - scheduleDTO: 商品と期間中の単価、期間
+//
+// Returns:
+//   - *dto.PriceScheduleDTO: 登録された価格スケジュール
+//   - error: エラー情報
+func (s *PriceScheduleServiceImpl) Schedule(ctx context.Context, scheduleDTO *dto.SchedulePriceDTO) (result *dto.PriceScheduleDTO, err error) {
+	var (
+		tx        *sql.Tx
+		productId *products.ProductId
+		schedule  *pricing.PriceSchedule
+		existing  []*pricing.PriceSchedule
+	)
+
+	productId, err = products.NewProductId(scheduleDTO.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err = pricing.NewPriceSchedule(productId, scheduleDTO.Price, scheduleDTO.StartsAt.UTC(), scheduleDTO.EndsAt.UTC(), s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	// 同じ商品への登録を直列化し、期間の重なりを確実に検出するため商品をロックする
+	if _, err = s.lockProduct(ctx, tx, productId); err != nil {
+		return nil, err
+	}
+	existing, err = s.scheduleRepo.FindPendingByProductId(ctx, tx, productId)
+	if err != nil {
+		return nil, err
+	}
+	if err = pricing.CheckOverlap(schedule, existing); err != nil {
+		return nil, err
+	}
+	if err = s.scheduleRepo.Create(ctx, tx, schedule); err != nil {
+		return nil, err
+	}
+
+	result = dto.NewPriceScheduleDTOFromEntity(schedule)
+	return result, nil
+}
+
+// Cancel は価格スケジュールを取り消します。適用中の場合は商品の単価を適用前に戻します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - cancelDTO: 取り消す価格スケジュール
+//
+// Returns:
+//   - *dto.PriceScheduleDTO: 取り消された価格スケジュール
+//   - error: エラー情報
+func (s *PriceScheduleServiceImpl) Cancel(ctx context.Context, cancelDTO *dto.CancelPriceScheduleDTO) (result *dto.PriceScheduleDTO, err error) {
+	var (
+		tx         *sql.Tx
+		productId  *products.ProductId
+		scheduleId *pricing.PriceScheduleId
+		product    *products.Product
+		schedule   *pricing.PriceSchedule
+	)
+
+	productId, err = products.NewProductId(cancelDTO.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	scheduleId, err = pricing.NewPriceScheduleId(cancelDTO.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	product, err = s.lockProduct(ctx, tx, productId)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err = s.scheduleRepo.LockById(ctx, tx, scheduleId)
+	if err != nil {
+		err = toNotFoundError(err, "PRICE_SCHEDULE_NOT_FOUND", "Price schedule not found")
+		return nil, err
+	}
+	if !schedule.ProductId().Equals(productId) {
+		err = errs.NewApplicationError("PRICE_SCHEDULE_NOT_FOUND", "Price schedule not found")
+		return nil, err
+	}
+
+	before := product.Price().Value()
+	if err = schedule.Cancel(product); err != nil {
+		return nil, err
+	}
+	if err = s.save(ctx, tx, product, schedule, product.Price().Value() != before); err != nil {
+		return nil, err
+	}
+
+	result = dto.NewPriceScheduleDTOFromEntity(schedule)
+	return result, nil
+}
+
+// ApplyDue は開始時刻を過ぎた価格スケジュールを適用し、終了時刻を過ぎた価格スケジュールを終了します。
+// 価格スケジュールごとに個別のトランザクションで処理し、一部で失敗しても残りの処理を続けます。
+// 状態は単価の変更と同じトランザクションで永続化するため、再起動後も適用・終了は1回だけ行われます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - limit: 1回の処理で対象とする価格スケジュール数の上限
+//
+// Returns:
+//   - int: 適用または終了した価格スケジュールの件数
+//   - error: 失敗した価格スケジュールのエラーをまとめたエラー
+func (s *PriceScheduleServiceImpl) ApplyDue(ctx context.Context, limit int) (int, error) {
+	now := s.now()
+	ids, err := s.findDueIds(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	var applyErrors []error
+	for _, id := range ids {
+		advanced, err := s.advance(ctx, id, now)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "価格スケジュールの適用に失敗しました",
+				slog.String("price_schedule_id", id.Value()), slog.Any("error", err))
+			applyErrors = append(applyErrors, err)
+			continue
+		}
+		if advanced {
+			applied++
+		}
+	}
+	return applied, errors.Join(applyErrors...)
+}
+
+// findDueIds は処理が必要な価格スケジュールIDを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - now: 現在時刻
+//   - limit: 取得する件数の上限
+//
+// Returns:
+//   - []*pricing.PriceScheduleId: 価格スケジュールIDのリスト
+//   - error: エラー情報
+func (s *PriceScheduleServiceImpl) findDueIds(ctx context.Context, now time.Time, limit int) (result []*pricing.PriceScheduleId, err error) {
+	var tx *sql.Tx
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	result, err = s.scheduleRepo.FindDueIds(ctx, tx, now, limit)
+	return result, err
+}
+
+// advance は1つの価格スケジュールを適用または終了します。
+// ロックを取得した時点で処理が不要になっていた場合（他のインスタンスで処理済み、取消済み）は何もしません。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: 価格スケジュールID
+//   - now: 現在時刻
+//
+// Returns:
+//   - bool: 適用または終了した場合はtrue
+//   - error: エラー情報
+func (s *PriceScheduleServiceImpl) advance(ctx context.Context, id *pricing.PriceScheduleId, now time.Time) (result bool, err error) {
+	var (
+		tx       *sql.Tx
+		product  *products.Product
+		schedule *pricing.PriceSchedule
+	)
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	// 商品行を先にロックするため、ロックせずに価格スケジュールの商品IDを取得する
+	schedule, err = s.scheduleRepo.FindById(ctx, tx, id)
+	if err != nil {
+		return false, err
+	}
+	product, err = s.productRepo.LockById(ctx, tx, schedule.ProductId())
+	if err != nil {
+		return false, err
+	}
+	schedule, err = s.scheduleRepo.LockById(ctx, tx, id)
+	if err != nil {
+		return false, err
+	}
+	if !schedule.IsDue(now) {
+		return false, nil
+	}
+
+	before := product.Price().Value()
+	if err = schedule.Advance(product, now); err != nil {
+		return false, err
+	}
+	if err = s.save(ctx, tx, product, schedule, product.Price().Value() != before); err != nil {
+		return false, err
+	}
+	s.logger.InfoContext(ctx, "価格スケジュールを進めました",
+		slog.String("price_schedule_id", id.Value()),
+		slog.String("product_id", schedule.ProductId().Value()),
+		slog.String("status", string(schedule.Status())),
+		slog.Uint64("price", uint64(product.Price().Value())),
+	)
+	return true, nil
+}
+
+// save は価格スケジュールと、単価を変更した場合は商品を永続化します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - product: ロック済みの商品
+//   - schedule: ロック済みの価格スケジュール
+//   - priceChanged: 商品の単価を変更したかどうか
+//
+// Returns:
+//   - error: エラー情報
+func (s *PriceScheduleServiceImpl) save(ctx context.Context, tx *sql.Tx, product *products.Product, schedule *pricing.PriceSchedule, priceChanged bool) error {
+	if priceChanged {
+		if err := s.productRepo.UpdateById(ctx, tx, product); err != nil {
+			return err
+		}
+	}
+	return s.scheduleRepo.Update(ctx, tx, schedule)
+}
+
+// lockProduct は価格スケジュールの対象の商品を排他ロックして取得します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//
+// Returns:
+//   - *products.Product: ロックした商品
+//   - error: 商品が存在しない場合はApplicationError (コード: PRODUCT_NOT_FOUND)、その他のエラー
+func (s *PriceScheduleServiceImpl) lockProduct(ctx context.Context, tx *sql.Tx, productId *products.ProductId) (*products.Product, error) {
+	product, err := s.productRepo.LockById(ctx, tx, productId)
+	if err != nil {
+		return nil, toNotFoundError(err, "PRODUCT_NOT_FOUND", "Product not found")
+	}
+	return product, nil
+}
+
+var _ service.PriceScheduleService = (*PriceScheduleServiceImpl)(nil)
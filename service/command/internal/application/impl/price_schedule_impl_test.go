@@ -0,0 +1,249 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/pricing"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	mock_repository "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/mock/repository"
+	mock_service "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/mock/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("PriceScheduleService", Label("UnitTests"), func() {
+	var (
+		ctrl             *gomock.Controller
+		mockScheduleRepo *mock_repository.MockPriceScheduleRepository
+		mockProductRepo  *mock_repository.MockProductRepository
+		mockTm           *mock_service.MockTransactionManager
+		ps               *PriceScheduleServiceImpl
+		ctx              context.Context
+		mockTx           *sql.Tx
+		now              time.Time
+		product          *products.Product
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockScheduleRepo = mock_repository.NewMockPriceScheduleRepository(ctrl)
+		mockProductRepo = mock_repository.NewMockProductRepository(ctrl)
+		mockTm = mock_service.NewMockTransactionManager(ctrl)
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		ps = NewPriceScheduleServiceImpl(logger, mockScheduleRepo, mockProductRepo, mockTm)
+		now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		ps.now = func() time.Time { return now }
+		ctx = context.Background()
+		mockTx = &sql.Tx{}
+
+		name, err := products.NewProductName("TestProduct")
+		Expect(err).NotTo(HaveOccurred())
+		price, err := products.NewProductPrice(1000)
+		Expect(err).NotTo(HaveOccurred())
+		categoryName, err := categories.NewCategoryName("TestCategory")
+		Expect(err).NotTo(HaveOccurred())
+		category, err := categories.NewCategory(categoryName)
+		Expect(err).NotTo(HaveOccurred())
+		product, err = products.NewProduct(name, price, category)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	// buildSchedule はテスト用の価格スケジュールを生成します。
+	buildSchedule := func(regularPrice uint32, status pricing.PriceScheduleStatus, startsAt time.Time, endsAt time.Time) *pricing.PriceSchedule {
+		id, err := pricing.NewPriceScheduleId(uuid.NewString())
+		Expect(err).NotTo(HaveOccurred())
+		schedule, err := pricing.BuildPriceSchedule(id, product.Id(), 800, regularPrice, status, startsAt, endsAt)
+		Expect(err).NotTo(HaveOccurred())
+		return schedule
+	}
+
+	// expectAppError はエラーが指定したコードのApplicationErrorであることを検証します。
+	expectAppError := func(err error, code string) {
+		Expect(err).To(HaveOccurred())
+		var appErr *errs.ApplicationError
+		Expect(errors.As(err, &appErr)).To(BeTrue())
+		Expect(appErr.Code).To(Equal(code))
+	}
+
+	// expectDomainError はエラーが指定したコードのDomainErrorであることを検証します。
+	expectDomainError := func(err error, code string) {
+		Expect(err).To(HaveOccurred())
+		var domainErr *errs.DomainError
+		Expect(errors.As(err, &domainErr)).To(BeTrue())
+		Expect(domainErr.Code).To(Equal(code))
+	}
+
+	Describe("Schedule", func() {
+		It("商品をロックし、期間が重ならない場合に価格スケジュールを登録すること", func() {
+			existing := buildSchedule(0, pricing.PRICE_SCHEDULE_SCHEDULED, now.Add(3*time.Hour), now.Add(4*time.Hour))
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, product.Id()).Return(product, nil),
+				mockScheduleRepo.EXPECT().FindPendingByProductId(ctx, mockTx, product.Id()).Return([]*pricing.PriceSchedule{existing}, nil),
+				mockScheduleRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ps.Schedule(ctx, &dto.SchedulePriceDTO{
+				ProductId: product.Id().Value(),
+				Price:     800,
+				StartsAt:  now.Add(time.Hour),
+				EndsAt:    now.Add(2 * time.Hour),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ProductId).To(Equal(product.Id().Value()))
+			Expect(result.Price).To(Equal(uint32(800)))
+			Expect(result.Status).To(Equal("SCHEDULED"))
+		})
+
+		It("期間が重なる価格スケジュールがある場合はPRICE_SCHEDULE_OVERLAPエラーを返すこと", func() {
+			existing := buildSchedule(0, pricing.PRICE_SCHEDULE_SCHEDULED, now.Add(90*time.Minute), now.Add(3*time.Hour))
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, product.Id()).Return(product, nil),
+				mockScheduleRepo.EXPECT().FindPendingByProductId(ctx, mockTx, product.Id()).Return([]*pricing.PriceSchedule{existing}, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ps.Schedule(ctx, &dto.SchedulePriceDTO{
+				ProductId: product.Id().Value(),
+				Price:     800,
+				StartsAt:  now.Add(time.Hour),
+				EndsAt:    now.Add(2 * time.Hour),
+			})
+
+			expectDomainError(err, "PRICE_SCHEDULE_OVERLAP")
+			Expect(result).To(BeNil())
+		})
+
+		It("終了時刻が開始時刻以前の場合はトランザクションを開始せずにINVALID_ARGUMENTエラーを返すこと", func() {
+			result, err := ps.Schedule(ctx, &dto.SchedulePriceDTO{
+				ProductId: product.Id().Value(),
+				Price:     800,
+				StartsAt:  now.Add(2 * time.Hour),
+				EndsAt:    now.Add(time.Hour),
+			})
+
+			expectDomainError(err, "INVALID_ARGUMENT")
+			Expect(result).To(BeNil())
+		})
+	})
+
+	Describe("Cancel", func() {
+		It("適用中の価格スケジュールを取り消し、商品の単価を適用前に戻すこと", func() {
+			schedule := buildSchedule(1000, pricing.PRICE_SCHEDULE_ACTIVE, now.Add(-time.Hour), now.Add(time.Hour))
+			salePrice, err := products.NewProductPrice(800)
+			Expect(err).NotTo(HaveOccurred())
+			product.ChangePrice(salePrice)
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, product.Id()).Return(product, nil),
+				mockScheduleRepo.EXPECT().LockById(ctx, mockTx, schedule.Id()).Return(schedule, nil),
+				mockProductRepo.EXPECT().UpdateById(ctx, mockTx, product).Return(nil),
+				mockScheduleRepo.EXPECT().Update(ctx, mockTx, schedule).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ps.Cancel(ctx, &dto.CancelPriceScheduleDTO{ProductId: product.Id().Value(), Id: schedule.Id().Value()})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal("CANCELLED"))
+			Expect(product.Price().Value()).To(Equal(uint32(1000)))
+		})
+
+		It("別の商品の価格スケジュールの場合はPRICE_SCHEDULE_NOT_FOUNDエラーを返すこと", func() {
+			otherId, err := products.NewProductId(uuid.NewString())
+			Expect(err).NotTo(HaveOccurred())
+			id, err := pricing.NewPriceScheduleId(uuid.NewString())
+			Expect(err).NotTo(HaveOccurred())
+			other, err := pricing.BuildPriceSchedule(id, otherId, 800, 0, pricing.PRICE_SCHEDULE_SCHEDULED, now.Add(time.Hour), now.Add(2*time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, product.Id()).Return(product, nil),
+				mockScheduleRepo.EXPECT().LockById(ctx, mockTx, other.Id()).Return(other, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ps.Cancel(ctx, &dto.CancelPriceScheduleDTO{ProductId: product.Id().Value(), Id: other.Id().Value()})
+
+			expectAppError(err, "PRICE_SCHEDULE_NOT_FOUND")
+			Expect(result).To(BeNil())
+		})
+	})
+
+	Describe("ApplyDue", func() {
+		It("価格スケジュールごとに商品、価格スケジュールの順にロックして適用し、失敗した価格スケジュールがあっても処理を続けること", func() {
+			failing := buildSchedule(0, pricing.PRICE_SCHEDULE_SCHEDULED, now.Add(-time.Minute), now.Add(time.Hour))
+			due := buildSchedule(0, pricing.PRICE_SCHEDULE_SCHEDULED, now.Add(-time.Minute), now.Add(time.Hour))
+			listTx := &sql.Tx{}
+			failingTx := &sql.Tx{}
+			lockErr := errors.New("lock wait timeout")
+
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(listTx, nil),
+				mockScheduleRepo.EXPECT().FindDueIds(ctx, listTx, now, 10).Return([]*pricing.PriceScheduleId{failing.Id(), due.Id()}, nil),
+				mockTm.EXPECT().Complete(ctx, listTx, nil).Return(nil),
+				mockTm.EXPECT().Begin(ctx).Return(failingTx, nil),
+				mockScheduleRepo.EXPECT().FindById(ctx, failingTx, failing.Id()).Return(failing, nil),
+				mockProductRepo.EXPECT().LockById(ctx, failingTx, product.Id()).Return(nil, lockErr),
+				mockTm.EXPECT().Complete(ctx, failingTx, lockErr).Return(nil),
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockScheduleRepo.EXPECT().FindById(ctx, mockTx, due.Id()).Return(due, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, product.Id()).Return(product, nil),
+				mockScheduleRepo.EXPECT().LockById(ctx, mockTx, due.Id()).Return(due, nil),
+				mockProductRepo.EXPECT().UpdateById(ctx, mockTx, product).Return(nil),
+				mockScheduleRepo.EXPECT().Update(ctx, mockTx, due).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			count, err := ps.ApplyDue(ctx, 10)
+
+			Expect(err).To(MatchError(lockErr))
+			Expect(count).To(Equal(1))
+			Expect(due.Status()).To(Equal(pricing.PRICE_SCHEDULE_ACTIVE))
+			Expect(due.RegularPrice()).To(Equal(uint32(1000)))
+			Expect(product.Price().Value()).To(Equal(uint32(800)))
+		})
+
+		It("ロックを取得した時点で処理済みの価格スケジュールは適用しないこと", func() {
+			listed := buildSchedule(0, pricing.PRICE_SCHEDULE_SCHEDULED, now.Add(-time.Minute), now.Add(time.Hour))
+			// 他のインスタンスが先に適用した後の状態
+			locked, err := pricing.BuildPriceSchedule(listed.Id(), product.Id(), 800, 1000, pricing.PRICE_SCHEDULE_ACTIVE, listed.StartsAt(), listed.EndsAt())
+			Expect(err).NotTo(HaveOccurred())
+			listTx := &sql.Tx{}
+
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(listTx, nil),
+				mockScheduleRepo.EXPECT().FindDueIds(ctx, listTx, now, 10).Return([]*pricing.PriceScheduleId{listed.Id()}, nil),
+				mockTm.EXPECT().Complete(ctx, listTx, nil).Return(nil),
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockScheduleRepo.EXPECT().FindById(ctx, mockTx, listed.Id()).Return(listed, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, product.Id()).Return(product, nil),
+				mockScheduleRepo.EXPECT().LockById(ctx, mockTx, listed.Id()).Return(locked, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			count, err := ps.ApplyDue(ctx, 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(0))
+			Expect(product.Price().Value()).To(Equal(uint32(1000)))
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package impl
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
+)
+
+// PriceScheduler は開始・終了時刻を過ぎた価格スケジュールを定期的に適用・終了するバックグラウンド処理です。
+// 停止中に開始・終了時刻を過ぎた価格スケジュールを反映するため、起動直後にも1回実行します。
+type PriceScheduler struct {
+	scheduleService service.PriceScheduleService // 価格スケジュールサービス
+	interval        time.Duration                // 実行間隔
+	batchSize       int                          // 1回の処理で対象とする価格スケジュール数の上限
+	logger          *slog.Logger                 // 構造化ログ出力
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPriceScheduler は新しいPriceSchedulerインスタンスを生成します。
+//
+// Parameters:
+//   - logger: 構造化ログ出力用のロガー
+//   - scheduleService: 価格スケジュールサービス
+//   - interval: 実行間隔
+//   - batchSize: 1回の処理で対象とする価格スケジュール数の上限
+//
+// Returns:
+//   - *PriceScheduler: 初期化されたPriceScheduler
+func NewPriceScheduler(logger *slog.Logger, scheduleService service.PriceScheduleService, interval time.Duration, batchSize int) *PriceScheduler {
+	return &PriceScheduler{
+		scheduleService: scheduleService,
+		interval:        interval,
+		batchSize:       batchSize,
+		logger:          logger,
+	}
+}
+
+// Start は定期実行を別のゴルーチンで開始します。
+func (s *PriceScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.Run(ctx)
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.Run(ctx)
+			}
+		}
+	}()
+}
+
+// Stop は定期実行を停止し、実行中の処理の完了を待ちます。
+func (s *PriceScheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+// Run は開始・終了時刻を過ぎた価格スケジュールを1回分適用・終了します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+func (s *PriceScheduler) Run(ctx context.Context) {
+	applied, err := s.scheduleService.ApplyDue(ctx, s.batchSize)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "価格スケジュールの適用処理でエラーが発生しました", slog.Any("error", err))
+	}
+	if applied > 0 {
+		s.logger.InfoContext(ctx, "価格スケジュールを適用・終了しました", slog.Int("count", applied))
+	}
+}
@@ -5,11 +5,13 @@ import (
 	"database/sql"
 	"log/slog"
 
-	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/pricing"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/tags"
 )
 
 // ProductServiceImpl は商品サービスの実装です。
@@ -41,7 +43,8 @@ func NewProductServiceImpl(logger *slog.Logger, productRepo products.ProductRepo
 }
 
 // Add は新しい商品を追加します。
-// 商品名の重複チェックを行い、トランザクション内で作成処理を実行します。
+// トランザクション内で作成処理を実行し、商品名の重複は正規化キーの一意制約で検出します。
+// スラッグが指定されない場合は商品名から生成し、使用済みの場合は連番を付けて一意にします。
 //
 // Parameters:
 //   - ctx: リクエストコンテキスト
@@ -52,15 +55,20 @@ func NewProductServiceImpl(logger *slog.Logger, productRepo products.ProductRepo
 //   - error: エラー情報
 func (s *ProductServiceImpl) Add(ctx context.Context, productDTO *dto.CreateProductDTO) (result *dto.ProductDTO, err error) {
 	var (
-		tx      *sql.Tx
-		product *products.Product
-		exists  bool
+		tx       *sql.Tx
+		product  *products.Product
+		explicit names.Slug
+		slug     names.Slug
 	)
 
 	product, err = dto.ProductFromCreateDTO(productDTO)
 	if err != nil {
 		return nil, err
 	}
+	explicit, err = dto.SlugFromDTO(productDTO.Slug)
+	if err != nil {
+		return nil, err
+	}
 
 	tx, err = s.tm.Begin(ctx)
 	if err != nil {
@@ -71,19 +79,16 @@ func (s *ProductServiceImpl) Add(ctx context.Context, productDTO *dto.CreateProd
 		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
 	}()
 
-	// categoryが既に存在するかチェック
-
-	exists, err = s.productRepo.ExistsByName(ctx, tx, product.Name())
+	generated := names.GenerateSlug(product.Name().Value(), slugFallback(product.Id().Value()))
+	slug, err = assignSlug(explicit, generated, product.Id().Value(), s.slugOwnerFinder(ctx, tx), "PRODUCT_SLUG_ALREADY_EXISTS")
 	if err != nil {
 		return nil, err
 	}
-	if exists {
-		// defer内で評価されるerrに代入し、トランザクション完了時にロールバックされるようにする
-		err = errs.NewApplicationError("PRODUCT_ALREADY_EXISTS", "Product already exists")
-		return nil, err
-	}
+	product.ChangeSlug(slug)
 
+	// 正規化した名前の一意制約で重複を検出する（事前の存在確認は同時実行時に競合するため行わない）
 	if err = s.productRepo.Create(ctx, tx, product); err != nil {
+		err = toAlreadyExistsError(err, "PRODUCT_ALREADY_EXISTS", "Product already exists")
 		return nil, err
 	}
 
@@ -92,7 +97,8 @@ func (s *ProductServiceImpl) Add(ctx context.Context, productDTO *dto.CreateProd
 }
 
 // Update は既存の商品情報を更新します。
-// カテゴリ情報を取得してからトランザクション内で更新処理を実行します。
+// トランザクション内で更新前の商品とカテゴリ情報を取得してから更新処理を実行します。
+// 商品名を変更してもスラッグは変わらず、スラッグが指定された場合のみ変更します。
 //
 // Parameters:
 //   - ctx: リクエストコンテキスト
@@ -104,11 +110,23 @@ func (s *ProductServiceImpl) Add(ctx context.Context, productDTO *dto.CreateProd
 func (s *ProductServiceImpl) Update(ctx context.Context, productDTO *dto.UpdateProductDTO) (result *dto.ProductDTO, err error) {
 	var (
 		tx         *sql.Tx
+		productId  *products.ProductId
 		categoryId *categories.CategoryId
 		category   *categories.Category
+		current    *products.Product
 		product    *products.Product
+		slug       names.Slug
 	)
 
+	productId, err = products.NewProductId(productDTO.Id)
+	if err != nil {
+		return nil, err
+	}
+	slug, err = dto.SlugFromDTO(productDTO.Slug)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err = s.tm.Begin(ctx)
 	if err != nil {
 		return nil, err
@@ -117,6 +135,12 @@ func (s *ProductServiceImpl) Update(ctx context.Context, productDTO *dto.UpdateP
 		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
 	}()
 
+	// 通貨・税率区分の引き継ぎと翻訳のマージのため、更新前の商品をロックして取得
+	current, err = s.lockProduct(ctx, tx, productId)
+	if err != nil {
+		return nil, err
+	}
+
 	// カテゴリ名をrepositoryから取得
 	// FIXME: カテゴリ名は更新しないのに毎回取得するのは無駄がある
 	categoryId, err = categories.NewCategoryId(productDTO.CategoryId)
@@ -128,12 +152,20 @@ func (s *ProductServiceImpl) Update(ctx context.Context, productDTO *dto.UpdateP
 		return nil, err
 	}
 
-	product, err = dto.ProductFromUpdateDTO(productDTO, category.Name())
+	product, err = dto.ProductFromUpdateDTO(productDTO, category.Name(), current)
 	if err != nil {
 		return nil, err
 	}
+	if slug != "" && slug != current.Slug() {
+		slug, err = assignSlug(slug, "", productId.Value(), s.slugOwnerFinder(ctx, tx), "PRODUCT_SLUG_ALREADY_EXISTS")
+		if err != nil {
+			return nil, err
+		}
+		product.ChangeSlug(slug)
+	}
 
 	if err = s.productRepo.UpdateById(ctx, tx, product); err != nil {
+		err = toAlreadyExistsError(err, "PRODUCT_ALREADY_EXISTS", "Product already exists")
 		return nil, err
 	}
 
@@ -182,4 +214,366 @@ func (s *ProductServiceImpl) Delete(ctx context.Context, productDTO *dto.DeleteP
 	return result, nil
 }
 
+// Publish は下書きまたは一時停止中の商品を公開します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - productDTO: 公開する商品のID情報を含むDTO
+//
+// Returns:
+//   - *dto.ProductDTO: 公開された商品のDTO
+//   - error: 許可されていない遷移の場合はDomainError (コード: INVALID_STATUS_TRANSITION)、その他のエラー
+func (s *ProductServiceImpl) Publish(ctx context.Context, productDTO *dto.ChangeProductStatusDTO) (*dto.ProductDTO, error) {
+	return s.changeStatus(ctx, productDTO, (*products.Product).Publish)
+}
+
+// Suspend は公開中の商品の販売を一時停止します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - productDTO: 一時停止する商品のID情報を含むDTO
+//
+// Returns:
+//   - *dto.ProductDTO: 一時停止された商品のDTO
+//   - error: 許可されていない遷移の場合はDomainError (コード: INVALID_STATUS_TRANSITION)、その他のエラー
+func (s *ProductServiceImpl) Suspend(ctx context.Context, productDTO *dto.ChangeProductStatusDTO) (*dto.ProductDTO, error) {
+	return s.changeStatus(ctx, productDTO, (*products.Product).Suspend)
+}
+
+// Discontinue は商品の販売を終了します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - productDTO: 販売を終了する商品のID情報を含むDTO
+//
+// Returns:
+//   - *dto.ProductDTO: 販売を終了した商品のDTO
+//   - error: 許可されていない遷移の場合はDomainError (コード: INVALID_STATUS_TRANSITION)、その他のエラー
+func (s *ProductServiceImpl) Discontinue(ctx context.Context, productDTO *dto.ChangeProductStatusDTO) (*dto.ProductDTO, error) {
+	return s.changeStatus(ctx, productDTO, (*products.Product).Discontinue)
+}
+
+// changeStatus は商品を排他ロックして販売状態を遷移させ、同じトランザクション内で永続化します。
+// 状態遷移の可否は商品集約が判定します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - productDTO: 対象の商品のID情報を含むDTO
+//   - transition: 商品集約の状態遷移メソッド
+//
+// Returns:
+//   - *dto.ProductDTO: 状態を変更した商品のDTO
+//   - error: エラー情報
+func (s *ProductServiceImpl) changeStatus(ctx context.Context, productDTO *dto.ChangeProductStatusDTO, transition func(*products.Product) error) (result *dto.ProductDTO, err error) {
+	var (
+		productId *products.ProductId
+		product   *products.Product
+	)
+	productId, err = products.NewProductId(productDTO.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	product, err = s.lockProduct(ctx, tx, productId)
+	if err != nil {
+		return nil, err
+	}
+	if err = transition(product); err != nil {
+		return nil, err
+	}
+	if err = s.productRepo.UpdateById(ctx, tx, product); err != nil {
+		return nil, err
+	}
+
+	result = dto.NewProductDTOFromEntity(product)
+	return result, nil
+}
+
+// AddVariant は商品にバリエーションを追加します。
+// 親の商品を排他ロックした上で商品集約の不変条件を検証し、同じトランザクション内で永続化します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - variantDTO: 追加するバリエーション情報
+//
+// Returns:
+//   - *dto.VariantDTO: 追加されたバリエーションのDTO
+//   - error: エラー情報
+func (s *ProductServiceImpl) AddVariant(ctx context.Context, variantDTO *dto.AddVariantDTO) (result *dto.VariantDTO, err error) {
+	var (
+		productId *products.ProductId
+		variant   *products.Variant
+		product   *products.Product
+	)
+	productId, err = products.NewProductId(variantDTO.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	variant, err = dto.VariantFromAddDTO(variantDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	product, err = s.lockProduct(ctx, tx, productId)
+	if err != nil {
+		return nil, err
+	}
+	if err = product.AddVariant(variant); err != nil {
+		return nil, err
+	}
+	// 他の商品のバリエーションとのSKUの重複はSKUの一意制約で検出する
+	if err = s.productRepo.AddVariant(ctx, tx, productId, variant); err != nil {
+		err = toAlreadyExistsError(err, "VARIANT_ALREADY_EXISTS", "Variant already exists")
+		return nil, err
+	}
+
+	result = dto.NewVariantDTOFromEntity(product, variant)
+	return result, nil
+}
+
+// UpdateVariant は既存のバリエーションを更新します。
+// 親の商品を排他ロックした上で商品集約の不変条件を検証し、同じトランザクション内で永続化します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - variantDTO: 更新するバリエーション情報
+//
+// Returns:
+//   - *dto.VariantDTO: 更新されたバリエーションのDTO
+//   - error: エラー情報
+func (s *ProductServiceImpl) UpdateVariant(ctx context.Context, variantDTO *dto.UpdateVariantDTO) (result *dto.VariantDTO, err error) {
+	var (
+		productId *products.ProductId
+		variant   *products.Variant
+		product   *products.Product
+	)
+	productId, err = products.NewProductId(variantDTO.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	variant, err = dto.VariantFromUpdateDTO(variantDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	product, err = s.lockProduct(ctx, tx, productId)
+	if err != nil {
+		return nil, err
+	}
+	if err = product.UpdateVariant(variant); err != nil {
+		return nil, err
+	}
+	if err = s.productRepo.UpdateVariant(ctx, tx, variant); err != nil {
+		err = toAlreadyExistsError(err, "VARIANT_ALREADY_EXISTS", "Variant already exists")
+		return nil, err
+	}
+
+	result = dto.NewVariantDTOFromEntity(product, variant)
+	return result, nil
+}
+
+// RemoveVariant は指定されたバリエーションを削除します。
+// 親の商品を排他ロックした上で、商品に属するバリエーションであることを確認してから削除します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - variantDTO: 削除するバリエーション情報
+//
+// Returns:
+//   - *dto.VariantDTO: 削除されたバリエーションのDTO
+//   - error: エラー情報
+func (s *ProductServiceImpl) RemoveVariant(ctx context.Context, variantDTO *dto.RemoveVariantDTO) (result *dto.VariantDTO, err error) {
+	var (
+		productId *products.ProductId
+		variantId *products.VariantId
+		variant   *products.Variant
+		product   *products.Product
+	)
+	productId, err = products.NewProductId(variantDTO.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	variantId, err = products.NewVariantId(variantDTO.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	product, err = s.lockProduct(ctx, tx, productId)
+	if err != nil {
+		return nil, err
+	}
+	variant, err = product.RemoveVariant(variantId)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.productRepo.RemoveVariant(ctx, tx, variantId); err != nil {
+		return nil, err
+	}
+
+	result = dto.NewVariantDTOFromEntity(product, variant)
+	return result, nil
+}
+
+// AdjustPrices はカテゴリ（とタグ）で絞り込んだ商品の単価を一括で変更します。
+// 対象の商品を商品ID順に排他ロックしてから変更後の単価を計算し、すべての商品を同じトランザクション内で永続化します。
+// 1件でも変更後の単価が範囲外になる場合は、いずれの商品も変更しません。
+// プレビューの場合も同じ手順で変更後の単価を計算しますが、永続化は行いません。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - adjustDTO: 対象の商品の条件と変更規則
+//
+// Returns:
+//   - *dto.AdjustPricesResultDTO: 商品ごとの変更前後の単価（商品ID順）
+//   - error: カテゴリが存在しない場合はApplicationError (コード: CATEGORY_NOT_FOUND)、
+//     変更後の単価が範囲外の場合はDomainError (コード: PRICE_ADJUSTMENT_OUT_OF_RANGE)、
+//     金額を含む変更で通貨が混在する場合はDomainError (コード: PRICE_ADJUSTMENT_MIXED_CURRENCIES)、その他のエラー
+func (s *ProductServiceImpl) AdjustPrices(ctx context.Context, adjustDTO *dto.AdjustPricesDTO) (result *dto.AdjustPricesResultDTO, err error) {
+	var (
+		tx          *sql.Tx
+		categoryId  *categories.CategoryId
+		adjustment  *pricing.PriceAdjustment
+		tagKeys     []string
+		categoryIds []*categories.CategoryId
+		productIds  []*products.ProductId
+	)
+
+	categoryId, err = categories.NewCategoryId(adjustDTO.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	adjustment, err = dto.PriceAdjustmentFromDTO(adjustDTO)
+	if err != nil {
+		return nil, err
+	}
+	if len(adjustDTO.Tags) > 0 {
+		var tagNames []*tags.TagName
+		if tagNames, err = tags.NewTagNames(adjustDTO.Tags); err != nil {
+			return nil, err
+		}
+		for _, name := range tagNames {
+			tagKeys = append(tagKeys, name.Key())
+		}
+	}
+
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	if adjustDTO.IncludeDescendants {
+		categoryIds, err = s.categoryRepo.FindSubtreeIds(ctx, tx, categoryId)
+	} else {
+		_, err = s.categoryRepo.FindById(ctx, tx, categoryId)
+		categoryIds = []*categories.CategoryId{categoryId}
+	}
+	if err != nil {
+		err = toNotFoundError(err, "CATEGORY_NOT_FOUND", "Category not found")
+		return nil, err
+	}
+	productIds, err = s.productRepo.LockIdsByCategoryIds(ctx, tx, categoryIds, tagKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]*products.Product, 0, len(productIds))
+	for _, productId := range productIds {
+		var product *products.Product
+		if product, err = s.productRepo.FindById(ctx, tx, productId); err != nil {
+			return nil, err
+		}
+		targets = append(targets, product)
+	}
+	if err = adjustment.CheckCurrencies(targets); err != nil {
+		return nil, err
+	}
+
+	// すべての商品の変更後の単価を検証してから永続化する
+	adjustments := make([]*dto.PriceAdjustmentDTO, 0, len(targets))
+	for _, product := range targets {
+		before := product.Price().Value()
+		if err = adjustment.Apply(product); err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments, dto.NewPriceAdjustmentDTO(product, before))
+	}
+	if !adjustDTO.Preview {
+		for i, product := range targets {
+			if adjustments[i].AfterPrice == adjustments[i].BeforePrice {
+				continue
+			}
+			if err = s.productRepo.UpdateById(ctx, tx, product); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result = &dto.AdjustPricesResultDTO{Adjustments: adjustments, Preview: adjustDTO.Preview}
+	return result, nil
+}
+
+// lockProduct は更新する商品を排他ロックして取得します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//
+// Returns:
+//   - *products.Product: ロックした商品
+//   - error: 商品が存在しない場合はApplicationError (コード: PRODUCT_NOT_FOUND)、その他のエラー
+func (s *ProductServiceImpl) lockProduct(ctx context.Context, tx *sql.Tx, productId *products.ProductId) (*products.Product, error) {
+	product, err := s.productRepo.LockById(ctx, tx, productId)
+	if err != nil {
+		return nil, toNotFoundError(err, "PRODUCT_NOT_FOUND", "Product not found")
+	}
+	return product, nil
+}
+
+// slugOwnerFinder はスラッグを使用している商品のIDを取得する関数を返します。
+func (s *ProductServiceImpl) slugOwnerFinder(ctx context.Context, tx *sql.Tx) slugOwnerFinder {
+	return func(slug names.Slug) (string, error) {
+		id, err := s.productRepo.FindIdBySlug(ctx, tx, slug)
+		if err != nil {
+			return "", err
+		}
+		return id.Value(), nil
+	}
+}
+
 var _ service.ProductService = (*ProductServiceImpl)(nil)
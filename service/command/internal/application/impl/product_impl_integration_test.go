@@ -11,11 +11,14 @@ import (
 	"errors"
 	"io"
 	"log/slog"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/repository"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/testhelpers"
@@ -206,6 +209,33 @@ var _ = Describe("ProductService Integration Test", Ordered, func() {
 			Expect(oldExists).To(BeFalse(), "古い商品名がDBに残っています")
 		})
 
+		It("スラッグを変更しても変更前のスラッグから商品を解決できること", func() {
+			previousSlug := testProductDTO.Slug
+			Expect(previousSlug).NotTo(BeEmpty(), "商品名からスラッグが生成されていません")
+			newSlug := "slug-" + strings.ReplaceAll(uuid.NewString(), "-", "")
+
+			updateDTO := &dto.UpdateProductDTO{
+				Id:         testProduct.Id().Value(),
+				Name:       testProduct.Name().Value(),
+				CategoryId: testProduct.Category().Id().Value(),
+				Price:      testProduct.Price().Value(),
+				Slug:       newSlug,
+			}
+			result, err := ps.Update(ctx, updateDTO)
+			Expect(err).NotTo(HaveOccurred(), "スラッグの変更に失敗しました")
+			Expect(result.Slug).To(Equal(newSlug))
+
+			// 変更前・変更後のスラッグがどちらも同じ商品を指すことを確認
+			tx, err := tm.Begin(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { _ = tm.Complete(ctx, tx, nil) }()
+			for _, slug := range []string{previousSlug, newSlug} {
+				id, err := productRepo.FindIdBySlug(ctx, tx, names.Slug(slug))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(id.Value()).To(Equal(testProduct.Id().Value()))
+			}
+		})
+
 		It("存在しない商品を更新しようとするとエラーになること", func() {
 			// 存在しない商品を作成
 			nonExistentName, err := products.NewProductName(testhelpers.GenerateUniqueProductName())
@@ -310,4 +340,103 @@ var _ = Describe("ProductService Integration Test", Ordered, func() {
 			Expect(crudErr.Code).To(Equal("NOT_FOUND"), "エラーコードが期待値と異なります")
 		})
 	})
+
+	Context("バリエーションの動作確認", func() {
+		var (
+			productDTO *dto.ProductDTO
+			other      *dto.ProductDTO
+		)
+
+		BeforeEach(func() {
+			categoryDTO, err := cs.Add(ctx, &dto.CreateCategoryDTO{Name: testhelpers.GenerateUniqueCategoryName()})
+			Expect(err).NotTo(HaveOccurred())
+			productDTO, err = ps.Add(ctx, &dto.CreateProductDTO{
+				Name:     testhelpers.GenerateUniqueProductName(),
+				Price:    2000,
+				Category: categoryDTO,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			other, err = ps.Add(ctx, &dto.CreateProductDTO{
+				Name:     testhelpers.GenerateUniqueProductName(),
+				Price:    2000,
+				Category: categoryDTO,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			// バリエーションは商品の削除時にカスケード削除される
+			DeferCleanup(testhelpers.CleanupProductCategory, tm, productRepo, categoryRepo, productDTO, categoryDTO)
+			DeferCleanup(testhelpers.CleanupProduct, tm, productRepo, other)
+		})
+
+		It("追加・変更・削除したバリエーションが商品と合わせて取得できること", func() {
+			sku := uuid.NewString()[:8] + "-m"
+			priceOverride := uint32(2500)
+			added, err := ps.AddVariant(ctx, &dto.AddVariantDTO{
+				ProductId:     productDTO.Id,
+				Sku:           sku,
+				Options:       []dto.VariantOptionDTO{{Axis: "サイズ", Value: "M"}, {Axis: "カラー", Value: "ブラック"}},
+				PriceOverride: &priceOverride,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(added.Price).To(Equal(uint32(2500)))
+
+			product := findProduct(ctx, tm, productRepo, productDTO.Id)
+			Expect(product.Variants()).To(HaveLen(1))
+			variant := product.Variants()[0]
+			Expect(variant.Sku().Value()).To(Equal(strings.ToUpper(sku)))
+			Expect(variant.Options().Values()).To(HaveLen(2))
+			Expect(variant.PriceOverride().Value()).To(Equal(uint32(2500)))
+
+			updated, err := ps.UpdateVariant(ctx, &dto.UpdateVariantDTO{
+				Id:        added.Id,
+				ProductId: productDTO.Id,
+				Sku:       sku,
+				Options:   []dto.VariantOptionDTO{{Axis: "サイズ", Value: "L"}, {Axis: "カラー", Value: "ブラック"}},
+				Status:    "INACTIVE",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.PriceOverride).To(BeNil())
+			Expect(updated.Price).To(Equal(uint32(2000)))
+
+			product = findProduct(ctx, tm, productRepo, productDTO.Id)
+			Expect(product.Variants()[0].Status()).To(Equal(products.VARIANT_INACTIVE))
+			Expect(product.Variants()[0].PriceOverride()).To(BeNil())
+
+			_, err = ps.RemoveVariant(ctx, &dto.RemoveVariantDTO{Id: added.Id, ProductId: productDTO.Id})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findProduct(ctx, tm, productRepo, productDTO.Id).Variants()).To(BeEmpty())
+		})
+
+		It("他の商品と同じSKUは追加できないこと", func() {
+			sku := uuid.NewString()[:8]
+			_, err := ps.AddVariant(ctx, &dto.AddVariantDTO{
+				ProductId: other.Id,
+				Sku:       sku,
+				Options:   []dto.VariantOptionDTO{{Axis: "サイズ", Value: "M"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = ps.AddVariant(ctx, &dto.AddVariantDTO{
+				ProductId: productDTO.Id,
+				Sku:       sku,
+				Options:   []dto.VariantOptionDTO{{Axis: "サイズ", Value: "M"}},
+			})
+			var appErr *errs.ApplicationError
+			Expect(errors.As(err, &appErr)).To(BeTrue())
+			Expect(appErr.Code).To(Equal("VARIANT_ALREADY_EXISTS"))
+		})
+	})
 })
+
+// findProduct はトランザクション内で商品をバリエーションと合わせて取得します。
+func findProduct(ctx context.Context, tm service.TransactionManager, repo products.ProductRepository, id string) (product *products.Product) {
+	productId, err := products.NewProductId(id)
+	Expect(err).NotTo(HaveOccurred())
+	tx, err := tm.Begin(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	defer func() {
+		Expect(tm.Complete(ctx, tx, err)).To(Succeed())
+	}()
+	product, err = repo.FindById(ctx, tx, productId)
+	Expect(err).NotTo(HaveOccurred())
+	return product
+}
@@ -3,6 +3,7 @@ package impl
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -11,6 +12,7 @@ import (
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
 	mock_repository "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/mock/repository"
 	mock_service "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/mock/service"
@@ -80,7 +82,7 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 				// Arrange: モックの期待値を順序付きで設定
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
-					mockProductRepo.EXPECT().ExistsByName(ctx, mockTx, testProduct.Name()).Return(false, nil),
+					mockProductRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("testproduct")).Return(nil, errs.NewCRUDError("NOT_FOUND", "not found")),
 					mockProductRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Return(nil),
 					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
 				)
@@ -110,7 +112,9 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
-					mockProductRepo.EXPECT().ExistsByName(ctx, mockTx, testProduct.Name()).Return(true, nil),
+					mockProductRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("testproduct")).Return(nil, errs.NewCRUDError("NOT_FOUND", "not found")),
+					mockProductRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).
+						Return(errs.NewCRUDError("ALREADY_EXISTS", "同じ名前が既に登録されています。")),
 					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).
 						Do(func(ctx context.Context, tx *sql.Tx, err error) {
 							// Completeに渡されるエラーがApplicationErrorであることを検証
@@ -157,7 +161,7 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 			})
 		})
 
-		Context("when ExistsByName fails", func() {
+		Context("when Create fails", func() {
 			It("should return the error and rollback", func() {
 				// Arrange
 				createDTO := &dto.CreateProductDTO{
@@ -168,11 +172,12 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 					},
 					Price: 1000,
 				}
-				existsErr := fmt.Errorf("database error")
+				createErr := errs.NewCRUDError("DB_ERROR", "failed to create product")
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
-					mockProductRepo.EXPECT().ExistsByName(ctx, mockTx, testProduct.Name()).Return(false, existsErr),
-					mockTm.EXPECT().Complete(ctx, mockTx, existsErr).Return(nil),
+					mockProductRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("testproduct")).Return(nil, errs.NewCRUDError("NOT_FOUND", "not found")),
+					mockProductRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Return(createErr),
+					mockTm.EXPECT().Complete(ctx, mockTx, createErr).Return(nil),
 				)
 
 				// Act
@@ -181,12 +186,12 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 				// Assert
 				Expect(err).To(HaveOccurred())
 				Expect(result).To(BeNil())
-				Expect(err).To(Equal(existsErr))
+				Expect(err).To(Equal(createErr))
 			})
 		})
 
-		Context("when Create fails", func() {
-			It("should return the error and rollback", func() {
+		Context("when commit fails", func() {
+			It("should return the commit error", func() {
 				// Arrange
 				createDTO := &dto.CreateProductDTO{
 					Name: "TestProduct",
@@ -196,12 +201,12 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 					},
 					Price: 1000,
 				}
-				createErr := errs.NewCRUDError("DB_ERROR", "failed to create product")
+				commitErr := fmt.Errorf("commit failed")
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
-					mockProductRepo.EXPECT().ExistsByName(ctx, mockTx, testProduct.Name()).Return(false, nil),
-					mockProductRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Return(createErr),
-					mockTm.EXPECT().Complete(ctx, mockTx, createErr).Return(nil),
+					mockProductRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("testproduct")).Return(nil, errs.NewCRUDError("NOT_FOUND", "not found")),
+					mockProductRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Return(nil),
+					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(commitErr),
 				)
 
 				// Act
@@ -210,12 +215,12 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 				// Assert
 				Expect(err).To(HaveOccurred())
 				Expect(result).To(BeNil())
-				Expect(err).To(Equal(createErr))
+				Expect(err).To(Equal(commitErr))
 			})
 		})
 
-		Context("when commit fails", func() {
-			It("should return the commit error", func() {
+		Context("when generated slug is already used by another product", func() {
+			It("should add a numbered suffix to the slug", func() {
 				// Arrange
 				createDTO := &dto.CreateProductDTO{
 					Name: "TestProduct",
@@ -225,21 +230,51 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 					},
 					Price: 1000,
 				}
-				commitErr := fmt.Errorf("commit failed")
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
-					mockProductRepo.EXPECT().ExistsByName(ctx, mockTx, testProduct.Name()).Return(false, nil),
-					mockProductRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Return(nil),
-					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(commitErr),
+					mockProductRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("testproduct")).Return(testProduct.Id(), nil),
+					mockProductRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("testproduct-2")).Return(nil, errs.NewCRUDError("NOT_FOUND", "not found")),
+					mockProductRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Do(
+						func(ctx context.Context, tx *sql.Tx, product *products.Product) {
+							Expect(product.Slug()).To(Equal(names.Slug("testproduct-2")))
+						}).Return(nil),
+					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+				)
+
+				// Act
+				result, err := ps.Add(ctx, createDTO)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Slug).To(Equal("testproduct-2"))
+			})
+		})
+
+		Context("when specified slug is already used by another product", func() {
+			It("should return ApplicationError with PRODUCT_SLUG_ALREADY_EXISTS code", func() {
+				// Arrange
+				createDTO := &dto.CreateProductDTO{
+					Name: "TestProduct",
+					Category: &dto.CategoryDTO{
+						Id:   testProduct.Category().Id().Value(),
+						Name: testProduct.Category().Name().Value(),
+					},
+					Price: 1000,
+					Slug:  "Wireless-Mouse",
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockProductRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("wireless-mouse")).Return(testProduct.Id(), nil),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
 				)
 
 				// Act
 				result, err := ps.Add(ctx, createDTO)
 
 				// Assert
-				Expect(err).To(HaveOccurred())
 				Expect(result).To(BeNil())
-				Expect(err).To(Equal(commitErr))
+				Expect(err).To(BeAssignableToTypeOf(&errs.ApplicationError{}))
+				Expect(err.(*errs.ApplicationError).Code).To(Equal("PRODUCT_SLUG_ALREADY_EXISTS"))
 			})
 		})
 	})
@@ -257,6 +292,7 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
 					mockCategoryRepo.EXPECT().FindById(ctx, mockTx, gomock.Any()).Do(
 						func(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) {
 							Expect(id.Value()).To(Equal(updateDTO.CategoryId))
@@ -267,6 +303,8 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 							Expect(product.Price().Value()).To(Equal(updateDTO.Price))
 							Expect(product.Category().Id().Value()).To(Equal(updateDTO.CategoryId))
 							Expect(product.Id().Value()).To(Equal(testProduct.Id().Value()))
+							Expect(product.Price().Currency()).To(Equal(testProduct.Price().Currency()))
+							Expect(product.Price().TaxClass()).To(Equal(testProduct.Price().TaxClass()))
 						}).Return(nil),
 					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
 				)
@@ -282,6 +320,38 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 			})
 		})
 
+		Context("when slug is specified", func() {
+			It("should change the slug to the specified one", func() {
+				// Arrange
+				testProduct.ChangeSlug("testproduct")
+				updateDTO := &dto.UpdateProductDTO{
+					Id:         testProduct.Id().Value(),
+					Name:       "UpdatedProduct",
+					CategoryId: testProduct.Category().Id().Value(),
+					Price:      2000,
+					Slug:       "updated-product",
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+					mockCategoryRepo.EXPECT().FindById(ctx, mockTx, gomock.Any()).Return(testProduct.Category(), nil),
+					mockProductRepo.EXPECT().FindIdBySlug(ctx, mockTx, names.Slug("updated-product")).Return(testProduct.Id(), nil),
+					mockProductRepo.EXPECT().UpdateById(ctx, mockTx, gomock.Any()).Do(
+						func(ctx context.Context, tx *sql.Tx, product *products.Product) {
+							Expect(product.Slug()).To(Equal(names.Slug("updated-product")))
+						}).Return(nil),
+					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+				)
+
+				// Act
+				result, err := ps.Update(ctx, updateDTO)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Slug).To(Equal("updated-product"))
+			})
+		})
+
 		Context("when UpdateById fails", func() {
 			It("should return the error and rollback", func() {
 				// Arrange
@@ -295,6 +365,7 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 				updateErr := errs.NewCRUDError("NOT_FOUND", "product not found")
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
 					mockCategoryRepo.EXPECT().FindById(ctx, mockTx, gomock.Any()).Do(
 						func(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) {
 							Expect(id.Value()).To(Equal(updateDTO.CategoryId))
@@ -313,6 +384,36 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 			})
 		})
 
+		Context("when updated name already exists", func() {
+			It("should return ApplicationError with PRODUCT_ALREADY_EXISTS code", func() {
+				// Arrange
+				updateDTO := &dto.UpdateProductDTO{
+					Id:         testProduct.Id().Value(),
+					Name:       "UpdatedProduct",
+					CategoryId: testProduct.Category().Id().Value(),
+					Price:      2000,
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+					mockCategoryRepo.EXPECT().FindById(ctx, mockTx, gomock.Any()).Return(testProduct.Category(), nil),
+					mockProductRepo.EXPECT().UpdateById(ctx, mockTx, gomock.Any()).
+						Return(errs.NewCRUDError("ALREADY_EXISTS", "同じ名前が既に登録されています。")),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+				)
+
+				// Act
+				result, err := ps.Update(ctx, updateDTO)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+				Expect(err).To(BeAssignableToTypeOf(&errs.ApplicationError{}))
+				appErr := err.(*errs.ApplicationError)
+				Expect(appErr.Code).To(Equal("PRODUCT_ALREADY_EXISTS"))
+			})
+		})
+
 		Context("when FindById fails", func() {
 			It("should return the error and rollback", func() {
 				// Arrange
@@ -325,6 +426,7 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 				findErr := errs.NewCRUDError("NOT_FOUND", "category not found")
 				gomock.InOrder(
 					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
 					mockCategoryRepo.EXPECT().FindById(ctx, mockTx, gomock.Any()).Do(
 						func(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) {
 							Expect(id.Value()).To(Equal(updateDTO.CategoryId))
@@ -342,6 +444,34 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 			})
 		})
 
+		Context("when the product does not exist", func() {
+			It("should return ApplicationError with PRODUCT_NOT_FOUND code", func() {
+				// Arrange
+				updateDTO := &dto.UpdateProductDTO{
+					Id:         testProduct.Id().Value(),
+					Name:       "UpdatedProduct",
+					CategoryId: testProduct.Category().Id().Value(),
+					Price:      2000,
+				}
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).
+						Return(nil, errs.NewCRUDError("NOT_FOUND", "product not found")),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+				)
+
+				// Act
+				result, err := ps.Update(ctx, updateDTO)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+				Expect(err).To(BeAssignableToTypeOf(&errs.ApplicationError{}))
+				appErr := err.(*errs.ApplicationError)
+				Expect(appErr.Code).To(Equal("PRODUCT_NOT_FOUND"))
+			})
+		})
+
 		Context("when Begin fails", func() {
 			It("should return the error from Begin", func() {
 				// Arrange
@@ -434,4 +564,396 @@ var _ = Describe("ProductService", Label("UnitTests"), func() {
 			})
 		})
 	})
+
+	Describe("AddVariant", func() {
+		var addDTO *dto.AddVariantDTO
+
+		BeforeEach(func() {
+			addDTO = &dto.AddVariantDTO{
+				ProductId: testProduct.Id().Value(),
+				Sku:       "tp-m",
+				Options:   []dto.VariantOptionDTO{{Axis: "サイズ", Value: "M"}},
+			}
+		})
+
+		Context("when the variant is valid", func() {
+			It("should lock the product and add the variant", func() {
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+					mockProductRepo.EXPECT().AddVariant(ctx, mockTx, testProduct.Id(), gomock.Any()).Return(nil),
+					mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+				)
+
+				result, err := ps.AddVariant(ctx, addDTO)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.ProductId).To(Equal(testProduct.Id().Value()))
+				Expect(result.Sku).To(Equal("TP-M"))
+				Expect(result.Status).To(Equal("ACTIVE"))
+				Expect(result.PriceOverride).To(BeNil())
+				Expect(result.Price).To(Equal(uint32(1000)))
+				Expect(testProduct.Variants()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the SKU is already used by another product", func() {
+			It("should return ApplicationError with VARIANT_ALREADY_EXISTS code", func() {
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+					mockProductRepo.EXPECT().AddVariant(ctx, mockTx, testProduct.Id(), gomock.Any()).
+						Return(errs.NewCRUDError("ALREADY_EXISTS", "同じSKUが既に登録されています。")),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+				)
+
+				result, err := ps.AddVariant(ctx, addDTO)
+
+				Expect(result).To(BeNil())
+				var appErr *errs.ApplicationError
+				Expect(errors.As(err, &appErr)).To(BeTrue())
+				Expect(appErr.Code).To(Equal("VARIANT_ALREADY_EXISTS"))
+			})
+		})
+
+		Context("when the option combination already exists in the product", func() {
+			It("should return DomainError without persisting", func() {
+				existing, err := dto.VariantFromAddDTO(&dto.AddVariantDTO{
+					Sku:     "TP-M-OLD",
+					Options: []dto.VariantOptionDTO{{Axis: "サイズ", Value: "M"}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(testProduct.AddVariant(existing)).To(Succeed())
+
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+				)
+
+				result, err := ps.AddVariant(ctx, addDTO)
+
+				Expect(result).To(BeNil())
+				var domainErr *errs.DomainError
+				Expect(errors.As(err, &domainErr)).To(BeTrue())
+				Expect(domainErr.Code).To(Equal("VARIANT_ALREADY_EXISTS"))
+			})
+		})
+
+		Context("when the product does not exist", func() {
+			It("should return ApplicationError with PRODUCT_NOT_FOUND code", func() {
+				gomock.InOrder(
+					mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+					mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).
+						Return(nil, errs.NewCRUDError("NOT_FOUND", "product not found")),
+					mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+				)
+
+				result, err := ps.AddVariant(ctx, addDTO)
+
+				Expect(result).To(BeNil())
+				var appErr *errs.ApplicationError
+				Expect(errors.As(err, &appErr)).To(BeTrue())
+				Expect(appErr.Code).To(Equal("PRODUCT_NOT_FOUND"))
+			})
+		})
+
+		Context("when the options are invalid", func() {
+			It("should return DomainError without beginning a transaction", func() {
+				addDTO.Options = nil
+
+				result, err := ps.AddVariant(ctx, addDTO)
+
+				Expect(result).To(BeNil())
+				var domainErr *errs.DomainError
+				Expect(errors.As(err, &domainErr)).To(BeTrue())
+				Expect(domainErr.Code).To(Equal("INVALID_ARGUMENT"))
+			})
+		})
+	})
+
+	Describe("UpdateVariant and RemoveVariant", func() {
+		var existing *products.Variant
+
+		BeforeEach(func() {
+			var err error
+			existing, err = dto.VariantFromAddDTO(&dto.AddVariantDTO{
+				Sku:     "TP-M",
+				Options: []dto.VariantOptionDTO{{Axis: "サイズ", Value: "M"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testProduct.AddVariant(existing)).To(Succeed())
+		})
+
+		It("should replace the variant attributes", func() {
+			priceOverride := uint32(1200)
+			updateDTO := &dto.UpdateVariantDTO{
+				Id:            existing.Id().Value(),
+				ProductId:     testProduct.Id().Value(),
+				Sku:           "TP-M",
+				Options:       []dto.VariantOptionDTO{{Axis: "サイズ", Value: "M"}},
+				PriceOverride: &priceOverride,
+				Status:        "INACTIVE",
+			}
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+				mockProductRepo.EXPECT().UpdateVariant(ctx, mockTx, gomock.Any()).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ps.UpdateVariant(ctx, updateDTO)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Id).To(Equal(existing.Id().Value()))
+			Expect(*result.PriceOverride).To(Equal(uint32(1200)))
+			Expect(result.Price).To(Equal(uint32(1200)))
+			Expect(result.Status).To(Equal("INACTIVE"))
+		})
+
+		It("should return DomainError with VARIANT_NOT_FOUND code when the variant belongs to another product", func() {
+			updateDTO := &dto.UpdateVariantDTO{
+				Id:        "00000000-0000-4000-8000-000000000000",
+				ProductId: testProduct.Id().Value(),
+				Sku:       "TP-L",
+				Options:   []dto.VariantOptionDTO{{Axis: "サイズ", Value: "L"}},
+			}
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ps.UpdateVariant(ctx, updateDTO)
+
+			Expect(result).To(BeNil())
+			var domainErr *errs.DomainError
+			Expect(errors.As(err, &domainErr)).To(BeTrue())
+			Expect(domainErr.Code).To(Equal("VARIANT_NOT_FOUND"))
+		})
+
+		It("should remove the variant", func() {
+			removeDTO := &dto.RemoveVariantDTO{Id: existing.Id().Value(), ProductId: testProduct.Id().Value()}
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+				mockProductRepo.EXPECT().RemoveVariant(ctx, mockTx, existing.Id()).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ps.RemoveVariant(ctx, removeDTO)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Sku).To(Equal("TP-M"))
+			Expect(testProduct.Variants()).To(BeEmpty())
+		})
+	})
+
+	Describe("Publish, Suspend and Discontinue", func() {
+		var statusDTO *dto.ChangeProductStatusDTO
+
+		BeforeEach(func() {
+			statusDTO = &dto.ChangeProductStatusDTO{Id: testProduct.Id().Value()}
+		})
+
+		It("should publish the draft product", func() {
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+				mockProductRepo.EXPECT().UpdateById(ctx, mockTx, testProduct).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ps.Publish(ctx, statusDTO)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal("PUBLISHED"))
+		})
+
+		It("should return DomainError with INVALID_STATUS_TRANSITION code without persisting", func() {
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ps.Suspend(ctx, statusDTO)
+
+			Expect(result).To(BeNil())
+			var domainErr *errs.DomainError
+			Expect(errors.As(err, &domainErr)).To(BeTrue())
+			Expect(domainErr.Code).To(Equal("INVALID_STATUS_TRANSITION"))
+			Expect(testProduct.Status()).To(Equal(products.PRODUCT_DRAFT))
+		})
+
+		It("should discontinue the product", func() {
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+				mockProductRepo.EXPECT().UpdateById(ctx, mockTx, testProduct).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ps.Discontinue(ctx, statusDTO)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal("DISCONTINUED"))
+		})
+
+		It("should return ApplicationError with PRODUCT_NOT_FOUND code when the product does not exist", func() {
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().LockById(ctx, mockTx, testProduct.Id()).
+					Return(nil, errs.NewCRUDError("NOT_FOUND", "商品が見つかりません")),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ps.Publish(ctx, statusDTO)
+
+			Expect(result).To(BeNil())
+			var appErr *errs.ApplicationError
+			Expect(errors.As(err, &appErr)).To(BeTrue())
+			Expect(appErr.Code).To(Equal("PRODUCT_NOT_FOUND"))
+		})
+	})
+
+	Describe("AdjustPrices", func() {
+		var (
+			otherProduct *products.Product
+			adjustDTO    *dto.AdjustPricesDTO
+		)
+
+		BeforeEach(func() {
+			name, err := products.NewProductName("OtherProduct")
+			Expect(err).NotTo(HaveOccurred())
+			price, err := products.NewProductPrice(1999)
+			Expect(err).NotTo(HaveOccurred())
+			otherProduct, err = products.NewProduct(name, price, testProduct.Category())
+			Expect(err).NotTo(HaveOccurred())
+
+			adjustDTO = &dto.AdjustPricesDTO{
+				CategoryId:   testProduct.Category().Id().Value(),
+				Type:         "PERCENT",
+				Value:        -10,
+				Rounding:     "HALF_UP",
+				RoundingUnit: 10,
+			}
+		})
+
+		It("should lock the products in the category and update their prices in one transaction", func() {
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockCategoryRepo.EXPECT().FindById(ctx, mockTx, testProduct.Category().Id()).Return(testProduct.Category(), nil),
+				mockProductRepo.EXPECT().LockIdsByCategoryIds(ctx, mockTx, []*categories.CategoryId{testProduct.Category().Id()}, nil).
+					Return([]*products.ProductId{testProduct.Id(), otherProduct.Id()}, nil),
+				mockProductRepo.EXPECT().FindById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+				mockProductRepo.EXPECT().FindById(ctx, mockTx, otherProduct.Id()).Return(otherProduct, nil),
+				mockProductRepo.EXPECT().UpdateById(ctx, mockTx, testProduct).Return(nil),
+				mockProductRepo.EXPECT().UpdateById(ctx, mockTx, otherProduct).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ps.AdjustPrices(ctx, adjustDTO)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Preview).To(BeFalse())
+			Expect(result.Adjustments).To(HaveLen(2))
+			Expect(result.Adjustments[0].BeforePrice).To(Equal(uint32(1000)))
+			Expect(result.Adjustments[0].AfterPrice).To(Equal(uint32(900)))
+			Expect(result.Adjustments[1].BeforePrice).To(Equal(uint32(1999)))
+			Expect(result.Adjustments[1].AfterPrice).To(Equal(uint32(1800)))
+			Expect(otherProduct.Price().Value()).To(Equal(uint32(1800)))
+		})
+
+		It("should include descendant categories and filter by normalized tag names", func() {
+			childId, err := categories.NewCategoryId("c7d1e1b4-5f0a-4c3e-9f43-2b7d8e0a1c11")
+			Expect(err).NotTo(HaveOccurred())
+			subtree := []*categories.CategoryId{testProduct.Category().Id(), childId}
+			adjustDTO.IncludeDescendants = true
+			adjustDTO.Tags = []string{"セール", "ｾｰﾙ", "新商品"}
+
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockCategoryRepo.EXPECT().FindSubtreeIds(ctx, mockTx, testProduct.Category().Id()).Return(subtree, nil),
+				mockProductRepo.EXPECT().LockIdsByCategoryIds(ctx, mockTx, subtree, gomock.Len(2)).
+					Return([]*products.ProductId{}, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ps.AdjustPrices(ctx, adjustDTO)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Adjustments).To(BeEmpty())
+		})
+
+		It("should return the adjusted prices without persisting when previewing", func() {
+			adjustDTO.Preview = true
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockCategoryRepo.EXPECT().FindById(ctx, mockTx, testProduct.Category().Id()).Return(testProduct.Category(), nil),
+				mockProductRepo.EXPECT().LockIdsByCategoryIds(ctx, mockTx, gomock.Any(), nil).
+					Return([]*products.ProductId{testProduct.Id()}, nil),
+				mockProductRepo.EXPECT().FindById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ps.AdjustPrices(ctx, adjustDTO)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Preview).To(BeTrue())
+			Expect(result.Adjustments).To(HaveLen(1))
+			Expect(result.Adjustments[0].ProductName).To(Equal("TestProduct"))
+			Expect(result.Adjustments[0].AfterPrice).To(Equal(uint32(900)))
+		})
+
+		It("should not update any product when one of the adjusted prices is out of range", func() {
+			adjustDTO.Type = "FIXED"
+			adjustDTO.Value = -1500
+			adjustDTO.RoundingUnit = 0
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockCategoryRepo.EXPECT().FindById(ctx, mockTx, testProduct.Category().Id()).Return(testProduct.Category(), nil),
+				mockProductRepo.EXPECT().LockIdsByCategoryIds(ctx, mockTx, gomock.Any(), nil).
+					Return([]*products.ProductId{otherProduct.Id(), testProduct.Id()}, nil),
+				mockProductRepo.EXPECT().FindById(ctx, mockTx, otherProduct.Id()).Return(otherProduct, nil),
+				mockProductRepo.EXPECT().FindById(ctx, mockTx, testProduct.Id()).Return(testProduct, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ps.AdjustPrices(ctx, adjustDTO)
+
+			Expect(result).To(BeNil())
+			var domainErr *errs.DomainError
+			Expect(errors.As(err, &domainErr)).To(BeTrue())
+			Expect(domainErr.Code).To(Equal("PRICE_ADJUSTMENT_OUT_OF_RANGE"))
+		})
+
+		It("should return ApplicationError with CATEGORY_NOT_FOUND code when the category does not exist", func() {
+			adjustDTO.IncludeDescendants = true
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockCategoryRepo.EXPECT().FindSubtreeIds(ctx, mockTx, testProduct.Category().Id()).
+					Return(nil, errs.NewCRUDError("NOT_FOUND", "カテゴリが見つかりません")),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ps.AdjustPrices(ctx, adjustDTO)
+
+			Expect(result).To(BeNil())
+			var appErr *errs.ApplicationError
+			Expect(errors.As(err, &appErr)).To(BeTrue())
+			Expect(appErr.Code).To(Equal("CATEGORY_NOT_FOUND"))
+		})
+
+		It("should return DomainError without beginning a transaction when the adjustment is invalid", func() {
+			adjustDTO.Value = -100
+
+			result, err := ps.AdjustPrices(ctx, adjustDTO)
+
+			Expect(result).To(BeNil())
+			var domainErr *errs.DomainError
+			Expect(errors.As(err, &domainErr)).To(BeTrue())
+			Expect(domainErr.Code).To(Equal("INVALID_ARGUMENT"))
+		})
+	})
 })
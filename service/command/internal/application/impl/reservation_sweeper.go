@@ -0,0 +1,83 @@
+package impl
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
+)
+
+// ReservationSweeper は期限切れの引当を定期的に失効させるバックグラウンド処理です。
+// 引当時にも同じ商品の期限切れの引当は失効させますが、引当が行われない商品の在庫を戻すために定期実行します。
+type ReservationSweeper struct {
+	stockService service.StockService // 在庫サービス
+	interval     time.Duration        // 実行間隔
+	batchSize    int                  // 1回の処理で対象とする商品数の上限
+	logger       *slog.Logger         // 構造化ログ出力
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewReservationSweeper は新しいReservationSweeperインスタンスを生成します。
+//
+// Parameters:
+//   - logger: 構造化ログ出力用のロガー
+//   - stockService: 在庫サービス
+//   - interval: 実行間隔
+//   - batchSize: 1回の処理で対象とする商品数の上限
+//
+// Returns:
+//   - *ReservationSweeper: 初期化されたReservationSweeper
+func NewReservationSweeper(logger *slog.Logger, stockService service.StockService, interval time.Duration, batchSize int) *ReservationSweeper {
+	return &ReservationSweeper{
+		stockService: stockService,
+		interval:     interval,
+		batchSize:    batchSize,
+		logger:       logger,
+	}
+}
+
+// Start は定期実行を別のゴルーチンで開始します。
+func (s *ReservationSweeper) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.Sweep(ctx)
+			}
+		}
+	}()
+}
+
+// Stop は定期実行を停止し、実行中の処理の完了を待ちます。
+func (s *ReservationSweeper) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+// Sweep は期限切れの引当を1回分失効させます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+func (s *ReservationSweeper) Sweep(ctx context.Context) {
+	expired, err := s.stockService.ExpireReservations(ctx, s.batchSize)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "期限切れの引当の失効処理でエラーが発生しました", slog.Any("error", err))
+	}
+	if expired > 0 {
+		s.logger.InfoContext(ctx, "期限切れの引当を失効させました", slog.Int("count", expired))
+	}
+}
@@ -0,0 +1,75 @@
+package impl
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+)
+
+// SLUG_MAX_ATTEMPTS は名前から生成したスラッグを一意にするために付ける連番の上限です。
+const SLUG_MAX_ATTEMPTS = 100
+
+// slugOwnerFinder はスラッグを現在のスラッグまたは履歴として使用しているエンティティのIDを取得する関数です。
+// スラッグが使用されていない場合はCRUDError (コード: NOT_FOUND)を返します。
+type slugOwnerFinder func(slug names.Slug) (string, error)
+
+// assignSlug はエンティティに割り当てるスラッグを決定します。
+// explicitが指定された場合はそのスラッグを使用し、他のエンティティが使用している場合はエラーを返します。
+// 指定されない場合はgeneratedを使用し、他のエンティティが使用している場合は連番を付けて一意にします。
+// 変更前のスラッグは旧URLの解決に使用するため、他のエンティティの履歴にあるスラッグも使用できません。
+//
+// Parameters:
+//   - explicit: 明示的に指定されたスラッグ（エンプティの場合はgeneratedを使用）
+//   - generated: 名前から生成したスラッグ
+//   - ownerId: スラッグを割り当てるエンティティのID（自身が使用しているスラッグは割り当て可能）
+//   - findOwner: スラッグを使用しているエンティティのIDを取得する関数
+//   - code: スラッグを割り当てられない場合のアプリケーションエラーコード
+//
+// Returns:
+//   - names.Slug: 割り当てるスラッグ
+//   - error: スラッグを割り当てられない場合はApplicationError、その他のエラー
+func assignSlug(explicit names.Slug, generated names.Slug, ownerId string, findOwner slugOwnerFinder, code string) (names.Slug, error) {
+	if explicit != "" {
+		available, err := isSlugAvailable(explicit, ownerId, findOwner)
+		if err != nil {
+			return "", err
+		}
+		if !available {
+			return "", errs.NewApplicationError(code, fmt.Sprintf("スラッグ: %s は既に使用されています", explicit.Value()))
+		}
+		return explicit, nil
+	}
+	for n := 1; n <= SLUG_MAX_ATTEMPTS; n++ {
+		candidate := generated.WithSuffix(n)
+		available, err := isSlugAvailable(candidate, ownerId, findOwner)
+		if err != nil {
+			return "", err
+		}
+		if available {
+			return candidate, nil
+		}
+	}
+	return "", errs.NewApplicationError(code, fmt.Sprintf("スラッグ: %s に付ける連番が上限に達しました。スラッグを指定してください", generated.Value()))
+}
+
+// isSlugAvailable はスラッグが未使用、または指定されたエンティティ自身が使用しているかを判定します。
+func isSlugAvailable(slug names.Slug, ownerId string, findOwner slugOwnerFinder) (bool, error) {
+	id, err := findOwner(slug)
+	if err != nil {
+		var crudErr *errs.CRUDError
+		if errors.As(err, &crudErr) && crudErr.Code == "NOT_FOUND" {
+			return true, nil
+		}
+		return false, err
+	}
+	return id == ownerId, nil
+}
+
+// slugFallback は名前からスラッグを生成できない場合に使用する文字列として、IDの先頭の区切りまでを返します。
+func slugFallback(id string) string {
+	prefix, _, _ := strings.Cut(id, "-")
+	return prefix
+}
@@ -0,0 +1,394 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/stocks"
+)
+
+// StockServiceImpl は在庫サービスの実装です。
+// 在庫行を行ロックしてから数量を更新するため、同時に引き当てても在庫数を超えて引き当てることはありません。
+type StockServiceImpl struct {
+	stockRepo   stocks.StockRepository     // 在庫と引当の永続化
+	productRepo products.ProductRepository // 商品の存在確認
+	tm          service.TransactionManager // トランザクション管理
+	policy      *stocks.ReservationPolicy  // 引当の有効期限ポリシー
+	logger      *slog.Logger               // 構造化ログ出力
+	now         func() time.Time           // 現在時刻（テストで差し替え可能）
+}
+
+// NewStockServiceImpl は新しいStockServiceImplインスタンスを生成します。
+//
+// Parameters:
+//   - logger: 構造化ログ出力用のロガー
+//   - stockRepo: 在庫と引当の永続化を担うリポジトリ
+//   - productRepo: 商品の存在確認を担うリポジトリ
+//   - tm: トランザクション管理を担うマネージャー
+//   - policy: 引当の有効期限ポリシー
+//
+// Returns:
+//   - *StockServiceImpl: 初期化された在庫サービス実装
+func NewStockServiceImpl(logger *slog.Logger, stockRepo stocks.StockRepository, productRepo products.ProductRepository, tm service.TransactionManager, policy *stocks.ReservationPolicy) *StockServiceImpl {
+	return &StockServiceImpl{
+		stockRepo:   stockRepo,
+		productRepo: productRepo,
+		tm:          tm,
+		policy:      policy,
+		logger:      logger,
+		now:         func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Adjust は入荷や棚卸によって在庫数を増減します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - adjustDTO: 増減する商品と数量
+//
+// Returns:
+//   - *dto.StockDTO: 増減後の在庫
+//   - error: エラー情報
+func (s *StockServiceImpl) Adjust(ctx context.Context, adjustDTO *dto.AdjustStockDTO) (result *dto.StockDTO, err error) {
+	var (
+		tx        *sql.Tx
+		productId *products.ProductId
+		stock     *stocks.Stock
+	)
+
+	productId, err = products.NewProductId(adjustDTO.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	stock, err = s.lockStock(ctx, tx, productId)
+	if err != nil {
+		return nil, err
+	}
+	if err = stock.Adjust(adjustDTO.Delta); err != nil {
+		return nil, err
+	}
+	if err = s.stockRepo.Update(ctx, tx, stock); err != nil {
+		return nil, err
+	}
+
+	result = dto.NewStockDTOFromEntity(stock)
+	return result, nil
+}
+
+// Reserve は在庫を引き当てます。
+// 在庫行をロックした後、同じ商品の期限切れの引当を失効させてから引当可能な数量を判定します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - reserveDTO: 引き当てる商品と数量
+//
+// Returns:
+//   - *dto.ReservationDTO: 作成された引当
+//   - error: エラー情報
+func (s *StockServiceImpl) Reserve(ctx context.Context, reserveDTO *dto.ReserveStockDTO) (result *dto.ReservationDTO, err error) {
+	var (
+		tx          *sql.Tx
+		productId   *products.ProductId
+		quantity    *stocks.Quantity
+		expiresAt   time.Time
+		stock       *stocks.Stock
+		reservation *stocks.Reservation
+	)
+
+	productId, err = products.NewProductId(reserveDTO.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	quantity, err = stocks.NewQuantity(reserveDTO.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	expiresAt, err = s.policy.ExpiresAt(now, reserveDTO.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	stock, err = s.lockStock(ctx, tx, productId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.expireStale(ctx, tx, stock, now); err != nil {
+		return nil, err
+	}
+	reservation, err = stock.Reserve(quantity, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.stockRepo.CreateReservation(ctx, tx, reservation); err != nil {
+		return nil, err
+	}
+	if err = s.stockRepo.Update(ctx, tx, stock); err != nil {
+		return nil, err
+	}
+
+	result = dto.NewReservationDTOFromEntity(reservation, stock)
+	return result, nil
+}
+
+// Release は引当をキャンセルし、在庫を引当可能に戻します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - releaseDTO: 解放する引当
+//
+// Returns:
+//   - *dto.ReservationDTO: 解放された引当
+//   - error: エラー情報
+func (s *StockServiceImpl) Release(ctx context.Context, releaseDTO *dto.ReleaseReservationDTO) (*dto.ReservationDTO, error) {
+	return s.settle(ctx, releaseDTO.Id, func(stock *stocks.Stock, reservation *stocks.Reservation) error {
+		return stock.Release(reservation)
+	})
+}
+
+// Commit は引当を確定し、在庫数から差し引きます。
+// 有効期限を過ぎた引当は確定できません。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - commitDTO: 確定する引当
+//
+// Returns:
+//   - *dto.ReservationDTO: 確定された引当
+//   - error: エラー情報
+func (s *StockServiceImpl) Commit(ctx context.Context, commitDTO *dto.CommitReservationDTO) (*dto.ReservationDTO, error) {
+	now := s.now()
+	return s.settle(ctx, commitDTO.Id, func(stock *stocks.Stock, reservation *stocks.Reservation) error {
+		return stock.Commit(reservation, now)
+	})
+}
+
+// ExpireReservations は有効期限切れの引当を失効させ、在庫を引当可能に戻します。
+// 商品ごとに個別のトランザクションで処理し、一部の商品で失敗しても残りの商品の処理を続けます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - limit: 1回の処理で対象とする商品数の上限
+//
+// Returns:
+//   - int: 失効させた引当の件数
+//   - error: 失敗した商品のエラーをまとめたエラー
+func (s *StockServiceImpl) ExpireReservations(ctx context.Context, limit int) (int, error) {
+	now := s.now()
+	productIds, err := s.findProductIdsWithExpiredReservations(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	var expireErrors []error
+	for _, productId := range productIds {
+		count, err := s.expireByProductId(ctx, productId, now)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "期限切れの引当の失効に失敗しました",
+				slog.String("product_id", productId.Value()), slog.Any("error", err))
+			expireErrors = append(expireErrors, err)
+			continue
+		}
+		expired += count
+	}
+	return expired, errors.Join(expireErrors...)
+}
+
+// settle は引当を解放または確定する共通処理です。
+// デッドロックを避けるため、在庫行、引当行の順にロックします。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - id: 引当ID
+//   - operation: ロックした在庫と引当に対する操作
+//
+// Returns:
+//   - *dto.ReservationDTO: 操作後の引当
+//   - error: エラー情報
+func (s *StockServiceImpl) settle(ctx context.Context, id string, operation func(*stocks.Stock, *stocks.Reservation) error) (result *dto.ReservationDTO, err error) {
+	var (
+		tx            *sql.Tx
+		reservationId *stocks.ReservationId
+		reservation   *stocks.Reservation
+		stock         *stocks.Stock
+	)
+
+	reservationId, err = stocks.NewReservationId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	// 在庫行を先にロックするため、ロックせずに引当の商品IDを取得する
+	reservation, err = s.stockRepo.FindReservationById(ctx, tx, reservationId)
+	if err != nil {
+		err = toNotFoundError(err, "RESERVATION_NOT_FOUND", "Reservation not found")
+		return nil, err
+	}
+	stock, err = s.stockRepo.LockByProductId(ctx, tx, reservation.ProductId())
+	if err != nil {
+		return nil, err
+	}
+	reservation, err = s.stockRepo.LockReservationById(ctx, tx, reservationId)
+	if err != nil {
+		err = toNotFoundError(err, "RESERVATION_NOT_FOUND", "Reservation not found")
+		return nil, err
+	}
+
+	if err = operation(stock, reservation); err != nil {
+		return nil, err
+	}
+	if err = s.stockRepo.UpdateReservationStatus(ctx, tx, reservation); err != nil {
+		return nil, err
+	}
+	if err = s.stockRepo.Update(ctx, tx, stock); err != nil {
+		return nil, err
+	}
+
+	result = dto.NewReservationDTOFromEntity(reservation, stock)
+	return result, nil
+}
+
+// lockStock は商品の存在を確認してから在庫行をロックして取得します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//
+// Returns:
+//   - *stocks.Stock: ロックした在庫
+//   - error: 商品が存在しない場合はPRODUCT_NOT_FOUNDエラー
+func (s *StockServiceImpl) lockStock(ctx context.Context, tx *sql.Tx, productId *products.ProductId) (*stocks.Stock, error) {
+	exists, err := s.productRepo.ExistsById(ctx, tx, productId)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errs.NewApplicationError("PRODUCT_NOT_FOUND", "Product not found")
+	}
+	return s.stockRepo.LockByProductId(ctx, tx, productId)
+}
+
+// expireStale はロック済みの在庫について期限切れの引当を失効させます。
+// 在庫の更新は呼び出し元で行います。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - tx: トランザクション
+//   - stock: ロック済みの在庫
+//   - now: 現在時刻
+//
+// Returns:
+//   - int: 失効させた引当の件数
+//   - error: エラー情報
+func (s *StockServiceImpl) expireStale(ctx context.Context, tx *sql.Tx, stock *stocks.Stock, now time.Time) (int, error) {
+	reservations, err := s.stockRepo.FindExpiredReservations(ctx, tx, stock.ProductId(), now)
+	if err != nil {
+		return 0, err
+	}
+	for _, reservation := range reservations {
+		if err := stock.Expire(reservation); err != nil {
+			return 0, err
+		}
+		if err := s.stockRepo.UpdateReservationStatus(ctx, tx, reservation); err != nil {
+			return 0, err
+		}
+	}
+	return len(reservations), nil
+}
+
+// findProductIdsWithExpiredReservations は期限切れの引当を持つ商品IDを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - now: 現在時刻
+//   - limit: 取得する商品数の上限
+//
+// Returns:
+//   - []*products.ProductId: 商品IDのリスト
+//   - error: エラー情報
+func (s *StockServiceImpl) findProductIdsWithExpiredReservations(ctx context.Context, now time.Time, limit int) (result []*products.ProductId, err error) {
+	var tx *sql.Tx
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	result, err = s.stockRepo.FindProductIdsWithExpiredReservations(ctx, tx, now, limit)
+	return result, err
+}
+
+// expireByProductId は1つの商品について期限切れの引当を失効させます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productId: 商品ID
+//   - now: 現在時刻
+//
+// Returns:
+//   - int: 失効させた引当の件数
+//   - error: エラー情報
+func (s *StockServiceImpl) expireByProductId(ctx context.Context, productId *products.ProductId, now time.Time) (result int, err error) {
+	var (
+		tx    *sql.Tx
+		stock *stocks.Stock
+	)
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	stock, err = s.stockRepo.LockByProductId(ctx, tx, productId)
+	if err != nil {
+		return 0, err
+	}
+	result, err = s.expireStale(ctx, tx, stock, now)
+	if err != nil || result == 0 {
+		return result, err
+	}
+	if err = s.stockRepo.Update(ctx, tx, stock); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+var _ service.StockService = (*StockServiceImpl)(nil)
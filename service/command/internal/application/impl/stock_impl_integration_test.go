@@ -0,0 +1,153 @@
+//go:build integration || !ci
+
+package impl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/stocks"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/repository"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/testhelpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StockService Integration Test", Ordered, func() {
+	var (
+		ss           *StockServiceImpl
+		ps           service.ProductService
+		cs           service.CategoryService
+		tm           service.TransactionManager
+		productRepo  products.ProductRepository
+		categoryRepo categories.CategoryRepository
+		ctx          context.Context
+		productDTO   *dto.ProductDTO
+	)
+
+	BeforeAll(func() {
+		err := testhelpers.SetupDatabase("../../../", "config")
+		Expect(err).NotTo(HaveOccurred())
+
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		productRepo = repository.NewProductRepositoryImpl(logger)
+		categoryRepo = repository.NewCategoryRepositoryImpl(logger)
+		tm = repository.NewTransactionManagerImpl(logger)
+		policy, err := stocks.NewReservationPolicy(15*time.Minute, time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		ss = NewStockServiceImpl(logger, repository.NewStockRepositoryImpl(logger), productRepo, tm, policy)
+		ps = NewProductServiceImpl(logger, productRepo, categoryRepo, tm)
+		cs = NewCategoryServiceImpl(logger, categoryRepo, tm)
+	})
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ss.now = func() time.Time { return time.Now().UTC() }
+
+		// 各テストで在庫0の新しい商品を作成する（在庫と引当は商品の削除時にカスケード削除される）
+		categoryDTO, err := cs.Add(ctx, &dto.CreateCategoryDTO{Name: testhelpers.GenerateUniqueCategoryName()})
+		Expect(err).NotTo(HaveOccurred())
+		productDTO, err = ps.Add(ctx, &dto.CreateProductDTO{
+			Name:     testhelpers.GenerateUniqueProductName(),
+			Price:    1000,
+			Category: categoryDTO,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(testhelpers.CleanupProductCategory, tm, productRepo, categoryRepo, productDTO, categoryDTO)
+	})
+
+	It("同時に引き当てても在庫数を超えて引き当てないこと", func() {
+		_, err := ss.Adjust(ctx, &dto.AdjustStockDTO{ProductId: productDTO.Id, Delta: 10})
+		Expect(err).NotTo(HaveOccurred())
+
+		const requests = 20
+		var (
+			wg        sync.WaitGroup
+			mu        sync.Mutex
+			succeeded int
+			rejected  int
+		)
+		for range requests {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer GinkgoRecover()
+				_, reserveErr := ss.Reserve(ctx, &dto.ReserveStockDTO{ProductId: productDTO.Id, Quantity: 1})
+				mu.Lock()
+				defer mu.Unlock()
+				if reserveErr == nil {
+					succeeded++
+					return
+				}
+				var domainErr *errs.DomainError
+				Expect(errors.As(reserveErr, &domainErr)).To(BeTrue(), "unexpected error: %v", reserveErr)
+				Expect(domainErr.Code).To(Equal("INSUFFICIENT_STOCK"))
+				rejected++
+			}()
+		}
+		wg.Wait()
+
+		Expect(succeeded).To(Equal(10))
+		Expect(rejected).To(Equal(requests - 10))
+
+		stock, err := ss.Adjust(ctx, &dto.AdjustStockDTO{ProductId: productDTO.Id, Delta: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stock.Reserved).To(Equal(uint32(10)))
+		Expect(stock.Available).To(Equal(uint32(1)))
+	})
+
+	It("引当を確定すると在庫数から差し引かれ、二重に確定できないこと", func() {
+		_, err := ss.Adjust(ctx, &dto.AdjustStockDTO{ProductId: productDTO.Id, Delta: 5})
+		Expect(err).NotTo(HaveOccurred())
+		reservation, err := ss.Reserve(ctx, &dto.ReserveStockDTO{ProductId: productDTO.Id, Quantity: 3})
+		Expect(err).NotTo(HaveOccurred())
+
+		committed, err := ss.Commit(ctx, &dto.CommitReservationDTO{Id: reservation.Id})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(committed.Status).To(Equal("COMMITTED"))
+		Expect(committed.Stock).To(Equal(&dto.StockDTO{ProductId: productDTO.Id, OnHand: 2, Reserved: 0, Available: 2}))
+
+		_, err = ss.Release(ctx, &dto.ReleaseReservationDTO{Id: reservation.Id})
+		var domainErr *errs.DomainError
+		Expect(errors.As(err, &domainErr)).To(BeTrue())
+		Expect(domainErr.Code).To(Equal("RESERVATION_NOT_ACTIVE"))
+	})
+
+	It("期限切れの引当は失効処理で引当可能に戻ること", func() {
+		_, err := ss.Adjust(ctx, &dto.AdjustStockDTO{ProductId: productDTO.Id, Delta: 5})
+		Expect(err).NotTo(HaveOccurred())
+		reservation, err := ss.Reserve(ctx, &dto.ReserveStockDTO{ProductId: productDTO.Id, Quantity: 5, TTL: time.Minute})
+		Expect(err).NotTo(HaveOccurred())
+
+		// 有効期限の後に時刻を進める
+		ss.now = func() time.Time { return time.Now().UTC().Add(2 * time.Minute) }
+		expired, err := ss.ExpireReservations(ctx, 1000)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expired).To(BeNumerically(">=", 1))
+
+		_, err = ss.Commit(ctx, &dto.CommitReservationDTO{Id: reservation.Id})
+		var domainErr *errs.DomainError
+		Expect(errors.As(err, &domainErr)).To(BeTrue())
+		Expect(domainErr.Code).To(Equal("RESERVATION_NOT_ACTIVE"))
+
+		stock, err := ss.Adjust(ctx, &dto.AdjustStockDTO{ProductId: productDTO.Id, Delta: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stock.Available).To(Equal(uint32(6)))
+	})
+
+	It("存在しない引当はRESERVATION_NOT_FOUNDエラーになること", func() {
+		_, err := ss.Release(ctx, &dto.ReleaseReservationDTO{Id: "00000000-0000-4000-8000-000000000000"})
+		var appErr *errs.ApplicationError
+		Expect(errors.As(err, &appErr)).To(BeTrue())
+		Expect(appErr.Code).To(Equal("RESERVATION_NOT_FOUND"))
+	})
+})
@@ -0,0 +1,291 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/stocks"
+	mock_repository "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/mock/repository"
+	mock_service "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/mock/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("StockService", Label("UnitTests"), func() {
+	var (
+		ctrl            *gomock.Controller
+		mockStockRepo   *mock_repository.MockStockRepository
+		mockProductRepo *mock_repository.MockProductRepository
+		mockTm          *mock_service.MockTransactionManager
+		ss              *StockServiceImpl
+		ctx             context.Context
+		mockTx          *sql.Tx
+		now             time.Time
+		productId       *products.ProductId
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockStockRepo = mock_repository.NewMockStockRepository(ctrl)
+		mockProductRepo = mock_repository.NewMockProductRepository(ctrl)
+		mockTm = mock_service.NewMockTransactionManager(ctrl)
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		policy, err := stocks.NewReservationPolicy(15*time.Minute, time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		ss = NewStockServiceImpl(logger, mockStockRepo, mockProductRepo, mockTm, policy)
+		now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		ss.now = func() time.Time { return now }
+		ctx = context.Background()
+		mockTx = &sql.Tx{}
+		productId, err = products.NewProductId(uuid.NewString())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	// buildStock はテスト用の在庫集約を生成します。
+	buildStock := func(onHand uint32, reserved uint32) *stocks.Stock {
+		stock, err := stocks.BuildStock(productId, onHand, reserved)
+		Expect(err).NotTo(HaveOccurred())
+		return stock
+	}
+
+	// buildReservation はテスト用の引当を生成します。
+	buildReservation := func(quantity uint32, status stocks.ReservationStatus, expiresAt time.Time) *stocks.Reservation {
+		id, err := stocks.NewReservationId(uuid.NewString())
+		Expect(err).NotTo(HaveOccurred())
+		q, err := stocks.NewQuantity(quantity)
+		Expect(err).NotTo(HaveOccurred())
+		reservation, err := stocks.BuildReservation(id, productId, q, status, expiresAt)
+		Expect(err).NotTo(HaveOccurred())
+		return reservation
+	}
+
+	// expectAppError はエラーが指定したコードのApplicationErrorであることを検証します。
+	expectAppError := func(err error, code string) {
+		Expect(err).To(HaveOccurred())
+		var appErr *errs.ApplicationError
+		Expect(errors.As(err, &appErr)).To(BeTrue())
+		Expect(appErr.Code).To(Equal(code))
+	}
+
+	// expectDomainError はエラーが指定したコードのDomainErrorであることを検証します。
+	expectDomainError := func(err error, code string) {
+		Expect(err).To(HaveOccurred())
+		var domainErr *errs.DomainError
+		Expect(errors.As(err, &domainErr)).To(BeTrue())
+		Expect(domainErr.Code).To(Equal(code))
+	}
+
+	Describe("Adjust", func() {
+		It("在庫行をロックして在庫数を増減すること", func() {
+			stock := buildStock(10, 2)
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().ExistsById(ctx, mockTx, productId).Return(true, nil),
+				mockStockRepo.EXPECT().LockByProductId(ctx, mockTx, productId).Return(stock, nil),
+				mockStockRepo.EXPECT().Update(ctx, mockTx, stock).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ss.Adjust(ctx, &dto.AdjustStockDTO{ProductId: productId.Value(), Delta: 5})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(&dto.StockDTO{ProductId: productId.Value(), OnHand: 15, Reserved: 2, Available: 13}))
+		})
+
+		It("商品が存在しない場合はPRODUCT_NOT_FOUNDエラーを返すこと", func() {
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().ExistsById(ctx, mockTx, productId).Return(false, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ss.Adjust(ctx, &dto.AdjustStockDTO{ProductId: productId.Value(), Delta: 5})
+
+			expectAppError(err, "PRODUCT_NOT_FOUND")
+			Expect(result).To(BeNil())
+		})
+
+		It("引当済みの数量を下回る場合はINSUFFICIENT_STOCKエラーでロールバックすること", func() {
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().ExistsById(ctx, mockTx, productId).Return(true, nil),
+				mockStockRepo.EXPECT().LockByProductId(ctx, mockTx, productId).Return(buildStock(10, 8), nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ss.Adjust(ctx, &dto.AdjustStockDTO{ProductId: productId.Value(), Delta: -5})
+
+			expectDomainError(err, "INSUFFICIENT_STOCK")
+			Expect(result).To(BeNil())
+		})
+	})
+
+	Describe("Reserve", func() {
+		It("期限切れの引当を失効させてから引き当てること", func() {
+			stock := buildStock(10, 8)
+			expired := buildReservation(8, stocks.RESERVATION_RESERVED, now.Add(-time.Second))
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().ExistsById(ctx, mockTx, productId).Return(true, nil),
+				mockStockRepo.EXPECT().LockByProductId(ctx, mockTx, productId).Return(stock, nil),
+				mockStockRepo.EXPECT().FindExpiredReservations(ctx, mockTx, productId, now).Return([]*stocks.Reservation{expired}, nil),
+				mockStockRepo.EXPECT().UpdateReservationStatus(ctx, mockTx, expired).Return(nil),
+				mockStockRepo.EXPECT().CreateReservation(ctx, mockTx, gomock.Any()).Return(nil),
+				mockStockRepo.EXPECT().Update(ctx, mockTx, stock).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ss.Reserve(ctx, &dto.ReserveStockDTO{ProductId: productId.Value(), Quantity: 6})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expired.Status()).To(Equal(stocks.RESERVATION_EXPIRED))
+			Expect(result.Quantity).To(Equal(uint32(6)))
+			Expect(result.Status).To(Equal("RESERVED"))
+			Expect(result.ExpiresAt).To(Equal(now.Add(15 * time.Minute)))
+			Expect(result.Stock).To(Equal(&dto.StockDTO{ProductId: productId.Value(), OnHand: 10, Reserved: 6, Available: 4}))
+		})
+
+		It("引当可能な数量が不足する場合はINSUFFICIENT_STOCKエラーでロールバックすること", func() {
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().ExistsById(ctx, mockTx, productId).Return(true, nil),
+				mockStockRepo.EXPECT().LockByProductId(ctx, mockTx, productId).Return(buildStock(10, 8), nil),
+				mockStockRepo.EXPECT().FindExpiredReservations(ctx, mockTx, productId, now).Return(nil, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ss.Reserve(ctx, &dto.ReserveStockDTO{ProductId: productId.Value(), Quantity: 3})
+
+			expectDomainError(err, "INSUFFICIENT_STOCK")
+			Expect(result).To(BeNil())
+		})
+
+		It("有効期限が上限を超える場合はトランザクションを開始せずにエラーを返すこと", func() {
+			result, err := ss.Reserve(ctx, &dto.ReserveStockDTO{ProductId: productId.Value(), Quantity: 1, TTL: 2 * time.Hour})
+
+			expectDomainError(err, "INVALID_ARGUMENT")
+			Expect(result).To(BeNil())
+		})
+	})
+
+	Describe("Release", func() {
+		It("在庫行、引当行の順にロックして引当を解放すること", func() {
+			stock := buildStock(10, 4)
+			reservation := buildReservation(4, stocks.RESERVATION_RESERVED, now.Add(time.Minute))
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockStockRepo.EXPECT().FindReservationById(ctx, mockTx, reservation.Id()).Return(reservation, nil),
+				mockStockRepo.EXPECT().LockByProductId(ctx, mockTx, productId).Return(stock, nil),
+				mockStockRepo.EXPECT().LockReservationById(ctx, mockTx, reservation.Id()).Return(reservation, nil),
+				mockStockRepo.EXPECT().UpdateReservationStatus(ctx, mockTx, reservation).Return(nil),
+				mockStockRepo.EXPECT().Update(ctx, mockTx, stock).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ss.Release(ctx, &dto.ReleaseReservationDTO{Id: reservation.Id().Value()})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal("RELEASED"))
+			Expect(result.Stock.Available).To(Equal(uint32(10)))
+		})
+
+		It("引当が存在しない場合はRESERVATION_NOT_FOUNDエラーを返すこと", func() {
+			id := uuid.NewString()
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockStockRepo.EXPECT().FindReservationById(ctx, mockTx, gomock.Any()).Return(nil, errs.NewCRUDError("NOT_FOUND", "not found")),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ss.Release(ctx, &dto.ReleaseReservationDTO{Id: id})
+
+			expectAppError(err, "RESERVATION_NOT_FOUND")
+			Expect(result).To(BeNil())
+		})
+	})
+
+	Describe("Commit", func() {
+		It("引当を確定して在庫数から差し引くこと", func() {
+			stock := buildStock(10, 4)
+			reservation := buildReservation(4, stocks.RESERVATION_RESERVED, now.Add(time.Minute))
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockStockRepo.EXPECT().FindReservationById(ctx, mockTx, reservation.Id()).Return(reservation, nil),
+				mockStockRepo.EXPECT().LockByProductId(ctx, mockTx, productId).Return(stock, nil),
+				mockStockRepo.EXPECT().LockReservationById(ctx, mockTx, reservation.Id()).Return(reservation, nil),
+				mockStockRepo.EXPECT().UpdateReservationStatus(ctx, mockTx, reservation).Return(nil),
+				mockStockRepo.EXPECT().Update(ctx, mockTx, stock).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ss.Commit(ctx, &dto.CommitReservationDTO{Id: reservation.Id().Value()})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal("COMMITTED"))
+			Expect(result.Stock).To(Equal(&dto.StockDTO{ProductId: productId.Value(), OnHand: 6, Reserved: 0, Available: 6}))
+		})
+
+		It("有効期限切れの引当はRESERVATION_EXPIREDエラーでロールバックすること", func() {
+			stock := buildStock(10, 4)
+			reservation := buildReservation(4, stocks.RESERVATION_RESERVED, now)
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockStockRepo.EXPECT().FindReservationById(ctx, mockTx, reservation.Id()).Return(reservation, nil),
+				mockStockRepo.EXPECT().LockByProductId(ctx, mockTx, productId).Return(stock, nil),
+				mockStockRepo.EXPECT().LockReservationById(ctx, mockTx, reservation.Id()).Return(reservation, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ss.Commit(ctx, &dto.CommitReservationDTO{Id: reservation.Id().Value()})
+
+			expectDomainError(err, "RESERVATION_EXPIRED")
+			Expect(result).To(BeNil())
+		})
+	})
+
+	Describe("ExpireReservations", func() {
+		It("商品ごとに期限切れの引当を失効させ、失敗した商品があっても処理を続けること", func() {
+			otherId, err := products.NewProductId(uuid.NewString())
+			Expect(err).NotTo(HaveOccurred())
+			otherTx := &sql.Tx{}
+			listTx := &sql.Tx{}
+			stock := buildStock(10, 3)
+			expired := buildReservation(3, stocks.RESERVATION_RESERVED, now.Add(-time.Minute))
+			lockErr := errors.New("lock wait timeout")
+
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(listTx, nil),
+				mockStockRepo.EXPECT().FindProductIdsWithExpiredReservations(ctx, listTx, now, 10).Return([]*products.ProductId{otherId, productId}, nil),
+				mockTm.EXPECT().Complete(ctx, listTx, nil).Return(nil),
+				mockTm.EXPECT().Begin(ctx).Return(otherTx, nil),
+				mockStockRepo.EXPECT().LockByProductId(ctx, otherTx, otherId).Return(nil, lockErr),
+				mockTm.EXPECT().Complete(ctx, otherTx, lockErr).Return(nil),
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockStockRepo.EXPECT().LockByProductId(ctx, mockTx, productId).Return(stock, nil),
+				mockStockRepo.EXPECT().FindExpiredReservations(ctx, mockTx, productId, now).Return([]*stocks.Reservation{expired}, nil),
+				mockStockRepo.EXPECT().UpdateReservationStatus(ctx, mockTx, expired).Return(nil),
+				mockStockRepo.EXPECT().Update(ctx, mockTx, stock).Return(nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			count, err := ss.ExpireReservations(ctx, 10)
+
+			Expect(err).To(MatchError(lockErr))
+			Expect(count).To(Equal(1))
+			Expect(stock.Available()).To(Equal(uint32(10)))
+		})
+	})
+})
@@ -0,0 +1,189 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/tags"
+)
+
+// TagServiceImpl はタグサービスの実装です。
+// 複数の商品と複数のタグの組み合わせを1つのトランザクションでまとめて付与・削除します。
+type TagServiceImpl struct {
+	tagRepo     tags.TagRepository         // タグと商品へのタグ付けの永続化
+	productRepo products.ProductRepository // 商品の存在確認
+	tm          service.TransactionManager // トランザクション管理
+	logger      *slog.Logger               // 構造化ログ出力
+}
+
+// NewTagServiceImpl は新しいTagServiceImplインスタンスを生成します。
+//
+// Parameters:
+//   - logger: 構造化ログ出力用のロガー
+//   - tagRepo: タグと商品へのタグ付けの永続化を担うリポジトリ
+//   - productRepo: 商品の存在確認を担うリポジトリ
+//   - tm: トランザクション管理を担うマネージャー
+//
+// Returns:
+//   - *TagServiceImpl: 初期化されたタグサービス実装
+func NewTagServiceImpl(logger *slog.Logger, tagRepo tags.TagRepository, productRepo products.ProductRepository, tm service.TransactionManager) *TagServiceImpl {
+	return &TagServiceImpl{
+		tagRepo:     tagRepo,
+		productRepo: productRepo,
+		tm:          tm,
+		logger:      logger,
+	}
+}
+
+// Attach は指定されたすべての商品に指定されたすべてのタグを付与します。
+// 存在しないタグは作成してから付与し、既に付与されている組み合わせは無視します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - changeDTO: 対象の商品と付与するタグ名
+//
+// Returns:
+//   - *dto.TagChangeResultDTO: 付与したタグと新たに付与した組み合わせの数
+//   - error: 商品が存在しない場合はPRODUCT_NOT_FOUNDエラー
+func (s *TagServiceImpl) Attach(ctx context.Context, changeDTO *dto.ChangeTagsDTO) (result *dto.TagChangeResultDTO, err error) {
+	var (
+		tx         *sql.Tx
+		productIds []*products.ProductId
+		tagNames   []*tags.TagName
+		newTags    []*tags.Tag
+		tagList    []*tags.Tag
+		attached   int64
+	)
+
+	productIds, err = products.NewProductIds(changeDTO.ProductIds)
+	if err != nil {
+		return nil, err
+	}
+	tagNames, err = tags.NewTagNames(changeDTO.TagNames)
+	if err != nil {
+		return nil, err
+	}
+	newTags = make([]*tags.Tag, 0, len(tagNames))
+	for _, name := range tagNames {
+		tag, tagErr := tags.NewTag(name)
+		if tagErr != nil {
+			return nil, tagErr
+		}
+		newTags = append(newTags, tag)
+	}
+
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	if err = s.ensureProductsExist(ctx, tx, productIds); err != nil {
+		return nil, err
+	}
+	// 既存のタグは作成せず、作成後に名前で取得して既存のタグのIDを使用する
+	if err = s.tagRepo.CreateIfNotExists(ctx, tx, newTags); err != nil {
+		return nil, err
+	}
+	tagList, err = s.tagRepo.LockByNames(ctx, tx, tagNames)
+	if err != nil {
+		return nil, err
+	}
+	attached, err = s.tagRepo.AttachToProducts(ctx, tx, productIds, tagIds(tagList))
+	if err != nil {
+		return nil, err
+	}
+
+	result = dto.NewTagChangeResultDTO(tagList, attached)
+	return result, nil
+}
+
+// Detach は指定されたすべての商品から指定されたすべてのタグを削除します。
+// 存在しないタグ名や付与されていない組み合わせは無視します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - changeDTO: 対象の商品と削除するタグ名
+//
+// Returns:
+//   - *dto.TagChangeResultDTO: 削除したタグと削除した組み合わせの数
+//   - error: 商品が存在しない場合はPRODUCT_NOT_FOUNDエラー
+func (s *TagServiceImpl) Detach(ctx context.Context, changeDTO *dto.ChangeTagsDTO) (result *dto.TagChangeResultDTO, err error) {
+	var (
+		tx         *sql.Tx
+		productIds []*products.ProductId
+		tagNames   []*tags.TagName
+		tagList    []*tags.Tag
+		detached   int64
+	)
+
+	productIds, err = products.NewProductIds(changeDTO.ProductIds)
+	if err != nil {
+		return nil, err
+	}
+	tagNames, err = tags.NewTagNames(changeDTO.TagNames)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = s.tm.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		handleTransactionComplete(ctx, s.tm, tx, &err, &result, s.logger)
+	}()
+
+	if err = s.ensureProductsExist(ctx, tx, productIds); err != nil {
+		return nil, err
+	}
+	tagList, err = s.tagRepo.LockByNames(ctx, tx, tagNames)
+	if err != nil {
+		return nil, err
+	}
+	detached, err = s.tagRepo.DetachFromProducts(ctx, tx, productIds, tagIds(tagList))
+	if err != nil {
+		return nil, err
+	}
+
+	result = dto.NewTagChangeResultDTO(tagList, detached)
+	return result, nil
+}
+
+// ensureProductsExist は指定されたすべての商品が存在することを確認します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - tx: トランザクション
+//   - productIds: 商品ID
+//
+// Returns:
+//   - error: 存在しない商品がある場合はPRODUCT_NOT_FOUNDエラー
+func (s *TagServiceImpl) ensureProductsExist(ctx context.Context, tx *sql.Tx, productIds []*products.ProductId) error {
+	for _, productId := range productIds {
+		exists, err := s.productRepo.ExistsById(ctx, tx, productId)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errs.NewApplicationError("PRODUCT_NOT_FOUND", "Product not found: "+productId.Value())
+		}
+	}
+	return nil
+}
+
+// tagIds はタグのIDの一覧を返します。
+func tagIds(tagList []*tags.Tag) []*tags.TagId {
+	ids := make([]*tags.TagId, 0, len(tagList))
+	for _, tag := range tagList {
+		ids = append(ids, tag.Id())
+	}
+	return ids
+}
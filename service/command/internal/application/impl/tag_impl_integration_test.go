@@ -0,0 +1,74 @@
+//go:build integration || !ci
+
+package impl
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/repository"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/testhelpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TagService Integration Test", Ordered, func() {
+	var (
+		ts           *TagServiceImpl
+		ps           service.ProductService
+		cs           service.CategoryService
+		tm           service.TransactionManager
+		productRepo  products.ProductRepository
+		categoryRepo categories.CategoryRepository
+		ctx          context.Context
+		productDTO   *dto.ProductDTO
+	)
+
+	BeforeAll(func() {
+		err := testhelpers.SetupDatabase("../../../", "config")
+		Expect(err).NotTo(HaveOccurred())
+
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		productRepo = repository.NewProductRepositoryImpl(logger)
+		categoryRepo = repository.NewCategoryRepositoryImpl(logger)
+		tm = repository.NewTransactionManagerImpl(logger)
+		ts = NewTagServiceImpl(logger, repository.NewTagRepositoryImpl(logger), productRepo, tm)
+		ps = NewProductServiceImpl(logger, productRepo, categoryRepo, tm)
+		cs = NewCategoryServiceImpl(logger, categoryRepo, tm)
+	})
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		// 商品とタグの関連は商品の削除時にカスケード削除される
+		categoryDTO, err := cs.Add(ctx, &dto.CreateCategoryDTO{Name: testhelpers.GenerateUniqueCategoryName()})
+		Expect(err).NotTo(HaveOccurred())
+		productDTO, err = ps.Add(ctx, &dto.CreateProductDTO{
+			Name:     testhelpers.GenerateUniqueProductName(),
+			Price:    1000,
+			Category: categoryDTO,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(testhelpers.CleanupProductCategory, tm, productRepo, categoryRepo, productDTO, categoryDTO)
+	})
+
+	It("既存のタグは再利用し、同じタグを二重に付与しないこと", func() {
+		attached, err := ts.Attach(ctx, &dto.ChangeTagsDTO{ProductIds: []string{productDTO.Id}, TagNames: []string{"ﾜｲﾔﾚｽ"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(attached.Changed).To(Equal(int64(1)))
+		Expect(attached.Tags).To(Equal([]dto.TagDTO{{Id: "0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a61", Name: "ワイヤレス"}}))
+
+		attached, err = ts.Attach(ctx, &dto.ChangeTagsDTO{ProductIds: []string{productDTO.Id}, TagNames: []string{"ワイヤレス"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(attached.Changed).To(Equal(int64(0)))
+
+		detached, err := ts.Detach(ctx, &dto.ChangeTagsDTO{ProductIds: []string{productDTO.Id}, TagNames: []string{"ワイヤレス"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(detached.Changed).To(Equal(int64(1)))
+	})
+})
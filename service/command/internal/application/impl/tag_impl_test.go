@@ -0,0 +1,130 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/tags"
+	mock_repository "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/mock/repository"
+	mock_service "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/mock/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("TagService", Label("UnitTests"), func() {
+	var (
+		ctrl            *gomock.Controller
+		mockTagRepo     *mock_repository.MockTagRepository
+		mockProductRepo *mock_repository.MockProductRepository
+		mockTm          *mock_service.MockTransactionManager
+		ts              *TagServiceImpl
+		ctx             context.Context
+		mockTx          *sql.Tx
+		productId       *products.ProductId
+		wireless        *tags.Tag
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockTagRepo = mock_repository.NewMockTagRepository(ctrl)
+		mockProductRepo = mock_repository.NewMockProductRepository(ctrl)
+		mockTm = mock_service.NewMockTransactionManager(ctrl)
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		ts = NewTagServiceImpl(logger, mockTagRepo, mockProductRepo, mockTm)
+		ctx = context.Background()
+		mockTx = &sql.Tx{}
+
+		var err error
+		productId, err = products.NewProductId(uuid.NewString())
+		Expect(err).NotTo(HaveOccurred())
+		id, err := tags.NewTagId(uuid.NewString())
+		Expect(err).NotTo(HaveOccurred())
+		name, err := tags.NewTagName("ワイヤレス")
+		Expect(err).NotTo(HaveOccurred())
+		wireless, err = tags.BuildTag(id, name)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("Attach", func() {
+		It("重複を除いたタグを作成してから付与すること", func() {
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().ExistsById(ctx, mockTx, productId).Return(true, nil),
+				mockTagRepo.EXPECT().CreateIfNotExists(ctx, mockTx, gomock.Len(1)).Return(nil),
+				mockTagRepo.EXPECT().LockByNames(ctx, mockTx, gomock.Len(1)).Return([]*tags.Tag{wireless}, nil),
+				mockTagRepo.EXPECT().AttachToProducts(ctx, mockTx, []*products.ProductId{productId}, []*tags.TagId{wireless.Id()}).Return(int64(1), nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ts.Attach(ctx, &dto.ChangeTagsDTO{
+				ProductIds: []string{productId.Value(), productId.Value()},
+				TagNames:   []string{"ワイヤレス", "ﾜｲﾔﾚｽ"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(&dto.TagChangeResultDTO{
+				Tags:    []dto.TagDTO{{Id: wireless.Id().Value(), Name: "ワイヤレス"}},
+				Changed: 1,
+			}))
+		})
+
+		It("商品が存在しない場合はPRODUCT_NOT_FOUNDエラーでロールバックすること", func() {
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().ExistsById(ctx, mockTx, productId).Return(false, nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, gomock.Any()).Return(nil),
+			)
+
+			result, err := ts.Attach(ctx, &dto.ChangeTagsDTO{ProductIds: []string{productId.Value()}, TagNames: []string{"ワイヤレス"}})
+
+			Expect(err).To(HaveOccurred())
+			var appErr *errs.ApplicationError
+			Expect(errors.As(err, &appErr)).To(BeTrue())
+			Expect(appErr.Code).To(Equal("PRODUCT_NOT_FOUND"))
+			Expect(result).To(BeNil())
+		})
+
+		It("タグ名が指定されていない場合はトランザクションを開始せずにエラーを返すこと", func() {
+			result, err := ts.Attach(ctx, &dto.ChangeTagsDTO{ProductIds: []string{productId.Value()}})
+
+			Expect(err).To(HaveOccurred())
+			var domainErr *errs.DomainError
+			Expect(errors.As(err, &domainErr)).To(BeTrue())
+			Expect(domainErr.Code).To(Equal("INVALID_ARGUMENT"))
+			Expect(result).To(BeNil())
+		})
+	})
+
+	Describe("Detach", func() {
+		It("存在するタグのみ外すこと", func() {
+			gomock.InOrder(
+				mockTm.EXPECT().Begin(ctx).Return(mockTx, nil),
+				mockProductRepo.EXPECT().ExistsById(ctx, mockTx, productId).Return(true, nil),
+				mockTagRepo.EXPECT().LockByNames(ctx, mockTx, gomock.Len(2)).Return([]*tags.Tag{wireless}, nil),
+				mockTagRepo.EXPECT().DetachFromProducts(ctx, mockTx, []*products.ProductId{productId}, []*tags.TagId{wireless.Id()}).Return(int64(1), nil),
+				mockTm.EXPECT().Complete(ctx, mockTx, nil).Return(nil),
+			)
+
+			result, err := ts.Detach(ctx, &dto.ChangeTagsDTO{
+				ProductIds: []string{productId.Value()},
+				TagNames:   []string{"ワイヤレス", "存在しないタグ"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Tags).To(HaveLen(1))
+			Expect(result.Changed).To(Equal(int64(1)))
+		})
+	})
+})
@@ -1,9 +1,13 @@
 package application
 
 import (
+	"context"
+	"log/slog"
+
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/impl"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/config"
 	"go.uber.org/fx"
 )
 
@@ -20,5 +24,67 @@ var Module = fx.Module(
 			impl.NewProductServiceImpl,
 			fx.As(new(service.ProductService)),
 		),
+		fx.Annotate(
+			impl.NewStockServiceImpl,
+			fx.As(new(service.StockService)),
+		),
+		fx.Annotate(
+			impl.NewTagServiceImpl,
+			fx.As(new(service.TagService)),
+		),
+		fx.Annotate(
+			impl.NewPriceScheduleServiceImpl,
+			fx.As(new(service.PriceScheduleService)),
+		),
+		newReservationSweeper,
+		newPriceScheduler,
 	),
+	fx.Invoke(registerLifecycleHooks),
 )
+
+// newReservationSweeper は在庫引当の設定からReservationSweeperを生成します。
+//
+// Parameters:
+//   - logger: ロガー
+//   - stockService: 在庫サービス
+//   - cfg: 在庫引当の設定
+//
+// Returns:
+//   - *impl.ReservationSweeper: 期限切れの引当を失効させるバックグラウンド処理
+func newReservationSweeper(logger *slog.Logger, stockService service.StockService, cfg *config.InventoryConfig) *impl.ReservationSweeper {
+	return impl.NewReservationSweeper(logger, stockService, cfg.SweepInterval, cfg.SweepBatchSize)
+}
+
+// newPriceScheduler は価格スケジュールの設定からPriceSchedulerを生成します。
+//
+// Parameters:
+//   - logger: ロガー
+//   - scheduleService: 価格スケジュールサービス
+//   - cfg: 価格スケジュールの設定
+//
+// Returns:
+//   - *impl.PriceScheduler: 価格スケジュールを適用・終了するバックグラウンド処理
+func newPriceScheduler(logger *slog.Logger, scheduleService service.PriceScheduleService, cfg *config.PricingConfig) *impl.PriceScheduler {
+	return impl.NewPriceScheduler(logger, scheduleService, cfg.ScheduleInterval, cfg.ScheduleBatchSize)
+}
+
+// registerLifecycleHooks は期限切れの引当の定期失効処理と価格スケジュールの定期適用処理をアプリケーションライフサイクルに登録します。
+//
+// Parameters:
+//   - lc: Fxライフサイクル
+//   - sweeper: 期限切れの引当を失効させるバックグラウンド処理
+//   - scheduler: 価格スケジュールを適用・終了するバックグラウンド処理
+func registerLifecycleHooks(lc fx.Lifecycle, sweeper *impl.ReservationSweeper, scheduler *impl.PriceScheduler) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			sweeper.Start()
+			scheduler.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			scheduler.Stop()
+			sweeper.Stop()
+			return nil
+		},
+	})
+}
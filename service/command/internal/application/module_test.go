@@ -41,17 +41,23 @@ var _ = Describe("Application Module", func() {
 		It("should provide all required services", func() {
 			var categoryService service.CategoryService
 			var productService service.ProductService
+			var stockService service.StockService
+			var tagService service.TagService
+			var priceScheduleService service.PriceScheduleService
 
 			app := fx.New(
 				configOption,
 				Module,
-				fx.Populate(&categoryService, &productService),
+				fx.Populate(&categoryService, &productService, &stockService, &tagService, &priceScheduleService),
 				fx.NopLogger,
 			)
 
 			Expect(app.Err()).ToNot(HaveOccurred())
 			Expect(categoryService).ToNot(BeNil(), "category service should be provided")
 			Expect(productService).ToNot(BeNil(), "product service should be provided")
+			Expect(stockService).ToNot(BeNil(), "stock service should be provided")
+			Expect(tagService).ToNot(BeNil(), "tag service should be provided")
+			Expect(priceScheduleService).ToNot(BeNil(), "price schedule service should be provided")
 		})
 
 		It("should properly wire dependencies from infrastructure layer", func() {
@@ -42,4 +42,15 @@ type CategoryService interface {
 	//   - *dto.CategoryDTO: 削除されたカテゴリ
 	//   - error: エラー
 	Delete(ctx context.Context, categoryDTO *dto.DeleteCategoryDTO) (*dto.CategoryDTO, error)
+
+	// Move は指定されたカテゴリを別の親カテゴリの下に移動します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - categoryDTO: 移動するカテゴリと移動先の親カテゴリ
+	//
+	// Returns:
+	//   - *dto.CategoryDTO: 移動後のカテゴリ
+	//   - error: エラー
+	Move(ctx context.Context, categoryDTO *dto.MoveCategoryDTO) (*dto.CategoryDTO, error)
 }
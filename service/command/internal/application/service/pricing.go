@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+)
+
+// PriceScheduleService は価格スケジュール（期間限定の販売価格）に関するアプリケーションサービスのインターフェースです。
+//
+//go:generate go tool mockgen -source=$GOFILE -destination=../../mock/service/price_schedule_service_mock.go -package=mock_service
+type PriceScheduleService interface {
+	// Schedule は商品に価格スケジュールを登録します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - scheduleDTO: 商品と期間中の単価、期間
+	//
+	// Returns:
+	//   - *dto.PriceScheduleDTO: 登録された価格スケジュール
+	//   - error: エラー
+	Schedule(ctx context.Context, scheduleDTO *dto.SchedulePriceDTO) (*dto.PriceScheduleDTO, error)
+
+	// Cancel は価格スケジュールを取り消します。適用中の場合は商品の単価を適用前に戻します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - cancelDTO: 取り消す価格スケジュール
+	//
+	// Returns:
+	//   - *dto.PriceScheduleDTO: 取り消された価格スケジュール
+	//   - error: エラー
+	Cancel(ctx context.Context, cancelDTO *dto.CancelPriceScheduleDTO) (*dto.PriceScheduleDTO, error)
+
+	// ApplyDue は開始時刻を過ぎた価格スケジュールを適用し、終了時刻を過ぎた価格スケジュールを終了します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - limit: 1回の処理で対象とする価格スケジュール数の上限
+	//
+	// Returns:
+	//   - int: 適用または終了した価格スケジュールの件数
+	//   - error: エラー
+	ApplyDue(ctx context.Context, limit int) (int, error)
+}
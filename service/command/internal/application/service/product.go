@@ -42,4 +42,82 @@ type ProductService interface {
 	//   - *dto.ProductDTO: 削除された商品
 	//   - error: エラー
 	Delete(ctx context.Context, productDTO *dto.DeleteProductDTO) (*dto.ProductDTO, error)
+
+	// Publish は下書きまたは一時停止中の商品を公開します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - productDTO: 公開する商品情報
+	//
+	// Returns:
+	//   - *dto.ProductDTO: 公開された商品
+	//   - error: エラー
+	Publish(ctx context.Context, productDTO *dto.ChangeProductStatusDTO) (*dto.ProductDTO, error)
+
+	// Suspend は公開中の商品の販売を一時停止します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - productDTO: 一時停止する商品情報
+	//
+	// Returns:
+	//   - *dto.ProductDTO: 一時停止された商品
+	//   - error: エラー
+	Suspend(ctx context.Context, productDTO *dto.ChangeProductStatusDTO) (*dto.ProductDTO, error)
+
+	// Discontinue は商品の販売を終了します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - productDTO: 販売を終了する商品情報
+	//
+	// Returns:
+	//   - *dto.ProductDTO: 販売を終了した商品
+	//   - error: エラー
+	Discontinue(ctx context.Context, productDTO *dto.ChangeProductStatusDTO) (*dto.ProductDTO, error)
+
+	// AddVariant は商品にバリエーションを追加します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - variantDTO: 追加するバリエーション情報
+	//
+	// Returns:
+	//   - *dto.VariantDTO: 追加されたバリエーション
+	//   - error: エラー
+	AddVariant(ctx context.Context, variantDTO *dto.AddVariantDTO) (*dto.VariantDTO, error)
+
+	// UpdateVariant は既存のバリエーションを更新します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - variantDTO: 更新するバリエーション情報
+	//
+	// Returns:
+	//   - *dto.VariantDTO: 更新されたバリエーション
+	//   - error: エラー
+	UpdateVariant(ctx context.Context, variantDTO *dto.UpdateVariantDTO) (*dto.VariantDTO, error)
+
+	// RemoveVariant は指定されたバリエーションを削除します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - variantDTO: 削除するバリエーション情報
+	//
+	// Returns:
+	//   - *dto.VariantDTO: 削除されたバリエーション
+	//   - error: エラー
+	RemoveVariant(ctx context.Context, variantDTO *dto.RemoveVariantDTO) (*dto.VariantDTO, error)
+
+	// AdjustPrices はカテゴリ（とタグ）で絞り込んだ商品の単価を一括で変更します。
+	// すべての商品を1つのトランザクションで変更し、1件でも変更できない場合はいずれの商品も変更しません。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - adjustDTO: 対象の商品の条件と変更規則
+	//
+	// Returns:
+	//   - *dto.AdjustPricesResultDTO: 商品ごとの変更前後の単価
+	//   - error: エラー
+	AdjustPrices(ctx context.Context, adjustDTO *dto.AdjustPricesDTO) (*dto.AdjustPricesResultDTO, error)
 }
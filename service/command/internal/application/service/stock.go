@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+)
+
+// StockService は在庫と在庫引当に関するアプリケーションサービスのインターフェースです。
+//
+//go:generate go tool mockgen -source=$GOFILE -destination=../../mock/service/stock_service_mock.go -package=mock_service
+type StockService interface {
+	// Adjust は入荷や棚卸によって在庫数を増減します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - adjustDTO: 増減する商品と数量
+	//
+	// Returns:
+	//   - *dto.StockDTO: 増減後の在庫
+	//   - error: エラー
+	Adjust(ctx context.Context, adjustDTO *dto.AdjustStockDTO) (*dto.StockDTO, error)
+
+	// Reserve は在庫を引き当てます。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - reserveDTO: 引き当てる商品と数量
+	//
+	// Returns:
+	//   - *dto.ReservationDTO: 作成された引当
+	//   - error: エラー
+	Reserve(ctx context.Context, reserveDTO *dto.ReserveStockDTO) (*dto.ReservationDTO, error)
+
+	// Release は引当をキャンセルし、在庫を引当可能に戻します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - releaseDTO: 解放する引当
+	//
+	// Returns:
+	//   - *dto.ReservationDTO: 解放された引当
+	//   - error: エラー
+	Release(ctx context.Context, releaseDTO *dto.ReleaseReservationDTO) (*dto.ReservationDTO, error)
+
+	// Commit は引当を確定し、在庫数から差し引きます。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - commitDTO: 確定する引当
+	//
+	// Returns:
+	//   - *dto.ReservationDTO: 確定された引当
+	//   - error: エラー
+	Commit(ctx context.Context, commitDTO *dto.CommitReservationDTO) (*dto.ReservationDTO, error)
+
+	// ExpireReservations は有効期限切れの引当を失効させ、在庫を引当可能に戻します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - limit: 1回の処理で対象とする商品数の上限
+	//
+	// Returns:
+	//   - int: 失効させた引当の件数
+	//   - error: エラー
+	ExpireReservations(ctx context.Context, limit int) (int, error)
+}
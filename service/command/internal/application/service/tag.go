@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+)
+
+// TagService は商品へのタグ付けに関するアプリケーションサービスのインターフェースです。
+//
+//go:generate go tool mockgen -source=$GOFILE -destination=../../mock/service/tag_service_mock.go -package=mock_service
+type TagService interface {
+	// Attach は指定されたすべての商品に指定されたすべてのタグを付与します。
+	// 存在しないタグは作成してから付与します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - changeDTO: 対象の商品と付与するタグ名
+	//
+	// Returns:
+	//   - *dto.TagChangeResultDTO: 付与したタグと新たに付与した組み合わせの数
+	//   - error: エラー
+	Attach(ctx context.Context, changeDTO *dto.ChangeTagsDTO) (*dto.TagChangeResultDTO, error)
+
+	// Detach は指定されたすべての商品から指定されたすべてのタグを削除します。
+	// 存在しないタグ名は無視します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - changeDTO: 対象の商品と削除するタグ名
+	//
+	// Returns:
+	//   - *dto.TagChangeResultDTO: 削除したタグと削除した組み合わせの数
+	//   - error: エラー
+	Detach(ctx context.Context, changeDTO *dto.ChangeTagsDTO) (*dto.TagChangeResultDTO, error)
+}
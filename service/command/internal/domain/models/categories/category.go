@@ -1,14 +1,23 @@
 package categories
 
 import (
+	"fmt"
+	"maps"
+
 	"github.com/google/uuid"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 )
 
 // Category はカテゴリエンティティを表すドメインオブジェクトです。
+// カテゴリは親カテゴリを1つ持つ木構造を構成し、親カテゴリを持たないカテゴリをルートカテゴリとします。
 type Category struct {
-	id   *CategoryId   // カテゴリID
-	name *CategoryName // カテゴリ名
+	id       *CategoryId   // カテゴリID
+	name     *CategoryName // カテゴリ名
+	parentId *CategoryId   // 親カテゴリID（ルートカテゴリの場合はnil）
+	slug     names.Slug    // URLに使用するスラッグ
+
+	translations map[names.Locale]*CategoryName // 既定のロケール以外のカテゴリ名
 }
 
 // Id はカテゴリIDを返します。
@@ -21,11 +30,76 @@ func (c *Category) Name() *CategoryName {
 	return c.name
 }
 
+// Slug はURLに使用するスラッグを返します。
+func (c *Category) Slug() names.Slug {
+	return c.slug
+}
+
+// ChangeSlug はスラッグを変更します。一意性の検証はアプリケーション層で行います。
+func (c *Category) ChangeSlug(slug names.Slug) {
+	c.slug = slug
+}
+
+// Translations は既定のロケール以外のカテゴリ名をロケールごとに返します。
+func (c *Category) Translations() map[names.Locale]*CategoryName {
+	return maps.Clone(c.translations)
+}
+
+// ParentId は親カテゴリIDを返します。ルートカテゴリの場合はnilを返します。
+func (c *Category) ParentId() *CategoryId {
+	return c.parentId
+}
+
+// IsRoot はルートカテゴリかどうかを返します。
+func (c *Category) IsRoot() bool {
+	return c.parentId == nil
+}
+
+// MoveTo はカテゴリを別の親カテゴリの下に移動します。
+// 自身またはその子孫の下に移動すると木構造が循環するため、移動先の親カテゴリからルートまでの経路に
+// 自身が含まれる場合はエラーを返します。
+//
+// Parameters:
+//   - parentPath: 移動先の親カテゴリからルートまでのカテゴリID（先頭が親カテゴリ）。空の場合はルートに移動します
+//
+// Returns:
+//   - error: 循環する場合はDomainError (コード: CATEGORY_CYCLE)
+func (c *Category) MoveTo(parentPath []*CategoryId) error {
+	for _, id := range parentPath {
+		if c.id.Equals(id) {
+			return errs.NewDomainError("CATEGORY_CYCLE", "カテゴリを自身またはその子孫の下に移動することはできません")
+		}
+	}
+	if len(parentPath) == 0 {
+		c.parentId = nil
+		return nil
+	}
+	c.parentId = parentPath[0]
+	return nil
+}
+
 // ChangeName はカテゴリ名を変更します。
 func (c *Category) ChangeName(name *CategoryName) {
 	c.name = name
 }
 
+// ChangeTranslations は既定のロケール以外のカテゴリ名を置き換えます。
+//
+// Parameters:
+//   - translations: ロケールごとのカテゴリ名
+//
+// Returns:
+//   - error: 既定のロケールが含まれる場合はDomainError (コード: INVALID_ARGUMENT)
+func (c *Category) ChangeTranslations(translations map[names.Locale]*CategoryName) error {
+	if _, ok := translations[names.DefaultLocale]; ok {
+		return errs.NewDomainError(
+			"INVALID_ARGUMENT", fmt.Sprintf("既定のロケール(%s)のカテゴリ名は翻訳に指定できません", names.DefaultLocale),
+		)
+	}
+	c.translations = maps.Clone(translations)
+	return nil
+}
+
 // Equals は2つのカテゴリエンティティの同一性を検証します。
 func (c *Category) Equals(other *Category) (bool, error) {
 	if other == nil {
@@ -34,7 +108,8 @@ func (c *Category) Equals(other *Category) (bool, error) {
 	return c.id.Equals(other.Id()), nil
 }
 
-// NewCategory は新しいカテゴリエンティティを生成します。
+// NewCategory は新しいルートカテゴリエンティティを生成します。
+// 親カテゴリの下に作成する場合は、生成後にMoveToで移動します。
 func NewCategory(name *CategoryName) (*Category, error) {
 	uid, err := uuid.NewRandom()
 	if err != nil {
@@ -50,10 +125,12 @@ func NewCategory(name *CategoryName) (*Category, error) {
 }
 
 // BuildCategory は既存のカテゴリIDを使用してカテゴリエンティティを再構築します。
-func BuildCategory(id *CategoryId, name *CategoryName) (*Category, error) {
+// parentIdにはルートカテゴリの場合はnilを指定します。
+func BuildCategory(id *CategoryId, name *CategoryName, parentId *CategoryId) (*Category, error) {
 	category := Category{
-		id:   id,
-		name: name,
+		id:       id,
+		name:     name,
+		parentId: parentId,
 	}
 	return &category, nil
 }
@@ -5,11 +5,13 @@ import (
 	"unicode/utf8"
 
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 )
 
 // CategoryName はカテゴリ名を表す値オブジェクトです。
 type CategoryName struct {
 	value string // カテゴリ名
+	key   string // 重複判定に使用する正規化キー
 }
 
 // Value はカテゴリ名の値を返します。
@@ -17,6 +19,12 @@ func (c *CategoryName) Value() string {
 	return c.value
 }
 
+// Key は重複判定に使用する正規化キーを返します。
+// 全角・半角や空白の違いのみのカテゴリ名は同じキーになります。
+func (c *CategoryName) Key() string {
+	return c.key
+}
+
 // NewCategoryName はカテゴリ名を生成します。
 func NewCategoryName(value string) (*CategoryName, error) {
 	const MIN_LENGTH int = 1  // 最小文字数
@@ -29,5 +37,10 @@ func NewCategoryName(value string) (*CategoryName, error) {
 		)
 	}
 
-	return &CategoryName{value: value}, nil
+	key := names.Key(value)
+	if key == "" {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "カテゴリ名は空白以外の文字を含めてください")
+	}
+
+	return &CategoryName{value: value, key: key}, nil
 }
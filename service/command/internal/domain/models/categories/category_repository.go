@@ -3,6 +3,8 @@ package categories
 import (
 	"context"
 	"database/sql"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 )
 
 // CategoryRepository はカテゴリエンティティの永続化を担うリポジトリインターフェースです。
@@ -11,6 +13,7 @@ import (
 //go:generate go tool mockgen -source=$GOFILE -destination=../../../mock/repository/category_repository_mock.go -package=mock_repository
 type CategoryRepository interface {
 	// ExistsByName は指定されたカテゴリ名が既に存在するかをチェックします。
+	// 名前は全角・半角や空白の違いを無視した正規化キーで比較します。
 	//
 	// Parameters:
 	//   - ctx: コンテキスト
@@ -22,6 +25,20 @@ type CategoryRepository interface {
 	//   - error: データベースエラーが発生した場合
 	ExistsByName(ctx context.Context, tx *sql.Tx, name *CategoryName) (bool, error)
 
+	// FindIdBySlug は指定されたスラッグを使用しているカテゴリのIDを取得します。
+	// 現在のスラッグに加えて、変更前のスラッグの履歴も検索します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - slug: スラッグ
+	//
+	// Returns:
+	//   - *CategoryId: スラッグを使用しているカテゴリのID
+	//   - error: カテゴリが存在しない場合はCRUDError (コード: NOT_FOUND)、
+	//     データベースエラーが発生した場合はそのエラー
+	FindIdBySlug(ctx context.Context, tx *sql.Tx, slug names.Slug) (*CategoryId, error)
+
 	// FindById は指定されたIDのカテゴリを取得します。
 	// カテゴリが存在しない場合はNOT_FOUNDエラーを返します。
 	//
@@ -36,6 +53,59 @@ type CategoryRepository interface {
 	//     データベースエラーが発生した場合はそのエラー
 	FindById(ctx context.Context, tx *sql.Tx, id *CategoryId) (*Category, error)
 
+	// LockById は指定されたIDのカテゴリを排他ロックして取得します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - id: 取得するカテゴリのID
+	//
+	// Returns:
+	//   - *Category: 取得したカテゴリエンティティ
+	//   - error: カテゴリが存在しない場合はCRUDError (コード: NOT_FOUND)、
+	//     データベースエラーが発生した場合はそのエラー
+	LockById(ctx context.Context, tx *sql.Tx, id *CategoryId) (*Category, error)
+
+	// LockPath は指定されたカテゴリからルートまでのカテゴリを順に排他ロックし、そのIDを返します。
+	// カテゴリの移動は、移動するカテゴリと移動先の経路をロックしてから循環を判定するため、
+	// 同時に移動しても木構造が循環することはありません。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - id: 起点となるカテゴリのID
+	//
+	// Returns:
+	//   - []*CategoryId: 起点のカテゴリからルートまでのカテゴリID（先頭が起点のカテゴリ）
+	//   - error: カテゴリが存在しない場合はCRUDError (コード: NOT_FOUND)、
+	//     データベースエラーが発生した場合はそのエラー
+	LockPath(ctx context.Context, tx *sql.Tx, id *CategoryId) ([]*CategoryId, error)
+
+	// FindSubtreeIds は指定されたカテゴリとその子孫カテゴリのIDを取得します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - id: 起点となるカテゴリのID
+	//
+	// Returns:
+	//   - []*CategoryId: 起点のカテゴリと子孫カテゴリのID（先頭が起点のカテゴリ）
+	//   - error: カテゴリが存在しない場合はCRUDError (コード: NOT_FOUND)、
+	//     データベースエラーが発生した場合はそのエラー
+	FindSubtreeIds(ctx context.Context, tx *sql.Tx, id *CategoryId) ([]*CategoryId, error)
+
+	// ExistsByParentId は指定されたカテゴリを親に持つカテゴリが存在するかをチェックします。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - parentId: 親カテゴリのID
+	//
+	// Returns:
+	//   - bool: 子カテゴリが存在する場合はtrue
+	//   - error: データベースエラーが発生した場合
+	ExistsByParentId(ctx context.Context, tx *sql.Tx, parentId *CategoryId) (bool, error)
+
 	// Create は新しいカテゴリをデータベースに作成します。
 	//
 	// Parameters:
@@ -44,10 +114,12 @@ type CategoryRepository interface {
 	//   - category: 作成するカテゴリ情報
 	//
 	// Returns:
-	//   - error: データベースエラーが発生した場合
+	//   - error: 名前の正規化キーまたはスラッグが重複する場合はCRUDError (コード: ALREADY_EXISTS)、
+	//     データベースエラーが発生した場合はそのエラー
 	Create(ctx context.Context, tx *sql.Tx, category *Category) error
 
-	// UpdateById は指定されたIDのカテゴリ情報を更新します。
+	// UpdateById は指定されたIDのカテゴリ名とスラッグを更新します。親カテゴリは変更しません。
+	// スラッグが変更された場合は、変更前のスラッグを履歴に残し、旧URLからも解決できるようにします。
 	// カテゴリが存在しない場合はNOT_FOUNDエラーを返します。
 	//
 	// Parameters:
@@ -57,9 +129,22 @@ type CategoryRepository interface {
 	//
 	// Returns:
 	//   - error: カテゴリが存在しない場合はCRUDError (コード: NOT_FOUND)、
+	//     名前の正規化キーまたはスラッグが重複する場合はCRUDError (コード: ALREADY_EXISTS)、
 	//     データベースエラーが発生した場合はそのエラー
 	UpdateById(ctx context.Context, tx *sql.Tx, category *Category) error
 
+	// UpdateParentById は指定されたIDのカテゴリの親カテゴリを更新します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - category: 移動後のカテゴリ情報
+	//
+	// Returns:
+	//   - error: カテゴリが存在しない場合はCRUDError (コード: NOT_FOUND)、
+	//     データベースエラーが発生した場合はそのエラー
+	UpdateParentById(ctx context.Context, tx *sql.Tx, category *Category) error
+
 	// DeleteById は指定されたIDのカテゴリを削除します。
 	// カテゴリが存在しない場合はNOT_FOUNDエラーを返します。
 	//
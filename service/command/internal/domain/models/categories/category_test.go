@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -87,7 +88,22 @@ var _ = Describe("Categoryエンティティを構成する値オブジェクト
 			true,
 			"INVALID_ARGUMENT",
 		),
+		Entry(
+			"空白のみの場合、エラーになること",
+			" 　 ",
+			true,
+			"INVALID_ARGUMENT",
+		),
 	)
+
+	It("全角・半角や空白の違いのみの名前は同じ正規化キーになること", func() {
+		a, err := NewCategoryName("ﾊﾟｿｺﾝ　周辺機器")
+		Expect(err).NotTo(HaveOccurred())
+		b, err := NewCategoryName("パソコン 周辺機器")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(a.Value()).NotTo(Equal(b.Value()), "表示用の値は入力のまま保持すること")
+		Expect(a.Key()).To(Equal(b.Key()))
+	})
 })
 
 var _ = Describe("Categoryエンティティオブジェクト", Label("Categoryエンティティ"), func() {
@@ -142,7 +158,7 @@ var _ = Describe("Categoryエンティティオブジェクト", Label("Category
 	DescribeTable("BuildCategory",
 		func(setupFunc func() (*CategoryId, *CategoryName), expectError bool) {
 			id, name := setupFunc()
-			category, err := BuildCategory(id, name)
+			category, err := BuildCategory(id, name, nil)
 
 			if expectError {
 				Expect(err).To(HaveOccurred())
@@ -201,6 +217,66 @@ var _ = Describe("Categoryエンティティオブジェクト", Label("Category
 		),
 	)
 
+	DescribeTable("MoveTo",
+		func(pathFunc func(self *CategoryId) []*CategoryId, expectedErrorCode string) {
+			category, err := NewCategory(validCategoryName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(category.IsRoot()).To(BeTrue())
+
+			path := pathFunc(category.Id())
+			err = category.MoveTo(path)
+
+			if expectedErrorCode != "" {
+				Expect(err).To(HaveOccurred())
+				domainErr, ok := err.(*errs.DomainError)
+				Expect(ok).To(BeTrue())
+				Expect(domainErr.Code).To(Equal(expectedErrorCode))
+				Expect(category.IsRoot()).To(BeTrue())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			if len(path) == 0 {
+				Expect(category.IsRoot()).To(BeTrue())
+				Expect(category.ParentId()).To(BeNil())
+			} else {
+				Expect(category.IsRoot()).To(BeFalse())
+				Expect(category.ParentId()).To(Equal(path[0]))
+			}
+		},
+		Entry(
+			"親カテゴリの下に移動できること",
+			func(self *CategoryId) []*CategoryId {
+				parent, _ := NewCategoryId(uuid.New().String())
+				root, _ := NewCategoryId(uuid.New().String())
+				return []*CategoryId{parent, root}
+			},
+			"",
+		),
+		Entry(
+			"空の経路の場合はルートに移動できること",
+			func(self *CategoryId) []*CategoryId {
+				return nil
+			},
+			"",
+		),
+		Entry(
+			"自身の下には移動できないこと",
+			func(self *CategoryId) []*CategoryId {
+				return []*CategoryId{self}
+			},
+			"CATEGORY_CYCLE",
+		),
+		Entry(
+			"子孫の下には移動できないこと",
+			func(self *CategoryId) []*CategoryId {
+				grandChild, _ := NewCategoryId(uuid.New().String())
+				child, _ := NewCategoryId(uuid.New().String())
+				return []*CategoryId{grandChild, child, self}
+			},
+			"CATEGORY_CYCLE",
+		),
+	)
+
 	DescribeTable("Equals",
 		func(setupFunc func() (*Category, *Category), expectEqual bool, expectError bool, expectedErrorCode string) {
 			category1, category2 := setupFunc()
@@ -223,8 +299,8 @@ var _ = Describe("Categoryエンティティオブジェクト", Label("Category
 				categoryId, _ := NewCategoryId(uuid.New().String())
 				name, _ := NewCategoryName("テストカテゴリ")
 
-				category1, _ := BuildCategory(categoryId, name)
-				category2, _ := BuildCategory(categoryId, name)
+				category1, _ := BuildCategory(categoryId, name, nil)
+				category2, _ := BuildCategory(categoryId, name, nil)
 				return category1, category2
 			},
 			true,
@@ -256,4 +332,25 @@ var _ = Describe("Categoryエンティティオブジェクト", Label("Category
 			"INVALID_ARGUMENT",
 		),
 	)
+
+	Describe("ChangeTranslations", func() {
+		It("既定のロケール以外のカテゴリ名を設定できること", func() {
+			name, _ := NewCategoryName("文房具")
+			category, _ := NewCategory(name)
+			en, _ := NewCategoryName("Stationery")
+
+			Expect(category.ChangeTranslations(map[names.Locale]*CategoryName{"en": en})).To(Succeed())
+			Expect(category.Translations()).To(HaveKeyWithValue(names.Locale("en"), en))
+		})
+
+		It("既定のロケールを指定した場合、エラーになること", func() {
+			name, _ := NewCategoryName("文房具")
+			category, _ := NewCategory(name)
+
+			err := category.ChangeTranslations(map[names.Locale]*CategoryName{names.DefaultLocale: name})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*errs.DomainError).Code).To(Equal("INVALID_ARGUMENT"))
+			Expect(category.Translations()).To(BeEmpty())
+		})
+	})
 })
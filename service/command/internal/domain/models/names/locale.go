@@ -0,0 +1,55 @@
+package names
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// DefaultLocale は既定のロケールです。商品名・カテゴリ名の本体はこのロケールの名前として扱います。
+const DefaultLocale Locale = "ja"
+
+// localePattern はロケールの形式（言語コード、または言語コードと地域コード）です。
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+
+// Locale は名前の翻訳に使用するロケールを表す値オブジェクトです。
+// 言語コードは小文字、地域コードは大文字に正規化します（例: en-us → en-US）。
+type Locale string
+
+// Value はロケールの値を返します。
+func (l Locale) Value() string {
+	return string(l)
+}
+
+// Base は地域コードを除いた言語コードを返します（例: en-US → en）。
+func (l Locale) Base() Locale {
+	base, _, _ := strings.Cut(string(l), "-")
+	return Locale(base)
+}
+
+// IsDefault は既定のロケールかどうかを返します。
+func (l Locale) IsDefault() bool {
+	return l == DefaultLocale
+}
+
+// NewLocale はロケールを生成します。
+//
+// Parameters:
+//   - value: ロケール（例: ja, en, en-US）。区切り文字には「_」も使用できます
+//
+// Returns:
+//   - Locale: 正規化したロケール
+//   - error: 形式が不正な場合はDomainError (コード: INVALID_ARGUMENT)
+func NewLocale(value string) (Locale, error) {
+	language, region, found := strings.Cut(strings.ReplaceAll(strings.TrimSpace(value), "_", "-"), "-")
+	normalized := strings.ToLower(language)
+	if found {
+		normalized += "-" + strings.ToUpper(region)
+	}
+	if !localePattern.MatchString(normalized) {
+		return "", errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("ロケールの形式が不正です: %s", value))
+	}
+	return Locale(normalized), nil
+}
@@ -0,0 +1,39 @@
+package names
+
+import (
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ロケール", Label("NewLocale関数"), func() {
+	DescribeTable("ロケールを正規化すること",
+		func(input string, expected Locale) {
+			locale, err := NewLocale(input)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(locale).To(Equal(expected))
+		},
+		Entry("言語コードのみ", "en", Locale("en")),
+		Entry("大文字の言語コード", "JA", Locale("ja")),
+		Entry("小文字の地域コード", "en-us", Locale("en-US")),
+		Entry("区切り文字がアンダースコア", "zh_tw", Locale("zh-TW")),
+	)
+
+	DescribeTable("不正な形式はエラーになること",
+		func(input string) {
+			_, err := NewLocale(input)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*errs.DomainError).Code).To(Equal("INVALID_ARGUMENT"))
+		},
+		Entry("空文字列", ""),
+		Entry("言語コードが長すぎる", "english"),
+		Entry("地域コードが数字", "es-419"),
+		Entry("区切り文字のみ", "-"),
+	)
+
+	It("地域コードを除いた言語コードを返すこと", func() {
+		Expect(Locale("en-US").Base()).To(Equal(Locale("en")))
+		Expect(Locale("ja").Base()).To(Equal(Locale("ja")))
+		Expect(DefaultLocale.IsDefault()).To(BeTrue())
+	})
+})
@@ -0,0 +1,56 @@
+// Package names は商品名・カテゴリ名の重複判定に使用する正規化処理を提供します。
+package names
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// かな変換の対象範囲（ひらがな「ぁ」〜「ゖ」と踊り字「ゝ」「ゞ」）とカタカナへのオフセット
+const (
+	HIRAGANA_FIRST       rune = 'ぁ'
+	HIRAGANA_LAST        rune = 'ゖ'
+	HIRAGANA_ITERATION   rune = 'ゝ'
+	HIRAGANA_VOICED_ITER rune = 'ゞ'
+	HIRAGANA_TO_KATAKANA rune = 'ァ' - 'ぁ'
+)
+
+// foldKana はひらがなとカタカナを同一視するかどうかです。
+var foldKana atomic.Bool
+
+// SetKanaFolding はひらがなとカタカナを同一視するかどうかを設定します。
+// 正規化キーは永続化されるため、アプリケーション起動時に一度だけ設定してください。
+func SetKanaFolding(enabled bool) {
+	foldKana.Store(enabled)
+}
+
+// KanaFolding はひらがなとカタカナを同一視する設定かどうかを返します。
+func KanaFolding() bool {
+	return foldKana.Load()
+}
+
+// Key は名前の重複判定に使用する正規化キーを返します。
+// 以下の順に正規化します。
+//   - NFKC正規化（半角カナ→全角カナ、全角英数字→半角英数字、濁点の結合など）
+//   - 幅の統一（NFKCで変換されない全角・半角記号の統一）
+//   - 空白の除去と連続する空白の1文字への集約
+//   - ひらがな→カタカナ（SetKanaFoldingで有効にした場合のみ）
+func Key(value string) string {
+	key := width.Fold.String(norm.NFKC.String(value))
+	key = strings.Join(strings.Fields(key), " ")
+	if foldKana.Load() {
+		key = strings.Map(hiraganaToKatakana, key)
+	}
+	return key
+}
+
+// hiraganaToKatakana はひらがなを対応するカタカナに変換します。
+func hiraganaToKatakana(r rune) rune {
+	if (r >= HIRAGANA_FIRST && r <= HIRAGANA_LAST) || r == HIRAGANA_ITERATION || r == HIRAGANA_VOICED_ITER {
+		return r + HIRAGANA_TO_KATAKANA
+	}
+	return r
+}
@@ -0,0 +1,47 @@
+package names
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestNamesPackage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "domain/models/names packageのテスト")
+}
+
+var _ = Describe("名前の正規化キー", Label("Key関数"), func() {
+	AfterEach(func() {
+		SetKanaFolding(false)
+	})
+
+	DescribeTable("表記ゆれを同一のキーに正規化すること",
+		func(a string, b string) {
+			Expect(Key(a)).To(Equal(Key(b)))
+		},
+		Entry("半角カナと全角カナ", "ﾎﾞｰﾙﾍﾟﾝ", "ボールペン"),
+		Entry("全角英数字と半角英数字", "ＵＳＢ３．０ケーブル", "USB3.0ケーブル"),
+		Entry("全角括弧と半角括弧", "水性ボールペン（黒）", "水性ボールペン(黒)"),
+		Entry("連続する空白と全角空白", "ワイヤレス　  マウス", "ワイヤレス マウス"),
+		Entry("前後の空白", " 文房具\t", "文房具"),
+		Entry("結合文字の濁点・半濁点", "ホ\u3099ールヘ\u309aン", "ボールペン"),
+	)
+
+	It("表記の異なる名前は別のキーになること", func() {
+		Expect(Key("ボールペン")).NotTo(Equal(Key("ボールペン(黒)")))
+	})
+
+	It("既定ではひらがなとカタカナを区別すること", func() {
+		Expect(KanaFolding()).To(BeFalse())
+		Expect(Key("ぼーるぺん")).NotTo(Equal(Key("ボールペン")))
+	})
+
+	It("かな変換を有効にするとひらがなとカタカナを同一視すること", func() {
+		SetKanaFolding(true)
+		Expect(Key("ぼーるぺん")).To(Equal(Key("ボールペン")))
+		Expect(Key("ﾎﾞｰﾙﾍﾟﾝ")).To(Equal("ボールペン"))
+		Expect(Key("いすゞ")).To(Equal("イスヾ"))
+	})
+})
@@ -0,0 +1,197 @@
+package names
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// SLUG_MAX_LENGTH はスラッグの最大文字数です。
+const SLUG_MAX_LENGTH = 100
+
+// slugPattern はスラッグの形式（半角英小文字・数字をハイフンで区切ったもの）です。
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Slug は商品・カテゴリをURLで識別するための値オブジェクトです（例: water-based-ballpoint-pen-black）。
+// 商品・カテゴリそれぞれで一意で、変更前のスラッグも履歴として引き続き解決できます。
+type Slug string
+
+// Value はスラッグの値を返します。
+func (s Slug) Value() string {
+	return string(s)
+}
+
+// WithSuffix は重複を避けるために連番を付けたスラッグを返します（例: borupen → borupen-2）。
+// 連番が1以下の場合はそのまま返します。最大文字数を超える場合は末尾を切り詰めてから連番を付けます。
+//
+// Parameters:
+//   - n: 連番
+//
+// Returns:
+//   - Slug: 連番を付けたスラッグ
+func (s Slug) WithSuffix(n int) Slug {
+	if n <= 1 {
+		return s
+	}
+	suffix := "-" + strconv.Itoa(n)
+	return Slug(truncateSlug(string(s), SLUG_MAX_LENGTH-len(suffix)) + suffix)
+}
+
+// NewSlug は明示的に指定されたスラッグを生成します。
+// 前後の空白を除去し、英字を小文字に正規化します。
+//
+// Parameters:
+//   - value: スラッグ（半角英数字とハイフン）
+//
+// Returns:
+//   - Slug: 正規化したスラッグ
+//   - error: 形式が不正な場合はDomainError (コード: INVALID_ARGUMENT)
+func NewSlug(value string) (Slug, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if len(normalized) > SLUG_MAX_LENGTH || !slugPattern.MatchString(normalized) {
+		return "", errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("スラッグは%d文字以内の半角英小文字・数字をハイフンで区切って入力してください: %s", SLUG_MAX_LENGTH, value))
+	}
+	return Slug(normalized), nil
+}
+
+// GenerateSlug は名前からスラッグを生成します。
+// 以下の順に変換します。
+//   - NFKC正規化と幅の統一（全角英数字→半角英数字、半角カナ→全角カナなど）
+//   - ひらがな・カタカナのヘボン式ローマ字への翻字（長音符は除去）
+//   - 半角英数字以外（漢字・記号・空白など）をハイフンに置換し、連続するハイフンを集約
+//
+// 漢字は翻字しないため、漢字のみの名前など変換結果が空になる場合はfallbackを使用します。
+//
+// Parameters:
+//   - name: 商品名・カテゴリ名
+//   - fallback: 変換結果が空の場合に使用する文字列（IDの先頭など）
+//
+// Returns:
+//   - Slug: 生成したスラッグ（一意性は保証しません）
+func GenerateSlug(name string, fallback string) Slug {
+	slug := slugify(transliterate(width.Fold.String(norm.NFKC.String(name))))
+	if slug == "" {
+		slug = slugify(fallback)
+	}
+	return Slug(truncateSlug(slug, SLUG_MAX_LENGTH))
+}
+
+// slugify は半角英数字以外をハイフンに置換し、連続するハイフンと前後のハイフンを除去します。
+func slugify(value string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(value) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+			continue
+		}
+		hyphen = true
+	}
+	return b.String()
+}
+
+// truncateSlug はスラッグを最大文字数で切り詰め、末尾のハイフンを除去します。
+func truncateSlug(slug string, max int) string {
+	if len(slug) > max {
+		slug = slug[:max]
+	}
+	return strings.TrimRight(slug, "-")
+}
+
+// transliterate はひらがな・カタカナをヘボン式ローマ字に翻字します。
+// 拗音（キャ、シュなど）と外来語の表記（ティ、ファなど）は2文字で1音として扱い、
+// 促音（ッ）は次の子音を重ね（チの前はt）、長音符（ー）は除去します。かな以外の文字はそのまま残します。
+func transliterate(value string) string {
+	runes := []rune(value)
+	for i, r := range runes {
+		runes[i] = hiraganaToKatakana(r)
+	}
+
+	var b strings.Builder
+	sokuon := false
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch r {
+		case 'ッ':
+			sokuon = true
+			continue
+		case 'ー':
+			continue
+		}
+
+		romaji := ""
+		if i+1 < len(runes) {
+			if digraph, ok := kanaDigraphs[string(runes[i:i+2])]; ok {
+				romaji = digraph
+				i++
+			}
+		}
+		if romaji == "" {
+			romaji = kanaRomaji[r]
+		}
+		if romaji == "" {
+			b.WriteRune(r)
+			sokuon = false
+			continue
+		}
+		if sokuon {
+			if strings.HasPrefix(romaji, "ch") {
+				b.WriteByte('t')
+			} else if !strings.ContainsRune("aiueon", rune(romaji[0])) {
+				b.WriteByte(romaji[0])
+			}
+			sokuon = false
+		}
+		b.WriteString(romaji)
+	}
+	return b.String()
+}
+
+// kanaRomaji はカタカナ1文字のヘボン式ローマ字です。
+var kanaRomaji = map[rune]string{
+	'ア': "a", 'イ': "i", 'ウ': "u", 'エ': "e", 'オ': "o",
+	'ァ': "a", 'ィ': "i", 'ゥ': "u", 'ェ': "e", 'ォ': "o",
+	'カ': "ka", 'キ': "ki", 'ク': "ku", 'ケ': "ke", 'コ': "ko",
+	'ガ': "ga", 'ギ': "gi", 'グ': "gu", 'ゲ': "ge", 'ゴ': "go",
+	'サ': "sa", 'シ': "shi", 'ス': "su", 'セ': "se", 'ソ': "so",
+	'ザ': "za", 'ジ': "ji", 'ズ': "zu", 'ゼ': "ze", 'ゾ': "zo",
+	'タ': "ta", 'チ': "chi", 'ツ': "tsu", 'テ': "te", 'ト': "to",
+	'ダ': "da", 'ヂ': "ji", 'ヅ': "zu", 'デ': "de", 'ド': "do",
+	'ナ': "na", 'ニ': "ni", 'ヌ': "nu", 'ネ': "ne", 'ノ': "no",
+	'ハ': "ha", 'ヒ': "hi", 'フ': "fu", 'ヘ': "he", 'ホ': "ho",
+	'バ': "ba", 'ビ': "bi", 'ブ': "bu", 'ベ': "be", 'ボ': "bo",
+	'パ': "pa", 'ピ': "pi", 'プ': "pu", 'ペ': "pe", 'ポ': "po",
+	'マ': "ma", 'ミ': "mi", 'ム': "mu", 'メ': "me", 'モ': "mo",
+	'ヤ': "ya", 'ユ': "yu", 'ヨ': "yo", 'ャ': "ya", 'ュ': "yu", 'ョ': "yo",
+	'ラ': "ra", 'リ': "ri", 'ル': "ru", 'レ': "re", 'ロ': "ro",
+	'ワ': "wa", 'ヰ': "i", 'ヱ': "e", 'ヲ': "o", 'ン': "n",
+	'ヴ': "vu",
+}
+
+// kanaDigraphs は2文字で1音となるカタカナのヘボン式ローマ字です。
+var kanaDigraphs = map[string]string{
+	"キャ": "kya", "キュ": "kyu", "キョ": "kyo",
+	"ギャ": "gya", "ギュ": "gyu", "ギョ": "gyo",
+	"シャ": "sha", "シュ": "shu", "ショ": "sho", "シェ": "she",
+	"ジャ": "ja", "ジュ": "ju", "ジョ": "jo", "ジェ": "je",
+	"チャ": "cha", "チュ": "chu", "チョ": "cho", "チェ": "che",
+	"ニャ": "nya", "ニュ": "nyu", "ニョ": "nyo",
+	"ヒャ": "hya", "ヒュ": "hyu", "ヒョ": "hyo",
+	"ビャ": "bya", "ビュ": "byu", "ビョ": "byo",
+	"ピャ": "pya", "ピュ": "pyu", "ピョ": "pyo",
+	"ミャ": "mya", "ミュ": "myu", "ミョ": "myo",
+	"リャ": "rya", "リュ": "ryu", "リョ": "ryo",
+	"ティ": "ti", "ディ": "di", "トゥ": "tu", "ドゥ": "du",
+	"ファ": "fa", "フィ": "fi", "フェ": "fe", "フォ": "fo",
+	"ウィ": "wi", "ウェ": "we", "ウォ": "wo",
+	"ヴァ": "va", "ヴィ": "vi", "ヴェ": "ve", "ヴォ": "vo",
+}
@@ -0,0 +1,70 @@
+package names
+
+import (
+	"strings"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("スラッグ", func() {
+	Context("GenerateSlug関数", Label("GenerateSlug関数"), func() {
+		DescribeTable("名前からURLに使用できるスラッグを生成すること",
+			func(name string, expected Slug) {
+				Expect(GenerateSlug(name, "3b1f9c2e")).To(Equal(expected))
+			},
+			Entry("英字と空白", "Water Pen Black", Slug("water-pen-black")),
+			Entry("全角英数字", "ＵＳＢメモリ　３２ＧＢ", Slug("usbmemori-32gb")),
+			Entry("カタカナと長音符", "ボールペン", Slug("borupen")),
+			Entry("ひらがな", "えんぴつ", Slug("enpitsu")),
+			Entry("半角カナ", "ﾉｰﾄ", Slug("noto")),
+			Entry("拗音", "シャープペンシル", Slug("shapupenshiru")),
+			Entry("促音", "レザーネックレス", Slug("rezanekkuresu")),
+			Entry("促音とチ", "マッチ", Slug("matchi")),
+			Entry("外来語の表記", "ファイル ティッシュ", Slug("fairu-tisshu")),
+			Entry("漢字と記号はハイフンに置換", "水性ボールペン(黒)", Slug("borupen")),
+			Entry("漢字のみの名前はfallbackを使用", "万年筆", Slug("3b1f9c2e")),
+		)
+
+		It("最大文字数で切り詰めること", func() {
+			slug := GenerateSlug(strings.Repeat("ab ", 60), "")
+			Expect(len(slug)).To(BeNumerically("<=", SLUG_MAX_LENGTH))
+			Expect(slug.Value()).NotTo(HaveSuffix("-"))
+		})
+	})
+
+	Context("NewSlug関数", Label("NewSlug関数"), func() {
+		It("前後の空白を除去して小文字に正規化すること", func() {
+			slug, err := NewSlug(" Fountain-Pen-2 ")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(slug).To(Equal(Slug("fountain-pen-2")))
+		})
+
+		DescribeTable("不正な形式はエラーになること",
+			func(input string) {
+				_, err := NewSlug(input)
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*errs.DomainError).Code).To(Equal("INVALID_ARGUMENT"))
+			},
+			Entry("空文字列", ""),
+			Entry("連続するハイフン", "fountain--pen"),
+			Entry("先頭のハイフン", "-pen"),
+			Entry("英数字以外", "万年筆"),
+			Entry("最大文字数を超える", strings.Repeat("a", SLUG_MAX_LENGTH+1)),
+		)
+	})
+
+	Context("WithSuffixメソッド", Label("WithSuffixメソッド"), func() {
+		It("連番を付けること", func() {
+			Expect(Slug("borupen").WithSuffix(1)).To(Equal(Slug("borupen")))
+			Expect(Slug("borupen").WithSuffix(2)).To(Equal(Slug("borupen-2")))
+		})
+
+		It("最大文字数を超えないように切り詰めること", func() {
+			slug := Slug(strings.Repeat("a", SLUG_MAX_LENGTH)).WithSuffix(12)
+			Expect(len(slug)).To(Equal(SLUG_MAX_LENGTH))
+			Expect(slug.Value()).To(HaveSuffix("a-12"))
+		})
+	})
+})
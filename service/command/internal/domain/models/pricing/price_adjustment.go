@@ -0,0 +1,194 @@
+package pricing
+
+import (
+	"fmt"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// PriceAdjustmentType は単価の一括変更の方法を表します。
+type PriceAdjustmentType string
+
+const (
+	PRICE_ADJUSTMENT_PERCENT PriceAdjustmentType = "PERCENT" // 現在の単価に対する割合（%）で変更
+	PRICE_ADJUSTMENT_FIXED   PriceAdjustmentType = "FIXED"   // 金額（通貨の最小単位）で変更
+)
+
+// ParsePriceAdjustmentType は文字列から単価の一括変更の方法を生成します。
+func ParsePriceAdjustmentType(value string) (PriceAdjustmentType, error) {
+	switch adjustmentType := PriceAdjustmentType(value); adjustmentType {
+	case PRICE_ADJUSTMENT_PERCENT, PRICE_ADJUSTMENT_FIXED:
+		return adjustmentType, nil
+	default:
+		return "", errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("未対応の単価の変更方法です: %s", value))
+	}
+}
+
+// PriceAdjustment は複数の商品の単価を一括で変更する規則を表す値オブジェクトです。
+// 変更後の単価は端数処理の単位に丸めてから、下限・上限の範囲に収めます。
+type PriceAdjustment struct {
+	adjustmentType PriceAdjustmentType // 変更の方法
+	value          int32               // 変更量（PERCENTの場合は%、FIXEDの場合は金額）
+	rounding       money.Rounding      // 端数処理の方法
+	roundingUnit   uint32              // 端数処理の単位（通貨の最小単位）
+	minPrice       uint32              // 変更後の単価の下限（0の場合は下限なし）
+	maxPrice       uint32              // 変更後の単価の上限（0の場合は上限なし）
+}
+
+// AdjustmentType は変更の方法を返します。
+func (a *PriceAdjustment) AdjustmentType() PriceAdjustmentType {
+	return a.adjustmentType
+}
+
+// Value は変更量を返します。
+func (a *PriceAdjustment) Value() int32 {
+	return a.value
+}
+
+// Rounding は端数処理の方法を返します。
+func (a *PriceAdjustment) Rounding() money.Rounding {
+	return a.rounding
+}
+
+// RoundingUnit は端数処理の単位を返します。
+func (a *PriceAdjustment) RoundingUnit() uint32 {
+	return a.roundingUnit
+}
+
+// MinPrice は変更後の単価の下限を返します。下限なしの場合は0です。
+func (a *PriceAdjustment) MinPrice() uint32 {
+	return a.minPrice
+}
+
+// MaxPrice は変更後の単価の上限を返します。上限なしの場合は0です。
+func (a *PriceAdjustment) MaxPrice() uint32 {
+	return a.maxPrice
+}
+
+// Apply は商品の単価を変更規則に従って変更します。通貨と税率区分は変更しません。
+//
+// Parameters:
+//   - product: 単価を変更する商品
+//
+// Returns:
+//   - error: 変更後の単価が商品価格の範囲外になる場合はDomainError (コード: PRICE_ADJUSTMENT_OUT_OF_RANGE)
+func (a *PriceAdjustment) Apply(product *products.Product) error {
+	current := product.Price()
+	adjusted := a.adjust(int64(current.Value()))
+	price, err := products.NewProductPriceWithTax(uint32(adjusted), current.Currency(), current.TaxClass())
+	if err != nil {
+		return errs.NewDomainErrorWithCause(
+			"PRICE_ADJUSTMENT_OUT_OF_RANGE",
+			fmt.Sprintf("商品番号: %s の変更後の単価（%d）が商品価格の範囲外です", product.Id().Value(), adjusted),
+			err,
+		)
+	}
+	product.ChangePrice(price)
+	return nil
+}
+
+// adjust は変更後の単価を計算します。
+func (a *PriceAdjustment) adjust(price int64) int64 {
+	unit := int64(a.roundingUnit)
+	var adjusted int64
+	switch a.adjustmentType {
+	case PRICE_ADJUSTMENT_PERCENT:
+		adjusted = a.rounding.Divide(price*(100+int64(a.value)), 100*unit) * unit
+	default:
+		adjusted = max(price+int64(a.value), 0)
+		adjusted = a.rounding.Divide(adjusted, unit) * unit
+	}
+	if a.minPrice > 0 {
+		adjusted = max(adjusted, int64(a.minPrice))
+	}
+	if a.maxPrice > 0 {
+		adjusted = min(adjusted, int64(a.maxPrice))
+	}
+	return adjusted
+}
+
+// CheckCurrencies は変更規則に金額が含まれる場合に、対象の商品の通貨がすべて同じであることを検証します。
+// 金額は通貨の最小単位で指定するため、通貨の異なる商品を同じ金額で変更すると意図しない単価になります。
+//
+// Parameters:
+//   - targets: 単価を変更する商品
+//
+// Returns:
+//   - error: 通貨が混在する場合はDomainError (コード: PRICE_ADJUSTMENT_MIXED_CURRENCIES)
+func (a *PriceAdjustment) CheckCurrencies(targets []*products.Product) error {
+	if a.adjustmentType != PRICE_ADJUSTMENT_FIXED && a.roundingUnit == 1 && a.minPrice == 0 && a.maxPrice == 0 {
+		return nil
+	}
+	for _, product := range targets {
+		if currency := product.Price().Currency(); currency != targets[0].Price().Currency() {
+			return errs.NewDomainError(
+				"PRICE_ADJUSTMENT_MIXED_CURRENCIES",
+				fmt.Sprintf("金額を含む変更では通貨の異なる商品（%s, %s）を同時に変更できません", targets[0].Price().Currency(), currency),
+			)
+		}
+	}
+	return nil
+}
+
+// NewPriceAdjustment は単価の一括変更の規則を生成します。
+//
+// Parameters:
+//   - adjustmentType: 変更の方法
+//   - value: 変更量（PERCENTの場合は-99〜1,000%、FIXEDの場合は-1,000,000〜1,000,000）
+//   - rounding: 端数処理の方法
+//   - roundingUnit: 端数処理の単位（0の場合は1、1〜10,000）
+//   - minPrice: 変更後の単価の下限（0の場合は下限なし）
+//   - maxPrice: 変更後の単価の上限（0の場合は上限なし）
+//
+// Returns:
+//   - *PriceAdjustment: 単価の一括変更の規則
+//   - error: 値が不正な場合はDomainError (コード: INVALID_ARGUMENT)
+func NewPriceAdjustment(adjustmentType PriceAdjustmentType, value int32, rounding money.Rounding, roundingUnit uint32, minPrice uint32, maxPrice uint32) (*PriceAdjustment, error) {
+	const (
+		MIN_PERCENT       int32  = -99     // 割合の最小値（単価が0にならないようにする）
+		MAX_PERCENT       int32  = 1000    // 割合の最大値
+		MAX_AMOUNT        int32  = 1000000 // 金額の最大値（商品価格の最大値）
+		MAX_ROUNDING_UNIT uint32 = 10000   // 端数処理の単位の最大値
+	)
+
+	switch adjustmentType {
+	case PRICE_ADJUSTMENT_PERCENT:
+		if value < MIN_PERCENT || value > MAX_PERCENT {
+			return nil, errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("変更する割合は%d%%以上%d%%以下で入力してください", MIN_PERCENT, MAX_PERCENT))
+		}
+	case PRICE_ADJUSTMENT_FIXED:
+		if value < -MAX_AMOUNT || value > MAX_AMOUNT {
+			return nil, errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("変更する金額は%d以上%d以下で入力してください", -MAX_AMOUNT, MAX_AMOUNT))
+		}
+	default:
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("未対応の単価の変更方法です: %s", adjustmentType))
+	}
+	if roundingUnit == 0 {
+		roundingUnit = 1
+	}
+	if roundingUnit > MAX_ROUNDING_UNIT {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("端数処理の単位は1以上%d以下で入力してください", MAX_ROUNDING_UNIT))
+	}
+	for _, limit := range []uint32{minPrice, maxPrice} {
+		if limit == 0 {
+			continue
+		}
+		if _, err := products.NewProductPrice(limit); err != nil {
+			return nil, err
+		}
+	}
+	if minPrice > 0 && maxPrice > 0 && minPrice > maxPrice {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "変更後の単価の下限は上限以下で入力してください")
+	}
+
+	return &PriceAdjustment{
+		adjustmentType: adjustmentType,
+		value:          value,
+		rounding:       rounding,
+		roundingUnit:   roundingUnit,
+		minPrice:       minPrice,
+		maxPrice:       maxPrice,
+	}, nil
+}
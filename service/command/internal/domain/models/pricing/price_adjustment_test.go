@@ -0,0 +1,96 @@
+package pricing
+
+import (
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newPricedProduct はテスト用の指定した単価・通貨の商品を生成します。
+func newPricedProduct(value uint32, currency string) *products.Product {
+	name, err := products.NewProductName("ボールペン")
+	Expect(err).NotTo(HaveOccurred())
+	price, err := products.NewProductPriceWithTax(value, currency, money.TaxClassStandard)
+	Expect(err).NotTo(HaveOccurred())
+	categoryName, err := categories.NewCategoryName("文房具")
+	Expect(err).NotTo(HaveOccurred())
+	category, err := categories.NewCategory(categoryName)
+	Expect(err).NotTo(HaveOccurred())
+	product, err := products.NewProduct(name, price, category)
+	Expect(err).NotTo(HaveOccurred())
+	return product
+}
+
+var _ = Describe("単価の一括変更", Label("PriceAdjustment"), func() {
+	DescribeTable("生成時のバリデーション",
+		func(adjustmentType PriceAdjustmentType, value int32, roundingUnit uint32, minPrice uint32, maxPrice uint32, expectError bool) {
+			adjustment, err := NewPriceAdjustment(adjustmentType, value, money.RoundingFloor, roundingUnit, minPrice, maxPrice)
+			if expectError {
+				expectDomainError(err, "INVALID_ARGUMENT")
+				Expect(adjustment).To(BeNil())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(adjustment.RoundingUnit()).To(BeNumerically(">=", 1))
+			}
+		},
+		Entry("正常系: 10%値下げ", PRICE_ADJUSTMENT_PERCENT, int32(-10), uint32(0), uint32(0), uint32(0), false),
+		Entry("正常系: 100円値上げ、下限・上限あり", PRICE_ADJUSTMENT_FIXED, int32(100), uint32(10), uint32(100), uint32(5000), false),
+		Entry("異常系: 割合が-99%未満", PRICE_ADJUSTMENT_PERCENT, int32(-100), uint32(1), uint32(0), uint32(0), true),
+		Entry("異常系: 割合が1,000%超", PRICE_ADJUSTMENT_PERCENT, int32(1001), uint32(1), uint32(0), uint32(0), true),
+		Entry("異常系: 金額が範囲外", PRICE_ADJUSTMENT_FIXED, int32(-1000001), uint32(1), uint32(0), uint32(0), true),
+		Entry("異常系: 端数処理の単位が範囲外", PRICE_ADJUSTMENT_PERCENT, int32(10), uint32(10001), uint32(0), uint32(0), true),
+		Entry("異常系: 下限が上限より大きい", PRICE_ADJUSTMENT_PERCENT, int32(10), uint32(1), uint32(2000), uint32(1000), true),
+		Entry("異常系: 上限が商品価格の範囲外", PRICE_ADJUSTMENT_PERCENT, int32(10), uint32(1), uint32(0), uint32(1000001), true),
+		Entry("異常系: 未対応の変更方法", PriceAdjustmentType("RATIO"), int32(10), uint32(1), uint32(0), uint32(0), true),
+	)
+
+	DescribeTable("変更後の単価",
+		func(adjustmentType PriceAdjustmentType, value int32, rounding money.Rounding, roundingUnit uint32, minPrice uint32, maxPrice uint32, before uint32, after uint32) {
+			adjustment, err := NewPriceAdjustment(adjustmentType, value, rounding, roundingUnit, minPrice, maxPrice)
+			Expect(err).NotTo(HaveOccurred())
+			product := newPricedProduct(before, money.DefaultCurrency)
+
+			Expect(adjustment.Apply(product)).To(Succeed())
+			Expect(product.Price().Value()).To(Equal(after))
+			Expect(product.Price().Currency()).To(Equal(money.DefaultCurrency))
+			Expect(product.Price().TaxClass()).To(Equal(money.TaxClassStandard))
+		},
+		Entry("10%値下げ（切り捨て）", PRICE_ADJUSTMENT_PERCENT, int32(-10), money.RoundingFloor, uint32(1), uint32(0), uint32(0), uint32(1234), uint32(1110)),
+		Entry("10%値下げ（切り上げ）", PRICE_ADJUSTMENT_PERCENT, int32(-10), money.RoundingCeil, uint32(1), uint32(0), uint32(0), uint32(1234), uint32(1111)),
+		Entry("10%値下げ（10円単位で四捨五入）", PRICE_ADJUSTMENT_PERCENT, int32(-10), money.RoundingHalfUp, uint32(10), uint32(0), uint32(0), uint32(1234), uint32(1110)),
+		Entry("5%値上げ（100円単位で切り上げ）", PRICE_ADJUSTMENT_PERCENT, int32(5), money.RoundingCeil, uint32(100), uint32(0), uint32(0), uint32(1234), uint32(1300)),
+		Entry("100円値上げ", PRICE_ADJUSTMENT_FIXED, int32(100), money.RoundingFloor, uint32(1), uint32(0), uint32(0), uint32(1234), uint32(1334)),
+		Entry("値下げ後の単価を下限に合わせる", PRICE_ADJUSTMENT_FIXED, int32(-500), money.RoundingFloor, uint32(1), uint32(980), uint32(0), uint32(1234), uint32(980)),
+		Entry("値上げ後の単価を上限に合わせる", PRICE_ADJUSTMENT_PERCENT, int32(50), money.RoundingFloor, uint32(1), uint32(0), uint32(1500), uint32(1234), uint32(1500)),
+		Entry("単価が0以下になる場合も下限があれば下限に合わせる", PRICE_ADJUSTMENT_FIXED, int32(-2000), money.RoundingFloor, uint32(1), uint32(100), uint32(0), uint32(1234), uint32(100)),
+	)
+
+	It("変更後の単価が商品価格の範囲外の場合はエラー", func() {
+		adjustment, err := NewPriceAdjustment(PRICE_ADJUSTMENT_FIXED, -2000, money.RoundingFloor, 1, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+		product := newPricedProduct(1234, money.DefaultCurrency)
+
+		expectDomainError(adjustment.Apply(product), "PRICE_ADJUSTMENT_OUT_OF_RANGE")
+		Expect(product.Price().Value()).To(Equal(uint32(1234)))
+	})
+
+	DescribeTable("通貨の混在",
+		func(adjustmentType PriceAdjustmentType, roundingUnit uint32, expectError bool) {
+			adjustment, err := NewPriceAdjustment(adjustmentType, 10, money.RoundingFloor, roundingUnit, 0, 0)
+			Expect(err).NotTo(HaveOccurred())
+			targets := []*products.Product{newPricedProduct(1000, "JPY"), newPricedProduct(1000, "USD")}
+
+			err = adjustment.CheckCurrencies(targets)
+			if expectError {
+				expectDomainError(err, "PRICE_ADJUSTMENT_MIXED_CURRENCIES")
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		},
+		Entry("割合のみの変更は通貨が混在してもよい", PRICE_ADJUSTMENT_PERCENT, uint32(1), false),
+		Entry("金額での変更は通貨が混在するとエラー", PRICE_ADJUSTMENT_FIXED, uint32(1), true),
+		Entry("端数処理の単位を指定した割合の変更は通貨が混在するとエラー", PRICE_ADJUSTMENT_PERCENT, uint32(10), true),
+	)
+})
@@ -0,0 +1,247 @@
+package pricing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// PriceScheduleStatus は価格スケジュールの状態を表します。
+type PriceScheduleStatus string
+
+const (
+	PRICE_SCHEDULE_SCHEDULED PriceScheduleStatus = "SCHEDULED" // 予定（開始前）
+	PRICE_SCHEDULE_ACTIVE    PriceScheduleStatus = "ACTIVE"    // 適用中
+	PRICE_SCHEDULE_COMPLETED PriceScheduleStatus = "COMPLETED" // 終了
+	PRICE_SCHEDULE_CANCELLED PriceScheduleStatus = "CANCELLED" // 取消
+)
+
+// ParsePriceScheduleStatus は文字列から価格スケジュールの状態を生成します。
+func ParsePriceScheduleStatus(value string) (PriceScheduleStatus, error) {
+	switch status := PriceScheduleStatus(value); status {
+	case PRICE_SCHEDULE_SCHEDULED, PRICE_SCHEDULE_ACTIVE, PRICE_SCHEDULE_COMPLETED, PRICE_SCHEDULE_CANCELLED:
+		return status, nil
+	default:
+		return "", errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("不明な価格スケジュールの状態です: %s", value))
+	}
+}
+
+// PriceSchedule は商品の期間限定の販売価格を表すエンティティです。
+// 開始時刻に商品の単価を販売価格に変更し、終了時刻に適用前の単価へ戻します。
+// 商品の単価を変更するため、状態の変更は必ず商品をロックしてから行います。
+type PriceSchedule struct {
+	id           *PriceScheduleId    // 価格スケジュールID
+	productId    *products.ProductId // 商品ID
+	price        uint32              // 期間中の税抜の単価
+	regularPrice uint32              // 適用前の単価（未適用の場合は0）
+	status       PriceScheduleStatus // 状態
+	startsAt     time.Time           // 開始時刻
+	endsAt       time.Time           // 終了時刻
+}
+
+// Id は価格スケジュールIDを返します。
+func (s *PriceSchedule) Id() *PriceScheduleId {
+	return s.id
+}
+
+// ProductId は商品IDを返します。
+func (s *PriceSchedule) ProductId() *products.ProductId {
+	return s.productId
+}
+
+// Price は期間中の税抜の単価を返します。
+func (s *PriceSchedule) Price() uint32 {
+	return s.price
+}
+
+// RegularPrice は適用前の単価を返します。未適用の場合は0です。
+func (s *PriceSchedule) RegularPrice() uint32 {
+	return s.regularPrice
+}
+
+// Status は価格スケジュールの状態を返します。
+func (s *PriceSchedule) Status() PriceScheduleStatus {
+	return s.status
+}
+
+// StartsAt は開始時刻を返します。
+func (s *PriceSchedule) StartsAt() time.Time {
+	return s.startsAt
+}
+
+// EndsAt は終了時刻を返します。
+func (s *PriceSchedule) EndsAt() time.Time {
+	return s.endsAt
+}
+
+// IsPending は予定または適用中（まだ終了していない）かどうかを返します。
+func (s *PriceSchedule) IsPending() bool {
+	return s.status == PRICE_SCHEDULE_SCHEDULED || s.status == PRICE_SCHEDULE_ACTIVE
+}
+
+// IsDue は指定時刻において適用または終了の処理が必要かどうかを返します。
+func (s *PriceSchedule) IsDue(now time.Time) bool {
+	switch s.status {
+	case PRICE_SCHEDULE_SCHEDULED:
+		return !now.Before(s.startsAt)
+	case PRICE_SCHEDULE_ACTIVE:
+		return !now.Before(s.endsAt)
+	default:
+		return false
+	}
+}
+
+// Overlaps は2つの価格スケジュールの期間が重なるかどうかを返します。
+// 終了した価格スケジュールや取り消した価格スケジュールとは重ならないものとして扱います。
+func (s *PriceSchedule) Overlaps(other *PriceSchedule) bool {
+	if !s.IsPending() || !other.IsPending() {
+		return false
+	}
+	return s.startsAt.Before(other.endsAt) && other.startsAt.Before(s.endsAt)
+}
+
+// Advance は指定時刻に応じて価格スケジュールを進め、商品の単価を変更します。
+//   - 予定で開始時刻を過ぎている場合は、適用前の単価を記録して販売価格に変更します
+//   - 予定のまま終了時刻を過ぎている場合（停止中に期間が過ぎた場合）は、単価を変更せずに終了します
+//   - 適用中で終了時刻を過ぎている場合は、適用前の単価に戻して終了します
+//
+// 処理が不要な場合は何もしません。
+func (s *PriceSchedule) Advance(product *products.Product, now time.Time) error {
+	if !s.IsDue(now) {
+		return nil
+	}
+	if s.status == PRICE_SCHEDULE_SCHEDULED {
+		if !now.Before(s.endsAt) {
+			s.status = PRICE_SCHEDULE_COMPLETED
+			return nil
+		}
+		return s.apply(product)
+	}
+	if err := s.revert(product); err != nil {
+		return err
+	}
+	s.status = PRICE_SCHEDULE_COMPLETED
+	return nil
+}
+
+// Cancel は価格スケジュールを取り消します。適用中の場合は適用前の単価に戻します。
+func (s *PriceSchedule) Cancel(product *products.Product) error {
+	if !s.IsPending() {
+		return errs.NewDomainError(
+			"PRICE_SCHEDULE_NOT_PENDING",
+			fmt.Sprintf("価格スケジュールID: %s は%sのため取り消せません", s.id.Value(), s.status),
+		)
+	}
+	if s.status == PRICE_SCHEDULE_ACTIVE {
+		if err := s.revert(product); err != nil {
+			return err
+		}
+	}
+	s.status = PRICE_SCHEDULE_CANCELLED
+	return nil
+}
+
+// apply は適用前の単価を記録し、商品の単価を販売価格に変更します。
+func (s *PriceSchedule) apply(product *products.Product) error {
+	price, err := products.NewProductPriceWithTax(s.price, product.Price().Currency(), product.Price().TaxClass())
+	if err != nil {
+		return err
+	}
+	s.regularPrice = product.Price().Value()
+	product.ChangePrice(price)
+	s.status = PRICE_SCHEDULE_ACTIVE
+	return nil
+}
+
+// revert は商品の単価を適用前の単価に戻します。
+// 期間中に単価が手動で変更された場合は、変更後の単価を優先して戻しません。
+func (s *PriceSchedule) revert(product *products.Product) error {
+	if product.Price().Value() != s.price {
+		return nil
+	}
+	price, err := products.NewProductPriceWithTax(s.regularPrice, product.Price().Currency(), product.Price().TaxClass())
+	if err != nil {
+		return err
+	}
+	product.ChangePrice(price)
+	return nil
+}
+
+// NewPriceSchedule は新しい価格スケジュールを生成します。
+//
+// Parameters:
+//   - productId: 商品ID
+//   - price: 期間中の税抜の単価（商品価格と同じ範囲）
+//   - startsAt: 開始時刻
+//   - endsAt: 終了時刻（開始時刻と現在時刻より後）
+//   - now: 現在時刻
+//
+// Returns:
+//   - *PriceSchedule: 価格スケジュール
+//   - error: 単価や期間が不正な場合はDomainError (コード: INVALID_ARGUMENT)
+func NewPriceSchedule(productId *products.ProductId, price uint32, startsAt time.Time, endsAt time.Time, now time.Time) (*PriceSchedule, error) {
+	if _, err := products.NewProductPrice(price); err != nil {
+		return nil, err
+	}
+	if !startsAt.Before(endsAt) {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "価格スケジュールの終了時刻は開始時刻より後である必要があります")
+	}
+	if !now.Before(endsAt) {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "価格スケジュールの終了時刻は現在時刻より後である必要があります")
+	}
+
+	uid, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainErrorWithCause("INTERNAL", "価格スケジュールIDの生成に失敗しました", err)
+	}
+	id, err := NewPriceScheduleId(uid.String())
+	if err != nil {
+		return nil, errs.NewDomainErrorWithCause("INTERNAL", "価格スケジュールIDの生成に失敗しました", err)
+	}
+
+	return &PriceSchedule{
+		id:        id,
+		productId: productId,
+		price:     price,
+		status:    PRICE_SCHEDULE_SCHEDULED,
+		startsAt:  startsAt,
+		endsAt:    endsAt,
+	}, nil
+}
+
+// BuildPriceSchedule は既存の価格スケジュールIDを使用して価格スケジュールを再構築します。
+func BuildPriceSchedule(id *PriceScheduleId, productId *products.ProductId, price uint32, regularPrice uint32, status PriceScheduleStatus, startsAt time.Time, endsAt time.Time) (*PriceSchedule, error) {
+	schedule := PriceSchedule{
+		id:           id,
+		productId:    productId,
+		price:        price,
+		regularPrice: regularPrice,
+		status:       status,
+		startsAt:     startsAt,
+		endsAt:       endsAt,
+	}
+	return &schedule, nil
+}
+
+// CheckOverlap は新しい価格スケジュールが既存の価格スケジュールと期間が重ならないことを検証します。
+//
+// Parameters:
+//   - schedule: 新しい価格スケジュール
+//   - existing: 同じ商品の既存の価格スケジュール
+//
+// Returns:
+//   - error: 期間が重なる場合はDomainError (コード: PRICE_SCHEDULE_OVERLAP)
+func CheckOverlap(schedule *PriceSchedule, existing []*PriceSchedule) error {
+	for _, other := range existing {
+		if schedule.Overlaps(other) {
+			return errs.NewDomainError(
+				"PRICE_SCHEDULE_OVERLAP",
+				fmt.Sprintf("価格スケジュールID: %s と期間が重なっています", other.Id().Value()),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package pricing
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// PriceScheduleId は価格スケジュールIDを表す値オブジェクトです。
+type PriceScheduleId struct {
+	value string // 価格スケジュール番号(UUID)
+}
+
+// Value は価格スケジュールIDの値を返します。
+func (p *PriceScheduleId) Value() string {
+	return p.value
+}
+
+// Equals は2つの価格スケジュールIDの同一性を検証します。
+func (p *PriceScheduleId) Equals(other *PriceScheduleId) bool {
+	if other == nil {
+		return false
+	}
+	if p == other {
+		return true
+	}
+	return p.value == other.Value()
+}
+
+var getUUIDRegexp = sync.OnceValue(func() *regexp.Regexp {
+	const REGEXP string = "^([0-9a-f]{8})-([0-9a-f]{4})-([0-9a-f]{4})-([0-9a-f]{4})-([0-9a-f]{12})$"
+	return regexp.MustCompile(REGEXP)
+})
+
+// NewPriceScheduleId は価格スケジュールIDを生成します。
+func NewPriceScheduleId(value string) (*PriceScheduleId, error) {
+	const LENGTH int = 36
+
+	if utf8.RuneCountInString(value) != LENGTH {
+		return nil, errs.NewDomainError(
+			"INVALID_ARGUMENT", fmt.Sprintf("価格スケジュールIDの長さは%d文字である必要があります", LENGTH),
+		)
+	}
+	if !getUUIDRegexp().MatchString(value) {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "価格スケジュールIDはUUIDの形式である必要があります")
+	}
+
+	return &PriceScheduleId{value: value}, nil
+}
@@ -0,0 +1,86 @@
+package pricing
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// PriceScheduleRepository は価格スケジュールの永続化を担うリポジトリインターフェースです。
+// 価格スケジュールは商品の単価を変更するため、商品、価格スケジュールの順にロックします。
+//
+//go:generate go tool mockgen -source=$GOFILE -destination=../../../mock/repository/price_schedule_repository_mock.go -package=mock_repository
+type PriceScheduleRepository interface {
+	// Create は新しい価格スケジュールを追加します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - schedule: 価格スケジュール
+	//
+	// Returns:
+	//   - error: エラー
+	Create(ctx context.Context, tx *sql.Tx, schedule *PriceSchedule) error
+
+	// FindById は価格スケジュールIDで価格スケジュールをロックせずに取得します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - id: 価格スケジュールID
+	//
+	// Returns:
+	//   - *PriceSchedule: 価格スケジュール
+	//   - error: 存在しない場合はCRUDError (コード: NOT_FOUND)、その他のエラー
+	FindById(ctx context.Context, tx *sql.Tx, id *PriceScheduleId) (*PriceSchedule, error)
+
+	// LockById は価格スケジュールIDで価格スケジュールを排他ロックして取得します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - id: 価格スケジュールID
+	//
+	// Returns:
+	//   - *PriceSchedule: 価格スケジュール
+	//   - error: 存在しない場合はCRUDError (コード: NOT_FOUND)、その他のエラー
+	LockById(ctx context.Context, tx *sql.Tx, id *PriceScheduleId) (*PriceSchedule, error)
+
+	// FindPendingByProductId は商品の予定または適用中の価格スケジュールを開始時刻順に取得します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - productId: 商品ID
+	//
+	// Returns:
+	//   - []*PriceSchedule: 価格スケジュールのリスト
+	//   - error: エラー
+	FindPendingByProductId(ctx context.Context, tx *sql.Tx, productId *products.ProductId) ([]*PriceSchedule, error)
+
+	// FindDueIds は開始時刻を過ぎた予定の価格スケジュールと、終了時刻を過ぎた適用中の価格スケジュールのIDを取得します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - now: 基準時刻
+	//   - limit: 取得する件数の上限
+	//
+	// Returns:
+	//   - []*PriceScheduleId: 価格スケジュールIDのリスト
+	//   - error: エラー
+	FindDueIds(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]*PriceScheduleId, error)
+
+	// Update は価格スケジュールの状態と適用前の単価を更新します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - schedule: 価格スケジュール
+	//
+	// Returns:
+	//   - error: 存在しない場合はCRUDError (コード: NOT_FOUND)、その他のエラー
+	Update(ctx context.Context, tx *sql.Tx, schedule *PriceSchedule) error
+}
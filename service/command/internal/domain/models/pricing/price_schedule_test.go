@@ -0,0 +1,188 @@
+package pricing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPricingPackage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "domain/models/pricing packageのテスト")
+}
+
+// expectDomainError はエラーが指定したコードのDomainErrorであることを検証します。
+func expectDomainError(err error, code string) {
+	Expect(err).To(HaveOccurred())
+	domainErr, ok := err.(*errs.DomainError)
+	Expect(ok).To(BeTrue())
+	Expect(domainErr.Code).To(Equal(code))
+}
+
+// newTestProduct はテスト用の単価1,000円（軽減税率）の商品を生成します。
+func newTestProduct() *products.Product {
+	name, err := products.NewProductName("コーヒー豆")
+	Expect(err).NotTo(HaveOccurred())
+	price, err := products.NewProductPriceWithTax(1000, money.DefaultCurrency, money.TaxClassReduced)
+	Expect(err).NotTo(HaveOccurred())
+	categoryName, err := categories.NewCategoryName("食品")
+	Expect(err).NotTo(HaveOccurred())
+	category, err := categories.NewCategory(categoryName)
+	Expect(err).NotTo(HaveOccurred())
+	product, err := products.NewProduct(name, price, category)
+	Expect(err).NotTo(HaveOccurred())
+	return product
+}
+
+var _ = Describe("価格スケジュール", Label("PriceSchedule"), func() {
+	var (
+		product  *products.Product
+		startsAt time.Time
+		endsAt   time.Time
+	)
+
+	BeforeEach(func() {
+		product = newTestProduct()
+		startsAt = time.Date(2026, 10, 23, 15, 0, 0, 0, time.UTC) // 2026-10-24 00:00 JST
+		endsAt = startsAt.Add(72 * time.Hour)
+	})
+
+	DescribeTable("生成時のバリデーション",
+		func(price uint32, start func() time.Time, end func() time.Time, nowOffset time.Duration, expectError bool) {
+			schedule, err := NewPriceSchedule(product.Id(), price, start(), end(), startsAt.Add(nowOffset))
+			if expectError {
+				expectDomainError(err, "INVALID_ARGUMENT")
+				Expect(schedule).To(BeNil())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(schedule.Status()).To(Equal(PRICE_SCHEDULE_SCHEDULED))
+				Expect(schedule.RegularPrice()).To(BeZero())
+			}
+		},
+		Entry("正しい期間の場合、エラーにならないこと", uint32(980), func() time.Time { return startsAt }, func() time.Time { return endsAt }, -time.Hour, false),
+		Entry("開始時刻を過ぎていても終了前なら、エラーにならないこと", uint32(980), func() time.Time { return startsAt }, func() time.Time { return endsAt }, time.Hour, false),
+		Entry("単価が0円の場合、エラーになること", uint32(0), func() time.Time { return startsAt }, func() time.Time { return endsAt }, -time.Hour, true),
+		Entry("終了時刻が開始時刻と同じ場合、エラーになること", uint32(980), func() time.Time { return startsAt }, func() time.Time { return startsAt }, -time.Hour, true),
+		Entry("終了時刻を過ぎている場合、エラーになること", uint32(980), func() time.Time { return startsAt }, func() time.Time { return endsAt }, 72*time.Hour, true),
+	)
+
+	Context("Advance", func() {
+		var schedule *PriceSchedule
+
+		BeforeEach(func() {
+			var err error
+			schedule, err = NewPriceSchedule(product.Id(), 980, startsAt, endsAt, startsAt.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("開始前は何もしないこと", func() {
+			Expect(schedule.IsDue(startsAt.Add(-time.Second))).To(BeFalse())
+			Expect(schedule.Advance(product, startsAt.Add(-time.Second))).To(Succeed())
+			Expect(schedule.Status()).To(Equal(PRICE_SCHEDULE_SCHEDULED))
+			Expect(product.Price().Value()).To(Equal(uint32(1000)))
+		})
+
+		It("開始時刻に販売価格を適用し、終了時刻に元の単価へ戻すこと", func() {
+			Expect(schedule.Advance(product, startsAt)).To(Succeed())
+			Expect(schedule.Status()).To(Equal(PRICE_SCHEDULE_ACTIVE))
+			Expect(schedule.RegularPrice()).To(Equal(uint32(1000)))
+			Expect(product.Price().Value()).To(Equal(uint32(980)))
+			Expect(product.Price().TaxClass()).To(Equal(money.TaxClassReduced))
+
+			// 期間中に再度呼び出しても何もしない
+			Expect(schedule.Advance(product, startsAt.Add(time.Hour))).To(Succeed())
+			Expect(schedule.Status()).To(Equal(PRICE_SCHEDULE_ACTIVE))
+
+			Expect(schedule.Advance(product, endsAt)).To(Succeed())
+			Expect(schedule.Status()).To(Equal(PRICE_SCHEDULE_COMPLETED))
+			Expect(product.Price().Value()).To(Equal(uint32(1000)))
+			Expect(schedule.IsDue(endsAt.Add(time.Hour))).To(BeFalse())
+		})
+
+		It("期間中に単価が変更された場合、終了時に元の単価へ戻さないこと", func() {
+			Expect(schedule.Advance(product, startsAt)).To(Succeed())
+			changed, err := products.NewProductPriceWithTax(900, money.DefaultCurrency, money.TaxClassReduced)
+			Expect(err).NotTo(HaveOccurred())
+			product.ChangePrice(changed)
+
+			Expect(schedule.Advance(product, endsAt)).To(Succeed())
+			Expect(schedule.Status()).To(Equal(PRICE_SCHEDULE_COMPLETED))
+			Expect(product.Price().Value()).To(Equal(uint32(900)))
+		})
+
+		It("適用されないまま終了時刻を過ぎた場合、単価を変更せずに終了すること", func() {
+			Expect(schedule.Advance(product, endsAt.Add(time.Minute))).To(Succeed())
+			Expect(schedule.Status()).To(Equal(PRICE_SCHEDULE_COMPLETED))
+			Expect(product.Price().Value()).To(Equal(uint32(1000)))
+		})
+	})
+
+	Context("Cancel", func() {
+		var schedule *PriceSchedule
+
+		BeforeEach(func() {
+			var err error
+			schedule, err = NewPriceSchedule(product.Id(), 980, startsAt, endsAt, startsAt.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("予定の価格スケジュールを取り消せること", func() {
+			Expect(schedule.Cancel(product)).To(Succeed())
+			Expect(schedule.Status()).To(Equal(PRICE_SCHEDULE_CANCELLED))
+			Expect(product.Price().Value()).To(Equal(uint32(1000)))
+		})
+
+		It("適用中の価格スケジュールを取り消すと元の単価へ戻すこと", func() {
+			Expect(schedule.Advance(product, startsAt)).To(Succeed())
+			Expect(schedule.Cancel(product)).To(Succeed())
+			Expect(schedule.Status()).To(Equal(PRICE_SCHEDULE_CANCELLED))
+			Expect(product.Price().Value()).To(Equal(uint32(1000)))
+		})
+
+		It("終了した価格スケジュールは取り消せないこと", func() {
+			Expect(schedule.Advance(product, endsAt)).To(Succeed())
+			expectDomainError(schedule.Cancel(product), "PRICE_SCHEDULE_NOT_PENDING")
+		})
+	})
+
+	DescribeTable("期間の重なりの検証",
+		func(offset time.Duration, length time.Duration, expectOverlap bool) {
+			existing, err := NewPriceSchedule(product.Id(), 980, startsAt, endsAt, startsAt.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			schedule, err := NewPriceSchedule(product.Id(), 900, startsAt.Add(offset), startsAt.Add(offset+length), startsAt.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+
+			err = CheckOverlap(schedule, []*PriceSchedule{existing})
+			if expectOverlap {
+				expectDomainError(err, "PRICE_SCHEDULE_OVERLAP")
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		},
+		Entry("期間が一部重なる場合、エラーになること", 24*time.Hour, 72*time.Hour, true),
+		Entry("期間に含まれる場合、エラーになること", time.Hour, time.Hour, true),
+		Entry("終了時刻と開始時刻が一致する場合、エラーにならないこと", 72*time.Hour, time.Hour, false),
+		Entry("前の期間の場合、エラーにならないこと", -2*time.Hour, 2*time.Hour, false),
+	)
+
+	DescribeTable("状態のパース",
+		func(value string, expectError bool) {
+			status, err := ParsePriceScheduleStatus(value)
+			if expectError {
+				expectDomainError(err, "INVALID_ARGUMENT")
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(status)).To(Equal(value))
+			}
+		},
+		Entry("SCHEDULEDの場合、エラーにならないこと", "SCHEDULED", false),
+		Entry("COMPLETEDの場合、エラーにならないこと", "COMPLETED", false),
+		Entry("不明な値の場合、エラーになること", "UNKNOWN", true),
+	)
+})
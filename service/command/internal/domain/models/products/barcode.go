@@ -0,0 +1,87 @@
+package products
+
+import (
+	"strings"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// BarcodeFormat はバーコードの種類です。
+type BarcodeFormat string
+
+const (
+	BARCODE_EAN13 BarcodeFormat = "EAN13" // JAN-13/EAN-13（UPC-Aは先頭に0を付けたEAN-13として扱う）
+	BARCODE_EAN8  BarcodeFormat = "EAN8"  // JAN-8/EAN-8（短縮形）
+)
+
+// Barcode は商品のJAN/EAN/UPCバーコードを表す値オブジェクトです。
+// UPC-A（12桁）は先頭に0を付けたEAN-13に正規化するため、同じ商品のUPC-AとEAN-13は等しくなります。
+// 全商品を通して一意です。
+type Barcode struct {
+	value string // 正規化したバーコード（8桁または13桁の数字）
+}
+
+// Value は正規化したバーコードを返します。
+func (b *Barcode) Value() string {
+	return b.value
+}
+
+// Format はバーコードの種類を返します。
+func (b *Barcode) Format() BarcodeFormat {
+	if len(b.value) == 8 {
+		return BARCODE_EAN8
+	}
+	return BARCODE_EAN13
+}
+
+// Equals は2つのバーコードが等しいかを検証します。
+func (b *Barcode) Equals(other *Barcode) bool {
+	if other == nil {
+		return false
+	}
+	return b.value == other.Value()
+}
+
+// NewBarcode はバーコードを生成します。
+// 前後の空白を除去し、JAN-13/EAN-13（13桁）、JAN-8/EAN-8（8桁）、UPC-A（12桁）のチェックデジットを検証します。
+//
+// Parameters:
+//   - value: バーコード（数字のみ）
+//
+// Returns:
+//   - *Barcode: バーコード
+//   - error: 桁数・文字種・チェックデジットが不正な場合はDomainError (コード: INVALID_ARGUMENT)
+func NewBarcode(value string) (*Barcode, error) {
+	normalized := strings.TrimSpace(value)
+	switch len(normalized) {
+	case 8, 13:
+	case 12:
+		normalized = "0" + normalized
+	default:
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "バーコードは8桁、12桁または13桁の数字で入力してください")
+	}
+	for _, r := range normalized {
+		if r < '0' || r > '9' {
+			return nil, errs.NewDomainError("INVALID_ARGUMENT", "バーコードは8桁、12桁または13桁の数字で入力してください")
+		}
+	}
+	if checkDigit(normalized[:len(normalized)-1]) != normalized[len(normalized)-1]-'0' {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "バーコードのチェックデジットが正しくありません")
+	}
+
+	return &Barcode{value: normalized}, nil
+}
+
+// checkDigit はGS1のモジュラス10/ウェイト3でチェックデジットを計算します。
+// チェックデジットの直前の桁から順に3, 1, 3, ...の重みを掛けて合計します。
+func checkDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte((10 - sum%10) % 10)
+}
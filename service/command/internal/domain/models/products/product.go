@@ -1,17 +1,28 @@
 package products
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+
 	"github.com/google/uuid"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 )
 
 // Product は商品エンティティを表すドメインオブジェクトです。
 type Product struct {
 	id       *ProductId           // 商品ID
 	name     *ProductName         // 商品名
-	price    *ProductPrice        // 商品価格(単位: 円)
+	price    *ProductPrice        // 商品価格（税抜の単価と税率区分）
 	category *categories.Category // カテゴリ
+	variants []*Variant           // バリエーション
+	status   ProductStatus        // 販売状態
+	barcode  *Barcode             // バーコード（未登録の場合はnil）
+	slug     names.Slug           // URLに使用するスラッグ
+
+	translations map[names.Locale]*ProductName // 既定のロケール以外の商品名
 }
 
 // Id は商品IDを返します。
@@ -24,6 +35,11 @@ func (p *Product) Name() *ProductName {
 	return p.name
 }
 
+// Translations は既定のロケール以外の商品名をロケールごとに返します。
+func (p *Product) Translations() map[names.Locale]*ProductName {
+	return maps.Clone(p.translations)
+}
+
 // Price は商品価格を返します。
 func (p *Product) Price() *ProductPrice {
 	return p.price
@@ -34,11 +50,153 @@ func (p *Product) Category() *categories.Category {
 	return p.category
 }
 
+// Status は販売状態を返します。
+func (p *Product) Status() ProductStatus {
+	return p.status
+}
+
+// Barcode はバーコードを返します。未登録の場合はnilです。
+func (p *Product) Barcode() *Barcode {
+	return p.barcode
+}
+
+// ChangeBarcode はバーコードを変更します。nilの場合はバーコードを削除します。
+func (p *Product) ChangeBarcode(barcode *Barcode) {
+	p.barcode = barcode
+}
+
+// Slug はURLに使用するスラッグを返します。
+func (p *Product) Slug() names.Slug {
+	return p.slug
+}
+
+// ChangeSlug はスラッグを変更します。一意性の検証はアプリケーション層で行います。
+// 商品名を変更してもスラッグは変わらないため、URLを変更する場合のみ使用します。
+func (p *Product) ChangeSlug(slug names.Slug) {
+	p.slug = slug
+}
+
+// Publish は商品を公開します。下書きまたは一時停止中の商品のみ公開できます。
+func (p *Product) Publish() error {
+	return p.changeStatus(PRODUCT_PUBLISHED)
+}
+
+// Suspend は公開中の商品の販売を一時停止します。
+func (p *Product) Suspend() error {
+	return p.changeStatus(PRODUCT_SUSPENDED)
+}
+
+// Discontinue は商品の販売を終了します。販売終了した商品は再び公開できません。
+func (p *Product) Discontinue() error {
+	return p.changeStatus(PRODUCT_DISCONTINUED)
+}
+
+// changeStatus は許可された遷移の場合のみ販売状態を変更します。
+// 許可されていない遷移の場合はDomainError (コード: INVALID_STATUS_TRANSITION) を返します。
+func (p *Product) changeStatus(to ProductStatus) error {
+	if !p.status.CanTransitionTo(to) {
+		return errs.NewDomainError(
+			"INVALID_STATUS_TRANSITION", fmt.Sprintf("商品の状態を%sから%sに変更できません", p.status, to),
+		)
+	}
+	p.status = to
+	return nil
+}
+
+// Variants はバリエーションを返します。
+func (p *Product) Variants() []*Variant {
+	return slices.Clone(p.variants)
+}
+
+// FindVariant は指定したIDのバリエーションを返します。
+// 存在しない場合はVARIANT_NOT_FOUNDエラーを返します。
+func (p *Product) FindVariant(id *VariantId) (*Variant, error) {
+	for _, variant := range p.variants {
+		if variant.Id().Equals(id) {
+			return variant, nil
+		}
+	}
+	return nil, errs.NewDomainError("VARIANT_NOT_FOUND", fmt.Sprintf("バリエーションが見つかりません: %s", id.Value()))
+}
+
+// AddVariant はバリエーションを追加します。
+// SKUコードと選択肢の組み合わせが商品内で重複する場合や、選択肢の軸が既存のバリエーションと異なる場合はエラーを返します。
+func (p *Product) AddVariant(variant *Variant) error {
+	if err := p.checkVariant(variant); err != nil {
+		return err
+	}
+	p.variants = append(p.variants, variant)
+	return nil
+}
+
+// UpdateVariant は同じIDのバリエーションを置き換えます。
+// 不変条件はAddVariantと同じです。
+func (p *Product) UpdateVariant(variant *Variant) error {
+	index := slices.IndexFunc(p.variants, variant.Equals)
+	if index < 0 {
+		return errs.NewDomainError("VARIANT_NOT_FOUND", fmt.Sprintf("バリエーションが見つかりません: %s", variant.Id().Value()))
+	}
+	if err := p.checkVariant(variant); err != nil {
+		return err
+	}
+	p.variants[index] = variant
+	return nil
+}
+
+// RemoveVariant は指定したIDのバリエーションを削除し、削除したバリエーションを返します。
+func (p *Product) RemoveVariant(id *VariantId) (*Variant, error) {
+	variant, err := p.FindVariant(id)
+	if err != nil {
+		return nil, err
+	}
+	p.variants = slices.DeleteFunc(p.variants, variant.Equals)
+	return variant, nil
+}
+
+// checkVariant は同じIDのバリエーションを除く既存のバリエーションとの不変条件を検証します。
+func (p *Product) checkVariant(variant *Variant) error {
+	for _, other := range p.variants {
+		if other.Equals(variant) {
+			continue
+		}
+		if other.Options().AxesKey() != variant.Options().AxesKey() {
+			return errs.NewDomainError("INVALID_ARGUMENT", "選択肢の軸は既存のバリエーションと同じにしてください")
+		}
+		if other.Sku().Equals(variant.Sku()) {
+			return errs.NewDomainError(
+				"VARIANT_ALREADY_EXISTS", fmt.Sprintf("SKUが重複しています: %s", variant.Sku().Value()),
+			)
+		}
+		if other.Options().Equals(variant.Options()) {
+			return errs.NewDomainError("VARIANT_ALREADY_EXISTS", "選択肢の組み合わせが重複しています")
+		}
+	}
+	return nil
+}
+
 // ChangeName は商品名を変更します。
 func (p *Product) ChangeName(name *ProductName) {
 	p.name = name
 }
 
+// ChangeTranslations は既定のロケール以外の商品名を置き換えます。
+// 既定のロケールの商品名はChangeNameで変更するため、既定のロケールが含まれる場合はエラーを返します。
+//
+// Parameters:
+//   - translations: ロケールごとの商品名
+//
+// Returns:
+//   - error: 既定のロケールが含まれる場合はDomainError (コード: INVALID_ARGUMENT)
+func (p *Product) ChangeTranslations(translations map[names.Locale]*ProductName) error {
+	if _, ok := translations[names.DefaultLocale]; ok {
+		return errs.NewDomainError(
+			"INVALID_ARGUMENT", fmt.Sprintf("既定のロケール(%s)の商品名は翻訳に指定できません", names.DefaultLocale),
+		)
+	}
+	p.translations = maps.Clone(translations)
+	return nil
+}
+
 // ChangePrice は商品価格を変更します。
 func (p *Product) ChangePrice(price *ProductPrice) {
 	p.price = price
@@ -58,6 +216,7 @@ func (p *Product) Equals(other *Product) (bool, error) {
 }
 
 // NewProduct は新しい商品エンティティを生成します。
+// 生成した商品は下書き（DRAFT）で、公開するまで問合せサービスの一覧には表示されません。
 func NewProduct(name *ProductName, price *ProductPrice, category *categories.Category) (*Product, error) {
 	uid, err := uuid.NewRandom()
 	if err != nil {
@@ -69,16 +228,19 @@ func NewProduct(name *ProductName, price *ProductPrice, category *categories.Cat
 		return nil, errs.NewDomainErrorWithCause("INTERNAL", "商品IDの生成に失敗しました", err)
 	}
 
-	return &Product{id: id, name: name, price: price, category: category}, nil
+	return &Product{id: id, name: name, price: price, category: category, status: PRODUCT_DRAFT}, nil
 }
 
 // BuildProduct は既存の商品IDを使用して商品エンティティを再構築します。
-func BuildProduct(id *ProductId, name *ProductName, price *ProductPrice, category *categories.Category) (*Product, error) {
+// variantsには永続化済みのバリエーションを指定します。
+func BuildProduct(id *ProductId, name *ProductName, price *ProductPrice, category *categories.Category, status ProductStatus, variants ...*Variant) (*Product, error) {
 	product := Product{
 		id:       id,
 		name:     name,
 		price:    price,
 		category: category,
+		variants: variants,
+		status:   status,
 	}
 	return &product, nil
 }
@@ -55,3 +55,31 @@ func NewProductId(value string) (*ProductId, error) {
 
 	return &ProductId{value: value}, nil
 }
+
+// NewProductIds は重複を除いた商品IDの一覧を生成します。複数の商品をまとめて操作する場合に使用します。
+//
+// Parameters:
+//   - values: 商品IDの文字列（1件以上）
+//
+// Returns:
+//   - []*ProductId: 重複を除いた商品ID（指定された順序を保持）
+//   - error: 空の場合や不正な商品IDが含まれる場合はDomainError (コード: INVALID_ARGUMENT)
+func NewProductIds(values []string) ([]*ProductId, error) {
+	if len(values) == 0 {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "商品IDを1つ以上指定してください")
+	}
+	seen := make(map[string]struct{}, len(values))
+	ids := make([]*ProductId, 0, len(values))
+	for _, value := range values {
+		id, err := NewProductId(value)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[id.Value()]; ok {
+			continue
+		}
+		seen[id.Value()] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -5,11 +5,13 @@ import (
 	"unicode/utf8"
 
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 )
 
 // ProductName は商品名を表す値オブジェクトです。
 type ProductName struct {
 	value string // 商品名
+	key   string // 重複判定に使用する正規化キー
 }
 
 // Value は商品名の値を返します。
@@ -17,6 +19,12 @@ func (p *ProductName) Value() string {
 	return p.value
 }
 
+// Key は重複判定に使用する正規化キーを返します。
+// 全角・半角や空白の違いのみの商品名は同じキーになります。
+func (p *ProductName) Key() string {
+	return p.key
+}
+
 // NewProductName は商品名を生成します。
 func NewProductName(value string) (*ProductName, error) {
 	const MIN_LENGTH int = 1   // 最小文字数
@@ -29,5 +37,10 @@ func NewProductName(value string) (*ProductName, error) {
 		)
 	}
 
-	return &ProductName{value: value}, nil
+	key := names.Key(value)
+	if key == "" {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "商品名は空白以外の文字を含めてください")
+	}
+
+	return &ProductName{value: value, key: key}, nil
 }
@@ -4,20 +4,49 @@ import (
 	"fmt"
 
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
 )
 
 // ProductPrice は商品価格を表す値オブジェクトです。
+// 税抜の単価と、税込価格の計算に使用する税率区分を持ちます。
 type ProductPrice struct {
-	value uint32 // 商品価格(単位: 円)
+	amount   *money.Money   // 税抜の単価
+	taxClass money.TaxClass // 税率区分
 }
 
-// Value は商品価格の値を返します（単位: 円）。
+// Value は税抜の単価を通貨の最小単位で返します（円の場合は円）。
 func (p *ProductPrice) Value() uint32 {
-	return p.value
+	return uint32(p.amount.Amount())
 }
 
-// NewProductPrice は商品価格を生成します。
+// Money は税抜の単価を返します。
+func (p *ProductPrice) Money() *money.Money {
+	return p.amount
+}
+
+// Currency は通貨コードを返します。
+func (p *ProductPrice) Currency() string {
+	return p.amount.Currency()
+}
+
+// TaxClass は税率区分を返します。
+func (p *ProductPrice) TaxClass() money.TaxClass {
+	return p.taxClass
+}
+
+// IncludingTax は税込価格を返します。
+func (p *ProductPrice) IncludingTax(rounding money.Rounding) *money.Money {
+	return p.amount.IncludingTax(p.taxClass, rounding)
+}
+
+// NewProductPrice は円建て・標準税率の商品価格を生成します。
 func NewProductPrice(value uint32) (*ProductPrice, error) {
+	return NewProductPriceWithTax(value, money.DefaultCurrency, money.TaxClassStandard)
+}
+
+// NewProductPriceWithTax は通貨と税率区分を指定して商品価格を生成します。
+// 単価は通貨の最小単位で1以上1,000,000以下です。
+func NewProductPriceWithTax(value uint32, currency string, taxClass money.TaxClass) (*ProductPrice, error) {
 	const MIN_VALUE uint32 = 1       // 最小値(1円)
 	const MAX_VALUE uint32 = 1000000 // 最大値(1,000,000円)
 
@@ -27,6 +56,13 @@ func NewProductPrice(value uint32) (*ProductPrice, error) {
 			fmt.Sprintf("商品価格は%d円以上%d円以下で入力してください", MIN_VALUE, MAX_VALUE),
 		)
 	}
+	if taxClass.Rate() == 0 {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("未対応の税率区分です: %s", taxClass))
+	}
+	amount, err := money.NewMoney(int64(value), currency)
+	if err != nil {
+		return nil, err
+	}
 
-	return &ProductPrice{value: value}, nil
+	return &ProductPrice{amount: amount, taxClass: taxClass}, nil
 }
@@ -3,6 +3,9 @@ package products
 import (
 	"context"
 	"database/sql"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 )
 
 // ProductRepository は商品エンティティの永続化を担うリポジトリインターフェースです。
@@ -22,6 +25,7 @@ type ProductRepository interface {
 	ExistsById(ctx context.Context, tx *sql.Tx, id *ProductId) (bool, error)
 
 	// FindById は指定された商品IDで商品を取得します。
+	// 商品のバリエーションも合わせて取得します。
 	//
 	// Parameters:
 	//   - ctx: コンテキスト
@@ -33,7 +37,35 @@ type ProductRepository interface {
 	//   - error: エラー
 	FindById(ctx context.Context, tx *sql.Tx, id *ProductId) (*Product, error)
 
+	// LockById は指定された商品を排他ロックし、バリエーションと合わせて取得します。
+	// バリエーションの追加・変更・削除は、商品内の不変条件を保つため必ずこのメソッドでロックしてから行います。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - id: 商品ID
+	//
+	// Returns:
+	//   - *Product: 商品エンティティ
+	//   - error: 存在しない場合はCRUDError (コード: NOT_FOUND)、その他のエラー
+	LockById(ctx context.Context, tx *sql.Tx, id *ProductId) (*Product, error)
+
+	// LockIdsByCategoryIds は指定されたカテゴリのいずれかに属する商品を排他ロックし、そのIDを返します。
+	// 複数の商品の一括変更で使用し、デッドロックを避けるため商品ID順にロックします。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - categoryIds: 商品が属するカテゴリのID
+	//   - tagKeys: 商品にすべて付与されている必要があるタグ名の正規化キー（重複なし、空の場合は絞り込まない）
+	//
+	// Returns:
+	//   - []*ProductId: ロックした商品のID（商品ID順）
+	//   - error: エラー
+	LockIdsByCategoryIds(ctx context.Context, tx *sql.Tx, categoryIds []*categories.CategoryId, tagKeys []string) ([]*ProductId, error)
+
 	// ExistsByName は指定された商品名が存在するかチェックします。
+	// 名前は全角・半角や空白の違いを無視した正規化キーで比較します。
 	//
 	// Parameters:
 	//   - ctx: コンテキスト
@@ -45,6 +77,19 @@ type ProductRepository interface {
 	//   - error: エラー
 	ExistsByName(ctx context.Context, tx *sql.Tx, name *ProductName) (bool, error)
 
+	// FindIdBySlug は指定されたスラッグを使用している商品のIDを取得します。
+	// 現在のスラッグに加えて、変更前のスラッグの履歴も検索します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - slug: スラッグ
+	//
+	// Returns:
+	//   - *ProductId: スラッグを使用している商品のID
+	//   - error: 存在しない場合はCRUDError (コード: NOT_FOUND)、その他のエラー
+	FindIdBySlug(ctx context.Context, tx *sql.Tx, slug names.Slug) (*ProductId, error)
+
 	// Create は新しい商品をデータベースに追加します。
 	//
 	// Parameters:
@@ -53,10 +98,12 @@ type ProductRepository interface {
 	//   - product: 商品エンティティ
 	//
 	// Returns:
-	//   - error: エラー
+	//   - error: 名前の正規化キーまたはスラッグが重複する場合はCRUDError (コード: ALREADY_EXISTS)、
+	//     その他のエラー
 	Create(ctx context.Context, tx *sql.Tx, product *Product) error
 
 	// UpdateById は商品IDを指定して商品情報を更新します。
+	// スラッグが変更された場合は、変更前のスラッグを履歴に残し、旧URLからも解決できるようにします。
 	//
 	// Parameters:
 	//   - ctx: コンテキスト
@@ -64,7 +111,8 @@ type ProductRepository interface {
 	//   - product: 商品エンティティ
 	//
 	// Returns:
-	//   - error: エラー
+	//   - error: 名前の正規化キーまたはスラッグが重複する場合はCRUDError (コード: ALREADY_EXISTS)、
+	//     その他のエラー
 	UpdateById(ctx context.Context, tx *sql.Tx, product *Product) error
 
 	// DeleteById は商品IDを指定して商品を削除します。
@@ -77,4 +125,40 @@ type ProductRepository interface {
 	// Returns:
 	//   - error: エラー
 	DeleteById(ctx context.Context, tx *sql.Tx, id *ProductId) error
+
+	// AddVariant は商品にバリエーションを追加します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - productId: 商品ID
+	//   - variant: バリエーション
+	//
+	// Returns:
+	//   - error: SKUまたは選択肢の組み合わせが重複する場合はCRUDError (コード: ALREADY_EXISTS)、
+	//     その他のエラー
+	AddVariant(ctx context.Context, tx *sql.Tx, productId *ProductId, variant *Variant) error
+
+	// UpdateVariant はバリエーションIDを指定してバリエーションを更新します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - variant: バリエーション
+	//
+	// Returns:
+	//   - error: SKUまたは選択肢の組み合わせが重複する場合はCRUDError (コード: ALREADY_EXISTS)、
+	//     存在しない場合はCRUDError (コード: NOT_FOUND)、その他のエラー
+	UpdateVariant(ctx context.Context, tx *sql.Tx, variant *Variant) error
+
+	// RemoveVariant はバリエーションIDを指定してバリエーションを削除します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - id: バリエーションID
+	//
+	// Returns:
+	//   - error: 存在しない場合はCRUDError (コード: NOT_FOUND)、その他のエラー
+	RemoveVariant(ctx context.Context, tx *sql.Tx, id *VariantId) error
 }
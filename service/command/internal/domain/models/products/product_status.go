@@ -0,0 +1,46 @@
+package products
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// ProductStatus は商品の販売状態（ライフサイクル）を表します。
+type ProductStatus string
+
+const (
+	PRODUCT_DRAFT        ProductStatus = "DRAFT"        // 下書き（公開前）
+	PRODUCT_PUBLISHED    ProductStatus = "PUBLISHED"    // 公開中
+	PRODUCT_SUSPENDED    ProductStatus = "SUSPENDED"    // 一時停止
+	PRODUCT_DISCONTINUED ProductStatus = "DISCONTINUED" // 販売終了
+)
+
+// productStatusTransitions は販売状態ごとに遷移できる状態です。
+// 販売終了した商品はどの状態にも遷移できません。
+var productStatusTransitions = map[ProductStatus][]ProductStatus{
+	PRODUCT_DRAFT:        {PRODUCT_PUBLISHED, PRODUCT_DISCONTINUED},
+	PRODUCT_PUBLISHED:    {PRODUCT_SUSPENDED, PRODUCT_DISCONTINUED},
+	PRODUCT_SUSPENDED:    {PRODUCT_PUBLISHED, PRODUCT_DISCONTINUED},
+	PRODUCT_DISCONTINUED: {},
+}
+
+// ParseProductStatus は文字列から商品の販売状態を生成します。
+func ParseProductStatus(value string) (ProductStatus, error) {
+	status := ProductStatus(value)
+	if _, ok := productStatusTransitions[status]; !ok {
+		return "", errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("不明な商品の状態です: %s", value))
+	}
+	return status, nil
+}
+
+// CanTransitionTo は指定した状態に遷移できるかを返します。
+func (s ProductStatus) CanTransitionTo(to ProductStatus) bool {
+	return slices.Contains(productStatusTransitions[s], to)
+}
+
+// IsPublished は公開中かどうかを返します。
+func (s ProductStatus) IsPublished() bool {
+	return s == PRODUCT_PUBLISHED
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -85,8 +87,23 @@ var _ = Describe("Productエンティティを構成する値オブジェクト"
 			true,
 			"INVALID_ARGUMENT",
 		),
+		Entry(
+			"空白のみの場合、エラーになること",
+			" 　 ",
+			true,
+			"INVALID_ARGUMENT",
+		),
 	)
 
+	It("全角・半角や空白の違いのみの名前は同じ正規化キーになること", func() {
+		a, err := NewProductName("ﾎﾞｰﾙﾍﾟﾝ（黒）")
+		Expect(err).NotTo(HaveOccurred())
+		b, err := NewProductName("ボールペン(黒)")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(a.Value()).NotTo(Equal(b.Value()), "表示用の値は入力のまま保持すること")
+		Expect(a.Key()).To(Equal(b.Key()))
+	})
+
 	DescribeTable("商品価格のバリデーション",
 		func(price uint32, expectError bool, expectedErrorCode string) {
 			productPrice, err := NewProductPrice(price)
@@ -121,6 +138,86 @@ var _ = Describe("Productエンティティを構成する値オブジェクト"
 			"",
 		),
 	)
+
+	Describe("通貨と税率区分", func() {
+		It("NewProductPriceは円建て・標準税率になること", func() {
+			price, err := NewProductPrice(1000)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(price.Currency()).To(Equal("JPY"))
+			Expect(price.TaxClass()).To(Equal(money.TaxClassStandard))
+			Expect(price.IncludingTax(money.RoundingFloor).Amount()).To(Equal(int64(1100)))
+		})
+
+		It("軽減税率の税込価格を計算できること", func() {
+			price, err := NewProductPriceWithTax(198, "JPY", money.TaxClassReduced)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(price.Value()).To(Equal(uint32(198)))
+			Expect(price.IncludingTax(money.RoundingFloor).Amount()).To(Equal(int64(213)))
+			Expect(price.IncludingTax(money.RoundingHalfUp).Amount()).To(Equal(int64(214)))
+		})
+
+		DescribeTable("不正な通貨・税率区分はエラーになること",
+			func(currency string, taxClass money.TaxClass) {
+				price, err := NewProductPriceWithTax(1000, currency, taxClass)
+				Expect(err).To(HaveOccurred())
+				domainErr, ok := err.(*errs.DomainError)
+				Expect(ok).To(BeTrue())
+				Expect(domainErr.Code).To(Equal("INVALID_ARGUMENT"))
+				Expect(price).To(BeNil())
+			},
+			Entry("未対応の通貨", "GBP", money.TaxClassStandard),
+			Entry("未対応の税率区分", "JPY", money.TaxClass("EXEMPT")),
+		)
+	})
+
+	Describe("NewProductIds", func() {
+		It("重複を除いて指定された順序で商品IDを生成すること", func() {
+			a, b := uuid.New().String(), uuid.New().String()
+			ids, err := NewProductIds([]string{a, b, a})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(HaveLen(2))
+			Expect(ids[0].Value()).To(Equal(a))
+			Expect(ids[1].Value()).To(Equal(b))
+		})
+
+		It("空の場合、エラーになること", func() {
+			ids, err := NewProductIds([]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(ids).To(BeNil())
+		})
+
+		It("不正な商品IDが含まれる場合、エラーになること", func() {
+			ids, err := NewProductIds([]string{uuid.New().String(), "invalid"})
+			Expect(err).To(HaveOccurred())
+			Expect(ids).To(BeNil())
+		})
+	})
+
+	DescribeTable("バーコードのバリデーション",
+		func(value string, expectError bool, expected string, format BarcodeFormat) {
+			barcode, err := NewBarcode(value)
+
+			if expectError {
+				Expect(err).To(HaveOccurred())
+				domainErr, ok := err.(*errs.DomainError)
+				Expect(ok).To(BeTrue())
+				Expect(domainErr.Code).To(Equal("INVALID_ARGUMENT"))
+				Expect(barcode).To(BeNil())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(barcode.Value()).To(Equal(expected))
+				Expect(barcode.Format()).To(Equal(format))
+			}
+		},
+		Entry("JAN-13", "4901234567894", false, "4901234567894", BARCODE_EAN13),
+		Entry("JAN-8", "49123456", false, "49123456", BARCODE_EAN8),
+		Entry("UPC-AはEAN-13に正規化すること", "036000291452", false, "0036000291452", BARCODE_EAN13),
+		Entry("前後の空白を除去すること", " 4569951116179 ", false, "4569951116179", BARCODE_EAN13),
+		Entry("チェックデジットが誤っている場合、エラーになること", "4901234567895", true, "", BarcodeFormat("")),
+		Entry("桁数が不正な場合、エラーになること", "49012345678", true, "", BarcodeFormat("")),
+		Entry("数字以外を含む場合、エラーになること", "49O1234567894", true, "", BarcodeFormat("")),
+		Entry("空文字列の場合、エラーになること", "", true, "", BarcodeFormat("")),
+	)
 })
 
 var _ = Describe("Productエンティティオブジェクト", Label("Productエンティティ"), func() {
@@ -160,6 +257,7 @@ var _ = Describe("Productエンティティオブジェクト", Label("Product
 				Expect(product.Name()).To(Equal(name))
 				Expect(product.Price()).To(Equal(price))
 				Expect(product.Category()).To(Equal(category))
+				Expect(product.Status()).To(Equal(PRODUCT_DRAFT))
 			}
 		},
 		Entry(
@@ -179,7 +277,7 @@ var _ = Describe("Productエンティティオブジェクト", Label("Product
 	DescribeTable("BuildProduct",
 		func(setupFunc func() (*ProductId, *ProductName, *ProductPrice, *categories.Category), expectError bool) {
 			id, name, price, category := setupFunc()
-			product, err := BuildProduct(id, name, price, category)
+			product, err := BuildProduct(id, name, price, category, PRODUCT_PUBLISHED)
 
 			if expectError {
 				Expect(err).To(HaveOccurred())
@@ -315,8 +413,8 @@ var _ = Describe("Productエンティティオブジェクト", Label("Product
 				categoryName, _ := categories.NewCategoryName("カテゴリ1")
 				category, _ := categories.NewCategory(categoryName)
 
-				product1, _ := BuildProduct(productId, name, price, category)
-				product2, _ := BuildProduct(productId, name, price, category)
+				product1, _ := BuildProduct(productId, name, price, category, PRODUCT_PUBLISHED)
+				product2, _ := BuildProduct(productId, name, price, category, PRODUCT_PUBLISHED)
 				return product1, product2
 			},
 			true,
@@ -355,4 +453,72 @@ var _ = Describe("Productエンティティオブジェクト", Label("Product
 			"INVALID_ARGUMENT",
 		),
 	)
+
+	Describe("ChangeTranslations", func() {
+		It("既定のロケール以外の商品名を設定できること", func() {
+			product, err := NewProduct(validProductName, validProductPrice, validCategory)
+			Expect(err).NotTo(HaveOccurred())
+			en, _ := NewProductName("Test product")
+
+			Expect(product.ChangeTranslations(map[names.Locale]*ProductName{"en": en})).To(Succeed())
+			Expect(product.Translations()).To(HaveKeyWithValue(names.Locale("en"), en))
+		})
+
+		It("既定のロケールを指定した場合、エラーになること", func() {
+			product, err := NewProduct(validProductName, validProductPrice, validCategory)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = product.ChangeTranslations(map[names.Locale]*ProductName{names.DefaultLocale: validProductName})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*errs.DomainError).Code).To(Equal("INVALID_ARGUMENT"))
+			Expect(product.Translations()).To(BeEmpty())
+		})
+	})
+
+	Describe("販売状態の遷移", func() {
+		DescribeTable("許可された遷移のみ状態を変更すること",
+			func(from ProductStatus, transition func(*Product) error, expected ProductStatus, expectError bool) {
+				id, err := NewProductId(uuid.New().String())
+				Expect(err).NotTo(HaveOccurred())
+				product, err := BuildProduct(id, validProductName, validProductPrice, validCategory, from)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = transition(product)
+
+				if expectError {
+					Expect(err).To(HaveOccurred())
+					Expect(err.(*errs.DomainError).Code).To(Equal("INVALID_STATUS_TRANSITION"))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+				Expect(product.Status()).To(Equal(expected))
+			},
+			Entry("下書きの商品を公開できること", PRODUCT_DRAFT, (*Product).Publish, PRODUCT_PUBLISHED, false),
+			Entry("下書きの商品の販売を終了できること", PRODUCT_DRAFT, (*Product).Discontinue, PRODUCT_DISCONTINUED, false),
+			Entry("下書きの商品は一時停止できないこと", PRODUCT_DRAFT, (*Product).Suspend, PRODUCT_DRAFT, true),
+			Entry("公開中の商品を一時停止できること", PRODUCT_PUBLISHED, (*Product).Suspend, PRODUCT_SUSPENDED, false),
+			Entry("公開中の商品の販売を終了できること", PRODUCT_PUBLISHED, (*Product).Discontinue, PRODUCT_DISCONTINUED, false),
+			Entry("公開中の商品は再度公開できないこと", PRODUCT_PUBLISHED, (*Product).Publish, PRODUCT_PUBLISHED, true),
+			Entry("一時停止中の商品を再公開できること", PRODUCT_SUSPENDED, (*Product).Publish, PRODUCT_PUBLISHED, false),
+			Entry("一時停止中の商品の販売を終了できること", PRODUCT_SUSPENDED, (*Product).Discontinue, PRODUCT_DISCONTINUED, false),
+			Entry("販売終了した商品は公開できないこと", PRODUCT_DISCONTINUED, (*Product).Publish, PRODUCT_DISCONTINUED, true),
+			Entry("販売終了した商品は一時停止できないこと", PRODUCT_DISCONTINUED, (*Product).Suspend, PRODUCT_DISCONTINUED, true),
+		)
+
+		DescribeTable("ParseProductStatus",
+			func(value string, expectError bool) {
+				status, err := ParseProductStatus(value)
+				if expectError {
+					Expect(err).To(HaveOccurred())
+					Expect(err.(*errs.DomainError).Code).To(Equal("INVALID_ARGUMENT"))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(status)).To(Equal(value))
+				}
+			},
+			Entry("DRAFTを解釈できること", "DRAFT", false),
+			Entry("DISCONTINUEDを解釈できること", "DISCONTINUED", false),
+			Entry("不明な状態の場合、エラーになること", "DELETED", true),
+		)
+	})
 })
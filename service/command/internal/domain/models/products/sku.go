@@ -0,0 +1,51 @@
+package products
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// Sku はバリエーションごとの在庫管理単位コード(SKU)を表す値オブジェクトです。
+// 英大文字・数字・ハイフンのみで構成され、全商品を通して一意です。
+type Sku struct {
+	value string // SKUコード
+}
+
+// Value はSKUコードを返します。
+func (s *Sku) Value() string {
+	return s.value
+}
+
+// Equals は2つのSKUコードが等しいかを検証します。
+func (s *Sku) Equals(other *Sku) bool {
+	if other == nil {
+		return false
+	}
+	return s.value == other.Value()
+}
+
+var getSkuRegexp = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile("^[A-Z0-9]+(-[A-Z0-9]+)*$")
+})
+
+// NewSku はSKUコードを生成します。
+// 前後の空白を除去し、英小文字は大文字に変換します。
+func NewSku(value string) (*Sku, error) {
+	const MAX_LENGTH int = 64
+
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	if normalized == "" || len(normalized) > MAX_LENGTH {
+		return nil, errs.NewDomainError(
+			"INVALID_ARGUMENT", fmt.Sprintf("SKUは1文字以上%d文字以下で入力してください", MAX_LENGTH),
+		)
+	}
+	if !getSkuRegexp().MatchString(normalized) {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "SKUは英数字とハイフンで入力してください")
+	}
+
+	return &Sku{value: normalized}, nil
+}
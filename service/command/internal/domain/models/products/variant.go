@@ -0,0 +1,111 @@
+package products
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// VariantStatus はバリエーションの販売状態を表します。
+type VariantStatus string
+
+const (
+	VARIANT_ACTIVE   VariantStatus = "ACTIVE"   // 販売中
+	VARIANT_INACTIVE VariantStatus = "INACTIVE" // 販売停止
+)
+
+// ParseVariantStatus は文字列からバリエーションの販売状態を生成します。
+func ParseVariantStatus(value string) (VariantStatus, error) {
+	switch status := VariantStatus(value); status {
+	case VARIANT_ACTIVE, VARIANT_INACTIVE:
+		return status, nil
+	default:
+		return "", errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("不明なバリエーションの状態です: %s", value))
+	}
+}
+
+// Variant は商品のバリエーション(サイズやカラー違い)を表すエンティティです。
+// バリエーションは商品集約(Product)を経由してのみ追加・変更・削除します。
+type Variant struct {
+	id            *VariantId      // バリエーションID
+	sku           *Sku            // SKUコード
+	options       *VariantOptions // 選択肢の組み合わせ
+	priceOverride *ProductPrice   // 価格の上書き(nilの場合は商品価格を使用)
+	status        VariantStatus   // 販売状態
+}
+
+// Id はバリエーションIDを返します。
+func (v *Variant) Id() *VariantId {
+	return v.id
+}
+
+// Sku はSKUコードを返します。
+func (v *Variant) Sku() *Sku {
+	return v.sku
+}
+
+// Options は選択肢の組み合わせを返します。
+func (v *Variant) Options() *VariantOptions {
+	return v.options
+}
+
+// PriceOverride は上書きした価格を返します。上書きしていない場合はnilを返します。
+func (v *Variant) PriceOverride() *ProductPrice {
+	return v.priceOverride
+}
+
+// Status は販売状態を返します。
+func (v *Variant) Status() VariantStatus {
+	return v.status
+}
+
+// EffectivePrice は商品価格を考慮したバリエーションの販売価格を返します。
+func (v *Variant) EffectivePrice(productPrice *ProductPrice) *ProductPrice {
+	if v.priceOverride != nil {
+		return v.priceOverride
+	}
+	return productPrice
+}
+
+// Equals は2つのバリエーションの同一性を検証します。
+func (v *Variant) Equals(other *Variant) bool {
+	if other == nil {
+		return false
+	}
+	return v.id.Equals(other.Id())
+}
+
+// NewVariant は新しいバリエーションを生成します。
+//
+// Parameters:
+//   - sku: SKUコード
+//   - options: 選択肢の組み合わせ
+//   - priceOverride: 価格の上書き(nilの場合は商品価格を使用)
+//   - status: 販売状態
+//
+// Returns:
+//   - *Variant: 生成したバリエーション
+//   - error: ID生成に失敗した場合のエラー
+func NewVariant(sku *Sku, options *VariantOptions, priceOverride *ProductPrice, status VariantStatus) (*Variant, error) {
+	uid, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainErrorWithCause("INTERNAL", "バリエーションIDの生成に失敗しました", err)
+	}
+	id, err := NewVariantId(uid.String())
+	if err != nil {
+		return nil, errs.NewDomainErrorWithCause("INTERNAL", "バリエーションIDの生成に失敗しました", err)
+	}
+	return BuildVariant(id, sku, options, priceOverride, status), nil
+}
+
+// BuildVariant は既存のバリエーションIDを使用してバリエーションを再構築します。
+func BuildVariant(id *VariantId, sku *Sku, options *VariantOptions, priceOverride *ProductPrice, status VariantStatus) *Variant {
+	return &Variant{
+		id:            id,
+		sku:           sku,
+		options:       options,
+		priceOverride: priceOverride,
+		status:        status,
+	}
+}
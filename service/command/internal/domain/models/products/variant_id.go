@@ -0,0 +1,45 @@
+package products
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// VariantId はバリエーションIDを表す値オブジェクトです。
+type VariantId struct {
+	value string // バリエーション番号(UUID)
+}
+
+// Value はバリエーションIDの値を返します。
+func (v *VariantId) Value() string {
+	return v.value
+}
+
+// Equals は2つのバリエーションIDの同一性を検証します。
+func (v *VariantId) Equals(other *VariantId) bool {
+	if other == nil {
+		return false
+	}
+	if v == other {
+		return true
+	}
+	return v.value == other.Value()
+}
+
+// NewVariantId はバリエーションIDを生成します。
+func NewVariantId(value string) (*VariantId, error) {
+	const LENGTH int = 36
+
+	if utf8.RuneCountInString(value) != LENGTH {
+		return nil, errs.NewDomainError(
+			"INVALID_ARGUMENT", fmt.Sprintf("バリエーションIDの長さは%d文字である必要があります", LENGTH),
+		)
+	}
+	if !getUUIDRegexp().MatchString(value) {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "バリエーションIDはUUIDの形式である必要があります")
+	}
+
+	return &VariantId{value: value}, nil
+}
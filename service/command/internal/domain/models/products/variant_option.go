@@ -0,0 +1,118 @@
+package products
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+)
+
+// VariantOption はバリエーションの選択肢(軸と値の組)を表す値オブジェクトです。
+// 例: 軸「サイズ」と値「M」、軸「カラー」と値「ブラック」
+type VariantOption struct {
+	axis  string // 選択肢の軸
+	value string // 選択肢の値
+}
+
+// Axis は選択肢の軸を返します。
+func (o *VariantOption) Axis() string {
+	return o.axis
+}
+
+// Value は選択肢の値を返します。
+func (o *VariantOption) Value() string {
+	return o.value
+}
+
+// axisKey は軸の重複判定に使用する正規化キーを返します。
+func (o *VariantOption) axisKey() string {
+	return names.Key(o.axis)
+}
+
+// NewVariantOption はバリエーションの選択肢を生成します。
+func NewVariantOption(axis string, value string) (*VariantOption, error) {
+	const MAX_AXIS_LENGTH int = 30
+	const MAX_VALUE_LENGTH int = 50
+
+	axis = strings.TrimSpace(axis)
+	value = strings.TrimSpace(value)
+	if axis == "" || utf8.RuneCountInString(axis) > MAX_AXIS_LENGTH {
+		return nil, errs.NewDomainError(
+			"INVALID_ARGUMENT", fmt.Sprintf("選択肢の軸は1文字以上%d文字以下で入力してください", MAX_AXIS_LENGTH),
+		)
+	}
+	if value == "" || utf8.RuneCountInString(value) > MAX_VALUE_LENGTH {
+		return nil, errs.NewDomainError(
+			"INVALID_ARGUMENT", fmt.Sprintf("選択肢の値は1文字以上%d文字以下で入力してください", MAX_VALUE_LENGTH),
+		)
+	}
+
+	return &VariantOption{axis: axis, value: value}, nil
+}
+
+// VariantOptions はバリエーションを特定する選択肢の組み合わせを表す値オブジェクトです。
+// 選択肢は軸の正規化キーの順に並べて保持します。
+type VariantOptions struct {
+	options []*VariantOption // 選択肢
+}
+
+// Values は選択肢を軸の順に返します。
+func (o *VariantOptions) Values() []*VariantOption {
+	return slices.Clone(o.options)
+}
+
+// Key は選択肢の組み合わせの重複判定に使用する正規化キーを返します。
+// 例: "カラー=ブラック;サイズ=M"
+func (o *VariantOptions) Key() string {
+	pairs := make([]string, 0, len(o.options))
+	for _, option := range o.options {
+		pairs = append(pairs, option.axisKey()+"="+names.Key(option.value))
+	}
+	return strings.Join(pairs, ";")
+}
+
+// AxesKey は選択肢の軸の組み合わせを表す正規化キーを返します。
+// 同じ商品のバリエーションはすべて同じ軸の組み合わせを持ちます。
+func (o *VariantOptions) AxesKey() string {
+	axes := make([]string, 0, len(o.options))
+	for _, option := range o.options {
+		axes = append(axes, option.axisKey())
+	}
+	return strings.Join(axes, ";")
+}
+
+// Equals は2つの選択肢の組み合わせが等しいかを検証します。
+func (o *VariantOptions) Equals(other *VariantOptions) bool {
+	if other == nil {
+		return false
+	}
+	return o.Key() == other.Key()
+}
+
+// NewVariantOptions は選択肢の組み合わせを生成します。
+// 選択肢は1件以上3件以下で、同じ軸を重複して指定することはできません。
+func NewVariantOptions(options ...*VariantOption) (*VariantOptions, error) {
+	const MAX_OPTIONS int = 3
+
+	if len(options) == 0 || len(options) > MAX_OPTIONS {
+		return nil, errs.NewDomainError(
+			"INVALID_ARGUMENT", fmt.Sprintf("選択肢は1件以上%d件以下で指定してください", MAX_OPTIONS),
+		)
+	}
+	sorted := slices.Clone(options)
+	slices.SortFunc(sorted, func(a, b *VariantOption) int {
+		return strings.Compare(a.axisKey(), b.axisKey())
+	})
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1].axisKey() == sorted[i].axisKey() {
+			return nil, errs.NewDomainError(
+				"INVALID_ARGUMENT", fmt.Sprintf("選択肢の軸が重複しています: %s", sorted[i].axis),
+			)
+		}
+	}
+
+	return &VariantOptions{options: sorted}, nil
+}
@@ -0,0 +1,174 @@
+package products
+
+import (
+	"strings"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Variantを構成する値オブジェクト", Label("Variantの値オブジェクト"), func() {
+	DescribeTable("SKUのバリデーション",
+		func(value string, expectError bool, expected string) {
+			sku, err := NewSku(value)
+
+			if expectError {
+				Expect(err).To(HaveOccurred())
+				domainErr, ok := err.(*errs.DomainError)
+				Expect(ok).To(BeTrue())
+				Expect(domainErr.Code).To(Equal("INVALID_ARGUMENT"))
+				Expect(sku).To(BeNil())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sku.Value()).To(Equal(expected))
+			}
+		},
+		Entry("空文字列の場合、エラーになること", "", true, ""),
+		Entry("65文字以上の場合、エラーになること", strings.Repeat("A", 65), true, ""),
+		Entry("記号を含む場合、エラーになること", "TS_001", true, ""),
+		Entry("ハイフンで始まる場合、エラーになること", "-TS001", true, ""),
+		Entry("連続するハイフンを含む場合、エラーになること", "TS--001", true, ""),
+		Entry("前後の空白を除去し大文字に変換すること", " ts-001-m ", false, "TS-001-M"),
+	)
+
+	DescribeTable("選択肢のバリデーション",
+		func(axis string, value string, expectError bool) {
+			option, err := NewVariantOption(axis, value)
+
+			if expectError {
+				Expect(err).To(HaveOccurred())
+				Expect(option).To(BeNil())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(option).NotTo(BeNil())
+			}
+		},
+		Entry("軸が空の場合、エラーになること", " ", "M", true),
+		Entry("値が空の場合、エラーになること", "サイズ", "", true),
+		Entry("軸が31文字以上の場合、エラーになること", strings.Repeat("あ", 31), "M", true),
+		Entry("値が51文字以上の場合、エラーになること", "サイズ", strings.Repeat("あ", 51), true),
+		Entry("正常な値の場合、エラーにならないこと", "サイズ", "M", false),
+	)
+
+	It("選択肢の組み合わせは軸の順序に関係なく同じ正規化キーになること", func() {
+		a, err := NewVariantOptions(mustOption("サイズ", "M"), mustOption("カラー", "ブラック"))
+		Expect(err).NotTo(HaveOccurred())
+		b, err := NewVariantOptions(mustOption("カラー", "ﾌﾞﾗｯｸ"), mustOption("サイズ", "Ｍ"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(a.Key()).To(Equal(b.Key()))
+		Expect(a.Equals(b)).To(BeTrue())
+		Expect(a.AxesKey()).To(Equal(b.AxesKey()))
+	})
+
+	DescribeTable("選択肢の組み合わせのバリデーション",
+		func(options []*VariantOption) {
+			variantOptions, err := NewVariantOptions(options...)
+			Expect(err).To(HaveOccurred())
+			Expect(variantOptions).To(BeNil())
+		},
+		Entry("選択肢がない場合、エラーになること", []*VariantOption{}),
+		Entry("選択肢が4件以上の場合、エラーになること", []*VariantOption{
+			mustOption("a", "1"), mustOption("b", "1"), mustOption("c", "1"), mustOption("d", "1"),
+		}),
+		Entry("軸が重複する場合、エラーになること", []*VariantOption{
+			mustOption("サイズ", "M"), mustOption("ｻｲｽﾞ", "L"),
+		}),
+	)
+})
+
+var _ = Describe("Productのバリエーション", Label("Variant"), func() {
+	var product *Product
+
+	BeforeEach(func() {
+		var err error
+		product, err = NewProduct(mustProductName("Tシャツ"), mustProductPrice(2000), nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("価格を上書きしない場合は商品価格で販売すること", func() {
+		variant := mustVariant("TS-M", nil, mustOption("サイズ", "M"))
+		Expect(variant.EffectivePrice(product.Price()).Value()).To(Equal(uint32(2000)))
+
+		override := mustVariant("TS-XL", mustProductPrice(2500), mustOption("サイズ", "XL"))
+		Expect(override.EffectivePrice(product.Price()).Value()).To(Equal(uint32(2500)))
+	})
+
+	It("バリエーションを追加・変更・削除できること", func() {
+		m := mustVariant("TS-M", nil, mustOption("サイズ", "M"))
+		Expect(product.AddVariant(m)).To(Succeed())
+		Expect(product.AddVariant(mustVariant("TS-L", nil, mustOption("サイズ", "L")))).To(Succeed())
+		Expect(product.Variants()).To(HaveLen(2))
+
+		updated := BuildVariant(m.Id(), m.Sku(), m.Options(), mustProductPrice(1800), VARIANT_INACTIVE)
+		Expect(product.UpdateVariant(updated)).To(Succeed())
+		found, err := product.FindVariant(m.Id())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found.Status()).To(Equal(VARIANT_INACTIVE))
+		Expect(found.PriceOverride().Value()).To(Equal(uint32(1800)))
+
+		removed, err := product.RemoveVariant(m.Id())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed.Equals(m)).To(BeTrue())
+		Expect(product.Variants()).To(HaveLen(1))
+		_, err = product.FindVariant(m.Id())
+		Expect(err.(*errs.DomainError).Code).To(Equal("VARIANT_NOT_FOUND"))
+	})
+
+	DescribeTable("商品内の不変条件に違反するバリエーションは追加できないこと",
+		func(variant func() *Variant, expectedCode string) {
+			Expect(product.AddVariant(mustVariant("TS-M", nil, mustOption("サイズ", "M")))).To(Succeed())
+
+			err := product.AddVariant(variant())
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*errs.DomainError).Code).To(Equal(expectedCode))
+			Expect(product.Variants()).To(HaveLen(1))
+		},
+		Entry("SKUが重複する場合",
+			func() *Variant { return mustVariant("ts-m", nil, mustOption("サイズ", "L")) },
+			"VARIANT_ALREADY_EXISTS",
+		),
+		Entry("選択肢の組み合わせが重複する場合",
+			func() *Variant { return mustVariant("TS-M2", nil, mustOption("ｻｲｽﾞ", "Ｍ")) },
+			"VARIANT_ALREADY_EXISTS",
+		),
+		Entry("選択肢の軸が異なる場合",
+			func() *Variant { return mustVariant("TS-BLK", nil, mustOption("カラー", "ブラック")) },
+			"INVALID_ARGUMENT",
+		),
+	)
+
+	It("存在しないバリエーションは変更できないこと", func() {
+		err := product.UpdateVariant(mustVariant("TS-M", nil, mustOption("サイズ", "M")))
+		Expect(err.(*errs.DomainError).Code).To(Equal("VARIANT_NOT_FOUND"))
+	})
+})
+
+func mustOption(axis string, value string) *VariantOption {
+	option, err := NewVariantOption(axis, value)
+	Expect(err).NotTo(HaveOccurred())
+	return option
+}
+
+func mustVariant(sku string, priceOverride *ProductPrice, options ...*VariantOption) *Variant {
+	s, err := NewSku(sku)
+	Expect(err).NotTo(HaveOccurred())
+	variantOptions, err := NewVariantOptions(options...)
+	Expect(err).NotTo(HaveOccurred())
+	variant, err := NewVariant(s, variantOptions, priceOverride, VARIANT_ACTIVE)
+	Expect(err).NotTo(HaveOccurred())
+	return variant
+}
+
+func mustProductName(value string) *ProductName {
+	name, err := NewProductName(value)
+	Expect(err).NotTo(HaveOccurred())
+	return name
+}
+
+func mustProductPrice(value uint32) *ProductPrice {
+	price, err := NewProductPrice(value)
+	Expect(err).NotTo(HaveOccurred())
+	return price
+}
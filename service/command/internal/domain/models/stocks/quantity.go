@@ -0,0 +1,34 @@
+package stocks
+
+import (
+	"fmt"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// MAX_QUANTITY は在庫数・引当数量の上限です。
+const MAX_QUANTITY uint32 = 1000000
+
+// Quantity は引当数量を表す値オブジェクトです。
+type Quantity struct {
+	value uint32 // 数量(単位: 個)
+}
+
+// Value は数量の値を返します。
+func (q *Quantity) Value() uint32 {
+	return q.value
+}
+
+// NewQuantity は引当数量を生成します。
+func NewQuantity(value uint32) (*Quantity, error) {
+	const MIN_VALUE uint32 = 1 // 最小値(1個)
+
+	if value < MIN_VALUE || value > MAX_QUANTITY {
+		return nil, errs.NewDomainError(
+			"INVALID_ARGUMENT",
+			fmt.Sprintf("数量は%d個以上%d個以下で入力してください", MIN_VALUE, MAX_QUANTITY),
+		)
+	}
+
+	return &Quantity{value: value}, nil
+}
@@ -0,0 +1,120 @@
+package stocks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// ReservationStatus は在庫引当の状態を表します。
+type ReservationStatus string
+
+const (
+	RESERVATION_RESERVED  ReservationStatus = "RESERVED"  // 引当中
+	RESERVATION_RELEASED  ReservationStatus = "RELEASED"  // 解放済み（キャンセル）
+	RESERVATION_COMMITTED ReservationStatus = "COMMITTED" // 確定済み（出荷）
+	RESERVATION_EXPIRED   ReservationStatus = "EXPIRED"   // 期限切れ
+)
+
+// ParseReservationStatus は文字列から在庫引当の状態を生成します。
+func ParseReservationStatus(value string) (ReservationStatus, error) {
+	switch status := ReservationStatus(value); status {
+	case RESERVATION_RESERVED, RESERVATION_RELEASED, RESERVATION_COMMITTED, RESERVATION_EXPIRED:
+		return status, nil
+	default:
+		return "", errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("不明な引当状態です: %s", value))
+	}
+}
+
+// Reservation は在庫引当エンティティを表すドメインオブジェクトです。
+// 引当は在庫集約(Stock)を経由してのみ状態を変更します。
+type Reservation struct {
+	id        *ReservationId      // 引当ID
+	productId *products.ProductId // 商品ID
+	quantity  *Quantity           // 引当数量
+	status    ReservationStatus   // 状態
+	expiresAt time.Time           // 有効期限
+}
+
+// Id は引当IDを返します。
+func (r *Reservation) Id() *ReservationId {
+	return r.id
+}
+
+// ProductId は商品IDを返します。
+func (r *Reservation) ProductId() *products.ProductId {
+	return r.productId
+}
+
+// Quantity は引当数量を返します。
+func (r *Reservation) Quantity() *Quantity {
+	return r.quantity
+}
+
+// Status は引当の状態を返します。
+func (r *Reservation) Status() ReservationStatus {
+	return r.status
+}
+
+// ExpiresAt は引当の有効期限を返します。
+func (r *Reservation) ExpiresAt() time.Time {
+	return r.expiresAt
+}
+
+// IsActive は引当中（在庫を確保している状態）かどうかを返します。
+func (r *Reservation) IsActive() bool {
+	return r.status == RESERVATION_RESERVED
+}
+
+// IsExpired は指定時刻において有効期限を過ぎた引当中の引当かどうかを返します。
+func (r *Reservation) IsExpired(now time.Time) bool {
+	return r.IsActive() && !now.Before(r.expiresAt)
+}
+
+// transition は引当中の引当を指定した状態に遷移させます。
+func (r *Reservation) transition(status ReservationStatus) error {
+	if !r.IsActive() {
+		return errs.NewDomainError(
+			"RESERVATION_NOT_ACTIVE",
+			fmt.Sprintf("引当ID: %s は%sのため変更できません", r.id.Value(), r.status),
+		)
+	}
+	r.status = status
+	return nil
+}
+
+// newReservation は新しい在庫引当エンティティを生成します。
+func newReservation(productId *products.ProductId, quantity *Quantity, expiresAt time.Time) (*Reservation, error) {
+	uid, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainErrorWithCause("INTERNAL", "引当IDの生成に失敗しました", err)
+	}
+
+	id, err := NewReservationId(uid.String())
+	if err != nil {
+		return nil, errs.NewDomainErrorWithCause("INTERNAL", "引当IDの生成に失敗しました", err)
+	}
+
+	return &Reservation{
+		id:        id,
+		productId: productId,
+		quantity:  quantity,
+		status:    RESERVATION_RESERVED,
+		expiresAt: expiresAt,
+	}, nil
+}
+
+// BuildReservation は既存の引当IDを使用して在庫引当エンティティを再構築します。
+func BuildReservation(id *ReservationId, productId *products.ProductId, quantity *Quantity, status ReservationStatus, expiresAt time.Time) (*Reservation, error) {
+	reservation := Reservation{
+		id:        id,
+		productId: productId,
+		quantity:  quantity,
+		status:    status,
+		expiresAt: expiresAt,
+	}
+	return &reservation, nil
+}
@@ -0,0 +1,56 @@
+package stocks
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// ReservationId は在庫引当IDを表す値オブジェクトです。
+type ReservationId struct {
+	value string // 引当番号(UUID)
+}
+
+// Value は在庫引当IDの値を返します。
+func (r *ReservationId) Value() string {
+	return r.value
+}
+
+// Equals は2つの在庫引当IDの同一性を検証します。
+func (r *ReservationId) Equals(other *ReservationId) bool {
+	if other == nil {
+		return false
+	}
+	if r == other { // アドレスが同じ?
+		return true
+	}
+	// 値の比較
+	return r.value == other.Value()
+}
+
+var getUUIDRegexp = sync.OnceValue(func() *regexp.Regexp {
+	const REGEXP string = "^([0-9a-f]{8})-([0-9a-f]{4})-([0-9a-f]{4})-([0-9a-f]{4})-([0-9a-f]{12})$"
+	return regexp.MustCompile(REGEXP)
+})
+
+// NewReservationId は在庫引当IDを生成します。
+func NewReservationId(value string) (*ReservationId, error) {
+	// フィールドの長さ
+	const LENGTH int = 36
+
+	// 引数の文字数チェック
+	if utf8.RuneCountInString(value) != LENGTH {
+		return nil, errs.NewDomainError(
+			"INVALID_ARGUMENT", fmt.Sprintf("引当IDの長さは%d文字である必要があります", LENGTH),
+		)
+	}
+	// UUIDの形式チェック
+	if !getUUIDRegexp().MatchString(value) {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "引当IDはUUIDの形式である必要があります")
+	}
+
+	return &ReservationId{value: value}, nil
+}
@@ -0,0 +1,47 @@
+package stocks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// ReservationPolicy は在庫引当の有効期限に関するポリシーです。
+type ReservationPolicy struct {
+	defaultTTL time.Duration // 有効期限の既定値
+	maxTTL     time.Duration // 指定できる有効期限の上限
+}
+
+// DefaultTTL は有効期限の既定値を返します。
+func (p *ReservationPolicy) DefaultTTL() time.Duration {
+	return p.defaultTTL
+}
+
+// MaxTTL は指定できる有効期限の上限を返します。
+func (p *ReservationPolicy) MaxTTL() time.Duration {
+	return p.maxTTL
+}
+
+// ExpiresAt は引当の有効期限を計算します。
+// ttlが0の場合は既定値を使用し、上限を超える場合はINVALID_ARGUMENTエラーを返します。
+func (p *ReservationPolicy) ExpiresAt(now time.Time, ttl time.Duration) (time.Time, error) {
+	if ttl == 0 {
+		ttl = p.defaultTTL
+	}
+	if ttl < 0 || ttl > p.maxTTL {
+		return time.Time{}, errs.NewDomainError(
+			"INVALID_ARGUMENT",
+			fmt.Sprintf("引当の有効期限は%s以下で指定してください", p.maxTTL),
+		)
+	}
+	return now.Add(ttl), nil
+}
+
+// NewReservationPolicy は在庫引当の有効期限ポリシーを生成します。
+func NewReservationPolicy(defaultTTL time.Duration, maxTTL time.Duration) (*ReservationPolicy, error) {
+	if defaultTTL <= 0 || defaultTTL > maxTTL {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "引当の有効期限の既定値は0より大きく上限以下である必要があります")
+	}
+	return &ReservationPolicy{defaultTTL: defaultTTL, maxTTL: maxTTL}, nil
+}
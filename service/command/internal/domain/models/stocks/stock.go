@@ -0,0 +1,128 @@
+package stocks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// Stock は商品ごとの在庫を表す集約ルートです。
+// 引当済みの数量が在庫数を超えないこと（売り越さないこと）を不変条件として保証します。
+type Stock struct {
+	productId *products.ProductId // 商品ID
+	onHand    uint32              // 在庫数
+	reserved  uint32              // 引当済みの数量
+}
+
+// ProductId は商品IDを返します。
+func (s *Stock) ProductId() *products.ProductId {
+	return s.productId
+}
+
+// OnHand は在庫数を返します。
+func (s *Stock) OnHand() uint32 {
+	return s.onHand
+}
+
+// Reserved は引当済みの数量を返します。
+func (s *Stock) Reserved() uint32 {
+	return s.reserved
+}
+
+// Available は引当可能な数量（在庫数 - 引当済みの数量）を返します。
+func (s *Stock) Available() uint32 {
+	return s.onHand - s.reserved
+}
+
+// Adjust は入荷や棚卸によって在庫数を増減します。
+// 在庫数を0未満、引当済みの数量未満、または上限を超える値にすることはできません。
+func (s *Stock) Adjust(delta int32) error {
+	next := int64(s.onHand) + int64(delta)
+	if next < 0 {
+		return errs.NewDomainError("INVALID_ARGUMENT", "在庫数を0未満にすることはできません")
+	}
+	if next > int64(MAX_QUANTITY) {
+		return errs.NewDomainError("INVALID_ARGUMENT", fmt.Sprintf("在庫数は%d個以下である必要があります", MAX_QUANTITY))
+	}
+	if next < int64(s.reserved) {
+		return errs.NewDomainError(
+			"INSUFFICIENT_STOCK",
+			fmt.Sprintf("引当済みの数量(%d個)を下回る在庫数にはできません", s.reserved),
+		)
+	}
+	s.onHand = uint32(next)
+	return nil
+}
+
+// Reserve は指定数量を引き当て、新しい在庫引当を生成します。
+// 引当可能な数量が不足している場合はINSUFFICIENT_STOCKエラーを返します。
+func (s *Stock) Reserve(quantity *Quantity, expiresAt time.Time) (*Reservation, error) {
+	if quantity.Value() > s.Available() {
+		return nil, errs.NewDomainError(
+			"INSUFFICIENT_STOCK",
+			fmt.Sprintf("引当可能な数量(%d個)が不足しています", s.Available()),
+		)
+	}
+	reservation, err := newReservation(s.productId, quantity, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	s.reserved += quantity.Value()
+	return reservation, nil
+}
+
+// Release は引当をキャンセルし、引き当てていた数量を引当可能に戻します。
+func (s *Stock) Release(reservation *Reservation) error {
+	return s.settle(reservation, RESERVATION_RELEASED)
+}
+
+// Expire は有効期限切れの引当を失効させ、引き当てていた数量を引当可能に戻します。
+func (s *Stock) Expire(reservation *Reservation) error {
+	return s.settle(reservation, RESERVATION_EXPIRED)
+}
+
+// Commit は引当を確定し、引き当てていた数量を在庫数から差し引きます。
+// 有効期限を過ぎた引当は確定できず、RESERVATION_EXPIREDエラーを返します。
+func (s *Stock) Commit(reservation *Reservation, now time.Time) error {
+	if reservation.IsExpired(now) {
+		return errs.NewDomainError(
+			"RESERVATION_EXPIRED",
+			fmt.Sprintf("引当ID: %s は有効期限が切れています", reservation.Id().Value()),
+		)
+	}
+	if err := s.settle(reservation, RESERVATION_COMMITTED); err != nil {
+		return err
+	}
+	s.onHand -= reservation.Quantity().Value()
+	return nil
+}
+
+// settle は引当中の引当を指定した状態に遷移させ、引当済みの数量から差し引きます。
+func (s *Stock) settle(reservation *Reservation, status ReservationStatus) error {
+	if !s.productId.Equals(reservation.ProductId()) {
+		return errs.NewDomainError("INVALID_ARGUMENT", "別の商品の引当は操作できません")
+	}
+	if err := reservation.transition(status); err != nil {
+		return err
+	}
+	s.reserved -= reservation.Quantity().Value()
+	return nil
+}
+
+// NewStock は在庫数0の在庫集約を生成します。
+func NewStock(productId *products.ProductId) *Stock {
+	return &Stock{productId: productId}
+}
+
+// BuildStock は永続化された値から在庫集約を再構築します。
+func BuildStock(productId *products.ProductId, onHand uint32, reserved uint32) (*Stock, error) {
+	if reserved > onHand {
+		return nil, errs.NewDomainError(
+			"INVALID_ARGUMENT",
+			fmt.Sprintf("引当済みの数量(%d個)が在庫数(%d個)を超えています", reserved, onHand),
+		)
+	}
+	return &Stock{productId: productId, onHand: onHand, reserved: reserved}, nil
+}
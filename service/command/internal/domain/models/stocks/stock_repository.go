@@ -0,0 +1,118 @@
+package stocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// StockRepository は在庫集約と在庫引当の永続化を担うリポジトリインターフェースです。
+// 同時実行時の売り越しを防ぐため、在庫の変更は必ずLockByProductIdで排他ロックを取得してから行います。
+//
+//go:generate go tool mockgen -source=$GOFILE -destination=../../../mock/repository/stock_repository_mock.go -package=mock_repository
+type StockRepository interface {
+	// LockByProductId は指定された商品の在庫を排他ロックして取得します。
+	// 在庫が未登録の場合は在庫数0で登録してからロックします。
+	// ロックはトランザクションの終了まで保持されます。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - productId: 商品ID
+	//
+	// Returns:
+	//   - *Stock: 取得した在庫集約
+	//   - error: 商品が存在しない場合はCRUDError (コード: NOT_FOUND)、
+	//     データベースエラーが発生した場合はそのエラー
+	LockByProductId(ctx context.Context, tx *sql.Tx, productId *products.ProductId) (*Stock, error)
+
+	// Update は在庫数と引当済みの数量を更新します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - stock: 更新する在庫集約
+	//
+	// Returns:
+	//   - error: データベースエラーが発生した場合
+	Update(ctx context.Context, tx *sql.Tx, stock *Stock) error
+
+	// CreateReservation は新しい在庫引当を作成します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - reservation: 作成する在庫引当
+	//
+	// Returns:
+	//   - error: データベースエラーが発生した場合
+	CreateReservation(ctx context.Context, tx *sql.Tx, reservation *Reservation) error
+
+	// FindReservationById は指定されたIDの在庫引当を取得します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - id: 引当ID
+	//
+	// Returns:
+	//   - *Reservation: 取得した在庫引当
+	//   - error: 引当が存在しない場合はCRUDError (コード: NOT_FOUND)、
+	//     データベースエラーが発生した場合はそのエラー
+	FindReservationById(ctx context.Context, tx *sql.Tx, id *ReservationId) (*Reservation, error)
+
+	// LockReservationById は指定されたIDの在庫引当を排他ロックして取得します。
+	// デッドロックを避けるため、対象商品の在庫をLockByProductIdでロックした後に呼び出します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - id: 引当ID
+	//
+	// Returns:
+	//   - *Reservation: 取得した在庫引当
+	//   - error: 引当が存在しない場合はCRUDError (コード: NOT_FOUND)、
+	//     データベースエラーが発生した場合はそのエラー
+	LockReservationById(ctx context.Context, tx *sql.Tx, id *ReservationId) (*Reservation, error)
+
+	// FindExpiredReservations は指定された商品の有効期限切れの引当中の在庫引当を排他ロックして取得します。
+	// LockReservationByIdと同様に、対象商品の在庫をロックした後に呼び出します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - productId: 商品ID
+	//   - now: 基準時刻
+	//
+	// Returns:
+	//   - []*Reservation: 有効期限切れの在庫引当
+	//   - error: データベースエラーが発生した場合
+	FindExpiredReservations(ctx context.Context, tx *sql.Tx, productId *products.ProductId, now time.Time) ([]*Reservation, error)
+
+	// FindProductIdsWithExpiredReservations は有効期限切れの引当中の在庫引当を持つ商品IDを取得します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - now: 基準時刻
+	//   - limit: 最大件数
+	//
+	// Returns:
+	//   - []*products.ProductId: 商品ID
+	//   - error: データベースエラーが発生した場合
+	FindProductIdsWithExpiredReservations(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]*products.ProductId, error)
+
+	// UpdateReservationStatus は在庫引当の状態を更新します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - reservation: 更新する在庫引当
+	//
+	// Returns:
+	//   - error: 引当が存在しない場合はCRUDError (コード: NOT_FOUND)、
+	//     データベースエラーが発生した場合はそのエラー
+	UpdateReservationStatus(ctx context.Context, tx *sql.Tx, reservation *Reservation) error
+}
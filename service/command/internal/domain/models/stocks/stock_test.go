@@ -0,0 +1,250 @@
+package stocks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestStocksPackage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "domain/models/stocks packageのテスト")
+}
+
+// expectDomainError はエラーが指定したコードのDomainErrorであることを検証します。
+func expectDomainError(err error, code string) {
+	Expect(err).To(HaveOccurred())
+	domainErr, ok := err.(*errs.DomainError)
+	Expect(ok).To(BeTrue())
+	Expect(domainErr.Code).To(Equal(code))
+}
+
+// mustQuantity はテスト用の引当数量を生成します。
+func mustQuantity(value uint32) *Quantity {
+	quantity, err := NewQuantity(value)
+	Expect(err).NotTo(HaveOccurred())
+	return quantity
+}
+
+var _ = Describe("Stock集約を構成する値オブジェクト", Label("Stockの値オブジェクト"), func() {
+	DescribeTable("引当数量のバリデーション",
+		func(value uint32, expectError bool) {
+			quantity, err := NewQuantity(value)
+			if expectError {
+				expectDomainError(err, "INVALID_ARGUMENT")
+				Expect(quantity).To(BeNil())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(quantity.Value()).To(Equal(value))
+			}
+		},
+		Entry("0個の場合、エラーになること", uint32(0), true),
+		Entry("1個の場合、エラーにならないこと", uint32(1), false),
+		Entry("上限の場合、エラーにならないこと", MAX_QUANTITY, false),
+		Entry("上限を超える場合、エラーになること", MAX_QUANTITY+1, true),
+	)
+
+	DescribeTable("引当IDのバリデーション",
+		func(id string, expectError bool) {
+			reservationId, err := NewReservationId(id)
+			if expectError {
+				expectDomainError(err, "INVALID_ARGUMENT")
+				Expect(reservationId).To(BeNil())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reservationId.Value()).To(Equal(id))
+			}
+		},
+		Entry("空文字列の場合、エラーになること", "", true),
+		Entry("UUID形式ではない場合、エラーになること", strings.Repeat("a", 36), true),
+		Entry("正常なUUID形式の場合、エラーにならないこと", uuid.New().String(), false),
+	)
+
+	DescribeTable("引当状態の変換",
+		func(value string, expectError bool) {
+			status, err := ParseReservationStatus(value)
+			if expectError {
+				expectDomainError(err, "INVALID_ARGUMENT")
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(status)).To(Equal(value))
+			}
+		},
+		Entry("RESERVED", "RESERVED", false),
+		Entry("RELEASED", "RELEASED", false),
+		Entry("COMMITTED", "COMMITTED", false),
+		Entry("EXPIRED", "EXPIRED", false),
+		Entry("不明な状態の場合、エラーになること", "UNKNOWN", true),
+	)
+})
+
+var _ = Describe("ReservationPolicy", Label("引当の有効期限"), func() {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	It("既定値が上限を超える場合は生成できないこと", func() {
+		policy, err := NewReservationPolicy(2*time.Hour, time.Hour)
+		expectDomainError(err, "INVALID_ARGUMENT")
+		Expect(policy).To(BeNil())
+	})
+
+	DescribeTable("有効期限の計算",
+		func(ttl time.Duration, expectError bool, expected time.Time) {
+			policy, err := NewReservationPolicy(15*time.Minute, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+
+			expiresAt, err := policy.ExpiresAt(now, ttl)
+			if expectError {
+				expectDomainError(err, "INVALID_ARGUMENT")
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(expiresAt).To(Equal(expected))
+			}
+		},
+		Entry("0の場合は既定値を使用すること", time.Duration(0), false, now.Add(15*time.Minute)),
+		Entry("指定した有効期限を使用すること", 30*time.Minute, false, now.Add(30*time.Minute)),
+		Entry("上限と等しい場合はエラーにならないこと", time.Hour, false, now.Add(time.Hour)),
+		Entry("上限を超える場合はエラーになること", time.Hour+time.Second, true, time.Time{}),
+		Entry("負の値の場合はエラーになること", -time.Second, true, time.Time{}),
+	)
+})
+
+var _ = Describe("Stock集約", Label("Stock集約"), func() {
+	var (
+		productId *products.ProductId
+		now       time.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+		productId, err = products.NewProductId(uuid.New().String())
+		Expect(err).NotTo(HaveOccurred())
+		now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	// newStock はテスト用の在庫集約を生成します。
+	newStock := func(onHand uint32, reserved uint32) *Stock {
+		stock, err := BuildStock(productId, onHand, reserved)
+		Expect(err).NotTo(HaveOccurred())
+		return stock
+	}
+
+	It("引当済みの数量が在庫数を超える値からは再構築できないこと", func() {
+		stock, err := BuildStock(productId, 1, 2)
+		expectDomainError(err, "INVALID_ARGUMENT")
+		Expect(stock).To(BeNil())
+	})
+
+	It("新しい在庫集約の在庫数は0であること", func() {
+		stock := NewStock(productId)
+		Expect(stock.OnHand()).To(BeZero())
+		Expect(stock.Reserved()).To(BeZero())
+		Expect(stock.Available()).To(BeZero())
+	})
+
+	DescribeTable("在庫数の増減",
+		func(onHand uint32, reserved uint32, delta int32, expectedCode string, expectedOnHand uint32) {
+			stock := newStock(onHand, reserved)
+			err := stock.Adjust(delta)
+			if expectedCode != "" {
+				expectDomainError(err, expectedCode)
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(stock.OnHand()).To(Equal(expectedOnHand))
+		},
+		Entry("入荷で在庫数が増えること", uint32(10), uint32(0), int32(5), "", uint32(15)),
+		Entry("棚卸で在庫数が減ること", uint32(10), uint32(3), int32(-7), "", uint32(3)),
+		Entry("在庫数を0未満にできないこと", uint32(10), uint32(0), int32(-11), "INVALID_ARGUMENT", uint32(10)),
+		Entry("引当済みの数量を下回れないこと", uint32(10), uint32(5), int32(-6), "INSUFFICIENT_STOCK", uint32(10)),
+		Entry("上限を超えられないこと", MAX_QUANTITY, uint32(0), int32(1), "INVALID_ARGUMENT", MAX_QUANTITY),
+	)
+
+	Describe("引当", func() {
+		It("引当可能な数量の範囲で引き当てられること", func() {
+			stock := newStock(10, 2)
+			expiresAt := now.Add(15 * time.Minute)
+
+			reservation, err := stock.Reserve(mustQuantity(8), expiresAt)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reservation.ProductId().Equals(productId)).To(BeTrue())
+			Expect(reservation.Quantity().Value()).To(Equal(uint32(8)))
+			Expect(reservation.Status()).To(Equal(RESERVATION_RESERVED))
+			Expect(reservation.ExpiresAt()).To(Equal(expiresAt))
+			Expect(stock.Reserved()).To(Equal(uint32(10)))
+			Expect(stock.Available()).To(BeZero())
+		})
+
+		It("引当可能な数量を超えて引き当てられないこと", func() {
+			stock := newStock(10, 2)
+
+			reservation, err := stock.Reserve(mustQuantity(9), now)
+
+			expectDomainError(err, "INSUFFICIENT_STOCK")
+			Expect(reservation).To(BeNil())
+			Expect(stock.Reserved()).To(Equal(uint32(2)))
+		})
+	})
+
+	Describe("引当の解放・失効・確定", func() {
+		var (
+			stock       *Stock
+			reservation *Reservation
+		)
+
+		BeforeEach(func() {
+			var err error
+			stock = newStock(10, 0)
+			reservation, err = stock.Reserve(mustQuantity(4), now.Add(time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("解放すると引当可能な数量に戻ること", func() {
+			Expect(stock.Release(reservation)).To(Succeed())
+			Expect(reservation.Status()).To(Equal(RESERVATION_RELEASED))
+			Expect(stock.OnHand()).To(Equal(uint32(10)))
+			Expect(stock.Available()).To(Equal(uint32(10)))
+		})
+
+		It("失効すると引当可能な数量に戻ること", func() {
+			Expect(stock.Expire(reservation)).To(Succeed())
+			Expect(reservation.Status()).To(Equal(RESERVATION_EXPIRED))
+			Expect(stock.Available()).To(Equal(uint32(10)))
+		})
+
+		It("確定すると在庫数から差し引かれること", func() {
+			Expect(stock.Commit(reservation, now)).To(Succeed())
+			Expect(reservation.Status()).To(Equal(RESERVATION_COMMITTED))
+			Expect(stock.OnHand()).To(Equal(uint32(6)))
+			Expect(stock.Reserved()).To(BeZero())
+		})
+
+		It("有効期限を過ぎた引当は確定できないこと", func() {
+			Expect(reservation.IsExpired(now.Add(time.Minute))).To(BeTrue())
+			expectDomainError(stock.Commit(reservation, now.Add(time.Minute)), "RESERVATION_EXPIRED")
+			Expect(reservation.Status()).To(Equal(RESERVATION_RESERVED))
+			Expect(stock.Reserved()).To(Equal(uint32(4)))
+		})
+
+		It("引当中ではない引当は二重に処理できないこと", func() {
+			Expect(stock.Commit(reservation, now)).To(Succeed())
+			expectDomainError(stock.Release(reservation), "RESERVATION_NOT_ACTIVE")
+			Expect(stock.OnHand()).To(Equal(uint32(6)))
+			Expect(stock.Reserved()).To(BeZero())
+		})
+
+		It("別の商品の引当は操作できないこと", func() {
+			otherId, err := products.NewProductId(uuid.New().String())
+			Expect(err).NotTo(HaveOccurred())
+			other := NewStock(otherId)
+			expectDomainError(other.Release(reservation), "INVALID_ARGUMENT")
+			Expect(reservation.Status()).To(Equal(RESERVATION_RESERVED))
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package tags
+
+import (
+	"github.com/google/uuid"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// Tag はタグエンティティを表すドメインオブジェクトです。
+// タグは商品に自由に付与できるラベルで、1つの商品に複数のタグを付与できます。
+// タグは商品に付与されるときに作成され、名前の正規化キーで同一性を判定します。
+type Tag struct {
+	id   *TagId   // タグID
+	name *TagName // タグ名
+}
+
+// Id はタグIDを返します。
+func (t *Tag) Id() *TagId {
+	return t.id
+}
+
+// Name はタグ名を返します。
+func (t *Tag) Name() *TagName {
+	return t.name
+}
+
+// Equals は2つのタグエンティティの同一性を検証します。
+func (t *Tag) Equals(other *Tag) (bool, error) {
+	if other == nil {
+		return false, errs.NewDomainError("INVALID_ARGUMENT", "比較対象のTagがnilです")
+	}
+	return t.id.Equals(other.Id()), nil
+}
+
+// NewTag は新しいタグエンティティを生成します。
+func NewTag(name *TagName) (*Tag, error) {
+	uid, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainErrorWithCause("INTERNAL", "タグIDの生成に失敗しました", err)
+	}
+
+	id, err := NewTagId(uid.String())
+	if err != nil {
+		return nil, errs.NewDomainErrorWithCause("INTERNAL", "タグIDの生成に失敗しました", err)
+	}
+
+	return &Tag{id: id, name: name}, nil
+}
+
+// BuildTag は既存のタグIDを使用してタグエンティティを再構築します。
+func BuildTag(id *TagId, name *TagName) (*Tag, error) {
+	return &Tag{id: id, name: name}, nil
+}
@@ -0,0 +1,55 @@
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+)
+
+// TagId はタグIDを表す値オブジェクトです。
+type TagId struct {
+	value string
+}
+
+// Value はタグIDの値を返します。
+func (t *TagId) Value() string {
+	return t.value
+}
+
+// Equals は2つのタグIDの同一性を検証します。
+func (t *TagId) Equals(other *TagId) bool {
+	if other == nil {
+		return false
+	}
+	if t == other { // アドレスが同じ?
+		return true
+	}
+	// 値が同じ
+	return t.value == other.Value()
+}
+
+var getUUIDRegexp = sync.OnceValue(func() *regexp.Regexp {
+	const REGEXP string = "^([0-9a-f]{8})-([0-9a-f]{4})-([0-9a-f]{4})-([0-9a-f]{4})-([0-9a-f]{12})$"
+	return regexp.MustCompile(REGEXP)
+})
+
+// NewTagId はタグIDを生成します。
+func NewTagId(value string) (*TagId, error) {
+	// フィールドの長さ
+	const LENGTH int = 36
+
+	// 引数の文字数チェック
+	if utf8.RuneCountInString(value) != LENGTH {
+		return nil, errs.NewDomainError(
+			"INVALID_ARGUMENT", fmt.Sprintf("タグIDの長さは%d文字である必要があります", LENGTH),
+		)
+	}
+	// UUIDの形式チェック
+	if !getUUIDRegexp().MatchString(value) {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "タグIDはUUIDの形式である必要があります")
+	}
+	return &TagId{value: value}, nil
+}
@@ -0,0 +1,75 @@
+package tags
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+)
+
+// TagName はタグ名を表す値オブジェクトです。
+type TagName struct {
+	value string // タグ名
+	key   string // 重複判定に使用する正規化キー
+}
+
+// Value はタグ名の値を返します。
+func (t *TagName) Value() string {
+	return t.value
+}
+
+// Key は重複判定に使用する正規化キーを返します。
+// 全角・半角や空白の違いのみのタグ名は同じキーになり、同じタグとして扱われます。
+func (t *TagName) Key() string {
+	return t.key
+}
+
+// NewTagName はタグ名を生成します。
+func NewTagName(value string) (*TagName, error) {
+	const MIN_LENGTH int = 1  // 最小文字数
+	const MAX_LENGTH int = 30 // 最大文字数
+
+	if count := utf8.RuneCountInString(value); count < MIN_LENGTH || count > MAX_LENGTH {
+		return nil, errs.NewDomainError(
+			"INVALID_ARGUMENT",
+			fmt.Sprintf("タグ名は%d文字以上%d文字以下で入力してください。Got: len(%s) = %d", MIN_LENGTH, MAX_LENGTH, value, count),
+		)
+	}
+
+	key := names.Key(value)
+	if key == "" {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "タグ名は空白以外の文字を含めてください")
+	}
+
+	return &TagName{value: value, key: key}, nil
+}
+
+// NewTagNames は重複を除いたタグ名の一覧を生成します。
+// 正規化キーが同じタグ名は最初に指定されたものを残します。
+//
+// Parameters:
+//   - values: タグ名の文字列（1件以上）
+//
+// Returns:
+//   - []*TagName: 重複を除いたタグ名（指定された順序を保持）
+//   - error: 空の場合や不正なタグ名が含まれる場合はDomainError (コード: INVALID_ARGUMENT)
+func NewTagNames(values []string) ([]*TagName, error) {
+	if len(values) == 0 {
+		return nil, errs.NewDomainError("INVALID_ARGUMENT", "タグ名を1つ以上指定してください")
+	}
+	seen := make(map[string]struct{}, len(values))
+	tagNames := make([]*TagName, 0, len(values))
+	for _, value := range values {
+		name, err := NewTagName(value)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[name.Key()]; ok {
+			continue
+		}
+		seen[name.Key()] = struct{}{}
+		tagNames = append(tagNames, name)
+	}
+	return tagNames, nil
+}
@@ -0,0 +1,67 @@
+package tags
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// TagRepository はタグエンティティと商品へのタグ付けの永続化を担うリポジトリインターフェースです。
+//
+//go:generate go tool mockgen -source=$GOFILE -destination=../../../mock/repository/tag_repository_mock.go -package=mock_repository
+type TagRepository interface {
+	// CreateIfNotExists は正規化キーが同じタグが存在しない場合のみタグを作成します。
+	// 同時に同じタグを作成しても一意制約違反にはなりません。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - tags: 作成するタグ
+	//
+	// Returns:
+	//   - error: データベースエラーが発生した場合
+	CreateIfNotExists(ctx context.Context, tx *sql.Tx, tags []*Tag) error
+
+	// LockByNames は指定されたタグ名のタグを共有ロックして取得します。
+	// 名前は全角・半角や空白の違いを無視した正規化キーで比較し、存在しないタグ名は無視します。
+	// ロック読み取りのため、他のトランザクションがコミットしたタグも取得できます。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - names: 取得するタグ名
+	//
+	// Returns:
+	//   - []*Tag: 取得したタグ
+	//   - error: データベースエラーが発生した場合
+	LockByNames(ctx context.Context, tx *sql.Tx, names []*TagName) ([]*Tag, error)
+
+	// AttachToProducts は指定されたすべての商品に指定されたすべてのタグを付与します。
+	// 既に付与されている組み合わせは無視します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - productIds: タグを付与する商品のID
+	//   - tagIds: 付与するタグのID
+	//
+	// Returns:
+	//   - int64: 新たに付与した商品とタグの組み合わせの数
+	//   - error: データベースエラーが発生した場合
+	AttachToProducts(ctx context.Context, tx *sql.Tx, productIds []*products.ProductId, tagIds []*TagId) (int64, error)
+
+	// DetachFromProducts は指定されたすべての商品から指定されたすべてのタグを外します。
+	// 付与されていない組み合わせは無視します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - tx: トランザクション
+	//   - productIds: タグを外す商品のID
+	//   - tagIds: 外すタグのID
+	//
+	// Returns:
+	//   - int64: 外した商品とタグの組み合わせの数
+	//   - error: データベースエラーが発生した場合
+	DetachFromProducts(ctx context.Context, tx *sql.Tx, productIds []*products.ProductId, tagIds []*TagId) (int64, error)
+}
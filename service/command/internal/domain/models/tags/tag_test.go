@@ -0,0 +1,132 @@
+package tags
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEntityPackage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "domain/models/tags packageのテスト")
+}
+
+var _ = Describe("Tagエンティティを構成する値オブジェクト", Label("TagId構造体の生成"), func() {
+	DescribeTable("TagIdのバリデーション",
+		func(input string, expectError bool, expectedErrorMsg string) {
+			tagId, err := NewTagId(input)
+			if expectError {
+				Expect(err).To(HaveOccurred())
+				domainErr, ok := err.(*errs.DomainError)
+				Expect(ok).To(BeTrue())
+				Expect(domainErr).To(Equal(errs.NewDomainError("INVALID_ARGUMENT", expectedErrorMsg)))
+				Expect(tagId).To(BeNil())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tagId.Value()).To(Equal(input))
+			}
+		},
+		Entry("空文字列の場合、エラーになること", "", true, "タグIDの長さは36文字である必要があります"),
+		Entry("UUID形式ではない場合、エラーになること", strings.Repeat("a", 36), true, "タグIDはUUIDの形式である必要があります"),
+		Entry("正常なUUID形式の場合、エラーにならないこと", uuid.New().String(), false, ""),
+	)
+
+	DescribeTable("タグ名のバリデーション",
+		func(name string, expectError bool) {
+			tagName, err := NewTagName(name)
+			if expectError {
+				Expect(err).To(HaveOccurred())
+				domainErr, ok := err.(*errs.DomainError)
+				Expect(ok).To(BeTrue())
+				Expect(domainErr.Code).To(Equal("INVALID_ARGUMENT"))
+				Expect(tagName).To(BeNil())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tagName.Value()).To(Equal(name))
+			}
+		},
+		Entry("空文字列の場合、エラーになること", "", true),
+		Entry("31文字以上の場合、エラーになること", strings.Repeat("a", 31), true),
+		Entry("空白のみの場合、エラーになること", " 　 ", true),
+		Entry("30文字の場合、エラーにならないこと", strings.Repeat("a", 30), false),
+	)
+
+	It("全角・半角や空白の違いのみのタグ名は同じ正規化キーになること", func() {
+		a, err := NewTagName("ﾜｲﾔﾚｽ　対応")
+		Expect(err).NotTo(HaveOccurred())
+		b, err := NewTagName("ワイヤレス 対応")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(a.Value()).NotTo(Equal(b.Value()), "表示用の値は入力のまま保持すること")
+		Expect(a.Key()).To(Equal(b.Key()))
+	})
+
+	Describe("NewTagNames", func() {
+		It("正規化キーが同じタグ名は最初に指定されたものだけを残すこと", func() {
+			tagNames, err := NewTagNames([]string{"ワイヤレス", "ゲーミング", "ﾜｲﾔﾚｽ"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tagNames).To(HaveLen(2))
+			Expect(tagNames[0].Value()).To(Equal("ワイヤレス"))
+			Expect(tagNames[1].Value()).To(Equal("ゲーミング"))
+		})
+
+		It("空の場合、エラーになること", func() {
+			tagNames, err := NewTagNames(nil)
+			Expect(err).To(HaveOccurred())
+			Expect(tagNames).To(BeNil())
+		})
+
+		It("不正なタグ名が含まれる場合、エラーになること", func() {
+			tagNames, err := NewTagNames([]string{"ワイヤレス", ""})
+			Expect(err).To(HaveOccurred())
+			Expect(tagNames).To(BeNil())
+		})
+	})
+})
+
+var _ = Describe("Tagエンティティオブジェクト", Label("Tagエンティティ"), func() {
+	var name *TagName
+
+	BeforeEach(func() {
+		var err error
+		name, err = NewTagName("ワイヤレス")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("NewTagは新しいIDでタグを生成すること", func() {
+		tag, err := NewTag(name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tag.Id()).NotTo(BeNil())
+		Expect(tag.Name()).To(Equal(name))
+
+		other, err := NewTag(name)
+		Expect(err).NotTo(HaveOccurred())
+		equal, err := tag.Equals(other)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(equal).To(BeFalse(), "名前が同じでもIDが異なるタグは別のタグであること")
+	})
+
+	It("BuildTagは指定されたIDでタグを再構築すること", func() {
+		id, err := NewTagId(uuid.New().String())
+		Expect(err).NotTo(HaveOccurred())
+		tag, err := BuildTag(id, name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tag.Id()).To(Equal(id))
+
+		rebuilt, err := BuildTag(id, name)
+		Expect(err).NotTo(HaveOccurred())
+		equal, err := tag.Equals(rebuilt)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(equal).To(BeTrue())
+	})
+
+	It("nilと比較した場合、エラーになること", func() {
+		tag, err := NewTag(name)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = tag.Equals(nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
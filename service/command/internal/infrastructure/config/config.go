@@ -37,15 +37,17 @@ func NewViper(configPath string, configName string) *viper.Viper {
 
 	// 特殊なバインディング
 	bindings := map[string]string{
-		"mysql.dbname":             "DB_DBNAME",
-		"mysql.host":               "DB_HOST",
-		"mysql.port":               "DB_PORT",
-		"mysql.user":               "DB_USER",
-		"mysql.pass":               "DB_PASS",
-		"mysql.max_idle_conns":     "DB_MAX_IDLE_CONNS",
-		"mysql.max_open_conns":     "DB_MAX_OPEN_CONNS",
-		"mysql.conn_max_lifetime":  "DB_CONN_MAX_LIFETIME",
-		"mysql.conn_max_idle_time": "DB_CONN_MAX_IDLE_TIME",
+		"db.driver":             "DB_DRIVER",
+		"db.dbname":             "DB_DBNAME",
+		"db.host":               "DB_HOST",
+		"db.port":               "DB_PORT",
+		"db.user":               "DB_USER",
+		"db.pass":               "DB_PASS",
+		"db.sslmode":            "DB_SSLMODE",
+		"db.max_idle_conns":     "DB_MAX_IDLE_CONNS",
+		"db.max_open_conns":     "DB_MAX_OPEN_CONNS",
+		"db.conn_max_lifetime":  "DB_CONN_MAX_LIFETIME",
+		"db.conn_max_idle_time": "DB_CONN_MAX_IDLE_TIME",
 	}
 	for key, env := range bindings {
 		if bindErr := v.BindEnv(key, env); bindErr != nil {
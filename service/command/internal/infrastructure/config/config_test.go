@@ -18,11 +18,13 @@ func TestConfig(t *testing.T) {
 var testEnvVars = []string{
 	"LOG_LEVEL",
 	"LOG_FORMAT",
+	"DB_DRIVER",
 	"DB_DBNAME",
 	"DB_HOST",
 	"DB_PORT",
 	"DB_USER",
 	"DB_PASS",
+	"DB_SSLMODE",
 	"DB_MAX_IDLE_CONNS",
 	"DB_MAX_OPEN_CONNS",
 	"DB_CONN_MAX_LIFETIME",
@@ -83,12 +85,14 @@ const defaultTestConfigContent = `[log]
 level = "info"
 format = "text"
 
-[mysql]
+[db]
+driver = "mysql"
 dbname = "test_db"
 host = "test_host"
 port = 3307
 user = "test_user"
 pass = "test_pass"
+sslmode = "disable"
 max_idle_conns = 5
 max_open_conns = 50
 conn_max_lifetime = "15m"
@@ -121,16 +125,18 @@ var _ = Describe("NewViper関数", func() {
 			Expect(v.GetString("log.level")).To(Equal("info"))
 			Expect(v.GetString("log.format")).To(Equal("text"))
 
-			// mysql設定
-			Expect(v.GetString("mysql.dbname")).To(Equal("test_db"))
-			Expect(v.GetString("mysql.host")).To(Equal("test_host"))
-			Expect(v.GetInt("mysql.port")).To(Equal(3307))
-			Expect(v.GetString("mysql.user")).To(Equal("test_user"))
-			Expect(v.GetString("mysql.pass")).To(Equal("test_pass"))
-			Expect(v.GetInt("mysql.max_idle_conns")).To(Equal(5))
-			Expect(v.GetInt("mysql.max_open_conns")).To(Equal(50))
-			Expect(v.GetDuration("mysql.conn_max_lifetime")).To(Equal(15 * time.Minute))
-			Expect(v.GetDuration("mysql.conn_max_idle_time")).To(Equal(300 * time.Second))
+			// db設定
+			Expect(v.GetString("db.driver")).To(Equal("mysql"))
+			Expect(v.GetString("db.dbname")).To(Equal("test_db"))
+			Expect(v.GetString("db.host")).To(Equal("test_host"))
+			Expect(v.GetInt("db.port")).To(Equal(3307))
+			Expect(v.GetString("db.user")).To(Equal("test_user"))
+			Expect(v.GetString("db.pass")).To(Equal("test_pass"))
+			Expect(v.GetString("db.sslmode")).To(Equal("disable"))
+			Expect(v.GetInt("db.max_idle_conns")).To(Equal(5))
+			Expect(v.GetInt("db.max_open_conns")).To(Equal(50))
+			Expect(v.GetDuration("db.conn_max_lifetime")).To(Equal(15 * time.Minute))
+			Expect(v.GetDuration("db.conn_max_idle_time")).To(Equal(300 * time.Second))
 		})
 	})
 
@@ -146,11 +152,11 @@ var _ = Describe("NewViper関数", func() {
 		It("環境変数がTOMLファイルの設定を上書きする", func() {
 			v := NewViper(tempDir, "test_config")
 
-			Expect(v.GetString("mysql.dbname")).To(Equal("env_db"))
-			Expect(v.GetString("mysql.host")).To(Equal("env_host"))
-			Expect(v.GetInt("mysql.port")).To(Equal(3308))
-			Expect(v.GetString("mysql.user")).To(Equal("env_user"))
-			Expect(v.GetString("mysql.pass")).To(Equal("env_pass"))
+			Expect(v.GetString("db.dbname")).To(Equal("env_db"))
+			Expect(v.GetString("db.host")).To(Equal("env_host"))
+			Expect(v.GetInt("db.port")).To(Equal(3308))
+			Expect(v.GetString("db.user")).To(Equal("env_user"))
+			Expect(v.GetString("db.pass")).To(Equal("env_pass"))
 		})
 	})
 
@@ -164,11 +170,11 @@ var _ = Describe("NewViper関数", func() {
 		It("環境変数とTOMLファイルの値を組み合わせる", func() {
 			v := NewViper(tempDir, "test_config")
 
-			Expect(v.GetString("mysql.dbname")).To(Equal("partial_db"))
-			Expect(v.GetString("mysql.host")).To(Equal("test_host")) // TOML
-			Expect(v.GetInt("mysql.port")).To(Equal(3309))
-			Expect(v.GetString("mysql.user")).To(Equal("test_user")) // TOML
-			Expect(v.GetString("mysql.pass")).To(Equal("test_pass")) // TOML
+			Expect(v.GetString("db.dbname")).To(Equal("partial_db"))
+			Expect(v.GetString("db.host")).To(Equal("test_host")) // TOML
+			Expect(v.GetInt("db.port")).To(Equal(3309))
+			Expect(v.GetString("db.user")).To(Equal("test_user")) // TOML
+			Expect(v.GetString("db.pass")).To(Equal("test_pass")) // TOML
 		})
 	})
 
@@ -187,15 +193,19 @@ var _ = Describe("NewViper関数", func() {
 			Expect(os.Setenv("DB_MAX_OPEN_CONNS", "200")).To(Succeed())
 			Expect(os.Setenv("DB_CONN_MAX_LIFETIME", "60m")).To(Succeed())
 			Expect(os.Setenv("DB_CONN_MAX_IDLE_TIME", "10m")).To(Succeed())
+			Expect(os.Setenv("DB_DRIVER", "postgres")).To(Succeed())
+			Expect(os.Setenv("DB_SSLMODE", "require")).To(Succeed())
 		})
 
 		It("DB_プレフィックスの環境変数が正しくバインドされる", func() {
 			v := NewViper(tempDir, "test_config")
 
-			Expect(v.GetInt("mysql.max_idle_conns")).To(Equal(20))
-			Expect(v.GetInt("mysql.max_open_conns")).To(Equal(200))
-			Expect(v.GetDuration("mysql.conn_max_lifetime")).To(Equal(60 * time.Minute))
-			Expect(v.GetDuration("mysql.conn_max_idle_time")).To(Equal(10 * time.Minute))
+			Expect(v.GetInt("db.max_idle_conns")).To(Equal(20))
+			Expect(v.GetInt("db.max_open_conns")).To(Equal(200))
+			Expect(v.GetDuration("db.conn_max_lifetime")).To(Equal(60 * time.Minute))
+			Expect(v.GetDuration("db.conn_max_idle_time")).To(Equal(10 * time.Minute))
+			Expect(v.GetString("db.driver")).To(Equal("postgres"))
+			Expect(v.GetString("db.sslmode")).To(Equal("require"))
 		})
 	})
 
@@ -228,3 +238,148 @@ var _ = Describe("NewViper関数", func() {
 		})
 	})
 })
+
+var _ = Describe("NewNormalizationConfig関数", func() {
+	var tempDir string
+
+	AfterEach(func() {
+		cleanupTestConfig(tempDir)
+	})
+
+	It("かな変換の設定を読み込む", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent + "\n[normalization]\nfold_kana = true\n")
+		cfg, err := NewNormalizationConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.FoldKana).To(BeTrue())
+	})
+
+	It("設定がない場合はエラーを返す", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent)
+		cfg, err := NewNormalizationConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).To(HaveOccurred())
+		Expect(cfg).To(BeNil())
+	})
+})
+
+var _ = Describe("NewInventoryConfig関数", func() {
+	var tempDir string
+
+	AfterEach(func() {
+		cleanupTestConfig(tempDir)
+	})
+
+	It("在庫引当の設定を読み込む", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent + `
+[inventory]
+reservation_ttl = "15m"
+max_reservation_ttl = "24h"
+sweep_interval = "1m"
+sweep_batch_size = 100
+`)
+		cfg, err := NewInventoryConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.ReservationTTL).To(Equal(15 * time.Minute))
+		Expect(cfg.MaxReservationTTL).To(Equal(24 * time.Hour))
+		Expect(cfg.SweepInterval).To(Equal(time.Minute))
+		Expect(cfg.SweepBatchSize).To(Equal(100))
+	})
+
+	It("既定の有効期限が上限を超える場合はエラーを返す", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent + `
+[inventory]
+reservation_ttl = "48h"
+max_reservation_ttl = "24h"
+sweep_interval = "1m"
+sweep_batch_size = 100
+`)
+		cfg, err := NewInventoryConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).To(HaveOccurred())
+		Expect(cfg).To(BeNil())
+	})
+
+	It("設定がない場合はエラーを返す", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent)
+		cfg, err := NewInventoryConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).To(HaveOccurred())
+		Expect(cfg).To(BeNil())
+	})
+})
+
+var _ = Describe("NewRepositoryConfig関数", func() {
+	var tempDir string
+
+	AfterEach(func() {
+		cleanupTestConfig(tempDir)
+	})
+
+	It("リポジトリの実装の設定を読み込む", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent + "\n[repository]\nbackend = \"memory\"\nseed = true\n")
+		cfg, err := NewRepositoryConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Backend).To(Equal(REPOSITORY_BACKEND_MEMORY))
+		Expect(cfg.Seed).To(BeTrue())
+	})
+
+	It("旧名のmysqlはdbとして扱う", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent + "\n[repository]\nbackend = \"mysql\"\nseed = false\n")
+		cfg, err := NewRepositoryConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Backend).To(Equal(REPOSITORY_BACKEND_DB))
+	})
+
+	It("未対応の実装を指定した場合はエラーを返す", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent + "\n[repository]\nbackend = \"oracle\"\nseed = false\n")
+		cfg, err := NewRepositoryConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).To(HaveOccurred())
+		Expect(cfg).To(BeNil())
+	})
+
+	It("設定がない場合はエラーを返す", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent)
+		cfg, err := NewRepositoryConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).To(HaveOccurred())
+		Expect(cfg).To(BeNil())
+	})
+})
+
+var _ = Describe("NewMigrationConfig関数", func() {
+	var tempDir string
+
+	AfterEach(func() {
+		cleanupTestConfig(tempDir)
+	})
+
+	It("マイグレーションの設定を読み込む", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent + "\n[migration]\nauto = true\nlock_timeout = \"10s\"\n")
+		cfg, err := NewMigrationConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Auto).To(BeTrue())
+		Expect(cfg.LockTimeout).To(Equal(10 * time.Second))
+	})
+
+	It("ロックの待ち時間が1秒未満の場合はエラーを返す", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent + "\n[migration]\nauto = false\nlock_timeout = \"500ms\"\n")
+		cfg, err := NewMigrationConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).To(HaveOccurred())
+		Expect(cfg).To(BeNil())
+	})
+
+	It("設定がない場合はエラーを返す", func() {
+		tempDir, _ = setupTestConfig(defaultTestConfigContent)
+		cfg, err := NewMigrationConfig(NewViper(tempDir, "test_config"))
+
+		Expect(err).To(HaveOccurred())
+		Expect(cfg).To(BeNil())
+	})
+})
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/spf13/viper"
+)
+
+// InventoryConfig は在庫引当の設定を保持します。
+type InventoryConfig struct {
+	ReservationTTL    time.Duration // 引当の有効期限の既定値
+	MaxReservationTTL time.Duration // リクエストで指定できる有効期限の上限
+	SweepInterval     time.Duration // 期限切れの引当を失効させる間隔
+	SweepBatchSize    int           // 1回の失効処理で対象とする商品数の上限
+}
+
+// NewInventoryConfig はViperから設定を読み込みInventoryConfigを生成します。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *InventoryConfig: 在庫引当の設定
+//   - error: 設定の読み込みに失敗した場合、または値が不正な場合のエラー
+func NewInventoryConfig(v *viper.Viper) (*InventoryConfig, error) {
+	var configErrors []error
+	cfg := &InventoryConfig{
+		ReservationTTL:    utils.GetKey[time.Duration](v, "inventory.reservation_ttl", &configErrors),
+		MaxReservationTTL: utils.GetKey[time.Duration](v, "inventory.max_reservation_ttl", &configErrors),
+		SweepInterval:     utils.GetKey[time.Duration](v, "inventory.sweep_interval", &configErrors),
+		SweepBatchSize:    utils.GetKey[int](v, "inventory.sweep_batch_size", &configErrors),
+	}
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+
+	if cfg.ReservationTTL <= 0 || cfg.ReservationTTL > cfg.MaxReservationTTL {
+		configErrors = append(configErrors, errors.New("inventory.reservation_ttl must be positive and not exceed inventory.max_reservation_ttl"))
+	}
+	if cfg.SweepInterval <= 0 {
+		configErrors = append(configErrors, errors.New("inventory.sweep_interval must be positive"))
+	}
+	if cfg.SweepBatchSize <= 0 {
+		configErrors = append(configErrors, errors.New("inventory.sweep_batch_size must be positive"))
+	}
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+	return cfg, nil
+}
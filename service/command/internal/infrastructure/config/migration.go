@@ -0,0 +1,39 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/spf13/viper"
+)
+
+// MigrationConfig はスキーマのマイグレーションの設定を保持します。
+type MigrationConfig struct {
+	Auto        bool          // 起動時に未適用のマイグレーションを適用するかどうか
+	LockTimeout time.Duration // 他のプロセスが実行中の場合にロックの取得を待つ時間
+}
+
+// NewMigrationConfig はViperから設定を読み込みMigrationConfigを生成します。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *MigrationConfig: マイグレーションの設定
+//   - error: 設定の読み込みに失敗した場合、または値が不正な場合のエラー
+func NewMigrationConfig(v *viper.Viper) (*MigrationConfig, error) {
+	var configErrors []error
+	cfg := &MigrationConfig{
+		Auto:        utils.GetKey[bool](v, "migration.auto", &configErrors),
+		LockTimeout: utils.GetKey[time.Duration](v, "migration.lock_timeout", &configErrors),
+	}
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+
+	if cfg.LockTimeout < time.Second {
+		return nil, errors.New("migration.lock_timeout must be at least 1s")
+	}
+	return cfg, nil
+}
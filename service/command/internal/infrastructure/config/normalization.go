@@ -0,0 +1,32 @@
+package config
+
+import (
+	"errors"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/spf13/viper"
+)
+
+// NormalizationConfig は商品名・カテゴリ名の正規化設定を保持します。
+type NormalizationConfig struct {
+	FoldKana bool // ひらがなとカタカナを同一視するかどうか
+}
+
+// NewNormalizationConfig はViperから設定を読み込みNormalizationConfigを生成します。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *NormalizationConfig: 正規化設定
+//   - error: 設定の読み込みに失敗した場合のエラー
+func NewNormalizationConfig(v *viper.Viper) (*NormalizationConfig, error) {
+	var configErrors []error
+	cfg := &NormalizationConfig{
+		FoldKana: utils.GetKey[bool](v, "normalization.fold_kana", &configErrors),
+	}
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+	return cfg, nil
+}
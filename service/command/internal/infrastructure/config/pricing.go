@@ -0,0 +1,45 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/spf13/viper"
+)
+
+// PricingConfig は価格スケジュールの設定を保持します。
+type PricingConfig struct {
+	ScheduleInterval  time.Duration // 価格スケジュールを適用・終了する間隔
+	ScheduleBatchSize int           // 1回の処理で対象とする価格スケジュール数の上限
+}
+
+// NewPricingConfig はViperから設定を読み込みPricingConfigを生成します。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *PricingConfig: 価格スケジュールの設定
+//   - error: 設定の読み込みに失敗した場合、または値が不正な場合のエラー
+func NewPricingConfig(v *viper.Viper) (*PricingConfig, error) {
+	var configErrors []error
+	cfg := &PricingConfig{
+		ScheduleInterval:  utils.GetKey[time.Duration](v, "pricing.schedule_interval", &configErrors),
+		ScheduleBatchSize: utils.GetKey[int](v, "pricing.schedule_batch_size", &configErrors),
+	}
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+
+	if cfg.ScheduleInterval <= 0 {
+		configErrors = append(configErrors, errors.New("pricing.schedule_interval must be positive"))
+	}
+	if cfg.ScheduleBatchSize <= 0 {
+		configErrors = append(configErrors, errors.New("pricing.schedule_batch_size must be positive"))
+	}
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+	return cfg, nil
+}
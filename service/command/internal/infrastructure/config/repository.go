@@ -0,0 +1,51 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/spf13/viper"
+)
+
+const (
+	REPOSITORY_BACKEND_DB     = "db"     // [db]で設定したデータベース（MySQL / PostgreSQL）にSQLBoilerで永続化する
+	REPOSITORY_BACKEND_MEMORY = "memory" // プロセスのメモリ上に保持する（DBなしでの動作確認・テスト用）
+
+	// REPOSITORY_BACKEND_MYSQL はREPOSITORY_BACKEND_DBの旧名です。既存の設定との互換性のために受け付けます。
+	REPOSITORY_BACKEND_MYSQL = "mysql"
+)
+
+// RepositoryConfig はリポジトリの実装の設定を保持します。
+type RepositoryConfig struct {
+	Backend string // リポジトリの実装（db / memory）
+	Seed    bool   // インメモリの場合にサンプルデータを投入するかどうか
+}
+
+// NewRepositoryConfig はViperから設定を読み込みRepositoryConfigを生成します。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *RepositoryConfig: リポジトリの実装の設定
+//   - error: 設定の読み込みに失敗した場合、または値が不正な場合のエラー
+func NewRepositoryConfig(v *viper.Viper) (*RepositoryConfig, error) {
+	var configErrors []error
+	cfg := &RepositoryConfig{
+		Backend: utils.GetKey[string](v, "repository.backend", &configErrors),
+		Seed:    utils.GetKey[bool](v, "repository.seed", &configErrors),
+	}
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+
+	switch cfg.Backend {
+	case REPOSITORY_BACKEND_DB, REPOSITORY_BACKEND_MEMORY:
+	case REPOSITORY_BACKEND_MYSQL:
+		cfg.Backend = REPOSITORY_BACKEND_DB
+	default:
+		return nil, fmt.Errorf("repository.backend must be %q or %q: %q", REPOSITORY_BACKEND_DB, REPOSITORY_BACKEND_MEMORY, cfg.Backend)
+	}
+	return cfg, nil
+}
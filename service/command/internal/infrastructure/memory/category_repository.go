@@ -0,0 +1,446 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+)
+
+// CategoryRepositoryImpl はカテゴリリポジトリのインメモリ実装です。
+type CategoryRepositoryImpl struct {
+	store  *Store
+	logger *slog.Logger
+}
+
+// NewCategoryRepositoryImpl は新しいCategoryRepositoryImplインスタンスを生成します。
+//
+// Parameters:
+//   - store: データストア
+//   - logger: ロガー
+//
+// Returns:
+//   - *CategoryRepositoryImpl: CategoryRepositoryImplポインタ
+func NewCategoryRepositoryImpl(store *Store, logger *slog.Logger) *CategoryRepositoryImpl {
+	return &CategoryRepositoryImpl{store: store, logger: logger}
+}
+
+// ExistsByName は指定されたカテゴリ名の正規化キーと一致するカテゴリが存在するかどうかをチェックします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - name: カテゴリ名
+//
+// Returns:
+//   - bool: カテゴリが存在する場合はtrue
+//   - error: トランザクションが不正な場合のエラー
+func (r *CategoryRepositoryImpl) ExistsByName(ctx context.Context, tx *sql.Tx, name *categories.CategoryName) (bool, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return false, err
+	}
+	_, found := t.findCategoryByKey(name.Key())
+	return found, nil
+}
+
+// FindIdBySlug は現在または変更前のスラッグでカテゴリIDを検索します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - slug: スラッグ
+//
+// Returns:
+//   - *categories.CategoryId: スラッグを使用している（使用していた）カテゴリのID
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) FindIdBySlug(ctx context.Context, tx *sql.Tx, slug names.Slug) (*categories.CategoryId, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	if record, found := t.findCategoryBySlug(slug); found {
+		return record.id, nil
+	}
+	if ownerId, ok := t.categorySlugHistory[slug.Value()]; ok {
+		return categories.NewCategoryId(ownerId)
+	}
+	return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("スラッグ: %s のカテゴリは存在しません。", slug.Value()))
+}
+
+// FindById は指定されたIDのカテゴリを翻訳と合わせて取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: カテゴリID
+//
+// Returns:
+//   - *categories.Category: 見つかったカテゴリエンティティ
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) FindById(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) (*categories.Category, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	record, err := t.findCategory(id)
+	if err != nil {
+		return nil, err
+	}
+	return record.toCategory(true)
+}
+
+// LockById は指定されたIDのカテゴリを取得します。
+// トランザクションは直列に実行されるため、FindByIdと同じです。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: カテゴリID
+//
+// Returns:
+//   - *categories.Category: 見つかったカテゴリエンティティ
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) LockById(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) (*categories.Category, error) {
+	return r.FindById(ctx, tx, id)
+}
+
+// LockPath は指定されたカテゴリからルートまでのカテゴリIDを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 起点のカテゴリID
+//
+// Returns:
+//   - []*categories.CategoryId: 起点のカテゴリからルートまでのカテゴリID（先頭が起点のカテゴリ）
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー、親カテゴリが循環している場合はCATEGORY_CYCLEエラー
+func (r *CategoryRepositoryImpl) LockPath(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) ([]*categories.CategoryId, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	path := []*categories.CategoryId{}
+	visited := map[string]bool{}
+	current := id
+	for current != nil {
+		if visited[current.Value()] {
+			return nil, errs.NewInternalError("CATEGORY_CYCLE", fmt.Sprintf("カテゴリ番号: %s の親カテゴリが循環しています。", id.Value()))
+		}
+		visited[current.Value()] = true
+
+		record, err := t.findCategory(current)
+		if err != nil {
+			return nil, err
+		}
+		path = append(path, record.id)
+		current = record.parentId
+	}
+	return path, nil
+}
+
+// FindSubtreeIds は指定されたカテゴリとその子孫のカテゴリIDを、根からの深さ順に取得します。
+// 同じ深さのカテゴリはIDの順に並べます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 根となるカテゴリID
+//
+// Returns:
+//   - []*categories.CategoryId: 部分木のカテゴリID（先頭が根）
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) FindSubtreeIds(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) ([]*categories.CategoryId, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := t.categories[id.Value()]
+	if !ok {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリ番号: %s は存在しません。", id.Value()))
+	}
+	ids := []*categories.CategoryId{root.id}
+	level := []*categories.CategoryId{root.id}
+	for len(level) > 0 {
+		next := []*categories.CategoryId{}
+		for _, parentId := range level {
+			for _, record := range t.categories {
+				if record.parentId != nil && record.parentId.Equals(parentId) {
+					next = append(next, record.id)
+				}
+			}
+		}
+		slices.SortFunc(next, func(a, b *categories.CategoryId) int { return cmp.Compare(a.Value(), b.Value()) })
+		ids = append(ids, next...)
+		level = next
+	}
+	return ids, nil
+}
+
+// ExistsByParentId は指定されたカテゴリを親に持つカテゴリが存在するかどうかをチェックします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - parentId: 親カテゴリID
+//
+// Returns:
+//   - bool: 子カテゴリが存在する場合はtrue
+//   - error: トランザクションが不正な場合のエラー
+func (r *CategoryRepositoryImpl) ExistsByParentId(ctx context.Context, tx *sql.Tx, parentId *categories.CategoryId) (bool, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return false, err
+	}
+	return t.hasChildCategory(parentId.Value()), nil
+}
+
+// Create は新しいカテゴリを翻訳と合わせて登録します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - category: 登録するカテゴリエンティティ
+//
+// Returns:
+//   - error: 名前やスラッグが重複する場合はALREADY_EXISTSエラー
+func (r *CategoryRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, category *categories.Category) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	if err := t.insertCategory(category); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to create category", slog.Any("error", err))
+		return err
+	}
+	r.logger.InfoContext(ctx, "カテゴリが新規作成されました",
+		slog.String("obj_id", category.Id().Value()),
+		slog.String("name", category.Name().Value()))
+	return nil
+}
+
+// UpdateById はカテゴリ名・スラッグ・翻訳を更新します。
+// スラッグを変更した場合は変更前のスラッグを履歴に記録します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - category: 更新するカテゴリエンティティ
+//
+// Returns:
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー、名前やスラッグが重複する場合はALREADY_EXISTSエラー
+func (r *CategoryRepositoryImpl) UpdateById(ctx context.Context, tx *sql.Tx, category *categories.Category) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	record, ok := t.categories[category.Id().Value()]
+	if !ok {
+		return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリ番号: %s は存在しないため、更新できませんでした。", category.Id().Value()))
+	}
+	if other, found := t.findCategoryByKey(category.Name().Key()); found && !other.id.Equals(record.id) {
+		return alreadyExists("名前")
+	}
+	if other, found := t.findCategoryBySlug(category.Slug()); found && !other.id.Equals(record.id) {
+		return alreadyExists("スラッグ")
+	}
+	recordSlugHistory(t.categorySlugHistory, record.id.Value(), record.slug, category.Slug())
+	record.name = category.Name()
+	record.slug = category.Slug()
+	record.translations = category.Translations()
+	t.categories[record.id.Value()] = record
+	r.logger.InfoContext(ctx, "カテゴリが更新されました",
+		slog.String("obj_id", record.id.Value()),
+		slog.String("name", record.name.Value()))
+	return nil
+}
+
+// UpdateParentById はカテゴリの親カテゴリを更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - category: 移動後のカテゴリエンティティ
+//
+// Returns:
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) UpdateParentById(ctx context.Context, tx *sql.Tx, category *categories.Category) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	record, err := t.findCategory(category.Id())
+	if err != nil {
+		return err
+	}
+	if !category.IsRoot() {
+		if _, ok := t.categories[category.ParentId().Value()]; !ok {
+			return foreignKeyViolation(fmt.Sprintf("親カテゴリ番号: %s は存在しません。", category.ParentId().Value()))
+		}
+	}
+	record.parentId = category.ParentId()
+	t.categories[record.id.Value()] = record
+	r.logger.InfoContext(ctx, "カテゴリが更新されました",
+		slog.String("obj_id", record.id.Value()),
+		slog.String("name", record.name.Value()))
+	return nil
+}
+
+// DeleteById は指定されたIDのカテゴリを削除します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: カテゴリID
+//
+// Returns:
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー、商品や子カテゴリから参照されている場合はエラー
+func (r *CategoryRepositoryImpl) DeleteById(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	record, ok := t.categories[id.Value()]
+	if !ok {
+		return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリ番号: %s は存在しないため、削除できませんでした。", id.Value()))
+	}
+	return r.delete(ctx, t, record)
+}
+
+// DeleteByName は指定された名前のカテゴリを削除します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - name: カテゴリ名
+//
+// Returns:
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー、商品や子カテゴリから参照されている場合はエラー
+func (r *CategoryRepositoryImpl) DeleteByName(ctx context.Context, tx *sql.Tx, name *categories.CategoryName) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	record, found := t.findCategoryByKey(name.Key())
+	if !found {
+		return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリ名: %s は存在しないため、削除できませんでした。", name.Value()))
+	}
+	return r.delete(ctx, t, record)
+}
+
+// delete はカテゴリを削除し、翻訳とスラッグの履歴も合わせて削除します（ON DELETE CASCADEに相当）。
+func (r *CategoryRepositoryImpl) delete(ctx context.Context, t *tables, record categoryRecord) error {
+	id := record.id.Value()
+	if t.hasChildCategory(id) {
+		return foreignKeyViolation(fmt.Sprintf("カテゴリ番号: %s は子カテゴリから参照されているため、削除できませんでした。", id))
+	}
+	for _, product := range t.products {
+		if product.categoryId.Value() == id {
+			return foreignKeyViolation(fmt.Sprintf("カテゴリ番号: %s は商品から参照されているため、削除できませんでした。", id))
+		}
+	}
+	delete(t.categories, id)
+	deleteSlugHistory(t.categorySlugHistory, id)
+	r.logger.InfoContext(ctx, "カテゴリが削除されました",
+		slog.String("obj_id", id),
+		slog.String("name", record.name.Value()))
+	return nil
+}
+
+// findCategory はIDでカテゴリの行を検索します。
+func (t *tables) findCategory(id *categories.CategoryId) (categoryRecord, error) {
+	record, ok := t.categories[id.Value()]
+	if !ok {
+		return categoryRecord{}, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリ番号: %s は存在しないため、取得できませんでした。", id.Value()))
+	}
+	return record, nil
+}
+
+// findCategoryByKey は名前の正規化キーでカテゴリの行を検索します。
+func (t *tables) findCategoryByKey(key string) (categoryRecord, bool) {
+	for _, record := range t.categories {
+		if record.name.Key() == key {
+			return record, true
+		}
+	}
+	return categoryRecord{}, false
+}
+
+// findCategoryBySlug は現在のスラッグでカテゴリの行を検索します。
+func (t *tables) findCategoryBySlug(slug names.Slug) (categoryRecord, bool) {
+	for _, record := range t.categories {
+		if record.slug == slug {
+			return record, true
+		}
+	}
+	return categoryRecord{}, false
+}
+
+// hasChildCategory は指定されたカテゴリを親に持つカテゴリが存在するかどうかを返します。
+func (t *tables) hasChildCategory(parentId string) bool {
+	for _, record := range t.categories {
+		if record.parentId != nil && record.parentId.Value() == parentId {
+			return true
+		}
+	}
+	return false
+}
+
+// insertCategory はカテゴリの行を追加します。一意制約と外部キー制約を検証します。
+func (t *tables) insertCategory(category *categories.Category) error {
+	if _, ok := t.categories[category.Id().Value()]; ok {
+		return errs.NewCRUDError("DB_UNIQUE_CONSTRAINT_VIOLATION", "一意制約違反です。")
+	}
+	if _, found := t.findCategoryByKey(category.Name().Key()); found {
+		return alreadyExists("名前")
+	}
+	if _, found := t.findCategoryBySlug(category.Slug()); found {
+		return alreadyExists("スラッグ")
+	}
+	if !category.IsRoot() {
+		if _, ok := t.categories[category.ParentId().Value()]; !ok {
+			return foreignKeyViolation(fmt.Sprintf("親カテゴリ番号: %s は存在しません。", category.ParentId().Value()))
+		}
+	}
+	t.categories[category.Id().Value()] = categoryRecord{
+		seq:          t.nextSeq(),
+		id:           category.Id(),
+		name:         category.Name(),
+		parentId:     category.ParentId(),
+		slug:         category.Slug(),
+		translations: category.Translations(),
+	}
+	return nil
+}
+
+// recordSlugHistory はスラッグの変更を履歴に記録します。
+// 変更後のスラッグが自身の履歴にある場合（元のスラッグに戻した場合）は履歴から削除します。
+func recordSlugHistory(history map[string]string, id string, from names.Slug, to names.Slug) {
+	if from == to {
+		return
+	}
+	if owner, ok := history[to.Value()]; ok && owner == id {
+		delete(history, to.Value())
+	}
+	if from == "" {
+		return
+	}
+	history[from.Value()] = id
+}
+
+// deleteSlugHistory は指定されたエンティティのスラッグの履歴を削除します。
+func deleteSlugHistory(history map[string]string, id string) {
+	for slug, owner := range history {
+		if owner == id {
+			delete(history, slug)
+		}
+	}
+}
+
+var _ categories.CategoryRepository = (*CategoryRepositoryImpl)(nil)
@@ -0,0 +1,66 @@
+package memory_test
+
+import (
+	"context"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/fx"
+)
+
+var _ = Describe("repository.backend = memory", func() {
+	var (
+		ctx             context.Context
+		categoryService service.CategoryService
+		productService  service.ProductService
+		stockService    service.StockService
+	)
+
+	BeforeEach(func() {
+		// viperの環境変数による上書きでインメモリの実装を選択する
+		GinkgoT().Setenv("REPOSITORY_BACKEND", "memory")
+		ctx = context.Background()
+
+		app := fx.New(
+			fx.Supply(
+				fx.Annotate("../../../", fx.ResultTags(`name:"configPath"`)),
+				fx.Annotate("config", fx.ResultTags(`name:"configName"`)),
+			),
+			application.Module,
+			fx.Populate(&categoryService, &productService, &stockService),
+			fx.NopLogger,
+		)
+		Expect(app.Err()).NotTo(HaveOccurred(), "DBなしでfx appを初期化できませんでした")
+	})
+
+	It("DBなしでカテゴリと商品を登録し、在庫を引き当てられること", func() {
+		categoryDTO, err := categoryService.Add(ctx, &dto.CreateCategoryDTO{Name: "インメモリのカテゴリ"})
+		Expect(err).NotTo(HaveOccurred())
+		productDTO, err := productService.Add(ctx, &dto.CreateProductDTO{Name: "インメモリの商品", Price: 500, Category: categoryDTO})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = stockService.Adjust(ctx, &dto.AdjustStockDTO{ProductId: productDTO.Id, Delta: 3})
+		Expect(err).NotTo(HaveOccurred())
+		reservation, err := stockService.Reserve(ctx, &dto.ReserveStockDTO{ProductId: productDTO.Id, Quantity: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reservation.Stock.Available).To(Equal(uint32(1)))
+
+		_, err = stockService.Reserve(ctx, &dto.ReserveStockDTO{ProductId: productDTO.Id, Quantity: 2})
+		Expect(err).To(HaveOccurred(), "引当可能な数量を超えて引き当てられてしまいました")
+	})
+
+	It("サンプルデータと同じ名前の商品は登録できないこと", func() {
+		_, err := productService.Add(ctx, &dto.CreateProductDTO{
+			Name:     "ワイヤレスマウス",
+			Price:    900,
+			Category: &dto.CategoryDTO{Id: "c05b1952-3bdf-4449-9b83-d0d123a667ce", Name: "パソコン周辺機器"},
+		})
+		appErr, ok := err.(*errs.ApplicationError)
+		Expect(ok).To(BeTrue(), "返されたエラーがApplicationErrorではありません")
+		Expect(appErr.Code).To(Equal("PRODUCT_ALREADY_EXISTS"))
+	})
+})
@@ -0,0 +1,242 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/pricing"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// PriceScheduleRepositoryImpl は価格スケジュールリポジトリのインメモリ実装です。
+type PriceScheduleRepositoryImpl struct {
+	store  *Store
+	logger *slog.Logger
+}
+
+// NewPriceScheduleRepositoryImpl は新しいPriceScheduleRepositoryImplインスタンスを生成します。
+//
+// Parameters:
+//   - store: データストア
+//   - logger: ロガー
+//
+// Returns:
+//   - *PriceScheduleRepositoryImpl: PriceScheduleRepositoryImplポインタ
+func NewPriceScheduleRepositoryImpl(store *Store, logger *slog.Logger) *PriceScheduleRepositoryImpl {
+	return &PriceScheduleRepositoryImpl{store: store, logger: logger}
+}
+
+// Create は価格スケジュールを登録します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - schedule: 登録する価格スケジュール
+//
+// Returns:
+//   - error: 商品が存在しない場合のエラー
+func (r *PriceScheduleRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, schedule *pricing.PriceSchedule) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	if err := t.insertSchedule(schedule); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to create price schedule", slog.Any("error", err))
+		return err
+	}
+	r.logger.InfoContext(ctx, "価格スケジュールを登録しました。",
+		slog.String("obj_id", schedule.Id().Value()),
+		slog.String("product_id", schedule.ProductId().Value()),
+		slog.Int("price", int(schedule.Price())),
+		slog.Time("starts_at", schedule.StartsAt()),
+		slog.Time("ends_at", schedule.EndsAt()),
+	)
+	return nil
+}
+
+// FindById は指定されたIDの価格スケジュールを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 価格スケジュールID
+//
+// Returns:
+//   - *pricing.PriceSchedule: 価格スケジュール
+//   - error: 価格スケジュールが存在しない場合はNOT_FOUNDエラー
+func (r *PriceScheduleRepositoryImpl) FindById(ctx context.Context, tx *sql.Tx, id *pricing.PriceScheduleId) (*pricing.PriceSchedule, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	record, ok := t.schedules[id.Value()]
+	if !ok {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("価格スケジュール番号: %s は存在しません。", id.Value()))
+	}
+	return record.toSchedule()
+}
+
+// LockById は指定されたIDの価格スケジュールを取得します。
+// トランザクションは直列に実行されるため、FindByIdと同じです。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 価格スケジュールID
+//
+// Returns:
+//   - *pricing.PriceSchedule: 価格スケジュール
+//   - error: 価格スケジュールが存在しない場合はNOT_FOUNDエラー
+func (r *PriceScheduleRepositoryImpl) LockById(ctx context.Context, tx *sql.Tx, id *pricing.PriceScheduleId) (*pricing.PriceSchedule, error) {
+	return r.FindById(ctx, tx, id)
+}
+
+// FindPendingByProductId は商品の予定および適用中の価格スケジュールを開始時刻順に取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//
+// Returns:
+//   - []*pricing.PriceSchedule: 価格スケジュール
+//   - error: トランザクションが不正な場合のエラー
+func (r *PriceScheduleRepositoryImpl) FindPendingByProductId(ctx context.Context, tx *sql.Tx, productId *products.ProductId) ([]*pricing.PriceSchedule, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	records := []scheduleRecord{}
+	for _, record := range t.schedules {
+		if record.productId != productId.Value() {
+			continue
+		}
+		if record.status == string(pricing.PRICE_SCHEDULE_SCHEDULED) || record.status == string(pricing.PRICE_SCHEDULE_ACTIVE) {
+			records = append(records, record)
+		}
+	}
+	slices.SortFunc(records, func(a, b scheduleRecord) int { return a.startsAt.Compare(b.startsAt) })
+	schedules := make([]*pricing.PriceSchedule, 0, len(records))
+	for _, record := range records {
+		schedule, err := record.toSchedule()
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+// FindDueIds は開始時刻を過ぎた予定の価格スケジュールと、終了時刻を過ぎた適用中の価格スケジュールのIDを登録順に取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - now: 現在時刻
+//   - limit: 取得する件数の上限
+//
+// Returns:
+//   - []*pricing.PriceScheduleId: 価格スケジュールID
+//   - error: トランザクションが不正な場合のエラー
+func (r *PriceScheduleRepositoryImpl) FindDueIds(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]*pricing.PriceScheduleId, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	records := []scheduleRecord{}
+	for _, record := range t.schedules {
+		started := record.status == string(pricing.PRICE_SCHEDULE_SCHEDULED) && !record.startsAt.After(now)
+		ended := record.status == string(pricing.PRICE_SCHEDULE_ACTIVE) && !record.endsAt.After(now)
+		if started || ended {
+			records = append(records, record)
+		}
+	}
+	slices.SortFunc(records, func(a, b scheduleRecord) int { return cmp.Compare(a.seq, b.seq) })
+	if len(records) > limit {
+		records = records[:limit]
+	}
+	ids := make([]*pricing.PriceScheduleId, 0, len(records))
+	for _, record := range records {
+		id, err := pricing.NewPriceScheduleId(record.id)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Update は価格スケジュールの状態と適用前の単価を更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - schedule: 更新する価格スケジュール
+//
+// Returns:
+//   - error: 価格スケジュールが存在しない場合はNOT_FOUNDエラー
+func (r *PriceScheduleRepositoryImpl) Update(ctx context.Context, tx *sql.Tx, schedule *pricing.PriceSchedule) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	record, ok := t.schedules[schedule.Id().Value()]
+	if !ok {
+		return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("価格スケジュール番号: %s は存在しないため、更新できませんでした。", schedule.Id().Value()))
+	}
+	record.status = string(schedule.Status())
+	record.regularPrice = schedule.RegularPrice()
+	t.schedules[record.id] = record
+	r.logger.InfoContext(ctx, "価格スケジュールを変更しました。",
+		slog.String("obj_id", record.id),
+		slog.String("product_id", record.productId),
+		slog.String("status", record.status),
+	)
+	return nil
+}
+
+// insertSchedule は価格スケジュールの行を追加します。一意制約と外部キー制約を検証します。
+func (t *tables) insertSchedule(schedule *pricing.PriceSchedule) error {
+	if _, ok := t.schedules[schedule.Id().Value()]; ok {
+		return errs.NewCRUDError("DB_UNIQUE_CONSTRAINT_VIOLATION", "一意制約違反です。")
+	}
+	if _, ok := t.products[schedule.ProductId().Value()]; !ok {
+		return foreignKeyViolation(fmt.Sprintf("商品番号: %s は存在しません。", schedule.ProductId().Value()))
+	}
+	t.schedules[schedule.Id().Value()] = scheduleRecord{
+		seq:          t.nextSeq(),
+		id:           schedule.Id().Value(),
+		productId:    schedule.ProductId().Value(),
+		price:        schedule.Price(),
+		regularPrice: schedule.RegularPrice(),
+		status:       string(schedule.Status()),
+		startsAt:     schedule.StartsAt(),
+		endsAt:       schedule.EndsAt(),
+	}
+	return nil
+}
+
+// toSchedule は価格スケジュールの行から価格スケジュールを再構築します。
+func (r scheduleRecord) toSchedule() (*pricing.PriceSchedule, error) {
+	id, err := pricing.NewPriceScheduleId(r.id)
+	if err != nil {
+		return nil, err
+	}
+	productId, err := products.NewProductId(r.productId)
+	if err != nil {
+		return nil, err
+	}
+	status, err := pricing.ParsePriceScheduleStatus(r.status)
+	if err != nil {
+		return nil, err
+	}
+	return pricing.BuildPriceSchedule(id, productId, r.price, r.regularPrice, status, r.startsAt, r.endsAt)
+}
+
+var _ pricing.PriceScheduleRepository = (*PriceScheduleRepositoryImpl)(nil)
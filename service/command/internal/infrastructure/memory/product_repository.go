@@ -0,0 +1,500 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// ProductRepositoryImpl は商品リポジトリのインメモリ実装です。
+type ProductRepositoryImpl struct {
+	store  *Store
+	logger *slog.Logger
+}
+
+// NewProductRepositoryImpl は新しいProductRepositoryImplインスタンスを生成します。
+//
+// Parameters:
+//   - store: データストア
+//   - logger: ロガー
+//
+// Returns:
+//   - *ProductRepositoryImpl: ProductRepositoryImplポインタ
+func NewProductRepositoryImpl(store *Store, logger *slog.Logger) *ProductRepositoryImpl {
+	return &ProductRepositoryImpl{store: store, logger: logger}
+}
+
+// ExistsById は指定された商品IDが存在するかどうかをチェックします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 商品ID
+//
+// Returns:
+//   - bool: 商品が存在する場合はtrue
+//   - error: トランザクションが不正な場合のエラー
+func (r *ProductRepositoryImpl) ExistsById(ctx context.Context, tx *sql.Tx, id *products.ProductId) (bool, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return false, err
+	}
+	_, ok := t.products[id.Value()]
+	return ok, nil
+}
+
+// FindById は指定されたIDの商品をバリエーションと合わせて取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 商品ID
+//
+// Returns:
+//   - *products.Product: 見つかった商品エンティティ
+//   - error: 商品が存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) FindById(ctx context.Context, tx *sql.Tx, id *products.ProductId) (*products.Product, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	record, ok := t.products[id.Value()]
+	if !ok {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("商品番号: %s は存在しません。", id.Value()))
+	}
+	return t.toProduct(record)
+}
+
+// LockById は指定されたIDの商品をバリエーションと合わせて取得します。
+// トランザクションは直列に実行されるため、FindByIdと同じです。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 商品ID
+//
+// Returns:
+//   - *products.Product: 見つかった商品エンティティ
+//   - error: 商品が存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) LockById(ctx context.Context, tx *sql.Tx, id *products.ProductId) (*products.Product, error) {
+	return r.FindById(ctx, tx, id)
+}
+
+// LockIdsByCategoryIds は指定されたカテゴリに属する商品のIDを、IDの順に取得します。
+// タグ名の正規化キーを指定した場合は、指定されたすべてのタグが付与された商品に絞り込みます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - categoryIds: カテゴリID
+//   - tagKeys: タグ名の正規化キー（空の場合は絞り込まない）
+//
+// Returns:
+//   - []*products.ProductId: 商品ID
+//   - error: トランザクションが不正な場合のエラー
+func (r *ProductRepositoryImpl) LockIdsByCategoryIds(ctx context.Context, tx *sql.Tx, categoryIds []*categories.CategoryId, tagKeys []string) ([]*products.ProductId, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	ids := []*products.ProductId{}
+	for _, record := range t.products {
+		if !slices.ContainsFunc(categoryIds, record.categoryId.Equals) {
+			continue
+		}
+		if !t.hasAllTags(record.id.Value(), tagKeys) {
+			continue
+		}
+		ids = append(ids, record.id)
+	}
+	slices.SortFunc(ids, func(a, b *products.ProductId) int { return cmp.Compare(a.Value(), b.Value()) })
+	return ids, nil
+}
+
+// ExistsByName は指定された商品名の正規化キーと一致する商品が存在するかどうかをチェックします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - name: 商品名
+//
+// Returns:
+//   - bool: 商品が存在する場合はtrue
+//   - error: トランザクションが不正な場合のエラー
+func (r *ProductRepositoryImpl) ExistsByName(ctx context.Context, tx *sql.Tx, name *products.ProductName) (bool, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return false, err
+	}
+	_, found := t.findProduct(func(record productRecord) bool { return record.name.Key() == name.Key() })
+	return found, nil
+}
+
+// FindIdBySlug は現在または変更前のスラッグで商品IDを検索します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - slug: スラッグ
+//
+// Returns:
+//   - *products.ProductId: スラッグを使用している（使用していた）商品のID
+//   - error: 商品が存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) FindIdBySlug(ctx context.Context, tx *sql.Tx, slug names.Slug) (*products.ProductId, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	if record, found := t.findProduct(func(record productRecord) bool { return record.slug == slug }); found {
+		return record.id, nil
+	}
+	if ownerId, ok := t.productSlugHistory[slug.Value()]; ok {
+		return products.NewProductId(ownerId)
+	}
+	return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("スラッグ: %s の商品は存在しません。", slug.Value()))
+}
+
+// Create は新しい商品を翻訳と合わせて登録します。
+// バリエーションはAddVariantで登録します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - product: 登録する商品エンティティ
+//
+// Returns:
+//   - error: 名前・バーコード・スラッグが重複する場合はALREADY_EXISTSエラー
+func (r *ProductRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, product *products.Product) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	if err := t.insertProduct(product); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to create product", slog.Any("error", err))
+		return err
+	}
+	r.logger.InfoContext(ctx, "商品を登録しました。",
+		slog.String("obj_id", product.Id().Value()),
+		slog.String("name", product.Name().Value()),
+		slog.Int("price", int(product.Price().Value())),
+		slog.String("category_id", product.Category().Id().Value()),
+	)
+	return nil
+}
+
+// UpdateById は商品を更新します。
+// スラッグを変更した場合は変更前のスラッグを履歴に記録します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - product: 更新する商品エンティティ
+//
+// Returns:
+//   - error: 商品が存在しない場合はNOT_FOUNDエラー、名前・バーコード・スラッグが重複する場合はALREADY_EXISTSエラー
+func (r *ProductRepositoryImpl) UpdateById(ctx context.Context, tx *sql.Tx, product *products.Product) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	record, ok := t.products[product.Id().Value()]
+	if !ok {
+		return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("商品番号: %s は存在しないため、更新できませんでした。", product.Id().Value()))
+	}
+	if err := t.checkProductUnique(product, record.id.Value()); err != nil {
+		return err
+	}
+	if _, ok := t.categories[product.Category().Id().Value()]; !ok {
+		return foreignKeyViolation(fmt.Sprintf("カテゴリ番号: %s は存在しません。", product.Category().Id().Value()))
+	}
+	recordSlugHistory(t.productSlugHistory, record.id.Value(), record.slug, product.Slug())
+	updated := newProductRecord(product)
+	updated.seq = record.seq
+	t.products[record.id.Value()] = updated
+	r.logger.InfoContext(ctx, "商品を変更しました。",
+		slog.String("obj_id", updated.id.Value()),
+		slog.String("name", updated.name.Value()),
+		slog.Int("price", int(updated.price.Value())),
+		slog.String("category_id", updated.categoryId.Value()),
+	)
+	return nil
+}
+
+// DeleteById は指定されたIDの商品を削除します。
+// バリエーション・在庫・引当・タグの関連・翻訳・価格スケジュール・スラッグの履歴も合わせて削除します（ON DELETE CASCADEに相当）。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 商品ID
+//
+// Returns:
+//   - error: 商品が存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) DeleteById(ctx context.Context, tx *sql.Tx, id *products.ProductId) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	record, ok := t.products[id.Value()]
+	if !ok {
+		return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("商品番号: %s は存在しないため、削除できませんでした。", id.Value()))
+	}
+	productId := id.Value()
+	delete(t.products, productId)
+	delete(t.stocks, productId)
+	for variantId, variant := range t.variants {
+		if variant.productId == productId {
+			delete(t.variants, variantId)
+		}
+	}
+	for reservationId, reservation := range t.reservations {
+		if reservation.productId == productId {
+			delete(t.reservations, reservationId)
+		}
+	}
+	for scheduleId, schedule := range t.schedules {
+		if schedule.productId == productId {
+			delete(t.schedules, scheduleId)
+		}
+	}
+	for key := range t.productTags {
+		if key.productId == productId {
+			delete(t.productTags, key)
+		}
+	}
+	deleteSlugHistory(t.productSlugHistory, productId)
+	r.logger.InfoContext(ctx, "商品を削除しました。",
+		slog.String("obj_id", productId),
+		slog.String("name", record.name.Value()),
+		slog.Int("price", int(record.price.Value())),
+		slog.String("category_id", record.categoryId.Value()),
+	)
+	return nil
+}
+
+// AddVariant は商品にバリエーションを登録します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//   - variant: 登録するバリエーション
+//
+// Returns:
+//   - error: SKUや選択肢の組み合わせが重複する場合はALREADY_EXISTSエラー
+func (r *ProductRepositoryImpl) AddVariant(ctx context.Context, tx *sql.Tx, productId *products.ProductId, variant *products.Variant) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	if err := t.insertVariant(productId.Value(), variant); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to create product variant", slog.Any("error", err))
+		return err
+	}
+	r.logger.InfoContext(ctx, "商品バリエーションを登録しました。",
+		slog.String("obj_id", variant.Id().Value()),
+		slog.String("product_id", productId.Value()),
+		slog.String("sku", variant.Sku().Value()),
+	)
+	return nil
+}
+
+// UpdateVariant はバリエーションを更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - variant: 更新するバリエーション
+//
+// Returns:
+//   - error: バリエーションが存在しない場合はNOT_FOUNDエラー、SKUや選択肢の組み合わせが重複する場合はALREADY_EXISTSエラー
+func (r *ProductRepositoryImpl) UpdateVariant(ctx context.Context, tx *sql.Tx, variant *products.Variant) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	record, ok := t.variants[variant.Id().Value()]
+	if !ok {
+		return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("バリエーション番号: %s は存在しないため、更新できませんでした。", variant.Id().Value()))
+	}
+	if err := t.checkVariantUnique(record.productId, variant); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to update product variant", slog.Any("error", err))
+		return err
+	}
+	record.variant = variant
+	t.variants[variant.Id().Value()] = record
+	r.logger.InfoContext(ctx, "商品バリエーションを変更しました。",
+		slog.String("obj_id", variant.Id().Value()),
+		slog.String("product_id", record.productId),
+		slog.String("sku", variant.Sku().Value()),
+	)
+	return nil
+}
+
+// RemoveVariant はバリエーションを削除します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: バリエーションID
+//
+// Returns:
+//   - error: バリエーションが存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) RemoveVariant(ctx context.Context, tx *sql.Tx, id *products.VariantId) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	record, ok := t.variants[id.Value()]
+	if !ok {
+		return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("バリエーション番号: %s は存在しないため、削除できませんでした。", id.Value()))
+	}
+	delete(t.variants, id.Value())
+	r.logger.InfoContext(ctx, "商品バリエーションを削除しました。",
+		slog.String("obj_id", id.Value()),
+		slog.String("product_id", record.productId),
+		slog.String("sku", record.variant.Sku().Value()),
+	)
+	return nil
+}
+
+// newProductRecord は商品エンティティから商品の行を生成します。
+func newProductRecord(product *products.Product) productRecord {
+	return productRecord{
+		id:           product.Id(),
+		name:         product.Name(),
+		price:        product.Price(),
+		categoryId:   product.Category().Id(),
+		status:       product.Status(),
+		barcode:      product.Barcode(),
+		slug:         product.Slug(),
+		translations: product.Translations(),
+	}
+}
+
+// toProduct は商品の行から、カテゴリとバリエーションを合わせて商品エンティティを再構築します。
+func (t *tables) toProduct(record productRecord) (*products.Product, error) {
+	categoryRecord, ok := t.categories[record.categoryId.Value()]
+	if !ok {
+		return nil, errs.NewCRUDError("NOT_FOUND", "関連するカテゴリが見つかりません")
+	}
+	category, err := categoryRecord.toCategory(false)
+	if err != nil {
+		return nil, err
+	}
+	variantRecords := []variantRecord{}
+	for _, variant := range t.variants {
+		if variant.productId == record.id.Value() {
+			variantRecords = append(variantRecords, variant)
+		}
+	}
+	slices.SortFunc(variantRecords, func(a, b variantRecord) int { return cmp.Compare(a.seq, b.seq) })
+	variants := make([]*products.Variant, len(variantRecords))
+	for i, variant := range variantRecords {
+		variants[i] = variant.variant
+	}
+
+	product, err := products.BuildProduct(record.id, record.name, record.price, category, record.status, variants...)
+	if err != nil {
+		return nil, err
+	}
+	if err := product.ChangeTranslations(record.translations); err != nil {
+		return nil, err
+	}
+	if record.barcode != nil {
+		product.ChangeBarcode(record.barcode)
+	}
+	product.ChangeSlug(record.slug)
+	return product, nil
+}
+
+// findProduct は条件に一致する商品の行を検索します。
+func (t *tables) findProduct(match func(record productRecord) bool) (productRecord, bool) {
+	for _, record := range t.products {
+		if match(record) {
+			return record, true
+		}
+	}
+	return productRecord{}, false
+}
+
+// checkProductUnique は商品の名前・バーコード・スラッグの一意制約を検証します。
+// excludeIdには更新対象の商品IDを指定します（登録の場合は空文字列）。
+func (t *tables) checkProductUnique(product *products.Product, excludeId string) error {
+	others := func(match func(record productRecord) bool) bool {
+		_, found := t.findProduct(func(record productRecord) bool {
+			return record.id.Value() != excludeId && match(record)
+		})
+		return found
+	}
+	if others(func(record productRecord) bool { return record.name.Key() == product.Name().Key() }) {
+		return alreadyExists("名前")
+	}
+	if product.Barcode() != nil && others(func(record productRecord) bool {
+		return record.barcode != nil && record.barcode.Value() == product.Barcode().Value()
+	}) {
+		return alreadyExists("バーコード")
+	}
+	if others(func(record productRecord) bool { return record.slug == product.Slug() }) {
+		return alreadyExists("スラッグ")
+	}
+	return nil
+}
+
+// insertProduct は商品の行を追加します。一意制約と外部キー制約を検証します。
+func (t *tables) insertProduct(product *products.Product) error {
+	if _, ok := t.products[product.Id().Value()]; ok {
+		return errs.NewCRUDError("DB_UNIQUE_CONSTRAINT_VIOLATION", "一意制約違反です。")
+	}
+	if err := t.checkProductUnique(product, ""); err != nil {
+		return err
+	}
+	if _, ok := t.categories[product.Category().Id().Value()]; !ok {
+		return foreignKeyViolation(fmt.Sprintf("カテゴリ番号: %s は存在しません。", product.Category().Id().Value()))
+	}
+	record := newProductRecord(product)
+	record.seq = t.nextSeq()
+	t.products[product.Id().Value()] = record
+	return nil
+}
+
+// checkVariantUnique はバリエーションのSKUと、商品内の選択肢の組み合わせの一意制約を検証します。
+func (t *tables) checkVariantUnique(productId string, variant *products.Variant) error {
+	for id, record := range t.variants {
+		if id == variant.Id().Value() {
+			continue
+		}
+		if record.variant.Sku().Value() == variant.Sku().Value() {
+			return alreadyExists("SKU")
+		}
+		if record.productId == productId && record.variant.Options().Key() == variant.Options().Key() {
+			return alreadyExists("選択肢の組み合わせ")
+		}
+	}
+	return nil
+}
+
+// insertVariant はバリエーションの行を追加します。一意制約と外部キー制約を検証します。
+func (t *tables) insertVariant(productId string, variant *products.Variant) error {
+	if _, ok := t.variants[variant.Id().Value()]; ok {
+		return errs.NewCRUDError("DB_UNIQUE_CONSTRAINT_VIOLATION", "一意制約違反です。")
+	}
+	if _, ok := t.products[productId]; !ok {
+		return foreignKeyViolation(fmt.Sprintf("商品番号: %s は存在しません。", productId))
+	}
+	if err := t.checkVariantUnique(productId, variant); err != nil {
+		return err
+	}
+	t.variants[variant.Id().Value()] = variantRecord{seq: t.nextSeq(), productId: productId, variant: variant}
+	return nil
+}
+
+var _ products.ProductRepository = (*ProductRepositoryImpl)(nil)
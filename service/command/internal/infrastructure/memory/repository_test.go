@@ -0,0 +1,152 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/tags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	seedStationeryId    = "b1524011-b6af-417e-8bf2-f449dd58b5c0"
+	seedPcPeripheralsId = "c05b1952-3bdf-4449-9b83-d0d123a667ce"
+	seedWirelessMouseId = "82014174-6785-4242-b307-a806fd1f8470"
+)
+
+var _ = Describe("インメモリのリポジトリ", func() {
+	var (
+		ctx          context.Context
+		tx           *sql.Tx
+		categoryRepo *CategoryRepositoryImpl
+		productRepo  *ProductRepositoryImpl
+		stockRepo    *StockRepositoryImpl
+		tagRepo      *TagRepositoryImpl
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		store := NewStore()
+		Expect(Seed(store)).To(Succeed())
+		categoryRepo = NewCategoryRepositoryImpl(store, logger)
+		productRepo = NewProductRepositoryImpl(store, logger)
+		stockRepo = NewStockRepositoryImpl(store, logger)
+		tagRepo = NewTagRepositoryImpl(store, logger)
+
+		tm := NewTransactionManagerImpl(store, logger)
+		var err error
+		tx, err = tm.Begin(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			Expect(tm.Complete(ctx, tx, nil)).To(Succeed())
+		})
+	})
+
+	productIdOf := func(value string) *products.ProductId {
+		id, err := products.NewProductId(value)
+		Expect(err).NotTo(HaveOccurred())
+		return id
+	}
+
+	categoryIdOf := func(value string) *categories.CategoryId {
+		id, err := categories.NewCategoryId(value)
+		Expect(err).NotTo(HaveOccurred())
+		return id
+	}
+
+	Context("初期データ", func() {
+		It("カテゴリ・バリエーション・在庫を取得できること", func() {
+			category, err := categoryRepo.FindById(ctx, tx, categoryIdOf(seedStationeryId))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(category.Name().Value()).To(Equal("文房具"))
+			Expect(category.Translations()).To(HaveKey(names.Locale("en")))
+
+			product, err := productRepo.FindById(ctx, tx, productIdOf("ac413f22-0cf1-490a-9635-7e9ca810e544"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(product.Variants()).To(HaveLen(2))
+			Expect(product.Barcode().Value()).To(Equal("4901234567894"))
+
+			stock, err := stockRepo.LockByProductId(ctx, tx, product.Id())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stock.OnHand()).To(Equal(uint32(100)))
+		})
+
+		It("変更前のスラッグから商品を取得できること", func() {
+			slug, err := names.NewSlug("waiyaresumausu")
+			Expect(err).NotTo(HaveOccurred())
+			id, err := productRepo.FindIdBySlug(ctx, tx, slug)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id.Value()).To(Equal(seedWirelessMouseId))
+		})
+
+		It("カテゴリとタグで商品を絞り込めること", func() {
+			ids, err := productRepo.LockIdsByCategoryIds(ctx, tx, []*categories.CategoryId{categoryIdOf(seedPcPeripheralsId)}, []string{"ワイヤレス"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(HaveLen(3))
+		})
+	})
+
+	Context("一意制約", func() {
+		It("同じ名前の商品を登録するとALREADY_EXISTSエラーを返すこと", func() {
+			category, err := categoryRepo.FindById(ctx, tx, categoryIdOf(seedPcPeripheralsId))
+			Expect(err).NotTo(HaveOccurred())
+			name, err := products.NewProductName("ワイヤレスマウス")
+			Expect(err).NotTo(HaveOccurred())
+			price, err := products.NewProductPrice(100)
+			Expect(err).NotTo(HaveOccurred())
+			product, err := products.NewProduct(name, price, category)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = productRepo.Create(ctx, tx, product)
+			crudErr, ok := err.(*errs.CRUDError)
+			Expect(ok).To(BeTrue(), "返されたエラーがCRUDErrorではありません")
+			Expect(crudErr.Code).To(Equal("ALREADY_EXISTS"))
+		})
+	})
+
+	Context("外部キー制約", func() {
+		It("存在しない商品の在庫を取得するとDB_FOREIGN_KEY_VIOLATIONエラーを返すこと", func() {
+			_, err := stockRepo.LockByProductId(ctx, tx, productIdOf("0b9f8e7d-6c5b-4a39-8281-7f6e5d4c3b2a"))
+			crudErr, ok := err.(*errs.CRUDError)
+			Expect(ok).To(BeTrue(), "返されたエラーがCRUDErrorではありません")
+			Expect(crudErr.Code).To(Equal("DB_FOREIGN_KEY_VIOLATION"))
+		})
+
+		It("子カテゴリがあるカテゴリを削除するとDB_FOREIGN_KEY_VIOLATIONエラーを返すこと", func() {
+			err := categoryRepo.DeleteById(ctx, tx, categoryIdOf(seedStationeryId))
+			crudErr, ok := err.(*errs.CRUDError)
+			Expect(ok).To(BeTrue(), "返されたエラーがCRUDErrorではありません")
+			Expect(crudErr.Code).To(Equal("DB_FOREIGN_KEY_VIOLATION"))
+		})
+	})
+
+	Context("削除", func() {
+
+		It("商品を削除すると在庫とタグの関連も削除すること", func() {
+			productId := productIdOf(seedWirelessMouseId)
+			Expect(productRepo.DeleteById(ctx, tx, productId)).To(Succeed())
+
+			_, err := productRepo.FindById(ctx, tx, productId)
+			Expect(err).To(HaveOccurred())
+			_, err = stockRepo.LockByProductId(ctx, tx, productId)
+			Expect(err).To(HaveOccurred(), "削除した商品の在庫が残っています")
+
+			tagName, err := tags.NewTagName("ワイヤレス")
+			Expect(err).NotTo(HaveOccurred())
+			tagList, err := tagRepo.LockByNames(ctx, tx, []*tags.TagName{tagName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tagList).To(HaveLen(1))
+			detached, err := tagRepo.DetachFromProducts(ctx, tx, []*products.ProductId{productId}, []*tags.TagId{tagList[0].Id()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(detached).To(BeZero(), "削除した商品のタグの関連が残っています")
+		})
+	})
+})
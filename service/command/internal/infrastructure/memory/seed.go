@@ -0,0 +1,320 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/pricing"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/tags"
+)
+
+// 初期データは db/command/init/create_record.sql と同じ内容です。
+
+type seedCategory struct {
+	id, name, slug, parentId, nameEn string
+}
+
+type seedProduct struct {
+	id, name, slug string
+	price          uint32
+	categoryId     string
+	barcode        string
+	nameEn         string
+	tagIds         []string
+}
+
+type seedVariant struct {
+	id, productId, sku, axis, value string
+	priceOverride                   uint32
+}
+
+type seedSchedule struct {
+	id, productId       string
+	price, regularPrice uint32
+	status              string
+	startsAt, endsAt    time.Time
+}
+
+const (
+	seedTagWireless = "0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a61"
+	seedTagGaming   = "0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a62"
+)
+
+var seedCategories = []seedCategory{
+	{id: "b1524011-b6af-417e-8bf2-f449dd58b5c0", name: "文房具", slug: "stationery", nameEn: "Stationery"},
+	{id: "762bd1ea-9700-4bab-a28d-6cbebf20ddc2", name: "雑貨", slug: "goods", nameEn: "Goods"},
+	{id: "c05b1952-3bdf-4449-9b83-d0d123a667ce", name: "パソコン周辺機器", slug: "pc-peripherals", nameEn: "PC Peripherals"},
+	{id: "3f6b8a2e-5c41-4d7e-9a0b-7e2d4c1f8b93", name: "筆記具", slug: "writing-instruments", parentId: "b1524011-b6af-417e-8bf2-f449dd58b5c0"},
+	{id: "a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64", name: "鉛筆", slug: "pencils", parentId: "3f6b8a2e-5c41-4d7e-9a0b-7e2d4c1f8b93"},
+}
+
+var seedProducts = []seedProduct{
+	{id: "ac413f22-0cf1-490a-9635-7e9ca810e544", name: "水性ボールペン(黒)", slug: "water-based-ballpoint-pen-black", price: 120, categoryId: "b1524011-b6af-417e-8bf2-f449dd58b5c0", barcode: "4901234567894"},
+	{id: "8f81a72a-58ef-422b-b472-d982e8665292", name: "水性ボールペン(赤)", slug: "water-based-ballpoint-pen-red", price: 120, categoryId: "b1524011-b6af-417e-8bf2-f449dd58b5c0"},
+	{id: "d952b98c-a1ea-478d-8380-3b90fde872ea", name: "水性ボールペン(青)", slug: "water-based-ballpoint-pen-blue", price: 120, categoryId: "b1524011-b6af-417e-8bf2-f449dd58b5c0"},
+	{id: "9959e553-c9da-4646-bd85-8663a3541583", name: "油性ボールペン(黒)", slug: "oil-based-ballpoint-pen-black", price: 100, categoryId: "b1524011-b6af-417e-8bf2-f449dd58b5c0"},
+	{id: "79023e82-9197-40a5-b236-26487f404be4", name: "油性ボールペン(赤)", slug: "oil-based-ballpoint-pen-red", price: 100, categoryId: "b1524011-b6af-417e-8bf2-f449dd58b5c0"},
+	{id: "7dfd0fd0-0893-4d20-83ef-6f70aab0ab76", name: "油性ボールペン(青)", slug: "oil-based-ballpoint-pen-blue", price: 100, categoryId: "b1524011-b6af-417e-8bf2-f449dd58b5c0"},
+	{id: "dc7243af-c2ce-4136-bd5d-c6b28ee0a20a", name: "蛍光ペン(黄)", slug: "highlighter-yellow", price: 130, categoryId: "b1524011-b6af-417e-8bf2-f449dd58b5c0"},
+	{id: "83fbc81d-2498-4da6-b8c2-54878d3b67ff", name: "蛍光ペン(赤)", slug: "highlighter-red", price: 130, categoryId: "b1524011-b6af-417e-8bf2-f449dd58b5c0"},
+	{id: "ee4b3752-3fbd-45fc-afb5-8f37c3f701c9", name: "蛍光ペン(青)", slug: "highlighter-blue", price: 130, categoryId: "b1524011-b6af-417e-8bf2-f449dd58b5c0"},
+	{id: "35cb51a7-df79-4771-9939-7f32c19bca45", name: "蛍光ペン(緑)", slug: "highlighter-green", price: 130, categoryId: "b1524011-b6af-417e-8bf2-f449dd58b5c0"},
+	{id: "e4850253-f363-4e79-8110-7335e4af45be", name: "鉛筆(黒)", slug: "pencil-black", price: 100, categoryId: "a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64"},
+	{id: "5ca7dbdf-0010-44c5-a001-e4c13c4fe3a1", name: "鉛筆(赤)", slug: "pencil-red", price: 100, categoryId: "a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64"},
+	{id: "fbc43b9b-90a9-4712-925c-4d66a2a30372", name: "色鉛筆(12色)", slug: "colored-pencils-12", price: 400, categoryId: "a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64"},
+	{id: "4b3db238-8ada-49b4-bb60-1a034914e528", name: "色鉛筆(48色)", slug: "colored-pencils-48", price: 1300, categoryId: "a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64"},
+	{id: "debdbd8c-5b48-4b1a-9697-98ba321ddd40", name: "レザーネックレス", slug: "leather-necklace", price: 300, categoryId: "762bd1ea-9700-4bab-a28d-6cbebf20ddc2"},
+	{id: "367197c5-32bd-479a-9102-c601145464c4", name: "ワンタッチ開閉傘", slug: "one-touch-umbrella", price: 3000, categoryId: "762bd1ea-9700-4bab-a28d-6cbebf20ddc2"},
+	{id: "657578d2-8820-4490-a6ec-06d9c7cccd0f", name: "金魚風呂敷", slug: "goldfish-furoshiki", price: 500, categoryId: "762bd1ea-9700-4bab-a28d-6cbebf20ddc2"},
+	{id: "8c107894-4ebc-445b-9603-c9e8e6524f9d", name: "折畳トートバッグ", slug: "foldable-tote-bag", price: 600, categoryId: "762bd1ea-9700-4bab-a28d-6cbebf20ddc2"},
+	{id: "2f8e074c-d0b1-441b-9dd4-6cf0ec570ce6", name: "アイマスク", slug: "eye-mask", price: 900, categoryId: "762bd1ea-9700-4bab-a28d-6cbebf20ddc2"},
+	{id: "2fb9fe48-3520-47ef-9e1a-338db7152884", name: "防水スプレー", slug: "waterproof-spray", price: 500, categoryId: "762bd1ea-9700-4bab-a28d-6cbebf20ddc2"},
+	{id: "f536311a-b9de-4873-a603-70953a2261be", name: "キーホルダ", slug: "key-holder", price: 800, categoryId: "762bd1ea-9700-4bab-a28d-6cbebf20ddc2"},
+	{id: "82014174-6785-4242-b307-a806fd1f8470", name: "ワイヤレスマウス", slug: "wireless-mouse", price: 900, categoryId: "c05b1952-3bdf-4449-9b83-d0d123a667ce", barcode: "4569951116179", nameEn: "Wireless Mouse", tagIds: []string{seedTagWireless}},
+	{id: "ddd1e5ae-fb90-4a47-bb87-c91b305c7444", name: "ワイヤレストラックボール", slug: "wireless-trackball", price: 1300, categoryId: "c05b1952-3bdf-4449-9b83-d0d123a667ce", nameEn: "Wireless Trackball", tagIds: []string{seedTagWireless}},
+	{id: "aa5e07aa-06f9-4037-9755-e1de3c0ad4ac", name: "有線光学式マウス", slug: "wired-optical-mouse", price: 500, categoryId: "c05b1952-3bdf-4449-9b83-d0d123a667ce"},
+	{id: "53cfa873-c86b-48bd-a68c-458d7bb5c844", name: "光学式ゲーミングマウス", slug: "optical-gaming-mouse", price: 4800, categoryId: "c05b1952-3bdf-4449-9b83-d0d123a667ce", tagIds: []string{seedTagGaming}},
+	{id: "376f7a75-cc99-4428-b35a-889bcb3c90af", name: "有線ゲーミングマウス", slug: "wired-gaming-mouse", price: 3800, categoryId: "c05b1952-3bdf-4449-9b83-d0d123a667ce", tagIds: []string{seedTagGaming}},
+	{id: "38c6e236-90ca-48a2-b427-acb9d834b591", name: "USB有線式キーボード", slug: "usb-wired-keyboard", price: 1400, categoryId: "c05b1952-3bdf-4449-9b83-d0d123a667ce"},
+	{id: "dc2e5a33-a2b7-4414-9a53-f9750e7da8ed", name: "無線式キーボード", slug: "wireless-keyboard", price: 1900, categoryId: "c05b1952-3bdf-4449-9b83-d0d123a667ce", nameEn: "Wireless Keyboard", tagIds: []string{seedTagWireless}},
+}
+
+var seedTags = map[string]string{
+	seedTagWireless: "ワイヤレス",
+	seedTagGaming:   "ゲーミング",
+}
+
+var seedVariants = []seedVariant{
+	{id: "5e0f2d4a-8f5b-4f0e-9a55-2f3c3f6a1b01", productId: "ac413f22-0cf1-490a-9635-7e9ca810e544", sku: "PEN-BLK-05", axis: "ボール径", value: "0.5mm"},
+	{id: "5e0f2d4a-8f5b-4f0e-9a55-2f3c3f6a1b02", productId: "ac413f22-0cf1-490a-9635-7e9ca810e544", sku: "PEN-BLK-07", axis: "ボール径", value: "0.7mm", priceOverride: 130},
+}
+
+var seedSchedules = []seedSchedule{
+	{
+		id: "5e0c2b7a-41d9-4f3e-9a68-0b1d2c3e4f51", productId: "dc7243af-c2ce-4136-bd5d-c6b28ee0a20a", price: 100, regularPrice: 130, status: "COMPLETED",
+		startsAt: time.Date(2020, 1, 3, 15, 0, 0, 0, time.UTC), endsAt: time.Date(2020, 1, 5, 15, 0, 0, 0, time.UTC),
+	},
+	{
+		id: "5e0c2b7a-41d9-4f3e-9a68-0b1d2c3e4f52", productId: "dc7243af-c2ce-4136-bd5d-c6b28ee0a20a", price: 110, status: "SCHEDULED",
+		startsAt: time.Date(2099, 1, 2, 15, 0, 0, 0, time.UTC), endsAt: time.Date(2099, 1, 4, 15, 0, 0, 0, time.UTC),
+	},
+}
+
+var seedProductSlugHistory = map[string]string{"waiyaresumausu": "82014174-6785-4242-b307-a806fd1f8470"}
+
+var seedCategorySlugHistory = map[string]string{"bunbogu": "b1524011-b6af-417e-8bf2-f449dd58b5c0"}
+
+// Seed はデータストアに初期データを登録します。
+// 初期データは db/command/init/create_record.sql と同じ内容で、全商品の在庫は100です。
+//
+// Parameters:
+//   - store: 初期データを登録するデータストア
+//
+// Returns:
+//   - error: 初期データが不正な場合や一意制約に違反する場合のエラー
+func Seed(store *Store) error {
+	tx, err := store.begin(context.Background())
+	if err != nil {
+		return err
+	}
+	t, err := store.tablesOf(tx)
+	if err == nil {
+		err = t.seed()
+	}
+	if completeErr := store.complete(tx, err == nil); completeErr != nil && err == nil {
+		err = completeErr
+	}
+	return err
+}
+
+// seed は初期データの行を追加します。
+func (t *tables) seed() error {
+	categoryById := map[string]*categories.Category{}
+	for _, seed := range seedCategories {
+		category, err := seed.build()
+		if err != nil {
+			return err
+		}
+		if err := t.insertCategory(category); err != nil {
+			return err
+		}
+		categoryById[seed.id] = category
+	}
+	for id, name := range seedTags {
+		tagId, err := tags.NewTagId(id)
+		if err != nil {
+			return err
+		}
+		tagName, err := tags.NewTagName(name)
+		if err != nil {
+			return err
+		}
+		tag, err := tags.BuildTag(tagId, tagName)
+		if err != nil {
+			return err
+		}
+		t.insertTagIfNotExists(tag)
+	}
+	for _, seed := range seedProducts {
+		product, err := seed.build(categoryById[seed.categoryId])
+		if err != nil {
+			return err
+		}
+		if err := t.insertProduct(product); err != nil {
+			return err
+		}
+		t.stocks[seed.id] = stockRecord{onHand: 100}
+		for _, tagId := range seed.tagIds {
+			if _, err := t.attachTag(seed.id, tagId); err != nil {
+				return err
+			}
+		}
+	}
+	for _, seed := range seedVariants {
+		variant, err := seed.build()
+		if err != nil {
+			return err
+		}
+		if err := t.insertVariant(seed.productId, variant); err != nil {
+			return err
+		}
+	}
+	for _, seed := range seedSchedules {
+		schedule, err := seed.build()
+		if err != nil {
+			return err
+		}
+		if err := t.insertSchedule(schedule); err != nil {
+			return err
+		}
+	}
+	for slug, id := range seedProductSlugHistory {
+		t.productSlugHistory[slug] = id
+	}
+	for slug, id := range seedCategorySlugHistory {
+		t.categorySlugHistory[slug] = id
+	}
+	return nil
+}
+
+func (s seedCategory) build() (*categories.Category, error) {
+	id, err := categories.NewCategoryId(s.id)
+	if err != nil {
+		return nil, err
+	}
+	name, err := categories.NewCategoryName(s.name)
+	if err != nil {
+		return nil, err
+	}
+	var parentId *categories.CategoryId
+	if s.parentId != "" {
+		if parentId, err = categories.NewCategoryId(s.parentId); err != nil {
+			return nil, err
+		}
+	}
+	category, err := categories.BuildCategory(id, name, parentId)
+	if err != nil {
+		return nil, err
+	}
+	slug, err := names.NewSlug(s.slug)
+	if err != nil {
+		return nil, err
+	}
+	category.ChangeSlug(slug)
+	if s.nameEn != "" {
+		nameEn, err := categories.NewCategoryName(s.nameEn)
+		if err != nil {
+			return nil, err
+		}
+		if err := category.ChangeTranslations(map[names.Locale]*categories.CategoryName{"en": nameEn}); err != nil {
+			return nil, err
+		}
+	}
+	return category, nil
+}
+
+func (s seedProduct) build(category *categories.Category) (*products.Product, error) {
+	id, err := products.NewProductId(s.id)
+	if err != nil {
+		return nil, err
+	}
+	name, err := products.NewProductName(s.name)
+	if err != nil {
+		return nil, err
+	}
+	price, err := products.NewProductPrice(s.price)
+	if err != nil {
+		return nil, err
+	}
+	product, err := products.BuildProduct(id, name, price, category, products.PRODUCT_PUBLISHED)
+	if err != nil {
+		return nil, err
+	}
+	slug, err := names.NewSlug(s.slug)
+	if err != nil {
+		return nil, err
+	}
+	product.ChangeSlug(slug)
+	if s.barcode != "" {
+		barcode, err := products.NewBarcode(s.barcode)
+		if err != nil {
+			return nil, err
+		}
+		product.ChangeBarcode(barcode)
+	}
+	if s.nameEn != "" {
+		nameEn, err := products.NewProductName(s.nameEn)
+		if err != nil {
+			return nil, err
+		}
+		if err := product.ChangeTranslations(map[names.Locale]*products.ProductName{"en": nameEn}); err != nil {
+			return nil, err
+		}
+	}
+	return product, nil
+}
+
+func (s seedVariant) build() (*products.Variant, error) {
+	id, err := products.NewVariantId(s.id)
+	if err != nil {
+		return nil, err
+	}
+	sku, err := products.NewSku(s.sku)
+	if err != nil {
+		return nil, err
+	}
+	option, err := products.NewVariantOption(s.axis, s.value)
+	if err != nil {
+		return nil, err
+	}
+	options, err := products.NewVariantOptions(option)
+	if err != nil {
+		return nil, err
+	}
+	var priceOverride *products.ProductPrice
+	if s.priceOverride != 0 {
+		if priceOverride, err = products.NewProductPrice(s.priceOverride); err != nil {
+			return nil, err
+		}
+	}
+	return products.BuildVariant(id, sku, options, priceOverride, products.VARIANT_ACTIVE), nil
+}
+
+func (s seedSchedule) build() (*pricing.PriceSchedule, error) {
+	id, err := pricing.NewPriceScheduleId(s.id)
+	if err != nil {
+		return nil, err
+	}
+	productId, err := products.NewProductId(s.productId)
+	if err != nil {
+		return nil, err
+	}
+	status, err := pricing.ParsePriceScheduleStatus(s.status)
+	if err != nil {
+		return nil, err
+	}
+	return pricing.BuildPriceSchedule(id, productId, s.price, s.regularPrice, status, s.startsAt, s.endsAt)
+}
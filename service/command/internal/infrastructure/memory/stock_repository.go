@@ -0,0 +1,285 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/stocks"
+)
+
+// StockRepositoryImpl は在庫リポジトリのインメモリ実装です。
+type StockRepositoryImpl struct {
+	store  *Store
+	logger *slog.Logger
+}
+
+// NewStockRepositoryImpl は新しいStockRepositoryImplインスタンスを生成します。
+//
+// Parameters:
+//   - store: データストア
+//   - logger: ロガー
+//
+// Returns:
+//   - *StockRepositoryImpl: StockRepositoryImplポインタ
+func NewStockRepositoryImpl(store *Store, logger *slog.Logger) *StockRepositoryImpl {
+	return &StockRepositoryImpl{store: store, logger: logger}
+}
+
+// LockByProductId は商品の在庫を取得します。
+// 在庫が未登録の場合は在庫数0の在庫を登録してから取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//
+// Returns:
+//   - *stocks.Stock: 在庫
+//   - error: 商品が存在しない場合のエラー
+func (r *StockRepositoryImpl) LockByProductId(ctx context.Context, tx *sql.Tx, productId *products.ProductId) (*stocks.Stock, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	record, ok := t.stocks[productId.Value()]
+	if !ok {
+		if _, exists := t.products[productId.Value()]; !exists {
+			return nil, foreignKeyViolation(fmt.Sprintf("商品番号: %s は存在しません。", productId.Value()))
+		}
+		t.stocks[productId.Value()] = record
+	}
+	return stocks.BuildStock(productId, record.onHand, record.reserved)
+}
+
+// Update は在庫数と引当済みの数量を更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - stock: 更新する在庫
+//
+// Returns:
+//   - error: トランザクションが不正な場合のエラー
+func (r *StockRepositoryImpl) Update(ctx context.Context, tx *sql.Tx, stock *stocks.Stock) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	if _, ok := t.stocks[stock.ProductId().Value()]; !ok {
+		return nil
+	}
+	t.stocks[stock.ProductId().Value()] = stockRecord{onHand: stock.OnHand(), reserved: stock.Reserved()}
+	r.logger.InfoContext(ctx, "在庫を変更しました。",
+		slog.String("product_id", stock.ProductId().Value()),
+		slog.Int("on_hand", int(stock.OnHand())),
+		slog.Int("reserved", int(stock.Reserved())),
+	)
+	return nil
+}
+
+// CreateReservation は在庫引当を登録します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - reservation: 登録する引当
+//
+// Returns:
+//   - error: 商品が存在しない場合のエラー
+func (r *StockRepositoryImpl) CreateReservation(ctx context.Context, tx *sql.Tx, reservation *stocks.Reservation) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	if _, ok := t.reservations[reservation.Id().Value()]; ok {
+		return errs.NewCRUDError("DB_UNIQUE_CONSTRAINT_VIOLATION", "一意制約違反です。")
+	}
+	if _, ok := t.products[reservation.ProductId().Value()]; !ok {
+		return foreignKeyViolation(fmt.Sprintf("商品番号: %s は存在しません。", reservation.ProductId().Value()))
+	}
+	t.reservations[reservation.Id().Value()] = reservationRecord{
+		seq:       t.nextSeq(),
+		id:        reservation.Id().Value(),
+		productId: reservation.ProductId().Value(),
+		quantity:  reservation.Quantity().Value(),
+		status:    string(reservation.Status()),
+		expiresAt: reservation.ExpiresAt(),
+	}
+	r.logger.InfoContext(ctx, "在庫を引き当てました。",
+		slog.String("obj_id", reservation.Id().Value()),
+		slog.String("product_id", reservation.ProductId().Value()),
+		slog.Int("quantity", int(reservation.Quantity().Value())),
+		slog.Time("expires_at", reservation.ExpiresAt()),
+	)
+	return nil
+}
+
+// FindReservationById は指定されたIDの引当を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 引当ID
+//
+// Returns:
+//   - *stocks.Reservation: 引当
+//   - error: 引当が存在しない場合はNOT_FOUNDエラー
+func (r *StockRepositoryImpl) FindReservationById(ctx context.Context, tx *sql.Tx, id *stocks.ReservationId) (*stocks.Reservation, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	record, ok := t.reservations[id.Value()]
+	if !ok {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("引当番号: %s は存在しません。", id.Value()))
+	}
+	return record.toReservation()
+}
+
+// LockReservationById は指定されたIDの引当を取得します。
+// トランザクションは直列に実行されるため、FindReservationByIdと同じです。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 引当ID
+//
+// Returns:
+//   - *stocks.Reservation: 引当
+//   - error: 引当が存在しない場合はNOT_FOUNDエラー
+func (r *StockRepositoryImpl) LockReservationById(ctx context.Context, tx *sql.Tx, id *stocks.ReservationId) (*stocks.Reservation, error) {
+	return r.FindReservationById(ctx, tx, id)
+}
+
+// FindExpiredReservations は商品の引当のうち、有効期限を過ぎた引当中の引当を登録順に取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//   - now: 現在時刻
+//
+// Returns:
+//   - []*stocks.Reservation: 期限切れの引当
+//   - error: トランザクションが不正な場合のエラー
+func (r *StockRepositoryImpl) FindExpiredReservations(ctx context.Context, tx *sql.Tx, productId *products.ProductId, now time.Time) ([]*stocks.Reservation, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	records := t.expiredReservations(now)
+	reservations := make([]*stocks.Reservation, 0, len(records))
+	for _, record := range records {
+		if record.productId != productId.Value() {
+			continue
+		}
+		reservation, err := record.toReservation()
+		if err != nil {
+			return nil, err
+		}
+		reservations = append(reservations, reservation)
+	}
+	return reservations, nil
+}
+
+// FindProductIdsWithExpiredReservations は期限切れの引当中の引当がある商品のIDを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - now: 現在時刻
+//   - limit: 取得する商品数の上限
+//
+// Returns:
+//   - []*products.ProductId: 商品ID
+//   - error: トランザクションが不正な場合のエラー
+func (r *StockRepositoryImpl) FindProductIdsWithExpiredReservations(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]*products.ProductId, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	productIds := []*products.ProductId{}
+	seen := map[string]bool{}
+	for _, record := range t.expiredReservations(now) {
+		if seen[record.productId] || len(productIds) >= limit {
+			continue
+		}
+		seen[record.productId] = true
+		productId, err := products.NewProductId(record.productId)
+		if err != nil {
+			return nil, err
+		}
+		productIds = append(productIds, productId)
+	}
+	return productIds, nil
+}
+
+// UpdateReservationStatus は引当の状態を更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - reservation: 更新する引当
+//
+// Returns:
+//   - error: 引当が存在しない場合はNOT_FOUNDエラー
+func (r *StockRepositoryImpl) UpdateReservationStatus(ctx context.Context, tx *sql.Tx, reservation *stocks.Reservation) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	record, ok := t.reservations[reservation.Id().Value()]
+	if !ok {
+		return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("引当番号: %s は存在しないため、更新できませんでした。", reservation.Id().Value()))
+	}
+	record.status = string(reservation.Status())
+	t.reservations[record.id] = record
+	r.logger.InfoContext(ctx, "在庫引当を変更しました。",
+		slog.String("obj_id", record.id),
+		slog.String("product_id", record.productId),
+		slog.String("status", record.status),
+	)
+	return nil
+}
+
+// expiredReservations は有効期限を過ぎた引当中の引当を登録順に返します。
+func (t *tables) expiredReservations(now time.Time) []reservationRecord {
+	records := []reservationRecord{}
+	for _, record := range t.reservations {
+		if record.status == string(stocks.RESERVATION_RESERVED) && !record.expiresAt.After(now) {
+			records = append(records, record)
+		}
+	}
+	slices.SortFunc(records, func(a, b reservationRecord) int { return cmp.Compare(a.seq, b.seq) })
+	return records
+}
+
+// toReservation は引当の行から引当を再構築します。
+func (r reservationRecord) toReservation() (*stocks.Reservation, error) {
+	id, err := stocks.NewReservationId(r.id)
+	if err != nil {
+		return nil, err
+	}
+	productId, err := products.NewProductId(r.productId)
+	if err != nil {
+		return nil, err
+	}
+	quantity, err := stocks.NewQuantity(r.quantity)
+	if err != nil {
+		return nil, err
+	}
+	status, err := stocks.ParseReservationStatus(r.status)
+	if err != nil {
+		return nil, err
+	}
+	return stocks.BuildReservation(id, productId, quantity, status, r.expiresAt)
+}
+
+var _ stocks.StockRepository = (*StockRepositoryImpl)(nil)
@@ -0,0 +1,236 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+)
+
+// Store はインメモリのリポジトリが共有するデータストアです。
+// コミット済みのテーブルと、実行中のトランザクションの作業用のテーブルを保持します。
+//
+// トランザクションは開始時にコミット済みのテーブルを複製し、コミット時に作業用のテーブルで置き換えます。
+// ロールバック時は作業用のテーブルを破棄するため、トランザクション中の変更はすべて取り消されます。
+// トランザクションは1つずつ直列に実行されるため、MySQLの行ロック(SELECT ... FOR UPDATE)と同等以上の分離が保証されます。
+type Store struct {
+	sem       chan struct{} // 実行中のトランザクションを1つに制限するセマフォ
+	mu        sync.Mutex    // committedとworkingを保護する
+	committed *tables
+	working   map[*sql.Tx]*tables
+}
+
+// NewStore は空のStoreを生成します。
+//
+// Returns:
+//   - *Store: Storeポインタ
+func NewStore() *Store {
+	return &Store{
+		sem:       make(chan struct{}, 1),
+		committed: newTables(),
+		working:   map[*sql.Tx]*tables{},
+	}
+}
+
+// begin はトランザクションを開始し、作業用のテーブルを用意します。
+// 他のトランザクションが実行中の場合は完了するまで待機します。
+// 返す*sql.Txはトランザクションを識別するためだけに使用し、メソッドを呼び出してはいけません。
+func (s *Store) begin(ctx context.Context) (*sql.Tx, error) {
+	select {
+	case s.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, errs.NewInternalErrorWithCause("DB_CONNECTION_ERROR", "トランザクションを開始できませんでした", ctx.Err())
+	}
+	tx := new(sql.Tx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.working[tx] = s.committed.clone()
+	return tx, nil
+}
+
+// complete はトランザクションを完了します。
+// commitがtrueの場合は作業用のテーブルをコミットし、falseの場合は破棄します。
+func (s *Store) complete(tx *sql.Tx, commit bool) error {
+	s.mu.Lock()
+	t, ok := s.working[tx]
+	if !ok {
+		s.mu.Unlock()
+		return errs.NewInternalErrorWithCause("DB_DRIVER_ERROR", "トランザクションは既に完了しています", sql.ErrTxDone)
+	}
+	delete(s.working, tx)
+	if commit {
+		s.committed = t
+	}
+	s.mu.Unlock()
+	<-s.sem
+	return nil
+}
+
+// tablesOf はトランザクションの作業用のテーブルを返します。
+func (s *Store) tablesOf(tx *sql.Tx) (*tables, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.working[tx]
+	if !ok {
+		return nil, errs.NewInternalErrorWithCause("DB_DRIVER_ERROR", "トランザクションが開始されていないか、既に完了しています", sql.ErrTxDone)
+	}
+	return t, nil
+}
+
+// categoryRecord はcategoryテーブルとcategory_name_translationテーブルの行です。
+// テーブルの複製を浅いコピーで済ませるため、保存後の値は変更せずに置き換えます。
+type categoryRecord struct {
+	seq          int // 登録順（AUTO_INCREMENTの主キーに相当）
+	id           *categories.CategoryId
+	name         *categories.CategoryName
+	parentId     *categories.CategoryId
+	slug         names.Slug
+	translations map[names.Locale]*categories.CategoryName
+}
+
+// productRecord はproductテーブルとproduct_name_translationテーブルの行です。
+type productRecord struct {
+	seq          int
+	id           *products.ProductId
+	name         *products.ProductName
+	price        *products.ProductPrice
+	categoryId   *categories.CategoryId
+	status       products.ProductStatus
+	barcode      *products.Barcode
+	slug         names.Slug
+	translations map[names.Locale]*products.ProductName
+}
+
+// variantRecord はproduct_variantテーブルの行です。バリエーションは不変のため、そのまま保持します。
+type variantRecord struct {
+	seq       int
+	productId string
+	variant   *products.Variant
+}
+
+// stockRecord はstockテーブルの行です。
+type stockRecord struct {
+	onHand   uint32
+	reserved uint32
+}
+
+// reservationRecord はstock_reservationテーブルの行です。
+type reservationRecord struct {
+	seq       int
+	id        string
+	productId string
+	quantity  uint32
+	status    string
+	expiresAt time.Time
+}
+
+// tagRecord はtagテーブルの行です。
+type tagRecord struct {
+	seq  int
+	id   string
+	name string
+	key  string
+}
+
+// productTagKey はproduct_tagテーブルの主キーです。
+type productTagKey struct {
+	productId string
+	tagId     string
+}
+
+// scheduleRecord はproduct_price_scheduleテーブルの行です。
+type scheduleRecord struct {
+	seq          int
+	id           string
+	productId    string
+	price        uint32
+	regularPrice uint32
+	status       string
+	startsAt     time.Time
+	endsAt       time.Time
+}
+
+// tables はインメモリのテーブル群です。キーはobj_id（在庫は商品ID）です。
+type tables struct {
+	seq                 int
+	categories          map[string]categoryRecord
+	categorySlugHistory map[string]string // 変更前のスラッグ → カテゴリID
+	products            map[string]productRecord
+	productSlugHistory  map[string]string // 変更前のスラッグ → 商品ID
+	variants            map[string]variantRecord
+	stocks              map[string]stockRecord
+	reservations        map[string]reservationRecord
+	tags                map[string]tagRecord
+	productTags         map[productTagKey]struct{}
+	schedules           map[string]scheduleRecord
+}
+
+func newTables() *tables {
+	return &tables{
+		categories:          map[string]categoryRecord{},
+		categorySlugHistory: map[string]string{},
+		products:            map[string]productRecord{},
+		productSlugHistory:  map[string]string{},
+		variants:            map[string]variantRecord{},
+		stocks:              map[string]stockRecord{},
+		reservations:        map[string]reservationRecord{},
+		tags:                map[string]tagRecord{},
+		productTags:         map[productTagKey]struct{}{},
+		schedules:           map[string]scheduleRecord{},
+	}
+}
+
+// clone はテーブル群を複製します。行は値として保持しているため、マップの浅いコピーで独立した複製になります。
+func (t *tables) clone() *tables {
+	return &tables{
+		seq:                 t.seq,
+		categories:          maps.Clone(t.categories),
+		categorySlugHistory: maps.Clone(t.categorySlugHistory),
+		products:            maps.Clone(t.products),
+		productSlugHistory:  maps.Clone(t.productSlugHistory),
+		variants:            maps.Clone(t.variants),
+		stocks:              maps.Clone(t.stocks),
+		reservations:        maps.Clone(t.reservations),
+		tags:                maps.Clone(t.tags),
+		productTags:         maps.Clone(t.productTags),
+		schedules:           maps.Clone(t.schedules),
+	}
+}
+
+// nextSeq は登録順を採番します。
+func (t *tables) nextSeq() int {
+	t.seq++
+	return t.seq
+}
+
+// toCategory はカテゴリの行からカテゴリエンティティを再構築します。
+func (r categoryRecord) toCategory(withTranslations bool) (*categories.Category, error) {
+	category, err := categories.BuildCategory(r.id, r.name, r.parentId)
+	if err != nil {
+		return nil, err
+	}
+	category.ChangeSlug(r.slug)
+	if withTranslations {
+		if err := category.ChangeTranslations(r.translations); err != nil {
+			return nil, err
+		}
+	}
+	return category, nil
+}
+
+// alreadyExists は一意制約違反のエラーを生成します。メッセージはMySQLの実装と同じです。
+func alreadyExists(target string) error {
+	return errs.NewCRUDError("ALREADY_EXISTS", "同じ"+target+"が既に登録されています。")
+}
+
+// foreignKeyViolation は外部キー制約違反のエラーを生成します。
+// データベースの実装（handler.DBErrHandler）と同じエラーコードを返し、同じ失敗を同じステータスで応答します。
+func foreignKeyViolation(message string) error {
+	return errs.NewCRUDError("DB_FOREIGN_KEY_VIOLATION", message)
+}
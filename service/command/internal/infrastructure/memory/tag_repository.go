@@ -0,0 +1,219 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/tags"
+)
+
+// TagRepositoryImpl はタグリポジトリのインメモリ実装です。
+type TagRepositoryImpl struct {
+	store  *Store
+	logger *slog.Logger
+}
+
+// NewTagRepositoryImpl は新しいTagRepositoryImplインスタンスを生成します。
+//
+// Parameters:
+//   - store: データストア
+//   - logger: ロガー
+//
+// Returns:
+//   - *TagRepositoryImpl: TagRepositoryImplポインタ
+func NewTagRepositoryImpl(store *Store, logger *slog.Logger) *TagRepositoryImpl {
+	return &TagRepositoryImpl{store: store, logger: logger}
+}
+
+// CreateIfNotExists はタグを登録します。同じ正規化キーのタグが登録済みの場合は何もしません。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - tagList: 登録するタグ
+//
+// Returns:
+//   - error: トランザクションが不正な場合のエラー
+func (r *TagRepositoryImpl) CreateIfNotExists(ctx context.Context, tx *sql.Tx, tagList []*tags.Tag) error {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return err
+	}
+	for _, tag := range tagList {
+		t.insertTagIfNotExists(tag)
+	}
+	return nil
+}
+
+// LockByNames は指定された名前の正規化キーと一致するタグを登録順に取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - names: タグ名
+//
+// Returns:
+//   - []*tags.Tag: 見つかったタグ
+//   - error: トランザクションが不正な場合のエラー
+func (r *TagRepositoryImpl) LockByNames(ctx context.Context, tx *sql.Tx, names []*tags.TagName) ([]*tags.Tag, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, name.Key())
+	}
+	records := []tagRecord{}
+	for _, record := range t.tags {
+		if slices.Contains(keys, record.key) {
+			records = append(records, record)
+		}
+	}
+	slices.SortFunc(records, func(a, b tagRecord) int { return cmp.Compare(a.seq, b.seq) })
+	tagList := make([]*tags.Tag, 0, len(records))
+	for _, record := range records {
+		tag, err := record.toTag()
+		if err != nil {
+			return nil, err
+		}
+		tagList = append(tagList, tag)
+	}
+	return tagList, nil
+}
+
+// AttachToProducts は商品にタグを付与します。付与済みの組み合わせは無視します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productIds: 商品ID
+//   - tagIds: タグID
+//
+// Returns:
+//   - int64: 新たに付与した件数
+//   - error: 商品やタグが存在しない場合のエラー
+func (r *TagRepositoryImpl) AttachToProducts(ctx context.Context, tx *sql.Tx, productIds []*products.ProductId, tagIds []*tags.TagId) (int64, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return 0, err
+	}
+	var attached int64
+	for _, productId := range productIds {
+		for _, tagId := range tagIds {
+			added, err := t.attachTag(productId.Value(), tagId.Value())
+			if err != nil {
+				r.logger.ErrorContext(ctx, "Failed to attach tags", slog.Any("error", err))
+				return 0, err
+			}
+			if added {
+				attached++
+			}
+		}
+	}
+	r.logger.InfoContext(ctx, "商品にタグを付与しました。", slog.Int64("attached", attached))
+	return attached, nil
+}
+
+// DetachFromProducts は商品からタグを外します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productIds: 商品ID
+//   - tagIds: タグID
+//
+// Returns:
+//   - int64: 外した件数
+//   - error: トランザクションが不正な場合のエラー
+func (r *TagRepositoryImpl) DetachFromProducts(ctx context.Context, tx *sql.Tx, productIds []*products.ProductId, tagIds []*tags.TagId) (int64, error) {
+	t, err := r.store.tablesOf(tx)
+	if err != nil {
+		return 0, err
+	}
+	var detached int64
+	for _, productId := range productIds {
+		for _, tagId := range tagIds {
+			key := productTagKey{productId: productId.Value(), tagId: tagId.Value()}
+			if _, ok := t.productTags[key]; ok {
+				delete(t.productTags, key)
+				detached++
+			}
+		}
+	}
+	r.logger.InfoContext(ctx, "商品からタグを外しました。", slog.Int64("detached", detached))
+	return detached, nil
+}
+
+// insertTagIfNotExists は同じIDまたは正規化キーのタグが存在しない場合にタグの行を追加します。
+func (t *tables) insertTagIfNotExists(tag *tags.Tag) {
+	if _, ok := t.tags[tag.Id().Value()]; ok {
+		return
+	}
+	for _, record := range t.tags {
+		if record.key == tag.Name().Key() {
+			return
+		}
+	}
+	t.tags[tag.Id().Value()] = tagRecord{
+		seq:  t.nextSeq(),
+		id:   tag.Id().Value(),
+		name: tag.Name().Value(),
+		key:  tag.Name().Key(),
+	}
+}
+
+// attachTag は商品とタグの関連の行を追加します。追加した場合はtrueを返します。
+func (t *tables) attachTag(productId string, tagId string) (bool, error) {
+	if _, ok := t.products[productId]; !ok {
+		return false, foreignKeyViolation(fmt.Sprintf("商品番号: %s は存在しません。", productId))
+	}
+	if _, ok := t.tags[tagId]; !ok {
+		return false, foreignKeyViolation(fmt.Sprintf("タグ番号: %s は存在しません。", tagId))
+	}
+	key := productTagKey{productId: productId, tagId: tagId}
+	if _, ok := t.productTags[key]; ok {
+		return false, nil
+	}
+	t.productTags[key] = struct{}{}
+	return true, nil
+}
+
+// hasAllTags は商品に指定された正規化キーのタグがすべて付与されているかどうかを返します。
+func (t *tables) hasAllTags(productId string, tagKeys []string) bool {
+	for _, key := range tagKeys {
+		found := false
+		for _, record := range t.tags {
+			if record.key != key {
+				continue
+			}
+			if _, ok := t.productTags[productTagKey{productId: productId, tagId: record.id}]; ok {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// toTag はタグの行からタグを再構築します。
+func (r tagRecord) toTag() (*tags.Tag, error) {
+	id, err := tags.NewTagId(r.id)
+	if err != nil {
+		return nil, err
+	}
+	name, err := tags.NewTagName(r.name)
+	if err != nil {
+		return nil, err
+	}
+	return tags.BuildTag(id, name)
+}
+
+var _ tags.TagRepository = (*TagRepositoryImpl)(nil)
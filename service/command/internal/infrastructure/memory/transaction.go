@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
+)
+
+// TransactionManagerImpl はトランザクションマネージャーのインメモリ実装です。
+type TransactionManagerImpl struct {
+	store  *Store
+	logger *slog.Logger
+}
+
+// NewTransactionManagerImpl は新しいTransactionManagerImplインスタンスを生成します。
+//
+// Parameters:
+//   - store: データストア
+//   - logger: ロガー
+//
+// Returns:
+//   - *TransactionManagerImpl: TransactionManagerImplポインタ
+func NewTransactionManagerImpl(store *Store, logger *slog.Logger) *TransactionManagerImpl {
+	return &TransactionManagerImpl{store: store, logger: logger}
+}
+
+// Begin は新しいトランザクションを開始します。
+// 他のトランザクションが実行中の場合は、完了するかコンテキストがキャンセルされるまで待機します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - *sql.Tx: トランザクションを識別するハンドル（メソッドは呼び出せません）
+//   - error: コンテキストがキャンセルされた場合のエラー
+func (tm *TransactionManagerImpl) Begin(ctx context.Context) (*sql.Tx, error) {
+	return tm.store.begin(ctx)
+}
+
+// Complete はトランザクションを完了します。
+// errがnilの場合はコミットし、それ以外の場合はトランザクション中の変更をすべて取り消します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: 完了するトランザクション
+//   - err: 処理中に発生したエラー
+//
+// Returns:
+//   - error: トランザクションが既に完了している場合のエラー
+func (tm *TransactionManagerImpl) Complete(ctx context.Context, tx *sql.Tx, err error) error {
+	if err != nil {
+		if rbErr := tm.store.complete(tx, false); rbErr != nil {
+			return rbErr
+		}
+		tm.logger.WarnContext(ctx, "トランザクションをロールバックしました")
+		return nil
+	}
+	if cmErr := tm.store.complete(tx, true); cmErr != nil {
+		return cmErr
+	}
+	tm.logger.InfoContext(ctx, "トランザクションをコミットしました")
+	return nil
+}
+
+var _ service.TransactionManager = (*TransactionManagerImpl)(nil)
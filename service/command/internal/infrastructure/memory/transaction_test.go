@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TransactionManagerImpl", func() {
+	var (
+		ctx  context.Context
+		tm   *TransactionManagerImpl
+		repo *CategoryRepositoryImpl
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		store := NewStore()
+		tm = NewTransactionManagerImpl(store, logger)
+		repo = NewCategoryRepositoryImpl(store, logger)
+	})
+
+	// addCategory はトランザクション内でカテゴリを登録し、エラーを渡してトランザクションを完了します。
+	addCategory := func(name string, completeErr error) *categories.Category {
+		categoryName, err := categories.NewCategoryName(name)
+		Expect(err).NotTo(HaveOccurred())
+		category, err := categories.NewCategory(categoryName)
+		Expect(err).NotTo(HaveOccurred())
+
+		tx, err := tm.Begin(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repo.Create(ctx, tx, category)).To(Succeed())
+		Expect(tm.Complete(ctx, tx, completeErr)).To(Succeed())
+		return category
+	}
+
+	exists := func(category *categories.Category) bool {
+		tx, err := tm.Begin(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { Expect(tm.Complete(ctx, tx, nil)).To(Succeed()) }()
+		found, err := repo.ExistsByName(ctx, tx, category.Name())
+		Expect(err).NotTo(HaveOccurred())
+		return found
+	}
+
+	It("エラーがnilの場合は変更をコミットすること", func() {
+		category := addCategory("コミットするカテゴリ", nil)
+		Expect(exists(category)).To(BeTrue(), "コミットした変更が見つかりません")
+	})
+
+	It("エラーが渡された場合は変更をロールバックすること", func() {
+		category := addCategory("ロールバックするカテゴリ", errors.New("何らかのエラー"))
+		Expect(exists(category)).To(BeFalse(), "ロールバックした変更が残っています")
+	})
+
+	It("完了したトランザクションは使用できないこと", func() {
+		tx, err := tm.Begin(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tm.Complete(ctx, tx, nil)).To(Succeed())
+
+		_, err = repo.ExistsByParentId(ctx, tx, nil)
+		Expect(err).To(BeAssignableToTypeOf(&errs.InternalError{}))
+		Expect(tm.Complete(ctx, tx, nil)).NotTo(Succeed(), "完了済みのトランザクションを再度完了できてしまいました")
+	})
+
+	It("実行中のトランザクションがある場合は完了するまで開始を待機すること", func() {
+		tx, err := tm.Begin(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err = tm.Begin(waitCtx)
+		Expect(err).To(BeAssignableToTypeOf(&errs.InternalError{}), "実行中のトランザクションと並行して開始できてしまいました")
+
+		Expect(tm.Complete(ctx, tx, nil)).To(Succeed())
+		next, err := tm.Begin(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tm.Complete(ctx, next, nil)).To(Succeed())
+	})
+})
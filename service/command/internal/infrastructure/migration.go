@@ -0,0 +1,105 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"io/fs"
+	"log/slog"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/db/migrations"
+	"github.com/haru-256/practical-go-grpc-micro-service/db/migrations/postgres"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/migrate"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/config"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/handler"
+	"go.uber.org/fx"
+)
+
+// RunMigrate はmigrateサブコマンドを実行します。
+// 設定ファイルのデータベースに接続し、ドライバに対応する db/migrations（PostgreSQLの場合は db/migrations/postgres）のマイグレーションを適用・取り消し、または適用状況を出力します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - configPath: 設定ファイルのディレクトリパス
+//   - configName: 設定ファイル名（拡張子なし）
+//   - args: サブコマンド以降の引数（例: ["up"]、["down", "1"]、["status"]）
+//   - w: 結果の出力先
+//
+// Returns:
+//   - error: 引数が不正な場合、または設定の読み込み、DB接続、マイグレーションの実行に失敗した場合のエラー
+func RunMigrate(ctx context.Context, configPath string, configName string, args []string, w io.Writer) error {
+	cmd, err := migrate.ParseArgs(args)
+	if err != nil {
+		return err
+	}
+	v := config.NewViper(configPath, configName)
+	logger, err := log.NewLogger(v)
+	if err != nil {
+		return err
+	}
+	cfg, err := config.NewMigrationConfig(v)
+	if err != nil {
+		return err
+	}
+	dbConfig, err := handler.NewDBConfig(v)
+	if err != nil {
+		return err
+	}
+	db, err := handler.NewDatabase(dbConfig)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	migrator, err := newMigrator(db, dbConfig.Driver, cfg, logger)
+	if err != nil {
+		return err
+	}
+	return migrate.Run(ctx, migrator, cmd, w)
+}
+
+// newMigrator はドライバに対応するマイグレーションを読み込みMigratorを生成します。
+// MySQLの場合は db/migrations、PostgreSQLの場合は db/migrations/postgres を使用します。
+//
+// Parameters:
+//   - db: データベース接続
+//   - driver: データベースのドライバ（handler.DRIVER_MYSQL / handler.DRIVER_POSTGRES）
+//   - cfg: マイグレーションの設定
+//   - logger: ロガー
+//
+// Returns:
+//   - *migrate.Migrator: Migratorポインタ
+//   - error: マイグレーションファイルの読み込みに失敗した場合のエラー
+func newMigrator(db *sql.DB, driver string, cfg *config.MigrationConfig, logger *slog.Logger) (*migrate.Migrator, error) {
+	fsys, dialect := fs.FS(migrations.FS), migrate.MySQL
+	if driver == handler.DRIVER_POSTGRES {
+		fsys, dialect = postgres.FS, migrate.Postgres
+	}
+	loaded, err := migrate.Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return migrate.NewMigrator(db, dialect, loaded, cfg.LockTimeout, logger), nil
+}
+
+// registerMigrationHook は起動時に未適用のマイグレーションを適用するフックを登録します。
+// リポジトリを使用するコンポーネントのフックより先に実行されるように、DB接続の直後に登録します。
+//
+// Parameters:
+//   - lc: Fxライフサイクル
+//   - migrator: Migrator
+//   - logger: ロガー
+func registerMigrationHook(lc fx.Lifecycle, migrator *migrate.Migrator, logger *slog.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			applied, err := migrator.Up(ctx)
+			if err != nil {
+				logger.ErrorContext(ctx, "Failed to apply migrations", slog.Any("error", err))
+				return err
+			}
+			logger.InfoContext(ctx, "Migrations applied", slog.Int("applied", applied))
+			return nil
+		},
+	})
+}
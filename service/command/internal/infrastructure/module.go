@@ -8,21 +8,35 @@ import (
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/pricing"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/stocks"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/tags"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/config"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/memory"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/handler"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/repository"
+	"github.com/spf13/viper"
 	"go.uber.org/fx"
 )
 
-// Module はSQLBoilerを使用したインフラストラクチャ層のFxモジュールです。
+// Module はインフラストラクチャ層のFxモジュールです。
+// リポジトリの実装は設定（repository.backend）により、SQLBoiler（db。MySQLまたはPostgreSQL）とインメモリ（memory）から選択します。
 // このモジュールは以下を提供します:
-//   - データベース設定の読み込み（NewDBConfig）
-//   - データベース接続の確立（NewDatabase）
-//   - カテゴリリポジトリの実装（NewCategoryRepositoryImpl → categories.CategoryRepository）
-//   - 商品リポジトリの実装（NewProductRepositoryImpl → products.ProductRepository）
-//   - トランザクションマネージャーの実装（NewTransactionManagerImpl → service.TransactionManager）
-//   - アプリケーション停止時のDB接続クローズ処理
+//   - リポジトリの実装の設定の読み込み（NewRepositoryConfig）
+//   - カテゴリリポジトリの実装（→ categories.CategoryRepository）
+//   - 商品リポジトリの実装（→ products.ProductRepository）
+//   - 在庫リポジトリの実装（→ stocks.StockRepository）
+//   - タグリポジトリの実装（→ tags.TagRepository）
+//   - 価格スケジュールリポジトリの実装（→ pricing.PriceScheduleRepository）
+//   - トランザクションマネージャーの実装（→ service.TransactionManager）
+//   - データベース接続（dbの場合のみ。停止時にクローズします）
+//   - スキーマのマイグレーションの設定（NewMigrationConfig。dbでmigration.autoが有効な場合は起動時に適用します）
+//   - 在庫引当の設定と有効期限ポリシー（NewInventoryConfig, newReservationPolicy）
+//   - 価格スケジュールの設定（NewPricingConfig）
+//   - 名前の正規化設定の適用（NewNormalizationConfig）
+//   - 実行時に変更できるログレベルとロガー、管理用エンドポイント（NewLevels, NewLoggerWithLevels, NewAdminHandler）
 var Module = fx.Module(
 	"infrastructure",
 	fx.Provide(
@@ -30,25 +44,122 @@ var Module = fx.Module(
 			config.NewViper,
 			fx.ParamTags(`name:"configPath"`, `name:"configName"`),
 		),
-		handler.NewDBConfig,
-		handler.NewDatabase,
-		log.NewLogger,
-		fx.Annotate(
-			repository.NewCategoryRepositoryImpl,
-			fx.As(new(categories.CategoryRepository)),
-		),
-		fx.Annotate(
-			repository.NewProductRepositoryImpl,
-			fx.As(new(products.ProductRepository)),
-		),
-		fx.Annotate(
-			repository.NewTransactionManagerImpl,
-			fx.As(new(service.TransactionManager)),
-		),
+		config.NewRepositoryConfig,
+		config.NewMigrationConfig,
+		config.NewNormalizationConfig,
+		config.NewInventoryConfig,
+		newReservationPolicy,
+		config.NewPricingConfig,
+		log.NewLevels,
+		log.NewLoggerWithLevels,
+		log.NewAdminConfig,
+		log.NewAdminHandler,
+		newRepositories,
 	),
-	fx.Invoke(registerLifecycleHooks),
+	fx.Invoke(applyNormalizationConfig),
+	// 設定ファイルの変更時にログレベルを読み込み直す
+	fx.Invoke(log.WatchConfig),
 )
 
+// applyNormalizationConfig は名前の正規化設定をドメイン層に適用します。
+// 正規化キーは永続化されるため、リクエストを受け付ける前に設定する必要があります。
+//
+// Parameters:
+//   - cfg: 正規化設定
+//   - logger: ロガー
+func applyNormalizationConfig(cfg *config.NormalizationConfig, logger *slog.Logger) {
+	names.SetKanaFolding(cfg.FoldKana)
+	logger.Info("Name normalization configured", slog.Bool("fold_kana", cfg.FoldKana))
+}
+
+// newReservationPolicy は在庫引当の設定から有効期限ポリシーを生成します。
+//
+// Parameters:
+//   - cfg: 在庫引当の設定
+//
+// Returns:
+//   - *stocks.ReservationPolicy: 引当の有効期限ポリシー
+//   - error: 設定値が不正な場合のエラー
+func newReservationPolicy(cfg *config.InventoryConfig) (*stocks.ReservationPolicy, error) {
+	return stocks.NewReservationPolicy(cfg.ReservationTTL, cfg.MaxReservationTTL)
+}
+
+// repositories は選択した実装のリポジトリとトランザクションマネージャーです。
+type repositories struct {
+	fx.Out
+
+	CategoryRepository      categories.CategoryRepository
+	ProductRepository       products.ProductRepository
+	StockRepository         stocks.StockRepository
+	TagRepository           tags.TagRepository
+	PriceScheduleRepository pricing.PriceScheduleRepository
+	TransactionManager      service.TransactionManager
+	DB                      *sql.DB // データベース接続（memoryの場合はnil）
+}
+
+// newRepositories は設定で選択した実装のリポジトリを生成します。
+// dbの場合は[db]のドライバのデータベースに接続し、アプリケーション停止時に接続をクローズするフックを登録します。
+// migration.autoが有効な場合は、起動時に未適用のマイグレーションを適用するフックも登録します。
+// memoryの場合はプロセス内のデータストアを使用し、設定に応じてサンプルデータを投入します。
+//
+// Parameters:
+//   - lc: Fxライフサイクル
+//   - cfg: リポジトリの実装の設定
+//   - migrationCfg: マイグレーションの設定
+//   - v: Viperインスタンス（dbの接続設定の読み込みに使用）
+//   - logger: ロガー
+//   - levels: 実行時に変更できるログレベル（SQLBoilerのデバッグモードを追従させる）
+//
+// Returns:
+//   - repositories: リポジトリとトランザクションマネージャー
+//   - error: 接続設定の読み込み、DB接続、マイグレーションファイルの読み込み、またはサンプルデータの投入に失敗した場合のエラー
+func newRepositories(lc fx.Lifecycle, cfg *config.RepositoryConfig, migrationCfg *config.MigrationConfig, v *viper.Viper, logger *slog.Logger, levels *log.Levels) (repositories, error) {
+	if cfg.Backend == config.REPOSITORY_BACKEND_MEMORY {
+		store := memory.NewStore()
+		if cfg.Seed {
+			if err := memory.Seed(store); err != nil {
+				return repositories{}, err
+			}
+		}
+		logger.Info("Using in-memory repositories", slog.Bool("seed", cfg.Seed))
+		return repositories{
+			CategoryRepository:      memory.NewCategoryRepositoryImpl(store, logger),
+			ProductRepository:       memory.NewProductRepositoryImpl(store, logger),
+			StockRepository:         memory.NewStockRepositoryImpl(store, logger),
+			TagRepository:           memory.NewTagRepositoryImpl(store, logger),
+			PriceScheduleRepository: memory.NewPriceScheduleRepositoryImpl(store, logger),
+			TransactionManager:      memory.NewTransactionManagerImpl(store, logger),
+		}, nil
+	}
+
+	dbConfig, err := handler.NewDBConfig(v)
+	if err != nil {
+		return repositories{}, err
+	}
+	db, err := handler.NewDatabase(dbConfig)
+	if err != nil {
+		return repositories{}, err
+	}
+	handler.FollowLogLevel(levels)
+	registerLifecycleHooks(lc, db, logger)
+	if migrationCfg.Auto {
+		migrator, err := newMigrator(db, dbConfig.Driver, migrationCfg, logger)
+		if err != nil {
+			return repositories{}, err
+		}
+		registerMigrationHook(lc, migrator, logger)
+	}
+	return repositories{
+		CategoryRepository:      repository.NewCategoryRepositoryImpl(logger),
+		ProductRepository:       repository.NewProductRepositoryImpl(logger),
+		StockRepository:         repository.NewStockRepositoryImpl(logger),
+		TagRepository:           repository.NewTagRepositoryImpl(logger),
+		PriceScheduleRepository: repository.NewPriceScheduleRepositoryImpl(logger),
+		TransactionManager:      repository.NewTransactionManagerImpl(logger),
+		DB:                      db,
+	}, nil
+}
+
 // registerLifecycleHooks はアプリケーションライフサイクルフックを登録します。
 // OnStopフックでデータベース接続のクローズ処理を実行します。
 //
@@ -0,0 +1,71 @@
+// Package dialect はリポジトリで直接記述するSQLをビルドしたデータベースの方言に変換します。
+//
+// リポジトリのSQLはMySQLの記法（識別子は`で囲み、プレースホルダーは?）で記述します。
+// -tags postgres でビルドした場合は、PostgreSQLの記法（識別子は"で囲み、プレースホルダーは$1, $2, ...）に変換します。
+// SQLBoilerのモデルと同様に、方言はビルドタグで決まります。
+package dialect
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Rebind はqueries.Rawで実行するSQLを方言に合わせて変換します。
+// 文字列リテラル（'）の中の`と?は変換しません。
+//
+// Parameters:
+//   - query: MySQLの記法のSQL
+//
+// Returns:
+//   - string: 方言に合わせたSQL
+func Rebind(query string) string {
+	return rebind(query, postgres, true)
+}
+
+// Quote はqm.Where等のクエリ修飾子に渡すSQLの識別子の引用符を方言に合わせて変換します。
+// プレースホルダーはSQLBoilerがクエリ全体で番号を振り直すため、変換しません。
+//
+// Parameters:
+//   - fragment: MySQLの記法のSQLの断片
+//
+// Returns:
+//   - string: 方言に合わせたSQLの断片
+func Quote(fragment string) string {
+	return rebind(fragment, postgres, false)
+}
+
+// rebind はSQLをPostgreSQLの記法に変換します。
+//
+// Parameters:
+//   - query: MySQLの記法のSQL
+//   - toPostgres: PostgreSQLの記法に変換するかどうか（falseの場合はそのまま返す）
+//   - numbered: ?を$1, $2, ...に置き換えるかどうか
+//
+// Returns:
+//   - string: 変換したSQL
+func rebind(query string, toPostgres bool, numbered bool) string {
+	if !toPostgres {
+		return query
+	}
+	var b strings.Builder
+	b.Grow(len(query))
+	n := 0
+	inLiteral := false
+	for _, r := range query {
+		switch {
+		case r == '\'':
+			inLiteral = !inLiteral // 引用符の二重化（''）は閉じてすぐ開くのと同じ扱いになる
+			b.WriteRune(r)
+		case inLiteral:
+			b.WriteRune(r)
+		case r == '`':
+			b.WriteRune('"')
+		case r == '?' && numbered:
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
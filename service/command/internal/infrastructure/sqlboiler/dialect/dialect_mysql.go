@@ -0,0 +1,32 @@
+//go:build !postgres
+
+package dialect
+
+// postgres はPostgreSQLの記法に変換するかどうかです。
+const postgres = false
+
+// OnDuplicateKeyNoop は一意キーが重複した行の挿入を、既存の行を変更しない更新として扱う句を返します。
+// 既存の行は排他ロックされます。
+//
+// Parameters:
+//   - table: テーブル名（MySQLでは使用しません）
+//   - key: 一意キーの列名（MySQLでは使用しません）
+//   - column: 同じ値で更新する列名
+//
+// Returns:
+//   - string: ON DUPLICATE KEY UPDATE句
+func OnDuplicateKeyNoop(table string, key string, column string) string {
+	return "ON DUPLICATE KEY UPDATE `" + column + "` = `" + column + "`"
+}
+
+// OnDuplicateKeySkip は一意キーが重複した行の挿入を無視する句を返します。無視した行は更新件数に含まれません。
+// INSERT IGNOREと異なり、外部キー制約違反などのエラーは無視しません。
+//
+// Parameters:
+//   - column: 同じ値で更新する列名（MySQLでは値が変わらない更新は更新件数に含まれません）
+//
+// Returns:
+//   - string: ON DUPLICATE KEY UPDATE句
+func OnDuplicateKeySkip(column string) string {
+	return "ON DUPLICATE KEY UPDATE `" + column + "` = `" + column + "`"
+}
@@ -0,0 +1,32 @@
+//go:build postgres
+
+package dialect
+
+// postgres はPostgreSQLの記法に変換するかどうかです。
+const postgres = true
+
+// OnDuplicateKeyNoop は一意キーが重複した行の挿入を、既存の行を変更しない更新として扱う句を返します。
+// 既存の行は排他ロックされます。
+//
+// Parameters:
+//   - table: テーブル名
+//   - key: 一意キーの列名（競合の判定に使用します）
+//   - column: 同じ値で更新する列名
+//
+// Returns:
+//   - string: ON CONFLICT DO UPDATE句
+func OnDuplicateKeyNoop(table string, key string, column string) string {
+	return `ON CONFLICT ("` + key + `") DO UPDATE SET "` + column + `" = "` + table + `"."` + column + `"`
+}
+
+// OnDuplicateKeySkip は一意キーが重複した行の挿入を無視する句を返します。無視した行は更新件数に含まれません。
+// 外部キー制約違反などのエラーは無視しません。
+//
+// Parameters:
+//   - column: 一意キーの列名（PostgreSQLでは使用しません）
+//
+// Returns:
+//   - string: ON CONFLICT DO NOTHING句
+func OnDuplicateKeySkip(column string) string {
+	return "ON CONFLICT DO NOTHING"
+}
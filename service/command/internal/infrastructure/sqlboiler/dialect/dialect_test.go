@@ -0,0 +1,41 @@
+package dialect
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("rebind", func() {
+	DescribeTable("MySQLの記法のSQLをPostgreSQLの記法に変換する",
+		func(query string, numbered bool, expected string) {
+			Expect(rebind(query, true, numbered)).To(Equal(expected))
+		},
+		Entry("識別子の引用符とプレースホルダー",
+			"DELETE FROM `product_tag` WHERE `product_id` IN (?,?) AND `tag_id` = ?", true,
+			`DELETE FROM "product_tag" WHERE "product_id" IN ($1,$2) AND "tag_id" = $3`),
+		Entry("文字列リテラルの中は変換しない",
+			"SELECT `name` FROM `tag` WHERE `name` = 'a?`b''c' AND `obj_id` = ?", true,
+			`SELECT "name" FROM "tag" WHERE "name" = 'a?`+"`"+`b''c' AND "obj_id" = $1`),
+		Entry("クエリ修飾子の断片はプレースホルダーを変換しない",
+			"`product`.`obj_id` IN (SELECT `product_id` FROM `product_tag` WHERE `tag_id` = ?)", false,
+			`"product"."obj_id" IN (SELECT "product_id" FROM "product_tag" WHERE "tag_id" = ?)`),
+	)
+
+	It("MySQLの場合はそのまま返す", func() {
+		query := "SELECT `locale`,`name` FROM `product_name_translation` WHERE `product_id` = ?"
+		Expect(rebind(query, false, true)).To(Equal(query))
+	})
+
+	It("ビルドした方言に合わせて変換する", func() {
+		query := "SELECT `obj_id` FROM `tag` WHERE `name_key` = ?"
+		if postgres {
+			Expect(Rebind(query)).To(Equal(`SELECT "obj_id" FROM "tag" WHERE "name_key" = $1`))
+			Expect(OnDuplicateKeyNoop("stock", "product_id", "on_hand")).To(Equal(`ON CONFLICT ("product_id") DO UPDATE SET "on_hand" = "stock"."on_hand"`))
+			Expect(OnDuplicateKeySkip("tag_id")).To(Equal("ON CONFLICT DO NOTHING"))
+		} else {
+			Expect(Rebind(query)).To(Equal(query))
+			Expect(OnDuplicateKeyNoop("stock", "product_id", "on_hand")).To(Equal("ON DUPLICATE KEY UPDATE `on_hand` = `on_hand`"))
+			Expect(OnDuplicateKeySkip("tag_id")).To(Equal("ON DUPLICATE KEY UPDATE `tag_id` = `tag_id`"))
+		}
+	})
+})
@@ -4,22 +4,33 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	_ "github.com/jackc/pgx/v5/stdlib" // database/sqlにpgxドライバを登録する
 	"github.com/spf13/viper"
 )
 
+const (
+	DRIVER_MYSQL    = "mysql"    // MySQL（go-sql-driver/mysql）
+	DRIVER_POSTGRES = "postgres" // PostgreSQL（pgx）
+)
+
 // DBConfig はデータベース接続設定を保持する構造体です。
 // TOMLファイルまたは環境変数から読み込まれます。
 type DBConfig struct {
+	Driver          string        //	ドライバ（mysql / postgres）
 	DBName          string        //	データベース名
 	Host            string        //	ホスト名
 	Port            int           //	ポート番号
 	User            string        //	ユーザー名
 	Pass            string        //	パスワード
+	SSLMode         string        //	SSLモード（postgresの場合のみ使用。例: disable, require）
 	MaxIdleConns    int           //	最大アイドル接続数
 	MaxOpenConns    int           //	最大接続数
 	ConnMaxLifetime time.Duration //	接続の最大生存時間(分)
@@ -30,8 +41,10 @@ type DBConfig struct {
 // NewDBConfig はデータベース設定を生成します。
 // 外部から注入されたviperインスタンスを使用してTOMLファイルまたは環境変数から設定を読み込みます。
 //
+// ドライバ（db.driver）はビルドしたSQLBoilerのモデルと一致する必要があります（BUILD_DRIVER）。
+//
 // 環境変数（DB_プレフィックス）:
-//   - DB_DBNAME, DB_HOST, DB_PORT, DB_USER, DB_PASS
+//   - DB_DRIVER, DB_DBNAME, DB_HOST, DB_PORT, DB_USER, DB_PASS, DB_SSLMODE
 //   - DB_MAX_IDLE_CONNS, DB_MAX_OPEN_CONNS
 //   - DB_CONN_MAX_LIFETIME, DB_CONN_MAX_IDLE_TIME
 //
@@ -40,29 +53,66 @@ type DBConfig struct {
 //
 // Returns:
 //   - *DBConfig: データベース設定
-//   - error: パースエラー、または必須設定キーが存在しない場合
+//   - error: パースエラー、必須設定キーが存在しない場合、またはドライバが不正な場合
 func NewDBConfig(v *viper.Viper) (*DBConfig, error) {
 	var configErrors []error
 	cfg := DBConfig{
-		DBName:          utils.GetKey[string](v, "mysql.dbname", &configErrors),
-		Host:            utils.GetKey[string](v, "mysql.host", &configErrors),
-		Port:            utils.GetKey[int](v, "mysql.port", &configErrors),
-		User:            utils.GetKey[string](v, "mysql.user", &configErrors),
-		Pass:            utils.GetKey[string](v, "mysql.pass", &configErrors),
-		MaxIdleConns:    utils.GetKey[int](v, "mysql.max_idle_conns", &configErrors),
-		MaxOpenConns:    utils.GetKey[int](v, "mysql.max_open_conns", &configErrors),
-		ConnMaxLifetime: utils.GetKey[time.Duration](v, "mysql.conn_max_lifetime", &configErrors),
-		ConnMaxIdleTime: utils.GetKey[time.Duration](v, "mysql.conn_max_idle_time", &configErrors),
+		Driver:          utils.GetKey[string](v, "db.driver", &configErrors),
+		DBName:          utils.GetKey[string](v, "db.dbname", &configErrors),
+		Host:            utils.GetKey[string](v, "db.host", &configErrors),
+		Port:            utils.GetKey[int](v, "db.port", &configErrors),
+		User:            utils.GetKey[string](v, "db.user", &configErrors),
+		Pass:            utils.GetKey[string](v, "db.pass", &configErrors),
+		SSLMode:         v.GetString("db.sslmode"), // mysqlでは使用しないため任意
+		MaxIdleConns:    utils.GetKey[int](v, "db.max_idle_conns", &configErrors),
+		MaxOpenConns:    utils.GetKey[int](v, "db.max_open_conns", &configErrors),
+		ConnMaxLifetime: utils.GetKey[time.Duration](v, "db.conn_max_lifetime", &configErrors),
+		ConnMaxIdleTime: utils.GetKey[time.Duration](v, "db.conn_max_idle_time", &configErrors),
 		LogLevel:        utils.GetKey[string](v, "log.level", &configErrors),
 	}
 	// すべての環境変数を読み込んだ後、エラーがあればまとめて返す
 	if len(configErrors) > 0 {
 		return &cfg, errors.Join(configErrors...)
 	}
+
+	switch cfg.Driver {
+	case DRIVER_MYSQL, DRIVER_POSTGRES:
+	default:
+		return &cfg, fmt.Errorf("db.driver must be %q or %q: %q", DRIVER_MYSQL, DRIVER_POSTGRES, cfg.Driver)
+	}
+	if cfg.Driver != BUILD_DRIVER {
+		return &cfg, fmt.Errorf("db.driver %q does not match the generated models: build with -tags postgres to use %q (built for %q)", cfg.Driver, DRIVER_POSTGRES, BUILD_DRIVER)
+	}
 	return &cfg, nil
 }
 
-// NewDatabase は指定された設定でMySQLデータベース接続を確立します。
+// dataSource はドライバごとのdatabase/sqlのドライバ名と接続文字列を生成します。
+//
+// Parameters:
+//   - config: データベース設定
+//
+// Returns:
+//   - string: database/sqlのドライバ名
+//   - string: 接続文字列
+func dataSource(config *DBConfig) (string, string) {
+	if config.Driver == DRIVER_POSTGRES {
+		sslMode := config.SSLMode
+		if sslMode == "" {
+			sslMode = "disable"
+		}
+		dsn := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(config.User, config.Pass),
+			Host:     net.JoinHostPort(config.Host, strconv.Itoa(config.Port)),
+			Path:     "/" + config.DBName,
+			RawQuery: url.Values{"sslmode": {sslMode}, "timezone": {"UTC"}}.Encode(),
+		}
+		return "pgx", dsn.String()
+	}
+	return "mysql", fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true", config.User, config.Pass, config.Host, config.Port, config.DBName)
+}
+
+// NewDatabase は指定された設定でデータベース（MySQL / PostgreSQL）接続を確立します。
 // この関数はSQLBoilerのグローバル状態も設定します。
 //
 // Note: この関数はboil.SetDB()を通じてグローバルなDB接続を設定します。
@@ -79,8 +129,7 @@ func NewDBConfig(v *viper.Viper) (*DBConfig, error) {
 //   - error: 接続エラーまたは設定エラー
 func NewDatabase(config *DBConfig) (*sql.DB, error) {
 	// 接続文字列を生成する
-	rdbms := "mysql"
-	connectStr := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true", config.User, config.Pass, config.Host, config.Port, config.DBName)
+	rdbms, connectStr := dataSource(config)
 
 	// DB接続
 	db, err := sql.Open(rdbms, connectStr)
@@ -4,7 +4,6 @@ package handler
 
 import (
 	"os"
-	"testing"
 	"time"
 
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/config"
@@ -12,19 +11,16 @@ import (
 	. "github.com/onsi/gomega"
 )
 
-func TestConn(t *testing.T) {
-	RegisterFailHandler(Fail)
-	RunSpecs(t, "infrastructure/sqlboiler/handler packageのテスト")
-}
-
 // testEnvVars はテストで使用される環境変数のリストです。
 var testEnvVars = []string{
 	"LOG_LEVEL",
+	"DB_DRIVER",
 	"DB_DBNAME",
 	"DB_HOST",
 	"DB_PORT",
 	"DB_USER",
 	"DB_PASS",
+	"DB_SSLMODE",
 	"DB_MAX_IDLE_CONNS",
 	"DB_MAX_OPEN_CONNS",
 	"DB_CONN_MAX_LIFETIME",
@@ -103,7 +99,8 @@ func cleanupTestConfig(tempDir string) {
 const productionTestConfigContent = `[log]
 level = "info"
 
-[mysql]
+[db]
+driver = "mysql"
 dbname = "sample_db"
 host = "localhost"
 port = 3306
@@ -229,6 +226,7 @@ var _ = Describe("NewDatabase関数", Label("DBConnect"), func() {
 		},
 		Entry("正常系: 有効な設定で接続", func() *DBConfig {
 			return &DBConfig{
+				Driver:          DRIVER_MYSQL,
 				DBName:          "sample_db",
 				Host:            "localhost",
 				Port:            3306,
@@ -242,6 +240,7 @@ var _ = Describe("NewDatabase関数", Label("DBConnect"), func() {
 		}, false, ""),
 		Entry("異常系: 不正なホスト名", func() *DBConfig {
 			return &DBConfig{
+				Driver:          DRIVER_MYSQL,
 				DBName:          "sample_db",
 				Host:            "invalid_host",
 				Port:            3306,
@@ -255,6 +254,7 @@ var _ = Describe("NewDatabase関数", Label("DBConnect"), func() {
 		}, true, ""),
 		Entry("異常系: 不正なポート番号", func() *DBConfig {
 			return &DBConfig{
+				Driver:          DRIVER_MYSQL,
 				DBName:          "sample_db",
 				Host:            "localhost",
 				Port:            99999,
@@ -0,0 +1,7 @@
+//go:build postgres
+
+package handler
+
+// BUILD_DRIVER はこのバイナリのSQLBoilerのモデルが対応するドライバです。
+// モデルはドライバごとに生成されるため、PostgreSQLを使用する場合は -tags postgres でビルドします。
+const BUILD_DRIVER = DRIVER_POSTGRES
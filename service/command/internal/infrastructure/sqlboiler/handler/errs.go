@@ -4,17 +4,56 @@ import (
 	"errors"
 	"log"
 	"net"
+	"strings"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// NAME_KEY_INDEX は名前の正規化キーに対する一意インデックス名です。
+const NAME_KEY_INDEX = "idx_name_key"
+
+// SKU_INDEX はバリエーションのSKUコードに対する一意インデックス名です。
+const SKU_INDEX = "idx_sku"
+
+// BARCODE_INDEX は商品のバーコードに対する一意インデックス名です。
+const BARCODE_INDEX = "idx_barcode"
+
+// SLUG_INDEX は商品・カテゴリのスラッグに対する一意インデックス名です。
+const SLUG_INDEX = "idx_slug"
+
+// OPTIONS_KEY_INDEX はバリエーションの選択肢の組み合わせに対する一意インデックス名です。
+const OPTIONS_KEY_INDEX = "idx_options_key"
+
+// uniqueViolationMessages は一意制約（インデックス）名と重複時のメッセージの対応です。
+// PostgreSQLではインデックス名がスキーマ内で一意である必要があるため、制約名にテーブル名の接頭辞が付きます（例: product_idx_slug）。
+// そのため、どちらのデータベースでも制約名を含むかどうかで判定します。
+var uniqueViolationMessages = []struct {
+	index   string
+	message string
+}{
+	{NAME_KEY_INDEX, "同じ名前が既に登録されています。"},
+	{SKU_INDEX, "同じSKUが既に登録されています。"},
+	{BARCODE_INDEX, "同じバーコードが既に登録されています。"},
+	{SLUG_INDEX, "同じスラッグが既に登録されています。"},
+	{OPTIONS_KEY_INDEX, "同じ選択肢の組み合わせが既に登録されています。"},
+}
+
 // DBErrHandler はデータベースアクセスエラーを適切なドメインエラーに変換します。
+// MySQLとPostgreSQLのエラーは同じ種類のエラーに変換されます。
 //
 // この関数は以下のエラータイプを処理します:
 //   - *net.OpError: ネットワーク接続エラー（接続タイムアウト等）
 //   - *mysql.MySQLError: MySQLドライバ固有のエラー
-//   - 1062: 一意制約違反
+//   - 1062: 一意制約違反（名前の正規化キー・SKU・バーコード・スラッグ・選択肢の組み合わせの場合はALREADY_EXISTS）
+//   - 1451, 1452: 外部キー制約違反
+//   - 1213: デッドロックによるトランザクションの中断
+//   - その他: ドライバエラー
+//   - *pgconn.PgError: PostgreSQLドライバ固有のエラー（SQLSTATE）
+//   - 23505: 一意制約違反（MySQLの1062と同じ）
+//   - 23503: 外部キー制約違反
+//   - 40001, 40P01: シリアライズ失敗・デッドロックによるトランザクションの中断
 //   - その他: ドライバエラー
 //   - その他: 不明なエラー
 //
@@ -26,19 +65,78 @@ import (
 func DBErrHandler(err error) error {
 	var opErr *net.OpError
 	var driverErr *mysql.MySQLError
+	var pgErr *pgconn.PgError
 	if errors.As(err, &opErr) { // 接続タイムアウトやネットワーク関連の問題で接続が確立できない場合
 		// TODO: slogに変更する
 		log.Println(err.Error())
 		return errs.NewInternalErrorWithCause("DB_CONNECTION_ERROR", opErr.Error(), opErr)
 	} else if errors.As(err, &driverErr) { // MySQLドライバエラーの場合
 		log.Printf("Code:%d Message:%s", driverErr.Number, driverErr.Message)
-		if driverErr.Number == 1062 { // 一意制約違反の場合
-			return errs.NewCRUDErrorWithCause("DB_UNIQUE_CONSTRAINT_VIOLATION", "一意制約違反です。", driverErr)
-		} else {
+		switch driverErr.Number {
+		case 1062: // 一意制約違反の場合
+			return uniqueViolationError(driverErr.Message, driverErr)
+		case 1451, 1452: // 外部キー制約違反の場合（参照されている行の削除・存在しない行の参照）
+			return foreignKeyViolationError(driverErr)
+		case 1213: // デッドロックの場合
+			return serializationFailureError(driverErr)
+		default:
 			return errs.NewInternalErrorWithCause("DB_DRIVER_ERROR", driverErr.Message, driverErr)
 		}
+	} else if errors.As(err, &pgErr) { // PostgreSQLドライバエラーの場合
+		log.Printf("Code:%s Constraint:%s Message:%s", pgErr.Code, pgErr.ConstraintName, pgErr.Message)
+		switch pgErr.Code {
+		case "23505": // 一意制約違反（unique_violation）の場合
+			return uniqueViolationError(pgErr.ConstraintName, pgErr)
+		case "23503": // 外部キー制約違反（foreign_key_violation）の場合
+			return foreignKeyViolationError(pgErr)
+		case "40001", "40P01": // シリアライズ失敗（serialization_failure）・デッドロック（deadlock_detected）の場合
+			return serializationFailureError(pgErr)
+		default:
+			return errs.NewInternalErrorWithCause("DB_DRIVER_ERROR", pgErr.Message, pgErr)
+		}
 	} else { // その他のエラー
 		log.Println(err.Error())
 		return errs.NewInternalErrorWithCause("UNKNOWN_ERROR", err.Error(), err)
 	}
 }
+
+// uniqueViolationError は一意制約違反のエラーを生成します。
+// 重複した制約が業務上の一意性（名前・SKU等）の場合はALREADY_EXISTSとします。
+//
+// Parameters:
+//   - constraint: 違反した制約名を含む文字列（MySQLはエラーメッセージ、PostgreSQLは制約名）
+//   - cause: ドライバのエラー
+//
+// Returns:
+//   - error: CRUDエラー
+func uniqueViolationError(constraint string, cause error) error {
+	for _, m := range uniqueViolationMessages {
+		if strings.Contains(constraint, m.index) {
+			return errs.NewCRUDErrorWithCause("ALREADY_EXISTS", m.message, cause)
+		}
+	}
+	return errs.NewCRUDErrorWithCause("DB_UNIQUE_CONSTRAINT_VIOLATION", "一意制約違反です。", cause)
+}
+
+// foreignKeyViolationError は外部キー制約違反のエラーを生成します。
+//
+// Parameters:
+//   - cause: ドライバのエラー
+//
+// Returns:
+//   - error: CRUDエラー
+func foreignKeyViolationError(cause error) error {
+	return errs.NewCRUDErrorWithCause("DB_FOREIGN_KEY_VIOLATION", "外部キー制約違反です。", cause)
+}
+
+// serializationFailureError は同時実行の競合によりトランザクションが中断されたエラーを生成します。
+// トランザクションはロールバックされているため、呼び出し元で再試行できます。
+//
+// Parameters:
+//   - cause: ドライバのエラー
+//
+// Returns:
+//   - error: 内部エラー
+func serializationFailureError(cause error) error {
+	return errs.NewInternalErrorWithCause("DB_SERIALIZATION_FAILURE", "同時実行の競合によりトランザクションが中断されました。", cause)
+}
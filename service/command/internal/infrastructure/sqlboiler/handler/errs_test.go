@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/jackc/pgx/v5/pgconn"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DBErrHandler関数", func() {
+	DescribeTable("ドライバのエラーをCRUDエラーに変換する",
+		func(err error, code string, message string) {
+			var crudErr *errs.CRUDError
+			converted := DBErrHandler(fmt.Errorf("wrapped: %w", err))
+			Expect(errors.As(converted, &crudErr)).To(BeTrue())
+			Expect(crudErr.Code).To(Equal(code))
+			Expect(crudErr.Message).To(Equal(message))
+			Expect(errors.Is(converted, err)).To(BeTrue())
+		},
+		Entry("MySQL: 名前の重複",
+			&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'x' for key 'product.idx_name_key'"},
+			"ALREADY_EXISTS", "同じ名前が既に登録されています。"),
+		Entry("MySQL: その他の一意制約違反",
+			&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'x' for key 'product.idx_obj_id'"},
+			"DB_UNIQUE_CONSTRAINT_VIOLATION", "一意制約違反です。"),
+		Entry("MySQL: 外部キー制約違反",
+			&mysql.MySQLError{Number: 1452, Message: "Cannot add or update a child row"},
+			"DB_FOREIGN_KEY_VIOLATION", "外部キー制約違反です。"),
+		Entry("PostgreSQL: 名前の重複",
+			&pgconn.PgError{Code: "23505", ConstraintName: "product_idx_name_key"},
+			"ALREADY_EXISTS", "同じ名前が既に登録されています。"),
+		Entry("PostgreSQL: SKUの重複",
+			&pgconn.PgError{Code: "23505", ConstraintName: "product_variant_idx_sku"},
+			"ALREADY_EXISTS", "同じSKUが既に登録されています。"),
+		Entry("PostgreSQL: スラッグの重複",
+			&pgconn.PgError{Code: "23505", ConstraintName: "category_idx_slug"},
+			"ALREADY_EXISTS", "同じスラッグが既に登録されています。"),
+		Entry("PostgreSQL: 選択肢の組み合わせの重複",
+			&pgconn.PgError{Code: "23505", ConstraintName: "product_variant_idx_options_key"},
+			"ALREADY_EXISTS", "同じ選択肢の組み合わせが既に登録されています。"),
+		Entry("PostgreSQL: その他の一意制約違反",
+			&pgconn.PgError{Code: "23505", ConstraintName: "product_pkey"},
+			"DB_UNIQUE_CONSTRAINT_VIOLATION", "一意制約違反です。"),
+		Entry("PostgreSQL: 外部キー制約違反",
+			&pgconn.PgError{Code: "23503", ConstraintName: "product_category_fk"},
+			"DB_FOREIGN_KEY_VIOLATION", "外部キー制約違反です。"),
+	)
+
+	DescribeTable("ドライバのエラーを内部エラーに変換する",
+		func(err error, code string) {
+			var internalErr *errs.InternalError
+			converted := DBErrHandler(err)
+			Expect(errors.As(converted, &internalErr)).To(BeTrue())
+			Expect(internalErr.Code).To(Equal(code))
+		},
+		Entry("MySQL: デッドロック", &mysql.MySQLError{Number: 1213, Message: "Deadlock found"}, "DB_SERIALIZATION_FAILURE"),
+		Entry("MySQL: その他", &mysql.MySQLError{Number: 1146, Message: "Table doesn't exist"}, "DB_DRIVER_ERROR"),
+		Entry("PostgreSQL: シリアライズ失敗", &pgconn.PgError{Code: "40001"}, "DB_SERIALIZATION_FAILURE"),
+		Entry("PostgreSQL: デッドロック", &pgconn.PgError{Code: "40P01"}, "DB_SERIALIZATION_FAILURE"),
+		Entry("PostgreSQL: その他", &pgconn.PgError{Code: "42P01", Message: "relation does not exist"}, "DB_DRIVER_ERROR"),
+		Entry("不明なエラー", errors.New("unexpected"), "UNKNOWN_ERROR"),
+	)
+})
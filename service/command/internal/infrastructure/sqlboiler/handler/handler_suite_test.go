@@ -0,0 +1,13 @@
+package handler
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestConn(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "infrastructure/sqlboiler/handler packageのテスト")
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
+)
+
+// LOG_GROUP はSQLBoilerのログレベルを個別に変更するときのグループ名です。
+const LOG_GROUP = "db"
+
+// debugLevels はSQLBoilerのデバッグ出力の判定に使用するログレベルです（FollowLogLevelで設定）。
+var debugLevels atomic.Pointer[log.Levels]
+
+// FollowLogLevel はSQLBoilerのデバッグ出力をLevelsのdbグループのレベルに追従させます。
+// 起動時のlog.levelで固定していたログ出力を、実行時に変更したログレベルに従わせます。
+// boil.DebugModeはクエリを実行するゴルーチンから読まれるグローバル変数のため書き換えず、
+// リポジトリがWithDebugでコンテキストごとにデバッグ出力を指定します。
+//
+// Parameters:
+//   - levels: 実行時に変更できるログレベル
+func FollowLogLevel(levels *log.Levels) {
+	debugLevels.Store(levels)
+}
+
+// WithDebug はdbグループのレベルがdebug以下の場合に生成されたSQLを出力するコンテキストを返します。
+// FollowLogLevelを呼び出していない場合は、NewDatabaseで設定したboil.DebugModeに従います。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - context.Context: SQLBoilerのデバッグ出力を指定したコンテキスト
+func WithDebug(ctx context.Context) context.Context {
+	levels := debugLevels.Load()
+	if levels == nil {
+		return ctx
+	}
+	return boil.WithDebug(ctx, levels.Level(LOG_GROUP) <= slog.LevelDebug)
+}
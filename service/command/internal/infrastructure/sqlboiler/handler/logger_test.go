@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("WithDebug", func() {
+	var levels *log.Levels
+
+	BeforeEach(func() {
+		v := viper.New()
+		v.Set("log.level", "info")
+		var err error
+		levels, err = log.NewLevels(v)
+		Expect(err).NotTo(HaveOccurred())
+		FollowLogLevel(levels)
+		DeferCleanup(func() { debugLevels.Store(nil) })
+	})
+
+	It("dbグループのレベルに応じてコンテキストごとにデバッグ出力を指定する", func() {
+		ctx := context.Background()
+		Expect(boil.IsDebug(WithDebug(ctx))).To(BeFalse())
+
+		levels.SetGroup(LOG_GROUP, slog.LevelDebug)
+
+		Expect(boil.IsDebug(WithDebug(ctx))).To(BeTrue())
+	})
+
+	It("レベルの変更中にクエリのゴルーチンから参照しても競合しない", func() {
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			for range 100 {
+				levels.SetGroup(LOG_GROUP, slog.LevelDebug)
+				levels.ResetGroup(LOG_GROUP)
+			}
+		})
+		wg.Go(func() {
+			for range 100 {
+				_ = boil.IsDebug(WithDebug(context.Background()))
+			}
+		})
+		wg.Wait()
+	})
+})
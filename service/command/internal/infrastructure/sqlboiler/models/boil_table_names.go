@@ -1,12 +1,34 @@
+//go:build !postgres
+
 // Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package models
 
 var TableNames = struct {
-	Category string
-	Product  string
+	Category                string
+	CategoryNameTranslation string
+	CategorySlugHistory     string
+	Product                 string
+	ProductNameTranslation  string
+	ProductPriceSchedule    string
+	ProductSlugHistory      string
+	ProductTag              string
+	ProductVariant          string
+	Stock                   string
+	StockReservation        string
+	Tag                     string
 }{
-	Category: "category",
-	Product:  "product",
+	Category:                "category",
+	CategoryNameTranslation: "category_name_translation",
+	CategorySlugHistory:     "category_slug_history",
+	Product:                 "product",
+	ProductNameTranslation:  "product_name_translation",
+	ProductPriceSchedule:    "product_price_schedule",
+	ProductSlugHistory:      "product_slug_history",
+	ProductTag:              "product_tag",
+	ProductVariant:          "product_variant",
+	Stock:                   "stock",
+	StockReservation:        "stock_reservation",
+	Tag:                     "tag",
 }
@@ -1,3 +1,5 @@
+//go:build !postgres
+
 // Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
@@ -13,6 +15,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,32 +26,47 @@ import (
 
 // Category is an object representing the database table.
 type Category struct {
-	ID    int    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ObjID string `boil:"obj_id" json:"obj_id" toml:"obj_id" yaml:"obj_id"`
-	Name  string `boil:"name" json:"name" toml:"name" yaml:"name"`
+	ID       int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ObjID    string      `boil:"obj_id" json:"obj_id" toml:"obj_id" yaml:"obj_id"`
+	Name     string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	NameKey  string      `boil:"name_key" json:"name_key" toml:"name_key" yaml:"name_key"`
+	ParentID null.String `boil:"parent_id" json:"parent_id,omitempty" toml:"parent_id" yaml:"parent_id,omitempty"`
+	Slug     string      `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
 
 	R *categoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L categoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var CategoryColumns = struct {
-	ID    string
-	ObjID string
-	Name  string
+	ID       string
+	ObjID    string
+	Name     string
+	NameKey  string
+	ParentID string
+	Slug     string
 }{
-	ID:    "id",
-	ObjID: "obj_id",
-	Name:  "name",
+	ID:       "id",
+	ObjID:    "obj_id",
+	Name:     "name",
+	NameKey:  "name_key",
+	ParentID: "parent_id",
+	Slug:     "slug",
 }
 
 var CategoryTableColumns = struct {
-	ID    string
-	ObjID string
-	Name  string
+	ID       string
+	ObjID    string
+	Name     string
+	NameKey  string
+	ParentID string
+	Slug     string
 }{
-	ID:    "category.id",
-	ObjID: "category.obj_id",
-	Name:  "category.name",
+	ID:       "category.id",
+	ObjID:    "category.obj_id",
+	Name:     "category.name",
+	NameKey:  "category.name_key",
+	ParentID: "category.parent_id",
+	Slug:     "category.slug",
 }
 
 // Generated where
@@ -101,14 +119,64 @@ func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var CategoryWhere = struct {
-	ID    whereHelperint
-	ObjID whereHelperstring
-	Name  whereHelperstring
+	ID       whereHelperint
+	ObjID    whereHelperstring
+	Name     whereHelperstring
+	NameKey  whereHelperstring
+	ParentID whereHelpernull_String
+	Slug     whereHelperstring
 }{
-	ID:    whereHelperint{field: "`category`.`id`"},
-	ObjID: whereHelperstring{field: "`category`.`obj_id`"},
-	Name:  whereHelperstring{field: "`category`.`name`"},
+	ID:       whereHelperint{field: "`category`.`id`"},
+	ObjID:    whereHelperstring{field: "`category`.`obj_id`"},
+	Name:     whereHelperstring{field: "`category`.`name`"},
+	NameKey:  whereHelperstring{field: "`category`.`name_key`"},
+	ParentID: whereHelpernull_String{field: "`category`.`parent_id`"},
+	Slug:     whereHelperstring{field: "`category`.`slug`"},
 }
 
 // CategoryRels is where relationship names are stored.
@@ -148,9 +216,9 @@ func (r *categoryR) GetProducts() ProductSlice {
 type categoryL struct{}
 
 var (
-	categoryAllColumns            = []string{"id", "obj_id", "name"}
-	categoryColumnsWithoutDefault = []string{"obj_id", "name"}
-	categoryColumnsWithDefault    = []string{"id"}
+	categoryAllColumns            = []string{"id", "obj_id", "name", "name_key", "parent_id", "slug"}
+	categoryColumnsWithoutDefault = []string{"obj_id", "name", "name_key", "slug"}
+	categoryColumnsWithDefault    = []string{"id", "parent_id"}
 	categoryPrimaryKeyColumns     = []string{"id"}
 	categoryGeneratedColumns      = []string{}
 )
@@ -918,6 +986,7 @@ func (o CategorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor,
 var mySQLCategoryUniqueColumns = []string{
 	"id",
 	"obj_id",
+	"name_key",
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
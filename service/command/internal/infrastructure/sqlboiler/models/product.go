@@ -1,3 +1,5 @@
+//go:build !postgres
+
 // Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
@@ -13,6 +15,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,11 +26,17 @@ import (
 
 // Product is an object representing the database table.
 type Product struct {
-	ID         int    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ObjID      string `boil:"obj_id" json:"obj_id" toml:"obj_id" yaml:"obj_id"`
-	Name       string `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Price      int    `boil:"price" json:"price" toml:"price" yaml:"price"`
-	CategoryID string `boil:"category_id" json:"category_id" toml:"category_id" yaml:"category_id"`
+	ID         int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ObjID      string      `boil:"obj_id" json:"obj_id" toml:"obj_id" yaml:"obj_id"`
+	Name       string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	NameKey    string      `boil:"name_key" json:"name_key" toml:"name_key" yaml:"name_key"`
+	Price      int         `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Currency   string      `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	TaxClass   string      `boil:"tax_class" json:"tax_class" toml:"tax_class" yaml:"tax_class"`
+	Status     string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Barcode    null.String `boil:"barcode" json:"barcode,omitempty" toml:"barcode" yaml:"barcode,omitempty"`
+	Slug       string      `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	CategoryID string      `boil:"category_id" json:"category_id" toml:"category_id" yaml:"category_id"`
 
 	R *productR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L productL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -37,13 +46,25 @@ var ProductColumns = struct {
 	ID         string
 	ObjID      string
 	Name       string
+	NameKey    string
 	Price      string
+	Currency   string
+	TaxClass   string
+	Status     string
+	Barcode    string
+	Slug       string
 	CategoryID string
 }{
 	ID:         "id",
 	ObjID:      "obj_id",
 	Name:       "name",
+	NameKey:    "name_key",
 	Price:      "price",
+	Currency:   "currency",
+	TaxClass:   "tax_class",
+	Status:     "status",
+	Barcode:    "barcode",
+	Slug:       "slug",
 	CategoryID: "category_id",
 }
 
@@ -51,13 +72,25 @@ var ProductTableColumns = struct {
 	ID         string
 	ObjID      string
 	Name       string
+	NameKey    string
 	Price      string
+	Currency   string
+	TaxClass   string
+	Status     string
+	Barcode    string
+	Slug       string
 	CategoryID string
 }{
 	ID:         "product.id",
 	ObjID:      "product.obj_id",
 	Name:       "product.name",
+	NameKey:    "product.name_key",
 	Price:      "product.price",
+	Currency:   "product.currency",
+	TaxClass:   "product.tax_class",
+	Status:     "product.status",
+	Barcode:    "product.barcode",
+	Slug:       "product.slug",
 	CategoryID: "product.category_id",
 }
 
@@ -67,13 +100,25 @@ var ProductWhere = struct {
 	ID         whereHelperint
 	ObjID      whereHelperstring
 	Name       whereHelperstring
+	NameKey    whereHelperstring
 	Price      whereHelperint
+	Currency   whereHelperstring
+	TaxClass   whereHelperstring
+	Status     whereHelperstring
+	Barcode    whereHelpernull_String
+	Slug       whereHelperstring
 	CategoryID whereHelperstring
 }{
 	ID:         whereHelperint{field: "`product`.`id`"},
 	ObjID:      whereHelperstring{field: "`product`.`obj_id`"},
 	Name:       whereHelperstring{field: "`product`.`name`"},
+	NameKey:    whereHelperstring{field: "`product`.`name_key`"},
 	Price:      whereHelperint{field: "`product`.`price`"},
+	Currency:   whereHelperstring{field: "`product`.`currency`"},
+	TaxClass:   whereHelperstring{field: "`product`.`tax_class`"},
+	Status:     whereHelperstring{field: "`product`.`status`"},
+	Barcode:    whereHelpernull_String{field: "`product`.`barcode`"},
+	Slug:       whereHelperstring{field: "`product`.`slug`"},
 	CategoryID: whereHelperstring{field: "`product`.`category_id`"},
 }
 
@@ -114,9 +159,9 @@ func (r *productR) GetCategory() *Category {
 type productL struct{}
 
 var (
-	productAllColumns            = []string{"id", "obj_id", "name", "price", "category_id"}
-	productColumnsWithoutDefault = []string{"obj_id", "name", "price", "category_id"}
-	productColumnsWithDefault    = []string{"id"}
+	productAllColumns            = []string{"id", "obj_id", "name", "name_key", "price", "currency", "tax_class", "status", "barcode", "slug", "category_id"}
+	productColumnsWithoutDefault = []string{"obj_id", "name", "name_key", "price", "slug", "category_id"}
+	productColumnsWithDefault    = []string{"id", "currency", "tax_class", "status", "barcode"}
 	productPrimaryKeyColumns     = []string{"id"}
 	productGeneratedColumns      = []string{}
 )
@@ -882,6 +927,7 @@ func (o ProductSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor,
 var mySQLProductUniqueColumns = []string{
 	"id",
 	"obj_id",
+	"name_key",
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
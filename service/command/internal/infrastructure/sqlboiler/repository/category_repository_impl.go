@@ -7,13 +7,25 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/dialect"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/handler"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/models"
 )
 
+// subtreeIdsQuery は起点のカテゴリと子孫カテゴリのIDを、起点からの深さ順に取得する再帰クエリです。
+const subtreeIdsQuery = "WITH RECURSIVE `subtree` (`obj_id`, `depth`) AS (" +
+	"SELECT `obj_id`, 0 FROM `category` WHERE `obj_id` = ? " +
+	"UNION ALL " +
+	"SELECT `c`.`obj_id`, `s`.`depth` + 1 FROM `category` `c` JOIN `subtree` `s` ON `c`.`parent_id` = `s`.`obj_id`" +
+	") SELECT `obj_id` FROM `subtree` ORDER BY `depth`, `obj_id`"
+
 // CategoryRepositoryImpl はカテゴリリポジトリのSQLBoilerを使用した実装です。
 type CategoryRepositoryImpl struct {
 	logger *slog.Logger
@@ -36,6 +48,7 @@ func NewCategoryRepositoryImpl(logger *slog.Logger) *CategoryRepositoryImpl {
 }
 
 // ExistsByName は指定されたカテゴリ名が既に存在するかをチェックします。
+// 名前は全角・半角や空白の違いを無視した正規化キーで比較します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -46,7 +59,8 @@ func NewCategoryRepositoryImpl(logger *slog.Logger) *CategoryRepositoryImpl {
 //   - bool: カテゴリが存在する場合はtrue、存在しない場合はfalse
 //   - error: データベースエラーが発生した場合
 func (r *CategoryRepositoryImpl) ExistsByName(ctx context.Context, tx *sql.Tx, name *categories.CategoryName) (bool, error) {
-	condition := models.CategoryWhere.Name.EQ(name.Value())
+	ctx = handler.WithDebug(ctx)
+	condition := models.CategoryWhere.NameKey.EQ(name.Key())
 	exists, err := models.Categories(condition).Exists(ctx, tx)
 	if err != nil {
 		r.logger.ErrorContext(ctx, "Failed to check if category exists", slog.Any("error", err))
@@ -55,6 +69,30 @@ func (r *CategoryRepositoryImpl) ExistsByName(ctx context.Context, tx *sql.Tx, n
 	return exists, nil
 }
 
+// FindIdBySlug は指定されたスラッグを現在のスラッグまたは変更前のスラッグとして使用しているカテゴリのIDを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - slug: スラッグ
+//
+// Returns:
+//   - *categories.CategoryId: スラッグを使用しているカテゴリのID
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー、
+//     データベースエラーが発生した場合はそのエラー
+func (r *CategoryRepositoryImpl) FindIdBySlug(ctx context.Context, tx *sql.Tx, slug names.Slug) (*categories.CategoryId, error) {
+	ctx = handler.WithDebug(ctx)
+	ownerId, found, err := categorySlugHistory.findOwnerId(ctx, tx, slug)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find category by slug", slog.Any("error", err))
+		return nil, err
+	}
+	if !found {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("スラッグ: %s のカテゴリは存在しません。", slug.Value()))
+	}
+	return categories.NewCategoryId(ownerId)
+}
+
 // FindById は指定されたIDのカテゴリを取得します。
 //
 // Parameters:
@@ -67,28 +105,120 @@ func (r *CategoryRepositoryImpl) ExistsByName(ctx context.Context, tx *sql.Tx, n
 //   - error: カテゴリが存在しない場合はNOT_FOUNDエラー、
 //     データベースエラーが発生した場合はそのエラー
 func (r *CategoryRepositoryImpl) FindById(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) (*categories.Category, error) {
-	condition := models.CategoryWhere.ObjID.EQ(id.Value())
-	model, err := models.Categories(condition).One(ctx, tx)
+	ctx = handler.WithDebug(ctx)
+	model, err := r.findModelById(ctx, tx, id)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errs.NewCRUDErrorWithCause("NOT_FOUND", fmt.Sprintf("カテゴリ番号: %s は存在しないため、取得できませんでした。", id.Value()), err)
-		}
-		return nil, handler.DBErrHandler(err)
+		return nil, err
 	}
+	return r.toCategoryEntityWithTranslations(ctx, tx, model)
+}
 
-	categoryId, err := categories.NewCategoryId(model.ObjID)
+// LockById は指定されたIDのカテゴリを排他ロック（SELECT ... FOR UPDATE）して取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 取得するカテゴリのID
+//
+// Returns:
+//   - *categories.Category: 見つかったカテゴリエンティティ
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー、
+//     データベースエラーが発生した場合はそのエラー
+func (r *CategoryRepositoryImpl) LockById(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) (*categories.Category, error) {
+	ctx = handler.WithDebug(ctx)
+	model, err := r.findModelById(ctx, tx, id, qm.For("UPDATE"))
 	if err != nil {
 		return nil, err
 	}
-	categoryName, err := categories.NewCategoryName(model.Name)
-	if err != nil {
-		return nil, err
+	return r.toCategoryEntityWithTranslations(ctx, tx, model)
+}
+
+// LockPath は指定されたカテゴリからルートまで親をたどり、各カテゴリを排他ロックします。
+// 親子関係は循環しない前提ですが、データ不整合で循環していた場合はエラーを返します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 起点となるカテゴリのID
+//
+// Returns:
+//   - []*categories.CategoryId: 起点のカテゴリからルートまでのカテゴリID
+//   - error: 起点のカテゴリが存在しない場合はNOT_FOUNDエラー、
+//     データベースエラーが発生した場合はそのエラー
+func (r *CategoryRepositoryImpl) LockPath(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) ([]*categories.CategoryId, error) {
+	ctx = handler.WithDebug(ctx)
+	path := []*categories.CategoryId{}
+	visited := map[string]bool{}
+	current := id
+	for current != nil {
+		if visited[current.Value()] {
+			return nil, errs.NewInternalError("CATEGORY_CYCLE", fmt.Sprintf("カテゴリ番号: %s の親カテゴリが循環しています。", id.Value()))
+		}
+		visited[current.Value()] = true
+
+		category, err := r.LockById(ctx, tx, current)
+		if err != nil {
+			return nil, err
+		}
+		path = append(path, category.Id())
+		current = category.ParentId()
+	}
+	return path, nil
+}
+
+// FindSubtreeIds は指定されたカテゴリとその子孫カテゴリのIDを再帰クエリで取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 起点となるカテゴリのID
+//
+// Returns:
+//   - []*categories.CategoryId: 起点のカテゴリと子孫カテゴリのID（先頭が起点のカテゴリ）
+//   - error: 起点のカテゴリが存在しない場合はNOT_FOUNDエラー、
+//     データベースエラーが発生した場合はそのエラー
+func (r *CategoryRepositoryImpl) FindSubtreeIds(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) ([]*categories.CategoryId, error) {
+	ctx = handler.WithDebug(ctx)
+	var records []struct {
+		ObjID string `boil:"obj_id"`
+	}
+	if err := queries.Raw(dialect.Rebind(subtreeIdsQuery), id.Value()).Bind(ctx, tx, &records); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find category subtree", slog.Any("error", err))
+		return nil, handler.DBErrHandler(err)
 	}
-	category, convErr := categories.BuildCategory(categoryId, categoryName)
-	if convErr != nil {
-		return nil, convErr
+	if len(records) == 0 {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリ番号: %s は存在しません。", id.Value()))
 	}
-	return category, nil
+	ids := make([]*categories.CategoryId, 0, len(records))
+	for _, record := range records {
+		categoryId, err := categories.NewCategoryId(record.ObjID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, categoryId)
+	}
+	return ids, nil
+}
+
+// ExistsByParentId は指定されたカテゴリを親に持つカテゴリが存在するかをチェックします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - parentId: 親カテゴリのID
+//
+// Returns:
+//   - bool: 子カテゴリが存在する場合はtrue
+//   - error: データベースエラーが発生した場合
+func (r *CategoryRepositoryImpl) ExistsByParentId(ctx context.Context, tx *sql.Tx, parentId *categories.CategoryId) (bool, error) {
+	ctx = handler.WithDebug(ctx)
+	condition := models.CategoryWhere.ParentID.EQ(null.StringFrom(parentId.Value()))
+	exists, err := models.Categories(condition).Exists(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to check if child categories exist", slog.Any("error", err))
+		return false, handler.DBErrHandler(err)
+	}
+	return exists, nil
 }
 
 // Create は新しいカテゴリをデータベースに追加します。
@@ -104,19 +234,28 @@ func (r *CategoryRepositoryImpl) FindById(ctx context.Context, tx *sql.Tx, id *c
 // Returns:
 //   - error: データベースエラーが発生した場合
 func (r *CategoryRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, category *categories.Category) error {
+	ctx = handler.WithDebug(ctx)
 	newCategory := models.Category{
-		ObjID: category.Id().Value(),
-		Name:  category.Name().Value(),
+		ObjID:    category.Id().Value(),
+		Name:     category.Name().Value(),
+		NameKey:  category.Name().Key(),
+		ParentID: toParentIdColumn(category),
+		Slug:     category.Slug().Value(),
 	}
 	// NOTE: boil.Infer() でauto-incrementのIDは無視され、勝手にDB側で採番された後、sqlboiler側の構造体にセットされる
 	if err := newCategory.Insert(ctx, tx, boil.Infer()); err != nil {
 		r.logger.ErrorContext(ctx, "Failed to create category", slog.Any("error", err))
 		return handler.DBErrHandler(err)
 	}
+	if err := categoryNameTranslation.replace(ctx, tx, newCategory.ObjID, categoryTranslationValues(category)); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to create category name translations", slog.Any("error", err))
+		return err
+	}
 	return nil
 }
 
 // UpdateById は指定されたIDのカテゴリ情報を更新します。
+// スラッグが変更された場合は、変更前のスラッグを履歴に記録します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -127,6 +266,7 @@ func (r *CategoryRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, categor
 //   - error: カテゴリが存在しない場合はNOT_FOUNDエラー、
 //     データベースエラーが発生した場合はそのエラー
 func (r *CategoryRepositoryImpl) UpdateById(ctx context.Context, tx *sql.Tx, category *categories.Category) error {
+	ctx = handler.WithDebug(ctx)
 	condition := models.CategoryWhere.ObjID.EQ(category.Id().Value())
 	upModel, err := models.Categories(condition).One(ctx, tx)
 	if err != nil {
@@ -135,10 +275,46 @@ func (r *CategoryRepositoryImpl) UpdateById(ctx context.Context, tx *sql.Tx, cat
 		}
 		return handler.DBErrHandler(err)
 	}
+	previousSlug := names.Slug(upModel.Slug)
 	// Update the fields of upModel as needed
 	upModel.ObjID = category.Id().Value()
 	upModel.Name = category.Name().Value()
-	if _, updateErr := upModel.Update(ctx, tx, boil.Whitelist(models.CategoryColumns.ObjID, models.CategoryColumns.Name)); updateErr != nil {
+	upModel.NameKey = category.Name().Key()
+	upModel.Slug = category.Slug().Value()
+	if _, updateErr := upModel.Update(ctx, tx, boil.Whitelist(
+		models.CategoryColumns.ObjID, models.CategoryColumns.Name, models.CategoryColumns.NameKey, models.CategoryColumns.Slug,
+	)); updateErr != nil {
+		return handler.DBErrHandler(updateErr)
+	}
+	if err := categorySlugHistory.record(ctx, tx, upModel.ObjID, previousSlug, category.Slug()); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to record category slug history", slog.Any("error", err))
+		return err
+	}
+	if err := categoryNameTranslation.replace(ctx, tx, upModel.ObjID, categoryTranslationValues(category)); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to update category name translations", slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
+// UpdateParentById は指定されたIDのカテゴリの親カテゴリを更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - category: 移動後のカテゴリ情報
+//
+// Returns:
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー、
+//     データベースエラーが発生した場合はそのエラー
+func (r *CategoryRepositoryImpl) UpdateParentById(ctx context.Context, tx *sql.Tx, category *categories.Category) error {
+	ctx = handler.WithDebug(ctx)
+	upModel, err := r.findModelById(ctx, tx, category.Id())
+	if err != nil {
+		return err
+	}
+	upModel.ParentID = toParentIdColumn(category)
+	if _, updateErr := upModel.Update(ctx, tx, boil.Whitelist(models.CategoryColumns.ParentID)); updateErr != nil {
 		return handler.DBErrHandler(updateErr)
 	}
 	return nil
@@ -155,6 +331,7 @@ func (r *CategoryRepositoryImpl) UpdateById(ctx context.Context, tx *sql.Tx, cat
 //   - error: カテゴリが存在しない場合はNOT_FOUNDエラー、
 //     データベースエラーが発生した場合はそのエラー
 func (r *CategoryRepositoryImpl) DeleteById(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) error {
+	ctx = handler.WithDebug(ctx)
 	condition := models.CategoryWhere.ObjID.EQ(id.Value())
 	delModel, err := models.Categories(condition).One(ctx, tx)
 	if err != nil {
@@ -180,7 +357,8 @@ func (r *CategoryRepositoryImpl) DeleteById(ctx context.Context, tx *sql.Tx, id
 //   - error: カテゴリが存在しない場合はNOT_FOUNDエラー、
 //     データベースエラーが発生した場合はそのエラー
 func (r *CategoryRepositoryImpl) DeleteByName(ctx context.Context, tx *sql.Tx, name *categories.CategoryName) error {
-	condition := models.CategoryWhere.Name.EQ(name.Value())
+	ctx = handler.WithDebug(ctx)
+	condition := models.CategoryWhere.NameKey.EQ(name.Key())
 	delModel, err := models.Categories(condition).One(ctx, tx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -194,6 +372,84 @@ func (r *CategoryRepositoryImpl) DeleteByName(ctx context.Context, tx *sql.Tx, n
 	return nil
 }
 
+// findModelById は指定されたIDのカテゴリのモデルを取得します。
+func (r *CategoryRepositoryImpl) findModelById(ctx context.Context, tx *sql.Tx, id *categories.CategoryId, mods ...qm.QueryMod) (*models.Category, error) {
+	mods = append([]qm.QueryMod{models.CategoryWhere.ObjID.EQ(id.Value())}, mods...)
+	model, err := models.Categories(mods...).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NewCRUDErrorWithCause("NOT_FOUND", fmt.Sprintf("カテゴリ番号: %s は存在しないため、取得できませんでした。", id.Value()), err)
+		}
+		return nil, handler.DBErrHandler(err)
+	}
+	return model, nil
+}
+
+// toCategoryEntity はカテゴリのモデルをドメインエンティティに変換します。
+func toCategoryEntity(model *models.Category) (*categories.Category, error) {
+	categoryId, err := categories.NewCategoryId(model.ObjID)
+	if err != nil {
+		return nil, err
+	}
+	categoryName, err := categories.NewCategoryName(model.Name)
+	if err != nil {
+		return nil, err
+	}
+	var parentId *categories.CategoryId
+	if model.ParentID.Valid {
+		if parentId, err = categories.NewCategoryId(model.ParentID.String); err != nil {
+			return nil, err
+		}
+	}
+	category, err := categories.BuildCategory(categoryId, categoryName, parentId)
+	if err != nil {
+		return nil, err
+	}
+	category.ChangeSlug(names.Slug(model.Slug))
+	return category, nil
+}
+
+// toCategoryEntityWithTranslations はカテゴリのモデルをカテゴリ名の翻訳と合わせてドメインエンティティに変換します。
+func (r *CategoryRepositoryImpl) toCategoryEntityWithTranslations(ctx context.Context, tx *sql.Tx, model *models.Category) (*categories.Category, error) {
+	category, err := toCategoryEntity(model)
+	if err != nil {
+		return nil, err
+	}
+	records, err := categoryNameTranslation.find(ctx, tx, model.ObjID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find category name translations", slog.Any("error", err))
+		return nil, err
+	}
+	translations := make(map[names.Locale]*categories.CategoryName, len(records))
+	for locale, value := range records {
+		if translations[locale], err = categories.NewCategoryName(value); err != nil {
+			return nil, err
+		}
+	}
+	if err := category.ChangeTranslations(translations); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// categoryTranslationValues はカテゴリ名の翻訳を翻訳テーブルに保存する値に変換します。
+func categoryTranslationValues(category *categories.Category) map[names.Locale]string {
+	translations := category.Translations()
+	values := make(map[names.Locale]string, len(translations))
+	for locale, name := range translations {
+		values[locale] = name.Value()
+	}
+	return values
+}
+
+// toParentIdColumn はカテゴリの親カテゴリIDをparent_id列の値に変換します。
+func toParentIdColumn(category *categories.Category) null.String {
+	if category.IsRoot() {
+		return null.String{}
+	}
+	return null.StringFrom(category.ParentId().Value())
+}
+
 // categoryHookFactory は指定されたフックタイプに応じたカテゴリ用フック関数を生成します。
 // loggerをクロージャーに保持し、各フック実行時に構造化ログを出力します。
 //
@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/pricing"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/handler"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/models"
+)
+
+// PriceScheduleRepositoryImpl は価格スケジュールリポジトリのSQLBoilerを使用した実装です。
+type PriceScheduleRepositoryImpl struct {
+	logger *slog.Logger
+}
+
+// NewPriceScheduleRepositoryImpl は新しいPriceScheduleRepositoryImplインスタンスを生成します。
+func NewPriceScheduleRepositoryImpl(logger *slog.Logger) *PriceScheduleRepositoryImpl {
+	return &PriceScheduleRepositoryImpl{logger: logger}
+}
+
+// Create は新しい価格スケジュールを追加します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - schedule: 価格スケジュール
+//
+// Returns:
+//   - error: データベースエラー
+func (r *PriceScheduleRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, schedule *pricing.PriceSchedule) error {
+	ctx = handler.WithDebug(ctx)
+	newSchedule := models.ProductPriceSchedule{
+		ObjID:        schedule.Id().Value(),
+		ProductID:    schedule.ProductId().Value(),
+		Price:        int(schedule.Price()),
+		RegularPrice: toNullRegularPrice(schedule),
+		Status:       string(schedule.Status()),
+		StartsAt:     schedule.StartsAt(),
+		EndsAt:       schedule.EndsAt(),
+	}
+	if err := newSchedule.Insert(ctx, tx, boil.Infer()); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to create price schedule", slog.Any("error", err))
+		return handler.DBErrHandler(err)
+	}
+	r.logger.InfoContext(ctx, "価格スケジュールを登録しました。",
+		slog.String("obj_id", newSchedule.ObjID),
+		slog.String("product_id", newSchedule.ProductID),
+		slog.Int("price", newSchedule.Price),
+		slog.Time("starts_at", newSchedule.StartsAt),
+		slog.Time("ends_at", newSchedule.EndsAt),
+	)
+	return nil
+}
+
+// FindById は価格スケジュールIDで価格スケジュールをロックせずに取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 価格スケジュールID
+//
+// Returns:
+//   - *pricing.PriceSchedule: 価格スケジュール
+//   - error: 存在しない場合やデータベースエラー
+func (r *PriceScheduleRepositoryImpl) FindById(ctx context.Context, tx *sql.Tx, id *pricing.PriceScheduleId) (*pricing.PriceSchedule, error) {
+	ctx = handler.WithDebug(ctx)
+	return r.findSchedule(ctx, tx, id)
+}
+
+// LockById は価格スケジュールIDで価格スケジュールを排他ロックして取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 価格スケジュールID
+//
+// Returns:
+//   - *pricing.PriceSchedule: 価格スケジュール
+//   - error: 存在しない場合やデータベースエラー
+func (r *PriceScheduleRepositoryImpl) LockById(ctx context.Context, tx *sql.Tx, id *pricing.PriceScheduleId) (*pricing.PriceSchedule, error) {
+	ctx = handler.WithDebug(ctx)
+	return r.findSchedule(ctx, tx, id, qm.For("UPDATE"))
+}
+
+// FindPendingByProductId は商品の予定または適用中の価格スケジュールを開始時刻順に取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//
+// Returns:
+//   - []*pricing.PriceSchedule: 価格スケジュールのリスト
+//   - error: データベースエラー
+func (r *PriceScheduleRepositoryImpl) FindPendingByProductId(ctx context.Context, tx *sql.Tx, productId *products.ProductId) ([]*pricing.PriceSchedule, error) {
+	ctx = handler.WithDebug(ctx)
+	modelSlice, err := models.ProductPriceSchedules(
+		models.ProductPriceScheduleWhere.ProductID.EQ(productId.Value()),
+		models.ProductPriceScheduleWhere.Status.IN([]string{
+			string(pricing.PRICE_SCHEDULE_SCHEDULED),
+			string(pricing.PRICE_SCHEDULE_ACTIVE),
+		}),
+		qm.OrderBy(models.ProductPriceScheduleColumns.StartsAt),
+	).All(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find pending price schedules", slog.Any("error", err))
+		return nil, handler.DBErrHandler(err)
+	}
+	schedules := make([]*pricing.PriceSchedule, 0, len(modelSlice))
+	for _, model := range modelSlice {
+		schedule, buildErr := buildPriceSchedule(model)
+		if buildErr != nil {
+			return nil, buildErr
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+// FindDueIds は開始時刻を過ぎた予定の価格スケジュールと、終了時刻を過ぎた適用中の価格スケジュールのIDを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - now: 基準時刻
+//   - limit: 最大件数
+//
+// Returns:
+//   - []*pricing.PriceScheduleId: 価格スケジュールID
+//   - error: データベースエラー
+func (r *PriceScheduleRepositoryImpl) FindDueIds(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]*pricing.PriceScheduleId, error) {
+	ctx = handler.WithDebug(ctx)
+	modelSlice, err := models.ProductPriceSchedules(
+		qm.Select(models.ProductPriceScheduleColumns.ObjID),
+		qm.Expr(
+			models.ProductPriceScheduleWhere.Status.EQ(string(pricing.PRICE_SCHEDULE_SCHEDULED)),
+			models.ProductPriceScheduleWhere.StartsAt.LTE(now),
+		),
+		qm.Or2(qm.Expr(
+			models.ProductPriceScheduleWhere.Status.EQ(string(pricing.PRICE_SCHEDULE_ACTIVE)),
+			models.ProductPriceScheduleWhere.EndsAt.LTE(now),
+		)),
+		qm.OrderBy(models.ProductPriceScheduleColumns.ID),
+		qm.Limit(limit),
+	).All(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find due price schedules", slog.Any("error", err))
+		return nil, handler.DBErrHandler(err)
+	}
+	ids := make([]*pricing.PriceScheduleId, 0, len(modelSlice))
+	for _, model := range modelSlice {
+		id, idErr := pricing.NewPriceScheduleId(model.ObjID)
+		if idErr != nil {
+			return nil, idErr
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Update は価格スケジュールの状態と適用前の単価を更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - schedule: 価格スケジュール
+//
+// Returns:
+//   - error: 存在しない場合やデータベースエラー
+func (r *PriceScheduleRepositoryImpl) Update(ctx context.Context, tx *sql.Tx, schedule *pricing.PriceSchedule) error {
+	ctx = handler.WithDebug(ctx)
+	condition := models.ProductPriceScheduleWhere.ObjID.EQ(schedule.Id().Value())
+	rowsAff, err := models.ProductPriceSchedules(condition).UpdateAll(ctx, tx, models.M{
+		models.ProductPriceScheduleColumns.Status:       string(schedule.Status()),
+		models.ProductPriceScheduleColumns.RegularPrice: toNullRegularPrice(schedule),
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to update price schedule", slog.Any("error", err))
+		return handler.DBErrHandler(err)
+	}
+	if rowsAff == 0 {
+		return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("価格スケジュール番号: %s は存在しないため、更新できませんでした。", schedule.Id().Value()))
+	}
+	r.logger.InfoContext(ctx, "価格スケジュールを変更しました。",
+		slog.String("obj_id", schedule.Id().Value()),
+		slog.String("product_id", schedule.ProductId().Value()),
+		slog.String("status", string(schedule.Status())),
+	)
+	return nil
+}
+
+// findSchedule は指定されたIDの価格スケジュールを取得します。
+func (r *PriceScheduleRepositoryImpl) findSchedule(ctx context.Context, tx *sql.Tx, id *pricing.PriceScheduleId, mods ...qm.QueryMod) (*pricing.PriceSchedule, error) {
+	mods = append(mods, models.ProductPriceScheduleWhere.ObjID.EQ(id.Value()))
+	model, err := models.ProductPriceSchedules(mods...).One(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find price schedule by ID", slog.Any("error", err))
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("価格スケジュール番号: %s は存在しません。", id.Value()))
+		}
+		return nil, handler.DBErrHandler(err)
+	}
+	return buildPriceSchedule(model)
+}
+
+// toNullRegularPrice は適用前の単価をNULL許容の値に変換します。未適用の場合はNULLです。
+func toNullRegularPrice(schedule *pricing.PriceSchedule) null.Int {
+	if schedule.RegularPrice() == 0 {
+		return null.Int{}
+	}
+	return null.IntFrom(int(schedule.RegularPrice()))
+}
+
+// buildPriceSchedule はSQLBoilerのモデルから価格スケジュールを再構築します。
+func buildPriceSchedule(model *models.ProductPriceSchedule) (*pricing.PriceSchedule, error) {
+	id, err := pricing.NewPriceScheduleId(model.ObjID)
+	if err != nil {
+		return nil, err
+	}
+	productId, err := products.NewProductId(model.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	status, err := pricing.ParsePriceScheduleStatus(model.Status)
+	if err != nil {
+		return nil, err
+	}
+	return pricing.BuildPriceSchedule(id, productId, uint32(model.Price), uint32(model.RegularPrice.Int), status, model.StartsAt, model.EndsAt)
+}
+
+var _ pricing.PriceScheduleRepository = (*PriceScheduleRepositoryImpl)(nil)
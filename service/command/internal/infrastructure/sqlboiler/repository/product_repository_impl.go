@@ -3,19 +3,32 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/types"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/dialect"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/handler"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/models"
 )
 
+// productIdsWithAllTagsQuery は指定されたタグ名の正規化キーのタグがすべて付与された商品に絞り込む条件です。
+// IN句はタグ名の数だけプレースホルダを繰り返し、最後のプレースホルダにタグ名の数を指定します。
+const productIdsWithAllTagsQuery = "`product`.`obj_id` IN (" +
+	"SELECT `pt`.`product_id` FROM `product_tag` `pt` JOIN `tag` `t` ON `t`.`obj_id` = `pt`.`tag_id` " +
+	"WHERE `t`.`name_key` IN (%s) GROUP BY `pt`.`product_id` HAVING COUNT(*) = ?)"
+
 // ProductRepositoryImpl は商品リポジトリのSQLBoilerを使用した実装です。
 type ProductRepositoryImpl struct {
 	logger *slog.Logger
@@ -40,6 +53,7 @@ func NewProductRepositoryImpl(logger *slog.Logger) *ProductRepositoryImpl {
 //   - bool: 商品が存在する場合はtrue
 //   - error: データベースエラー
 func (r *ProductRepositoryImpl) ExistsById(ctx context.Context, tx *sql.Tx, id *products.ProductId) (bool, error) {
+	ctx = handler.WithDebug(ctx)
 	condition := models.ProductWhere.ObjID.EQ(id.Value())
 	exists, err := models.Products(condition).Exists(ctx, tx)
 	if err != nil {
@@ -49,7 +63,7 @@ func (r *ProductRepositoryImpl) ExistsById(ctx context.Context, tx *sql.Tx, id *
 	return exists, nil
 }
 
-// FindById は指定されたIDの商品を取得します。
+// FindById は指定されたIDの商品をバリエーションと合わせて取得します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -60,8 +74,90 @@ func (r *ProductRepositoryImpl) ExistsById(ctx context.Context, tx *sql.Tx, id *
 //   - *products.Product: 見つかった商品エンティティ
 //   - error: 商品が存在しない場合やデータベースエラー
 func (r *ProductRepositoryImpl) FindById(ctx context.Context, tx *sql.Tx, id *products.ProductId) (*products.Product, error) {
-	condition := models.ProductWhere.ObjID.EQ(id.Value())
-	model, err := models.Products(condition, qm.Load(models.ProductRels.Category)).One(ctx, tx)
+	ctx = handler.WithDebug(ctx)
+	return r.findById(ctx, tx, id)
+}
+
+// LockById は指定されたIDの商品を排他ロック(SELECT ... FOR UPDATE)し、バリエーションと合わせて取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 商品ID
+//
+// Returns:
+//   - *products.Product: ロックした商品エンティティ
+//   - error: 商品が存在しない場合やデータベースエラー
+func (r *ProductRepositoryImpl) LockById(ctx context.Context, tx *sql.Tx, id *products.ProductId) (*products.Product, error) {
+	ctx = handler.WithDebug(ctx)
+	return r.findById(ctx, tx, id, qm.For("UPDATE"))
+}
+
+// LockIdsByCategoryIds は指定されたカテゴリのいずれかに属する商品を商品ID順に排他ロック(SELECT ... FOR UPDATE)し、そのIDを返します。
+// タグ名の正規化キーを指定した場合は、すべてのタグが付与された商品に絞り込みます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - categoryIds: 商品が属するカテゴリのID
+//   - tagKeys: 商品にすべて付与されている必要があるタグ名の正規化キー（重複なし、空の場合は絞り込まない）
+//
+// Returns:
+//   - []*products.ProductId: ロックした商品のID（商品ID順）
+//   - error: データベースエラー
+func (r *ProductRepositoryImpl) LockIdsByCategoryIds(ctx context.Context, tx *sql.Tx, categoryIds []*categories.CategoryId, tagKeys []string) ([]*products.ProductId, error) {
+	ctx = handler.WithDebug(ctx)
+	if len(categoryIds) == 0 {
+		return []*products.ProductId{}, nil
+	}
+	values := make([]string, len(categoryIds))
+	for i, categoryId := range categoryIds {
+		values[i] = categoryId.Value()
+	}
+	mods := []qm.QueryMod{
+		qm.Select(models.ProductColumns.ObjID),
+		models.ProductWhere.CategoryID.IN(values),
+	}
+	if len(tagKeys) > 0 {
+		args := make([]any, 0, len(tagKeys)+1)
+		for _, key := range tagKeys {
+			args = append(args, key)
+		}
+		args = append(args, len(tagKeys))
+		mods = append(mods, qm.Where(dialect.Quote(strings.Replace(productIdsWithAllTagsQuery, "%s", placeholders(len(tagKeys), 1), 1)), args...))
+	}
+	mods = append(mods, qm.OrderBy(models.ProductColumns.ObjID), qm.For("UPDATE"))
+
+	productModels, err := models.Products(mods...).All(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to lock products by category", slog.Any("error", err))
+		return nil, handler.DBErrHandler(err)
+	}
+	ids := make([]*products.ProductId, 0, len(productModels))
+	for _, model := range productModels {
+		id, err := products.NewProductId(model.ObjID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// findById は指定されたIDの商品をカテゴリ・バリエーションと合わせて取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 商品ID
+//   - mods: 商品の取得に追加するクエリ修飾子
+//
+// Returns:
+//   - *products.Product: 見つかった商品エンティティ
+//   - error: 商品が存在しない場合やデータベースエラー
+func (r *ProductRepositoryImpl) findById(ctx context.Context, tx *sql.Tx, id *products.ProductId, mods ...qm.QueryMod) (*products.Product, error) {
+	mods = append([]qm.QueryMod{models.ProductWhere.ObjID.EQ(id.Value()), qm.Load(models.ProductRels.Category)}, mods...)
+	model, err := models.Products(mods...).One(ctx, tx)
 	if err != nil {
 		r.logger.ErrorContext(ctx, "Failed to find product by ID", slog.Any("error", err))
 		if errors.Is(err, sql.ErrNoRows) {
@@ -75,42 +171,98 @@ func (r *ProductRepositoryImpl) FindById(ctx context.Context, tx *sql.Tx, id *pr
 		return nil, errs.NewCRUDError("NOT_FOUND", "関連するカテゴリが見つかりません")
 	}
 	// Categoryエンティティを構築
-	categoryId, err := categories.NewCategoryId(model.R.Category.ObjID)
+	category, err := toCategoryEntity(model.R.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	// Productエンティティを構築
+	productId, err := products.NewProductId(model.ObjID)
 	if err != nil {
 		return nil, err
 	}
-	categoryName, err := categories.NewCategoryName(model.R.Category.Name)
+	productName, err := products.NewProductName(model.Name)
 	if err != nil {
 		return nil, err
 	}
-	category, err := categories.BuildCategory(categoryId, categoryName)
+	productPrice, err := products.NewProductPriceWithTax(uint32(model.Price), model.Currency, money.TaxClass(model.TaxClass))
 	if err != nil {
 		return nil, err
 	}
 
-	// Productエンティティを構築
-	productId, err := products.NewProductId(model.ObjID)
+	status, err := products.ParseProductStatus(model.Status)
 	if err != nil {
 		return nil, err
 	}
-	productName, err := products.NewProductName(model.Name)
+
+	variants, err := r.findVariantsByProductId(ctx, tx, productId)
 	if err != nil {
 		return nil, err
 	}
-	productPrice, err := products.NewProductPrice(uint32(model.Price))
+
+	product, err := products.BuildProduct(productId, productName, productPrice, category, status, variants...)
 	if err != nil {
 		return nil, err
 	}
 
-	product, err := products.BuildProduct(productId, productName, productPrice, category)
+	records, err := productNameTranslation.find(ctx, tx, productId.Value())
 	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find product name translations", slog.Any("error", err))
 		return nil, err
 	}
+	translations := make(map[names.Locale]*products.ProductName, len(records))
+	for locale, value := range records {
+		if translations[locale], err = products.NewProductName(value); err != nil {
+			return nil, err
+		}
+	}
+	if err := product.ChangeTranslations(translations); err != nil {
+		return nil, err
+	}
+	if model.Barcode.Valid {
+		barcode, err := products.NewBarcode(model.Barcode.String)
+		if err != nil {
+			return nil, err
+		}
+		product.ChangeBarcode(barcode)
+	}
+	product.ChangeSlug(names.Slug(model.Slug))
 
 	return product, nil
 }
 
+// findVariantsByProductId は指定された商品のバリエーションを登録順に取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//
+// Returns:
+//   - []*products.Variant: バリエーション
+//   - error: データベースエラー
+func (r *ProductRepositoryImpl) findVariantsByProductId(ctx context.Context, tx *sql.Tx, productId *products.ProductId) ([]*products.Variant, error) {
+	variantModels, err := models.ProductVariants(
+		models.ProductVariantWhere.ProductID.EQ(productId.Value()),
+		qm.OrderBy(models.ProductVariantColumns.ID),
+	).All(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find product variants", slog.Any("error", err))
+		return nil, handler.DBErrHandler(err)
+	}
+	variants := make([]*products.Variant, 0, len(variantModels))
+	for _, model := range variantModels {
+		variant, err := toVariantEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, variant)
+	}
+	return variants, nil
+}
+
 // ExistsByName は指定された名前の商品が存在するかどうかをチェックします。
+// 名前は全角・半角や空白の違いを無視した正規化キーで比較します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -121,7 +273,8 @@ func (r *ProductRepositoryImpl) FindById(ctx context.Context, tx *sql.Tx, id *pr
 //   - bool: 商品が存在する場合はtrue
 //   - error: データベースエラー
 func (r *ProductRepositoryImpl) ExistsByName(ctx context.Context, tx *sql.Tx, name *products.ProductName) (bool, error) {
-	condition := models.ProductWhere.Name.EQ(name.Value())
+	ctx = handler.WithDebug(ctx)
+	condition := models.ProductWhere.NameKey.EQ(name.Key())
 	exists, err := models.Products(condition).Exists(ctx, tx)
 	if err != nil {
 		r.logger.ErrorContext(ctx, "Failed to check if product exists", slog.Any("error", err))
@@ -130,6 +283,29 @@ func (r *ProductRepositoryImpl) ExistsByName(ctx context.Context, tx *sql.Tx, na
 	return exists, nil
 }
 
+// FindIdBySlug は指定されたスラッグを現在のスラッグまたは変更前のスラッグとして使用している商品のIDを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - slug: スラッグ
+//
+// Returns:
+//   - *products.ProductId: スラッグを使用している商品のID
+//   - error: 存在しない場合はNOT_FOUNDエラー、データベースエラー
+func (r *ProductRepositoryImpl) FindIdBySlug(ctx context.Context, tx *sql.Tx, slug names.Slug) (*products.ProductId, error) {
+	ctx = handler.WithDebug(ctx)
+	ownerId, found, err := productSlugHistory.findOwnerId(ctx, tx, slug)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find product by slug", slog.Any("error", err))
+		return nil, err
+	}
+	if !found {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("スラッグ: %s の商品は存在しません。", slug.Value()))
+	}
+	return products.NewProductId(ownerId)
+}
+
 // Create は新しい商品をデータベースに追加します。
 //
 // Parameters:
@@ -140,10 +316,17 @@ func (r *ProductRepositoryImpl) ExistsByName(ctx context.Context, tx *sql.Tx, na
 // Returns:
 //   - error: データベースエラー
 func (r *ProductRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, product *products.Product) error {
+	ctx = handler.WithDebug(ctx)
 	newProduct := models.Product{
 		ObjID:      product.Id().Value(),
 		Name:       product.Name().Value(),
+		NameKey:    product.Name().Key(),
 		Price:      int(product.Price().Value()),
+		Currency:   product.Price().Currency(),
+		TaxClass:   string(product.Price().TaxClass()),
+		Status:     string(product.Status()),
+		Barcode:    barcodeToNullString(product.Barcode()),
+		Slug:       product.Slug().Value(),
 		CategoryID: product.Category().Id().Value(),
 	}
 	// NOTE: boil.Infer() でauto-incrementのIDは無視され、勝手にDB側で採番された後、sqlboiler側の構造体にセットされる
@@ -151,11 +334,16 @@ func (r *ProductRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, product
 		r.logger.ErrorContext(ctx, "Failed to create product", slog.Any("error", err))
 		return handler.DBErrHandler(err)
 	}
+	if err := productNameTranslation.replace(ctx, tx, newProduct.ObjID, productTranslationValues(product)); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to create product name translations", slog.Any("error", err))
+		return err
+	}
 
 	return nil
 }
 
 // UpdateById は指定されたIDの商品情報を更新します。
+// スラッグが変更された場合は、変更前のスラッグを履歴に記録します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -165,6 +353,7 @@ func (r *ProductRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, product
 // Returns:
 //   - error: 商品が存在しない場合やデータベースエラー
 func (r *ProductRepositoryImpl) UpdateById(ctx context.Context, tx *sql.Tx, Product *products.Product) error {
+	ctx = handler.WithDebug(ctx)
 	condition := models.ProductWhere.ObjID.EQ(Product.Id().Value())
 	upModel, err := models.Products(condition).One(ctx, tx)
 	if err != nil {
@@ -173,19 +362,40 @@ func (r *ProductRepositoryImpl) UpdateById(ctx context.Context, tx *sql.Tx, Prod
 		}
 		return handler.DBErrHandler(err)
 	}
+	previousSlug := names.Slug(upModel.Slug)
 	// Update the fields of upModel as needed
 	upModel.ObjID = Product.Id().Value()
 	upModel.Name = Product.Name().Value()
+	upModel.NameKey = Product.Name().Key()
 	upModel.Price = int(Product.Price().Value())
+	upModel.Currency = Product.Price().Currency()
+	upModel.TaxClass = string(Product.Price().TaxClass())
+	upModel.Status = string(Product.Status())
+	upModel.Barcode = barcodeToNullString(Product.Barcode())
+	upModel.Slug = Product.Slug().Value()
 	upModel.CategoryID = Product.Category().Id().Value()
 	if _, updateErr := upModel.Update(ctx, tx, boil.Whitelist(
 		models.ProductColumns.ObjID,
 		models.ProductColumns.Name,
+		models.ProductColumns.NameKey,
 		models.ProductColumns.Price,
+		models.ProductColumns.Currency,
+		models.ProductColumns.TaxClass,
+		models.ProductColumns.Status,
+		models.ProductColumns.Barcode,
+		models.ProductColumns.Slug,
 		models.ProductColumns.CategoryID,
 	)); updateErr != nil {
 		return handler.DBErrHandler(updateErr)
 	}
+	if err := productSlugHistory.record(ctx, tx, upModel.ObjID, previousSlug, Product.Slug()); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to record product slug history", slog.Any("error", err))
+		return err
+	}
+	if err := productNameTranslation.replace(ctx, tx, upModel.ObjID, productTranslationValues(Product)); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to update product name translations", slog.Any("error", err))
+		return err
+	}
 	return nil
 }
 
@@ -199,6 +409,7 @@ func (r *ProductRepositoryImpl) UpdateById(ctx context.Context, tx *sql.Tx, Prod
 // Returns:
 //   - error: 商品が存在しない場合やデータベースエラー
 func (r *ProductRepositoryImpl) DeleteById(ctx context.Context, tx *sql.Tx, id *products.ProductId) error {
+	ctx = handler.WithDebug(ctx)
 	condition := models.ProductWhere.ObjID.EQ(id.Value())
 	delModel, err := models.Products(condition).One(ctx, tx)
 	if err != nil {
@@ -213,6 +424,200 @@ func (r *ProductRepositoryImpl) DeleteById(ctx context.Context, tx *sql.Tx, id *
 	return nil
 }
 
+// AddVariant は商品にバリエーションを追加します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//   - variant: 追加するバリエーション
+//
+// Returns:
+//   - error: SKUまたは選択肢の組み合わせが重複する場合やデータベースエラー
+func (r *ProductRepositoryImpl) AddVariant(ctx context.Context, tx *sql.Tx, productId *products.ProductId, variant *products.Variant) error {
+	ctx = handler.WithDebug(ctx)
+	newVariant := models.ProductVariant{
+		ObjID:     variant.Id().Value(),
+		ProductID: productId.Value(),
+	}
+	if err := setVariantColumns(&newVariant, variant); err != nil {
+		return err
+	}
+	if err := newVariant.Insert(ctx, tx, boil.Infer()); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to create product variant", slog.Any("error", err))
+		return handler.DBErrHandler(err)
+	}
+	r.logger.InfoContext(ctx, "商品バリエーションを登録しました。",
+		slog.String("obj_id", newVariant.ObjID),
+		slog.String("product_id", newVariant.ProductID),
+		slog.String("sku", newVariant.Sku),
+	)
+	return nil
+}
+
+// UpdateVariant は指定されたIDのバリエーションを更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - variant: 更新するバリエーション
+//
+// Returns:
+//   - error: バリエーションが存在しない場合、SKUまたは選択肢の組み合わせが重複する場合やデータベースエラー
+func (r *ProductRepositoryImpl) UpdateVariant(ctx context.Context, tx *sql.Tx, variant *products.Variant) error {
+	ctx = handler.WithDebug(ctx)
+	condition := models.ProductVariantWhere.ObjID.EQ(variant.Id().Value())
+	upModel, err := models.ProductVariants(condition).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.NewCRUDErrorWithCause("NOT_FOUND", fmt.Sprintf("バリエーション番号: %s は存在しないため、更新できませんでした。", variant.Id().Value()), err)
+		}
+		return handler.DBErrHandler(err)
+	}
+	if err := setVariantColumns(upModel, variant); err != nil {
+		return err
+	}
+	if _, updateErr := upModel.Update(ctx, tx, boil.Whitelist(
+		models.ProductVariantColumns.Sku,
+		models.ProductVariantColumns.Options,
+		models.ProductVariantColumns.OptionsKey,
+		models.ProductVariantColumns.PriceOverride,
+		models.ProductVariantColumns.Status,
+	)); updateErr != nil {
+		r.logger.ErrorContext(ctx, "Failed to update product variant", slog.Any("error", updateErr))
+		return handler.DBErrHandler(updateErr)
+	}
+	r.logger.InfoContext(ctx, "商品バリエーションを変更しました。",
+		slog.String("obj_id", upModel.ObjID),
+		slog.String("product_id", upModel.ProductID),
+		slog.String("sku", upModel.Sku),
+	)
+	return nil
+}
+
+// RemoveVariant は指定されたIDのバリエーションを削除します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 削除するバリエーションのID
+//
+// Returns:
+//   - error: バリエーションが存在しない場合やデータベースエラー
+func (r *ProductRepositoryImpl) RemoveVariant(ctx context.Context, tx *sql.Tx, id *products.VariantId) error {
+	ctx = handler.WithDebug(ctx)
+	condition := models.ProductVariantWhere.ObjID.EQ(id.Value())
+	delModel, err := models.ProductVariants(condition).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("バリエーション番号: %s は存在しないため、削除できませんでした。", id.Value()))
+		}
+		return handler.DBErrHandler(err)
+	}
+	if _, deleteErr := delModel.Delete(ctx, tx); deleteErr != nil {
+		r.logger.ErrorContext(ctx, "Failed to delete product variant", slog.Any("error", deleteErr))
+		return handler.DBErrHandler(deleteErr)
+	}
+	r.logger.InfoContext(ctx, "商品バリエーションを削除しました。",
+		slog.String("obj_id", delModel.ObjID),
+		slog.String("product_id", delModel.ProductID),
+		slog.String("sku", delModel.Sku),
+	)
+	return nil
+}
+
+// productTranslationValues は商品名の翻訳を翻訳テーブルに保存する値に変換します。
+func productTranslationValues(product *products.Product) map[names.Locale]string {
+	translations := product.Translations()
+	values := make(map[names.Locale]string, len(translations))
+	for locale, name := range translations {
+		values[locale] = name.Value()
+	}
+	return values
+}
+
+// variantOptionRecord はproduct_variant.options列に保存する選択肢のJSON表現です。
+type variantOptionRecord struct {
+	Axis  string `json:"axis"`
+	Value string `json:"value"`
+}
+
+// setVariantColumns はバリエーションエンティティの値をモデルの各列に設定します。
+//
+// Parameters:
+//   - model: 設定先のモデル
+//   - variant: バリエーションエンティティ
+//
+// Returns:
+//   - error: 選択肢のJSON変換に失敗した場合のエラー
+func setVariantColumns(model *models.ProductVariant, variant *products.Variant) error {
+	options := variant.Options().Values()
+	records := make([]variantOptionRecord, 0, len(options))
+	for _, option := range options {
+		records = append(records, variantOptionRecord{Axis: option.Axis(), Value: option.Value()})
+	}
+	optionsJSON, err := json.Marshal(records)
+	if err != nil {
+		return errs.NewInternalErrorWithCause("INTERNAL", "選択肢のJSON変換に失敗しました", err)
+	}
+	model.Sku = variant.Sku().Value()
+	model.Options = types.JSON(optionsJSON)
+	model.OptionsKey = variant.Options().Key()
+	model.PriceOverride = null.Int{}
+	if variant.PriceOverride() != nil {
+		model.PriceOverride = null.IntFrom(int(variant.PriceOverride().Value()))
+	}
+	model.Status = string(variant.Status())
+	return nil
+}
+
+// toVariantEntity はモデルからバリエーションエンティティを再構築します。
+//
+// Parameters:
+//   - model: バリエーションのモデル
+//
+// Returns:
+//   - *products.Variant: バリエーションエンティティ
+//   - error: 保存されている値が不正な場合のエラー
+func toVariantEntity(model *models.ProductVariant) (*products.Variant, error) {
+	id, err := products.NewVariantId(model.ObjID)
+	if err != nil {
+		return nil, err
+	}
+	sku, err := products.NewSku(model.Sku)
+	if err != nil {
+		return nil, err
+	}
+	var records []variantOptionRecord
+	if err := json.Unmarshal(model.Options, &records); err != nil {
+		return nil, errs.NewInternalErrorWithCause("INTERNAL", "選択肢のJSON変換に失敗しました", err)
+	}
+	options := make([]*products.VariantOption, 0, len(records))
+	for _, record := range records {
+		option, err := products.NewVariantOption(record.Axis, record.Value)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, option)
+	}
+	variantOptions, err := products.NewVariantOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+	var priceOverride *products.ProductPrice
+	if model.PriceOverride.Valid {
+		priceOverride, err = products.NewProductPrice(uint32(model.PriceOverride.Int))
+		if err != nil {
+			return nil, err
+		}
+	}
+	status, err := products.ParseVariantStatus(model.Status)
+	if err != nil {
+		return nil, err
+	}
+	return products.BuildVariant(id, sku, variantOptions, priceOverride, status), nil
+}
+
 // productHookFactory は指定されたフックタイプに応じた商品用フック関数を生成します。
 //
 // Parameters:
@@ -262,3 +667,11 @@ func productHookFactory(hookType boil.HookPoint, logger *slog.Logger) func(ctx c
 }
 
 var _ products.ProductRepository = (*ProductRepositoryImpl)(nil)
+
+// barcodeToNullString はバーコードをNULL許容の文字列に変換します。未登録の場合はNULLです。
+func barcodeToNullString(barcode *products.Barcode) null.String {
+	if barcode == nil {
+		return null.String{}
+	}
+	return null.StringFrom(barcode.Value())
+}
@@ -10,11 +10,16 @@ import (
 	"database/sql"
 	"io"
 	"log/slog"
+	"time"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/pricing"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/tags"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -54,6 +59,7 @@ var _ = Describe("categoryRepositoryImpl構造体", Ordered, Label("CategoryRepo
 			Expect(result).To(Equal(expected), "存在するカテゴリ名に対してExistsByNameがfalseを返しました。")
 		},
 		Entry("文房具", "文房具", true),
+		Entry("前後に全角空白を含む文房具", "　文房具　", true),
 		Entry("食品", "食品", false),
 	)
 
@@ -73,7 +79,7 @@ var _ = Describe("categoryRepositoryImpl構造体", Ordered, Label("CategoryRepo
 			Expect(idErr).NotTo(HaveOccurred(), "テスト用カテゴリIDの生成に失敗しました。")
 			name, nameErr := categories.NewCategoryName("新しい文房具")
 			Expect(nameErr).NotTo(HaveOccurred(), "テスト用カテゴリ名の生成に失敗しました。")
-			category, categoryErr := categories.BuildCategory(id, name)
+			category, categoryErr := categories.BuildCategory(id, name, nil)
 			Expect(categoryErr).NotTo(HaveOccurred(), "テスト用カテゴリの生成に失敗しました。")
 
 			createErr := rep.Create(ctx, tx, category)
@@ -83,6 +89,19 @@ var _ = Describe("categoryRepositoryImpl構造体", Ordered, Label("CategoryRepo
 			Expect(crudErr.Code).To(Equal("DB_UNIQUE_CONSTRAINT_VIOLATION"))
 			Expect(crudErr.Message).To(ContainSubstring("一意制約違反です。"))
 		})
+		It("正規化後の名前が重複するとALREADY_EXISTSエラーになること", func() {
+			// 全角空白を除くと既存の「文房具」と同じ名前になる
+			name, nameErr := categories.NewCategoryName("　文房具　")
+			Expect(nameErr).NotTo(HaveOccurred(), "テスト用カテゴリ名の生成に失敗しました。")
+			category, categoryErr := categories.NewCategory(name)
+			Expect(categoryErr).NotTo(HaveOccurred(), "テスト用カテゴリの生成に失敗しました。")
+
+			createErr := rep.Create(ctx, tx, category)
+			Expect(createErr).To(HaveOccurred())
+			crudErr, ok := createErr.(*errs.CRUDError)
+			Expect(ok).To(BeTrue())
+			Expect(crudErr.Code).To(Equal("ALREADY_EXISTS"))
+		})
 	})
 
 	Context("FindByIdの動作確認", func() {
@@ -96,6 +115,8 @@ var _ = Describe("categoryRepositoryImpl構造体", Ordered, Label("CategoryRepo
 			Expect(category).NotTo(BeNil())
 			Expect(category.Id().Value()).To(Equal("b1524011-b6af-417e-8bf2-f449dd58b5c0"))
 			Expect(category.Name().Value()).To(Equal("文房具"))
+			Expect(category.Translations()).To(HaveKey(names.Locale("en")))
+			Expect(category.Translations()[names.Locale("en")].Value()).To(Equal("Stationery"))
 		})
 
 		It("存在しないカテゴリIDで取得しようとするとエラーになること", func() {
@@ -112,6 +133,80 @@ var _ = Describe("categoryRepositoryImpl構造体", Ordered, Label("CategoryRepo
 		})
 	})
 
+	Context("LockPathの動作確認", func() {
+		It("カテゴリからルートまでの経路を取得できること", func() {
+			// 初期データの 文房具 > 筆記具 > 鉛筆
+			id, idErr := categories.NewCategoryId("a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用カテゴリIDの生成に失敗しました。")
+
+			path, lockErr := rep.LockPath(ctx, tx, id)
+			Expect(lockErr).NotTo(HaveOccurred(), "経路の取得に失敗しました。")
+			Expect(path).To(HaveLen(3))
+			Expect(path[0].Value()).To(Equal("a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64"))
+			Expect(path[1].Value()).To(Equal("3f6b8a2e-5c41-4d7e-9a0b-7e2d4c1f8b93"))
+			Expect(path[2].Value()).To(Equal("b1524011-b6af-417e-8bf2-f449dd58b5c0"))
+		})
+
+		It("存在しないカテゴリIDの場合はエラーになること", func() {
+			id, idErr := categories.NewCategoryId("00000000-0000-0000-0000-000000000000")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用カテゴリIDの生成に失敗しました。")
+
+			path, lockErr := rep.LockPath(ctx, tx, id)
+			Expect(lockErr).To(HaveOccurred())
+			Expect(path).To(BeNil())
+			crudErr, ok := lockErr.(*errs.CRUDError)
+			Expect(ok).To(BeTrue(), "返されたエラーがCRUDErrorではありません")
+			Expect(crudErr.Code).To(Equal("NOT_FOUND"))
+		})
+	})
+
+	Context("FindSubtreeIdsの動作確認", func() {
+		It("カテゴリと子孫カテゴリのIDを階層の浅い順に取得できること", func() {
+			// 初期データの 文房具 > 筆記具 > 鉛筆
+			id, idErr := categories.NewCategoryId("b1524011-b6af-417e-8bf2-f449dd58b5c0")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用カテゴリIDの生成に失敗しました。")
+
+			ids, findErr := rep.FindSubtreeIds(ctx, tx, id)
+			Expect(findErr).NotTo(HaveOccurred(), "子孫カテゴリの取得に失敗しました。")
+			Expect(ids).To(HaveLen(3))
+			Expect(ids[0].Value()).To(Equal("b1524011-b6af-417e-8bf2-f449dd58b5c0"))
+			Expect(ids[1].Value()).To(Equal("3f6b8a2e-5c41-4d7e-9a0b-7e2d4c1f8b93"))
+			Expect(ids[2].Value()).To(Equal("a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64"))
+		})
+
+		It("存在しないカテゴリIDの場合はエラーになること", func() {
+			id, idErr := categories.NewCategoryId("00000000-0000-0000-0000-000000000000")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用カテゴリIDの生成に失敗しました。")
+
+			ids, findErr := rep.FindSubtreeIds(ctx, tx, id)
+			Expect(findErr).To(HaveOccurred())
+			Expect(ids).To(BeNil())
+			crudErr, ok := findErr.(*errs.CRUDError)
+			Expect(ok).To(BeTrue(), "返されたエラーがCRUDErrorではありません")
+			Expect(crudErr.Code).To(Equal("NOT_FOUND"))
+		})
+	})
+
+	Context("ExistsByParentIdの動作確認", func() {
+		It("子カテゴリを持つカテゴリの場合はtrueを返すこと", func() {
+			id, idErr := categories.NewCategoryId("b1524011-b6af-417e-8bf2-f449dd58b5c0")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用カテゴリIDの生成に失敗しました。")
+
+			exists, err := rep.ExistsByParentId(ctx, tx, id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("子カテゴリを持たないカテゴリの場合はfalseを返すこと", func() {
+			id, idErr := categories.NewCategoryId("a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用カテゴリIDの生成に失敗しました。")
+
+			exists, err := rep.ExistsByParentId(ctx, tx, id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+	})
+
 	Context("DeleteByIdの動作確認", func() {
 		It("既存のカテゴリを削除できること", func() {
 			// まず新しいカテゴリを作成
@@ -197,7 +292,7 @@ var _ = Describe("productRepositoryImpl構造体", Ordered, Label("ProductReposi
 		Expect(catNameErr).NotTo(HaveOccurred(), "テスト用カテゴリ名の生成に失敗しました。")
 		catId, catIdErr := categories.NewCategoryId("b1524011-b6af-417e-8bf2-f449dd58b5c0")
 		Expect(catIdErr).NotTo(HaveOccurred(), "テスト用カテゴリIDの生成に失敗しました。")
-		testCategory, err = categories.BuildCategory(catId, catName)
+		testCategory, err = categories.BuildCategory(catId, catName, nil)
 		Expect(err).NotTo(HaveOccurred(), "テスト用カテゴリの生成に失敗しました。")
 	})
 
@@ -235,6 +330,8 @@ var _ = Describe("productRepositoryImpl構造体", Ordered, Label("ProductReposi
 			Expect(result).To(Equal(expected))
 		},
 		Entry("存在する商品名", "水性ボールペン(黒)", true),
+		Entry("半角カナの存在する商品名", "水性ﾎﾞｰﾙﾍﾟﾝ(黒)", true),
+		Entry("全角括弧の存在する商品名", "水性ボールペン（黒）", true),
 		Entry("存在しない商品名", "存在しない商品", false),
 	)
 
@@ -264,7 +361,7 @@ var _ = Describe("productRepositoryImpl構造体", Ordered, Label("ProductReposi
 			Expect(nameErr).NotTo(HaveOccurred(), "テスト用商品名の生成に失敗しました。")
 			price, priceErr := products.NewProductPrice(500)
 			Expect(priceErr).NotTo(HaveOccurred(), "テスト用商品価格の生成に失敗しました。")
-			product, productErr := products.BuildProduct(id, name, price, testCategory)
+			product, productErr := products.BuildProduct(id, name, price, testCategory, products.PRODUCT_PUBLISHED)
 			Expect(productErr).NotTo(HaveOccurred(), "テスト用商品の生成に失敗しました。")
 
 			createErr := rep.Create(ctx, tx, product)
@@ -274,6 +371,41 @@ var _ = Describe("productRepositoryImpl構造体", Ordered, Label("ProductReposi
 			Expect(crudErr.Code).To(Equal("DB_UNIQUE_CONSTRAINT_VIOLATION"))
 			Expect(crudErr.Message).To(ContainSubstring("一意制約違反です。"))
 		})
+
+		It("正規化後の名前が重複するとALREADY_EXISTSエラーになること", func() {
+			// 半角カナは既存の「水性ボールペン(黒)」と同じ正規化キーになる
+			name, nameErr := products.NewProductName("水性ﾎﾞｰﾙﾍﾟﾝ(黒)")
+			Expect(nameErr).NotTo(HaveOccurred(), "テスト用商品名の生成に失敗しました。")
+			price, priceErr := products.NewProductPrice(500)
+			Expect(priceErr).NotTo(HaveOccurred(), "テスト用商品価格の生成に失敗しました。")
+			product, productErr := products.NewProduct(name, price, testCategory)
+			Expect(productErr).NotTo(HaveOccurred(), "テスト用商品の生成に失敗しました。")
+
+			createErr := rep.Create(ctx, tx, product)
+			Expect(createErr).To(HaveOccurred())
+			crudErr, ok := createErr.(*errs.CRUDError)
+			Expect(ok).To(BeTrue())
+			Expect(crudErr.Code).To(Equal("ALREADY_EXISTS"))
+		})
+
+		It("バーコードが重複するとALREADY_EXISTSエラーになること", func() {
+			// 水性ボールペン(黒)と同じバーコード
+			name, nameErr := products.NewProductName("バーコード重複商品")
+			Expect(nameErr).NotTo(HaveOccurred(), "テスト用商品名の生成に失敗しました。")
+			price, priceErr := products.NewProductPrice(500)
+			Expect(priceErr).NotTo(HaveOccurred(), "テスト用商品価格の生成に失敗しました。")
+			product, productErr := products.NewProduct(name, price, testCategory)
+			Expect(productErr).NotTo(HaveOccurred(), "テスト用商品の生成に失敗しました。")
+			barcode, barcodeErr := products.NewBarcode("4901234567894")
+			Expect(barcodeErr).NotTo(HaveOccurred(), "テスト用バーコードの生成に失敗しました。")
+			product.ChangeBarcode(barcode)
+
+			createErr := rep.Create(ctx, tx, product)
+			Expect(createErr).To(HaveOccurred())
+			crudErr, ok := createErr.(*errs.CRUDError)
+			Expect(ok).To(BeTrue())
+			Expect(crudErr.Code).To(Equal("ALREADY_EXISTS"))
+		})
 	})
 
 	Context("FindByIdの動作確認", func() {
@@ -288,6 +420,10 @@ var _ = Describe("productRepositoryImpl構造体", Ordered, Label("ProductReposi
 			Expect(product.Id().Value()).To(Equal("ac413f22-0cf1-490a-9635-7e9ca810e544"))
 			Expect(product.Name().Value()).To(Equal("水性ボールペン(黒)"))
 			Expect(product.Price().Value()).To(Equal(uint32(120)))
+			Expect(product.Price().Currency()).To(Equal("JPY"))
+			Expect(product.Price().TaxClass()).To(Equal(money.TaxClassStandard))
+			Expect(product.Barcode()).NotTo(BeNil())
+			Expect(product.Barcode().Value()).To(Equal("4901234567894"))
 			// Category情報も確認
 			Expect(product.Category()).NotTo(BeNil())
 			Expect(product.Category().Name().Value()).To(Equal("文房具"))
@@ -307,6 +443,26 @@ var _ = Describe("productRepositoryImpl構造体", Ordered, Label("ProductReposi
 		})
 	})
 
+	Context("FindIdBySlugの動作確認", func() {
+		DescribeTable("現在のスラッグと変更前のスラッグから商品IDを取得できること",
+			func(slug string) {
+				id, findErr := rep.FindIdBySlug(ctx, tx, names.Slug(slug))
+				Expect(findErr).NotTo(HaveOccurred(), "商品IDの取得に失敗しました。")
+				Expect(id.Value()).To(Equal("82014174-6785-4242-b307-a806fd1f8470"))
+			},
+			Entry("現在のスラッグ", "wireless-mouse"),
+			Entry("変更前のスラッグ", "waiyaresumausu"),
+		)
+
+		It("使用されていないスラッグはNOT_FOUNDエラーになること", func() {
+			_, findErr := rep.FindIdBySlug(ctx, tx, names.Slug("unknown-product"))
+			Expect(findErr).To(HaveOccurred())
+			crudErr, ok := findErr.(*errs.CRUDError)
+			Expect(ok).To(BeTrue(), "返されたエラーがCRUDErrorではありません")
+			Expect(crudErr.Code).To(Equal("NOT_FOUND"))
+		})
+	})
+
 	Context("UpdateByIdの動作確認", func() {
 		It("既存の商品を更新できること", func() {
 			// 既存の商品(水性ボールペン(黒))を取得して更新
@@ -316,13 +472,88 @@ var _ = Describe("productRepositoryImpl構造体", Ordered, Label("ProductReposi
 			Expect(nameErr).NotTo(HaveOccurred(), "テスト用商品名の生成に失敗しました。")
 			newPrice, priceErr := products.NewProductPrice(150)
 			Expect(priceErr).NotTo(HaveOccurred(), "テスト用商品価格の生成に失敗しました。")
-			product, productErr := products.BuildProduct(id, newName, newPrice, testCategory)
+			product, productErr := products.BuildProduct(id, newName, newPrice, testCategory, products.PRODUCT_PUBLISHED)
 			Expect(productErr).NotTo(HaveOccurred(), "テスト用商品の生成に失敗しました。")
 
 			updateErr := rep.UpdateById(ctx, tx, product)
 			Expect(updateErr).NotTo(HaveOccurred(), "商品の更新に失敗しました。")
 		})
 
+		It("通貨と税率区分を更新できること", func() {
+			id, idErr := products.NewProductId("ac413f22-0cf1-490a-9635-7e9ca810e544")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用商品IDの生成に失敗しました。")
+			name, nameErr := products.NewProductName("水性ボールペン(黒)")
+			Expect(nameErr).NotTo(HaveOccurred(), "テスト用商品名の生成に失敗しました。")
+			price, priceErr := products.NewProductPriceWithTax(199, "USD", money.TaxClassReduced)
+			Expect(priceErr).NotTo(HaveOccurred(), "テスト用商品価格の生成に失敗しました。")
+			product, productErr := products.BuildProduct(id, name, price, testCategory, products.PRODUCT_PUBLISHED)
+			Expect(productErr).NotTo(HaveOccurred(), "テスト用商品の生成に失敗しました。")
+
+			updateErr := rep.UpdateById(ctx, tx, product)
+			Expect(updateErr).NotTo(HaveOccurred(), "商品の更新に失敗しました。")
+
+			found, findErr := rep.FindById(ctx, tx, id)
+			Expect(findErr).NotTo(HaveOccurred(), "商品の取得に失敗しました。")
+			Expect(found.Price().Value()).To(Equal(uint32(199)))
+			Expect(found.Price().Currency()).To(Equal("USD"))
+			Expect(found.Price().TaxClass()).To(Equal(money.TaxClassReduced))
+		})
+
+		It("商品名の翻訳を置き換えられること", func() {
+			// 既存の商品(ワイヤレスマウス)は英語の商品名を持つ
+			id, idErr := products.NewProductId("82014174-6785-4242-b307-a806fd1f8470")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用商品IDの生成に失敗しました。")
+			product, findErr := rep.FindById(ctx, tx, id)
+			Expect(findErr).NotTo(HaveOccurred(), "商品の取得に失敗しました。")
+			Expect(product.Translations()).To(HaveKey(names.Locale("en")))
+
+			fr, nameErr := products.NewProductName("Souris sans fil")
+			Expect(nameErr).NotTo(HaveOccurred(), "テスト用商品名の生成に失敗しました。")
+			Expect(product.ChangeTranslations(map[names.Locale]*products.ProductName{"fr": fr})).To(Succeed())
+			Expect(rep.UpdateById(ctx, tx, product)).To(Succeed())
+
+			found, findErr := rep.FindById(ctx, tx, id)
+			Expect(findErr).NotTo(HaveOccurred(), "商品の取得に失敗しました。")
+			Expect(found.Translations()).To(HaveLen(1))
+			Expect(found.Translations()[names.Locale("fr")].Value()).To(Equal("Souris sans fil"))
+		})
+
+		It("スラッグを変更すると変更前のスラッグを履歴に記録すること", func() {
+			// 既存の商品(水性ボールペン(黒))のスラッグを変更し、元のスラッグに戻す
+			id, idErr := products.NewProductId("ac413f22-0cf1-490a-9635-7e9ca810e544")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用商品IDの生成に失敗しました。")
+			product, findErr := rep.FindById(ctx, tx, id)
+			Expect(findErr).NotTo(HaveOccurred(), "商品の取得に失敗しました。")
+			Expect(product.Slug()).To(Equal(names.Slug("water-based-ballpoint-pen-black")))
+
+			product.ChangeSlug("gel-pen-black")
+			Expect(rep.UpdateById(ctx, tx, product)).To(Succeed())
+			previousId, findErr := rep.FindIdBySlug(ctx, tx, "water-based-ballpoint-pen-black")
+			Expect(findErr).NotTo(HaveOccurred(), "変更前のスラッグから商品IDを取得できません。")
+			Expect(previousId.Value()).To(Equal(id.Value()))
+
+			product.ChangeSlug("water-based-ballpoint-pen-black")
+			Expect(rep.UpdateById(ctx, tx, product)).To(Succeed())
+			found, findErr := rep.FindById(ctx, tx, id)
+			Expect(findErr).NotTo(HaveOccurred(), "商品の取得に失敗しました。")
+			Expect(found.Slug()).To(Equal(names.Slug("water-based-ballpoint-pen-black")))
+		})
+
+		It("スラッグが重複するとALREADY_EXISTSエラーになること", func() {
+			// 水性ボールペン(黒)のスラッグをワイヤレスマウスと同じスラッグに変更する
+			id, idErr := products.NewProductId("ac413f22-0cf1-490a-9635-7e9ca810e544")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用商品IDの生成に失敗しました。")
+			product, findErr := rep.FindById(ctx, tx, id)
+			Expect(findErr).NotTo(HaveOccurred(), "商品の取得に失敗しました。")
+			product.ChangeSlug("wireless-mouse")
+
+			updateErr := rep.UpdateById(ctx, tx, product)
+			Expect(updateErr).To(HaveOccurred())
+			crudErr, ok := updateErr.(*errs.CRUDError)
+			Expect(ok).To(BeTrue())
+			Expect(crudErr.Code).To(Equal("ALREADY_EXISTS"))
+		})
+
 		It("存在しない商品IDで更新しようとするとエラーになること", func() {
 			id, idErr := products.NewProductId("00000000-0000-0000-0000-000000000000")
 			Expect(idErr).NotTo(HaveOccurred(), "テスト用商品IDの生成に失敗しました。")
@@ -330,7 +561,7 @@ var _ = Describe("productRepositoryImpl構造体", Ordered, Label("ProductReposi
 			Expect(nameErr).NotTo(HaveOccurred(), "テスト用商品名の生成に失敗しました。")
 			price, priceErr := products.NewProductPrice(100)
 			Expect(priceErr).NotTo(HaveOccurred(), "テスト用商品価格の生成に失敗しました。")
-			product, productErr := products.BuildProduct(id, name, price, testCategory)
+			product, productErr := products.BuildProduct(id, name, price, testCategory, products.PRODUCT_PUBLISHED)
 			Expect(productErr).NotTo(HaveOccurred(), "テスト用商品の生成に失敗しました。")
 
 			updateErr := rep.UpdateById(ctx, tx, product)
@@ -378,4 +609,198 @@ var _ = Describe("productRepositoryImpl構造体", Ordered, Label("ProductReposi
 		})
 	})
 
+	Context("LockIdsByCategoryIdsの動作確認", func() {
+		It("カテゴリに属する商品のIDを商品ID順に取得できること", func() {
+			// 初期データの鉛筆カテゴリには4件の商品が属する
+			pencil, idErr := categories.NewCategoryId("a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用カテゴリIDの生成に失敗しました。")
+
+			ids, lockErr := rep.LockIdsByCategoryIds(ctx, tx, []*categories.CategoryId{pencil}, nil)
+			Expect(lockErr).NotTo(HaveOccurred(), "商品IDの取得に失敗しました。")
+			Expect(ids).To(HaveLen(4))
+			Expect(ids[0].Value()).To(Equal("4b3db238-8ada-49b4-bb60-1a034914e528"))
+			Expect(ids[3].Value()).To(Equal("fbc43b9b-90a9-4712-925c-4d66a2a30372"))
+		})
+
+		It("指定したすべてのタグが付与された商品に絞り込めること", func() {
+			peripherals, idErr := categories.NewCategoryId("c05b1952-3bdf-4449-9b83-d0d123a667ce")
+			Expect(idErr).NotTo(HaveOccurred(), "テスト用カテゴリIDの生成に失敗しました。")
+
+			ids, lockErr := rep.LockIdsByCategoryIds(ctx, tx, []*categories.CategoryId{peripherals}, []string{"ワイヤレス"})
+			Expect(lockErr).NotTo(HaveOccurred(), "商品IDの取得に失敗しました。")
+			Expect(ids).To(HaveLen(3))
+			Expect(ids[0].Value()).To(Equal("82014174-6785-4242-b307-a806fd1f8470"))
+			Expect(ids[1].Value()).To(Equal("dc2e5a33-a2b7-4414-9a53-f9750e7da8ed"))
+			Expect(ids[2].Value()).To(Equal("ddd1e5ae-fb90-4a47-bb87-c91b305c7444"))
+
+			ids, lockErr = rep.LockIdsByCategoryIds(ctx, tx, []*categories.CategoryId{peripherals}, []string{"ワイヤレス", "ゲーミング"})
+			Expect(lockErr).NotTo(HaveOccurred(), "商品IDの取得に失敗しました。")
+			Expect(ids).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("tagRepositoryImpl構造体", Ordered, Label("TagRepositoryインターフェースメソッドのテスト"), func() {
+	var rep tags.TagRepository
+	var ctx context.Context
+	var tx *sql.Tx
+	var err error
+	var mouse, trackball *products.ProductId
+
+	BeforeAll(func() {
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		rep = NewTagRepositoryImpl(logger)
+		// ワイヤレスマウスとワイヤレストラックボールにはタグ「ワイヤレス」が付与済み
+		mouse, err = products.NewProductId("82014174-6785-4242-b307-a806fd1f8470")
+		Expect(err).NotTo(HaveOccurred())
+		trackball, err = products.NewProductId("ddd1e5ae-fb90-4a47-bb87-c91b305c7444")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		tx, err = boil.BeginTx(ctx, nil)
+		Expect(err).NotTo(HaveOccurred(), "トランザクションの開始に失敗しました。")
+	})
+
+	AfterEach(func() {
+		err = tx.Rollback()
+		Expect(err).NotTo(HaveOccurred(), "トランザクションのロールバックに失敗しました。")
+	})
+
+	newTags := func(values ...string) []*tags.Tag {
+		tagList := make([]*tags.Tag, 0, len(values))
+		for _, value := range values {
+			name, nameErr := tags.NewTagName(value)
+			Expect(nameErr).NotTo(HaveOccurred(), "テスト用タグ名の生成に失敗しました。")
+			tag, tagErr := tags.NewTag(name)
+			Expect(tagErr).NotTo(HaveOccurred(), "テスト用タグの生成に失敗しました。")
+			tagList = append(tagList, tag)
+		}
+		return tagList
+	}
+
+	Context("CreateIfNotExistsとLockByNamesの動作確認", func() {
+		It("存在しないタグのみ作成し、既存のタグは元のIDのまま取得できること", func() {
+			createErr := rep.CreateIfNotExists(ctx, tx, newTags("新商品", "ﾜｲﾔﾚｽ"))
+			Expect(createErr).NotTo(HaveOccurred(), "タグの作成に失敗しました。")
+
+			names, nameErr := tags.NewTagNames([]string{"新商品", "ワイヤレス", "存在しないタグ"})
+			Expect(nameErr).NotTo(HaveOccurred())
+			found, findErr := rep.LockByNames(ctx, tx, names)
+			Expect(findErr).NotTo(HaveOccurred(), "タグの取得に失敗しました。")
+			Expect(found).To(HaveLen(2))
+			ids := []string{found[0].Id().Value(), found[1].Id().Value()}
+			Expect(ids).To(ContainElement("0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a61"), "既存のタグのIDが変わっています。")
+		})
+	})
+
+	Context("AttachToProductsとDetachFromProductsの動作確認", func() {
+		It("付与済みの組み合わせを除いて付与し、付与済みの組み合わせのみ外すこと", func() {
+			names, nameErr := tags.NewTagNames([]string{"ワイヤレス", "ゲーミング"})
+			Expect(nameErr).NotTo(HaveOccurred())
+			found, findErr := rep.LockByNames(ctx, tx, names)
+			Expect(findErr).NotTo(HaveOccurred())
+			Expect(found).To(HaveLen(2))
+			tagIds := []*tags.TagId{found[0].Id(), found[1].Id()}
+
+			// 4組のうち「ワイヤレス」の2組は付与済み
+			attached, attachErr := rep.AttachToProducts(ctx, tx, []*products.ProductId{mouse, trackball}, tagIds)
+			Expect(attachErr).NotTo(HaveOccurred(), "タグの付与に失敗しました。")
+			Expect(attached).To(Equal(int64(2)))
+
+			detached, detachErr := rep.DetachFromProducts(ctx, tx, []*products.ProductId{mouse, trackball}, tagIds)
+			Expect(detachErr).NotTo(HaveOccurred(), "タグの削除に失敗しました。")
+			Expect(detached).To(Equal(int64(4)))
+		})
+
+		It("存在しない商品に付与しようとするとエラーになること", func() {
+			names, nameErr := tags.NewTagNames([]string{"ワイヤレス"})
+			Expect(nameErr).NotTo(HaveOccurred())
+			found, findErr := rep.LockByNames(ctx, tx, names)
+			Expect(findErr).NotTo(HaveOccurred())
+			unknown, idErr := products.NewProductId("00000000-0000-0000-0000-000000000000")
+			Expect(idErr).NotTo(HaveOccurred())
+
+			_, attachErr := rep.AttachToProducts(ctx, tx, []*products.ProductId{unknown}, []*tags.TagId{found[0].Id()})
+			Expect(attachErr).To(HaveOccurred())
+		})
+	})
+})
+
+var _ = Describe("priceScheduleRepositoryImpl構造体", Ordered, Label("PriceScheduleRepositoryインターフェースメソッドのテスト"), func() {
+	var rep pricing.PriceScheduleRepository
+	var ctx context.Context
+	var tx *sql.Tx
+	var err error
+	var pen *products.ProductId
+	var now time.Time
+
+	BeforeAll(func() {
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		rep = NewPriceScheduleRepositoryImpl(logger)
+		// 水性ボールペン(黒)
+		pen, err = products.NewProductId("ac413f22-0cf1-490a-9635-7e9ca810e544")
+		Expect(err).NotTo(HaveOccurred())
+		now = time.Now().UTC().Truncate(time.Second)
+	})
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		tx, err = boil.BeginTx(ctx, nil)
+		Expect(err).NotTo(HaveOccurred(), "トランザクションの開始に失敗しました。")
+	})
+
+	AfterEach(func() {
+		err = tx.Rollback()
+		Expect(err).NotTo(HaveOccurred(), "トランザクションのロールバックに失敗しました。")
+	})
+
+	newSchedule := func(startsAt time.Time, endsAt time.Time) *pricing.PriceSchedule {
+		schedule, scheduleErr := pricing.NewPriceSchedule(pen, 100, startsAt, endsAt, now)
+		Expect(scheduleErr).NotTo(HaveOccurred(), "テスト用価格スケジュールの生成に失敗しました。")
+		Expect(rep.Create(ctx, tx, schedule)).To(Succeed(), "価格スケジュールの作成に失敗しました。")
+		return schedule
+	}
+
+	It("作成した価格スケジュールをロックして取得し、状態を更新できること", func() {
+		schedule := newSchedule(now.Add(time.Hour), now.Add(2*time.Hour))
+
+		found, findErr := rep.LockById(ctx, tx, schedule.Id())
+		Expect(findErr).NotTo(HaveOccurred())
+		Expect(found.Price()).To(Equal(uint32(100)))
+		Expect(found.Status()).To(Equal(pricing.PRICE_SCHEDULE_SCHEDULED))
+		Expect(found.StartsAt().Equal(schedule.StartsAt())).To(BeTrue())
+
+		Expect(found.Cancel(nil)).To(Succeed())
+		Expect(rep.Update(ctx, tx, found)).To(Succeed())
+
+		pending, pendingErr := rep.FindPendingByProductId(ctx, tx, pen)
+		Expect(pendingErr).NotTo(HaveOccurred())
+		Expect(pending).To(BeEmpty())
+	})
+
+	It("開始時刻を過ぎた予定の価格スケジュールのみ処理対象として取得すること", func() {
+		due := newSchedule(now.Add(-time.Minute), now.Add(time.Hour))
+		newSchedule(now.Add(2*time.Hour), now.Add(3*time.Hour))
+
+		ids, findErr := rep.FindDueIds(ctx, tx, now, 10)
+		Expect(findErr).NotTo(HaveOccurred())
+		Expect(ids).To(HaveLen(1))
+		Expect(ids[0].Equals(due.Id())).To(BeTrue())
+
+		pending, pendingErr := rep.FindPendingByProductId(ctx, tx, pen)
+		Expect(pendingErr).NotTo(HaveOccurred())
+		Expect(pending).To(HaveLen(2))
+		Expect(pending[0].Id().Equals(due.Id())).To(BeTrue(), "開始時刻順に取得されていません。")
+	})
+
+	It("存在しない価格スケジュールIDの場合はNOT_FOUNDになること", func() {
+		id, idErr := pricing.NewPriceScheduleId("00000000-0000-0000-0000-000000000000")
+		Expect(idErr).NotTo(HaveOccurred())
+		_, findErr := rep.FindById(ctx, tx, id)
+		crudErr, ok := findErr.(*errs.CRUDError)
+		Expect(ok).To(BeTrue())
+		Expect(crudErr.Code).To(Equal("NOT_FOUND"))
+	})
 })
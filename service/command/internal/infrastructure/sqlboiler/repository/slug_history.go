@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/dialect"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/handler"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/models"
+)
+
+// slugHistoryTable は変更前のスラッグを保存するテーブルです。
+// 履歴テーブルはスラッグを主キーとする関連テーブルのため、モデルを使用せずにクエリを発行します。
+type slugHistoryTable struct {
+	name        string // テーブル名
+	keyColumn   string // スラッグを使用していたエンティティのIDを保存する列名
+	entityTable string // 現在のスラッグを保存するエンティティのテーブル名
+}
+
+var (
+	// productSlugHistory は商品のスラッグの履歴テーブルです。
+	productSlugHistory = slugHistoryTable{name: models.TableNames.ProductSlugHistory, keyColumn: "product_id", entityTable: models.TableNames.Product}
+	// categorySlugHistory はカテゴリのスラッグの履歴テーブルです。
+	categorySlugHistory = slugHistoryTable{name: models.TableNames.CategorySlugHistory, keyColumn: "category_id", entityTable: models.TableNames.Category}
+)
+
+// findOwnerId は指定されたスラッグを現在のスラッグまたは履歴として使用しているエンティティのIDを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - slug: スラッグ
+//
+// Returns:
+//   - string: スラッグを使用しているエンティティのID
+//   - bool: スラッグが使用されている場合はtrue
+//   - error: データベースエラーが発生した場合
+func (t slugHistoryTable) findOwnerId(ctx context.Context, tx *sql.Tx, slug names.Slug) (string, bool, error) {
+	query := fmt.Sprintf(
+		"SELECT `obj_id` AS `owner_id` FROM `%s` WHERE `slug` = ? UNION ALL SELECT `%s` FROM `%s` WHERE `slug` = ? LIMIT 1",
+		t.entityTable, t.keyColumn, t.name,
+	)
+	var records []struct {
+		OwnerID string `boil:"owner_id"`
+	}
+	if err := queries.Raw(dialect.Rebind(query), slug.Value(), slug.Value()).Bind(ctx, tx, &records); err != nil {
+		return "", false, handler.DBErrHandler(err)
+	}
+	if len(records) == 0 {
+		return "", false, nil
+	}
+	return records[0].OwnerID, true, nil
+}
+
+// record はスラッグの変更を履歴に記録します。
+// 変更前のスラッグを履歴に追加し、変更後のスラッグが自身の履歴にある場合は現在のスラッグに戻すため履歴から削除します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: スラッグを変更したエンティティのID
+//   - from: 変更前のスラッグ
+//   - to: 変更後のスラッグ
+//
+// Returns:
+//   - error: データベースエラーが発生した場合
+func (t slugHistoryTable) record(ctx context.Context, tx *sql.Tx, id string, from names.Slug, to names.Slug) error {
+	if from == to {
+		return nil
+	}
+	query := fmt.Sprintf("DELETE FROM `%s` WHERE `slug` = ? AND `%s` = ?", t.name, t.keyColumn)
+	if _, err := queries.Raw(dialect.Rebind(query), to.Value(), id).ExecContext(ctx, tx); err != nil {
+		return handler.DBErrHandler(err)
+	}
+	if from == "" {
+		return nil
+	}
+	query = fmt.Sprintf("INSERT INTO `%s` (`slug`,`%s`) VALUES (?,?)", t.name, t.keyColumn)
+	if _, err := queries.Raw(dialect.Rebind(query), from.Value(), id).ExecContext(ctx, tx); err != nil {
+		return handler.DBErrHandler(err)
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/stocks"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/dialect"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/handler"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/models"
+)
+
+// ensureStockQuery は在庫が未登録の場合に在庫数0で登録し、在庫行の排他ロックを取得するクエリです。
+// 重複時も更新として扱われるため、登録済みかどうかに関わらず行ロックを取得できます。
+var ensureStockQuery = "INSERT INTO `stock` (`product_id`,`on_hand`,`reserved`) VALUES (?,0,0) " +
+	dialect.OnDuplicateKeyNoop("stock", "product_id", "on_hand")
+
+// StockRepositoryImpl は在庫リポジトリのSQLBoilerを使用した実装です。
+type StockRepositoryImpl struct {
+	logger *slog.Logger
+}
+
+// NewStockRepositoryImpl は新しいStockRepositoryImplインスタンスを生成します。
+func NewStockRepositoryImpl(logger *slog.Logger) *StockRepositoryImpl {
+	models.AddStockReservationHook(boil.AfterInsertHook, stockReservationHookFactory(boil.AfterInsertHook, logger))
+	return &StockRepositoryImpl{logger: logger}
+}
+
+// LockByProductId は指定された商品の在庫を排他ロックして取得します。
+// 在庫が未登録の場合は在庫数0で登録してからロックします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//
+// Returns:
+//   - *stocks.Stock: 取得した在庫集約
+//   - error: 商品が存在しない場合やデータベースエラー
+func (r *StockRepositoryImpl) LockByProductId(ctx context.Context, tx *sql.Tx, productId *products.ProductId) (*stocks.Stock, error) {
+	ctx = handler.WithDebug(ctx)
+	if _, err := queries.Raw(dialect.Rebind(ensureStockQuery), productId.Value()).ExecContext(ctx, tx); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to ensure stock", slog.Any("error", err))
+		return nil, handler.DBErrHandler(err)
+	}
+	// NOTE: REPEATABLE READでも最新のコミット済みの値を読むため、ロック読み取り(FOR UPDATE)を使用する
+	condition := models.StockWhere.ProductID.EQ(productId.Value())
+	model, err := models.Stocks(condition, qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to lock stock", slog.Any("error", err))
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("商品番号: %s の在庫は存在しません。", productId.Value()))
+		}
+		return nil, handler.DBErrHandler(err)
+	}
+	return stocks.BuildStock(productId, uint32(model.OnHand), uint32(model.Reserved))
+}
+
+// Update は在庫数と引当済みの数量を更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - stock: 更新する在庫集約
+//
+// Returns:
+//   - error: データベースエラー
+func (r *StockRepositoryImpl) Update(ctx context.Context, tx *sql.Tx, stock *stocks.Stock) error {
+	ctx = handler.WithDebug(ctx)
+	condition := models.StockWhere.ProductID.EQ(stock.ProductId().Value())
+	// NOTE: MySQLは値が変わらない行を更新件数に含めないため、更新件数による存在確認は行わない
+	if _, err := models.Stocks(condition).UpdateAll(ctx, tx, models.M{
+		models.StockColumns.OnHand:   int(stock.OnHand()),
+		models.StockColumns.Reserved: int(stock.Reserved()),
+	}); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to update stock", slog.Any("error", err))
+		return handler.DBErrHandler(err)
+	}
+	r.logger.InfoContext(ctx, "在庫を変更しました。",
+		slog.String("product_id", stock.ProductId().Value()),
+		slog.Int("on_hand", int(stock.OnHand())),
+		slog.Int("reserved", int(stock.Reserved())),
+	)
+	return nil
+}
+
+// CreateReservation は新しい在庫引当をデータベースに追加します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - reservation: 作成する在庫引当
+//
+// Returns:
+//   - error: データベースエラー
+func (r *StockRepositoryImpl) CreateReservation(ctx context.Context, tx *sql.Tx, reservation *stocks.Reservation) error {
+	ctx = handler.WithDebug(ctx)
+	newReservation := models.StockReservation{
+		ObjID:     reservation.Id().Value(),
+		ProductID: reservation.ProductId().Value(),
+		Quantity:  int(reservation.Quantity().Value()),
+		Status:    string(reservation.Status()),
+		ExpiresAt: reservation.ExpiresAt(),
+	}
+	if err := newReservation.Insert(ctx, tx, boil.Infer()); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to create stock reservation", slog.Any("error", err))
+		return handler.DBErrHandler(err)
+	}
+	return nil
+}
+
+// FindReservationById は指定されたIDの在庫引当を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 引当ID
+//
+// Returns:
+//   - *stocks.Reservation: 見つかった在庫引当
+//   - error: 引当が存在しない場合やデータベースエラー
+func (r *StockRepositoryImpl) FindReservationById(ctx context.Context, tx *sql.Tx, id *stocks.ReservationId) (*stocks.Reservation, error) {
+	ctx = handler.WithDebug(ctx)
+	return r.findReservation(ctx, tx, id)
+}
+
+// LockReservationById は指定されたIDの在庫引当を排他ロックして取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 引当ID
+//
+// Returns:
+//   - *stocks.Reservation: 見つかった在庫引当
+//   - error: 引当が存在しない場合やデータベースエラー
+func (r *StockRepositoryImpl) LockReservationById(ctx context.Context, tx *sql.Tx, id *stocks.ReservationId) (*stocks.Reservation, error) {
+	ctx = handler.WithDebug(ctx)
+	return r.findReservation(ctx, tx, id, qm.For("UPDATE"))
+}
+
+// FindExpiredReservations は指定された商品の有効期限切れの引当中の在庫引当を排他ロックして取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productId: 商品ID
+//   - now: 基準時刻
+//
+// Returns:
+//   - []*stocks.Reservation: 有効期限切れの在庫引当
+//   - error: データベースエラー
+func (r *StockRepositoryImpl) FindExpiredReservations(ctx context.Context, tx *sql.Tx, productId *products.ProductId, now time.Time) ([]*stocks.Reservation, error) {
+	ctx = handler.WithDebug(ctx)
+	modelSlice, err := models.StockReservations(
+		models.StockReservationWhere.ProductID.EQ(productId.Value()),
+		models.StockReservationWhere.Status.EQ(string(stocks.RESERVATION_RESERVED)),
+		models.StockReservationWhere.ExpiresAt.LTE(now),
+		qm.For("UPDATE"),
+	).All(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find expired stock reservations", slog.Any("error", err))
+		return nil, handler.DBErrHandler(err)
+	}
+	reservations := make([]*stocks.Reservation, 0, len(modelSlice))
+	for _, model := range modelSlice {
+		reservation, buildErr := buildReservation(model)
+		if buildErr != nil {
+			return nil, buildErr
+		}
+		reservations = append(reservations, reservation)
+	}
+	return reservations, nil
+}
+
+// FindProductIdsWithExpiredReservations は有効期限切れの引当中の在庫引当を持つ商品IDを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - now: 基準時刻
+//   - limit: 最大件数
+//
+// Returns:
+//   - []*products.ProductId: 商品ID
+//   - error: データベースエラー
+func (r *StockRepositoryImpl) FindProductIdsWithExpiredReservations(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]*products.ProductId, error) {
+	ctx = handler.WithDebug(ctx)
+	modelSlice, err := models.StockReservations(
+		qm.Select("DISTINCT "+models.StockReservationColumns.ProductID),
+		models.StockReservationWhere.Status.EQ(string(stocks.RESERVATION_RESERVED)),
+		models.StockReservationWhere.ExpiresAt.LTE(now),
+		qm.Limit(limit),
+	).All(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find products with expired stock reservations", slog.Any("error", err))
+		return nil, handler.DBErrHandler(err)
+	}
+	productIds := make([]*products.ProductId, 0, len(modelSlice))
+	for _, model := range modelSlice {
+		productId, idErr := products.NewProductId(model.ProductID)
+		if idErr != nil {
+			return nil, idErr
+		}
+		productIds = append(productIds, productId)
+	}
+	return productIds, nil
+}
+
+// UpdateReservationStatus は在庫引当の状態を更新します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - reservation: 更新する在庫引当
+//
+// Returns:
+//   - error: 引当が存在しない場合やデータベースエラー
+func (r *StockRepositoryImpl) UpdateReservationStatus(ctx context.Context, tx *sql.Tx, reservation *stocks.Reservation) error {
+	ctx = handler.WithDebug(ctx)
+	condition := models.StockReservationWhere.ObjID.EQ(reservation.Id().Value())
+	rowsAff, err := models.StockReservations(condition).UpdateAll(ctx, tx, models.M{
+		models.StockReservationColumns.Status: string(reservation.Status()),
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to update stock reservation", slog.Any("error", err))
+		return handler.DBErrHandler(err)
+	}
+	if rowsAff == 0 {
+		return errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("引当番号: %s は存在しないため、更新できませんでした。", reservation.Id().Value()))
+	}
+	r.logger.InfoContext(ctx, "在庫引当を変更しました。",
+		slog.String("obj_id", reservation.Id().Value()),
+		slog.String("product_id", reservation.ProductId().Value()),
+		slog.String("status", string(reservation.Status())),
+	)
+	return nil
+}
+
+// findReservation は指定されたIDの在庫引当を取得します。
+func (r *StockRepositoryImpl) findReservation(ctx context.Context, tx *sql.Tx, id *stocks.ReservationId, mods ...qm.QueryMod) (*stocks.Reservation, error) {
+	mods = append(mods, models.StockReservationWhere.ObjID.EQ(id.Value()))
+	model, err := models.StockReservations(mods...).One(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to find stock reservation by ID", slog.Any("error", err))
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("引当番号: %s は存在しません。", id.Value()))
+		}
+		return nil, handler.DBErrHandler(err)
+	}
+	return buildReservation(model)
+}
+
+// buildReservation はSQLBoilerのモデルから在庫引当エンティティを再構築します。
+func buildReservation(model *models.StockReservation) (*stocks.Reservation, error) {
+	id, err := stocks.NewReservationId(model.ObjID)
+	if err != nil {
+		return nil, err
+	}
+	productId, err := products.NewProductId(model.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	quantity, err := stocks.NewQuantity(uint32(model.Quantity))
+	if err != nil {
+		return nil, err
+	}
+	status, err := stocks.ParseReservationStatus(model.Status)
+	if err != nil {
+		return nil, err
+	}
+	return stocks.BuildReservation(id, productId, quantity, status, model.ExpiresAt)
+}
+
+// stockReservationHookFactory は指定されたフックタイプに応じた在庫引当用フック関数を生成します。
+//
+// Parameters:
+//   - hookType: フックのタイプ
+//   - logger: ロガー
+//
+// Returns:
+//   - func: SQLBoilerのフック関数
+//
+// Panics:
+//   - hookTypeが想定外の値の場合
+func stockReservationHookFactory(hookType boil.HookPoint, logger *slog.Logger) func(ctx context.Context, exec boil.ContextExecutor, reservation *models.StockReservation) error {
+	switch hookType {
+	case boil.AfterInsertHook:
+		return func(ctx context.Context, exec boil.ContextExecutor, reservation *models.StockReservation) error {
+			logger.InfoContext(ctx, "在庫を引き当てました。",
+				slog.String("obj_id", reservation.ObjID),
+				slog.String("product_id", reservation.ProductID),
+				slog.Int("quantity", reservation.Quantity),
+				slog.Time("expires_at", reservation.ExpiresAt),
+			)
+			return nil
+		}
+	default:
+		panic("Invalid hookType")
+	}
+}
+
+var _ stocks.StockRepository = (*StockRepositoryImpl)(nil)
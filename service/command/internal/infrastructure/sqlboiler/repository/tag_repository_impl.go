@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/tags"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/dialect"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/handler"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/models"
+)
+
+// NOTE: 重複時も更新として扱うことで、INSERT IGNOREと異なり外部キー制約違反などのエラーは握りつぶさない
+// クエリはMySQLの記法で記述し、実行時にdialect.Rebindでビルドしたデータベースの方言に変換する
+var (
+	// createTagQuery はタグを作成するクエリです。VALUES句は作成するタグの数だけ繰り返します。
+	createTagQuery = "INSERT INTO `tag` (`obj_id`,`name`,`name_key`) VALUES %s " +
+		dialect.OnDuplicateKeyNoop("tag", "name_key", "name_key")
+	// attachTagQuery は商品にタグを付与するクエリです。VALUES句は商品とタグの組み合わせの数だけ繰り返します。
+	// 既に付与済みの組み合わせは更新件数に含まれません。
+	attachTagQuery = "INSERT INTO `product_tag` (`product_id`,`tag_id`) VALUES %s " +
+		dialect.OnDuplicateKeySkip("tag_id")
+	// detachTagQuery は商品からタグを外すクエリです。
+	detachTagQuery = "DELETE FROM `product_tag` WHERE `product_id` IN (%s) AND `tag_id` IN (%s)"
+)
+
+// TagRepositoryImpl はタグリポジトリのSQLBoilerを使用した実装です。
+// 商品とタグの関連（product_tag）は主キーのみの関連テーブルのため、モデルを使用せずにクエリを発行します。
+type TagRepositoryImpl struct {
+	logger *slog.Logger
+}
+
+// NewTagRepositoryImpl は新しいTagRepositoryImplインスタンスを生成します。
+func NewTagRepositoryImpl(logger *slog.Logger) *TagRepositoryImpl {
+	return &TagRepositoryImpl{logger: logger}
+}
+
+// CreateIfNotExists は正規化キーが同じタグが存在しない場合のみタグを作成します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - tagList: 作成するタグ
+//
+// Returns:
+//   - error: データベースエラーが発生した場合
+func (r *TagRepositoryImpl) CreateIfNotExists(ctx context.Context, tx *sql.Tx, tagList []*tags.Tag) error {
+	ctx = handler.WithDebug(ctx)
+	if len(tagList) == 0 {
+		return nil
+	}
+	args := make([]any, 0, len(tagList)*3)
+	for _, tag := range tagList {
+		args = append(args, tag.Id().Value(), tag.Name().Value(), tag.Name().Key())
+	}
+	query := strings.Replace(createTagQuery, "%s", placeholders(len(tagList), 3), 1)
+	if _, err := queries.Raw(dialect.Rebind(query), args...).ExecContext(ctx, tx); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to create tags", slog.Any("error", err))
+		return handler.DBErrHandler(err)
+	}
+	return nil
+}
+
+// LockByNames は指定されたタグ名のタグを共有ロック（SELECT ... FOR SHARE）して取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - names: 取得するタグ名
+//
+// Returns:
+//   - []*tags.Tag: 取得したタグ
+//   - error: データベースエラーが発生した場合
+func (r *TagRepositoryImpl) LockByNames(ctx context.Context, tx *sql.Tx, names []*tags.TagName) ([]*tags.Tag, error) {
+	ctx = handler.WithDebug(ctx)
+	if len(names) == 0 {
+		return []*tags.Tag{}, nil
+	}
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, name.Key())
+	}
+	// NOTE: REPEATABLE READでも他のトランザクションが作成したタグを読むため、ロック読み取りを使用する
+	modelSlice, err := models.Tags(models.TagWhere.NameKey.IN(keys), qm.For("SHARE")).All(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to lock tags", slog.Any("error", err))
+		return nil, handler.DBErrHandler(err)
+	}
+	tagList := make([]*tags.Tag, 0, len(modelSlice))
+	for _, model := range modelSlice {
+		tag, buildErr := toTagEntity(model)
+		if buildErr != nil {
+			return nil, buildErr
+		}
+		tagList = append(tagList, tag)
+	}
+	return tagList, nil
+}
+
+// AttachToProducts は指定されたすべての商品に指定されたすべてのタグを付与します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productIds: タグを付与する商品のID
+//   - tagIds: 付与するタグのID
+//
+// Returns:
+//   - int64: 新たに付与した商品とタグの組み合わせの数
+//   - error: データベースエラーが発生した場合
+func (r *TagRepositoryImpl) AttachToProducts(ctx context.Context, tx *sql.Tx, productIds []*products.ProductId, tagIds []*tags.TagId) (int64, error) {
+	ctx = handler.WithDebug(ctx)
+	if len(productIds) == 0 || len(tagIds) == 0 {
+		return 0, nil
+	}
+	args := make([]any, 0, len(productIds)*len(tagIds)*2)
+	for _, productId := range productIds {
+		for _, tagId := range tagIds {
+			args = append(args, productId.Value(), tagId.Value())
+		}
+	}
+	query := strings.Replace(attachTagQuery, "%s", placeholders(len(productIds)*len(tagIds), 2), 1)
+	// NOTE: 重複時は値が変わらないため、影響を受けた行数は新たに付与した組み合わせの数になる
+	result, err := queries.Raw(dialect.Rebind(query), args...).ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to attach tags", slog.Any("error", err))
+		return 0, handler.DBErrHandler(err)
+	}
+	attached, err := result.RowsAffected()
+	if err != nil {
+		return 0, handler.DBErrHandler(err)
+	}
+	r.logger.InfoContext(ctx, "商品にタグを付与しました。", slog.Int64("attached", attached))
+	return attached, nil
+}
+
+// DetachFromProducts は指定されたすべての商品から指定されたすべてのタグを外します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - productIds: タグを外す商品のID
+//   - tagIds: 外すタグのID
+//
+// Returns:
+//   - int64: 外した商品とタグの組み合わせの数
+//   - error: データベースエラーが発生した場合
+func (r *TagRepositoryImpl) DetachFromProducts(ctx context.Context, tx *sql.Tx, productIds []*products.ProductId, tagIds []*tags.TagId) (int64, error) {
+	ctx = handler.WithDebug(ctx)
+	if len(productIds) == 0 || len(tagIds) == 0 {
+		return 0, nil
+	}
+	args := make([]any, 0, len(productIds)+len(tagIds))
+	for _, productId := range productIds {
+		args = append(args, productId.Value())
+	}
+	for _, tagId := range tagIds {
+		args = append(args, tagId.Value())
+	}
+	query := strings.Replace(detachTagQuery, "%s", placeholders(len(productIds), 1), 1)
+	query = strings.Replace(query, "%s", placeholders(len(tagIds), 1), 1)
+	result, err := queries.Raw(dialect.Rebind(query), args...).ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to detach tags", slog.Any("error", err))
+		return 0, handler.DBErrHandler(err)
+	}
+	detached, err := result.RowsAffected()
+	if err != nil {
+		return 0, handler.DBErrHandler(err)
+	}
+	r.logger.InfoContext(ctx, "商品からタグを外しました。", slog.Int64("detached", detached))
+	return detached, nil
+}
+
+// toTagEntity はSQLBoilerのモデルをタグエンティティに変換します。
+func toTagEntity(model *models.Tag) (*tags.Tag, error) {
+	id, err := tags.NewTagId(model.ObjID)
+	if err != nil {
+		return nil, err
+	}
+	name, err := tags.NewTagName(model.Name)
+	if err != nil {
+		return nil, err
+	}
+	return tags.BuildTag(id, name)
+}
+
+// placeholders はクエリのプレースホルダを生成します。
+// columnsが1の場合は「?,?」、2以上の場合は「(?,?),(?,?)」の形式になります。
+//
+// Parameters:
+//   - rows: 行数
+//   - columns: 1行あたりの列数
+//
+// Returns:
+//   - string: プレースホルダ
+func placeholders(rows int, columns int) string {
+	if columns == 1 {
+		return strings.TrimSuffix(strings.Repeat("?,", rows), ",")
+	}
+	row := "(" + strings.TrimSuffix(strings.Repeat("?,", columns), ",") + ")"
+	return strings.TrimSuffix(strings.Repeat(row+",", rows), ",")
+}
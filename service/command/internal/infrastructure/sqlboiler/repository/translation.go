@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/dialect"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/handler"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/infrastructure/sqlboiler/models"
+)
+
+// translationTable は名前の翻訳を保存するテーブルです。
+// 翻訳テーブルは主キーのみで識別する関連テーブルのため、モデルを使用せずにクエリを発行します。
+type translationTable struct {
+	name      string // テーブル名
+	keyColumn string // 翻訳元のエンティティのIDを保存する列名
+}
+
+var (
+	// productNameTranslation は商品名の翻訳テーブルです。
+	productNameTranslation = translationTable{name: models.TableNames.ProductNameTranslation, keyColumn: "product_id"}
+	// categoryNameTranslation はカテゴリ名の翻訳テーブルです。
+	categoryNameTranslation = translationTable{name: models.TableNames.CategoryNameTranslation, keyColumn: "category_id"}
+)
+
+// translationRecord は翻訳テーブルの1行です。
+type translationRecord struct {
+	Locale string `boil:"locale"`
+	Name   string `boil:"name"`
+}
+
+// find は指定されたエンティティの翻訳をロケールごとに取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 翻訳元のエンティティのID
+//
+// Returns:
+//   - map[names.Locale]string: ロケールごとの名前
+//   - error: データベースエラーが発生した場合
+func (t translationTable) find(ctx context.Context, tx *sql.Tx, id string) (map[names.Locale]string, error) {
+	query := fmt.Sprintf("SELECT `locale`,`name` FROM `%s` WHERE `%s` = ?", t.name, t.keyColumn)
+	var records []*translationRecord
+	if err := queries.Raw(dialect.Rebind(query), id).Bind(ctx, tx, &records); err != nil {
+		return nil, handler.DBErrHandler(err)
+	}
+	translations := make(map[names.Locale]string, len(records))
+	for _, record := range records {
+		translations[names.Locale(record.Locale)] = record.Name
+	}
+	return translations, nil
+}
+
+// replace は指定されたエンティティの翻訳をすべて置き換えます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - tx: トランザクション
+//   - id: 翻訳元のエンティティのID
+//   - translations: ロケールごとの名前
+//
+// Returns:
+//   - error: データベースエラーが発生した場合
+func (t translationTable) replace(ctx context.Context, tx *sql.Tx, id string, translations map[names.Locale]string) error {
+	query := fmt.Sprintf("DELETE FROM `%s` WHERE `%s` = ?", t.name, t.keyColumn)
+	if _, err := queries.Raw(dialect.Rebind(query), id).ExecContext(ctx, tx); err != nil {
+		return handler.DBErrHandler(err)
+	}
+	if len(translations) == 0 {
+		return nil
+	}
+	args := make([]any, 0, len(translations)*3)
+	for locale, name := range translations {
+		args = append(args, id, locale.Value(), name)
+	}
+	query = fmt.Sprintf("INSERT INTO `%s` (`%s`,`locale`,`name`) VALUES %s", t.name, t.keyColumn, placeholders(len(translations), 3))
+	if _, err := queries.Raw(dialect.Rebind(query), args...).ExecContext(ctx, tx); err != nil {
+		return handler.DBErrHandler(err)
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	reflect "reflect"
 
 	categories "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	names "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -99,6 +100,21 @@ func (mr *MockCategoryRepositoryMockRecorder) ExistsByName(ctx, tx, name any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByName", reflect.TypeOf((*MockCategoryRepository)(nil).ExistsByName), ctx, tx, name)
 }
 
+// ExistsByParentId mocks base method.
+func (m *MockCategoryRepository) ExistsByParentId(ctx context.Context, tx *sql.Tx, parentId *categories.CategoryId) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByParentId", ctx, tx, parentId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByParentId indicates an expected call of ExistsByParentId.
+func (mr *MockCategoryRepositoryMockRecorder) ExistsByParentId(ctx, tx, parentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByParentId", reflect.TypeOf((*MockCategoryRepository)(nil).ExistsByParentId), ctx, tx, parentId)
+}
+
 // FindById mocks base method.
 func (m *MockCategoryRepository) FindById(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) (*categories.Category, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +130,66 @@ func (mr *MockCategoryRepositoryMockRecorder) FindById(ctx, tx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockCategoryRepository)(nil).FindById), ctx, tx, id)
 }
 
+// FindIdBySlug mocks base method.
+func (m *MockCategoryRepository) FindIdBySlug(ctx context.Context, tx *sql.Tx, slug names.Slug) (*categories.CategoryId, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdBySlug", ctx, tx, slug)
+	ret0, _ := ret[0].(*categories.CategoryId)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdBySlug indicates an expected call of FindIdBySlug.
+func (mr *MockCategoryRepositoryMockRecorder) FindIdBySlug(ctx, tx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdBySlug", reflect.TypeOf((*MockCategoryRepository)(nil).FindIdBySlug), ctx, tx, slug)
+}
+
+// FindSubtreeIds mocks base method.
+func (m *MockCategoryRepository) FindSubtreeIds(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) ([]*categories.CategoryId, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubtreeIds", ctx, tx, id)
+	ret0, _ := ret[0].([]*categories.CategoryId)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubtreeIds indicates an expected call of FindSubtreeIds.
+func (mr *MockCategoryRepositoryMockRecorder) FindSubtreeIds(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubtreeIds", reflect.TypeOf((*MockCategoryRepository)(nil).FindSubtreeIds), ctx, tx, id)
+}
+
+// LockById mocks base method.
+func (m *MockCategoryRepository) LockById(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) (*categories.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockById", ctx, tx, id)
+	ret0, _ := ret[0].(*categories.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockById indicates an expected call of LockById.
+func (mr *MockCategoryRepositoryMockRecorder) LockById(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockById", reflect.TypeOf((*MockCategoryRepository)(nil).LockById), ctx, tx, id)
+}
+
+// LockPath mocks base method.
+func (m *MockCategoryRepository) LockPath(ctx context.Context, tx *sql.Tx, id *categories.CategoryId) ([]*categories.CategoryId, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPath", ctx, tx, id)
+	ret0, _ := ret[0].([]*categories.CategoryId)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockPath indicates an expected call of LockPath.
+func (mr *MockCategoryRepositoryMockRecorder) LockPath(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPath", reflect.TypeOf((*MockCategoryRepository)(nil).LockPath), ctx, tx, id)
+}
+
 // UpdateById mocks base method.
 func (m *MockCategoryRepository) UpdateById(ctx context.Context, tx *sql.Tx, category *categories.Category) error {
 	m.ctrl.T.Helper()
@@ -127,3 +203,17 @@ func (mr *MockCategoryRepositoryMockRecorder) UpdateById(ctx, tx, category any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateById", reflect.TypeOf((*MockCategoryRepository)(nil).UpdateById), ctx, tx, category)
 }
+
+// UpdateParentById mocks base method.
+func (m *MockCategoryRepository) UpdateParentById(ctx context.Context, tx *sql.Tx, category *categories.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateParentById", ctx, tx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateParentById indicates an expected call of UpdateParentById.
+func (mr *MockCategoryRepositoryMockRecorder) UpdateParentById(ctx, tx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateParentById", reflect.TypeOf((*MockCategoryRepository)(nil).UpdateParentById), ctx, tx, category)
+}
@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: price_schedule_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=price_schedule_repository.go -destination=../../../mock/repository/price_schedule_repository_mock.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+	time "time"
+
+	pricing "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/pricing"
+	products "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPriceScheduleRepository is a mock of PriceScheduleRepository interface.
+type MockPriceScheduleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceScheduleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPriceScheduleRepositoryMockRecorder is the mock recorder for MockPriceScheduleRepository.
+type MockPriceScheduleRepositoryMockRecorder struct {
+	mock *MockPriceScheduleRepository
+}
+
+// NewMockPriceScheduleRepository creates a new mock instance.
+func NewMockPriceScheduleRepository(ctrl *gomock.Controller) *MockPriceScheduleRepository {
+	mock := &MockPriceScheduleRepository{ctrl: ctrl}
+	mock.recorder = &MockPriceScheduleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceScheduleRepository) EXPECT() *MockPriceScheduleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPriceScheduleRepository) Create(ctx context.Context, tx *sql.Tx, schedule *pricing.PriceSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPriceScheduleRepositoryMockRecorder) Create(ctx, tx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPriceScheduleRepository)(nil).Create), ctx, tx, schedule)
+}
+
+// FindById mocks base method.
+func (m *MockPriceScheduleRepository) FindById(ctx context.Context, tx *sql.Tx, id *pricing.PriceScheduleId) (*pricing.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, tx, id)
+	ret0, _ := ret[0].(*pricing.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockPriceScheduleRepositoryMockRecorder) FindById(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockPriceScheduleRepository)(nil).FindById), ctx, tx, id)
+}
+
+// FindDueIds mocks base method.
+func (m *MockPriceScheduleRepository) FindDueIds(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]*pricing.PriceScheduleId, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDueIds", ctx, tx, now, limit)
+	ret0, _ := ret[0].([]*pricing.PriceScheduleId)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDueIds indicates an expected call of FindDueIds.
+func (mr *MockPriceScheduleRepositoryMockRecorder) FindDueIds(ctx, tx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDueIds", reflect.TypeOf((*MockPriceScheduleRepository)(nil).FindDueIds), ctx, tx, now, limit)
+}
+
+// FindPendingByProductId mocks base method.
+func (m *MockPriceScheduleRepository) FindPendingByProductId(ctx context.Context, tx *sql.Tx, productId *products.ProductId) ([]*pricing.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingByProductId", ctx, tx, productId)
+	ret0, _ := ret[0].([]*pricing.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingByProductId indicates an expected call of FindPendingByProductId.
+func (mr *MockPriceScheduleRepositoryMockRecorder) FindPendingByProductId(ctx, tx, productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingByProductId", reflect.TypeOf((*MockPriceScheduleRepository)(nil).FindPendingByProductId), ctx, tx, productId)
+}
+
+// LockById mocks base method.
+func (m *MockPriceScheduleRepository) LockById(ctx context.Context, tx *sql.Tx, id *pricing.PriceScheduleId) (*pricing.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockById", ctx, tx, id)
+	ret0, _ := ret[0].(*pricing.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockById indicates an expected call of LockById.
+func (mr *MockPriceScheduleRepositoryMockRecorder) LockById(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockById", reflect.TypeOf((*MockPriceScheduleRepository)(nil).LockById), ctx, tx, id)
+}
+
+// Update mocks base method.
+func (m *MockPriceScheduleRepository) Update(ctx context.Context, tx *sql.Tx, schedule *pricing.PriceSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPriceScheduleRepositoryMockRecorder) Update(ctx, tx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPriceScheduleRepository)(nil).Update), ctx, tx, schedule)
+}
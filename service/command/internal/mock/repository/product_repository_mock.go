@@ -14,6 +14,8 @@ import (
 	sql "database/sql"
 	reflect "reflect"
 
+	categories "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/categories"
+	names "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/names"
 	products "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,6 +44,20 @@ func (m *MockProductRepository) EXPECT() *MockProductRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddVariant mocks base method.
+func (m *MockProductRepository) AddVariant(ctx context.Context, tx *sql.Tx, productId *products.ProductId, variant *products.Variant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVariant", ctx, tx, productId, variant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddVariant indicates an expected call of AddVariant.
+func (mr *MockProductRepositoryMockRecorder) AddVariant(ctx, tx, productId, variant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVariant", reflect.TypeOf((*MockProductRepository)(nil).AddVariant), ctx, tx, productId, variant)
+}
+
 // Create mocks base method.
 func (m *MockProductRepository) Create(ctx context.Context, tx *sql.Tx, product *products.Product) error {
 	m.ctrl.T.Helper()
@@ -115,6 +131,65 @@ func (mr *MockProductRepositoryMockRecorder) FindById(ctx, tx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockProductRepository)(nil).FindById), ctx, tx, id)
 }
 
+// FindIdBySlug mocks base method.
+func (m *MockProductRepository) FindIdBySlug(ctx context.Context, tx *sql.Tx, slug names.Slug) (*products.ProductId, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdBySlug", ctx, tx, slug)
+	ret0, _ := ret[0].(*products.ProductId)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdBySlug indicates an expected call of FindIdBySlug.
+func (mr *MockProductRepositoryMockRecorder) FindIdBySlug(ctx, tx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdBySlug", reflect.TypeOf((*MockProductRepository)(nil).FindIdBySlug), ctx, tx, slug)
+}
+
+// LockById mocks base method.
+func (m *MockProductRepository) LockById(ctx context.Context, tx *sql.Tx, id *products.ProductId) (*products.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockById", ctx, tx, id)
+	ret0, _ := ret[0].(*products.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockById indicates an expected call of LockById.
+func (mr *MockProductRepositoryMockRecorder) LockById(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockById", reflect.TypeOf((*MockProductRepository)(nil).LockById), ctx, tx, id)
+}
+
+// LockIdsByCategoryIds mocks base method.
+func (m *MockProductRepository) LockIdsByCategoryIds(ctx context.Context, tx *sql.Tx, categoryIds []*categories.CategoryId, tagKeys []string) ([]*products.ProductId, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockIdsByCategoryIds", ctx, tx, categoryIds, tagKeys)
+	ret0, _ := ret[0].([]*products.ProductId)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockIdsByCategoryIds indicates an expected call of LockIdsByCategoryIds.
+func (mr *MockProductRepositoryMockRecorder) LockIdsByCategoryIds(ctx, tx, categoryIds, tagKeys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockIdsByCategoryIds", reflect.TypeOf((*MockProductRepository)(nil).LockIdsByCategoryIds), ctx, tx, categoryIds, tagKeys)
+}
+
+// RemoveVariant mocks base method.
+func (m *MockProductRepository) RemoveVariant(ctx context.Context, tx *sql.Tx, id *products.VariantId) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVariant", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveVariant indicates an expected call of RemoveVariant.
+func (mr *MockProductRepositoryMockRecorder) RemoveVariant(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVariant", reflect.TypeOf((*MockProductRepository)(nil).RemoveVariant), ctx, tx, id)
+}
+
 // UpdateById mocks base method.
 func (m *MockProductRepository) UpdateById(ctx context.Context, tx *sql.Tx, product *products.Product) error {
 	m.ctrl.T.Helper()
@@ -128,3 +203,17 @@ func (mr *MockProductRepositoryMockRecorder) UpdateById(ctx, tx, product any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateById", reflect.TypeOf((*MockProductRepository)(nil).UpdateById), ctx, tx, product)
 }
+
+// UpdateVariant mocks base method.
+func (m *MockProductRepository) UpdateVariant(ctx context.Context, tx *sql.Tx, variant *products.Variant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", ctx, tx, variant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockProductRepositoryMockRecorder) UpdateVariant(ctx, tx, variant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockProductRepository)(nil).UpdateVariant), ctx, tx, variant)
+}
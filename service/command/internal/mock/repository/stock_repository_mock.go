@@ -0,0 +1,162 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: stock_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=stock_repository.go -destination=../../../mock/repository/stock_repository_mock.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+	time "time"
+
+	products "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	stocks "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/stocks"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStockRepository is a mock of StockRepository interface.
+type MockStockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockStockRepositoryMockRecorder is the mock recorder for MockStockRepository.
+type MockStockRepositoryMockRecorder struct {
+	mock *MockStockRepository
+}
+
+// NewMockStockRepository creates a new mock instance.
+func NewMockStockRepository(ctrl *gomock.Controller) *MockStockRepository {
+	mock := &MockStockRepository{ctrl: ctrl}
+	mock.recorder = &MockStockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockRepository) EXPECT() *MockStockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateReservation mocks base method.
+func (m *MockStockRepository) CreateReservation(ctx context.Context, tx *sql.Tx, reservation *stocks.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReservation", ctx, tx, reservation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReservation indicates an expected call of CreateReservation.
+func (mr *MockStockRepositoryMockRecorder) CreateReservation(ctx, tx, reservation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservation", reflect.TypeOf((*MockStockRepository)(nil).CreateReservation), ctx, tx, reservation)
+}
+
+// FindExpiredReservations mocks base method.
+func (m *MockStockRepository) FindExpiredReservations(ctx context.Context, tx *sql.Tx, productId *products.ProductId, now time.Time) ([]*stocks.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiredReservations", ctx, tx, productId, now)
+	ret0, _ := ret[0].([]*stocks.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpiredReservations indicates an expected call of FindExpiredReservations.
+func (mr *MockStockRepositoryMockRecorder) FindExpiredReservations(ctx, tx, productId, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiredReservations", reflect.TypeOf((*MockStockRepository)(nil).FindExpiredReservations), ctx, tx, productId, now)
+}
+
+// FindProductIdsWithExpiredReservations mocks base method.
+func (m *MockStockRepository) FindProductIdsWithExpiredReservations(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]*products.ProductId, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductIdsWithExpiredReservations", ctx, tx, now, limit)
+	ret0, _ := ret[0].([]*products.ProductId)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductIdsWithExpiredReservations indicates an expected call of FindProductIdsWithExpiredReservations.
+func (mr *MockStockRepositoryMockRecorder) FindProductIdsWithExpiredReservations(ctx, tx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductIdsWithExpiredReservations", reflect.TypeOf((*MockStockRepository)(nil).FindProductIdsWithExpiredReservations), ctx, tx, now, limit)
+}
+
+// FindReservationById mocks base method.
+func (m *MockStockRepository) FindReservationById(ctx context.Context, tx *sql.Tx, id *stocks.ReservationId) (*stocks.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReservationById", ctx, tx, id)
+	ret0, _ := ret[0].(*stocks.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReservationById indicates an expected call of FindReservationById.
+func (mr *MockStockRepositoryMockRecorder) FindReservationById(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReservationById", reflect.TypeOf((*MockStockRepository)(nil).FindReservationById), ctx, tx, id)
+}
+
+// LockByProductId mocks base method.
+func (m *MockStockRepository) LockByProductId(ctx context.Context, tx *sql.Tx, productId *products.ProductId) (*stocks.Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByProductId", ctx, tx, productId)
+	ret0, _ := ret[0].(*stocks.Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockByProductId indicates an expected call of LockByProductId.
+func (mr *MockStockRepositoryMockRecorder) LockByProductId(ctx, tx, productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByProductId", reflect.TypeOf((*MockStockRepository)(nil).LockByProductId), ctx, tx, productId)
+}
+
+// LockReservationById mocks base method.
+func (m *MockStockRepository) LockReservationById(ctx context.Context, tx *sql.Tx, id *stocks.ReservationId) (*stocks.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockReservationById", ctx, tx, id)
+	ret0, _ := ret[0].(*stocks.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockReservationById indicates an expected call of LockReservationById.
+func (mr *MockStockRepositoryMockRecorder) LockReservationById(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockReservationById", reflect.TypeOf((*MockStockRepository)(nil).LockReservationById), ctx, tx, id)
+}
+
+// Update mocks base method.
+func (m *MockStockRepository) Update(ctx context.Context, tx *sql.Tx, stock *stocks.Stock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tx, stock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStockRepositoryMockRecorder) Update(ctx, tx, stock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStockRepository)(nil).Update), ctx, tx, stock)
+}
+
+// UpdateReservationStatus mocks base method.
+func (m *MockStockRepository) UpdateReservationStatus(ctx context.Context, tx *sql.Tx, reservation *stocks.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReservationStatus", ctx, tx, reservation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReservationStatus indicates an expected call of UpdateReservationStatus.
+func (mr *MockStockRepositoryMockRecorder) UpdateReservationStatus(ctx, tx, reservation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReservationStatus", reflect.TypeOf((*MockStockRepository)(nil).UpdateReservationStatus), ctx, tx, reservation)
+}
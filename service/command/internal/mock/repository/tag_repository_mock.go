@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tag_repository.go -destination=../../../mock/repository/tag_repository_mock.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	products "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/products"
+	tags "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/domain/models/tags"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagRepository is a mock of TagRepository interface.
+type MockTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTagRepositoryMockRecorder is the mock recorder for MockTagRepository.
+type MockTagRepositoryMockRecorder struct {
+	mock *MockTagRepository
+}
+
+// NewMockTagRepository creates a new mock instance.
+func NewMockTagRepository(ctrl *gomock.Controller) *MockTagRepository {
+	mock := &MockTagRepository{ctrl: ctrl}
+	mock.recorder = &MockTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AttachToProducts mocks base method.
+func (m *MockTagRepository) AttachToProducts(ctx context.Context, tx *sql.Tx, productIds []*products.ProductId, tagIds []*tags.TagId) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachToProducts", ctx, tx, productIds, tagIds)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachToProducts indicates an expected call of AttachToProducts.
+func (mr *MockTagRepositoryMockRecorder) AttachToProducts(ctx, tx, productIds, tagIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachToProducts", reflect.TypeOf((*MockTagRepository)(nil).AttachToProducts), ctx, tx, productIds, tagIds)
+}
+
+// CreateIfNotExists mocks base method.
+func (m *MockTagRepository) CreateIfNotExists(ctx context.Context, tx *sql.Tx, arg2 []*tags.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfNotExists", ctx, tx, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIfNotExists indicates an expected call of CreateIfNotExists.
+func (mr *MockTagRepositoryMockRecorder) CreateIfNotExists(ctx, tx, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockTagRepository)(nil).CreateIfNotExists), ctx, tx, arg2)
+}
+
+// DetachFromProducts mocks base method.
+func (m *MockTagRepository) DetachFromProducts(ctx context.Context, tx *sql.Tx, productIds []*products.ProductId, tagIds []*tags.TagId) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFromProducts", ctx, tx, productIds, tagIds)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachFromProducts indicates an expected call of DetachFromProducts.
+func (mr *MockTagRepositoryMockRecorder) DetachFromProducts(ctx, tx, productIds, tagIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFromProducts", reflect.TypeOf((*MockTagRepository)(nil).DetachFromProducts), ctx, tx, productIds, tagIds)
+}
+
+// LockByNames mocks base method.
+func (m *MockTagRepository) LockByNames(ctx context.Context, tx *sql.Tx, names []*tags.TagName) ([]*tags.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByNames", ctx, tx, names)
+	ret0, _ := ret[0].([]*tags.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockByNames indicates an expected call of LockByNames.
+func (mr *MockTagRepositoryMockRecorder) LockByNames(ctx, tx, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByNames", reflect.TypeOf((*MockTagRepository)(nil).LockByNames), ctx, tx, names)
+}
@@ -71,6 +71,21 @@ func (mr *MockCategoryServiceMockRecorder) Delete(ctx, categoryDTO any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategoryService)(nil).Delete), ctx, categoryDTO)
 }
 
+// Move mocks base method.
+func (m *MockCategoryService) Move(ctx context.Context, categoryDTO *dto.MoveCategoryDTO) (*dto.CategoryDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, categoryDTO)
+	ret0, _ := ret[0].(*dto.CategoryDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockCategoryServiceMockRecorder) Move(ctx, categoryDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockCategoryService)(nil).Move), ctx, categoryDTO)
+}
+
 // Update mocks base method.
 func (m *MockCategoryService) Update(ctx context.Context, categoryDTO *dto.UpdateCategoryDTO) (*dto.CategoryDTO, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pricing.go
+//
+// Generated by this command:
+//
+//	mockgen -source=pricing.go -destination=../../mock/service/price_schedule_service_mock.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPriceScheduleService is a mock of PriceScheduleService interface.
+type MockPriceScheduleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceScheduleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPriceScheduleServiceMockRecorder is the mock recorder for MockPriceScheduleService.
+type MockPriceScheduleServiceMockRecorder struct {
+	mock *MockPriceScheduleService
+}
+
+// NewMockPriceScheduleService creates a new mock instance.
+func NewMockPriceScheduleService(ctrl *gomock.Controller) *MockPriceScheduleService {
+	mock := &MockPriceScheduleService{ctrl: ctrl}
+	mock.recorder = &MockPriceScheduleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceScheduleService) EXPECT() *MockPriceScheduleServiceMockRecorder {
+	return m.recorder
+}
+
+// ApplyDue mocks base method.
+func (m *MockPriceScheduleService) ApplyDue(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyDue", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyDue indicates an expected call of ApplyDue.
+func (mr *MockPriceScheduleServiceMockRecorder) ApplyDue(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyDue", reflect.TypeOf((*MockPriceScheduleService)(nil).ApplyDue), ctx, limit)
+}
+
+// Cancel mocks base method.
+func (m *MockPriceScheduleService) Cancel(ctx context.Context, cancelDTO *dto.CancelPriceScheduleDTO) (*dto.PriceScheduleDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, cancelDTO)
+	ret0, _ := ret[0].(*dto.PriceScheduleDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockPriceScheduleServiceMockRecorder) Cancel(ctx, cancelDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockPriceScheduleService)(nil).Cancel), ctx, cancelDTO)
+}
+
+// Schedule mocks base method.
+func (m *MockPriceScheduleService) Schedule(ctx context.Context, scheduleDTO *dto.SchedulePriceDTO) (*dto.PriceScheduleDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", ctx, scheduleDTO)
+	ret0, _ := ret[0].(*dto.PriceScheduleDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockPriceScheduleServiceMockRecorder) Schedule(ctx, scheduleDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockPriceScheduleService)(nil).Schedule), ctx, scheduleDTO)
+}
@@ -56,6 +56,36 @@ func (mr *MockProductServiceMockRecorder) Add(ctx, ProductDTO any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockProductService)(nil).Add), ctx, ProductDTO)
 }
 
+// AddVariant mocks base method.
+func (m *MockProductService) AddVariant(ctx context.Context, variantDTO *dto.AddVariantDTO) (*dto.VariantDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVariant", ctx, variantDTO)
+	ret0, _ := ret[0].(*dto.VariantDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVariant indicates an expected call of AddVariant.
+func (mr *MockProductServiceMockRecorder) AddVariant(ctx, variantDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVariant", reflect.TypeOf((*MockProductService)(nil).AddVariant), ctx, variantDTO)
+}
+
+// AdjustPrices mocks base method.
+func (m *MockProductService) AdjustPrices(ctx context.Context, adjustDTO *dto.AdjustPricesDTO) (*dto.AdjustPricesResultDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustPrices", ctx, adjustDTO)
+	ret0, _ := ret[0].(*dto.AdjustPricesResultDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustPrices indicates an expected call of AdjustPrices.
+func (mr *MockProductServiceMockRecorder) AdjustPrices(ctx, adjustDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustPrices", reflect.TypeOf((*MockProductService)(nil).AdjustPrices), ctx, adjustDTO)
+}
+
 // Delete mocks base method.
 func (m *MockProductService) Delete(ctx context.Context, productDTO *dto.DeleteProductDTO) (*dto.ProductDTO, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +101,66 @@ func (mr *MockProductServiceMockRecorder) Delete(ctx, productDTO any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProductService)(nil).Delete), ctx, productDTO)
 }
 
+// Discontinue mocks base method.
+func (m *MockProductService) Discontinue(ctx context.Context, productDTO *dto.ChangeProductStatusDTO) (*dto.ProductDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Discontinue", ctx, productDTO)
+	ret0, _ := ret[0].(*dto.ProductDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Discontinue indicates an expected call of Discontinue.
+func (mr *MockProductServiceMockRecorder) Discontinue(ctx, productDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Discontinue", reflect.TypeOf((*MockProductService)(nil).Discontinue), ctx, productDTO)
+}
+
+// Publish mocks base method.
+func (m *MockProductService) Publish(ctx context.Context, productDTO *dto.ChangeProductStatusDTO) (*dto.ProductDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, productDTO)
+	ret0, _ := ret[0].(*dto.ProductDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockProductServiceMockRecorder) Publish(ctx, productDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockProductService)(nil).Publish), ctx, productDTO)
+}
+
+// RemoveVariant mocks base method.
+func (m *MockProductService) RemoveVariant(ctx context.Context, variantDTO *dto.RemoveVariantDTO) (*dto.VariantDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVariant", ctx, variantDTO)
+	ret0, _ := ret[0].(*dto.VariantDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVariant indicates an expected call of RemoveVariant.
+func (mr *MockProductServiceMockRecorder) RemoveVariant(ctx, variantDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVariant", reflect.TypeOf((*MockProductService)(nil).RemoveVariant), ctx, variantDTO)
+}
+
+// Suspend mocks base method.
+func (m *MockProductService) Suspend(ctx context.Context, productDTO *dto.ChangeProductStatusDTO) (*dto.ProductDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", ctx, productDTO)
+	ret0, _ := ret[0].(*dto.ProductDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockProductServiceMockRecorder) Suspend(ctx, productDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockProductService)(nil).Suspend), ctx, productDTO)
+}
+
 // Update mocks base method.
 func (m *MockProductService) Update(ctx context.Context, productDTO *dto.UpdateProductDTO) (*dto.ProductDTO, error) {
 	m.ctrl.T.Helper()
@@ -85,3 +175,18 @@ func (mr *MockProductServiceMockRecorder) Update(ctx, productDTO any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProductService)(nil).Update), ctx, productDTO)
 }
+
+// UpdateVariant mocks base method.
+func (m *MockProductService) UpdateVariant(ctx context.Context, variantDTO *dto.UpdateVariantDTO) (*dto.VariantDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", ctx, variantDTO)
+	ret0, _ := ret[0].(*dto.VariantDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockProductServiceMockRecorder) UpdateVariant(ctx, variantDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockProductService)(nil).UpdateVariant), ctx, variantDTO)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: stock.go
+//
+// Generated by this command:
+//
+//	mockgen -source=stock.go -destination=../../mock/service/stock_service_mock.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStockService is a mock of StockService interface.
+type MockStockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockStockServiceMockRecorder is the mock recorder for MockStockService.
+type MockStockServiceMockRecorder struct {
+	mock *MockStockService
+}
+
+// NewMockStockService creates a new mock instance.
+func NewMockStockService(ctrl *gomock.Controller) *MockStockService {
+	mock := &MockStockService{ctrl: ctrl}
+	mock.recorder = &MockStockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockService) EXPECT() *MockStockServiceMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockStockService) Adjust(ctx context.Context, adjustDTO *dto.AdjustStockDTO) (*dto.StockDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", ctx, adjustDTO)
+	ret0, _ := ret[0].(*dto.StockDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockStockServiceMockRecorder) Adjust(ctx, adjustDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockStockService)(nil).Adjust), ctx, adjustDTO)
+}
+
+// Commit mocks base method.
+func (m *MockStockService) Commit(ctx context.Context, commitDTO *dto.CommitReservationDTO) (*dto.ReservationDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", ctx, commitDTO)
+	ret0, _ := ret[0].(*dto.ReservationDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockStockServiceMockRecorder) Commit(ctx, commitDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockStockService)(nil).Commit), ctx, commitDTO)
+}
+
+// ExpireReservations mocks base method.
+func (m *MockStockService) ExpireReservations(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireReservations", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireReservations indicates an expected call of ExpireReservations.
+func (mr *MockStockServiceMockRecorder) ExpireReservations(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireReservations", reflect.TypeOf((*MockStockService)(nil).ExpireReservations), ctx, limit)
+}
+
+// Release mocks base method.
+func (m *MockStockService) Release(ctx context.Context, releaseDTO *dto.ReleaseReservationDTO) (*dto.ReservationDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, releaseDTO)
+	ret0, _ := ret[0].(*dto.ReservationDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockStockServiceMockRecorder) Release(ctx, releaseDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockStockService)(nil).Release), ctx, releaseDTO)
+}
+
+// Reserve mocks base method.
+func (m *MockStockService) Reserve(ctx context.Context, reserveDTO *dto.ReserveStockDTO) (*dto.ReservationDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, reserveDTO)
+	ret0, _ := ret[0].(*dto.ReservationDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockStockServiceMockRecorder) Reserve(ctx, reserveDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockStockService)(nil).Reserve), ctx, reserveDTO)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tag.go -destination=../../mock/service/tag_service_mock.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagService is a mock of TagService interface.
+type MockTagService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTagServiceMockRecorder is the mock recorder for MockTagService.
+type MockTagServiceMockRecorder struct {
+	mock *MockTagService
+}
+
+// NewMockTagService creates a new mock instance.
+func NewMockTagService(ctrl *gomock.Controller) *MockTagService {
+	mock := &MockTagService{ctrl: ctrl}
+	mock.recorder = &MockTagServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagService) EXPECT() *MockTagServiceMockRecorder {
+	return m.recorder
+}
+
+// Attach mocks base method.
+func (m *MockTagService) Attach(ctx context.Context, changeDTO *dto.ChangeTagsDTO) (*dto.TagChangeResultDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attach", ctx, changeDTO)
+	ret0, _ := ret[0].(*dto.TagChangeResultDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attach indicates an expected call of Attach.
+func (mr *MockTagServiceMockRecorder) Attach(ctx, changeDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockTagService)(nil).Attach), ctx, changeDTO)
+}
+
+// Detach mocks base method.
+func (m *MockTagService) Detach(ctx context.Context, changeDTO *dto.ChangeTagsDTO) (*dto.TagChangeResultDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detach", ctx, changeDTO)
+	ret0, _ := ret[0].(*dto.TagChangeResultDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Detach indicates an expected call of Detach.
+func (mr *MockTagServiceMockRecorder) Detach(ctx, changeDTO any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detach", reflect.TypeOf((*MockTagService)(nil).Detach), ctx, changeDTO)
+}
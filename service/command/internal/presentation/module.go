@@ -5,9 +5,9 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
-	"time"
 
 	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/httpserver"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/presentation/server"
 	"go.uber.org/fx"
@@ -15,7 +15,7 @@ import (
 
 // Module はプレゼンテーション層のFXモジュールです。
 // コマンドサービスのプレゼンテーション層に必要な以下の依存関係を提供します：
-//   - CategoryServiceHandlerの実装
+//   - CategoryServiceHandler、ProductServiceHandler、StockServiceHandler、TagServiceHandler、PriceScheduleServiceHandlerの実装
 //   - CommandServerのセットアップ
 //   - サーバーライフサイクル管理（起動とグレースフルシャットダウン）
 //
@@ -33,6 +33,19 @@ var Module = fx.Module(
 			server.NewProductServiceHandlerImpl,
 			fx.As(new(cmdconnect.ProductServiceHandler)),
 		),
+		fx.Annotate(
+			server.NewStockServiceHandlerImpl,
+			fx.As(new(cmdconnect.StockServiceHandler)),
+		),
+		fx.Annotate(
+			server.NewTagServiceHandlerImpl,
+			fx.As(new(cmdconnect.TagServiceHandler)),
+		),
+		fx.Annotate(
+			server.NewPriceScheduleServiceHandlerImpl,
+			fx.As(new(cmdconnect.PriceScheduleServiceHandler)),
+		),
+		httpserver.NewConfig,
 		server.NewCommandServer,
 	),
 	fx.Invoke(registerLifecycleHooks),
@@ -44,12 +57,13 @@ var Module = fx.Module(
 // Parameters:
 //   - lc: FXライフサイクルマネージャー
 //   - srv: 管理対象のコマンドサーバーインスタンス
+//   - cfg: HTTPサーバーの設定（停止時の待ち時間）
 //   - logger: サーバーライフサイクルイベントを記録するロガー
 //
 // この関数は以下の2つのフックを登録します：
 //   - OnStart: 別のゴルーチンでgRPCサーバーを起動し、起動イベントをログに記録
-//   - OnStop: 30秒のタイムアウトでグレースフルシャットダウンを実行し、停止イベントをログに記録
-func registerLifecycleHooks(lc fx.Lifecycle, srv *server.CommandServer, logger *slog.Logger) {
+//   - OnStop: ヘルスチェックをNOT_SERVINGにしてdrain_periodだけ待ち、shutdown_timeoutを上限にグレースフルシャットダウンを実行し、停止イベントをログに記録
+func registerLifecycleHooks(lc fx.Lifecycle, srv *server.CommandServer, cfg *httpserver.Config, logger *slog.Logger) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			// port競合によるエラーになる問題の対策のため、ListenAndServeではなく、ListenしてServeする
@@ -69,11 +83,8 @@ func registerLifecycleHooks(lc fx.Lifecycle, srv *server.CommandServer, logger *
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
-			shutdownCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-			defer cancel()
-
-			// サーバーをグレースフルシャットダウン
-			if shutdownErr := srv.Server.Shutdown(shutdownCtx); shutdownErr != nil {
+			// ロードバランサーの振り分け対象から外れるのを待ってから、サーバーをグレースフルシャットダウン
+			if shutdownErr := httpserver.Shutdown(ctx, srv.Server, srv.Health, srv.Services, cfg, logger); shutdownErr != nil {
 				logger.Error("Server shutdown failed", "error", shutdownErr)
 				return shutdownErr
 			}
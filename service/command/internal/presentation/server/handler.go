@@ -2,13 +2,17 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	cmd "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
 	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
 	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/service"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -19,6 +23,11 @@ func createCategoryFromDTO(dto *dto.CategoryDTO) *common.Category {
 	c := &common.Category{}
 	c.SetId(dto.Id)
 	c.SetName(dto.Name)
+	if dto.ParentId != "" {
+		c.SetParentId(dto.ParentId)
+	}
+	c.SetTranslations(dto.Translations)
+	c.SetSlug(dto.Slug)
 	return c
 }
 
@@ -30,9 +39,117 @@ func createProductFromDTO(dto *dto.ProductDTO) *common.Product {
 	p.SetName(dto.Name)
 	p.SetPrice(int32(dto.Price))
 	p.SetCategory(c)
+	p.SetTaxClass(taxClasses[dto.TaxClass])
+	m := &common.Money{}
+	m.SetAmount(int64(dto.Price))
+	m.SetCurrency(dto.Currency)
+	p.SetPriceExcludingTax(m)
+	p.SetTranslations(dto.Translations)
+	p.SetStatus(productStatuses[dto.Status])
+	p.SetBarcode(dto.Barcode)
+	p.SetSlug(dto.Slug)
 	return p
 }
 
+// productStatuses はドメインの商品の販売状態とProtobufの列挙値の対応です。
+var productStatuses = map[string]common.ProductStatus{
+	"DRAFT":        common.ProductStatus_PRODUCT_STATUS_DRAFT,
+	"PUBLISHED":    common.ProductStatus_PRODUCT_STATUS_PUBLISHED,
+	"SUSPENDED":    common.ProductStatus_PRODUCT_STATUS_SUSPENDED,
+	"DISCONTINUED": common.ProductStatus_PRODUCT_STATUS_DISCONTINUED,
+}
+
+// taxClasses はドメインの税率区分とProtobufの列挙値の対応です。
+var taxClasses = map[string]common.TaxClass{
+	"STANDARD": common.TaxClass_TAX_CLASS_STANDARD,
+	"REDUCED":  common.TaxClass_TAX_CLASS_REDUCED,
+}
+
+// taxClassFromProto はProtobufの税率区分をDTOの税率区分に変換します。
+// 未指定の場合はエンプティを返します。
+func taxClassFromProto(class common.TaxClass) string {
+	for name, value := range taxClasses {
+		if value == class {
+			return name
+		}
+	}
+	return ""
+}
+
+// variantStatuses はドメインのバリエーションの販売状態とProtobufの列挙値の対応です。
+var variantStatuses = map[string]common.VariantStatus{
+	"ACTIVE":   common.VariantStatus_VARIANT_STATUS_ACTIVE,
+	"INACTIVE": common.VariantStatus_VARIANT_STATUS_INACTIVE,
+}
+
+// createVariantFromDTO はDTOからProtobufのProductVariantを作成します。
+func createVariantFromDTO(dto *dto.VariantDTO) *common.ProductVariant {
+	options := make([]*common.VariantOption, 0, len(dto.Options))
+	for _, option := range dto.Options {
+		o := &common.VariantOption{}
+		o.SetAxis(option.Axis)
+		o.SetValue(option.Value)
+		options = append(options, o)
+	}
+	v := &common.ProductVariant{}
+	v.SetId(dto.Id)
+	v.SetSku(dto.Sku)
+	v.SetOptions(options)
+	if dto.PriceOverride != nil {
+		v.SetPriceOverride(int32(*dto.PriceOverride))
+	}
+	v.SetPrice(int32(dto.Price))
+	v.SetStatus(variantStatuses[dto.Status])
+	return v
+}
+
+// variantAttributesFromRequest はリクエストのバリエーション属性をDTOの値に変換します。
+// 販売状態が未指定の場合は空文字列を返し、アプリケーション層で既定値(ACTIVE)が使用されます。
+func variantAttributesFromRequest(attrs *cmd.VariantAttributes) (options []dto.VariantOptionDTO, priceOverride *uint32, status string) {
+	for _, option := range attrs.GetOptions() {
+		options = append(options, dto.VariantOptionDTO{Axis: option.GetAxis(), Value: option.GetValue()})
+	}
+	if attrs.HasPriceOverride() {
+		value := uint32(attrs.GetPriceOverride())
+		priceOverride = &value
+	}
+	for name, value := range variantStatuses {
+		if value == attrs.GetStatus() {
+			status = name
+		}
+	}
+	return options, priceOverride, status
+}
+
+// createStockFromDTO はDTOからProtobufのStockを作成します。
+func createStockFromDTO(dto *dto.StockDTO) *common.Stock {
+	st := &common.Stock{}
+	st.SetProductId(dto.ProductId)
+	st.SetOnHand(int32(dto.OnHand))
+	st.SetReserved(int32(dto.Reserved))
+	st.SetAvailable(int32(dto.Available))
+	return st
+}
+
+// reservationStatuses はドメインの引当状態とProtobufの列挙値の対応です。
+var reservationStatuses = map[string]cmd.ReservationStatus{
+	"RESERVED":  cmd.ReservationStatus_RESERVATION_STATUS_RESERVED,
+	"RELEASED":  cmd.ReservationStatus_RESERVATION_STATUS_RELEASED,
+	"COMMITTED": cmd.ReservationStatus_RESERVATION_STATUS_COMMITTED,
+	"EXPIRED":   cmd.ReservationStatus_RESERVATION_STATUS_EXPIRED,
+}
+
+// createReservationFromDTO はDTOからProtobufのReservationを作成します。
+func createReservationFromDTO(dto *dto.ReservationDTO) *cmd.Reservation {
+	r := &cmd.Reservation{}
+	r.SetId(dto.Id)
+	r.SetProductId(dto.ProductId)
+	r.SetQuantity(int32(dto.Quantity))
+	r.SetStatus(reservationStatuses[dto.Status])
+	r.SetExpiresAt(timestamppb.New(dto.ExpiresAt))
+	return r
+}
+
 // CategoryServiceHandlerImpl はカテゴリサービスのgRPCハンドラー実装です。
 // Connect RPCを使用してカテゴリの作成、更新、削除のエンドポイントを提供します。
 type CategoryServiceHandlerImpl struct {
@@ -61,19 +178,22 @@ func NewCategoryServiceHandlerImpl(logger *slog.Logger, cs service.CategoryServi
 //
 // Parameters:
 //   - ctx: リクエストコンテキスト
-//   - req: カテゴリ作成リクエスト（カテゴリ名を含む）
+//   - req: カテゴリ作成リクエスト（カテゴリ名と任意の親カテゴリIDを含む）
 //
 // Returns:
 //   - *connect.Response[cmd.CreateCategoryResponse]: 作成されたカテゴリ情報を含むレスポンス
-//   - error: バリデーションエラーの場合はCodeInvalidArgument、サービス層エラーの場合はCodeInternal
+//   - error: バリデーションエラーの場合はCodeInvalidArgument、名前またはスラッグが重複する場合はCodeAlreadyExists、親カテゴリが存在しない場合はCodeNotFound、その他のサービス層エラーの場合はCodeInternal
 func (s *CategoryServiceHandlerImpl) CreateCategory(ctx context.Context, req *connect.Request[cmd.CreateCategoryRequest]) (*connect.Response[cmd.CreateCategoryResponse], error) {
 	createCategoryDTO := &dto.CreateCategoryDTO{
-		Name: req.Msg.GetName().GetValue(),
+		Name:         req.Msg.GetName().GetValue(),
+		ParentId:     req.Msg.GetParentId().GetValue(),
+		Slug:         req.Msg.GetSlug(),
+		Translations: req.Msg.GetTranslations(),
 	}
 
 	categoryDTO, err := s.cs.Add(ctx, createCategoryDTO)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create error: %w", err))
+		return nil, handleError(err, "create error")
 	}
 
 	res := &cmd.CreateCategoryResponse{}
@@ -91,16 +211,18 @@ func (s *CategoryServiceHandlerImpl) CreateCategory(ctx context.Context, req *co
 //
 // Returns:
 //   - *connect.Response[cmd.UpdateCategoryResponse]: 更新されたカテゴリ情報を含むレスポンス
-//   - error: バリデーションエラーの場合はCodeInvalidArgument、サービス層エラーの場合はCodeInternal
+//   - error: バリデーションエラーの場合はCodeInvalidArgument、名前またはスラッグが重複する場合はCodeAlreadyExists、その他のサービス層エラーの場合はCodeInternal
 func (s *CategoryServiceHandlerImpl) UpdateCategory(ctx context.Context, req *connect.Request[cmd.UpdateCategoryRequest]) (*connect.Response[cmd.UpdateCategoryResponse], error) {
 	updateCategoryDTO := &dto.UpdateCategoryDTO{
-		Id:   req.Msg.GetCategory().GetId().GetValue(),
-		Name: req.Msg.GetCategory().GetName().GetValue(),
+		Id:           req.Msg.GetCategory().GetId().GetValue(),
+		Name:         req.Msg.GetCategory().GetName().GetValue(),
+		Slug:         req.Msg.GetCategory().GetSlug(),
+		Translations: req.Msg.GetCategory().GetTranslations(),
 	}
 
 	categoryDTO, err := s.cs.Update(ctx, updateCategoryDTO)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update error: %w", err))
+		return nil, handleError(err, "update error")
 	}
 
 	res := &cmd.UpdateCategoryResponse{}
@@ -118,7 +240,7 @@ func (s *CategoryServiceHandlerImpl) UpdateCategory(ctx context.Context, req *co
 //
 // Returns:
 //   - *connect.Response[cmd.DeleteCategoryResponse]: 削除されたカテゴリ情報を含むレスポンス
-//   - error: バリデーションエラーの場合はCodeInvalidArgument、サービス層エラーの場合はCodeInternal
+//   - error: バリデーションエラーの場合はCodeInvalidArgument、子カテゴリが存在する場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
 func (s *CategoryServiceHandlerImpl) DeleteCategory(ctx context.Context, req *connect.Request[cmd.DeleteCategoryRequest]) (*connect.Response[cmd.DeleteCategoryResponse], error) {
 	deleteCategoryDTO := &dto.DeleteCategoryDTO{
 		Id: req.Msg.GetCategoryId().GetValue(),
@@ -126,7 +248,7 @@ func (s *CategoryServiceHandlerImpl) DeleteCategory(ctx context.Context, req *co
 
 	categoryDTO, err := s.cs.Delete(ctx, deleteCategoryDTO)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("delete error: %w", err))
+		return nil, handleError(err, "delete error")
 	}
 
 	res := &cmd.DeleteCategoryResponse{}
@@ -136,6 +258,33 @@ func (s *CategoryServiceHandlerImpl) DeleteCategory(ctx context.Context, req *co
 	return connect.NewResponse(res), nil
 }
 
+// MoveCategory は既存のカテゴリを別の親カテゴリの下に移動します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: カテゴリ移動リクエスト（カテゴリIDと移動先の親カテゴリID、未設定の場合はルートに移動）
+//
+// Returns:
+//   - *connect.Response[cmd.MoveCategoryResponse]: 移動後のカテゴリ情報を含むレスポンス
+//   - error: カテゴリまたは親カテゴリが存在しない場合はCodeNotFound、自身または子孫の下に移動する場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
+func (s *CategoryServiceHandlerImpl) MoveCategory(ctx context.Context, req *connect.Request[cmd.MoveCategoryRequest]) (*connect.Response[cmd.MoveCategoryResponse], error) {
+	moveCategoryDTO := &dto.MoveCategoryDTO{
+		Id:       req.Msg.GetCategoryId().GetValue(),
+		ParentId: req.Msg.GetParentId().GetValue(),
+	}
+
+	categoryDTO, err := s.cs.Move(ctx, moveCategoryDTO)
+	if err != nil {
+		return nil, handleError(err, "move error")
+	}
+
+	res := &cmd.MoveCategoryResponse{}
+	res.SetCategory(createCategoryFromDTO(categoryDTO))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
 // ProductServiceHandlerImpl は商品サービスのgRPCハンドラー実装です。
 // Connect RPCを使用して商品の作成、更新、削除のエンドポイントを提供します。
 type ProductServiceHandlerImpl struct {
@@ -164,24 +313,29 @@ func NewProductServiceHandlerImpl(logger *slog.Logger, ps service.ProductService
 //
 // Parameters:
 //   - ctx: リクエストコンテキスト
-//   - req: 商品作成リクエスト（商品名、価格、カテゴリ情報を含む）
+//   - req: 商品作成リクエスト（商品名、価格、通貨、税率区分、カテゴリ情報、バーコードを含む）
 //
 // Returns:
 //   - *connect.Response[cmd.CreateProductResponse]: 作成された商品情報を含むレスポンス
-//   - error: バリデーションエラーの場合はCodeInvalidArgument、サービス層エラーの場合はCodeInternal
+//   - error: バリデーションエラーの場合はCodeInvalidArgument、名前・バーコード・スラッグが重複する場合はCodeAlreadyExists、その他のサービス層エラーの場合はCodeInternal
 func (s *ProductServiceHandlerImpl) CreateProduct(ctx context.Context, req *connect.Request[cmd.CreateProductRequest]) (*connect.Response[cmd.CreateProductResponse], error) {
 	createProductDTO := &dto.CreateProductDTO{
-		Name:  req.Msg.GetProduct().GetName().GetValue(),
-		Price: uint32(req.Msg.GetProduct().GetPrice().GetValue()),
+		Name:     req.Msg.GetProduct().GetName().GetValue(),
+		Price:    uint32(req.Msg.GetProduct().GetPrice().GetValue()),
+		Currency: req.Msg.GetProduct().GetCurrency(),
+		TaxClass: taxClassFromProto(req.Msg.GetProduct().GetTaxClass()),
 		Category: &dto.CategoryDTO{
 			Id:   req.Msg.GetProduct().GetCategory().GetId().GetValue(),
 			Name: req.Msg.GetProduct().GetCategory().GetName().GetValue(),
 		},
+		Translations: req.Msg.GetProduct().GetTranslations(),
+		Barcode:      req.Msg.GetProduct().GetBarcode(),
+		Slug:         req.Msg.GetProduct().GetSlug(),
 	}
 
 	productDTO, err := s.ps.Add(ctx, createProductDTO)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create error: %w", err))
+		return nil, handleError(err, "create error")
 	}
 
 	res := &cmd.CreateProductResponse{}
@@ -195,22 +349,31 @@ func (s *ProductServiceHandlerImpl) CreateProduct(ctx context.Context, req *conn
 //
 // Parameters:
 //   - ctx: リクエストコンテキスト
-//   - req: 商品更新リクエスト（商品ID、名前、価格、カテゴリ情報を含む）
+//   - req: 商品更新リクエスト（商品ID、名前、価格、通貨、税率区分、カテゴリ情報、バーコードを含む）
 //
 // Returns:
 //   - *connect.Response[cmd.UpdateProductResponse]: 更新された商品情報を含むレスポンス
-//   - error: バリデーションエラーの場合はCodeInvalidArgument、サービス層エラーの場合はCodeInternal
+//   - error: バリデーションエラーの場合はCodeInvalidArgument、名前・バーコード・スラッグが重複する場合はCodeAlreadyExists、その他のサービス層エラーの場合はCodeInternal
 func (s *ProductServiceHandlerImpl) UpdateProduct(ctx context.Context, req *connect.Request[cmd.UpdateProductRequest]) (*connect.Response[cmd.UpdateProductResponse], error) {
 	updateProductDTO := &dto.UpdateProductDTO{
 		Id:         req.Msg.GetProduct().GetId().GetValue(),
 		Name:       req.Msg.GetProduct().GetName().GetValue(),
 		Price:      uint32(req.Msg.GetProduct().GetPrice().GetValue()),
+		Currency:   req.Msg.GetProduct().GetCurrency(),
+		TaxClass:   taxClassFromProto(req.Msg.GetProduct().GetTaxClass()),
 		CategoryId: req.Msg.GetProduct().GetCategoryId().GetValue(),
+		Slug:       req.Msg.GetProduct().GetSlug(),
+
+		Translations: req.Msg.GetProduct().GetTranslations(),
+	}
+	if req.Msg.GetProduct().HasBarcode() {
+		barcode := req.Msg.GetProduct().GetBarcode()
+		updateProductDTO.Barcode = &barcode
 	}
 
 	productDTO, err := s.ps.Update(ctx, updateProductDTO)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update error: %w", err))
+		return nil, handleError(err, "update error")
 	}
 
 	res := &cmd.UpdateProductResponse{}
@@ -236,7 +399,7 @@ func (s *ProductServiceHandlerImpl) DeleteProduct(ctx context.Context, req *conn
 
 	productDTO, err := s.ps.Delete(ctx, deleteProductDTO)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("delete error: %w", err))
+		return nil, handleError(err, "delete error")
 	}
 
 	res := &cmd.DeleteProductResponse{}
@@ -245,3 +408,610 @@ func (s *ProductServiceHandlerImpl) DeleteProduct(ctx context.Context, req *conn
 
 	return connect.NewResponse(res), nil
 }
+
+// PublishProduct は下書きまたは一時停止中の商品を公開します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: 商品公開リクエスト（商品IDを含む）
+//
+// Returns:
+//   - *connect.Response[cmd.PublishProductResponse]: 状態を変更した商品情報を含むレスポンス
+//   - error: 商品が存在しない場合はCodeNotFound、許可されていない状態遷移の場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
+func (s *ProductServiceHandlerImpl) PublishProduct(ctx context.Context, req *connect.Request[cmd.PublishProductRequest]) (*connect.Response[cmd.PublishProductResponse], error) {
+	productDTO, err := s.ps.Publish(ctx, &dto.ChangeProductStatusDTO{Id: req.Msg.GetProductId().GetValue()})
+	if err != nil {
+		return nil, handleError(err, "publish error")
+	}
+
+	res := &cmd.PublishProductResponse{}
+	res.SetProduct(createProductFromDTO(productDTO))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// SuspendProduct は公開中の商品の販売を一時停止します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: 商品一時停止リクエスト（商品IDを含む）
+//
+// Returns:
+//   - *connect.Response[cmd.SuspendProductResponse]: 状態を変更した商品情報を含むレスポンス
+//   - error: 商品が存在しない場合はCodeNotFound、許可されていない状態遷移の場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
+func (s *ProductServiceHandlerImpl) SuspendProduct(ctx context.Context, req *connect.Request[cmd.SuspendProductRequest]) (*connect.Response[cmd.SuspendProductResponse], error) {
+	productDTO, err := s.ps.Suspend(ctx, &dto.ChangeProductStatusDTO{Id: req.Msg.GetProductId().GetValue()})
+	if err != nil {
+		return nil, handleError(err, "suspend error")
+	}
+
+	res := &cmd.SuspendProductResponse{}
+	res.SetProduct(createProductFromDTO(productDTO))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// DiscontinueProduct は商品の販売を終了します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: 商品販売終了リクエスト（商品IDを含む）
+//
+// Returns:
+//   - *connect.Response[cmd.DiscontinueProductResponse]: 状態を変更した商品情報を含むレスポンス
+//   - error: 商品が存在しない場合はCodeNotFound、許可されていない状態遷移の場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
+func (s *ProductServiceHandlerImpl) DiscontinueProduct(ctx context.Context, req *connect.Request[cmd.DiscontinueProductRequest]) (*connect.Response[cmd.DiscontinueProductResponse], error) {
+	productDTO, err := s.ps.Discontinue(ctx, &dto.ChangeProductStatusDTO{Id: req.Msg.GetProductId().GetValue()})
+	if err != nil {
+		return nil, handleError(err, "discontinue error")
+	}
+
+	res := &cmd.DiscontinueProductResponse{}
+	res.SetProduct(createProductFromDTO(productDTO))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// AddVariant は商品にバリエーションを追加します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: バリエーション追加リクエスト（商品IDとバリエーションの属性を含む）
+//
+// Returns:
+//   - *connect.Response[cmd.AddVariantResponse]: 追加されたバリエーションを含むレスポンス
+//   - error: バリデーションエラーや選択肢の軸が既存のバリエーションと異なる場合はCodeInvalidArgument、
+//     商品が存在しない場合はCodeNotFound、SKUまたは選択肢の組み合わせが重複する場合はCodeAlreadyExists、
+//     その他のサービス層エラーの場合はCodeInternal
+func (s *ProductServiceHandlerImpl) AddVariant(ctx context.Context, req *connect.Request[cmd.AddVariantRequest]) (*connect.Response[cmd.AddVariantResponse], error) {
+	options, priceOverride, status := variantAttributesFromRequest(req.Msg.GetVariant())
+	addVariantDTO := &dto.AddVariantDTO{
+		ProductId:     req.Msg.GetProductId().GetValue(),
+		Sku:           req.Msg.GetVariant().GetSku(),
+		Options:       options,
+		PriceOverride: priceOverride,
+		Status:        status,
+	}
+
+	variantDTO, err := s.ps.AddVariant(ctx, addVariantDTO)
+	if err != nil {
+		return nil, handleError(err, "add variant error")
+	}
+
+	res := &cmd.AddVariantResponse{}
+	res.SetVariant(createVariantFromDTO(variantDTO))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// UpdateVariant は商品のバリエーションを置き換えます。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: バリエーション更新リクエスト（商品ID、バリエーションIDとバリエーションの属性を含む）
+//
+// Returns:
+//   - *connect.Response[cmd.UpdateVariantResponse]: 更新されたバリエーションを含むレスポンス
+//   - error: バリデーションエラーの場合はCodeInvalidArgument、商品またはバリエーションが存在しない場合はCodeNotFound、
+//     SKUまたは選択肢の組み合わせが重複する場合はCodeAlreadyExists、その他のサービス層エラーの場合はCodeInternal
+func (s *ProductServiceHandlerImpl) UpdateVariant(ctx context.Context, req *connect.Request[cmd.UpdateVariantRequest]) (*connect.Response[cmd.UpdateVariantResponse], error) {
+	options, priceOverride, status := variantAttributesFromRequest(req.Msg.GetVariant())
+	updateVariantDTO := &dto.UpdateVariantDTO{
+		Id:            req.Msg.GetVariantId(),
+		ProductId:     req.Msg.GetProductId().GetValue(),
+		Sku:           req.Msg.GetVariant().GetSku(),
+		Options:       options,
+		PriceOverride: priceOverride,
+		Status:        status,
+	}
+
+	variantDTO, err := s.ps.UpdateVariant(ctx, updateVariantDTO)
+	if err != nil {
+		return nil, handleError(err, "update variant error")
+	}
+
+	res := &cmd.UpdateVariantResponse{}
+	res.SetVariant(createVariantFromDTO(variantDTO))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// RemoveVariant は商品のバリエーションを削除します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: バリエーション削除リクエスト（商品IDとバリエーションIDを含む）
+//
+// Returns:
+//   - *connect.Response[cmd.RemoveVariantResponse]: 削除されたバリエーションを含むレスポンス
+//   - error: バリデーションエラーの場合はCodeInvalidArgument、商品またはバリエーションが存在しない場合はCodeNotFound、
+//     その他のサービス層エラーの場合はCodeInternal
+func (s *ProductServiceHandlerImpl) RemoveVariant(ctx context.Context, req *connect.Request[cmd.RemoveVariantRequest]) (*connect.Response[cmd.RemoveVariantResponse], error) {
+	removeVariantDTO := &dto.RemoveVariantDTO{
+		Id:        req.Msg.GetVariantId(),
+		ProductId: req.Msg.GetProductId().GetValue(),
+	}
+
+	variantDTO, err := s.ps.RemoveVariant(ctx, removeVariantDTO)
+	if err != nil {
+		return nil, handleError(err, "remove variant error")
+	}
+
+	res := &cmd.RemoveVariantResponse{}
+	res.SetVariant(createVariantFromDTO(variantDTO))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// priceAdjustmentTypes はProtobufの単価の一括変更の方法とDTOの変更の方法の対応です。
+var priceAdjustmentTypes = map[cmd.PriceAdjustmentType]string{
+	cmd.PriceAdjustmentType_PRICE_ADJUSTMENT_TYPE_PERCENT: "PERCENT",
+	cmd.PriceAdjustmentType_PRICE_ADJUSTMENT_TYPE_FIXED:   "FIXED",
+}
+
+// priceRoundings はProtobufの端数処理の方法とDTOの端数処理の方法の対応です。
+// 未指定の場合はエンプティ（切り捨て）になります。
+var priceRoundings = map[cmd.PriceRounding]string{
+	cmd.PriceRounding_PRICE_ROUNDING_FLOOR:   "FLOOR",
+	cmd.PriceRounding_PRICE_ROUNDING_HALF_UP: "HALF_UP",
+	cmd.PriceRounding_PRICE_ROUNDING_CEIL:    "CEIL",
+}
+
+// AdjustPrices はカテゴリに属する商品の単価を一括で変更します。
+// プレビューの場合は単価を変更せずに変更前後の単価を返します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: 単価の一括変更リクエスト（カテゴリID、絞り込み条件、変更の規則を含む）
+//
+// Returns:
+//   - *connect.Response[cmd.AdjustPricesResponse]: 商品ごとの変更前後の単価を含むレスポンス
+//   - error: バリデーションエラーの場合はCodeInvalidArgument、カテゴリが存在しない場合はCodeNotFound、
+//     変更後の単価が範囲外または金額を含む変更で通貨が混在する場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
+func (s *ProductServiceHandlerImpl) AdjustPrices(ctx context.Context, req *connect.Request[cmd.AdjustPricesRequest]) (*connect.Response[cmd.AdjustPricesResponse], error) {
+	adjustment := req.Msg.GetAdjustment()
+	adjustDTO := &dto.AdjustPricesDTO{
+		CategoryId:         req.Msg.GetCategoryId().GetValue(),
+		IncludeDescendants: req.Msg.GetIncludeDescendants(),
+		Tags:               req.Msg.GetTags(),
+		Type:               priceAdjustmentTypes[adjustment.GetType()],
+		Value:              adjustment.GetValue(),
+		Rounding:           priceRoundings[adjustment.GetRounding()],
+		RoundingUnit:       adjustment.GetRoundingUnit(),
+		MinPrice:           adjustment.GetMinPrice(),
+		MaxPrice:           adjustment.GetMaxPrice(),
+		Preview:            req.Msg.GetPreview(),
+	}
+
+	resultDTO, err := s.ps.AdjustPrices(ctx, adjustDTO)
+	if err != nil {
+		return nil, handleError(err, "adjust prices error")
+	}
+
+	results := make([]*cmd.PriceAdjustmentResult, 0, len(resultDTO.Adjustments))
+	for _, adjustmentDTO := range resultDTO.Adjustments {
+		result := &cmd.PriceAdjustmentResult{}
+		result.SetProductId(adjustmentDTO.ProductId)
+		result.SetProductName(adjustmentDTO.ProductName)
+		result.SetCurrency(adjustmentDTO.Currency)
+		result.SetBeforePrice(adjustmentDTO.BeforePrice)
+		result.SetAfterPrice(adjustmentDTO.AfterPrice)
+		results = append(results, result)
+	}
+
+	res := &cmd.AdjustPricesResponse{}
+	res.SetResults(results)
+	res.SetPreview(resultDTO.Preview)
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// createTagsFromDTO はDTOからProtobufのTagの一覧を作成します。
+func createTagsFromDTO(dtos []dto.TagDTO) []*common.Tag {
+	tags := make([]*common.Tag, 0, len(dtos))
+	for _, d := range dtos {
+		t := &common.Tag{}
+		t.SetId(d.Id)
+		t.SetName(d.Name)
+		tags = append(tags, t)
+	}
+	return tags
+}
+
+// StockServiceHandlerImpl は在庫サービスのgRPCハンドラー実装です。
+// Connect RPCを使用して在庫数の増減と在庫引当のエンドポイントを提供します。
+type StockServiceHandlerImpl struct {
+	logger *slog.Logger
+	ss     service.StockService
+	cmdconnect.UnimplementedStockServiceHandler
+}
+
+// NewStockServiceHandlerImpl は新しいStockServiceHandlerImplインスタンスを生成します。
+//
+// Parameters:
+//   - logger: ロガーインスタンス
+//   - ss: 在庫サービスのインターフェース
+//
+// Returns:
+//   - *StockServiceHandlerImpl: 生成されたハンドラーインスタンス
+//   - error: エラーが発生した場合（現在は常にnil）
+func NewStockServiceHandlerImpl(logger *slog.Logger, ss service.StockService) (*StockServiceHandlerImpl, error) {
+	return &StockServiceHandlerImpl{
+		logger: logger,
+		ss:     ss,
+	}, nil
+}
+
+// AdjustStock は入荷や棚卸によって在庫数を増減します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: 在庫数の増減リクエスト（商品IDと増減数を含む）
+//
+// Returns:
+//   - *connect.Response[cmd.AdjustStockResponse]: 増減後の在庫を含むレスポンス
+//   - error: 商品が存在しない場合はCodeNotFound、引当済みの数量を下回る場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
+func (s *StockServiceHandlerImpl) AdjustStock(ctx context.Context, req *connect.Request[cmd.AdjustStockRequest]) (*connect.Response[cmd.AdjustStockResponse], error) {
+	adjustStockDTO := &dto.AdjustStockDTO{
+		ProductId: req.Msg.GetProductId().GetValue(),
+		Delta:     req.Msg.GetDelta(),
+	}
+
+	stockDTO, err := s.ss.Adjust(ctx, adjustStockDTO)
+	if err != nil {
+		return nil, handleError(err, "adjust stock error")
+	}
+
+	res := &cmd.AdjustStockResponse{}
+	res.SetStock(createStockFromDTO(stockDTO))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// ReserveStock は在庫を引き当てます。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: 在庫引当リクエスト（商品ID、引当数量、有効期限を含む）
+//
+// Returns:
+//   - *connect.Response[cmd.ReserveStockResponse]: 作成された引当と引当後の在庫を含むレスポンス
+//   - error: 商品が存在しない場合はCodeNotFound、引当可能な数量が不足する場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
+func (s *StockServiceHandlerImpl) ReserveStock(ctx context.Context, req *connect.Request[cmd.ReserveStockRequest]) (*connect.Response[cmd.ReserveStockResponse], error) {
+	reserveStockDTO := &dto.ReserveStockDTO{
+		ProductId: req.Msg.GetProductId().GetValue(),
+		Quantity:  uint32(req.Msg.GetQuantity()),
+		TTL:       time.Duration(req.Msg.GetTtlSeconds()) * time.Second,
+	}
+
+	reservationDTO, err := s.ss.Reserve(ctx, reserveStockDTO)
+	if err != nil {
+		return nil, handleError(err, "reserve stock error")
+	}
+
+	res := &cmd.ReserveStockResponse{}
+	res.SetReservation(createReservationFromDTO(reservationDTO))
+	res.SetStock(createStockFromDTO(reservationDTO.Stock))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// ReleaseReservation は引当をキャンセルし、在庫を引当可能に戻します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: 引当解放リクエスト（引当IDを含む）
+//
+// Returns:
+//   - *connect.Response[cmd.ReleaseReservationResponse]: 解放された引当と解放後の在庫を含むレスポンス
+//   - error: 引当が存在しない場合はCodeNotFound、引当中ではない場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
+func (s *StockServiceHandlerImpl) ReleaseReservation(ctx context.Context, req *connect.Request[cmd.ReleaseReservationRequest]) (*connect.Response[cmd.ReleaseReservationResponse], error) {
+	releaseDTO := &dto.ReleaseReservationDTO{
+		Id: req.Msg.GetReservationId(),
+	}
+
+	reservationDTO, err := s.ss.Release(ctx, releaseDTO)
+	if err != nil {
+		return nil, handleError(err, "release reservation error")
+	}
+
+	res := &cmd.ReleaseReservationResponse{}
+	res.SetReservation(createReservationFromDTO(reservationDTO))
+	res.SetStock(createStockFromDTO(reservationDTO.Stock))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// CommitReservation は引当を確定し、在庫数から差し引きます。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: 引当確定リクエスト（引当IDを含む）
+//
+// Returns:
+//   - *connect.Response[cmd.CommitReservationResponse]: 確定された引当と確定後の在庫を含むレスポンス
+//   - error: 引当が存在しない場合はCodeNotFound、引当中ではない場合や有効期限切れの場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
+func (s *StockServiceHandlerImpl) CommitReservation(ctx context.Context, req *connect.Request[cmd.CommitReservationRequest]) (*connect.Response[cmd.CommitReservationResponse], error) {
+	commitDTO := &dto.CommitReservationDTO{
+		Id: req.Msg.GetReservationId(),
+	}
+
+	reservationDTO, err := s.ss.Commit(ctx, commitDTO)
+	if err != nil {
+		return nil, handleError(err, "commit reservation error")
+	}
+
+	res := &cmd.CommitReservationResponse{}
+	res.SetReservation(createReservationFromDTO(reservationDTO))
+	res.SetStock(createStockFromDTO(reservationDTO.Stock))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// TagServiceHandlerImpl はタグサービスのgRPCハンドラー実装です。
+// Connect RPCを使用して複数の商品へのタグの一括付与・削除のエンドポイントを提供します。
+type TagServiceHandlerImpl struct {
+	logger *slog.Logger
+	ts     service.TagService
+	cmdconnect.UnimplementedTagServiceHandler
+}
+
+// NewTagServiceHandlerImpl は新しいTagServiceHandlerImplインスタンスを生成します。
+//
+// Parameters:
+//   - logger: ロガーインスタンス
+//   - ts: タグサービスのインターフェース
+//
+// Returns:
+//   - *TagServiceHandlerImpl: 生成されたハンドラーインスタンス
+//   - error: エラーが発生した場合（現在は常にnil）
+func NewTagServiceHandlerImpl(logger *slog.Logger, ts service.TagService) (*TagServiceHandlerImpl, error) {
+	return &TagServiceHandlerImpl{
+		logger: logger,
+		ts:     ts,
+	}, nil
+}
+
+// AttachTags は指定されたすべての商品に指定されたすべてのタグを付与します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: タグ付与リクエスト（商品IDとタグ名を含む）
+//
+// Returns:
+//   - *connect.Response[cmd.AttachTagsResponse]: 付与したタグと新たに付与した組み合わせの数を含むレスポンス
+//   - error: 商品が存在しない場合はCodeNotFound、その他のサービス層エラーの場合はCodeInternal
+func (s *TagServiceHandlerImpl) AttachTags(ctx context.Context, req *connect.Request[cmd.AttachTagsRequest]) (*connect.Response[cmd.AttachTagsResponse], error) {
+	changeDTO := &dto.ChangeTagsDTO{
+		ProductIds: req.Msg.GetProductIds(),
+		TagNames:   req.Msg.GetTagNames(),
+	}
+
+	resultDTO, err := s.ts.Attach(ctx, changeDTO)
+	if err != nil {
+		return nil, handleError(err, "attach tags error")
+	}
+
+	res := &cmd.AttachTagsResponse{}
+	res.SetTags(createTagsFromDTO(resultDTO.Tags))
+	res.SetAttachedCount(int32(resultDTO.Changed))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// DetachTags は指定されたすべての商品から指定されたすべてのタグを外します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: タグ削除リクエスト（商品IDとタグ名を含む）
+//
+// Returns:
+//   - *connect.Response[cmd.DetachTagsResponse]: 外したタグと外した組み合わせの数を含むレスポンス
+//   - error: 商品が存在しない場合はCodeNotFound、その他のサービス層エラーの場合はCodeInternal
+func (s *TagServiceHandlerImpl) DetachTags(ctx context.Context, req *connect.Request[cmd.DetachTagsRequest]) (*connect.Response[cmd.DetachTagsResponse], error) {
+	changeDTO := &dto.ChangeTagsDTO{
+		ProductIds: req.Msg.GetProductIds(),
+		TagNames:   req.Msg.GetTagNames(),
+	}
+
+	resultDTO, err := s.ts.Detach(ctx, changeDTO)
+	if err != nil {
+		return nil, handleError(err, "detach tags error")
+	}
+
+	res := &cmd.DetachTagsResponse{}
+	res.SetTags(createTagsFromDTO(resultDTO.Tags))
+	res.SetDetachedCount(int32(resultDTO.Changed))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// priceScheduleStatuses はドメインの価格スケジュールの状態とProtobufの列挙値の対応です。
+var priceScheduleStatuses = map[string]common.PriceScheduleStatus{
+	"SCHEDULED": common.PriceScheduleStatus_PRICE_SCHEDULE_STATUS_SCHEDULED,
+	"ACTIVE":    common.PriceScheduleStatus_PRICE_SCHEDULE_STATUS_ACTIVE,
+	"COMPLETED": common.PriceScheduleStatus_PRICE_SCHEDULE_STATUS_COMPLETED,
+	"CANCELLED": common.PriceScheduleStatus_PRICE_SCHEDULE_STATUS_CANCELLED,
+}
+
+// createPriceScheduleFromDTO はDTOからProtobufのPriceScheduleを作成します。
+func createPriceScheduleFromDTO(dto *dto.PriceScheduleDTO) *common.PriceSchedule {
+	ps := &common.PriceSchedule{}
+	ps.SetId(dto.Id)
+	ps.SetProductId(dto.ProductId)
+	ps.SetPrice(int32(dto.Price))
+	if dto.RegularPrice > 0 {
+		ps.SetRegularPrice(int32(dto.RegularPrice))
+	}
+	ps.SetStatus(priceScheduleStatuses[dto.Status])
+	ps.SetStartsAt(timestamppb.New(dto.StartsAt))
+	ps.SetEndsAt(timestamppb.New(dto.EndsAt))
+	return ps
+}
+
+// PriceScheduleServiceHandlerImpl は価格スケジュールサービスのgRPCハンドラー実装です。
+// Connect RPCを使用して期間限定の販売価格の登録・取消のエンドポイントを提供します。
+type PriceScheduleServiceHandlerImpl struct {
+	logger *slog.Logger
+	pss    service.PriceScheduleService
+	cmdconnect.UnimplementedPriceScheduleServiceHandler
+}
+
+// NewPriceScheduleServiceHandlerImpl は新しいPriceScheduleServiceHandlerImplインスタンスを生成します。
+//
+// Parameters:
+//   - logger: ロガーインスタンス
+//   - pss: 価格スケジュールサービスのインターフェース
+//
+// Returns:
+//   - *PriceScheduleServiceHandlerImpl: 生成されたハンドラーインスタンス
+//   - error: エラーが発生した場合（現在は常にnil）
+func NewPriceScheduleServiceHandlerImpl(logger *slog.Logger, pss service.PriceScheduleService) (*PriceScheduleServiceHandlerImpl, error) {
+	return &PriceScheduleServiceHandlerImpl{
+		logger: logger,
+		pss:    pss,
+	}, nil
+}
+
+// SchedulePrice は商品に価格スケジュールを登録します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: 価格スケジュール登録リクエスト（商品ID、期間中の単価、開始・終了時刻を含む）
+//
+// Returns:
+//   - *connect.Response[cmd.SchedulePriceResponse]: 登録された価格スケジュールを含むレスポンス
+//   - error: 商品が存在しない場合はCodeNotFound、期間が不正な場合はCodeInvalidArgument、期間が重なる場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
+func (s *PriceScheduleServiceHandlerImpl) SchedulePrice(ctx context.Context, req *connect.Request[cmd.SchedulePriceRequest]) (*connect.Response[cmd.SchedulePriceResponse], error) {
+	scheduleDTO := &dto.SchedulePriceDTO{
+		ProductId: req.Msg.GetProductId().GetValue(),
+		Price:     uint32(req.Msg.GetPrice().GetValue()),
+		StartsAt:  req.Msg.GetStartsAt().AsTime(),
+		EndsAt:    req.Msg.GetEndsAt().AsTime(),
+	}
+
+	priceScheduleDTO, err := s.pss.Schedule(ctx, scheduleDTO)
+	if err != nil {
+		return nil, handleError(err, "schedule price error")
+	}
+
+	res := &cmd.SchedulePriceResponse{}
+	res.SetPriceSchedule(createPriceScheduleFromDTO(priceScheduleDTO))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// CancelPriceSchedule は価格スケジュールを取り消します。適用中の場合は商品の単価を適用前に戻します。
+//
+// Parameters:
+//   - ctx: リクエストコンテキスト
+//   - req: 価格スケジュール取消リクエスト（商品IDと価格スケジュールIDを含む）
+//
+// Returns:
+//   - *connect.Response[cmd.CancelPriceScheduleResponse]: 取り消された価格スケジュールを含むレスポンス
+//   - error: 商品または価格スケジュールが存在しない場合はCodeNotFound、終了済みまたは取消済みの場合はCodeFailedPrecondition、その他のサービス層エラーの場合はCodeInternal
+func (s *PriceScheduleServiceHandlerImpl) CancelPriceSchedule(ctx context.Context, req *connect.Request[cmd.CancelPriceScheduleRequest]) (*connect.Response[cmd.CancelPriceScheduleResponse], error) {
+	cancelDTO := &dto.CancelPriceScheduleDTO{
+		ProductId: req.Msg.GetProductId().GetValue(),
+		Id:        req.Msg.GetPriceScheduleId(),
+	}
+
+	priceScheduleDTO, err := s.pss.Cancel(ctx, cancelDTO)
+	if err != nil {
+		return nil, handleError(err, "cancel price schedule error")
+	}
+
+	res := &cmd.CancelPriceScheduleResponse{}
+	res.SetPriceSchedule(createPriceScheduleFromDTO(priceScheduleDTO))
+	res.SetTimestamp(timestamppb.Now())
+
+	return connect.NewResponse(res), nil
+}
+
+// failedPreconditionCodes はCodeFailedPreconditionに変換するアプリケーションエラー・ドメインエラーのコードです。
+var failedPreconditionCodes = map[string]bool{
+	"INSUFFICIENT_STOCK":                true,
+	"RESERVATION_NOT_ACTIVE":            true,
+	"RESERVATION_EXPIRED":               true,
+	"CATEGORY_CYCLE":                    true,
+	"CATEGORY_HAS_CHILDREN":             true,
+	"INVALID_STATUS_TRANSITION":         true,
+	"PRICE_SCHEDULE_OVERLAP":            true,
+	"PRICE_SCHEDULE_NOT_PENDING":        true,
+	"PRICE_ADJUSTMENT_OUT_OF_RANGE":     true,
+	"PRICE_ADJUSTMENT_MIXED_CURRENCIES": true,
+}
+
+// handleError はサービス層のエラーを適切なConnectエラーに変換します。
+// 名前・SKUなどの重複はCodeAlreadyExists、対象が存在しない場合はCodeNotFound、不正な値はCodeInvalidArgument、
+// 在庫不足や引当の状態、カテゴリ階層の制約、商品の状態遷移、価格スケジュールの期間と状態、単価の一括変更の範囲と通貨による失敗はCodeFailedPrecondition、それ以外はCodeInternalになります。
+//
+// Parameters:
+//   - err: サービス層のエラー
+//   - operation: 操作名
+//
+// Returns:
+//   - error: Connectエラー
+func handleError(err error, operation string) error {
+	wrapped := fmt.Errorf("%s: %w", operation, err)
+
+	var appErr *errs.ApplicationError
+	if errors.As(err, &appErr) {
+		switch {
+		case strings.HasSuffix(appErr.Code, "_ALREADY_EXISTS"):
+			return connect.NewError(connect.CodeAlreadyExists, wrapped)
+		case strings.HasSuffix(appErr.Code, "_NOT_FOUND"):
+			return connect.NewError(connect.CodeNotFound, wrapped)
+		case failedPreconditionCodes[appErr.Code]:
+			return connect.NewError(connect.CodeFailedPrecondition, wrapped)
+		}
+	}
+	var domainErr *errs.DomainError
+	if errors.As(err, &domainErr) {
+		switch {
+		case domainErr.Code == "INVALID_ARGUMENT":
+			return connect.NewError(connect.CodeInvalidArgument, wrapped)
+		case strings.HasSuffix(domainErr.Code, "_ALREADY_EXISTS"):
+			return connect.NewError(connect.CodeAlreadyExists, wrapped)
+		case strings.HasSuffix(domainErr.Code, "_NOT_FOUND"):
+			return connect.NewError(connect.CodeNotFound, wrapped)
+		case failedPreconditionCodes[domainErr.Code]:
+			return connect.NewError(connect.CodeFailedPrecondition, wrapped)
+		}
+	}
+	return connect.NewError(connect.CodeInternal, wrapped)
+}
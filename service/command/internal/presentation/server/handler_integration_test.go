@@ -156,7 +156,7 @@ var _ = Describe("CategoryServiceHandler Integration Test", Label("IntegrationTe
 			Expect(resp2).To(BeNil())
 			var connectErr *connect.Error
 			Expect(errors.As(err, &connectErr)).To(BeTrue())
-			Expect(connectErr.Code()).To(Equal(connect.CodeInternal))
+			Expect(connectErr.Code()).To(Equal(connect.CodeAlreadyExists))
 		})
 	})
 
@@ -508,7 +508,7 @@ var _ = Describe("ProductServiceHandler Integration Test", Label("IntegrationTes
 			Expect(resp2).To(BeNil())
 			var connectErr *connect.Error
 			Expect(errors.As(err, &connectErr)).To(BeTrue())
-			Expect(connectErr.Code()).To(Equal(connect.CodeInternal))
+			Expect(connectErr.Code()).To(Equal(connect.CodeAlreadyExists))
 		})
 	})
 
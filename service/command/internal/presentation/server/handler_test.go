@@ -7,10 +7,15 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	cmd "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
 	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
 	interceptor "github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/application/dto"
 	mock_service "github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/mock/service"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/command/internal/presentation/server"
@@ -132,6 +137,29 @@ var _ = Describe("CategoryServiceHandler Unit Test", Label("UnitTests"), func()
 				Expect(connectErr.Code()).To(Equal(connect.CodeInternal))
 			})
 		})
+
+		Context("異常系: カテゴリ名が重複する場合", func() {
+			It("AlreadyExists エラーを返すこと", func() {
+				// Arrange
+				categoryName := "TestCategory"
+				req := testhelpers.CreateCategoryRequest(categoryName)
+
+				expectedErr := errs.NewApplicationError("CATEGORY_ALREADY_EXISTS", "category already exists")
+				mockCategoryService.EXPECT().
+					Add(gomock.Any(), &dto.CreateCategoryDTO{Name: categoryName}).
+					Return(nil, expectedErr)
+
+				// Act
+				resp, err := client.CreateCategory(ctx, req)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				var connectErr *connect.Error
+				Expect(errors.As(err, &connectErr)).To(BeTrue())
+				Expect(connectErr.Code()).To(Equal(connect.CodeAlreadyExists))
+			})
+		})
 	})
 
 	Describe("UpdateCategory", func() {
@@ -288,6 +316,190 @@ var _ = Describe("CategoryServiceHandler Unit Test", Label("UnitTests"), func()
 			})
 		})
 	})
+
+	Describe("CreateCategory with parent", func() {
+		It("親カテゴリIDをサービス層に渡し、レスポンスに親カテゴリIDを設定すること", func() {
+			// Arrange
+			req := testhelpers.CreateChildCategoryRequest("筆記具", "parent-category-id")
+			expectedDTO := &dto.CategoryDTO{
+				Id:       "test-category-id",
+				Name:     "筆記具",
+				ParentId: "parent-category-id",
+			}
+			mockCategoryService.EXPECT().
+				Add(gomock.Any(), &dto.CreateCategoryDTO{Name: "筆記具", ParentId: "parent-category-id"}).
+				Return(expectedDTO, nil)
+
+			// Act
+			resp, err := client.CreateCategory(ctx, req)
+
+			// Assert
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.GetCategory().HasParentId()).To(BeTrue())
+			Expect(resp.Msg.GetCategory().GetParentId()).To(Equal("parent-category-id"))
+		})
+	})
+
+	Describe("CreateCategory with translations", func() {
+		It("翻訳をサービス層に渡し、レスポンスに翻訳を設定すること", func() {
+			// Arrange
+			req := testhelpers.CreateCategoryRequest("文房具")
+			req.Msg.SetTranslations(map[string]string{"en": "Stationery"})
+			expectedDTO := &dto.CategoryDTO{
+				Id:           "test-category-id",
+				Name:         "文房具",
+				Translations: map[string]string{"en": "Stationery"},
+			}
+			mockCategoryService.EXPECT().
+				Add(gomock.Any(), &dto.CreateCategoryDTO{Name: "文房具", Translations: map[string]string{"en": "Stationery"}}).
+				Return(expectedDTO, nil)
+
+			// Act
+			resp, err := client.CreateCategory(ctx, req)
+
+			// Assert
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.GetCategory().GetTranslations()).To(HaveKeyWithValue("en", "Stationery"))
+		})
+
+		It("不正なロケールの場合はCodeInvalidArgumentを返すこと", func() {
+			// Arrange
+			req := testhelpers.CreateCategoryRequest("文房具")
+			req.Msg.SetTranslations(map[string]string{"english": "Stationery"})
+
+			// Act
+			resp, err := client.CreateCategory(ctx, req)
+
+			// Assert
+			Expect(err).To(HaveOccurred())
+			Expect(resp).To(BeNil())
+			var connectErr *connect.Error
+			Expect(errors.As(err, &connectErr)).To(BeTrue())
+			Expect(connectErr.Code()).To(Equal(connect.CodeInvalidArgument))
+		})
+	})
+
+	Describe("MoveCategory", func() {
+		Context("正常系: カテゴリが正常に移動される場合", func() {
+			It("サービス層から返されたDTOをレスポンスとして返すこと", func() {
+				// Arrange
+				req := testhelpers.MoveCategoryRequest("test-category-id", "parent-category-id")
+				expectedDTO := &dto.CategoryDTO{
+					Id:       "test-category-id",
+					Name:     "MovedCategory",
+					ParentId: "parent-category-id",
+				}
+				mockCategoryService.EXPECT().
+					Move(gomock.Any(), &dto.MoveCategoryDTO{Id: "test-category-id", ParentId: "parent-category-id"}).
+					Return(expectedDTO, nil)
+
+				// Act
+				resp, err := client.MoveCategory(ctx, req)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Msg.GetCategory().GetId()).To(Equal(expectedDTO.Id))
+				Expect(resp.Msg.GetCategory().GetParentId()).To(Equal(expectedDTO.ParentId))
+				Expect(resp.Msg.GetTimestamp()).NotTo(BeNil())
+			})
+
+			It("親カテゴリIDを指定しない場合はルートに移動すること", func() {
+				// Arrange
+				req := testhelpers.MoveCategoryRequest("test-category-id", "")
+				expectedDTO := &dto.CategoryDTO{
+					Id:   "test-category-id",
+					Name: "MovedCategory",
+				}
+				mockCategoryService.EXPECT().
+					Move(gomock.Any(), &dto.MoveCategoryDTO{Id: "test-category-id"}).
+					Return(expectedDTO, nil)
+
+				// Act
+				resp, err := client.MoveCategory(ctx, req)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Msg.GetCategory().HasParentId()).To(BeFalse())
+			})
+		})
+
+		Context("異常系: バリデーションエラーが発生する場合", func() {
+			It("カテゴリIDが未設定の場合 InvalidArgument エラーを返すこと", func() {
+				// Arrange
+				req := connect.NewRequest(&cmd.MoveCategoryRequest{})
+
+				// Act
+				resp, err := client.MoveCategory(ctx, req)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				var connectErr *connect.Error
+				Expect(errors.As(err, &connectErr)).To(BeTrue())
+				Expect(connectErr.Code()).To(Equal(connect.CodeInvalidArgument))
+			})
+		})
+
+		Context("異常系: 自身または子孫の下に移動する場合", func() {
+			It("FailedPrecondition エラーを返すこと", func() {
+				// Arrange
+				req := testhelpers.MoveCategoryRequest("test-category-id", "child-category-id")
+				mockCategoryService.EXPECT().
+					Move(gomock.Any(), gomock.Any()).
+					Return(nil, errs.NewDomainError("CATEGORY_CYCLE", "カテゴリを自身またはその子孫の下に移動することはできません"))
+
+				// Act
+				resp, err := client.MoveCategory(ctx, req)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				var connectErr *connect.Error
+				Expect(errors.As(err, &connectErr)).To(BeTrue())
+				Expect(connectErr.Code()).To(Equal(connect.CodeFailedPrecondition))
+			})
+		})
+
+		Context("異常系: 親カテゴリが存在しない場合", func() {
+			It("NotFound エラーを返すこと", func() {
+				// Arrange
+				req := testhelpers.MoveCategoryRequest("test-category-id", "missing-category-id")
+				mockCategoryService.EXPECT().
+					Move(gomock.Any(), gomock.Any()).
+					Return(nil, errs.NewApplicationError("PARENT_CATEGORY_NOT_FOUND", "parent category not found"))
+
+				// Act
+				resp, err := client.MoveCategory(ctx, req)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				var connectErr *connect.Error
+				Expect(errors.As(err, &connectErr)).To(BeTrue())
+				Expect(connectErr.Code()).To(Equal(connect.CodeNotFound))
+			})
+		})
+	})
+
+	Describe("DeleteCategory with children", func() {
+		It("子カテゴリを持つ場合 FailedPrecondition エラーを返すこと", func() {
+			// Arrange
+			req := testhelpers.CreateDeleteCategoryRequest("test-category-id")
+			mockCategoryService.EXPECT().
+				Delete(gomock.Any(), &dto.DeleteCategoryDTO{Id: "test-category-id"}).
+				Return(nil, errs.NewApplicationError("CATEGORY_HAS_CHILDREN", "Category has child categories"))
+
+			// Act
+			resp, err := client.DeleteCategory(ctx, req)
+
+			// Assert
+			Expect(err).To(HaveOccurred())
+			Expect(resp).To(BeNil())
+			var connectErr *connect.Error
+			Expect(errors.As(err, &connectErr)).To(BeTrue())
+			Expect(connectErr.Code()).To(Equal(connect.CodeFailedPrecondition))
+		})
+	})
 })
 
 var _ = Describe("ProductServiceHandler Unit Test", Label("UnitTests"), func() {
@@ -379,9 +591,134 @@ var _ = Describe("ProductServiceHandler Unit Test", Label("UnitTests"), func() {
 				Expect(resp.Msg.GetProduct().GetCategory().GetName()).To(Equal(expectedDTO.Category.Name))
 				Expect(resp.Msg.GetTimestamp()).NotTo(BeNil())
 			})
+
+			It("通貨と税率区分をサービス層に渡し、税抜価格をレスポンスに設定すること", func() {
+				// Arrange
+				req := testhelpers.CreateProductRequest("Coffee", 1250, "cat-id", "Food")
+				req.Msg.GetProduct().SetCurrency("USD")
+				req.Msg.GetProduct().SetTaxClass(common.TaxClass_TAX_CLASS_REDUCED)
+
+				mockProductService.EXPECT().
+					Add(gomock.Any(), &dto.CreateProductDTO{
+						Name:     "Coffee",
+						Price:    1250,
+						Currency: "USD",
+						TaxClass: "REDUCED",
+						Category: &dto.CategoryDTO{
+							Id:   "cat-id",
+							Name: "Food",
+						},
+					}).
+					Return(&dto.ProductDTO{
+						Id:       "test-product-id",
+						Name:     "Coffee",
+						Price:    1250,
+						Currency: "USD",
+						TaxClass: "REDUCED",
+						Category: &dto.CategoryDTO{Id: "cat-id", Name: "Food"},
+					}, nil)
+
+				// Act
+				resp, err := client.CreateProduct(ctx, req)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Msg.GetProduct().GetTaxClass()).To(Equal(common.TaxClass_TAX_CLASS_REDUCED))
+				Expect(resp.Msg.GetProduct().GetPriceExcludingTax().GetAmount()).To(Equal(int64(1250)))
+				Expect(resp.Msg.GetProduct().GetPriceExcludingTax().GetCurrency()).To(Equal("USD"))
+				Expect(resp.Msg.GetProduct().HasPriceIncludingTax()).To(BeFalse())
+			})
+
+			It("バーコードをサービス層に渡し、レスポンスに設定すること", func() {
+				// Arrange
+				req := testhelpers.CreateProductRequest("Pen", 120, "cat-id", "Stationery")
+				req.Msg.GetProduct().SetBarcode("036000291452")
+
+				mockProductService.EXPECT().
+					Add(gomock.Any(), &dto.CreateProductDTO{
+						Name:     "Pen",
+						Price:    120,
+						Category: &dto.CategoryDTO{Id: "cat-id", Name: "Stationery"},
+						Barcode:  "036000291452",
+					}).
+					Return(&dto.ProductDTO{
+						Id:       "test-product-id",
+						Name:     "Pen",
+						Price:    120,
+						Category: &dto.CategoryDTO{Id: "cat-id", Name: "Stationery"},
+						Barcode:  "0036000291452",
+					}, nil)
+
+				// Act
+				resp, err := client.CreateProduct(ctx, req)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Msg.GetProduct().GetBarcode()).To(Equal("0036000291452"))
+			})
+
+			It("スラッグをサービス層に渡し、レスポンスに設定すること", func() {
+				// Arrange
+				req := testhelpers.CreateProductRequest("Pen", 120, "cat-id", "Stationery")
+				req.Msg.GetProduct().SetSlug("Gel-Pen")
+
+				mockProductService.EXPECT().
+					Add(gomock.Any(), &dto.CreateProductDTO{
+						Name:     "Pen",
+						Price:    120,
+						Category: &dto.CategoryDTO{Id: "cat-id", Name: "Stationery"},
+						Slug:     "Gel-Pen",
+					}).
+					Return(&dto.ProductDTO{
+						Id:       "test-product-id",
+						Name:     "Pen",
+						Price:    120,
+						Category: &dto.CategoryDTO{Id: "cat-id", Name: "Stationery"},
+						Slug:     "gel-pen",
+					}, nil)
+
+				// Act
+				resp, err := client.CreateProduct(ctx, req)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Msg.GetProduct().GetSlug()).To(Equal("gel-pen"))
+			})
 		})
 
 		Context("異常系: バリデーションエラーが発生する場合", func() {
+			It("形式が不正なスラッグで InvalidArgument エラーを返すこと", func() {
+				// Arrange
+				req := testhelpers.CreateProductRequest("Pen", 120, "cat-id", "Stationery")
+				req.Msg.GetProduct().SetSlug("gel pen")
+
+				// Act
+				resp, err := client.CreateProduct(ctx, req)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				var connectErr *connect.Error
+				Expect(errors.As(err, &connectErr)).To(BeTrue())
+				Expect(connectErr.Code()).To(Equal(connect.CodeInvalidArgument))
+			})
+
+			It("桁数が不正なバーコードで InvalidArgument エラーを返すこと", func() {
+				// Arrange
+				req := testhelpers.CreateProductRequest("Pen", 120, "cat-id", "Stationery")
+				req.Msg.GetProduct().SetBarcode("12345")
+
+				// Act
+				resp, err := client.CreateProduct(ctx, req)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				var connectErr *connect.Error
+				Expect(errors.As(err, &connectErr)).To(BeTrue())
+				Expect(connectErr.Code()).To(Equal(connect.CodeInvalidArgument))
+			})
+
 			It("空の商品名で InvalidArgument エラーを返すこと", func() {
 				// Arrange
 				req := testhelpers.CreateProductRequest("", 1000, "cat-id", "Category")
@@ -460,6 +797,32 @@ var _ = Describe("ProductServiceHandler Unit Test", Label("UnitTests"), func() {
 				Expect(connectErr.Code()).To(Equal(connect.CodeInternal))
 			})
 		})
+
+		Context("異常系: 商品名が重複する場合", func() {
+			It("AlreadyExists エラーを返すこと", func() {
+				// Arrange
+				productName := "TestProduct"
+				productPrice := uint32(1000)
+				categoryId := "test-category-id"
+				categoryName := "TestCategory"
+				req := testhelpers.CreateProductRequest(productName, productPrice, categoryId, categoryName)
+
+				expectedErr := errs.NewApplicationError("PRODUCT_ALREADY_EXISTS", "product already exists")
+				mockProductService.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
+					Return(nil, expectedErr)
+
+				// Act
+				resp, err := client.CreateProduct(ctx, req)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				var connectErr *connect.Error
+				Expect(errors.As(err, &connectErr)).To(BeTrue())
+				Expect(connectErr.Code()).To(Equal(connect.CodeAlreadyExists))
+			})
+		})
 	})
 
 	Describe("UpdateProduct", func() {
@@ -503,6 +866,35 @@ var _ = Describe("ProductServiceHandler Unit Test", Label("UnitTests"), func() {
 				Expect(resp.Msg.GetProduct().GetCategory().GetId()).To(Equal(expectedDTO.Category.Id))
 				Expect(resp.Msg.GetTimestamp()).NotTo(BeNil())
 			})
+
+			It("空文字列のバーコードを削除としてサービス層に渡すこと", func() {
+				// Arrange
+				req := testhelpers.UpdateProductRequest("prod-id", "Pen", 120, "cat-id")
+				req.Msg.GetProduct().SetBarcode("")
+				barcode := ""
+
+				mockProductService.EXPECT().
+					Update(gomock.Any(), &dto.UpdateProductDTO{
+						Id:         "prod-id",
+						Name:       "Pen",
+						Price:      120,
+						CategoryId: "cat-id",
+						Barcode:    &barcode,
+					}).
+					Return(&dto.ProductDTO{
+						Id:       "prod-id",
+						Name:     "Pen",
+						Price:    120,
+						Category: &dto.CategoryDTO{Id: "cat-id", Name: "Stationery"},
+					}, nil)
+
+				// Act
+				resp, err := client.UpdateProduct(ctx, req)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Msg.GetProduct().GetBarcode()).To(BeEmpty())
+			})
 		})
 
 		Context("異常系: バリデーションエラーが発生する場合", func() {
@@ -673,4 +1065,707 @@ var _ = Describe("ProductServiceHandler Unit Test", Label("UnitTests"), func() {
 			})
 		})
 	})
+
+	Describe("PublishProduct", func() {
+		Context("正常系: 商品が公開される場合", func() {
+			It("公開された商品の状態をレスポンスとして返すこと", func() {
+				// Arrange
+				productId := "test-product-id"
+				req := testhelpers.PublishProductRequest(productId)
+
+				mockProductService.EXPECT().
+					Publish(gomock.Any(), &dto.ChangeProductStatusDTO{Id: productId}).
+					Return(&dto.ProductDTO{
+						Id:       productId,
+						Name:     "PublishedProduct",
+						Price:    1000,
+						Status:   "PUBLISHED",
+						Category: &dto.CategoryDTO{Id: "cat-id", Name: "Category"},
+					}, nil)
+
+				// Act
+				resp, err := client.PublishProduct(ctx, req)
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Msg.GetProduct().GetId()).To(Equal(productId))
+				Expect(resp.Msg.GetProduct().GetStatus()).To(Equal(common.ProductStatus_PRODUCT_STATUS_PUBLISHED))
+				Expect(resp.Msg.GetTimestamp()).NotTo(BeNil())
+			})
+		})
+
+		Context("異常系: 許可されていない状態遷移の場合", func() {
+			It("FailedPrecondition エラーを返すこと", func() {
+				// Arrange
+				productId := "test-product-id"
+				req := testhelpers.PublishProductRequest(productId)
+
+				mockProductService.EXPECT().
+					Publish(gomock.Any(), &dto.ChangeProductStatusDTO{Id: productId}).
+					Return(nil, errs.NewDomainError("INVALID_STATUS_TRANSITION", "商品の状態をDISCONTINUEDからPUBLISHEDに変更できません"))
+
+				// Act
+				resp, err := client.PublishProduct(ctx, req)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				var connectErr *connect.Error
+				Expect(errors.As(err, &connectErr)).To(BeTrue())
+				Expect(connectErr.Code()).To(Equal(connect.CodeFailedPrecondition))
+			})
+		})
+	})
+
+	Describe("SuspendProduct", func() {
+		Context("異常系: 商品が存在しない場合", func() {
+			It("NotFound エラーを返すこと", func() {
+				// Arrange
+				productId := "test-product-id"
+				req := testhelpers.SuspendProductRequest(productId)
+
+				mockProductService.EXPECT().
+					Suspend(gomock.Any(), &dto.ChangeProductStatusDTO{Id: productId}).
+					Return(nil, errs.NewApplicationError("PRODUCT_NOT_FOUND", "Product not found"))
+
+				// Act
+				resp, err := client.SuspendProduct(ctx, req)
+
+				// Assert
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				var connectErr *connect.Error
+				Expect(errors.As(err, &connectErr)).To(BeTrue())
+				Expect(connectErr.Code()).To(Equal(connect.CodeNotFound))
+			})
+		})
+	})
+
+	Describe("AddVariant", func() {
+		const productId = "test-product-id"
+
+		It("サービス層から返されたDTOをレスポンスとして返すこと", func() {
+			priceOverride := uint32(2500)
+			attrs := testhelpers.VariantAttributes("ts-m", "サイズ", "M")
+			attrs.SetPriceOverride(2500)
+			req := testhelpers.AddVariantRequest(productId, attrs)
+
+			mockProductService.EXPECT().
+				AddVariant(gomock.Any(), &dto.AddVariantDTO{
+					ProductId:     productId,
+					Sku:           "ts-m",
+					Options:       []dto.VariantOptionDTO{{Axis: "サイズ", Value: "M"}},
+					PriceOverride: &priceOverride,
+				}).
+				Return(&dto.VariantDTO{
+					Id:            "test-variant-id",
+					ProductId:     productId,
+					Sku:           "TS-M",
+					Options:       []dto.VariantOptionDTO{{Axis: "サイズ", Value: "M"}},
+					PriceOverride: &priceOverride,
+					Price:         2500,
+					Status:        "ACTIVE",
+				}, nil)
+
+			resp, err := client.AddVariant(ctx, req)
+
+			Expect(err).NotTo(HaveOccurred())
+			variant := resp.Msg.GetVariant()
+			Expect(variant.GetId()).To(Equal("test-variant-id"))
+			Expect(variant.GetSku()).To(Equal("TS-M"))
+			Expect(variant.GetOptions()).To(HaveLen(1))
+			Expect(variant.GetOptions()[0].GetAxis()).To(Equal("サイズ"))
+			Expect(variant.HasPriceOverride()).To(BeTrue())
+			Expect(variant.GetPrice()).To(Equal(int32(2500)))
+			Expect(variant.GetStatus()).To(Equal(common.VariantStatus_VARIANT_STATUS_ACTIVE))
+			Expect(resp.Msg.GetTimestamp()).NotTo(BeNil())
+		})
+
+		It("選択肢がない場合は InvalidArgument エラーを返すこと", func() {
+			req := testhelpers.AddVariantRequest(productId, testhelpers.VariantAttributes("TS-M"))
+
+			_, err := client.AddVariant(ctx, req)
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+		})
+
+		DescribeTable("サービス層のエラーを対応するコードに変換すること",
+			func(serviceErr error, expectedCode connect.Code) {
+				req := testhelpers.AddVariantRequest(productId, testhelpers.VariantAttributes("TS-M", "サイズ", "M"))
+				mockProductService.EXPECT().AddVariant(gomock.Any(), gomock.Any()).Return(nil, serviceErr)
+
+				_, err := client.AddVariant(ctx, req)
+
+				Expect(connect.CodeOf(err)).To(Equal(expectedCode))
+			},
+			Entry("SKUの重複は AlreadyExists",
+				errs.NewApplicationError("VARIANT_ALREADY_EXISTS", "Variant already exists"), connect.CodeAlreadyExists),
+			Entry("商品内の選択肢の組み合わせの重複は AlreadyExists",
+				errs.NewDomainError("VARIANT_ALREADY_EXISTS", "選択肢の組み合わせが重複しています"), connect.CodeAlreadyExists),
+			Entry("商品が存在しない場合は NotFound",
+				errs.NewApplicationError("PRODUCT_NOT_FOUND", "Product not found"), connect.CodeNotFound),
+			Entry("選択肢の軸が異なる場合は InvalidArgument",
+				errs.NewDomainError("INVALID_ARGUMENT", "選択肢の軸は既存のバリエーションと同じにしてください"), connect.CodeInvalidArgument),
+		)
+	})
+
+	Describe("UpdateVariant", func() {
+		It("販売状態をDTOの文字列に変換してサービス層に渡すこと", func() {
+			variantId := uuid.NewString()
+			attrs := testhelpers.VariantAttributes("TS-M", "サイズ", "M")
+			attrs.SetStatus(common.VariantStatus_VARIANT_STATUS_INACTIVE)
+			req := testhelpers.UpdateVariantRequest("test-product-id", variantId, attrs)
+
+			mockProductService.EXPECT().
+				UpdateVariant(gomock.Any(), &dto.UpdateVariantDTO{
+					Id:        variantId,
+					ProductId: "test-product-id",
+					Sku:       "TS-M",
+					Options:   []dto.VariantOptionDTO{{Axis: "サイズ", Value: "M"}},
+					Status:    "INACTIVE",
+				}).
+				Return(&dto.VariantDTO{Id: variantId, Sku: "TS-M", Price: 1000, Status: "INACTIVE"}, nil)
+
+			resp, err := client.UpdateVariant(ctx, req)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.GetVariant().HasPriceOverride()).To(BeFalse())
+			Expect(resp.Msg.GetVariant().GetStatus()).To(Equal(common.VariantStatus_VARIANT_STATUS_INACTIVE))
+		})
+
+		It("バリエーションが存在しない場合は NotFound エラーを返すこと", func() {
+			req := testhelpers.UpdateVariantRequest("test-product-id", uuid.NewString(), testhelpers.VariantAttributes("TS-M", "サイズ", "M"))
+			mockProductService.EXPECT().UpdateVariant(gomock.Any(), gomock.Any()).
+				Return(nil, errs.NewDomainError("VARIANT_NOT_FOUND", "バリエーションが見つかりません"))
+
+			_, err := client.UpdateVariant(ctx, req)
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeNotFound))
+		})
+	})
+
+	Describe("RemoveVariant", func() {
+		It("削除したバリエーションを返すこと", func() {
+			variantId := uuid.NewString()
+			req := testhelpers.RemoveVariantRequest("test-product-id", variantId)
+			mockProductService.EXPECT().
+				RemoveVariant(gomock.Any(), &dto.RemoveVariantDTO{Id: variantId, ProductId: "test-product-id"}).
+				Return(&dto.VariantDTO{Id: variantId, Sku: "TS-M", Price: 1000, Status: "ACTIVE"}, nil)
+
+			resp, err := client.RemoveVariant(ctx, req)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.GetVariant().GetId()).To(Equal(variantId))
+		})
+
+		It("UUID形式ではないバリエーションIDは InvalidArgument エラーを返すこと", func() {
+			_, err := client.RemoveVariant(ctx, testhelpers.RemoveVariantRequest("test-product-id", "invalid"))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+		})
+	})
+
+	Describe("AdjustPrices", func() {
+		var categoryId string
+
+		BeforeEach(func() {
+			categoryId = uuid.NewString()
+		})
+
+		It("商品ごとの変更前後の単価を返すこと", func() {
+			mockProductService.EXPECT().
+				AdjustPrices(gomock.Any(), &dto.AdjustPricesDTO{CategoryId: categoryId, Type: "PERCENT", Value: -10, Preview: true}).
+				Return(&dto.AdjustPricesResultDTO{
+					Adjustments: []*dto.PriceAdjustmentDTO{
+						{ProductId: "test-product-id", ProductName: "ボールペン", Currency: "JPY", BeforePrice: 1200, AfterPrice: 1080},
+					},
+					Preview: true,
+				}, nil)
+
+			resp, err := client.AdjustPrices(ctx, testhelpers.AdjustPricesRequest(categoryId, cmd.PriceAdjustmentType_PRICE_ADJUSTMENT_TYPE_PERCENT, -10, true))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.GetPreview()).To(BeTrue())
+			Expect(resp.Msg.GetResults()).To(HaveLen(1))
+			result := resp.Msg.GetResults()[0]
+			Expect(result.GetProductId()).To(Equal("test-product-id"))
+			Expect(result.GetBeforePrice()).To(Equal(uint32(1200)))
+			Expect(result.GetAfterPrice()).To(Equal(uint32(1080)))
+			Expect(resp.Msg.GetTimestamp()).NotTo(BeNil())
+		})
+
+		It("変更の方法が未指定の場合は InvalidArgument エラーを返すこと", func() {
+			_, err := client.AdjustPrices(ctx, testhelpers.AdjustPricesRequest(categoryId, cmd.PriceAdjustmentType_PRICE_ADJUSTMENT_TYPE_UNSPECIFIED, 10, false))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+		})
+
+		It("変更後の単価が範囲外の場合は FailedPrecondition エラーを返すこと", func() {
+			mockProductService.EXPECT().
+				AdjustPrices(gomock.Any(), gomock.Any()).
+				Return(nil, errs.NewDomainError("PRICE_ADJUSTMENT_OUT_OF_RANGE", "out of range"))
+
+			_, err := client.AdjustPrices(ctx, testhelpers.AdjustPricesRequest(categoryId, cmd.PriceAdjustmentType_PRICE_ADJUSTMENT_TYPE_FIXED, -2000, false))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeFailedPrecondition))
+		})
+	})
+})
+
+var _ = Describe("StockServiceHandler Unit Test", Label("UnitTests"), func() {
+	var (
+		ctrl             *gomock.Controller
+		mockStockService *mock_service.MockStockService
+		client           cmdconnect.StockServiceClient
+		ctx              context.Context
+		productID        string
+		reservationID    string
+		expiresAt        time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockStockService = mock_service.NewMockStockService(ctrl)
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		ssh, err := server.NewStockServiceHandlerImpl(logger, mockStockService)
+		Expect(err).NotTo(HaveOccurred())
+
+		validator, err := interceptor.NewValidator(logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		mux := http.NewServeMux()
+		path, handler := cmdconnect.NewStockServiceHandler(
+			ssh,
+			connect.WithInterceptors(validator.NewUnaryInterceptor()),
+		)
+		mux.Handle(path, handler)
+		testServer := httptest.NewServer(mux)
+		DeferCleanup(testServer.Close)
+
+		client = cmdconnect.NewStockServiceClient(
+			testServer.Client(),
+			testServer.URL,
+		)
+
+		ctx = context.Background()
+		productID = uuid.NewString()
+		reservationID = uuid.NewString()
+		expiresAt = time.Date(2025, 1, 1, 12, 15, 0, 0, time.UTC)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	// expectCode はエラーが指定したConnectエラーコードであることを検証します。
+	expectCode := func(err error, code connect.Code) {
+		Expect(err).To(HaveOccurred())
+		var connectErr *connect.Error
+		Expect(errors.As(err, &connectErr)).To(BeTrue())
+		Expect(connectErr.Code()).To(Equal(code))
+	}
+
+	Describe("AdjustStock", func() {
+		It("増減後の在庫をレスポンスとして返すこと", func() {
+			mockStockService.EXPECT().
+				Adjust(gomock.Any(), &dto.AdjustStockDTO{ProductId: productID, Delta: 5}).
+				Return(&dto.StockDTO{ProductId: productID, OnHand: 15, Reserved: 3, Available: 12}, nil)
+
+			resp, err := client.AdjustStock(ctx, testhelpers.AdjustStockRequest(productID, 5))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.GetStock().GetProductId()).To(Equal(productID))
+			Expect(resp.Msg.GetStock().GetOnHand()).To(Equal(int32(15)))
+			Expect(resp.Msg.GetStock().GetReserved()).To(Equal(int32(3)))
+			Expect(resp.Msg.GetStock().GetAvailable()).To(Equal(int32(12)))
+			Expect(resp.Msg.GetTimestamp()).NotTo(BeNil())
+		})
+
+		It("増減数が0の場合、InvalidArgument エラーを返すこと", func() {
+			resp, err := client.AdjustStock(ctx, testhelpers.AdjustStockRequest(productID, 0))
+			expectCode(err, connect.CodeInvalidArgument)
+			Expect(resp).To(BeNil())
+		})
+
+		It("商品が存在しない場合、NotFound エラーを返すこと", func() {
+			mockStockService.EXPECT().
+				Adjust(gomock.Any(), gomock.Any()).
+				Return(nil, errs.NewApplicationError("PRODUCT_NOT_FOUND", "Product not found"))
+
+			_, err := client.AdjustStock(ctx, testhelpers.AdjustStockRequest(productID, 5))
+			expectCode(err, connect.CodeNotFound)
+		})
+
+		It("引当済みの数量を下回る場合、FailedPrecondition エラーを返すこと", func() {
+			mockStockService.EXPECT().
+				Adjust(gomock.Any(), gomock.Any()).
+				Return(nil, errs.NewDomainError("INSUFFICIENT_STOCK", "insufficient stock"))
+
+			_, err := client.AdjustStock(ctx, testhelpers.AdjustStockRequest(productID, -5))
+			expectCode(err, connect.CodeFailedPrecondition)
+		})
+	})
+
+	Describe("ReserveStock", func() {
+		It("作成された引当と引当後の在庫をレスポンスとして返すこと", func() {
+			mockStockService.EXPECT().
+				Reserve(gomock.Any(), &dto.ReserveStockDTO{ProductId: productID, Quantity: 2, TTL: 60 * time.Second}).
+				Return(&dto.ReservationDTO{
+					Id:        reservationID,
+					ProductId: productID,
+					Quantity:  2,
+					Status:    "RESERVED",
+					ExpiresAt: expiresAt,
+					Stock:     &dto.StockDTO{ProductId: productID, OnHand: 10, Reserved: 2, Available: 8},
+				}, nil)
+
+			resp, err := client.ReserveStock(ctx, testhelpers.ReserveStockRequest(productID, 2, 60))
+
+			Expect(err).NotTo(HaveOccurred())
+			reservation := resp.Msg.GetReservation()
+			Expect(reservation.GetId()).To(Equal(reservationID))
+			Expect(reservation.GetQuantity()).To(Equal(int32(2)))
+			Expect(reservation.GetStatus()).To(Equal(cmd.ReservationStatus_RESERVATION_STATUS_RESERVED))
+			Expect(reservation.GetExpiresAt().AsTime()).To(Equal(expiresAt))
+			Expect(resp.Msg.GetStock().GetAvailable()).To(Equal(int32(8)))
+		})
+
+		DescribeTable("不正なリクエストの場合、InvalidArgument エラーを返すこと",
+			func(quantity int32, ttlSeconds int32) {
+				_, err := client.ReserveStock(ctx, testhelpers.ReserveStockRequest(productID, quantity, ttlSeconds))
+				expectCode(err, connect.CodeInvalidArgument)
+			},
+			Entry("引当数量が0", int32(0), int32(0)),
+			Entry("有効期限が負", int32(1), int32(-1)),
+			Entry("有効期限が上限を超える", int32(1), int32(86401)),
+		)
+
+		It("引当可能な数量が不足する場合、FailedPrecondition エラーを返すこと", func() {
+			mockStockService.EXPECT().
+				Reserve(gomock.Any(), gomock.Any()).
+				Return(nil, errs.NewDomainError("INSUFFICIENT_STOCK", "insufficient stock"))
+
+			_, err := client.ReserveStock(ctx, testhelpers.ReserveStockRequest(productID, 2, 0))
+			expectCode(err, connect.CodeFailedPrecondition)
+		})
+	})
+
+	Describe("ReleaseReservation", func() {
+		It("解放された引当をレスポンスとして返すこと", func() {
+			mockStockService.EXPECT().
+				Release(gomock.Any(), &dto.ReleaseReservationDTO{Id: reservationID}).
+				Return(&dto.ReservationDTO{
+					Id:        reservationID,
+					ProductId: productID,
+					Quantity:  2,
+					Status:    "RELEASED",
+					ExpiresAt: expiresAt,
+					Stock:     &dto.StockDTO{ProductId: productID, OnHand: 10, Available: 10},
+				}, nil)
+
+			resp, err := client.ReleaseReservation(ctx, testhelpers.ReleaseReservationRequest(reservationID))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.GetReservation().GetStatus()).To(Equal(cmd.ReservationStatus_RESERVATION_STATUS_RELEASED))
+			Expect(resp.Msg.GetStock().GetAvailable()).To(Equal(int32(10)))
+		})
+
+		It("引当IDがUUID形式ではない場合、InvalidArgument エラーを返すこと", func() {
+			_, err := client.ReleaseReservation(ctx, testhelpers.ReleaseReservationRequest("invalid"))
+			expectCode(err, connect.CodeInvalidArgument)
+		})
+
+		It("引当が存在しない場合、NotFound エラーを返すこと", func() {
+			mockStockService.EXPECT().
+				Release(gomock.Any(), gomock.Any()).
+				Return(nil, errs.NewApplicationError("RESERVATION_NOT_FOUND", "Reservation not found"))
+
+			_, err := client.ReleaseReservation(ctx, testhelpers.ReleaseReservationRequest(reservationID))
+			expectCode(err, connect.CodeNotFound)
+		})
+	})
+
+	Describe("CommitReservation", func() {
+		It("確定された引当をレスポンスとして返すこと", func() {
+			mockStockService.EXPECT().
+				Commit(gomock.Any(), &dto.CommitReservationDTO{Id: reservationID}).
+				Return(&dto.ReservationDTO{
+					Id:        reservationID,
+					ProductId: productID,
+					Quantity:  2,
+					Status:    "COMMITTED",
+					ExpiresAt: expiresAt,
+					Stock:     &dto.StockDTO{ProductId: productID, OnHand: 8, Available: 8},
+				}, nil)
+
+			resp, err := client.CommitReservation(ctx, testhelpers.CommitReservationRequest(reservationID))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.GetReservation().GetStatus()).To(Equal(cmd.ReservationStatus_RESERVATION_STATUS_COMMITTED))
+			Expect(resp.Msg.GetStock().GetOnHand()).To(Equal(int32(8)))
+		})
+
+		DescribeTable("引当の状態によって確定できない場合、FailedPrecondition エラーを返すこと",
+			func(code string) {
+				mockStockService.EXPECT().
+					Commit(gomock.Any(), gomock.Any()).
+					Return(nil, errs.NewDomainError(code, "cannot commit"))
+
+				_, err := client.CommitReservation(ctx, testhelpers.CommitReservationRequest(reservationID))
+				expectCode(err, connect.CodeFailedPrecondition)
+			},
+			Entry("有効期限切れ", "RESERVATION_EXPIRED"),
+			Entry("引当中ではない", "RESERVATION_NOT_ACTIVE"),
+		)
+
+		It("その他のエラーの場合、Internal エラーを返すこと", func() {
+			mockStockService.EXPECT().
+				Commit(gomock.Any(), gomock.Any()).
+				Return(nil, errors.New("service error"))
+
+			_, err := client.CommitReservation(ctx, testhelpers.CommitReservationRequest(reservationID))
+			expectCode(err, connect.CodeInternal)
+		})
+	})
+})
+
+var _ = Describe("TagServiceHandler Unit Test", Label("UnitTests"), func() {
+	var (
+		ctrl           *gomock.Controller
+		mockTagService *mock_service.MockTagService
+		client         cmdconnect.TagServiceClient
+		ctx            context.Context
+		productID      string
+		tagID          string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockTagService = mock_service.NewMockTagService(ctrl)
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		tsh, err := server.NewTagServiceHandlerImpl(logger, mockTagService)
+		Expect(err).NotTo(HaveOccurred())
+
+		validator, err := interceptor.NewValidator(logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		mux := http.NewServeMux()
+		path, handler := cmdconnect.NewTagServiceHandler(
+			tsh,
+			connect.WithInterceptors(validator.NewUnaryInterceptor()),
+		)
+		mux.Handle(path, handler)
+		testServer := httptest.NewServer(mux)
+		DeferCleanup(testServer.Close)
+
+		client = cmdconnect.NewTagServiceClient(
+			testServer.Client(),
+			testServer.URL,
+		)
+
+		ctx = context.Background()
+		productID = uuid.NewString()
+		tagID = uuid.NewString()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	// expectCode はエラーが指定したConnectエラーコードであることを検証します。
+	expectCode := func(err error, code connect.Code) {
+		Expect(err).To(HaveOccurred())
+		var connectErr *connect.Error
+		Expect(errors.As(err, &connectErr)).To(BeTrue())
+		Expect(connectErr.Code()).To(Equal(code))
+	}
+
+	Describe("AttachTags", func() {
+		It("付与したタグと新たに付与した組み合わせの数をレスポンスとして返すこと", func() {
+			mockTagService.EXPECT().
+				Attach(gomock.Any(), &dto.ChangeTagsDTO{ProductIds: []string{productID}, TagNames: []string{"ワイヤレス"}}).
+				Return(&dto.TagChangeResultDTO{Tags: []dto.TagDTO{{Id: tagID, Name: "ワイヤレス"}}, Changed: 1}, nil)
+
+			resp, err := client.AttachTags(ctx, testhelpers.AttachTagsRequest([]string{productID}, []string{"ワイヤレス"}))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.GetTags()).To(HaveLen(1))
+			Expect(resp.Msg.GetTags()[0].GetId()).To(Equal(tagID))
+			Expect(resp.Msg.GetTags()[0].GetName()).To(Equal("ワイヤレス"))
+			Expect(resp.Msg.GetAttachedCount()).To(Equal(int32(1)))
+			Expect(resp.Msg.GetTimestamp()).NotTo(BeNil())
+		})
+
+		DescribeTable("リクエストが不正な場合、InvalidArgument エラーを返すこと",
+			func(productIDs []string, tagNames []string) {
+				resp, err := client.AttachTags(ctx, testhelpers.AttachTagsRequest(productIDs, tagNames))
+				expectCode(err, connect.CodeInvalidArgument)
+				Expect(resp).To(BeNil())
+			},
+			Entry("商品IDが空", []string{}, []string{"ワイヤレス"}),
+			Entry("商品IDがUUIDではない", []string{"invalid"}, []string{"ワイヤレス"}),
+			Entry("タグ名が空", []string{uuid.NewString()}, []string{}),
+			Entry("タグ名が31文字以上", []string{uuid.NewString()}, []string{"abcdefghijklmnopqrstuvwxyz12345"}),
+		)
+
+		It("商品が存在しない場合、NotFound エラーを返すこと", func() {
+			mockTagService.EXPECT().
+				Attach(gomock.Any(), gomock.Any()).
+				Return(nil, errs.NewApplicationError("PRODUCT_NOT_FOUND", "Product not found"))
+
+			_, err := client.AttachTags(ctx, testhelpers.AttachTagsRequest([]string{productID}, []string{"ワイヤレス"}))
+			expectCode(err, connect.CodeNotFound)
+		})
+	})
+
+	Describe("DetachTags", func() {
+		It("外した組み合わせの数をレスポンスとして返すこと", func() {
+			mockTagService.EXPECT().
+				Detach(gomock.Any(), &dto.ChangeTagsDTO{ProductIds: []string{productID}, TagNames: []string{"ワイヤレス"}}).
+				Return(&dto.TagChangeResultDTO{Tags: []dto.TagDTO{{Id: tagID, Name: "ワイヤレス"}}, Changed: 1}, nil)
+
+			resp, err := client.DetachTags(ctx, testhelpers.DetachTagsRequest([]string{productID}, []string{"ワイヤレス"}))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.GetDetachedCount()).To(Equal(int32(1)))
+		})
+
+		It("その他のエラーの場合、Internal エラーを返すこと", func() {
+			mockTagService.EXPECT().
+				Detach(gomock.Any(), gomock.Any()).
+				Return(nil, errors.New("service error"))
+
+			_, err := client.DetachTags(ctx, testhelpers.DetachTagsRequest([]string{productID}, []string{"ワイヤレス"}))
+			expectCode(err, connect.CodeInternal)
+		})
+	})
+})
+
+var _ = Describe("PriceScheduleServiceHandler Unit Test", Label("UnitTests"), func() {
+	var (
+		ctrl                     *gomock.Controller
+		mockPriceScheduleService *mock_service.MockPriceScheduleService
+		client                   cmdconnect.PriceScheduleServiceClient
+		ctx                      context.Context
+		productID                string
+		scheduleID               string
+		startsAt                 time.Time
+		endsAt                   time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockPriceScheduleService = mock_service.NewMockPriceScheduleService(ctrl)
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		pssh, err := server.NewPriceScheduleServiceHandlerImpl(logger, mockPriceScheduleService)
+		Expect(err).NotTo(HaveOccurred())
+
+		validator, err := interceptor.NewValidator(logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		mux := http.NewServeMux()
+		path, handler := cmdconnect.NewPriceScheduleServiceHandler(
+			pssh,
+			connect.WithInterceptors(validator.NewUnaryInterceptor()),
+		)
+		mux.Handle(path, handler)
+		testServer := httptest.NewServer(mux)
+		DeferCleanup(testServer.Close)
+
+		client = cmdconnect.NewPriceScheduleServiceClient(
+			testServer.Client(),
+			testServer.URL,
+		)
+
+		ctx = context.Background()
+		productID = uuid.NewString()
+		scheduleID = uuid.NewString()
+		startsAt = time.Date(2025, 1, 3, 15, 0, 0, 0, time.UTC)
+		endsAt = time.Date(2025, 1, 5, 15, 0, 0, 0, time.UTC)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	// expectCode はエラーが指定したConnectエラーコードであることを検証します。
+	expectCode := func(err error, code connect.Code) {
+		Expect(err).To(HaveOccurred())
+		var connectErr *connect.Error
+		Expect(errors.As(err, &connectErr)).To(BeTrue())
+		Expect(connectErr.Code()).To(Equal(code))
+	}
+
+	Describe("SchedulePrice", func() {
+		It("登録された価格スケジュールをレスポンスとして返すこと", func() {
+			mockPriceScheduleService.EXPECT().
+				Schedule(gomock.Any(), &dto.SchedulePriceDTO{ProductId: productID, Price: 980, StartsAt: startsAt, EndsAt: endsAt}).
+				Return(&dto.PriceScheduleDTO{Id: scheduleID, ProductId: productID, Price: 980, Status: "SCHEDULED", StartsAt: startsAt, EndsAt: endsAt}, nil)
+
+			resp, err := client.SchedulePrice(ctx, testhelpers.SchedulePriceRequest(productID, 980, startsAt, endsAt))
+
+			Expect(err).NotTo(HaveOccurred())
+			schedule := resp.Msg.GetPriceSchedule()
+			Expect(schedule.GetId()).To(Equal(scheduleID))
+			Expect(schedule.GetPrice()).To(Equal(int32(980)))
+			Expect(schedule.HasRegularPrice()).To(BeFalse())
+			Expect(schedule.GetStatus()).To(Equal(common.PriceScheduleStatus_PRICE_SCHEDULE_STATUS_SCHEDULED))
+			Expect(schedule.GetStartsAt().AsTime()).To(Equal(startsAt))
+			Expect(schedule.GetEndsAt().AsTime()).To(Equal(endsAt))
+			Expect(resp.Msg.GetTimestamp()).NotTo(BeNil())
+		})
+
+		It("単価が0の場合、InvalidArgument エラーを返すこと", func() {
+			resp, err := client.SchedulePrice(ctx, testhelpers.SchedulePriceRequest(productID, 0, startsAt, endsAt))
+			expectCode(err, connect.CodeInvalidArgument)
+			Expect(resp).To(BeNil())
+		})
+
+		It("期間が重なる場合、FailedPrecondition エラーを返すこと", func() {
+			mockPriceScheduleService.EXPECT().
+				Schedule(gomock.Any(), gomock.Any()).
+				Return(nil, errs.NewDomainError("PRICE_SCHEDULE_OVERLAP", "overlap"))
+
+			_, err := client.SchedulePrice(ctx, testhelpers.SchedulePriceRequest(productID, 980, startsAt, endsAt))
+			expectCode(err, connect.CodeFailedPrecondition)
+		})
+	})
+
+	Describe("CancelPriceSchedule", func() {
+		It("取り消された価格スケジュールをレスポンスとして返すこと", func() {
+			mockPriceScheduleService.EXPECT().
+				Cancel(gomock.Any(), &dto.CancelPriceScheduleDTO{ProductId: productID, Id: scheduleID}).
+				Return(&dto.PriceScheduleDTO{Id: scheduleID, ProductId: productID, Price: 980, RegularPrice: 1200, Status: "CANCELLED", StartsAt: startsAt, EndsAt: endsAt}, nil)
+
+			resp, err := client.CancelPriceSchedule(ctx, testhelpers.CancelPriceScheduleRequest(productID, scheduleID))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.GetPriceSchedule().GetStatus()).To(Equal(common.PriceScheduleStatus_PRICE_SCHEDULE_STATUS_CANCELLED))
+			Expect(resp.Msg.GetPriceSchedule().GetRegularPrice()).To(Equal(int32(1200)))
+		})
+
+		It("価格スケジュールIDがUUIDではない場合、InvalidArgument エラーを返すこと", func() {
+			resp, err := client.CancelPriceSchedule(ctx, testhelpers.CancelPriceScheduleRequest(productID, "invalid"))
+			expectCode(err, connect.CodeInvalidArgument)
+			Expect(resp).To(BeNil())
+		})
+
+		It("価格スケジュールが存在しない場合、NotFound エラーを返すこと", func() {
+			mockPriceScheduleService.EXPECT().
+				Cancel(gomock.Any(), gomock.Any()).
+				Return(nil, errs.NewApplicationError("PRICE_SCHEDULE_NOT_FOUND", "Price schedule not found"))
+
+			_, err := client.CancelPriceSchedule(ctx, testhelpers.CancelPriceScheduleRequest(productID, scheduleID))
+			expectCode(err, connect.CodeNotFound)
+		})
+
+		It("終了済みの場合、FailedPrecondition エラーを返すこと", func() {
+			mockPriceScheduleService.EXPECT().
+				Cancel(gomock.Any(), gomock.Any()).
+				Return(nil, errs.NewDomainError("PRICE_SCHEDULE_NOT_PENDING", "not pending"))
+
+			_, err := client.CancelPriceSchedule(ctx, testhelpers.CancelPriceScheduleRequest(productID, scheduleID))
+			expectCode(err, connect.CodeFailedPrecondition)
+		})
+	})
 })
@@ -1,38 +1,44 @@
 package server
 
 import (
-	"fmt"
 	"log/slog"
 	"net/http"
-	"time"
 
 	"connectrpc.com/connect"
 	"connectrpc.com/grpchealth"
 	"connectrpc.com/grpcreflect"
+	command "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
 	cmdconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1/commandv1connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/httpserver"
 	interceptor "github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
-	"github.com/spf13/viper"
-	"golang.org/x/net/http2"
-	"golang.org/x/net/http2/h2c"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
 )
 
 // CommandServer はgRPCコマンドサービスのHTTPサーバーをラップする構造体です。
 type CommandServer struct {
-	Server *http.Server
+	Server   *http.Server              // HTTPサーバー
+	Health   *grpchealth.StaticChecker // ヘルスチェック（停止時にNOT_SERVINGにする）
+	Services []string                  // ヘルスチェックに登録したサービス名
 }
 
 // NewCommandServer はCommandServerの新しいインスタンスを作成します。
 //
 // Parameters:
-//   - addr: サーバーがリッスンするアドレス (例: ":8080", "localhost:8080")
+//   - cfg: HTTPサーバーの設定（アドレス、タイムアウト、ストリーミングRPCの期限）
 //   - logger: 構造化ロギング用のslogロガー
+//   - admin: ログレベルを変更する管理用エンドポイントのハンドラ
 //   - csh: カテゴリサービスのgRPCハンドラ実装
+//   - psh: 商品サービスのgRPCハンドラ実装
+//   - ssh: 在庫サービスのgRPCハンドラ実装
+//   - tsh: タグサービスのgRPCハンドラ実装
+//   - pssh: 価格スケジュールサービスのgRPCハンドラ実装
 //
 // Returns:
 //   - *CommandServer: 初期化されたCommandServerインスタンス
 //   - error: 初期化中にエラーが発生した場合のエラー (現在は常にnil)
-func NewCommandServer(viper *viper.Viper, logger *slog.Logger, csh cmdconnect.CategoryServiceHandler, psh cmdconnect.ProductServiceHandler) (*CommandServer, error) {
-	reqRespLogger := interceptor.NewReqRespLogger(logger)
+func NewCommandServer(cfg *httpserver.Config, logger *slog.Logger, admin *log.AdminHandler, csh cmdconnect.CategoryServiceHandler, psh cmdconnect.ProductServiceHandler, ssh cmdconnect.StockServiceHandler, tsh cmdconnect.TagServiceHandler, pssh cmdconnect.PriceScheduleServiceHandler) (*CommandServer, error) {
+	// リクエスト・レスポンスのログはrpcグループとしてレベルを個別に変更できるようにする
+	reqRespLogger := interceptor.NewReqRespLogger(log.Named(logger, "rpc"))
 	validator, err := interceptor.NewValidator(logger)
 	if err != nil {
 		return nil, err
@@ -46,6 +52,12 @@ func NewCommandServer(viper *viper.Viper, logger *slog.Logger, csh cmdconnect.Ca
 	mux.Handle(path, handler)
 	path, handler = cmdconnect.NewProductServiceHandler(psh, interceptors)
 	mux.Handle(path, handler)
+	path, handler = cmdconnect.NewStockServiceHandler(ssh, interceptors)
+	mux.Handle(path, handler)
+	path, handler = cmdconnect.NewTagServiceHandler(tsh, interceptors)
+	mux.Handle(path, handler)
+	path, handler = cmdconnect.NewPriceScheduleServiceHandler(pssh, interceptors)
+	mux.Handle(path, handler)
 
 	// ヘルスチェックハンドラの登録
 	// 標準的なパス: /grpc.health.v1.Health/Check が自動で作られる
@@ -55,20 +67,21 @@ func NewCommandServer(viper *viper.Viper, logger *slog.Logger, csh cmdconnect.Ca
 	mux.Handle(grpchealth.NewHandler(checker))
 
 	// reflection
-	reflector := grpcreflect.NewStaticReflector(
+	services := []string{
 		cmdconnect.CategoryServiceName,
 		cmdconnect.ProductServiceName,
-	)
+		cmdconnect.StockServiceName,
+		cmdconnect.TagServiceName,
+		cmdconnect.PriceScheduleServiceName,
+	}
+	reflector := grpcreflect.NewStaticReflector(services...)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
 
-	server := &http.Server{
-		Addr:         fmt.Sprintf("%s:%s", viper.GetString("server.host"), viper.GetString("server.port")),
-		Handler:      h2c.NewHandler(mux, &http2.Server{}),
-		ReadTimeout:  10 * time.Second,
-		WriteTimeout: 10 * time.Second,
-		// HTTP Keep-Aliveのタイムアウト設定
-		IdleTimeout: 120 * time.Second,
-	}
-	return &CommandServer{Server: server}, nil
+	// ログレベルの管理用エンドポイント（admin.tokenが未設定の場合は404を返す）
+	mux.Handle(log.AdminPath, admin)
+
+	// ストリーミングRPCはサーバー全体の書き込みのタイムアウトの対象外にし、RPCごとの期限を設定する
+	server := httpserver.New(cfg, mux, httpserver.StreamingProcedures(command.File_command_v1_command_proto))
+	return &CommandServer{Server: server, Health: checker, Services: services}, nil
 }
@@ -2,9 +2,12 @@
 package testhelpers
 
 import (
+	"time"
+
 	"connectrpc.com/connect"
 	cmd "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/command/v1"
 	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // CreateCategoryRequest はCreateCategoryRequestを生成するヘルパー関数です。
@@ -23,6 +26,22 @@ func CreateCategoryRequest(name string) *connect.Request[cmd.CreateCategoryReque
 	return connect.NewRequest(createCategoryReq)
 }
 
+// CreateChildCategoryRequest は親カテゴリを指定したCreateCategoryRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - name: カテゴリ名
+//   - parentID: 親カテゴリID
+//
+// Returns:
+//   - *connect.Request[cmd.CreateCategoryRequest]: 生成されたリクエスト
+func CreateChildCategoryRequest(name, parentID string) *connect.Request[cmd.CreateCategoryRequest] {
+	req := CreateCategoryRequest(name)
+	parentId := &common.CategoryId{}
+	parentId.SetValue(parentID)
+	req.Msg.SetParentId(parentId)
+	return req
+}
+
 // CreateUpdateCategoryRequest はUpdateCategoryRequestを生成するヘルパー関数です。
 //
 // Parameters:
@@ -61,6 +80,27 @@ func CreateDeleteCategoryRequest(id string) *connect.Request[cmd.DeleteCategoryR
 	return connect.NewRequest(deleteCategoryReq)
 }
 
+// MoveCategoryRequest はMoveCategoryRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - id: 移動するカテゴリID
+//   - parentID: 移動先の親カテゴリID（エンプティの場合はルートに移動）
+//
+// Returns:
+//   - *connect.Request[cmd.MoveCategoryRequest]: 生成されたリクエスト
+func MoveCategoryRequest(id, parentID string) *connect.Request[cmd.MoveCategoryRequest] {
+	categoryId := &common.CategoryId{}
+	categoryId.SetValue(id)
+	moveCategoryReq := &cmd.MoveCategoryRequest{}
+	moveCategoryReq.SetCategoryId(categoryId)
+	if parentID != "" {
+		parentId := &common.CategoryId{}
+		parentId.SetValue(parentID)
+		moveCategoryReq.SetParentId(parentId)
+	}
+	return connect.NewRequest(moveCategoryReq)
+}
+
 // CreateProductRequest はCreateProductRequestを生成するヘルパー関数です。
 //
 // Parameters:
@@ -142,3 +182,267 @@ func DeleteProductRequest(id string) *connect.Request[cmd.DeleteProductRequest]
 	deleteProductReq.SetProductId(productID)
 	return connect.NewRequest(deleteProductReq)
 }
+
+// PublishProductRequest はPublishProductRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - id: 商品ID
+//
+// Returns:
+//   - *connect.Request[cmd.PublishProductRequest]: 生成されたリクエスト
+func PublishProductRequest(id string) *connect.Request[cmd.PublishProductRequest] {
+	productID := &common.ProductId{}
+	productID.SetValue(id)
+	req := &cmd.PublishProductRequest{}
+	req.SetProductId(productID)
+	return connect.NewRequest(req)
+}
+
+// SuspendProductRequest はSuspendProductRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - id: 商品ID
+//
+// Returns:
+//   - *connect.Request[cmd.SuspendProductRequest]: 生成されたリクエスト
+func SuspendProductRequest(id string) *connect.Request[cmd.SuspendProductRequest] {
+	productID := &common.ProductId{}
+	productID.SetValue(id)
+	req := &cmd.SuspendProductRequest{}
+	req.SetProductId(productID)
+	return connect.NewRequest(req)
+}
+
+// AdjustStockRequest はAdjustStockRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - productID: 商品ID
+//   - delta: 在庫数の増減
+//
+// Returns:
+//   - *connect.Request[cmd.AdjustStockRequest]: 生成されたリクエスト
+func AdjustStockRequest(productID string, delta int32) *connect.Request[cmd.AdjustStockRequest] {
+	pid := &common.ProductId{}
+	pid.SetValue(productID)
+	req := &cmd.AdjustStockRequest{}
+	req.SetProductId(pid)
+	req.SetDelta(delta)
+	return connect.NewRequest(req)
+}
+
+// ReserveStockRequest はReserveStockRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - productID: 商品ID
+//   - quantity: 引当数量
+//   - ttlSeconds: 引当の有効期限（秒）
+//
+// Returns:
+//   - *connect.Request[cmd.ReserveStockRequest]: 生成されたリクエスト
+func ReserveStockRequest(productID string, quantity int32, ttlSeconds int32) *connect.Request[cmd.ReserveStockRequest] {
+	pid := &common.ProductId{}
+	pid.SetValue(productID)
+	req := &cmd.ReserveStockRequest{}
+	req.SetProductId(pid)
+	req.SetQuantity(quantity)
+	req.SetTtlSeconds(ttlSeconds)
+	return connect.NewRequest(req)
+}
+
+// ReleaseReservationRequest はReleaseReservationRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - reservationID: 引当ID
+//
+// Returns:
+//   - *connect.Request[cmd.ReleaseReservationRequest]: 生成されたリクエスト
+func ReleaseReservationRequest(reservationID string) *connect.Request[cmd.ReleaseReservationRequest] {
+	req := &cmd.ReleaseReservationRequest{}
+	req.SetReservationId(reservationID)
+	return connect.NewRequest(req)
+}
+
+// CommitReservationRequest はCommitReservationRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - reservationID: 引当ID
+//
+// Returns:
+//   - *connect.Request[cmd.CommitReservationRequest]: 生成されたリクエスト
+func CommitReservationRequest(reservationID string) *connect.Request[cmd.CommitReservationRequest] {
+	req := &cmd.CommitReservationRequest{}
+	req.SetReservationId(reservationID)
+	return connect.NewRequest(req)
+}
+
+// VariantAttributes はAddVariantRequest・UpdateVariantRequestのバリエーション属性を生成するヘルパー関数です。
+//
+// Parameters:
+//   - sku: SKUコード
+//   - options: 選択肢の軸と値の組（軸, 値, 軸, 値, ...の順）
+//
+// Returns:
+//   - *cmd.VariantAttributes: 生成されたバリエーション属性
+func VariantAttributes(sku string, options ...string) *cmd.VariantAttributes {
+	attrs := &cmd.VariantAttributes{}
+	attrs.SetSku(sku)
+	values := make([]*common.VariantOption, 0, len(options)/2)
+	for i := 0; i+1 < len(options); i += 2 {
+		option := &common.VariantOption{}
+		option.SetAxis(options[i])
+		option.SetValue(options[i+1])
+		values = append(values, option)
+	}
+	attrs.SetOptions(values)
+	return attrs
+}
+
+// AddVariantRequest はAddVariantRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - productID: 商品ID
+//   - attrs: バリエーション属性
+//
+// Returns:
+//   - *connect.Request[cmd.AddVariantRequest]: 生成されたリクエスト
+func AddVariantRequest(productID string, attrs *cmd.VariantAttributes) *connect.Request[cmd.AddVariantRequest] {
+	pid := &common.ProductId{}
+	pid.SetValue(productID)
+	req := &cmd.AddVariantRequest{}
+	req.SetProductId(pid)
+	req.SetVariant(attrs)
+	return connect.NewRequest(req)
+}
+
+// UpdateVariantRequest はUpdateVariantRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - productID: 商品ID
+//   - variantID: バリエーションID
+//   - attrs: バリエーション属性
+//
+// Returns:
+//   - *connect.Request[cmd.UpdateVariantRequest]: 生成されたリクエスト
+func UpdateVariantRequest(productID, variantID string, attrs *cmd.VariantAttributes) *connect.Request[cmd.UpdateVariantRequest] {
+	pid := &common.ProductId{}
+	pid.SetValue(productID)
+	req := &cmd.UpdateVariantRequest{}
+	req.SetProductId(pid)
+	req.SetVariantId(variantID)
+	req.SetVariant(attrs)
+	return connect.NewRequest(req)
+}
+
+// RemoveVariantRequest はRemoveVariantRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - productID: 商品ID
+//   - variantID: バリエーションID
+//
+// Returns:
+//   - *connect.Request[cmd.RemoveVariantRequest]: 生成されたリクエスト
+func RemoveVariantRequest(productID, variantID string) *connect.Request[cmd.RemoveVariantRequest] {
+	pid := &common.ProductId{}
+	pid.SetValue(productID)
+	req := &cmd.RemoveVariantRequest{}
+	req.SetProductId(pid)
+	req.SetVariantId(variantID)
+	return connect.NewRequest(req)
+}
+
+// AdjustPricesRequest はAdjustPricesRequestを生成するヘルパー関数です。
+// 端数処理は未指定（切り捨て）、下限・上限なしで、子孫カテゴリとタグでは絞り込みません。
+//
+// Parameters:
+//   - categoryID: 対象の商品が属するカテゴリID
+//   - adjustmentType: 変更の方法
+//   - value: 変更量
+//   - preview: trueの場合はプレビュー
+//
+// Returns:
+//   - *connect.Request[cmd.AdjustPricesRequest]: 生成されたリクエスト
+func AdjustPricesRequest(categoryID string, adjustmentType cmd.PriceAdjustmentType, value int32, preview bool) *connect.Request[cmd.AdjustPricesRequest] {
+	cid := &common.CategoryId{}
+	cid.SetValue(categoryID)
+	adjustment := &cmd.PriceAdjustment{}
+	adjustment.SetType(adjustmentType)
+	adjustment.SetValue(value)
+
+	req := &cmd.AdjustPricesRequest{}
+	req.SetCategoryId(cid)
+	req.SetAdjustment(adjustment)
+	req.SetPreview(preview)
+	return connect.NewRequest(req)
+}
+
+// AttachTagsRequest はAttachTagsRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - productIDs: タグを付与する商品ID
+//   - tagNames: 付与するタグ名
+//
+// Returns:
+//   - *connect.Request[cmd.AttachTagsRequest]: 生成されたリクエスト
+func AttachTagsRequest(productIDs []string, tagNames []string) *connect.Request[cmd.AttachTagsRequest] {
+	req := &cmd.AttachTagsRequest{}
+	req.SetProductIds(productIDs)
+	req.SetTagNames(tagNames)
+	return connect.NewRequest(req)
+}
+
+// DetachTagsRequest はDetachTagsRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - productIDs: タグを外す商品ID
+//   - tagNames: 外すタグ名
+//
+// Returns:
+//   - *connect.Request[cmd.DetachTagsRequest]: 生成されたリクエスト
+func DetachTagsRequest(productIDs []string, tagNames []string) *connect.Request[cmd.DetachTagsRequest] {
+	req := &cmd.DetachTagsRequest{}
+	req.SetProductIds(productIDs)
+	req.SetTagNames(tagNames)
+	return connect.NewRequest(req)
+}
+
+// SchedulePriceRequest はSchedulePriceRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - productID: 商品ID
+//   - price: 期間中の単価
+//   - startsAt: 開始時刻
+//   - endsAt: 終了時刻
+//
+// Returns:
+//   - *connect.Request[cmd.SchedulePriceRequest]: 生成されたリクエスト
+func SchedulePriceRequest(productID string, price uint32, startsAt, endsAt time.Time) *connect.Request[cmd.SchedulePriceRequest] {
+	pid := &common.ProductId{}
+	pid.SetValue(productID)
+	p := &common.ProductPrice{}
+	p.SetValue(int32(price))
+
+	req := &cmd.SchedulePriceRequest{}
+	req.SetProductId(pid)
+	req.SetPrice(p)
+	req.SetStartsAt(timestamppb.New(startsAt))
+	req.SetEndsAt(timestamppb.New(endsAt))
+	return connect.NewRequest(req)
+}
+
+// CancelPriceScheduleRequest はCancelPriceScheduleRequestを生成するヘルパー関数です。
+//
+// Parameters:
+//   - productID: 商品ID
+//   - priceScheduleID: 価格スケジュールID
+//
+// Returns:
+//   - *connect.Request[cmd.CancelPriceScheduleRequest]: 生成されたリクエスト
+func CancelPriceScheduleRequest(productID, priceScheduleID string) *connect.Request[cmd.CancelPriceScheduleRequest] {
+	pid := &common.ProductId{}
+	pid.SetValue(productID)
+
+	req := &cmd.CancelPriceScheduleRequest{}
+	req.SetProductId(pid)
+	req.SetPriceScheduleId(priceScheduleID)
+	return connect.NewRequest(req)
+}
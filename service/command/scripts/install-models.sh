@@ -0,0 +1,27 @@
+#!/usr/bin/env bash
+# SQLBoilerが生成したモデルにビルドタグを付けて models ディレクトリへ配置する。
+# MySQL用とPostgreSQL用のモデルを同じパッケージに置き、ビルドタグ（postgres）で切り替えるため、
+# 配置先にある同じビルドタグのファイルだけを置き換える。
+#
+# Usage: scripts/install-models.sh <生成先ディレクトリ> <ビルドタグ> <ファイル名の接尾辞>
+#   例: scripts/install-models.sh .models-mysql '!postgres' ''
+#       scripts/install-models.sh .models-psql 'postgres' '_psql'
+set -euo pipefail
+
+src="$1"
+tag="$2"
+suffix="$3"
+dest="internal/infrastructure/sqlboiler/models"
+
+# 同じビルドタグの既存のファイルを削除する
+grep -lx "//go:build ${tag}" "${dest}"/*.go | xargs -r rm -f
+
+for file in "${src}"/*.go; do
+  name="$(basename "${file}" .go)"
+  # psql_upsert.go のようにドライバ名で始まるファイルは接尾辞を付けない
+  if [[ -n "${suffix}" && "${name}" != psql_* ]]; then
+    name="${name}${suffix}"
+  fi
+  { printf '//go:build %s\n\n' "${tag}"; cat "${file}"; } > "${dest}/${name}.go"
+done
+rm -rf "${src}"
@@ -1,15 +1,60 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/httpserver"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/config"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/presentation"
 	"go.uber.org/fx"
 )
 
+// NOTE: バイナリを実行する位置からの相対パスで指定する
+const (
+	configPath = "./"
+	configName = "config"
+)
+
 func main() {
+	// migrateサブコマンド: スキーマのマイグレーションを実行して終了する（例: main migrate up）
+	// loadサブコマンド: SQLiteの読み取りモデルへ投入して終了する（例: main load、main load snapshot.json）
+	// exportサブコマンド: 読み取りモデルをエクスポートファイルに書き出して終了する（例: main export snapshot.json）
+	if len(os.Args) > 1 {
+		var run func(ctx context.Context, configPath string, configName string, args []string, w io.Writer) error
+		switch os.Args[1] {
+		case "migrate":
+			run = infrastructure.RunMigrate
+		case "load":
+			run = infrastructure.RunLoad
+		case "export":
+			run = infrastructure.RunExport
+		}
+		if run != nil {
+			if err := run(context.Background(), configPath, configName, os.Args[2:], os.Stdout); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	// 停止時のドレインとグレースフルシャットダウンがfxの既定の停止のタイムアウト（15秒）で打ち切られないよう、
+	// 停止のタイムアウトを[server]のdrain_periodとshutdown_timeoutから決める
+	serverCfg, err := httpserver.NewConfig(config.NewViper(configPath, configName))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
 	app := fx.New(
+		fx.StopTimeout(serverCfg.StopTimeout()),
 		fx.Supply(
-			fx.Annotate("./", fx.ResultTags(`name:"configPath"`)),
-			fx.Annotate("config", fx.ResultTags(`name:"configName"`)),
+			fx.Annotate(configPath, fx.ResultTags(`name:"configPath"`)),
+			fx.Annotate(configName, fx.ResultTags(`name:"configName"`)),
 		),
 		presentation.Module,
 	)
@@ -2,8 +2,12 @@ package models
 
 // カテゴリエンティティ
 type Category struct {
-	id   string
-	name string
+	id           string
+	name         string
+	parentId     string       // 親カテゴリID（ルートカテゴリの場合はエンプティ）
+	translations Translations // 既定のロケール以外のカテゴリ名
+	locale       string       // nameのロケール
+	slug         string       // URLに使用するスラッグ
 }
 
 // NewCategory はCategoryを生成します。
@@ -15,7 +19,7 @@ type Category struct {
 // Returns:
 //   - *Category: Categoryポインタ
 func NewCategory(id string, name string) *Category {
-	return &Category{id: id, name: name}
+	return &Category{id: id, name: name, locale: DefaultLocale}
 }
 
 // Id はカテゴリIDを返します。
@@ -33,3 +37,134 @@ func (c *Category) Id() string {
 func (c *Category) Name() string {
 	return c.name
 }
+
+// WithParentId は親カテゴリIDを設定したCategoryのコピーを返します。
+//
+// Parameters:
+//   - parentId: 親カテゴリID（ルートカテゴリの場合はエンプティ）
+//
+// Returns:
+//   - *Category: 親カテゴリIDを設定したCategoryポインタ
+func (c *Category) WithParentId(parentId string) *Category {
+	copied := *c
+	copied.parentId = parentId
+	return &copied
+}
+
+// ParentId は親カテゴリIDを返します。
+//
+// Returns:
+//   - string: 親カテゴリID（ルートカテゴリの場合はエンプティ）
+func (c *Category) ParentId() string {
+	return c.parentId
+}
+
+// IsRoot はルートカテゴリかどうかを返します。
+//
+// Returns:
+//   - bool: ルートカテゴリの場合はtrue
+func (c *Category) IsRoot() bool {
+	return c.parentId == ""
+}
+
+// WithSlug はスラッグを設定したCategoryのコピーを返します。
+//
+// Parameters:
+//   - slug: URLに使用するスラッグ
+//
+// Returns:
+//   - *Category: スラッグを設定したCategoryポインタ
+func (c *Category) WithSlug(slug string) *Category {
+	copied := *c
+	copied.slug = slug
+	return &copied
+}
+
+// Slug はURLに使用するスラッグを返します。
+//
+// Returns:
+//   - string: スラッグ
+func (c *Category) Slug() string {
+	return c.slug
+}
+
+// WithTranslations は既定のロケール以外のカテゴリ名を設定したCategoryのコピーを返します。
+//
+// Parameters:
+//   - translations: 既定のロケール以外のカテゴリ名
+//
+// Returns:
+//   - *Category: 翻訳を設定したCategoryポインタ
+func (c *Category) WithTranslations(translations Translations) *Category {
+	copied := *c
+	copied.translations = translations
+	return &copied
+}
+
+// Translations は既定のロケール以外のカテゴリ名を返します。
+//
+// Returns:
+//   - Translations: 既定のロケール以外のカテゴリ名
+func (c *Category) Translations() Translations {
+	return c.translations
+}
+
+// Locale はカテゴリ名のロケールを返します。
+//
+// Returns:
+//   - string: カテゴリ名のロケール
+func (c *Category) Locale() string {
+	return c.locale
+}
+
+// Localize は優先するロケールのカテゴリ名に置き換えたCategoryのコピーを返します。
+// 名前の選択規則はTranslations.Resolveに従います。
+//
+// Parameters:
+//   - preferred: 優先するロケール（優先度の高い順）
+//
+// Returns:
+//   - *Category: カテゴリ名を置き換えたCategoryポインタ
+func (c *Category) Localize(preferred []string) *Category {
+	// 置き換え済みの場合は既定のロケールの名前が残っていないため、そのまま返す
+	if c.locale != DefaultLocale {
+		return c
+	}
+	copied := *c
+	copied.name, copied.locale = c.translations.Resolve(preferred, c.name)
+	return &copied
+}
+
+// CategoryNode はカテゴリ木のノードです。
+type CategoryNode struct {
+	category *Category       // カテゴリ
+	children []*CategoryNode // 子カテゴリのノード
+}
+
+// NewCategoryNode はCategoryNodeを生成します。
+//
+// Parameters:
+//   - category: カテゴリ
+//   - children: 子カテゴリのノード
+//
+// Returns:
+//   - *CategoryNode: CategoryNodeポインタ
+func NewCategoryNode(category *Category, children []*CategoryNode) *CategoryNode {
+	return &CategoryNode{category: category, children: children}
+}
+
+// Category はノードのカテゴリを返します。
+//
+// Returns:
+//   - *Category: カテゴリ
+func (n *CategoryNode) Category() *Category {
+	return n.category
+}
+
+// Children は子カテゴリのノードを返します。
+//
+// Returns:
+//   - []*CategoryNode: 子カテゴリのノード
+func (n *CategoryNode) Children() []*CategoryNode {
+	return n.children
+}
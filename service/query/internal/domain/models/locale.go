@@ -0,0 +1,57 @@
+package models
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// DefaultLocale は既定のロケールです。商品名・カテゴリ名の本体はこのロケールの名前として扱います。
+const DefaultLocale = "ja"
+
+// Translations は既定のロケール以外の名前です。キーは「en」「en-US」形式のロケールです。
+type Translations map[string]string
+
+// Resolve は優先するロケールの順に名前を選択します。
+// ロケールごとに、完全一致・言語コードの一致・既定のロケールの順に一致を判定し、
+// いずれのロケールにも一致しない場合は既定のロケールの名前を返します。
+//
+// Parameters:
+//   - preferred: 優先するロケール（優先度の高い順）
+//   - defaultName: 既定のロケールの名前
+//
+// Returns:
+//   - string: 選択した名前
+//   - string: 選択した名前のロケール
+func (t Translations) Resolve(preferred []string, defaultName string) (string, string) {
+	for _, locale := range preferred {
+		if name, ok := t[locale]; ok {
+			return name, locale
+		}
+		base := baseLanguage(locale)
+		if base == DefaultLocale {
+			return defaultName, DefaultLocale
+		}
+		if name, ok := t[base]; ok {
+			return name, base
+		}
+		// 地域コード付きの翻訳のみ登録されている場合は、言語コードが一致する翻訳を使用する
+		for _, candidate := range sortedLocales(t) {
+			if baseLanguage(candidate) == base {
+				return t[candidate], candidate
+			}
+		}
+	}
+	return defaultName, DefaultLocale
+}
+
+// baseLanguage は地域コードを除いた言語コードを返します（例: en-US → en）。
+func baseLanguage(locale string) string {
+	base, _, _ := strings.Cut(locale, "-")
+	return base
+}
+
+// sortedLocales は翻訳のロケールを辞書順に返します。
+func sortedLocales(t Translations) []string {
+	return slices.Sorted(maps.Keys(t))
+}
@@ -0,0 +1,85 @@
+package models
+
+import "time"
+
+// PRICE_SCHEDULE_SCHEDULED と PRICE_SCHEDULE_ACTIVE は商品とともに返す価格スケジュールの状態です。
+const (
+	PRICE_SCHEDULE_SCHEDULED = "SCHEDULED" // 予定（開始前）
+	PRICE_SCHEDULE_ACTIVE    = "ACTIVE"    // 適用中
+)
+
+// PriceSchedule は商品の期間限定の販売価格です。
+// 商品の単価はコマンドサービスが開始時刻に販売価格へ、終了時刻に適用前の単価へ変更するため、
+// 商品の価格は常に現在適用されている単価です。
+type PriceSchedule struct {
+	id           string
+	price        uint32
+	regularPrice *uint32
+	status       string
+	startsAt     time.Time
+	endsAt       time.Time
+}
+
+// NewPriceSchedule はPriceScheduleを生成します。
+//
+// Parameters:
+//   - id: 価格スケジュールID
+//   - price: 期間中の税抜の単価
+//   - regularPrice: 適用前の単価（未適用の場合はnil）
+//   - status: 状態（SCHEDULED / ACTIVE）
+//   - startsAt: 開始時刻
+//   - endsAt: 終了時刻
+//
+// Returns:
+//   - *PriceSchedule: PriceScheduleポインタ
+func NewPriceSchedule(id string, price uint32, regularPrice *uint32, status string, startsAt time.Time, endsAt time.Time) *PriceSchedule {
+	return &PriceSchedule{id: id, price: price, regularPrice: regularPrice, status: status, startsAt: startsAt, endsAt: endsAt}
+}
+
+// Id は価格スケジュールIDを返します。
+//
+// Returns:
+//   - string: 価格スケジュールID
+func (s *PriceSchedule) Id() string {
+	return s.id
+}
+
+// Price は期間中の税抜の単価を返します。
+//
+// Returns:
+//   - uint32: 期間中の税抜の単価
+func (s *PriceSchedule) Price() uint32 {
+	return s.price
+}
+
+// RegularPrice は適用前の単価を返します。
+//
+// Returns:
+//   - *uint32: 適用前の単価（未適用の場合はnil）
+func (s *PriceSchedule) RegularPrice() *uint32 {
+	return s.regularPrice
+}
+
+// Status は状態を返します。
+//
+// Returns:
+//   - string: 状態
+func (s *PriceSchedule) Status() string {
+	return s.status
+}
+
+// StartsAt は開始時刻を返します。
+//
+// Returns:
+//   - time.Time: 開始時刻
+func (s *PriceSchedule) StartsAt() time.Time {
+	return s.startsAt
+}
+
+// EndsAt は終了時刻を返します。
+//
+// Returns:
+//   - time.Time: 終了時刻
+func (s *PriceSchedule) EndsAt() time.Time {
+	return s.endsAt
+}
@@ -1,24 +1,50 @@
 package models
 
+import "github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
+
+// PRODUCT_STATUS_PUBLISHED は公開中の商品の販売状態です。一覧・検索には公開中の商品のみを返します。
+const PRODUCT_STATUS_PUBLISHED = "PUBLISHED"
+
 type Product struct {
-	id       string
-	name     string
-	price    uint32
-	category *Category
+	id                string
+	name              string
+	price             uint32
+	currency          string
+	taxClass          money.TaxClass
+	category          *Category
+	availableQuantity uint32
+	variants          []*Variant
+	tags              []*Tag
+	translations      Translations     // 既定のロケール以外の商品名
+	locale            string           // nameのロケール
+	status            string           // 販売状態（DRAFT / PUBLISHED / SUSPENDED / DISCONTINUED）
+	priceSchedules    []*PriceSchedule // 適用中および予定の価格スケジュール
+	barcode           string           // JAN/EANバーコード（未登録の場合は空文字列）
+	slug              string           // URLに使用するスラッグ
 }
 
 // NewProduct はProductを生成します。
+// 通貨はJPY、税率区分は標準税率、販売状態は公開中として生成します。
 //
 // Parameters:
 //   - id: 商品ID
 //   - name: 商品名
-//   - price: 価格
+//   - price: 税抜の価格（通貨の最小単位）
 //   - category: カテゴリ
 //
 // Returns:
 //   - *Product: Productポインタ
 func NewProduct(id string, name string, price uint32, category *Category) *Product {
-	return &Product{id: id, name: name, price: price, category: category}
+	return &Product{
+		id:       id,
+		name:     name,
+		price:    price,
+		currency: money.DefaultCurrency,
+		taxClass: money.TaxClassStandard,
+		category: category,
+		locale:   DefaultLocale,
+		status:   PRODUCT_STATUS_PUBLISHED,
+	}
 }
 
 // Id は商品IDを返します。
@@ -37,14 +63,183 @@ func (p *Product) Name() string {
 	return p.name
 }
 
+// WithTranslations は既定のロケール以外の商品名を設定したProductのコピーを返します。
+//
+// Parameters:
+//   - translations: 既定のロケール以外の商品名
+//
+// Returns:
+//   - *Product: 翻訳を設定したProductポインタ
+func (p *Product) WithTranslations(translations Translations) *Product {
+	copied := *p
+	copied.translations = translations
+	return &copied
+}
+
+// Translations は既定のロケール以外の商品名を返します。
+//
+// Returns:
+//   - Translations: 既定のロケール以外の商品名
+func (p *Product) Translations() Translations {
+	return p.translations
+}
+
+// Locale は商品名のロケールを返します。
+//
+// Returns:
+//   - string: 商品名のロケール
+func (p *Product) Locale() string {
+	return p.locale
+}
+
+// Localize は商品名とカテゴリ名を優先するロケールの名前に置き換えたProductのコピーを返します。
+// 名前の選択規則はTranslations.Resolveに従います。
+//
+// Parameters:
+//   - preferred: 優先するロケール（優先度の高い順）
+//
+// Returns:
+//   - *Product: 名前を置き換えたProductポインタ
+func (p *Product) Localize(preferred []string) *Product {
+	// 置き換え済みの場合は既定のロケールの名前が残っていないため、そのまま返す
+	if p.locale != DefaultLocale {
+		return p
+	}
+	copied := *p
+	copied.name, copied.locale = p.translations.Resolve(preferred, p.name)
+	if p.category != nil {
+		copied.category = p.category.Localize(preferred)
+	}
+	return &copied
+}
+
 // Price は価格を返します。
 //
 // Returns:
-//   - uint32: 価格
+//   - uint32: 税抜の価格（通貨の最小単位）
 func (p *Product) Price() uint32 {
 	return p.price
 }
 
+// WithTax は通貨と税率区分を設定したProductのコピーを返します。
+//
+// Parameters:
+//   - currency: 通貨コード
+//   - taxClass: 税率区分
+//
+// Returns:
+//   - *Product: 通貨と税率区分を設定したProductポインタ
+func (p *Product) WithTax(currency string, taxClass money.TaxClass) *Product {
+	copied := *p
+	copied.currency = currency
+	copied.taxClass = taxClass
+	return &copied
+}
+
+// WithStatus は販売状態を設定したProductのコピーを返します。
+//
+// Parameters:
+//   - status: 販売状態
+//
+// Returns:
+//   - *Product: 販売状態を設定したProductポインタ
+func (p *Product) WithStatus(status string) *Product {
+	copied := *p
+	copied.status = status
+	return &copied
+}
+
+// WithBarcode はバーコードを設定したProductのコピーを返します。
+//
+// Parameters:
+//   - barcode: JAN/EANバーコード（未登録の場合は空文字列）
+//
+// Returns:
+//   - *Product: バーコードを設定したProductポインタ
+func (p *Product) WithBarcode(barcode string) *Product {
+	copied := *p
+	copied.barcode = barcode
+	return &copied
+}
+
+// Barcode はバーコードを返します。
+//
+// Returns:
+//   - string: JAN/EANバーコード（未登録の場合は空文字列）
+func (p *Product) Barcode() string {
+	return p.barcode
+}
+
+// WithSlug はスラッグを設定したProductのコピーを返します。
+//
+// Parameters:
+//   - slug: URLに使用するスラッグ
+//
+// Returns:
+//   - *Product: スラッグを設定したProductポインタ
+func (p *Product) WithSlug(slug string) *Product {
+	copied := *p
+	copied.slug = slug
+	return &copied
+}
+
+// Slug はURLに使用するスラッグを返します。
+//
+// Returns:
+//   - string: スラッグ
+func (p *Product) Slug() string {
+	return p.slug
+}
+
+// Status は販売状態を返します。
+//
+// Returns:
+//   - string: 販売状態
+func (p *Product) Status() string {
+	return p.status
+}
+
+// Currency は通貨コードを返します。
+//
+// Returns:
+//   - string: 通貨コード
+func (p *Product) Currency() string {
+	return p.currency
+}
+
+// TaxClass は税率区分を返します。
+//
+// Returns:
+//   - money.TaxClass: 税率区分
+func (p *Product) TaxClass() money.TaxClass {
+	return p.taxClass
+}
+
+// PriceExcludingTax は税抜価格を返します。
+//
+// Returns:
+//   - *money.Money: 税抜価格
+//   - error: 通貨が未対応の場合のエラー
+func (p *Product) PriceExcludingTax() (*money.Money, error) {
+	return money.NewMoney(int64(p.price), p.currency)
+}
+
+// PriceIncludingTax は税込価格を返します。
+//
+// Parameters:
+//   - rounding: 消費税額の端数処理の方法
+//
+// Returns:
+//   - *money.Money: 税込価格
+//   - error: 通貨が未対応の場合のエラー
+func (p *Product) PriceIncludingTax(rounding money.Rounding) (*money.Money, error) {
+	excluding, err := p.PriceExcludingTax()
+	if err != nil {
+		return nil, err
+	}
+	return excluding.IncludingTax(p.taxClass, rounding), nil
+}
+
 // Category はカテゴリを返します。
 //
 // Returns:
@@ -52,3 +247,180 @@ func (p *Product) Price() uint32 {
 func (p *Product) Category() *Category {
 	return p.category
 }
+
+// WithAvailableQuantity は引当可能な在庫数を設定したProductのコピーを返します。
+//
+// Parameters:
+//   - quantity: 引当可能な在庫数
+//
+// Returns:
+//   - *Product: 引当可能な在庫数を設定したProductポインタ
+func (p *Product) WithAvailableQuantity(quantity uint32) *Product {
+	copied := *p
+	copied.availableQuantity = quantity
+	return &copied
+}
+
+// AvailableQuantity は引当可能な在庫数を返します。
+//
+// Returns:
+//   - uint32: 引当可能な在庫数（在庫が登録されていない場合は0）
+func (p *Product) AvailableQuantity() uint32 {
+	return p.availableQuantity
+}
+
+// InStock は引当可能な在庫があるかを返します。
+//
+// Returns:
+//   - bool: 引当可能な在庫がある場合true
+func (p *Product) InStock() bool {
+	return p.availableQuantity > 0
+}
+
+// WithVariants はバリエーションを設定したProductのコピーを返します。
+//
+// Parameters:
+//   - variants: バリエーション
+//
+// Returns:
+//   - *Product: バリエーションを設定したProductポインタ
+func (p *Product) WithVariants(variants []*Variant) *Product {
+	copied := *p
+	copied.variants = variants
+	return &copied
+}
+
+// Variants はバリエーションを返します。
+//
+// Returns:
+//   - []*Variant: バリエーション（商品の個別取得時以外は空）
+func (p *Product) Variants() []*Variant {
+	return p.variants
+}
+
+// WithTags は付与されたタグを設定したProductのコピーを返します。
+//
+// Parameters:
+//   - tags: 付与されたタグ
+//
+// Returns:
+//   - *Product: タグを設定したProductポインタ
+func (p *Product) WithTags(tags []*Tag) *Product {
+	copied := *p
+	copied.tags = tags
+	return &copied
+}
+
+// Tags は付与されたタグを返します。
+//
+// Returns:
+//   - []*Tag: 付与されたタグ
+func (p *Product) Tags() []*Tag {
+	return p.tags
+}
+
+// WithPriceSchedules は適用中および予定の価格スケジュールを設定したProductのコピーを返します。
+//
+// Parameters:
+//   - schedules: 適用中および予定の価格スケジュール（開始時刻順）
+//
+// Returns:
+//   - *Product: 価格スケジュールを設定したProductポインタ
+func (p *Product) WithPriceSchedules(schedules []*PriceSchedule) *Product {
+	copied := *p
+	copied.priceSchedules = schedules
+	return &copied
+}
+
+// PriceSchedules は適用中および予定の価格スケジュールを返します。
+//
+// Returns:
+//   - []*PriceSchedule: 価格スケジュール（商品の個別取得時以外は空）
+func (p *Product) PriceSchedules() []*PriceSchedule {
+	return p.priceSchedules
+}
+
+// ProductFilter は商品一覧の絞り込み条件
+// 複数の条件を指定した場合は、すべての条件を満たす商品に絞り込みます。
+type ProductFilter struct {
+	categoryId         string   // カテゴリID（エンプティの場合はカテゴリで絞り込まない）
+	includeDescendants bool     // trueの場合は子孫カテゴリの商品も含める
+	tags               []string // タグ名（すべてのタグが付与された商品に絞り込む）
+}
+
+// NewProductFilter は条件のないProductFilterを生成します。
+//
+// Returns:
+//   - *ProductFilter: ProductFilterポインタ
+func NewProductFilter() *ProductFilter {
+	return &ProductFilter{}
+}
+
+// WithCategory はカテゴリの条件を設定したProductFilterのコピーを返します。
+//
+// Parameters:
+//   - categoryId: カテゴリID
+//   - includeDescendants: trueの場合は子孫カテゴリの商品も含める
+//
+// Returns:
+//   - *ProductFilter: カテゴリの条件を設定したProductFilterポインタ
+func (f *ProductFilter) WithCategory(categoryId string, includeDescendants bool) *ProductFilter {
+	copied := *f
+	copied.categoryId = categoryId
+	copied.includeDescendants = includeDescendants
+	return &copied
+}
+
+// WithTags はタグの条件を設定したProductFilterのコピーを返します。
+// 重複するタグ名は1つにまとめます。
+//
+// Parameters:
+//   - tags: タグ名
+//
+// Returns:
+//   - *ProductFilter: タグの条件を設定したProductFilterポインタ
+func (f *ProductFilter) WithTags(tags []string) *ProductFilter {
+	copied := *f
+	seen := make(map[string]struct{}, len(tags))
+	copied.tags = make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		copied.tags = append(copied.tags, tag)
+	}
+	return &copied
+}
+
+// CategoryId はカテゴリIDを返します。
+//
+// Returns:
+//   - string: カテゴリID（エンプティの場合はカテゴリで絞り込まない）
+func (f *ProductFilter) CategoryId() string {
+	return f.categoryId
+}
+
+// IncludeDescendants は子孫カテゴリの商品も含めるかを返します。
+//
+// Returns:
+//   - bool: trueの場合は子孫カテゴリの商品も含める
+func (f *ProductFilter) IncludeDescendants() bool {
+	return f.includeDescendants
+}
+
+// Tags はタグ名を返します。
+//
+// Returns:
+//   - []string: タグ名（すべてのタグが付与された商品に絞り込む）
+func (f *ProductFilter) Tags() []string {
+	return f.tags
+}
+
+// IsEmpty は絞り込み条件がないかを返します。
+//
+// Returns:
+//   - bool: 条件がない場合true
+func (f *ProductFilter) IsEmpty() bool {
+	return f.categoryId == "" && len(f.tags) == 0
+}
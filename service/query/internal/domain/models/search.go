@@ -0,0 +1,143 @@
+package models
+
+// ProductHit は全文検索でヒットした商品です。
+type ProductHit struct {
+	product    *Product
+	score      float64
+	highlights []string
+}
+
+// NewProductHit はProductHitを生成します。
+//
+// Parameters:
+//   - product: 商品
+//   - score: 関連度スコア
+//   - highlights: 一致箇所を強調した商品名のフラグメント
+//
+// Returns:
+//   - *ProductHit: ProductHitポインタ
+func NewProductHit(product *Product, score float64, highlights []string) *ProductHit {
+	return &ProductHit{product: product, score: score, highlights: highlights}
+}
+
+// Product は商品を返します。
+//
+// Returns:
+//   - *Product: Productポインタ
+func (h *ProductHit) Product() *Product {
+	return h.product
+}
+
+// Score は関連度スコアを返します。
+//
+// Returns:
+//   - float64: 関連度スコア
+func (h *ProductHit) Score() float64 {
+	return h.score
+}
+
+// Highlights は一致箇所を強調した商品名のフラグメントを返します。
+//
+// Returns:
+//   - []string: フラグメント
+func (h *ProductHit) Highlights() []string {
+	return h.highlights
+}
+
+// FacetCount はファセットの集計値です。
+type FacetCount struct {
+	key   string
+	label string
+	count int
+}
+
+// NewFacetCount はFacetCountを生成します。
+//
+// Parameters:
+//   - key: 集計キー
+//   - label: 表示名
+//   - count: 件数
+//
+// Returns:
+//   - *FacetCount: FacetCountポインタ
+func NewFacetCount(key string, label string, count int) *FacetCount {
+	return &FacetCount{key: key, label: label, count: count}
+}
+
+// Key は集計キーを返します。
+//
+// Returns:
+//   - string: 集計キー
+func (f *FacetCount) Key() string {
+	return f.key
+}
+
+// Label は表示名を返します。
+//
+// Returns:
+//   - string: 表示名
+func (f *FacetCount) Label() string {
+	return f.label
+}
+
+// Count は件数を返します。
+//
+// Returns:
+//   - int: 件数
+func (f *FacetCount) Count() int {
+	return f.count
+}
+
+// SearchResult は商品の全文検索結果です。
+type SearchResult struct {
+	hits       []*ProductHit
+	total      uint64
+	categories []*FacetCount
+	priceBands []*FacetCount
+}
+
+// NewSearchResult はSearchResultを生成します。
+//
+// Parameters:
+//   - hits: 関連度順のヒット
+//   - total: ヒット総数
+//   - categories: カテゴリ別件数
+//   - priceBands: 価格帯別件数
+//
+// Returns:
+//   - *SearchResult: SearchResultポインタ
+func NewSearchResult(hits []*ProductHit, total uint64, categories []*FacetCount, priceBands []*FacetCount) *SearchResult {
+	return &SearchResult{hits: hits, total: total, categories: categories, priceBands: priceBands}
+}
+
+// Hits は関連度順のヒットを返します。
+//
+// Returns:
+//   - []*ProductHit: ヒット
+func (r *SearchResult) Hits() []*ProductHit {
+	return r.hits
+}
+
+// Total はヒット総数を返します。
+//
+// Returns:
+//   - uint64: ヒット総数
+func (r *SearchResult) Total() uint64 {
+	return r.total
+}
+
+// Categories はカテゴリ別件数を返します。
+//
+// Returns:
+//   - []*FacetCount: カテゴリ別件数
+func (r *SearchResult) Categories() []*FacetCount {
+	return r.categories
+}
+
+// PriceBands は価格帯別件数を返します。
+//
+// Returns:
+//   - []*FacetCount: 価格帯別件数
+func (r *SearchResult) PriceBands() []*FacetCount {
+	return r.priceBands
+}
@@ -0,0 +1,56 @@
+package models
+
+// Stock は商品の在庫です。
+type Stock struct {
+	productId string
+	onHand    uint32
+	reserved  uint32
+}
+
+// NewStock はStockを生成します。
+//
+// Parameters:
+//   - productId: 商品ID
+//   - onHand: 在庫数
+//   - reserved: 引当済みの数量
+//
+// Returns:
+//   - *Stock: Stockポインタ
+func NewStock(productId string, onHand uint32, reserved uint32) *Stock {
+	return &Stock{productId: productId, onHand: onHand, reserved: reserved}
+}
+
+// ProductId は商品IDを返します。
+//
+// Returns:
+//   - string: 商品ID
+func (s *Stock) ProductId() string {
+	return s.productId
+}
+
+// OnHand は在庫数を返します。
+//
+// Returns:
+//   - uint32: 在庫数
+func (s *Stock) OnHand() uint32 {
+	return s.onHand
+}
+
+// Reserved は引当済みの数量を返します。
+//
+// Returns:
+//   - uint32: 引当済みの数量
+func (s *Stock) Reserved() uint32 {
+	return s.reserved
+}
+
+// Available は引当可能な数量を返します。
+//
+// Returns:
+//   - uint32: 在庫数から引当済みの数量を引いた数量
+func (s *Stock) Available() uint32 {
+	if s.reserved > s.onHand {
+		return 0
+	}
+	return s.onHand - s.reserved
+}
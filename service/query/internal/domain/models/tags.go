@@ -0,0 +1,69 @@
+package models
+
+// Tag は商品に付与されるタグ
+type Tag struct {
+	id   string // タグID
+	name string // タグ名
+}
+
+// NewTag はTagを生成します。
+//
+// Parameters:
+//   - id: タグID
+//   - name: タグ名
+//
+// Returns:
+//   - *Tag: Tagポインタ
+func NewTag(id string, name string) *Tag {
+	return &Tag{id: id, name: name}
+}
+
+// Id はタグIDを返します。
+//
+// Returns:
+//   - string: タグID
+func (t *Tag) Id() string {
+	return t.id
+}
+
+// Name はタグ名を返します。
+//
+// Returns:
+//   - string: タグ名
+func (t *Tag) Name() string {
+	return t.name
+}
+
+// TagUsage はタグと、そのタグが付与された商品数
+type TagUsage struct {
+	tag          *Tag   // タグ
+	productCount uint32 // タグが付与された商品数
+}
+
+// NewTagUsage はTagUsageを生成します。
+//
+// Parameters:
+//   - tag: タグ
+//   - productCount: タグが付与された商品数
+//
+// Returns:
+//   - *TagUsage: TagUsageポインタ
+func NewTagUsage(tag *Tag, productCount uint32) *TagUsage {
+	return &TagUsage{tag: tag, productCount: productCount}
+}
+
+// Tag はタグを返します。
+//
+// Returns:
+//   - *Tag: Tagポインタ
+func (u *TagUsage) Tag() *Tag {
+	return u.tag
+}
+
+// ProductCount はタグが付与された商品数を返します。
+//
+// Returns:
+//   - uint32: タグが付与された商品数
+func (u *TagUsage) ProductCount() uint32 {
+	return u.productCount
+}
@@ -0,0 +1,113 @@
+package models
+
+// VariantOption はバリエーションの選択肢（軸と値の組）です。
+type VariantOption struct {
+	axis  string
+	value string
+}
+
+// NewVariantOption はVariantOptionを生成します。
+//
+// Parameters:
+//   - axis: 選択肢の軸
+//   - value: 選択肢の値
+//
+// Returns:
+//   - *VariantOption: VariantOptionポインタ
+func NewVariantOption(axis string, value string) *VariantOption {
+	return &VariantOption{axis: axis, value: value}
+}
+
+// Axis は選択肢の軸を返します。
+//
+// Returns:
+//   - string: 選択肢の軸
+func (o *VariantOption) Axis() string {
+	return o.axis
+}
+
+// Value は選択肢の値を返します。
+//
+// Returns:
+//   - string: 選択肢の値
+func (o *VariantOption) Value() string {
+	return o.value
+}
+
+// Variant は商品のバリエーションです。
+type Variant struct {
+	id            string
+	sku           string
+	options       []*VariantOption
+	priceOverride *uint32
+	status        string
+}
+
+// NewVariant はVariantを生成します。
+//
+// Parameters:
+//   - id: バリエーションID
+//   - sku: SKUコード
+//   - options: 選択肢の組み合わせ
+//   - priceOverride: 価格の上書き（nilの場合は商品価格）
+//   - status: 販売状態（ACTIVE / INACTIVE）
+//
+// Returns:
+//   - *Variant: Variantポインタ
+func NewVariant(id string, sku string, options []*VariantOption, priceOverride *uint32, status string) *Variant {
+	return &Variant{id: id, sku: sku, options: options, priceOverride: priceOverride, status: status}
+}
+
+// Id はバリエーションIDを返します。
+//
+// Returns:
+//   - string: バリエーションID
+func (v *Variant) Id() string {
+	return v.id
+}
+
+// Sku はSKUコードを返します。
+//
+// Returns:
+//   - string: SKUコード
+func (v *Variant) Sku() string {
+	return v.sku
+}
+
+// Options は選択肢の組み合わせを返します。
+//
+// Returns:
+//   - []*VariantOption: 選択肢の組み合わせ
+func (v *Variant) Options() []*VariantOption {
+	return v.options
+}
+
+// PriceOverride は価格の上書きを返します。
+//
+// Returns:
+//   - *uint32: 価格の上書き（上書きしていない場合はnil）
+func (v *Variant) PriceOverride() *uint32 {
+	return v.priceOverride
+}
+
+// Status は販売状態を返します。
+//
+// Returns:
+//   - string: 販売状態（ACTIVE / INACTIVE）
+func (v *Variant) Status() string {
+	return v.status
+}
+
+// EffectivePrice は商品価格を考慮した販売価格を返します。
+//
+// Parameters:
+//   - productPrice: 商品価格
+//
+// Returns:
+//   - uint32: 価格の上書きがある場合はその価格、ない場合は商品価格
+func (v *Variant) EffectivePrice(productPrice uint32) uint32 {
+	if v.priceOverride != nil {
+		return *v.priceOverride
+	}
+	return productPrice
+}
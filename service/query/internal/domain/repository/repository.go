@@ -20,16 +20,44 @@ type ProductRepository interface {
 	//   - error: エラー
 	List(ctx context.Context) ([]*models.Product, error)
 
-	// FindById は商品IDで商品を検索します。
+	// FindById は商品IDで公開中の商品を検索します。
+	// 商品のバリエーションも合わせて取得します。
 	//
 	// Parameters:
 	//   - ctx: コンテキスト
 	//   - id: 商品ID
+	//   - includeUnpublished: 公開中以外の商品も返す場合はtrue（管理用）
 	//
 	// Returns:
 	//   - *models.Product: 商品
-	//   - error: エラー
-	FindById(ctx context.Context, id string) (*models.Product, error)
+	//   - error: 商品が存在しない場合はNOT_FOUNDエラー
+	FindById(ctx context.Context, id string, includeUnpublished bool) (*models.Product, error)
+
+	// FindByBarcode はバーコードで公開中の商品を検索します。
+	// 商品のバリエーションも合わせて取得します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - barcode: JAN/EAN/UPCバーコード
+	//
+	// Returns:
+	//   - *models.Product: 商品
+	//   - error: 公開中の商品が存在しない場合はNOT_FOUNDエラー
+	FindByBarcode(ctx context.Context, barcode string) (*models.Product, error)
+
+	// FindBySlug はスラッグで公開中の商品を検索します。
+	// 現在のスラッグで見つからない場合は変更前のスラッグの履歴から検索します。
+	// 変更前のスラッグで見つかった場合、返す商品のスラッグは現在のスラッグです。
+	// 商品のバリエーションも合わせて取得します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - slug: スラッグ
+	//
+	// Returns:
+	//   - *models.Product: 商品
+	//   - error: 公開中の商品が存在しない場合はNOT_FOUNDエラー
+	FindBySlug(ctx context.Context, slug string) (*models.Product, error)
 
 	// FindByNameLike は商品名の部分一致で商品を検索します。
 	//
@@ -41,6 +69,30 @@ type ProductRepository interface {
 	//   - []*models.Product: 商品リスト
 	//   - error: エラー
 	FindByNameLike(ctx context.Context, keyword string) ([]*models.Product, error)
+
+	// FindStockByProductId は商品IDで在庫を検索します。
+	// 在庫が登録されていない商品の場合は在庫数0の在庫を返します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - productId: 商品ID
+	//
+	// Returns:
+	//   - *models.Stock: 在庫
+	//   - error: 商品が存在しない場合はNOT_FOUNDエラー
+	FindStockByProductId(ctx context.Context, productId string) (*models.Stock, error)
+
+	// ListByFilter は絞り込み条件に一致する商品を取得します。
+	// カテゴリとタグの両方を指定した場合は、両方の条件を満たす商品を取得します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - filter: 絞り込み条件
+	//
+	// Returns:
+	//   - []*models.Product: 商品リスト
+	//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+	ListByFilter(ctx context.Context, filter *models.ProductFilter) ([]*models.Product, error)
 }
 
 // CategoryRepository はカテゴリリポジトリのインターフェースです。
@@ -65,4 +117,64 @@ type CategoryRepository interface {
 	//   - *models.Category: カテゴリ
 	//   - error: エラー
 	FindById(ctx context.Context, id string) (*models.Category, error)
+
+	// FindBySlug はスラッグでカテゴリを検索します。
+	// 現在のスラッグで見つからない場合は変更前のスラッグの履歴から検索します。
+	// 変更前のスラッグで見つかった場合、返すカテゴリのスラッグは現在のスラッグです。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - slug: スラッグ
+	//
+	// Returns:
+	//   - *models.Category: カテゴリ
+	//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+	FindBySlug(ctx context.Context, slug string) (*models.Category, error)
+
+	// FindChildren は親カテゴリIDで子カテゴリを検索します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - parentId: 親カテゴリID（エンプティの場合はルートカテゴリを検索）
+	//
+	// Returns:
+	//   - []*models.Category: 子カテゴリリスト
+	//   - error: 親カテゴリが存在しない場合はNOT_FOUNDエラー
+	FindChildren(ctx context.Context, parentId string) ([]*models.Category, error)
+
+	// FindAncestors はルートから指定されたカテゴリまでのカテゴリを検索します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - id: カテゴリID
+	//
+	// Returns:
+	//   - []*models.Category: ルートから指定されたカテゴリまでのカテゴリリスト（末尾が指定されたカテゴリ）
+	//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+	FindAncestors(ctx context.Context, id string) ([]*models.Category, error)
+
+	// FindSubtree は指定されたカテゴリを根とする部分木を検索します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - id: 根となるカテゴリID
+	//
+	// Returns:
+	//   - *models.CategoryNode: 部分木の根ノード
+	//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+	FindSubtree(ctx context.Context, id string) (*models.CategoryNode, error)
+}
+
+// TagRepository はタグリポジトリのインターフェースです。
+type TagRepository interface {
+	// ListWithUsage はすべてのタグを、付与された商品数とともに取得します。
+	// 付与された商品数の多い順に並べ、商品に付与されていないタグも含めます。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//
+	// Returns:
+	//   - []*models.TagUsage: タグと付与された商品数のリスト
+	//   - error: エラー
+	ListWithUsage(ctx context.Context) ([]*models.TagUsage, error)
 }
@@ -0,0 +1,46 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=../../mock/repository/search_mock.go -package=mock_repository
+
+package repository
+
+import (
+	"context"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+)
+
+// SearchEngine は商品の全文検索エンジンのインターフェースです。
+type SearchEngine interface {
+	// Reindex は与えられた商品でインデックスを同期します。
+	// 与えられた商品に含まれない商品はインデックスから削除されます。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - products: 同期する全商品
+	//
+	// Returns:
+	//   - error: エラー
+	Reindex(ctx context.Context, products []*models.Product) error
+
+	// Search はキーワードで商品を全文検索します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - keyword: 検索キーワード
+	//
+	// Returns:
+	//   - *models.SearchResult: 関連度順の検索結果とファセット
+	//   - error: エラー
+	Search(ctx context.Context, keyword string) (*models.SearchResult, error)
+
+	// Suggest は入力中の検索語に対するサジェストを返します。
+	//
+	// Parameters:
+	//   - ctx: コンテキスト
+	//   - prefix: 入力中の検索語
+	//   - limit: 最大件数（0以下の場合は実装のデフォルト値）
+	//
+	// Returns:
+	//   - []*models.ProductHit: 関連度順のサジェスト
+	//   - error: エラー
+	Suggest(ctx context.Context, prefix string, limit int) ([]*models.ProductHit, error)
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/config"
 	"github.com/stretchr/testify/assert"
@@ -124,3 +125,113 @@ max_idle_conns = 10
 		})
 	}
 }
+
+func TestNewRepositoryConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		configContent string
+		envVars       map[string]string
+		wantBackend   string
+		wantSeed      bool
+		wantErr       bool
+	}{
+		{
+			name:          "正常系: リポジトリの実装の設定を読み込める",
+			configContent: "[repository]\nbackend = \"memory\"\nseed = true\n",
+			wantBackend:   config.REPOSITORY_BACKEND_MEMORY,
+			wantSeed:      true,
+		},
+		{
+			name:          "正常系: 環境変数で実装を上書きできる",
+			configContent: "[repository]\nbackend = \"mysql\"\nseed = false\n",
+			envVars:       map[string]string{"REPOSITORY_BACKEND": "memory"},
+			wantBackend:   config.REPOSITORY_BACKEND_MEMORY,
+		},
+		{
+			name:          "異常系: 未対応の実装を指定した場合はエラー",
+			configContent: "[repository]\nbackend = \"oracle\"\nseed = false\n",
+			wantErr:       true,
+		},
+		{
+			name:          "異常系: 設定がない場合はエラー",
+			configContent: "[server]\nport = 8085\n",
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			err := os.WriteFile(filepath.Join(tmpDir, "test_config.toml"), []byte(tt.configContent), 0644)
+			require.NoError(t, err)
+			for key, value := range tt.envVars {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := config.NewRepositoryConfig(config.NewViper(tmpDir, "test_config"))
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, cfg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBackend, cfg.Backend)
+			assert.Equal(t, tt.wantSeed, cfg.Seed)
+		})
+	}
+}
+
+func TestNewMigrationConfig(t *testing.T) {
+	tests := []struct {
+		name            string
+		configContent   string
+		envVars         map[string]string
+		wantAuto        bool
+		wantLockTimeout time.Duration
+		wantErr         bool
+	}{
+		{
+			name:            "正常系: マイグレーションの設定を読み込める",
+			configContent:   "[migration]\nauto = false\nlock_timeout = \"30s\"\n",
+			wantLockTimeout: 30 * time.Second,
+		},
+		{
+			name:            "正常系: 環境変数で起動時の適用を有効にできる",
+			configContent:   "[migration]\nauto = false\nlock_timeout = \"30s\"\n",
+			envVars:         map[string]string{"MIGRATION_AUTO": "true"},
+			wantAuto:        true,
+			wantLockTimeout: 30 * time.Second,
+		},
+		{
+			name:          "異常系: ロックの待ち時間が1秒未満の場合はエラー",
+			configContent: "[migration]\nauto = false\nlock_timeout = \"500ms\"\n",
+			wantErr:       true,
+		},
+		{
+			name:          "異常系: 設定がない場合はエラー",
+			configContent: "[server]\nport = 8085\n",
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			err := os.WriteFile(filepath.Join(tmpDir, "test_config.toml"), []byte(tt.configContent), 0644)
+			require.NoError(t, err)
+			for key, value := range tt.envVars {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := config.NewMigrationConfig(config.NewViper(tmpDir, "test_config"))
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, cfg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAuto, cfg.Auto)
+			assert.Equal(t, tt.wantLockTimeout, cfg.LockTimeout)
+		})
+	}
+}
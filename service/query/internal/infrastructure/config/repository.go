@@ -0,0 +1,47 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/spf13/viper"
+)
+
+const (
+	REPOSITORY_BACKEND_MYSQL  = "mysql"  // MySQL（GORM）から読み取る
+	REPOSITORY_BACKEND_SQLITE = "sqlite" // SQLite（GORM）の読み取りモデルから読み取る（ローカル開発・エッジ環境用）
+	REPOSITORY_BACKEND_MEMORY = "memory" // プロセスのメモリ上に保持する（DBなしでの動作確認・テスト用）
+)
+
+// RepositoryConfig はリポジトリの実装の設定を保持します。
+type RepositoryConfig struct {
+	Backend string // リポジトリの実装（mysql / sqlite / memory）
+	Seed    bool   // インメモリの場合にサンプルデータを投入するかどうか
+}
+
+// NewRepositoryConfig はViperから設定を読み込みRepositoryConfigを生成します。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *RepositoryConfig: リポジトリの実装の設定
+//   - error: 設定の読み込みに失敗した場合、または値が不正な場合のエラー
+func NewRepositoryConfig(v *viper.Viper) (*RepositoryConfig, error) {
+	var configErrors []error
+	cfg := &RepositoryConfig{
+		Backend: utils.GetKey[string](v, "repository.backend", &configErrors),
+		Seed:    utils.GetKey[bool](v, "repository.seed", &configErrors),
+	}
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+
+	switch cfg.Backend {
+	case REPOSITORY_BACKEND_MYSQL, REPOSITORY_BACKEND_SQLITE, REPOSITORY_BACKEND_MEMORY:
+	default:
+		return nil, fmt.Errorf("repository.backend must be %q, %q or %q: %q", REPOSITORY_BACKEND_MYSQL, REPOSITORY_BACKEND_SQLITE, REPOSITORY_BACKEND_MEMORY, cfg.Backend)
+	}
+	return cfg, nil
+}
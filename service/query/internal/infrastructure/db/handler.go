@@ -9,6 +9,8 @@ import (
 	"strings"
 	"time"
 
+	sqlite_go "github.com/glebarez/go-sqlite"
+	"github.com/glebarez/sqlite"
 	mysql_go "github.com/go-sql-driver/mysql"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
@@ -18,8 +20,15 @@ import (
 	gorm_logger "gorm.io/gorm/logger"
 )
 
+const (
+	DRIVER_MYSQL  = "mysql"  // MySQL（コマンドDBのレプリカ）
+	DRIVER_SQLITE = "sqlite" // SQLite（組み込みの読み取りモデル。純Goのドライバを使用）
+)
+
 // DBConfig はデータベース接続の設定を保持します。
 type DBConfig struct {
+	Driver          string        //	ドライバ（mysql / sqlite）
+	Path            string        //	SQLiteのデータベースファイルのパス
 	DBName          string        //	データベース名
 	Host            string        //	ホスト名
 	Port            int           //	ポート番号
@@ -32,7 +41,7 @@ type DBConfig struct {
 	LogLevel        string        // ログレベル
 }
 
-// NewDBConfig はViperから設定を読み込みDBConfigを生成します。
+// NewDBConfig はViperからMySQLの設定を読み込みDBConfigを生成します。
 //
 // Parameters:
 //   - v: Viperインスタンス
@@ -43,6 +52,7 @@ type DBConfig struct {
 func NewDBConfig(v *viper.Viper) (*DBConfig, error) {
 	var configErrors []error
 	cfg := &DBConfig{
+		Driver:          DRIVER_MYSQL,
 		DBName:          utils.GetKey[string](v, "mysql.dbname", &configErrors),
 		Host:            utils.GetKey[string](v, "mysql.host", &configErrors),
 		Port:            utils.GetKey[int](v, "mysql.port", &configErrors),
@@ -61,7 +71,33 @@ func NewDBConfig(v *viper.Viper) (*DBConfig, error) {
 	return cfg, nil
 }
 
+// NewSQLiteDBConfig はViperからSQLiteの設定を読み込みDBConfigを生成します。
+// MySQLの接続設定（mysql.*）は使用しません。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *DBConfig: データベース設定
+//   - error: 設定の読み込みに失敗した場合、またはパスが空の場合のエラー
+func NewSQLiteDBConfig(v *viper.Viper) (*DBConfig, error) {
+	var configErrors []error
+	cfg := &DBConfig{
+		Driver:   DRIVER_SQLITE,
+		Path:     utils.GetKey[string](v, "sqlite.path", &configErrors),
+		LogLevel: utils.GetKey[string](v, "log.level", &configErrors),
+	}
+	if len(configErrors) > 0 {
+		return cfg, errors.Join(configErrors...)
+	}
+	if cfg.Path == "" {
+		return cfg, errors.New("sqlite.path must not be empty")
+	}
+	return cfg, nil
+}
+
 // NewDatabase はデータベース接続を確立しGORM DBインスタンスを返します。
+// SQLiteの場合は、読み取りモデルのテーブルが存在しなければ作成します。
 //
 // Parameters:
 //   - config: データベース設定
@@ -72,45 +108,65 @@ func NewDBConfig(v *viper.Viper) (*DBConfig, error) {
 //   - error: 接続に失敗した場合のエラー
 func NewDatabase(config *DBConfig, logger *slog.Logger) (*gorm.DB, error) {
 	ctx := context.Background()
-	connectStr := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local", config.User, config.Pass, config.Host, config.Port, config.DBName)
-	conn, err := gorm.Open(mysql.Open(connectStr), &gorm.Config{})
+
+	// 生成されたSQLをログに出力する設定
+	// 0: Silent, 1: Error, 2: Warn, 3: Info
+	var loggerLevel gorm_logger.LogLevel
+	switch strings.ToLower(config.LogLevel) {
+	case "debug":
+		loggerLevel = gorm_logger.Info
+	case "info":
+		loggerLevel = gorm_logger.Info
+	case "warn":
+		loggerLevel = gorm_logger.Warn
+	case "error":
+		loggerLevel = gorm_logger.Error
+	default:
+		return nil, errs.NewInternalErrorWithCause("INVALID_LOG_LEVEL", fmt.Sprintf("不正なログレベルです: %s", config.LogLevel), nil)
+	}
+
+	var dialector gorm.Dialector
+	switch config.Driver {
+	case DRIVER_MYSQL:
+		// 時刻はUTCで保存されているため、UTCとして読み書きする
+		connectStr := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=UTC", config.User, config.Pass, config.Host, config.Port, config.DBName)
+		dialector = mysql.Open(connectStr)
+	case DRIVER_SQLITE:
+		// 読み込み（load）中も読み取れるようにWALモードにし、書き込み中のロックを待つ
+		dialector = sqlite.Open(config.Path + "?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	default:
+		return nil, errs.NewInternalError("INVALID_DB_DRIVER", fmt.Sprintf("未対応のドライバです: %s", config.Driver))
+	}
+	conn, err := gorm.Open(dialector, &gorm.Config{
+		// SQLiteの読み取りモデルは行を一括で入れ替えるため、外部キー制約を作成しない
+		DisableForeignKeyConstraintWhenMigrating: true,
+	})
+	if err != nil {
+		return nil, DBErrHandler(ctx, err, logger)
+	}
+	db, err := conn.DB()
 	if err != nil {
 		return nil, DBErrHandler(ctx, err, logger)
 	}
-	if db, err := conn.DB(); err != nil {
+	if err := db.Ping(); err != nil {
 		return nil, DBErrHandler(ctx, err, logger)
-	} else {
-		if err := db.Ping(); err != nil {
-			return nil, DBErrHandler(ctx, err, logger)
-		}
+	}
+	if config.Driver == DRIVER_MYSQL {
 		// 接続プールの設定
 		db.SetMaxIdleConns(config.MaxIdleConns)       // 最大アイドル接続数
 		db.SetMaxOpenConns(config.MaxOpenConns)       // 最大接続数
 		db.SetConnMaxLifetime(config.ConnMaxLifetime) // 接続の最大生存時間
 		db.SetConnMaxIdleTime(config.ConnMaxIdleTime) // 接続の最大アイドル時間
-
-		// 生成されたSQLをログに出力する設定
-		// 0: Silent, 1: Error, 2: Warn, 3: Info
-		var loggerLevel gorm_logger.LogLevel
-		switch strings.ToLower(config.LogLevel) {
-		case "debug":
-			loggerLevel = gorm_logger.Info
-		case "info":
-			loggerLevel = gorm_logger.Info
-		case "warn":
-			loggerLevel = gorm_logger.Warn
-		case "error":
-			loggerLevel = gorm_logger.Error
-		default:
-			return nil, errs.NewInternalErrorWithCause("INVALID_LOG_LEVEL", fmt.Sprintf("不正なログレベルです: %s", config.LogLevel), nil)
-		}
-		conn.Logger = conn.Logger.LogMode(loggerLevel)
+	} else if err := conn.WithContext(ctx).AutoMigrate(readModels...); err != nil {
+		return nil, DBErrHandler(ctx, err, logger)
 	}
+	conn.Logger = conn.Logger.LogMode(loggerLevel)
 
 	return conn, nil
 }
 
 // DBErrHandler はデータベースアクセスエラーを適切なドメインエラーに変換します。
+// MySQLとSQLiteのドライバのエラーはいずれもDB_DRIVER_ERRORに変換します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -122,12 +178,16 @@ func NewDatabase(config *DBConfig, logger *slog.Logger) (*gorm.DB, error) {
 func DBErrHandler(ctx context.Context, err error, logger *slog.Logger) error {
 	var opErr *net.OpError
 	var driverErr *mysql_go.MySQLError
+	var sqliteErr *sqlite_go.Error
 	if errors.As(err, &opErr) { // 接続タイムアウトやネットワーク関連の問題で接続が確立できない場合
 		logger.ErrorContext(ctx, "DB connection error", slog.Any("error", opErr))
 		return errs.NewInternalErrorWithCause("DB_CONNECTION_ERROR", opErr.Error(), opErr)
 	} else if errors.As(err, &driverErr) { // MySQLドライバエラーの場合
 		logger.WarnContext(ctx, "MySQL driver error", slog.Int("code", int(driverErr.Number)), slog.String("message", driverErr.Message))
 		return errs.NewInternalErrorWithCause("DB_DRIVER_ERROR", driverErr.Message, driverErr)
+	} else if errors.As(err, &sqliteErr) { // SQLiteドライバエラーの場合
+		logger.WarnContext(ctx, "SQLite driver error", slog.Int("code", sqliteErr.Code()), slog.String("message", sqliteErr.Error()))
+		return errs.NewInternalErrorWithCause("DB_DRIVER_ERROR", sqliteErr.Error(), sqliteErr)
 	} else { // その他のエラー
 		logger.ErrorContext(ctx, "Unknown database error", slog.Any("error", err))
 		return errs.NewInternalErrorWithCause("UNKNOWN_ERROR", err.Error(), err)
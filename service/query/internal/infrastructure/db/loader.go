@@ -0,0 +1,156 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// loadBatchSize は読み取りモデルへ1回に書き込む行数です。
+const loadBatchSize = 500
+
+// readModels はクエリサービスが読み取るテーブルのモデルです。SQLiteのテーブルの作成に使用します。
+var readModels = []any{
+	&Category{},
+	&CategoryNameTranslation{},
+	&CategorySlugHistory{},
+	&Product{},
+	&ProductNameTranslation{},
+	&ProductSlugHistory{},
+	&Stock{},
+	&ProductVariant{},
+	&ProductPriceSchedule{},
+	&Tag{},
+	&ProductTag{},
+}
+
+// Snapshot は読み取りモデルのすべての行です。
+// コマンドDB（またはそのレプリカ）から読み込み、SQLiteの読み取りモデルへの投入とエクスポートファイルに使用します。
+type Snapshot struct {
+	Categories            []*Category                `json:"categories"`
+	CategoryTranslations  []*CategoryNameTranslation `json:"category_translations"`
+	CategorySlugHistories []*CategorySlugHistory     `json:"category_slug_histories"`
+	Products              []*Product                 `json:"products"`
+	ProductTranslations   []*ProductNameTranslation  `json:"product_translations"`
+	ProductSlugHistories  []*ProductSlugHistory      `json:"product_slug_histories"`
+	Stocks                []*Stock                   `json:"stocks"`
+	Variants              []*ProductVariant          `json:"variants"`
+	PriceSchedules        []*ProductPriceSchedule    `json:"price_schedules"`
+	Tags                  []*Tag                     `json:"tags"`
+	ProductTags           []*ProductTag              `json:"product_tags"`
+}
+
+// tables はスナップショットの各テーブルの行へのポインタを返します。
+func (s *Snapshot) tables() []any {
+	return []any{
+		&s.Categories,
+		&s.CategoryTranslations,
+		&s.CategorySlugHistories,
+		&s.Products,
+		&s.ProductTranslations,
+		&s.ProductSlugHistories,
+		&s.Stocks,
+		&s.Variants,
+		&s.PriceSchedules,
+		&s.Tags,
+		&s.ProductTags,
+	}
+}
+
+// ReadSnapshot はデータベースから読み取りモデルのすべての行を読み込みます。
+// 関連は読み込まず、各テーブルの行を主キーの順に取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - src: 読み込み元のデータベース接続（コマンドDBまたはクエリDB）
+//
+// Returns:
+//   - *Snapshot: 読み取りモデルのすべての行
+//   - error: 読み込みに失敗した場合のエラー
+func ReadSnapshot(ctx context.Context, src *gorm.DB) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	for _, rows := range snapshot.tables() {
+		if result := src.WithContext(ctx).Order(clause.OrderByColumn{Column: clause.PrimaryColumn}).Find(rows); result.Error != nil {
+			return nil, result.Error
+		}
+	}
+	return snapshot, nil
+}
+
+// LoadSnapshot は読み取りモデルのすべての行をスナップショットの行で置き換えます。
+// 1つのトランザクションで置き換えるため、失敗した場合は置き換える前の行が残ります。
+// 時刻はUTCに揃えて書き込みます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - dst: 書き込み先のデータベース接続（SQLite）
+//   - snapshot: 読み取りモデルのすべての行
+//
+// Returns:
+//   - error: 書き込みに失敗した場合のエラー
+func LoadSnapshot(ctx context.Context, dst *gorm.DB, snapshot *Snapshot) error {
+	for _, history := range snapshot.CategorySlugHistories {
+		history.CreatedAt = history.CreatedAt.UTC()
+	}
+	for _, history := range snapshot.ProductSlugHistories {
+		history.CreatedAt = history.CreatedAt.UTC()
+	}
+	for _, schedule := range snapshot.PriceSchedules {
+		schedule.StartsAt = schedule.StartsAt.UTC()
+		schedule.EndsAt = schedule.EndsAt.UTC()
+	}
+
+	return dst.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, model := range readModels {
+			if result := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(model); result.Error != nil {
+				return result.Error
+			}
+		}
+		for _, rows := range snapshot.tables() {
+			if result := tx.Omit(clause.Associations).CreateInBatches(rows, loadBatchSize); result.Error != nil {
+				return result.Error
+			}
+		}
+		return nil
+	})
+}
+
+// ReadSnapshotFile はエクスポートファイル（JSON）からスナップショットを読み込みます。
+//
+// Parameters:
+//   - path: エクスポートファイルのパス
+//
+// Returns:
+//   - *Snapshot: 読み取りモデルのすべての行
+//   - error: ファイルの読み込みまたはJSONの解析に失敗した場合のエラー
+func ReadSnapshotFile(path string) (*Snapshot, error) {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal(body, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot %s: %w", path, err)
+	}
+	return snapshot, nil
+}
+
+// WriteSnapshotFile はスナップショットをエクスポートファイル（JSON）に書き込みます。
+//
+// Parameters:
+//   - path: エクスポートファイルのパス
+//   - snapshot: 読み取りモデルのすべての行
+//
+// Returns:
+//   - error: ファイルの書き込みに失敗した場合のエラー
+func WriteSnapshotFile(path string, snapshot *Snapshot) error {
+	body, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, body, 0o644)
+}
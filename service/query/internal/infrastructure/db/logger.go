@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
+	"gorm.io/gorm"
+	gorm_logger "gorm.io/gorm/logger"
+)
+
+// LOG_GROUP はGORMのログレベルを個別に変更するときのグループ名です。
+const LOG_GROUP = "db"
+
+// levelLogger はLevelsのdbグループのレベルに応じてGORMのログモードを切り替えるロガーです。
+type levelLogger struct {
+	levels *log.Levels
+	modes  map[gorm_logger.LogLevel]gorm_logger.Interface // ログモードごとのロガー
+}
+
+// FollowLogLevel はGORMのログモードをLevelsのdbグループのレベルに追従させます。
+// 起動時のlog.levelで固定していたログ出力を、実行時に変更したログレベルに従わせます。
+//
+// Parameters:
+//   - conn: データベース接続
+//   - levels: 実行時に変更できるログレベル
+func FollowLogLevel(conn *gorm.DB, levels *log.Levels) {
+	base := conn.Logger
+	modes := map[gorm_logger.LogLevel]gorm_logger.Interface{}
+	for _, mode := range []gorm_logger.LogLevel{gorm_logger.Info, gorm_logger.Warn, gorm_logger.Error} {
+		modes[mode] = base.LogMode(mode)
+	}
+	conn.Logger = &levelLogger{levels: levels, modes: modes}
+}
+
+// gormLogLevel はslogのレベルをGORMのログモードに変換します。
+// GORMはSQLをInfoで出力するため、debugとinfoはいずれもInfoにします。
+func gormLogLevel(level slog.Level) gorm_logger.LogLevel {
+	switch {
+	case level <= slog.LevelInfo:
+		return gorm_logger.Info
+	case level <= slog.LevelWarn:
+		return gorm_logger.Warn
+	default:
+		return gorm_logger.Error
+	}
+}
+
+func (l *levelLogger) current() gorm_logger.Interface {
+	return l.modes[gormLogLevel(l.levels.Level(LOG_GROUP))]
+}
+
+// LogMode はLevelsに従うため、指定したログモードを無視して自身を返します。
+func (l *levelLogger) LogMode(gorm_logger.LogLevel) gorm_logger.Interface {
+	return l
+}
+
+func (l *levelLogger) Info(ctx context.Context, msg string, data ...any) {
+	l.current().Info(ctx, msg, data...)
+}
+
+func (l *levelLogger) Warn(ctx context.Context, msg string, data ...any) {
+	l.current().Warn(ctx, msg, data...)
+}
+
+func (l *levelLogger) Error(ctx context.Context, msg string, data ...any) {
+	l.current().Error(ctx, msg, data...)
+}
+
+func (l *levelLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	l.current().Trace(ctx, begin, fc, err)
+}
@@ -0,0 +1,85 @@
+package db_test
+
+import (
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/db"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/testhelpers"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gorm_logger "gorm.io/gorm/logger"
+)
+
+// logWriter はGORMのログを記録するgorm_logger.Writerです。
+type logWriter struct {
+	lines []string
+}
+
+func (w *logWriter) Printf(format string, args ...any) {
+	w.lines = append(w.lines, fmt.Sprintf(format, args...))
+}
+
+func TestFollowLogLevel(t *testing.T) {
+	// Arrange
+	conn, err := db.NewDatabase(&db.DBConfig{
+		Driver:   db.DRIVER_SQLITE,
+		Path:     filepath.Join(t.TempDir(), "query.sqlite3"),
+		LogLevel: "error",
+	}, testhelpers.TestLogger)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := conn.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	writer := &logWriter{}
+	conn.Logger = gorm_logger.New(writer, gorm_logger.Config{LogLevel: gorm_logger.Error})
+	v := viper.New()
+	v.Set("log.level", "warn")
+	levels, err := log.NewLevels(v)
+	require.NoError(t, err)
+	db.FollowLogLevel(conn, levels)
+
+	tests := []struct {
+		name    string
+		set     func()
+		wantSQL bool
+	}{
+		{
+			name:    "正常系: warnの場合はSQLを出力しない",
+			set:     func() {},
+			wantSQL: false,
+		},
+		{
+			name:    "正常系: dbグループをdebugにするとSQLを出力する",
+			set:     func() { levels.SetGroup(db.LOG_GROUP, slog.LevelDebug) },
+			wantSQL: true,
+		},
+		{
+			name:    "正常系: dbグループの上書きを解除すると全体のレベルに戻る",
+			set:     func() { levels.ResetGroup(db.LOG_GROUP) },
+			wantSQL: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.set()
+			writer.lines = nil
+
+			// Act
+			var count int64
+			require.NoError(t, conn.Table("product").Count(&count).Error)
+
+			// Assert
+			logged := strings.Contains(strings.Join(writer.lines, "\n"), "SELECT count(*)")
+			assert.Equal(t, tt.wantSQL, logged)
+		})
+	}
+}
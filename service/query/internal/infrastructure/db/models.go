@@ -1,14 +1,30 @@
 package db
 
+import "time"
+
 // Product は商品のデータベースモデルです。
 type Product struct {
-	Id         int    `gorm:"column:id;primaryKey"`
-	ObjId      string `gorm:"column:obj_id;primaryKey"`
-	Name       string `gorm:"column:name"`
-	Price      uint32 `gorm:"column:price"`
-	CategoryId string `gorm:"column:category_id"`
+	Id         int    `gorm:"column:id;primaryKey" json:"id"`
+	ObjId      string `gorm:"column:obj_id;primaryKey" json:"obj_id"`
+	Name       string `gorm:"column:name" json:"name"`
+	Price      uint32 `gorm:"column:price" json:"price"`
+	Currency   string `gorm:"column:currency" json:"currency"`
+	TaxClass   string `gorm:"column:tax_class" json:"tax_class"`
+	Status     string `gorm:"column:status" json:"status"`
+	CategoryId string `gorm:"column:category_id" json:"category_id"`
+
+	Barcode *string `gorm:"column:barcode" json:"barcode,omitempty"` // JAN/EANバーコード（未登録の場合はNULL）
+	Slug    string  `gorm:"column:slug" json:"slug"`                 // URLに使用するスラッグ
+
+	Category Category `gorm:"foreignKey:CategoryId;references:ObjId" json:"-"`
+	Stock    *Stock   `gorm:"foreignKey:ProductId;references:ObjId" json:"-"`
+
+	Variants []ProductVariant `gorm:"foreignKey:ProductId;references:ObjId" json:"-"`
+	Tags     []Tag            `gorm:"many2many:product_tag;foreignKey:ObjId;joinForeignKey:ProductId;references:ObjId;joinReferences:TagId" json:"-"`
 
-	Category Category `gorm:"foreignKey:CategoryId;references:ObjId"`
+	Translations []ProductNameTranslation `gorm:"foreignKey:ProductId;references:ObjId" json:"-"`
+
+	PriceSchedules []ProductPriceSchedule `gorm:"foreignKey:ProductId;references:ObjId" json:"-"`
 }
 
 // TableName はテーブル名を返します。
@@ -18,12 +34,140 @@ func (Product) TableName() string {
 
 // Category はカテゴリのデータベースモデルです。
 type Category struct {
-	Id    int    `gorm:"column:id;primaryKey"`
-	ObjId string `gorm:"column:obj_id;primaryKey"`
-	Name  string `gorm:"column:name"`
+	Id       int     `gorm:"column:id;primaryKey" json:"id"`
+	ObjId    string  `gorm:"column:obj_id;primaryKey" json:"obj_id"`
+	Name     string  `gorm:"column:name" json:"name"`
+	ParentId *string `gorm:"column:parent_id" json:"parent_id,omitempty"` // 親カテゴリ（ルートカテゴリの場合はNULL）
+	Slug     string  `gorm:"column:slug" json:"slug"`                     // URLに使用するスラッグ
+
+	Translations []CategoryNameTranslation `gorm:"foreignKey:CategoryId;references:ObjId" json:"-"`
 }
 
 // TableName はテーブル名を返します。
 func (Category) TableName() string {
 	return "category"
 }
+
+// ProductNameTranslation は商品名の翻訳のデータベースモデルです。
+type ProductNameTranslation struct {
+	ProductId string `gorm:"column:product_id;primaryKey" json:"product_id"`
+	Locale    string `gorm:"column:locale;primaryKey" json:"locale"`
+	Name      string `gorm:"column:name" json:"name"`
+}
+
+// TableName はテーブル名を返します。
+func (ProductNameTranslation) TableName() string {
+	return "product_name_translation"
+}
+
+// CategoryNameTranslation はカテゴリ名の翻訳のデータベースモデルです。
+type CategoryNameTranslation struct {
+	CategoryId string `gorm:"column:category_id;primaryKey" json:"category_id"`
+	Locale     string `gorm:"column:locale;primaryKey" json:"locale"`
+	Name       string `gorm:"column:name" json:"name"`
+}
+
+// TableName はテーブル名を返します。
+func (CategoryNameTranslation) TableName() string {
+	return "category_name_translation"
+}
+
+// ProductSlugHistory は商品の変更前のスラッグのデータベースモデルです。
+type ProductSlugHistory struct {
+	Slug      string    `gorm:"column:slug;primaryKey" json:"slug"`
+	ProductId string    `gorm:"column:product_id" json:"product_id"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+// TableName はテーブル名を返します。
+func (ProductSlugHistory) TableName() string {
+	return "product_slug_history"
+}
+
+// CategorySlugHistory はカテゴリの変更前のスラッグのデータベースモデルです。
+type CategorySlugHistory struct {
+	Slug       string    `gorm:"column:slug;primaryKey" json:"slug"`
+	CategoryId string    `gorm:"column:category_id" json:"category_id"`
+	CreatedAt  time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+// TableName はテーブル名を返します。
+func (CategorySlugHistory) TableName() string {
+	return "category_slug_history"
+}
+
+// Stock は在庫のデータベースモデルです。
+type Stock struct {
+	Id        int    `gorm:"column:id;primaryKey" json:"id"`
+	ProductId string `gorm:"column:product_id" json:"product_id"`
+	OnHand    uint32 `gorm:"column:on_hand" json:"on_hand"`
+	Reserved  uint32 `gorm:"column:reserved" json:"reserved"`
+}
+
+// TableName はテーブル名を返します。
+func (Stock) TableName() string {
+	return "stock"
+}
+
+// ProductVariant は商品バリエーションのデータベースモデルです。
+type ProductVariant struct {
+	Id            int     `gorm:"column:id;primaryKey" json:"id"`
+	ObjId         string  `gorm:"column:obj_id" json:"obj_id"`
+	ProductId     string  `gorm:"column:product_id" json:"product_id"`
+	Sku           string  `gorm:"column:sku" json:"sku"`
+	Options       string  `gorm:"column:options" json:"options"` // 選択肢のJSON配列（例: [{"axis":"サイズ","value":"M"}]）
+	PriceOverride *uint32 `gorm:"column:price_override" json:"price_override,omitempty"`
+	Status        string  `gorm:"column:status" json:"status"`
+}
+
+// TableName はテーブル名を返します。
+func (ProductVariant) TableName() string {
+	return "product_variant"
+}
+
+// ProductPriceSchedule は価格スケジュールのデータベースモデルです。
+type ProductPriceSchedule struct {
+	Id           int       `gorm:"column:id;primaryKey" json:"id"`
+	ObjId        string    `gorm:"column:obj_id" json:"obj_id"`
+	ProductId    string    `gorm:"column:product_id" json:"product_id"`
+	Price        uint32    `gorm:"column:price" json:"price"`
+	RegularPrice *uint32   `gorm:"column:regular_price" json:"regular_price,omitempty"` // 適用前の単価（未適用の場合はNULL）
+	Status       string    `gorm:"column:status" json:"status"`
+	StartsAt     time.Time `gorm:"column:starts_at" json:"starts_at"`
+	EndsAt       time.Time `gorm:"column:ends_at" json:"ends_at"`
+}
+
+// TableName はテーブル名を返します。
+func (ProductPriceSchedule) TableName() string {
+	return "product_price_schedule"
+}
+
+// Tag はタグのデータベースモデルです。
+type Tag struct {
+	Id    int    `gorm:"column:id;primaryKey" json:"id"`
+	ObjId string `gorm:"column:obj_id" json:"obj_id"`
+	Name  string `gorm:"column:name" json:"name"`
+}
+
+// TableName はテーブル名を返します。
+func (Tag) TableName() string {
+	return "tag"
+}
+
+// ProductTag は商品とタグの関連のデータベースモデルです。
+type ProductTag struct {
+	ProductId string `gorm:"column:product_id;primaryKey" json:"product_id"`
+	TagId     string `gorm:"column:tag_id;primaryKey" json:"tag_id"`
+}
+
+// TableName はテーブル名を返します。
+func (ProductTag) TableName() string {
+	return "product_tag"
+}
+
+// TagUsage はタグと付与された商品数の集計結果です。
+type TagUsage struct {
+	ObjId        string `gorm:"column:obj_id"`
+	Name         string `gorm:"column:name"`
+	ProductCount uint32 `gorm:"column:product_count"`
+}
@@ -2,21 +2,70 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/repository"
 	"gorm.io/gorm"
 )
 
 const (
-	PRODUCT_ID_COLUMN   = "obj_id"
-	PRODUCT_NAME_COLUMN = "name"
+	PRODUCT_ID_COLUMN          = "obj_id"
+	PRODUCT_NAME_COLUMN        = "name"
+	PRODUCT_CATEGORY_ID_COLUMN = "category_id"
+	PRODUCT_STATUS_COLUMN      = "status"
+	PRODUCT_BARCODE_COLUMN     = "barcode"
+	PRODUCT_SLUG_COLUMN        = "slug"
+	VARIANT_ID_COLUMN          = "id"
+	SCHEDULE_STATUS_COLUMN     = "status"
+	SCHEDULE_STARTS_AT_COLUMN  = "starts_at"
+	SCHEDULE_ENDS_AT_COLUMN    = "ends_at"
+	CATEGORY_ID_COLUMN         = "obj_id"
+	CATEGORY_PARENT_ID_COLUMN  = "parent_id"
+	CATEGORY_SLUG_COLUMN       = "slug"
+	SLUG_HISTORY_SLUG_COLUMN   = "slug"
+	TAG_NAME_COLUMN            = "name"
 )
 
+// subtreeQuery は指定されたカテゴリとその子孫を、根からの深さ順に取得する再帰クエリです。
+const subtreeQuery = `
+WITH RECURSIVE subtree AS (
+	SELECT id, obj_id, name, parent_id, slug, 0 AS depth FROM category WHERE obj_id = ?
+	UNION ALL
+	SELECT c.id, c.obj_id, c.name, c.parent_id, c.slug, s.depth + 1 FROM category c JOIN subtree s ON c.parent_id = s.obj_id
+)
+SELECT id, obj_id, name, parent_id, slug FROM subtree ORDER BY depth, id`
+
+// ancestorsQuery は指定されたカテゴリからルートまでのカテゴリを、ルートから順に取得する再帰クエリです。
+const ancestorsQuery = `
+WITH RECURSIVE ancestors AS (
+	SELECT id, obj_id, name, parent_id, slug, 0 AS depth FROM category WHERE obj_id = ?
+	UNION ALL
+	SELECT c.id, c.obj_id, c.name, c.parent_id, c.slug, a.depth + 1 FROM category c JOIN ancestors a ON c.obj_id = a.parent_id
+)
+SELECT id, obj_id, name, parent_id, slug FROM ancestors ORDER BY depth DESC`
+
+// taggedProductsQuery は指定されたすべてのタグが付与された商品IDを取得するクエリです。
+const taggedProductsQuery = `
+SELECT pt.product_id FROM product_tag pt JOIN tag t ON t.obj_id = pt.tag_id
+WHERE t.name IN ? GROUP BY pt.product_id HAVING COUNT(DISTINCT pt.tag_id) = ?`
+
+// translatedProductsQuery は商品名の翻訳が部分一致する商品IDを取得するクエリです。
+const translatedProductsQuery = `SELECT product_id FROM product_name_translation WHERE name LIKE ?`
+
+// tagUsageQuery はすべてのタグを付与された商品数の多い順に取得するクエリです。
+const tagUsageQuery = `
+SELECT t.obj_id, t.name, COUNT(pt.product_id) AS product_count
+FROM tag t LEFT JOIN product_tag pt ON pt.tag_id = t.obj_id
+GROUP BY t.id, t.obj_id, t.name ORDER BY product_count DESC, t.name`
+
 // ProductRepositoryImpl は商品リポジトリのGORM実装です。
 type ProductRepositoryImpl struct {
 	db     *gorm.DB
@@ -35,7 +84,7 @@ func NewProductRepositoryImpl(db *gorm.DB, logger *slog.Logger) *ProductReposito
 	return &ProductRepositoryImpl{db: db, logger: logger}
 }
 
-// List はすべての商品を取得します。
+// List は公開中のすべての商品を取得します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -45,35 +94,122 @@ func NewProductRepositoryImpl(db *gorm.DB, logger *slog.Logger) *ProductReposito
 //   - error: エラー
 func (r *ProductRepositoryImpl) List(ctx context.Context) ([]*models.Product, error) {
 	products := []*Product{}
-	if result := r.db.WithContext(ctx).Preload("Category").Find(&products); result.Error != nil {
+	if result := preloadProduct(r.db.WithContext(ctx)).Scopes(published).Find(&products); result.Error != nil {
 		return nil, DBErrHandler(ctx, result.Error, r.logger)
 	}
 
 	return toProductModels(products), nil
 }
 
-// FindById は商品IDで商品を検索します。
+// FindById は商品IDで公開中の商品を検索します。
+// 一覧・検索と異なり、バリエーションを登録順に、終了時刻前の適用中および予定の価格スケジュールを開始時刻順に取得します。
+// includeUnpublishedがtrueの場合（catalogctlなどの管理用）は公開中以外の商品も返します。
 //
 // Parameters:
 //   - ctx: コンテキスト
 //   - id: 商品ID
+//   - includeUnpublished: 公開中以外の商品も返す場合はtrue
 //
 // Returns:
 //   - *models.Product: 商品
-//   - error: エラー
-func (r *ProductRepositoryImpl) FindById(ctx context.Context, id string) (*models.Product, error) {
+//   - error: 商品が存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) FindById(ctx context.Context, id string, includeUnpublished bool) (*models.Product, error) {
 	product := &Product{}
-	if result := r.db.WithContext(ctx).Preload("Category").Where(fmt.Sprintf("%s = ?", PRODUCT_ID_COLUMN), id).First(product); result.Error != nil {
+	variantsInOrder := func(db *gorm.DB) *gorm.DB { return db.Order(VARIANT_ID_COLUMN) }
+	pendingSchedules := func(db *gorm.DB) *gorm.DB {
+		// 価格スケジュールの時刻はUTCで保存されているため、UTCの現在時刻と比較する（ドライバに依存しないように引数で渡す）
+		return db.Where(fmt.Sprintf("%s IN ? AND %s > ?", SCHEDULE_STATUS_COLUMN, SCHEDULE_ENDS_AT_COLUMN),
+			[]string{models.PRICE_SCHEDULE_SCHEDULED, models.PRICE_SCHEDULE_ACTIVE}, time.Now().UTC()).
+			Order(SCHEDULE_STARTS_AT_COLUMN)
+	}
+	tx := preloadProduct(r.db.WithContext(ctx)).Preload("Variants", variantsInOrder).Preload("PriceSchedules", pendingSchedules)
+	if !includeUnpublished {
+		tx = tx.Scopes(published)
+	}
+	if result := tx.Where(fmt.Sprintf("%s = ?", PRODUCT_ID_COLUMN), id).First(product); result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("商品ID: %s が見つかりませんでした", id))
 		}
 		return nil, DBErrHandler(ctx, result.Error, r.logger)
 	}
 
-	return toProductModel(product), nil
+	variants, err := toVariantModels(product.Variants)
+	if err != nil {
+		return nil, err
+	}
+	return toProductModel(product).WithVariants(variants).WithPriceSchedules(toPriceScheduleModels(product.PriceSchedules)), nil
+}
+
+// FindByBarcode は公開中の商品をバーコードで検索します。
+// UPC-A（12桁）はコマンドサービスと同じく先頭に0を付けたEAN-13として検索します。
+// 販売できない商品を返さないよう、公開中以外の商品は見つからない扱いにします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - barcode: JAN/EAN/UPCバーコード
+//
+// Returns:
+//   - *models.Product: 商品
+//   - error: 公開中の商品が存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) FindByBarcode(ctx context.Context, barcode string) (*models.Product, error) {
+	normalized := normalizeBarcode(barcode)
+	product := &Product{}
+	variantsInOrder := func(db *gorm.DB) *gorm.DB { return db.Order(VARIANT_ID_COLUMN) }
+	if result := preloadProduct(r.db.WithContext(ctx)).Preload("Variants", variantsInOrder).Scopes(published).Where(fmt.Sprintf("%s = ?", PRODUCT_BARCODE_COLUMN), normalized).First(product); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("バーコード: %s の商品が見つかりませんでした", barcode))
+		}
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	}
+
+	variants, err := toVariantModels(product.Variants)
+	if err != nil {
+		return nil, err
+	}
+	return toProductModel(product).WithVariants(variants), nil
 }
 
-// FindByNameLike は商品名で商品を部分一致検索します。
+// FindBySlug は公開中の商品をスラッグで検索します。
+// 現在のスラッグで見つからない場合は変更前のスラッグの履歴から検索し、旧URLも解決できるようにします。
+// 返す商品のスラッグは常に現在のスラッグです。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - slug: スラッグ（大文字は小文字として扱う）
+//
+// Returns:
+//   - *models.Product: 商品
+//   - error: 公開中の商品が存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) FindBySlug(ctx context.Context, slug string) (*models.Product, error) {
+	normalized := strings.ToLower(strings.TrimSpace(slug))
+	column, value := PRODUCT_SLUG_COLUMN, normalized
+
+	history := &ProductSlugHistory{}
+	if result := r.db.WithContext(ctx).Where(fmt.Sprintf("%s = ?", SLUG_HISTORY_SLUG_COLUMN), normalized).Limit(1).Find(history); result.Error != nil {
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	} else if result.RowsAffected > 0 {
+		// 履歴にあるスラッグは現在のスラッグとして使用されないため、変更後の商品をIDで検索する
+		column, value = PRODUCT_ID_COLUMN, history.ProductId
+	}
+
+	product := &Product{}
+	variantsInOrder := func(db *gorm.DB) *gorm.DB { return db.Order(VARIANT_ID_COLUMN) }
+	if result := preloadProduct(r.db.WithContext(ctx)).Preload("Variants", variantsInOrder).Scopes(published).Where(fmt.Sprintf("%s = ?", column), value).First(product); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("スラッグ: %s の商品が見つかりませんでした", slug))
+		}
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	}
+
+	variants, err := toVariantModels(product.Variants)
+	if err != nil {
+		return nil, err
+	}
+	return toProductModel(product).WithVariants(variants), nil
+}
+
+// FindByNameLike は公開中の商品を商品名で部分一致検索します。
+// 既定のロケールの商品名に加え、すべてのロケールの翻訳と照合します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -89,13 +225,114 @@ func (r *ProductRepositoryImpl) FindByNameLike(ctx context.Context, keyword stri
 
 	products := []*Product{}
 	likePattern := "%" + keyword + "%"
-	if result := r.db.WithContext(ctx).Preload("Category").Where(fmt.Sprintf("%s LIKE ?", PRODUCT_NAME_COLUMN), likePattern).Find(&products); result.Error != nil {
+	translated := r.db.WithContext(ctx).Raw(translatedProductsQuery, likePattern)
+	condition := fmt.Sprintf("%s LIKE ? OR %s IN (?)", PRODUCT_NAME_COLUMN, PRODUCT_ID_COLUMN)
+	if result := preloadProduct(r.db.WithContext(ctx)).Scopes(published).Where(condition, likePattern, translated).Find(&products); result.Error != nil {
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	}
+
+	return toProductModels(products), nil
+}
+
+// FindStockByProductId は商品IDで在庫を検索します。
+// 在庫は最初の入荷や引当の時点で登録されるため、在庫が未登録の商品は在庫数0として扱います。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productId: 商品ID
+//
+// Returns:
+//   - *models.Stock: 在庫
+//   - error: エラー
+func (r *ProductRepositoryImpl) FindStockByProductId(ctx context.Context, productId string) (*models.Stock, error) {
+	product := &Product{}
+	if result := r.db.WithContext(ctx).Preload("Stock").Where(fmt.Sprintf("%s = ?", PRODUCT_ID_COLUMN), productId).First(product); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("商品ID: %s が見つかりませんでした", productId))
+		}
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	}
+
+	return toStockModel(product), nil
+}
+
+// ListByFilter は絞り込み条件に一致する公開中の商品を取得します。
+// 子孫カテゴリを含める場合は、再帰クエリで取得した部分木のカテゴリに属する商品を取得します。
+// タグを指定した場合は、指定されたすべてのタグが付与された商品に絞り込みます。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - filter: 絞り込み条件
+//
+// Returns:
+//   - []*models.Product: 商品リスト
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) ListByFilter(ctx context.Context, filter *models.ProductFilter) ([]*models.Product, error) {
+	query := preloadProduct(r.db.WithContext(ctx)).Scopes(published)
+	if filter.CategoryId() != "" {
+		categoryIds, err := r.findCategoryIds(ctx, filter.CategoryId(), filter.IncludeDescendants())
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(fmt.Sprintf("%s IN ?", PRODUCT_CATEGORY_ID_COLUMN), categoryIds)
+	}
+	if tags := filter.Tags(); len(tags) > 0 {
+		query = query.Where(fmt.Sprintf("%s IN (?)", PRODUCT_ID_COLUMN), r.db.Raw(taggedProductsQuery, tags, len(tags)))
+	}
+
+	products := []*Product{}
+	if result := query.Find(&products); result.Error != nil {
 		return nil, DBErrHandler(ctx, result.Error, r.logger)
 	}
 
 	return toProductModels(products), nil
 }
 
+// findCategoryIds は絞り込みに使用するカテゴリIDを取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - categoryId: カテゴリID
+//   - includeDescendants: trueの場合は子孫カテゴリのIDも含める
+//
+// Returns:
+//   - []string: カテゴリIDリスト
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) findCategoryIds(ctx context.Context, categoryId string, includeDescendants bool) ([]string, error) {
+	categories := []*Category{}
+	var result *gorm.DB
+	if includeDescendants {
+		result = r.db.WithContext(ctx).Raw(subtreeQuery, categoryId).Scan(&categories)
+	} else {
+		result = r.db.WithContext(ctx).Where(fmt.Sprintf("%s = ?", CATEGORY_ID_COLUMN), categoryId).Find(&categories)
+	}
+	if result.Error != nil {
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	}
+	if len(categories) == 0 {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリID: %s が見つかりませんでした", categoryId))
+	}
+
+	categoryIds := make([]string, len(categories))
+	for i, c := range categories {
+		categoryIds[i] = c.ObjId
+	}
+	return categoryIds, nil
+}
+
+// preloadProduct は商品とともに取得する関連（カテゴリ・在庫・タグ・商品名とカテゴリ名の翻訳）を設定します。
+// タグはタグ名の順に取得します。
+func preloadProduct(db *gorm.DB) *gorm.DB {
+	tagsInOrder := func(db *gorm.DB) *gorm.DB { return db.Order(TAG_NAME_COLUMN) }
+	return db.Preload("Category").Preload("Category.Translations").Preload("Stock").Preload("Tags", tagsInOrder).
+		Preload("Translations")
+}
+
+// published は公開中の商品に絞り込みます。
+func published(db *gorm.DB) *gorm.DB {
+	return db.Where(fmt.Sprintf("%s = ?", PRODUCT_STATUS_COLUMN), models.PRODUCT_STATUS_PUBLISHED)
+}
+
 func toProductModels(products []*Product) []*models.Product {
 	results := make([]*models.Product, len(products))
 	for i, p := range products {
@@ -104,8 +341,94 @@ func toProductModels(products []*Product) []*models.Product {
 	return results
 }
 func toProductModel(product *Product) *models.Product {
-	category := models.NewCategory(product.Category.ObjId, product.Category.Name)
-	return models.NewProduct(product.ObjId, product.Name, product.Price, category)
+	category := toCategoryModel(&product.Category)
+	return models.NewProduct(product.ObjId, product.Name, product.Price, category).
+		WithTax(product.Currency, money.TaxClass(product.TaxClass)).
+		WithAvailableQuantity(toStockModel(product).Available()).
+		WithTags(toTagModels(product.Tags)).
+		WithTranslations(toProductTranslations(product.Translations)).
+		WithStatus(product.Status).
+		WithBarcode(toBarcode(product.Barcode)).
+		WithSlug(product.Slug)
+}
+
+// normalizeBarcode はコマンドサービスの保存形式に合わせて、UPC-A（12桁）の先頭に0を付けたEAN-13に変換します。
+func normalizeBarcode(barcode string) string {
+	barcode = strings.TrimSpace(barcode)
+	if len(barcode) == 12 {
+		return "0" + barcode
+	}
+	return barcode
+}
+
+// toBarcode はNULLのバーコードを空文字列に変換します。
+func toBarcode(barcode *string) string {
+	if barcode == nil {
+		return ""
+	}
+	return *barcode
+}
+
+// toProductTranslations は商品名の翻訳をロケールごとの名前に変換します。
+func toProductTranslations(translations []ProductNameTranslation) models.Translations {
+	if len(translations) == 0 {
+		return nil
+	}
+	results := make(models.Translations, len(translations))
+	for _, t := range translations {
+		results[t.Locale] = t.Name
+	}
+	return results
+}
+
+func toTagModels(tags []Tag) []*models.Tag {
+	results := make([]*models.Tag, len(tags))
+	for i, t := range tags {
+		results[i] = models.NewTag(t.ObjId, t.Name)
+	}
+	return results
+}
+
+func toPriceScheduleModels(schedules []ProductPriceSchedule) []*models.PriceSchedule {
+	results := make([]*models.PriceSchedule, len(schedules))
+	for i, s := range schedules {
+		results[i] = models.NewPriceSchedule(s.ObjId, s.Price, s.RegularPrice, s.Status, asUTC(s.StartsAt), asUTC(s.EndsAt))
+	}
+	return results
+}
+
+// asUTC はコマンドサービスがUTCで保存した時刻を、接続のタイムゾーンによらずUTCの時刻として読み替えます。
+func asUTC(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+func toStockModel(product *Product) *models.Stock {
+	if product.Stock == nil {
+		return models.NewStock(product.ObjId, 0, 0)
+	}
+	return models.NewStock(product.ObjId, product.Stock.OnHand, product.Stock.Reserved)
+}
+
+// variantOptionRecord はproduct_variant.options列に保存された選択肢のJSON表現です。
+type variantOptionRecord struct {
+	Axis  string `json:"axis"`
+	Value string `json:"value"`
+}
+
+func toVariantModels(variants []ProductVariant) ([]*models.Variant, error) {
+	results := make([]*models.Variant, 0, len(variants))
+	for _, v := range variants {
+		var records []variantOptionRecord
+		if err := json.Unmarshal([]byte(v.Options), &records); err != nil {
+			return nil, errs.NewInternalErrorWithCause("INVALID_VARIANT_OPTIONS", fmt.Sprintf("バリエーションID: %s の選択肢を読み取れませんでした", v.ObjId), err)
+		}
+		options := make([]*models.VariantOption, 0, len(records))
+		for _, record := range records {
+			options = append(options, models.NewVariantOption(record.Axis, record.Value))
+		}
+		results = append(results, models.NewVariant(v.ObjId, v.Sku, options, v.PriceOverride, v.Status))
+	}
+	return results, nil
 }
 
 var _ repository.ProductRepository = (*ProductRepositoryImpl)(nil)
@@ -138,7 +461,7 @@ func NewCategoryRepositoryImpl(db *gorm.DB, logger *slog.Logger) *CategoryReposi
 //   - error: エラー
 func (r *CategoryRepositoryImpl) List(ctx context.Context) ([]*models.Category, error) {
 	categories := []*Category{}
-	if result := r.db.WithContext(ctx).Find(&categories); result.Error != nil {
+	if result := r.db.WithContext(ctx).Preload("Translations").Find(&categories); result.Error != nil {
 		return nil, DBErrHandler(ctx, result.Error, r.logger)
 	}
 
@@ -156,7 +479,7 @@ func (r *CategoryRepositoryImpl) List(ctx context.Context) ([]*models.Category,
 //   - error: エラー
 func (r *CategoryRepositoryImpl) FindById(ctx context.Context, id string) (*models.Category, error) {
 	category := &Category{}
-	if result := r.db.WithContext(ctx).Where("obj_id = ?", id).First(category); result.Error != nil {
+	if result := r.db.WithContext(ctx).Preload("Translations").Where(fmt.Sprintf("%s = ?", CATEGORY_ID_COLUMN), id).First(category); result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリID: %s が見つかりませんでした", id))
 		}
@@ -166,6 +489,110 @@ func (r *CategoryRepositoryImpl) FindById(ctx context.Context, id string) (*mode
 	return toCategoryModel(category), nil
 }
 
+// FindBySlug はカテゴリをスラッグで検索します。
+// 現在のスラッグで見つからない場合は変更前のスラッグの履歴から検索し、旧URLも解決できるようにします。
+// 返すカテゴリのスラッグは常に現在のスラッグです。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - slug: スラッグ（大文字は小文字として扱う）
+//
+// Returns:
+//   - *models.Category: カテゴリ
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) FindBySlug(ctx context.Context, slug string) (*models.Category, error) {
+	normalized := strings.ToLower(strings.TrimSpace(slug))
+	column, value := CATEGORY_SLUG_COLUMN, normalized
+
+	history := &CategorySlugHistory{}
+	if result := r.db.WithContext(ctx).Where(fmt.Sprintf("%s = ?", SLUG_HISTORY_SLUG_COLUMN), normalized).Limit(1).Find(history); result.Error != nil {
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	} else if result.RowsAffected > 0 {
+		// 履歴にあるスラッグは現在のスラッグとして使用されないため、変更後のカテゴリをIDで検索する
+		column, value = CATEGORY_ID_COLUMN, history.CategoryId
+	}
+
+	category := &Category{}
+	if result := r.db.WithContext(ctx).Preload("Translations").Where(fmt.Sprintf("%s = ?", column), value).First(category); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("スラッグ: %s のカテゴリが見つかりませんでした", slug))
+		}
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	}
+
+	return toCategoryModel(category), nil
+}
+
+// FindChildren は親カテゴリIDで子カテゴリを検索します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - parentId: 親カテゴリID（エンプティの場合はルートカテゴリを検索）
+//
+// Returns:
+//   - []*models.Category: 子カテゴリリスト
+//   - error: 親カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) FindChildren(ctx context.Context, parentId string) ([]*models.Category, error) {
+	query := r.db.WithContext(ctx).Order("id")
+	if parentId == "" {
+		query = query.Where(fmt.Sprintf("%s IS NULL", CATEGORY_PARENT_ID_COLUMN))
+	} else {
+		if _, err := r.FindById(ctx, parentId); err != nil {
+			return nil, err
+		}
+		query = query.Where(fmt.Sprintf("%s = ?", CATEGORY_PARENT_ID_COLUMN), parentId)
+	}
+
+	categories := []*Category{}
+	if result := query.Find(&categories); result.Error != nil {
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	}
+
+	return toCategoryModels(categories), nil
+}
+
+// FindAncestors はルートから指定されたカテゴリまでのカテゴリを、再帰クエリで検索します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: カテゴリID
+//
+// Returns:
+//   - []*models.Category: ルートから指定されたカテゴリまでのカテゴリリスト（末尾が指定されたカテゴリ）
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) FindAncestors(ctx context.Context, id string) ([]*models.Category, error) {
+	categories := []*Category{}
+	if result := r.db.WithContext(ctx).Raw(ancestorsQuery, id).Scan(&categories); result.Error != nil {
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	}
+	if len(categories) == 0 {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリID: %s が見つかりませんでした", id))
+	}
+
+	return toCategoryModels(categories), nil
+}
+
+// FindSubtree は指定されたカテゴリを根とする部分木を、再帰クエリで検索します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: 根となるカテゴリID
+//
+// Returns:
+//   - *models.CategoryNode: 部分木の根ノード
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) FindSubtree(ctx context.Context, id string) (*models.CategoryNode, error) {
+	categories := []*Category{}
+	if result := r.db.WithContext(ctx).Raw(subtreeQuery, id).Scan(&categories); result.Error != nil {
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	}
+	if len(categories) == 0 {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリID: %s が見つかりませんでした", id))
+	}
+
+	return toCategoryTree(toCategoryModels(categories)), nil
+}
+
 func toCategoryModels(categories []*Category) []*models.Category {
 	results := make([]*models.Category, len(categories))
 	for i, c := range categories {
@@ -175,7 +602,77 @@ func toCategoryModels(categories []*Category) []*models.Category {
 }
 
 func toCategoryModel(category *Category) *models.Category {
-	return models.NewCategory(category.ObjId, category.Name)
+	result := models.NewCategory(category.ObjId, category.Name).WithSlug(category.Slug)
+	if category.ParentId != nil {
+		result = result.WithParentId(*category.ParentId)
+	}
+	if len(category.Translations) > 0 {
+		translations := make(models.Translations, len(category.Translations))
+		for _, t := range category.Translations {
+			translations[t.Locale] = t.Name
+		}
+		result = result.WithTranslations(translations)
+	}
+	return result
+}
+
+// toCategoryTree は根からの深さ順に並んだ部分木のカテゴリを木構造に組み立てます。
+// 先頭のカテゴリを根とし、兄弟の順序は与えられた順序を保ちます。
+func toCategoryTree(categories []*models.Category) *models.CategoryNode {
+	children := map[string][]*models.Category{}
+	for _, c := range categories[1:] {
+		children[c.ParentId()] = append(children[c.ParentId()], c)
+	}
+	var build func(category *models.Category) *models.CategoryNode
+	build = func(category *models.Category) *models.CategoryNode {
+		nodes := make([]*models.CategoryNode, 0, len(children[category.Id()]))
+		for _, child := range children[category.Id()] {
+			nodes = append(nodes, build(child))
+		}
+		return models.NewCategoryNode(category, nodes)
+	}
+	return build(categories[0])
 }
 
 var _ repository.CategoryRepository = (*CategoryRepositoryImpl)(nil)
+
+// TagRepositoryImpl はタグリポジトリのGORM実装です。
+type TagRepositoryImpl struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+// NewTagRepositoryImpl はTagRepositoryImplを生成します。
+//
+// Parameters:
+//   - db: データベース接続
+//   - logger: ロガー
+//
+// Returns:
+//   - *TagRepositoryImpl: TagRepositoryImplポインタ
+func NewTagRepositoryImpl(db *gorm.DB, logger *slog.Logger) *TagRepositoryImpl {
+	return &TagRepositoryImpl{db: db, logger: logger}
+}
+
+// ListWithUsage はすべてのタグを、付与された商品数の多い順に取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - []*models.TagUsage: タグと付与された商品数のリスト
+//   - error: エラー
+func (r *TagRepositoryImpl) ListWithUsage(ctx context.Context) ([]*models.TagUsage, error) {
+	usages := []*TagUsage{}
+	if result := r.db.WithContext(ctx).Raw(tagUsageQuery).Scan(&usages); result.Error != nil {
+		return nil, DBErrHandler(ctx, result.Error, r.logger)
+	}
+
+	results := make([]*models.TagUsage, len(usages))
+	for i, u := range usages {
+		results[i] = models.NewTagUsage(models.NewTag(u.ObjId, u.Name), u.ProductCount)
+	}
+	return results, nil
+}
+
+var _ repository.TagRepository = (*TagRepositoryImpl)(nil)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
@@ -55,6 +56,9 @@ func TestProductRepositoryImpl_List(t *testing.T) {
 				require.NoError(t, err)
 				productList := products.([]*models.Product)
 				assert.Greater(t, len(productList), 0, "商品リストが空です")
+				for _, product := range productList {
+					assert.Equal(t, models.PRODUCT_STATUS_PUBLISHED, product.Status(), "公開中の商品のみを返す")
+				}
 				// 最初の商品の詳細を検証
 				if len(productList) > 0 {
 					product := productList[0]
@@ -97,6 +101,31 @@ func TestProductRepositoryImpl_FindById(t *testing.T) {
 				assert.NotEmpty(t, p.Name())
 				assert.Greater(t, p.Price(), uint32(0))
 				assert.NotNil(t, p.Category())
+				// 初期データのバリエーションが登録順に取得できる
+				require.Len(t, p.Variants(), 2)
+				assert.Equal(t, "PEN-BLK-05", p.Variants()[0].Sku())
+				assert.Nil(t, p.Variants()[0].PriceOverride())
+				require.Len(t, p.Variants()[0].Options(), 1)
+				assert.Equal(t, "ボール径", p.Variants()[0].Options()[0].Axis())
+				assert.Equal(t, "0.5mm", p.Variants()[0].Options()[0].Value())
+				require.NotNil(t, p.Variants()[1].PriceOverride())
+				assert.Equal(t, uint32(130), *p.Variants()[1].PriceOverride())
+				assert.Empty(t, p.PriceSchedules())
+			},
+		},
+		{
+			name:      "正常系: 終了した価格スケジュールを除いて予定の価格スケジュールを取得できる",
+			productId: "dc7243af-c2ce-4136-bd5d-c6b28ee0a20a", // 蛍光ペン(黄)
+			assertions: func(t *testing.T, product interface{}, err error) {
+				require.NoError(t, err)
+				p := product.(*models.Product)
+				require.Len(t, p.PriceSchedules(), 1)
+				schedule := p.PriceSchedules()[0]
+				assert.Equal(t, "5e0c2b7a-41d9-4f3e-9a68-0b1d2c3e4f52", schedule.Id())
+				assert.Equal(t, uint32(110), schedule.Price())
+				assert.Nil(t, schedule.RegularPrice())
+				assert.Equal(t, models.PRICE_SCHEDULE_SCHEDULED, schedule.Status())
+				assert.Equal(t, time.Date(2099, 1, 2, 15, 0, 0, 0, time.UTC), schedule.StartsAt())
 			},
 		},
 		{
@@ -113,12 +142,140 @@ func TestProductRepositoryImpl_FindById(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := context.Background()
-			product, err := repo.FindById(ctx, tt.productId)
+			product, err := repo.FindById(ctx, tt.productId, false)
+			tt.assertions(t, product, err)
+		})
+	}
+}
+
+func TestProductRepositoryImpl_FindByBarcode(t *testing.T) {
+	repo := db.NewProductRepositoryImpl(testDBConn, testhelpers.TestLogger)
+
+	tests := []struct {
+		name       string
+		barcode    string
+		assertions func(t *testing.T, product interface{}, err error)
+	}{
+		{
+			name:    "正常系: JAN-13で商品を取得できる",
+			barcode: "4901234567894", // 水性ボールペン(黒)
+			assertions: func(t *testing.T, product interface{}, err error) {
+				require.NoError(t, err)
+				p := product.(*models.Product)
+				assert.Equal(t, "ac413f22-0cf1-490a-9635-7e9ca810e544", p.Id())
+				assert.Equal(t, "4901234567894", p.Barcode())
+				assert.Len(t, p.Variants(), 2)
+			},
+		},
+		{
+			name:    "異常系: 登録されていないバーコードの場合、エラーを返す",
+			barcode: "036000291452",
+			assertions: func(t *testing.T, product interface{}, err error) {
+				require.Error(t, err)
+				assert.Nil(t, product)
+				assert.Contains(t, err.Error(), "NOT_FOUND")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			product, err := repo.FindByBarcode(ctx, tt.barcode)
+			tt.assertions(t, product, err)
+		})
+	}
+}
+
+func TestProductRepositoryImpl_FindBySlug(t *testing.T) {
+	repo := db.NewProductRepositoryImpl(testDBConn, testhelpers.TestLogger)
+
+	tests := []struct {
+		name       string
+		slug       string
+		assertions func(t *testing.T, product interface{}, err error)
+	}{
+		{
+			name: "正常系: 現在のスラッグで商品を取得できる",
+			slug: "Wireless-Mouse",
+			assertions: func(t *testing.T, product interface{}, err error) {
+				require.NoError(t, err)
+				p := product.(*models.Product)
+				assert.Equal(t, "82014174-6785-4242-b307-a806fd1f8470", p.Id())
+				assert.Equal(t, "wireless-mouse", p.Slug())
+			},
+		},
+		{
+			name: "正常系: 変更前のスラッグで商品を取得でき、現在のスラッグを返す",
+			slug: "waiyaresumausu",
+			assertions: func(t *testing.T, product interface{}, err error) {
+				require.NoError(t, err)
+				p := product.(*models.Product)
+				assert.Equal(t, "82014174-6785-4242-b307-a806fd1f8470", p.Id())
+				assert.Equal(t, "wireless-mouse", p.Slug())
+			},
+		},
+		{
+			name: "異常系: 存在しないスラッグの場合、エラーを返す",
+			slug: "fountain-pen",
+			assertions: func(t *testing.T, product interface{}, err error) {
+				require.Error(t, err)
+				assert.Nil(t, product)
+				assert.Contains(t, err.Error(), "NOT_FOUND")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			product, err := repo.FindBySlug(ctx, tt.slug)
 			tt.assertions(t, product, err)
 		})
 	}
 }
 
+func TestProductRepositoryImpl_FindStockByProductId(t *testing.T) {
+	repo := db.NewProductRepositoryImpl(testDBConn, testhelpers.TestLogger)
+
+	tests := []struct {
+		name       string
+		productId  string
+		assertions func(t *testing.T, stock *models.Stock, err error)
+	}{
+		{
+			name:      "正常系: 商品IDで在庫を取得できる",
+			productId: "ac413f22-0cf1-490a-9635-7e9ca810e544", // 実際のテストデータ
+			assertions: func(t *testing.T, stock *models.Stock, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, stock)
+				assert.Equal(t, "ac413f22-0cf1-490a-9635-7e9ca810e544", stock.ProductId())
+				assert.LessOrEqual(t, stock.Reserved(), stock.OnHand())
+				assert.Equal(t, stock.OnHand()-stock.Reserved(), stock.Available())
+			},
+		},
+		{
+			name:      "異常系: 存在しない商品IDの場合、エラーを返す",
+			productId: "non-existent-id",
+			assertions: func(t *testing.T, stock *models.Stock, err error) {
+				require.Error(t, err)
+				assert.Nil(t, stock)
+				var crudErr *errs.CRUDError
+				require.ErrorAs(t, err, &crudErr)
+				assert.Equal(t, "NOT_FOUND", crudErr.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			stock, err := repo.FindStockByProductId(ctx, tt.productId)
+			tt.assertions(t, stock, err)
+		})
+	}
+}
+
 func TestProductRepositoryImpl_FindByNameLike(t *testing.T) {
 	repo := db.NewProductRepositoryImpl(testDBConn, testhelpers.TestLogger)
 
@@ -141,6 +298,19 @@ func TestProductRepositoryImpl_FindByNameLike(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:    "正常系: 商品名の翻訳の部分一致で商品を取得できる",
+			keyword: "Wireless",
+			assertions: func(t *testing.T, products interface{}, err error) {
+				require.NoError(t, err)
+				productList := products.([]*models.Product)
+				require.Len(t, productList, 3)
+				for _, p := range productList {
+					assert.Contains(t, p.Translations()["en"], "Wireless")
+					assert.Equal(t, "PC Peripherals", p.Category().Translations()["en"])
+				}
+			},
+		},
 		{
 			name:    "正常系: 一致する商品がない場合、空のリストを返す",
 			keyword: "存在しないキーワード12345",
@@ -245,3 +415,231 @@ func TestCategoryRepositoryImpl_FindById(t *testing.T) {
 		})
 	}
 }
+
+func TestCategoryRepositoryImpl_FindBySlug(t *testing.T) {
+	repo := db.NewCategoryRepositoryImpl(testDBConn, testhelpers.TestLogger)
+
+	tests := []struct {
+		name       string
+		slug       string
+		assertions func(t *testing.T, category interface{}, err error)
+	}{
+		{
+			name: "正常系: 現在のスラッグでカテゴリを取得できる",
+			slug: "stationery",
+			assertions: func(t *testing.T, category interface{}, err error) {
+				require.NoError(t, err)
+				c := category.(*models.Category)
+				assert.Equal(t, "b1524011-b6af-417e-8bf2-f449dd58b5c0", c.Id())
+				assert.Equal(t, "stationery", c.Slug())
+			},
+		},
+		{
+			name: "正常系: 変更前のスラッグでカテゴリを取得でき、現在のスラッグを返す",
+			slug: "bunbogu",
+			assertions: func(t *testing.T, category interface{}, err error) {
+				require.NoError(t, err)
+				c := category.(*models.Category)
+				assert.Equal(t, "b1524011-b6af-417e-8bf2-f449dd58b5c0", c.Id())
+				assert.Equal(t, "stationery", c.Slug())
+			},
+		},
+		{
+			name: "異常系: 存在しないスラッグの場合、エラーを返す",
+			slug: "furniture",
+			assertions: func(t *testing.T, category interface{}, err error) {
+				require.Error(t, err)
+				assert.Nil(t, category)
+				assert.Contains(t, err.Error(), "NOT_FOUND")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			category, err := repo.FindBySlug(ctx, tt.slug)
+			tt.assertions(t, category, err)
+		})
+	}
+}
+
+func TestProductRepositoryImpl_ListByFilter(t *testing.T) {
+	repo := db.NewProductRepositoryImpl(testDBConn, testhelpers.TestLogger)
+
+	tests := []struct {
+		name               string
+		categoryId         string
+		includeDescendants bool
+		tags               []string
+		assertions         func(t *testing.T, products []*models.Product, err error)
+	}{
+		{
+			name:       "正常系: 指定したカテゴリの商品のみを取得できる",
+			categoryId: "b1524011-b6af-417e-8bf2-f449dd58b5c0", // 文房具
+			assertions: func(t *testing.T, products []*models.Product, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, products)
+				for _, p := range products {
+					assert.Equal(t, "b1524011-b6af-417e-8bf2-f449dd58b5c0", p.Category().Id())
+				}
+			},
+		},
+		{
+			name:               "正常系: 子孫カテゴリの商品も取得できる",
+			categoryId:         "b1524011-b6af-417e-8bf2-f449dd58b5c0", // 文房具 > 筆記具 > 鉛筆
+			includeDescendants: true,
+			assertions: func(t *testing.T, products []*models.Product, err error) {
+				require.NoError(t, err)
+				categoryIds := map[string]bool{}
+				for _, p := range products {
+					categoryIds[p.Category().Id()] = true
+				}
+				assert.True(t, categoryIds["b1524011-b6af-417e-8bf2-f449dd58b5c0"])
+				assert.True(t, categoryIds["a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64"], "子孫カテゴリ（鉛筆）の商品が含まれていません")
+			},
+		},
+		{
+			name: "正常系: 指定したタグが付与された商品のみを取得できる",
+			tags: []string{"ワイヤレス"},
+			assertions: func(t *testing.T, products []*models.Product, err error) {
+				require.NoError(t, err)
+				require.Len(t, products, 3)
+				for _, p := range products {
+					require.NotEmpty(t, p.Tags())
+					names := []string{}
+					for _, tag := range p.Tags() {
+						names = append(names, tag.Name())
+					}
+					assert.Contains(t, names, "ワイヤレス")
+				}
+			},
+		},
+		{
+			name: "正常系: 複数のタグを指定した場合、すべてのタグが付与された商品のみを取得する",
+			tags: []string{"ワイヤレス", "ゲーミング"},
+			assertions: func(t *testing.T, products []*models.Product, err error) {
+				require.NoError(t, err)
+				assert.Empty(t, products, "ワイヤレスとゲーミングの両方が付与された商品はありません")
+			},
+		},
+		{
+			name:       "正常系: カテゴリとタグの両方の条件を満たす商品のみを取得する",
+			categoryId: "c05b1952-3bdf-4449-9b83-d0d123a667ce", // パソコン周辺機器
+			tags:       []string{"ゲーミング"},
+			assertions: func(t *testing.T, products []*models.Product, err error) {
+				require.NoError(t, err)
+				assert.Len(t, products, 2)
+			},
+		},
+		{
+			name:       "異常系: 存在しないカテゴリIDの場合、エラーを返す",
+			categoryId: "non-existent-category-id",
+			assertions: func(t *testing.T, products []*models.Product, err error) {
+				require.Error(t, err)
+				assert.Nil(t, products)
+				assert.Contains(t, err.Error(), "NOT_FOUND")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			filter := models.NewProductFilter().WithTags(tt.tags)
+			if tt.categoryId != "" {
+				filter = filter.WithCategory(tt.categoryId, tt.includeDescendants)
+			}
+			products, err := repo.ListByFilter(ctx, filter)
+			tt.assertions(t, products, err)
+		})
+	}
+}
+
+func TestCategoryRepositoryImpl_FindChildren(t *testing.T) {
+	repo := db.NewCategoryRepositoryImpl(testDBConn, testhelpers.TestLogger)
+
+	t.Run("正常系: 親カテゴリ未指定の場合、ルートカテゴリを取得できる", func(t *testing.T) {
+		categories, err := repo.FindChildren(context.Background(), "")
+		require.NoError(t, err)
+		require.NotEmpty(t, categories)
+		for _, c := range categories {
+			assert.True(t, c.IsRoot())
+		}
+	})
+
+	t.Run("正常系: 子カテゴリを取得できる", func(t *testing.T) {
+		categories, err := repo.FindChildren(context.Background(), "b1524011-b6af-417e-8bf2-f449dd58b5c0")
+		require.NoError(t, err)
+		require.Len(t, categories, 1)
+		assert.Equal(t, "筆記具", categories[0].Name())
+		assert.Equal(t, "b1524011-b6af-417e-8bf2-f449dd58b5c0", categories[0].ParentId())
+	})
+
+	t.Run("異常系: 存在しない親カテゴリIDの場合、エラーを返す", func(t *testing.T) {
+		categories, err := repo.FindChildren(context.Background(), "non-existent-category-id")
+		require.Error(t, err)
+		assert.Nil(t, categories)
+		assert.Contains(t, err.Error(), "NOT_FOUND")
+	})
+}
+
+func TestCategoryRepositoryImpl_FindAncestors(t *testing.T) {
+	repo := db.NewCategoryRepositoryImpl(testDBConn, testhelpers.TestLogger)
+
+	t.Run("正常系: ルートから指定カテゴリまでのカテゴリを取得できる", func(t *testing.T) {
+		categories, err := repo.FindAncestors(context.Background(), "a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64")
+		require.NoError(t, err)
+		names := make([]string, 0, len(categories))
+		for _, c := range categories {
+			names = append(names, c.Name())
+		}
+		assert.Equal(t, []string{"文房具", "筆記具", "鉛筆"}, names)
+	})
+
+	t.Run("異常系: 存在しないカテゴリIDの場合、エラーを返す", func(t *testing.T) {
+		categories, err := repo.FindAncestors(context.Background(), "non-existent-category-id")
+		require.Error(t, err)
+		assert.Nil(t, categories)
+		assert.Contains(t, err.Error(), "NOT_FOUND")
+	})
+}
+
+func TestCategoryRepositoryImpl_FindSubtree(t *testing.T) {
+	repo := db.NewCategoryRepositoryImpl(testDBConn, testhelpers.TestLogger)
+
+	t.Run("正常系: 部分木を取得できる", func(t *testing.T) {
+		root, err := repo.FindSubtree(context.Background(), "b1524011-b6af-417e-8bf2-f449dd58b5c0")
+		require.NoError(t, err)
+		assert.Equal(t, "文房具", root.Category().Name())
+		require.Len(t, root.Children(), 1)
+		assert.Equal(t, "筆記具", root.Children()[0].Category().Name())
+		require.Len(t, root.Children()[0].Children(), 1)
+		assert.Equal(t, "鉛筆", root.Children()[0].Children()[0].Category().Name())
+		assert.Empty(t, root.Children()[0].Children()[0].Children())
+	})
+
+	t.Run("異常系: 存在しないカテゴリIDの場合、エラーを返す", func(t *testing.T) {
+		root, err := repo.FindSubtree(context.Background(), "non-existent-category-id")
+		require.Error(t, err)
+		assert.Nil(t, root)
+		assert.Contains(t, err.Error(), "NOT_FOUND")
+	})
+}
+
+func TestTagRepositoryImpl_ListWithUsage(t *testing.T) {
+	repo := db.NewTagRepositoryImpl(testDBConn, testhelpers.TestLogger)
+
+	usages, err := repo.ListWithUsage(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(usages), 2)
+	counts := map[string]uint32{}
+	for _, u := range usages {
+		counts[u.Tag().Name()] = u.ProductCount()
+	}
+	assert.Equal(t, uint32(3), counts["ワイヤレス"])
+	assert.Equal(t, uint32(2), counts["ゲーミング"])
+	for i := 1; i < len(usages); i++ {
+		assert.GreaterOrEqual(t, usages[i-1].ProductCount(), usages[i].ProductCount(), "付与された商品数の多い順に並んでいません")
+	}
+}
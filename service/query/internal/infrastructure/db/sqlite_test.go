@@ -0,0 +1,349 @@
+package db_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/db"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/testhelpers"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const (
+	sqliteStationeryId = "b1524011-b6af-417e-8bf2-f449dd58b5c0"
+	sqlitePencilId     = "a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64"
+	sqlitePenId        = "ac413f22-0cf1-490a-9635-7e9ca810e544"
+	sqliteMarkerId     = "dc7243af-c2ce-4136-bd5d-c6b28ee0a20a"
+	sqliteDraftId      = "3f0e5c8a-9d21-4b7e-8c64-1a2b3c4d5e6f"
+)
+
+func TestNewSQLiteDBConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		path       string
+		assertions func(t *testing.T, cfg *db.DBConfig, err error)
+	}{
+		{
+			name: "正常系: SQLiteのファイルパスを読み込める",
+			path: "query.sqlite3",
+			assertions: func(t *testing.T, cfg *db.DBConfig, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, db.DRIVER_SQLITE, cfg.Driver)
+				assert.Equal(t, "query.sqlite3", cfg.Path)
+				assert.Equal(t, "info", cfg.LogLevel)
+			},
+		},
+		{
+			name: "異常系: ファイルパスが空の場合、エラーを返す",
+			path: "",
+			assertions: func(t *testing.T, cfg *db.DBConfig, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "sqlite.path")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v := viper.New()
+			v.Set("sqlite.path", tt.path)
+			v.Set("log.level", "info")
+			cfg, err := db.NewSQLiteDBConfig(v)
+			tt.assertions(t, cfg, err)
+		})
+	}
+}
+
+// newSQLiteSnapshot はSQLiteの読み取りモデルに投入するテストデータを生成します。
+func newSQLiteSnapshot() *db.Snapshot {
+	stationeryId := sqliteStationeryId
+	overridden := uint32(130)
+	regular := uint32(150)
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	return &db.Snapshot{
+		Categories: []*db.Category{
+			{Id: 1, ObjId: sqliteStationeryId, Name: "文房具", Slug: "stationery"},
+			{Id: 2, ObjId: sqlitePencilId, Name: "鉛筆", ParentId: &stationeryId, Slug: "pencil"},
+		},
+		CategoryTranslations: []*db.CategoryNameTranslation{
+			{CategoryId: sqliteStationeryId, Locale: "en", Name: "Stationery"},
+		},
+		CategorySlugHistories: []*db.CategorySlugHistory{
+			{Slug: "bungu", CategoryId: sqliteStationeryId, CreatedAt: time.Date(2026, 1, 1, 9, 0, 0, 0, jst)},
+		},
+		Products: []*db.Product{
+			{Id: 1, ObjId: sqlitePenId, Name: "ボールペン", Price: 120, Currency: "JPY", TaxClass: "standard", Status: models.PRODUCT_STATUS_PUBLISHED, CategoryId: sqliteStationeryId, Slug: "ballpoint-pen"},
+			{Id: 2, ObjId: sqliteMarkerId, Name: "蛍光ペン(黄)", Price: 150, Currency: "JPY", TaxClass: "standard", Status: models.PRODUCT_STATUS_PUBLISHED, CategoryId: sqlitePencilId, Slug: "marker-yellow"},
+			{Id: 3, ObjId: sqliteDraftId, Name: "下書きのペン", Price: 100, Currency: "JPY", TaxClass: "standard", Status: "DRAFT", CategoryId: sqliteStationeryId, Slug: "draft-pen"},
+		},
+		ProductTranslations: []*db.ProductNameTranslation{
+			{ProductId: sqlitePenId, Locale: "en", Name: "Ballpoint Pen"},
+		},
+		ProductSlugHistories: []*db.ProductSlugHistory{
+			{Slug: "pen", ProductId: sqlitePenId, CreatedAt: time.Date(2026, 1, 1, 9, 0, 0, 0, jst)},
+		},
+		Stocks: []*db.Stock{
+			{Id: 1, ProductId: sqlitePenId, OnHand: 10, Reserved: 2},
+		},
+		Variants: []*db.ProductVariant{
+			{Id: 1, ObjId: "0b6f1c2d-3e4f-4a5b-8c6d-7e8f9a0b1c2d", ProductId: sqlitePenId, Sku: "PEN-BLK-05", Options: `[{"axis":"ボール径","value":"0.5mm"}]`, Status: "active"},
+			{Id: 2, ObjId: "1c7a2d3e-4f5a-4b6c-9d7e-8f9a0b1c2d3e", ProductId: sqlitePenId, Sku: "PEN-BLK-07", Options: `[{"axis":"ボール径","value":"0.7mm"}]`, PriceOverride: &overridden, Status: "active"},
+		},
+		PriceSchedules: []*db.ProductPriceSchedule{
+			{Id: 1, ObjId: "4d9b1a69-30c8-4e2d-8957-fa0c1b2d3e41", ProductId: sqliteMarkerId, Price: 100, RegularPrice: &regular, Status: "ENDED",
+				StartsAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), EndsAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+			{Id: 2, ObjId: "5e0c2b7a-41d9-4f3e-9a68-0b1d2c3e4f52", ProductId: sqliteMarkerId, Price: 110, Status: models.PRICE_SCHEDULE_SCHEDULED,
+				StartsAt: time.Date(2099, 1, 3, 0, 0, 0, 0, jst), EndsAt: time.Date(2099, 1, 10, 0, 0, 0, 0, jst)},
+		},
+		Tags: []*db.Tag{
+			{Id: 1, ObjId: "6f1d3c8b-52e0-4a4f-8b79-1c2e3d4f5a63", Name: "定番"},
+			{Id: 2, ObjId: "7a2e4d9c-63f1-4b5a-9c8a-2d3f4e5a6b74", Name: "新商品"},
+		},
+		ProductTags: []*db.ProductTag{
+			{ProductId: sqlitePenId, TagId: "6f1d3c8b-52e0-4a4f-8b79-1c2e3d4f5a63"},
+			{ProductId: sqliteMarkerId, TagId: "6f1d3c8b-52e0-4a4f-8b79-1c2e3d4f5a63"},
+			{ProductId: sqliteMarkerId, TagId: "7a2e4d9c-63f1-4b5a-9c8a-2d3f4e5a6b74"},
+		},
+	}
+}
+
+// setupSQLite はテスト用のSQLiteの読み取りモデルを作成し、テストデータを投入します。
+func setupSQLite(t *testing.T) *gorm.DB {
+	t.Helper()
+	conn, err := db.NewDatabase(&db.DBConfig{
+		Driver:   db.DRIVER_SQLITE,
+		Path:     filepath.Join(t.TempDir(), "query.sqlite3"),
+		LogLevel: "info",
+	}, testhelpers.TestLogger)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := conn.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	require.NoError(t, db.LoadSnapshot(context.Background(), conn, newSQLiteSnapshot()))
+	return conn
+}
+
+func TestSQLite_ProductRepository(t *testing.T) {
+	conn := setupSQLite(t)
+	repo := db.NewProductRepositoryImpl(conn, testhelpers.TestLogger)
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		assertions func(t *testing.T)
+	}{
+		{
+			name: "正常系: 公開中の商品のみを一覧できる",
+			assertions: func(t *testing.T) {
+				products, err := repo.List(ctx)
+				require.NoError(t, err)
+				require.Len(t, products, 2)
+				for _, p := range products {
+					assert.Equal(t, models.PRODUCT_STATUS_PUBLISHED, p.Status())
+					assert.NotEmpty(t, p.Category().Name())
+				}
+			},
+		},
+		{
+			name: "正常系: バリエーションを登録順に取得できる",
+			assertions: func(t *testing.T) {
+				p, err := repo.FindById(ctx, sqlitePenId, false)
+				require.NoError(t, err)
+				require.Len(t, p.Variants(), 2)
+				assert.Equal(t, "PEN-BLK-05", p.Variants()[0].Sku())
+				assert.Equal(t, "0.5mm", p.Variants()[0].Options()[0].Value())
+				require.NotNil(t, p.Variants()[1].PriceOverride())
+				assert.Equal(t, uint32(130), *p.Variants()[1].PriceOverride())
+				stock, err := repo.FindStockByProductId(ctx, sqlitePenId)
+				require.NoError(t, err)
+				assert.Equal(t, uint32(8), stock.Available())
+			},
+		},
+		{
+			name: "正常系: 終了した価格スケジュールを除き、開始日時をUTCで取得できる",
+			assertions: func(t *testing.T) {
+				p, err := repo.FindById(ctx, sqliteMarkerId, false)
+				require.NoError(t, err)
+				require.Len(t, p.PriceSchedules(), 1)
+				schedule := p.PriceSchedules()[0]
+				assert.Equal(t, "5e0c2b7a-41d9-4f3e-9a68-0b1d2c3e4f52", schedule.Id())
+				assert.Equal(t, time.Date(2099, 1, 2, 15, 0, 0, 0, time.UTC), schedule.StartsAt())
+			},
+		},
+		{
+			name: "正常系: 翻訳された商品名でも部分一致で検索できる",
+			assertions: func(t *testing.T) {
+				products, err := repo.FindByNameLike(ctx, "Ballpoint")
+				require.NoError(t, err)
+				require.Len(t, products, 1)
+				assert.Equal(t, sqlitePenId, products[0].Id())
+			},
+		},
+		{
+			name: "正常系: 子孫カテゴリとタグで絞り込める",
+			assertions: func(t *testing.T) {
+				filter := models.NewProductFilter().WithCategory(sqliteStationeryId, true).WithTags([]string{"定番", "新商品"})
+				products, err := repo.ListByFilter(ctx, filter)
+				require.NoError(t, err)
+				require.Len(t, products, 1)
+				assert.Equal(t, sqliteMarkerId, products[0].Id())
+				require.Len(t, products[0].Tags(), 2)
+			},
+		},
+		{
+			name: "正常系: 変更前のスラッグでも商品を取得できる",
+			assertions: func(t *testing.T) {
+				p, err := repo.FindBySlug(ctx, "pen")
+				require.NoError(t, err)
+				assert.Equal(t, sqlitePenId, p.Id())
+				assert.Equal(t, "ballpoint-pen", p.Slug())
+			},
+		},
+		{
+			name: "異常系: 公開中以外の商品は商品IDで取得できない",
+			assertions: func(t *testing.T) {
+				p, err := repo.FindById(ctx, sqliteDraftId, false)
+				require.Error(t, err)
+				assert.Nil(t, p)
+				assert.Contains(t, err.Error(), "NOT_FOUND")
+			},
+		},
+		{
+			name: "正常系: 管理用に公開中以外の商品も商品IDで取得できる",
+			assertions: func(t *testing.T) {
+				p, err := repo.FindById(ctx, sqliteDraftId, true)
+				require.NoError(t, err)
+				assert.Equal(t, "DRAFT", p.Status())
+			},
+		},
+		{
+			name: "異常系: 存在しない商品IDの場合、NOT_FOUNDを返す",
+			assertions: func(t *testing.T) {
+				p, err := repo.FindById(ctx, "non-existent-id", false)
+				require.Error(t, err)
+				assert.Nil(t, p)
+				assert.Contains(t, err.Error(), "NOT_FOUND")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, tt.assertions)
+	}
+}
+
+func TestSQLite_CategoryAndTagRepository(t *testing.T) {
+	conn := setupSQLite(t)
+	categoryRepo := db.NewCategoryRepositoryImpl(conn, testhelpers.TestLogger)
+	tagRepo := db.NewTagRepositoryImpl(conn, testhelpers.TestLogger)
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		assertions func(t *testing.T)
+	}{
+		{
+			name: "正常系: 翻訳を含めてカテゴリを取得できる",
+			assertions: func(t *testing.T) {
+				category, err := categoryRepo.FindById(ctx, sqliteStationeryId)
+				require.NoError(t, err)
+				assert.Equal(t, "文房具", category.Name())
+				assert.True(t, category.IsRoot())
+				assert.Equal(t, "Stationery", category.Localize([]string{"en"}).Name())
+			},
+		},
+		{
+			name: "正常系: 変更前のスラッグでもカテゴリを取得できる",
+			assertions: func(t *testing.T) {
+				category, err := categoryRepo.FindBySlug(ctx, "bungu")
+				require.NoError(t, err)
+				assert.Equal(t, sqliteStationeryId, category.Id())
+			},
+		},
+		{
+			name: "正常系: 再帰クエリで祖先とサブツリーを取得できる",
+			assertions: func(t *testing.T) {
+				ancestors, err := categoryRepo.FindAncestors(ctx, sqlitePencilId)
+				require.NoError(t, err)
+				require.Len(t, ancestors, 2)
+				assert.Equal(t, sqliteStationeryId, ancestors[0].Id())
+
+				tree, err := categoryRepo.FindSubtree(ctx, sqliteStationeryId)
+				require.NoError(t, err)
+				require.Len(t, tree.Children(), 1)
+				assert.Equal(t, sqlitePencilId, tree.Children()[0].Category().Id())
+			},
+		},
+		{
+			name: "正常系: タグを付与された商品数の多い順に取得できる",
+			assertions: func(t *testing.T) {
+				usages, err := tagRepo.ListWithUsage(ctx)
+				require.NoError(t, err)
+				require.Len(t, usages, 2)
+				assert.Equal(t, "定番", usages[0].Tag().Name())
+				assert.Equal(t, uint32(2), usages[0].ProductCount())
+				assert.Equal(t, uint32(1), usages[1].ProductCount())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, tt.assertions)
+	}
+}
+
+func TestSQLite_LoadSnapshot(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: エクスポートファイルから読み込んだ行で置き換える", func(t *testing.T) {
+		conn := setupSQLite(t)
+
+		path := filepath.Join(t.TempDir(), "snapshot.json")
+		exported, err := db.ReadSnapshot(ctx, conn)
+		require.NoError(t, err)
+		exported.Products = exported.Products[:1]
+		exported.Stocks = nil
+		require.NoError(t, db.WriteSnapshotFile(path, exported))
+
+		snapshot, err := db.ReadSnapshotFile(path)
+		require.NoError(t, err)
+		require.NoError(t, db.LoadSnapshot(ctx, conn, snapshot))
+
+		reloaded, err := db.ReadSnapshot(ctx, conn)
+		require.NoError(t, err)
+		require.Len(t, reloaded.Products, 1)
+		assert.Equal(t, sqlitePenId, reloaded.Products[0].ObjId)
+		assert.Empty(t, reloaded.Stocks)
+		assert.Len(t, reloaded.Variants, 2)
+		require.Len(t, reloaded.PriceSchedules, 2)
+		assert.Equal(t, time.Date(2099, 1, 2, 15, 0, 0, 0, time.UTC), reloaded.PriceSchedules[1].StartsAt.UTC())
+	})
+
+	t.Run("異常系: エクスポートファイルが不正な場合、エラーを返す", func(t *testing.T) {
+		_, err := db.ReadSnapshotFile(filepath.Join(t.TempDir(), "missing.json"))
+		require.Error(t, err)
+	})
+
+	t.Run("異常系: SQLiteのエラーはDB_DRIVER_ERRORに変換する", func(t *testing.T) {
+		conn := setupSQLite(t)
+		result := conn.Exec("SELECT * FROM no_such_table")
+		require.Error(t, result.Error)
+
+		err := db.DBErrHandler(ctx, result.Error, testhelpers.TestLogger)
+		var internalErr *errs.InternalError
+		require.ErrorAs(t, err, &internalErr)
+		assert.Equal(t, "DB_DRIVER_ERROR", internalErr.Code)
+	})
+}
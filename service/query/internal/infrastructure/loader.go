@@ -0,0 +1,129 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/config"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/db"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+// LoadUsage はloadサブコマンドとexportサブコマンドの使い方です。
+const LoadUsage = `usage:
+  load           MySQL（[mysql]の接続先）からSQLiteの読み取りモデル（[sqlite].path）へ投入する
+  load <file>    エクスポートファイルからSQLiteの読み取りモデルへ投入する
+  export <file>  MySQL（[mysql]の接続先）の読み取りモデルをエクスポートファイルに書き出す
+`
+
+// RunLoad はloadサブコマンドを実行します。
+// 引数がない場合はMySQLから、ファイルを指定した場合はエクスポートファイルから読み込み、SQLiteの読み取りモデルのすべての行を置き換えます。
+// コマンドDBから読み込む場合は、環境変数DB_HOST・DB_PORTで接続先をコマンドDBに変更してください。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - configPath: 設定ファイルのディレクトリパス
+//   - configName: 設定ファイル名（拡張子なし）
+//   - args: サブコマンド以降の引数（空、またはエクスポートファイルのパス）
+//   - w: 結果の出力先
+//
+// Returns:
+//   - error: 引数が不正な場合、または読み込み・書き込みに失敗した場合のエラー
+func RunLoad(ctx context.Context, configPath string, configName string, args []string, w io.Writer) error {
+	if len(args) > 1 {
+		return fmt.Errorf("load takes at most one argument\n%s", LoadUsage)
+	}
+	v := config.NewViper(configPath, configName)
+	logger, err := log.NewLogger(v)
+	if err != nil {
+		return err
+	}
+
+	var snapshot *db.Snapshot
+	if len(args) == 1 {
+		snapshot, err = db.ReadSnapshotFile(args[0])
+	} else {
+		snapshot, err = readSnapshotFromMySQL(ctx, v, logger)
+	}
+	if err != nil {
+		return err
+	}
+
+	dbConfig, err := db.NewSQLiteDBConfig(v)
+	if err != nil {
+		return err
+	}
+	conn, err := db.NewDatabase(dbConfig, logger)
+	if err != nil {
+		return err
+	}
+	defer closeDatabase(conn)
+	if err := db.LoadSnapshot(ctx, conn, snapshot); err != nil {
+		return db.DBErrHandler(ctx, err, logger)
+	}
+	_, err = fmt.Fprintf(w, "loaded %d categories, %d products and %d tags into %s\n",
+		len(snapshot.Categories), len(snapshot.Products), len(snapshot.Tags), dbConfig.Path)
+	return err
+}
+
+// RunExport はexportサブコマンドを実行します。
+// MySQLから読み取りモデルのすべての行を読み込み、エクスポートファイル（JSON）に書き出します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - configPath: 設定ファイルのディレクトリパス
+//   - configName: 設定ファイル名（拡張子なし）
+//   - args: サブコマンド以降の引数（エクスポートファイルのパス）
+//   - w: 結果の出力先
+//
+// Returns:
+//   - error: 引数が不正な場合、または読み込み・書き込みに失敗した場合のエラー
+func RunExport(ctx context.Context, configPath string, configName string, args []string, w io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("export takes exactly one argument\n%s", LoadUsage)
+	}
+	v := config.NewViper(configPath, configName)
+	logger, err := log.NewLogger(v)
+	if err != nil {
+		return err
+	}
+	snapshot, err := readSnapshotFromMySQL(ctx, v, logger)
+	if err != nil {
+		return err
+	}
+	if err := db.WriteSnapshotFile(args[0], snapshot); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "exported %d categories, %d products and %d tags to %s\n",
+		len(snapshot.Categories), len(snapshot.Products), len(snapshot.Tags), args[0])
+	return err
+}
+
+// readSnapshotFromMySQL はMySQL（[mysql]の接続先）から読み取りモデルのすべての行を読み込みます。
+func readSnapshotFromMySQL(ctx context.Context, v *viper.Viper, logger *slog.Logger) (*db.Snapshot, error) {
+	dbConfig, err := db.NewDBConfig(v)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := db.NewDatabase(dbConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	defer closeDatabase(conn)
+	snapshot, err := db.ReadSnapshot(ctx, conn)
+	if err != nil {
+		return nil, db.DBErrHandler(ctx, err, logger)
+	}
+	return snapshot, nil
+}
+
+// closeDatabase はデータベース接続をクローズします。
+func closeDatabase(conn *gorm.DB) {
+	if sqlDB, err := conn.DB(); err == nil {
+		_ = sqlDB.Close()
+	}
+}
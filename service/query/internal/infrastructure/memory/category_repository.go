@@ -0,0 +1,177 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/repository"
+)
+
+// CategoryRepositoryImpl はカテゴリリポジトリのインメモリ実装です。
+type CategoryRepositoryImpl struct {
+	store  *Store
+	logger *slog.Logger
+}
+
+// NewCategoryRepositoryImpl はCategoryRepositoryImplを生成します。
+//
+// Parameters:
+//   - store: データストア
+//   - logger: ロガー
+//
+// Returns:
+//   - *CategoryRepositoryImpl: CategoryRepositoryImplポインタ
+func NewCategoryRepositoryImpl(store *Store, logger *slog.Logger) *CategoryRepositoryImpl {
+	return &CategoryRepositoryImpl{store: store, logger: logger}
+}
+
+// List はすべてのカテゴリを登録順に取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - []*models.Category: カテゴリリスト
+//   - error: エラー
+func (r *CategoryRepositoryImpl) List(ctx context.Context) ([]*models.Category, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	return toCategoryModels(r.store.categories), nil
+}
+
+// FindById はカテゴリIDでカテゴリを検索します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: カテゴリID
+//
+// Returns:
+//   - *models.Category: カテゴリ
+//   - error: エラー
+func (r *CategoryRepositoryImpl) FindById(ctx context.Context, id string) (*models.Category, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	category, ok := r.store.findCategory(id)
+	if !ok {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリID: %s が見つかりませんでした", id))
+	}
+	return category.toCategoryModel(), nil
+}
+
+// FindBySlug はカテゴリをスラッグで検索します。
+// 現在のスラッグで見つからない場合は変更前のスラッグの履歴から検索します。返すカテゴリのスラッグは常に現在のスラッグです。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - slug: スラッグ（大文字は小文字として扱う）
+//
+// Returns:
+//   - *models.Category: カテゴリ
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) FindBySlug(ctx context.Context, slug string) (*models.Category, error) {
+	normalized := strings.ToLower(strings.TrimSpace(slug))
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	match := func(c *categoryRow) bool { return c.slug == normalized }
+	if id, ok := r.store.categorySlugHistory[normalized]; ok {
+		// 履歴にあるスラッグは現在のスラッグとして使用されないため、変更後のカテゴリをIDで検索する
+		match = func(c *categoryRow) bool { return c.id == id }
+	}
+	for _, c := range r.store.categories {
+		if match(c) {
+			return c.toCategoryModel(), nil
+		}
+	}
+	return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("スラッグ: %s のカテゴリが見つかりませんでした", slug))
+}
+
+// FindChildren は親カテゴリIDで子カテゴリを登録順に検索します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - parentId: 親カテゴリID（エンプティの場合はルートカテゴリを検索）
+//
+// Returns:
+//   - []*models.Category: 子カテゴリリスト
+//   - error: 親カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) FindChildren(ctx context.Context, parentId string) ([]*models.Category, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	if parentId != "" {
+		if _, ok := r.store.findCategory(parentId); !ok {
+			return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリID: %s が見つかりませんでした", parentId))
+		}
+	}
+	children := []*categoryRow{}
+	for _, c := range r.store.categories {
+		if c.parentId == parentId {
+			children = append(children, c)
+		}
+	}
+	return toCategoryModels(children), nil
+}
+
+// FindAncestors はルートから指定されたカテゴリまでのカテゴリを検索します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: カテゴリID
+//
+// Returns:
+//   - []*models.Category: ルートから指定されたカテゴリまでのカテゴリリスト（末尾が指定されたカテゴリ）
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) FindAncestors(ctx context.Context, id string) ([]*models.Category, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	path := []*categoryRow{}
+	for current, ok := r.store.findCategory(id); ok; current, ok = r.store.findCategory(current.parentId) {
+		path = append([]*categoryRow{current}, path...)
+	}
+	if len(path) == 0 {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリID: %s が見つかりませんでした", id))
+	}
+	return toCategoryModels(path), nil
+}
+
+// FindSubtree は指定されたカテゴリを根とする部分木を検索します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: 根となるカテゴリID
+//
+// Returns:
+//   - *models.CategoryNode: 部分木の根ノード
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *CategoryRepositoryImpl) FindSubtree(ctx context.Context, id string) (*models.CategoryNode, error) {
+	r.store.mu.RLock()
+	rows := r.store.subtree(id)
+	r.store.mu.RUnlock()
+	if len(rows) == 0 {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリID: %s が見つかりませんでした", id))
+	}
+
+	children := map[string][]*categoryRow{}
+	for _, c := range rows[1:] {
+		children[c.parentId] = append(children[c.parentId], c)
+	}
+	var build func(c *categoryRow) *models.CategoryNode
+	build = func(c *categoryRow) *models.CategoryNode {
+		nodes := make([]*models.CategoryNode, 0, len(children[c.id]))
+		for _, child := range children[c.id] {
+			nodes = append(nodes, build(child))
+		}
+		return models.NewCategoryNode(c.toCategoryModel(), nodes)
+	}
+	return build(rows[0]), nil
+}
+
+var _ repository.CategoryRepository = (*CategoryRepositoryImpl)(nil)
@@ -0,0 +1,44 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/repository"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+)
+
+func TestModule_MemoryBackend(t *testing.T) {
+	// viperの環境変数による上書きでインメモリの実装を選択する
+	t.Setenv("REPOSITORY_BACKEND", "memory")
+
+	var (
+		productRepo  repository.ProductRepository
+		searchEngine repository.SearchEngine
+	)
+	app := fx.New(
+		fx.Supply(
+			fx.Annotate("../../../", fx.ResultTags(`name:"configPath"`)),
+			fx.Annotate("config", fx.ResultTags(`name:"configName"`)),
+		),
+		infrastructure.Module,
+		fx.Populate(&productRepo, &searchEngine),
+		fx.NopLogger,
+	)
+	require.NoError(t, app.Err(), "DBなしでfx appを初期化できませんでした")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, app.Start(ctx))
+	defer func() {
+		assert.NoError(t, app.Stop(ctx))
+	}()
+
+	products, err := productRepo.List(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, products, "サンプルデータが投入されていません")
+}
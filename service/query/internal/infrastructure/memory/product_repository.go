@@ -0,0 +1,280 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/repository"
+)
+
+// ProductRepositoryImpl は商品リポジトリのインメモリ実装です。
+// 検索条件と並び順はGORMの実装と同じです。
+type ProductRepositoryImpl struct {
+	store  *Store
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewProductRepositoryImpl はProductRepositoryImplを生成します。
+//
+// Parameters:
+//   - store: データストア
+//   - logger: ロガー
+//
+// Returns:
+//   - *ProductRepositoryImpl: ProductRepositoryImplポインタ
+func NewProductRepositoryImpl(store *Store, logger *slog.Logger) *ProductRepositoryImpl {
+	return &ProductRepositoryImpl{store: store, logger: logger, now: func() time.Time { return time.Now().UTC() }}
+}
+
+// List は公開中のすべての商品を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - []*models.Product: 商品リスト
+//   - error: エラー
+func (r *ProductRepositoryImpl) List(ctx context.Context) ([]*models.Product, error) {
+	return r.listPublished(func(p *productRow) bool { return true }), nil
+}
+
+// FindById は商品IDで公開中の商品を検索します。
+// 一覧・検索と異なり、バリエーションと、終了時刻前の適用中および予定の価格スケジュールを開始時刻順に取得します。
+// includeUnpublishedがtrueの場合は公開中以外の商品も返します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - id: 商品ID
+//   - includeUnpublished: 公開中以外の商品も返す場合はtrue
+//
+// Returns:
+//   - *models.Product: 商品
+//   - error: 商品が存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) FindById(ctx context.Context, id string, includeUnpublished bool) (*models.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	product, ok := r.store.findProduct(func(p *productRow) bool { return (includeUnpublished || isPublished(p)) && p.id == id })
+	if !ok {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("商品ID: %s が見つかりませんでした", id))
+	}
+
+	now := r.now()
+	schedules := []*models.PriceSchedule{}
+	for _, s := range product.schedules {
+		pending := s.Status() == models.PRICE_SCHEDULE_SCHEDULED || s.Status() == models.PRICE_SCHEDULE_ACTIVE
+		if pending && s.EndsAt().After(now) {
+			schedules = append(schedules, s)
+		}
+	}
+	slices.SortStableFunc(schedules, func(a, b *models.PriceSchedule) int { return a.StartsAt().Compare(b.StartsAt()) })
+	return r.store.toProductModel(product).WithVariants(product.variants).WithPriceSchedules(schedules), nil
+}
+
+// FindByBarcode は公開中の商品をバーコードで検索します。
+// UPC-A（12桁）はコマンドサービスと同じく先頭に0を付けたEAN-13として検索します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - barcode: JAN/EAN/UPCバーコード
+//
+// Returns:
+//   - *models.Product: 商品
+//   - error: 公開中の商品が存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) FindByBarcode(ctx context.Context, barcode string) (*models.Product, error) {
+	normalized := strings.TrimSpace(barcode)
+	if len(normalized) == 12 {
+		normalized = "0" + normalized
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	product, ok := r.store.findProduct(func(p *productRow) bool { return isPublished(p) && p.barcode == normalized })
+	if !ok {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("バーコード: %s の商品が見つかりませんでした", barcode))
+	}
+	return r.store.toProductModel(product).WithVariants(product.variants), nil
+}
+
+// FindBySlug は公開中の商品をスラッグで検索します。
+// 現在のスラッグで見つからない場合は変更前のスラッグの履歴から検索します。返す商品のスラッグは常に現在のスラッグです。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - slug: スラッグ（大文字は小文字として扱う）
+//
+// Returns:
+//   - *models.Product: 商品
+//   - error: 公開中の商品が存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) FindBySlug(ctx context.Context, slug string) (*models.Product, error) {
+	normalized := strings.ToLower(strings.TrimSpace(slug))
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	match := func(p *productRow) bool { return p.slug == normalized }
+	if id, ok := r.store.productSlugHistory[normalized]; ok {
+		match = func(p *productRow) bool { return p.id == id }
+	}
+	product, ok := r.store.findProduct(func(p *productRow) bool { return isPublished(p) && match(p) })
+	if !ok {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("スラッグ: %s の商品が見つかりませんでした", slug))
+	}
+	return r.store.toProductModel(product).WithVariants(product.variants), nil
+}
+
+// FindByNameLike は公開中の商品を商品名で部分一致検索します。
+// 既定のロケールの商品名に加え、すべてのロケールの翻訳と照合します。大文字と小文字は区別しません。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - keyword: 検索キーワード
+//
+// Returns:
+//   - []*models.Product: 商品リスト
+//   - error: エラー
+func (r *ProductRepositoryImpl) FindByNameLike(ctx context.Context, keyword string) ([]*models.Product, error) {
+	if keyword == "" {
+		return nil, errs.NewInternalError("INVALID_KEYWORD", "検索キーワードが空です")
+	}
+
+	lower := strings.ToLower(keyword)
+	contains := func(name string) bool { return strings.Contains(strings.ToLower(name), lower) }
+	return r.listPublished(func(p *productRow) bool {
+		if contains(p.name) {
+			return true
+		}
+		for _, name := range p.translations {
+			if contains(name) {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
+
+// FindStockByProductId は商品IDで在庫を検索します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - productId: 商品ID
+//
+// Returns:
+//   - *models.Stock: 在庫
+//   - error: エラー
+func (r *ProductRepositoryImpl) FindStockByProductId(ctx context.Context, productId string) (*models.Stock, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	product, ok := r.store.findProduct(func(p *productRow) bool { return p.id == productId })
+	if !ok {
+		return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("商品ID: %s が見つかりませんでした", productId))
+	}
+	return models.NewStock(product.id, product.onHand, product.reserved), nil
+}
+
+// ListByFilter は絞り込み条件に一致する公開中の商品を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - filter: 絞り込み条件
+//
+// Returns:
+//   - []*models.Product: 商品リスト
+//   - error: カテゴリが存在しない場合はNOT_FOUNDエラー
+func (r *ProductRepositoryImpl) ListByFilter(ctx context.Context, filter *models.ProductFilter) ([]*models.Product, error) {
+	var categoryIds []string
+	if filter.CategoryId() != "" {
+		r.store.mu.RLock()
+		rows := []*categoryRow{}
+		if filter.IncludeDescendants() {
+			rows = r.store.subtree(filter.CategoryId())
+		} else if category, ok := r.store.findCategory(filter.CategoryId()); ok {
+			rows = append(rows, category)
+		}
+		r.store.mu.RUnlock()
+		if len(rows) == 0 {
+			return nil, errs.NewCRUDError("NOT_FOUND", fmt.Sprintf("カテゴリID: %s が見つかりませんでした", filter.CategoryId()))
+		}
+		for _, c := range rows {
+			categoryIds = append(categoryIds, c.id)
+		}
+	}
+
+	return r.listPublished(func(p *productRow) bool {
+		if categoryIds != nil && !slices.Contains(categoryIds, p.categoryId) {
+			return false
+		}
+		return r.store.hasAllTags(p, filter.Tags())
+	}), nil
+}
+
+// listPublished は条件に一致する公開中の商品を登録順に返します。
+func (r *ProductRepositoryImpl) listPublished(match func(p *productRow) bool) []*models.Product {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	results := []*models.Product{}
+	for _, p := range r.store.products {
+		if isPublished(p) && match(p) {
+			results = append(results, r.store.toProductModel(p))
+		}
+	}
+	return results
+}
+
+// isPublished は公開中の商品かどうかを返します。
+func isPublished(p *productRow) bool {
+	return p.status == models.PRODUCT_STATUS_PUBLISHED
+}
+
+// hasAllTags は商品に指定された名前のタグがすべて付与されているかどうかを返します。
+func (s *Store) hasAllTags(p *productRow, names []string) bool {
+	for _, name := range names {
+		if !slices.ContainsFunc(s.productTags(p), func(t *models.Tag) bool { return strings.EqualFold(t.Name(), name) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// productTags は商品に付与されたタグをタグ名の順に返します。
+func (s *Store) productTags(p *productRow) []*models.Tag {
+	tags := []*models.Tag{}
+	for _, t := range s.tags {
+		if slices.Contains(p.tagIds, t.Id()) {
+			tags = append(tags, t)
+		}
+	}
+	slices.SortFunc(tags, func(a, b *models.Tag) int { return cmp.Compare(a.Name(), b.Name()) })
+	return tags
+}
+
+// toProductModel は商品の行を、カテゴリ・在庫・タグ・翻訳を合わせたクエリモデルに変換します。
+func (s *Store) toProductModel(p *productRow) *models.Product {
+	var category *models.Category
+	if c, ok := s.findCategory(p.categoryId); ok {
+		category = c.toCategoryModel()
+	}
+	result := models.NewProduct(p.id, p.name, p.price, category).
+		WithAvailableQuantity(models.NewStock(p.id, p.onHand, p.reserved).Available()).
+		WithTags(s.productTags(p)).
+		WithStatus(p.status).
+		WithBarcode(p.barcode).
+		WithSlug(p.slug)
+	if len(p.translations) > 0 {
+		result = result.WithTranslations(p.translations)
+	}
+	return result
+}
+
+var _ repository.ProductRepository = (*ProductRepositoryImpl)(nil)
@@ -0,0 +1,186 @@
+package memory_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	stationeryId    = "b1524011-b6af-417e-8bf2-f449dd58b5c0"
+	pencilsId       = "a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64"
+	wirelessMouseId = "82014174-6785-4242-b307-a806fd1f8470"
+	highlighterId   = "dc7243af-c2ce-4136-bd5d-c6b28ee0a20a"
+)
+
+func newSeededStore() *memory.Store {
+	store := memory.NewStore()
+	memory.Seed(store)
+	return store
+}
+
+var testLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func TestProductRepositoryImpl(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewProductRepositoryImpl(newSeededStore(), testLogger)
+
+	tests := []struct {
+		name       string
+		assertions func(t *testing.T)
+	}{
+		{
+			name: "正常系: 公開中の商品を登録順に取得できる",
+			assertions: func(t *testing.T) {
+				products, err := repo.List(ctx)
+				require.NoError(t, err)
+				assert.Len(t, products, 28)
+				assert.Equal(t, "水性ボールペン(黒)", products[0].Name())
+				assert.Equal(t, uint32(100), products[0].AvailableQuantity())
+			},
+		},
+		{
+			name: "正常系: 商品IDで予定の価格スケジュールのみを取得できる",
+			assertions: func(t *testing.T) {
+				product, err := repo.FindById(ctx, highlighterId, false)
+				require.NoError(t, err)
+				require.Len(t, product.PriceSchedules(), 1)
+				assert.Equal(t, models.PRICE_SCHEDULE_SCHEDULED, product.PriceSchedules()[0].Status())
+			},
+		},
+		{
+			name: "正常系: 前後の空白を除いたバーコードで商品を取得できる",
+			assertions: func(t *testing.T) {
+				product, err := repo.FindByBarcode(ctx, " 4569951116179 ")
+				require.NoError(t, err)
+				assert.Equal(t, wirelessMouseId, product.Id())
+			},
+		},
+		{
+			name: "正常系: 変更前のスラッグで商品を取得すると現在のスラッグを返す",
+			assertions: func(t *testing.T) {
+				product, err := repo.FindBySlug(ctx, "WaiyaresuMausu")
+				require.NoError(t, err)
+				assert.Equal(t, "wireless-mouse", product.Slug())
+			},
+		},
+		{
+			name: "正常系: 翻訳された商品名でも部分一致検索できる",
+			assertions: func(t *testing.T) {
+				products, err := repo.FindByNameLike(ctx, "trackball")
+				require.NoError(t, err)
+				require.Len(t, products, 1)
+				assert.Equal(t, "ワイヤレストラックボール", products[0].Name())
+			},
+		},
+		{
+			name: "異常系: 空のキーワードはエラー",
+			assertions: func(t *testing.T) {
+				_, err := repo.FindByNameLike(ctx, "")
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "正常系: 子孫カテゴリを含めてカテゴリとタグで絞り込める",
+			assertions: func(t *testing.T) {
+				products, err := repo.ListByFilter(ctx, models.NewProductFilter().WithCategory(stationeryId, true))
+				require.NoError(t, err)
+				assert.Len(t, products, 14)
+
+				products, err = repo.ListByFilter(ctx, models.NewProductFilter().WithTags([]string{"ワイヤレス"}))
+				require.NoError(t, err)
+				assert.Len(t, products, 3)
+			},
+		},
+		{
+			name: "異常系: 存在しない商品の在庫はNOT_FOUND",
+			assertions: func(t *testing.T) {
+				_, err := repo.FindStockByProductId(ctx, "00000000-0000-0000-0000-000000000000")
+				var crudErr *errs.CRUDError
+				require.ErrorAs(t, err, &crudErr)
+				assert.Equal(t, "NOT_FOUND", crudErr.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, tt.assertions)
+	}
+}
+
+func TestCategoryRepositoryImpl(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewCategoryRepositoryImpl(newSeededStore(), testLogger)
+
+	tests := []struct {
+		name       string
+		assertions func(t *testing.T)
+	}{
+		{
+			name: "正常系: 親カテゴリを指定しない場合はルートカテゴリを取得できる",
+			assertions: func(t *testing.T) {
+				categories, err := repo.FindChildren(ctx, "")
+				require.NoError(t, err)
+				assert.Len(t, categories, 3)
+			},
+		},
+		{
+			name: "正常系: ルートから順に祖先を取得できる",
+			assertions: func(t *testing.T) {
+				categories, err := repo.FindAncestors(ctx, pencilsId)
+				require.NoError(t, err)
+				require.Len(t, categories, 3)
+				assert.Equal(t, stationeryId, categories[0].Id())
+				assert.Equal(t, pencilsId, categories[2].Id())
+			},
+		},
+		{
+			name: "正常系: 部分木を取得できる",
+			assertions: func(t *testing.T) {
+				root, err := repo.FindSubtree(ctx, stationeryId)
+				require.NoError(t, err)
+				require.Len(t, root.Children(), 1)
+				assert.Equal(t, pencilsId, root.Children()[0].Children()[0].Category().Id())
+			},
+		},
+		{
+			name: "正常系: 変更前のスラッグでカテゴリを取得できる",
+			assertions: func(t *testing.T) {
+				category, err := repo.FindBySlug(ctx, "bunbogu")
+				require.NoError(t, err)
+				assert.Equal(t, "stationery", category.Slug())
+			},
+		},
+		{
+			name: "異常系: 存在しない親カテゴリはNOT_FOUND",
+			assertions: func(t *testing.T) {
+				_, err := repo.FindChildren(ctx, "00000000-0000-0000-0000-000000000000")
+				var crudErr *errs.CRUDError
+				require.ErrorAs(t, err, &crudErr)
+				assert.Equal(t, "NOT_FOUND", crudErr.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, tt.assertions)
+	}
+}
+
+func TestTagRepositoryImpl_ListWithUsage(t *testing.T) {
+	repo := memory.NewTagRepositoryImpl(newSeededStore(), testLogger)
+
+	usages, err := repo.ListWithUsage(context.Background())
+	require.NoError(t, err)
+	require.Len(t, usages, 2)
+	assert.Equal(t, "ワイヤレス", usages[0].Tag().Name())
+	assert.Equal(t, uint32(3), usages[0].ProductCount())
+	assert.Equal(t, uint32(2), usages[1].ProductCount())
+}
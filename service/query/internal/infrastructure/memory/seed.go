@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+)
+
+// Seed はデータストアに初期データを登録します。
+// 初期データは db/command/init/create_record.sql と同じ内容で、全商品の在庫は100です。
+//
+// Parameters:
+//   - store: 初期データを登録するデータストア
+func Seed(store *Store) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	const (
+		stationery    = "b1524011-b6af-417e-8bf2-f449dd58b5c0"
+		goods         = "762bd1ea-9700-4bab-a28d-6cbebf20ddc2"
+		pcPeripherals = "c05b1952-3bdf-4449-9b83-d0d123a667ce"
+		writingTools  = "3f6b8a2e-5c41-4d7e-9a0b-7e2d4c1f8b93"
+		pencils       = "a8d2e4f1-6b37-4c9a-8e05-2f1b7d9c3a64"
+		tagWireless   = "0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a61"
+		tagGaming     = "0c7e4b1a-3d52-4f8e-b6a9-1e2d3c4b5a62"
+		highlighter   = "dc7243af-c2ce-4136-bd5d-c6b28ee0a20a"
+	)
+	english := func(name string) models.Translations { return models.Translations{"en": name} }
+
+	store.categories = []*categoryRow{
+		{id: stationery, name: "文房具", slug: "stationery", translations: english("Stationery")},
+		{id: goods, name: "雑貨", slug: "goods", translations: english("Goods")},
+		{id: pcPeripherals, name: "パソコン周辺機器", slug: "pc-peripherals", translations: english("PC Peripherals")},
+		{id: writingTools, name: "筆記具", slug: "writing-instruments", parentId: stationery},
+		{id: pencils, name: "鉛筆", slug: "pencils", parentId: writingTools},
+	}
+	store.tags = []*models.Tag{
+		models.NewTag(tagWireless, "ワイヤレス"),
+		models.NewTag(tagGaming, "ゲーミング"),
+	}
+
+	priceOverride := uint32(130)
+	regularPrice := uint32(130)
+	store.products = []*productRow{
+		{id: "ac413f22-0cf1-490a-9635-7e9ca810e544", name: "水性ボールペン(黒)", slug: "water-based-ballpoint-pen-black", price: 120, categoryId: stationery, barcode: "4901234567894",
+			variants: []*models.Variant{
+				models.NewVariant("5e0f2d4a-8f5b-4f0e-9a55-2f3c3f6a1b01", "PEN-BLK-05", []*models.VariantOption{models.NewVariantOption("ボール径", "0.5mm")}, nil, "ACTIVE"),
+				models.NewVariant("5e0f2d4a-8f5b-4f0e-9a55-2f3c3f6a1b02", "PEN-BLK-07", []*models.VariantOption{models.NewVariantOption("ボール径", "0.7mm")}, &priceOverride, "ACTIVE"),
+			}},
+		{id: "8f81a72a-58ef-422b-b472-d982e8665292", name: "水性ボールペン(赤)", slug: "water-based-ballpoint-pen-red", price: 120, categoryId: stationery},
+		{id: "d952b98c-a1ea-478d-8380-3b90fde872ea", name: "水性ボールペン(青)", slug: "water-based-ballpoint-pen-blue", price: 120, categoryId: stationery},
+		{id: "9959e553-c9da-4646-bd85-8663a3541583", name: "油性ボールペン(黒)", slug: "oil-based-ballpoint-pen-black", price: 100, categoryId: stationery},
+		{id: "79023e82-9197-40a5-b236-26487f404be4", name: "油性ボールペン(赤)", slug: "oil-based-ballpoint-pen-red", price: 100, categoryId: stationery},
+		{id: "7dfd0fd0-0893-4d20-83ef-6f70aab0ab76", name: "油性ボールペン(青)", slug: "oil-based-ballpoint-pen-blue", price: 100, categoryId: stationery},
+		{id: highlighter, name: "蛍光ペン(黄)", slug: "highlighter-yellow", price: 130, categoryId: stationery,
+			schedules: []*models.PriceSchedule{
+				models.NewPriceSchedule("5e0c2b7a-41d9-4f3e-9a68-0b1d2c3e4f51", 100, &regularPrice, "COMPLETED",
+					time.Date(2020, 1, 3, 15, 0, 0, 0, time.UTC), time.Date(2020, 1, 5, 15, 0, 0, 0, time.UTC)),
+				models.NewPriceSchedule("5e0c2b7a-41d9-4f3e-9a68-0b1d2c3e4f52", 110, nil, models.PRICE_SCHEDULE_SCHEDULED,
+					time.Date(2099, 1, 2, 15, 0, 0, 0, time.UTC), time.Date(2099, 1, 4, 15, 0, 0, 0, time.UTC)),
+			}},
+		{id: "83fbc81d-2498-4da6-b8c2-54878d3b67ff", name: "蛍光ペン(赤)", slug: "highlighter-red", price: 130, categoryId: stationery},
+		{id: "ee4b3752-3fbd-45fc-afb5-8f37c3f701c9", name: "蛍光ペン(青)", slug: "highlighter-blue", price: 130, categoryId: stationery},
+		{id: "35cb51a7-df79-4771-9939-7f32c19bca45", name: "蛍光ペン(緑)", slug: "highlighter-green", price: 130, categoryId: stationery},
+		{id: "e4850253-f363-4e79-8110-7335e4af45be", name: "鉛筆(黒)", slug: "pencil-black", price: 100, categoryId: pencils},
+		{id: "5ca7dbdf-0010-44c5-a001-e4c13c4fe3a1", name: "鉛筆(赤)", slug: "pencil-red", price: 100, categoryId: pencils},
+		{id: "fbc43b9b-90a9-4712-925c-4d66a2a30372", name: "色鉛筆(12色)", slug: "colored-pencils-12", price: 400, categoryId: pencils},
+		{id: "4b3db238-8ada-49b4-bb60-1a034914e528", name: "色鉛筆(48色)", slug: "colored-pencils-48", price: 1300, categoryId: pencils},
+		{id: "debdbd8c-5b48-4b1a-9697-98ba321ddd40", name: "レザーネックレス", slug: "leather-necklace", price: 300, categoryId: goods},
+		{id: "367197c5-32bd-479a-9102-c601145464c4", name: "ワンタッチ開閉傘", slug: "one-touch-umbrella", price: 3000, categoryId: goods},
+		{id: "657578d2-8820-4490-a6ec-06d9c7cccd0f", name: "金魚風呂敷", slug: "goldfish-furoshiki", price: 500, categoryId: goods},
+		{id: "8c107894-4ebc-445b-9603-c9e8e6524f9d", name: "折畳トートバッグ", slug: "foldable-tote-bag", price: 600, categoryId: goods},
+		{id: "2f8e074c-d0b1-441b-9dd4-6cf0ec570ce6", name: "アイマスク", slug: "eye-mask", price: 900, categoryId: goods},
+		{id: "2fb9fe48-3520-47ef-9e1a-338db7152884", name: "防水スプレー", slug: "waterproof-spray", price: 500, categoryId: goods},
+		{id: "f536311a-b9de-4873-a603-70953a2261be", name: "キーホルダ", slug: "key-holder", price: 800, categoryId: goods},
+		{id: "82014174-6785-4242-b307-a806fd1f8470", name: "ワイヤレスマウス", slug: "wireless-mouse", price: 900, categoryId: pcPeripherals, barcode: "4569951116179",
+			translations: english("Wireless Mouse"), tagIds: []string{tagWireless}},
+		{id: "ddd1e5ae-fb90-4a47-bb87-c91b305c7444", name: "ワイヤレストラックボール", slug: "wireless-trackball", price: 1300, categoryId: pcPeripherals,
+			translations: english("Wireless Trackball"), tagIds: []string{tagWireless}},
+		{id: "aa5e07aa-06f9-4037-9755-e1de3c0ad4ac", name: "有線光学式マウス", slug: "wired-optical-mouse", price: 500, categoryId: pcPeripherals},
+		{id: "53cfa873-c86b-48bd-a68c-458d7bb5c844", name: "光学式ゲーミングマウス", slug: "optical-gaming-mouse", price: 4800, categoryId: pcPeripherals, tagIds: []string{tagGaming}},
+		{id: "376f7a75-cc99-4428-b35a-889bcb3c90af", name: "有線ゲーミングマウス", slug: "wired-gaming-mouse", price: 3800, categoryId: pcPeripherals, tagIds: []string{tagGaming}},
+		{id: "38c6e236-90ca-48a2-b427-acb9d834b591", name: "USB有線式キーボード", slug: "usb-wired-keyboard", price: 1400, categoryId: pcPeripherals},
+		{id: "dc2e5a33-a2b7-4414-9a53-f9750e7da8ed", name: "無線式キーボード", slug: "wireless-keyboard", price: 1900, categoryId: pcPeripherals,
+			translations: english("Wireless Keyboard"), tagIds: []string{tagWireless}},
+	}
+	for _, p := range store.products {
+		p.status = models.PRODUCT_STATUS_PUBLISHED
+		p.onHand = 100
+	}
+
+	store.productSlugHistory["waiyaresumausu"] = "82014174-6785-4242-b307-a806fd1f8470"
+	store.categorySlugHistory["bunbogu"] = stationery
+}
@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+)
+
+// Store はインメモリのリポジトリが共有するデータストアです。
+// クエリDBのテーブルに相当する行を登録順に保持します。
+// クエリサービスは読み取り専用のため、行は初期データの投入時にのみ追加します。
+type Store struct {
+	mu                  sync.RWMutex
+	categories          []*categoryRow
+	categorySlugHistory map[string]string // 変更前のスラッグ → カテゴリID
+	products            []*productRow
+	productSlugHistory  map[string]string // 変更前のスラッグ → 商品ID
+	tags                []*models.Tag
+}
+
+// categoryRow はcategoryテーブルとcategory_name_translationテーブルの行です。
+type categoryRow struct {
+	id           string
+	name         string
+	parentId     string // ルートカテゴリの場合は空文字列
+	slug         string
+	translations models.Translations
+}
+
+// productRow は商品と、商品に関連するテーブル（在庫・タグ・バリエーション・価格スケジュール・翻訳）の行です。
+type productRow struct {
+	id           string
+	name         string
+	price        uint32
+	categoryId   string
+	status       string
+	barcode      string // 未登録の場合は空文字列
+	slug         string
+	translations models.Translations
+	onHand       uint32
+	reserved     uint32
+	tagIds       []string
+	variants     []*models.Variant       // 登録順
+	schedules    []*models.PriceSchedule // すべての状態の価格スケジュール
+}
+
+// NewStore は空のStoreを生成します。
+//
+// Returns:
+//   - *Store: Storeポインタ
+func NewStore() *Store {
+	return &Store{
+		categorySlugHistory: map[string]string{},
+		productSlugHistory:  map[string]string{},
+	}
+}
+
+// findCategory はカテゴリIDでカテゴリの行を検索します。
+func (s *Store) findCategory(id string) (*categoryRow, bool) {
+	i := slices.IndexFunc(s.categories, func(c *categoryRow) bool { return c.id == id })
+	if i < 0 {
+		return nil, false
+	}
+	return s.categories[i], true
+}
+
+// findProduct は条件に一致する最初の商品の行を検索します。
+func (s *Store) findProduct(match func(p *productRow) bool) (*productRow, bool) {
+	i := slices.IndexFunc(s.products, match)
+	if i < 0 {
+		return nil, false
+	}
+	return s.products[i], true
+}
+
+// subtree は指定されたカテゴリとその子孫を、根からの深さ順（同じ深さでは登録順）に返します。
+func (s *Store) subtree(id string) []*categoryRow {
+	root, ok := s.findCategory(id)
+	if !ok {
+		return nil
+	}
+	rows := []*categoryRow{root}
+	for level := []*categoryRow{root}; len(level) > 0; {
+		next := []*categoryRow{}
+		for _, c := range s.categories {
+			if slices.ContainsFunc(level, func(parent *categoryRow) bool { return c.parentId == parent.id }) {
+				next = append(next, c)
+			}
+		}
+		rows = append(rows, next...)
+		level = next
+	}
+	return rows
+}
+
+// toCategoryModel はカテゴリの行をクエリモデルに変換します。
+func (c *categoryRow) toCategoryModel() *models.Category {
+	result := models.NewCategory(c.id, c.name).WithSlug(c.slug)
+	if c.parentId != "" {
+		result = result.WithParentId(c.parentId)
+	}
+	if len(c.translations) > 0 {
+		result = result.WithTranslations(c.translations)
+	}
+	return result
+}
+
+func toCategoryModels(rows []*categoryRow) []*models.Category {
+	results := make([]*models.Category, len(rows))
+	for i, c := range rows {
+		results[i] = c.toCategoryModel()
+	}
+	return results
+}
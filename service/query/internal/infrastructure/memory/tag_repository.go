@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/repository"
+)
+
+// TagRepositoryImpl はタグリポジトリのインメモリ実装です。
+type TagRepositoryImpl struct {
+	store  *Store
+	logger *slog.Logger
+}
+
+// NewTagRepositoryImpl はTagRepositoryImplを生成します。
+//
+// Parameters:
+//   - store: データストア
+//   - logger: ロガー
+//
+// Returns:
+//   - *TagRepositoryImpl: TagRepositoryImplポインタ
+func NewTagRepositoryImpl(store *Store, logger *slog.Logger) *TagRepositoryImpl {
+	return &TagRepositoryImpl{store: store, logger: logger}
+}
+
+// ListWithUsage はすべてのタグを、付与された商品数の多い順（同数の場合はタグ名の順）に取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - []*models.TagUsage: タグと付与された商品数のリスト
+//   - error: エラー
+func (r *TagRepositoryImpl) ListWithUsage(ctx context.Context) ([]*models.TagUsage, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	results := make([]*models.TagUsage, 0, len(r.store.tags))
+	for _, t := range r.store.tags {
+		var count uint32
+		for _, p := range r.store.products {
+			if slices.Contains(p.tagIds, t.Id()) {
+				count++
+			}
+		}
+		results = append(results, models.NewTagUsage(t, count))
+	}
+	slices.SortStableFunc(results, func(a, b *models.TagUsage) int {
+		if c := cmp.Compare(b.ProductCount(), a.ProductCount()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Tag().Name(), b.Tag().Name())
+	})
+	return results, nil
+}
+
+var _ repository.TagRepository = (*TagRepositoryImpl)(nil)
@@ -0,0 +1,104 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/db/migrations"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/migrate"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/config"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/db"
+	"go.uber.org/fx"
+)
+
+// RunMigrate はmigrateサブコマンドを実行します。
+// 設定ファイルのデータベースに接続し、db/migrations のマイグレーションを適用・取り消し、または適用状況を出力します。
+//
+// Note: クエリDBがコマンドDBのレプリカの場合、スキーマの変更はレプリケーションで反映されます。
+// その場合はコマンドサービスで適用し、クエリサービスではstatusで反映を確認してください。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - configPath: 設定ファイルのディレクトリパス
+//   - configName: 設定ファイル名（拡張子なし）
+//   - args: サブコマンド以降の引数（例: ["up"]、["down", "1"]、["status"]）
+//   - w: 結果の出力先
+//
+// Returns:
+//   - error: 引数が不正な場合、または設定の読み込み、DB接続、マイグレーションの実行に失敗した場合のエラー
+func RunMigrate(ctx context.Context, configPath string, configName string, args []string, w io.Writer) error {
+	cmd, err := migrate.ParseArgs(args)
+	if err != nil {
+		return err
+	}
+	v := config.NewViper(configPath, configName)
+	logger, err := log.NewLogger(v)
+	if err != nil {
+		return err
+	}
+	cfg, err := config.NewMigrationConfig(v)
+	if err != nil {
+		return err
+	}
+	dbConfig, err := db.NewDBConfig(v)
+	if err != nil {
+		return err
+	}
+	gormDB, err := db.NewDatabase(dbConfig, logger)
+	if err != nil {
+		return err
+	}
+	conn, err := gormDB.DB()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	migrator, err := newMigrator(conn, cfg, logger)
+	if err != nil {
+		return err
+	}
+	return migrate.Run(ctx, migrator, cmd, w)
+}
+
+// newMigrator は db/migrations のマイグレーションを読み込みMigratorを生成します。
+//
+// Parameters:
+//   - conn: データベース接続
+//   - cfg: マイグレーションの設定
+//   - logger: ロガー
+//
+// Returns:
+//   - *migrate.Migrator: Migratorポインタ
+//   - error: マイグレーションファイルの読み込みに失敗した場合のエラー
+func newMigrator(conn *sql.DB, cfg *config.MigrationConfig, logger *slog.Logger) (*migrate.Migrator, error) {
+	loaded, err := migrate.Load(migrations.FS)
+	if err != nil {
+		return nil, err
+	}
+	return migrate.NewMigrator(conn, migrate.MySQL, loaded, cfg.LockTimeout, logger), nil
+}
+
+// registerMigrationHook は起動時に未適用のマイグレーションを適用するフックを登録します。
+// 検索インデックスの同期より先に実行されるように、DB接続の直後に登録します。
+//
+// Parameters:
+//   - lc: Fxライフサイクル
+//   - migrator: Migrator
+//   - logger: ロガー
+func registerMigrationHook(lc fx.Lifecycle, migrator *migrate.Migrator, logger *slog.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			applied, err := migrator.Up(ctx)
+			if err != nil {
+				logger.ErrorContext(ctx, "Failed to apply migrations", slog.Any("error", err))
+				return err
+			}
+			logger.InfoContext(ctx, "Migrations applied", slog.Int("applied", applied))
+			return nil
+		},
+	})
+}
@@ -8,12 +8,18 @@ import (
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/repository"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/config"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/db"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/memory"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/search"
+	"github.com/spf13/viper"
 	"go.uber.org/fx"
 	"gorm.io/gorm"
 )
 
 // Module はインフラストラクチャ層のFxモジュールです。
-// 設定読み込み、データベース接続、リポジトリ実装、ロガーを提供します。
+// 設定読み込み、データベース接続、リポジトリ実装、全文検索エンジン、ロガーを提供します。
+// ログレベルは設定ファイルの変更と管理用エンドポイント（log.AdminHandler）で実行時に変更できます。
+// リポジトリの実装は設定（repository.backend）により、GORM（mysql / sqlite）とインメモリ（memory）から選択します。
+// mysqlでmigration.autoが有効な場合は、起動時に未適用のスキーマのマイグレーションを適用します。
 var Module = fx.Module(
 	"infrastructure",
 	fx.Provide(
@@ -21,21 +27,102 @@ var Module = fx.Module(
 			config.NewViper,
 			fx.ParamTags(`name:"configPath"`, `name:"configName"`),
 		),
-		db.NewDBConfig,
-		db.NewDatabase,
-		log.NewLogger,
+		config.NewRepositoryConfig,
+		config.NewMigrationConfig,
+		log.NewLevels,
+		log.NewLoggerWithLevels,
+		log.NewAdminConfig,
+		log.NewAdminHandler,
+		newRepositories,
+		search.NewSearchConfig,
 		fx.Annotate(
-			db.NewCategoryRepositoryImpl,
-			fx.As(new(repository.CategoryRepository)),
-		),
-		fx.Annotate(
-			db.NewProductRepositoryImpl,
-			fx.As(new(repository.ProductRepository)),
+			search.NewBleveSearchEngine,
+			fx.As(fx.Self()),
+			fx.As(new(repository.SearchEngine)),
 		),
+		search.NewIndexSyncer,
 	),
-	fx.Invoke(registerLifecycleHooks),
+	// 設定ファイルの変更時にログレベルを読み込み直す
+	fx.Invoke(log.WatchConfig),
+	// DB接続より先に停止させるため、DBのフック（newRepositoriesで登録）より後に登録する
+	fx.Invoke(search.RegisterLifecycleHooks),
 )
 
+// repositories は選択した実装のリポジトリです。
+type repositories struct {
+	fx.Out
+
+	CategoryRepository repository.CategoryRepository
+	ProductRepository  repository.ProductRepository
+	TagRepository      repository.TagRepository
+	DB                 *gorm.DB // データベース接続（memoryの場合はnil）
+}
+
+// newRepositories は設定で選択した実装のリポジトリを生成します。
+// mysqlとsqliteの場合はデータベースに接続し、アプリケーション停止時に接続をクローズするフックを登録します。
+// mysqlでmigration.autoが有効な場合は、起動時に未適用のマイグレーションを適用するフックも登録します。
+// sqliteの場合は読み取りモデルのテーブルを作成します。データはloadサブコマンドで投入します。
+// memoryの場合はプロセス内のデータストアを使用し、設定に応じてサンプルデータを投入します。
+// インメモリのデータストアはコマンドサービスの変更を反映しないため、読み取りの動作確認に使用します。
+//
+// Parameters:
+//   - lc: Fxライフサイクル
+//   - cfg: リポジトリの実装の設定
+//   - migrationCfg: マイグレーションの設定
+//   - v: Viperインスタンス（mysqlとsqliteの接続設定の読み込みに使用）
+//   - logger: ロガー
+//   - levels: 実行時に変更できるログレベル（GORMのログモードを追従させる）
+//
+// Returns:
+//   - repositories: リポジトリ
+//   - error: 接続設定の読み込み、DB接続、またはマイグレーションファイルの読み込みに失敗した場合のエラー
+func newRepositories(lc fx.Lifecycle, cfg *config.RepositoryConfig, migrationCfg *config.MigrationConfig, v *viper.Viper, logger *slog.Logger, levels *log.Levels) (repositories, error) {
+	if cfg.Backend == config.REPOSITORY_BACKEND_MEMORY {
+		store := memory.NewStore()
+		if cfg.Seed {
+			memory.Seed(store)
+		}
+		logger.Info("Using in-memory repositories", slog.Bool("seed", cfg.Seed))
+		return repositories{
+			CategoryRepository: memory.NewCategoryRepositoryImpl(store, logger),
+			ProductRepository:  memory.NewProductRepositoryImpl(store, logger),
+			TagRepository:      memory.NewTagRepositoryImpl(store, logger),
+		}, nil
+	}
+
+	newDBConfig := db.NewDBConfig
+	if cfg.Backend == config.REPOSITORY_BACKEND_SQLITE {
+		newDBConfig = db.NewSQLiteDBConfig
+	}
+	dbConfig, err := newDBConfig(v)
+	if err != nil {
+		return repositories{}, err
+	}
+	conn, err := db.NewDatabase(dbConfig, logger)
+	if err != nil {
+		return repositories{}, err
+	}
+	db.FollowLogLevel(conn, levels)
+	registerLifecycleHooks(lc, conn, logger)
+	if migrationCfg.Auto && dbConfig.Driver == db.DRIVER_MYSQL {
+		sqlDB, err := conn.DB()
+		if err != nil {
+			return repositories{}, err
+		}
+		migrator, err := newMigrator(sqlDB, migrationCfg, logger)
+		if err != nil {
+			return repositories{}, err
+		}
+		registerMigrationHook(lc, migrator, logger)
+	}
+	return repositories{
+		CategoryRepository: db.NewCategoryRepositoryImpl(conn, logger),
+		ProductRepository:  db.NewProductRepositoryImpl(conn, logger),
+		TagRepository:      db.NewTagRepositoryImpl(conn, logger),
+		DB:                 conn,
+	}, nil
+}
+
 // registerLifecycleHooks はアプリケーションライフサイクルフックを登録します。
 //
 // Parameters:
@@ -0,0 +1,501 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/analysis/token/edgengram"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/single"
+	"github.com/blevesearch/bleve/v2/mapping"
+	htmlhighlighter "github.com/blevesearch/bleve/v2/search/highlight/highlighter/html"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/repository"
+)
+
+const (
+	FIELD_NAME          = "name"
+	FIELD_NAME_PREFIX   = "name_prefix"
+	FIELD_PRICE         = "price"
+	FIELD_CURRENCY      = "currency"
+	FIELD_TAX_CLASS     = "tax_class"
+	FIELD_CATEGORY_ID   = "category_id"
+	FIELD_CATEGORY_NAME = "category_name"
+
+	FIELD_TRANSLATED_NAMES      = "translated_names"      // 既定のロケール以外の商品名（全ロケールをまとめて索引する）
+	FIELD_TRANSLATIONS          = "translations"          // 商品名の翻訳（ロケール -> 商品名のJSON）
+	FIELD_CATEGORY_TRANSLATIONS = "category_translations" // カテゴリ名の翻訳（ロケール -> カテゴリ名のJSON）
+
+	FACET_CATEGORY   = "category"
+	FACET_PRICE_BAND = "price_band"
+
+	// 前方一致用のアナライザ。商品名全体を1トークンとして正規化し、先頭からのn-gramを索引する
+	prefixIndexAnalyzer  = "name_prefix_index"
+	prefixSearchAnalyzer = "name_prefix_search"
+	prefixNgramFilter    = "name_prefix_edge_ngram"
+	prefixMaxLength      = 100 // 商品名の最大文字数
+
+	defaultSuggestLimit = 10
+)
+
+// priceBand は価格帯ファセットの区間です。minは含み、maxは含みません。
+type priceBand struct {
+	key   string
+	label string
+	min   *float64
+	max   *float64
+}
+
+// priceBands は価格帯ファセットの区間一覧です。
+var priceBands = []priceBand{
+	{key: "0-500", label: "500円未満", max: ptr(500)},
+	{key: "500-1000", label: "500円以上1,000円未満", min: ptr(500), max: ptr(1000)},
+	{key: "1000-5000", label: "1,000円以上5,000円未満", min: ptr(1000), max: ptr(5000)},
+	{key: "5000-", label: "5,000円以上", min: ptr(5000)},
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
+
+// BleveSearchEngine はBleveによる商品の全文検索エンジンです。
+// 商品名はCJKアナライザ（bigram）で解析するため、日本語の分かち書きなしで検索できます。
+type BleveSearchEngine struct {
+	index      bleve.Index
+	maxResults int
+	logger     *slog.Logger
+
+	mu            sync.RWMutex
+	ids           map[string]struct{} // インデックス済みの商品ID
+	categoryNames map[string]string   // カテゴリID -> カテゴリ名（ファセットの表示名）
+}
+
+// NewBleveSearchEngine はBleveSearchEngineを生成します。
+// IndexPathが空の場合はメモリ上にインデックスを作成し、指定された場合はディスク上のインデックスを開きます。
+//
+// Parameters:
+//   - cfg: 検索エンジン設定
+//   - logger: ロガー
+//
+// Returns:
+//   - *BleveSearchEngine: BleveSearchEngineポインタ
+//   - error: インデックスの作成に失敗した場合のエラー
+func NewBleveSearchEngine(cfg *SearchConfig, logger *slog.Logger) (*BleveSearchEngine, error) {
+	index, err := openIndex(cfg.IndexPath)
+	if err != nil {
+		return nil, errs.NewInternalErrorWithCause("SEARCH_INDEX_ERROR", fmt.Sprintf("検索インデックスを開けませんでした: %s", cfg.IndexPath), err)
+	}
+	engine := &BleveSearchEngine{
+		index:         index,
+		maxResults:    cfg.MaxResults,
+		logger:        logger,
+		ids:           map[string]struct{}{},
+		categoryNames: map[string]string{},
+	}
+	if err := engine.loadState(); err != nil {
+		_ = index.Close()
+		return nil, errs.NewInternalErrorWithCause("SEARCH_INDEX_ERROR", "検索インデックスの読み込みに失敗しました", err)
+	}
+	return engine, nil
+}
+
+func openIndex(path string) (bleve.Index, error) {
+	if path == "" {
+		return bleve.NewMemOnly(newIndexMapping())
+	}
+	if _, err := os.Stat(path); err == nil {
+		return bleve.Open(path)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return bleve.New(path, newIndexMapping())
+}
+
+// newIndexMapping は商品ドキュメントのマッピングを生成します。
+func newIndexMapping() mapping.IndexMapping {
+	name := bleve.NewTextFieldMapping()
+	name.Analyzer = cjk.AnalyzerName
+	name.Store = true
+	name.IncludeTermVectors = true // ハイライトに必要
+
+	namePrefix := bleve.NewTextFieldMapping()
+	namePrefix.Analyzer = prefixIndexAnalyzer
+
+	price := bleve.NewNumericFieldMapping()
+	price.Store = true
+
+	// 通貨と税率区分は検索結果から税込価格を計算するために保存のみ行う
+	currency := bleve.NewTextFieldMapping()
+	currency.Index = false
+	currency.Store = true
+
+	taxClass := bleve.NewTextFieldMapping()
+	taxClass.Index = false
+	taxClass.Store = true
+
+	categoryId := bleve.NewKeywordFieldMapping()
+	categoryId.Analyzer = keyword.Name
+	categoryId.Store = true
+
+	categoryName := bleve.NewTextFieldMapping()
+	categoryName.Index = false
+	categoryName.Store = true
+
+	// 翻訳はロケールを問わず一致させるため、すべてのロケールの商品名を同じフィールドに索引する
+	translatedNames := bleve.NewTextFieldMapping()
+	translatedNames.Analyzer = cjk.AnalyzerName
+	translatedNames.Store = true
+	translatedNames.IncludeTermVectors = true // ハイライトに必要
+
+	// 翻訳は検索結果の商品名をロケールに合わせて置き換えるために保存のみ行う
+	translations := bleve.NewTextFieldMapping()
+	translations.Index = false
+	translations.Store = true
+
+	categoryTranslations := bleve.NewTextFieldMapping()
+	categoryTranslations.Index = false
+	categoryTranslations.Store = true
+
+	product := bleve.NewDocumentStaticMapping()
+	product.AddFieldMappingsAt(FIELD_NAME, name)
+	product.AddFieldMappingsAt(FIELD_NAME_PREFIX, namePrefix)
+	product.AddFieldMappingsAt(FIELD_PRICE, price)
+	product.AddFieldMappingsAt(FIELD_CURRENCY, currency)
+	product.AddFieldMappingsAt(FIELD_TAX_CLASS, taxClass)
+	product.AddFieldMappingsAt(FIELD_CATEGORY_ID, categoryId)
+	product.AddFieldMappingsAt(FIELD_CATEGORY_NAME, categoryName)
+	product.AddFieldMappingsAt(FIELD_TRANSLATED_NAMES, translatedNames)
+	product.AddFieldMappingsAt(FIELD_TRANSLATIONS, translations)
+	product.AddFieldMappingsAt(FIELD_CATEGORY_TRANSLATIONS, categoryTranslations)
+
+	m := bleve.NewIndexMapping()
+	// 登録に失敗するのは設定値の誤りのみのため、エラーは無視する
+	_ = m.AddCustomTokenFilter(prefixNgramFilter, map[string]any{
+		"type": edgengram.Name,
+		"min":  1.0,
+		"max":  float64(prefixMaxLength),
+	})
+	_ = m.AddCustomAnalyzer(prefixIndexAnalyzer, map[string]any{
+		"type":          custom.Name,
+		"tokenizer":     single.Name,
+		"token_filters": []any{cjk.WidthName, lowercase.Name, prefixNgramFilter},
+	})
+	_ = m.AddCustomAnalyzer(prefixSearchAnalyzer, map[string]any{
+		"type":          custom.Name,
+		"tokenizer":     single.Name,
+		"token_filters": []any{cjk.WidthName, lowercase.Name},
+	})
+	m.DefaultMapping = product
+	return m
+}
+
+// loadState はディスク上のインデックスから商品IDとカテゴリ名を読み込みます。
+func (e *BleveSearchEngine) loadState() error {
+	count, err := e.index.DocCount()
+	if err != nil || count == 0 {
+		return err
+	}
+	req := bleve.NewSearchRequestOptions(bleve.NewMatchAllQuery(), int(count), 0, false)
+	req.Fields = []string{FIELD_CATEGORY_ID, FIELD_CATEGORY_NAME}
+	res, err := e.index.Search(req)
+	if err != nil {
+		return err
+	}
+	for _, hit := range res.Hits {
+		e.ids[hit.ID] = struct{}{}
+		if id, ok := hit.Fields[FIELD_CATEGORY_ID].(string); ok {
+			e.categoryNames[id], _ = hit.Fields[FIELD_CATEGORY_NAME].(string)
+		}
+	}
+	return nil
+}
+
+// Reindex は与えられた商品でインデックスを同期します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - products: 同期する全商品
+//
+// Returns:
+//   - error: エラー
+func (e *BleveSearchEngine) Reindex(ctx context.Context, products []*models.Product) error {
+	ids := make(map[string]struct{}, len(products))
+	categoryNames := map[string]string{}
+	batch := e.index.NewBatch()
+	for _, p := range products {
+		doc := map[string]any{
+			FIELD_NAME:        p.Name(),
+			FIELD_NAME_PREFIX: p.Name(),
+			FIELD_PRICE:       float64(p.Price()),
+			FIELD_CURRENCY:    p.Currency(),
+			FIELD_TAX_CLASS:   string(p.TaxClass()),
+		}
+		if len(p.Translations()) > 0 {
+			doc[FIELD_TRANSLATED_NAMES] = slices.Sorted(maps.Values(p.Translations()))
+			doc[FIELD_TRANSLATIONS] = marshalTranslations(p.Translations())
+		}
+		if c := p.Category(); c != nil {
+			doc[FIELD_CATEGORY_ID] = c.Id()
+			doc[FIELD_CATEGORY_NAME] = c.Name()
+			categoryNames[c.Id()] = c.Name()
+			if len(c.Translations()) > 0 {
+				doc[FIELD_CATEGORY_TRANSLATIONS] = marshalTranslations(c.Translations())
+			}
+		}
+		if err := batch.Index(p.Id(), doc); err != nil {
+			return errs.NewInternalErrorWithCause("SEARCH_INDEX_ERROR", fmt.Sprintf("商品ID: %s のインデックス登録に失敗しました", p.Id()), err)
+		}
+		ids[p.Id()] = struct{}{}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	removed := 0
+	for id := range e.ids {
+		if _, ok := ids[id]; !ok {
+			batch.Delete(id)
+			removed++
+		}
+	}
+	if err := e.index.Batch(batch); err != nil {
+		return errs.NewInternalErrorWithCause("SEARCH_INDEX_ERROR", "検索インデックスの更新に失敗しました", err)
+	}
+	e.ids = ids
+	e.categoryNames = categoryNames
+	e.logger.DebugContext(ctx, "Search index synchronized", slog.Int("indexed", len(ids)), slog.Int("removed", removed))
+	return nil
+}
+
+// Search はキーワードで商品を全文検索します。
+// 既定のロケールの商品名またはいずれかのロケールの翻訳が、キーワードのすべてのbigramを含む商品を関連度順に返します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - keyword: 検索キーワード
+//
+// Returns:
+//   - *models.SearchResult: 関連度順の検索結果とファセット
+//   - error: エラー
+func (e *BleveSearchEngine) Search(ctx context.Context, keyword string) (*models.SearchResult, error) {
+	if keyword == "" {
+		return nil, errs.NewInternalError("INVALID_KEYWORD", "検索キーワードが空です")
+	}
+
+	match := bleve.NewMatchQuery(keyword)
+	match.SetField(FIELD_NAME)
+	match.SetOperator(query.MatchQueryOperatorAnd)
+
+	translated := bleve.NewMatchQuery(keyword)
+	translated.SetField(FIELD_TRANSLATED_NAMES)
+	translated.SetOperator(query.MatchQueryOperatorAnd)
+
+	req := bleve.NewSearchRequestOptions(bleve.NewDisjunctionQuery(match, translated), e.maxResults, 0, false)
+	req.Fields = storedFields
+	req.Highlight = bleve.NewHighlightWithStyle(htmlhighlighter.Name)
+	req.Highlight.AddField(FIELD_NAME)
+	req.Highlight.AddField(FIELD_TRANSLATED_NAMES)
+	req.AddFacet(FACET_CATEGORY, bleve.NewFacetRequest(FIELD_CATEGORY_ID, e.categoryCount()))
+	priceFacet := bleve.NewFacetRequest(FIELD_PRICE, len(priceBands))
+	for _, b := range priceBands {
+		priceFacet.AddNumericRange(b.key, b.min, b.max)
+	}
+	req.AddFacet(FACET_PRICE_BAND, priceFacet)
+
+	res, err := e.index.SearchInContext(ctx, req)
+	if err != nil {
+		return nil, errs.NewInternalErrorWithCause("SEARCH_ERROR", fmt.Sprintf("キーワード: %s の検索に失敗しました", keyword), err)
+	}
+
+	hits := make([]*models.ProductHit, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		product := toProductFromFields(hit.ID, hit.Fields)
+		hits = append(hits, models.NewProductHit(product, hit.Score, highlightsOf(hit.Fragments)))
+	}
+
+	return models.NewSearchResult(hits, res.Total, e.categoryFacets(res), priceBandFacets(res)), nil
+}
+
+// Suggest は入力中の検索語に対するサジェストを返します。
+// 商品名の前方一致を優先し、検索語のbigramをすべて含む商品名または翻訳（部分一致）も候補とします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - prefix: 入力中の検索語
+//   - limit: 最大件数（0以下の場合は10件）
+//
+// Returns:
+//   - []*models.ProductHit: 関連度順のサジェスト
+//   - error: エラー
+func (e *BleveSearchEngine) Suggest(ctx context.Context, prefix string, limit int) ([]*models.ProductHit, error) {
+	if prefix == "" {
+		return []*models.ProductHit{}, nil
+	}
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+
+	startsWith := bleve.NewMatchQuery(prefix)
+	startsWith.SetField(FIELD_NAME_PREFIX)
+	startsWith.Analyzer = prefixSearchAnalyzer
+	startsWith.SetBoost(3)
+
+	contains := bleve.NewMatchQuery(prefix)
+	contains.SetField(FIELD_NAME)
+	contains.SetOperator(query.MatchQueryOperatorAnd)
+
+	translated := bleve.NewMatchQuery(prefix)
+	translated.SetField(FIELD_TRANSLATED_NAMES)
+	translated.SetOperator(query.MatchQueryOperatorAnd)
+
+	req := bleve.NewSearchRequestOptions(bleve.NewDisjunctionQuery(startsWith, contains, translated), limit, 0, false)
+	req.Fields = storedFields
+	req.Highlight = bleve.NewHighlightWithStyle(htmlhighlighter.Name)
+	req.Highlight.AddField(FIELD_NAME)
+	req.Highlight.AddField(FIELD_TRANSLATED_NAMES)
+
+	res, err := e.index.SearchInContext(ctx, req)
+	if err != nil {
+		return nil, errs.NewInternalErrorWithCause("SEARCH_ERROR", fmt.Sprintf("検索語: %s のサジェストに失敗しました", prefix), err)
+	}
+
+	hits := make([]*models.ProductHit, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		product := toProductFromFields(hit.ID, hit.Fields)
+		highlights := highlightsOf(hit.Fragments)
+		if len(highlights) == 0 {
+			// 前方一致のみでヒットした場合はハイライトが生成されないため、商品名をそのまま返す
+			highlights = []string{html.EscapeString(product.Name())}
+		}
+		hits = append(hits, models.NewProductHit(product, hit.Score, highlights))
+	}
+	return hits, nil
+}
+
+// Close はインデックスを閉じます。
+//
+// Returns:
+//   - error: エラー
+func (e *BleveSearchEngine) Close() error {
+	return e.index.Close()
+}
+
+// toProductFromFields はインデックスに保存されたフィールドから商品を復元します。
+func toProductFromFields(id string, fields map[string]any) *models.Product {
+	name, _ := fields[FIELD_NAME].(string)
+	price, _ := fields[FIELD_PRICE].(float64)
+	categoryId, _ := fields[FIELD_CATEGORY_ID].(string)
+	categoryName, _ := fields[FIELD_CATEGORY_NAME].(string)
+	category := models.NewCategory(categoryId, categoryName)
+	if translations := unmarshalTranslations(fields[FIELD_CATEGORY_TRANSLATIONS]); translations != nil {
+		category = category.WithTranslations(translations)
+	}
+	product := models.NewProduct(id, name, uint32(price), category)
+	// 通貨と税率区分を保存する前に作成されたインデックスの場合はデフォルト値のままとする
+	currency, _ := fields[FIELD_CURRENCY].(string)
+	taxClass, _ := fields[FIELD_TAX_CLASS].(string)
+	if currency != "" && taxClass != "" {
+		product = product.WithTax(currency, money.TaxClass(taxClass))
+	}
+	if translations := unmarshalTranslations(fields[FIELD_TRANSLATIONS]); translations != nil {
+		product = product.WithTranslations(translations)
+	}
+	return product
+}
+
+// storedFields は検索結果から商品を復元するために取得するフィールドです。
+var storedFields = []string{
+	FIELD_NAME, FIELD_PRICE, FIELD_CURRENCY, FIELD_TAX_CLASS, FIELD_CATEGORY_ID, FIELD_CATEGORY_NAME,
+	FIELD_TRANSLATIONS, FIELD_CATEGORY_TRANSLATIONS,
+}
+
+// highlightsOf は既定のロケールの商品名、翻訳の順に一致箇所を含むフラグメントを返します。
+// 一致箇所を含むフラグメントがない場合は既定のロケールの商品名のフラグメントを返します。
+func highlightsOf(fragments map[string][]string) []string {
+	matched := slices.DeleteFunc(
+		slices.Concat(fragments[FIELD_NAME], fragments[FIELD_TRANSLATED_NAMES]),
+		func(fragment string) bool { return !strings.Contains(fragment, "<mark>") },
+	)
+	if len(matched) == 0 {
+		return fragments[FIELD_NAME]
+	}
+	return matched
+}
+
+// marshalTranslations は翻訳をインデックスに保存するJSONに変換します。
+func marshalTranslations(translations models.Translations) string {
+	// map[string]stringのJSON変換は失敗しない
+	b, _ := json.Marshal(translations)
+	return string(b)
+}
+
+// unmarshalTranslations はインデックスに保存されたJSONから翻訳を復元します。
+// 翻訳が保存されていない場合はnilを返します。
+func unmarshalTranslations(field any) models.Translations {
+	value, ok := field.(string)
+	if !ok || value == "" {
+		return nil
+	}
+	var translations models.Translations
+	if err := json.Unmarshal([]byte(value), &translations); err != nil {
+		return nil
+	}
+	return translations
+}
+
+func (e *BleveSearchEngine) categoryCount() int {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return max(len(e.categoryNames), 1)
+}
+
+func (e *BleveSearchEngine) categoryFacets(res *bleve.SearchResult) []*models.FacetCount {
+	facet, ok := res.Facets[FACET_CATEGORY]
+	if !ok || facet.Terms == nil {
+		return nil
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	terms := facet.Terms.Terms()
+	counts := make([]*models.FacetCount, 0, len(terms))
+	for _, term := range terms {
+		counts = append(counts, models.NewFacetCount(term.Term, e.categoryNames[term.Term], term.Count))
+	}
+	return counts
+}
+
+// priceBandFacets は価格帯ファセットを区間の定義順に並べて返します。件数が0の区間は含みません。
+func priceBandFacets(res *bleve.SearchResult) []*models.FacetCount {
+	facet, ok := res.Facets[FACET_PRICE_BAND]
+	if !ok {
+		return nil
+	}
+	byKey := make(map[string]int, len(facet.NumericRanges))
+	for _, r := range facet.NumericRanges {
+		byKey[r.Name] = r.Count
+	}
+	counts := make([]*models.FacetCount, 0, len(priceBands))
+	for _, b := range priceBands {
+		if c := byKey[b.key]; c > 0 {
+			counts = append(counts, models.NewFacetCount(b.key, b.label, c))
+		}
+	}
+	return counts
+}
+
+var _ repository.SearchEngine = (*BleveSearchEngine)(nil)
@@ -0,0 +1,230 @@
+package search_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/search"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestProducts() []*models.Product {
+	stationery := models.NewCategory("cat-stationery", "文房具")
+	pc := models.NewCategory("cat-pc", "パソコン周辺機器").WithTranslations(models.Translations{"en": "PC Peripherals"})
+	return []*models.Product{
+		models.NewProduct("p1", "水性ボールペン(黒)", 120, stationery),
+		models.NewProduct("p2", "油性ボールペン(赤)", 100, stationery),
+		models.NewProduct("p3", "色鉛筆(48色)", 1300, stationery).WithTax("JPY", money.TaxClassReduced),
+		models.NewProduct("p4", "USB有線式キーボード", 1400, pc),
+		models.NewProduct("p5", "光学式ゲーミングマウス", 4800, pc),
+		models.NewProduct("p6", "ワイヤレスマウス", 900, pc).WithTranslations(models.Translations{"en": "Wireless Mouse"}),
+	}
+}
+
+func newTestEngine(t *testing.T, indexPath string) *search.BleveSearchEngine {
+	t.Helper()
+	engine, err := search.NewBleveSearchEngine(&search.SearchConfig{IndexPath: indexPath, MaxResults: 10}, testhelpers.TestLogger)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = engine.Close() })
+	return engine
+}
+
+func hitIds(result *models.SearchResult) []string {
+	ids := make([]string, len(result.Hits()))
+	for i, hit := range result.Hits() {
+		ids[i] = hit.Product().Id()
+	}
+	return ids
+}
+
+func TestBleveSearchEngine_Search(t *testing.T) {
+	ctx := context.Background()
+	engine := newTestEngine(t, "")
+	require.NoError(t, engine.Reindex(ctx, newTestProducts()))
+
+	t.Run("正常系_日本語のキーワードで検索できる", func(t *testing.T) {
+		result, err := engine.Search(ctx, "ボールペン")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(2), result.Total())
+		assert.ElementsMatch(t, []string{"p1", "p2"}, hitIds(result))
+		hit := result.Hits()[0]
+		assert.Greater(t, hit.Score(), 0.0)
+		require.NotEmpty(t, hit.Highlights())
+		assert.Contains(t, hit.Highlights()[0], "<mark>ボールペン</mark>")
+		assert.Equal(t, "文房具", hit.Product().Category().Name())
+	})
+
+	t.Run("正常系_検索結果に通貨と税率区分が復元される", func(t *testing.T) {
+		result, err := engine.Search(ctx, "色鉛筆")
+		require.NoError(t, err)
+
+		require.Len(t, result.Hits(), 1)
+		product := result.Hits()[0].Product()
+		assert.Equal(t, uint32(1300), product.Price())
+		assert.Equal(t, "JPY", product.Currency())
+		assert.Equal(t, money.TaxClassReduced, product.TaxClass())
+	})
+
+	t.Run("正常系_翻訳の商品名でも検索でき、翻訳を復元できる", func(t *testing.T) {
+		result, err := engine.Search(ctx, "wireless mouse")
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"p6"}, hitIds(result))
+		hit := result.Hits()[0]
+		require.NotEmpty(t, hit.Highlights())
+		assert.Contains(t, hit.Highlights()[0], "<mark>Wireless</mark>")
+		assert.Equal(t, "Wireless Mouse", hit.Product().Translations()["en"])
+		assert.Equal(t, "PC Peripherals", hit.Product().Category().Translations()["en"])
+	})
+
+	t.Run("正常系_複数語はすべてを含む商品が対象になる", func(t *testing.T) {
+		result, err := engine.Search(ctx, "ボールペン 黒")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"p1"}, hitIds(result))
+	})
+
+	t.Run("正常系_部分的な文字の重なりではヒットしない", func(t *testing.T) {
+		// 「キーボード」は「ボー」を含むが「ボールペン」のbigramをすべては含まない
+		result, err := engine.Search(ctx, "ボールペン")
+		require.NoError(t, err)
+
+		assert.NotContains(t, hitIds(result), "p4")
+	})
+
+	t.Run("正常系_全角英数字と大文字小文字を区別しない", func(t *testing.T) {
+		result, err := engine.Search(ctx, "ｕｓｂ")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"p4"}, hitIds(result))
+	})
+
+	t.Run("正常系_カテゴリと価格帯のファセットを返す", func(t *testing.T) {
+		result, err := engine.Search(ctx, "マウス")
+		require.NoError(t, err)
+
+		require.Len(t, result.Categories(), 1)
+		assert.Equal(t, "cat-pc", result.Categories()[0].Key())
+		assert.Equal(t, "パソコン周辺機器", result.Categories()[0].Label())
+		assert.Equal(t, 2, result.Categories()[0].Count())
+
+		require.Len(t, result.PriceBands(), 2)
+		assert.Equal(t, "500-1000", result.PriceBands()[0].Key())
+		assert.Equal(t, 1, result.PriceBands()[0].Count())
+		assert.Equal(t, "1000-5000", result.PriceBands()[1].Key())
+		assert.Equal(t, 1, result.PriceBands()[1].Count())
+	})
+
+	t.Run("正常系_ヒットしない場合は空の結果を返す", func(t *testing.T) {
+		result, err := engine.Search(ctx, "存在しない商品")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(0), result.Total())
+		assert.Empty(t, result.Hits())
+	})
+
+	t.Run("異常系_キーワードが空", func(t *testing.T) {
+		_, err := engine.Search(ctx, "")
+		assert.Error(t, err)
+	})
+}
+
+func TestBleveSearchEngine_Reindex(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系_含まれない商品はインデックスから削除される", func(t *testing.T) {
+		engine := newTestEngine(t, "")
+		products := newTestProducts()
+		require.NoError(t, engine.Reindex(ctx, products))
+
+		// p1を削除し、p2の名前を変更
+		renamed := models.NewProduct("p2", "油性マーカー(赤)", 100, products[1].Category())
+		require.NoError(t, engine.Reindex(ctx, append([]*models.Product{renamed}, products[2:]...)))
+
+		result, err := engine.Search(ctx, "ボールペン")
+		require.NoError(t, err)
+		assert.Empty(t, hitIds(result))
+
+		result, err = engine.Search(ctx, "マーカー")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p2"}, hitIds(result))
+	})
+
+	t.Run("正常系_ディスク上のインデックスを再度開ける", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "products.bleve")
+		engine, err := search.NewBleveSearchEngine(&search.SearchConfig{IndexPath: path, MaxResults: 10}, testhelpers.TestLogger)
+		require.NoError(t, err)
+		require.NoError(t, engine.Reindex(ctx, newTestProducts()))
+		require.NoError(t, engine.Close())
+
+		reopened := newTestEngine(t, path)
+		result, err := reopened.Search(ctx, "マウス")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"p5", "p6"}, hitIds(result))
+		assert.Equal(t, "パソコン周辺機器", result.Categories()[0].Label())
+
+		// 再度開いた後も削除が反映される
+		require.NoError(t, reopened.Reindex(ctx, newTestProducts()[:5]))
+		result, err = reopened.Search(ctx, "マウス")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p5"}, hitIds(result))
+	})
+}
+
+func TestBleveSearchEngine_Suggest(t *testing.T) {
+	ctx := context.Background()
+	engine := newTestEngine(t, "")
+	require.NoError(t, engine.Reindex(ctx, newTestProducts()))
+
+	suggestIds := func(hits []*models.ProductHit) []string {
+		ids := make([]string, len(hits))
+		for i, hit := range hits {
+			ids[i] = hit.Product().Id()
+		}
+		return ids
+	}
+
+	t.Run("正常系_1文字の前方一致でサジェストできる", func(t *testing.T) {
+		hits, err := engine.Suggest(ctx, "ワ", 10)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"p6"}, suggestIds(hits))
+		require.NotEmpty(t, hits[0].Highlights())
+		assert.Equal(t, "パソコン周辺機器", hits[0].Product().Category().Name())
+	})
+
+	t.Run("正常系_前方一致が部分一致より上位になる", func(t *testing.T) {
+		hits, err := engine.Suggest(ctx, "ボールペ", 10)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"p1", "p2"}, suggestIds(hits))
+
+		hits, err = engine.Suggest(ctx, "マウス", 10)
+		require.NoError(t, err)
+		require.Len(t, hits, 2)
+		assert.Equal(t, "p5", hits[1].Product().Id(), "長い名前の部分一致は後ろになる")
+	})
+
+	t.Run("正常系_全角英字でも前方一致する", func(t *testing.T) {
+		hits, err := engine.Suggest(ctx, "ｕｓ", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p4"}, suggestIds(hits))
+	})
+
+	t.Run("正常系_件数を制限できる", func(t *testing.T) {
+		hits, err := engine.Suggest(ctx, "ボールペン", 1)
+		require.NoError(t, err)
+		assert.Len(t, hits, 1)
+	})
+
+	t.Run("正常系_空の検索語は空の結果を返す", func(t *testing.T) {
+		hits, err := engine.Suggest(ctx, "", 10)
+		require.NoError(t, err)
+		assert.Empty(t, hits)
+	})
+}
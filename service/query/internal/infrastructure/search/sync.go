@@ -0,0 +1,144 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/repository"
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+)
+
+// SearchConfig は全文検索エンジンの設定を保持します。
+type SearchConfig struct {
+	IndexPath    string        // インデックスの保存先（空の場合はメモリ上に作成）
+	SyncInterval time.Duration // クエリDBからインデックスへ同期する間隔
+	MaxResults   int           // 1回の検索で返す最大件数
+}
+
+// NewSearchConfig はViperから設定を読み込みSearchConfigを生成します。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *SearchConfig: 検索エンジン設定
+//   - error: 設定の読み込みに失敗した場合のエラー
+func NewSearchConfig(v *viper.Viper) (*SearchConfig, error) {
+	var configErrors []error
+	cfg := &SearchConfig{
+		IndexPath:    utils.GetKey[string](v, "search.index_path", &configErrors),
+		SyncInterval: utils.GetKey[time.Duration](v, "search.sync_interval", &configErrors),
+		MaxResults:   utils.GetKey[int](v, "search.max_results", &configErrors),
+	}
+	if len(configErrors) > 0 {
+		return cfg, errors.Join(configErrors...)
+	}
+	return cfg, nil
+}
+
+// IndexSyncer はクエリDBの商品を定期的に検索インデックスへ同期します。
+type IndexSyncer struct {
+	engine   repository.SearchEngine
+	repo     repository.ProductRepository
+	interval time.Duration
+	logger   *slog.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewIndexSyncer はIndexSyncerを生成します。
+//
+// Parameters:
+//   - cfg: 検索エンジン設定
+//   - engine: 検索エンジン
+//   - repo: 商品リポジトリ
+//   - logger: ロガー
+//
+// Returns:
+//   - *IndexSyncer: IndexSyncerポインタ
+func NewIndexSyncer(cfg *SearchConfig, engine repository.SearchEngine, repo repository.ProductRepository, logger *slog.Logger) *IndexSyncer {
+	return &IndexSyncer{engine: engine, repo: repo, interval: cfg.SyncInterval, logger: logger}
+}
+
+// Sync はクエリDBの全商品でインデックスを1回同期します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//
+// Returns:
+//   - error: エラー
+func (s *IndexSyncer) Sync(ctx context.Context) error {
+	products, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	return s.engine.Reindex(ctx, products)
+}
+
+// Start は初回同期を行い、以降はバックグラウンドで定期的に同期します。
+// 初回同期に失敗した場合も起動は継続し、検索はLIKE検索にフォールバックします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+func (s *IndexSyncer) Start(ctx context.Context) {
+	if err := s.Sync(ctx); err != nil {
+		s.logger.WarnContext(ctx, "Initial search index sync failed", slog.Any("error", err))
+	}
+	if s.interval <= 0 {
+		return
+	}
+
+	loopCtx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-loopCtx.Done():
+				return
+			case <-ticker.C:
+				if err := s.Sync(loopCtx); err != nil && loopCtx.Err() == nil {
+					s.logger.WarnContext(loopCtx, "Search index sync failed", slog.Any("error", err))
+				}
+			}
+		}
+	}()
+}
+
+// Stop はバックグラウンドの同期を停止します。
+func (s *IndexSyncer) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+// RegisterLifecycleHooks は検索インデックスの同期と終了処理をFxライフサイクルに登録します。
+//
+// Parameters:
+//   - lc: Fxライフサイクル
+//   - syncer: インデックス同期
+//   - engine: 検索エンジン
+//   - logger: ロガー
+func RegisterLifecycleHooks(lc fx.Lifecycle, syncer *IndexSyncer, engine *BleveSearchEngine, logger *slog.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			syncer.Start(ctx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			syncer.Stop()
+			logger.InfoContext(ctx, "Closing search index...")
+			return engine.Close()
+		},
+	})
+}
@@ -41,19 +41,34 @@ func (m *MockProductRepository) EXPECT() *MockProductRepositoryMockRecorder {
 	return m.recorder
 }
 
+// FindByBarcode mocks base method.
+func (m *MockProductRepository) FindByBarcode(ctx context.Context, barcode string) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBarcode", ctx, barcode)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByBarcode indicates an expected call of FindByBarcode.
+func (mr *MockProductRepositoryMockRecorder) FindByBarcode(ctx, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBarcode", reflect.TypeOf((*MockProductRepository)(nil).FindByBarcode), ctx, barcode)
+}
+
 // FindById mocks base method.
-func (m *MockProductRepository) FindById(ctx context.Context, id string) (*models.Product, error) {
+func (m *MockProductRepository) FindById(ctx context.Context, id string, includeUnpublished bool) (*models.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret := m.ctrl.Call(m, "FindById", ctx, id, includeUnpublished)
 	ret0, _ := ret[0].(*models.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindById indicates an expected call of FindById.
-func (mr *MockProductRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) FindById(ctx, id, includeUnpublished any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockProductRepository)(nil).FindById), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockProductRepository)(nil).FindById), ctx, id, includeUnpublished)
 }
 
 // FindByNameLike mocks base method.
@@ -71,6 +86,36 @@ func (mr *MockProductRepositoryMockRecorder) FindByNameLike(ctx, keyword any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNameLike", reflect.TypeOf((*MockProductRepository)(nil).FindByNameLike), ctx, keyword)
 }
 
+// FindBySlug mocks base method.
+func (m *MockProductRepository) FindBySlug(ctx context.Context, slug string) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlug", ctx, slug)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MockProductRepositoryMockRecorder) FindBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockProductRepository)(nil).FindBySlug), ctx, slug)
+}
+
+// FindStockByProductId mocks base method.
+func (m *MockProductRepository) FindStockByProductId(ctx context.Context, productId string) (*models.Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStockByProductId", ctx, productId)
+	ret0, _ := ret[0].(*models.Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStockByProductId indicates an expected call of FindStockByProductId.
+func (mr *MockProductRepositoryMockRecorder) FindStockByProductId(ctx, productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStockByProductId", reflect.TypeOf((*MockProductRepository)(nil).FindStockByProductId), ctx, productId)
+}
+
 // List mocks base method.
 func (m *MockProductRepository) List(ctx context.Context) ([]*models.Product, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +131,21 @@ func (mr *MockProductRepositoryMockRecorder) List(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProductRepository)(nil).List), ctx)
 }
 
+// ListByFilter mocks base method.
+func (m *MockProductRepository) ListByFilter(ctx context.Context, filter *models.ProductFilter) ([]*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByFilter", ctx, filter)
+	ret0, _ := ret[0].([]*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByFilter indicates an expected call of ListByFilter.
+func (mr *MockProductRepositoryMockRecorder) ListByFilter(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByFilter", reflect.TypeOf((*MockProductRepository)(nil).ListByFilter), ctx, filter)
+}
+
 // MockCategoryRepository is a mock of CategoryRepository interface.
 type MockCategoryRepository struct {
 	ctrl     *gomock.Controller
@@ -110,6 +170,21 @@ func (m *MockCategoryRepository) EXPECT() *MockCategoryRepositoryMockRecorder {
 	return m.recorder
 }
 
+// FindAncestors mocks base method.
+func (m *MockCategoryRepository) FindAncestors(ctx context.Context, id string) ([]*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAncestors", ctx, id)
+	ret0, _ := ret[0].([]*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAncestors indicates an expected call of FindAncestors.
+func (mr *MockCategoryRepositoryMockRecorder) FindAncestors(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAncestors", reflect.TypeOf((*MockCategoryRepository)(nil).FindAncestors), ctx, id)
+}
+
 // FindById mocks base method.
 func (m *MockCategoryRepository) FindById(ctx context.Context, id string) (*models.Category, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +200,51 @@ func (mr *MockCategoryRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockCategoryRepository)(nil).FindById), ctx, id)
 }
 
+// FindBySlug mocks base method.
+func (m *MockCategoryRepository) FindBySlug(ctx context.Context, slug string) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlug", ctx, slug)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MockCategoryRepositoryMockRecorder) FindBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockCategoryRepository)(nil).FindBySlug), ctx, slug)
+}
+
+// FindChildren mocks base method.
+func (m *MockCategoryRepository) FindChildren(ctx context.Context, parentId string) ([]*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChildren", ctx, parentId)
+	ret0, _ := ret[0].([]*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChildren indicates an expected call of FindChildren.
+func (mr *MockCategoryRepositoryMockRecorder) FindChildren(ctx, parentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChildren", reflect.TypeOf((*MockCategoryRepository)(nil).FindChildren), ctx, parentId)
+}
+
+// FindSubtree mocks base method.
+func (m *MockCategoryRepository) FindSubtree(ctx context.Context, id string) (*models.CategoryNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubtree", ctx, id)
+	ret0, _ := ret[0].(*models.CategoryNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubtree indicates an expected call of FindSubtree.
+func (mr *MockCategoryRepositoryMockRecorder) FindSubtree(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubtree", reflect.TypeOf((*MockCategoryRepository)(nil).FindSubtree), ctx, id)
+}
+
 // List mocks base method.
 func (m *MockCategoryRepository) List(ctx context.Context) ([]*models.Category, error) {
 	m.ctrl.T.Helper()
@@ -139,3 +259,42 @@ func (mr *MockCategoryRepositoryMockRecorder) List(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCategoryRepository)(nil).List), ctx)
 }
+
+// MockTagRepository is a mock of TagRepository interface.
+type MockTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTagRepositoryMockRecorder is the mock recorder for MockTagRepository.
+type MockTagRepositoryMockRecorder struct {
+	mock *MockTagRepository
+}
+
+// NewMockTagRepository creates a new mock instance.
+func NewMockTagRepository(ctrl *gomock.Controller) *MockTagRepository {
+	mock := &MockTagRepository{ctrl: ctrl}
+	mock.recorder = &MockTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListWithUsage mocks base method.
+func (m *MockTagRepository) ListWithUsage(ctx context.Context) ([]*models.TagUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithUsage", ctx)
+	ret0, _ := ret[0].([]*models.TagUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithUsage indicates an expected call of ListWithUsage.
+func (mr *MockTagRepositoryMockRecorder) ListWithUsage(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithUsage", reflect.TypeOf((*MockTagRepository)(nil).ListWithUsage), ctx)
+}
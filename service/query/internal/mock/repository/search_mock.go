@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: search.go
+//
+// Generated by this command:
+//
+//	mockgen -source=search.go -destination=../../mock/repository/search_mock.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSearchEngine is a mock of SearchEngine interface.
+type MockSearchEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchEngineMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchEngineMockRecorder is the mock recorder for MockSearchEngine.
+type MockSearchEngineMockRecorder struct {
+	mock *MockSearchEngine
+}
+
+// NewMockSearchEngine creates a new mock instance.
+func NewMockSearchEngine(ctrl *gomock.Controller) *MockSearchEngine {
+	mock := &MockSearchEngine{ctrl: ctrl}
+	mock.recorder = &MockSearchEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchEngine) EXPECT() *MockSearchEngineMockRecorder {
+	return m.recorder
+}
+
+// Reindex mocks base method.
+func (m *MockSearchEngine) Reindex(ctx context.Context, products []*models.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reindex", ctx, products)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reindex indicates an expected call of Reindex.
+func (mr *MockSearchEngineMockRecorder) Reindex(ctx, products any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reindex", reflect.TypeOf((*MockSearchEngine)(nil).Reindex), ctx, products)
+}
+
+// Search mocks base method.
+func (m *MockSearchEngine) Search(ctx context.Context, keyword string) (*models.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, keyword)
+	ret0, _ := ret[0].(*models.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchEngineMockRecorder) Search(ctx, keyword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchEngine)(nil).Search), ctx, keyword)
+}
+
+// Suggest mocks base method.
+func (m *MockSearchEngine) Suggest(ctx context.Context, prefix string, limit int) ([]*models.ProductHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, prefix, limit)
+	ret0, _ := ret[0].([]*models.ProductHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockSearchEngineMockRecorder) Suggest(ctx, prefix, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockSearchEngine)(nil).Suggest), ctx, prefix, limit)
+}
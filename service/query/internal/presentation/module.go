@@ -5,9 +5,9 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
-	"time"
 
 	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/httpserver"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/presentation/server"
 	"go.uber.org/fx"
@@ -22,10 +22,16 @@ var Module = fx.Module(
 			server.NewCategoryServiceHandlerImpl,
 			fx.As(new(queryconnect.CategoryServiceHandler)),
 		),
+		server.NewTaxConfig,
 		fx.Annotate(
 			server.NewProductServiceHandlerImpl,
 			fx.As(new(queryconnect.ProductServiceHandler)),
 		),
+		fx.Annotate(
+			server.NewTagServiceHandlerImpl,
+			fx.As(new(queryconnect.TagServiceHandler)),
+		),
+		httpserver.NewConfig,
 		server.NewQueryServer,
 	),
 	fx.Invoke(registerLifecycleHooks),
@@ -36,12 +42,13 @@ var Module = fx.Module(
 // Parameters:
 //   - lc: FXライフサイクルマネージャー
 //   - srv: 管理対象のクエリサーバーインスタンス
+//   - cfg: HTTPサーバーの設定（停止時の待ち時間）
 //   - logger: サーバーライフサイクルイベントを記録するロガー
 //
 // この関数は以下の2つのフックを登録します：
 //   - OnStart: 別のゴルーチンでgRPCサーバーを起動し、起動イベントをログに記録
-//   - OnStop: 30秒のタイムアウトでグレースフルシャットダウンを実行し、停止イベントをログに記録
-func registerLifecycleHooks(lc fx.Lifecycle, srv *server.QueryServer, logger *slog.Logger) {
+//   - OnStop: ヘルスチェックをNOT_SERVINGにしてdrain_periodだけ待ち、shutdown_timeoutを上限にグレースフルシャットダウンを実行し、停止イベントをログに記録
+func registerLifecycleHooks(lc fx.Lifecycle, srv *server.QueryServer, cfg *httpserver.Config, logger *slog.Logger) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			// port競合によるエラーになる問題の対策のため、ListenAndServeではなく、ListenしてServeする
@@ -61,11 +68,8 @@ func registerLifecycleHooks(lc fx.Lifecycle, srv *server.QueryServer, logger *sl
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
-			shutdownCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-			defer cancel()
-
-			// サーバーをグレースフルシャットダウン
-			if shutdownErr := srv.Server.Shutdown(shutdownCtx); shutdownErr != nil {
+			// ロードバランサーの振り分け対象から外れるのを待ってから、サーバーをグレースフルシャットダウン
+			if shutdownErr := httpserver.Shutdown(ctx, srv.Server, srv.Health, srv.Services, cfg, logger); shutdownErr != nil {
 				logger.Error("Server shutdown failed", "error", shutdownErr)
 				return shutdownErr
 			}
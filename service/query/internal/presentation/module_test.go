@@ -35,8 +35,10 @@ func TestModule(t *testing.T) {
 			return slog.New(slog.NewTextHandler(io.Discard, nil)), nil
 		},
 		// Viperの設定をデコレートしてポート0(動的に空いているところを使用)を指定する
+		// 停止時の待ち時間は不要なため0にする
 		func(v *viper.Viper) *viper.Viper {
 			v.Set("server.port", "0")
+			v.Set("server.drain_period", "0s")
 			return v
 		},
 	)
@@ -4,15 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"strings"
+	"sync"
 
 	"connectrpc.com/connect"
 	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
 	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
 	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/repository"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // CategoryServiceHandlerImpl はCategoryServiceのgRPCハンドラ実装です。
@@ -56,6 +61,12 @@ func (h *CategoryServiceHandlerImpl) ListCategories(ctx context.Context, req *co
 		return nil, handleError(err, "failed to list categories")
 	}
 
+	// Accept-Languageで指定されたロケールのカテゴリ名に置き換える
+	locales := preferredLocales("", req.Header())
+	for i, category := range categories {
+		categories[i] = category.Localize(locales)
+	}
+
 	// レスポンス生成
 	res := &query.ListCategoriesResponse{}
 	res.SetCategories(toCategoriesProto(categories))
@@ -82,15 +93,116 @@ func (h *CategoryServiceHandlerImpl) GetCategoryById(ctx context.Context, req *c
 
 	// レスポンス生成
 	res := &query.GetCategoryByIdResponse{}
-	res.SetCategory(toCategoryProto(category))
+	res.SetCategory(toCategoryProto(category.Localize(preferredLocales("", req.Header()))))
+
+	return connect.NewResponse(res), nil
+}
+
+// GetCategoryBySlug はスラッグでカテゴリを取得します。
+// 変更前のスラッグで問合せした場合はmovedをtrueにし、クライアントが現在のスラッグへリダイレクトできるようにします。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - req: リクエスト
+//
+// Returns:
+//   - *connect.Response[query.GetCategoryBySlugResponse]: レスポンス
+//   - error: カテゴリが存在しない場合はCodeNotFound
+func (h *CategoryServiceHandlerImpl) GetCategoryBySlug(ctx context.Context, req *connect.Request[query.GetCategoryBySlugRequest]) (*connect.Response[query.GetCategoryBySlugResponse], error) {
+	// カテゴリを取得
+	category, err := h.repo.FindBySlug(ctx, req.Msg.GetSlug())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to get category by slug", "error", err, "slug", req.Msg.GetSlug())
+		return nil, handleError(err, "failed to get category by slug")
+	}
+
+	// レスポンス生成
+	res := &query.GetCategoryBySlugResponse{}
+	res.SetCategory(toCategoryProto(category.Localize(preferredLocales("", req.Header()))))
+	res.SetMoved(isSlugMoved(req.Msg.GetSlug(), category.Slug()))
+
+	return connect.NewResponse(res), nil
+}
+
+// ListChildCategories は子カテゴリ一覧を取得します。
+// 親カテゴリIDが未設定の場合はルートカテゴリ一覧を返します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - req: リクエスト
+//
+// Returns:
+//   - *connect.Response[query.ListChildCategoriesResponse]: レスポンス
+//   - error: エラー
+func (h *CategoryServiceHandlerImpl) ListChildCategories(ctx context.Context, req *connect.Request[query.ListChildCategoriesRequest]) (*connect.Response[query.ListChildCategoriesResponse], error) {
+	// 子カテゴリ一覧を取得
+	categories, err := h.repo.FindChildren(ctx, req.Msg.GetParentId())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to list child categories", "error", err, "parent_id", req.Msg.GetParentId())
+		return nil, handleError(err, "failed to list child categories")
+	}
+
+	// レスポンス生成
+	res := &query.ListChildCategoriesResponse{}
+	res.SetCategories(toCategoriesProto(categories))
+
+	return connect.NewResponse(res), nil
+}
+
+// GetCategoryAncestors はルートから指定されたカテゴリまでのカテゴリ（パンくずリスト）を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - req: リクエスト
+//
+// Returns:
+//   - *connect.Response[query.GetCategoryAncestorsResponse]: レスポンス
+//   - error: エラー
+func (h *CategoryServiceHandlerImpl) GetCategoryAncestors(ctx context.Context, req *connect.Request[query.GetCategoryAncestorsRequest]) (*connect.Response[query.GetCategoryAncestorsResponse], error) {
+	// 祖先カテゴリを取得
+	categories, err := h.repo.FindAncestors(ctx, req.Msg.GetId())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to get category ancestors", "error", err, "id", req.Msg.GetId())
+		return nil, handleError(err, "failed to get category ancestors")
+	}
+
+	// レスポンス生成
+	res := &query.GetCategoryAncestorsResponse{}
+	res.SetCategories(toCategoriesProto(categories))
+
+	return connect.NewResponse(res), nil
+}
+
+// GetCategorySubtree は指定されたカテゴリを根とする部分木を取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - req: リクエスト
+//
+// Returns:
+//   - *connect.Response[query.GetCategorySubtreeResponse]: レスポンス
+//   - error: エラー
+func (h *CategoryServiceHandlerImpl) GetCategorySubtree(ctx context.Context, req *connect.Request[query.GetCategorySubtreeRequest]) (*connect.Response[query.GetCategorySubtreeResponse], error) {
+	// 部分木を取得
+	root, err := h.repo.FindSubtree(ctx, req.Msg.GetId())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to get category subtree", "error", err, "id", req.Msg.GetId())
+		return nil, handleError(err, "failed to get category subtree")
+	}
+
+	// レスポンス生成
+	res := &query.GetCategorySubtreeResponse{}
+	res.SetRoot(toCategoryNodeProto(root))
 
 	return connect.NewResponse(res), nil
 }
 
 // ProductServiceHandlerImpl はProductServiceのgRPCハンドラ実装です。
 type ProductServiceHandlerImpl struct {
-	logger *slog.Logger                 // ロガー
-	repo   repository.ProductRepository // 商品リポジトリ
+	logger   *slog.Logger                 // ロガー
+	repo     repository.ProductRepository // 商品リポジトリ
+	search   repository.SearchEngine      // 全文検索エンジン
+	rounding money.Rounding               // 税込価格の端数処理の方法
 	queryconnect.UnimplementedProductServiceHandler
 }
 
@@ -100,18 +212,25 @@ type ProductServiceHandlerImpl struct {
 //   - logger: ロガー
 //   - validator: バリデータ
 //   - repo: 商品リポジトリ
+//   - search: 全文検索エンジン
+//   - tax: 税込価格の計算設定
 //
 // Returns:
 //   - *ProductServiceHandlerImpl: ハンドラインスタンス
 //   - error: エラー
-func NewProductServiceHandlerImpl(logger *slog.Logger, repo repository.ProductRepository) (*ProductServiceHandlerImpl, error) {
+func NewProductServiceHandlerImpl(logger *slog.Logger, repo repository.ProductRepository, search repository.SearchEngine, tax *TaxConfig) (*ProductServiceHandlerImpl, error) {
 	return &ProductServiceHandlerImpl{
-		logger: logger,
-		repo:   repo,
+		logger:   logger,
+		repo:     repo,
+		search:   search,
+		rounding: tax.Rounding,
 	}, nil
 }
 
 // ListProducts は商品一覧を取得します。
+// カテゴリIDが設定されている場合はそのカテゴリ（include_descendantsがtrueの場合は子孫カテゴリも含む）の商品を返します。
+// タグが設定されている場合は、指定されたすべてのタグが付与された商品に絞り込みます。
+// 商品名・カテゴリ名はリクエストのロケール（未設定の場合はAccept-Languageヘッダ）の名前を返します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -122,7 +241,19 @@ func NewProductServiceHandlerImpl(logger *slog.Logger, repo repository.ProductRe
 //   - error: エラー
 func (h *ProductServiceHandlerImpl) ListProducts(ctx context.Context, req *connect.Request[query.ListProductsRequest]) (*connect.Response[query.ListProductsResponse], error) {
 	// 商品取得
-	products, err := h.repo.List(ctx)
+	var (
+		products []*models.Product
+		err      error
+	)
+	filter := models.NewProductFilter().WithTags(req.Msg.GetTags())
+	if req.Msg.HasCategoryId() {
+		filter = filter.WithCategory(req.Msg.GetCategoryId(), req.Msg.GetIncludeDescendants())
+	}
+	if filter.IsEmpty() {
+		products, err = h.repo.List(ctx)
+	} else {
+		products, err = h.repo.ListByFilter(ctx, filter)
+	}
 	if err != nil {
 		h.logger.ErrorContext(ctx, "Failed to list products", "error", err)
 		return nil, handleError(err, "failed to list products")
@@ -130,12 +261,14 @@ func (h *ProductServiceHandlerImpl) ListProducts(ctx context.Context, req *conne
 
 	// レスポンス生成
 	res := &query.ListProductsResponse{}
-	res.SetProducts(toProductsProto(products))
+	products = localizeProducts(products, preferredLocales(req.Msg.GetLocale(), req.Header()))
+	res.SetProducts(toProductsProto(products, h.rounding))
 
 	return connect.NewResponse(res), nil
 }
 
 // StreamProducts は商品一覧をサーバーストリームで返します。
+// 商品名・カテゴリ名はAccept-Languageヘッダのロケールの名前を返します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -149,9 +282,10 @@ func (h *ProductServiceHandlerImpl) StreamProducts(ctx context.Context, req *con
 		h.logger.ErrorContext(ctx, "Failed to list products", "error", err)
 		return handleError(err, "failed to list products")
 	} else {
+		locales := preferredLocales("", req.Header())
 		for _, product := range products {
 			res := &query.StreamProductsResponse{}
-			res.SetProduct(toProductProto(product))
+			res.SetProduct(toProductProto(product.Localize(locales), h.rounding))
 			if sendErr := stream.Send(res); sendErr != nil {
 				h.logger.ErrorContext(ctx, "Failed to send product in stream", "error", sendErr, "product_id", product.Id())
 				return handleError(sendErr, "failed to send product in stream")
@@ -161,7 +295,9 @@ func (h *ProductServiceHandlerImpl) StreamProducts(ctx context.Context, req *con
 	return nil
 }
 
-// GetProductById は商品IDで商品を取得します。
+// GetProductById は商品IDで公開中の商品を取得します。
+// include_unpublishedを指定した場合（catalogctlなどの管理用）は公開中以外の商品も取得します。
+// 商品名・カテゴリ名はリクエストのロケール（未設定の場合はAccept-Languageヘッダ）の名前を返します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -169,10 +305,10 @@ func (h *ProductServiceHandlerImpl) StreamProducts(ctx context.Context, req *con
 //
 // Returns:
 //   - *connect.Response[query.GetProductByIdResponse]: レスポンス
-//   - error: エラー
+//   - error: 商品が存在しない場合はCodeNotFound
 func (h *ProductServiceHandlerImpl) GetProductById(ctx context.Context, req *connect.Request[query.GetProductByIdRequest]) (*connect.Response[query.GetProductByIdResponse], error) {
 	// 商品を取得
-	product, err := h.repo.FindById(ctx, req.Msg.GetId())
+	product, err := h.repo.FindById(ctx, req.Msg.GetId(), req.Msg.GetIncludeUnpublished())
 	if err != nil {
 		h.logger.ErrorContext(ctx, "Failed to get product by id", "error", err, "id", req.Msg.GetId())
 		return nil, handleError(err, "failed to get product by id")
@@ -180,12 +316,95 @@ func (h *ProductServiceHandlerImpl) GetProductById(ctx context.Context, req *con
 
 	// レスポンス生成
 	res := &query.GetProductByIdResponse{}
-	res.SetProduct(toProductProto(product))
+	product = product.Localize(preferredLocales(req.Msg.GetLocale(), req.Header()))
+	res.SetProduct(toProductProto(product, h.rounding))
+
+	return connect.NewResponse(res), nil
+}
+
+// GetProductByBarcode はバーコードで公開中の商品を取得します。
+// 商品名・カテゴリ名はリクエストのロケール（未設定の場合はAccept-Languageヘッダ）の名前を返します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - req: リクエスト
+//
+// Returns:
+//   - *connect.Response[query.GetProductByBarcodeResponse]: レスポンス
+//   - error: 公開中の商品が存在しない場合はCodeNotFound
+func (h *ProductServiceHandlerImpl) GetProductByBarcode(ctx context.Context, req *connect.Request[query.GetProductByBarcodeRequest]) (*connect.Response[query.GetProductByBarcodeResponse], error) {
+	// 商品を取得
+	product, err := h.repo.FindByBarcode(ctx, req.Msg.GetBarcode())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to get product by barcode", "error", err, "barcode", req.Msg.GetBarcode())
+		return nil, handleError(err, "failed to get product by barcode")
+	}
+
+	// レスポンス生成
+	res := &query.GetProductByBarcodeResponse{}
+	product = product.Localize(preferredLocales(req.Msg.GetLocale(), req.Header()))
+	res.SetProduct(toProductProto(product, h.rounding))
+
+	return connect.NewResponse(res), nil
+}
+
+// GetProductBySlug はスラッグで公開中の商品を取得します。
+// 変更前のスラッグで問合せした場合はmovedをtrueにし、クライアントが現在のスラッグへリダイレクトできるようにします。
+// 商品名・カテゴリ名はリクエストのロケール（未設定の場合はAccept-Languageヘッダ）の名前を返します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - req: リクエスト
+//
+// Returns:
+//   - *connect.Response[query.GetProductBySlugResponse]: レスポンス
+//   - error: 公開中の商品が存在しない場合はCodeNotFound
+func (h *ProductServiceHandlerImpl) GetProductBySlug(ctx context.Context, req *connect.Request[query.GetProductBySlugRequest]) (*connect.Response[query.GetProductBySlugResponse], error) {
+	// 商品を取得
+	product, err := h.repo.FindBySlug(ctx, req.Msg.GetSlug())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to get product by slug", "error", err, "slug", req.Msg.GetSlug())
+		return nil, handleError(err, "failed to get product by slug")
+	}
+
+	// レスポンス生成
+	res := &query.GetProductBySlugResponse{}
+	product = product.Localize(preferredLocales(req.Msg.GetLocale(), req.Header()))
+	res.SetProduct(toProductProto(product, h.rounding))
+	res.SetMoved(isSlugMoved(req.Msg.GetSlug(), product.Slug()))
+
+	return connect.NewResponse(res), nil
+}
+
+// GetStock は商品IDで在庫を取得します。
+// 問合せ用DBはレプリカのため、直前のコマンドの結果が反映されていない場合があります。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - req: リクエスト
+//
+// Returns:
+//   - *connect.Response[query.GetStockResponse]: レスポンス
+//   - error: エラー
+func (h *ProductServiceHandlerImpl) GetStock(ctx context.Context, req *connect.Request[query.GetStockRequest]) (*connect.Response[query.GetStockResponse], error) {
+	// 在庫を取得
+	stock, err := h.repo.FindStockByProductId(ctx, req.Msg.GetProductId())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to get stock by product id", "error", err, "product_id", req.Msg.GetProductId())
+		return nil, handleError(err, "failed to get stock by product id")
+	}
+
+	// レスポンス生成
+	res := &query.GetStockResponse{}
+	res.SetStock(toStockProto(stock))
 
 	return connect.NewResponse(res), nil
 }
 
 // SearchProductsByKeyword は商品名のキーワードで商品を検索します。
+// 全文検索エンジンで関連度順に検索し、検索エンジンがエラーを返した場合やヒットしなかった場合
+// （bigramにならない1文字のキーワードなど）は商品名のLIKE検索にフォールバックします。
+// いずれの検索もすべてのロケールの商品名と照合し、結果の商品名はリクエストのロケールの名前を返します。
 //
 // Parameters:
 //   - ctx: コンテキスト
@@ -195,16 +414,223 @@ func (h *ProductServiceHandlerImpl) GetProductById(ctx context.Context, req *con
 //   - *connect.Response[query.SearchProductsByKeywordResponse]: レスポンス
 //   - error: エラー
 func (h *ProductServiceHandlerImpl) SearchProductsByKeyword(ctx context.Context, req *connect.Request[query.SearchProductsByKeywordRequest]) (*connect.Response[query.SearchProductsByKeywordResponse], error) {
-	// 商品取得
-	products, err := h.repo.FindByNameLike(ctx, req.Msg.GetKeyword())
+	keyword := req.Msg.GetKeyword()
+	locales := preferredLocales(req.Msg.GetLocale(), req.Header())
+
+	// 全文検索
+	result, err := h.search.Search(ctx, keyword)
+	if err == nil && result.Total() > 0 {
+		res := &query.SearchProductsByKeywordResponse{}
+		products := make([]*models.Product, len(result.Hits()))
+		hits := make([]*models.ProductHit, len(result.Hits()))
+		for i, hit := range result.Hits() {
+			products[i] = hit.Product().Localize(locales)
+			hits[i] = models.NewProductHit(products[i], hit.Score(), hit.Highlights())
+		}
+		res.SetProducts(toProductsProto(products, h.rounding))
+		res.SetHits(toSearchHitsProto(hits, h.rounding))
+		res.SetFacets(toSearchFacetsProto(result))
+		return connect.NewResponse(res), nil
+	}
+	if err != nil {
+		h.logger.WarnContext(ctx, "Full-text search failed, falling back to LIKE search", "error", err, "keyword", keyword)
+	}
+
+	// LIKE検索へのフォールバック
+	products, err := h.repo.FindByNameLike(ctx, keyword)
 	if err != nil {
-		h.logger.ErrorContext(ctx, "Failed to search products by keyword", "error", err, "keyword", req.Msg.GetKeyword())
+		h.logger.ErrorContext(ctx, "Failed to search products by keyword", "error", err, "keyword", keyword)
 		return nil, handleError(err, "failed to search products by keyword")
 	}
 
 	// レスポンス生成
 	res := &query.SearchProductsByKeywordResponse{}
-	res.SetProducts(toProductsProto(products))
+	res.SetProducts(toProductsProto(localizeProducts(products, locales), h.rounding))
+	res.SetFallback(true)
+
+	return connect.NewResponse(res), nil
+}
+
+// suggestLookups はSuggestProductsで最後に受信した検索語の通し番号と、処理中の問合せのキャンセル関数を保持します。
+// 受信と問合せの開始・送信の判定を同じロックで行い、古い検索語の結果を送信しないようにします。
+type suggestLookups struct {
+	mu     sync.Mutex
+	seq    uint64             // 最後に受信した検索語の通し番号
+	cancel context.CancelFunc // 処理中の問合せのキャンセル関数
+}
+
+// receive は新しい検索語の受信を記録し、処理中の古い検索語の問合せをキャンセルします。
+//
+// Returns:
+//   - uint64: 受信した検索語の通し番号
+func (l *suggestLookups) receive() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.seq++
+	if l.cancel != nil {
+		l.cancel()
+		l.cancel = nil
+	}
+	return l.seq
+}
+
+// start は検索語の問合せを開始します。より新しい検索語を受信済みの場合はcancelを呼び出してfalseを返します。
+//
+// Parameters:
+//   - seq: 検索語の通し番号
+//   - cancel: 問合せのキャンセル関数
+//
+// Returns:
+//   - bool: 問合せを開始できる場合はtrue
+func (l *suggestLookups) start(seq uint64, cancel context.CancelFunc) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if seq != l.seq {
+		cancel()
+		return false
+	}
+	l.cancel = cancel
+	return true
+}
+
+// isLatest は最後に受信した検索語かどうかを返します。
+//
+// Parameters:
+//   - seq: 検索語の通し番号
+//
+// Returns:
+//   - bool: 最後に受信した検索語の場合はtrue
+func (l *suggestLookups) isLatest(seq uint64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return seq == l.seq
+}
+
+// SuggestProducts は入力中の検索語を受信するたびにサジェストを返します。
+// 新しい検索語を受信すると処理中の古い検索語の問合せをキャンセルし、古い結果は送信しません。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - stream: 双方向ストリーム
+//
+// Returns:
+//   - error: エラー
+func (h *ProductServiceHandlerImpl) SuggestProducts(ctx context.Context, stream *connect.BidiStream[query.SuggestProductsRequest, query.SuggestProductsResponse]) error {
+	type suggestRequest struct {
+		req *query.SuggestProductsRequest
+		seq uint64
+	}
+	var lookups suggestLookups
+	// 最新の検索語のみを保持するチャネル（未処理の古い検索語は上書きする）
+	latest := make(chan suggestRequest, 1)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		defer close(latest)
+		for {
+			req, err := stream.Receive()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					recvErr <- err
+				}
+				return
+			}
+			// 処理中の古い検索語の問合せをキャンセル
+			seq := lookups.receive()
+			select {
+			case <-latest:
+			default:
+			}
+			latest <- suggestRequest{req: req, seq: seq}
+		}
+	}()
+
+	for r := range latest {
+		req := r.req
+		lookupCtx, cancel := context.WithCancel(ctx)
+		if !lookups.start(r.seq, cancel) {
+			// 取り出してから問合せを開始するまでに、より新しい検索語を受信済み
+			continue
+		}
+
+		hits, err := h.search.Suggest(lookupCtx, req.GetPrefix(), int(req.GetLimit()))
+		cancel()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !lookups.isLatest(r.seq) {
+			// 問合せ中に、より新しい検索語を受信済み
+			continue
+		}
+		if err != nil {
+			h.logger.ErrorContext(ctx, "Failed to suggest products", "error", err, "prefix", req.GetPrefix())
+			return handleError(err, "failed to suggest products")
+		}
+
+		res := &query.SuggestProductsResponse{}
+		res.SetPrefix(req.GetPrefix())
+		res.SetSuggestions(toSuggestionsProto(hits))
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+
+	select {
+	case err := <-recvErr:
+		return err
+	default:
+		return nil
+	}
+}
+
+// TagServiceHandlerImpl はTagServiceのgRPCハンドラ実装です。
+type TagServiceHandlerImpl struct {
+	logger *slog.Logger             // ロガー
+	repo   repository.TagRepository // タグリポジトリ
+	queryconnect.UnimplementedTagServiceHandler
+}
+
+// NewTagServiceHandlerImpl はTagServiceHandlerImplを生成します。
+//
+// Parameters:
+//   - logger: ロガー
+//   - repo: タグリポジトリ
+//
+// Returns:
+//   - *TagServiceHandlerImpl: ハンドラインスタンス
+//   - error: エラー
+func NewTagServiceHandlerImpl(logger *slog.Logger, repo repository.TagRepository) (*TagServiceHandlerImpl, error) {
+	return &TagServiceHandlerImpl{
+		logger: logger,
+		repo:   repo,
+	}, nil
+}
+
+// ListTags はタグ一覧を付与された商品数とともに取得します。
+//
+// Parameters:
+//   - ctx: コンテキスト
+//   - req: リクエスト
+//
+// Returns:
+//   - *connect.Response[query.ListTagsResponse]: レスポンス
+//   - error: エラー
+func (h *TagServiceHandlerImpl) ListTags(ctx context.Context, req *connect.Request[query.ListTagsRequest]) (*connect.Response[query.ListTagsResponse], error) {
+	usages, err := h.repo.ListWithUsage(ctx)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to list tags", "error", err)
+		return nil, handleError(err, "failed to list tags")
+	}
+
+	result := make([]*query.TagUsage, len(usages))
+	for i, usage := range usages {
+		u := &query.TagUsage{}
+		u.SetTag(toTagsProto([]*models.Tag{usage.Tag()})[0])
+		u.SetProductCount(int32(usage.ProductCount()))
+		result[i] = u
+	}
+	res := &query.ListTagsResponse{}
+	res.SetTags(result)
 
 	return connect.NewResponse(res), nil
 }
@@ -220,6 +646,11 @@ func toCategoryProto(category *models.Category) *common.Category {
 	c := &common.Category{}
 	c.SetId(category.Id())
 	c.SetName(category.Name())
+	if !category.IsRoot() {
+		c.SetParentId(category.ParentId())
+	}
+	c.SetLocale(category.Locale())
+	c.SetSlug(category.Slug())
 	return c
 }
 
@@ -238,39 +669,284 @@ func toCategoriesProto(categories []*models.Category) []*common.Category {
 	return result
 }
 
+// toCategoryNodeProto はドメインモデルのCategoryNodeをprotobufのCategoryNodeに変換します。
+//
+// Parameters:
+//   - node: ドメインモデルのCategoryNode
+//
+// Returns:
+//   - *query.CategoryNode: protobufのCategoryNode
+func toCategoryNodeProto(node *models.CategoryNode) *query.CategoryNode {
+	children := make([]*query.CategoryNode, len(node.Children()))
+	for i, child := range node.Children() {
+		children[i] = toCategoryNodeProto(child)
+	}
+	n := &query.CategoryNode{}
+	n.SetCategory(toCategoryProto(node.Category()))
+	n.SetChildren(children)
+	return n
+}
+
 // toProductProto はドメインモデルのProductをprotobufのProductに変換します。
 //
 // Parameters:
 //   - product: ドメインモデルのProduct
+//   - rounding: 税込価格の端数処理の方法
 //
 // Returns:
 //   - *common.Product: protobufのProduct
-func toProductProto(product *models.Product) *common.Product {
+func toProductProto(product *models.Product, rounding money.Rounding) *common.Product {
 	p := &common.Product{}
 	p.SetId(product.Id())
 	p.SetName(product.Name())
+	p.SetLocale(product.Locale())
 	p.SetPrice(int32(product.Price()))
+	p.SetTaxClass(taxClasses[product.TaxClass()])
+	// 通貨はコマンドサービスで検証済みのため、未対応の通貨の場合は価格を設定しない
+	if excluding, err := product.PriceExcludingTax(); err == nil {
+		p.SetPriceExcludingTax(toMoneyProto(excluding))
+	}
+	if including, err := product.PriceIncludingTax(rounding); err == nil {
+		p.SetPriceIncludingTax(toMoneyProto(including))
+	}
 	if product.Category() != nil {
 		p.SetCategory(toCategoryProto(product.Category()))
 	}
+	p.SetAvailableQuantity(int32(product.AvailableQuantity()))
+	p.SetInStock(product.InStock())
+	variants := make([]*common.ProductVariant, 0, len(product.Variants()))
+	for _, variant := range product.Variants() {
+		variants = append(variants, toVariantProto(variant, product.Price()))
+	}
+	p.SetVariants(variants)
+	p.SetTags(toTagsProto(product.Tags()))
+	p.SetStatus(productStatuses[product.Status()])
+	p.SetPriceSchedules(toPriceSchedulesProto(product.Id(), product.PriceSchedules()))
+	p.SetBarcode(product.Barcode())
+	p.SetSlug(product.Slug())
 	return p
 }
 
+// priceScheduleStatuses は価格スケジュールの状態とprotobufの列挙値の対応です。
+var priceScheduleStatuses = map[string]common.PriceScheduleStatus{
+	models.PRICE_SCHEDULE_SCHEDULED: common.PriceScheduleStatus_PRICE_SCHEDULE_STATUS_SCHEDULED,
+	models.PRICE_SCHEDULE_ACTIVE:    common.PriceScheduleStatus_PRICE_SCHEDULE_STATUS_ACTIVE,
+}
+
+// toPriceSchedulesProto はドメインモデルのPriceScheduleスライスをprotobufのPriceScheduleスライスに変換します。
+//
+// Parameters:
+//   - productId: 商品ID
+//   - schedules: ドメインモデルのPriceScheduleスライス
+//
+// Returns:
+//   - []*common.PriceSchedule: protobufのPriceScheduleスライス
+func toPriceSchedulesProto(productId string, schedules []*models.PriceSchedule) []*common.PriceSchedule {
+	result := make([]*common.PriceSchedule, len(schedules))
+	for i, schedule := range schedules {
+		s := &common.PriceSchedule{}
+		s.SetId(schedule.Id())
+		s.SetProductId(productId)
+		s.SetPrice(int32(schedule.Price()))
+		if schedule.RegularPrice() != nil {
+			s.SetRegularPrice(int32(*schedule.RegularPrice()))
+		}
+		s.SetStatus(priceScheduleStatuses[schedule.Status()])
+		s.SetStartsAt(timestamppb.New(schedule.StartsAt()))
+		s.SetEndsAt(timestamppb.New(schedule.EndsAt()))
+		result[i] = s
+	}
+	return result
+}
+
+// productStatuses は商品の販売状態とprotobufの列挙値の対応です。
+var productStatuses = map[string]common.ProductStatus{
+	"DRAFT":                         common.ProductStatus_PRODUCT_STATUS_DRAFT,
+	models.PRODUCT_STATUS_PUBLISHED: common.ProductStatus_PRODUCT_STATUS_PUBLISHED,
+	"SUSPENDED":                     common.ProductStatus_PRODUCT_STATUS_SUSPENDED,
+	"DISCONTINUED":                  common.ProductStatus_PRODUCT_STATUS_DISCONTINUED,
+}
+
+// taxClasses は税率区分とprotobufの列挙値の対応です。
+var taxClasses = map[money.TaxClass]common.TaxClass{
+	money.TaxClassStandard: common.TaxClass_TAX_CLASS_STANDARD,
+	money.TaxClassReduced:  common.TaxClass_TAX_CLASS_REDUCED,
+}
+
+// toMoneyProto は金額をprotobufのMoneyに変換します。
+//
+// Parameters:
+//   - m: 金額
+//
+// Returns:
+//   - *common.Money: protobufのMoney
+func toMoneyProto(m *money.Money) *common.Money {
+	result := &common.Money{}
+	result.SetAmount(m.Amount())
+	result.SetCurrency(m.Currency())
+	return result
+}
+
+// toTagsProto はドメインモデルのTagスライスをprotobufのTagスライスに変換します。
+//
+// Parameters:
+//   - tags: ドメインモデルのTagスライス
+//
+// Returns:
+//   - []*common.Tag: protobufのTagスライス
+func toTagsProto(tags []*models.Tag) []*common.Tag {
+	result := make([]*common.Tag, len(tags))
+	for i, tag := range tags {
+		t := &common.Tag{}
+		t.SetId(tag.Id())
+		t.SetName(tag.Name())
+		result[i] = t
+	}
+	return result
+}
+
+// variantStatuses はバリエーションの販売状態とprotobufの列挙値の対応です。
+var variantStatuses = map[string]common.VariantStatus{
+	"ACTIVE":   common.VariantStatus_VARIANT_STATUS_ACTIVE,
+	"INACTIVE": common.VariantStatus_VARIANT_STATUS_INACTIVE,
+}
+
+// toVariantProto はドメインモデルのVariantをprotobufのProductVariantに変換します。
+//
+// Parameters:
+//   - variant: ドメインモデルのVariant
+//   - productPrice: 商品価格（価格の上書きがない場合の販売価格）
+//
+// Returns:
+//   - *common.ProductVariant: protobufのProductVariant
+func toVariantProto(variant *models.Variant, productPrice uint32) *common.ProductVariant {
+	options := make([]*common.VariantOption, 0, len(variant.Options()))
+	for _, option := range variant.Options() {
+		o := &common.VariantOption{}
+		o.SetAxis(option.Axis())
+		o.SetValue(option.Value())
+		options = append(options, o)
+	}
+	v := &common.ProductVariant{}
+	v.SetId(variant.Id())
+	v.SetSku(variant.Sku())
+	v.SetOptions(options)
+	if variant.PriceOverride() != nil {
+		v.SetPriceOverride(int32(*variant.PriceOverride()))
+	}
+	v.SetPrice(int32(variant.EffectivePrice(productPrice)))
+	v.SetStatus(variantStatuses[variant.Status()])
+	return v
+}
+
+// toStockProto はドメインモデルのStockをprotobufのStockに変換します。
+//
+// Parameters:
+//   - stock: ドメインモデルのStock
+//
+// Returns:
+//   - *common.Stock: protobufのStock
+func toStockProto(stock *models.Stock) *common.Stock {
+	s := &common.Stock{}
+	s.SetProductId(stock.ProductId())
+	s.SetOnHand(int32(stock.OnHand()))
+	s.SetReserved(int32(stock.Reserved()))
+	s.SetAvailable(int32(stock.Available()))
+	return s
+}
+
 // toProductsProto はドメインモデルのProductスライスをprotobufのProductスライスに変換します。
 //
 // Parameters:
 //   - products: ドメインモデルのProductスライス
+//   - rounding: 税込価格の端数処理の方法
 //
 // Returns:
 //   - []*common.Product: protobufのProductスライス
-func toProductsProto(products []*models.Product) []*common.Product {
+func toProductsProto(products []*models.Product, rounding money.Rounding) []*common.Product {
 	result := make([]*common.Product, len(products))
 	for i, product := range products {
-		result[i] = toProductProto(product)
+		result[i] = toProductProto(product, rounding)
+	}
+	return result
+}
+
+// toSearchHitsProto はドメインモデルのProductHitスライスをprotobufのSearchHitスライスに変換します。
+//
+// Parameters:
+//   - hits: ドメインモデルのProductHitスライス
+//   - rounding: 税込価格の端数処理の方法
+//
+// Returns:
+//   - []*query.SearchHit: protobufのSearchHitスライス
+func toSearchHitsProto(hits []*models.ProductHit, rounding money.Rounding) []*query.SearchHit {
+	result := make([]*query.SearchHit, len(hits))
+	for i, hit := range hits {
+		h := &query.SearchHit{}
+		h.SetProduct(toProductProto(hit.Product(), rounding))
+		h.SetScore(hit.Score())
+		h.SetHighlights(hit.Highlights())
+		result[i] = h
 	}
 	return result
 }
 
+// toSuggestionsProto はドメインモデルのProductHitスライスをprotobufのProductSuggestionスライスに変換します。
+//
+// Parameters:
+//   - hits: ドメインモデルのProductHitスライス
+//
+// Returns:
+//   - []*query.ProductSuggestion: protobufのProductSuggestionスライス
+func toSuggestionsProto(hits []*models.ProductHit) []*query.ProductSuggestion {
+	result := make([]*query.ProductSuggestion, len(hits))
+	for i, hit := range hits {
+		s := &query.ProductSuggestion{}
+		s.SetProductId(hit.Product().Id())
+		s.SetName(hit.Product().Name())
+		if len(hit.Highlights()) > 0 {
+			s.SetHighlight(hit.Highlights()[0])
+		}
+		if hit.Product().Category() != nil {
+			s.SetCategory(toCategoryProto(hit.Product().Category()))
+		}
+		s.SetScore(hit.Score())
+		result[i] = s
+	}
+	return result
+}
+
+// toSearchFacetsProto は検索結果のファセットをprotobufのSearchFacetsに変換します。
+//
+// Parameters:
+//   - result: ドメインモデルのSearchResult
+//
+// Returns:
+//   - *query.SearchFacets: protobufのSearchFacets
+func toSearchFacetsProto(result *models.SearchResult) *query.SearchFacets {
+	toFacetCounts := func(counts []*models.FacetCount) []*query.FacetCount {
+		converted := make([]*query.FacetCount, len(counts))
+		for i, c := range counts {
+			fc := &query.FacetCount{}
+			fc.SetKey(c.Key())
+			fc.SetLabel(c.Label())
+			fc.SetCount(int32(c.Count()))
+			converted[i] = fc
+		}
+		return converted
+	}
+	facets := &query.SearchFacets{}
+	facets.SetCategories(toFacetCounts(result.Categories()))
+	facets.SetPriceBands(toFacetCounts(result.PriceBands()))
+	return facets
+}
+
+// isSlugMoved は問合せしたスラッグが現在のスラッグと異なる（変更前のスラッグで問合せした）かを判定します。
+// スラッグは小文字で保存されているため、大文字小文字の違いは変更として扱いません。
+func isSlugMoved(requested string, current string) bool {
+	return strings.ToLower(strings.TrimSpace(requested)) != current
+}
+
 // handleError はドメインエラーを適切なgRPCエラーに変換します。
 //
 // Parameters:
@@ -13,7 +13,9 @@ import (
 	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
 	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
 	interceptor "github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/db"
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/infrastructure/search"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/presentation/server"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/testhelpers"
 	"github.com/stretchr/testify/assert"
@@ -50,7 +52,12 @@ func TestMain(m *testing.M) {
 func setupProductIntegrationTests(t *testing.T) queryconnect.ProductServiceClient {
 	t.Helper()
 	repo := db.NewProductRepositoryImpl(testDBConn, testhelpers.TestLogger)
-	productHandler, err := server.NewProductServiceHandlerImpl(testhelpers.TestLogger, repo)
+	searchCfg := &search.SearchConfig{MaxResults: 100}
+	engine, err := search.NewBleveSearchEngine(searchCfg, testhelpers.TestLogger)
+	require.NoError(t, err, "Failed to create search engine")
+	t.Cleanup(func() { _ = engine.Close() })
+	require.NoError(t, search.NewIndexSyncer(searchCfg, engine, repo, testhelpers.TestLogger).Sync(context.Background()))
+	productHandler, err := server.NewProductServiceHandlerImpl(testhelpers.TestLogger, repo, engine, &server.TaxConfig{Rounding: money.RoundingFloor})
 	require.NoError(t, err, "Failed to create product handler")
 	reqRespLogger := interceptor.NewReqRespLogger(testhelpers.TestLogger)
 	validator, err := interceptor.NewValidator(testhelpers.TestLogger)
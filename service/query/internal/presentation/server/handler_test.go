@@ -3,15 +3,20 @@ package server
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
+	common "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/common/v1"
 	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
 	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
 	interceptor "github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
 	"github.com/haru-256/practical-go-grpc-micro-service/pkg/errs"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
 	mock_repository "github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/mock/repository"
 	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/testhelpers"
@@ -94,6 +99,149 @@ func TestProductServiceHandlerImpl_ListProducts(t *testing.T) {
 	}
 }
 
+// TestProductServiceHandlerImpl_ListProducts_ByCategory はカテゴリを指定したListProductsメソッドのテストです。
+func TestProductServiceHandlerImpl_ListProducts_ByCategory(t *testing.T) {
+	tests := []struct {
+		name               string
+		includeDescendants bool
+		setupMock          func(*productHandlerSetup)
+		wantErr            bool
+		wantCode           connect.Code
+		wantIds            []string
+	}{
+		{
+			name: "正常系_指定したカテゴリの商品が取得できる",
+			setupMock: func(s *productHandlerSetup) {
+				stationery := models.NewCategory("cat1", "文房具")
+				s.repo.EXPECT().ListByFilter(gomock.Any(), models.NewProductFilter().WithTags(nil).WithCategory("cat1", false)).
+					Return([]*models.Product{models.NewProduct("prod1", "ボールペン", 120, stationery)}, nil)
+			},
+			wantIds: []string{"prod1"},
+		},
+		{
+			name:               "正常系_子孫カテゴリの商品も取得できる",
+			includeDescendants: true,
+			setupMock: func(s *productHandlerSetup) {
+				stationery := models.NewCategory("cat1", "文房具")
+				pencil := models.NewCategory("cat2", "鉛筆").WithParentId("cat1")
+				s.repo.EXPECT().ListByFilter(gomock.Any(), models.NewProductFilter().WithTags(nil).WithCategory("cat1", true)).
+					Return([]*models.Product{
+						models.NewProduct("prod1", "ボールペン", 120, stationery),
+						models.NewProduct("prod2", "鉛筆(黒)", 100, pencil),
+					}, nil)
+			},
+			wantIds: []string{"prod1", "prod2"},
+		},
+		{
+			name: "異常系_カテゴリが見つからない",
+			setupMock: func(s *productHandlerSetup) {
+				s.repo.EXPECT().ListByFilter(gomock.Any(), models.NewProductFilter().WithTags(nil).WithCategory("cat1", false)).
+					Return(nil, errs.NewCRUDError("NOT_FOUND", "category not found"))
+			},
+			wantErr:  true,
+			wantCode: connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupProductHandler(t)
+			defer s.cleanup()
+
+			tt.setupMock(s)
+
+			req := connect.NewRequest(&query.ListProductsRequest{})
+			req.Msg.SetCategoryId("cat1")
+			req.Msg.SetIncludeDescendants(tt.includeDescendants)
+			resp, err := s.client.ListProducts(s.ctx, req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, 0, len(resp.Msg.GetProducts()))
+			for _, p := range resp.Msg.GetProducts() {
+				ids = append(ids, p.GetId())
+			}
+			assert.Equal(t, tt.wantIds, ids)
+		})
+	}
+}
+
+// TestProductServiceHandlerImpl_ListProducts_ByTags はタグを指定したListProductsメソッドのテストです。
+func TestProductServiceHandlerImpl_ListProducts_ByTags(t *testing.T) {
+	tests := []struct {
+		name       string
+		categoryId *string
+		tags       []string
+		setupMock  func(*productHandlerSetup)
+		wantErr    bool
+		wantCode   connect.Code
+		wantIds    []string
+	}{
+		{
+			name: "正常系_指定したタグが付与された商品が取得できる",
+			tags: []string{"ワイヤレス", "ゲーミング"},
+			setupMock: func(s *productHandlerSetup) {
+				peripheral := models.NewCategory("cat1", "パソコン周辺機器")
+				product := models.NewProduct("prod1", "ワイヤレスマウス", 3000, peripheral).
+					WithTags([]*models.Tag{models.NewTag("tag2", "ゲーミング"), models.NewTag("tag1", "ワイヤレス")})
+				s.repo.EXPECT().ListByFilter(gomock.Any(), models.NewProductFilter().WithTags([]string{"ワイヤレス", "ゲーミング"})).
+					Return([]*models.Product{product}, nil)
+			},
+			wantIds: []string{"prod1"},
+		},
+		{
+			name:       "正常系_カテゴリとタグを組み合わせて絞り込める",
+			categoryId: ptr("cat1"),
+			tags:       []string{"ワイヤレス"},
+			setupMock: func(s *productHandlerSetup) {
+				s.repo.EXPECT().ListByFilter(gomock.Any(), models.NewProductFilter().WithTags([]string{"ワイヤレス"}).WithCategory("cat1", false)).
+					Return([]*models.Product{}, nil)
+			},
+			wantIds: []string{},
+		},
+		{
+			name:     "異常系_タグ名が空文字列",
+			tags:     []string{""},
+			wantErr:  true,
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupProductHandler(t)
+			defer s.cleanup()
+
+			if tt.setupMock != nil {
+				tt.setupMock(s)
+			}
+
+			req := connect.NewRequest(&query.ListProductsRequest{})
+			if tt.categoryId != nil {
+				req.Msg.SetCategoryId(*tt.categoryId)
+			}
+			req.Msg.SetTags(tt.tags)
+			resp, err := s.client.ListProducts(s.ctx, req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, 0, len(resp.Msg.GetProducts()))
+			for _, p := range resp.Msg.GetProducts() {
+				ids = append(ids, p.GetId())
+			}
+			assert.Equal(t, tt.wantIds, ids)
+		})
+	}
+}
+
 // TestProductServiceHandlerImpl_StreamProducts はStreamProductsメソッドのテストです。
 func TestProductServiceHandlerImpl_StreamProducts(t *testing.T) {
 	readStream := func(t *testing.T, stream *connect.ServerStreamForClient[query.StreamProductsResponse]) []*query.StreamProductsResponse {
@@ -206,12 +354,13 @@ func TestProductServiceHandlerImpl_StreamProducts(t *testing.T) {
 // TestProductServiceHandlerImpl_GetProductById はGetProductByIdメソッドのテストです。
 func TestProductServiceHandlerImpl_GetProductById(t *testing.T) {
 	tests := []struct {
-		name         string
-		productID    string
-		setupMock    func(*productHandlerSetup)
-		wantErr      bool
-		wantCode     connect.Code
-		validateResp func(t *testing.T, resp *connect.Response[query.GetProductByIdResponse])
+		name               string
+		productID          string
+		includeUnpublished bool
+		setupMock          func(*productHandlerSetup)
+		wantErr            bool
+		wantCode           connect.Code
+		validateResp       func(t *testing.T, resp *connect.Response[query.GetProductByIdResponse])
 	}{
 		{
 			name:      "正常系_商品が取得できる",
@@ -219,7 +368,7 @@ func TestProductServiceHandlerImpl_GetProductById(t *testing.T) {
 			setupMock: func(s *productHandlerSetup) {
 				category := models.NewCategory("cat1", "Electronics")
 				product := models.NewProduct("prod1", "Product 1", 1000, category)
-				s.repo.EXPECT().FindById(gomock.Any(), "prod1").Return(product, nil)
+				s.repo.EXPECT().FindById(gomock.Any(), "prod1", false).Return(product, nil)
 			},
 			wantErr: false,
 			validateResp: func(t *testing.T, resp *connect.Response[query.GetProductByIdResponse]) {
@@ -231,11 +380,57 @@ func TestProductServiceHandlerImpl_GetProductById(t *testing.T) {
 				assert.Equal(t, int32(1000), product.GetPrice())
 			},
 		},
+		{
+			name:      "正常系_適用中と予定の価格スケジュールが取得できる",
+			productID: "prod1",
+			setupMock: func(s *productHandlerSetup) {
+				regular := uint32(1000)
+				category := models.NewCategory("cat1", "Electronics")
+				product := models.NewProduct("prod1", "Product 1", 800, category).WithPriceSchedules([]*models.PriceSchedule{
+					models.NewPriceSchedule("sched1", 800, &regular, models.PRICE_SCHEDULE_ACTIVE,
+						time.Date(2025, 1, 3, 15, 0, 0, 0, time.UTC), time.Date(2025, 1, 5, 15, 0, 0, 0, time.UTC)),
+					models.NewPriceSchedule("sched2", 900, nil, models.PRICE_SCHEDULE_SCHEDULED,
+						time.Date(2025, 2, 3, 15, 0, 0, 0, time.UTC), time.Date(2025, 2, 5, 15, 0, 0, 0, time.UTC)),
+				})
+				s.repo.EXPECT().FindById(gomock.Any(), "prod1", false).Return(product, nil)
+			},
+			wantErr: false,
+			validateResp: func(t *testing.T, resp *connect.Response[query.GetProductByIdResponse]) {
+				product := resp.Msg.GetProduct()
+				// 商品の単価は適用中の価格スケジュールの販売価格
+				assert.Equal(t, int32(800), product.GetPrice())
+				require.Len(t, product.GetPriceSchedules(), 2)
+				active := product.GetPriceSchedules()[0]
+				assert.Equal(t, "sched1", active.GetId())
+				assert.Equal(t, "prod1", active.GetProductId())
+				assert.Equal(t, common.PriceScheduleStatus_PRICE_SCHEDULE_STATUS_ACTIVE, active.GetStatus())
+				assert.Equal(t, int32(1000), active.GetRegularPrice())
+				assert.Equal(t, time.Date(2025, 1, 3, 15, 0, 0, 0, time.UTC), active.GetStartsAt().AsTime())
+				upcoming := product.GetPriceSchedules()[1]
+				assert.Equal(t, common.PriceScheduleStatus_PRICE_SCHEDULE_STATUS_SCHEDULED, upcoming.GetStatus())
+				assert.Equal(t, int32(900), upcoming.GetPrice())
+				assert.False(t, upcoming.HasRegularPrice())
+			},
+		},
+		{
+			name:               "正常系_include_unpublishedを指定すると公開中以外の商品も取得できる",
+			productID:          "prod1",
+			includeUnpublished: true,
+			setupMock: func(s *productHandlerSetup) {
+				category := models.NewCategory("cat1", "Electronics")
+				product := models.NewProduct("prod1", "Product 1", 1000, category).WithStatus("DRAFT")
+				s.repo.EXPECT().FindById(gomock.Any(), "prod1", true).Return(product, nil)
+			},
+			wantErr: false,
+			validateResp: func(t *testing.T, resp *connect.Response[query.GetProductByIdResponse]) {
+				assert.Equal(t, common.ProductStatus_PRODUCT_STATUS_DRAFT, resp.Msg.GetProduct().GetStatus())
+			},
+		},
 		{
 			name:      "異常系_商品が見つからない",
 			productID: "nonexistent",
 			setupMock: func(s *productHandlerSetup) {
-				s.repo.EXPECT().FindById(gomock.Any(), "nonexistent").Return(nil, errs.NewCRUDError("NOT_FOUND", "product not found"))
+				s.repo.EXPECT().FindById(gomock.Any(), "nonexistent", false).Return(nil, errs.NewCRUDError("NOT_FOUND", "product not found"))
 			},
 			wantErr:  true,
 			wantCode: connect.CodeNotFound,
@@ -253,7 +448,7 @@ func TestProductServiceHandlerImpl_GetProductById(t *testing.T) {
 			name:      "異常系_リポジトリエラー",
 			productID: "prod1",
 			setupMock: func(s *productHandlerSetup) {
-				s.repo.EXPECT().FindById(gomock.Any(), "prod1").Return(nil, errs.NewInternalError("database", "database error"))
+				s.repo.EXPECT().FindById(gomock.Any(), "prod1", false).Return(nil, errs.NewInternalError("database", "database error"))
 			},
 			wantErr:  true,
 			wantCode: connect.CodeInternal,
@@ -271,6 +466,7 @@ func TestProductServiceHandlerImpl_GetProductById(t *testing.T) {
 
 			req := connect.NewRequest(&query.GetProductByIdRequest{})
 			req.Msg.SetId(tt.productID)
+			req.Msg.SetIncludeUnpublished(tt.includeUnpublished)
 			resp, err := s.client.GetProductById(s.ctx, req)
 
 			if tt.wantErr {
@@ -286,7 +482,404 @@ func TestProductServiceHandlerImpl_GetProductById(t *testing.T) {
 	}
 }
 
+func TestProductServiceHandlerImpl_GetProductByBarcode(t *testing.T) {
+	tests := []struct {
+		name         string
+		barcode      string
+		setupMock    func(*productHandlerSetup)
+		wantErr      bool
+		wantCode     connect.Code
+		validateResp func(t *testing.T, resp *connect.Response[query.GetProductByBarcodeResponse])
+	}{
+		{
+			name:    "正常系_商品が取得できる",
+			barcode: "4901234567894",
+			setupMock: func(s *productHandlerSetup) {
+				category := models.NewCategory("cat1", "Stationery")
+				product := models.NewProduct("prod1", "Pen", 120, category).WithBarcode("4901234567894")
+				s.repo.EXPECT().FindByBarcode(gomock.Any(), "4901234567894").Return(product, nil)
+			},
+			wantErr: false,
+			validateResp: func(t *testing.T, resp *connect.Response[query.GetProductByBarcodeResponse]) {
+				require.NotNil(t, resp)
+				product := resp.Msg.GetProduct()
+				require.NotNil(t, product)
+				assert.Equal(t, "prod1", product.GetId())
+				assert.Equal(t, "4901234567894", product.GetBarcode())
+			},
+		},
+		{
+			name:    "異常系_商品が見つからない",
+			barcode: "036000291452",
+			setupMock: func(s *productHandlerSetup) {
+				s.repo.EXPECT().FindByBarcode(gomock.Any(), "036000291452").Return(nil, errs.NewCRUDError("NOT_FOUND", "product not found"))
+			},
+			wantErr:  true,
+			wantCode: connect.CodeNotFound,
+		},
+		{
+			name:    "異常系_バリデーションエラー_桁数が不正",
+			barcode: "12345",
+			setupMock: func(s *productHandlerSetup) {
+				// バリデーションエラーはリポジトリ呼び出し前に発生するため、モックは設定しない
+			},
+			wantErr:  true,
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupProductHandler(t)
+			defer s.cleanup()
+
+			if tt.setupMock != nil {
+				tt.setupMock(s)
+			}
+
+			req := connect.NewRequest(&query.GetProductByBarcodeRequest{})
+			req.Msg.SetBarcode(tt.barcode)
+			resp, err := s.client.GetProductByBarcode(s.ctx, req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+			} else {
+				require.NoError(t, err)
+				if tt.validateResp != nil {
+					tt.validateResp(t, resp)
+				}
+			}
+		})
+	}
+}
+
+// TestProductServiceHandlerImpl_GetProductById_Locale はGetProductByIdのロケールに応じた商品名の選択のテストです。
+func TestProductServiceHandlerImpl_GetProductBySlug(t *testing.T) {
+	tests := []struct {
+		name         string
+		slug         string
+		setupMock    func(*productHandlerSetup)
+		wantErr      bool
+		wantCode     connect.Code
+		validateResp func(t *testing.T, resp *connect.Response[query.GetProductBySlugResponse])
+	}{
+		{
+			name: "正常系_現在のスラッグで商品が取得できる",
+			slug: "Wireless-Mouse",
+			setupMock: func(s *productHandlerSetup) {
+				category := models.NewCategory("cat1", "PC")
+				product := models.NewProduct("prod1", "Mouse", 900, category).WithSlug("wireless-mouse")
+				s.repo.EXPECT().FindBySlug(gomock.Any(), "Wireless-Mouse").Return(product, nil)
+			},
+			wantErr: false,
+			validateResp: func(t *testing.T, resp *connect.Response[query.GetProductBySlugResponse]) {
+				require.NotNil(t, resp)
+				assert.Equal(t, "wireless-mouse", resp.Msg.GetProduct().GetSlug())
+				assert.False(t, resp.Msg.GetMoved())
+			},
+		},
+		{
+			name: "正常系_変更前のスラッグの場合、現在のスラッグとmovedを返す",
+			slug: "waiyaresumausu",
+			setupMock: func(s *productHandlerSetup) {
+				category := models.NewCategory("cat1", "PC")
+				product := models.NewProduct("prod1", "Mouse", 900, category).WithSlug("wireless-mouse")
+				s.repo.EXPECT().FindBySlug(gomock.Any(), "waiyaresumausu").Return(product, nil)
+			},
+			wantErr: false,
+			validateResp: func(t *testing.T, resp *connect.Response[query.GetProductBySlugResponse]) {
+				require.NotNil(t, resp)
+				assert.Equal(t, "wireless-mouse", resp.Msg.GetProduct().GetSlug())
+				assert.True(t, resp.Msg.GetMoved())
+			},
+		},
+		{
+			name: "異常系_商品が見つからない",
+			slug: "fountain-pen",
+			setupMock: func(s *productHandlerSetup) {
+				s.repo.EXPECT().FindBySlug(gomock.Any(), "fountain-pen").Return(nil, errs.NewCRUDError("NOT_FOUND", "product not found"))
+			},
+			wantErr:  true,
+			wantCode: connect.CodeNotFound,
+		},
+		{
+			name: "異常系_バリデーションエラー_スラッグが空",
+			slug: "",
+			setupMock: func(s *productHandlerSetup) {
+				// バリデーションエラーはリポジトリ呼び出し前に発生するため、モックは設定しない
+			},
+			wantErr:  true,
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupProductHandler(t)
+			defer s.cleanup()
+
+			if tt.setupMock != nil {
+				tt.setupMock(s)
+			}
+
+			req := connect.NewRequest(&query.GetProductBySlugRequest{})
+			req.Msg.SetSlug(tt.slug)
+			resp, err := s.client.GetProductBySlug(s.ctx, req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+			} else {
+				require.NoError(t, err)
+				if tt.validateResp != nil {
+					tt.validateResp(t, resp)
+				}
+			}
+		})
+	}
+}
+
+func TestProductServiceHandlerImpl_GetProductById_Locale(t *testing.T) {
+	tests := []struct {
+		name             string
+		locale           string
+		acceptLanguage   string
+		wantName         string
+		wantLocale       string
+		wantCategoryName string
+	}{
+		{
+			name:             "正常系_リクエストのロケールの名前を返す",
+			locale:           "en",
+			wantName:         "Wireless Mouse",
+			wantLocale:       "en",
+			wantCategoryName: "PC Peripherals",
+		},
+		{
+			name:             "正常系_リクエストのロケールはAccept-Languageより優先する",
+			locale:           "ja",
+			acceptLanguage:   "en",
+			wantName:         "ワイヤレスマウス",
+			wantLocale:       "ja",
+			wantCategoryName: "パソコン周辺機器",
+		},
+		{
+			name:             "正常系_Accept-Languageの品質値の順に選択する",
+			acceptLanguage:   "fr;q=0.9, en-US;q=0.8",
+			wantName:         "Souris sans fil",
+			wantLocale:       "fr",
+			wantCategoryName: "PC Peripherals",
+		},
+		{
+			name:             "正常系_地域コード付きのロケールは言語コードの翻訳にフォールバックする",
+			acceptLanguage:   "en-GB",
+			wantName:         "Wireless Mouse",
+			wantLocale:       "en",
+			wantCategoryName: "PC Peripherals",
+		},
+		{
+			name:             "正常系_翻訳がない場合は既定のロケールの名前を返す",
+			locale:           "de",
+			wantName:         "ワイヤレスマウス",
+			wantLocale:       "ja",
+			wantCategoryName: "パソコン周辺機器",
+		},
+		{
+			name:             "正常系_ロケールの指定がない場合は既定のロケールの名前を返す",
+			wantName:         "ワイヤレスマウス",
+			wantLocale:       "ja",
+			wantCategoryName: "パソコン周辺機器",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupProductHandler(t)
+			defer s.cleanup()
+
+			category := models.NewCategory("cat1", "パソコン周辺機器").
+				WithTranslations(models.Translations{"en": "PC Peripherals"})
+			product := models.NewProduct("prod1", "ワイヤレスマウス", 900, category).
+				WithTranslations(models.Translations{"en": "Wireless Mouse", "fr": "Souris sans fil"})
+			s.repo.EXPECT().FindById(gomock.Any(), "prod1", false).Return(product, nil)
+
+			req := connect.NewRequest(&query.GetProductByIdRequest{})
+			req.Msg.SetId("prod1")
+			if tt.locale != "" {
+				req.Msg.SetLocale(tt.locale)
+			}
+			if tt.acceptLanguage != "" {
+				req.Header().Set(ACCEPT_LANGUAGE_HEADER, tt.acceptLanguage)
+			}
+			resp, err := s.client.GetProductById(s.ctx, req)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, resp.Msg.GetProduct().GetName())
+			assert.Equal(t, tt.wantLocale, resp.Msg.GetProduct().GetLocale())
+			assert.Equal(t, tt.wantCategoryName, resp.Msg.GetProduct().GetCategory().GetName())
+		})
+	}
+
+	t.Run("異常系_ロケールの形式が不正", func(t *testing.T) {
+		s := setupProductHandler(t)
+		defer s.cleanup()
+
+		req := connect.NewRequest(&query.GetProductByIdRequest{})
+		req.Msg.SetId("prod1")
+		req.Msg.SetLocale("english")
+		_, err := s.client.GetProductById(s.ctx, req)
+
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
+
 // TestProductServiceHandlerImpl_SearchProductsByKeyword はSearchProductsByKeywordメソッドのテストです。
+func TestProductServiceHandlerImpl_GetStock(t *testing.T) {
+	tests := []struct {
+		name         string
+		productID    string
+		setupMock    func(*productHandlerSetup)
+		wantErr      bool
+		wantCode     connect.Code
+		validateResp func(t *testing.T, resp *connect.Response[query.GetStockResponse])
+	}{
+		{
+			name:      "正常系_在庫が取得できる",
+			productID: "prod1",
+			setupMock: func(s *productHandlerSetup) {
+				s.repo.EXPECT().FindStockByProductId(gomock.Any(), "prod1").Return(models.NewStock("prod1", 10, 3), nil)
+			},
+			wantErr: false,
+			validateResp: func(t *testing.T, resp *connect.Response[query.GetStockResponse]) {
+				require.NotNil(t, resp)
+				stock := resp.Msg.GetStock()
+				require.NotNil(t, stock)
+				assert.Equal(t, "prod1", stock.GetProductId())
+				assert.Equal(t, int32(10), stock.GetOnHand())
+				assert.Equal(t, int32(3), stock.GetReserved())
+				assert.Equal(t, int32(7), stock.GetAvailable())
+			},
+		},
+		{
+			name:      "異常系_商品が見つからない",
+			productID: "nonexistent",
+			setupMock: func(s *productHandlerSetup) {
+				s.repo.EXPECT().FindStockByProductId(gomock.Any(), "nonexistent").Return(nil, errs.NewCRUDError("NOT_FOUND", "product not found"))
+			},
+			wantErr:  true,
+			wantCode: connect.CodeNotFound,
+		},
+		{
+			name:      "異常系_バリデーションエラー_IDが空",
+			productID: "",
+			wantErr:   true,
+			wantCode:  connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupProductHandler(t)
+			defer s.cleanup()
+
+			if tt.setupMock != nil {
+				tt.setupMock(s)
+			}
+
+			req := connect.NewRequest(&query.GetStockRequest{})
+			req.Msg.SetProductId(tt.productID)
+			resp, err := s.client.GetStock(s.ctx, req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+			} else {
+				require.NoError(t, err)
+				if tt.validateResp != nil {
+					tt.validateResp(t, resp)
+				}
+			}
+		})
+	}
+}
+
+func TestToProductProto_Availability(t *testing.T) {
+	category := models.NewCategory("cat1", "Electronics")
+	product := models.NewProduct("prod1", "Product 1", 1000, category)
+
+	outOfStock := toProductProto(product, money.RoundingFloor)
+	assert.Equal(t, int32(0), outOfStock.GetAvailableQuantity())
+	assert.False(t, outOfStock.GetInStock())
+
+	inStock := toProductProto(product.WithAvailableQuantity(5), money.RoundingFloor)
+	assert.Equal(t, int32(5), inStock.GetAvailableQuantity())
+	assert.True(t, inStock.GetInStock())
+	assert.Equal(t, uint32(0), product.AvailableQuantity(), "元の商品は変更されないこと")
+}
+
+func TestToProductProto_Variants(t *testing.T) {
+	category := models.NewCategory("cat1", "Apparel")
+	priceOverride := uint32(2500)
+	product := models.NewProduct("prod1", "Tシャツ", 2000, category).WithVariants([]*models.Variant{
+		models.NewVariant("var1", "TS-M", []*models.VariantOption{models.NewVariantOption("サイズ", "M")}, nil, "ACTIVE"),
+		models.NewVariant("var2", "TS-XL", []*models.VariantOption{models.NewVariantOption("サイズ", "XL")}, &priceOverride, "INACTIVE"),
+	})
+
+	p := toProductProto(product, money.RoundingFloor)
+
+	require.Len(t, p.GetVariants(), 2)
+	inherited := p.GetVariants()[0]
+	assert.Equal(t, "TS-M", inherited.GetSku())
+	assert.False(t, inherited.HasPriceOverride())
+	assert.Equal(t, int32(2000), inherited.GetPrice(), "価格を上書きしない場合は商品価格になること")
+	assert.Equal(t, common.VariantStatus_VARIANT_STATUS_ACTIVE, inherited.GetStatus())
+	require.Len(t, inherited.GetOptions(), 1)
+	assert.Equal(t, "サイズ", inherited.GetOptions()[0].GetAxis())
+	assert.Equal(t, "M", inherited.GetOptions()[0].GetValue())
+
+	overridden := p.GetVariants()[1]
+	assert.Equal(t, int32(2500), overridden.GetPriceOverride())
+	assert.Equal(t, int32(2500), overridden.GetPrice())
+	assert.Equal(t, common.VariantStatus_VARIANT_STATUS_INACTIVE, overridden.GetStatus())
+
+	assert.Empty(t, toProductProto(models.NewProduct("prod2", "Product 2", 1000, category), money.RoundingFloor).GetVariants())
+}
+
+func TestToProductProto_Tax(t *testing.T) {
+	category := models.NewCategory("cat1", "Food")
+
+	standard := toProductProto(models.NewProduct("prod1", "Product 1", 1000, category), money.RoundingFloor)
+	assert.Equal(t, common.TaxClass_TAX_CLASS_STANDARD, standard.GetTaxClass())
+	assert.Equal(t, int64(1000), standard.GetPriceExcludingTax().GetAmount())
+	assert.Equal(t, "JPY", standard.GetPriceExcludingTax().GetCurrency())
+	assert.Equal(t, int64(1100), standard.GetPriceIncludingTax().GetAmount())
+	assert.Equal(t, "JPY", standard.GetPriceIncludingTax().GetCurrency())
+
+	reduced := models.NewProduct("prod2", "Product 2", 1299, category).WithTax("USD", money.TaxClassReduced)
+	floor := toProductProto(reduced, money.RoundingFloor)
+	assert.Equal(t, common.TaxClass_TAX_CLASS_REDUCED, floor.GetTaxClass())
+	assert.Equal(t, int64(1402), floor.GetPriceIncludingTax().GetAmount(), "1299 * 1.08 = 1402.92 を切り捨てること")
+	assert.Equal(t, "USD", floor.GetPriceIncludingTax().GetCurrency())
+	assert.Equal(t, int64(1403), toProductProto(reduced, money.RoundingHalfUp).GetPriceIncludingTax().GetAmount())
+	assert.Equal(t, int64(1403), toProductProto(reduced, money.RoundingCeil).GetPriceIncludingTax().GetAmount())
+
+	unsupported := toProductProto(models.NewProduct("prod3", "Product 3", 1000, category).WithTax("XXX", money.TaxClassStandard), money.RoundingFloor)
+	assert.False(t, unsupported.HasPriceExcludingTax(), "未対応の通貨の場合は価格を設定しないこと")
+	assert.False(t, unsupported.HasPriceIncludingTax())
+	assert.Equal(t, int32(1000), unsupported.GetPrice())
+}
+
+func TestToProductProto_Status(t *testing.T) {
+	category := models.NewCategory("cat1", "Electronics")
+	product := models.NewProduct("prod1", "Product 1", 1000, category)
+
+	assert.Equal(t, common.ProductStatus_PRODUCT_STATUS_PUBLISHED, toProductProto(product, money.RoundingFloor).GetStatus())
+	assert.Equal(t, common.ProductStatus_PRODUCT_STATUS_SUSPENDED, toProductProto(product.WithStatus("SUSPENDED"), money.RoundingFloor).GetStatus())
+	assert.Equal(t, common.ProductStatus_PRODUCT_STATUS_DISCONTINUED, toProductProto(product.WithStatus("DISCONTINUED"), money.RoundingFloor).GetStatus())
+}
+
 func TestProductServiceHandlerImpl_SearchProductsByKeyword(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -297,9 +890,63 @@ func TestProductServiceHandlerImpl_SearchProductsByKeyword(t *testing.T) {
 		validateResp func(t *testing.T, resp *connect.Response[query.SearchProductsByKeywordResponse])
 	}{
 		{
-			name:    "正常系_商品が検索できる",
+			name:    "正常系_全文検索で関連度順に検索できる",
+			keyword: "ボールペン",
+			setupMock: func(s *productHandlerSetup) {
+				category := models.NewCategory("cat1", "文房具")
+				hits := []*models.ProductHit{
+					models.NewProductHit(models.NewProduct("prod1", "水性ボールペン(黒)", 120, category), 1.5, []string{"水性<mark>ボールペン</mark>(黒)"}),
+					models.NewProductHit(models.NewProduct("prod2", "油性ボールペン(赤)", 100, category), 0.8, []string{"油性<mark>ボールペン</mark>(赤)"}),
+				}
+				result := models.NewSearchResult(
+					hits, 2,
+					[]*models.FacetCount{models.NewFacetCount("cat1", "文房具", 2)},
+					[]*models.FacetCount{models.NewFacetCount("0-500", "500円未満", 2)},
+				)
+				s.search.EXPECT().Search(gomock.Any(), "ボールペン").Return(result, nil)
+			},
+			wantErr: false,
+			validateResp: func(t *testing.T, resp *connect.Response[query.SearchProductsByKeywordResponse]) {
+				require.NotNil(t, resp)
+				assert.False(t, resp.Msg.GetFallback())
+				products := resp.Msg.GetProducts()
+				require.Len(t, products, 2)
+				assert.Equal(t, "prod1", products[0].GetId())
+				hits := resp.Msg.GetHits()
+				require.Len(t, hits, 2)
+				assert.Equal(t, 1.5, hits[0].GetScore())
+				assert.Equal(t, []string{"水性<mark>ボールペン</mark>(黒)"}, hits[0].GetHighlights())
+				categories := resp.Msg.GetFacets().GetCategories()
+				require.Len(t, categories, 1)
+				assert.Equal(t, "文房具", categories[0].GetLabel())
+				assert.Equal(t, int32(2), categories[0].GetCount())
+				priceBands := resp.Msg.GetFacets().GetPriceBands()
+				require.Len(t, priceBands, 1)
+				assert.Equal(t, "0-500", priceBands[0].GetKey())
+			},
+		},
+		{
+			name:    "正常系_全文検索エラー時はLIKE検索にフォールバックする",
+			keyword: "Laptop",
+			setupMock: func(s *productHandlerSetup) {
+				s.search.EXPECT().Search(gomock.Any(), "Laptop").Return(nil, errs.NewInternalError("SEARCH_ERROR", "search error"))
+				category := models.NewCategory("cat1", "Electronics")
+				products := []*models.Product{models.NewProduct("prod1", "Laptop Pro", 150000, category)}
+				s.repo.EXPECT().FindByNameLike(gomock.Any(), "Laptop").Return(products, nil)
+			},
+			wantErr: false,
+			validateResp: func(t *testing.T, resp *connect.Response[query.SearchProductsByKeywordResponse]) {
+				require.NotNil(t, resp)
+				assert.True(t, resp.Msg.GetFallback())
+				require.Len(t, resp.Msg.GetProducts(), 1)
+				assert.Empty(t, resp.Msg.GetHits())
+			},
+		},
+		{
+			name:    "正常系_全文検索でヒットしない場合はLIKE検索にフォールバックする",
 			keyword: "Laptop",
 			setupMock: func(s *productHandlerSetup) {
+				s.search.EXPECT().Search(gomock.Any(), "Laptop").Return(models.NewSearchResult(nil, 0, nil, nil), nil)
 				category := models.NewCategory("cat1", "Electronics")
 				products := []*models.Product{
 					models.NewProduct("prod1", "Laptop Pro", 150000, category),
@@ -310,6 +957,7 @@ func TestProductServiceHandlerImpl_SearchProductsByKeyword(t *testing.T) {
 			wantErr: false,
 			validateResp: func(t *testing.T, resp *connect.Response[query.SearchProductsByKeywordResponse]) {
 				require.NotNil(t, resp)
+				assert.True(t, resp.Msg.GetFallback())
 				require.Len(t, resp.Msg.GetProducts(), 2)
 			},
 		},
@@ -317,6 +965,7 @@ func TestProductServiceHandlerImpl_SearchProductsByKeyword(t *testing.T) {
 			name:    "正常系_検索結果が空",
 			keyword: "NonExistent",
 			setupMock: func(s *productHandlerSetup) {
+				s.search.EXPECT().Search(gomock.Any(), "NonExistent").Return(models.NewSearchResult(nil, 0, nil, nil), nil)
 				s.repo.EXPECT().FindByNameLike(gomock.Any(), "NonExistent").Return([]*models.Product{}, nil)
 			},
 			wantErr: false,
@@ -338,6 +987,7 @@ func TestProductServiceHandlerImpl_SearchProductsByKeyword(t *testing.T) {
 			name:    "異常系_リポジトリエラー",
 			keyword: "Product",
 			setupMock: func(s *productHandlerSetup) {
+				s.search.EXPECT().Search(gomock.Any(), "Product").Return(models.NewSearchResult(nil, 0, nil, nil), nil)
 				s.repo.EXPECT().FindByNameLike(gomock.Any(), "Product").Return(nil, errs.NewInternalError("database", "database error"))
 			},
 			wantErr:  true,
@@ -371,6 +1021,176 @@ func TestProductServiceHandlerImpl_SearchProductsByKeyword(t *testing.T) {
 	}
 }
 
+// TestProductServiceHandlerImpl_SuggestProducts はSuggestProductsメソッドのテストです。
+func TestProductServiceHandlerImpl_SuggestProducts(t *testing.T) {
+	newRequest := func(prefix string) *query.SuggestProductsRequest {
+		req := &query.SuggestProductsRequest{}
+		req.SetPrefix(prefix)
+		req.SetLimit(5)
+		return req
+	}
+	category := models.NewCategory("cat1", "文房具")
+
+	t.Run("正常系_検索語ごとにサジェストを受信できる", func(t *testing.T) {
+		s := setupProductHandler(t)
+		defer s.cleanup()
+
+		s.search.EXPECT().Suggest(gomock.Any(), "ボー", 5).Return([]*models.ProductHit{
+			models.NewProductHit(models.NewProduct("prod1", "ボールペン", 120, category), 2.0, []string{"<mark>ボー</mark>ルペン"}),
+		}, nil)
+		s.search.EXPECT().Suggest(gomock.Any(), "", 5).Return([]*models.ProductHit{}, nil)
+
+		stream := s.client.SuggestProducts(s.ctx)
+		require.NoError(t, stream.Send(newRequest("ボー")))
+		res, err := stream.Receive()
+		require.NoError(t, err)
+		assert.Equal(t, "ボー", res.GetPrefix())
+		require.Len(t, res.GetSuggestions(), 1)
+		assert.Equal(t, "prod1", res.GetSuggestions()[0].GetProductId())
+		assert.Equal(t, "<mark>ボー</mark>ルペン", res.GetSuggestions()[0].GetHighlight())
+		assert.Equal(t, "文房具", res.GetSuggestions()[0].GetCategory().GetName())
+
+		require.NoError(t, stream.Send(newRequest("")))
+		res, err = stream.Receive()
+		require.NoError(t, err)
+		assert.Empty(t, res.GetSuggestions())
+
+		require.NoError(t, stream.CloseRequest())
+		_, err = stream.Receive()
+		assert.ErrorIs(t, err, io.EOF)
+		require.NoError(t, stream.CloseResponse())
+	})
+
+	t.Run("正常系_新しい検索語を受信すると古い問合せはキャンセルされる", func(t *testing.T) {
+		s := setupProductHandler(t)
+		defer s.cleanup()
+
+		started := make(chan struct{})
+		s.search.EXPECT().Suggest(gomock.Any(), "ボ", 5).DoAndReturn(
+			func(ctx context.Context, _ string, _ int) ([]*models.ProductHit, error) {
+				close(started)
+				<-ctx.Done() // 新しい検索語によるキャンセルを待つ
+				return nil, ctx.Err()
+			})
+		s.search.EXPECT().Suggest(gomock.Any(), "ボール", 5).Return([]*models.ProductHit{
+			models.NewProductHit(models.NewProduct("prod1", "ボールペン", 120, category), 2.0, nil),
+		}, nil)
+
+		stream := s.client.SuggestProducts(s.ctx)
+		require.NoError(t, stream.Send(newRequest("ボ")))
+		<-started
+		require.NoError(t, stream.Send(newRequest("ボール")))
+
+		res, err := stream.Receive()
+		require.NoError(t, err)
+		assert.Equal(t, "ボール", res.GetPrefix(), "キャンセルされた古い検索語の結果は送信されない")
+		require.NoError(t, stream.CloseRequest())
+		require.NoError(t, stream.CloseResponse())
+	})
+
+	t.Run("正常系_キャンセル後に古い問合せが成功しても古い結果は送信されない", func(t *testing.T) {
+		s := setupProductHandler(t)
+		defer s.cleanup()
+
+		started := make(chan struct{})
+		s.search.EXPECT().Suggest(gomock.Any(), "ボ", 5).DoAndReturn(
+			func(ctx context.Context, _ string, _ int) ([]*models.ProductHit, error) {
+				close(started)
+				<-ctx.Done()
+				// キャンセルを無視して結果を返す検索エンジンを模擬する
+				return []*models.ProductHit{models.NewProductHit(models.NewProduct("prod2", "ボタン電池", 300, category), 1.0, nil)}, nil
+			})
+		s.search.EXPECT().Suggest(gomock.Any(), "ボール", 5).Return([]*models.ProductHit{
+			models.NewProductHit(models.NewProduct("prod1", "ボールペン", 120, category), 2.0, nil),
+		}, nil)
+
+		stream := s.client.SuggestProducts(s.ctx)
+		require.NoError(t, stream.Send(newRequest("ボ")))
+		<-started
+		require.NoError(t, stream.Send(newRequest("ボール")))
+
+		res, err := stream.Receive()
+		require.NoError(t, err)
+		assert.Equal(t, "ボール", res.GetPrefix())
+		require.NoError(t, stream.CloseRequest())
+		_, err = stream.Receive()
+		assert.ErrorIs(t, err, io.EOF, "古い検索語の結果は送信されない")
+		require.NoError(t, stream.CloseResponse())
+	})
+
+	t.Run("異常系_検索エンジンエラー", func(t *testing.T) {
+		s := setupProductHandler(t)
+		defer s.cleanup()
+
+		s.search.EXPECT().Suggest(gomock.Any(), "ボ", 5).Return(nil, errs.NewInternalError("SEARCH_ERROR", "search error"))
+
+		stream := s.client.SuggestProducts(s.ctx)
+		require.NoError(t, stream.Send(newRequest("ボ")))
+		_, err := stream.Receive()
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+		require.NoError(t, stream.CloseResponse())
+	})
+
+	t.Run("異常系_バリデーションエラー_検索語が長すぎる", func(t *testing.T) {
+		s := setupProductHandler(t)
+		defer s.cleanup()
+
+		stream := s.client.SuggestProducts(s.ctx)
+		require.NoError(t, stream.Send(newRequest(strings.Repeat("あ", 101))))
+		_, err := stream.Receive()
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		require.NoError(t, stream.CloseResponse())
+	})
+}
+
+// TestSuggestLookups は検索語の受信と問合せが交互に進む場合に、古い検索語の結果を送信しないことのテストです。
+func TestSuggestLookups(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, l *suggestLookups)
+	}{
+		{
+			name: "正常系_最後に受信した検索語は問合せを開始して送信できる",
+			run: func(t *testing.T, l *suggestLookups) {
+				seq := l.receive()
+				assert.True(t, l.start(seq, func() {}))
+				assert.True(t, l.isLatest(seq))
+			},
+		},
+		{
+			name: "正常系_取り出してから問合せを開始するまでに新しい検索語を受信すると古い問合せは開始しない",
+			run: func(t *testing.T, l *suggestLookups) {
+				first := l.receive()
+				second := l.receive() // 1件目を取り出した直後に2件目を受信
+				var canceled bool
+				assert.False(t, l.start(first, func() { canceled = true }))
+				assert.True(t, canceled, "開始しない問合せのコンテキストはキャンセルする")
+				assert.True(t, l.start(second, func() {}))
+			},
+		},
+		{
+			name: "正常系_問合せ中に新しい検索語を受信すると古い問合せをキャンセルし、送信しない",
+			run: func(t *testing.T, l *suggestLookups) {
+				first := l.receive()
+				var canceled bool
+				require.True(t, l.start(first, func() { canceled = true }))
+				second := l.receive()
+				assert.True(t, canceled)
+				assert.False(t, l.isLatest(first))
+				assert.True(t, l.isLatest(second))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, &suggestLookups{})
+		})
+	}
+}
+
 // TestCategoryServiceHandlerImpl_ListCategories はListCategoriesメソッドのテストです。
 func TestCategoryServiceHandlerImpl_ListCategories(t *testing.T) {
 	tests := []struct {
@@ -523,11 +1343,339 @@ func TestCategoryServiceHandlerImpl_GetCategoryById(t *testing.T) {
 	}
 }
 
+// TestCategoryServiceHandlerImpl_GetCategoryBySlug はGetCategoryBySlugメソッドのテストです。
+func TestCategoryServiceHandlerImpl_GetCategoryBySlug(t *testing.T) {
+	tests := []struct {
+		name      string
+		slug      string
+		setupMock func(*categoryHandlerSetup)
+		wantErr   bool
+		wantCode  connect.Code
+		wantSlug  string
+		wantMoved bool
+	}{
+		{
+			name: "正常系_現在のスラッグでカテゴリが取得できる",
+			slug: "stationery",
+			setupMock: func(s *categoryHandlerSetup) {
+				category := models.NewCategory("cat1", "Category 1").WithSlug("stationery")
+				s.repo.EXPECT().FindBySlug(gomock.Any(), "stationery").Return(category, nil)
+			},
+			wantSlug:  "stationery",
+			wantMoved: false,
+		},
+		{
+			name: "正常系_変更前のスラッグの場合、現在のスラッグとmovedを返す",
+			slug: "bunbogu",
+			setupMock: func(s *categoryHandlerSetup) {
+				category := models.NewCategory("cat1", "Category 1").WithSlug("stationery")
+				s.repo.EXPECT().FindBySlug(gomock.Any(), "bunbogu").Return(category, nil)
+			},
+			wantSlug:  "stationery",
+			wantMoved: true,
+		},
+		{
+			name: "異常系_カテゴリが見つからない",
+			slug: "furniture",
+			setupMock: func(s *categoryHandlerSetup) {
+				s.repo.EXPECT().FindBySlug(gomock.Any(), "furniture").Return(nil, errs.NewCRUDError("NOT_FOUND", "category not found"))
+			},
+			wantErr:  true,
+			wantCode: connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupCategoryHandler(t)
+			defer s.cleanup()
+
+			tt.setupMock(s)
+
+			req := connect.NewRequest(&query.GetCategoryBySlugRequest{})
+			req.Msg.SetSlug(tt.slug)
+			resp, err := s.client.GetCategoryBySlug(s.ctx, req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "cat1", resp.Msg.GetCategory().GetId())
+				assert.Equal(t, tt.wantSlug, resp.Msg.GetCategory().GetSlug())
+				assert.Equal(t, tt.wantMoved, resp.Msg.GetMoved())
+			}
+		})
+	}
+}
+
+// TestCategoryServiceHandlerImpl_ListChildCategories はListChildCategoriesメソッドのテストです。
+func TestCategoryServiceHandlerImpl_ListChildCategories(t *testing.T) {
+	tests := []struct {
+		name         string
+		parentID     *string
+		setupMock    func(*categoryHandlerSetup)
+		wantErr      bool
+		wantCode     connect.Code
+		validateResp func(t *testing.T, resp *connect.Response[query.ListChildCategoriesResponse])
+	}{
+		{
+			name: "正常系_親カテゴリ未指定の場合ルートカテゴリが取得できる",
+			setupMock: func(s *categoryHandlerSetup) {
+				s.repo.EXPECT().FindChildren(gomock.Any(), "").
+					Return([]*models.Category{models.NewCategory("cat1", "文房具")}, nil)
+			},
+			validateResp: func(t *testing.T, resp *connect.Response[query.ListChildCategoriesResponse]) {
+				require.Len(t, resp.Msg.GetCategories(), 1)
+				assert.Equal(t, "cat1", resp.Msg.GetCategories()[0].GetId())
+				assert.False(t, resp.Msg.GetCategories()[0].HasParentId())
+			},
+		},
+		{
+			name:     "正常系_子カテゴリが取得できる",
+			parentID: ptr("cat1"),
+			setupMock: func(s *categoryHandlerSetup) {
+				s.repo.EXPECT().FindChildren(gomock.Any(), "cat1").
+					Return([]*models.Category{models.NewCategory("cat2", "筆記具").WithParentId("cat1")}, nil)
+			},
+			validateResp: func(t *testing.T, resp *connect.Response[query.ListChildCategoriesResponse]) {
+				require.Len(t, resp.Msg.GetCategories(), 1)
+				assert.Equal(t, "cat2", resp.Msg.GetCategories()[0].GetId())
+				assert.Equal(t, "cat1", resp.Msg.GetCategories()[0].GetParentId())
+			},
+		},
+		{
+			name:     "異常系_親カテゴリが見つからない",
+			parentID: ptr("nonexistent"),
+			setupMock: func(s *categoryHandlerSetup) {
+				s.repo.EXPECT().FindChildren(gomock.Any(), "nonexistent").
+					Return(nil, errs.NewCRUDError("NOT_FOUND", "category not found"))
+			},
+			wantErr:  true,
+			wantCode: connect.CodeNotFound,
+		},
+		{
+			name:     "異常系_バリデーションエラー_親カテゴリIDが空",
+			parentID: ptr(""),
+			wantErr:  true,
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupCategoryHandler(t)
+			defer s.cleanup()
+
+			if tt.setupMock != nil {
+				tt.setupMock(s)
+			}
+
+			req := connect.NewRequest(&query.ListChildCategoriesRequest{})
+			if tt.parentID != nil {
+				req.Msg.SetParentId(*tt.parentID)
+			}
+			resp, err := s.client.ListChildCategories(s.ctx, req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+			} else {
+				require.NoError(t, err)
+				tt.validateResp(t, resp)
+			}
+		})
+	}
+}
+
+// TestCategoryServiceHandlerImpl_GetCategoryAncestors はGetCategoryAncestorsメソッドのテストです。
+func TestCategoryServiceHandlerImpl_GetCategoryAncestors(t *testing.T) {
+	tests := []struct {
+		name       string
+		categoryID string
+		setupMock  func(*categoryHandlerSetup)
+		wantErr    bool
+		wantCode   connect.Code
+		wantNames  []string
+	}{
+		{
+			name:       "正常系_ルートから指定カテゴリまでのカテゴリが取得できる",
+			categoryID: "cat3",
+			setupMock: func(s *categoryHandlerSetup) {
+				s.repo.EXPECT().FindAncestors(gomock.Any(), "cat3").Return([]*models.Category{
+					models.NewCategory("cat1", "文房具"),
+					models.NewCategory("cat2", "筆記具").WithParentId("cat1"),
+					models.NewCategory("cat3", "ボールペン").WithParentId("cat2"),
+				}, nil)
+			},
+			wantNames: []string{"文房具", "筆記具", "ボールペン"},
+		},
+		{
+			name:       "異常系_カテゴリが見つからない",
+			categoryID: "nonexistent",
+			setupMock: func(s *categoryHandlerSetup) {
+				s.repo.EXPECT().FindAncestors(gomock.Any(), "nonexistent").
+					Return(nil, errs.NewCRUDError("NOT_FOUND", "category not found"))
+			},
+			wantErr:  true,
+			wantCode: connect.CodeNotFound,
+		},
+		{
+			name:       "異常系_バリデーションエラー_IDが空",
+			categoryID: "",
+			wantErr:    true,
+			wantCode:   connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupCategoryHandler(t)
+			defer s.cleanup()
+
+			if tt.setupMock != nil {
+				tt.setupMock(s)
+			}
+
+			req := connect.NewRequest(&query.GetCategoryAncestorsRequest{})
+			req.Msg.SetId(tt.categoryID)
+			resp, err := s.client.GetCategoryAncestors(s.ctx, req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			names := make([]string, 0, len(resp.Msg.GetCategories()))
+			for _, c := range resp.Msg.GetCategories() {
+				names = append(names, c.GetName())
+			}
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
+
+// TestTagServiceHandlerImpl_ListTags はListTagsメソッドのテストです。
+func TestTagServiceHandlerImpl_ListTags(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(*tagHandlerSetup)
+		wantErr   bool
+		wantCode  connect.Code
+		validate  func(t *testing.T, resp *connect.Response[query.ListTagsResponse])
+	}{
+		{
+			name: "正常系_タグ一覧が商品数とともに取得できる",
+			setupMock: func(s *tagHandlerSetup) {
+				s.repo.EXPECT().ListWithUsage(gomock.Any()).Return([]*models.TagUsage{
+					models.NewTagUsage(models.NewTag("tag1", "ワイヤレス"), 3),
+					models.NewTagUsage(models.NewTag("tag2", "ゲーミング"), 0),
+				}, nil)
+			},
+			validate: func(t *testing.T, resp *connect.Response[query.ListTagsResponse]) {
+				tags := resp.Msg.GetTags()
+				require.Len(t, tags, 2)
+				assert.Equal(t, "tag1", tags[0].GetTag().GetId())
+				assert.Equal(t, "ワイヤレス", tags[0].GetTag().GetName())
+				assert.Equal(t, int32(3), tags[0].GetProductCount())
+				assert.Equal(t, "tag2", tags[1].GetTag().GetId())
+				assert.Equal(t, int32(0), tags[1].GetProductCount())
+			},
+		},
+		{
+			name: "正常系_タグが存在しない場合は空のリストを返す",
+			setupMock: func(s *tagHandlerSetup) {
+				s.repo.EXPECT().ListWithUsage(gomock.Any()).Return([]*models.TagUsage{}, nil)
+			},
+			validate: func(t *testing.T, resp *connect.Response[query.ListTagsResponse]) {
+				assert.Empty(t, resp.Msg.GetTags())
+			},
+		},
+		{
+			name: "異常系_リポジトリエラー",
+			setupMock: func(s *tagHandlerSetup) {
+				s.repo.EXPECT().ListWithUsage(gomock.Any()).Return(nil, errs.NewInternalError("database", "database error"))
+			},
+			wantErr:  true,
+			wantCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupTagHandler(t)
+			defer s.cleanup()
+
+			tt.setupMock(s)
+
+			resp, err := s.client.ListTags(s.ctx, connect.NewRequest(&query.ListTagsRequest{}))
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			tt.validate(t, resp)
+		})
+	}
+}
+
+// TestCategoryServiceHandlerImpl_GetCategorySubtree はGetCategorySubtreeメソッドのテストです。
+func TestCategoryServiceHandlerImpl_GetCategorySubtree(t *testing.T) {
+	t.Run("正常系_部分木が取得できる", func(t *testing.T) {
+		s := setupCategoryHandler(t)
+		defer s.cleanup()
+
+		pencil := models.NewCategoryNode(models.NewCategory("cat3", "鉛筆").WithParentId("cat2"), nil)
+		writing := models.NewCategoryNode(models.NewCategory("cat2", "筆記具").WithParentId("cat1"), []*models.CategoryNode{pencil})
+		root := models.NewCategoryNode(models.NewCategory("cat1", "文房具"), []*models.CategoryNode{writing})
+		s.repo.EXPECT().FindSubtree(gomock.Any(), "cat1").Return(root, nil)
+
+		req := connect.NewRequest(&query.GetCategorySubtreeRequest{})
+		req.Msg.SetId("cat1")
+		resp, err := s.client.GetCategorySubtree(s.ctx, req)
+
+		require.NoError(t, err)
+		node := resp.Msg.GetRoot()
+		require.NotNil(t, node)
+		assert.Equal(t, "文房具", node.GetCategory().GetName())
+		require.Len(t, node.GetChildren(), 1)
+		assert.Equal(t, "筆記具", node.GetChildren()[0].GetCategory().GetName())
+		require.Len(t, node.GetChildren()[0].GetChildren(), 1)
+		assert.Equal(t, "鉛筆", node.GetChildren()[0].GetChildren()[0].GetCategory().GetName())
+		assert.Equal(t, "cat2", node.GetChildren()[0].GetChildren()[0].GetCategory().GetParentId())
+		assert.Empty(t, node.GetChildren()[0].GetChildren()[0].GetChildren())
+	})
+
+	t.Run("異常系_カテゴリが見つからない", func(t *testing.T) {
+		s := setupCategoryHandler(t)
+		defer s.cleanup()
+
+		s.repo.EXPECT().FindSubtree(gomock.Any(), "nonexistent").
+			Return(nil, errs.NewCRUDError("NOT_FOUND", "category not found"))
+
+		req := connect.NewRequest(&query.GetCategorySubtreeRequest{})
+		req.Msg.SetId("nonexistent")
+		_, err := s.client.GetCategorySubtree(s.ctx, req)
+
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
+
+// ptr は値のポインタを返します。
+func ptr[T any](v T) *T {
+	return &v
+}
+
 // productHandlerSetup はProductServiceHandlerのテスト用セットアップです。
 type productHandlerSetup struct {
 	ctx    context.Context
 	ctrl   *gomock.Controller
 	repo   *mock_repository.MockProductRepository
+	search *mock_repository.MockSearchEngine
 	client queryconnect.ProductServiceClient
 	server *httptest.Server
 }
@@ -539,8 +1687,9 @@ func setupProductHandler(t *testing.T) *productHandlerSetup {
 	ctx := context.Background()
 	ctrl := gomock.NewController(t)
 	repo := mock_repository.NewMockProductRepository(ctrl)
+	search := mock_repository.NewMockSearchEngine(ctrl)
 
-	handler, err := NewProductServiceHandlerImpl(testhelpers.TestLogger, repo)
+	handler, err := NewProductServiceHandlerImpl(testhelpers.TestLogger, repo, search, &TaxConfig{Rounding: money.RoundingFloor})
 	require.NoError(t, err)
 
 	reqRespLogger := interceptor.NewReqRespLogger(testhelpers.TestLogger)
@@ -553,10 +1702,14 @@ func setupProductHandler(t *testing.T) *productHandlerSetup {
 		connect.WithInterceptors(
 			reqRespLogger,
 			validator.NewUnaryInterceptor(),
+			validator.NewStreamInterceptor(),
 		),
 	)
 	mux.Handle(path, handlerWithInterceptors)
-	testServer := httptest.NewServer(mux)
+	// 双方向ストリーミングにはHTTP/2が必要
+	testServer := httptest.NewUnstartedServer(mux)
+	testServer.EnableHTTP2 = true
+	testServer.StartTLS()
 
 	client := queryconnect.NewProductServiceClient(testServer.Client(), testServer.URL)
 
@@ -564,6 +1717,7 @@ func setupProductHandler(t *testing.T) *productHandlerSetup {
 		ctx:    ctx,
 		ctrl:   ctrl,
 		repo:   repo,
+		search: search,
 		client: client,
 		server: testServer,
 	}
@@ -575,6 +1729,45 @@ func (s *productHandlerSetup) cleanup() {
 	s.ctrl.Finish()
 }
 
+// tagHandlerSetup はTagServiceHandlerのテスト用セットアップです。
+type tagHandlerSetup struct {
+	ctx    context.Context
+	ctrl   *gomock.Controller
+	repo   *mock_repository.MockTagRepository
+	client queryconnect.TagServiceClient
+	server *httptest.Server
+}
+
+// setupTagHandler はTagServiceHandlerのテストセットアップを作成します。
+func setupTagHandler(t *testing.T) *tagHandlerSetup {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := mock_repository.NewMockTagRepository(ctrl)
+
+	handler, err := NewTagServiceHandlerImpl(testhelpers.TestLogger, repo)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	path, h := queryconnect.NewTagServiceHandler(handler)
+	mux.Handle(path, h)
+	testServer := httptest.NewServer(mux)
+
+	return &tagHandlerSetup{
+		ctx:    context.Background(),
+		ctrl:   ctrl,
+		repo:   repo,
+		client: queryconnect.NewTagServiceClient(testServer.Client(), testServer.URL),
+		server: testServer,
+	}
+}
+
+// cleanup はテストセットアップのクリーンアップを行います。
+func (s *tagHandlerSetup) cleanup() {
+	s.server.Close()
+	s.ctrl.Finish()
+}
+
 // categoryHandlerSetup はCategoryServiceHandlerのテスト用セットアップです。
 type categoryHandlerSetup struct {
 	ctx    context.Context
@@ -668,6 +1861,15 @@ func TestToCategoryProto(t *testing.T) {
 
 		assert.Equal(t, "cat1", proto.GetId())
 		assert.Equal(t, "Category 1", proto.GetName())
+		assert.False(t, proto.HasParentId())
+	})
+
+	t.Run("親カテゴリIDが設定される", func(t *testing.T) {
+		category := models.NewCategory("cat2", "Category 2").WithParentId("cat1")
+		proto := toCategoryProto(category)
+
+		assert.True(t, proto.HasParentId())
+		assert.Equal(t, "cat1", proto.GetParentId())
 	})
 }
 
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/service/query/internal/domain/models"
+	"golang.org/x/text/language"
+)
+
+// ACCEPT_LANGUAGE_HEADER はクライアントが優先するロケールを指定するリクエストヘッダです。
+// ゲートウェイはHTTPリクエストのAccept-Languageヘッダをそのまま転送します。
+const ACCEPT_LANGUAGE_HEADER = "Accept-Language"
+
+// preferredLocales は商品名・カテゴリ名の選択に使用するロケールを優先度の高い順に返します。
+// リクエストでロケールが指定された場合はそのロケールのみを使用し、
+// 指定されていない場合はAccept-Languageヘッダの品質値の順に使用します。
+// 解釈できないロケールは無視し、いずれも解釈できない場合は空（既定のロケール）を返します。
+//
+// Parameters:
+//   - requested: リクエストで指定されたロケール（未指定の場合はエンプティ）
+//   - header: リクエストヘッダ
+//
+// Returns:
+//   - []string: 優先するロケール（「en」「en-US」形式）
+func preferredLocales(requested string, header http.Header) []string {
+	if requested != "" {
+		tag, err := language.Parse(requested)
+		if err != nil {
+			return nil
+		}
+		return []string{tag.String()}
+	}
+	// NOTE: 一部の値が不正な場合も解釈できた値は返されるため、エラーは無視する
+	tags, _, _ := language.ParseAcceptLanguage(header.Get(ACCEPT_LANGUAGE_HEADER))
+	locales := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag == language.Und {
+			continue
+		}
+		locales = append(locales, tag.String())
+	}
+	return locales
+}
+
+// localizeProducts は商品名とカテゴリ名を優先するロケールの名前に置き換えます。
+//
+// Parameters:
+//   - products: 商品
+//   - locales: 優先するロケール（優先度の高い順）
+//
+// Returns:
+//   - []*models.Product: 名前を置き換えた商品
+func localizeProducts(products []*models.Product, locales []string) []*models.Product {
+	result := make([]*models.Product, len(products))
+	for i, product := range products {
+		result[i] = product.Localize(locales)
+	}
+	return result
+}
@@ -1,43 +1,47 @@
 package server
 
 import (
-	"fmt"
 	"log/slog"
 	"net/http"
-	"time"
 
 	"connectrpc.com/connect"
 	"connectrpc.com/grpchealth"
 	"connectrpc.com/grpcreflect"
+	query "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1"
 	queryconnect "github.com/haru-256/practical-go-grpc-micro-service/api/gen/go/query/v1/queryv1connect"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/httpserver"
 	interceptor "github.com/haru-256/practical-go-grpc-micro-service/pkg/connect/interceptor"
-	"github.com/spf13/viper"
-	"golang.org/x/net/http2"
-	"golang.org/x/net/http2/h2c"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/log"
 )
 
 // QueryServer はgRPCクエリサービスのHTTPサーバーをラップする構造体です。
 type QueryServer struct {
-	Server *http.Server
+	Server   *http.Server              // HTTPサーバー
+	Health   *grpchealth.StaticChecker // ヘルスチェック（停止時にNOT_SERVINGにする）
+	Services []string                  // ヘルスチェックに登録したサービス名
 }
 
 // NewQueryServer はQueryServerの新しいインスタンスを作成します。
 //
 // Parameters:
-//   - addr: サーバーがリッスンするアドレス (例: ":8080", "localhost:8080")
+//   - cfg: HTTPサーバーの設定（アドレス、タイムアウト、ストリーミングRPCの期限）
 //   - logger: 構造化ロギング用のslogロガー
+//   - admin: ログレベルを変更する管理用エンドポイントのハンドラ
 //   - csh: カテゴリサービスのgRPCハンドラ実装
+//   - psh: 商品サービスのgRPCハンドラ実装
+//   - tsh: タグサービスのgRPCハンドラ実装
 //
 // Returns:
 //   - *QueryServer: 初期化されたQueryServerインスタンス
 //   - error: 初期化中にエラーが発生した場合のエラー (現在は常にnil)
-func NewQueryServer(viper *viper.Viper, logger *slog.Logger, csh queryconnect.CategoryServiceHandler, psh queryconnect.ProductServiceHandler) (*QueryServer, error) {
-	reqRespLogger := interceptor.NewReqRespLogger(logger)
+func NewQueryServer(cfg *httpserver.Config, logger *slog.Logger, admin *log.AdminHandler, csh queryconnect.CategoryServiceHandler, psh queryconnect.ProductServiceHandler, tsh queryconnect.TagServiceHandler) (*QueryServer, error) {
+	// リクエスト・レスポンスのログはrpcグループとしてレベルを個別に変更できるようにする
+	reqRespLogger := interceptor.NewReqRespLogger(log.Named(logger, "rpc"))
 	validator, err := interceptor.NewValidator(logger)
 	if err != nil {
 		return nil, err
 	}
-	interceptors := connect.WithInterceptors(reqRespLogger, validator.NewUnaryInterceptor())
+	interceptors := connect.WithInterceptors(reqRespLogger, validator.NewUnaryInterceptor(), validator.NewStreamInterceptor())
 
 	mux := http.NewServeMux()
 
@@ -46,30 +50,28 @@ func NewQueryServer(viper *viper.Viper, logger *slog.Logger, csh queryconnect.Ca
 	mux.Handle(path, handler)
 	path, handler = queryconnect.NewProductServiceHandler(psh, interceptors)
 	mux.Handle(path, handler)
+	path, handler = queryconnect.NewTagServiceHandler(tsh, interceptors)
+	mux.Handle(path, handler)
 
 	// ヘルスチェックハンドラの登録
 	// 標準的なパス: /grpc.health.v1.Health/Check が自動で作られる
-	checker := grpchealth.NewStaticChecker(
+	services := []string{
 		queryconnect.CategoryServiceName, // カテゴリサービスを登録
 		queryconnect.ProductServiceName,  // プロダクトサービスを登録
-	)
+		queryconnect.TagServiceName,      // タグサービスを登録
+	}
+	checker := grpchealth.NewStaticChecker(services...)
 	mux.Handle(grpchealth.NewHandler(checker))
 
 	// reflection
-	reflector := grpcreflect.NewStaticReflector(
-		queryconnect.CategoryServiceName,
-		queryconnect.ProductServiceName,
-	)
+	reflector := grpcreflect.NewStaticReflector(services...)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
 
-	server := &http.Server{
-		Addr:         fmt.Sprintf("%s:%s", viper.GetString("server.host"), viper.GetString("server.port")),
-		Handler:      h2c.NewHandler(mux, &http2.Server{}),
-		ReadTimeout:  10 * time.Second,
-		WriteTimeout: 10 * time.Second,
-		// HTTP Keep-Aliveのタイムアウト設定
-		IdleTimeout: 120 * time.Second,
-	}
-	return &QueryServer{Server: server}, nil
+	// ログレベルの管理用エンドポイント（admin.tokenが未設定の場合は404を返す）
+	mux.Handle(log.AdminPath, admin)
+
+	// ストリーミングRPCはサーバー全体の書き込みのタイムアウトの対象外にし、RPCごとの期限を設定する
+	server := httpserver.New(cfg, mux, httpserver.StreamingProcedures(query.File_query_v1_query_proto))
+	return &QueryServer{Server: server, Health: checker, Services: services}, nil
 }
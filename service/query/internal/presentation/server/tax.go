@@ -0,0 +1,35 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/money"
+	"github.com/haru-256/practical-go-grpc-micro-service/pkg/utils"
+	"github.com/spf13/viper"
+)
+
+// TaxConfig は税込価格の計算設定を保持します。
+type TaxConfig struct {
+	Rounding money.Rounding // 消費税額の端数処理の方法
+}
+
+// NewTaxConfig はViperから設定を読み込みTaxConfigを生成します。
+//
+// Parameters:
+//   - v: Viperインスタンス
+//
+// Returns:
+//   - *TaxConfig: 税込価格の計算設定
+//   - error: 設定の読み込みに失敗した場合、または未対応の端数処理の方法の場合のエラー
+func NewTaxConfig(v *viper.Viper) (*TaxConfig, error) {
+	var configErrors []error
+	value := utils.GetKey[string](v, "tax.rounding", &configErrors)
+	if len(configErrors) > 0 {
+		return nil, errors.Join(configErrors...)
+	}
+	rounding, err := money.ParseRounding(value)
+	if err != nil {
+		return nil, err
+	}
+	return &TaxConfig{Rounding: rounding}, nil
+}